@@ -20,6 +20,8 @@ import (
 	"syscall"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -30,9 +32,11 @@ import (
 	"github.com/steveyegge/gastown/controller/internal/beadswatcher"
 	"github.com/steveyegge/gastown/controller/internal/config"
 	"github.com/steveyegge/gastown/controller/internal/daemonclient"
+	"github.com/steveyegge/gastown/controller/internal/metrics"
 	"github.com/steveyegge/gastown/controller/internal/podmanager"
 	"github.com/steveyegge/gastown/controller/internal/reconciler"
 	"github.com/steveyegge/gastown/controller/internal/statusreporter"
+	"github.com/steveyegge/gastown/controller/internal/tracing"
 )
 
 func main() {
@@ -43,6 +47,16 @@ func main() {
 		"daemon", fmt.Sprintf("%s:%d", cfg.DaemonHost, cfg.DaemonPort),
 		"namespace", cfg.Namespace)
 
+	shutdownTracing, err := tracing.Init(context.Background(), "gt-controller")
+	if err != nil {
+		logger.Warn("tracing disabled", "error", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = shutdownTracing(ctx)
+	}()
+
 	k8sClient, err := buildK8sClient(cfg.KubeConfig)
 	if err != nil {
 		logger.Error("failed to create K8s client", "error", err)
@@ -68,10 +82,11 @@ func main() {
 		watcher = beadswatcher.NewNATSWatcher(beadswatcher.NATSConfig{
 			NatsURL:      cfg.NatsURL,
 			ConsumerName: consumerName,
+			HookEvents:   cfg.NatsHookEvents,
 			Config:       watcherCfg,
 		}, logger)
 		logger.Info("using JetStream transport for beads events",
-			"nats_url", cfg.NatsURL, "consumer", consumerName)
+			"nats_url", cfg.NatsURL, "consumer", consumerName, "hook_events", cfg.NatsHookEvents)
 	default:
 		watcher = beadswatcher.NewSSEWatcher(watcherCfg, logger)
 		logger.Info("using SSE transport for beads events")
@@ -84,6 +99,9 @@ func main() {
 		Token:   cfg.DaemonToken,
 	})
 	status := statusreporter.NewHTTPReporter(daemon, k8sClient, cfg.Namespace, logger)
+	if cfg.ResourceMetrics {
+		status.SetUsageCollector(statusreporter.NewKubeletUsageCollector(k8sClient, logger))
+	}
 
 	// Populate rig cache from daemon rig beads.
 	cfg.RigCache = make(map[string]config.RigCacheEntry)
@@ -165,7 +183,7 @@ func run(ctx context.Context, logger *slog.Logger, cfg *config.Config, k8sClient
 	// Run reconciler once at startup to catch beads created during downtime.
 	if rec != nil {
 		logger.Info("running startup reconciliation")
-		if err := rec.Reconcile(ctx); err != nil {
+		if err := rec.StartupReconcile(ctx, 5, 2*time.Second); err != nil {
 			logger.Warn("startup reconciliation failed", "error", err)
 		}
 	}
@@ -200,6 +218,19 @@ func run(ctx context.Context, logger *slog.Logger, cfg *config.Config, k8sClient
 	}
 	go runPeriodicSync(ctx, logger, status, rec, daemon, cfg, syncInterval)
 
+	gate := newRestartGate(cfg, daemon, logger)
+	dispatch := func(event beadswatcher.Event, retry bool) {
+		if !gate.admit(ctx, event, retry) {
+			return
+		}
+		if err := handleEvent(ctx, logger, cfg, event, pods, status); err != nil {
+			logger.Error("failed to handle event", "type", event.Type, "agent", event.AgentName, "error", err)
+			eventsHandled.Inc(string(event.Type), "error")
+			return
+		}
+		eventsHandled.Inc(string(event.Type), "ok")
+	}
+
 	controllerReady.Store(true)
 	logger.Info("controller ready, waiting for beads events",
 		"sync_interval", syncInterval)
@@ -210,9 +241,10 @@ func run(ctx context.Context, logger *slog.Logger, cfg *config.Config, k8sClient
 			if !ok {
 				return nil // channel closed, watcher shut down
 			}
-			if err := handleEvent(ctx, logger, cfg, event, pods, status); err != nil {
-				logger.Error("failed to handle event", "type", event.Type, "agent", event.AgentName, "error", err)
-			}
+			dispatch(event, false)
+
+		case event := <-gate.retries:
+			dispatch(event, true)
 
 		case err := <-watcherDone:
 			return fmt.Errorf("watcher stopped: %w", err)
@@ -269,7 +301,7 @@ func runPeriodicSync(ctx context.Context, logger *slog.Logger, status statusrepo
 }
 
 // handleEvent translates a beads lifecycle event into K8s pod operations.
-func handleEvent(ctx context.Context, logger *slog.Logger, cfg *config.Config, event beadswatcher.Event, pods podmanager.Manager, status statusreporter.Reporter) error {
+func handleEvent(ctx context.Context, logger *slog.Logger, cfg *config.Config, event beadswatcher.Event, pods podmanager.Manager, status statusreporter.Reporter) (err error) {
 	logger.Info("handling beads event",
 		"type", event.Type, "rig", event.Rig, "role", event.Role,
 		"agent", event.AgentName, "bead", event.BeadID)
@@ -281,10 +313,19 @@ func handleEvent(ctx context.Context, logger *slog.Logger, cfg *config.Config, e
 		agentBeadID = fmt.Sprintf("gt-%s-%s-%s", event.Rig, event.Role, event.AgentName)
 	}
 
+	// Events are traced under the agent bead; gt sling links to it.
+	ctx, span := tracing.Start(ctx, agentBeadID, string(event.Type),
+		tracing.AttrAgent.String(event.AgentName), tracing.AttrRig.String(event.Rig))
+	defer func() { tracing.End(span, err) }()
+
 	switch event.Type {
 	case beadswatcher.AgentSpawn:
 		spec := buildAgentPodSpec(cfg, event)
-		if err := pods.CreateAgentPod(ctx, spec); err != nil {
+		_, podSpan := tracing.Start(ctx, agentBeadID, "create_pod",
+			attribute.String("k8s.pod.name", spec.PodName()), attribute.String("k8s.namespace.name", spec.Namespace))
+		err := pods.CreateAgentPod(ctx, spec)
+		tracing.End(podSpan, err)
+		if err != nil {
 			return err
 		}
 		// Backend metadata (coop_url) is written by SyncAll once the pod has an IP.
@@ -302,6 +343,13 @@ func handleEvent(ctx context.Context, logger *slog.Logger, cfg *config.Config, e
 		podName := fmt.Sprintf("gt-%s-%s-%s", event.Rig, event.Role, event.AgentName)
 		ns := namespaceFromEvent(event, cfg.Namespace)
 		err := pods.DeleteAgentPod(ctx, podName, ns)
+		// Killed crew lose their workspace; done/stopped crew keep it so a
+		// restart resumes where they left off.
+		if event.Type == beadswatcher.AgentKill && event.Role == "crew" {
+			if pvcErr := pods.DeleteWorkspacePVC(ctx, podmanager.WorkspaceClaimName(podName), ns); pvcErr != nil {
+				logger.Warn("failed to delete workspace PVC", "pod", podName, "error", pvcErr)
+			}
+		}
 		// Clear backend metadata so stale Coop URLs don't linger.
 		_ = status.ReportBackendMetadata(ctx, agentBeadID, statusreporter.BackendMetadata{})
 		// Report done status to beads regardless of delete error.
@@ -379,9 +427,13 @@ func BuildSpecFromBeadInfo(cfg *config.Config, rig, role, agentName string, meta
 
 	defaults := podmanager.DefaultPodDefaultsForRole(role)
 	podmanager.ApplyDefaults(&spec, defaults)
+	applyWorkspaceConfig(cfg, &spec)
 
 	// Apply rig-level overrides from rig bead metadata.
 	applyRigDefaults(cfg, &spec)
+	applyWorkspaceMetadata(&spec, metadata)
+	applyResourceOverrides(cfg, &spec, metadata)
+	applySchedulingOverrides(cfg, &spec, metadata)
 
 	applyCommonConfig(cfg, &spec)
 
@@ -414,9 +466,13 @@ func buildAgentPodSpec(cfg *config.Config, event beadswatcher.Event) podmanager.
 	// Apply role-specific defaults (workspace storage, resources).
 	defaults := podmanager.DefaultPodDefaultsForRole(event.Role)
 	podmanager.ApplyDefaults(&spec, defaults)
+	applyWorkspaceConfig(cfg, &spec)
 
 	// Apply rig-level overrides from rig bead metadata.
 	applyRigDefaults(cfg, &spec)
+	applyWorkspaceMetadata(&spec, event.Metadata)
+	applyResourceOverrides(cfg, &spec, event.Metadata)
+	applySchedulingOverrides(cfg, &spec, event.Metadata)
 
 	// Overlay event metadata for optional fields.
 	if sa := event.Metadata["service_account"]; sa != "" {
@@ -460,9 +516,116 @@ func applyRigDefaults(cfg *config.Config, spec *podmanager.AgentPodSpec) {
 	}
 }
 
+// applyWorkspaceConfig applies the controller-wide workspace PVC size and
+// storage class to roles that have persistent storage.
+func applyWorkspaceConfig(cfg *config.Config, spec *podmanager.AgentPodSpec) {
+	if spec.WorkspaceStorage == nil {
+		return
+	}
+	if cfg.WorkspaceSize != "" {
+		spec.WorkspaceStorage.Size = cfg.WorkspaceSize
+	}
+	if cfg.WorkspaceStorageClass != "" {
+		spec.WorkspaceStorage.StorageClassName = cfg.WorkspaceStorageClass
+	}
+}
+
+// applyWorkspaceMetadata overlays per-agent workspace_size and storage_class
+// from bead or event metadata. Sizes that don't parse as a quantity are
+// ignored so a typo can't block pod creation.
+func applyWorkspaceMetadata(spec *podmanager.AgentPodSpec, metadata map[string]string) {
+	if spec.WorkspaceStorage == nil {
+		return
+	}
+	if size := metadata["workspace_size"]; size != "" {
+		if _, err := resource.ParseQuantity(size); err == nil {
+			spec.WorkspaceStorage.Size = size
+		}
+	}
+	if sc := metadata["storage_class"]; sc != "" {
+		spec.WorkspaceStorage.StorageClassName = sc
+	}
+}
+
+// applyResourceOverrides layers the configured per-role resources, then
+// per-agent metadata (cpu_request, memory_limit, ...), onto the role's
+// default requests and limits. An invalid layer is skipped as a whole so a
+// bad value can't leave the pod with a request above its limit.
+func applyResourceOverrides(cfg *config.Config, spec *podmanager.AgentPodSpec, metadata map[string]string) {
+	layers := []struct {
+		source    string
+		overrides podmanager.ResourceOverrides
+	}{
+		{"config", roleResourceOverrides(cfg.RoleResources[spec.Role])},
+		{"metadata", podmanager.ResourceOverridesFromMetadata(metadata)},
+	}
+	for _, layer := range layers {
+		if layer.overrides.IsZero() {
+			continue
+		}
+		layer.overrides.GPUResource = cfg.GPUResource
+		merged, err := layer.overrides.Apply(spec.Resources)
+		if err != nil {
+			slog.Warn("ignoring invalid resource overrides",
+				"source", layer.source, "pod", spec.PodName(), "error", err)
+			continue
+		}
+		spec.Resources = merged
+	}
+}
+
+func roleResourceOverrides(r config.RoleResources) podmanager.ResourceOverrides {
+	return podmanager.ResourceOverrides{
+		CPURequest:    r.CPURequest,
+		CPULimit:      r.CPULimit,
+		MemoryRequest: r.MemoryRequest,
+		MemoryLimit:   r.MemoryLimit,
+		GPU:           r.GPU,
+	}
+}
+
+// applySchedulingOverrides adds the configured node selector and
+// tolerations, then per-agent node_selector and tolerations metadata, and
+// finally tolerations for any GPU resources the pod requests. Values that
+// don't parse are logged and skipped.
+func applySchedulingOverrides(cfg *config.Config, spec *podmanager.AgentPodSpec, metadata map[string]string) {
+	for _, layer := range []struct {
+		source       string
+		nodeSelector string
+		tolerations  string
+	}{
+		{"config", cfg.NodeSelector, cfg.Tolerations},
+		{"metadata", metadata[podmanager.MetaNodeSelector], metadata[podmanager.MetaTolerations]},
+	} {
+		selector, err := podmanager.ParseNodeSelector(layer.nodeSelector)
+		if err != nil {
+			slog.Warn("ignoring invalid node selector", "source", layer.source, "pod", spec.PodName(), "error", err)
+			selector = nil
+		}
+		tolerations, err := podmanager.ParseTolerations(layer.tolerations)
+		if err != nil {
+			slog.Warn("ignoring invalid tolerations", "source", layer.source, "pod", spec.PodName(), "error", err)
+			tolerations = nil
+		}
+		podmanager.ApplyScheduling(spec, selector, tolerations)
+	}
+	podmanager.ApplyScheduling(spec, nil, podmanager.GPUTolerations(spec.Resources))
+}
+
 // applyCommonConfig wires controller-level config into an AgentPodSpec.
 // Shared by both BuildSpecFromBeadInfo (reconciler) and buildAgentPodSpec (events).
 func applyCommonConfig(cfg *config.Config, spec *podmanager.AgentPodSpec) {
+	if mode := cfg.WorkloadModes[spec.Role]; mode != "" {
+		kind, err := podmanager.ParseWorkloadKind(mode)
+		if err != nil {
+			slog.Warn("ignoring workload mode", "role", spec.Role, "error", err)
+			kind = podmanager.WorkloadPod
+		}
+		spec.Workload = kind
+	}
+	if spec.Workload == podmanager.WorkloadJob && cfg.JobTTL > 0 {
+		spec.JobTTLSeconds = int32(cfg.JobTTL / time.Second)
+	}
 	if spec.ServiceAccountName == "" && cfg.DefaultServiceAccount != "" {
 		spec.ServiceAccountName = cfg.DefaultServiceAccount
 	}
@@ -472,6 +635,9 @@ func applyCommonConfig(cfg *config.Config, spec *podmanager.AgentPodSpec) {
 	if cfg.DaemonTokenSecret != "" {
 		spec.DaemonTokenSecret = cfg.DaemonTokenSecret
 	}
+	if cfg.AgentTLSSecret != "" {
+		spec.TLSSecret = strings.ReplaceAll(cfg.AgentTLSSecret, "{pod}", spec.PodName())
+	}
 
 	// Git credentials: inject GIT_USERNAME and GIT_TOKEN from secret for clone/push.
 	// Also pass the secret name to init-clone container for private repo clones.
@@ -648,6 +814,7 @@ func refreshRigCache(ctx context.Context, logger *slog.Logger, daemon *daemoncli
 			DefaultBranch: info.DefaultBranch,
 			Image:         info.Image,
 			StorageClass:  info.StorageClass,
+			MaxPolecats:   info.MaxPolecats,
 		}
 	}
 	logger.Info("refreshed rig cache", "count", len(rigs))
@@ -656,9 +823,14 @@ func refreshRigCache(ctx context.Context, logger *slog.Logger, daemon *daemoncli
 // controllerReady is set to true once the main event loop starts.
 var controllerReady atomic.Bool
 
+// eventsHandled counts beads lifecycle events the controller acted on.
+var eventsHandled = metrics.NewCounter("gt_controller_events_total",
+	"Beads lifecycle events handled, by event type and result.", "type", "result")
+
 // startHealthServer runs an HTTP server with /healthz (liveness) and /readyz
 // (readiness) endpoints. The liveness endpoint always returns 200; the
 // readiness endpoint returns 200 only after the controller event loop starts.
+// /metrics serves Prometheus metrics on the same port.
 func startHealthServer(port int, logger *slog.Logger) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
@@ -675,6 +847,8 @@ func startHealthServer(port int, logger *slog.Logger) {
 		}
 	})
 
+	mux.Handle("/metrics", metrics.Handler())
+
 	addr := fmt.Sprintf(":%d", port)
 	go func() {
 		logger.Info("health server listening", "addr", addr)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -164,6 +165,149 @@ func TestBuildAgentPodSpec_BasicFields(t *testing.T) {
 	}
 }
 
+func TestBuildAgentPodSpec_WorkspaceStorageOverrides(t *testing.T) {
+	cfg := testConfig()
+	cfg.WorkspaceSize = "20Gi"
+	cfg.WorkspaceStorageClass = "standard"
+	cfg.RigCache = map[string]config.RigCacheEntry{"gastown": {StorageClass: "gp3"}}
+
+	event := spawnEvent("gastown", "crew", "jane")
+	spec := buildAgentPodSpec(cfg, event)
+	if ws := spec.WorkspaceStorage; ws == nil || ws.Size != "20Gi" || ws.StorageClassName != "gp3" {
+		t.Fatalf("WorkspaceStorage = %+v, want config size and rig storage class", ws)
+	}
+
+	event.Metadata["workspace_size"] = "50Gi"
+	event.Metadata["storage_class"] = "io2"
+	spec = buildAgentPodSpec(cfg, event)
+	if ws := spec.WorkspaceStorage; ws.Size != "50Gi" || ws.StorageClassName != "io2" {
+		t.Errorf("WorkspaceStorage = %+v, want metadata overrides", ws)
+	}
+
+	event.Metadata["workspace_size"] = "huge"
+	spec = buildAgentPodSpec(cfg, event)
+	if spec.WorkspaceStorage.Size != "20Gi" {
+		t.Errorf("invalid workspace_size should be ignored, got %q", spec.WorkspaceStorage.Size)
+	}
+
+	// Roles without persistent storage are unaffected.
+	if spec := buildAgentPodSpec(cfg, spawnEvent("gastown", "polecat", "nux")); spec.WorkspaceStorage != nil {
+		t.Errorf("polecat WorkspaceStorage = %+v, want nil", spec.WorkspaceStorage)
+	}
+}
+
+func TestBuildAgentPodSpec_ResourceOverrides(t *testing.T) {
+	cfg := testConfig()
+	cfg.RoleResources = map[string]config.RoleResources{
+		"refinery": {CPULimit: "8", MemoryLimit: "16Gi"},
+		"witness":  {CPURequest: "250m", CPULimit: "500m"},
+	}
+
+	spec := buildAgentPodSpec(cfg, spawnEvent("gastown", "witness", "hq"))
+	if got := spec.Resources.Limits.Cpu().String(); got != "500m" {
+		t.Errorf("witness cpu limit = %s, want 500m", got)
+	}
+
+	event := spawnEvent("gastown", "refinery", "hq")
+	event.Metadata["memory_limit"] = "32Gi"
+	spec = buildAgentPodSpec(cfg, event)
+	if got := spec.Resources.Limits.Cpu().String(); got != "8" {
+		t.Errorf("refinery cpu limit = %s, want 8 from config", got)
+	}
+	if got := spec.Resources.Limits.Memory().String(); got != "32Gi" {
+		t.Errorf("refinery memory limit = %s, want 32Gi from metadata", got)
+	}
+
+	// A request above the limit rejects the whole metadata layer.
+	event.Metadata["memory_request"] = "64Gi"
+	spec = buildAgentPodSpec(cfg, event)
+	if got := spec.Resources.Limits.Memory().String(); got != "16Gi" {
+		t.Errorf("memory limit = %s, want 16Gi after invalid metadata", got)
+	}
+}
+
+func TestBuildAgentPodSpec_SchedulingOverrides(t *testing.T) {
+	cfg := testConfig()
+	cfg.NodeSelector = "pool=agents"
+	cfg.Tolerations = "dedicated=agents:NoSchedule"
+	cfg.GPUResource = "nvidia.com/gpu"
+
+	event := spawnEvent("gastown", "polecat", "nux")
+	event.Metadata["node_selector"] = "pool=gpu,gpu-type=a100"
+	event.Metadata["gpu"] = "1"
+	spec := buildAgentPodSpec(cfg, event)
+
+	if spec.NodeSelector["pool"] != "gpu" || spec.NodeSelector["gpu-type"] != "a100" || spec.NodeSelector["kubernetes.io/arch"] != "amd64" {
+		t.Errorf("NodeSelector = %v", spec.NodeSelector)
+	}
+	if gpu := spec.Resources.Limits["nvidia.com/gpu"]; gpu.Value() != 1 {
+		t.Errorf("GPU limit = %s, want 1", gpu.String())
+	}
+	keys := map[string]bool{}
+	for _, tol := range spec.Tolerations {
+		keys[tol.Key] = true
+	}
+	if !keys["dedicated"] || !keys["nvidia.com/gpu"] {
+		t.Errorf("Tolerations = %+v, want config and GPU tolerations", spec.Tolerations)
+	}
+
+	// Invalid metadata is skipped; config still applies.
+	event.Metadata["node_selector"] = "not a selector"
+	spec = buildAgentPodSpec(cfg, event)
+	if spec.NodeSelector["pool"] != "agents" {
+		t.Errorf("NodeSelector = %v, want config pool after invalid metadata", spec.NodeSelector)
+	}
+}
+
+func TestBuildAgentPodSpec_WorkloadModes(t *testing.T) {
+	cfg := testConfig()
+	cfg.WorkloadModes = map[string]string{"crew": "deployment", "polecat": "job", "witness": "daemonset"}
+	cfg.JobTTL = 10 * time.Minute
+
+	if spec := buildAgentPodSpec(cfg, spawnEvent("gastown", "crew", "jane")); spec.Workload != podmanager.WorkloadDeployment {
+		t.Errorf("crew Workload = %q, want deployment", spec.Workload)
+	}
+	spec := buildAgentPodSpec(cfg, spawnEvent("gastown", "polecat", "nux"))
+	if spec.Workload != podmanager.WorkloadJob || spec.JobTTLSeconds != 600 {
+		t.Errorf("polecat Workload = %q, TTL = %d; want job, 600", spec.Workload, spec.JobTTLSeconds)
+	}
+	if spec := buildAgentPodSpec(cfg, spawnEvent("gastown", "witness", "hq")); spec.Workload != podmanager.WorkloadPod {
+		t.Errorf("invalid mode should fall back to pod, got %q", spec.Workload)
+	}
+	if spec := buildAgentPodSpec(cfg, spawnEvent("gastown", "refinery", "hq")); spec.Workload != "" {
+		t.Errorf("unconfigured role Workload = %q, want empty (bare pod)", spec.Workload)
+	}
+}
+
+func TestHandleEvent_KillCrewDeletesWorkspacePVC(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	logger := slog.Default()
+	cfg := testConfig()
+	pods := podmanager.New(client, logger)
+	reporter := newRecordingReporter(client, cfg.Namespace, logger)
+	ctx := context.Background()
+
+	for _, agent := range []string{"jane", "max"} {
+		if err := handleEvent(ctx, logger, cfg, spawnEvent("gastown", "crew", agent), pods, reporter); err != nil {
+			t.Fatalf("spawn %s: %v", agent, err)
+		}
+	}
+	if err := handleEvent(ctx, logger, cfg, killEvent("gastown", "crew", "jane"), pods, reporter); err != nil {
+		t.Fatalf("kill: %v", err)
+	}
+	if err := handleEvent(ctx, logger, cfg, doneEvent("gastown", "crew", "max"), pods, reporter); err != nil {
+		t.Fatalf("done: %v", err)
+	}
+
+	pvcs := client.CoreV1().PersistentVolumeClaims("gastown")
+	if _, err := pvcs.Get(ctx, "gt-gastown-crew-jane-workspace", metav1.GetOptions{}); err == nil {
+		t.Error("killed crew workspace PVC should be deleted")
+	}
+	if _, err := pvcs.Get(ctx, "gt-gastown-crew-max-workspace", metav1.GetOptions{}); err != nil {
+		t.Errorf("done crew workspace PVC should be kept: %v", err)
+	}
+}
+
 func TestHandleEvent_SpawnWithCoopReportsBackendMetadata(t *testing.T) {
 	client := fake.NewSimpleClientset()
 	logger := slog.Default()
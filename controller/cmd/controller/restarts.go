@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/controller/internal/beadswatcher"
+	"github.com/steveyegge/gastown/controller/internal/config"
+	"github.com/steveyegge/gastown/controller/internal/daemonclient"
+	"github.com/steveyegge/gastown/controller/internal/restarttracker"
+)
+
+// restartGate sits in front of handleEvent and applies backoff and the
+// hourly restart cap to AgentStuck events. Deferred events come back on
+// retries once their backoff expires.
+type restartGate struct {
+	tracker  *restarttracker.Tracker
+	escalate func(ctx context.Context, event beadswatcher.Event, recent int) error
+	retries  chan beadswatcher.Event
+	logger   *slog.Logger
+
+	mu     sync.Mutex
+	timers map[string]*time.Timer // pending retry per deferred agent
+}
+
+func newRestartGate(cfg *config.Config, daemon *daemonclient.DaemonClient, logger *slog.Logger) *restartGate {
+	g := &restartGate{
+		tracker: restarttracker.New(restarttracker.Policy{
+			BaseBackoff:  cfg.RestartBackoff,
+			MaxBackoff:   cfg.RestartBackoffMax,
+			MaxPerWindow: cfg.MaxRestartsPerHour,
+		}),
+		retries: make(chan beadswatcher.Event, 16),
+		logger:  logger,
+		timers:  make(map[string]*time.Timer),
+	}
+	g.escalate = func(ctx context.Context, event beadswatcher.Event, recent int) error {
+		if daemon == nil {
+			return fmt.Errorf("no daemon client configured")
+		}
+		id, err := daemon.CreateBead(ctx, crashLoopDecision(event, recent))
+		if err != nil {
+			return err
+		}
+		logger.Info("filed crash-loop decision", "agent", event.AgentName, "decision", id)
+		return nil
+	}
+	return g
+}
+
+// admit reports whether event should be handled now. retry is true for
+// events coming back from g.retries.
+func (g *restartGate) admit(ctx context.Context, event beadswatcher.Event, retry bool) bool {
+	key := fmt.Sprintf("gt-%s-%s-%s", event.Rig, event.Role, event.AgentName)
+
+	switch event.Type {
+	case beadswatcher.AgentDone, beadswatcher.AgentKill, beadswatcher.AgentStop:
+		g.stopRetry(key)
+		g.tracker.Forget(key)
+		return true
+	case beadswatcher.AgentStuck:
+	default:
+		return true
+	}
+
+	if retry {
+		g.stopRetry(key)
+		// The agent finished or was killed after its restart was deferred
+		// (the timer may have fired before Forget stopped it).
+		if !g.tracker.Deferred(key) {
+			g.logger.Debug("dropping stale stuck retry", "agent", key)
+			return false
+		}
+	}
+
+	v := g.tracker.Evaluate(key, retry)
+	switch v.Action {
+	case restarttracker.Restart:
+		g.tracker.Record(key)
+		return true
+
+	case restarttracker.Defer:
+		g.logger.Info("backing off stuck agent restart",
+			"agent", key, "wait", v.Wait, "recent_restarts", v.Recent)
+		g.scheduleRetry(ctx, key, event, v.Wait)
+		return false
+
+	case restarttracker.Escalate:
+		g.logger.Warn("stuck agent hit restart cap, escalating",
+			"agent", key, "recent_restarts", v.Recent)
+		if err := g.escalate(ctx, event, v.Recent); err != nil {
+			g.logger.Error("failed to file crash-loop decision", "agent", key, "error", err)
+		}
+		return false
+
+	default:
+		g.logger.Debug("ignoring stuck event", "agent", key, "reason", v.Action)
+		return false
+	}
+}
+
+// scheduleRetry sends event back on g.retries after wait, replacing any
+// retry already pending for key.
+func (g *restartGate) scheduleRetry(ctx context.Context, key string, event beadswatcher.Event, wait time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if t := g.timers[key]; t != nil {
+		t.Stop()
+	}
+	g.timers[key] = time.AfterFunc(wait, func() {
+		select {
+		case g.retries <- event:
+		case <-ctx.Done():
+		}
+	})
+}
+
+// stopRetry cancels the pending retry for key, if any.
+func (g *restartGate) stopRetry(key string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if t := g.timers[key]; t != nil {
+		t.Stop()
+		delete(g.timers, key)
+	}
+}
+
+// crashLoopDecision builds the decision bead filed when an agent keeps
+// getting stuck.
+func crashLoopDecision(event beadswatcher.Event, recent int) daemonclient.CreateBeadRequest {
+	agent := fmt.Sprintf("%s/%s/%s", event.Rig, event.Role, event.AgentName)
+	return daemonclient.CreateBeadRequest{
+		Title:     fmt.Sprintf("Agent %s is crash-looping", agent),
+		IssueType: "decision",
+		Priority:  1,
+		Labels: []string{
+			"crash-loop",
+			"rig:" + event.Rig,
+			"role:" + event.Role,
+			"agent:" + event.AgentName,
+		},
+		Description: fmt.Sprintf(
+			"Agent %s (bead %s) was restarted %d times in the last %s after being "+
+				"detected as stuck. The controller has stopped restarting it.\n\n"+
+				"Options:\n"+
+				"1. Investigate the pod and restart the agent manually\n"+
+				"2. Kill the agent and reassign its work\n",
+			agent, event.BeadID, recent, restarttracker.Window),
+	}
+}
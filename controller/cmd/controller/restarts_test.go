@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/controller/internal/beadswatcher"
+)
+
+func TestRestartGate_BackoffAndEscalation(t *testing.T) {
+	cfg := testConfig()
+	cfg.RestartBackoff = 20 * time.Millisecond
+	cfg.RestartBackoffMax = 20 * time.Millisecond
+	cfg.MaxRestartsPerHour = 2
+
+	gate := newRestartGate(cfg, nil, slog.Default())
+	var escalated []int
+	gate.escalate = func(_ context.Context, _ beadswatcher.Event, recent int) error {
+		escalated = append(escalated, recent)
+		return nil
+	}
+	ctx := context.Background()
+	stuck := stuckEvent("gastown", "polecat", "nux")
+
+	if !gate.admit(ctx, stuck, false) {
+		t.Fatal("first stuck event should restart immediately")
+	}
+	if gate.admit(ctx, stuck, false) {
+		t.Fatal("second stuck event should be deferred")
+	}
+	if gate.admit(ctx, stuck, false) {
+		t.Fatal("duplicate stuck event should be dropped while a retry is pending")
+	}
+
+	select {
+	case retry := <-gate.retries:
+		if !gate.admit(ctx, retry, true) {
+			t.Fatal("retry after backoff should restart")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("deferred event was never retried")
+	}
+
+	if gate.admit(ctx, stuck, false) {
+		t.Fatal("stuck event at the cap should not restart")
+	}
+	if len(escalated) != 1 || escalated[0] != 2 {
+		t.Fatalf("escalations = %v, want one at 2 restarts", escalated)
+	}
+
+	// Killing the agent clears its history.
+	if !gate.admit(ctx, killEvent("gastown", "polecat", "nux"), false) {
+		t.Fatal("kill events always pass through")
+	}
+	if !gate.admit(ctx, stuck, false) {
+		t.Error("stuck event after kill should restart immediately")
+	}
+}
+
+func TestRestartGate_ForgetCancelsDeferredRestart(t *testing.T) {
+	cfg := testConfig()
+	cfg.RestartBackoff = 20 * time.Millisecond
+	cfg.RestartBackoffMax = 20 * time.Millisecond
+
+	gate := newRestartGate(cfg, nil, slog.Default())
+	ctx := context.Background()
+	stuck := stuckEvent("gastown", "polecat", "nux")
+
+	if !gate.admit(ctx, stuck, false) {
+		t.Fatal("first stuck event should restart immediately")
+	}
+	if gate.admit(ctx, stuck, false) {
+		t.Fatal("second stuck event should be deferred")
+	}
+	if !gate.admit(ctx, killEvent("gastown", "polecat", "nux"), false) {
+		t.Fatal("kill events always pass through")
+	}
+
+	select {
+	case <-gate.retries:
+		t.Fatal("deferred retry fired after the agent was killed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// A retry that was already queued when the agent was killed is stale.
+	if gate.admit(ctx, stuck, true) {
+		t.Error("stale retry after kill restarted the agent")
+	}
+}
+
+func TestCrashLoopDecision(t *testing.T) {
+	req := crashLoopDecision(stuckEvent("gastown", "crew", "jane"), 5)
+	if req.IssueType != "decision" {
+		t.Errorf("IssueType = %q, want decision", req.IssueType)
+	}
+	if !strings.Contains(req.Title, "gastown/crew/jane") {
+		t.Errorf("Title = %q", req.Title)
+	}
+	if !strings.Contains(req.Description, "restarted 5 times") {
+		t.Errorf("Description = %q", req.Description)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/nats-io/nats.go"
@@ -22,6 +24,11 @@ type NATSConfig struct {
 	// Allows crash recovery and fan-out across replicas.
 	ConsumerName string
 
+	// HookEvents also consumes agent lifecycle events from the HOOK_EVENTS
+	// stream (hooks.agent.*) that coop publishes, using a second durable
+	// consumer named ConsumerName+"-hooks".
+	HookEvents bool
+
 	// Config embeds the common watcher config for building lifecycle events.
 	Config
 }
@@ -29,9 +36,15 @@ type NATSConfig struct {
 // NATSWatcher subscribes to the MUTATION_EVENTS JetStream stream and translates
 // mutation events on agent beads into lifecycle Events. Uses a durable consumer
 // for crash recovery and replay.
+//
+// Delivery is at-least-once: a message is acked only after its event has been
+// handed to the events channel, so a controller restart replays anything it
+// had not yet consumed. Redelivered messages are recognised by their stream
+// sequence and acked without emitting a second event.
 type NATSWatcher struct {
 	cfg    NATSConfig
 	events chan Event
+	seen   *dedupSet
 	logger *slog.Logger
 }
 
@@ -40,6 +53,7 @@ func NewNATSWatcher(cfg NATSConfig, logger *slog.Logger) *NATSWatcher {
 	return &NATSWatcher{
 		cfg:    cfg,
 		events: make(chan Event, 64),
+		seen:   newDedupSet(dedupCapacity),
 		logger: logger,
 	}
 }
@@ -104,7 +118,8 @@ type mutationPayload struct {
 	AgentState string   `json:"agent_state,omitempty"`
 }
 
-// subscribe connects to NATS and subscribes to MUTATION_EVENTS via JetStream.
+// subscribe connects to NATS and consumes MUTATION_EVENTS, plus HOOK_EVENTS
+// when enabled. It returns when ctx is canceled or either consumer fails.
 func (w *NATSWatcher) subscribe(ctx context.Context) error {
 	opts := []nats.Option{
 		nats.Name("gastown-controller"),
@@ -129,22 +144,51 @@ func (w *NATSWatcher) subscribe(ctx context.Context) error {
 		consumerName = "controller"
 	}
 
-	w.logger.Info("subscribing to MUTATION_EVENTS stream",
-		"consumer", consumerName, "url", w.cfg.NatsURL)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	n := 1
+	errs := make(chan error, 2)
+	go func() {
+		errs <- w.consume(ctx, js, "MUTATION_EVENTS", "mutations.>", consumerName, w.mutationEvent)
+	}()
+	if w.cfg.HookEvents {
+		n++
+		go func() {
+			errs <- w.consume(ctx, js, "HOOK_EVENTS", "hooks.agent.>", consumerName+"-hooks", w.hookEvent)
+		}()
+	}
+
+	// The first consumer to stop takes the other down with it so Start
+	// reconnects both together.
+	err = <-errs
+	cancel()
+	for i := 1; i < n; i++ {
+		<-errs
+	}
+	return err
+}
+
+// consume runs a durable pull consumer on subject until ctx is canceled.
+// decode turns each message into an event; malformed or irrelevant messages
+// are acked and skipped.
+func (w *NATSWatcher) consume(ctx context.Context, js nats.JetStreamContext, stream, subject, durable string, decode func(*nats.Msg) (Event, bool)) error {
+	w.logger.Info("subscribing to JetStream stream",
+		"stream", stream, "consumer", durable, "url", w.cfg.NatsURL)
 
 	// Subscribe with a durable pull consumer for reliable delivery.
 	sub, err := js.PullSubscribe(
-		"mutations.>",
-		consumerName,
+		subject,
+		durable,
 		nats.AckExplicit(),
 		nats.DeliverAll(),
 	)
 	if err != nil {
-		return fmt.Errorf("JetStream subscribe: %w", err)
+		return fmt.Errorf("JetStream subscribe to %s: %w", stream, err)
 	}
 	defer func() { _ = sub.Unsubscribe() }()
 
-	w.logger.Info("JetStream subscription active")
+	w.logger.Info("JetStream subscription active", "stream", stream)
 
 	for {
 		select {
@@ -162,24 +206,81 @@ func (w *NATSWatcher) subscribe(ctx context.Context) error {
 			if ctx.Err() != nil {
 				return nil
 			}
-			return fmt.Errorf("JetStream fetch: %w", err)
+			return fmt.Errorf("JetStream fetch from %s: %w", stream, err)
 		}
 
 		for _, msg := range msgs {
-			w.processMessage(msg)
-			if err := msg.Ack(); err != nil {
-				w.logger.Warn("failed to ack message", "error", err)
+			if !w.deliver(ctx, msg, decode) {
+				// Shutting down; unacked messages are redelivered to
+				// the next controller.
+				return nil
 			}
 		}
 	}
 }
 
-// processMessage parses a JetStream message and emits a lifecycle Event if relevant.
-func (w *NATSWatcher) processMessage(msg *nats.Msg) {
+// deliver emits the event for msg and acks it. It blocks while the events
+// channel is full rather than dropping the event, and returns false without
+// acking if ctx is canceled first.
+func (w *NATSWatcher) deliver(ctx context.Context, msg *nats.Msg, decode func(*nats.Msg) (Event, bool)) bool {
+	key := messageKey(msg)
+	if key != "" && w.seen.contains(key) {
+		w.logger.Debug("skipping redelivered JetStream message", "key", key)
+		w.ack(msg)
+		return true
+	}
+
+	if event, ok := decode(msg); ok {
+		w.logger.Info("emitting lifecycle event (JetStream)",
+			"type", event.Type, "rig", event.Rig,
+			"role", event.Role, "agent", event.AgentName,
+			"bead", event.BeadID, "subject", msg.Subject)
+
+		select {
+		case w.events <- event:
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	if key != "" {
+		w.seen.add(key)
+	}
+	w.ack(msg)
+	return true
+}
+
+func (w *NATSWatcher) ack(msg *nats.Msg) {
+	if msg.Sub == nil {
+		return // not bound to a subscription (tests)
+	}
+	if err := msg.Ack(); err != nil {
+		w.logger.Warn("failed to ack message", "subject", msg.Subject, "error", err)
+	}
+}
+
+// messageKey identifies a message across redeliveries: the publisher's
+// Nats-Msg-Id if set, otherwise the stream name and sequence.
+func messageKey(msg *nats.Msg) string {
+	if id := msg.Header.Get(nats.MsgIdHdr); id != "" {
+		return id
+	}
+	if msg.Sub == nil {
+		return ""
+	}
+	meta, err := msg.Metadata()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", meta.Stream, meta.Sequence.Stream)
+}
+
+// mutationEvent decodes a MUTATION_EVENTS message into a lifecycle Event.
+func (w *NATSWatcher) mutationEvent(msg *nats.Msg) (Event, bool) {
 	var payload mutationPayload
 	if err := json.Unmarshal(msg.Data, &payload); err != nil {
 		w.logger.Debug("skipping malformed JetStream message", "error", err)
-		return
+		return Event{}, false
 	}
 
 	// Convert to the mutationEvent format used by the shared mapping logic.
@@ -199,26 +300,113 @@ func (w *NATSWatcher) processMessage(msg *nats.Msg) {
 	}
 
 	if !isAgentBead(raw) {
-		return
+		return Event{}, false
 	}
 
-	// Reuse the SSEWatcher's mapping logic via a temporary SSEWatcher.
-	// The mapping logic only depends on cfg, not on SSE connection state.
-	mapper := &SSEWatcher{cfg: w.cfg.Config, events: w.events, logger: w.logger}
-	event, ok := mapper.mapMutation(raw)
+	return w.mapper().mapMutation(raw)
+}
+
+// hookPayload is the agent lifecycle event coop publishes on
+// hooks.agent.<type>.
+type hookPayload struct {
+	Type      string `json:"type"`
+	BeadID    string `json:"bead_id,omitempty"`
+	Actor     string `json:"actor,omitempty"` // "gastown/polecats/rictus"
+	Rig       string `json:"rig,omitempty"`
+	Role      string `json:"role,omitempty"`
+	Agent     string `json:"agent,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+	Timestamp string `json:"timestamp,omitempty"`
+}
+
+// hookEventTypes maps hook event types to the lifecycle events they imply.
+// Informational hooks (heartbeats, idle, started) are not listed; pod
+// creation itself still comes from the agent bead on MUTATION_EVENTS.
+var hookEventTypes = map[string]EventType{
+	"AgentCrashed":     AgentStuck,
+	"OjAgentEscalated": AgentStuck,
+	"StopLoopDetected": AgentStuck,
+	"OjJobCompleted":   AgentDone,
+}
+
+// hookEvent decodes a HOOK_EVENTS message into a lifecycle Event.
+func (w *NATSWatcher) hookEvent(msg *nats.Msg) (Event, bool) {
+	var payload hookPayload
+	if err := json.Unmarshal(msg.Data, &payload); err != nil {
+		w.logger.Debug("skipping malformed hook event", "subject", msg.Subject, "error", err)
+		return Event{}, false
+	}
+	if payload.Type == "" {
+		// Fall back to the subject: hooks.agent.<type>.
+		payload.Type = msg.Subject[strings.LastIndex(msg.Subject, ".")+1:]
+	}
+
+	eventType, ok := hookEventTypes[payload.Type]
 	if !ok {
-		return
+		return Event{}, false
+	}
+
+	raw := mutationEvent{
+		Type:    "hook",
+		IssueID: payload.BeadID,
+		Actor:   payload.Actor,
+	}
+	if payload.Rig != "" && payload.Role != "" && payload.Agent != "" {
+		raw.Labels = []string{"rig:" + payload.Rig, "role:" + payload.Role, "agent:" + payload.Agent}
 	}
+	event, ok := w.mapper().buildEvent(eventType, raw)
+	if !ok {
+		return Event{}, false
+	}
+	event.Metadata["hook_event"] = payload.Type
+	if payload.Reason != "" {
+		event.Metadata["hook_reason"] = payload.Reason
+	}
+	return event, true
+}
 
-	w.logger.Info("emitting lifecycle event (JetStream)",
-		"type", event.Type, "rig", event.Rig,
-		"role", event.Role, "agent", event.AgentName,
-		"bead", event.BeadID)
+// mapper returns an SSEWatcher to reuse its mapping logic, which only
+// depends on cfg, not on SSE connection state.
+func (w *NATSWatcher) mapper() *SSEWatcher {
+	return &SSEWatcher{cfg: w.cfg.Config, events: w.events, logger: w.logger}
+}
+
+// dedupCapacity bounds how many message keys are remembered. It only needs
+// to cover the redelivery window of a consumer, not the whole stream.
+const dedupCapacity = 4096
+
+// dedupSet is a fixed-size set that forgets its oldest key once full.
+type dedupSet struct {
+	mu    sync.Mutex
+	keys  map[string]struct{}
+	order []string
+	next  int
+}
 
-	select {
-	case w.events <- event:
-	default:
-		w.logger.Warn("event channel full, dropping event",
-			"type", event.Type, "bead", event.BeadID)
+func newDedupSet(capacity int) *dedupSet {
+	return &dedupSet{
+		keys:  make(map[string]struct{}, capacity),
+		order: make([]string, capacity),
+	}
+}
+
+func (d *dedupSet) contains(key string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, ok := d.keys[key]
+	return ok
+}
+
+func (d *dedupSet) add(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.keys[key]; ok {
+		return
+	}
+	if old := d.order[d.next]; old != "" {
+		delete(d.keys, old)
 	}
+	d.order[d.next] = key
+	d.keys[key] = struct{}{}
+	d.next = (d.next + 1) % len(d.order)
 }
@@ -0,0 +1,136 @@
+package beadswatcher
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+)
+
+func newTestNATSWatcher() *NATSWatcher {
+	return NewNATSWatcher(NATSConfig{
+		Config: Config{Namespace: "gastown-test", DefaultImage: "agent:latest"},
+	}, slog.Default())
+}
+
+func TestNATSWatcher_HookEvent(t *testing.T) {
+	w := newTestNATSWatcher()
+
+	msg := &nats.Msg{
+		Subject: "hooks.agent.AgentCrashed",
+		Data:    []byte(`{"type":"AgentCrashed","bead_id":"gt-gastown-polecat-nux","actor":"gastown/polecats/nux","reason":"exit 137"}`),
+	}
+	event, ok := w.hookEvent(msg)
+	if !ok {
+		t.Fatal("expected crash hook to map to an event")
+	}
+	if event.Type != AgentStuck || event.Rig != "gastown" || event.Role != "polecat" || event.AgentName != "nux" {
+		t.Errorf("event = %+v", event)
+	}
+	if event.Metadata["hook_event"] != "AgentCrashed" || event.Metadata["hook_reason"] != "exit 137" {
+		t.Errorf("metadata = %v", event.Metadata)
+	}
+	if event.Metadata["namespace"] != "gastown-test" {
+		t.Errorf("namespace = %q", event.Metadata["namespace"])
+	}
+}
+
+func TestNATSWatcher_HookEventTypeFromSubject(t *testing.T) {
+	w := newTestNATSWatcher()
+
+	msg := &nats.Msg{
+		Subject: "hooks.agent.OjJobCompleted",
+		Data:    []byte(`{"rig":"gastown","role":"crew","agent":"jane"}`),
+	}
+	event, ok := w.hookEvent(msg)
+	if !ok || event.Type != AgentDone || event.AgentName != "jane" {
+		t.Errorf("hookEvent = %+v, %v; want AgentDone for jane", event, ok)
+	}
+}
+
+func TestNATSWatcher_HookEventIgnored(t *testing.T) {
+	w := newTestNATSWatcher()
+
+	for _, msg := range []*nats.Msg{
+		{Subject: "hooks.agent.AgentHeartbeat", Data: []byte(`{"actor":"gastown/polecats/nux"}`)},
+		{Subject: "hooks.agent.AgentCrashed", Data: []byte(`not json`)},
+		{Subject: "hooks.agent.AgentCrashed", Data: []byte(`{}`)},
+	} {
+		if event, ok := w.hookEvent(msg); ok {
+			t.Errorf("%s %s: unexpected event %+v", msg.Subject, msg.Data, event)
+		}
+	}
+}
+
+func TestNATSWatcher_MutationEvent(t *testing.T) {
+	w := newTestNATSWatcher()
+
+	msg := &nats.Msg{
+		Subject: "mutations.create",
+		Data:    []byte(`{"type":"create","issue_id":"gt-gastown-crew-jane","issue_type":"agent","labels":["rig:gastown","role:crew","agent:jane"]}`),
+	}
+	event, ok := w.mutationEvent(msg)
+	if !ok || event.Type != AgentSpawn || event.AgentName != "jane" {
+		t.Errorf("mutationEvent = %+v, %v; want AgentSpawn for jane", event, ok)
+	}
+
+	msg.Data = []byte(`{"type":"create","issue_id":"gt-123","issue_type":"task"}`)
+	if _, ok := w.mutationEvent(msg); ok {
+		t.Error("non-agent bead should be skipped")
+	}
+}
+
+func TestNATSWatcher_DeliverDedupsRedelivery(t *testing.T) {
+	w := newTestNATSWatcher()
+	ctx := context.Background()
+
+	msg := &nats.Msg{
+		Subject: "hooks.agent.AgentCrashed",
+		Header:  nats.Header{nats.MsgIdHdr: []string{"evt-1"}},
+		Data:    []byte(`{"type":"AgentCrashed","actor":"gastown/polecats/nux"}`),
+	}
+	for i := 0; i < 2; i++ {
+		if !w.deliver(ctx, msg, w.hookEvent) {
+			t.Fatalf("delivery %d failed", i)
+		}
+	}
+	if n := len(w.events); n != 1 {
+		t.Fatalf("got %d events, want 1", n)
+	}
+}
+
+func TestNATSWatcher_DeliverBlocksUntilCanceled(t *testing.T) {
+	w := newTestNATSWatcher()
+	for i := 0; i < cap(w.events); i++ {
+		w.events <- Event{}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	msg := &nats.Msg{
+		Subject: "hooks.agent.AgentCrashed",
+		Header:  nats.Header{nats.MsgIdHdr: []string{"evt-1"}},
+		Data:    []byte(`{"type":"AgentCrashed","actor":"gastown/polecats/nux"}`),
+	}
+	if w.deliver(ctx, msg, w.hookEvent) {
+		t.Fatal("deliver should report failure when the channel is full and ctx is done")
+	}
+	if w.seen.contains("evt-1") {
+		t.Error("undelivered message must not be marked as seen")
+	}
+}
+
+func TestDedupSet_Evicts(t *testing.T) {
+	d := newDedupSet(2)
+	for i := 0; i < 3; i++ {
+		d.add(fmt.Sprintf("k%d", i))
+	}
+	if d.contains("k0") {
+		t.Error("oldest key should be evicted")
+	}
+	if !d.contains("k1") || !d.contains("k2") {
+		t.Error("newest keys should be kept")
+	}
+}
@@ -299,6 +299,11 @@ func (w *SSEWatcher) mapMutation(raw mutationEvent) (Event, bool) {
 		if raw.AgentState == "stopping" {
 			return w.buildEvent(AgentStop, raw)
 		}
+		// The daemon's session monitor marks agents stuck when their
+		// terminal output stops changing → restart the pod.
+		if raw.AgentState == "stuck" {
+			return w.buildEvent(AgentStuck, raw)
+		}
 		// Metadata change on agent bead → may need pod update (e.g., sidecar change).
 		return w.buildEvent(AgentUpdate, raw)
 	default:
@@ -341,6 +346,9 @@ func (w *SSEWatcher) buildEvent(eventType EventType, raw mutationEvent) (Event,
 	if w.cfg.DaemonPort != "" {
 		meta["daemon_port"] = w.cfg.DaemonPort
 	}
+	for k, v := range podOverrideLabels(raw.Labels) {
+		meta[k] = v
+	}
 
 	return Event{
 		Type:      eventType,
@@ -352,6 +360,32 @@ func (w *SSEWatcher) buildEvent(eventType EventType, raw mutationEvent) (Event,
 	}, true
 }
 
+// podOverrideKeys are bead label keys copied into event metadata so the
+// controller can size and place an individual agent's pod.
+var podOverrideKeys = map[string]bool{
+	"cpu_request":    true,
+	"cpu_limit":      true,
+	"memory_request": true,
+	"memory_limit":   true,
+	"gpu":            true,
+	"node_selector":  true,
+	"tolerations":    true,
+	"workspace_size": true,
+	"storage_class":  true,
+}
+
+// podOverrideLabels returns "key:value" labels whose key is in podOverrideKeys.
+func podOverrideLabels(labels []string) map[string]string {
+	out := make(map[string]string)
+	for _, label := range labels {
+		k, v, ok := strings.Cut(label, ":")
+		if ok && podOverrideKeys[k] {
+			out[k] = v
+		}
+	}
+	return out
+}
+
 // extractAgentInfo extracts rig, role, and agent name from a mutation event.
 //
 // Strategy (in priority order):
@@ -232,6 +232,26 @@ func TestMapMutation_Create(t *testing.T) {
 	}
 }
 
+func TestMapMutation_CopiesPodOverrideLabels(t *testing.T) {
+	w := NewSSEWatcher(Config{Namespace: "test-ns"}, slog.Default())
+	raw := mutationEvent{
+		Type:    "create",
+		Actor:   "gastown/refinery/hq",
+		IssueID: "gt-abc",
+		Labels:  []string{"gt:agent", "cpu_limit:8", "memory_limit:16Gi", "priority:high"},
+	}
+	event, ok := w.mapMutation(raw)
+	if !ok {
+		t.Fatal("mapMutation should return true for create")
+	}
+	if event.Metadata["cpu_limit"] != "8" || event.Metadata["memory_limit"] != "16Gi" {
+		t.Errorf("Metadata = %v, want cpu_limit and memory_limit", event.Metadata)
+	}
+	if _, ok := event.Metadata["priority"]; ok {
+		t.Error("unrelated labels should not be copied into metadata")
+	}
+}
+
 func TestMapMutation_StatusClosed(t *testing.T) {
 	w := NewSSEWatcher(Config{}, slog.Default())
 	raw := mutationEvent{
@@ -303,6 +323,18 @@ func TestMapMutation_Update(t *testing.T) {
 	}
 }
 
+func TestMapMutation_UpdateStuck(t *testing.T) {
+	w := NewSSEWatcher(Config{}, slog.Default())
+	raw := mutationEvent{Type: "update", Actor: "gastown/polecats/rictus", AgentState: "stuck"}
+	event, ok := w.mapMutation(raw)
+	if !ok {
+		t.Fatal("mapMutation should return true for a stuck agent")
+	}
+	if event.Type != AgentStuck {
+		t.Errorf("event type = %q, want %q", event.Type, AgentStuck)
+	}
+}
+
 func TestBuildEvent_Metadata(t *testing.T) {
 	w := NewSSEWatcher(Config{
 		Namespace:    "test-ns",
@@ -2,9 +2,12 @@
 package config
 
 import (
+	"encoding/json"
 	"flag"
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -54,6 +57,12 @@ type Config struct {
 	// Injected as BD_DAEMON_TOKEN in agent pods.
 	DaemonTokenSecret string
 
+	// AgentTLSSecret is the K8s secret with agent client certificates for
+	// mutual TLS to the daemon (env: AGENT_TLS_SECRET). "{pod}" in the name is
+	// replaced by the pod name, so each agent can get a certificate naming
+	// only its own address.
+	AgentTLSSecret string
+
 	// TownName is the Gas Town deployment name (env: GT_TOWN_NAME).
 	// Used to set GT_TOWN_NAME in agent pods for workspace + materialization scope.
 	TownName string
@@ -103,6 +112,11 @@ type Config struct {
 	// Default: "controller-<namespace>".
 	NatsConsumerName string
 
+	// NatsHookEvents also consumes agent lifecycle events from the HOOK_EVENTS
+	// stream when Transport is "nats" (env: NATS_HOOK_EVENTS). Crashes and
+	// escalations then reach the controller without waiting for a sync pass.
+	NatsHookEvents bool
+
 	// SyncInterval is how often to reconcile pod statuses with beads (env: SYNC_INTERVAL).
 	// Default: 60s.
 	SyncInterval time.Duration
@@ -135,11 +149,79 @@ type Config struct {
 	// Default: 8081. Set to 0 to disable.
 	HealthPort int
 
+	// WorkspaceSize is the workspace PVC size for roles with persistent
+	// storage (env: WORKSPACE_SIZE). Empty keeps the role default (10Gi).
+	// Bead metadata "workspace_size" overrides per agent.
+	WorkspaceSize string
+
+	// WorkspaceStorageClass is the workspace PVC storage class
+	// (env: WORKSPACE_STORAGE_CLASS). Empty keeps the role default.
+	// Rig settings and bead metadata "storage_class" take precedence.
+	WorkspaceStorageClass string
+
+	// RoleResources overrides the default CPU/memory requests and limits per
+	// role (env: ROLE_RESOURCES, a JSON object keyed by role, e.g.
+	// {"refinery":{"cpuLimit":"8","memoryLimit":"16Gi"}}). Bead metadata
+	// (cpu_request, memory_limit, ...) overrides these per agent.
+	RoleResources map[string]RoleResources
+
+	// NodeSelector is a comma-separated key=value list added to every agent
+	// pod's node selector (env: AGENT_NODE_SELECTOR). Bead metadata
+	// "node_selector" adds to it per agent.
+	NodeSelector string
+
+	// Tolerations is a comma-separated key[=value][:Effect] list added to
+	// every agent pod (env: AGENT_TOLERATIONS). Bead metadata "tolerations"
+	// adds to it per agent.
+	Tolerations string
+
+	// GPUResource is the extended resource name used for GPU requests
+	// (env: GPU_RESOURCE, default: nvidia.com/gpu).
+	GPUResource string
+
+	// RestartBackoff is the minimum wait between restarts of a stuck agent,
+	// doubled for each further restart within an hour (env: RESTART_BACKOFF).
+	// Default: 30s.
+	RestartBackoff time.Duration
+
+	// RestartBackoffMax caps the wait between restarts (env: RESTART_BACKOFF_MAX).
+	// Default: 10m.
+	RestartBackoffMax time.Duration
+
+	// MaxRestartsPerHour is how many times a stuck agent is restarted within
+	// an hour before the controller stops and files a decision bead
+	// (env: MAX_RESTARTS_PER_HOUR). 0 = unlimited. Default: 5.
+	MaxRestartsPerHour int
+
+	// WorkloadModes maps role to the Kubernetes object that owns its pods:
+	// "pod" (default), "deployment", or "job" (env: AGENT_WORKLOAD_MODES,
+	// e.g. "crew=deployment,polecat=job").
+	WorkloadModes map[string]string
+
+	// JobTTL is how long finished agent Jobs are kept before Kubernetes
+	// garbage-collects them (env: JOB_TTL_AFTER_FINISHED). Default: 1h.
+	JobTTL time.Duration
+
+	// ResourceMetrics enables per-pod CPU, memory, and workspace disk usage
+	// reporting to agent beads (env: RESOURCE_METRICS). Requires "get" on
+	// nodes/proxy to read kubelet stats. Default: false.
+	ResourceMetrics bool
+
 	// RigCache maps rig name → metadata, populated at runtime from rig beads
 	// in the daemon. Not parsed from env/flags.
 	RigCache map[string]RigCacheEntry
 }
 
+// RoleResources holds compute quantities for one role. Empty fields keep
+// the built-in default.
+type RoleResources struct {
+	CPURequest    string `json:"cpuRequest,omitempty"`
+	CPULimit      string `json:"cpuLimit,omitempty"`
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+	MemoryLimit   string `json:"memoryLimit,omitempty"`
+	GPU           string `json:"gpu,omitempty"` // whole GPUs, e.g. "1"
+}
+
 // RigCacheEntry holds rig metadata from daemon rig beads.
 type RigCacheEntry struct {
 	Prefix        string // e.g., "bd", "gt"
@@ -149,6 +231,10 @@ type RigCacheEntry struct {
 	// Per-rig pod customization (from rig bead labels).
 	Image        string // Override agent image for this rig
 	StorageClass string // Override PVC storage class
+
+	// MaxPolecats caps the rig's concurrent polecat pods (rig bead label
+	// "max_polecats", set by gt rig config set --global). 0 = unlimited.
+	MaxPolecats int
 }
 
 // Parse reads configuration from flags and environment variables.
@@ -167,6 +253,7 @@ func Parse() *Config {
 		CoopBuiltin:    envBoolOr("COOP_BUILTIN", false),
 		CredentialsSecret: os.Getenv("CLAUDE_CREDENTIALS_SECRET"),
 		DaemonTokenSecret: os.Getenv("DAEMON_TOKEN_SECRET"),
+		AgentTLSSecret:    os.Getenv("AGENT_TLS_SECRET"),
 		TownName:          envOr("GT_TOWN_NAME", "town"),
 		GitCredentialsSecret: os.Getenv("GIT_CREDENTIALS_SECRET"),
 		NatsURL:               os.Getenv("NATS_URL"),
@@ -179,6 +266,7 @@ func Parse() *Config {
 		DefaultServiceAccount: os.Getenv("DEFAULT_SERVICE_ACCOUNT"),
 		Transport:         envOr("WATCHER_TRANSPORT", "sse"),
 		NatsConsumerName:  os.Getenv("NATS_CONSUMER_NAME"),
+		NatsHookEvents:    envBoolOr("NATS_HOOK_EVENTS", false),
 		SyncInterval:      envDurationOr("SYNC_INTERVAL", 60*time.Second),
 		MaxConcurrentPods:      envIntOr("MAX_CONCURRENT_PODS", 0),
 		SpawnBurstLimit:        envIntOr("SPAWN_BURST_LIMIT", 3),
@@ -186,6 +274,18 @@ func Parse() *Config {
 		LeaderElectionID:       envOr("LEADER_ELECTION_ID", "agent-controller-leader"),
 		LeaderElectionIdentity: envOr("POD_NAME", hostname()),
 		HealthPort:             envIntOr("HEALTH_PORT", 8081),
+		ResourceMetrics:        envBoolOr("RESOURCE_METRICS", false),
+		WorkspaceSize:          os.Getenv("WORKSPACE_SIZE"),
+		WorkspaceStorageClass:  os.Getenv("WORKSPACE_STORAGE_CLASS"),
+		RoleResources:          envRoleResources("ROLE_RESOURCES"),
+		NodeSelector:           os.Getenv("AGENT_NODE_SELECTOR"),
+		Tolerations:            os.Getenv("AGENT_TOLERATIONS"),
+		GPUResource:            envOr("GPU_RESOURCE", "nvidia.com/gpu"),
+		RestartBackoff:         envDurationOr("RESTART_BACKOFF", 30*time.Second),
+		RestartBackoffMax:      envDurationOr("RESTART_BACKOFF_MAX", 10*time.Minute),
+		MaxRestartsPerHour:     envIntOr("MAX_RESTARTS_PER_HOUR", 5),
+		WorkloadModes:          parseKeyValues(os.Getenv("AGENT_WORKLOAD_MODES")),
+		JobTTL:                 envDurationOr("JOB_TTL_AFTER_FINISHED", time.Hour),
 	}
 
 	flag.StringVar(&cfg.DaemonHost, "daemon-host", cfg.DaemonHost, "BD Daemon hostname")
@@ -200,6 +300,7 @@ func Parse() *Config {
 	flag.BoolVar(&cfg.CoopBuiltin, "coop-builtin", cfg.CoopBuiltin, "Agent image has coop built-in (HTTP probes, no sidecar)")
 	flag.StringVar(&cfg.CredentialsSecret, "credentials-secret", cfg.CredentialsSecret, "K8s secret with Claude OAuth credentials")
 	flag.StringVar(&cfg.DaemonTokenSecret, "daemon-token-secret", cfg.DaemonTokenSecret, "K8s secret with daemon auth token for agent pods")
+	flag.StringVar(&cfg.AgentTLSSecret, "agent-tls-secret", cfg.AgentTLSSecret, "K8s secret with agent mTLS client certificates ({pod} expands to the pod name)")
 	flag.StringVar(&cfg.TownName, "town-name", cfg.TownName, "Gas Town deployment name")
 	flag.StringVar(&cfg.GitCredentialsSecret, "git-credentials-secret", cfg.GitCredentialsSecret, "K8s secret with git credentials (username/token keys)")
 	flag.StringVar(&cfg.NatsURL, "nats-url", cfg.NatsURL, "NATS server URL for event bus")
@@ -212,12 +313,23 @@ func Parse() *Config {
 	flag.StringVar(&cfg.DefaultServiceAccount, "default-service-account", cfg.DefaultServiceAccount, "K8s ServiceAccount for agent pods")
 	flag.StringVar(&cfg.Transport, "transport", cfg.Transport, "Event transport: sse or nats")
 	flag.StringVar(&cfg.NatsConsumerName, "nats-consumer-name", cfg.NatsConsumerName, "Durable consumer name for JetStream")
+	flag.BoolVar(&cfg.NatsHookEvents, "nats-hook-events", cfg.NatsHookEvents, "Also consume agent lifecycle events from HOOK_EVENTS")
 	flag.DurationVar(&cfg.SyncInterval, "sync-interval", cfg.SyncInterval, "Interval for periodic pod status sync")
 	flag.IntVar(&cfg.MaxConcurrentPods, "max-concurrent-pods", cfg.MaxConcurrentPods, "Max agent pods (0=unlimited)")
 	flag.IntVar(&cfg.SpawnBurstLimit, "spawn-burst-limit", cfg.SpawnBurstLimit, "Max pods to create per reconcile pass")
 	flag.BoolVar(&cfg.LeaderElection, "leader-election", cfg.LeaderElection, "Enable K8s lease-based leader election")
 	flag.StringVar(&cfg.LeaderElectionID, "leader-election-id", cfg.LeaderElectionID, "Name of the Lease resource for leader election")
 	flag.IntVar(&cfg.HealthPort, "health-port", cfg.HealthPort, "HTTP health endpoint port (0 to disable)")
+	flag.StringVar(&cfg.WorkspaceSize, "workspace-size", cfg.WorkspaceSize, "Workspace PVC size for persistent roles (e.g., 20Gi)")
+	flag.StringVar(&cfg.WorkspaceStorageClass, "workspace-storage-class", cfg.WorkspaceStorageClass, "Workspace PVC storage class")
+	flag.StringVar(&cfg.NodeSelector, "agent-node-selector", cfg.NodeSelector, "Node selector for agent pods (k=v,...)")
+	flag.StringVar(&cfg.Tolerations, "agent-tolerations", cfg.Tolerations, "Tolerations for agent pods (key[=value][:Effect],...)")
+	flag.StringVar(&cfg.GPUResource, "gpu-resource", cfg.GPUResource, "Extended resource name for GPU requests")
+	flag.DurationVar(&cfg.RestartBackoff, "restart-backoff", cfg.RestartBackoff, "Initial backoff between stuck-agent restarts")
+	flag.DurationVar(&cfg.RestartBackoffMax, "restart-backoff-max", cfg.RestartBackoffMax, "Maximum backoff between stuck-agent restarts")
+	flag.IntVar(&cfg.MaxRestartsPerHour, "max-restarts-per-hour", cfg.MaxRestartsPerHour, "Stuck-agent restarts per hour before escalating (0=unlimited)")
+	flag.DurationVar(&cfg.JobTTL, "job-ttl", cfg.JobTTL, "How long finished agent Jobs are kept")
+	flag.BoolVar(&cfg.ResourceMetrics, "resource-metrics", cfg.ResourceMetrics, "Report pod resource usage to agent beads (needs nodes/proxy access)")
 	flag.Parse()
 
 	return cfg
@@ -258,6 +370,33 @@ func envDurationOr(key string, fallback time.Duration) time.Duration {
 	return fallback
 }
 
+// parseKeyValues parses "k1=v1,k2=v2". Entries without "=" are skipped.
+func parseKeyValues(s string) map[string]string {
+	out := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if ok && k != "" {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// envRoleResources parses a JSON role → resources map. Malformed values are
+// reported on stderr and ignored so the controller still starts with defaults.
+func envRoleResources(key string) map[string]RoleResources {
+	v := os.Getenv(key)
+	if v == "" {
+		return nil
+	}
+	var out map[string]RoleResources
+	if err := json.Unmarshal([]byte(v), &out); err != nil {
+		fmt.Fprintf(os.Stderr, "ignoring %s: %v\n", key, err)
+		return nil
+	}
+	return out
+}
+
 func hostname() string {
 	h, err := os.Hostname()
 	if err != nil {
@@ -47,3 +47,29 @@ func TestEnvIntOr(t *testing.T) {
 		}
 	})
 }
+
+func TestEnvRoleResources(t *testing.T) {
+	t.Setenv("TEST_ROLE_RESOURCES", `{"refinery":{"cpuLimit":"8","memoryLimit":"16Gi"},"witness":{"memoryRequest":"256Mi"}}`)
+	got := envRoleResources("TEST_ROLE_RESOURCES")
+	if got["refinery"].CPULimit != "8" || got["refinery"].MemoryLimit != "16Gi" {
+		t.Errorf("refinery = %+v", got["refinery"])
+	}
+	if got["witness"].MemoryRequest != "256Mi" {
+		t.Errorf("witness = %+v", got["witness"])
+	}
+
+	t.Setenv("TEST_ROLE_RESOURCES", "not json")
+	if got := envRoleResources("TEST_ROLE_RESOURCES"); got != nil {
+		t.Errorf("malformed value = %+v, want nil", got)
+	}
+}
+
+func TestParseKeyValues(t *testing.T) {
+	got := parseKeyValues("crew=deployment, polecat=job,bogus,=x")
+	if len(got) != 2 || got["crew"] != "deployment" || got["polecat"] != "job" {
+		t.Errorf("parseKeyValues() = %v", got)
+	}
+	if got := parseKeyValues(""); len(got) != 0 {
+		t.Errorf("parseKeyValues(\"\") = %v, want empty", got)
+	}
+}
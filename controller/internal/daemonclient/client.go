@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -123,6 +124,11 @@ func (c *DaemonClient) ListAgentBeads(ctx context.Context) ([]AgentBead, error)
 		if !hasLabel(issue.Labels, "execution_target:k8s") {
 			continue
 		}
+		// gt pause --drain suspends K8s polecats; they get no pod until
+		// gt resume --town sets them spawning again.
+		if issue.AgentState == "suspended" {
+			continue
+		}
 		// Prefer explicit labels (rig:X, role:Y, agent:Z) over ID parsing.
 		rig, role, name := extractFromLabels(issue.Labels)
 		if rig == "" || role == "" || name == "" {
@@ -134,12 +140,21 @@ func (c *DaemonClient) ListAgentBeads(ctx context.Context) ([]AgentBead, error)
 		if role == "" || name == "" {
 			continue
 		}
+		metadata := parseNotes(issue.Notes)
+		// gt sling records a limit override as a label so the notes
+		// rewrites from status sync can't drop it.
+		if v := labelValue(issue.Labels, "limit_override"); v != "" {
+			if metadata == nil {
+				metadata = make(map[string]string)
+			}
+			metadata["limit_override"] = v
+		}
 		beads = append(beads, AgentBead{
 			ID:        issue.ID,
 			Rig:       rig,
 			Role:      role,
 			AgentName: name,
-			Metadata:  parseNotes(issue.Notes),
+			Metadata:  metadata,
 		})
 	}
 
@@ -154,6 +169,7 @@ type RigInfo struct {
 	DefaultBranch  string // Default branch (e.g., "main")
 	Image          string // Per-rig agent image override
 	StorageClass   string // Per-rig PVC storage class override
+	MaxPolecats    int    // Per-rig concurrent polecat cap (0 = unlimited)
 }
 
 // ListRigBeads queries the daemon for rig beads (type=rig) and extracts
@@ -217,6 +233,8 @@ func (c *DaemonClient) ListRigBeads(ctx context.Context) (map[string]RigInfo, er
 				info.Image = parts[1]
 			case "storage_class":
 				info.StorageClass = parts[1]
+			case "max_polecats":
+				info.MaxPolecats, _ = strconv.Atoi(parts[1])
 			}
 		}
 		if name != "" {
@@ -296,6 +314,56 @@ func (c *DaemonClient) UpdateAgentState(ctx context.Context, beadID, state strin
 	return nil
 }
 
+// CreateBeadRequest describes a new bead for CreateBead.
+type CreateBeadRequest struct {
+	Title       string
+	Description string
+	IssueType   string // e.g., "decision", "bug"
+	Priority    int    // 0 (critical) to 4 (backlog)
+	Labels      []string
+}
+
+// CreateBead creates a bead via the daemon HTTP API and returns its ID.
+// The controller uses this to escalate problems it can't fix on its own.
+func (c *DaemonClient) CreateBead(ctx context.Context, bead CreateBeadRequest) (string, error) {
+	body := map[string]interface{}{
+		"title":       bead.Title,
+		"description": bead.Description,
+		"issue_type":  bead.IssueType,
+		"priority":    bead.Priority,
+		"labels":      bead.Labels,
+	}
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("encoding request: %w", err)
+	}
+
+	url := c.baseURL + "/bd.v1.BeadsService/Create"
+	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonBody)))
+	if err != nil {
+		return "", fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("daemon request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("daemon returned status %d for create %q", resp.StatusCode, bead.Title)
+	}
+	var created issueJSON
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return "", fmt.Errorf("decoding response: %w", err)
+	}
+	return created.ID, nil
+}
+
 // extractFromLabels extracts rig, role, and agent name from bead labels.
 func extractFromLabels(labels []string) (rig, role, name string) {
 	for _, label := range labels {
@@ -315,6 +383,16 @@ func extractFromLabels(labels []string) (rig, role, name string) {
 	return rig, role, name
 }
 
+// labelValue returns the value of the first "key:value" label for key.
+func labelValue(labels []string, key string) string {
+	for _, l := range labels {
+		if v, ok := strings.CutPrefix(l, key+":"); ok {
+			return v
+		}
+	}
+	return ""
+}
+
 // hasLabel checks if a label exists in the list.
 func hasLabel(labels []string, target string) bool {
 	for _, l := range labels {
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriteText(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("gt_test_total", "Things counted.", "kind")
+	c.Inc("a")
+	c.Add(2, `we"ird`)
+	r.NewGaugeFunc("gt_test_gauge", "A computed gauge.", func() float64 { return 3 })
+	h := r.NewHistogram("gt_test_seconds", "Latency.", []float64{0.1, 1}, "op")
+	h.Observe(0.05, "x")
+	h.Observe(0.5, "x")
+	h.Observe(5, "x")
+
+	var b strings.Builder
+	if err := r.WriteText(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := `# HELP gt_test_gauge A computed gauge.
+# TYPE gt_test_gauge gauge
+gt_test_gauge 3
+# HELP gt_test_seconds Latency.
+# TYPE gt_test_seconds histogram
+gt_test_seconds_bucket{op="x",le="0.1"} 1
+gt_test_seconds_bucket{op="x",le="1"} 2
+gt_test_seconds_bucket{op="x",le="+Inf"} 3
+gt_test_seconds_sum{op="x"} 5.55
+gt_test_seconds_count{op="x"} 3
+# HELP gt_test_total Things counted.
+# TYPE gt_test_total counter
+gt_test_total{kind="a"} 1
+gt_test_total{kind="we\"ird"} 2
+`
+	if b.String() != want {
+		t.Errorf("WriteText =\n%s\nwant\n%s", b.String(), want)
+	}
+}
@@ -233,20 +233,14 @@ func DefaultPodDefaultsForRole(role string) *PodDefaults {
 	switch role {
 	case "crew":
 		// Crew pods get persistent workspace storage.
-		defaults.WorkspaceStorage = &WorkspaceStorageSpec{
-			Size:             "10Gi",
-			StorageClassName: "gp2",
-		}
+		defaults.WorkspaceStorage = &WorkspaceStorageSpec{Size: "10Gi"}
 	case "polecat":
 		// Polecats use EmptyDir (no WorkspaceStorage).
 	case "mayor", "deacon":
 		// Town-level singletons get persistent storage.
 		// GT_SCOPE and BD_ACTOR are set in buildEnvVars (not here) to avoid
 		// duplicate env vars when ApplyDefaults merges the Env map.
-		defaults.WorkspaceStorage = &WorkspaceStorageSpec{
-			Size:             "10Gi",
-			StorageClassName: "gp2",
-		}
+		defaults.WorkspaceStorage = &WorkspaceStorageSpec{Size: "10Gi"}
 	}
 
 	return defaults
@@ -294,8 +294,8 @@ func TestDefaultPodDefaultsForRole_Mayor(t *testing.T) {
 	if d.WorkspaceStorage.Size != "10Gi" {
 		t.Errorf("workspace size = %q, want %q", d.WorkspaceStorage.Size, "10Gi")
 	}
-	if d.WorkspaceStorage.StorageClassName != "gp2" {
-		t.Errorf("storage class = %q, want %q", d.WorkspaceStorage.StorageClassName, "gp2")
+	if d.WorkspaceStorage.StorageClassName != "" {
+		t.Errorf("storage class = %q, want the cluster default", d.WorkspaceStorage.StorageClassName)
 	}
 	if d.Resources == nil {
 		t.Fatal("Resources should not be nil")
@@ -314,8 +314,8 @@ func TestDefaultPodDefaultsForRole_Deacon(t *testing.T) {
 	if d.WorkspaceStorage.Size != "10Gi" {
 		t.Errorf("workspace size = %q, want %q", d.WorkspaceStorage.Size, "10Gi")
 	}
-	if d.WorkspaceStorage.StorageClassName != "gp2" {
-		t.Errorf("storage class = %q, want %q", d.WorkspaceStorage.StorageClassName, "gp2")
+	if d.WorkspaceStorage.StorageClassName != "" {
+		t.Errorf("storage class = %q, want the cluster default", d.WorkspaceStorage.StorageClassName)
 	}
 	if d.Resources == nil {
 		t.Fatal("Resources should not be nil")
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -44,12 +45,14 @@ const (
 	VolumeTmp          = "tmp"
 	VolumeBeadsConfig  = "beads-config"
 	VolumeClaudeCreds  = "claude-creds"
+	VolumeAgentTLS     = "agent-tls"
 
 	// Mount paths.
 	MountWorkspace   = "/home/agent/gt"
 	MountTmp         = "/tmp"
 	MountBeadsConfig = "/etc/agent-pod"
 	MountClaudeCreds = "/tmp/claude-credentials"
+	MountAgentTLS    = "/etc/gt/tls"
 
 	// Session persistence: state dir on the workspace PVC.
 	MountStateDir = "/home/agent/gt/.state"
@@ -131,6 +134,19 @@ type AgentPodSpec struct {
 	// The "token" key is injected as the BD_DAEMON_TOKEN env var.
 	DaemonTokenSecret string
 
+	// TLSSecret is the K8s Secret name holding the agent's client certificate
+	// (tls.crt, tls.key, ca.crt) for mutual TLS to the daemon. It is mounted
+	// at MountAgentTLS and GT_TLS_DIR points there.
+	TLSSecret string
+
+	// Workload selects whether the pod is created bare (default) or through
+	// a Deployment or Job. See WorkloadKind.
+	Workload WorkloadKind
+
+	// JobTTLSeconds is how long a finished Job is kept when Workload is
+	// WorkloadJob. 0 uses DefaultJobTTLSeconds.
+	JobTTLSeconds int32
+
 	// CoopBuiltin indicates the agent image has coop built into its entrypoint.
 	// When true, the agent container exposes coop HTTP ports (8080/9090) and
 	// uses HTTP probes. No sidecar is added. Mutually exclusive with CoopSidecar.
@@ -191,6 +207,11 @@ type CoopSidecarSpec struct {
 	Resources *corev1.ResourceRequirements
 }
 
+// WorkspaceClaimName returns the default workspace PVC name for a pod.
+func WorkspaceClaimName(podName string) string {
+	return podName + "-workspace"
+}
+
 // WorkspaceStorageSpec configures a PVC-backed workspace volume.
 type WorkspaceStorageSpec struct {
 	// ClaimName is the PVC name. If empty, derived from pod name.
@@ -199,7 +220,8 @@ type WorkspaceStorageSpec struct {
 	// Size is the requested storage (e.g., "10Gi").
 	Size string
 
-	// StorageClassName is the storage class (e.g., "gp3").
+	// StorageClassName is the storage class (e.g., "gp3"). Empty uses the
+	// cluster's default storage class.
 	StorageClassName string
 }
 
@@ -222,6 +244,7 @@ func (s *AgentPodSpec) Labels() map[string]string {
 type Manager interface {
 	CreateAgentPod(ctx context.Context, spec AgentPodSpec) error
 	DeleteAgentPod(ctx context.Context, name, namespace string) error
+	DeleteWorkspacePVC(ctx context.Context, claimName, namespace string) error
 	ListAgentPods(ctx context.Context, namespace string, labelSelector map[string]string) ([]corev1.Pod, error)
 	GetAgentPod(ctx context.Context, name, namespace string) (*corev1.Pod, error)
 }
@@ -239,7 +262,13 @@ func New(client kubernetes.Interface, logger *slog.Logger) *K8sManager {
 
 // CreateAgentPod creates a pod for the given agent spec.
 // If the spec includes WorkspaceStorage, a PVC is created first (idempotent).
-func (m *K8sManager) CreateAgentPod(ctx context.Context, spec AgentPodSpec) error {
+func (m *K8sManager) CreateAgentPod(ctx context.Context, spec AgentPodSpec) (err error) {
+	defer observePodOp("create", time.Now(), &err)
+
+	if err := ValidateResources(spec.Resources); err != nil {
+		return fmt.Errorf("invalid resources for pod %s: %w", spec.PodName(), err)
+	}
+
 	// Ensure PVC exists before creating the pod.
 	if spec.WorkspaceStorage != nil {
 		if err := m.ensurePVC(ctx, spec); err != nil {
@@ -248,10 +277,13 @@ func (m *K8sManager) CreateAgentPod(ctx context.Context, spec AgentPodSpec) erro
 	}
 
 	pod := m.buildPod(spec)
+	if spec.Workload != "" && spec.Workload != WorkloadPod {
+		return m.createWorkload(ctx, spec, pod)
+	}
 	m.logger.Info("creating agent pod",
 		"pod", pod.Name, "rig", spec.Rig, "role", spec.Role, "agent", spec.AgentName)
 
-	_, err := m.client.CoreV1().Pods(spec.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	_, err = m.client.CoreV1().Pods(spec.Namespace).Create(ctx, pod, metav1.CreateOptions{})
 	if err != nil {
 		return fmt.Errorf("creating pod %s: %w", pod.Name, err)
 	}
@@ -263,16 +295,16 @@ func (m *K8sManager) ensurePVC(ctx context.Context, spec AgentPodSpec) error {
 	ws := spec.WorkspaceStorage
 	claimName := ws.ClaimName
 	if claimName == "" {
-		claimName = spec.PodName() + "-workspace"
+		claimName = WorkspaceClaimName(spec.PodName())
 	}
 
 	size := ws.Size
 	if size == "" {
 		size = "10Gi"
 	}
-	storageClass := ws.StorageClassName
-	if storageClass == "" {
-		storageClass = "gp2"
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return fmt.Errorf("invalid workspace size %q: %w", size, err)
 	}
 
 	pvc := &corev1.PersistentVolumeClaim{
@@ -282,17 +314,21 @@ func (m *K8sManager) ensurePVC(ctx context.Context, spec AgentPodSpec) error {
 			Labels:    spec.Labels(),
 		},
 		Spec: corev1.PersistentVolumeClaimSpec{
-			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
-			StorageClassName: &storageClass,
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
 			Resources: corev1.VolumeResourceRequirements{
 				Requests: corev1.ResourceList{
-					corev1.ResourceStorage: resource.MustParse(size),
+					corev1.ResourceStorage: quantity,
 				},
 			},
 		},
 	}
+	// Leave the class unset when none is configured so the cluster's
+	// default storage class applies.
+	if ws.StorageClassName != "" {
+		pvc.Spec.StorageClassName = &ws.StorageClassName
+	}
 
-	_, err := m.client.CoreV1().PersistentVolumeClaims(spec.Namespace).Create(ctx, pvc, metav1.CreateOptions{})
+	_, err = m.client.CoreV1().PersistentVolumeClaims(spec.Namespace).Create(ctx, pvc, metav1.CreateOptions{})
 	if apierrors.IsAlreadyExists(err) {
 		m.logger.Info("workspace PVC already exists", "pvc", claimName)
 		return nil
@@ -300,16 +336,37 @@ func (m *K8sManager) ensurePVC(ctx context.Context, spec AgentPodSpec) error {
 	if err != nil {
 		return fmt.Errorf("creating PVC %s: %w", claimName, err)
 	}
-	m.logger.Info("created workspace PVC", "pvc", claimName, "size", size, "storageClass", storageClass)
+	m.logger.Info("created workspace PVC", "pvc", claimName, "size", size, "storageClass", ws.StorageClassName)
 	return nil
 }
 
-// DeleteAgentPod deletes a pod by name and namespace.
-func (m *K8sManager) DeleteAgentPod(ctx context.Context, name, namespace string) error {
+// DeleteAgentPod deletes an agent's pod by name and namespace. A pod owned
+// by a Deployment or Job is deleted on its own and its controller replaces
+// it. A name with no pod behind it is taken as the canonical agent pod name,
+// and the Deployment or Job of that name is deleted instead.
+func (m *K8sManager) DeleteAgentPod(ctx context.Context, name, namespace string) (err error) {
+	defer observePodOp("delete", time.Now(), &err)
+
+	if _, err := m.client.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{}); apierrors.IsNotFound(err) {
+		deleted, err := m.deleteWorkload(ctx, name, namespace)
+		if err != nil || deleted {
+			return err
+		}
+	}
 	m.logger.Info("deleting agent pod", "pod", name, "namespace", namespace)
 	return m.client.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 }
 
+// DeleteWorkspacePVC deletes a workspace PVC. A missing claim is not an error.
+func (m *K8sManager) DeleteWorkspacePVC(ctx context.Context, claimName, namespace string) error {
+	m.logger.Info("deleting workspace PVC", "pvc", claimName, "namespace", namespace)
+	err := m.client.CoreV1().PersistentVolumeClaims(namespace).Delete(ctx, claimName, metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
 // ListAgentPods lists pods matching the given labels.
 func (m *K8sManager) ListAgentPods(ctx context.Context, namespace string, labelSelector map[string]string) ([]corev1.Pod, error) {
 	sel := labels.Set(labelSelector).String()
@@ -569,6 +626,11 @@ func (m *K8sManager) buildEnvVars(spec AgentPodSpec) []corev1.EnvVar {
 		})
 	}
 
+	// Client certificate for mutual TLS to the daemon.
+	if spec.TLSSecret != "" {
+		envVars = append(envVars, corev1.EnvVar{Name: "GT_TLS_DIR", Value: MountAgentTLS})
+	}
+
 	return envVars
 }
 
@@ -598,7 +660,7 @@ func (m *K8sManager) buildVolumes(spec AgentPodSpec) []corev1.Volume {
 	if spec.WorkspaceStorage != nil {
 		claimName := spec.WorkspaceStorage.ClaimName
 		if claimName == "" {
-			claimName = spec.PodName() + "-workspace"
+			claimName = WorkspaceClaimName(spec.PodName())
 		}
 		volumes = append(volumes, corev1.Volume{
 			Name: VolumeWorkspace,
@@ -649,6 +711,18 @@ func (m *K8sManager) buildVolumes(spec AgentPodSpec) []corev1.Volume {
 		})
 	}
 
+	// Agent TLS volume: Secret mount for the mTLS client certificate.
+	if spec.TLSSecret != "" {
+		volumes = append(volumes, corev1.Volume{
+			Name: VolumeAgentTLS,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: spec.TLSSecret,
+				},
+			},
+		})
+	}
+
 	return volumes
 }
 
@@ -687,6 +761,14 @@ func (m *K8sManager) buildVolumeMounts(spec AgentPodSpec) []corev1.VolumeMount {
 		})
 	}
 
+	if spec.TLSSecret != "" {
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      VolumeAgentTLS,
+			MountPath: MountAgentTLS,
+			ReadOnly:  true,
+		})
+	}
+
 	return mounts
 }
 
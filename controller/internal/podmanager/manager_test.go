@@ -3,6 +3,7 @@ package podmanager
 import (
 	"context"
 	"log/slog"
+	"strings"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -518,6 +519,14 @@ func TestK8sManager_VolumesPVC(t *testing.T) {
 	if ws.PersistentVolumeClaim.ClaimName != "my-pvc" {
 		t.Errorf("PVC claim name = %q, want %q", ws.PersistentVolumeClaim.ClaimName, "my-pvc")
 	}
+
+	pvc, err := client.CoreV1().PersistentVolumeClaims("gastown").Get(ctx, "my-pvc", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sc := pvc.Spec.StorageClassName; sc == nil || *sc != "gp3" {
+		t.Errorf("PVC storage class = %v, want gp3", sc)
+	}
 }
 
 func TestK8sManager_VolumesPVCDefaultClaimName(t *testing.T) {
@@ -534,6 +543,15 @@ func TestK8sManager_VolumesPVCDefaultClaimName(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// No class configured: leave it to the cluster's default storage class
+	pvc, err := client.CoreV1().PersistentVolumeClaims("gastown").Get(ctx, "gt-gastown-crew-jane-workspace", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pvc.Spec.StorageClassName != nil {
+		t.Errorf("PVC storage class = %q, want unset", *pvc.Spec.StorageClassName)
+	}
+
 	pod, _ := client.CoreV1().Pods("gastown").Get(ctx, "gt-gastown-crew-jane", metav1.GetOptions{})
 
 	for _, v := range pod.Spec.Volumes {
@@ -548,6 +566,49 @@ func TestK8sManager_VolumesPVCDefaultClaimName(t *testing.T) {
 	t.Error("workspace PVC volume not found")
 }
 
+func TestK8sManager_InvalidWorkspaceSize(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	mgr := New(client, slog.Default())
+
+	spec := AgentPodSpec{
+		Rig: "gastown", Role: "crew", AgentName: "jane",
+		Image: "agent:latest", Namespace: "gastown",
+		WorkspaceStorage: &WorkspaceStorageSpec{Size: "lots"},
+	}
+	err := mgr.CreateAgentPod(context.Background(), spec)
+	if err == nil || !strings.Contains(err.Error(), "invalid workspace size") {
+		t.Fatalf("CreateAgentPod() error = %v, want invalid workspace size", err)
+	}
+}
+
+func TestK8sManager_DeleteWorkspacePVC(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	mgr := New(client, slog.Default())
+	ctx := context.Background()
+
+	spec := AgentPodSpec{
+		Rig: "gastown", Role: "crew", AgentName: "jane",
+		Image: "agent:latest", Namespace: "gastown",
+		WorkspaceStorage: &WorkspaceStorageSpec{Size: "10Gi"},
+	}
+	if err := mgr.CreateAgentPod(ctx, spec); err != nil {
+		t.Fatal(err)
+	}
+
+	claim := WorkspaceClaimName(spec.PodName())
+	if err := mgr.DeleteWorkspacePVC(ctx, claim, "gastown"); err != nil {
+		t.Fatalf("DeleteWorkspacePVC() error = %v", err)
+	}
+	if _, err := client.CoreV1().PersistentVolumeClaims("gastown").Get(ctx, claim, metav1.GetOptions{}); err == nil {
+		t.Error("PVC should be deleted")
+	}
+
+	// Deleting again is not an error.
+	if err := mgr.DeleteWorkspacePVC(ctx, claim, "gastown"); err != nil {
+		t.Errorf("second DeleteWorkspacePVC() error = %v", err)
+	}
+}
+
 func TestK8sManager_ConfigMapMount(t *testing.T) {
 	client := fake.NewSimpleClientset()
 	mgr := New(client, slog.Default())
@@ -598,6 +659,57 @@ func TestK8sManager_ConfigMapMount(t *testing.T) {
 	}
 }
 
+func TestK8sManager_AgentTLSSecret(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	mgr := New(client, slog.Default())
+	ctx := context.Background()
+
+	spec := AgentPodSpec{
+		Rig: "gastown", Role: "polecat", AgentName: "nux",
+		Image: "agent:latest", Namespace: "gastown",
+		TLSSecret: "gt-tls-nux",
+	}
+	if err := mgr.CreateAgentPod(ctx, spec); err != nil {
+		t.Fatal(err)
+	}
+
+	pod, _ := client.CoreV1().Pods("gastown").Get(ctx, "gt-gastown-polecat-nux", metav1.GetOptions{})
+
+	found := false
+	for _, v := range pod.Spec.Volumes {
+		if v.Name == VolumeAgentTLS && v.Secret != nil && v.Secret.SecretName == "gt-tls-nux" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("agent-tls secret volume not found")
+	}
+
+	container := pod.Spec.Containers[0]
+	mountFound := false
+	for _, m := range container.VolumeMounts {
+		if m.Name == VolumeAgentTLS {
+			if m.MountPath != MountAgentTLS || !m.ReadOnly {
+				t.Errorf("agent-tls mount = %+v, want read-only at %s", m, MountAgentTLS)
+			}
+			mountFound = true
+		}
+	}
+	if !mountFound {
+		t.Error("agent-tls volume mount not found")
+	}
+
+	envFound := false
+	for _, e := range container.Env {
+		if e.Name == "GT_TLS_DIR" && e.Value == MountAgentTLS {
+			envFound = true
+		}
+	}
+	if !envFound {
+		t.Errorf("GT_TLS_DIR=%s not set", MountAgentTLS)
+	}
+}
+
 func TestK8sManager_ServiceAccount(t *testing.T) {
 	client := fake.NewSimpleClientset()
 	mgr := New(client, slog.Default())
@@ -1420,3 +1532,52 @@ func TestBuildPod_AgentStartupProbe(t *testing.T) {
 	}
 }
 
+func TestResourceOverrides_Apply(t *testing.T) {
+	base := DefaultPodDefaultsForRole("refinery").Resources
+
+	got, err := ResourceOverrides{CPULimit: "8", MemoryLimit: "16Gi"}.Apply(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lim := got.Limits[corev1.ResourceCPU]; lim.String() != "8" {
+		t.Errorf("cpu limit = %s, want 8", lim.String())
+	}
+	if req := got.Requests[corev1.ResourceMemory]; req.String() != DefaultMemoryRequest {
+		t.Errorf("memory request = %s, want default %s", req.String(), DefaultMemoryRequest)
+	}
+	if lim := base.Limits[corev1.ResourceCPU]; lim.String() != DefaultCPULimit {
+		t.Errorf("base was modified: cpu limit = %s", lim.String())
+	}
+
+	for name, o := range map[string]ResourceOverrides{
+		"malformed":       {MemoryLimit: "lots"},
+		"negative":        {CPURequest: "-1"},
+		"request > limit": {CPURequest: "6"},
+		"limit < request": {MemoryLimit: "512Mi"},
+	} {
+		if _, err := o.Apply(base); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestK8sManager_CreateAgentPodRejectsRequestAboveLimit(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	mgr := New(client, slog.Default())
+
+	spec := AgentPodSpec{
+		Rig: "gastown", Role: "polecat", AgentName: "nux",
+		Image: "agent:latest", Namespace: "gastown",
+		Resources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+		},
+	}
+	if err := mgr.CreateAgentPod(context.Background(), spec); err == nil {
+		t.Fatal("expected error for memory request above limit")
+	}
+	pods, _ := client.CoreV1().Pods("gastown").List(context.Background(), metav1.ListOptions{})
+	if len(pods.Items) != 0 {
+		t.Errorf("no pod should be created, got %d", len(pods.Items))
+	}
+}
@@ -0,0 +1,25 @@
+package podmanager
+
+import (
+	"time"
+
+	"github.com/steveyegge/gastown/controller/internal/metrics"
+)
+
+var (
+	podOps = metrics.NewCounter("gt_controller_pod_operations_total",
+		"Agent pod creates and deletes, by operation and result.", "op", "result")
+	podOpDuration = metrics.NewHistogram("gt_controller_pod_operation_duration_seconds",
+		"Time spent in K8s API calls to create or delete an agent pod.", nil, "op")
+)
+
+// observePodOp records one create or delete; call it deferred with a
+// pointer to the named error result.
+func observePodOp(op string, start time.Time, err *error) {
+	result := "ok"
+	if *err != nil {
+		result = "error"
+	}
+	podOps.Inc(op, result)
+	podOpDuration.ObserveSince(start, op)
+}
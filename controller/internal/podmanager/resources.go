@@ -0,0 +1,121 @@
+package podmanager
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Bead metadata keys that override an agent's compute resources.
+const (
+	MetaCPURequest    = "cpu_request"
+	MetaCPULimit      = "cpu_limit"
+	MetaMemoryRequest = "memory_request"
+	MetaMemoryLimit   = "memory_limit"
+	MetaGPU           = "gpu"
+)
+
+// DefaultGPUResource is the extended resource requested for GPUs when no
+// other name is configured.
+const DefaultGPUResource = "nvidia.com/gpu"
+
+// ResourceOverrides holds CPU and memory quantities (e.g., "500m", "8Gi")
+// that replace the corresponding role defaults, plus an optional whole-GPU
+// count. Empty fields keep the existing value.
+type ResourceOverrides struct {
+	CPURequest    string
+	CPULimit      string
+	MemoryRequest string
+	MemoryLimit   string
+	GPU           string
+
+	// GPUResource is the extended resource name for GPU (default
+	// DefaultGPUResource).
+	GPUResource string
+}
+
+// ResourceOverridesFromMetadata reads the cpu_request, cpu_limit,
+// memory_request, memory_limit, and gpu keys from bead metadata.
+func ResourceOverridesFromMetadata(metadata map[string]string) ResourceOverrides {
+	return ResourceOverrides{
+		CPURequest:    metadata[MetaCPURequest],
+		CPULimit:      metadata[MetaCPULimit],
+		MemoryRequest: metadata[MetaMemoryRequest],
+		MemoryLimit:   metadata[MetaMemoryLimit],
+		GPU:           metadata[MetaGPU],
+	}
+}
+
+// IsZero reports whether no overrides are set.
+func (o ResourceOverrides) IsZero() bool {
+	return o.CPURequest == "" && o.CPULimit == "" &&
+		o.MemoryRequest == "" && o.MemoryLimit == "" && o.GPU == ""
+}
+
+// Apply parses the overrides and merges them onto base, returning a new
+// ResourceRequirements. base is not modified. It fails if any quantity is
+// malformed or if the merged result has a request above its limit.
+func (o ResourceOverrides) Apply(base *corev1.ResourceRequirements) (*corev1.ResourceRequirements, error) {
+	override := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{},
+		Limits:   corev1.ResourceList{},
+	}
+	for _, f := range []struct {
+		key   string
+		value string
+		name  corev1.ResourceName
+		list  corev1.ResourceList
+	}{
+		{MetaCPURequest, o.CPURequest, corev1.ResourceCPU, override.Requests},
+		{MetaCPULimit, o.CPULimit, corev1.ResourceCPU, override.Limits},
+		{MetaMemoryRequest, o.MemoryRequest, corev1.ResourceMemory, override.Requests},
+		{MetaMemoryLimit, o.MemoryLimit, corev1.ResourceMemory, override.Limits},
+	} {
+		if f.value == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(f.value)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %w", f.key, f.value, err)
+		}
+		if q.Sign() <= 0 {
+			return nil, fmt.Errorf("%s %q: must be positive", f.key, f.value)
+		}
+		f.list[f.name] = q
+	}
+
+	if o.GPU != "" {
+		q, err := resource.ParseQuantity(o.GPU)
+		if err != nil || q.Sign() <= 0 || q.MilliValue()%1000 != 0 {
+			return nil, fmt.Errorf("%s %q: must be a positive whole number", MetaGPU, o.GPU)
+		}
+		name := corev1.ResourceName(o.GPUResource)
+		if name == "" {
+			name = DefaultGPUResource
+		}
+		// Extended resources can't be overcommitted: request must equal limit.
+		override.Requests[name] = q
+		override.Limits[name] = q
+	}
+
+	merged := mergeResources(base, override)
+	if err := ValidateResources(merged); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// ValidateResources checks that no resource request exceeds its limit,
+// which the API server would otherwise reject at pod creation.
+func ValidateResources(r *corev1.ResourceRequirements) error {
+	if r == nil {
+		return nil
+	}
+	for name, req := range r.Requests {
+		if limit, ok := r.Limits[name]; ok && req.Cmp(limit) > 0 {
+			return fmt.Errorf("%s request %s exceeds limit %s", name, req.String(), limit.String())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package podmanager
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Bead metadata keys that steer where an agent's pod is scheduled.
+const (
+	// MetaNodeSelector is a comma-separated list of key=value node labels,
+	// e.g. "pool=gpu,topology.kubernetes.io/zone=us-east-1a".
+	MetaNodeSelector = "node_selector"
+
+	// MetaTolerations is a comma-separated list of tolerations in taint
+	// syntax: key[=value][:Effect], e.g. "dedicated=agents:NoSchedule".
+	// A key with no value tolerates the taint regardless of its value.
+	MetaTolerations = "tolerations"
+)
+
+// ParseNodeSelector parses "k1=v1,k2=v2" into a node selector map. Keys
+// and values must be valid label keys and values.
+func ParseNodeSelector(s string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("node selector %q: expected key=value", pair)
+		}
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return nil, fmt.Errorf("node selector key %q: %s", k, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(v); len(errs) > 0 {
+			return nil, fmt.Errorf("node selector value %q: %s", v, strings.Join(errs, "; "))
+		}
+		out[k] = v
+	}
+	return out, nil
+}
+
+// ParseTolerations parses a comma-separated list of key[=value][:Effect]
+// entries. An empty effect tolerates all effects.
+func ParseTolerations(s string) ([]corev1.Toleration, error) {
+	var out []corev1.Toleration
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kv, effect, _ := strings.Cut(entry, ":")
+		key, value, hasValue := strings.Cut(kv, "=")
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("toleration key %q: %s", key, strings.Join(errs, "; "))
+		}
+		t := corev1.Toleration{Key: key, Operator: corev1.TolerationOpExists}
+		if hasValue {
+			t.Operator = corev1.TolerationOpEqual
+			t.Value = value
+		}
+		switch e := corev1.TaintEffect(effect); e {
+		case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+			t.Effect = e
+		default:
+			return nil, fmt.Errorf("toleration %q: unknown effect %q", entry, effect)
+		}
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+// ApplyScheduling merges node selector labels and tolerations onto spec.
+// Selector keys in nodeSelector replace existing ones; tolerations are
+// appended unless an identical one is already present.
+func ApplyScheduling(spec *AgentPodSpec, nodeSelector map[string]string, tolerations []corev1.Toleration) {
+	if len(nodeSelector) > 0 {
+		spec.NodeSelector = mergeMaps(spec.NodeSelector, nodeSelector)
+	}
+	if len(tolerations) > 0 {
+		merged := append([]corev1.Toleration(nil), spec.Tolerations...)
+		for _, t := range tolerations {
+			if !hasToleration(merged, t) {
+				merged = append(merged, t)
+			}
+		}
+		spec.Tolerations = merged
+	}
+}
+
+// GPUTolerations returns the tolerations GPU pods need for nodes tainted
+// with the GPU resource name, as GPU node pools commonly are.
+func GPUTolerations(r *corev1.ResourceRequirements) []corev1.Toleration {
+	if r == nil {
+		return nil
+	}
+	var out []corev1.Toleration
+	for name := range r.Limits {
+		if strings.HasSuffix(string(name), "/gpu") {
+			out = append(out, corev1.Toleration{
+				Key:      string(name),
+				Operator: corev1.TolerationOpExists,
+				Effect:   corev1.TaintEffectNoSchedule,
+			})
+		}
+	}
+	return out
+}
+
+func hasToleration(list []corev1.Toleration, t corev1.Toleration) bool {
+	for _, existing := range list {
+		if existing.MatchToleration(&t) && existing.Operator == t.Operator {
+			return true
+		}
+	}
+	return false
+}
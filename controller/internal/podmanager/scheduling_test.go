@@ -0,0 +1,121 @@
+package podmanager
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseNodeSelector(t *testing.T) {
+	got, err := ParseNodeSelector("pool=gpu, topology.kubernetes.io/zone=us-east-1a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got["pool"] != "gpu" || got["topology.kubernetes.io/zone"] != "us-east-1a" {
+		t.Errorf("ParseNodeSelector() = %v", got)
+	}
+
+	for _, bad := range []string{"pool", "bad key=x", "pool=has space"} {
+		if _, err := ParseNodeSelector(bad); err == nil {
+			t.Errorf("ParseNodeSelector(%q) expected error", bad)
+		}
+	}
+}
+
+func TestParseTolerations(t *testing.T) {
+	got, err := ParseTolerations("dedicated=agents:NoSchedule,spot,gpu:NoExecute")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []corev1.Toleration{
+		{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "agents", Effect: corev1.TaintEffectNoSchedule},
+		{Key: "spot", Operator: corev1.TolerationOpExists},
+		{Key: "gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d tolerations, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("toleration[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	for _, bad := range []string{"dedicated=agents:Sometimes", "=x"} {
+		if _, err := ParseTolerations(bad); err == nil {
+			t.Errorf("ParseTolerations(%q) expected error", bad)
+		}
+	}
+}
+
+func TestApplyScheduling_MergesWithDefaults(t *testing.T) {
+	spec := AgentPodSpec{Role: "polecat"}
+	ApplyDefaults(&spec, DefaultPodDefaultsForRole("polecat"))
+
+	spot := corev1.Toleration{Key: "spot", Operator: corev1.TolerationOpExists}
+	ApplyScheduling(&spec, map[string]string{"pool": "gpu"}, []corev1.Toleration{spot})
+	ApplyScheduling(&spec, nil, []corev1.Toleration{spot})
+
+	if spec.NodeSelector["kubernetes.io/arch"] != "amd64" || spec.NodeSelector["pool"] != "gpu" {
+		t.Errorf("NodeSelector = %v, want default arch plus pool", spec.NodeSelector)
+	}
+	if len(spec.Tolerations) != 1 {
+		t.Errorf("Tolerations = %+v, want one deduplicated entry", spec.Tolerations)
+	}
+	if DefaultPodDefaultsForRole("polecat").NodeSelector["pool"] != "" {
+		t.Error("role defaults should not be modified")
+	}
+}
+
+func TestK8sManager_GPUPodScheduling(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	mgr := New(client, slog.Default())
+	ctx := context.Background()
+
+	spec := AgentPodSpec{
+		Rig: "gastown", Role: "polecat", AgentName: "nux",
+		Image: "agent:latest", Namespace: "gastown",
+	}
+	ApplyDefaults(&spec, DefaultPodDefaultsForRole("polecat"))
+	res, err := ResourceOverrides{GPU: "2"}.Apply(spec.Resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec.Resources = res
+	ApplyScheduling(&spec, map[string]string{"pool": "gpu"}, GPUTolerations(spec.Resources))
+
+	if err := mgr.CreateAgentPod(ctx, spec); err != nil {
+		t.Fatal(err)
+	}
+	pod, err := client.CoreV1().Pods("gastown").Get(ctx, spec.PodName(), metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	agent := pod.Spec.Containers[0]
+	gpu := agent.Resources.Limits[DefaultGPUResource]
+	if gpu.Value() != 2 {
+		t.Errorf("GPU limit = %s, want 2", gpu.String())
+	}
+	if req := agent.Resources.Requests[DefaultGPUResource]; req.Cmp(gpu) != 0 {
+		t.Errorf("GPU request = %s, want equal to limit", req.String())
+	}
+	if pod.Spec.NodeSelector["pool"] != "gpu" {
+		t.Errorf("NodeSelector = %v", pod.Spec.NodeSelector)
+	}
+	if len(pod.Spec.Tolerations) != 1 || pod.Spec.Tolerations[0].Key != DefaultGPUResource {
+		t.Errorf("Tolerations = %+v, want GPU toleration", pod.Spec.Tolerations)
+	}
+}
+
+func TestResourceOverrides_InvalidGPU(t *testing.T) {
+	for _, bad := range []string{"0", "0.5", "many", "-1"} {
+		if _, err := (ResourceOverrides{GPU: bad}).Apply(nil); err == nil {
+			t.Errorf("GPU %q: expected error", bad)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package podmanager
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkloadKind selects which Kubernetes object owns an agent's pod.
+type WorkloadKind string
+
+const (
+	// WorkloadPod creates a bare pod that only the controller restarts.
+	WorkloadPod WorkloadKind = "pod"
+
+	// WorkloadDeployment wraps the pod in a single-replica Deployment so
+	// Kubernetes recreates it after node loss or eviction.
+	WorkloadDeployment WorkloadKind = "deployment"
+
+	// WorkloadJob wraps the pod in a Job so completion is tracked natively
+	// and finished agents are garbage-collected after JobTTLSeconds.
+	WorkloadJob WorkloadKind = "job"
+)
+
+// LabelWorkload is set on pods created through a Deployment or Job. Its
+// value is the WorkloadKind; the owning object is named spec.PodName().
+const LabelWorkload = "gastown.io/workload"
+
+const (
+	// DefaultJobTTLSeconds is how long a finished Job is kept.
+	DefaultJobTTLSeconds = 3600
+
+	// jobBackoffLimit is the number of pod retries a Job gets before it
+	// is marked failed and left for the reconciler.
+	jobBackoffLimit = 2
+)
+
+// ParseWorkloadKind validates a workload kind. An empty string is WorkloadPod.
+func ParseWorkloadKind(s string) (WorkloadKind, error) {
+	switch k := WorkloadKind(s); k {
+	case "", WorkloadPod:
+		return WorkloadPod, nil
+	case WorkloadDeployment, WorkloadJob:
+		return k, nil
+	default:
+		return "", fmt.Errorf("unknown workload kind %q (want pod, deployment, or job)", s)
+	}
+}
+
+// createWorkload creates a Deployment or Job whose template is pod.
+func (m *K8sManager) createWorkload(ctx context.Context, spec AgentPodSpec, pod *corev1.Pod) error {
+	name := spec.PodName()
+	tmpl := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      pod.Labels,
+			Annotations: pod.Annotations,
+		},
+		Spec: pod.Spec,
+	}
+	tmpl.Labels[LabelWorkload] = string(spec.Workload)
+	meta := metav1.ObjectMeta{
+		Name:        name,
+		Namespace:   spec.Namespace,
+		Labels:      spec.Labels(),
+		Annotations: pod.Annotations,
+	}
+
+	switch spec.Workload {
+	case WorkloadDeployment:
+		tmpl.Spec.RestartPolicy = corev1.RestartPolicyAlways
+		replicas := int32(1)
+		d := &appsv1.Deployment{
+			ObjectMeta: meta,
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: &metav1.LabelSelector{MatchLabels: spec.Labels()},
+				// Recreate so the old pod releases the RWO workspace PVC
+				// before its replacement tries to mount it.
+				Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+				Template: tmpl,
+			},
+		}
+		m.logger.Info("creating agent deployment",
+			"deployment", name, "rig", spec.Rig, "role", spec.Role, "agent", spec.AgentName)
+		if _, err := m.client.AppsV1().Deployments(spec.Namespace).Create(ctx, d, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating deployment %s: %w", name, err)
+		}
+		return nil
+
+	case WorkloadJob:
+		if tmpl.Spec.RestartPolicy == corev1.RestartPolicyAlways {
+			tmpl.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+		}
+		ttl := spec.JobTTLSeconds
+		if ttl == 0 {
+			ttl = DefaultJobTTLSeconds
+		}
+		backoff := int32(jobBackoffLimit)
+		j := &batchv1.Job{
+			ObjectMeta: meta,
+			Spec: batchv1.JobSpec{
+				BackoffLimit:            &backoff,
+				TTLSecondsAfterFinished: &ttl,
+				Template:                tmpl,
+			},
+		}
+		m.logger.Info("creating agent job",
+			"job", name, "rig", spec.Rig, "role", spec.Role, "agent", spec.AgentName)
+		if _, err := m.client.BatchV1().Jobs(spec.Namespace).Create(ctx, j, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating job %s: %w", name, err)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown workload kind %q", spec.Workload)
+	}
+}
+
+// deleteWorkload deletes the Deployment or Job called name, along with its
+// pods. It reports whether anything was found.
+func (m *K8sManager) deleteWorkload(ctx context.Context, name, namespace string) (bool, error) {
+	propagation := metav1.DeletePropagationBackground
+	opts := metav1.DeleteOptions{PropagationPolicy: &propagation}
+
+	err := m.client.AppsV1().Deployments(namespace).Delete(ctx, name, opts)
+	if err == nil {
+		m.logger.Info("deleted agent deployment", "deployment", name, "namespace", namespace)
+		return true, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("deleting deployment %s: %w", name, err)
+	}
+
+	err = m.client.BatchV1().Jobs(namespace).Delete(ctx, name, opts)
+	if err == nil {
+		m.logger.Info("deleted agent job", "job", name, "namespace", namespace)
+		return true, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("deleting job %s: %w", name, err)
+	}
+	return false, nil
+}
+
+// WorkloadOwner returns the name of the Deployment or Job that owns pod,
+// or "" for bare pods. The owner is named like the agent's canonical pod.
+func WorkloadOwner(pod *corev1.Pod) string {
+	if pod.Labels[LabelWorkload] == "" {
+		return ""
+	}
+	spec := AgentPodSpec{
+		Rig:       pod.Labels[LabelRig],
+		Role:      pod.Labels[LabelRole],
+		AgentName: pod.Labels[LabelAgent],
+	}
+	return spec.PodName()
+}
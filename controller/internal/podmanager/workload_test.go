@@ -0,0 +1,166 @@
+package podmanager
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseWorkloadKind(t *testing.T) {
+	for in, want := range map[string]WorkloadKind{
+		"":           WorkloadPod,
+		"pod":        WorkloadPod,
+		"deployment": WorkloadDeployment,
+		"job":        WorkloadJob,
+	} {
+		got, err := ParseWorkloadKind(in)
+		if err != nil || got != want {
+			t.Errorf("ParseWorkloadKind(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseWorkloadKind("statefulset"); err == nil {
+		t.Error("expected error for unsupported kind")
+	}
+}
+
+func TestK8sManager_CreateDeployment(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	mgr := New(client, slog.Default())
+	ctx := context.Background()
+
+	spec := AgentPodSpec{
+		Rig: "gastown", Role: "crew", AgentName: "jane", BeadID: "gt-gastown-crew-jane",
+		Image: "agent:latest", Namespace: "gastown", Workload: WorkloadDeployment,
+	}
+	if err := mgr.CreateAgentPod(ctx, spec); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := client.AppsV1().Deployments("gastown").Get(ctx, "gt-gastown-crew-jane", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("deployment not created: %v", err)
+	}
+	if *d.Spec.Replicas != 1 || d.Spec.Strategy.Type != appsv1.RecreateDeploymentStrategyType {
+		t.Errorf("replicas = %d, strategy = %s", *d.Spec.Replicas, d.Spec.Strategy.Type)
+	}
+	tmpl := d.Spec.Template
+	if tmpl.Labels[LabelWorkload] != "deployment" || tmpl.Labels[LabelAgent] != "jane" {
+		t.Errorf("template labels = %v", tmpl.Labels)
+	}
+	if tmpl.Annotations[AnnotationBeadID] != "gt-gastown-crew-jane" {
+		t.Errorf("template annotations = %v", tmpl.Annotations)
+	}
+	if tmpl.Spec.RestartPolicy != corev1.RestartPolicyAlways {
+		t.Errorf("RestartPolicy = %s, want Always", tmpl.Spec.RestartPolicy)
+	}
+	if pods, _ := client.CoreV1().Pods("gastown").List(ctx, metav1.ListOptions{}); len(pods.Items) != 0 {
+		t.Errorf("no bare pod should be created, got %d", len(pods.Items))
+	}
+
+	// Deleting by the canonical name removes the deployment.
+	if err := mgr.DeleteAgentPod(ctx, "gt-gastown-crew-jane", "gastown"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.AppsV1().Deployments("gastown").Get(ctx, "gt-gastown-crew-jane", metav1.GetOptions{}); err == nil {
+		t.Error("deployment should be deleted")
+	}
+}
+
+func TestK8sManager_CreateJob(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	mgr := New(client, slog.Default())
+	ctx := context.Background()
+
+	spec := AgentPodSpec{
+		Rig: "gastown", Role: "polecat", AgentName: "nux",
+		Image: "agent:latest", Namespace: "gastown",
+		Workload: WorkloadJob, JobTTLSeconds: 600,
+	}
+	if err := mgr.CreateAgentPod(ctx, spec); err != nil {
+		t.Fatal(err)
+	}
+
+	j, err := client.BatchV1().Jobs("gastown").Get(ctx, "gt-gastown-polecat-nux", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("job not created: %v", err)
+	}
+	if *j.Spec.TTLSecondsAfterFinished != 600 {
+		t.Errorf("TTLSecondsAfterFinished = %d, want 600", *j.Spec.TTLSecondsAfterFinished)
+	}
+	if j.Spec.Template.Spec.RestartPolicy != corev1.RestartPolicyNever {
+		t.Errorf("RestartPolicy = %s, want Never", j.Spec.Template.Spec.RestartPolicy)
+	}
+
+	// A pod the Job controller created is deleted on its own; the Job
+	// stays to replace it.
+	jobPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "gt-gastown-polecat-nux-x7k2p",
+		Namespace: "gastown",
+		Labels:    j.Spec.Template.Labels,
+	}}
+	if _, err := client.CoreV1().Pods("gastown").Create(ctx, jobPod, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.DeleteAgentPod(ctx, jobPod.Name, "gastown"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CoreV1().Pods("gastown").Get(ctx, jobPod.Name, metav1.GetOptions{}); err == nil {
+		t.Error("job pod should be deleted")
+	}
+	if _, err := client.BatchV1().Jobs("gastown").Get(ctx, "gt-gastown-polecat-nux", metav1.GetOptions{}); err != nil {
+		t.Errorf("job should be kept: %v", err)
+	}
+
+	// Deleting by the canonical name removes the job.
+	if err := mgr.DeleteAgentPod(ctx, "gt-gastown-polecat-nux", "gastown"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.BatchV1().Jobs("gastown").Get(ctx, "gt-gastown-polecat-nux", metav1.GetOptions{}); err == nil {
+		t.Error("job should be deleted")
+	}
+}
+
+func TestWorkloadOwner(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "gt-gastown-crew-jane-5d8f9-x7k2p",
+		Labels: map[string]string{
+			LabelRig: "gastown", LabelRole: "crew", LabelAgent: "jane",
+			LabelWorkload: string(WorkloadDeployment),
+		},
+	}}
+	if got := WorkloadOwner(pod); got != "gt-gastown-crew-jane" {
+		t.Errorf("WorkloadOwner() = %q, want gt-gastown-crew-jane", got)
+	}
+	delete(pod.Labels, LabelWorkload)
+	if got := WorkloadOwner(pod); got != "" {
+		t.Errorf("WorkloadOwner() of a bare pod = %q, want empty", got)
+	}
+}
+
+func TestK8sManager_DeleteBarePodSkipsWorkloads(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	mgr := New(client, slog.Default())
+	ctx := context.Background()
+
+	spec := AgentPodSpec{
+		Rig: "gastown", Role: "polecat", AgentName: "nux",
+		Image: "agent:latest", Namespace: "gastown",
+	}
+	if err := mgr.CreateAgentPod(ctx, spec); err != nil {
+		t.Fatal(err)
+	}
+	client.ClearActions()
+	if err := mgr.DeleteAgentPod(ctx, spec.PodName(), "gastown"); err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range client.Actions() {
+		if r := a.GetResource().Resource; r != "pods" {
+			t.Errorf("unexpected %s on %s when deleting a bare pod", a.GetVerb(), r)
+		}
+	}
+}
@@ -16,6 +16,11 @@ import (
 	"github.com/steveyegge/gastown/controller/internal/podmanager"
 )
 
+// MetadataLimitOverride is the agent bead metadata key, taken from the
+// limit_override label gt sling sets when an approved decision lets a
+// polecat past its rig's max_polecats.
+const MetadataLimitOverride = "limit_override"
+
 // SpecBuilder constructs an AgentPodSpec from config, bead identity, and metadata.
 // The metadata map may contain per-bead overrides (e.g., image).
 type SpecBuilder func(cfg *config.Config, rig, role, agentName string, metadata map[string]string) podmanager.AgentPodSpec
@@ -96,9 +101,21 @@ func (r *Reconciler) Reconcile(ctx context.Context) error {
 		if _, ok := p.Labels[podmanager.LabelAgent]; !ok {
 			continue
 		}
-		actualMap[p.Name] = p
+		// Pods owned by a Deployment or Job are keyed by their owner,
+		// which carries the agent's canonical name. A rollout can briefly
+		// leave two pods for one agent; keep the one that is taking over.
+		name := p.Name
+		if owner := podmanager.WorkloadOwner(&p); owner != "" {
+			name = owner
+			if cur, ok := actualMap[name]; ok && !preferPod(p, cur) {
+				continue
+			}
+		}
+		actualMap[name] = p
 	}
 
+	r.adoptPods(desired, actualMap)
+
 	// Delete orphan pods (exist in K8s but not in desired).
 	// Guard: if daemon returned zero beads but pods exist, this is likely a
 	// transient daemon issue (restart, query race, etc.). Refuse to mass-delete
@@ -122,11 +139,15 @@ func (r *Reconciler) Reconcile(ctx context.Context) error {
 	// Exclude both Failed and Succeeded pods — they are terminal and will be
 	// deleted+recreated below.
 	activePods := 0
+	rigPolecats := make(map[string]int) // active polecat pods per rig, for max_polecats
 	for name, pod := range actualMap {
-		if _, inDesired := desired[name]; inDesired &&
+		if bead, inDesired := desired[name]; inDesired &&
 			pod.Status.Phase != corev1.PodFailed &&
 			pod.Status.Phase != corev1.PodSucceeded {
 			activePods++
+			if bead.Role == "polecat" {
+				rigPolecats[bead.Rig]++
+			}
 		}
 	}
 
@@ -169,6 +190,10 @@ func (r *Reconciler) Reconcile(ctx context.Context) error {
 		if pod, exists := actualMap[name]; exists {
 			// Pod exists. Check if it's in a terminal state (Failed or Succeeded).
 			if pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded {
+				if podmanager.WorkloadOwner(&pod) != "" {
+					// The Deployment or Job replaces its own pods.
+					continue
+				}
 				r.logger.Info("deleting terminal pod for recreation",
 					"pod", name, "phase", pod.Status.Phase)
 				if err := r.pods.DeleteAgentPod(ctx, name, pod.Namespace); err != nil {
@@ -189,6 +214,9 @@ func (r *Reconciler) Reconcile(ctx context.Context) error {
 				}
 				r.upgradeTracker.MarkUpgrading(name)
 				activePods-- // no longer active after deletion
+				if bead.Role == "polecat" {
+					rigPolecats[bead.Rig]--
+				}
 				// Fall through to create with new spec.
 			} else {
 				continue
@@ -209,6 +237,17 @@ func (r *Reconciler) Reconcile(ctx context.Context) error {
 			continue
 		}
 
+		// Check the rig's polecat cap. gt sling enforces it too; this
+		// catches agent beads created some other way. Polecats slung with
+		// an approved override decision are exempt.
+		if bead.Role == "polecat" && bead.Metadata[MetadataLimitOverride] == "" {
+			if limit := r.cfg.RigCache[bead.Rig].MaxPolecats; limit > 0 && rigPolecats[bead.Rig] >= limit {
+				r.logger.Info("rig max polecats reached, deferring pod",
+					"rig", bead.Rig, "limit", limit, "active", rigPolecats[bead.Rig], "deferred", name)
+				continue
+			}
+		}
+
 		// Create the pod.
 		spec := r.specBuilder(r.cfg, bead.Rig, bead.Role, bead.AgentName, bead.Metadata)
 		spec.BeadID = bead.ID
@@ -218,6 +257,9 @@ func (r *Reconciler) Reconcile(ctx context.Context) error {
 		}
 		created++
 		activePods++
+		if bead.Role == "polecat" {
+			rigPolecats[bead.Rig]++
+		}
 	}
 
 	if created > 0 || len(desired) > len(actualMap) {
@@ -229,6 +271,80 @@ func (r *Reconciler) Reconcile(ctx context.Context) error {
 	return nil
 }
 
+// StartupReconcile runs Reconcile until it succeeds, retrying up to attempts
+// times with doubling backoff. The daemon is often still starting when the
+// controller comes up; without retries, pods created before a controller
+// restart would stay unmanaged until the first periodic sync.
+func (r *Reconciler) StartupReconcile(ctx context.Context, attempts int, backoff time.Duration) error {
+	var err error
+	for i := 0; i < attempts; i++ {
+		if err = r.Reconcile(ctx); err == nil {
+			return nil
+		}
+		if i == attempts-1 {
+			break
+		}
+		r.logger.Warn("startup reconciliation failed, retrying",
+			"attempt", i+1, "backoff", backoff, "error", err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff *= 2
+	}
+	return err
+}
+
+// preferPod reports whether pod a should stand for its workload over b:
+// a pod that isn't being deleted beats one that is, a live pod beats a
+// terminal one, and otherwise the newer pod wins.
+func preferPod(a, b corev1.Pod) bool {
+	if aGone, bGone := a.DeletionTimestamp != nil, b.DeletionTimestamp != nil; aGone != bGone {
+		return bGone
+	}
+	if aDone, bDone := isTerminal(&a), isTerminal(&b); aDone != bDone {
+		return bDone
+	}
+	return b.CreationTimestamp.Before(&a.CreationTimestamp)
+}
+
+func isTerminal(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded
+}
+
+// adoptPods matches existing pods to desired beads by the bead-id
+// annotation when the pod name differs from the computed one (e.g., pods
+// created by an earlier controller version). Adopted pods take over the
+// bead's desired entry so they are kept instead of being deleted as
+// orphans and recreated under a new name. Workload-owned pods are already
+// keyed by their owner, so every pod of a rollout maps to one entry.
+func (r *Reconciler) adoptPods(desired map[string]daemonclient.AgentBead, actual map[string]corev1.Pod) {
+	byID := make(map[string]string, len(desired)) // bead ID -> desired pod name
+	for name, b := range desired {
+		if b.ID != "" {
+			byID[b.ID] = name
+		}
+	}
+	for name, pod := range actual {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		beadID := pod.Annotations[podmanager.AnnotationBeadID]
+		want, ok := byID[beadID]
+		if !ok {
+			continue
+		}
+		if _, taken := actual[want]; taken {
+			continue // bead already has a pod under its computed name
+		}
+		r.logger.Info("adopting pod", "pod", name, "bead", beadID)
+		desired[name] = desired[want]
+		delete(desired, want)
+		delete(byID, beadID)
+	}
+}
+
 // podDriftReason returns a non-empty string describing why the pod needs
 // recreation, or "" if the pod matches the desired spec.
 func podDriftReason(desired podmanager.AgentPodSpec, actual *corev1.Pod, tracker *ImageDigestTracker) string {
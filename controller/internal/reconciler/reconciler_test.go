@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"log/slog"
 	"testing"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -732,6 +734,53 @@ func TestReconcile_MaxConcurrentPods(t *testing.T) {
 	}
 }
 
+func TestReconcile_RigMaxPolecats(t *testing.T) {
+	// gastown allows 2 polecats and already runs 1. Of three new polecats
+	// only one fits, plus the one slung with an override. Other roles and
+	// other rigs are not capped.
+	client := fake.NewSimpleClientset()
+	cfg := testCfg()
+	cfg.SpawnBurstLimit = 10
+	cfg.RigCache = map[string]config.RigCacheEntry{"gastown": {MaxPolecats: 2}}
+
+	createFakePod(t, client, "gt-gastown-polecat-furiosa", testNamespace, "Running")
+
+	overridden := bead("gastown", "polecat", "slit")
+	overridden.Metadata = map[string]string{MetadataLimitOverride: "hq-dec-1"}
+	lister := &mockBeadLister{beads: []daemonclient.AgentBead{
+		bead("gastown", "polecat", "furiosa"),
+		bead("gastown", "polecat", "nux"),
+		bead("gastown", "polecat", "toast"),
+		bead("gastown", "polecat", "capable"),
+		overridden,
+		bead("gastown", "crew", "max"),
+		bead("beads", "polecat", "ace"),
+	}}
+	pods := podmanager.New(client, slog.Default())
+	r := New(lister, pods, cfg, slog.Default(), testSpecBuilder)
+
+	if err := r.Reconcile(context.Background()); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	gastownPolecats := 0
+	found := make(map[string]bool)
+	for _, name := range listPodNames(t, client, testNamespace) {
+		found[name] = true
+		if rig, role, _ := parsePodName(t, name); rig == "gastown" && role == "polecat" {
+			gastownPolecats++
+		}
+	}
+	if gastownPolecats != 3 {
+		t.Errorf("gastown polecat pods = %d, want 3 (cap of 2 plus the override)", gastownPolecats)
+	}
+	for _, want := range []string{"gt-gastown-polecat-slit", "gt-gastown-crew-max", "gt-beads-polecat-ace"} {
+		if !found[want] {
+			t.Errorf("expected pod %s to be created", want)
+		}
+	}
+}
+
 func TestReconcile_BurstLimitConvergesOverMultiplePasses(t *testing.T) {
 	// 4 beads, burst limit 2 -> first pass creates 2, second pass creates remaining 2.
 	client := fake.NewSimpleClientset()
@@ -776,3 +825,134 @@ func TestReconcile_BurstLimitConvergesOverMultiplePasses(t *testing.T) {
 		t.Fatalf("after second pass: expected 4 pods, got %d: %v", len(names), names)
 	}
 }
+
+func TestReconcile_AdoptsPodByBeadAnnotation(t *testing.T) {
+	// Pod created under an older name but annotated with the bead ID is
+	// adopted: it survives and no duplicate is created.
+	client := fake.NewSimpleClientset()
+	createFakePod(t, client, "gt-gastown-polecat-old-furiosa", testNamespace, "Running")
+	pod, err := client.CoreV1().Pods(testNamespace).Get(context.Background(), "gt-gastown-polecat-old-furiosa", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pod.Annotations = map[string]string{podmanager.AnnotationBeadID: "gastown-furiosa"}
+	if _, err := client.CoreV1().Pods(testNamespace).Update(context.Background(), pod, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	r := newReconciler(client, []daemonclient.AgentBead{
+		bead("gastown", "polecat", "furiosa"),
+	}, nil)
+	if err := r.Reconcile(context.Background()); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+
+	names := listPodNames(t, client, testNamespace)
+	if len(names) != 1 || names[0] != "gt-gastown-polecat-old-furiosa" {
+		t.Errorf("pods = %v, want only the adopted pod", names)
+	}
+}
+
+// createWorkloadPod inserts a pod owned by the deployment named like the
+// agent's canonical pod.
+func createWorkloadPod(t *testing.T, client kubernetes.Interface, owner, suffix, phase string, created time.Time) {
+	t.Helper()
+	createFakePod(t, client, owner, testNamespace, phase)
+	ctx := context.Background()
+	pod, err := client.CoreV1().Pods(testNamespace).Get(ctx, owner, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CoreV1().Pods(testNamespace).Delete(ctx, owner, metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	pod.ResourceVersion = ""
+	pod.Name = owner + "-" + suffix
+	pod.Labels[podmanager.LabelWorkload] = string(podmanager.WorkloadDeployment)
+	pod.CreationTimestamp = metav1.NewTime(created)
+	if _, err := client.CoreV1().Pods(testNamespace).Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReconcile_WorkloadRolloutKeepsDeployment(t *testing.T) {
+	// A Deployment mid-rollout has its failed pod and the replacement.
+	// Neither is an orphan, and the Deployment is left to finish.
+	client := fake.NewSimpleClientset()
+	ctx := context.Background()
+	dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "gt-gastown-crew-jane", Namespace: testNamespace}}
+	if _, err := client.AppsV1().Deployments(testNamespace).Create(ctx, dep, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	createWorkloadPod(t, client, "gt-gastown-crew-jane", "old", "Failed", now.Add(-time.Hour))
+	createWorkloadPod(t, client, "gt-gastown-crew-jane", "new", "Pending", now)
+
+	r := newReconciler(client, []daemonclient.AgentBead{bead("gastown", "crew", "jane")}, nil)
+	if err := r.Reconcile(ctx); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if _, err := client.AppsV1().Deployments(testNamespace).Get(ctx, dep.Name, metav1.GetOptions{}); err != nil {
+		t.Errorf("deployment should survive the rollout: %v", err)
+	}
+	if names := listPodNames(t, client, testNamespace); len(names) != 2 {
+		t.Errorf("pods = %v, want both rollout pods left to the deployment", names)
+	}
+
+	// Once the bead is gone the whole workload goes.
+	r = newReconciler(client, []daemonclient.AgentBead{bead("gastown", "crew", "other")}, nil)
+	if err := r.Reconcile(ctx); err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if _, err := client.AppsV1().Deployments(testNamespace).Get(ctx, dep.Name, metav1.GetOptions{}); err == nil {
+		t.Error("orphaned deployment should be deleted")
+	}
+}
+
+// flakyBeadLister fails the first n calls, then returns beads.
+type flakyBeadLister struct {
+	failures int
+	calls    int
+	beads    []daemonclient.AgentBead
+}
+
+func (f *flakyBeadLister) ListAgentBeads(ctx context.Context) ([]daemonclient.AgentBead, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, fmt.Errorf("daemon not ready")
+	}
+	return f.beads, nil
+}
+
+func TestStartupReconcile_RetriesUntilDaemonReady(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	lister := &flakyBeadLister{failures: 2, beads: []daemonclient.AgentBead{bead("town", "mayor", "hq")}}
+	r := New(lister, podmanager.New(client, slog.Default()), testCfg(), slog.Default(), testSpecBuilder)
+
+	if err := r.StartupReconcile(context.Background(), 5, time.Millisecond); err != nil {
+		t.Fatalf("StartupReconcile: %v", err)
+	}
+	if lister.calls != 3 {
+		t.Errorf("lister calls = %d, want 3", lister.calls)
+	}
+	if names := listPodNames(t, client, testNamespace); len(names) != 1 {
+		t.Errorf("expected missing pod to be created, got %v", names)
+	}
+}
+
+func TestStartupReconcile_GivesUp(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	createFakePod(t, client, "gt-town-mayor-hq", testNamespace, "Running")
+	lister := &flakyBeadLister{failures: 10}
+	r := New(lister, podmanager.New(client, slog.Default()), testCfg(), slog.Default(), testSpecBuilder)
+
+	if err := r.StartupReconcile(context.Background(), 3, time.Millisecond); err == nil {
+		t.Fatal("expected error after exhausting attempts")
+	}
+	if lister.calls != 3 {
+		t.Errorf("lister calls = %d, want 3", lister.calls)
+	}
+	if names := listPodNames(t, client, testNamespace); len(names) != 1 {
+		t.Errorf("pods must be preserved while the daemon is unreachable, got %v", names)
+	}
+}
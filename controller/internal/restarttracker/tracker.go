@@ -0,0 +1,188 @@
+// Package restarttracker rate-limits restarts of stuck agents.
+//
+// AgentStuck events used to delete and recreate the pod every time, so an
+// agent that wedges on startup would flap forever. The tracker spaces
+// restarts out with exponential backoff and, once an agent has been
+// restarted too often within the last hour, tells the caller to stop and
+// escalate to a human instead.
+package restarttracker
+
+import (
+	"sync"
+	"time"
+)
+
+// Window is the period over which restarts count toward Policy.MaxPerWindow.
+const Window = time.Hour
+
+// Policy configures backoff and the restart cap.
+type Policy struct {
+	// BaseBackoff is the minimum gap after the first restart. Each further
+	// restart in the window doubles it.
+	BaseBackoff time.Duration
+
+	// MaxBackoff caps the gap between restarts.
+	MaxBackoff time.Duration
+
+	// MaxPerWindow is the number of restarts allowed per Window before the
+	// agent is escalated. 0 disables the cap.
+	MaxPerWindow int
+}
+
+// Action tells the caller what to do with a stuck event.
+type Action int
+
+const (
+	// Restart means restart the agent now and call Record.
+	Restart Action = iota
+
+	// Defer means the agent is backing off; retry the event after Wait.
+	Defer
+
+	// Escalate means the restart cap was just reached. The caller should
+	// raise a decision for a human and leave the agent alone.
+	Escalate
+
+	// Drop means a retry is already scheduled or the agent was already
+	// escalated; ignore the event.
+	Drop
+)
+
+func (a Action) String() string {
+	switch a {
+	case Restart:
+		return "restart"
+	case Defer:
+		return "defer"
+	case Escalate:
+		return "escalate"
+	case Drop:
+		return "drop"
+	default:
+		return "unknown"
+	}
+}
+
+// Verdict is the result of Evaluate.
+type Verdict struct {
+	Action Action
+	Wait   time.Duration // for Defer: time until the next restart is allowed
+	Recent int           // restarts within the window
+}
+
+type agentState struct {
+	restarts  []time.Time
+	deferred  bool
+	escalated bool
+}
+
+// Tracker records restarts per agent. It is safe for concurrent use.
+type Tracker struct {
+	policy Policy
+	now    func() time.Time
+
+	mu     sync.Mutex
+	agents map[string]*agentState
+}
+
+// New creates a tracker with the given policy.
+func New(policy Policy) *Tracker {
+	return &Tracker{
+		policy: policy,
+		now:    time.Now,
+		agents: make(map[string]*agentState),
+	}
+}
+
+// Evaluate decides what to do with a stuck event for agent. A Defer verdict
+// marks a retry as pending: later events are dropped until the caller
+// re-evaluates the deferred event with retry set.
+func (t *Tracker) Evaluate(agent string, retry bool) Verdict {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := t.state(agent)
+	now := t.now()
+	s.prune(now)
+	n := len(s.restarts)
+
+	if s.escalated {
+		if n > 0 {
+			return Verdict{Action: Drop, Recent: n}
+		}
+		// A full quiet window has passed; give the agent another chance.
+		s.escalated = false
+	}
+	if s.deferred && !retry {
+		return Verdict{Action: Drop, Recent: n}
+	}
+	s.deferred = false
+
+	if t.policy.MaxPerWindow > 0 && n >= t.policy.MaxPerWindow {
+		s.escalated = true
+		return Verdict{Action: Escalate, Recent: n}
+	}
+	if n > 0 {
+		next := s.restarts[n-1].Add(t.backoff(n))
+		if wait := next.Sub(now); wait > 0 {
+			s.deferred = true
+			return Verdict{Action: Defer, Wait: wait, Recent: n}
+		}
+	}
+	return Verdict{Action: Restart, Recent: n}
+}
+
+// Record notes that agent was restarted.
+func (t *Tracker) Record(agent string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := t.state(agent)
+	s.restarts = append(s.restarts, t.now())
+}
+
+// Deferred reports whether agent has a retry pending from a Defer verdict.
+// A retry for an agent without one (it was forgotten since) is stale.
+func (t *Tracker) Deferred(agent string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s, ok := t.agents[agent]
+	return ok && s.deferred
+}
+
+// Forget drops all history for agent, e.g. when it finishes or is killed.
+func (t *Tracker) Forget(agent string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.agents, agent)
+}
+
+// backoff returns the required gap after the nth restart in the window.
+func (t *Tracker) backoff(n int) time.Duration {
+	d, limit := t.policy.BaseBackoff, t.policy.MaxBackoff
+	for i := 1; i < n && (limit == 0 || d < limit); i++ {
+		d *= 2
+	}
+	if limit > 0 && d > limit {
+		d = limit
+	}
+	return d
+}
+
+func (t *Tracker) state(agent string) *agentState {
+	s, ok := t.agents[agent]
+	if !ok {
+		s = &agentState{}
+		t.agents[agent] = s
+	}
+	return s
+}
+
+// prune drops restarts older than Window.
+func (s *agentState) prune(now time.Time) {
+	cutoff := now.Add(-Window)
+	i := 0
+	for i < len(s.restarts) && !s.restarts[i].After(cutoff) {
+		i++
+	}
+	s.restarts = s.restarts[i:]
+}
@@ -0,0 +1,104 @@
+package restarttracker
+
+import (
+	"testing"
+	"time"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time          { return c.t }
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestTracker(p Policy) (*Tracker, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	tr := New(p)
+	tr.now = clock.now
+	return tr, clock
+}
+
+func TestTracker_ExponentialBackoff(t *testing.T) {
+	tr, clock := newTestTracker(Policy{BaseBackoff: 10 * time.Second, MaxBackoff: 30 * time.Second})
+	const agent = "gt-gastown-polecat-nux"
+
+	if v := tr.Evaluate(agent, false); v.Action != Restart {
+		t.Fatalf("first stuck event = %v, want restart", v.Action)
+	}
+	tr.Record(agent)
+
+	wantWaits := []time.Duration{10 * time.Second, 20 * time.Second, 30 * time.Second, 30 * time.Second}
+	for i, want := range wantWaits {
+		v := tr.Evaluate(agent, false)
+		if v.Action != Defer || v.Wait != want {
+			t.Fatalf("restart %d: got %v wait %v, want defer %v", i+2, v.Action, v.Wait, want)
+		}
+		if v := tr.Evaluate(agent, false); v.Action != Drop {
+			t.Errorf("duplicate event while deferred = %v, want drop", v.Action)
+		}
+		clock.advance(v.Wait)
+		if v := tr.Evaluate(agent, true); v.Action != Restart {
+			t.Fatalf("retry after backoff = %v, want restart", v.Action)
+		}
+		tr.Record(agent)
+	}
+}
+
+func TestTracker_EscalatesAtCap(t *testing.T) {
+	tr, clock := newTestTracker(Policy{BaseBackoff: time.Second, MaxBackoff: time.Second, MaxPerWindow: 3})
+	const agent = "gt-gastown-crew-jane"
+
+	for i := 0; i < 3; i++ {
+		if v := tr.Evaluate(agent, true); v.Action != Restart {
+			t.Fatalf("restart %d = %v, want restart", i+1, v.Action)
+		}
+		tr.Record(agent)
+		clock.advance(time.Minute)
+	}
+
+	if v := tr.Evaluate(agent, false); v.Action != Escalate || v.Recent != 3 {
+		t.Fatalf("at cap = %+v, want escalate with 3 recent", v)
+	}
+	if v := tr.Evaluate(agent, false); v.Action != Drop {
+		t.Errorf("after escalation = %v, want drop", v.Action)
+	}
+
+	// Once the window has passed with no restarts, the agent gets another chance.
+	clock.advance(Window)
+	if v := tr.Evaluate(agent, false); v.Action != Restart {
+		t.Errorf("after quiet window = %v, want restart", v.Action)
+	}
+}
+
+func TestTracker_Forget(t *testing.T) {
+	tr, _ := newTestTracker(Policy{BaseBackoff: time.Minute, MaxPerWindow: 1})
+	const agent = "gt-gastown-polecat-nux"
+
+	tr.Record(agent)
+	if v := tr.Evaluate(agent, false); v.Action != Escalate {
+		t.Fatalf("got %v, want escalate", v.Action)
+	}
+	tr.Forget(agent)
+	if v := tr.Evaluate(agent, false); v.Action != Restart {
+		t.Errorf("after Forget = %v, want restart", v.Action)
+	}
+}
+
+func TestTracker_Deferred(t *testing.T) {
+	tr, _ := newTestTracker(Policy{BaseBackoff: time.Minute})
+	const agent = "gt-gastown-polecat-nux"
+
+	tr.Record(agent)
+	if tr.Deferred(agent) {
+		t.Fatal("deferred before any Defer verdict")
+	}
+	if v := tr.Evaluate(agent, false); v.Action != Defer {
+		t.Fatalf("got %v, want defer", v.Action)
+	}
+	if !tr.Deferred(agent) {
+		t.Error("not deferred after a Defer verdict")
+	}
+	tr.Forget(agent)
+	if tr.Deferred(agent) {
+		t.Error("still deferred after Forget")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"os/exec"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +37,105 @@ type BackendMetadata struct {
 	Backend   string // "coop" or "k8s"
 	CoopURL   string // e.g., "http://gt-gastown-polecat-furiosa.gastown.svc.cluster.local:8080"
 	CoopToken string // auth token (optional)
+
+	// Runtime state, so gt status and the dashboard can show where and how
+	// the agent is running without kubectl access.
+	PodIP          string
+	NodeName       string
+	Phase          string    // Pending, Running, Succeeded, Failed, Unknown
+	Ready          bool      // only written when Phase is set
+	Restarts       int32     // summed over all containers
+	LastTransition time.Time // most recent pod condition change
+
+	// Resources is the pod's latest resource usage (optional).
+	Resources *ResourceUsage
+}
+
+// notes renders the metadata as key: value lines for the agent bead's
+// notes field. Returns "" if there is nothing to write.
+func (m BackendMetadata) notes() string {
+	var lines []string
+	if m.Backend != "" {
+		lines = append(lines, fmt.Sprintf("backend: %s", m.Backend))
+	}
+	if m.PodName != "" {
+		lines = append(lines, fmt.Sprintf("pod_name: %s", m.PodName))
+	}
+	if m.Namespace != "" {
+		lines = append(lines, fmt.Sprintf("pod_namespace: %s", m.Namespace))
+	}
+	if m.PodIP != "" {
+		lines = append(lines, fmt.Sprintf("pod_ip: %s", m.PodIP))
+	}
+	if m.NodeName != "" {
+		lines = append(lines, fmt.Sprintf("pod_node: %s", m.NodeName))
+	}
+	if m.Phase != "" {
+		lines = append(lines,
+			fmt.Sprintf("pod_phase: %s", m.Phase),
+			fmt.Sprintf("pod_ready: %t", m.Ready),
+			fmt.Sprintf("pod_restarts: %d", m.Restarts))
+	}
+	if !m.LastTransition.IsZero() {
+		lines = append(lines, fmt.Sprintf("pod_last_transition: %s", m.LastTransition.UTC().Format(time.RFC3339)))
+	}
+	if m.CoopURL != "" {
+		lines = append(lines, fmt.Sprintf("coop_url: %s", m.CoopURL))
+	}
+	if m.CoopToken != "" {
+		lines = append(lines, fmt.Sprintf("coop_token: %s", m.CoopToken))
+	}
+	if m.Resources != nil {
+		lines = append(lines, m.Resources.noteLines()...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// podMetadata builds the backend metadata SyncAll writes for a pod.
+// Coop pods get a coop_url using the pod IP (individual pods have no DNS
+// entries without a headless Service). The second return value is false
+// when there is nothing worth writing.
+func podMetadata(pod *corev1.Pod, usage *ResourceUsage) (BackendMetadata, bool) {
+	meta := BackendMetadata{
+		PodName:        pod.Name,
+		Namespace:      pod.Namespace,
+		PodIP:          pod.Status.PodIP,
+		NodeName:       pod.Spec.NodeName,
+		Phase:          string(pod.Status.Phase),
+		Ready:          isPodReady(pod),
+		Restarts:       podRestarts(pod),
+		LastTransition: lastTransition(pod),
+		Resources:      usage,
+	}
+	if coopPort := detectCoopPort(pod); coopPort > 0 && pod.Status.PodIP != "" {
+		meta.Backend = "coop"
+		meta.CoopURL = fmt.Sprintf("http://%s:%d", pod.Status.PodIP, coopPort)
+	}
+	return meta, meta.Backend != "" || meta.Phase != "" || meta.Resources != nil
+}
+
+// podRestarts sums restart counts across the pod's containers.
+func podRestarts(pod *corev1.Pod) int32 {
+	var n int32
+	for _, cs := range pod.Status.ContainerStatuses {
+		n += cs.RestartCount
+	}
+	return n
+}
+
+// lastTransition returns the most recent pod condition transition time,
+// falling back to the pod's start time.
+func lastTransition(pod *corev1.Pod) time.Time {
+	var t time.Time
+	for _, c := range pod.Status.Conditions {
+		if c.LastTransitionTime.After(t) {
+			t = c.LastTransitionTime.Time
+		}
+	}
+	if t.IsZero() && pod.Status.StartTime != nil {
+		t = pod.Status.StartTime.Time
+	}
+	return t
 }
 
 // Reporter syncs pod status back to beads.
@@ -77,6 +177,7 @@ type BdReporter struct {
 	cfg    BdConfig
 	client kubernetes.Interface
 	logger *slog.Logger
+	usage  UsageCollector // optional
 
 	// Metrics counters.
 	reportsTotal atomic.Int64
@@ -97,6 +198,11 @@ func NewBdReporter(cfg BdConfig, client kubernetes.Interface, logger *slog.Logge
 	}
 }
 
+// SetUsageCollector enables resource usage reporting during SyncAll.
+func (r *BdReporter) SetUsageCollector(c UsageCollector) {
+	r.usage = c
+}
+
 // ReportPodStatus updates the agent's state in beads based on pod phase.
 // agentName should be the agent bead ID (e.g., "gt-gastown-polecat-furiosa").
 func (r *BdReporter) ReportPodStatus(ctx context.Context, agentName string, status PodStatus) error {
@@ -138,33 +244,16 @@ func (r *BdReporter) ReportPodStatus(ctx context.Context, agentName string, stat
 func (r *BdReporter) ReportBackendMetadata(ctx context.Context, agentName string, meta BackendMetadata) error {
 	r.reportsTotal.Add(1)
 
-	// Build structured notes content with key: value lines.
-	var lines []string
-	if meta.Backend != "" {
-		lines = append(lines, fmt.Sprintf("backend: %s", meta.Backend))
-	}
-	if meta.PodName != "" {
-		lines = append(lines, fmt.Sprintf("pod_name: %s", meta.PodName))
-	}
-	if meta.Namespace != "" {
-		lines = append(lines, fmt.Sprintf("pod_namespace: %s", meta.Namespace))
-	}
-	if meta.CoopURL != "" {
-		lines = append(lines, fmt.Sprintf("coop_url: %s", meta.CoopURL))
-	}
-	if meta.CoopToken != "" {
-		lines = append(lines, fmt.Sprintf("coop_token: %s", meta.CoopToken))
-	}
-
-	if len(lines) == 0 {
+	notes := meta.notes()
+	if notes == "" {
 		return nil
 	}
 
-	notes := strings.Join(lines, "\n")
 	args := []string{"update", agentName, "--notes", notes}
 
 	r.logger.Info("reporting backend metadata to beads",
-		"agent", agentName, "backend", meta.Backend, "coop_url", meta.CoopURL)
+		"agent", agentName, "backend", meta.Backend, "coop_url", meta.CoopURL,
+		"phase", meta.Phase, "node", meta.NodeName)
 
 	if err := r.runBd(ctx, args...); err != nil {
 		r.reportErrors.Add(1)
@@ -187,6 +276,11 @@ func (r *BdReporter) SyncAll(ctx context.Context) error {
 		return fmt.Errorf("listing agent pods: %w", err)
 	}
 
+	var usage map[string]*ResourceUsage
+	if r.usage != nil {
+		usage = r.usage.CollectUsage(ctx, pods.Items)
+	}
+
 	var errs []string
 	for _, pod := range pods.Items {
 		agentLabel := pod.Labels[podmanager.LabelAgent]
@@ -209,15 +303,11 @@ func (r *BdReporter) SyncAll(ctx context.Context) error {
 			errs = append(errs, err.Error())
 		}
 
-		// Write backend metadata for coop-enabled pods so ResolveBackend() works
-		// after controller restarts. Uses pod IP (no headless Service for DNS).
-		if coopPort := detectCoopPort(&pod); coopPort > 0 && pod.Status.PodIP != "" {
-			_ = r.ReportBackendMetadata(ctx, beadID, BackendMetadata{
-				PodName:   pod.Name,
-				Namespace: pod.Namespace,
-				Backend:   "coop",
-				CoopURL:   fmt.Sprintf("http://%s:%d", pod.Status.PodIP, coopPort),
-			})
+		// Write runtime state and, for coop-enabled pods, backend metadata so
+		// ResolveBackend() works after controller restarts, along with
+		// resource usage when collected.
+		if meta, ok := podMetadata(&pod, usage[pod.Name]); ok {
+			_ = r.ReportBackendMetadata(ctx, beadID, meta)
 		}
 	}
 
@@ -353,6 +443,7 @@ type HTTPReporter struct {
 	client    kubernetes.Interface
 	namespace string
 	logger    *slog.Logger
+	usage     UsageCollector // optional
 
 	reportsTotal atomic.Int64
 	reportErrors atomic.Int64
@@ -370,6 +461,11 @@ func NewHTTPReporter(daemon BeadUpdater, client kubernetes.Interface, namespace
 	}
 }
 
+// SetUsageCollector enables resource usage reporting during SyncAll.
+func (r *HTTPReporter) SetUsageCollector(c UsageCollector) {
+	r.usage = c
+}
+
 // ReportPodStatus updates the agent's state in beads based on pod phase.
 // Maps K8s pod phases to beads agent states via the daemon HTTP API.
 func (r *HTTPReporter) ReportPodStatus(ctx context.Context, agentName string, status PodStatus) error {
@@ -401,30 +497,14 @@ func (r *HTTPReporter) ReportPodStatus(ctx context.Context, agentName string, st
 func (r *HTTPReporter) ReportBackendMetadata(ctx context.Context, agentName string, meta BackendMetadata) error {
 	r.reportsTotal.Add(1)
 
-	var lines []string
-	if meta.Backend != "" {
-		lines = append(lines, fmt.Sprintf("backend: %s", meta.Backend))
-	}
-	if meta.PodName != "" {
-		lines = append(lines, fmt.Sprintf("pod_name: %s", meta.PodName))
-	}
-	if meta.Namespace != "" {
-		lines = append(lines, fmt.Sprintf("pod_namespace: %s", meta.Namespace))
-	}
-	if meta.CoopURL != "" {
-		lines = append(lines, fmt.Sprintf("coop_url: %s", meta.CoopURL))
-	}
-	if meta.CoopToken != "" {
-		lines = append(lines, fmt.Sprintf("coop_token: %s", meta.CoopToken))
-	}
-
-	if len(lines) == 0 {
+	notes := meta.notes()
+	if notes == "" {
 		return nil
 	}
 
-	notes := strings.Join(lines, "\n")
 	r.logger.Info("reporting backend metadata via HTTP",
-		"agent", agentName, "backend", meta.Backend, "coop_url", meta.CoopURL)
+		"agent", agentName, "backend", meta.Backend, "coop_url", meta.CoopURL,
+		"phase", meta.Phase, "node", meta.NodeName)
 
 	if err := r.daemon.UpdateBeadNotes(ctx, agentName, notes); err != nil {
 		r.reportErrors.Add(1)
@@ -447,6 +527,11 @@ func (r *HTTPReporter) SyncAll(ctx context.Context) error {
 		return fmt.Errorf("listing agent pods: %w", err)
 	}
 
+	var usage map[string]*ResourceUsage
+	if r.usage != nil {
+		usage = r.usage.CollectUsage(ctx, pods.Items)
+	}
+
 	for _, pod := range pods.Items {
 		agentLabel := pod.Labels[podmanager.LabelAgent]
 		rigLabel := pod.Labels[podmanager.LabelRig]
@@ -466,16 +551,11 @@ func (r *HTTPReporter) SyncAll(ctx context.Context) error {
 
 		_ = r.ReportPodStatus(ctx, beadID, status)
 
-		// Write backend metadata for coop-enabled pods so ResolveBackend() works
-		// after controller restarts. Detect coop by checking for port 8080 on any container.
-		// Uses pod IP because individual pods don't have DNS entries (no headless Service).
-		if coopPort := detectCoopPort(&pod); coopPort > 0 && pod.Status.PodIP != "" {
-			_ = r.ReportBackendMetadata(ctx, beadID, BackendMetadata{
-				PodName:   pod.Name,
-				Namespace: pod.Namespace,
-				Backend:   "coop",
-				CoopURL:   fmt.Sprintf("http://%s:%d", pod.Status.PodIP, coopPort),
-			})
+		// Write runtime state and, for coop-enabled pods, backend metadata so
+		// ResolveBackend() works after controller restarts, along with
+		// resource usage when collected.
+		if meta, ok := podMetadata(&pod, usage[pod.Name]); ok {
+			_ = r.ReportBackendMetadata(ctx, beadID, meta)
 		}
 	}
 
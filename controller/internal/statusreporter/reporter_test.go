@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -323,9 +325,10 @@ func TestBdReporter_SyncAll_WithPods(t *testing.T) {
 		t.Errorf("SyncAll() = %v, want nil", err)
 	}
 
+	// Each pod gets a state report plus a runtime metadata report.
 	m := r.Metrics()
-	if m.StatusReportsTotal != 2 {
-		t.Errorf("reports total = %d, want 2", m.StatusReportsTotal)
+	if m.StatusReportsTotal != 4 {
+		t.Errorf("reports total = %d, want 4", m.StatusReportsTotal)
 	}
 	if m.SyncAllRuns != 1 {
 		t.Errorf("sync runs = %d, want 1", m.SyncAllRuns)
@@ -710,3 +713,61 @@ func TestHTTPReporter_SyncAll_UpdatesAgentStates(t *testing.T) {
 		t.Errorf("state = %q, want %q", mock.stateCalls[0].state, "working")
 	}
 }
+
+func TestHTTPReporter_SyncAll_WritesRuntimeState(t *testing.T) {
+	started := metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	ready := metav1.NewTime(started.Add(30 * time.Second))
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gt-gastown-crew-jane",
+			Namespace: "gastown",
+			Labels: map[string]string{
+				"app.kubernetes.io/name": "gastown",
+				"gastown.io/rig":         "gastown",
+				"gastown.io/role":        "crew",
+				"gastown.io/agent":       "jane",
+			},
+		},
+		Spec: corev1.PodSpec{NodeName: "node-a"},
+		Status: corev1.PodStatus{
+			Phase:     corev1.PodRunning,
+			PodIP:     "10.0.1.5",
+			StartTime: &started,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionTrue, LastTransitionTime: started},
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue, LastTransitionTime: ready},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "agent", RestartCount: 2},
+				{Name: "sidecar", RestartCount: 1},
+			},
+		},
+	}
+
+	mock := &mockBeadUpdater{}
+	r := NewHTTPReporter(mock, fake.NewSimpleClientset(pod), "gastown", slog.Default())
+	if err := r.SyncAll(context.Background()); err != nil {
+		t.Fatalf("SyncAll() error = %v", err)
+	}
+
+	if len(mock.notesCalls) != 1 {
+		t.Fatalf("expected 1 notes call, got %d", len(mock.notesCalls))
+	}
+	notes := mock.notesCalls[0].notes
+	for _, want := range []string{
+		"pod_name: gt-gastown-crew-jane",
+		"pod_ip: 10.0.1.5",
+		"pod_node: node-a",
+		"pod_phase: Running",
+		"pod_ready: true",
+		"pod_restarts: 3",
+		"pod_last_transition: 2026-03-01T12:00:30Z",
+	} {
+		if !strings.Contains(notes, want) {
+			t.Errorf("notes missing %q:\n%s", want, notes)
+		}
+	}
+	if strings.Contains(notes, "coop_url") {
+		t.Errorf("non-coop pod should not get a coop_url:\n%s", notes)
+	}
+}
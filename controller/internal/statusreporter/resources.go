@@ -0,0 +1,212 @@
+package statusreporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/steveyegge/gastown/controller/internal/podmanager"
+)
+
+// pressureThreshold is the usage/limit ratio at which a resource is
+// reported as under pressure.
+const pressureThreshold = 0.9
+
+// ResourceUsage is a point-in-time view of an agent pod's resource
+// consumption. It is written to the agent bead notes alongside backend
+// metadata so the witness can spot pods nearing their memory limit or
+// filling their workspace before they are OOM-killed or wedged.
+type ResourceUsage struct {
+	CPUMillicores          int64
+	MemoryBytes            int64 // working set of the agent container
+	MemoryLimitBytes       int64 // 0 = unlimited
+	WorkspaceUsedBytes     int64
+	WorkspaceCapacityBytes int64
+
+	AgentRunning    bool   // agent container is in the Running state
+	Restarts        int32  // agent container restart count
+	LastTermination string // reason for the last termination (e.g., "OOMKilled")
+}
+
+// Pressure returns the resources at or above pressureThreshold of their
+// limit: "memory", "disk", or both.
+func (u *ResourceUsage) Pressure() []string {
+	var out []string
+	if u.MemoryLimitBytes > 0 && float64(u.MemoryBytes) >= pressureThreshold*float64(u.MemoryLimitBytes) {
+		out = append(out, "memory")
+	}
+	if u.WorkspaceCapacityBytes > 0 && float64(u.WorkspaceUsedBytes) >= pressureThreshold*float64(u.WorkspaceCapacityBytes) {
+		out = append(out, "disk")
+	}
+	return out
+}
+
+// noteLines renders usage as key: value lines for agent bead notes.
+func (u *ResourceUsage) noteLines() []string {
+	lines := []string{
+		fmt.Sprintf("agent_running: %t", u.AgentRunning),
+		fmt.Sprintf("agent_restarts: %d", u.Restarts),
+		fmt.Sprintf("cpu_millicores: %d", u.CPUMillicores),
+		fmt.Sprintf("memory_bytes: %d", u.MemoryBytes),
+	}
+	if u.MemoryLimitBytes > 0 {
+		lines = append(lines, fmt.Sprintf("memory_limit_bytes: %d", u.MemoryLimitBytes))
+	}
+	if u.WorkspaceCapacityBytes > 0 {
+		lines = append(lines,
+			fmt.Sprintf("workspace_used_bytes: %d", u.WorkspaceUsedBytes),
+			fmt.Sprintf("workspace_capacity_bytes: %d", u.WorkspaceCapacityBytes))
+	}
+	if u.LastTermination != "" {
+		lines = append(lines, fmt.Sprintf("last_termination: %s", u.LastTermination))
+	}
+	for _, p := range u.Pressure() {
+		lines = append(lines, fmt.Sprintf("resource_pressure: %s", p))
+	}
+	return lines
+}
+
+// UsageCollector gathers resource usage for agent pods.
+type UsageCollector interface {
+	// CollectUsage returns usage keyed by pod name. Pods whose usage could
+	// not be determined are omitted.
+	CollectUsage(ctx context.Context, pods []corev1.Pod) map[string]*ResourceUsage
+}
+
+// KubeletUsageCollector reads pod CPU, memory, and volume usage from each
+// node's kubelet stats summary, proxied through the API server. It requires
+// "get" on nodes/proxy. Liveness and limits come from the pod object itself.
+type KubeletUsageCollector struct {
+	client kubernetes.Interface
+	logger *slog.Logger
+}
+
+// NewKubeletUsageCollector creates a collector backed by the kubelet summary API.
+func NewKubeletUsageCollector(client kubernetes.Interface, logger *slog.Logger) *KubeletUsageCollector {
+	return &KubeletUsageCollector{client: client, logger: logger}
+}
+
+// CollectUsage fetches one stats summary per node hosting an agent pod.
+func (c *KubeletUsageCollector) CollectUsage(ctx context.Context, pods []corev1.Pod) map[string]*ResourceUsage {
+	summaries := make(map[string]*kubeletSummary)
+	usage := make(map[string]*ResourceUsage, len(pods))
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		summary, fetched := summaries[pod.Spec.NodeName]
+		if !fetched {
+			var err error
+			summary, err = c.nodeSummary(ctx, pod.Spec.NodeName)
+			if err != nil {
+				c.logger.Debug("kubelet stats unavailable",
+					"node", pod.Spec.NodeName, "error", err)
+			}
+			summaries[pod.Spec.NodeName] = summary
+		}
+		usage[pod.Name] = usageFromPod(pod, summary)
+	}
+	return usage
+}
+
+func (c *KubeletUsageCollector) nodeSummary(ctx context.Context, node string) (*kubeletSummary, error) {
+	raw, err := c.client.CoreV1().RESTClient().Get().
+		Resource("nodes").
+		Name(node).
+		SubResource("proxy").
+		Suffix("stats/summary").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching stats summary for node %s: %w", node, err)
+	}
+	var summary kubeletSummary
+	if err := json.Unmarshal(raw, &summary); err != nil {
+		return nil, fmt.Errorf("parsing stats summary for node %s: %w", node, err)
+	}
+	return &summary, nil
+}
+
+// kubeletSummary is the subset of the kubelet /stats/summary response we use.
+type kubeletSummary struct {
+	Pods []kubeletPodStats `json:"pods"`
+}
+
+type kubeletPodStats struct {
+	PodRef struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"podRef"`
+	Containers []struct {
+		Name string `json:"name"`
+		CPU  *struct {
+			UsageNanoCores *uint64 `json:"usageNanoCores"`
+		} `json:"cpu"`
+		Memory *struct {
+			WorkingSetBytes *uint64 `json:"workingSetBytes"`
+		} `json:"memory"`
+	} `json:"containers"`
+	Volumes []struct {
+		Name          string  `json:"name"`
+		UsedBytes     *uint64 `json:"usedBytes"`
+		CapacityBytes *uint64 `json:"capacityBytes"`
+	} `json:"volume"`
+}
+
+// usageFromPod combines pod status with kubelet stats (which may be nil).
+func usageFromPod(pod *corev1.Pod, summary *kubeletSummary) *ResourceUsage {
+	u := &ResourceUsage{}
+	for _, c := range pod.Spec.Containers {
+		if c.Name == podmanager.ContainerName {
+			if limit, ok := c.Resources.Limits[corev1.ResourceMemory]; ok {
+				u.MemoryLimitBytes = limit.Value()
+			}
+		}
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name != podmanager.ContainerName {
+			continue
+		}
+		u.AgentRunning = cs.State.Running != nil
+		u.Restarts = cs.RestartCount
+		if t := cs.LastTerminationState.Terminated; t != nil {
+			u.LastTermination = t.Reason
+		}
+	}
+
+	if summary == nil {
+		return u
+	}
+	for _, ps := range summary.Pods {
+		if ps.PodRef.Name != pod.Name || ps.PodRef.Namespace != pod.Namespace {
+			continue
+		}
+		for _, c := range ps.Containers {
+			if c.Name != podmanager.ContainerName {
+				continue
+			}
+			if c.CPU != nil && c.CPU.UsageNanoCores != nil {
+				u.CPUMillicores = int64(*c.CPU.UsageNanoCores / 1_000_000)
+			}
+			if c.Memory != nil && c.Memory.WorkingSetBytes != nil {
+				u.MemoryBytes = int64(*c.Memory.WorkingSetBytes)
+			}
+		}
+		for _, v := range ps.Volumes {
+			if v.Name != podmanager.VolumeWorkspace {
+				continue
+			}
+			if v.UsedBytes != nil {
+				u.WorkspaceUsedBytes = int64(*v.UsedBytes)
+			}
+			if v.CapacityBytes != nil {
+				u.WorkspaceCapacityBytes = int64(*v.CapacityBytes)
+			}
+		}
+	}
+	return u
+}
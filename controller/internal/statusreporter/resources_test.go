@@ -0,0 +1,147 @@
+package statusreporter
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fixedUsageCollector returns canned usage keyed by pod name.
+type fixedUsageCollector map[string]*ResourceUsage
+
+func (c fixedUsageCollector) CollectUsage(_ context.Context, _ []corev1.Pod) map[string]*ResourceUsage {
+	return c
+}
+
+func TestResourceUsage_Pressure(t *testing.T) {
+	tests := []struct {
+		name  string
+		usage ResourceUsage
+		want  string
+	}{
+		{"none", ResourceUsage{MemoryBytes: 100, MemoryLimitBytes: 1000}, ""},
+		{"memory", ResourceUsage{MemoryBytes: 950, MemoryLimitBytes: 1000}, "memory"},
+		{"disk", ResourceUsage{WorkspaceUsedBytes: 9, WorkspaceCapacityBytes: 10}, "disk"},
+		{"both", ResourceUsage{MemoryBytes: 900, MemoryLimitBytes: 1000, WorkspaceUsedBytes: 10, WorkspaceCapacityBytes: 10}, "memory,disk"},
+		{"no limit", ResourceUsage{MemoryBytes: 1 << 40}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := strings.Join(tt.usage.Pressure(), ","); got != tt.want {
+				t.Errorf("Pressure() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsageFromPod(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "gt-gastown-polecat-furiosa", Namespace: "gastown"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "agent",
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "agent",
+				RestartCount: 2,
+				State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"},
+				},
+			}},
+		},
+	}
+
+	var summary kubeletSummary
+	raw := `{"pods": [{
+		"podRef": {"name": "gt-gastown-polecat-furiosa", "namespace": "gastown"},
+		"containers": [
+			{"name": "coop", "cpu": {"usageNanoCores": 5000000}, "memory": {"workingSetBytes": 1024}},
+			{"name": "agent", "cpu": {"usageNanoCores": 250000000}, "memory": {"workingSetBytes": 1048576000}}
+		],
+		"volume": [
+			{"name": "tmp", "usedBytes": 1, "capacityBytes": 2},
+			{"name": "workspace", "usedBytes": 2147483648, "capacityBytes": 10737418240}
+		]
+	}]}`
+	if err := json.Unmarshal([]byte(raw), &summary); err != nil {
+		t.Fatal(err)
+	}
+
+	u := usageFromPod(pod, &summary)
+	if !u.AgentRunning || u.Restarts != 2 || u.LastTermination != "OOMKilled" {
+		t.Errorf("liveness = %+v", u)
+	}
+	if u.CPUMillicores != 250 {
+		t.Errorf("CPUMillicores = %d, want 250", u.CPUMillicores)
+	}
+	if u.MemoryLimitBytes != 1<<30 || u.MemoryBytes != 1000<<20 {
+		t.Errorf("memory = %d/%d", u.MemoryBytes, u.MemoryLimitBytes)
+	}
+	if u.WorkspaceUsedBytes != 2<<30 || u.WorkspaceCapacityBytes != 10<<30 {
+		t.Errorf("workspace = %d/%d", u.WorkspaceUsedBytes, u.WorkspaceCapacityBytes)
+	}
+	if got := strings.Join(u.Pressure(), ","); got != "memory" {
+		t.Errorf("Pressure() = %q, want memory", got)
+	}
+
+	// Without kubelet stats, pod-derived fields are still reported.
+	u = usageFromPod(pod, nil)
+	if !u.AgentRunning || u.MemoryBytes != 0 {
+		t.Errorf("usage without summary = %+v", u)
+	}
+}
+
+func TestHTTPReporter_SyncAll_AttachesResourceUsage(t *testing.T) {
+	pods := []runtime.Object{
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gt-gastown-polecat-furiosa",
+				Namespace: "gastown",
+				Labels: map[string]string{
+					"app.kubernetes.io/name": "gastown",
+					"gastown.io/rig":         "gastown",
+					"gastown.io/role":        "polecat",
+					"gastown.io/agent":       "furiosa",
+				},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+	}
+
+	mock := &mockBeadUpdater{}
+	client := fake.NewSimpleClientset(pods...)
+	r := NewHTTPReporter(mock, client, "gastown", slog.Default())
+	r.SetUsageCollector(fixedUsageCollector{
+		"gt-gastown-polecat-furiosa": {AgentRunning: true, MemoryBytes: 950, MemoryLimitBytes: 1000},
+	})
+
+	if err := r.SyncAll(context.Background()); err != nil {
+		t.Fatalf("SyncAll() error = %v", err)
+	}
+	if len(mock.notesCalls) != 1 {
+		t.Fatalf("expected 1 notes call, got %d", len(mock.notesCalls))
+	}
+	notes := mock.notesCalls[0].notes
+	for _, want := range []string{"pod_name: gt-gastown-polecat-furiosa", "agent_running: true", "memory_bytes: 950", "resource_pressure: memory"} {
+		if !strings.Contains(notes, want) {
+			t.Errorf("notes missing %q:\n%s", want, notes)
+		}
+	}
+	if strings.Contains(notes, "coop_url") {
+		t.Errorf("non-coop pod should not get coop_url:\n%s", notes)
+	}
+}
@@ -0,0 +1,109 @@
+// Package tracing emits OpenTelemetry spans for the controller's handling of
+// beads events.
+//
+// It mirrors gastown's internal/tracing, which this module can't import, and
+// must derive the same span context from a bead ID so that controller spans
+// join the traces gt starts. Events carry the agent bead, so pod operations
+// land in the agent bead's trace; gt sling links its spawn span to it.
+//
+// Tracing is off unless OTEL_EXPORTER_OTLP_ENDPOINT or
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set.
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Span attribute keys.
+const (
+	AttrBeadID = attribute.Key("gt.bead.id")
+	AttrAgent  = attribute.Key("gt.agent")
+	AttrRig    = attribute.Key("gt.rig")
+)
+
+const tracerName = "github.com/steveyegge/gastown/controller"
+
+// Enabled reports whether an OTLP trace endpoint is configured.
+func Enabled() bool {
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// Init installs a global tracer provider that exports over OTLP/HTTP, named
+// service unless OTEL_SERVICE_NAME overrides it. The returned function
+// flushes buffered spans and must be called before the process exits. When
+// tracing is not enabled, Init does nothing and the shutdown is a no-op.
+func Init(ctx context.Context, service string) (shutdown func(context.Context) error, err error) {
+	shutdown = func(context.Context) error { return nil }
+	if !Enabled() {
+		return shutdown, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return shutdown, err
+	}
+	// Later detectors win, so OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES
+	// override the default service name.
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", service)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return shutdown, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return provider.Shutdown, nil
+}
+
+// BeadSpanContext is the shared parent of every span for beadID.
+func BeadSpanContext(beadID string) trace.SpanContext {
+	sum := sha256.Sum256([]byte("gt-bead:" + beadID))
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	copy(traceID[:], sum[:16])
+	copy(spanID[:], sum[16:24])
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+}
+
+// Start starts a span for one stage of beadID's lifecycle. If ctx already
+// carries a span, the new span is its child; otherwise it is parented to the
+// bead's shared span context.
+func Start(ctx context.Context, beadID, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() && beadID != "" {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, BeadSpanContext(beadID))
+	}
+	attrs = append(attrs, AttrBeadID.String(beadID))
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err, if any, on span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
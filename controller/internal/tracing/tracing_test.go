@@ -0,0 +1,18 @@
+package tracing
+
+import "testing"
+
+// The controller's spans only join gt's traces if both derive the same span
+// context; gastown's internal/tracing pins the same values.
+func TestBeadSpanContext(t *testing.T) {
+	sc := BeadSpanContext("gt-abc")
+	if got := sc.TraceID().String(); got != "bf49356d3263898783e219bdaf194ed3" {
+		t.Errorf("trace ID = %s", got)
+	}
+	if got := sc.SpanID().String(); got != "c1fa2c1c05603564" {
+		t.Errorf("span ID = %s", got)
+	}
+	if !sc.IsSampled() || !sc.IsRemote() {
+		t.Errorf("span context = %v; want sampled and remote", sc)
+	}
+}
@@ -414,7 +414,9 @@ type ListEventsRequest struct {
 	// Maximum number of events to return (default: 100, max: 1000)
 	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Read from curated feed (true) or raw events (false)
-	Curated       bool `protobuf:"varint,3,opt,name=curated,proto3" json:"curated,omitempty"`
+	Curated bool `protobuf:"varint,3,opt,name=curated,proto3" json:"curated,omitempty"`
+	// Page token from a previous response's next_page_token. Raw events only.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -470,13 +472,22 @@ func (x *ListEventsRequest) GetCurated() bool {
 	return false
 }
 
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 // ListEventsResponse returns events from the activity feed
 type ListEventsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// List of events
 	Events []*ActivityEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	// Total count (may be > len(events) if limited)
-	TotalCount    int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Token for the next (older) page; empty when there are no more events.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -525,6 +536,13 @@ func (x *ListEventsResponse) GetTotalCount() int32 {
 	return 0
 }
 
+func (x *ListEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // WatchEventsRequest requests real-time event streaming
 type WatchEventsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -916,15 +934,18 @@ const file_gastown_v1_activity_proto_rawDesc = "" +
 	"visibility\x18\x04 \x01(\x0e2\x16.gastown.v1.VisibilityR\n" +
 	"visibility\x12\x14\n" +
 	"\x05after\x18\x05 \x01(\tR\x05after\x12\x16\n" +
-	"\x06before\x18\x06 \x01(\tR\x06before\"t\n" +
+	"\x06before\x18\x06 \x01(\tR\x06before\"\x93\x01\n" +
 	"\x11ListEventsRequest\x12/\n" +
 	"\x06filter\x18\x01 \x01(\v2\x17.gastown.v1.EventFilterR\x06filter\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x18\n" +
-	"\acurated\x18\x03 \x01(\bR\acurated\"h\n" +
+	"\acurated\x18\x03 \x01(\bR\acurated\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x90\x01\n" +
 	"\x12ListEventsResponse\x121\n" +
 	"\x06events\x18\x01 \x03(\v2\x19.gastown.v1.ActivityEventR\x06events\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\xb1\x01\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xb1\x01\n" +
 	"\x12WatchEventsRequest\x12/\n" +
 	"\x06filter\x18\x01 \x01(\v2\x17.gastown.v1.EventFilterR\x06filter\x12)\n" +
 	"\x10include_backfill\x18\x02 \x01(\bR\x0fincludeBackfill\x12%\n" +
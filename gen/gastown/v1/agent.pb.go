@@ -9,6 +9,7 @@ package gastownv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -146,6 +147,62 @@ func (AgentState) EnumDescriptor() ([]byte, []int) {
 	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{1}
 }
 
+// Sort order for ListAgents
+type AgentSort int32
+
+const (
+	AgentSort_AGENT_SORT_UNSPECIFIED AgentSort = 0 // Discovery order: global agents, then each rig
+	AgentSort_AGENT_SORT_ADDRESS     AgentSort = 1 // By address
+	AgentSort_AGENT_SORT_NAME        AgentSort = 2 // By short name, then address
+	AgentSort_AGENT_SORT_STATE       AgentSort = 3 // By state, stopped agents last, then address
+	AgentSort_AGENT_SORT_TYPE        AgentSort = 4 // By type, then address
+)
+
+// Enum value maps for AgentSort.
+var (
+	AgentSort_name = map[int32]string{
+		0: "AGENT_SORT_UNSPECIFIED",
+		1: "AGENT_SORT_ADDRESS",
+		2: "AGENT_SORT_NAME",
+		3: "AGENT_SORT_STATE",
+		4: "AGENT_SORT_TYPE",
+	}
+	AgentSort_value = map[string]int32{
+		"AGENT_SORT_UNSPECIFIED": 0,
+		"AGENT_SORT_ADDRESS":     1,
+		"AGENT_SORT_NAME":        2,
+		"AGENT_SORT_STATE":       3,
+		"AGENT_SORT_TYPE":        4,
+	}
+)
+
+func (x AgentSort) Enum() *AgentSort {
+	p := new(AgentSort)
+	*p = x
+	return p
+}
+
+func (x AgentSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AgentSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_gastown_v1_agent_proto_enumTypes[2].Descriptor()
+}
+
+func (AgentSort) Type() protoreflect.EnumType {
+	return &file_gastown_v1_agent_proto_enumTypes[2]
+}
+
+func (x AgentSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AgentSort.Descriptor instead.
+func (AgentSort) EnumDescriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{2}
+}
+
 type ListAgentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Filter by rig (empty = all rigs)
@@ -156,6 +213,22 @@ type ListAgentsRequest struct {
 	IncludeStopped bool `protobuf:"varint,3,opt,name=include_stopped,json=includeStopped,proto3" json:"include_stopped,omitempty"`
 	// Include global agents (mayor, deacon)
 	IncludeGlobal bool `protobuf:"varint,4,opt,name=include_global,json=includeGlobal,proto3" json:"include_global,omitempty"`
+	// Filter by any of these agent types, in addition to type
+	Types []AgentType `protobuf:"varint,5,rep,packed,name=types,proto3,enum=gastown.v1.AgentType" json:"types,omitempty"`
+	// Filter by any of these states. Asking for AGENT_STATE_STOPPED
+	// includes stopped agents without include_stopped.
+	States []AgentState `protobuf:"varint,6,rep,packed,name=states,proto3,enum=gastown.v1.AgentState" json:"states,omitempty"`
+	// Only agents with (true) or without (false) hooked work. Looking this
+	// up fills hooked_bead.
+	HasWork *bool `protobuf:"varint,7,opt,name=has_work,json=hasWork,proto3,oneof" json:"has_work,omitempty"`
+	// Sort order (default: discovery order, by rig)
+	Sort AgentSort `protobuf:"varint,8,opt,name=sort,proto3,enum=gastown.v1.AgentSort" json:"sort,omitempty"`
+	// Reverse the sort order
+	Descending bool `protobuf:"varint,9,opt,name=descending,proto3" json:"descending,omitempty"`
+	// Agent fields to return. Address is always returned. Without a mask,
+	// agents carry every field except hooked_bead, hooked_title and
+	// unread_mail, which are only looked up when named here.
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,10,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -218,6 +291,48 @@ func (x *ListAgentsRequest) GetIncludeGlobal() bool {
 	return false
 }
 
+func (x *ListAgentsRequest) GetTypes() []AgentType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListAgentsRequest) GetStates() []AgentState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListAgentsRequest) GetHasWork() bool {
+	if x != nil && x.HasWork != nil {
+		return *x.HasWork
+	}
+	return false
+}
+
+func (x *ListAgentsRequest) GetSort() AgentSort {
+	if x != nil {
+		return x.Sort
+	}
+	return AgentSort_AGENT_SORT_UNSPECIFIED
+}
+
+func (x *ListAgentsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListAgentsRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 type ListAgentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Agents        []*Agent               `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
@@ -652,6 +767,114 @@ func (x *StartCrewResponse) GetCreated() bool {
 	return false
 }
 
+type StopCrewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rig containing the crew
+	Rig string `protobuf:"bytes,1,opt,name=rig,proto3" json:"rig,omitempty"`
+	// Crew worker name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Reason for stopping
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopCrewRequest) Reset() {
+	*x = StopCrewRequest{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopCrewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopCrewRequest) ProtoMessage() {}
+
+func (x *StopCrewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopCrewRequest.ProtoReflect.Descriptor instead.
+func (*StopCrewRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StopCrewRequest) GetRig() string {
+	if x != nil {
+		return x.Rig
+	}
+	return ""
+}
+
+func (x *StopCrewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StopCrewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type StopCrewResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The stopped crew worker
+	Agent         *Agent `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopCrewResponse) Reset() {
+	*x = StopCrewResponse{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopCrewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopCrewResponse) ProtoMessage() {}
+
+func (x *StopCrewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopCrewResponse.ProtoReflect.Descriptor instead.
+func (*StopCrewResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StopCrewResponse) GetAgent() *Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
 type StopAgentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Agent address
@@ -666,7 +889,7 @@ type StopAgentRequest struct {
 
 func (x *StopAgentRequest) Reset() {
 	*x = StopAgentRequest{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[8]
+	mi := &file_gastown_v1_agent_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -678,7 +901,7 @@ func (x *StopAgentRequest) String() string {
 func (*StopAgentRequest) ProtoMessage() {}
 
 func (x *StopAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[8]
+	mi := &file_gastown_v1_agent_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +914,7 @@ func (x *StopAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopAgentRequest.ProtoReflect.Descriptor instead.
 func (*StopAgentRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{8}
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StopAgentRequest) GetAgent() string {
@@ -727,7 +950,7 @@ type StopAgentResponse struct {
 
 func (x *StopAgentResponse) Reset() {
 	*x = StopAgentResponse{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[9]
+	mi := &file_gastown_v1_agent_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -739,7 +962,7 @@ func (x *StopAgentResponse) String() string {
 func (*StopAgentResponse) ProtoMessage() {}
 
 func (x *StopAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[9]
+	mi := &file_gastown_v1_agent_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -752,7 +975,7 @@ func (x *StopAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopAgentResponse.ProtoReflect.Descriptor instead.
 func (*StopAgentResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{9}
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StopAgentResponse) GetAgent() *Agent {
@@ -783,7 +1006,7 @@ type NudgeAgentRequest struct {
 
 func (x *NudgeAgentRequest) Reset() {
 	*x = NudgeAgentRequest{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[10]
+	mi := &file_gastown_v1_agent_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -795,7 +1018,7 @@ func (x *NudgeAgentRequest) String() string {
 func (*NudgeAgentRequest) ProtoMessage() {}
 
 func (x *NudgeAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[10]
+	mi := &file_gastown_v1_agent_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +1031,7 @@ func (x *NudgeAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NudgeAgentRequest.ProtoReflect.Descriptor instead.
 func (*NudgeAgentRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{10}
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *NudgeAgentRequest) GetAgent() string {
@@ -842,7 +1065,7 @@ type NudgeAgentResponse struct {
 
 func (x *NudgeAgentResponse) Reset() {
 	*x = NudgeAgentResponse{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[11]
+	mi := &file_gastown_v1_agent_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -854,7 +1077,7 @@ func (x *NudgeAgentResponse) String() string {
 func (*NudgeAgentResponse) ProtoMessage() {}
 
 func (x *NudgeAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[11]
+	mi := &file_gastown_v1_agent_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,7 +1090,7 @@ func (x *NudgeAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NudgeAgentResponse.ProtoReflect.Descriptor instead.
 func (*NudgeAgentResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{11}
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *NudgeAgentResponse) GetDelivered() bool {
@@ -891,14 +1114,23 @@ type PeekAgentRequest struct {
 	// Number of lines to return (default 50)
 	Lines int32 `protobuf:"varint,2,opt,name=lines,proto3" json:"lines,omitempty"`
 	// Return all scrollback history
-	All           bool `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	All bool `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	// Regular expression (RE2) to search the full scrollback for. When set,
+	// matching lines are returned in matches instead of the tail of the
+	// output, and lines/all are ignored.
+	Search string `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	// Lines of context to include before and after each match (max 50)
+	Before int32 `protobuf:"varint,5,opt,name=before,proto3" json:"before,omitempty"`
+	After  int32 `protobuf:"varint,6,opt,name=after,proto3" json:"after,omitempty"`
+	// Maximum number of matches to return (default 20, max 200)
+	MaxMatches    int32 `protobuf:"varint,7,opt,name=max_matches,json=maxMatches,proto3" json:"max_matches,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PeekAgentRequest) Reset() {
 	*x = PeekAgentRequest{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[12]
+	mi := &file_gastown_v1_agent_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -910,7 +1142,7 @@ func (x *PeekAgentRequest) String() string {
 func (*PeekAgentRequest) ProtoMessage() {}
 
 func (x *PeekAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[12]
+	mi := &file_gastown_v1_agent_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,7 +1155,7 @@ func (x *PeekAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeekAgentRequest.ProtoReflect.Descriptor instead.
 func (*PeekAgentRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{12}
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PeekAgentRequest) GetAgent() string {
@@ -947,6 +1179,34 @@ func (x *PeekAgentRequest) GetAll() bool {
 	return false
 }
 
+func (x *PeekAgentRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *PeekAgentRequest) GetBefore() int32 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+func (x *PeekAgentRequest) GetAfter() int32 {
+	if x != nil {
+		return x.After
+	}
+	return 0
+}
+
+func (x *PeekAgentRequest) GetMaxMatches() int32 {
+	if x != nil {
+		return x.MaxMatches
+	}
+	return 0
+}
+
 type PeekAgentResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Terminal output
@@ -954,14 +1214,20 @@ type PeekAgentResponse struct {
 	// Output as lines
 	Lines []string `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
 	// True if agent session exists
-	Exists        bool `protobuf:"varint,3,opt,name=exists,proto3" json:"exists,omitempty"`
+	Exists bool `protobuf:"varint,3,opt,name=exists,proto3" json:"exists,omitempty"`
+	// Search results, in scrollback order
+	Matches []*PeekMatch `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches,omitempty"`
+	// More lines matched than max_matches
+	Truncated bool `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Number of scrollback lines searched
+	SearchedLines int32 `protobuf:"varint,6,opt,name=searched_lines,json=searchedLines,proto3" json:"searched_lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PeekAgentResponse) Reset() {
 	*x = PeekAgentResponse{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[13]
+	mi := &file_gastown_v1_agent_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -973,7 +1239,7 @@ func (x *PeekAgentResponse) String() string {
 func (*PeekAgentResponse) ProtoMessage() {}
 
 func (x *PeekAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[13]
+	mi := &file_gastown_v1_agent_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,7 +1252,7 @@ func (x *PeekAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeekAgentResponse.ProtoReflect.Descriptor instead.
 func (*PeekAgentResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{13}
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PeekAgentResponse) GetOutput() string {
@@ -1010,35 +1276,55 @@ func (x *PeekAgentResponse) GetExists() bool {
 	return false
 }
 
-type WatchAgentsRequest struct {
+func (x *PeekAgentResponse) GetMatches() []*PeekMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *PeekAgentResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *PeekAgentResponse) GetSearchedLines() int32 {
+	if x != nil {
+		return x.SearchedLines
+	}
+	return 0
+}
+
+// PeekMatch is a scrollback line that matched a PeekAgent search.
+type PeekMatch struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Filter by rig
-	Rig string `protobuf:"bytes,1,opt,name=rig,proto3" json:"rig,omitempty"`
-	// Filter by type
-	Type AgentType `protobuf:"varint,2,opt,name=type,proto3,enum=gastown.v1.AgentType" json:"type,omitempty"`
-	// Include global agents
-	IncludeGlobal bool `protobuf:"varint,3,opt,name=include_global,json=includeGlobal,proto3" json:"include_global,omitempty"`
-	// Polling interval in milliseconds (default 5000)
-	IntervalMs    int32 `protobuf:"varint,4,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	// Line number of the match in the scrollback, counting from 1
+	Line int32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// Line number of the first context line
+	Start int32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	// The match with its surrounding context
+	Lines         []string `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WatchAgentsRequest) Reset() {
-	*x = WatchAgentsRequest{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[14]
+func (x *PeekMatch) Reset() {
+	*x = PeekMatch{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WatchAgentsRequest) String() string {
+func (x *PeekMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchAgentsRequest) ProtoMessage() {}
+func (*PeekMatch) ProtoMessage() {}
 
-func (x *WatchAgentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[14]
+func (x *PeekMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,43 +1335,108 @@ func (x *WatchAgentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchAgentsRequest.ProtoReflect.Descriptor instead.
-func (*WatchAgentsRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *WatchAgentsRequest) GetRig() string {
-	if x != nil {
-		return x.Rig
-	}
-	return ""
+// Deprecated: Use PeekMatch.ProtoReflect.Descriptor instead.
+func (*PeekMatch) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *WatchAgentsRequest) GetType() AgentType {
+func (x *PeekMatch) GetLine() int32 {
 	if x != nil {
-		return x.Type
+		return x.Line
 	}
-	return AgentType_AGENT_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *WatchAgentsRequest) GetIncludeGlobal() bool {
+func (x *PeekMatch) GetStart() int32 {
 	if x != nil {
-		return x.IncludeGlobal
+		return x.Start
 	}
-	return false
+	return 0
 }
 
-func (x *WatchAgentsRequest) GetIntervalMs() int32 {
+func (x *PeekMatch) GetLines() []string {
 	if x != nil {
-		return x.IntervalMs
+		return x.Lines
 	}
-	return 0
+	return nil
 }
 
-type AgentUpdate struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	UpdateType    string                 `protobuf:"bytes,2,opt,name=update_type,json=updateType,proto3" json:"update_type,omitempty"` // spawned, started, stopped, state_changed
+type WatchAgentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter by rig
+	Rig string `protobuf:"bytes,1,opt,name=rig,proto3" json:"rig,omitempty"`
+	// Filter by type
+	Type AgentType `protobuf:"varint,2,opt,name=type,proto3,enum=gastown.v1.AgentType" json:"type,omitempty"`
+	// Include global agents
+	IncludeGlobal bool `protobuf:"varint,3,opt,name=include_global,json=includeGlobal,proto3" json:"include_global,omitempty"`
+	// Polling interval in milliseconds (default 5000)
+	IntervalMs    int32 `protobuf:"varint,4,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAgentsRequest) Reset() {
+	*x = WatchAgentsRequest{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAgentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAgentsRequest) ProtoMessage() {}
+
+func (x *WatchAgentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAgentsRequest.ProtoReflect.Descriptor instead.
+func (*WatchAgentsRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WatchAgentsRequest) GetRig() string {
+	if x != nil {
+		return x.Rig
+	}
+	return ""
+}
+
+func (x *WatchAgentsRequest) GetType() AgentType {
+	if x != nil {
+		return x.Type
+	}
+	return AgentType_AGENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchAgentsRequest) GetIncludeGlobal() bool {
+	if x != nil {
+		return x.IncludeGlobal
+	}
+	return false
+}
+
+func (x *WatchAgentsRequest) GetIntervalMs() int32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+type AgentUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	UpdateType    string                 `protobuf:"bytes,2,opt,name=update_type,json=updateType,proto3" json:"update_type,omitempty"` // spawned, started, stopped, state_changed
 	Agent         *Agent                 `protobuf:"bytes,3,opt,name=agent,proto3" json:"agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1093,7 +1444,7 @@ type AgentUpdate struct {
 
 func (x *AgentUpdate) Reset() {
 	*x = AgentUpdate{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[15]
+	mi := &file_gastown_v1_agent_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1105,7 +1456,7 @@ func (x *AgentUpdate) String() string {
 func (*AgentUpdate) ProtoMessage() {}
 
 func (x *AgentUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[15]
+	mi := &file_gastown_v1_agent_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1118,7 +1469,7 @@ func (x *AgentUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentUpdate.ProtoReflect.Descriptor instead.
 func (*AgentUpdate) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{15}
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AgentUpdate) GetTimestamp() *timestamppb.Timestamp {
@@ -1142,6 +1493,297 @@ func (x *AgentUpdate) GetAgent() *Agent {
 	return nil
 }
 
+type WatchAgentOutputRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Agent address (e.g., "gastown/polecats/furiosa")
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// Number of existing lines to send before streaming deltas (default 0, max 1000)
+	BackfillLines int32 `protobuf:"varint,2,opt,name=backfill_lines,json=backfillLines,proto3" json:"backfill_lines,omitempty"`
+	// Capture window used for diffing (default 200, max 1000). Bursts larger
+	// than the window between polls are delivered as a reset.
+	WindowLines int32 `protobuf:"varint,3,opt,name=window_lines,json=windowLines,proto3" json:"window_lines,omitempty"`
+	// Polling interval in milliseconds (default 1000, min 100)
+	IntervalMs    int32 `protobuf:"varint,4,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAgentOutputRequest) Reset() {
+	*x = WatchAgentOutputRequest{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAgentOutputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAgentOutputRequest) ProtoMessage() {}
+
+func (x *WatchAgentOutputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAgentOutputRequest.ProtoReflect.Descriptor instead.
+func (*WatchAgentOutputRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WatchAgentOutputRequest) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *WatchAgentOutputRequest) GetBackfillLines() int32 {
+	if x != nil {
+		return x.BackfillLines
+	}
+	return 0
+}
+
+func (x *WatchAgentOutputRequest) GetWindowLines() int32 {
+	if x != nil {
+		return x.WindowLines
+	}
+	return 0
+}
+
+func (x *WatchAgentOutputRequest) GetIntervalMs() int32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+// AgentOutputChunk is one incremental update of an agent's terminal output.
+// Apply it by dropping drop_lines trailing lines from the local buffer and
+// appending lines. When resync is true, replace the buffer with lines.
+type AgentOutputChunk struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Lines added since the previous chunk
+	Lines []string `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	// Trailing lines redrawn in place that the client should drop first
+	DropLines int32 `protobuf:"varint,3,opt,name=drop_lines,json=dropLines,proto3" json:"drop_lines,omitempty"`
+	// Previous output could not be aligned; lines is the full capture window
+	Resync bool `protobuf:"varint,4,opt,name=resync,proto3" json:"resync,omitempty"`
+	// True for the initial backfill chunk
+	Backfill bool `protobuf:"varint,5,opt,name=backfill,proto3" json:"backfill,omitempty"`
+	// Whether the session still exists
+	Exists        bool `protobuf:"varint,6,opt,name=exists,proto3" json:"exists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentOutputChunk) Reset() {
+	*x = AgentOutputChunk{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentOutputChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentOutputChunk) ProtoMessage() {}
+
+func (x *AgentOutputChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentOutputChunk.ProtoReflect.Descriptor instead.
+func (*AgentOutputChunk) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AgentOutputChunk) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AgentOutputChunk) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *AgentOutputChunk) GetDropLines() int32 {
+	if x != nil {
+		return x.DropLines
+	}
+	return 0
+}
+
+func (x *AgentOutputChunk) GetResync() bool {
+	if x != nil {
+		return x.Resync
+	}
+	return false
+}
+
+func (x *AgentOutputChunk) GetBackfill() bool {
+	if x != nil {
+		return x.Backfill
+	}
+	return false
+}
+
+func (x *AgentOutputChunk) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+type GetAgentRecordingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Agent address (e.g., "gastown/polecats/furiosa")
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// Recording ID (default: the latest)
+	Recording     string `protobuf:"bytes,2,opt,name=recording,proto3" json:"recording,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentRecordingRequest) Reset() {
+	*x = GetAgentRecordingRequest{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentRecordingRequest) ProtoMessage() {}
+
+func (x *GetAgentRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentRecordingRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAgentRecordingRequest) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *GetAgentRecordingRequest) GetRecording() string {
+	if x != nil {
+		return x.Recording
+	}
+	return ""
+}
+
+type GetAgentRecordingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the returned recording
+	Recording string `protobuf:"bytes,1,opt,name=recording,proto3" json:"recording,omitempty"`
+	// When recording started
+	Started *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started,proto3" json:"started,omitempty"`
+	// IDs of all of the agent's recordings, oldest first
+	Recordings []string `protobuf:"bytes,3,rep,name=recordings,proto3" json:"recordings,omitempty"`
+	// Output changes in the order they were captured
+	Frames        []*AgentOutputChunk `protobuf:"bytes,4,rep,name=frames,proto3" json:"frames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentRecordingResponse) Reset() {
+	*x = GetAgentRecordingResponse{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentRecordingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentRecordingResponse) ProtoMessage() {}
+
+func (x *GetAgentRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentRecordingResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetAgentRecordingResponse) GetRecording() string {
+	if x != nil {
+		return x.Recording
+	}
+	return ""
+}
+
+func (x *GetAgentRecordingResponse) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *GetAgentRecordingResponse) GetRecordings() []string {
+	if x != nil {
+		return x.Recordings
+	}
+	return nil
+}
+
+func (x *GetAgentRecordingResponse) GetFrames() []*AgentOutputChunk {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
 type CreateCrewRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Crew worker name
@@ -1156,7 +1798,7 @@ type CreateCrewRequest struct {
 
 func (x *CreateCrewRequest) Reset() {
 	*x = CreateCrewRequest{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[16]
+	mi := &file_gastown_v1_agent_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1168,7 +1810,7 @@ func (x *CreateCrewRequest) String() string {
 func (*CreateCrewRequest) ProtoMessage() {}
 
 func (x *CreateCrewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[16]
+	mi := &file_gastown_v1_agent_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,7 +1823,7 @@ func (x *CreateCrewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCrewRequest.ProtoReflect.Descriptor instead.
 func (*CreateCrewRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{16}
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CreateCrewRequest) GetName() string {
@@ -1219,7 +1861,7 @@ type CreateCrewResponse struct {
 
 func (x *CreateCrewResponse) Reset() {
 	*x = CreateCrewResponse{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[17]
+	mi := &file_gastown_v1_agent_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1231,7 +1873,7 @@ func (x *CreateCrewResponse) String() string {
 func (*CreateCrewResponse) ProtoMessage() {}
 
 func (x *CreateCrewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[17]
+	mi := &file_gastown_v1_agent_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1244,7 +1886,7 @@ func (x *CreateCrewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCrewResponse.ProtoReflect.Descriptor instead.
 func (*CreateCrewResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{17}
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CreateCrewResponse) GetBeadId() string {
@@ -1286,7 +1928,7 @@ type RemoveCrewRequest struct {
 
 func (x *RemoveCrewRequest) Reset() {
 	*x = RemoveCrewRequest{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[18]
+	mi := &file_gastown_v1_agent_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1298,7 +1940,7 @@ func (x *RemoveCrewRequest) String() string {
 func (*RemoveCrewRequest) ProtoMessage() {}
 
 func (x *RemoveCrewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[18]
+	mi := &file_gastown_v1_agent_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,69 +1953,193 @@ func (x *RemoveCrewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveCrewRequest.ProtoReflect.Descriptor instead.
 func (*RemoveCrewRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{18}
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RemoveCrewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemoveCrewRequest) GetRig() string {
+	if x != nil {
+		return x.Rig
+	}
+	return ""
+}
+
+func (x *RemoveCrewRequest) GetPurge() bool {
+	if x != nil {
+		return x.Purge
+	}
+	return false
+}
+
+func (x *RemoveCrewRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *RemoveCrewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RemoveCrewResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The agent bead ID that was closed/deleted
+	BeadId string `protobuf:"bytes,1,opt,name=bead_id,json=beadId,proto3" json:"bead_id,omitempty"`
+	// True if the bead was deleted (purge=true), false if just closed
+	Deleted       bool `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCrewResponse) Reset() {
+	*x = RemoveCrewResponse{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCrewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCrewResponse) ProtoMessage() {}
+
+func (x *RemoveCrewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCrewResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCrewResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RemoveCrewResponse) GetBeadId() string {
+	if x != nil {
+		return x.BeadId
+	}
+	return ""
+}
+
+func (x *RemoveCrewResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type DestroyCrewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rig containing the crew
+	Rig string `protobuf:"bytes,1,opt,name=rig,proto3" json:"rig,omitempty"`
+	// Crew worker name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Destroy even if the workspace has uncommitted changes
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// Reason for destroying
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroyCrewRequest) Reset() {
+	*x = DestroyCrewRequest{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroyCrewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyCrewRequest) ProtoMessage() {}
+
+func (x *DestroyCrewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *RemoveCrewRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use DestroyCrewRequest.ProtoReflect.Descriptor instead.
+func (*DestroyCrewRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RemoveCrewRequest) GetRig() string {
+func (x *DestroyCrewRequest) GetRig() string {
 	if x != nil {
 		return x.Rig
 	}
 	return ""
 }
 
-func (x *RemoveCrewRequest) GetPurge() bool {
+func (x *DestroyCrewRequest) GetName() string {
 	if x != nil {
-		return x.Purge
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *RemoveCrewRequest) GetForce() bool {
+func (x *DestroyCrewRequest) GetForce() bool {
 	if x != nil {
 		return x.Force
 	}
 	return false
 }
 
-func (x *RemoveCrewRequest) GetReason() string {
+func (x *DestroyCrewRequest) GetReason() string {
 	if x != nil {
 		return x.Reason
 	}
 	return ""
 }
 
-type RemoveCrewResponse struct {
+type DestroyCrewResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The agent bead ID that was closed/deleted
-	BeadId string `protobuf:"bytes,1,opt,name=bead_id,json=beadId,proto3" json:"bead_id,omitempty"`
-	// True if the bead was deleted (purge=true), false if just closed
-	Deleted       bool `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// The agent bead ID that was deleted (K8s only)
+	BeadId        string `protobuf:"bytes,1,opt,name=bead_id,json=beadId,proto3" json:"bead_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveCrewResponse) Reset() {
-	*x = RemoveCrewResponse{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[19]
+func (x *DestroyCrewResponse) Reset() {
+	*x = DestroyCrewResponse{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveCrewResponse) String() string {
+func (x *DestroyCrewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCrewResponse) ProtoMessage() {}
+func (*DestroyCrewResponse) ProtoMessage() {}
 
-func (x *RemoveCrewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[19]
+func (x *DestroyCrewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1384,25 +2150,18 @@ func (x *RemoveCrewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCrewResponse.ProtoReflect.Descriptor instead.
-func (*RemoveCrewResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DestroyCrewResponse.ProtoReflect.Descriptor instead.
+func (*DestroyCrewResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *RemoveCrewResponse) GetBeadId() string {
+func (x *DestroyCrewResponse) GetBeadId() string {
 	if x != nil {
 		return x.BeadId
 	}
 	return ""
 }
 
-func (x *RemoveCrewResponse) GetDeleted() bool {
-	if x != nil {
-		return x.Deleted
-	}
-	return false
-}
-
 // Agent represents a crew worker or polecat
 type Agent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1442,7 +2201,7 @@ type Agent struct {
 
 func (x *Agent) Reset() {
 	*x = Agent{}
-	mi := &file_gastown_v1_agent_proto_msgTypes[20]
+	mi := &file_gastown_v1_agent_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1454,7 +2213,7 @@ func (x *Agent) String() string {
 func (*Agent) ProtoMessage() {}
 
 func (x *Agent) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_agent_proto_msgTypes[20]
+	mi := &file_gastown_v1_agent_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1467,7 +2226,7 @@ func (x *Agent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Agent.ProtoReflect.Descriptor instead.
 func (*Agent) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{20}
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Agent) GetAddress() string {
@@ -1575,17 +2334,333 @@ func (x *Agent) GetConvoyId() string {
 	return ""
 }
 
+type AgentFileInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Base name
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"` // Absolute path in the pod
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Mode          uint32                 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"` // Permission bits
+	Modified      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=modified,proto3" json:"modified,omitempty"`
+	IsDir         bool                   `protobuf:"varint,6,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentFileInfo) Reset() {
+	*x = AgentFileInfo{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentFileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentFileInfo) ProtoMessage() {}
+
+func (x *AgentFileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentFileInfo.ProtoReflect.Descriptor instead.
+func (*AgentFileInfo) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AgentFileInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AgentFileInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AgentFileInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AgentFileInfo) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *AgentFileInfo) GetModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+func (x *AgentFileInfo) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+type ListAgentFilesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Agent address (e.g., "gastown/polecats/furiosa")
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// Absolute directory path (default: the workspace root)
+	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentFilesRequest) Reset() {
+	*x = ListAgentFilesRequest{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentFilesRequest) ProtoMessage() {}
+
+func (x *ListAgentFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentFilesRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListAgentFilesRequest) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *ListAgentFilesRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ListAgentFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Files         []*AgentFileInfo       `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentFilesResponse) Reset() {
+	*x = ListAgentFilesResponse{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentFilesResponse) ProtoMessage() {}
+
+func (x *ListAgentFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListAgentFilesResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListAgentFilesResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ListAgentFilesResponse) GetFiles() []*AgentFileInfo {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type FetchAgentFileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Agent address (e.g., "gastown/polecats/furiosa")
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// Absolute file path
+	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchAgentFileRequest) Reset() {
+	*x = FetchAgentFileRequest{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchAgentFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchAgentFileRequest) ProtoMessage() {}
+
+func (x *FetchAgentFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchAgentFileRequest.ProtoReflect.Descriptor instead.
+func (*FetchAgentFileRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FetchAgentFileRequest) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *FetchAgentFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type AgentFileChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set on the first chunk only
+	Info          *AgentFileInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	Data          []byte         `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Offset        int64          `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentFileChunk) Reset() {
+	*x = AgentFileChunk{}
+	mi := &file_gastown_v1_agent_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentFileChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentFileChunk) ProtoMessage() {}
+
+func (x *AgentFileChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_agent_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentFileChunk.ProtoReflect.Descriptor instead.
+func (*AgentFileChunk) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_agent_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AgentFileChunk) GetInfo() *AgentFileInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *AgentFileChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AgentFileChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 var File_gastown_v1_agent_proto protoreflect.FileDescriptor
 
 const file_gastown_v1_agent_proto_rawDesc = "" +
 	"\n" +
 	"\x16gastown/v1/agent.proto\x12\n" +
-	"gastown.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17gastown/v1/common.proto\"\xa0\x01\n" +
+	"gastown.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17gastown/v1/common.proto\"\xb0\x03\n" +
 	"\x11ListAgentsRequest\x12\x10\n" +
 	"\x03rig\x18\x01 \x01(\tR\x03rig\x12)\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x15.gastown.v1.AgentTypeR\x04type\x12'\n" +
 	"\x0finclude_stopped\x18\x03 \x01(\bR\x0eincludeStopped\x12%\n" +
-	"\x0einclude_global\x18\x04 \x01(\bR\rincludeGlobal\"o\n" +
+	"\x0einclude_global\x18\x04 \x01(\bR\rincludeGlobal\x12+\n" +
+	"\x05types\x18\x05 \x03(\x0e2\x15.gastown.v1.AgentTypeR\x05types\x12.\n" +
+	"\x06states\x18\x06 \x03(\x0e2\x16.gastown.v1.AgentStateR\x06states\x12\x1e\n" +
+	"\bhas_work\x18\a \x01(\bH\x00R\ahasWork\x88\x01\x01\x12)\n" +
+	"\x04sort\x18\b \x01(\x0e2\x15.gastown.v1.AgentSortR\x04sort\x12\x1e\n" +
+	"\n" +
+	"descending\x18\t \x01(\bR\n" +
+	"descending\x129\n" +
+	"\n" +
+	"field_mask\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMaskB\v\n" +
+	"\t_has_work\"o\n" +
 	"\x12ListAgentsResponse\x12)\n" +
 	"\x06agents\x18\x01 \x03(\v2\x11.gastown.v1.AgentR\x06agents\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x18\n" +
@@ -1613,7 +2688,13 @@ const file_gastown_v1_agent_proto_rawDesc = "" +
 	"\x11StartCrewResponse\x12'\n" +
 	"\x05agent\x18\x01 \x01(\v2\x11.gastown.v1.AgentR\x05agent\x12\x18\n" +
 	"\asession\x18\x02 \x01(\tR\asession\x12\x18\n" +
-	"\acreated\x18\x03 \x01(\bR\acreated\"V\n" +
+	"\acreated\x18\x03 \x01(\bR\acreated\"O\n" +
+	"\x0fStopCrewRequest\x12\x10\n" +
+	"\x03rig\x18\x01 \x01(\tR\x03rig\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\";\n" +
+	"\x10StopCrewResponse\x12'\n" +
+	"\x05agent\x18\x01 \x01(\v2\x11.gastown.v1.AgentR\x05agent\"V\n" +
 	"\x10StopAgentRequest\x12\x14\n" +
 	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\x14\n" +
 	"\x05force\x18\x02 \x01(\bR\x05force\x12\x16\n" +
@@ -1627,15 +2708,27 @@ const file_gastown_v1_agent_proto_rawDesc = "" +
 	"\x06urgent\x18\x03 \x01(\bR\x06urgent\"L\n" +
 	"\x12NudgeAgentResponse\x12\x1c\n" +
 	"\tdelivered\x18\x01 \x01(\bR\tdelivered\x12\x18\n" +
-	"\asession\x18\x02 \x01(\tR\asession\"P\n" +
+	"\asession\x18\x02 \x01(\tR\asession\"\xb7\x01\n" +
 	"\x10PeekAgentRequest\x12\x14\n" +
 	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\x14\n" +
 	"\x05lines\x18\x02 \x01(\x05R\x05lines\x12\x10\n" +
-	"\x03all\x18\x03 \x01(\bR\x03all\"Y\n" +
+	"\x03all\x18\x03 \x01(\bR\x03all\x12\x16\n" +
+	"\x06search\x18\x04 \x01(\tR\x06search\x12\x16\n" +
+	"\x06before\x18\x05 \x01(\x05R\x06before\x12\x14\n" +
+	"\x05after\x18\x06 \x01(\x05R\x05after\x12\x1f\n" +
+	"\vmax_matches\x18\a \x01(\x05R\n" +
+	"maxMatches\"\xcf\x01\n" +
 	"\x11PeekAgentResponse\x12\x16\n" +
 	"\x06output\x18\x01 \x01(\tR\x06output\x12\x14\n" +
 	"\x05lines\x18\x02 \x03(\tR\x05lines\x12\x16\n" +
-	"\x06exists\x18\x03 \x01(\bR\x06exists\"\x99\x01\n" +
+	"\x06exists\x18\x03 \x01(\bR\x06exists\x12/\n" +
+	"\amatches\x18\x04 \x03(\v2\x15.gastown.v1.PeekMatchR\amatches\x12\x1c\n" +
+	"\ttruncated\x18\x05 \x01(\bR\ttruncated\x12%\n" +
+	"\x0esearched_lines\x18\x06 \x01(\x05R\rsearchedLines\"K\n" +
+	"\tPeekMatch\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x05R\x05start\x12\x14\n" +
+	"\x05lines\x18\x03 \x03(\tR\x05lines\"\x99\x01\n" +
 	"\x12WatchAgentsRequest\x12\x10\n" +
 	"\x03rig\x18\x01 \x01(\tR\x03rig\x12)\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x15.gastown.v1.AgentTypeR\x04type\x12%\n" +
@@ -1646,7 +2739,31 @@ const file_gastown_v1_agent_proto_rawDesc = "" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1f\n" +
 	"\vupdate_type\x18\x02 \x01(\tR\n" +
 	"updateType\x12'\n" +
-	"\x05agent\x18\x03 \x01(\v2\x11.gastown.v1.AgentR\x05agent\"Q\n" +
+	"\x05agent\x18\x03 \x01(\v2\x11.gastown.v1.AgentR\x05agent\"\x9a\x01\n" +
+	"\x17WatchAgentOutputRequest\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12%\n" +
+	"\x0ebackfill_lines\x18\x02 \x01(\x05R\rbackfillLines\x12!\n" +
+	"\fwindow_lines\x18\x03 \x01(\x05R\vwindowLines\x12\x1f\n" +
+	"\vinterval_ms\x18\x04 \x01(\x05R\n" +
+	"intervalMs\"\xcd\x01\n" +
+	"\x10AgentOutputChunk\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05lines\x18\x02 \x03(\tR\x05lines\x12\x1d\n" +
+	"\n" +
+	"drop_lines\x18\x03 \x01(\x05R\tdropLines\x12\x16\n" +
+	"\x06resync\x18\x04 \x01(\bR\x06resync\x12\x1a\n" +
+	"\bbackfill\x18\x05 \x01(\bR\bbackfill\x12\x16\n" +
+	"\x06exists\x18\x06 \x01(\bR\x06exists\"N\n" +
+	"\x18GetAgentRecordingRequest\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\x1c\n" +
+	"\trecording\x18\x02 \x01(\tR\trecording\"\xc5\x01\n" +
+	"\x19GetAgentRecordingResponse\x12\x1c\n" +
+	"\trecording\x18\x01 \x01(\tR\trecording\x124\n" +
+	"\astarted\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\astarted\x12\x1e\n" +
+	"\n" +
+	"recordings\x18\x03 \x03(\tR\n" +
+	"recordings\x124\n" +
+	"\x06frames\x18\x04 \x03(\v2\x1c.gastown.v1.AgentOutputChunkR\x06frames\"Q\n" +
 	"\x11CreateCrewRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03rig\x18\x02 \x01(\tR\x03rig\x12\x16\n" +
@@ -1663,7 +2780,14 @@ const file_gastown_v1_agent_proto_rawDesc = "" +
 	"\x06reason\x18\x05 \x01(\tR\x06reason\"G\n" +
 	"\x12RemoveCrewResponse\x12\x17\n" +
 	"\abead_id\x18\x01 \x01(\tR\x06beadId\x12\x18\n" +
-	"\adeleted\x18\x02 \x01(\bR\adeleted\"\x8a\x04\n" +
+	"\adeleted\x18\x02 \x01(\bR\adeleted\"h\n" +
+	"\x12DestroyCrewRequest\x12\x10\n" +
+	"\x03rig\x18\x01 \x01(\tR\x03rig\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\".\n" +
+	"\x13DestroyCrewResponse\x12\x17\n" +
+	"\abead_id\x18\x01 \x01(\tR\x06beadId\"\x8a\x04\n" +
 	"\x05Agent\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
@@ -1684,7 +2808,27 @@ const file_gastown_v1_agent_proto_rawDesc = "" +
 	"\rlast_activity\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\flastActivity\x12\x1d\n" +
 	"\n" +
 	"git_status\x18\x0e \x01(\tR\tgitStatus\x12\x1b\n" +
-	"\tconvoy_id\x18\x0f \x01(\tR\bconvoyId*\xb2\x01\n" +
+	"\tconvoy_id\x18\x0f \x01(\tR\bconvoyId\"\xae\x01\n" +
+	"\rAgentFileInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\rR\x04mode\x126\n" +
+	"\bmodified\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bmodified\x12\x15\n" +
+	"\x06is_dir\x18\x06 \x01(\bR\x05isDir\"A\n" +
+	"\x15ListAgentFilesRequest\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"]\n" +
+	"\x16ListAgentFilesResponse\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12/\n" +
+	"\x05files\x18\x02 \x03(\v2\x19.gastown.v1.AgentFileInfoR\x05files\"A\n" +
+	"\x15FetchAgentFileRequest\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"k\n" +
+	"\x0eAgentFileChunk\x12-\n" +
+	"\x04info\x18\x01 \x01(\v2\x19.gastown.v1.AgentFileInfoR\x04info\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset*\xb2\x01\n" +
 	"\tAgentType\x12\x1a\n" +
 	"\x16AGENT_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fAGENT_TYPE_CREW\x10\x01\x12\x16\n" +
@@ -1701,22 +2845,35 @@ const file_gastown_v1_agent_proto_rawDesc = "" +
 	"\x13AGENT_STATE_WORKING\x10\x03\x12\x14\n" +
 	"\x10AGENT_STATE_IDLE\x10\x04\x12\x15\n" +
 	"\x11AGENT_STATE_STUCK\x10\x05\x12\x14\n" +
-	"\x10AGENT_STATE_DONE\x10\x062\x84\x06\n" +
+	"\x10AGENT_STATE_DONE\x10\x06*\x7f\n" +
+	"\tAgentSort\x12\x1a\n" +
+	"\x16AGENT_SORT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12AGENT_SORT_ADDRESS\x10\x01\x12\x13\n" +
+	"\x0fAGENT_SORT_NAME\x10\x02\x12\x14\n" +
+	"\x10AGENT_SORT_STATE\x10\x03\x12\x13\n" +
+	"\x0fAGENT_SORT_TYPE\x10\x042\x82\n" +
+	"\n" +
 	"\fAgentService\x12K\n" +
 	"\n" +
 	"ListAgents\x12\x1d.gastown.v1.ListAgentsRequest\x1a\x1e.gastown.v1.ListAgentsResponse\x12E\n" +
 	"\bGetAgent\x12\x1b.gastown.v1.GetAgentRequest\x1a\x1c.gastown.v1.GetAgentResponse\x12Q\n" +
 	"\fSpawnPolecat\x12\x1f.gastown.v1.SpawnPolecatRequest\x1a .gastown.v1.SpawnPolecatResponse\x12H\n" +
-	"\tStartCrew\x12\x1c.gastown.v1.StartCrewRequest\x1a\x1d.gastown.v1.StartCrewResponse\x12H\n" +
+	"\tStartCrew\x12\x1c.gastown.v1.StartCrewRequest\x1a\x1d.gastown.v1.StartCrewResponse\x12E\n" +
+	"\bStopCrew\x12\x1b.gastown.v1.StopCrewRequest\x1a\x1c.gastown.v1.StopCrewResponse\x12H\n" +
 	"\tStopAgent\x12\x1c.gastown.v1.StopAgentRequest\x1a\x1d.gastown.v1.StopAgentResponse\x12K\n" +
 	"\n" +
 	"NudgeAgent\x12\x1d.gastown.v1.NudgeAgentRequest\x1a\x1e.gastown.v1.NudgeAgentResponse\x12H\n" +
 	"\tPeekAgent\x12\x1c.gastown.v1.PeekAgentRequest\x1a\x1d.gastown.v1.PeekAgentResponse\x12H\n" +
-	"\vWatchAgents\x12\x1e.gastown.v1.WatchAgentsRequest\x1a\x17.gastown.v1.AgentUpdate0\x01\x12K\n" +
+	"\vWatchAgents\x12\x1e.gastown.v1.WatchAgentsRequest\x1a\x17.gastown.v1.AgentUpdate0\x01\x12W\n" +
+	"\x10WatchAgentOutput\x12#.gastown.v1.WatchAgentOutputRequest\x1a\x1c.gastown.v1.AgentOutputChunk0\x01\x12`\n" +
+	"\x11GetAgentRecording\x12$.gastown.v1.GetAgentRecordingRequest\x1a%.gastown.v1.GetAgentRecordingResponse\x12W\n" +
+	"\x0eListAgentFiles\x12!.gastown.v1.ListAgentFilesRequest\x1a\".gastown.v1.ListAgentFilesResponse\x12Q\n" +
+	"\x0eFetchAgentFile\x12!.gastown.v1.FetchAgentFileRequest\x1a\x1a.gastown.v1.AgentFileChunk0\x01\x12K\n" +
 	"\n" +
 	"CreateCrew\x12\x1d.gastown.v1.CreateCrewRequest\x1a\x1e.gastown.v1.CreateCrewResponse\x12K\n" +
 	"\n" +
-	"RemoveCrew\x12\x1d.gastown.v1.RemoveCrewRequest\x1a\x1e.gastown.v1.RemoveCrewResponseB\x9d\x01\n" +
+	"RemoveCrew\x12\x1d.gastown.v1.RemoveCrewRequest\x1a\x1e.gastown.v1.RemoveCrewResponse\x12N\n" +
+	"\vDestroyCrew\x12\x1e.gastown.v1.DestroyCrewRequest\x1a\x1f.gastown.v1.DestroyCrewResponseB\x9d\x01\n" +
 	"\x0ecom.gastown.v1B\n" +
 	"AgentProtoP\x01Z6github.com/steveyegge/gastown/gen/gastown/v1;gastownv1\xa2\x02\x03GXX\xaa\x02\n" +
 	"Gastown.V1\xca\x02\n" +
@@ -1734,74 +2891,114 @@ func file_gastown_v1_agent_proto_rawDescGZIP() []byte {
 	return file_gastown_v1_agent_proto_rawDescData
 }
 
-var file_gastown_v1_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_gastown_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_gastown_v1_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_gastown_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_gastown_v1_agent_proto_goTypes = []any{
-	(AgentType)(0),                // 0: gastown.v1.AgentType
-	(AgentState)(0),               // 1: gastown.v1.AgentState
-	(*ListAgentsRequest)(nil),     // 2: gastown.v1.ListAgentsRequest
-	(*ListAgentsResponse)(nil),    // 3: gastown.v1.ListAgentsResponse
-	(*GetAgentRequest)(nil),       // 4: gastown.v1.GetAgentRequest
-	(*GetAgentResponse)(nil),      // 5: gastown.v1.GetAgentResponse
-	(*SpawnPolecatRequest)(nil),   // 6: gastown.v1.SpawnPolecatRequest
-	(*SpawnPolecatResponse)(nil),  // 7: gastown.v1.SpawnPolecatResponse
-	(*StartCrewRequest)(nil),      // 8: gastown.v1.StartCrewRequest
-	(*StartCrewResponse)(nil),     // 9: gastown.v1.StartCrewResponse
-	(*StopAgentRequest)(nil),      // 10: gastown.v1.StopAgentRequest
-	(*StopAgentResponse)(nil),     // 11: gastown.v1.StopAgentResponse
-	(*NudgeAgentRequest)(nil),     // 12: gastown.v1.NudgeAgentRequest
-	(*NudgeAgentResponse)(nil),    // 13: gastown.v1.NudgeAgentResponse
-	(*PeekAgentRequest)(nil),      // 14: gastown.v1.PeekAgentRequest
-	(*PeekAgentResponse)(nil),     // 15: gastown.v1.PeekAgentResponse
-	(*WatchAgentsRequest)(nil),    // 16: gastown.v1.WatchAgentsRequest
-	(*AgentUpdate)(nil),           // 17: gastown.v1.AgentUpdate
-	(*CreateCrewRequest)(nil),     // 18: gastown.v1.CreateCrewRequest
-	(*CreateCrewResponse)(nil),    // 19: gastown.v1.CreateCrewResponse
-	(*RemoveCrewRequest)(nil),     // 20: gastown.v1.RemoveCrewRequest
-	(*RemoveCrewResponse)(nil),    // 21: gastown.v1.RemoveCrewResponse
-	(*Agent)(nil),                 // 22: gastown.v1.Agent
-	(*timestamppb.Timestamp)(nil), // 23: google.protobuf.Timestamp
+	(AgentType)(0),                    // 0: gastown.v1.AgentType
+	(AgentState)(0),                   // 1: gastown.v1.AgentState
+	(AgentSort)(0),                    // 2: gastown.v1.AgentSort
+	(*ListAgentsRequest)(nil),         // 3: gastown.v1.ListAgentsRequest
+	(*ListAgentsResponse)(nil),        // 4: gastown.v1.ListAgentsResponse
+	(*GetAgentRequest)(nil),           // 5: gastown.v1.GetAgentRequest
+	(*GetAgentResponse)(nil),          // 6: gastown.v1.GetAgentResponse
+	(*SpawnPolecatRequest)(nil),       // 7: gastown.v1.SpawnPolecatRequest
+	(*SpawnPolecatResponse)(nil),      // 8: gastown.v1.SpawnPolecatResponse
+	(*StartCrewRequest)(nil),          // 9: gastown.v1.StartCrewRequest
+	(*StartCrewResponse)(nil),         // 10: gastown.v1.StartCrewResponse
+	(*StopCrewRequest)(nil),           // 11: gastown.v1.StopCrewRequest
+	(*StopCrewResponse)(nil),          // 12: gastown.v1.StopCrewResponse
+	(*StopAgentRequest)(nil),          // 13: gastown.v1.StopAgentRequest
+	(*StopAgentResponse)(nil),         // 14: gastown.v1.StopAgentResponse
+	(*NudgeAgentRequest)(nil),         // 15: gastown.v1.NudgeAgentRequest
+	(*NudgeAgentResponse)(nil),        // 16: gastown.v1.NudgeAgentResponse
+	(*PeekAgentRequest)(nil),          // 17: gastown.v1.PeekAgentRequest
+	(*PeekAgentResponse)(nil),         // 18: gastown.v1.PeekAgentResponse
+	(*PeekMatch)(nil),                 // 19: gastown.v1.PeekMatch
+	(*WatchAgentsRequest)(nil),        // 20: gastown.v1.WatchAgentsRequest
+	(*AgentUpdate)(nil),               // 21: gastown.v1.AgentUpdate
+	(*WatchAgentOutputRequest)(nil),   // 22: gastown.v1.WatchAgentOutputRequest
+	(*AgentOutputChunk)(nil),          // 23: gastown.v1.AgentOutputChunk
+	(*GetAgentRecordingRequest)(nil),  // 24: gastown.v1.GetAgentRecordingRequest
+	(*GetAgentRecordingResponse)(nil), // 25: gastown.v1.GetAgentRecordingResponse
+	(*CreateCrewRequest)(nil),         // 26: gastown.v1.CreateCrewRequest
+	(*CreateCrewResponse)(nil),        // 27: gastown.v1.CreateCrewResponse
+	(*RemoveCrewRequest)(nil),         // 28: gastown.v1.RemoveCrewRequest
+	(*RemoveCrewResponse)(nil),        // 29: gastown.v1.RemoveCrewResponse
+	(*DestroyCrewRequest)(nil),        // 30: gastown.v1.DestroyCrewRequest
+	(*DestroyCrewResponse)(nil),       // 31: gastown.v1.DestroyCrewResponse
+	(*Agent)(nil),                     // 32: gastown.v1.Agent
+	(*AgentFileInfo)(nil),             // 33: gastown.v1.AgentFileInfo
+	(*ListAgentFilesRequest)(nil),     // 34: gastown.v1.ListAgentFilesRequest
+	(*ListAgentFilesResponse)(nil),    // 35: gastown.v1.ListAgentFilesResponse
+	(*FetchAgentFileRequest)(nil),     // 36: gastown.v1.FetchAgentFileRequest
+	(*AgentFileChunk)(nil),            // 37: gastown.v1.AgentFileChunk
+	(*fieldmaskpb.FieldMask)(nil),     // 38: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),     // 39: google.protobuf.Timestamp
 }
 var file_gastown_v1_agent_proto_depIdxs = []int32{
 	0,  // 0: gastown.v1.ListAgentsRequest.type:type_name -> gastown.v1.AgentType
-	22, // 1: gastown.v1.ListAgentsResponse.agents:type_name -> gastown.v1.Agent
-	22, // 2: gastown.v1.GetAgentResponse.agent:type_name -> gastown.v1.Agent
-	22, // 3: gastown.v1.SpawnPolecatResponse.agent:type_name -> gastown.v1.Agent
-	22, // 4: gastown.v1.StartCrewResponse.agent:type_name -> gastown.v1.Agent
-	22, // 5: gastown.v1.StopAgentResponse.agent:type_name -> gastown.v1.Agent
-	0,  // 6: gastown.v1.WatchAgentsRequest.type:type_name -> gastown.v1.AgentType
-	23, // 7: gastown.v1.AgentUpdate.timestamp:type_name -> google.protobuf.Timestamp
-	22, // 8: gastown.v1.AgentUpdate.agent:type_name -> gastown.v1.Agent
-	22, // 9: gastown.v1.CreateCrewResponse.agent:type_name -> gastown.v1.Agent
-	0,  // 10: gastown.v1.Agent.type:type_name -> gastown.v1.AgentType
-	1,  // 11: gastown.v1.Agent.state:type_name -> gastown.v1.AgentState
-	23, // 12: gastown.v1.Agent.started_at:type_name -> google.protobuf.Timestamp
-	23, // 13: gastown.v1.Agent.last_activity:type_name -> google.protobuf.Timestamp
-	2,  // 14: gastown.v1.AgentService.ListAgents:input_type -> gastown.v1.ListAgentsRequest
-	4,  // 15: gastown.v1.AgentService.GetAgent:input_type -> gastown.v1.GetAgentRequest
-	6,  // 16: gastown.v1.AgentService.SpawnPolecat:input_type -> gastown.v1.SpawnPolecatRequest
-	8,  // 17: gastown.v1.AgentService.StartCrew:input_type -> gastown.v1.StartCrewRequest
-	10, // 18: gastown.v1.AgentService.StopAgent:input_type -> gastown.v1.StopAgentRequest
-	12, // 19: gastown.v1.AgentService.NudgeAgent:input_type -> gastown.v1.NudgeAgentRequest
-	14, // 20: gastown.v1.AgentService.PeekAgent:input_type -> gastown.v1.PeekAgentRequest
-	16, // 21: gastown.v1.AgentService.WatchAgents:input_type -> gastown.v1.WatchAgentsRequest
-	18, // 22: gastown.v1.AgentService.CreateCrew:input_type -> gastown.v1.CreateCrewRequest
-	20, // 23: gastown.v1.AgentService.RemoveCrew:input_type -> gastown.v1.RemoveCrewRequest
-	3,  // 24: gastown.v1.AgentService.ListAgents:output_type -> gastown.v1.ListAgentsResponse
-	5,  // 25: gastown.v1.AgentService.GetAgent:output_type -> gastown.v1.GetAgentResponse
-	7,  // 26: gastown.v1.AgentService.SpawnPolecat:output_type -> gastown.v1.SpawnPolecatResponse
-	9,  // 27: gastown.v1.AgentService.StartCrew:output_type -> gastown.v1.StartCrewResponse
-	11, // 28: gastown.v1.AgentService.StopAgent:output_type -> gastown.v1.StopAgentResponse
-	13, // 29: gastown.v1.AgentService.NudgeAgent:output_type -> gastown.v1.NudgeAgentResponse
-	15, // 30: gastown.v1.AgentService.PeekAgent:output_type -> gastown.v1.PeekAgentResponse
-	17, // 31: gastown.v1.AgentService.WatchAgents:output_type -> gastown.v1.AgentUpdate
-	19, // 32: gastown.v1.AgentService.CreateCrew:output_type -> gastown.v1.CreateCrewResponse
-	21, // 33: gastown.v1.AgentService.RemoveCrew:output_type -> gastown.v1.RemoveCrewResponse
-	24, // [24:34] is the sub-list for method output_type
-	14, // [14:24] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	0,  // 1: gastown.v1.ListAgentsRequest.types:type_name -> gastown.v1.AgentType
+	1,  // 2: gastown.v1.ListAgentsRequest.states:type_name -> gastown.v1.AgentState
+	2,  // 3: gastown.v1.ListAgentsRequest.sort:type_name -> gastown.v1.AgentSort
+	38, // 4: gastown.v1.ListAgentsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	32, // 5: gastown.v1.ListAgentsResponse.agents:type_name -> gastown.v1.Agent
+	32, // 6: gastown.v1.GetAgentResponse.agent:type_name -> gastown.v1.Agent
+	32, // 7: gastown.v1.SpawnPolecatResponse.agent:type_name -> gastown.v1.Agent
+	32, // 8: gastown.v1.StartCrewResponse.agent:type_name -> gastown.v1.Agent
+	32, // 9: gastown.v1.StopCrewResponse.agent:type_name -> gastown.v1.Agent
+	32, // 10: gastown.v1.StopAgentResponse.agent:type_name -> gastown.v1.Agent
+	19, // 11: gastown.v1.PeekAgentResponse.matches:type_name -> gastown.v1.PeekMatch
+	0,  // 12: gastown.v1.WatchAgentsRequest.type:type_name -> gastown.v1.AgentType
+	39, // 13: gastown.v1.AgentUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	32, // 14: gastown.v1.AgentUpdate.agent:type_name -> gastown.v1.Agent
+	39, // 15: gastown.v1.AgentOutputChunk.timestamp:type_name -> google.protobuf.Timestamp
+	39, // 16: gastown.v1.GetAgentRecordingResponse.started:type_name -> google.protobuf.Timestamp
+	23, // 17: gastown.v1.GetAgentRecordingResponse.frames:type_name -> gastown.v1.AgentOutputChunk
+	32, // 18: gastown.v1.CreateCrewResponse.agent:type_name -> gastown.v1.Agent
+	0,  // 19: gastown.v1.Agent.type:type_name -> gastown.v1.AgentType
+	1,  // 20: gastown.v1.Agent.state:type_name -> gastown.v1.AgentState
+	39, // 21: gastown.v1.Agent.started_at:type_name -> google.protobuf.Timestamp
+	39, // 22: gastown.v1.Agent.last_activity:type_name -> google.protobuf.Timestamp
+	39, // 23: gastown.v1.AgentFileInfo.modified:type_name -> google.protobuf.Timestamp
+	33, // 24: gastown.v1.ListAgentFilesResponse.files:type_name -> gastown.v1.AgentFileInfo
+	33, // 25: gastown.v1.AgentFileChunk.info:type_name -> gastown.v1.AgentFileInfo
+	3,  // 26: gastown.v1.AgentService.ListAgents:input_type -> gastown.v1.ListAgentsRequest
+	5,  // 27: gastown.v1.AgentService.GetAgent:input_type -> gastown.v1.GetAgentRequest
+	7,  // 28: gastown.v1.AgentService.SpawnPolecat:input_type -> gastown.v1.SpawnPolecatRequest
+	9,  // 29: gastown.v1.AgentService.StartCrew:input_type -> gastown.v1.StartCrewRequest
+	11, // 30: gastown.v1.AgentService.StopCrew:input_type -> gastown.v1.StopCrewRequest
+	13, // 31: gastown.v1.AgentService.StopAgent:input_type -> gastown.v1.StopAgentRequest
+	15, // 32: gastown.v1.AgentService.NudgeAgent:input_type -> gastown.v1.NudgeAgentRequest
+	17, // 33: gastown.v1.AgentService.PeekAgent:input_type -> gastown.v1.PeekAgentRequest
+	20, // 34: gastown.v1.AgentService.WatchAgents:input_type -> gastown.v1.WatchAgentsRequest
+	22, // 35: gastown.v1.AgentService.WatchAgentOutput:input_type -> gastown.v1.WatchAgentOutputRequest
+	24, // 36: gastown.v1.AgentService.GetAgentRecording:input_type -> gastown.v1.GetAgentRecordingRequest
+	34, // 37: gastown.v1.AgentService.ListAgentFiles:input_type -> gastown.v1.ListAgentFilesRequest
+	36, // 38: gastown.v1.AgentService.FetchAgentFile:input_type -> gastown.v1.FetchAgentFileRequest
+	26, // 39: gastown.v1.AgentService.CreateCrew:input_type -> gastown.v1.CreateCrewRequest
+	28, // 40: gastown.v1.AgentService.RemoveCrew:input_type -> gastown.v1.RemoveCrewRequest
+	30, // 41: gastown.v1.AgentService.DestroyCrew:input_type -> gastown.v1.DestroyCrewRequest
+	4,  // 42: gastown.v1.AgentService.ListAgents:output_type -> gastown.v1.ListAgentsResponse
+	6,  // 43: gastown.v1.AgentService.GetAgent:output_type -> gastown.v1.GetAgentResponse
+	8,  // 44: gastown.v1.AgentService.SpawnPolecat:output_type -> gastown.v1.SpawnPolecatResponse
+	10, // 45: gastown.v1.AgentService.StartCrew:output_type -> gastown.v1.StartCrewResponse
+	12, // 46: gastown.v1.AgentService.StopCrew:output_type -> gastown.v1.StopCrewResponse
+	14, // 47: gastown.v1.AgentService.StopAgent:output_type -> gastown.v1.StopAgentResponse
+	16, // 48: gastown.v1.AgentService.NudgeAgent:output_type -> gastown.v1.NudgeAgentResponse
+	18, // 49: gastown.v1.AgentService.PeekAgent:output_type -> gastown.v1.PeekAgentResponse
+	21, // 50: gastown.v1.AgentService.WatchAgents:output_type -> gastown.v1.AgentUpdate
+	23, // 51: gastown.v1.AgentService.WatchAgentOutput:output_type -> gastown.v1.AgentOutputChunk
+	25, // 52: gastown.v1.AgentService.GetAgentRecording:output_type -> gastown.v1.GetAgentRecordingResponse
+	35, // 53: gastown.v1.AgentService.ListAgentFiles:output_type -> gastown.v1.ListAgentFilesResponse
+	37, // 54: gastown.v1.AgentService.FetchAgentFile:output_type -> gastown.v1.AgentFileChunk
+	27, // 55: gastown.v1.AgentService.CreateCrew:output_type -> gastown.v1.CreateCrewResponse
+	29, // 56: gastown.v1.AgentService.RemoveCrew:output_type -> gastown.v1.RemoveCrewResponse
+	31, // 57: gastown.v1.AgentService.DestroyCrew:output_type -> gastown.v1.DestroyCrewResponse
+	42, // [42:58] is the sub-list for method output_type
+	26, // [26:42] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_gastown_v1_agent_proto_init() }
@@ -1810,13 +3007,14 @@ func file_gastown_v1_agent_proto_init() {
 		return
 	}
 	file_gastown_v1_common_proto_init()
+	file_gastown_v1_agent_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastown_v1_agent_proto_rawDesc), len(file_gastown_v1_agent_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   21,
+			NumEnums:      3,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -804,8 +804,8 @@ type CreateIssueRequest struct {
 	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	// Issue type
 	Type IssueType `protobuf:"varint,2,opt,name=type,proto3,enum=gastown.v1.IssueType" json:"type,omitempty"`
-	// Priority (0-4)
-	Priority int32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Priority (0-4). Unset uses bd's default.
+	Priority *int32 `protobuf:"varint,3,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
 	// Description
 	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	// Parent issue ID
@@ -869,8 +869,8 @@ func (x *CreateIssueRequest) GetType() IssueType {
 }
 
 func (x *CreateIssueRequest) GetPriority() int32 {
-	if x != nil {
-		return x.Priority
+	if x != nil && x.Priority != nil {
+		return *x.Priority
 	}
 	return 0
 }
@@ -987,7 +987,9 @@ type UpdateIssueRequest struct {
 	// Labels to remove
 	RemoveLabels []string `protobuf:"bytes,8,rep,name=remove_labels,json=removeLabels,proto3" json:"remove_labels,omitempty"`
 	// Labels to set (replaces all existing)
-	SetLabels     []string `protobuf:"bytes,9,rep,name=set_labels,json=setLabels,proto3" json:"set_labels,omitempty"`
+	SetLabels []string `protobuf:"bytes,9,rep,name=set_labels,json=setLabels,proto3" json:"set_labels,omitempty"`
+	// Actor (who is making the change)
+	Actor         string `protobuf:"bytes,10,opt,name=actor,proto3" json:"actor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1085,6 +1087,13 @@ func (x *UpdateIssueRequest) GetSetLabels() []string {
 	return nil
 }
 
+func (x *UpdateIssueRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
 type UpdateIssueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
@@ -1129,19 +1138,128 @@ func (x *UpdateIssueResponse) GetIssue() *Issue {
 	return nil
 }
 
+type CloseIssueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Issue ID (required)
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reason for closing
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Actor (who is closing it)
+	Actor         string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseIssueRequest) Reset() {
+	*x = CloseIssueRequest{}
+	mi := &file_gastown_v1_beads_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseIssueRequest) ProtoMessage() {}
+
+func (x *CloseIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_beads_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseIssueRequest.ProtoReflect.Descriptor instead.
+func (*CloseIssueRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CloseIssueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CloseIssueRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CloseIssueRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type CloseIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseIssueResponse) Reset() {
+	*x = CloseIssueResponse{}
+	mi := &file_gastown_v1_beads_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseIssueResponse) ProtoMessage() {}
+
+func (x *CloseIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_beads_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseIssueResponse.ProtoReflect.Descriptor instead.
+func (*CloseIssueResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CloseIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
 type CloseIssuesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Issue IDs to close
 	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 	// Reason for closing
-	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Actor (who is closing them)
+	Actor         string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CloseIssuesRequest) Reset() {
 	*x = CloseIssuesRequest{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[10]
+	mi := &file_gastown_v1_beads_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1153,7 +1271,7 @@ func (x *CloseIssuesRequest) String() string {
 func (*CloseIssuesRequest) ProtoMessage() {}
 
 func (x *CloseIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[10]
+	mi := &file_gastown_v1_beads_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,7 +1284,7 @@ func (x *CloseIssuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseIssuesRequest.ProtoReflect.Descriptor instead.
 func (*CloseIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{10}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CloseIssuesRequest) GetIds() []string {
@@ -1183,6 +1301,13 @@ func (x *CloseIssuesRequest) GetReason() string {
 	return ""
 }
 
+func (x *CloseIssuesRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
 type CloseIssuesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Number of issues closed
@@ -1195,7 +1320,7 @@ type CloseIssuesResponse struct {
 
 func (x *CloseIssuesResponse) Reset() {
 	*x = CloseIssuesResponse{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[11]
+	mi := &file_gastown_v1_beads_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1207,7 +1332,7 @@ func (x *CloseIssuesResponse) String() string {
 func (*CloseIssuesResponse) ProtoMessage() {}
 
 func (x *CloseIssuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[11]
+	mi := &file_gastown_v1_beads_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1220,7 +1345,7 @@ func (x *CloseIssuesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseIssuesResponse.ProtoReflect.Descriptor instead.
 func (*CloseIssuesResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{11}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CloseIssuesResponse) GetClosedCount() int32 {
@@ -1247,7 +1372,7 @@ type ReopenIssuesRequest struct {
 
 func (x *ReopenIssuesRequest) Reset() {
 	*x = ReopenIssuesRequest{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[12]
+	mi := &file_gastown_v1_beads_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1259,7 +1384,7 @@ func (x *ReopenIssuesRequest) String() string {
 func (*ReopenIssuesRequest) ProtoMessage() {}
 
 func (x *ReopenIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[12]
+	mi := &file_gastown_v1_beads_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1272,7 +1397,7 @@ func (x *ReopenIssuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReopenIssuesRequest.ProtoReflect.Descriptor instead.
 func (*ReopenIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{12}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReopenIssuesRequest) GetIds() []string {
@@ -1294,7 +1419,7 @@ type ReopenIssuesResponse struct {
 
 func (x *ReopenIssuesResponse) Reset() {
 	*x = ReopenIssuesResponse{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[13]
+	mi := &file_gastown_v1_beads_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1306,7 +1431,7 @@ func (x *ReopenIssuesResponse) String() string {
 func (*ReopenIssuesResponse) ProtoMessage() {}
 
 func (x *ReopenIssuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[13]
+	mi := &file_gastown_v1_beads_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,7 +1444,7 @@ func (x *ReopenIssuesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReopenIssuesResponse.ProtoReflect.Descriptor instead.
 func (*ReopenIssuesResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{13}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ReopenIssuesResponse) GetReopenedCount() int32 {
@@ -1338,7 +1463,9 @@ func (x *ReopenIssuesResponse) GetFailedIds() []string {
 
 type SearchIssuesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Search query
+	// Search query, e.g. `login "session expired" label:auth status:open`.
+	// Words match by prefix and must all appear; a repeated filter key
+	// matches any of its values, except label:, which requires them all.
 	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	// Filter by status
 	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1360,7 +1487,7 @@ type SearchIssuesRequest struct {
 
 func (x *SearchIssuesRequest) Reset() {
 	*x = SearchIssuesRequest{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[14]
+	mi := &file_gastown_v1_beads_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1372,7 +1499,7 @@ func (x *SearchIssuesRequest) String() string {
 func (*SearchIssuesRequest) ProtoMessage() {}
 
 func (x *SearchIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[14]
+	mi := &file_gastown_v1_beads_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,7 +1512,7 @@ func (x *SearchIssuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchIssuesRequest.ProtoReflect.Descriptor instead.
 func (*SearchIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{14}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SearchIssuesRequest) GetQuery() string {
@@ -1445,16 +1572,17 @@ func (x *SearchIssuesRequest) GetLimit() int32 {
 }
 
 type SearchIssuesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Issues []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	// Number of matches before limit was applied
+	Total         int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchIssuesResponse) Reset() {
 	*x = SearchIssuesResponse{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[15]
+	mi := &file_gastown_v1_beads_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1466,7 +1594,7 @@ func (x *SearchIssuesResponse) String() string {
 func (*SearchIssuesResponse) ProtoMessage() {}
 
 func (x *SearchIssuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[15]
+	mi := &file_gastown_v1_beads_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1479,7 +1607,7 @@ func (x *SearchIssuesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchIssuesResponse.ProtoReflect.Descriptor instead.
 func (*SearchIssuesResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{15}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SearchIssuesResponse) GetIssues() []*Issue {
@@ -1508,7 +1636,7 @@ type GetReadyIssuesRequest struct {
 
 func (x *GetReadyIssuesRequest) Reset() {
 	*x = GetReadyIssuesRequest{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[16]
+	mi := &file_gastown_v1_beads_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1520,7 +1648,7 @@ func (x *GetReadyIssuesRequest) String() string {
 func (*GetReadyIssuesRequest) ProtoMessage() {}
 
 func (x *GetReadyIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[16]
+	mi := &file_gastown_v1_beads_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,7 +1661,7 @@ func (x *GetReadyIssuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReadyIssuesRequest.ProtoReflect.Descriptor instead.
 func (*GetReadyIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{16}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetReadyIssuesRequest) GetLabel() string {
@@ -1560,7 +1688,7 @@ type GetReadyIssuesResponse struct {
 
 func (x *GetReadyIssuesResponse) Reset() {
 	*x = GetReadyIssuesResponse{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[17]
+	mi := &file_gastown_v1_beads_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1572,7 +1700,7 @@ func (x *GetReadyIssuesResponse) String() string {
 func (*GetReadyIssuesResponse) ProtoMessage() {}
 
 func (x *GetReadyIssuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[17]
+	mi := &file_gastown_v1_beads_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1585,7 +1713,7 @@ func (x *GetReadyIssuesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReadyIssuesResponse.ProtoReflect.Descriptor instead.
 func (*GetReadyIssuesResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{17}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetReadyIssuesResponse) GetIssues() []*Issue {
@@ -1612,7 +1740,7 @@ type GetBlockedIssuesRequest struct {
 
 func (x *GetBlockedIssuesRequest) Reset() {
 	*x = GetBlockedIssuesRequest{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[18]
+	mi := &file_gastown_v1_beads_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1624,7 +1752,7 @@ func (x *GetBlockedIssuesRequest) String() string {
 func (*GetBlockedIssuesRequest) ProtoMessage() {}
 
 func (x *GetBlockedIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[18]
+	mi := &file_gastown_v1_beads_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1637,7 +1765,7 @@ func (x *GetBlockedIssuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockedIssuesRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockedIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{18}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetBlockedIssuesRequest) GetLimit() int32 {
@@ -1657,7 +1785,7 @@ type GetBlockedIssuesResponse struct {
 
 func (x *GetBlockedIssuesResponse) Reset() {
 	*x = GetBlockedIssuesResponse{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[19]
+	mi := &file_gastown_v1_beads_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1669,7 +1797,7 @@ func (x *GetBlockedIssuesResponse) String() string {
 func (*GetBlockedIssuesResponse) ProtoMessage() {}
 
 func (x *GetBlockedIssuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[19]
+	mi := &file_gastown_v1_beads_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1682,7 +1810,7 @@ func (x *GetBlockedIssuesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockedIssuesResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockedIssuesResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{19}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetBlockedIssuesResponse) GetIssues() []*Issue {
@@ -1713,7 +1841,7 @@ type AddDependencyRequest struct {
 
 func (x *AddDependencyRequest) Reset() {
 	*x = AddDependencyRequest{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[20]
+	mi := &file_gastown_v1_beads_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1725,7 +1853,7 @@ func (x *AddDependencyRequest) String() string {
 func (*AddDependencyRequest) ProtoMessage() {}
 
 func (x *AddDependencyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[20]
+	mi := &file_gastown_v1_beads_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1738,7 +1866,7 @@ func (x *AddDependencyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDependencyRequest.ProtoReflect.Descriptor instead.
 func (*AddDependencyRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{20}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *AddDependencyRequest) GetIssueId() string {
@@ -1771,7 +1899,7 @@ type AddDependencyResponse struct {
 
 func (x *AddDependencyResponse) Reset() {
 	*x = AddDependencyResponse{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[21]
+	mi := &file_gastown_v1_beads_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1783,7 +1911,7 @@ func (x *AddDependencyResponse) String() string {
 func (*AddDependencyResponse) ProtoMessage() {}
 
 func (x *AddDependencyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[21]
+	mi := &file_gastown_v1_beads_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1796,7 +1924,7 @@ func (x *AddDependencyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDependencyResponse.ProtoReflect.Descriptor instead.
 func (*AddDependencyResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{21}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AddDependencyResponse) GetSuccess() bool {
@@ -1818,7 +1946,7 @@ type RemoveDependencyRequest struct {
 
 func (x *RemoveDependencyRequest) Reset() {
 	*x = RemoveDependencyRequest{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[22]
+	mi := &file_gastown_v1_beads_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1830,7 +1958,7 @@ func (x *RemoveDependencyRequest) String() string {
 func (*RemoveDependencyRequest) ProtoMessage() {}
 
 func (x *RemoveDependencyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[22]
+	mi := &file_gastown_v1_beads_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1843,7 +1971,7 @@ func (x *RemoveDependencyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveDependencyRequest.ProtoReflect.Descriptor instead.
 func (*RemoveDependencyRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{22}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RemoveDependencyRequest) GetIssueId() string {
@@ -1869,7 +1997,7 @@ type RemoveDependencyResponse struct {
 
 func (x *RemoveDependencyResponse) Reset() {
 	*x = RemoveDependencyResponse{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[23]
+	mi := &file_gastown_v1_beads_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1881,7 +2009,7 @@ func (x *RemoveDependencyResponse) String() string {
 func (*RemoveDependencyResponse) ProtoMessage() {}
 
 func (x *RemoveDependencyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[23]
+	mi := &file_gastown_v1_beads_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1894,7 +2022,7 @@ func (x *RemoveDependencyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveDependencyResponse.ProtoReflect.Descriptor instead.
 func (*RemoveDependencyResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{23}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RemoveDependencyResponse) GetSuccess() bool {
@@ -1918,7 +2046,7 @@ type ListDependenciesRequest struct {
 
 func (x *ListDependenciesRequest) Reset() {
 	*x = ListDependenciesRequest{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[24]
+	mi := &file_gastown_v1_beads_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1930,7 +2058,7 @@ func (x *ListDependenciesRequest) String() string {
 func (*ListDependenciesRequest) ProtoMessage() {}
 
 func (x *ListDependenciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[24]
+	mi := &file_gastown_v1_beads_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1943,7 +2071,7 @@ func (x *ListDependenciesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDependenciesRequest.ProtoReflect.Descriptor instead.
 func (*ListDependenciesRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{24}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListDependenciesRequest) GetIssueId() string {
@@ -1976,7 +2104,7 @@ type ListDependenciesResponse struct {
 
 func (x *ListDependenciesResponse) Reset() {
 	*x = ListDependenciesResponse{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[25]
+	mi := &file_gastown_v1_beads_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1988,7 +2116,7 @@ func (x *ListDependenciesResponse) String() string {
 func (*ListDependenciesResponse) ProtoMessage() {}
 
 func (x *ListDependenciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[25]
+	mi := &file_gastown_v1_beads_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2001,7 +2129,7 @@ func (x *ListDependenciesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDependenciesResponse.ProtoReflect.Descriptor instead.
 func (*ListDependenciesResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{25}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListDependenciesResponse) GetDependencies() []*Issue {
@@ -2029,7 +2157,7 @@ type Comment struct {
 
 func (x *Comment) Reset() {
 	*x = Comment{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[26]
+	mi := &file_gastown_v1_beads_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2041,7 +2169,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[26]
+	mi := &file_gastown_v1_beads_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2054,7 +2182,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{26}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Comment) GetId() string {
@@ -2106,7 +2234,7 @@ type AddCommentRequest struct {
 
 func (x *AddCommentRequest) Reset() {
 	*x = AddCommentRequest{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[27]
+	mi := &file_gastown_v1_beads_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2118,7 +2246,7 @@ func (x *AddCommentRequest) String() string {
 func (*AddCommentRequest) ProtoMessage() {}
 
 func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[27]
+	mi := &file_gastown_v1_beads_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2131,7 +2259,7 @@ func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
 func (*AddCommentRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{27}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AddCommentRequest) GetIssueId() string {
@@ -2164,7 +2292,7 @@ type AddCommentResponse struct {
 
 func (x *AddCommentResponse) Reset() {
 	*x = AddCommentResponse{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[28]
+	mi := &file_gastown_v1_beads_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2176,7 +2304,7 @@ func (x *AddCommentResponse) String() string {
 func (*AddCommentResponse) ProtoMessage() {}
 
 func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[28]
+	mi := &file_gastown_v1_beads_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2189,7 +2317,7 @@ func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCommentResponse.ProtoReflect.Descriptor instead.
 func (*AddCommentResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{28}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AddCommentResponse) GetComment() *Comment {
@@ -2211,7 +2339,7 @@ type ListCommentsRequest struct {
 
 func (x *ListCommentsRequest) Reset() {
 	*x = ListCommentsRequest{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[29]
+	mi := &file_gastown_v1_beads_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2223,7 +2351,7 @@ func (x *ListCommentsRequest) String() string {
 func (*ListCommentsRequest) ProtoMessage() {}
 
 func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[29]
+	mi := &file_gastown_v1_beads_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2236,7 +2364,7 @@ func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
 func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{29}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ListCommentsRequest) GetIssueId() string {
@@ -2262,7 +2390,7 @@ type ListCommentsResponse struct {
 
 func (x *ListCommentsResponse) Reset() {
 	*x = ListCommentsResponse{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[30]
+	mi := &file_gastown_v1_beads_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2274,7 +2402,7 @@ func (x *ListCommentsResponse) String() string {
 func (*ListCommentsResponse) ProtoMessage() {}
 
 func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[30]
+	mi := &file_gastown_v1_beads_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2287,7 +2415,7 @@ func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
 func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{30}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ListCommentsResponse) GetComments() []*Comment {
@@ -2311,7 +2439,7 @@ type ManageLabelsRequest struct {
 
 func (x *ManageLabelsRequest) Reset() {
 	*x = ManageLabelsRequest{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[31]
+	mi := &file_gastown_v1_beads_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2323,7 +2451,7 @@ func (x *ManageLabelsRequest) String() string {
 func (*ManageLabelsRequest) ProtoMessage() {}
 
 func (x *ManageLabelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[31]
+	mi := &file_gastown_v1_beads_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2336,7 +2464,7 @@ func (x *ManageLabelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManageLabelsRequest.ProtoReflect.Descriptor instead.
 func (*ManageLabelsRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{31}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ManageLabelsRequest) GetIssueId() string {
@@ -2370,7 +2498,7 @@ type ManageLabelsResponse struct {
 
 func (x *ManageLabelsResponse) Reset() {
 	*x = ManageLabelsResponse{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[32]
+	mi := &file_gastown_v1_beads_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2382,7 +2510,7 @@ func (x *ManageLabelsResponse) String() string {
 func (*ManageLabelsResponse) ProtoMessage() {}
 
 func (x *ManageLabelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[32]
+	mi := &file_gastown_v1_beads_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2395,7 +2523,7 @@ func (x *ManageLabelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManageLabelsResponse.ProtoReflect.Descriptor instead.
 func (*ManageLabelsResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{32}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ManageLabelsResponse) GetLabels() []string {
@@ -2413,7 +2541,7 @@ type GetStatsRequest struct {
 
 func (x *GetStatsRequest) Reset() {
 	*x = GetStatsRequest{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[33]
+	mi := &file_gastown_v1_beads_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2425,7 +2553,7 @@ func (x *GetStatsRequest) String() string {
 func (*GetStatsRequest) ProtoMessage() {}
 
 func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[33]
+	mi := &file_gastown_v1_beads_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2438,7 +2566,7 @@ func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{33}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{35}
 }
 
 type GetStatsResponse struct {
@@ -2461,7 +2589,7 @@ type GetStatsResponse struct {
 
 func (x *GetStatsResponse) Reset() {
 	*x = GetStatsResponse{}
-	mi := &file_gastown_v1_beads_proto_msgTypes[34]
+	mi := &file_gastown_v1_beads_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2473,7 +2601,7 @@ func (x *GetStatsResponse) String() string {
 func (*GetStatsResponse) ProtoMessage() {}
 
 func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_beads_proto_msgTypes[34]
+	mi := &file_gastown_v1_beads_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2486,7 +2614,7 @@ func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{34}
+	return file_gastown_v1_beads_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetStatsResponse) GetTotalIssues() int32 {
@@ -2592,11 +2720,11 @@ const file_gastown_v1_beads_proto_rawDesc = "" +
 	"\x0fGetIssueRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
 	"\x10GetIssueResponse\x12'\n" +
-	"\x05issue\x18\x01 \x01(\v2\x11.gastown.v1.IssueR\x05issue\"\xa3\x02\n" +
+	"\x05issue\x18\x01 \x01(\v2\x11.gastown.v1.IssueR\x05issue\"\xb5\x02\n" +
 	"\x12CreateIssueRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12)\n" +
-	"\x04type\x18\x02 \x01(\x0e2\x15.gastown.v1.IssueTypeR\x04type\x12\x1a\n" +
-	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12 \n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.gastown.v1.IssueTypeR\x04type\x12\x1f\n" +
+	"\bpriority\x18\x03 \x01(\x05H\x00R\bpriority\x88\x01\x01\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
 	"\x06parent\x18\x05 \x01(\tR\x06parent\x12\x1a\n" +
 	"\bassignee\x18\x06 \x01(\tR\bassignee\x12\x16\n" +
@@ -2604,9 +2732,10 @@ const file_gastown_v1_beads_proto_rawDesc = "" +
 	"\x05actor\x18\b \x01(\tR\x05actor\x12\x0e\n" +
 	"\x02id\x18\t \x01(\tR\x02id\x12\x1c\n" +
 	"\tephemeral\x18\n" +
-	" \x01(\bR\tephemeral\">\n" +
+	" \x01(\bR\tephemeralB\v\n" +
+	"\t_priority\">\n" +
 	"\x13CreateIssueResponse\x12'\n" +
-	"\x05issue\x18\x01 \x01(\v2\x11.gastown.v1.IssueR\x05issue\"\xe7\x02\n" +
+	"\x05issue\x18\x01 \x01(\v2\x11.gastown.v1.IssueR\x05issue\"\xfd\x02\n" +
 	"\x12UpdateIssueRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1b\n" +
@@ -2618,17 +2747,26 @@ const file_gastown_v1_beads_proto_rawDesc = "" +
 	"add_labels\x18\a \x03(\tR\taddLabels\x12#\n" +
 	"\rremove_labels\x18\b \x03(\tR\fremoveLabels\x12\x1d\n" +
 	"\n" +
-	"set_labels\x18\t \x03(\tR\tsetLabelsB\b\n" +
+	"set_labels\x18\t \x03(\tR\tsetLabels\x12\x14\n" +
+	"\x05actor\x18\n" +
+	" \x01(\tR\x05actorB\b\n" +
 	"\x06_titleB\t\n" +
 	"\a_statusB\v\n" +
 	"\t_priorityB\x0e\n" +
 	"\f_descriptionB\v\n" +
 	"\t_assignee\">\n" +
 	"\x13UpdateIssueResponse\x12'\n" +
-	"\x05issue\x18\x01 \x01(\v2\x11.gastown.v1.IssueR\x05issue\">\n" +
+	"\x05issue\x18\x01 \x01(\v2\x11.gastown.v1.IssueR\x05issue\"Q\n" +
+	"\x11CloseIssueRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\"=\n" +
+	"\x12CloseIssueResponse\x12'\n" +
+	"\x05issue\x18\x01 \x01(\v2\x11.gastown.v1.IssueR\x05issue\"T\n" +
 	"\x12CloseIssuesRequest\x12\x10\n" +
 	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"W\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\"W\n" +
 	"\x13CloseIssuesResponse\x12!\n" +
 	"\fclosed_count\x18\x01 \x01(\x05R\vclosedCount\x12\x1d\n" +
 	"\n" +
@@ -2737,14 +2875,15 @@ const file_gastown_v1_beads_proto_rawDesc = "" +
 	"\x1bDEPENDENCY_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aDEPENDENCY_TYPE_DEPENDS_ON\x10\x01\x12\x1a\n" +
 	"\x16DEPENDENCY_TYPE_BLOCKS\x10\x02\x12\x1a\n" +
-	"\x16DEPENDENCY_TYPE_TRACKS\x10\x032\xbe\n" +
-	"\n" +
+	"\x16DEPENDENCY_TYPE_TRACKS\x10\x032\x8b\v\n" +
 	"\fBeadsService\x12K\n" +
 	"\n" +
 	"ListIssues\x12\x1d.gastown.v1.ListIssuesRequest\x1a\x1e.gastown.v1.ListIssuesResponse\x12E\n" +
 	"\bGetIssue\x12\x1b.gastown.v1.GetIssueRequest\x1a\x1c.gastown.v1.GetIssueResponse\x12N\n" +
 	"\vCreateIssue\x12\x1e.gastown.v1.CreateIssueRequest\x1a\x1f.gastown.v1.CreateIssueResponse\x12N\n" +
-	"\vUpdateIssue\x12\x1e.gastown.v1.UpdateIssueRequest\x1a\x1f.gastown.v1.UpdateIssueResponse\x12N\n" +
+	"\vUpdateIssue\x12\x1e.gastown.v1.UpdateIssueRequest\x1a\x1f.gastown.v1.UpdateIssueResponse\x12K\n" +
+	"\n" +
+	"CloseIssue\x12\x1d.gastown.v1.CloseIssueRequest\x1a\x1e.gastown.v1.CloseIssueResponse\x12N\n" +
 	"\vCloseIssues\x12\x1e.gastown.v1.CloseIssuesRequest\x1a\x1f.gastown.v1.CloseIssuesResponse\x12Q\n" +
 	"\fReopenIssues\x12\x1f.gastown.v1.ReopenIssuesRequest\x1a .gastown.v1.ReopenIssuesResponse\x12Q\n" +
 	"\fSearchIssues\x12\x1f.gastown.v1.SearchIssuesRequest\x1a .gastown.v1.SearchIssuesResponse\x12W\n" +
@@ -2776,7 +2915,7 @@ func file_gastown_v1_beads_proto_rawDescGZIP() []byte {
 }
 
 var file_gastown_v1_beads_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_gastown_v1_beads_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_gastown_v1_beads_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_gastown_v1_beads_proto_goTypes = []any{
 	(IssueStatus)(0),                 // 0: gastown.v1.IssueStatus
 	(IssueType)(0),                   // 1: gastown.v1.IssueType
@@ -2791,39 +2930,41 @@ var file_gastown_v1_beads_proto_goTypes = []any{
 	(*CreateIssueResponse)(nil),      // 10: gastown.v1.CreateIssueResponse
 	(*UpdateIssueRequest)(nil),       // 11: gastown.v1.UpdateIssueRequest
 	(*UpdateIssueResponse)(nil),      // 12: gastown.v1.UpdateIssueResponse
-	(*CloseIssuesRequest)(nil),       // 13: gastown.v1.CloseIssuesRequest
-	(*CloseIssuesResponse)(nil),      // 14: gastown.v1.CloseIssuesResponse
-	(*ReopenIssuesRequest)(nil),      // 15: gastown.v1.ReopenIssuesRequest
-	(*ReopenIssuesResponse)(nil),     // 16: gastown.v1.ReopenIssuesResponse
-	(*SearchIssuesRequest)(nil),      // 17: gastown.v1.SearchIssuesRequest
-	(*SearchIssuesResponse)(nil),     // 18: gastown.v1.SearchIssuesResponse
-	(*GetReadyIssuesRequest)(nil),    // 19: gastown.v1.GetReadyIssuesRequest
-	(*GetReadyIssuesResponse)(nil),   // 20: gastown.v1.GetReadyIssuesResponse
-	(*GetBlockedIssuesRequest)(nil),  // 21: gastown.v1.GetBlockedIssuesRequest
-	(*GetBlockedIssuesResponse)(nil), // 22: gastown.v1.GetBlockedIssuesResponse
-	(*AddDependencyRequest)(nil),     // 23: gastown.v1.AddDependencyRequest
-	(*AddDependencyResponse)(nil),    // 24: gastown.v1.AddDependencyResponse
-	(*RemoveDependencyRequest)(nil),  // 25: gastown.v1.RemoveDependencyRequest
-	(*RemoveDependencyResponse)(nil), // 26: gastown.v1.RemoveDependencyResponse
-	(*ListDependenciesRequest)(nil),  // 27: gastown.v1.ListDependenciesRequest
-	(*ListDependenciesResponse)(nil), // 28: gastown.v1.ListDependenciesResponse
-	(*Comment)(nil),                  // 29: gastown.v1.Comment
-	(*AddCommentRequest)(nil),        // 30: gastown.v1.AddCommentRequest
-	(*AddCommentResponse)(nil),       // 31: gastown.v1.AddCommentResponse
-	(*ListCommentsRequest)(nil),      // 32: gastown.v1.ListCommentsRequest
-	(*ListCommentsResponse)(nil),     // 33: gastown.v1.ListCommentsResponse
-	(*ManageLabelsRequest)(nil),      // 34: gastown.v1.ManageLabelsRequest
-	(*ManageLabelsResponse)(nil),     // 35: gastown.v1.ManageLabelsResponse
-	(*GetStatsRequest)(nil),          // 36: gastown.v1.GetStatsRequest
-	(*GetStatsResponse)(nil),         // 37: gastown.v1.GetStatsResponse
-	(*timestamppb.Timestamp)(nil),    // 38: google.protobuf.Timestamp
+	(*CloseIssueRequest)(nil),        // 13: gastown.v1.CloseIssueRequest
+	(*CloseIssueResponse)(nil),       // 14: gastown.v1.CloseIssueResponse
+	(*CloseIssuesRequest)(nil),       // 15: gastown.v1.CloseIssuesRequest
+	(*CloseIssuesResponse)(nil),      // 16: gastown.v1.CloseIssuesResponse
+	(*ReopenIssuesRequest)(nil),      // 17: gastown.v1.ReopenIssuesRequest
+	(*ReopenIssuesResponse)(nil),     // 18: gastown.v1.ReopenIssuesResponse
+	(*SearchIssuesRequest)(nil),      // 19: gastown.v1.SearchIssuesRequest
+	(*SearchIssuesResponse)(nil),     // 20: gastown.v1.SearchIssuesResponse
+	(*GetReadyIssuesRequest)(nil),    // 21: gastown.v1.GetReadyIssuesRequest
+	(*GetReadyIssuesResponse)(nil),   // 22: gastown.v1.GetReadyIssuesResponse
+	(*GetBlockedIssuesRequest)(nil),  // 23: gastown.v1.GetBlockedIssuesRequest
+	(*GetBlockedIssuesResponse)(nil), // 24: gastown.v1.GetBlockedIssuesResponse
+	(*AddDependencyRequest)(nil),     // 25: gastown.v1.AddDependencyRequest
+	(*AddDependencyResponse)(nil),    // 26: gastown.v1.AddDependencyResponse
+	(*RemoveDependencyRequest)(nil),  // 27: gastown.v1.RemoveDependencyRequest
+	(*RemoveDependencyResponse)(nil), // 28: gastown.v1.RemoveDependencyResponse
+	(*ListDependenciesRequest)(nil),  // 29: gastown.v1.ListDependenciesRequest
+	(*ListDependenciesResponse)(nil), // 30: gastown.v1.ListDependenciesResponse
+	(*Comment)(nil),                  // 31: gastown.v1.Comment
+	(*AddCommentRequest)(nil),        // 32: gastown.v1.AddCommentRequest
+	(*AddCommentResponse)(nil),       // 33: gastown.v1.AddCommentResponse
+	(*ListCommentsRequest)(nil),      // 34: gastown.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),     // 35: gastown.v1.ListCommentsResponse
+	(*ManageLabelsRequest)(nil),      // 36: gastown.v1.ManageLabelsRequest
+	(*ManageLabelsResponse)(nil),     // 37: gastown.v1.ManageLabelsResponse
+	(*GetStatsRequest)(nil),          // 38: gastown.v1.GetStatsRequest
+	(*GetStatsResponse)(nil),         // 39: gastown.v1.GetStatsResponse
+	(*timestamppb.Timestamp)(nil),    // 40: google.protobuf.Timestamp
 }
 var file_gastown_v1_beads_proto_depIdxs = []int32{
 	0,  // 0: gastown.v1.Issue.status:type_name -> gastown.v1.IssueStatus
 	1,  // 1: gastown.v1.Issue.type:type_name -> gastown.v1.IssueType
-	38, // 2: gastown.v1.Issue.created_at:type_name -> google.protobuf.Timestamp
-	38, // 3: gastown.v1.Issue.updated_at:type_name -> google.protobuf.Timestamp
-	38, // 4: gastown.v1.Issue.closed_at:type_name -> google.protobuf.Timestamp
+	40, // 2: gastown.v1.Issue.created_at:type_name -> google.protobuf.Timestamp
+	40, // 3: gastown.v1.Issue.updated_at:type_name -> google.protobuf.Timestamp
+	40, // 4: gastown.v1.Issue.closed_at:type_name -> google.protobuf.Timestamp
 	0,  // 5: gastown.v1.IssueSummary.status:type_name -> gastown.v1.IssueStatus
 	1,  // 6: gastown.v1.IssueSummary.type:type_name -> gastown.v1.IssueType
 	1,  // 7: gastown.v1.ListIssuesRequest.type:type_name -> gastown.v1.IssueType
@@ -2832,53 +2973,56 @@ var file_gastown_v1_beads_proto_depIdxs = []int32{
 	1,  // 10: gastown.v1.CreateIssueRequest.type:type_name -> gastown.v1.IssueType
 	3,  // 11: gastown.v1.CreateIssueResponse.issue:type_name -> gastown.v1.Issue
 	3,  // 12: gastown.v1.UpdateIssueResponse.issue:type_name -> gastown.v1.Issue
-	1,  // 13: gastown.v1.SearchIssuesRequest.type:type_name -> gastown.v1.IssueType
-	3,  // 14: gastown.v1.SearchIssuesResponse.issues:type_name -> gastown.v1.Issue
-	3,  // 15: gastown.v1.GetReadyIssuesResponse.issues:type_name -> gastown.v1.Issue
-	3,  // 16: gastown.v1.GetBlockedIssuesResponse.issues:type_name -> gastown.v1.Issue
-	2,  // 17: gastown.v1.AddDependencyRequest.type:type_name -> gastown.v1.DependencyType
-	2,  // 18: gastown.v1.ListDependenciesRequest.type:type_name -> gastown.v1.DependencyType
-	3,  // 19: gastown.v1.ListDependenciesResponse.dependencies:type_name -> gastown.v1.Issue
-	38, // 20: gastown.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
-	29, // 21: gastown.v1.AddCommentResponse.comment:type_name -> gastown.v1.Comment
-	29, // 22: gastown.v1.ListCommentsResponse.comments:type_name -> gastown.v1.Comment
-	5,  // 23: gastown.v1.BeadsService.ListIssues:input_type -> gastown.v1.ListIssuesRequest
-	7,  // 24: gastown.v1.BeadsService.GetIssue:input_type -> gastown.v1.GetIssueRequest
-	9,  // 25: gastown.v1.BeadsService.CreateIssue:input_type -> gastown.v1.CreateIssueRequest
-	11, // 26: gastown.v1.BeadsService.UpdateIssue:input_type -> gastown.v1.UpdateIssueRequest
-	13, // 27: gastown.v1.BeadsService.CloseIssues:input_type -> gastown.v1.CloseIssuesRequest
-	15, // 28: gastown.v1.BeadsService.ReopenIssues:input_type -> gastown.v1.ReopenIssuesRequest
-	17, // 29: gastown.v1.BeadsService.SearchIssues:input_type -> gastown.v1.SearchIssuesRequest
-	19, // 30: gastown.v1.BeadsService.GetReadyIssues:input_type -> gastown.v1.GetReadyIssuesRequest
-	21, // 31: gastown.v1.BeadsService.GetBlockedIssues:input_type -> gastown.v1.GetBlockedIssuesRequest
-	23, // 32: gastown.v1.BeadsService.AddDependency:input_type -> gastown.v1.AddDependencyRequest
-	25, // 33: gastown.v1.BeadsService.RemoveDependency:input_type -> gastown.v1.RemoveDependencyRequest
-	27, // 34: gastown.v1.BeadsService.ListDependencies:input_type -> gastown.v1.ListDependenciesRequest
-	30, // 35: gastown.v1.BeadsService.AddComment:input_type -> gastown.v1.AddCommentRequest
-	32, // 36: gastown.v1.BeadsService.ListComments:input_type -> gastown.v1.ListCommentsRequest
-	34, // 37: gastown.v1.BeadsService.ManageLabels:input_type -> gastown.v1.ManageLabelsRequest
-	36, // 38: gastown.v1.BeadsService.GetStats:input_type -> gastown.v1.GetStatsRequest
-	6,  // 39: gastown.v1.BeadsService.ListIssues:output_type -> gastown.v1.ListIssuesResponse
-	8,  // 40: gastown.v1.BeadsService.GetIssue:output_type -> gastown.v1.GetIssueResponse
-	10, // 41: gastown.v1.BeadsService.CreateIssue:output_type -> gastown.v1.CreateIssueResponse
-	12, // 42: gastown.v1.BeadsService.UpdateIssue:output_type -> gastown.v1.UpdateIssueResponse
-	14, // 43: gastown.v1.BeadsService.CloseIssues:output_type -> gastown.v1.CloseIssuesResponse
-	16, // 44: gastown.v1.BeadsService.ReopenIssues:output_type -> gastown.v1.ReopenIssuesResponse
-	18, // 45: gastown.v1.BeadsService.SearchIssues:output_type -> gastown.v1.SearchIssuesResponse
-	20, // 46: gastown.v1.BeadsService.GetReadyIssues:output_type -> gastown.v1.GetReadyIssuesResponse
-	22, // 47: gastown.v1.BeadsService.GetBlockedIssues:output_type -> gastown.v1.GetBlockedIssuesResponse
-	24, // 48: gastown.v1.BeadsService.AddDependency:output_type -> gastown.v1.AddDependencyResponse
-	26, // 49: gastown.v1.BeadsService.RemoveDependency:output_type -> gastown.v1.RemoveDependencyResponse
-	28, // 50: gastown.v1.BeadsService.ListDependencies:output_type -> gastown.v1.ListDependenciesResponse
-	31, // 51: gastown.v1.BeadsService.AddComment:output_type -> gastown.v1.AddCommentResponse
-	33, // 52: gastown.v1.BeadsService.ListComments:output_type -> gastown.v1.ListCommentsResponse
-	35, // 53: gastown.v1.BeadsService.ManageLabels:output_type -> gastown.v1.ManageLabelsResponse
-	37, // 54: gastown.v1.BeadsService.GetStats:output_type -> gastown.v1.GetStatsResponse
-	39, // [39:55] is the sub-list for method output_type
-	23, // [23:39] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	3,  // 13: gastown.v1.CloseIssueResponse.issue:type_name -> gastown.v1.Issue
+	1,  // 14: gastown.v1.SearchIssuesRequest.type:type_name -> gastown.v1.IssueType
+	3,  // 15: gastown.v1.SearchIssuesResponse.issues:type_name -> gastown.v1.Issue
+	3,  // 16: gastown.v1.GetReadyIssuesResponse.issues:type_name -> gastown.v1.Issue
+	3,  // 17: gastown.v1.GetBlockedIssuesResponse.issues:type_name -> gastown.v1.Issue
+	2,  // 18: gastown.v1.AddDependencyRequest.type:type_name -> gastown.v1.DependencyType
+	2,  // 19: gastown.v1.ListDependenciesRequest.type:type_name -> gastown.v1.DependencyType
+	3,  // 20: gastown.v1.ListDependenciesResponse.dependencies:type_name -> gastown.v1.Issue
+	40, // 21: gastown.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	31, // 22: gastown.v1.AddCommentResponse.comment:type_name -> gastown.v1.Comment
+	31, // 23: gastown.v1.ListCommentsResponse.comments:type_name -> gastown.v1.Comment
+	5,  // 24: gastown.v1.BeadsService.ListIssues:input_type -> gastown.v1.ListIssuesRequest
+	7,  // 25: gastown.v1.BeadsService.GetIssue:input_type -> gastown.v1.GetIssueRequest
+	9,  // 26: gastown.v1.BeadsService.CreateIssue:input_type -> gastown.v1.CreateIssueRequest
+	11, // 27: gastown.v1.BeadsService.UpdateIssue:input_type -> gastown.v1.UpdateIssueRequest
+	13, // 28: gastown.v1.BeadsService.CloseIssue:input_type -> gastown.v1.CloseIssueRequest
+	15, // 29: gastown.v1.BeadsService.CloseIssues:input_type -> gastown.v1.CloseIssuesRequest
+	17, // 30: gastown.v1.BeadsService.ReopenIssues:input_type -> gastown.v1.ReopenIssuesRequest
+	19, // 31: gastown.v1.BeadsService.SearchIssues:input_type -> gastown.v1.SearchIssuesRequest
+	21, // 32: gastown.v1.BeadsService.GetReadyIssues:input_type -> gastown.v1.GetReadyIssuesRequest
+	23, // 33: gastown.v1.BeadsService.GetBlockedIssues:input_type -> gastown.v1.GetBlockedIssuesRequest
+	25, // 34: gastown.v1.BeadsService.AddDependency:input_type -> gastown.v1.AddDependencyRequest
+	27, // 35: gastown.v1.BeadsService.RemoveDependency:input_type -> gastown.v1.RemoveDependencyRequest
+	29, // 36: gastown.v1.BeadsService.ListDependencies:input_type -> gastown.v1.ListDependenciesRequest
+	32, // 37: gastown.v1.BeadsService.AddComment:input_type -> gastown.v1.AddCommentRequest
+	34, // 38: gastown.v1.BeadsService.ListComments:input_type -> gastown.v1.ListCommentsRequest
+	36, // 39: gastown.v1.BeadsService.ManageLabels:input_type -> gastown.v1.ManageLabelsRequest
+	38, // 40: gastown.v1.BeadsService.GetStats:input_type -> gastown.v1.GetStatsRequest
+	6,  // 41: gastown.v1.BeadsService.ListIssues:output_type -> gastown.v1.ListIssuesResponse
+	8,  // 42: gastown.v1.BeadsService.GetIssue:output_type -> gastown.v1.GetIssueResponse
+	10, // 43: gastown.v1.BeadsService.CreateIssue:output_type -> gastown.v1.CreateIssueResponse
+	12, // 44: gastown.v1.BeadsService.UpdateIssue:output_type -> gastown.v1.UpdateIssueResponse
+	14, // 45: gastown.v1.BeadsService.CloseIssue:output_type -> gastown.v1.CloseIssueResponse
+	16, // 46: gastown.v1.BeadsService.CloseIssues:output_type -> gastown.v1.CloseIssuesResponse
+	18, // 47: gastown.v1.BeadsService.ReopenIssues:output_type -> gastown.v1.ReopenIssuesResponse
+	20, // 48: gastown.v1.BeadsService.SearchIssues:output_type -> gastown.v1.SearchIssuesResponse
+	22, // 49: gastown.v1.BeadsService.GetReadyIssues:output_type -> gastown.v1.GetReadyIssuesResponse
+	24, // 50: gastown.v1.BeadsService.GetBlockedIssues:output_type -> gastown.v1.GetBlockedIssuesResponse
+	26, // 51: gastown.v1.BeadsService.AddDependency:output_type -> gastown.v1.AddDependencyResponse
+	28, // 52: gastown.v1.BeadsService.RemoveDependency:output_type -> gastown.v1.RemoveDependencyResponse
+	30, // 53: gastown.v1.BeadsService.ListDependencies:output_type -> gastown.v1.ListDependenciesResponse
+	33, // 54: gastown.v1.BeadsService.AddComment:output_type -> gastown.v1.AddCommentResponse
+	35, // 55: gastown.v1.BeadsService.ListComments:output_type -> gastown.v1.ListCommentsResponse
+	37, // 56: gastown.v1.BeadsService.ManageLabels:output_type -> gastown.v1.ManageLabelsResponse
+	39, // 57: gastown.v1.BeadsService.GetStats:output_type -> gastown.v1.GetStatsResponse
+	41, // [41:58] is the sub-list for method output_type
+	24, // [24:41] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_gastown_v1_beads_proto_init() }
@@ -2887,6 +3031,7 @@ func file_gastown_v1_beads_proto_init() {
 		return
 	}
 	file_gastown_v1_common_proto_init()
+	file_gastown_v1_beads_proto_msgTypes[6].OneofWrappers = []any{}
 	file_gastown_v1_beads_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2894,7 +3039,7 @@ func file_gastown_v1_beads_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastown_v1_beads_proto_rawDesc), len(file_gastown_v1_beads_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   35,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,1045 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: gastown/v1/dog.proto
+
+package gastownv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Dog operational state.
+type DogState int32
+
+const (
+	DogState_DOG_STATE_UNSPECIFIED DogState = 0
+	DogState_DOG_STATE_IDLE        DogState = 1
+	DogState_DOG_STATE_WORKING     DogState = 2
+)
+
+// Enum value maps for DogState.
+var (
+	DogState_name = map[int32]string{
+		0: "DOG_STATE_UNSPECIFIED",
+		1: "DOG_STATE_IDLE",
+		2: "DOG_STATE_WORKING",
+	}
+	DogState_value = map[string]int32{
+		"DOG_STATE_UNSPECIFIED": 0,
+		"DOG_STATE_IDLE":        1,
+		"DOG_STATE_WORKING":     2,
+	}
+)
+
+func (x DogState) Enum() *DogState {
+	p := new(DogState)
+	*p = x
+	return p
+}
+
+func (x DogState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DogState) Descriptor() protoreflect.EnumDescriptor {
+	return file_gastown_v1_dog_proto_enumTypes[0].Descriptor()
+}
+
+func (DogState) Type() protoreflect.EnumType {
+	return &file_gastown_v1_dog_proto_enumTypes[0]
+}
+
+func (x DogState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DogState.Descriptor instead.
+func (DogState) EnumDescriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{0}
+}
+
+type ListDogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         DogState               `protobuf:"varint,1,opt,name=state,proto3,enum=gastown.v1.DogState" json:"state,omitempty"` // Only dogs in this state (unspecified = all)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDogsRequest) Reset() {
+	*x = ListDogsRequest{}
+	mi := &file_gastown_v1_dog_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDogsRequest) ProtoMessage() {}
+
+func (x *ListDogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_dog_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDogsRequest.ProtoReflect.Descriptor instead.
+func (*ListDogsRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListDogsRequest) GetState() DogState {
+	if x != nil {
+		return x.State
+	}
+	return DogState_DOG_STATE_UNSPECIFIED
+}
+
+type ListDogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dogs          []*Dog                 `protobuf:"bytes,1,rep,name=dogs,proto3" json:"dogs,omitempty"`
+	Idle          int32                  `protobuf:"varint,2,opt,name=idle,proto3" json:"idle,omitempty"`
+	Working       int32                  `protobuf:"varint,3,opt,name=working,proto3" json:"working,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDogsResponse) Reset() {
+	*x = ListDogsResponse{}
+	mi := &file_gastown_v1_dog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDogsResponse) ProtoMessage() {}
+
+func (x *ListDogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_dog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDogsResponse.ProtoReflect.Descriptor instead.
+func (*ListDogsResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDogsResponse) GetDogs() []*Dog {
+	if x != nil {
+		return x.Dogs
+	}
+	return nil
+}
+
+func (x *ListDogsResponse) GetIdle() int32 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *ListDogsResponse) GetWorking() int32 {
+	if x != nil {
+		return x.Working
+	}
+	return 0
+}
+
+type GetDogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDogRequest) Reset() {
+	*x = GetDogRequest{}
+	mi := &file_gastown_v1_dog_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDogRequest) ProtoMessage() {}
+
+func (x *GetDogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_dog_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDogRequest.ProtoReflect.Descriptor instead.
+func (*GetDogRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDogRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetDogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dog           *Dog                   `protobuf:"bytes,1,opt,name=dog,proto3" json:"dog,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDogResponse) Reset() {
+	*x = GetDogResponse{}
+	mi := &file_gastown_v1_dog_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDogResponse) ProtoMessage() {}
+
+func (x *GetDogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_dog_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDogResponse.ProtoReflect.Descriptor instead.
+func (*GetDogResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDogResponse) GetDog() *Dog {
+	if x != nil {
+		return x.Dog
+	}
+	return nil
+}
+
+type AssignDogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                        // Dog to assign; empty picks an idle dog
+	Work          string                 `protobuf:"bytes,2,opt,name=work,proto3" json:"work,omitempty"`                                        // Required: bead ID or formula
+	Create        bool                   `protobuf:"varint,3,opt,name=create,proto3" json:"create,omitempty"`                                   // Add the dog (or a new one if none is idle)
+	Force         bool                   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`                                     // Reassign a dog already working on something else
+	SkipSession   bool                   `protobuf:"varint,5,opt,name=skip_session,json=skipSession,proto3" json:"skip_session,omitempty"`      // Record the assignment without starting the session
+	AgentOverride string                 `protobuf:"bytes,6,opt,name=agent_override,json=agentOverride,proto3" json:"agent_override,omitempty"` // Alternate agent for a new session
+	RequestedBy   string                 `protobuf:"bytes,7,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`       // Recorded in the event log
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignDogRequest) Reset() {
+	*x = AssignDogRequest{}
+	mi := &file_gastown_v1_dog_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignDogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignDogRequest) ProtoMessage() {}
+
+func (x *AssignDogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_dog_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignDogRequest.ProtoReflect.Descriptor instead.
+func (*AssignDogRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AssignDogRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssignDogRequest) GetWork() string {
+	if x != nil {
+		return x.Work
+	}
+	return ""
+}
+
+func (x *AssignDogRequest) GetCreate() bool {
+	if x != nil {
+		return x.Create
+	}
+	return false
+}
+
+func (x *AssignDogRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *AssignDogRequest) GetSkipSession() bool {
+	if x != nil {
+		return x.SkipSession
+	}
+	return false
+}
+
+func (x *AssignDogRequest) GetAgentOverride() string {
+	if x != nil {
+		return x.AgentOverride
+	}
+	return ""
+}
+
+func (x *AssignDogRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type AssignDogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dog           *Dog                   `protobuf:"bytes,1,opt,name=dog,proto3" json:"dog,omitempty"`
+	Spawned       bool                   `protobuf:"varint,2,opt,name=spawned,proto3" json:"spawned,omitempty"`                              // Dog was added for this assignment
+	ReplacedWork  string                 `protobuf:"bytes,3,opt,name=replaced_work,json=replacedWork,proto3" json:"replaced_work,omitempty"` // Work the dog was pulled off (with force)
+	SessionError  string                 `protobuf:"bytes,4,opt,name=session_error,json=sessionError,proto3" json:"session_error,omitempty"` // Session failed to start; the assignment stands
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignDogResponse) Reset() {
+	*x = AssignDogResponse{}
+	mi := &file_gastown_v1_dog_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignDogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignDogResponse) ProtoMessage() {}
+
+func (x *AssignDogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_dog_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignDogResponse.ProtoReflect.Descriptor instead.
+func (*AssignDogResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AssignDogResponse) GetDog() *Dog {
+	if x != nil {
+		return x.Dog
+	}
+	return nil
+}
+
+func (x *AssignDogResponse) GetSpawned() bool {
+	if x != nil {
+		return x.Spawned
+	}
+	return false
+}
+
+func (x *AssignDogResponse) GetReplacedWork() string {
+	if x != nil {
+		return x.ReplacedWork
+	}
+	return ""
+}
+
+func (x *AssignDogResponse) GetSessionError() string {
+	if x != nil {
+		return x.SessionError
+	}
+	return ""
+}
+
+type RecallDogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                                // Kill the session without a graceful interrupt
+	KeepSession   bool                   `protobuf:"varint,4,opt,name=keep_session,json=keepSession,proto3" json:"keep_session,omitempty"` // Only clear the assignment
+	Refresh       bool                   `protobuf:"varint,5,opt,name=refresh,proto3" json:"refresh,omitempty"`                            // Recreate the dog's worktrees afterwards
+	RequestedBy   string                 `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecallDogRequest) Reset() {
+	*x = RecallDogRequest{}
+	mi := &file_gastown_v1_dog_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecallDogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecallDogRequest) ProtoMessage() {}
+
+func (x *RecallDogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_dog_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecallDogRequest.ProtoReflect.Descriptor instead.
+func (*RecallDogRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RecallDogRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecallDogRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RecallDogRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *RecallDogRequest) GetKeepSession() bool {
+	if x != nil {
+		return x.KeepSession
+	}
+	return false
+}
+
+func (x *RecallDogRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+func (x *RecallDogRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type RecallDogResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Dog            *Dog                   `protobuf:"bytes,1,opt,name=dog,proto3" json:"dog,omitempty"`
+	RecalledWork   string                 `protobuf:"bytes,2,opt,name=recalled_work,json=recalledWork,proto3" json:"recalled_work,omitempty"` // Work the dog was pulled off, if any
+	SessionStopped bool                   `protobuf:"varint,3,opt,name=session_stopped,json=sessionStopped,proto3" json:"session_stopped,omitempty"`
+	Refreshed      bool                   `protobuf:"varint,4,opt,name=refreshed,proto3" json:"refreshed,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecallDogResponse) Reset() {
+	*x = RecallDogResponse{}
+	mi := &file_gastown_v1_dog_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecallDogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecallDogResponse) ProtoMessage() {}
+
+func (x *RecallDogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_dog_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecallDogResponse.ProtoReflect.Descriptor instead.
+func (*RecallDogResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RecallDogResponse) GetDog() *Dog {
+	if x != nil {
+		return x.Dog
+	}
+	return nil
+}
+
+func (x *RecallDogResponse) GetRecalledWork() string {
+	if x != nil {
+		return x.RecalledWork
+	}
+	return ""
+}
+
+func (x *RecallDogResponse) GetSessionStopped() bool {
+	if x != nil {
+		return x.SessionStopped
+	}
+	return false
+}
+
+func (x *RecallDogResponse) GetRefreshed() bool {
+	if x != nil {
+		return x.Refreshed
+	}
+	return false
+}
+
+type GetDogLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Lines         int32                  `protobuf:"varint,2,opt,name=lines,proto3" json:"lines,omitempty"`   // Session output lines (default 50, max 1000)
+	Events        int32                  `protobuf:"varint,3,opt,name=events,proto3" json:"events,omitempty"` // Newest events to return (0 = all)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDogLogsRequest) Reset() {
+	*x = GetDogLogsRequest{}
+	mi := &file_gastown_v1_dog_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDogLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDogLogsRequest) ProtoMessage() {}
+
+func (x *GetDogLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_dog_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDogLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetDogLogsRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetDogLogsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetDogLogsRequest) GetLines() int32 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+func (x *GetDogLogsRequest) GetEvents() int32 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+type GetDogLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*DogEvent            `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Output        string                 `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"` // Recent session output; empty if not running
+	Running       bool                   `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDogLogsResponse) Reset() {
+	*x = GetDogLogsResponse{}
+	mi := &file_gastown_v1_dog_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDogLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDogLogsResponse) ProtoMessage() {}
+
+func (x *GetDogLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_dog_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDogLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetDogLogsResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetDogLogsResponse) GetEvents() []*DogEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetDogLogsResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *GetDogLogsResponse) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+// A Deacon helper worker
+type Dog struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State          DogState               `protobuf:"varint,2,opt,name=state,proto3,enum=gastown.v1.DogState" json:"state,omitempty"`
+	Work           string                 `protobuf:"bytes,3,opt,name=work,proto3" json:"work,omitempty"`                                                                                     // Current assignment (bead ID or formula)
+	Worktrees      map[string]string      `protobuf:"bytes,4,rep,name=worktrees,proto3" json:"worktrees,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Rig -> worktree path
+	LastActive     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_active,json=lastActive,proto3" json:"last_active,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Session        string                 `protobuf:"bytes,7,opt,name=session,proto3" json:"session,omitempty"` // Session ID
+	SessionRunning bool                   `protobuf:"varint,8,opt,name=session_running,json=sessionRunning,proto3" json:"session_running,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Dog) Reset() {
+	*x = Dog{}
+	mi := &file_gastown_v1_dog_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dog) ProtoMessage() {}
+
+func (x *Dog) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_dog_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dog.ProtoReflect.Descriptor instead.
+func (*Dog) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Dog) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Dog) GetState() DogState {
+	if x != nil {
+		return x.State
+	}
+	return DogState_DOG_STATE_UNSPECIFIED
+}
+
+func (x *Dog) GetWork() string {
+	if x != nil {
+		return x.Work
+	}
+	return ""
+}
+
+func (x *Dog) GetWorktrees() map[string]string {
+	if x != nil {
+		return x.Worktrees
+	}
+	return nil
+}
+
+func (x *Dog) GetLastActive() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActive
+	}
+	return nil
+}
+
+func (x *Dog) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Dog) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *Dog) GetSessionRunning() bool {
+	if x != nil {
+		return x.SessionRunning
+	}
+	return false
+}
+
+// An entry in a dog's event log
+type DogEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // assigned, recalled, session_started, session_stopped, refreshed, error
+	Work          string                 `protobuf:"bytes,3,opt,name=work,proto3" json:"work,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	By            string                 `protobuf:"bytes,5,opt,name=by,proto3" json:"by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DogEvent) Reset() {
+	*x = DogEvent{}
+	mi := &file_gastown_v1_dog_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DogEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DogEvent) ProtoMessage() {}
+
+func (x *DogEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_dog_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DogEvent.ProtoReflect.Descriptor instead.
+func (*DogEvent) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_dog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DogEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *DogEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DogEvent) GetWork() string {
+	if x != nil {
+		return x.Work
+	}
+	return ""
+}
+
+func (x *DogEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DogEvent) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+var File_gastown_v1_dog_proto protoreflect.FileDescriptor
+
+const file_gastown_v1_dog_proto_rawDesc = "" +
+	"\n" +
+	"\x14gastown/v1/dog.proto\x12\n" +
+	"gastown.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"=\n" +
+	"\x0fListDogsRequest\x12*\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x14.gastown.v1.DogStateR\x05state\"e\n" +
+	"\x10ListDogsResponse\x12#\n" +
+	"\x04dogs\x18\x01 \x03(\v2\x0f.gastown.v1.DogR\x04dogs\x12\x12\n" +
+	"\x04idle\x18\x02 \x01(\x05R\x04idle\x12\x18\n" +
+	"\aworking\x18\x03 \x01(\x05R\aworking\"#\n" +
+	"\rGetDogRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"3\n" +
+	"\x0eGetDogResponse\x12!\n" +
+	"\x03dog\x18\x01 \x01(\v2\x0f.gastown.v1.DogR\x03dog\"\xd5\x01\n" +
+	"\x10AssignDogRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04work\x18\x02 \x01(\tR\x04work\x12\x16\n" +
+	"\x06create\x18\x03 \x01(\bR\x06create\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\x12!\n" +
+	"\fskip_session\x18\x05 \x01(\bR\vskipSession\x12%\n" +
+	"\x0eagent_override\x18\x06 \x01(\tR\ragentOverride\x12!\n" +
+	"\frequested_by\x18\a \x01(\tR\vrequestedBy\"\x9a\x01\n" +
+	"\x11AssignDogResponse\x12!\n" +
+	"\x03dog\x18\x01 \x01(\v2\x0f.gastown.v1.DogR\x03dog\x12\x18\n" +
+	"\aspawned\x18\x02 \x01(\bR\aspawned\x12#\n" +
+	"\rreplaced_work\x18\x03 \x01(\tR\freplacedWork\x12#\n" +
+	"\rsession_error\x18\x04 \x01(\tR\fsessionError\"\xb4\x01\n" +
+	"\x10RecallDogRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\x12!\n" +
+	"\fkeep_session\x18\x04 \x01(\bR\vkeepSession\x12\x18\n" +
+	"\arefresh\x18\x05 \x01(\bR\arefresh\x12!\n" +
+	"\frequested_by\x18\x06 \x01(\tR\vrequestedBy\"\xa2\x01\n" +
+	"\x11RecallDogResponse\x12!\n" +
+	"\x03dog\x18\x01 \x01(\v2\x0f.gastown.v1.DogR\x03dog\x12#\n" +
+	"\rrecalled_work\x18\x02 \x01(\tR\frecalledWork\x12'\n" +
+	"\x0fsession_stopped\x18\x03 \x01(\bR\x0esessionStopped\x12\x1c\n" +
+	"\trefreshed\x18\x04 \x01(\bR\trefreshed\"U\n" +
+	"\x11GetDogLogsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05lines\x18\x02 \x01(\x05R\x05lines\x12\x16\n" +
+	"\x06events\x18\x03 \x01(\x05R\x06events\"t\n" +
+	"\x12GetDogLogsResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.gastown.v1.DogEventR\x06events\x12\x16\n" +
+	"\x06output\x18\x02 \x01(\tR\x06output\x12\x18\n" +
+	"\arunning\x18\x03 \x01(\bR\arunning\"\x90\x03\n" +
+	"\x03Dog\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12*\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x14.gastown.v1.DogStateR\x05state\x12\x12\n" +
+	"\x04work\x18\x03 \x01(\tR\x04work\x12<\n" +
+	"\tworktrees\x18\x04 \x03(\v2\x1e.gastown.v1.Dog.WorktreesEntryR\tworktrees\x12;\n" +
+	"\vlast_active\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastActive\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\asession\x18\a \x01(\tR\asession\x12'\n" +
+	"\x0fsession_running\x18\b \x01(\bR\x0esessionRunning\x1a<\n" +
+	"\x0eWorktreesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8c\x01\n" +
+	"\bDogEvent\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04work\x18\x03 \x01(\tR\x04work\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x0e\n" +
+	"\x02by\x18\x05 \x01(\tR\x02by*P\n" +
+	"\bDogState\x12\x19\n" +
+	"\x15DOG_STATE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eDOG_STATE_IDLE\x10\x01\x12\x15\n" +
+	"\x11DOG_STATE_WORKING\x10\x022\xf5\x02\n" +
+	"\n" +
+	"DogService\x12E\n" +
+	"\bListDogs\x12\x1b.gastown.v1.ListDogsRequest\x1a\x1c.gastown.v1.ListDogsResponse\x12?\n" +
+	"\x06GetDog\x12\x19.gastown.v1.GetDogRequest\x1a\x1a.gastown.v1.GetDogResponse\x12H\n" +
+	"\tAssignDog\x12\x1c.gastown.v1.AssignDogRequest\x1a\x1d.gastown.v1.AssignDogResponse\x12H\n" +
+	"\tRecallDog\x12\x1c.gastown.v1.RecallDogRequest\x1a\x1d.gastown.v1.RecallDogResponse\x12K\n" +
+	"\n" +
+	"GetDogLogs\x12\x1d.gastown.v1.GetDogLogsRequest\x1a\x1e.gastown.v1.GetDogLogsResponseB\x9b\x01\n" +
+	"\x0ecom.gastown.v1B\bDogProtoP\x01Z6github.com/steveyegge/gastown/gen/gastown/v1;gastownv1\xa2\x02\x03GXX\xaa\x02\n" +
+	"Gastown.V1\xca\x02\n" +
+	"Gastown\\V1\xe2\x02\x16Gastown\\V1\\GPBMetadata\xea\x02\vGastown::V1b\x06proto3"
+
+var (
+	file_gastown_v1_dog_proto_rawDescOnce sync.Once
+	file_gastown_v1_dog_proto_rawDescData []byte
+)
+
+func file_gastown_v1_dog_proto_rawDescGZIP() []byte {
+	file_gastown_v1_dog_proto_rawDescOnce.Do(func() {
+		file_gastown_v1_dog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gastown_v1_dog_proto_rawDesc), len(file_gastown_v1_dog_proto_rawDesc)))
+	})
+	return file_gastown_v1_dog_proto_rawDescData
+}
+
+var file_gastown_v1_dog_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gastown_v1_dog_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_gastown_v1_dog_proto_goTypes = []any{
+	(DogState)(0),                 // 0: gastown.v1.DogState
+	(*ListDogsRequest)(nil),       // 1: gastown.v1.ListDogsRequest
+	(*ListDogsResponse)(nil),      // 2: gastown.v1.ListDogsResponse
+	(*GetDogRequest)(nil),         // 3: gastown.v1.GetDogRequest
+	(*GetDogResponse)(nil),        // 4: gastown.v1.GetDogResponse
+	(*AssignDogRequest)(nil),      // 5: gastown.v1.AssignDogRequest
+	(*AssignDogResponse)(nil),     // 6: gastown.v1.AssignDogResponse
+	(*RecallDogRequest)(nil),      // 7: gastown.v1.RecallDogRequest
+	(*RecallDogResponse)(nil),     // 8: gastown.v1.RecallDogResponse
+	(*GetDogLogsRequest)(nil),     // 9: gastown.v1.GetDogLogsRequest
+	(*GetDogLogsResponse)(nil),    // 10: gastown.v1.GetDogLogsResponse
+	(*Dog)(nil),                   // 11: gastown.v1.Dog
+	(*DogEvent)(nil),              // 12: gastown.v1.DogEvent
+	nil,                           // 13: gastown.v1.Dog.WorktreesEntry
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+}
+var file_gastown_v1_dog_proto_depIdxs = []int32{
+	0,  // 0: gastown.v1.ListDogsRequest.state:type_name -> gastown.v1.DogState
+	11, // 1: gastown.v1.ListDogsResponse.dogs:type_name -> gastown.v1.Dog
+	11, // 2: gastown.v1.GetDogResponse.dog:type_name -> gastown.v1.Dog
+	11, // 3: gastown.v1.AssignDogResponse.dog:type_name -> gastown.v1.Dog
+	11, // 4: gastown.v1.RecallDogResponse.dog:type_name -> gastown.v1.Dog
+	12, // 5: gastown.v1.GetDogLogsResponse.events:type_name -> gastown.v1.DogEvent
+	0,  // 6: gastown.v1.Dog.state:type_name -> gastown.v1.DogState
+	13, // 7: gastown.v1.Dog.worktrees:type_name -> gastown.v1.Dog.WorktreesEntry
+	14, // 8: gastown.v1.Dog.last_active:type_name -> google.protobuf.Timestamp
+	14, // 9: gastown.v1.Dog.created_at:type_name -> google.protobuf.Timestamp
+	14, // 10: gastown.v1.DogEvent.time:type_name -> google.protobuf.Timestamp
+	1,  // 11: gastown.v1.DogService.ListDogs:input_type -> gastown.v1.ListDogsRequest
+	3,  // 12: gastown.v1.DogService.GetDog:input_type -> gastown.v1.GetDogRequest
+	5,  // 13: gastown.v1.DogService.AssignDog:input_type -> gastown.v1.AssignDogRequest
+	7,  // 14: gastown.v1.DogService.RecallDog:input_type -> gastown.v1.RecallDogRequest
+	9,  // 15: gastown.v1.DogService.GetDogLogs:input_type -> gastown.v1.GetDogLogsRequest
+	2,  // 16: gastown.v1.DogService.ListDogs:output_type -> gastown.v1.ListDogsResponse
+	4,  // 17: gastown.v1.DogService.GetDog:output_type -> gastown.v1.GetDogResponse
+	6,  // 18: gastown.v1.DogService.AssignDog:output_type -> gastown.v1.AssignDogResponse
+	8,  // 19: gastown.v1.DogService.RecallDog:output_type -> gastown.v1.RecallDogResponse
+	10, // 20: gastown.v1.DogService.GetDogLogs:output_type -> gastown.v1.GetDogLogsResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_gastown_v1_dog_proto_init() }
+func file_gastown_v1_dog_proto_init() {
+	if File_gastown_v1_dog_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastown_v1_dog_proto_rawDesc), len(file_gastown_v1_dog_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gastown_v1_dog_proto_goTypes,
+		DependencyIndexes: file_gastown_v1_dog_proto_depIdxs,
+		EnumInfos:         file_gastown_v1_dog_proto_enumTypes,
+		MessageInfos:      file_gastown_v1_dog_proto_msgTypes,
+	}.Build()
+	File_gastown_v1_dog_proto = out.File
+	file_gastown_v1_dog_proto_goTypes = nil
+	file_gastown_v1_dog_proto_depIdxs = nil
+}
@@ -0,0 +1,927 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: gastown/v1/escalation.proto
+
+package gastownv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Escalation lifecycle state.
+type EscalationState int32
+
+const (
+	EscalationState_ESCALATION_STATE_UNSPECIFIED EscalationState = 0
+	EscalationState_ESCALATION_STATE_OPEN        EscalationState = 1 // Not yet acknowledged; SLA timer running
+	EscalationState_ESCALATION_STATE_ACKED       EscalationState = 2
+	EscalationState_ESCALATION_STATE_ASSIGNED    EscalationState = 3
+	EscalationState_ESCALATION_STATE_CLOSED      EscalationState = 4
+)
+
+// Enum value maps for EscalationState.
+var (
+	EscalationState_name = map[int32]string{
+		0: "ESCALATION_STATE_UNSPECIFIED",
+		1: "ESCALATION_STATE_OPEN",
+		2: "ESCALATION_STATE_ACKED",
+		3: "ESCALATION_STATE_ASSIGNED",
+		4: "ESCALATION_STATE_CLOSED",
+	}
+	EscalationState_value = map[string]int32{
+		"ESCALATION_STATE_UNSPECIFIED": 0,
+		"ESCALATION_STATE_OPEN":        1,
+		"ESCALATION_STATE_ACKED":       2,
+		"ESCALATION_STATE_ASSIGNED":    3,
+		"ESCALATION_STATE_CLOSED":      4,
+	}
+)
+
+func (x EscalationState) Enum() *EscalationState {
+	p := new(EscalationState)
+	*p = x
+	return p
+}
+
+func (x EscalationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EscalationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_gastown_v1_escalation_proto_enumTypes[0].Descriptor()
+}
+
+func (EscalationState) Type() protoreflect.EnumType {
+	return &file_gastown_v1_escalation_proto_enumTypes[0]
+}
+
+func (x EscalationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EscalationState.Descriptor instead.
+func (EscalationState) EnumDescriptor() ([]byte, []int) {
+	return file_gastown_v1_escalation_proto_rawDescGZIP(), []int{0}
+}
+
+type ListEscalationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IncludeClosed bool                   `protobuf:"varint,1,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"`
+	Severity      string                 `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`                              // Only this severity (critical, high, medium, low)
+	AssignedTo    string                 `protobuf:"bytes,3,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`        // Only escalations assigned to this address
+	BreachedOnly  bool                   `protobuf:"varint,4,opt,name=breached_only,json=breachedOnly,proto3" json:"breached_only,omitempty"` // Only open escalations past their SLA
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEscalationsRequest) Reset() {
+	*x = ListEscalationsRequest{}
+	mi := &file_gastown_v1_escalation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEscalationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEscalationsRequest) ProtoMessage() {}
+
+func (x *ListEscalationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_escalation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEscalationsRequest.ProtoReflect.Descriptor instead.
+func (*ListEscalationsRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_escalation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListEscalationsRequest) GetIncludeClosed() bool {
+	if x != nil {
+		return x.IncludeClosed
+	}
+	return false
+}
+
+func (x *ListEscalationsRequest) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ListEscalationsRequest) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *ListEscalationsRequest) GetBreachedOnly() bool {
+	if x != nil {
+		return x.BreachedOnly
+	}
+	return false
+}
+
+type ListEscalationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Escalations   []*Escalation          `protobuf:"bytes,1,rep,name=escalations,proto3" json:"escalations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEscalationsResponse) Reset() {
+	*x = ListEscalationsResponse{}
+	mi := &file_gastown_v1_escalation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEscalationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEscalationsResponse) ProtoMessage() {}
+
+func (x *ListEscalationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_escalation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEscalationsResponse.ProtoReflect.Descriptor instead.
+func (*ListEscalationsResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_escalation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListEscalationsResponse) GetEscalations() []*Escalation {
+	if x != nil {
+		return x.Escalations
+	}
+	return nil
+}
+
+type GetEscalationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EscalationId  string                 `protobuf:"bytes,1,opt,name=escalation_id,json=escalationId,proto3" json:"escalation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEscalationRequest) Reset() {
+	*x = GetEscalationRequest{}
+	mi := &file_gastown_v1_escalation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEscalationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEscalationRequest) ProtoMessage() {}
+
+func (x *GetEscalationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_escalation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEscalationRequest.ProtoReflect.Descriptor instead.
+func (*GetEscalationRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_escalation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetEscalationRequest) GetEscalationId() string {
+	if x != nil {
+		return x.EscalationId
+	}
+	return ""
+}
+
+type GetEscalationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Escalation    *Escalation            `protobuf:"bytes,1,opt,name=escalation,proto3" json:"escalation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEscalationResponse) Reset() {
+	*x = GetEscalationResponse{}
+	mi := &file_gastown_v1_escalation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEscalationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEscalationResponse) ProtoMessage() {}
+
+func (x *GetEscalationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_escalation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEscalationResponse.ProtoReflect.Descriptor instead.
+func (*GetEscalationResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_escalation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetEscalationResponse) GetEscalation() *Escalation {
+	if x != nil {
+		return x.Escalation
+	}
+	return nil
+}
+
+type AckEscalationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EscalationId  string                 `protobuf:"bytes,1,opt,name=escalation_id,json=escalationId,proto3" json:"escalation_id,omitempty"`
+	AckedBy       string                 `protobuf:"bytes,2,opt,name=acked_by,json=ackedBy,proto3" json:"acked_by,omitempty"` // Default: "rpc"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckEscalationRequest) Reset() {
+	*x = AckEscalationRequest{}
+	mi := &file_gastown_v1_escalation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckEscalationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckEscalationRequest) ProtoMessage() {}
+
+func (x *AckEscalationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_escalation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckEscalationRequest.ProtoReflect.Descriptor instead.
+func (*AckEscalationRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_escalation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AckEscalationRequest) GetEscalationId() string {
+	if x != nil {
+		return x.EscalationId
+	}
+	return ""
+}
+
+func (x *AckEscalationRequest) GetAckedBy() string {
+	if x != nil {
+		return x.AckedBy
+	}
+	return ""
+}
+
+type AckEscalationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Escalation    *Escalation            `protobuf:"bytes,1,opt,name=escalation,proto3" json:"escalation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckEscalationResponse) Reset() {
+	*x = AckEscalationResponse{}
+	mi := &file_gastown_v1_escalation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckEscalationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckEscalationResponse) ProtoMessage() {}
+
+func (x *AckEscalationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_escalation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckEscalationResponse.ProtoReflect.Descriptor instead.
+func (*AckEscalationResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_escalation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AckEscalationResponse) GetEscalation() *Escalation {
+	if x != nil {
+		return x.Escalation
+	}
+	return nil
+}
+
+type AssignEscalationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EscalationId  string                 `protobuf:"bytes,1,opt,name=escalation_id,json=escalationId,proto3" json:"escalation_id,omitempty"`
+	Assignee      string                 `protobuf:"bytes,2,opt,name=assignee,proto3" json:"assignee,omitempty"`                       // Required: agent address or "overseer"
+	AssignedBy    string                 `protobuf:"bytes,3,opt,name=assigned_by,json=assignedBy,proto3" json:"assigned_by,omitempty"` // Default: "rpc"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignEscalationRequest) Reset() {
+	*x = AssignEscalationRequest{}
+	mi := &file_gastown_v1_escalation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignEscalationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignEscalationRequest) ProtoMessage() {}
+
+func (x *AssignEscalationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_escalation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignEscalationRequest.ProtoReflect.Descriptor instead.
+func (*AssignEscalationRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_escalation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AssignEscalationRequest) GetEscalationId() string {
+	if x != nil {
+		return x.EscalationId
+	}
+	return ""
+}
+
+func (x *AssignEscalationRequest) GetAssignee() string {
+	if x != nil {
+		return x.Assignee
+	}
+	return ""
+}
+
+func (x *AssignEscalationRequest) GetAssignedBy() string {
+	if x != nil {
+		return x.AssignedBy
+	}
+	return ""
+}
+
+type AssignEscalationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Escalation    *Escalation            `protobuf:"bytes,1,opt,name=escalation,proto3" json:"escalation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignEscalationResponse) Reset() {
+	*x = AssignEscalationResponse{}
+	mi := &file_gastown_v1_escalation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignEscalationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignEscalationResponse) ProtoMessage() {}
+
+func (x *AssignEscalationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_escalation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignEscalationResponse.ProtoReflect.Descriptor instead.
+func (*AssignEscalationResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_escalation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AssignEscalationResponse) GetEscalation() *Escalation {
+	if x != nil {
+		return x.Escalation
+	}
+	return nil
+}
+
+type ResolveEscalationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EscalationId  string                 `protobuf:"bytes,1,opt,name=escalation_id,json=escalationId,proto3" json:"escalation_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                           // Required
+	ResolvedBy    string                 `protobuf:"bytes,3,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"` // Default: "rpc"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveEscalationRequest) Reset() {
+	*x = ResolveEscalationRequest{}
+	mi := &file_gastown_v1_escalation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveEscalationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveEscalationRequest) ProtoMessage() {}
+
+func (x *ResolveEscalationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_escalation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveEscalationRequest.ProtoReflect.Descriptor instead.
+func (*ResolveEscalationRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_escalation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResolveEscalationRequest) GetEscalationId() string {
+	if x != nil {
+		return x.EscalationId
+	}
+	return ""
+}
+
+func (x *ResolveEscalationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ResolveEscalationRequest) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+type ResolveEscalationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EscalationId  string                 `protobuf:"bytes,1,opt,name=escalation_id,json=escalationId,proto3" json:"escalation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveEscalationResponse) Reset() {
+	*x = ResolveEscalationResponse{}
+	mi := &file_gastown_v1_escalation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveEscalationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveEscalationResponse) ProtoMessage() {}
+
+func (x *ResolveEscalationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_escalation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveEscalationResponse.ProtoReflect.Descriptor instead.
+func (*ResolveEscalationResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_escalation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResolveEscalationResponse) GetEscalationId() string {
+	if x != nil {
+		return x.EscalationId
+	}
+	return ""
+}
+
+type Escalation struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title             string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	State             EscalationState        `protobuf:"varint,3,opt,name=state,proto3,enum=gastown.v1.EscalationState" json:"state,omitempty"`
+	Severity          string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"` // critical, high, medium, low
+	Reason            string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Source            string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	EscalatedBy       string                 `protobuf:"bytes,7,opt,name=escalated_by,json=escalatedBy,proto3" json:"escalated_by,omitempty"`
+	AckedBy           string                 `protobuf:"bytes,8,opt,name=acked_by,json=ackedBy,proto3" json:"acked_by,omitempty"`
+	AssignedTo        string                 `protobuf:"bytes,9,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	ClosedBy          string                 `protobuf:"bytes,10,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	ClosedReason      string                 `protobuf:"bytes,11,opt,name=closed_reason,json=closedReason,proto3" json:"closed_reason,omitempty"`
+	RelatedBead       string                 `protobuf:"bytes,12,opt,name=related_bead,json=relatedBead,proto3" json:"related_bead,omitempty"`
+	ReescalationCount int32                  `protobuf:"varint,13,opt,name=reescalation_count,json=reescalationCount,proto3" json:"reescalation_count,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AckedAt           *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=acked_at,json=ackedAt,proto3" json:"acked_at,omitempty"`
+	AssignedAt        *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	SlaDeadline       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=sla_deadline,json=slaDeadline,proto3" json:"sla_deadline,omitempty"` // Unset once acknowledged
+	SlaBreached       bool                   `protobuf:"varint,18,opt,name=sla_breached,json=slaBreached,proto3" json:"sla_breached,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Escalation) Reset() {
+	*x = Escalation{}
+	mi := &file_gastown_v1_escalation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Escalation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Escalation) ProtoMessage() {}
+
+func (x *Escalation) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_escalation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Escalation.ProtoReflect.Descriptor instead.
+func (*Escalation) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_escalation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Escalation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Escalation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Escalation) GetState() EscalationState {
+	if x != nil {
+		return x.State
+	}
+	return EscalationState_ESCALATION_STATE_UNSPECIFIED
+}
+
+func (x *Escalation) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Escalation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Escalation) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Escalation) GetEscalatedBy() string {
+	if x != nil {
+		return x.EscalatedBy
+	}
+	return ""
+}
+
+func (x *Escalation) GetAckedBy() string {
+	if x != nil {
+		return x.AckedBy
+	}
+	return ""
+}
+
+func (x *Escalation) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *Escalation) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+func (x *Escalation) GetClosedReason() string {
+	if x != nil {
+		return x.ClosedReason
+	}
+	return ""
+}
+
+func (x *Escalation) GetRelatedBead() string {
+	if x != nil {
+		return x.RelatedBead
+	}
+	return ""
+}
+
+func (x *Escalation) GetReescalationCount() int32 {
+	if x != nil {
+		return x.ReescalationCount
+	}
+	return 0
+}
+
+func (x *Escalation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Escalation) GetAckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AckedAt
+	}
+	return nil
+}
+
+func (x *Escalation) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *Escalation) GetSlaDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SlaDeadline
+	}
+	return nil
+}
+
+func (x *Escalation) GetSlaBreached() bool {
+	if x != nil {
+		return x.SlaBreached
+	}
+	return false
+}
+
+var File_gastown_v1_escalation_proto protoreflect.FileDescriptor
+
+const file_gastown_v1_escalation_proto_rawDesc = "" +
+	"\n" +
+	"\x1bgastown/v1/escalation.proto\x12\n" +
+	"gastown.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa1\x01\n" +
+	"\x16ListEscalationsRequest\x12%\n" +
+	"\x0einclude_closed\x18\x01 \x01(\bR\rincludeClosed\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x1f\n" +
+	"\vassigned_to\x18\x03 \x01(\tR\n" +
+	"assignedTo\x12#\n" +
+	"\rbreached_only\x18\x04 \x01(\bR\fbreachedOnly\"S\n" +
+	"\x17ListEscalationsResponse\x128\n" +
+	"\vescalations\x18\x01 \x03(\v2\x16.gastown.v1.EscalationR\vescalations\";\n" +
+	"\x14GetEscalationRequest\x12#\n" +
+	"\rescalation_id\x18\x01 \x01(\tR\fescalationId\"O\n" +
+	"\x15GetEscalationResponse\x126\n" +
+	"\n" +
+	"escalation\x18\x01 \x01(\v2\x16.gastown.v1.EscalationR\n" +
+	"escalation\"V\n" +
+	"\x14AckEscalationRequest\x12#\n" +
+	"\rescalation_id\x18\x01 \x01(\tR\fescalationId\x12\x19\n" +
+	"\backed_by\x18\x02 \x01(\tR\aackedBy\"O\n" +
+	"\x15AckEscalationResponse\x126\n" +
+	"\n" +
+	"escalation\x18\x01 \x01(\v2\x16.gastown.v1.EscalationR\n" +
+	"escalation\"{\n" +
+	"\x17AssignEscalationRequest\x12#\n" +
+	"\rescalation_id\x18\x01 \x01(\tR\fescalationId\x12\x1a\n" +
+	"\bassignee\x18\x02 \x01(\tR\bassignee\x12\x1f\n" +
+	"\vassigned_by\x18\x03 \x01(\tR\n" +
+	"assignedBy\"R\n" +
+	"\x18AssignEscalationResponse\x126\n" +
+	"\n" +
+	"escalation\x18\x01 \x01(\v2\x16.gastown.v1.EscalationR\n" +
+	"escalation\"x\n" +
+	"\x18ResolveEscalationRequest\x12#\n" +
+	"\rescalation_id\x18\x01 \x01(\tR\fescalationId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vresolved_by\x18\x03 \x01(\tR\n" +
+	"resolvedBy\"@\n" +
+	"\x19ResolveEscalationResponse\x12#\n" +
+	"\rescalation_id\x18\x01 \x01(\tR\fescalationId\"\xb5\x05\n" +
+	"\n" +
+	"Escalation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x121\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x1b.gastown.v1.EscalationStateR\x05state\x12\x1a\n" +
+	"\bseverity\x18\x04 \x01(\tR\bseverity\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12!\n" +
+	"\fescalated_by\x18\a \x01(\tR\vescalatedBy\x12\x19\n" +
+	"\backed_by\x18\b \x01(\tR\aackedBy\x12\x1f\n" +
+	"\vassigned_to\x18\t \x01(\tR\n" +
+	"assignedTo\x12\x1b\n" +
+	"\tclosed_by\x18\n" +
+	" \x01(\tR\bclosedBy\x12#\n" +
+	"\rclosed_reason\x18\v \x01(\tR\fclosedReason\x12!\n" +
+	"\frelated_bead\x18\f \x01(\tR\vrelatedBead\x12-\n" +
+	"\x12reescalation_count\x18\r \x01(\x05R\x11reescalationCount\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x125\n" +
+	"\backed_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\aackedAt\x12;\n" +
+	"\vassigned_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x12=\n" +
+	"\fsla_deadline\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vslaDeadline\x12!\n" +
+	"\fsla_breached\x18\x12 \x01(\bR\vslaBreached*\xa6\x01\n" +
+	"\x0fEscalationState\x12 \n" +
+	"\x1cESCALATION_STATE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ESCALATION_STATE_OPEN\x10\x01\x12\x1a\n" +
+	"\x16ESCALATION_STATE_ACKED\x10\x02\x12\x1d\n" +
+	"\x19ESCALATION_STATE_ASSIGNED\x10\x03\x12\x1b\n" +
+	"\x17ESCALATION_STATE_CLOSED\x10\x042\xdc\x03\n" +
+	"\x11EscalationService\x12Z\n" +
+	"\x0fListEscalations\x12\".gastown.v1.ListEscalationsRequest\x1a#.gastown.v1.ListEscalationsResponse\x12T\n" +
+	"\rGetEscalation\x12 .gastown.v1.GetEscalationRequest\x1a!.gastown.v1.GetEscalationResponse\x12T\n" +
+	"\rAckEscalation\x12 .gastown.v1.AckEscalationRequest\x1a!.gastown.v1.AckEscalationResponse\x12]\n" +
+	"\x10AssignEscalation\x12#.gastown.v1.AssignEscalationRequest\x1a$.gastown.v1.AssignEscalationResponse\x12`\n" +
+	"\x11ResolveEscalation\x12$.gastown.v1.ResolveEscalationRequest\x1a%.gastown.v1.ResolveEscalationResponseB\xa2\x01\n" +
+	"\x0ecom.gastown.v1B\x0fEscalationProtoP\x01Z6github.com/steveyegge/gastown/gen/gastown/v1;gastownv1\xa2\x02\x03GXX\xaa\x02\n" +
+	"Gastown.V1\xca\x02\n" +
+	"Gastown\\V1\xe2\x02\x16Gastown\\V1\\GPBMetadata\xea\x02\vGastown::V1b\x06proto3"
+
+var (
+	file_gastown_v1_escalation_proto_rawDescOnce sync.Once
+	file_gastown_v1_escalation_proto_rawDescData []byte
+)
+
+func file_gastown_v1_escalation_proto_rawDescGZIP() []byte {
+	file_gastown_v1_escalation_proto_rawDescOnce.Do(func() {
+		file_gastown_v1_escalation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gastown_v1_escalation_proto_rawDesc), len(file_gastown_v1_escalation_proto_rawDesc)))
+	})
+	return file_gastown_v1_escalation_proto_rawDescData
+}
+
+var file_gastown_v1_escalation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gastown_v1_escalation_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_gastown_v1_escalation_proto_goTypes = []any{
+	(EscalationState)(0),              // 0: gastown.v1.EscalationState
+	(*ListEscalationsRequest)(nil),    // 1: gastown.v1.ListEscalationsRequest
+	(*ListEscalationsResponse)(nil),   // 2: gastown.v1.ListEscalationsResponse
+	(*GetEscalationRequest)(nil),      // 3: gastown.v1.GetEscalationRequest
+	(*GetEscalationResponse)(nil),     // 4: gastown.v1.GetEscalationResponse
+	(*AckEscalationRequest)(nil),      // 5: gastown.v1.AckEscalationRequest
+	(*AckEscalationResponse)(nil),     // 6: gastown.v1.AckEscalationResponse
+	(*AssignEscalationRequest)(nil),   // 7: gastown.v1.AssignEscalationRequest
+	(*AssignEscalationResponse)(nil),  // 8: gastown.v1.AssignEscalationResponse
+	(*ResolveEscalationRequest)(nil),  // 9: gastown.v1.ResolveEscalationRequest
+	(*ResolveEscalationResponse)(nil), // 10: gastown.v1.ResolveEscalationResponse
+	(*Escalation)(nil),                // 11: gastown.v1.Escalation
+	(*timestamppb.Timestamp)(nil),     // 12: google.protobuf.Timestamp
+}
+var file_gastown_v1_escalation_proto_depIdxs = []int32{
+	11, // 0: gastown.v1.ListEscalationsResponse.escalations:type_name -> gastown.v1.Escalation
+	11, // 1: gastown.v1.GetEscalationResponse.escalation:type_name -> gastown.v1.Escalation
+	11, // 2: gastown.v1.AckEscalationResponse.escalation:type_name -> gastown.v1.Escalation
+	11, // 3: gastown.v1.AssignEscalationResponse.escalation:type_name -> gastown.v1.Escalation
+	0,  // 4: gastown.v1.Escalation.state:type_name -> gastown.v1.EscalationState
+	12, // 5: gastown.v1.Escalation.created_at:type_name -> google.protobuf.Timestamp
+	12, // 6: gastown.v1.Escalation.acked_at:type_name -> google.protobuf.Timestamp
+	12, // 7: gastown.v1.Escalation.assigned_at:type_name -> google.protobuf.Timestamp
+	12, // 8: gastown.v1.Escalation.sla_deadline:type_name -> google.protobuf.Timestamp
+	1,  // 9: gastown.v1.EscalationService.ListEscalations:input_type -> gastown.v1.ListEscalationsRequest
+	3,  // 10: gastown.v1.EscalationService.GetEscalation:input_type -> gastown.v1.GetEscalationRequest
+	5,  // 11: gastown.v1.EscalationService.AckEscalation:input_type -> gastown.v1.AckEscalationRequest
+	7,  // 12: gastown.v1.EscalationService.AssignEscalation:input_type -> gastown.v1.AssignEscalationRequest
+	9,  // 13: gastown.v1.EscalationService.ResolveEscalation:input_type -> gastown.v1.ResolveEscalationRequest
+	2,  // 14: gastown.v1.EscalationService.ListEscalations:output_type -> gastown.v1.ListEscalationsResponse
+	4,  // 15: gastown.v1.EscalationService.GetEscalation:output_type -> gastown.v1.GetEscalationResponse
+	6,  // 16: gastown.v1.EscalationService.AckEscalation:output_type -> gastown.v1.AckEscalationResponse
+	8,  // 17: gastown.v1.EscalationService.AssignEscalation:output_type -> gastown.v1.AssignEscalationResponse
+	10, // 18: gastown.v1.EscalationService.ResolveEscalation:output_type -> gastown.v1.ResolveEscalationResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_gastown_v1_escalation_proto_init() }
+func file_gastown_v1_escalation_proto_init() {
+	if File_gastown_v1_escalation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastown_v1_escalation_proto_rawDesc), len(file_gastown_v1_escalation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gastown_v1_escalation_proto_goTypes,
+		DependencyIndexes: file_gastown_v1_escalation_proto_depIdxs,
+		EnumInfos:         file_gastown_v1_escalation_proto_enumTypes,
+		MessageInfos:      file_gastown_v1_escalation_proto_msgTypes,
+	}.Build()
+	File_gastown_v1_escalation_proto = out.File
+	file_gastown_v1_escalation_proto_goTypes = nil
+	file_gastown_v1_escalation_proto_depIdxs = nil
+}
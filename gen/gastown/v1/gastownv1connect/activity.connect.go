@@ -49,13 +49,16 @@ const (
 
 // ActivityServiceClient is a client for the gastown.v1.ActivityService service.
 type ActivityServiceClient interface {
-	// ListEvents returns events from the activity feed
+	// ListEvents returns events from the activity feed, with filtering by
+	// type, actor, rig, visibility, and time range.
 	ListEvents(context.Context, *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error)
-	// WatchEvents streams new events in real-time
+	// WatchEvents streams new events in real-time with optional initial backfill.
 	WatchEvents(context.Context, *connect.Request[v1.WatchEventsRequest]) (*connect.ServerStreamForClient[v1.ActivityEvent], error)
-	// EmitEvent writes a new event to the activity log
+	// EmitEvent writes a custom event to the activity log. Used by external
+	// integrations (CI/CD, deploy tools) to record events in the feed.
 	EmitEvent(context.Context, *connect.Request[v1.EmitEventRequest]) (*connect.Response[v1.EmitEventResponse], error)
-	// StreamLogs streams log entries from agent log sources in real-time
+	// StreamLogs streams log entries from agent log sources (activity feed,
+	// town lifecycle log, or daemon log). Supports tail + follow pattern.
 	StreamLogs(context.Context, *connect.Request[v1.StreamLogsRequest]) (*connect.ServerStreamForClient[v1.LogEntry], error)
 }
 
@@ -127,13 +130,16 @@ func (c *activityServiceClient) StreamLogs(ctx context.Context, req *connect.Req
 
 // ActivityServiceHandler is an implementation of the gastown.v1.ActivityService service.
 type ActivityServiceHandler interface {
-	// ListEvents returns events from the activity feed
+	// ListEvents returns events from the activity feed, with filtering by
+	// type, actor, rig, visibility, and time range.
 	ListEvents(context.Context, *connect.Request[v1.ListEventsRequest]) (*connect.Response[v1.ListEventsResponse], error)
-	// WatchEvents streams new events in real-time
+	// WatchEvents streams new events in real-time with optional initial backfill.
 	WatchEvents(context.Context, *connect.Request[v1.WatchEventsRequest], *connect.ServerStream[v1.ActivityEvent]) error
-	// EmitEvent writes a new event to the activity log
+	// EmitEvent writes a custom event to the activity log. Used by external
+	// integrations (CI/CD, deploy tools) to record events in the feed.
 	EmitEvent(context.Context, *connect.Request[v1.EmitEventRequest]) (*connect.Response[v1.EmitEventResponse], error)
-	// StreamLogs streams log entries from agent log sources in real-time
+	// StreamLogs streams log entries from agent log sources (activity feed,
+	// town lifecycle log, or daemon log). Supports tail + follow pattern.
 	StreamLogs(context.Context, *connect.Request[v1.StreamLogsRequest], *connect.ServerStream[v1.LogEntry]) error
 }
 
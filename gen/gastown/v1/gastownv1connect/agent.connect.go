@@ -42,6 +42,8 @@ const (
 	AgentServiceSpawnPolecatProcedure = "/gastown.v1.AgentService/SpawnPolecat"
 	// AgentServiceStartCrewProcedure is the fully-qualified name of the AgentService's StartCrew RPC.
 	AgentServiceStartCrewProcedure = "/gastown.v1.AgentService/StartCrew"
+	// AgentServiceStopCrewProcedure is the fully-qualified name of the AgentService's StopCrew RPC.
+	AgentServiceStopCrewProcedure = "/gastown.v1.AgentService/StopCrew"
 	// AgentServiceStopAgentProcedure is the fully-qualified name of the AgentService's StopAgent RPC.
 	AgentServiceStopAgentProcedure = "/gastown.v1.AgentService/StopAgent"
 	// AgentServiceNudgeAgentProcedure is the fully-qualified name of the AgentService's NudgeAgent RPC.
@@ -51,36 +53,87 @@ const (
 	// AgentServiceWatchAgentsProcedure is the fully-qualified name of the AgentService's WatchAgents
 	// RPC.
 	AgentServiceWatchAgentsProcedure = "/gastown.v1.AgentService/WatchAgents"
+	// AgentServiceWatchAgentOutputProcedure is the fully-qualified name of the AgentService's
+	// WatchAgentOutput RPC.
+	AgentServiceWatchAgentOutputProcedure = "/gastown.v1.AgentService/WatchAgentOutput"
+	// AgentServiceGetAgentRecordingProcedure is the fully-qualified name of the AgentService's
+	// GetAgentRecording RPC.
+	AgentServiceGetAgentRecordingProcedure = "/gastown.v1.AgentService/GetAgentRecording"
+	// AgentServiceListAgentFilesProcedure is the fully-qualified name of the AgentService's
+	// ListAgentFiles RPC.
+	AgentServiceListAgentFilesProcedure = "/gastown.v1.AgentService/ListAgentFiles"
+	// AgentServiceFetchAgentFileProcedure is the fully-qualified name of the AgentService's
+	// FetchAgentFile RPC.
+	AgentServiceFetchAgentFileProcedure = "/gastown.v1.AgentService/FetchAgentFile"
 	// AgentServiceCreateCrewProcedure is the fully-qualified name of the AgentService's CreateCrew RPC.
 	AgentServiceCreateCrewProcedure = "/gastown.v1.AgentService/CreateCrew"
 	// AgentServiceRemoveCrewProcedure is the fully-qualified name of the AgentService's RemoveCrew RPC.
 	AgentServiceRemoveCrewProcedure = "/gastown.v1.AgentService/RemoveCrew"
+	// AgentServiceDestroyCrewProcedure is the fully-qualified name of the AgentService's DestroyCrew
+	// RPC.
+	AgentServiceDestroyCrewProcedure = "/gastown.v1.AgentService/DestroyCrew"
 )
 
 // AgentServiceClient is a client for the gastown.v1.AgentService service.
 type AgentServiceClient interface {
-	// ListAgents returns all agents (crew + polecats) in a rig or town
+	// ListAgents returns all agents in a rig or across the town.
+	// Filter by rig, type, state, and hooked work; sort; and use a field mask
+	// so heavy fields (hooked title, unread mail) are only computed on request.
 	ListAgents(context.Context, *connect.Request[v1.ListAgentsRequest]) (*connect.Response[v1.ListAgentsResponse], error)
-	// GetAgent returns details for a specific agent
+	// GetAgent returns details for a specific agent including recent terminal output.
 	GetAgent(context.Context, *connect.Request[v1.GetAgentRequest]) (*connect.Response[v1.GetAgentResponse], error)
-	// SpawnPolecat creates a new polecat in a rig
+	// SpawnPolecat creates a new ephemeral polecat agent in a rig.
+	// Optionally hooks a bead immediately and starts a Claude Code session.
 	SpawnPolecat(context.Context, *connect.Request[v1.SpawnPolecatRequest]) (*connect.Response[v1.SpawnPolecatResponse], error)
-	// StartCrew starts a crew worker session
+	// StartCrew starts (or restarts) a crew worker's Claude Code session.
+	// If create=true and the crew doesn't exist, creates it first.
 	StartCrew(context.Context, *connect.Request[v1.StartCrewRequest]) (*connect.Response[v1.StartCrewResponse], error)
-	// StopAgent stops an agent's session
+	// StopCrew stops a crew worker's session, keeping its workspace so it
+	// can be started again with StartCrew.
+	StopCrew(context.Context, *connect.Request[v1.StopCrewRequest]) (*connect.Response[v1.StopCrewResponse], error)
+	// StopAgent stops an agent's session. If the agent has incomplete work,
+	// returns had_incomplete_work=true. Use force=true to stop anyway.
 	StopAgent(context.Context, *connect.Request[v1.StopAgentRequest]) (*connect.Response[v1.StopAgentResponse], error)
-	// NudgeAgent sends a message to an agent's terminal
+	// NudgeAgent sends a text message to an agent's terminal session
+	// (injected via tmux send-keys). Used for directing agent attention.
 	NudgeAgent(context.Context, *connect.Request[v1.NudgeAgentRequest]) (*connect.Response[v1.NudgeAgentResponse], error)
-	// PeekAgent returns recent terminal output from an agent
+	// PeekAgent captures recent terminal output from an agent's tmux session.
+	// Returns up to `lines` lines of scrollback (default 50). With `search`,
+	// greps the full scrollback server-side and returns only matching lines
+	// with context.
 	PeekAgent(context.Context, *connect.Request[v1.PeekAgentRequest]) (*connect.Response[v1.PeekAgentResponse], error)
-	// WatchAgents streams agent status updates
+	// WatchAgents streams agent status updates in real-time. Emits events
+	// when agents are spawned, started, stopped, or change state.
 	WatchAgents(context.Context, *connect.Request[v1.WatchAgentsRequest]) (*connect.ServerStreamForClient[v1.AgentUpdate], error)
+	// WatchAgentOutput streams an agent's terminal output incrementally.
+	// Successive captures are diffed server-side and only new lines are sent,
+	// so clients tailing a session don't re-download the whole pane each poll.
+	// Set backfill_lines to receive existing output before the first delta.
+	WatchAgentOutput(context.Context, *connect.Request[v1.WatchAgentOutputRequest]) (*connect.ServerStreamForClient[v1.AgentOutputChunk], error)
+	// GetAgentRecording returns a recording of an agent's terminal session,
+	// made by the daemon when session recording is enabled. Frames are
+	// AgentOutputChunks to apply in order, the same as WatchAgentOutput's.
+	GetAgentRecording(context.Context, *connect.Request[v1.GetAgentRecordingRequest]) (*connect.Response[v1.GetAgentRecordingResponse], error)
+	// ListAgentFiles lists a directory inside an agent's pod.
+	// Paths must fall under the workspace or /tmp.
+	ListAgentFiles(context.Context, *connect.Request[v1.ListAgentFilesRequest]) (*connect.Response[v1.ListAgentFilesResponse], error)
+	// FetchAgentFile streams a file out of an agent's pod in chunks, so build
+	// artifacts, logs, and diffs can be retrieved without kubectl cp.
+	// Files larger than the server's size limit are rejected.
+	FetchAgentFile(context.Context, *connect.Request[v1.FetchAgentFileRequest]) (*connect.ServerStreamForClient[v1.AgentFileChunk], error)
 	// CreateCrew creates a crew workspace by writing an agent bead.
-	// The controller watches bead events and creates the crew pod.
+	// In K8s, the controller watches bead events and creates the crew pod.
+	// Locally, creates the git worktree and tmux session.
 	CreateCrew(context.Context, *connect.Request[v1.CreateCrewRequest]) (*connect.Response[v1.CreateCrewResponse], error)
 	// RemoveCrew removes a crew workspace by closing/deleting the agent bead.
-	// The controller reacts to the bead close/delete event to remove the pod.
+	// In K8s, the controller reacts to the bead event to remove the pod.
+	// Use purge=true to delete the bead entirely (vs just closing).
 	RemoveCrew(context.Context, *connect.Request[v1.RemoveCrewRequest]) (*connect.Response[v1.RemoveCrewResponse], error)
+	// DestroyCrew tears a crew worker down completely: the session is stopped
+	// and the workspace removed. In K8s this purges the agent bead, so the
+	// controller deletes the pod and its PVC. Workspaces with uncommitted
+	// changes are refused unless force=true.
+	DestroyCrew(context.Context, *connect.Request[v1.DestroyCrewRequest]) (*connect.Response[v1.DestroyCrewResponse], error)
 }
 
 // NewAgentServiceClient constructs a client for the gastown.v1.AgentService service. By default, it
@@ -118,6 +171,12 @@ func NewAgentServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(agentServiceMethods.ByName("StartCrew")),
 			connect.WithClientOptions(opts...),
 		),
+		stopCrew: connect.NewClient[v1.StopCrewRequest, v1.StopCrewResponse](
+			httpClient,
+			baseURL+AgentServiceStopCrewProcedure,
+			connect.WithSchema(agentServiceMethods.ByName("StopCrew")),
+			connect.WithClientOptions(opts...),
+		),
 		stopAgent: connect.NewClient[v1.StopAgentRequest, v1.StopAgentResponse](
 			httpClient,
 			baseURL+AgentServiceStopAgentProcedure,
@@ -142,6 +201,30 @@ func NewAgentServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(agentServiceMethods.ByName("WatchAgents")),
 			connect.WithClientOptions(opts...),
 		),
+		watchAgentOutput: connect.NewClient[v1.WatchAgentOutputRequest, v1.AgentOutputChunk](
+			httpClient,
+			baseURL+AgentServiceWatchAgentOutputProcedure,
+			connect.WithSchema(agentServiceMethods.ByName("WatchAgentOutput")),
+			connect.WithClientOptions(opts...),
+		),
+		getAgentRecording: connect.NewClient[v1.GetAgentRecordingRequest, v1.GetAgentRecordingResponse](
+			httpClient,
+			baseURL+AgentServiceGetAgentRecordingProcedure,
+			connect.WithSchema(agentServiceMethods.ByName("GetAgentRecording")),
+			connect.WithClientOptions(opts...),
+		),
+		listAgentFiles: connect.NewClient[v1.ListAgentFilesRequest, v1.ListAgentFilesResponse](
+			httpClient,
+			baseURL+AgentServiceListAgentFilesProcedure,
+			connect.WithSchema(agentServiceMethods.ByName("ListAgentFiles")),
+			connect.WithClientOptions(opts...),
+		),
+		fetchAgentFile: connect.NewClient[v1.FetchAgentFileRequest, v1.AgentFileChunk](
+			httpClient,
+			baseURL+AgentServiceFetchAgentFileProcedure,
+			connect.WithSchema(agentServiceMethods.ByName("FetchAgentFile")),
+			connect.WithClientOptions(opts...),
+		),
 		createCrew: connect.NewClient[v1.CreateCrewRequest, v1.CreateCrewResponse](
 			httpClient,
 			baseURL+AgentServiceCreateCrewProcedure,
@@ -154,21 +237,33 @@ func NewAgentServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(agentServiceMethods.ByName("RemoveCrew")),
 			connect.WithClientOptions(opts...),
 		),
+		destroyCrew: connect.NewClient[v1.DestroyCrewRequest, v1.DestroyCrewResponse](
+			httpClient,
+			baseURL+AgentServiceDestroyCrewProcedure,
+			connect.WithSchema(agentServiceMethods.ByName("DestroyCrew")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // agentServiceClient implements AgentServiceClient.
 type agentServiceClient struct {
-	listAgents   *connect.Client[v1.ListAgentsRequest, v1.ListAgentsResponse]
-	getAgent     *connect.Client[v1.GetAgentRequest, v1.GetAgentResponse]
-	spawnPolecat *connect.Client[v1.SpawnPolecatRequest, v1.SpawnPolecatResponse]
-	startCrew    *connect.Client[v1.StartCrewRequest, v1.StartCrewResponse]
-	stopAgent    *connect.Client[v1.StopAgentRequest, v1.StopAgentResponse]
-	nudgeAgent   *connect.Client[v1.NudgeAgentRequest, v1.NudgeAgentResponse]
-	peekAgent    *connect.Client[v1.PeekAgentRequest, v1.PeekAgentResponse]
-	watchAgents  *connect.Client[v1.WatchAgentsRequest, v1.AgentUpdate]
-	createCrew   *connect.Client[v1.CreateCrewRequest, v1.CreateCrewResponse]
-	removeCrew   *connect.Client[v1.RemoveCrewRequest, v1.RemoveCrewResponse]
+	listAgents        *connect.Client[v1.ListAgentsRequest, v1.ListAgentsResponse]
+	getAgent          *connect.Client[v1.GetAgentRequest, v1.GetAgentResponse]
+	spawnPolecat      *connect.Client[v1.SpawnPolecatRequest, v1.SpawnPolecatResponse]
+	startCrew         *connect.Client[v1.StartCrewRequest, v1.StartCrewResponse]
+	stopCrew          *connect.Client[v1.StopCrewRequest, v1.StopCrewResponse]
+	stopAgent         *connect.Client[v1.StopAgentRequest, v1.StopAgentResponse]
+	nudgeAgent        *connect.Client[v1.NudgeAgentRequest, v1.NudgeAgentResponse]
+	peekAgent         *connect.Client[v1.PeekAgentRequest, v1.PeekAgentResponse]
+	watchAgents       *connect.Client[v1.WatchAgentsRequest, v1.AgentUpdate]
+	watchAgentOutput  *connect.Client[v1.WatchAgentOutputRequest, v1.AgentOutputChunk]
+	getAgentRecording *connect.Client[v1.GetAgentRecordingRequest, v1.GetAgentRecordingResponse]
+	listAgentFiles    *connect.Client[v1.ListAgentFilesRequest, v1.ListAgentFilesResponse]
+	fetchAgentFile    *connect.Client[v1.FetchAgentFileRequest, v1.AgentFileChunk]
+	createCrew        *connect.Client[v1.CreateCrewRequest, v1.CreateCrewResponse]
+	removeCrew        *connect.Client[v1.RemoveCrewRequest, v1.RemoveCrewResponse]
+	destroyCrew       *connect.Client[v1.DestroyCrewRequest, v1.DestroyCrewResponse]
 }
 
 // ListAgents calls gastown.v1.AgentService.ListAgents.
@@ -191,6 +286,11 @@ func (c *agentServiceClient) StartCrew(ctx context.Context, req *connect.Request
 	return c.startCrew.CallUnary(ctx, req)
 }
 
+// StopCrew calls gastown.v1.AgentService.StopCrew.
+func (c *agentServiceClient) StopCrew(ctx context.Context, req *connect.Request[v1.StopCrewRequest]) (*connect.Response[v1.StopCrewResponse], error) {
+	return c.stopCrew.CallUnary(ctx, req)
+}
+
 // StopAgent calls gastown.v1.AgentService.StopAgent.
 func (c *agentServiceClient) StopAgent(ctx context.Context, req *connect.Request[v1.StopAgentRequest]) (*connect.Response[v1.StopAgentResponse], error) {
 	return c.stopAgent.CallUnary(ctx, req)
@@ -211,6 +311,26 @@ func (c *agentServiceClient) WatchAgents(ctx context.Context, req *connect.Reque
 	return c.watchAgents.CallServerStream(ctx, req)
 }
 
+// WatchAgentOutput calls gastown.v1.AgentService.WatchAgentOutput.
+func (c *agentServiceClient) WatchAgentOutput(ctx context.Context, req *connect.Request[v1.WatchAgentOutputRequest]) (*connect.ServerStreamForClient[v1.AgentOutputChunk], error) {
+	return c.watchAgentOutput.CallServerStream(ctx, req)
+}
+
+// GetAgentRecording calls gastown.v1.AgentService.GetAgentRecording.
+func (c *agentServiceClient) GetAgentRecording(ctx context.Context, req *connect.Request[v1.GetAgentRecordingRequest]) (*connect.Response[v1.GetAgentRecordingResponse], error) {
+	return c.getAgentRecording.CallUnary(ctx, req)
+}
+
+// ListAgentFiles calls gastown.v1.AgentService.ListAgentFiles.
+func (c *agentServiceClient) ListAgentFiles(ctx context.Context, req *connect.Request[v1.ListAgentFilesRequest]) (*connect.Response[v1.ListAgentFilesResponse], error) {
+	return c.listAgentFiles.CallUnary(ctx, req)
+}
+
+// FetchAgentFile calls gastown.v1.AgentService.FetchAgentFile.
+func (c *agentServiceClient) FetchAgentFile(ctx context.Context, req *connect.Request[v1.FetchAgentFileRequest]) (*connect.ServerStreamForClient[v1.AgentFileChunk], error) {
+	return c.fetchAgentFile.CallServerStream(ctx, req)
+}
+
 // CreateCrew calls gastown.v1.AgentService.CreateCrew.
 func (c *agentServiceClient) CreateCrew(ctx context.Context, req *connect.Request[v1.CreateCrewRequest]) (*connect.Response[v1.CreateCrewResponse], error) {
 	return c.createCrew.CallUnary(ctx, req)
@@ -221,30 +341,71 @@ func (c *agentServiceClient) RemoveCrew(ctx context.Context, req *connect.Reques
 	return c.removeCrew.CallUnary(ctx, req)
 }
 
+// DestroyCrew calls gastown.v1.AgentService.DestroyCrew.
+func (c *agentServiceClient) DestroyCrew(ctx context.Context, req *connect.Request[v1.DestroyCrewRequest]) (*connect.Response[v1.DestroyCrewResponse], error) {
+	return c.destroyCrew.CallUnary(ctx, req)
+}
+
 // AgentServiceHandler is an implementation of the gastown.v1.AgentService service.
 type AgentServiceHandler interface {
-	// ListAgents returns all agents (crew + polecats) in a rig or town
+	// ListAgents returns all agents in a rig or across the town.
+	// Filter by rig, type, state, and hooked work; sort; and use a field mask
+	// so heavy fields (hooked title, unread mail) are only computed on request.
 	ListAgents(context.Context, *connect.Request[v1.ListAgentsRequest]) (*connect.Response[v1.ListAgentsResponse], error)
-	// GetAgent returns details for a specific agent
+	// GetAgent returns details for a specific agent including recent terminal output.
 	GetAgent(context.Context, *connect.Request[v1.GetAgentRequest]) (*connect.Response[v1.GetAgentResponse], error)
-	// SpawnPolecat creates a new polecat in a rig
+	// SpawnPolecat creates a new ephemeral polecat agent in a rig.
+	// Optionally hooks a bead immediately and starts a Claude Code session.
 	SpawnPolecat(context.Context, *connect.Request[v1.SpawnPolecatRequest]) (*connect.Response[v1.SpawnPolecatResponse], error)
-	// StartCrew starts a crew worker session
+	// StartCrew starts (or restarts) a crew worker's Claude Code session.
+	// If create=true and the crew doesn't exist, creates it first.
 	StartCrew(context.Context, *connect.Request[v1.StartCrewRequest]) (*connect.Response[v1.StartCrewResponse], error)
-	// StopAgent stops an agent's session
+	// StopCrew stops a crew worker's session, keeping its workspace so it
+	// can be started again with StartCrew.
+	StopCrew(context.Context, *connect.Request[v1.StopCrewRequest]) (*connect.Response[v1.StopCrewResponse], error)
+	// StopAgent stops an agent's session. If the agent has incomplete work,
+	// returns had_incomplete_work=true. Use force=true to stop anyway.
 	StopAgent(context.Context, *connect.Request[v1.StopAgentRequest]) (*connect.Response[v1.StopAgentResponse], error)
-	// NudgeAgent sends a message to an agent's terminal
+	// NudgeAgent sends a text message to an agent's terminal session
+	// (injected via tmux send-keys). Used for directing agent attention.
 	NudgeAgent(context.Context, *connect.Request[v1.NudgeAgentRequest]) (*connect.Response[v1.NudgeAgentResponse], error)
-	// PeekAgent returns recent terminal output from an agent
+	// PeekAgent captures recent terminal output from an agent's tmux session.
+	// Returns up to `lines` lines of scrollback (default 50). With `search`,
+	// greps the full scrollback server-side and returns only matching lines
+	// with context.
 	PeekAgent(context.Context, *connect.Request[v1.PeekAgentRequest]) (*connect.Response[v1.PeekAgentResponse], error)
-	// WatchAgents streams agent status updates
+	// WatchAgents streams agent status updates in real-time. Emits events
+	// when agents are spawned, started, stopped, or change state.
 	WatchAgents(context.Context, *connect.Request[v1.WatchAgentsRequest], *connect.ServerStream[v1.AgentUpdate]) error
+	// WatchAgentOutput streams an agent's terminal output incrementally.
+	// Successive captures are diffed server-side and only new lines are sent,
+	// so clients tailing a session don't re-download the whole pane each poll.
+	// Set backfill_lines to receive existing output before the first delta.
+	WatchAgentOutput(context.Context, *connect.Request[v1.WatchAgentOutputRequest], *connect.ServerStream[v1.AgentOutputChunk]) error
+	// GetAgentRecording returns a recording of an agent's terminal session,
+	// made by the daemon when session recording is enabled. Frames are
+	// AgentOutputChunks to apply in order, the same as WatchAgentOutput's.
+	GetAgentRecording(context.Context, *connect.Request[v1.GetAgentRecordingRequest]) (*connect.Response[v1.GetAgentRecordingResponse], error)
+	// ListAgentFiles lists a directory inside an agent's pod.
+	// Paths must fall under the workspace or /tmp.
+	ListAgentFiles(context.Context, *connect.Request[v1.ListAgentFilesRequest]) (*connect.Response[v1.ListAgentFilesResponse], error)
+	// FetchAgentFile streams a file out of an agent's pod in chunks, so build
+	// artifacts, logs, and diffs can be retrieved without kubectl cp.
+	// Files larger than the server's size limit are rejected.
+	FetchAgentFile(context.Context, *connect.Request[v1.FetchAgentFileRequest], *connect.ServerStream[v1.AgentFileChunk]) error
 	// CreateCrew creates a crew workspace by writing an agent bead.
-	// The controller watches bead events and creates the crew pod.
+	// In K8s, the controller watches bead events and creates the crew pod.
+	// Locally, creates the git worktree and tmux session.
 	CreateCrew(context.Context, *connect.Request[v1.CreateCrewRequest]) (*connect.Response[v1.CreateCrewResponse], error)
 	// RemoveCrew removes a crew workspace by closing/deleting the agent bead.
-	// The controller reacts to the bead close/delete event to remove the pod.
+	// In K8s, the controller reacts to the bead event to remove the pod.
+	// Use purge=true to delete the bead entirely (vs just closing).
 	RemoveCrew(context.Context, *connect.Request[v1.RemoveCrewRequest]) (*connect.Response[v1.RemoveCrewResponse], error)
+	// DestroyCrew tears a crew worker down completely: the session is stopped
+	// and the workspace removed. In K8s this purges the agent bead, so the
+	// controller deletes the pod and its PVC. Workspaces with uncommitted
+	// changes are refused unless force=true.
+	DestroyCrew(context.Context, *connect.Request[v1.DestroyCrewRequest]) (*connect.Response[v1.DestroyCrewResponse], error)
 }
 
 // NewAgentServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -278,6 +439,12 @@ func NewAgentServiceHandler(svc AgentServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(agentServiceMethods.ByName("StartCrew")),
 		connect.WithHandlerOptions(opts...),
 	)
+	agentServiceStopCrewHandler := connect.NewUnaryHandler(
+		AgentServiceStopCrewProcedure,
+		svc.StopCrew,
+		connect.WithSchema(agentServiceMethods.ByName("StopCrew")),
+		connect.WithHandlerOptions(opts...),
+	)
 	agentServiceStopAgentHandler := connect.NewUnaryHandler(
 		AgentServiceStopAgentProcedure,
 		svc.StopAgent,
@@ -302,6 +469,30 @@ func NewAgentServiceHandler(svc AgentServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(agentServiceMethods.ByName("WatchAgents")),
 		connect.WithHandlerOptions(opts...),
 	)
+	agentServiceWatchAgentOutputHandler := connect.NewServerStreamHandler(
+		AgentServiceWatchAgentOutputProcedure,
+		svc.WatchAgentOutput,
+		connect.WithSchema(agentServiceMethods.ByName("WatchAgentOutput")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentServiceGetAgentRecordingHandler := connect.NewUnaryHandler(
+		AgentServiceGetAgentRecordingProcedure,
+		svc.GetAgentRecording,
+		connect.WithSchema(agentServiceMethods.ByName("GetAgentRecording")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentServiceListAgentFilesHandler := connect.NewUnaryHandler(
+		AgentServiceListAgentFilesProcedure,
+		svc.ListAgentFiles,
+		connect.WithSchema(agentServiceMethods.ByName("ListAgentFiles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentServiceFetchAgentFileHandler := connect.NewServerStreamHandler(
+		AgentServiceFetchAgentFileProcedure,
+		svc.FetchAgentFile,
+		connect.WithSchema(agentServiceMethods.ByName("FetchAgentFile")),
+		connect.WithHandlerOptions(opts...),
+	)
 	agentServiceCreateCrewHandler := connect.NewUnaryHandler(
 		AgentServiceCreateCrewProcedure,
 		svc.CreateCrew,
@@ -314,6 +505,12 @@ func NewAgentServiceHandler(svc AgentServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(agentServiceMethods.ByName("RemoveCrew")),
 		connect.WithHandlerOptions(opts...),
 	)
+	agentServiceDestroyCrewHandler := connect.NewUnaryHandler(
+		AgentServiceDestroyCrewProcedure,
+		svc.DestroyCrew,
+		connect.WithSchema(agentServiceMethods.ByName("DestroyCrew")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/gastown.v1.AgentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AgentServiceListAgentsProcedure:
@@ -324,6 +521,8 @@ func NewAgentServiceHandler(svc AgentServiceHandler, opts ...connect.HandlerOpti
 			agentServiceSpawnPolecatHandler.ServeHTTP(w, r)
 		case AgentServiceStartCrewProcedure:
 			agentServiceStartCrewHandler.ServeHTTP(w, r)
+		case AgentServiceStopCrewProcedure:
+			agentServiceStopCrewHandler.ServeHTTP(w, r)
 		case AgentServiceStopAgentProcedure:
 			agentServiceStopAgentHandler.ServeHTTP(w, r)
 		case AgentServiceNudgeAgentProcedure:
@@ -332,10 +531,20 @@ func NewAgentServiceHandler(svc AgentServiceHandler, opts ...connect.HandlerOpti
 			agentServicePeekAgentHandler.ServeHTTP(w, r)
 		case AgentServiceWatchAgentsProcedure:
 			agentServiceWatchAgentsHandler.ServeHTTP(w, r)
+		case AgentServiceWatchAgentOutputProcedure:
+			agentServiceWatchAgentOutputHandler.ServeHTTP(w, r)
+		case AgentServiceGetAgentRecordingProcedure:
+			agentServiceGetAgentRecordingHandler.ServeHTTP(w, r)
+		case AgentServiceListAgentFilesProcedure:
+			agentServiceListAgentFilesHandler.ServeHTTP(w, r)
+		case AgentServiceFetchAgentFileProcedure:
+			agentServiceFetchAgentFileHandler.ServeHTTP(w, r)
 		case AgentServiceCreateCrewProcedure:
 			agentServiceCreateCrewHandler.ServeHTTP(w, r)
 		case AgentServiceRemoveCrewProcedure:
 			agentServiceRemoveCrewHandler.ServeHTTP(w, r)
+		case AgentServiceDestroyCrewProcedure:
+			agentServiceDestroyCrewHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -361,6 +570,10 @@ func (UnimplementedAgentServiceHandler) StartCrew(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.AgentService.StartCrew is not implemented"))
 }
 
+func (UnimplementedAgentServiceHandler) StopCrew(context.Context, *connect.Request[v1.StopCrewRequest]) (*connect.Response[v1.StopCrewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.AgentService.StopCrew is not implemented"))
+}
+
 func (UnimplementedAgentServiceHandler) StopAgent(context.Context, *connect.Request[v1.StopAgentRequest]) (*connect.Response[v1.StopAgentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.AgentService.StopAgent is not implemented"))
 }
@@ -377,6 +590,22 @@ func (UnimplementedAgentServiceHandler) WatchAgents(context.Context, *connect.Re
 	return connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.AgentService.WatchAgents is not implemented"))
 }
 
+func (UnimplementedAgentServiceHandler) WatchAgentOutput(context.Context, *connect.Request[v1.WatchAgentOutputRequest], *connect.ServerStream[v1.AgentOutputChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.AgentService.WatchAgentOutput is not implemented"))
+}
+
+func (UnimplementedAgentServiceHandler) GetAgentRecording(context.Context, *connect.Request[v1.GetAgentRecordingRequest]) (*connect.Response[v1.GetAgentRecordingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.AgentService.GetAgentRecording is not implemented"))
+}
+
+func (UnimplementedAgentServiceHandler) ListAgentFiles(context.Context, *connect.Request[v1.ListAgentFilesRequest]) (*connect.Response[v1.ListAgentFilesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.AgentService.ListAgentFiles is not implemented"))
+}
+
+func (UnimplementedAgentServiceHandler) FetchAgentFile(context.Context, *connect.Request[v1.FetchAgentFileRequest], *connect.ServerStream[v1.AgentFileChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.AgentService.FetchAgentFile is not implemented"))
+}
+
 func (UnimplementedAgentServiceHandler) CreateCrew(context.Context, *connect.Request[v1.CreateCrewRequest]) (*connect.Response[v1.CreateCrewResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.AgentService.CreateCrew is not implemented"))
 }
@@ -384,3 +613,7 @@ func (UnimplementedAgentServiceHandler) CreateCrew(context.Context, *connect.Req
 func (UnimplementedAgentServiceHandler) RemoveCrew(context.Context, *connect.Request[v1.RemoveCrewRequest]) (*connect.Response[v1.RemoveCrewResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.AgentService.RemoveCrew is not implemented"))
 }
+
+func (UnimplementedAgentServiceHandler) DestroyCrew(context.Context, *connect.Request[v1.DestroyCrewRequest]) (*connect.Response[v1.DestroyCrewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.AgentService.DestroyCrew is not implemented"))
+}
@@ -43,6 +43,8 @@ const (
 	// BeadsServiceUpdateIssueProcedure is the fully-qualified name of the BeadsService's UpdateIssue
 	// RPC.
 	BeadsServiceUpdateIssueProcedure = "/gastown.v1.BeadsService/UpdateIssue"
+	// BeadsServiceCloseIssueProcedure is the fully-qualified name of the BeadsService's CloseIssue RPC.
+	BeadsServiceCloseIssueProcedure = "/gastown.v1.BeadsService/CloseIssue"
 	// BeadsServiceCloseIssuesProcedure is the fully-qualified name of the BeadsService's CloseIssues
 	// RPC.
 	BeadsServiceCloseIssuesProcedure = "/gastown.v1.BeadsService/CloseIssues"
@@ -81,37 +83,58 @@ const (
 
 // BeadsServiceClient is a client for the gastown.v1.BeadsService service.
 type BeadsServiceClient interface {
-	// ListIssues returns issues matching filters
+	// ListIssues returns issues matching the provided filters.
+	// Supports pagination via limit/offset. Results are ordered by priority
+	// then creation time. Use status/type/label/assignee filters to narrow results.
 	ListIssues(context.Context, *connect.Request[v1.ListIssuesRequest]) (*connect.Response[v1.ListIssuesResponse], error)
-	// GetIssue returns a single issue by ID
+	// GetIssue returns a single issue by ID with full details including
+	// dependency lists, labels, and child issue IDs.
 	GetIssue(context.Context, *connect.Request[v1.GetIssueRequest]) (*connect.Response[v1.GetIssueResponse], error)
-	// CreateIssue creates a new issue
+	// CreateIssue creates a new issue. Returns the created issue with
+	// its auto-generated hash-based ID (e.g., "gt-abc123").
+	// Set the `id` field to override auto-generation (for deterministic IDs).
 	CreateIssue(context.Context, *connect.Request[v1.CreateIssueRequest]) (*connect.Response[v1.CreateIssueResponse], error)
-	// UpdateIssue updates an existing issue
+	// UpdateIssue updates an existing issue. Only fields that are set in the
+	// request are modified — omitted fields are left unchanged.
+	// Label management supports add/remove/set-all semantics.
 	UpdateIssue(context.Context, *connect.Request[v1.UpdateIssueRequest]) (*connect.Response[v1.UpdateIssueResponse], error)
-	// CloseIssues closes one or more issues
+	// CloseIssue closes a single issue and returns it as closed.
+	CloseIssue(context.Context, *connect.Request[v1.CloseIssueRequest]) (*connect.Response[v1.CloseIssueResponse], error)
+	// CloseIssues closes one or more issues atomically.
+	// Returns the count of successfully closed issues and any failed IDs.
 	CloseIssues(context.Context, *connect.Request[v1.CloseIssuesRequest]) (*connect.Response[v1.CloseIssuesResponse], error)
-	// ReopenIssues reopens one or more closed issues
+	// ReopenIssues reopens one or more previously closed issues.
 	ReopenIssues(context.Context, *connect.Request[v1.ReopenIssuesRequest]) (*connect.Response[v1.ReopenIssuesResponse], error)
-	// SearchIssues searches issues by text query
+	// SearchIssues performs full-text search across issue IDs, titles and
+	// descriptions, served from an in-memory index kept by the server.
+	// The query combines words, "quoted phrases" and label:, assignee:,
+	// type: and status: filters with the request's own filters.
+	// Results are ranked by relevance, then priority.
 	SearchIssues(context.Context, *connect.Request[v1.SearchIssuesRequest]) (*connect.Response[v1.SearchIssuesResponse], error)
-	// GetReadyIssues returns issues ready to work (not blocked)
+	// GetReadyIssues returns open issues with no unresolved blocking dependencies.
+	// These are the issues agents should pick up next. Results are ordered by
+	// priority (lowest number = highest priority).
 	GetReadyIssues(context.Context, *connect.Request[v1.GetReadyIssuesRequest]) (*connect.Response[v1.GetReadyIssuesResponse], error)
-	// GetBlockedIssues returns issues blocked by dependencies
+	// GetBlockedIssues returns issues that have unresolved blocking dependencies.
 	GetBlockedIssues(context.Context, *connect.Request[v1.GetBlockedIssuesRequest]) (*connect.Response[v1.GetBlockedIssuesResponse], error)
-	// AddDependency adds a dependency between issues
+	// AddDependency creates a dependency relationship between two issues.
+	// Supports depends-on, blocks, and tracks relationship types.
+	// Circular dependency detection prevents invalid graphs.
 	AddDependency(context.Context, *connect.Request[v1.AddDependencyRequest]) (*connect.Response[v1.AddDependencyResponse], error)
-	// RemoveDependency removes a dependency between issues
+	// RemoveDependency removes a dependency relationship between two issues.
 	RemoveDependency(context.Context, *connect.Request[v1.RemoveDependencyRequest]) (*connect.Response[v1.RemoveDependencyResponse], error)
-	// ListDependencies lists dependencies for an issue
+	// ListDependencies returns issues related to the given issue via dependencies.
+	// Direction "up" returns what this issue depends on; "down" returns dependents.
 	ListDependencies(context.Context, *connect.Request[v1.ListDependenciesRequest]) (*connect.Response[v1.ListDependenciesResponse], error)
-	// AddComment adds a comment to an issue
+	// AddComment adds a text comment to an issue, attributed to the given author.
 	AddComment(context.Context, *connect.Request[v1.AddCommentRequest]) (*connect.Response[v1.AddCommentResponse], error)
-	// ListComments lists comments on an issue
+	// ListComments returns comments on an issue, ordered by creation time.
 	ListComments(context.Context, *connect.Request[v1.ListCommentsRequest]) (*connect.Response[v1.ListCommentsResponse], error)
-	// ManageLabels adds or removes labels from an issue
+	// ManageLabels atomically adds and/or removes labels from an issue.
+	// Returns the updated label set.
 	ManageLabels(context.Context, *connect.Request[v1.ManageLabelsRequest]) (*connect.Response[v1.ManageLabelsResponse], error)
-	// GetStats returns repository statistics
+	// GetStats returns aggregate repository statistics: total, open, closed,
+	// in-progress, and blocked issue counts.
 	GetStats(context.Context, *connect.Request[v1.GetStatsRequest]) (*connect.Response[v1.GetStatsResponse], error)
 }
 
@@ -150,6 +173,12 @@ func NewBeadsServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(beadsServiceMethods.ByName("UpdateIssue")),
 			connect.WithClientOptions(opts...),
 		),
+		closeIssue: connect.NewClient[v1.CloseIssueRequest, v1.CloseIssueResponse](
+			httpClient,
+			baseURL+BeadsServiceCloseIssueProcedure,
+			connect.WithSchema(beadsServiceMethods.ByName("CloseIssue")),
+			connect.WithClientOptions(opts...),
+		),
 		closeIssues: connect.NewClient[v1.CloseIssuesRequest, v1.CloseIssuesResponse](
 			httpClient,
 			baseURL+BeadsServiceCloseIssuesProcedure,
@@ -231,6 +260,7 @@ type beadsServiceClient struct {
 	getIssue         *connect.Client[v1.GetIssueRequest, v1.GetIssueResponse]
 	createIssue      *connect.Client[v1.CreateIssueRequest, v1.CreateIssueResponse]
 	updateIssue      *connect.Client[v1.UpdateIssueRequest, v1.UpdateIssueResponse]
+	closeIssue       *connect.Client[v1.CloseIssueRequest, v1.CloseIssueResponse]
 	closeIssues      *connect.Client[v1.CloseIssuesRequest, v1.CloseIssuesResponse]
 	reopenIssues     *connect.Client[v1.ReopenIssuesRequest, v1.ReopenIssuesResponse]
 	searchIssues     *connect.Client[v1.SearchIssuesRequest, v1.SearchIssuesResponse]
@@ -265,6 +295,11 @@ func (c *beadsServiceClient) UpdateIssue(ctx context.Context, req *connect.Reque
 	return c.updateIssue.CallUnary(ctx, req)
 }
 
+// CloseIssue calls gastown.v1.BeadsService.CloseIssue.
+func (c *beadsServiceClient) CloseIssue(ctx context.Context, req *connect.Request[v1.CloseIssueRequest]) (*connect.Response[v1.CloseIssueResponse], error) {
+	return c.closeIssue.CallUnary(ctx, req)
+}
+
 // CloseIssues calls gastown.v1.BeadsService.CloseIssues.
 func (c *beadsServiceClient) CloseIssues(ctx context.Context, req *connect.Request[v1.CloseIssuesRequest]) (*connect.Response[v1.CloseIssuesResponse], error) {
 	return c.closeIssues.CallUnary(ctx, req)
@@ -327,37 +362,58 @@ func (c *beadsServiceClient) GetStats(ctx context.Context, req *connect.Request[
 
 // BeadsServiceHandler is an implementation of the gastown.v1.BeadsService service.
 type BeadsServiceHandler interface {
-	// ListIssues returns issues matching filters
+	// ListIssues returns issues matching the provided filters.
+	// Supports pagination via limit/offset. Results are ordered by priority
+	// then creation time. Use status/type/label/assignee filters to narrow results.
 	ListIssues(context.Context, *connect.Request[v1.ListIssuesRequest]) (*connect.Response[v1.ListIssuesResponse], error)
-	// GetIssue returns a single issue by ID
+	// GetIssue returns a single issue by ID with full details including
+	// dependency lists, labels, and child issue IDs.
 	GetIssue(context.Context, *connect.Request[v1.GetIssueRequest]) (*connect.Response[v1.GetIssueResponse], error)
-	// CreateIssue creates a new issue
+	// CreateIssue creates a new issue. Returns the created issue with
+	// its auto-generated hash-based ID (e.g., "gt-abc123").
+	// Set the `id` field to override auto-generation (for deterministic IDs).
 	CreateIssue(context.Context, *connect.Request[v1.CreateIssueRequest]) (*connect.Response[v1.CreateIssueResponse], error)
-	// UpdateIssue updates an existing issue
+	// UpdateIssue updates an existing issue. Only fields that are set in the
+	// request are modified — omitted fields are left unchanged.
+	// Label management supports add/remove/set-all semantics.
 	UpdateIssue(context.Context, *connect.Request[v1.UpdateIssueRequest]) (*connect.Response[v1.UpdateIssueResponse], error)
-	// CloseIssues closes one or more issues
+	// CloseIssue closes a single issue and returns it as closed.
+	CloseIssue(context.Context, *connect.Request[v1.CloseIssueRequest]) (*connect.Response[v1.CloseIssueResponse], error)
+	// CloseIssues closes one or more issues atomically.
+	// Returns the count of successfully closed issues and any failed IDs.
 	CloseIssues(context.Context, *connect.Request[v1.CloseIssuesRequest]) (*connect.Response[v1.CloseIssuesResponse], error)
-	// ReopenIssues reopens one or more closed issues
+	// ReopenIssues reopens one or more previously closed issues.
 	ReopenIssues(context.Context, *connect.Request[v1.ReopenIssuesRequest]) (*connect.Response[v1.ReopenIssuesResponse], error)
-	// SearchIssues searches issues by text query
+	// SearchIssues performs full-text search across issue IDs, titles and
+	// descriptions, served from an in-memory index kept by the server.
+	// The query combines words, "quoted phrases" and label:, assignee:,
+	// type: and status: filters with the request's own filters.
+	// Results are ranked by relevance, then priority.
 	SearchIssues(context.Context, *connect.Request[v1.SearchIssuesRequest]) (*connect.Response[v1.SearchIssuesResponse], error)
-	// GetReadyIssues returns issues ready to work (not blocked)
+	// GetReadyIssues returns open issues with no unresolved blocking dependencies.
+	// These are the issues agents should pick up next. Results are ordered by
+	// priority (lowest number = highest priority).
 	GetReadyIssues(context.Context, *connect.Request[v1.GetReadyIssuesRequest]) (*connect.Response[v1.GetReadyIssuesResponse], error)
-	// GetBlockedIssues returns issues blocked by dependencies
+	// GetBlockedIssues returns issues that have unresolved blocking dependencies.
 	GetBlockedIssues(context.Context, *connect.Request[v1.GetBlockedIssuesRequest]) (*connect.Response[v1.GetBlockedIssuesResponse], error)
-	// AddDependency adds a dependency between issues
+	// AddDependency creates a dependency relationship between two issues.
+	// Supports depends-on, blocks, and tracks relationship types.
+	// Circular dependency detection prevents invalid graphs.
 	AddDependency(context.Context, *connect.Request[v1.AddDependencyRequest]) (*connect.Response[v1.AddDependencyResponse], error)
-	// RemoveDependency removes a dependency between issues
+	// RemoveDependency removes a dependency relationship between two issues.
 	RemoveDependency(context.Context, *connect.Request[v1.RemoveDependencyRequest]) (*connect.Response[v1.RemoveDependencyResponse], error)
-	// ListDependencies lists dependencies for an issue
+	// ListDependencies returns issues related to the given issue via dependencies.
+	// Direction "up" returns what this issue depends on; "down" returns dependents.
 	ListDependencies(context.Context, *connect.Request[v1.ListDependenciesRequest]) (*connect.Response[v1.ListDependenciesResponse], error)
-	// AddComment adds a comment to an issue
+	// AddComment adds a text comment to an issue, attributed to the given author.
 	AddComment(context.Context, *connect.Request[v1.AddCommentRequest]) (*connect.Response[v1.AddCommentResponse], error)
-	// ListComments lists comments on an issue
+	// ListComments returns comments on an issue, ordered by creation time.
 	ListComments(context.Context, *connect.Request[v1.ListCommentsRequest]) (*connect.Response[v1.ListCommentsResponse], error)
-	// ManageLabels adds or removes labels from an issue
+	// ManageLabels atomically adds and/or removes labels from an issue.
+	// Returns the updated label set.
 	ManageLabels(context.Context, *connect.Request[v1.ManageLabelsRequest]) (*connect.Response[v1.ManageLabelsResponse], error)
-	// GetStats returns repository statistics
+	// GetStats returns aggregate repository statistics: total, open, closed,
+	// in-progress, and blocked issue counts.
 	GetStats(context.Context, *connect.Request[v1.GetStatsRequest]) (*connect.Response[v1.GetStatsResponse], error)
 }
 
@@ -392,6 +448,12 @@ func NewBeadsServiceHandler(svc BeadsServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(beadsServiceMethods.ByName("UpdateIssue")),
 		connect.WithHandlerOptions(opts...),
 	)
+	beadsServiceCloseIssueHandler := connect.NewUnaryHandler(
+		BeadsServiceCloseIssueProcedure,
+		svc.CloseIssue,
+		connect.WithSchema(beadsServiceMethods.ByName("CloseIssue")),
+		connect.WithHandlerOptions(opts...),
+	)
 	beadsServiceCloseIssuesHandler := connect.NewUnaryHandler(
 		BeadsServiceCloseIssuesProcedure,
 		svc.CloseIssues,
@@ -474,6 +536,8 @@ func NewBeadsServiceHandler(svc BeadsServiceHandler, opts ...connect.HandlerOpti
 			beadsServiceCreateIssueHandler.ServeHTTP(w, r)
 		case BeadsServiceUpdateIssueProcedure:
 			beadsServiceUpdateIssueHandler.ServeHTTP(w, r)
+		case BeadsServiceCloseIssueProcedure:
+			beadsServiceCloseIssueHandler.ServeHTTP(w, r)
 		case BeadsServiceCloseIssuesProcedure:
 			beadsServiceCloseIssuesHandler.ServeHTTP(w, r)
 		case BeadsServiceReopenIssuesProcedure:
@@ -523,6 +587,10 @@ func (UnimplementedBeadsServiceHandler) UpdateIssue(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.BeadsService.UpdateIssue is not implemented"))
 }
 
+func (UnimplementedBeadsServiceHandler) CloseIssue(context.Context, *connect.Request[v1.CloseIssueRequest]) (*connect.Response[v1.CloseIssueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.BeadsService.CloseIssue is not implemented"))
+}
+
 func (UnimplementedBeadsServiceHandler) CloseIssues(context.Context, *connect.Request[v1.CloseIssuesRequest]) (*connect.Response[v1.CloseIssuesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.BeadsService.CloseIssues is not implemented"))
 }
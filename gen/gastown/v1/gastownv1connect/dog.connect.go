@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: gastown/v1/dog.proto
+
+package gastownv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DogServiceName is the fully-qualified name of the DogService service.
+	DogServiceName = "gastown.v1.DogService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DogServiceListDogsProcedure is the fully-qualified name of the DogService's ListDogs RPC.
+	DogServiceListDogsProcedure = "/gastown.v1.DogService/ListDogs"
+	// DogServiceGetDogProcedure is the fully-qualified name of the DogService's GetDog RPC.
+	DogServiceGetDogProcedure = "/gastown.v1.DogService/GetDog"
+	// DogServiceAssignDogProcedure is the fully-qualified name of the DogService's AssignDog RPC.
+	DogServiceAssignDogProcedure = "/gastown.v1.DogService/AssignDog"
+	// DogServiceRecallDogProcedure is the fully-qualified name of the DogService's RecallDog RPC.
+	DogServiceRecallDogProcedure = "/gastown.v1.DogService/RecallDog"
+	// DogServiceGetDogLogsProcedure is the fully-qualified name of the DogService's GetDogLogs RPC.
+	DogServiceGetDogLogsProcedure = "/gastown.v1.DogService/GetDogLogs"
+)
+
+// DogServiceClient is a client for the gastown.v1.DogService service.
+type DogServiceClient interface {
+	// ListDogs returns every dog in the kennel, by name.
+	ListDogs(context.Context, *connect.Request[v1.ListDogsRequest]) (*connect.Response[v1.ListDogsResponse], error)
+	// GetDog returns one dog's state and session status.
+	GetDog(context.Context, *connect.Request[v1.GetDogRequest]) (*connect.Response[v1.GetDogResponse], error)
+	// AssignDog gives work to a dog (or any idle dog) and starts its session.
+	// Returns FailedPrecondition if the dog is busy or no dog is idle.
+	AssignDog(context.Context, *connect.Request[v1.AssignDogRequest]) (*connect.Response[v1.AssignDogResponse], error)
+	// RecallDog pulls a dog off its work, stops its session and sets it idle.
+	RecallDog(context.Context, *connect.Request[v1.RecallDogRequest]) (*connect.Response[v1.RecallDogResponse], error)
+	// GetDogLogs returns a dog's event log and recent session output.
+	GetDogLogs(context.Context, *connect.Request[v1.GetDogLogsRequest]) (*connect.Response[v1.GetDogLogsResponse], error)
+}
+
+// NewDogServiceClient constructs a client for the gastown.v1.DogService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDogServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DogServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	dogServiceMethods := v1.File_gastown_v1_dog_proto.Services().ByName("DogService").Methods()
+	return &dogServiceClient{
+		listDogs: connect.NewClient[v1.ListDogsRequest, v1.ListDogsResponse](
+			httpClient,
+			baseURL+DogServiceListDogsProcedure,
+			connect.WithSchema(dogServiceMethods.ByName("ListDogs")),
+			connect.WithClientOptions(opts...),
+		),
+		getDog: connect.NewClient[v1.GetDogRequest, v1.GetDogResponse](
+			httpClient,
+			baseURL+DogServiceGetDogProcedure,
+			connect.WithSchema(dogServiceMethods.ByName("GetDog")),
+			connect.WithClientOptions(opts...),
+		),
+		assignDog: connect.NewClient[v1.AssignDogRequest, v1.AssignDogResponse](
+			httpClient,
+			baseURL+DogServiceAssignDogProcedure,
+			connect.WithSchema(dogServiceMethods.ByName("AssignDog")),
+			connect.WithClientOptions(opts...),
+		),
+		recallDog: connect.NewClient[v1.RecallDogRequest, v1.RecallDogResponse](
+			httpClient,
+			baseURL+DogServiceRecallDogProcedure,
+			connect.WithSchema(dogServiceMethods.ByName("RecallDog")),
+			connect.WithClientOptions(opts...),
+		),
+		getDogLogs: connect.NewClient[v1.GetDogLogsRequest, v1.GetDogLogsResponse](
+			httpClient,
+			baseURL+DogServiceGetDogLogsProcedure,
+			connect.WithSchema(dogServiceMethods.ByName("GetDogLogs")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// dogServiceClient implements DogServiceClient.
+type dogServiceClient struct {
+	listDogs   *connect.Client[v1.ListDogsRequest, v1.ListDogsResponse]
+	getDog     *connect.Client[v1.GetDogRequest, v1.GetDogResponse]
+	assignDog  *connect.Client[v1.AssignDogRequest, v1.AssignDogResponse]
+	recallDog  *connect.Client[v1.RecallDogRequest, v1.RecallDogResponse]
+	getDogLogs *connect.Client[v1.GetDogLogsRequest, v1.GetDogLogsResponse]
+}
+
+// ListDogs calls gastown.v1.DogService.ListDogs.
+func (c *dogServiceClient) ListDogs(ctx context.Context, req *connect.Request[v1.ListDogsRequest]) (*connect.Response[v1.ListDogsResponse], error) {
+	return c.listDogs.CallUnary(ctx, req)
+}
+
+// GetDog calls gastown.v1.DogService.GetDog.
+func (c *dogServiceClient) GetDog(ctx context.Context, req *connect.Request[v1.GetDogRequest]) (*connect.Response[v1.GetDogResponse], error) {
+	return c.getDog.CallUnary(ctx, req)
+}
+
+// AssignDog calls gastown.v1.DogService.AssignDog.
+func (c *dogServiceClient) AssignDog(ctx context.Context, req *connect.Request[v1.AssignDogRequest]) (*connect.Response[v1.AssignDogResponse], error) {
+	return c.assignDog.CallUnary(ctx, req)
+}
+
+// RecallDog calls gastown.v1.DogService.RecallDog.
+func (c *dogServiceClient) RecallDog(ctx context.Context, req *connect.Request[v1.RecallDogRequest]) (*connect.Response[v1.RecallDogResponse], error) {
+	return c.recallDog.CallUnary(ctx, req)
+}
+
+// GetDogLogs calls gastown.v1.DogService.GetDogLogs.
+func (c *dogServiceClient) GetDogLogs(ctx context.Context, req *connect.Request[v1.GetDogLogsRequest]) (*connect.Response[v1.GetDogLogsResponse], error) {
+	return c.getDogLogs.CallUnary(ctx, req)
+}
+
+// DogServiceHandler is an implementation of the gastown.v1.DogService service.
+type DogServiceHandler interface {
+	// ListDogs returns every dog in the kennel, by name.
+	ListDogs(context.Context, *connect.Request[v1.ListDogsRequest]) (*connect.Response[v1.ListDogsResponse], error)
+	// GetDog returns one dog's state and session status.
+	GetDog(context.Context, *connect.Request[v1.GetDogRequest]) (*connect.Response[v1.GetDogResponse], error)
+	// AssignDog gives work to a dog (or any idle dog) and starts its session.
+	// Returns FailedPrecondition if the dog is busy or no dog is idle.
+	AssignDog(context.Context, *connect.Request[v1.AssignDogRequest]) (*connect.Response[v1.AssignDogResponse], error)
+	// RecallDog pulls a dog off its work, stops its session and sets it idle.
+	RecallDog(context.Context, *connect.Request[v1.RecallDogRequest]) (*connect.Response[v1.RecallDogResponse], error)
+	// GetDogLogs returns a dog's event log and recent session output.
+	GetDogLogs(context.Context, *connect.Request[v1.GetDogLogsRequest]) (*connect.Response[v1.GetDogLogsResponse], error)
+}
+
+// NewDogServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDogServiceHandler(svc DogServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	dogServiceMethods := v1.File_gastown_v1_dog_proto.Services().ByName("DogService").Methods()
+	dogServiceListDogsHandler := connect.NewUnaryHandler(
+		DogServiceListDogsProcedure,
+		svc.ListDogs,
+		connect.WithSchema(dogServiceMethods.ByName("ListDogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dogServiceGetDogHandler := connect.NewUnaryHandler(
+		DogServiceGetDogProcedure,
+		svc.GetDog,
+		connect.WithSchema(dogServiceMethods.ByName("GetDog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dogServiceAssignDogHandler := connect.NewUnaryHandler(
+		DogServiceAssignDogProcedure,
+		svc.AssignDog,
+		connect.WithSchema(dogServiceMethods.ByName("AssignDog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dogServiceRecallDogHandler := connect.NewUnaryHandler(
+		DogServiceRecallDogProcedure,
+		svc.RecallDog,
+		connect.WithSchema(dogServiceMethods.ByName("RecallDog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dogServiceGetDogLogsHandler := connect.NewUnaryHandler(
+		DogServiceGetDogLogsProcedure,
+		svc.GetDogLogs,
+		connect.WithSchema(dogServiceMethods.ByName("GetDogLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/gastown.v1.DogService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DogServiceListDogsProcedure:
+			dogServiceListDogsHandler.ServeHTTP(w, r)
+		case DogServiceGetDogProcedure:
+			dogServiceGetDogHandler.ServeHTTP(w, r)
+		case DogServiceAssignDogProcedure:
+			dogServiceAssignDogHandler.ServeHTTP(w, r)
+		case DogServiceRecallDogProcedure:
+			dogServiceRecallDogHandler.ServeHTTP(w, r)
+		case DogServiceGetDogLogsProcedure:
+			dogServiceGetDogLogsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDogServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDogServiceHandler struct{}
+
+func (UnimplementedDogServiceHandler) ListDogs(context.Context, *connect.Request[v1.ListDogsRequest]) (*connect.Response[v1.ListDogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.DogService.ListDogs is not implemented"))
+}
+
+func (UnimplementedDogServiceHandler) GetDog(context.Context, *connect.Request[v1.GetDogRequest]) (*connect.Response[v1.GetDogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.DogService.GetDog is not implemented"))
+}
+
+func (UnimplementedDogServiceHandler) AssignDog(context.Context, *connect.Request[v1.AssignDogRequest]) (*connect.Response[v1.AssignDogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.DogService.AssignDog is not implemented"))
+}
+
+func (UnimplementedDogServiceHandler) RecallDog(context.Context, *connect.Request[v1.RecallDogRequest]) (*connect.Response[v1.RecallDogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.DogService.RecallDog is not implemented"))
+}
+
+func (UnimplementedDogServiceHandler) GetDogLogs(context.Context, *connect.Request[v1.GetDogLogsRequest]) (*connect.Response[v1.GetDogLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.DogService.GetDogLogs is not implemented"))
+}
@@ -0,0 +1,237 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: gastown/v1/escalation.proto
+
+package gastownv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// EscalationServiceName is the fully-qualified name of the EscalationService service.
+	EscalationServiceName = "gastown.v1.EscalationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// EscalationServiceListEscalationsProcedure is the fully-qualified name of the EscalationService's
+	// ListEscalations RPC.
+	EscalationServiceListEscalationsProcedure = "/gastown.v1.EscalationService/ListEscalations"
+	// EscalationServiceGetEscalationProcedure is the fully-qualified name of the EscalationService's
+	// GetEscalation RPC.
+	EscalationServiceGetEscalationProcedure = "/gastown.v1.EscalationService/GetEscalation"
+	// EscalationServiceAckEscalationProcedure is the fully-qualified name of the EscalationService's
+	// AckEscalation RPC.
+	EscalationServiceAckEscalationProcedure = "/gastown.v1.EscalationService/AckEscalation"
+	// EscalationServiceAssignEscalationProcedure is the fully-qualified name of the EscalationService's
+	// AssignEscalation RPC.
+	EscalationServiceAssignEscalationProcedure = "/gastown.v1.EscalationService/AssignEscalation"
+	// EscalationServiceResolveEscalationProcedure is the fully-qualified name of the
+	// EscalationService's ResolveEscalation RPC.
+	EscalationServiceResolveEscalationProcedure = "/gastown.v1.EscalationService/ResolveEscalation"
+)
+
+// EscalationServiceClient is a client for the gastown.v1.EscalationService service.
+type EscalationServiceClient interface {
+	// ListEscalations returns escalations, most severe first.
+	ListEscalations(context.Context, *connect.Request[v1.ListEscalationsRequest]) (*connect.Response[v1.ListEscalationsResponse], error)
+	// GetEscalation returns a single escalation.
+	GetEscalation(context.Context, *connect.Request[v1.GetEscalationRequest]) (*connect.Response[v1.GetEscalationResponse], error)
+	// AckEscalation acknowledges an escalation, stopping its SLA timer.
+	AckEscalation(context.Context, *connect.Request[v1.AckEscalationRequest]) (*connect.Response[v1.AckEscalationResponse], error)
+	// AssignEscalation makes someone the owner of an escalation, and
+	// acknowledges it if nobody has yet.
+	AssignEscalation(context.Context, *connect.Request[v1.AssignEscalationRequest]) (*connect.Response[v1.AssignEscalationResponse], error)
+	// ResolveEscalation closes an escalation with a resolution reason.
+	ResolveEscalation(context.Context, *connect.Request[v1.ResolveEscalationRequest]) (*connect.Response[v1.ResolveEscalationResponse], error)
+}
+
+// NewEscalationServiceClient constructs a client for the gastown.v1.EscalationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewEscalationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) EscalationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	escalationServiceMethods := v1.File_gastown_v1_escalation_proto.Services().ByName("EscalationService").Methods()
+	return &escalationServiceClient{
+		listEscalations: connect.NewClient[v1.ListEscalationsRequest, v1.ListEscalationsResponse](
+			httpClient,
+			baseURL+EscalationServiceListEscalationsProcedure,
+			connect.WithSchema(escalationServiceMethods.ByName("ListEscalations")),
+			connect.WithClientOptions(opts...),
+		),
+		getEscalation: connect.NewClient[v1.GetEscalationRequest, v1.GetEscalationResponse](
+			httpClient,
+			baseURL+EscalationServiceGetEscalationProcedure,
+			connect.WithSchema(escalationServiceMethods.ByName("GetEscalation")),
+			connect.WithClientOptions(opts...),
+		),
+		ackEscalation: connect.NewClient[v1.AckEscalationRequest, v1.AckEscalationResponse](
+			httpClient,
+			baseURL+EscalationServiceAckEscalationProcedure,
+			connect.WithSchema(escalationServiceMethods.ByName("AckEscalation")),
+			connect.WithClientOptions(opts...),
+		),
+		assignEscalation: connect.NewClient[v1.AssignEscalationRequest, v1.AssignEscalationResponse](
+			httpClient,
+			baseURL+EscalationServiceAssignEscalationProcedure,
+			connect.WithSchema(escalationServiceMethods.ByName("AssignEscalation")),
+			connect.WithClientOptions(opts...),
+		),
+		resolveEscalation: connect.NewClient[v1.ResolveEscalationRequest, v1.ResolveEscalationResponse](
+			httpClient,
+			baseURL+EscalationServiceResolveEscalationProcedure,
+			connect.WithSchema(escalationServiceMethods.ByName("ResolveEscalation")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// escalationServiceClient implements EscalationServiceClient.
+type escalationServiceClient struct {
+	listEscalations   *connect.Client[v1.ListEscalationsRequest, v1.ListEscalationsResponse]
+	getEscalation     *connect.Client[v1.GetEscalationRequest, v1.GetEscalationResponse]
+	ackEscalation     *connect.Client[v1.AckEscalationRequest, v1.AckEscalationResponse]
+	assignEscalation  *connect.Client[v1.AssignEscalationRequest, v1.AssignEscalationResponse]
+	resolveEscalation *connect.Client[v1.ResolveEscalationRequest, v1.ResolveEscalationResponse]
+}
+
+// ListEscalations calls gastown.v1.EscalationService.ListEscalations.
+func (c *escalationServiceClient) ListEscalations(ctx context.Context, req *connect.Request[v1.ListEscalationsRequest]) (*connect.Response[v1.ListEscalationsResponse], error) {
+	return c.listEscalations.CallUnary(ctx, req)
+}
+
+// GetEscalation calls gastown.v1.EscalationService.GetEscalation.
+func (c *escalationServiceClient) GetEscalation(ctx context.Context, req *connect.Request[v1.GetEscalationRequest]) (*connect.Response[v1.GetEscalationResponse], error) {
+	return c.getEscalation.CallUnary(ctx, req)
+}
+
+// AckEscalation calls gastown.v1.EscalationService.AckEscalation.
+func (c *escalationServiceClient) AckEscalation(ctx context.Context, req *connect.Request[v1.AckEscalationRequest]) (*connect.Response[v1.AckEscalationResponse], error) {
+	return c.ackEscalation.CallUnary(ctx, req)
+}
+
+// AssignEscalation calls gastown.v1.EscalationService.AssignEscalation.
+func (c *escalationServiceClient) AssignEscalation(ctx context.Context, req *connect.Request[v1.AssignEscalationRequest]) (*connect.Response[v1.AssignEscalationResponse], error) {
+	return c.assignEscalation.CallUnary(ctx, req)
+}
+
+// ResolveEscalation calls gastown.v1.EscalationService.ResolveEscalation.
+func (c *escalationServiceClient) ResolveEscalation(ctx context.Context, req *connect.Request[v1.ResolveEscalationRequest]) (*connect.Response[v1.ResolveEscalationResponse], error) {
+	return c.resolveEscalation.CallUnary(ctx, req)
+}
+
+// EscalationServiceHandler is an implementation of the gastown.v1.EscalationService service.
+type EscalationServiceHandler interface {
+	// ListEscalations returns escalations, most severe first.
+	ListEscalations(context.Context, *connect.Request[v1.ListEscalationsRequest]) (*connect.Response[v1.ListEscalationsResponse], error)
+	// GetEscalation returns a single escalation.
+	GetEscalation(context.Context, *connect.Request[v1.GetEscalationRequest]) (*connect.Response[v1.GetEscalationResponse], error)
+	// AckEscalation acknowledges an escalation, stopping its SLA timer.
+	AckEscalation(context.Context, *connect.Request[v1.AckEscalationRequest]) (*connect.Response[v1.AckEscalationResponse], error)
+	// AssignEscalation makes someone the owner of an escalation, and
+	// acknowledges it if nobody has yet.
+	AssignEscalation(context.Context, *connect.Request[v1.AssignEscalationRequest]) (*connect.Response[v1.AssignEscalationResponse], error)
+	// ResolveEscalation closes an escalation with a resolution reason.
+	ResolveEscalation(context.Context, *connect.Request[v1.ResolveEscalationRequest]) (*connect.Response[v1.ResolveEscalationResponse], error)
+}
+
+// NewEscalationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewEscalationServiceHandler(svc EscalationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	escalationServiceMethods := v1.File_gastown_v1_escalation_proto.Services().ByName("EscalationService").Methods()
+	escalationServiceListEscalationsHandler := connect.NewUnaryHandler(
+		EscalationServiceListEscalationsProcedure,
+		svc.ListEscalations,
+		connect.WithSchema(escalationServiceMethods.ByName("ListEscalations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	escalationServiceGetEscalationHandler := connect.NewUnaryHandler(
+		EscalationServiceGetEscalationProcedure,
+		svc.GetEscalation,
+		connect.WithSchema(escalationServiceMethods.ByName("GetEscalation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	escalationServiceAckEscalationHandler := connect.NewUnaryHandler(
+		EscalationServiceAckEscalationProcedure,
+		svc.AckEscalation,
+		connect.WithSchema(escalationServiceMethods.ByName("AckEscalation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	escalationServiceAssignEscalationHandler := connect.NewUnaryHandler(
+		EscalationServiceAssignEscalationProcedure,
+		svc.AssignEscalation,
+		connect.WithSchema(escalationServiceMethods.ByName("AssignEscalation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	escalationServiceResolveEscalationHandler := connect.NewUnaryHandler(
+		EscalationServiceResolveEscalationProcedure,
+		svc.ResolveEscalation,
+		connect.WithSchema(escalationServiceMethods.ByName("ResolveEscalation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/gastown.v1.EscalationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case EscalationServiceListEscalationsProcedure:
+			escalationServiceListEscalationsHandler.ServeHTTP(w, r)
+		case EscalationServiceGetEscalationProcedure:
+			escalationServiceGetEscalationHandler.ServeHTTP(w, r)
+		case EscalationServiceAckEscalationProcedure:
+			escalationServiceAckEscalationHandler.ServeHTTP(w, r)
+		case EscalationServiceAssignEscalationProcedure:
+			escalationServiceAssignEscalationHandler.ServeHTTP(w, r)
+		case EscalationServiceResolveEscalationProcedure:
+			escalationServiceResolveEscalationHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedEscalationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedEscalationServiceHandler struct{}
+
+func (UnimplementedEscalationServiceHandler) ListEscalations(context.Context, *connect.Request[v1.ListEscalationsRequest]) (*connect.Response[v1.ListEscalationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.EscalationService.ListEscalations is not implemented"))
+}
+
+func (UnimplementedEscalationServiceHandler) GetEscalation(context.Context, *connect.Request[v1.GetEscalationRequest]) (*connect.Response[v1.GetEscalationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.EscalationService.GetEscalation is not implemented"))
+}
+
+func (UnimplementedEscalationServiceHandler) AckEscalation(context.Context, *connect.Request[v1.AckEscalationRequest]) (*connect.Response[v1.AckEscalationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.EscalationService.AckEscalation is not implemented"))
+}
+
+func (UnimplementedEscalationServiceHandler) AssignEscalation(context.Context, *connect.Request[v1.AssignEscalationRequest]) (*connect.Response[v1.AssignEscalationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.EscalationService.AssignEscalation is not implemented"))
+}
+
+func (UnimplementedEscalationServiceHandler) ResolveEscalation(context.Context, *connect.Request[v1.ResolveEscalationRequest]) (*connect.Response[v1.ResolveEscalationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.EscalationService.ResolveEscalation is not implemented"))
+}
@@ -46,22 +46,29 @@ const (
 	MailServiceDeleteMessageProcedure = "/gastown.v1.MailService/DeleteMessage"
 	// MailServiceWatchInboxProcedure is the fully-qualified name of the MailService's WatchInbox RPC.
 	MailServiceWatchInboxProcedure = "/gastown.v1.MailService/WatchInbox"
+	// MailServiceGetThreadProcedure is the fully-qualified name of the MailService's GetThread RPC.
+	MailServiceGetThreadProcedure = "/gastown.v1.MailService/GetThread"
 )
 
 // MailServiceClient is a client for the gastown.v1.MailService service.
 type MailServiceClient interface {
-	// ListInbox returns messages for an address
+	// ListInbox returns a page of messages for an address, newest first.
+	// Supports unread, sender, priority, and date filters; pass next_cursor
+	// back as cursor to fetch the following page.
 	ListInbox(context.Context, *connect.Request[v1.ListInboxRequest]) (*connect.Response[v1.ListInboxResponse], error)
-	// ReadMessage returns a specific message
+	// ReadMessage returns a specific message by ID.
 	ReadMessage(context.Context, *connect.Request[v1.ReadMessageRequest]) (*connect.Response[v1.ReadMessageResponse], error)
-	// SendMessage sends a new message
+	// SendMessage sends a new message. Supports direct, reply, and CC delivery.
 	SendMessage(context.Context, *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error)
-	// MarkRead marks a message as read
+	// MarkRead marks a message as read (equivalent to closing the message issue).
 	MarkRead(context.Context, *connect.Request[v1.MarkReadRequest]) (*connect.Response[v1.MarkReadResponse], error)
-	// DeleteMessage deletes a message
+	// DeleteMessage permanently removes a message.
 	DeleteMessage(context.Context, *connect.Request[v1.DeleteMessageRequest]) (*connect.Response[v1.DeleteMessageResponse], error)
-	// WatchInbox streams new messages in real-time
+	// WatchInbox streams new messages as they arrive for the given address.
 	WatchInbox(context.Context, *connect.Request[v1.WatchInboxRequest]) (*connect.ServerStreamForClient[v1.Message], error)
+	// GetThread returns a whole conversation in reply order, by thread ID or
+	// by the ID of any message in it.
+	GetThread(context.Context, *connect.Request[v1.GetThreadRequest]) (*connect.Response[v1.GetThreadResponse], error)
 }
 
 // NewMailServiceClient constructs a client for the gastown.v1.MailService service. By default, it
@@ -111,6 +118,12 @@ func NewMailServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(mailServiceMethods.ByName("WatchInbox")),
 			connect.WithClientOptions(opts...),
 		),
+		getThread: connect.NewClient[v1.GetThreadRequest, v1.GetThreadResponse](
+			httpClient,
+			baseURL+MailServiceGetThreadProcedure,
+			connect.WithSchema(mailServiceMethods.ByName("GetThread")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -122,6 +135,7 @@ type mailServiceClient struct {
 	markRead      *connect.Client[v1.MarkReadRequest, v1.MarkReadResponse]
 	deleteMessage *connect.Client[v1.DeleteMessageRequest, v1.DeleteMessageResponse]
 	watchInbox    *connect.Client[v1.WatchInboxRequest, v1.Message]
+	getThread     *connect.Client[v1.GetThreadRequest, v1.GetThreadResponse]
 }
 
 // ListInbox calls gastown.v1.MailService.ListInbox.
@@ -154,20 +168,30 @@ func (c *mailServiceClient) WatchInbox(ctx context.Context, req *connect.Request
 	return c.watchInbox.CallServerStream(ctx, req)
 }
 
+// GetThread calls gastown.v1.MailService.GetThread.
+func (c *mailServiceClient) GetThread(ctx context.Context, req *connect.Request[v1.GetThreadRequest]) (*connect.Response[v1.GetThreadResponse], error) {
+	return c.getThread.CallUnary(ctx, req)
+}
+
 // MailServiceHandler is an implementation of the gastown.v1.MailService service.
 type MailServiceHandler interface {
-	// ListInbox returns messages for an address
+	// ListInbox returns a page of messages for an address, newest first.
+	// Supports unread, sender, priority, and date filters; pass next_cursor
+	// back as cursor to fetch the following page.
 	ListInbox(context.Context, *connect.Request[v1.ListInboxRequest]) (*connect.Response[v1.ListInboxResponse], error)
-	// ReadMessage returns a specific message
+	// ReadMessage returns a specific message by ID.
 	ReadMessage(context.Context, *connect.Request[v1.ReadMessageRequest]) (*connect.Response[v1.ReadMessageResponse], error)
-	// SendMessage sends a new message
+	// SendMessage sends a new message. Supports direct, reply, and CC delivery.
 	SendMessage(context.Context, *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error)
-	// MarkRead marks a message as read
+	// MarkRead marks a message as read (equivalent to closing the message issue).
 	MarkRead(context.Context, *connect.Request[v1.MarkReadRequest]) (*connect.Response[v1.MarkReadResponse], error)
-	// DeleteMessage deletes a message
+	// DeleteMessage permanently removes a message.
 	DeleteMessage(context.Context, *connect.Request[v1.DeleteMessageRequest]) (*connect.Response[v1.DeleteMessageResponse], error)
-	// WatchInbox streams new messages in real-time
+	// WatchInbox streams new messages as they arrive for the given address.
 	WatchInbox(context.Context, *connect.Request[v1.WatchInboxRequest], *connect.ServerStream[v1.Message]) error
+	// GetThread returns a whole conversation in reply order, by thread ID or
+	// by the ID of any message in it.
+	GetThread(context.Context, *connect.Request[v1.GetThreadRequest]) (*connect.Response[v1.GetThreadResponse], error)
 }
 
 // NewMailServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -213,6 +237,12 @@ func NewMailServiceHandler(svc MailServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(mailServiceMethods.ByName("WatchInbox")),
 		connect.WithHandlerOptions(opts...),
 	)
+	mailServiceGetThreadHandler := connect.NewUnaryHandler(
+		MailServiceGetThreadProcedure,
+		svc.GetThread,
+		connect.WithSchema(mailServiceMethods.ByName("GetThread")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/gastown.v1.MailService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MailServiceListInboxProcedure:
@@ -227,6 +257,8 @@ func NewMailServiceHandler(svc MailServiceHandler, opts ...connect.HandlerOption
 			mailServiceDeleteMessageHandler.ServeHTTP(w, r)
 		case MailServiceWatchInboxProcedure:
 			mailServiceWatchInboxHandler.ServeHTTP(w, r)
+		case MailServiceGetThreadProcedure:
+			mailServiceGetThreadHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -259,3 +291,7 @@ func (UnimplementedMailServiceHandler) DeleteMessage(context.Context, *connect.R
 func (UnimplementedMailServiceHandler) WatchInbox(context.Context, *connect.Request[v1.WatchInboxRequest], *connect.ServerStream[v1.Message]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.MailService.WatchInbox is not implemented"))
 }
+
+func (UnimplementedMailServiceHandler) GetThread(context.Context, *connect.Request[v1.GetThreadRequest]) (*connect.Response[v1.GetThreadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.MailService.GetThread is not implemented"))
+}
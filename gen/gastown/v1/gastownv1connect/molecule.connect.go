@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: gastown/v1/molecule.proto
+
+package gastownv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MoleculeServiceName is the fully-qualified name of the MoleculeService service.
+	MoleculeServiceName = "gastown.v1.MoleculeService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MoleculeServiceGetProgressProcedure is the fully-qualified name of the MoleculeService's
+	// GetProgress RPC.
+	MoleculeServiceGetProgressProcedure = "/gastown.v1.MoleculeService/GetProgress"
+)
+
+// MoleculeServiceClient is a client for the gastown.v1.MoleculeService service.
+type MoleculeServiceClient interface {
+	// GetProgress returns the progress of the molecule attached to a bead, or
+	// rooted at it. Returns NotFound if the bead has neither.
+	GetProgress(context.Context, *connect.Request[v1.GetMoleculeProgressRequest]) (*connect.Response[v1.GetMoleculeProgressResponse], error)
+}
+
+// NewMoleculeServiceClient constructs a client for the gastown.v1.MoleculeService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMoleculeServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MoleculeServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	moleculeServiceMethods := v1.File_gastown_v1_molecule_proto.Services().ByName("MoleculeService").Methods()
+	return &moleculeServiceClient{
+		getProgress: connect.NewClient[v1.GetMoleculeProgressRequest, v1.GetMoleculeProgressResponse](
+			httpClient,
+			baseURL+MoleculeServiceGetProgressProcedure,
+			connect.WithSchema(moleculeServiceMethods.ByName("GetProgress")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// moleculeServiceClient implements MoleculeServiceClient.
+type moleculeServiceClient struct {
+	getProgress *connect.Client[v1.GetMoleculeProgressRequest, v1.GetMoleculeProgressResponse]
+}
+
+// GetProgress calls gastown.v1.MoleculeService.GetProgress.
+func (c *moleculeServiceClient) GetProgress(ctx context.Context, req *connect.Request[v1.GetMoleculeProgressRequest]) (*connect.Response[v1.GetMoleculeProgressResponse], error) {
+	return c.getProgress.CallUnary(ctx, req)
+}
+
+// MoleculeServiceHandler is an implementation of the gastown.v1.MoleculeService service.
+type MoleculeServiceHandler interface {
+	// GetProgress returns the progress of the molecule attached to a bead, or
+	// rooted at it. Returns NotFound if the bead has neither.
+	GetProgress(context.Context, *connect.Request[v1.GetMoleculeProgressRequest]) (*connect.Response[v1.GetMoleculeProgressResponse], error)
+}
+
+// NewMoleculeServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMoleculeServiceHandler(svc MoleculeServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	moleculeServiceMethods := v1.File_gastown_v1_molecule_proto.Services().ByName("MoleculeService").Methods()
+	moleculeServiceGetProgressHandler := connect.NewUnaryHandler(
+		MoleculeServiceGetProgressProcedure,
+		svc.GetProgress,
+		connect.WithSchema(moleculeServiceMethods.ByName("GetProgress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/gastown.v1.MoleculeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MoleculeServiceGetProgressProcedure:
+			moleculeServiceGetProgressHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMoleculeServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMoleculeServiceHandler struct{}
+
+func (UnimplementedMoleculeServiceHandler) GetProgress(context.Context, *connect.Request[v1.GetMoleculeProgressRequest]) (*connect.Response[v1.GetMoleculeProgressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.MoleculeService.GetProgress is not implemented"))
+}
@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: gastown/v1/push.proto
+
+package gastownv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PushServiceName is the fully-qualified name of the PushService service.
+	PushServiceName = "gastown.v1.PushService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PushServiceRegisterDeviceProcedure is the fully-qualified name of the PushService's
+	// RegisterDevice RPC.
+	PushServiceRegisterDeviceProcedure = "/gastown.v1.PushService/RegisterDevice"
+	// PushServiceUnregisterDeviceProcedure is the fully-qualified name of the PushService's
+	// UnregisterDevice RPC.
+	PushServiceUnregisterDeviceProcedure = "/gastown.v1.PushService/UnregisterDevice"
+	// PushServiceListDevicesProcedure is the fully-qualified name of the PushService's ListDevices RPC.
+	PushServiceListDevicesProcedure = "/gastown.v1.PushService/ListDevices"
+	// PushServiceSendTestPushProcedure is the fully-qualified name of the PushService's SendTestPush
+	// RPC.
+	PushServiceSendTestPushProcedure = "/gastown.v1.PushService/SendTestPush"
+)
+
+// PushServiceClient is a client for the gastown.v1.PushService service.
+type PushServiceClient interface {
+	// RegisterDevice adds a device token, or refreshes one already known.
+	// Apps should call it on every launch; tokens change.
+	RegisterDevice(context.Context, *connect.Request[v1.RegisterDeviceRequest]) (*connect.Response[v1.RegisterDeviceResponse], error)
+	// UnregisterDevice stops pushes to a device, e.g. on sign-out.
+	UnregisterDevice(context.Context, *connect.Request[v1.UnregisterDeviceRequest]) (*connect.Response[v1.UnregisterDeviceResponse], error)
+	// ListDevices returns registered devices.
+	ListDevices(context.Context, *connect.Request[v1.ListDevicesRequest]) (*connect.Response[v1.ListDevicesResponse], error)
+	// SendTestPush sends a test notification to one device, or all.
+	SendTestPush(context.Context, *connect.Request[v1.SendTestPushRequest]) (*connect.Response[v1.SendTestPushResponse], error)
+}
+
+// NewPushServiceClient constructs a client for the gastown.v1.PushService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPushServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PushServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	pushServiceMethods := v1.File_gastown_v1_push_proto.Services().ByName("PushService").Methods()
+	return &pushServiceClient{
+		registerDevice: connect.NewClient[v1.RegisterDeviceRequest, v1.RegisterDeviceResponse](
+			httpClient,
+			baseURL+PushServiceRegisterDeviceProcedure,
+			connect.WithSchema(pushServiceMethods.ByName("RegisterDevice")),
+			connect.WithClientOptions(opts...),
+		),
+		unregisterDevice: connect.NewClient[v1.UnregisterDeviceRequest, v1.UnregisterDeviceResponse](
+			httpClient,
+			baseURL+PushServiceUnregisterDeviceProcedure,
+			connect.WithSchema(pushServiceMethods.ByName("UnregisterDevice")),
+			connect.WithClientOptions(opts...),
+		),
+		listDevices: connect.NewClient[v1.ListDevicesRequest, v1.ListDevicesResponse](
+			httpClient,
+			baseURL+PushServiceListDevicesProcedure,
+			connect.WithSchema(pushServiceMethods.ByName("ListDevices")),
+			connect.WithClientOptions(opts...),
+		),
+		sendTestPush: connect.NewClient[v1.SendTestPushRequest, v1.SendTestPushResponse](
+			httpClient,
+			baseURL+PushServiceSendTestPushProcedure,
+			connect.WithSchema(pushServiceMethods.ByName("SendTestPush")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// pushServiceClient implements PushServiceClient.
+type pushServiceClient struct {
+	registerDevice   *connect.Client[v1.RegisterDeviceRequest, v1.RegisterDeviceResponse]
+	unregisterDevice *connect.Client[v1.UnregisterDeviceRequest, v1.UnregisterDeviceResponse]
+	listDevices      *connect.Client[v1.ListDevicesRequest, v1.ListDevicesResponse]
+	sendTestPush     *connect.Client[v1.SendTestPushRequest, v1.SendTestPushResponse]
+}
+
+// RegisterDevice calls gastown.v1.PushService.RegisterDevice.
+func (c *pushServiceClient) RegisterDevice(ctx context.Context, req *connect.Request[v1.RegisterDeviceRequest]) (*connect.Response[v1.RegisterDeviceResponse], error) {
+	return c.registerDevice.CallUnary(ctx, req)
+}
+
+// UnregisterDevice calls gastown.v1.PushService.UnregisterDevice.
+func (c *pushServiceClient) UnregisterDevice(ctx context.Context, req *connect.Request[v1.UnregisterDeviceRequest]) (*connect.Response[v1.UnregisterDeviceResponse], error) {
+	return c.unregisterDevice.CallUnary(ctx, req)
+}
+
+// ListDevices calls gastown.v1.PushService.ListDevices.
+func (c *pushServiceClient) ListDevices(ctx context.Context, req *connect.Request[v1.ListDevicesRequest]) (*connect.Response[v1.ListDevicesResponse], error) {
+	return c.listDevices.CallUnary(ctx, req)
+}
+
+// SendTestPush calls gastown.v1.PushService.SendTestPush.
+func (c *pushServiceClient) SendTestPush(ctx context.Context, req *connect.Request[v1.SendTestPushRequest]) (*connect.Response[v1.SendTestPushResponse], error) {
+	return c.sendTestPush.CallUnary(ctx, req)
+}
+
+// PushServiceHandler is an implementation of the gastown.v1.PushService service.
+type PushServiceHandler interface {
+	// RegisterDevice adds a device token, or refreshes one already known.
+	// Apps should call it on every launch; tokens change.
+	RegisterDevice(context.Context, *connect.Request[v1.RegisterDeviceRequest]) (*connect.Response[v1.RegisterDeviceResponse], error)
+	// UnregisterDevice stops pushes to a device, e.g. on sign-out.
+	UnregisterDevice(context.Context, *connect.Request[v1.UnregisterDeviceRequest]) (*connect.Response[v1.UnregisterDeviceResponse], error)
+	// ListDevices returns registered devices.
+	ListDevices(context.Context, *connect.Request[v1.ListDevicesRequest]) (*connect.Response[v1.ListDevicesResponse], error)
+	// SendTestPush sends a test notification to one device, or all.
+	SendTestPush(context.Context, *connect.Request[v1.SendTestPushRequest]) (*connect.Response[v1.SendTestPushResponse], error)
+}
+
+// NewPushServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPushServiceHandler(svc PushServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	pushServiceMethods := v1.File_gastown_v1_push_proto.Services().ByName("PushService").Methods()
+	pushServiceRegisterDeviceHandler := connect.NewUnaryHandler(
+		PushServiceRegisterDeviceProcedure,
+		svc.RegisterDevice,
+		connect.WithSchema(pushServiceMethods.ByName("RegisterDevice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pushServiceUnregisterDeviceHandler := connect.NewUnaryHandler(
+		PushServiceUnregisterDeviceProcedure,
+		svc.UnregisterDevice,
+		connect.WithSchema(pushServiceMethods.ByName("UnregisterDevice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pushServiceListDevicesHandler := connect.NewUnaryHandler(
+		PushServiceListDevicesProcedure,
+		svc.ListDevices,
+		connect.WithSchema(pushServiceMethods.ByName("ListDevices")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pushServiceSendTestPushHandler := connect.NewUnaryHandler(
+		PushServiceSendTestPushProcedure,
+		svc.SendTestPush,
+		connect.WithSchema(pushServiceMethods.ByName("SendTestPush")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/gastown.v1.PushService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PushServiceRegisterDeviceProcedure:
+			pushServiceRegisterDeviceHandler.ServeHTTP(w, r)
+		case PushServiceUnregisterDeviceProcedure:
+			pushServiceUnregisterDeviceHandler.ServeHTTP(w, r)
+		case PushServiceListDevicesProcedure:
+			pushServiceListDevicesHandler.ServeHTTP(w, r)
+		case PushServiceSendTestPushProcedure:
+			pushServiceSendTestPushHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPushServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPushServiceHandler struct{}
+
+func (UnimplementedPushServiceHandler) RegisterDevice(context.Context, *connect.Request[v1.RegisterDeviceRequest]) (*connect.Response[v1.RegisterDeviceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.PushService.RegisterDevice is not implemented"))
+}
+
+func (UnimplementedPushServiceHandler) UnregisterDevice(context.Context, *connect.Request[v1.UnregisterDeviceRequest]) (*connect.Response[v1.UnregisterDeviceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.PushService.UnregisterDevice is not implemented"))
+}
+
+func (UnimplementedPushServiceHandler) ListDevices(context.Context, *connect.Request[v1.ListDevicesRequest]) (*connect.Response[v1.ListDevicesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.PushService.ListDevices is not implemented"))
+}
+
+func (UnimplementedPushServiceHandler) SendTestPush(context.Context, *connect.Request[v1.SendTestPushRequest]) (*connect.Response[v1.SendTestPushResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.PushService.SendTestPush is not implemented"))
+}
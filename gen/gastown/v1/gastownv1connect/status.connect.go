@@ -48,20 +48,37 @@ const (
 	// StatusServiceHealthCheckProcedure is the fully-qualified name of the StatusService's HealthCheck
 	// RPC.
 	StatusServiceHealthCheckProcedure = "/gastown.v1.StatusService/HealthCheck"
+	// StatusServiceRefreshRigsProcedure is the fully-qualified name of the StatusService's RefreshRigs
+	// RPC.
+	StatusServiceRefreshRigsProcedure = "/gastown.v1.StatusService/RefreshRigs"
 )
 
 // StatusServiceClient is a client for the gastown.v1.StatusService service.
 type StatusServiceClient interface {
-	// GetTownStatus returns the full status of the town
+	// GetTownStatus returns the full status of the town including overseer info,
+	// global agents (Mayor, Deacon), and per-rig status with agent details.
+	// Use fast=true to skip mail lookups for quicker responses.
 	GetTownStatus(context.Context, *connect.Request[v1.GetTownStatusRequest]) (*connect.Response[v1.GetTownStatusResponse], error)
-	// GetRigStatus returns status for a specific rig
+	// GetRigStatus returns detailed status for a specific rig including
+	// polecats, crews, hooks, and merge queue summary.
 	GetRigStatus(context.Context, *connect.Request[v1.GetRigStatusRequest]) (*connect.Response[v1.GetRigStatusResponse], error)
-	// GetAgentStatus returns status for a specific agent
+	// GetAgentStatus returns runtime status for a specific agent including
+	// session info, hooked work, and state.
 	GetAgentStatus(context.Context, *connect.Request[v1.GetAgentStatusRequest]) (*connect.Response[v1.GetAgentStatusResponse], error)
-	// WatchStatus streams status updates in real-time
+	// WatchStatus streams status updates in real-time. Emits updates when
+	// agent state changes, rigs are modified, or town-level changes occur.
 	WatchStatus(context.Context, *connect.Request[v1.WatchStatusRequest]) (*connect.ServerStreamForClient[v1.StatusUpdate], error)
-	// HealthCheck returns structured health of all system components
+	// HealthCheck returns structured health of system components. With no
+	// probe it checks everything (status "healthy" or "degraded"); the
+	// liveness probe checks only that the process is serving, and the
+	// readiness probe checks the dependencies requests need (status
+	// "healthy" or "unhealthy"). Served over HTTP as /health, /health/live,
+	// and /health/ready for K8s probes.
 	HealthCheck(context.Context, *connect.Request[v1.HealthCheckRequest]) (*connect.Response[v1.HealthCheckResponse], error)
+	// RefreshRigs rescans the town's rigs immediately instead of waiting for
+	// the rig index to notice a filesystem change, and drops cached status.
+	// Returns the rigs found.
+	RefreshRigs(context.Context, *connect.Request[v1.RefreshRigsRequest]) (*connect.Response[v1.RefreshRigsResponse], error)
 }
 
 // NewStatusServiceClient constructs a client for the gastown.v1.StatusService service. By default,
@@ -105,6 +122,12 @@ func NewStatusServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(statusServiceMethods.ByName("HealthCheck")),
 			connect.WithClientOptions(opts...),
 		),
+		refreshRigs: connect.NewClient[v1.RefreshRigsRequest, v1.RefreshRigsResponse](
+			httpClient,
+			baseURL+StatusServiceRefreshRigsProcedure,
+			connect.WithSchema(statusServiceMethods.ByName("RefreshRigs")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -115,6 +138,7 @@ type statusServiceClient struct {
 	getAgentStatus *connect.Client[v1.GetAgentStatusRequest, v1.GetAgentStatusResponse]
 	watchStatus    *connect.Client[v1.WatchStatusRequest, v1.StatusUpdate]
 	healthCheck    *connect.Client[v1.HealthCheckRequest, v1.HealthCheckResponse]
+	refreshRigs    *connect.Client[v1.RefreshRigsRequest, v1.RefreshRigsResponse]
 }
 
 // GetTownStatus calls gastown.v1.StatusService.GetTownStatus.
@@ -142,18 +166,37 @@ func (c *statusServiceClient) HealthCheck(ctx context.Context, req *connect.Requ
 	return c.healthCheck.CallUnary(ctx, req)
 }
 
+// RefreshRigs calls gastown.v1.StatusService.RefreshRigs.
+func (c *statusServiceClient) RefreshRigs(ctx context.Context, req *connect.Request[v1.RefreshRigsRequest]) (*connect.Response[v1.RefreshRigsResponse], error) {
+	return c.refreshRigs.CallUnary(ctx, req)
+}
+
 // StatusServiceHandler is an implementation of the gastown.v1.StatusService service.
 type StatusServiceHandler interface {
-	// GetTownStatus returns the full status of the town
+	// GetTownStatus returns the full status of the town including overseer info,
+	// global agents (Mayor, Deacon), and per-rig status with agent details.
+	// Use fast=true to skip mail lookups for quicker responses.
 	GetTownStatus(context.Context, *connect.Request[v1.GetTownStatusRequest]) (*connect.Response[v1.GetTownStatusResponse], error)
-	// GetRigStatus returns status for a specific rig
+	// GetRigStatus returns detailed status for a specific rig including
+	// polecats, crews, hooks, and merge queue summary.
 	GetRigStatus(context.Context, *connect.Request[v1.GetRigStatusRequest]) (*connect.Response[v1.GetRigStatusResponse], error)
-	// GetAgentStatus returns status for a specific agent
+	// GetAgentStatus returns runtime status for a specific agent including
+	// session info, hooked work, and state.
 	GetAgentStatus(context.Context, *connect.Request[v1.GetAgentStatusRequest]) (*connect.Response[v1.GetAgentStatusResponse], error)
-	// WatchStatus streams status updates in real-time
+	// WatchStatus streams status updates in real-time. Emits updates when
+	// agent state changes, rigs are modified, or town-level changes occur.
 	WatchStatus(context.Context, *connect.Request[v1.WatchStatusRequest], *connect.ServerStream[v1.StatusUpdate]) error
-	// HealthCheck returns structured health of all system components
+	// HealthCheck returns structured health of system components. With no
+	// probe it checks everything (status "healthy" or "degraded"); the
+	// liveness probe checks only that the process is serving, and the
+	// readiness probe checks the dependencies requests need (status
+	// "healthy" or "unhealthy"). Served over HTTP as /health, /health/live,
+	// and /health/ready for K8s probes.
 	HealthCheck(context.Context, *connect.Request[v1.HealthCheckRequest]) (*connect.Response[v1.HealthCheckResponse], error)
+	// RefreshRigs rescans the town's rigs immediately instead of waiting for
+	// the rig index to notice a filesystem change, and drops cached status.
+	// Returns the rigs found.
+	RefreshRigs(context.Context, *connect.Request[v1.RefreshRigsRequest]) (*connect.Response[v1.RefreshRigsResponse], error)
 }
 
 // NewStatusServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -193,6 +236,12 @@ func NewStatusServiceHandler(svc StatusServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(statusServiceMethods.ByName("HealthCheck")),
 		connect.WithHandlerOptions(opts...),
 	)
+	statusServiceRefreshRigsHandler := connect.NewUnaryHandler(
+		StatusServiceRefreshRigsProcedure,
+		svc.RefreshRigs,
+		connect.WithSchema(statusServiceMethods.ByName("RefreshRigs")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/gastown.v1.StatusService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case StatusServiceGetTownStatusProcedure:
@@ -205,6 +254,8 @@ func NewStatusServiceHandler(svc StatusServiceHandler, opts ...connect.HandlerOp
 			statusServiceWatchStatusHandler.ServeHTTP(w, r)
 		case StatusServiceHealthCheckProcedure:
 			statusServiceHealthCheckHandler.ServeHTTP(w, r)
+		case StatusServiceRefreshRigsProcedure:
+			statusServiceRefreshRigsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -233,3 +284,7 @@ func (UnimplementedStatusServiceHandler) WatchStatus(context.Context, *connect.R
 func (UnimplementedStatusServiceHandler) HealthCheck(context.Context, *connect.Request[v1.HealthCheckRequest]) (*connect.Response[v1.HealthCheckResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.StatusService.HealthCheck is not implemented"))
 }
+
+func (UnimplementedStatusServiceHandler) RefreshRigs(context.Context, *connect.Request[v1.RefreshRigsRequest]) (*connect.Response[v1.RefreshRigsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.StatusService.RefreshRigs is not implemented"))
+}
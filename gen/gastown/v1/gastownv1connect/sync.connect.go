@@ -0,0 +1,112 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: gastown/v1/sync.proto
+
+package gastownv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SyncServiceName is the fully-qualified name of the SyncService service.
+	SyncServiceName = "gastown.v1.SyncService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SyncServiceSyncProcedure is the fully-qualified name of the SyncService's Sync RPC.
+	SyncServiceSyncProcedure = "/gastown.v1.SyncService/Sync"
+)
+
+// SyncServiceClient is a client for the gastown.v1.SyncService service.
+type SyncServiceClient interface {
+	// Sync returns changes since a cursor. Page through with the returned
+	// cursor while has_more is set.
+	Sync(context.Context, *connect.Request[v1.SyncRequest]) (*connect.Response[v1.SyncResponse], error)
+}
+
+// NewSyncServiceClient constructs a client for the gastown.v1.SyncService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSyncServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SyncServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	syncServiceMethods := v1.File_gastown_v1_sync_proto.Services().ByName("SyncService").Methods()
+	return &syncServiceClient{
+		sync: connect.NewClient[v1.SyncRequest, v1.SyncResponse](
+			httpClient,
+			baseURL+SyncServiceSyncProcedure,
+			connect.WithSchema(syncServiceMethods.ByName("Sync")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// syncServiceClient implements SyncServiceClient.
+type syncServiceClient struct {
+	sync *connect.Client[v1.SyncRequest, v1.SyncResponse]
+}
+
+// Sync calls gastown.v1.SyncService.Sync.
+func (c *syncServiceClient) Sync(ctx context.Context, req *connect.Request[v1.SyncRequest]) (*connect.Response[v1.SyncResponse], error) {
+	return c.sync.CallUnary(ctx, req)
+}
+
+// SyncServiceHandler is an implementation of the gastown.v1.SyncService service.
+type SyncServiceHandler interface {
+	// Sync returns changes since a cursor. Page through with the returned
+	// cursor while has_more is set.
+	Sync(context.Context, *connect.Request[v1.SyncRequest]) (*connect.Response[v1.SyncResponse], error)
+}
+
+// NewSyncServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSyncServiceHandler(svc SyncServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	syncServiceMethods := v1.File_gastown_v1_sync_proto.Services().ByName("SyncService").Methods()
+	syncServiceSyncHandler := connect.NewUnaryHandler(
+		SyncServiceSyncProcedure,
+		svc.Sync,
+		connect.WithSchema(syncServiceMethods.ByName("Sync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/gastown.v1.SyncService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SyncServiceSyncProcedure:
+			syncServiceSyncHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSyncServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSyncServiceHandler struct{}
+
+func (UnimplementedSyncServiceHandler) Sync(context.Context, *connect.Request[v1.SyncRequest]) (*connect.Response[v1.SyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.SyncService.Sync is not implemented"))
+}
@@ -0,0 +1,143 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: gastown/v1/usage.proto
+
+package gastownv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// UsageServiceName is the fully-qualified name of the UsageService service.
+	UsageServiceName = "gastown.v1.UsageService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// UsageServiceGetUsageProcedure is the fully-qualified name of the UsageService's GetUsage RPC.
+	UsageServiceGetUsageProcedure = "/gastown.v1.UsageService/GetUsage"
+	// UsageServiceRecordUsageProcedure is the fully-qualified name of the UsageService's RecordUsage
+	// RPC.
+	UsageServiceRecordUsageProcedure = "/gastown.v1.UsageService/RecordUsage"
+)
+
+// UsageServiceClient is a client for the gastown.v1.UsageService service.
+type UsageServiceClient interface {
+	// GetUsage returns spend since a point in time, broken down by agent,
+	// rig, and bead, with the state of each daily budget.
+	GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error)
+	// RecordUsage adds usage reported by an agent to the ledger.
+	RecordUsage(context.Context, *connect.Request[v1.RecordUsageRequest]) (*connect.Response[v1.RecordUsageResponse], error)
+}
+
+// NewUsageServiceClient constructs a client for the gastown.v1.UsageService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewUsageServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) UsageServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	usageServiceMethods := v1.File_gastown_v1_usage_proto.Services().ByName("UsageService").Methods()
+	return &usageServiceClient{
+		getUsage: connect.NewClient[v1.GetUsageRequest, v1.GetUsageResponse](
+			httpClient,
+			baseURL+UsageServiceGetUsageProcedure,
+			connect.WithSchema(usageServiceMethods.ByName("GetUsage")),
+			connect.WithClientOptions(opts...),
+		),
+		recordUsage: connect.NewClient[v1.RecordUsageRequest, v1.RecordUsageResponse](
+			httpClient,
+			baseURL+UsageServiceRecordUsageProcedure,
+			connect.WithSchema(usageServiceMethods.ByName("RecordUsage")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// usageServiceClient implements UsageServiceClient.
+type usageServiceClient struct {
+	getUsage    *connect.Client[v1.GetUsageRequest, v1.GetUsageResponse]
+	recordUsage *connect.Client[v1.RecordUsageRequest, v1.RecordUsageResponse]
+}
+
+// GetUsage calls gastown.v1.UsageService.GetUsage.
+func (c *usageServiceClient) GetUsage(ctx context.Context, req *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error) {
+	return c.getUsage.CallUnary(ctx, req)
+}
+
+// RecordUsage calls gastown.v1.UsageService.RecordUsage.
+func (c *usageServiceClient) RecordUsage(ctx context.Context, req *connect.Request[v1.RecordUsageRequest]) (*connect.Response[v1.RecordUsageResponse], error) {
+	return c.recordUsage.CallUnary(ctx, req)
+}
+
+// UsageServiceHandler is an implementation of the gastown.v1.UsageService service.
+type UsageServiceHandler interface {
+	// GetUsage returns spend since a point in time, broken down by agent,
+	// rig, and bead, with the state of each daily budget.
+	GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error)
+	// RecordUsage adds usage reported by an agent to the ledger.
+	RecordUsage(context.Context, *connect.Request[v1.RecordUsageRequest]) (*connect.Response[v1.RecordUsageResponse], error)
+}
+
+// NewUsageServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewUsageServiceHandler(svc UsageServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	usageServiceMethods := v1.File_gastown_v1_usage_proto.Services().ByName("UsageService").Methods()
+	usageServiceGetUsageHandler := connect.NewUnaryHandler(
+		UsageServiceGetUsageProcedure,
+		svc.GetUsage,
+		connect.WithSchema(usageServiceMethods.ByName("GetUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usageServiceRecordUsageHandler := connect.NewUnaryHandler(
+		UsageServiceRecordUsageProcedure,
+		svc.RecordUsage,
+		connect.WithSchema(usageServiceMethods.ByName("RecordUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/gastown.v1.UsageService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case UsageServiceGetUsageProcedure:
+			usageServiceGetUsageHandler.ServeHTTP(w, r)
+		case UsageServiceRecordUsageProcedure:
+			usageServiceRecordUsageHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedUsageServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedUsageServiceHandler struct{}
+
+func (UnimplementedUsageServiceHandler) GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.UsageService.GetUsage is not implemented"))
+}
+
+func (UnimplementedUsageServiceHandler) RecordUsage(context.Context, *connect.Request[v1.RecordUsageRequest]) (*connect.Response[v1.RecordUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.UsageService.RecordUsage is not implemented"))
+}
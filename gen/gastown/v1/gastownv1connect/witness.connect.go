@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: gastown/v1/witness.proto
+
+package gastownv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WitnessServiceName is the fully-qualified name of the WitnessService service.
+	WitnessServiceName = "gastown.v1.WitnessService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WitnessServiceListFindingsProcedure is the fully-qualified name of the WitnessService's
+	// ListFindings RPC.
+	WitnessServiceListFindingsProcedure = "/gastown.v1.WitnessService/ListFindings"
+	// WitnessServiceReportFindingProcedure is the fully-qualified name of the WitnessService's
+	// ReportFinding RPC.
+	WitnessServiceReportFindingProcedure = "/gastown.v1.WitnessService/ReportFinding"
+	// WitnessServiceResolveFindingProcedure is the fully-qualified name of the WitnessService's
+	// ResolveFinding RPC.
+	WitnessServiceResolveFindingProcedure = "/gastown.v1.WitnessService/ResolveFinding"
+)
+
+// WitnessServiceClient is a client for the gastown.v1.WitnessService service.
+type WitnessServiceClient interface {
+	// ListFindings returns findings ranked by severity, then most recent report.
+	ListFindings(context.Context, *connect.Request[v1.ListFindingsRequest]) (*connect.Response[v1.ListFindingsResponse], error)
+	// ReportFinding records a finding, updating an open one for the same
+	// agent and category if it exists.
+	ReportFinding(context.Context, *connect.Request[v1.ReportFindingRequest]) (*connect.Response[v1.ReportFindingResponse], error)
+	// ResolveFinding closes a finding with a resolution reason.
+	ResolveFinding(context.Context, *connect.Request[v1.ResolveFindingRequest]) (*connect.Response[v1.ResolveFindingResponse], error)
+}
+
+// NewWitnessServiceClient constructs a client for the gastown.v1.WitnessService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWitnessServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WitnessServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	witnessServiceMethods := v1.File_gastown_v1_witness_proto.Services().ByName("WitnessService").Methods()
+	return &witnessServiceClient{
+		listFindings: connect.NewClient[v1.ListFindingsRequest, v1.ListFindingsResponse](
+			httpClient,
+			baseURL+WitnessServiceListFindingsProcedure,
+			connect.WithSchema(witnessServiceMethods.ByName("ListFindings")),
+			connect.WithClientOptions(opts...),
+		),
+		reportFinding: connect.NewClient[v1.ReportFindingRequest, v1.ReportFindingResponse](
+			httpClient,
+			baseURL+WitnessServiceReportFindingProcedure,
+			connect.WithSchema(witnessServiceMethods.ByName("ReportFinding")),
+			connect.WithClientOptions(opts...),
+		),
+		resolveFinding: connect.NewClient[v1.ResolveFindingRequest, v1.ResolveFindingResponse](
+			httpClient,
+			baseURL+WitnessServiceResolveFindingProcedure,
+			connect.WithSchema(witnessServiceMethods.ByName("ResolveFinding")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// witnessServiceClient implements WitnessServiceClient.
+type witnessServiceClient struct {
+	listFindings   *connect.Client[v1.ListFindingsRequest, v1.ListFindingsResponse]
+	reportFinding  *connect.Client[v1.ReportFindingRequest, v1.ReportFindingResponse]
+	resolveFinding *connect.Client[v1.ResolveFindingRequest, v1.ResolveFindingResponse]
+}
+
+// ListFindings calls gastown.v1.WitnessService.ListFindings.
+func (c *witnessServiceClient) ListFindings(ctx context.Context, req *connect.Request[v1.ListFindingsRequest]) (*connect.Response[v1.ListFindingsResponse], error) {
+	return c.listFindings.CallUnary(ctx, req)
+}
+
+// ReportFinding calls gastown.v1.WitnessService.ReportFinding.
+func (c *witnessServiceClient) ReportFinding(ctx context.Context, req *connect.Request[v1.ReportFindingRequest]) (*connect.Response[v1.ReportFindingResponse], error) {
+	return c.reportFinding.CallUnary(ctx, req)
+}
+
+// ResolveFinding calls gastown.v1.WitnessService.ResolveFinding.
+func (c *witnessServiceClient) ResolveFinding(ctx context.Context, req *connect.Request[v1.ResolveFindingRequest]) (*connect.Response[v1.ResolveFindingResponse], error) {
+	return c.resolveFinding.CallUnary(ctx, req)
+}
+
+// WitnessServiceHandler is an implementation of the gastown.v1.WitnessService service.
+type WitnessServiceHandler interface {
+	// ListFindings returns findings ranked by severity, then most recent report.
+	ListFindings(context.Context, *connect.Request[v1.ListFindingsRequest]) (*connect.Response[v1.ListFindingsResponse], error)
+	// ReportFinding records a finding, updating an open one for the same
+	// agent and category if it exists.
+	ReportFinding(context.Context, *connect.Request[v1.ReportFindingRequest]) (*connect.Response[v1.ReportFindingResponse], error)
+	// ResolveFinding closes a finding with a resolution reason.
+	ResolveFinding(context.Context, *connect.Request[v1.ResolveFindingRequest]) (*connect.Response[v1.ResolveFindingResponse], error)
+}
+
+// NewWitnessServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWitnessServiceHandler(svc WitnessServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	witnessServiceMethods := v1.File_gastown_v1_witness_proto.Services().ByName("WitnessService").Methods()
+	witnessServiceListFindingsHandler := connect.NewUnaryHandler(
+		WitnessServiceListFindingsProcedure,
+		svc.ListFindings,
+		connect.WithSchema(witnessServiceMethods.ByName("ListFindings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	witnessServiceReportFindingHandler := connect.NewUnaryHandler(
+		WitnessServiceReportFindingProcedure,
+		svc.ReportFinding,
+		connect.WithSchema(witnessServiceMethods.ByName("ReportFinding")),
+		connect.WithHandlerOptions(opts...),
+	)
+	witnessServiceResolveFindingHandler := connect.NewUnaryHandler(
+		WitnessServiceResolveFindingProcedure,
+		svc.ResolveFinding,
+		connect.WithSchema(witnessServiceMethods.ByName("ResolveFinding")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/gastown.v1.WitnessService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WitnessServiceListFindingsProcedure:
+			witnessServiceListFindingsHandler.ServeHTTP(w, r)
+		case WitnessServiceReportFindingProcedure:
+			witnessServiceReportFindingHandler.ServeHTTP(w, r)
+		case WitnessServiceResolveFindingProcedure:
+			witnessServiceResolveFindingHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWitnessServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWitnessServiceHandler struct{}
+
+func (UnimplementedWitnessServiceHandler) ListFindings(context.Context, *connect.Request[v1.ListFindingsRequest]) (*connect.Response[v1.ListFindingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.WitnessService.ListFindings is not implemented"))
+}
+
+func (UnimplementedWitnessServiceHandler) ReportFinding(context.Context, *connect.Request[v1.ReportFindingRequest]) (*connect.Response[v1.ReportFindingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.WitnessService.ReportFinding is not implemented"))
+}
+
+func (UnimplementedWitnessServiceHandler) ResolveFinding(context.Context, *connect.Request[v1.ResolveFindingRequest]) (*connect.Response[v1.ResolveFindingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("gastown.v1.WitnessService.ResolveFinding is not implemented"))
+}
@@ -128,11 +128,71 @@ func (Delivery) EnumDescriptor() ([]byte, []int) {
 	return file_gastown_v1_mail_proto_rawDescGZIP(), []int{1}
 }
 
+// Receipts a sender can request for a direct message
+type Receipt int32
+
+const (
+	Receipt_RECEIPT_UNSPECIFIED Receipt = 0 // No receipts
+	Receipt_RECEIPT_EVENT       Receipt = 1 // Delivery and read events in the activity feed
+	Receipt_RECEIPT_MAIL        Receipt = 2 // Events plus a read-notification mail to the sender
+)
+
+// Enum value maps for Receipt.
+var (
+	Receipt_name = map[int32]string{
+		0: "RECEIPT_UNSPECIFIED",
+		1: "RECEIPT_EVENT",
+		2: "RECEIPT_MAIL",
+	}
+	Receipt_value = map[string]int32{
+		"RECEIPT_UNSPECIFIED": 0,
+		"RECEIPT_EVENT":       1,
+		"RECEIPT_MAIL":        2,
+	}
+)
+
+func (x Receipt) Enum() *Receipt {
+	p := new(Receipt)
+	*p = x
+	return p
+}
+
+func (x Receipt) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Receipt) Descriptor() protoreflect.EnumDescriptor {
+	return file_gastown_v1_mail_proto_enumTypes[2].Descriptor()
+}
+
+func (Receipt) Type() protoreflect.EnumType {
+	return &file_gastown_v1_mail_proto_enumTypes[2]
+}
+
+func (x Receipt) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Receipt.Descriptor instead.
+func (Receipt) EnumDescriptor() ([]byte, []int) {
+	return file_gastown_v1_mail_proto_rawDescGZIP(), []int{2}
+}
+
 type ListInboxRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       *AgentAddress          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Empty = overseer inbox
-	UnreadOnly    bool                   `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // Max messages to return (0 = all)
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Address    *AgentAddress          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Empty = overseer inbox
+	UnreadOnly bool                   `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	Limit      int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // Page size (0 = all)
+	// Only messages from this sender; a trailing "/" matches a prefix (e.g., "gastown/")
+	From string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// Only messages at or above this priority
+	MinPriority Priority `protobuf:"varint,5,opt,name=min_priority,json=minPriority,proto3,enum=gastown.v1.Priority" json:"min_priority,omitempty"`
+	// Only messages sent after this time (incremental refresh)
+	Since *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	// Only messages sent at or before this time
+	Until *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3" json:"until,omitempty"`
+	// Cursor from a previous response's next_cursor
+	Cursor        string `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -188,11 +248,48 @@ func (x *ListInboxRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListInboxRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListInboxRequest) GetMinPriority() Priority {
+	if x != nil {
+		return x.MinPriority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *ListInboxRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListInboxRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ListInboxRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type ListInboxResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Unread        int32                  `protobuf:"varint,3,opt,name=unread,proto3" json:"unread,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                            // All messages in the inbox
+	Unread        int32                  `protobuf:"varint,3,opt,name=unread,proto3" json:"unread,omitempty"`                          // Unread messages in the inbox
+	Matched       int32                  `protobuf:"varint,4,opt,name=matched,proto3" json:"matched,omitempty"`                        // Messages matching the filters across all pages
+	NextCursor    string                 `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -248,6 +345,20 @@ func (x *ListInboxResponse) GetUnread() int32 {
 	return 0
 }
 
+func (x *ListInboxResponse) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *ListInboxResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type ReadMessageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
@@ -346,6 +457,8 @@ type SendMessageRequest struct {
 	Delivery      Delivery               `protobuf:"varint,6,opt,name=delivery,proto3,enum=gastown.v1.Delivery" json:"delivery,omitempty"`
 	ReplyTo       string                 `protobuf:"bytes,7,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"` // Message ID if this is a reply
 	Cc            []*AgentAddress        `protobuf:"bytes,8,rep,name=cc,proto3" json:"cc,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,9,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Receipt       Receipt                `protobuf:"varint,10,opt,name=receipt,proto3,enum=gastown.v1.Receipt" json:"receipt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -436,6 +549,20 @@ func (x *SendMessageRequest) GetCc() []*AgentAddress {
 	return nil
 }
 
+func (x *SendMessageRequest) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetReceipt() Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return Receipt_RECEIPT_UNSPECIFIED
+}
+
 type SendMessageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
@@ -684,6 +811,164 @@ func (x *WatchInboxRequest) GetAddress() *AgentAddress {
 	return nil
 }
 
+type GetThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      string                 `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // Used when thread_id is empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadRequest) Reset() {
+	*x = GetThreadRequest{}
+	mi := &file_gastown_v1_mail_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadRequest) ProtoMessage() {}
+
+func (x *GetThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_mail_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_mail_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetThreadRequest) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *GetThreadRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type GetThreadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      string                 `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Entries       []*ThreadEntry         `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadResponse) Reset() {
+	*x = GetThreadResponse{}
+	mi := &file_gastown_v1_mail_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadResponse) ProtoMessage() {}
+
+func (x *GetThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_mail_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadResponse.ProtoReflect.Descriptor instead.
+func (*GetThreadResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_mail_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetThreadResponse) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *GetThreadResponse) GetEntries() []*ThreadEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// A message in a conversation. depth counts reply hops from the message that
+// started the thread.
+type ThreadEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Depth         int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThreadEntry) Reset() {
+	*x = ThreadEntry{}
+	mi := &file_gastown_v1_mail_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadEntry) ProtoMessage() {}
+
+func (x *ThreadEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_mail_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadEntry.ProtoReflect.Descriptor instead.
+func (*ThreadEntry) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_mail_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ThreadEntry) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ThreadEntry) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
 // A mail message
 type Message struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -701,13 +986,15 @@ type Message struct {
 	ReplyTo       string                 `protobuf:"bytes,12,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
 	Pinned        bool                   `protobuf:"varint,13,opt,name=pinned,proto3" json:"pinned,omitempty"`
 	Cc            []*AgentAddress        `protobuf:"bytes,14,rep,name=cc,proto3" json:"cc,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,15,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Receipt       Receipt                `protobuf:"varint,16,opt,name=receipt,proto3,enum=gastown.v1.Receipt" json:"receipt,omitempty"` // Receipt the sender asked for
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Message) Reset() {
 	*x = Message{}
-	mi := &file_gastown_v1_mail_proto_msgTypes[11]
+	mi := &file_gastown_v1_mail_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -719,7 +1006,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_mail_proto_msgTypes[11]
+	mi := &file_gastown_v1_mail_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,7 +1019,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_mail_proto_rawDescGZIP(), []int{11}
+	return file_gastown_v1_mail_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Message) GetId() string {
@@ -833,26 +1120,126 @@ func (x *Message) GetCc() []*AgentAddress {
 	return nil
 }
 
+func (x *Message) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Message) GetReceipt() Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return Receipt_RECEIPT_UNSPECIFIED
+}
+
+// A file sent with a message: inline (data, base64 in JSON, capped at 48KiB
+// each and 64KiB per message) or a reference to a file in the town (path).
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"` // Absolute path of a referenced file; over RPC, under <town>/.runtime/attachments
+	Data          []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"` // Inline content
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_gastown_v1_mail_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_mail_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_mail_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Attachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Attachment) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_gastown_v1_mail_proto protoreflect.FileDescriptor
 
 const file_gastown_v1_mail_proto_rawDesc = "" +
 	"\n" +
 	"\x15gastown/v1/mail.proto\x12\n" +
-	"gastown.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17gastown/v1/common.proto\"}\n" +
+	"gastown.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17gastown/v1/common.proto\"\xc6\x02\n" +
 	"\x10ListInboxRequest\x122\n" +
 	"\aaddress\x18\x01 \x01(\v2\x18.gastown.v1.AgentAddressR\aaddress\x12\x1f\n" +
 	"\vunread_only\x18\x02 \x01(\bR\n" +
 	"unreadOnly\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"r\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x127\n" +
+	"\fmin_priority\x18\x05 \x01(\x0e2\x14.gastown.v1.PriorityR\vminPriority\x120\n" +
+	"\x05since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\"\xad\x01\n" +
 	"\x11ListInboxResponse\x12/\n" +
 	"\bmessages\x18\x01 \x03(\v2\x13.gastown.v1.MessageR\bmessages\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x16\n" +
-	"\x06unread\x18\x03 \x01(\x05R\x06unread\"3\n" +
+	"\x06unread\x18\x03 \x01(\x05R\x06unread\x12\x18\n" +
+	"\amatched\x18\x04 \x01(\x05R\amatched\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\"3\n" +
 	"\x12ReadMessageRequest\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\"D\n" +
 	"\x13ReadMessageResponse\x12-\n" +
-	"\amessage\x18\x01 \x01(\v2\x13.gastown.v1.MessageR\amessage\"\xc2\x02\n" +
+	"\amessage\x18\x01 \x01(\v2\x13.gastown.v1.MessageR\amessage\"\xab\x03\n" +
 	"\x12SendMessageRequest\x12(\n" +
 	"\x02to\x18\x01 \x01(\v2\x18.gastown.v1.AgentAddressR\x02to\x12\x18\n" +
 	"\asubject\x18\x02 \x01(\tR\asubject\x12\x12\n" +
@@ -861,7 +1248,10 @@ const file_gastown_v1_mail_proto_rawDesc = "" +
 	"\x04type\x18\x05 \x01(\x0e2\x17.gastown.v1.MessageTypeR\x04type\x120\n" +
 	"\bdelivery\x18\x06 \x01(\x0e2\x14.gastown.v1.DeliveryR\bdelivery\x12\x19\n" +
 	"\breply_to\x18\a \x01(\tR\areplyTo\x12(\n" +
-	"\x02cc\x18\b \x03(\v2\x18.gastown.v1.AgentAddressR\x02cc\"4\n" +
+	"\x02cc\x18\b \x03(\v2\x18.gastown.v1.AgentAddressR\x02cc\x128\n" +
+	"\vattachments\x18\t \x03(\v2\x16.gastown.v1.AttachmentR\vattachments\x12-\n" +
+	"\areceipt\x18\n" +
+	" \x01(\x0e2\x13.gastown.v1.ReceiptR\areceipt\"4\n" +
 	"\x13SendMessageResponse\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\"0\n" +
@@ -874,7 +1264,17 @@ const file_gastown_v1_mail_proto_rawDesc = "" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\"\x17\n" +
 	"\x15DeleteMessageResponse\"G\n" +
 	"\x11WatchInboxRequest\x122\n" +
-	"\aaddress\x18\x01 \x01(\v2\x18.gastown.v1.AgentAddressR\aaddress\"\xf8\x03\n" +
+	"\aaddress\x18\x01 \x01(\v2\x18.gastown.v1.AgentAddressR\aaddress\"N\n" +
+	"\x10GetThreadRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\tR\bthreadId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"c\n" +
+	"\x11GetThreadResponse\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\tR\bthreadId\x121\n" +
+	"\aentries\x18\x02 \x03(\v2\x17.gastown.v1.ThreadEntryR\aentries\"R\n" +
+	"\vThreadEntry\x12-\n" +
+	"\amessage\x18\x01 \x01(\v2\x13.gastown.v1.MessageR\amessage\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\"\xe1\x04\n" +
 	"\aMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
 	"\x04from\x18\x02 \x01(\v2\x18.gastown.v1.AgentAddressR\x04from\x12(\n" +
@@ -890,7 +1290,16 @@ const file_gastown_v1_mail_proto_rawDesc = "" +
 	"\tthread_id\x18\v \x01(\tR\bthreadId\x12\x19\n" +
 	"\breply_to\x18\f \x01(\tR\areplyTo\x12\x16\n" +
 	"\x06pinned\x18\r \x01(\bR\x06pinned\x12(\n" +
-	"\x02cc\x18\x0e \x03(\v2\x18.gastown.v1.AgentAddressR\x02cc*\x94\x01\n" +
+	"\x02cc\x18\x0e \x03(\v2\x18.gastown.v1.AgentAddressR\x02cc\x128\n" +
+	"\vattachments\x18\x0f \x03(\v2\x16.gastown.v1.AttachmentR\vattachments\x12-\n" +
+	"\areceipt\x18\x10 \x01(\x0e2\x13.gastown.v1.ReceiptR\areceipt\"\x7f\n" +
+	"\n" +
+	"Attachment\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data*\x94\x01\n" +
 	"\vMessageType\x12\x1c\n" +
 	"\x18MESSAGE_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11MESSAGE_TYPE_TASK\x10\x01\x12\x19\n" +
@@ -900,7 +1309,11 @@ const file_gastown_v1_mail_proto_rawDesc = "" +
 	"\bDelivery\x12\x18\n" +
 	"\x14DELIVERY_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eDELIVERY_QUEUE\x10\x01\x12\x16\n" +
-	"\x12DELIVERY_INTERRUPT\x10\x022\xd8\x03\n" +
+	"\x12DELIVERY_INTERRUPT\x10\x02*G\n" +
+	"\aReceipt\x12\x17\n" +
+	"\x13RECEIPT_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rRECEIPT_EVENT\x10\x01\x12\x10\n" +
+	"\fRECEIPT_MAIL\x10\x022\xa2\x04\n" +
 	"\vMailService\x12H\n" +
 	"\tListInbox\x12\x1c.gastown.v1.ListInboxRequest\x1a\x1d.gastown.v1.ListInboxResponse\x12N\n" +
 	"\vReadMessage\x12\x1e.gastown.v1.ReadMessageRequest\x1a\x1f.gastown.v1.ReadMessageResponse\x12N\n" +
@@ -908,7 +1321,8 @@ const file_gastown_v1_mail_proto_rawDesc = "" +
 	"\bMarkRead\x12\x1b.gastown.v1.MarkReadRequest\x1a\x1c.gastown.v1.MarkReadResponse\x12T\n" +
 	"\rDeleteMessage\x12 .gastown.v1.DeleteMessageRequest\x1a!.gastown.v1.DeleteMessageResponse\x12B\n" +
 	"\n" +
-	"WatchInbox\x12\x1d.gastown.v1.WatchInboxRequest\x1a\x13.gastown.v1.Message0\x01B\x9c\x01\n" +
+	"WatchInbox\x12\x1d.gastown.v1.WatchInboxRequest\x1a\x13.gastown.v1.Message0\x01\x12H\n" +
+	"\tGetThread\x12\x1c.gastown.v1.GetThreadRequest\x1a\x1d.gastown.v1.GetThreadResponseB\x9c\x01\n" +
 	"\x0ecom.gastown.v1B\tMailProtoP\x01Z6github.com/steveyegge/gastown/gen/gastown/v1;gastownv1\xa2\x02\x03GXX\xaa\x02\n" +
 	"Gastown.V1\xca\x02\n" +
 	"Gastown\\V1\xe2\x02\x16Gastown\\V1\\GPBMetadata\xea\x02\vGastown::V1b\x06proto3"
@@ -925,61 +1339,77 @@ func file_gastown_v1_mail_proto_rawDescGZIP() []byte {
 	return file_gastown_v1_mail_proto_rawDescData
 }
 
-var file_gastown_v1_mail_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_gastown_v1_mail_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_gastown_v1_mail_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_gastown_v1_mail_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_gastown_v1_mail_proto_goTypes = []any{
 	(MessageType)(0),              // 0: gastown.v1.MessageType
 	(Delivery)(0),                 // 1: gastown.v1.Delivery
-	(*ListInboxRequest)(nil),      // 2: gastown.v1.ListInboxRequest
-	(*ListInboxResponse)(nil),     // 3: gastown.v1.ListInboxResponse
-	(*ReadMessageRequest)(nil),    // 4: gastown.v1.ReadMessageRequest
-	(*ReadMessageResponse)(nil),   // 5: gastown.v1.ReadMessageResponse
-	(*SendMessageRequest)(nil),    // 6: gastown.v1.SendMessageRequest
-	(*SendMessageResponse)(nil),   // 7: gastown.v1.SendMessageResponse
-	(*MarkReadRequest)(nil),       // 8: gastown.v1.MarkReadRequest
-	(*MarkReadResponse)(nil),      // 9: gastown.v1.MarkReadResponse
-	(*DeleteMessageRequest)(nil),  // 10: gastown.v1.DeleteMessageRequest
-	(*DeleteMessageResponse)(nil), // 11: gastown.v1.DeleteMessageResponse
-	(*WatchInboxRequest)(nil),     // 12: gastown.v1.WatchInboxRequest
-	(*Message)(nil),               // 13: gastown.v1.Message
-	(*AgentAddress)(nil),          // 14: gastown.v1.AgentAddress
-	(Priority)(0),                 // 15: gastown.v1.Priority
-	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
+	(Receipt)(0),                  // 2: gastown.v1.Receipt
+	(*ListInboxRequest)(nil),      // 3: gastown.v1.ListInboxRequest
+	(*ListInboxResponse)(nil),     // 4: gastown.v1.ListInboxResponse
+	(*ReadMessageRequest)(nil),    // 5: gastown.v1.ReadMessageRequest
+	(*ReadMessageResponse)(nil),   // 6: gastown.v1.ReadMessageResponse
+	(*SendMessageRequest)(nil),    // 7: gastown.v1.SendMessageRequest
+	(*SendMessageResponse)(nil),   // 8: gastown.v1.SendMessageResponse
+	(*MarkReadRequest)(nil),       // 9: gastown.v1.MarkReadRequest
+	(*MarkReadResponse)(nil),      // 10: gastown.v1.MarkReadResponse
+	(*DeleteMessageRequest)(nil),  // 11: gastown.v1.DeleteMessageRequest
+	(*DeleteMessageResponse)(nil), // 12: gastown.v1.DeleteMessageResponse
+	(*WatchInboxRequest)(nil),     // 13: gastown.v1.WatchInboxRequest
+	(*GetThreadRequest)(nil),      // 14: gastown.v1.GetThreadRequest
+	(*GetThreadResponse)(nil),     // 15: gastown.v1.GetThreadResponse
+	(*ThreadEntry)(nil),           // 16: gastown.v1.ThreadEntry
+	(*Message)(nil),               // 17: gastown.v1.Message
+	(*Attachment)(nil),            // 18: gastown.v1.Attachment
+	(*AgentAddress)(nil),          // 19: gastown.v1.AgentAddress
+	(Priority)(0),                 // 20: gastown.v1.Priority
+	(*timestamppb.Timestamp)(nil), // 21: google.protobuf.Timestamp
 }
 var file_gastown_v1_mail_proto_depIdxs = []int32{
-	14, // 0: gastown.v1.ListInboxRequest.address:type_name -> gastown.v1.AgentAddress
-	13, // 1: gastown.v1.ListInboxResponse.messages:type_name -> gastown.v1.Message
-	13, // 2: gastown.v1.ReadMessageResponse.message:type_name -> gastown.v1.Message
-	14, // 3: gastown.v1.SendMessageRequest.to:type_name -> gastown.v1.AgentAddress
-	15, // 4: gastown.v1.SendMessageRequest.priority:type_name -> gastown.v1.Priority
-	0,  // 5: gastown.v1.SendMessageRequest.type:type_name -> gastown.v1.MessageType
-	1,  // 6: gastown.v1.SendMessageRequest.delivery:type_name -> gastown.v1.Delivery
-	14, // 7: gastown.v1.SendMessageRequest.cc:type_name -> gastown.v1.AgentAddress
-	14, // 8: gastown.v1.WatchInboxRequest.address:type_name -> gastown.v1.AgentAddress
-	14, // 9: gastown.v1.Message.from:type_name -> gastown.v1.AgentAddress
-	14, // 10: gastown.v1.Message.to:type_name -> gastown.v1.AgentAddress
-	16, // 11: gastown.v1.Message.timestamp:type_name -> google.protobuf.Timestamp
-	15, // 12: gastown.v1.Message.priority:type_name -> gastown.v1.Priority
-	0,  // 13: gastown.v1.Message.type:type_name -> gastown.v1.MessageType
-	1,  // 14: gastown.v1.Message.delivery:type_name -> gastown.v1.Delivery
-	14, // 15: gastown.v1.Message.cc:type_name -> gastown.v1.AgentAddress
-	2,  // 16: gastown.v1.MailService.ListInbox:input_type -> gastown.v1.ListInboxRequest
-	4,  // 17: gastown.v1.MailService.ReadMessage:input_type -> gastown.v1.ReadMessageRequest
-	6,  // 18: gastown.v1.MailService.SendMessage:input_type -> gastown.v1.SendMessageRequest
-	8,  // 19: gastown.v1.MailService.MarkRead:input_type -> gastown.v1.MarkReadRequest
-	10, // 20: gastown.v1.MailService.DeleteMessage:input_type -> gastown.v1.DeleteMessageRequest
-	12, // 21: gastown.v1.MailService.WatchInbox:input_type -> gastown.v1.WatchInboxRequest
-	3,  // 22: gastown.v1.MailService.ListInbox:output_type -> gastown.v1.ListInboxResponse
-	5,  // 23: gastown.v1.MailService.ReadMessage:output_type -> gastown.v1.ReadMessageResponse
-	7,  // 24: gastown.v1.MailService.SendMessage:output_type -> gastown.v1.SendMessageResponse
-	9,  // 25: gastown.v1.MailService.MarkRead:output_type -> gastown.v1.MarkReadResponse
-	11, // 26: gastown.v1.MailService.DeleteMessage:output_type -> gastown.v1.DeleteMessageResponse
-	13, // 27: gastown.v1.MailService.WatchInbox:output_type -> gastown.v1.Message
-	22, // [22:28] is the sub-list for method output_type
-	16, // [16:22] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	19, // 0: gastown.v1.ListInboxRequest.address:type_name -> gastown.v1.AgentAddress
+	20, // 1: gastown.v1.ListInboxRequest.min_priority:type_name -> gastown.v1.Priority
+	21, // 2: gastown.v1.ListInboxRequest.since:type_name -> google.protobuf.Timestamp
+	21, // 3: gastown.v1.ListInboxRequest.until:type_name -> google.protobuf.Timestamp
+	17, // 4: gastown.v1.ListInboxResponse.messages:type_name -> gastown.v1.Message
+	17, // 5: gastown.v1.ReadMessageResponse.message:type_name -> gastown.v1.Message
+	19, // 6: gastown.v1.SendMessageRequest.to:type_name -> gastown.v1.AgentAddress
+	20, // 7: gastown.v1.SendMessageRequest.priority:type_name -> gastown.v1.Priority
+	0,  // 8: gastown.v1.SendMessageRequest.type:type_name -> gastown.v1.MessageType
+	1,  // 9: gastown.v1.SendMessageRequest.delivery:type_name -> gastown.v1.Delivery
+	19, // 10: gastown.v1.SendMessageRequest.cc:type_name -> gastown.v1.AgentAddress
+	18, // 11: gastown.v1.SendMessageRequest.attachments:type_name -> gastown.v1.Attachment
+	2,  // 12: gastown.v1.SendMessageRequest.receipt:type_name -> gastown.v1.Receipt
+	19, // 13: gastown.v1.WatchInboxRequest.address:type_name -> gastown.v1.AgentAddress
+	16, // 14: gastown.v1.GetThreadResponse.entries:type_name -> gastown.v1.ThreadEntry
+	17, // 15: gastown.v1.ThreadEntry.message:type_name -> gastown.v1.Message
+	19, // 16: gastown.v1.Message.from:type_name -> gastown.v1.AgentAddress
+	19, // 17: gastown.v1.Message.to:type_name -> gastown.v1.AgentAddress
+	21, // 18: gastown.v1.Message.timestamp:type_name -> google.protobuf.Timestamp
+	20, // 19: gastown.v1.Message.priority:type_name -> gastown.v1.Priority
+	0,  // 20: gastown.v1.Message.type:type_name -> gastown.v1.MessageType
+	1,  // 21: gastown.v1.Message.delivery:type_name -> gastown.v1.Delivery
+	19, // 22: gastown.v1.Message.cc:type_name -> gastown.v1.AgentAddress
+	18, // 23: gastown.v1.Message.attachments:type_name -> gastown.v1.Attachment
+	2,  // 24: gastown.v1.Message.receipt:type_name -> gastown.v1.Receipt
+	3,  // 25: gastown.v1.MailService.ListInbox:input_type -> gastown.v1.ListInboxRequest
+	5,  // 26: gastown.v1.MailService.ReadMessage:input_type -> gastown.v1.ReadMessageRequest
+	7,  // 27: gastown.v1.MailService.SendMessage:input_type -> gastown.v1.SendMessageRequest
+	9,  // 28: gastown.v1.MailService.MarkRead:input_type -> gastown.v1.MarkReadRequest
+	11, // 29: gastown.v1.MailService.DeleteMessage:input_type -> gastown.v1.DeleteMessageRequest
+	13, // 30: gastown.v1.MailService.WatchInbox:input_type -> gastown.v1.WatchInboxRequest
+	14, // 31: gastown.v1.MailService.GetThread:input_type -> gastown.v1.GetThreadRequest
+	4,  // 32: gastown.v1.MailService.ListInbox:output_type -> gastown.v1.ListInboxResponse
+	6,  // 33: gastown.v1.MailService.ReadMessage:output_type -> gastown.v1.ReadMessageResponse
+	8,  // 34: gastown.v1.MailService.SendMessage:output_type -> gastown.v1.SendMessageResponse
+	10, // 35: gastown.v1.MailService.MarkRead:output_type -> gastown.v1.MarkReadResponse
+	12, // 36: gastown.v1.MailService.DeleteMessage:output_type -> gastown.v1.DeleteMessageResponse
+	17, // 37: gastown.v1.MailService.WatchInbox:output_type -> gastown.v1.Message
+	15, // 38: gastown.v1.MailService.GetThread:output_type -> gastown.v1.GetThreadResponse
+	32, // [32:39] is the sub-list for method output_type
+	25, // [25:32] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_gastown_v1_mail_proto_init() }
@@ -993,8 +1423,8 @@ func file_gastown_v1_mail_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastown_v1_mail_proto_rawDesc), len(file_gastown_v1_mail_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   12,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,484 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: gastown/v1/molecule.proto
+
+package gastownv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Step state within a molecule.
+type MoleculeStepState int32
+
+const (
+	MoleculeStepState_MOLECULE_STEP_STATE_UNSPECIFIED MoleculeStepState = 0
+	MoleculeStepState_MOLECULE_STEP_STATE_DONE        MoleculeStepState = 1
+	MoleculeStepState_MOLECULE_STEP_STATE_IN_PROGRESS MoleculeStepState = 2
+	MoleculeStepState_MOLECULE_STEP_STATE_READY       MoleculeStepState = 3 // Open, every blocker closed
+	MoleculeStepState_MOLECULE_STEP_STATE_BLOCKED     MoleculeStepState = 4 // Open, waiting on blocked_by
+)
+
+// Enum value maps for MoleculeStepState.
+var (
+	MoleculeStepState_name = map[int32]string{
+		0: "MOLECULE_STEP_STATE_UNSPECIFIED",
+		1: "MOLECULE_STEP_STATE_DONE",
+		2: "MOLECULE_STEP_STATE_IN_PROGRESS",
+		3: "MOLECULE_STEP_STATE_READY",
+		4: "MOLECULE_STEP_STATE_BLOCKED",
+	}
+	MoleculeStepState_value = map[string]int32{
+		"MOLECULE_STEP_STATE_UNSPECIFIED": 0,
+		"MOLECULE_STEP_STATE_DONE":        1,
+		"MOLECULE_STEP_STATE_IN_PROGRESS": 2,
+		"MOLECULE_STEP_STATE_READY":       3,
+		"MOLECULE_STEP_STATE_BLOCKED":     4,
+	}
+)
+
+func (x MoleculeStepState) Enum() *MoleculeStepState {
+	p := new(MoleculeStepState)
+	*p = x
+	return p
+}
+
+func (x MoleculeStepState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MoleculeStepState) Descriptor() protoreflect.EnumDescriptor {
+	return file_gastown_v1_molecule_proto_enumTypes[0].Descriptor()
+}
+
+func (MoleculeStepState) Type() protoreflect.EnumType {
+	return &file_gastown_v1_molecule_proto_enumTypes[0]
+}
+
+func (x MoleculeStepState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MoleculeStepState.Descriptor instead.
+func (MoleculeStepState) EnumDescriptor() ([]byte, []int) {
+	return file_gastown_v1_molecule_proto_rawDescGZIP(), []int{0}
+}
+
+type GetMoleculeProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BeadId        string                 `protobuf:"bytes,1,opt,name=bead_id,json=beadId,proto3" json:"bead_id,omitempty"` // Hooked bead with an attached molecule, or a molecule root
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMoleculeProgressRequest) Reset() {
+	*x = GetMoleculeProgressRequest{}
+	mi := &file_gastown_v1_molecule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMoleculeProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMoleculeProgressRequest) ProtoMessage() {}
+
+func (x *GetMoleculeProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_molecule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMoleculeProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetMoleculeProgressRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_molecule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetMoleculeProgressRequest) GetBeadId() string {
+	if x != nil {
+		return x.BeadId
+	}
+	return ""
+}
+
+type GetMoleculeProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Progress      *MoleculeProgress      `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMoleculeProgressResponse) Reset() {
+	*x = GetMoleculeProgressResponse{}
+	mi := &file_gastown_v1_molecule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMoleculeProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMoleculeProgressResponse) ProtoMessage() {}
+
+func (x *GetMoleculeProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_molecule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMoleculeProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetMoleculeProgressResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_molecule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetMoleculeProgressResponse) GetProgress() *MoleculeProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+// Progress of one molecule instance
+type MoleculeProgress struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BeadId          string                 `protobuf:"bytes,1,opt,name=bead_id,json=beadId,proto3" json:"bead_id,omitempty"` // Bead the progress was requested for
+	RootId          string                 `protobuf:"bytes,2,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"` // Molecule root the steps hang off
+	RootTitle       string                 `protobuf:"bytes,3,opt,name=root_title,json=rootTitle,proto3" json:"root_title,omitempty"`
+	MoleculeId      string                 `protobuf:"bytes,4,opt,name=molecule_id,json=moleculeId,proto3" json:"molecule_id,omitempty"` // Proto the steps were instantiated from, if known
+	TotalSteps      int32                  `protobuf:"varint,5,opt,name=total_steps,json=totalSteps,proto3" json:"total_steps,omitempty"`
+	DoneSteps       int32                  `protobuf:"varint,6,opt,name=done_steps,json=doneSteps,proto3" json:"done_steps,omitempty"`
+	InProgressSteps int32                  `protobuf:"varint,7,opt,name=in_progress_steps,json=inProgressSteps,proto3" json:"in_progress_steps,omitempty"`
+	PercentComplete int32                  `protobuf:"varint,8,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	Complete        bool                   `protobuf:"varint,9,opt,name=complete,proto3" json:"complete,omitempty"`
+	CurrentStep     *MoleculeStep          `protobuf:"bytes,10,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"` // First in-progress step, else first ready; unset when none
+	Steps           []*MoleculeStep        `protobuf:"bytes,11,rep,name=steps,proto3" json:"steps,omitempty"`
+	Blockers        []*MoleculeStep        `protobuf:"bytes,12,rep,name=blockers,proto3" json:"blockers,omitempty"` // Steps in the BLOCKED state
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MoleculeProgress) Reset() {
+	*x = MoleculeProgress{}
+	mi := &file_gastown_v1_molecule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoleculeProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoleculeProgress) ProtoMessage() {}
+
+func (x *MoleculeProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_molecule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoleculeProgress.ProtoReflect.Descriptor instead.
+func (*MoleculeProgress) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_molecule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MoleculeProgress) GetBeadId() string {
+	if x != nil {
+		return x.BeadId
+	}
+	return ""
+}
+
+func (x *MoleculeProgress) GetRootId() string {
+	if x != nil {
+		return x.RootId
+	}
+	return ""
+}
+
+func (x *MoleculeProgress) GetRootTitle() string {
+	if x != nil {
+		return x.RootTitle
+	}
+	return ""
+}
+
+func (x *MoleculeProgress) GetMoleculeId() string {
+	if x != nil {
+		return x.MoleculeId
+	}
+	return ""
+}
+
+func (x *MoleculeProgress) GetTotalSteps() int32 {
+	if x != nil {
+		return x.TotalSteps
+	}
+	return 0
+}
+
+func (x *MoleculeProgress) GetDoneSteps() int32 {
+	if x != nil {
+		return x.DoneSteps
+	}
+	return 0
+}
+
+func (x *MoleculeProgress) GetInProgressSteps() int32 {
+	if x != nil {
+		return x.InProgressSteps
+	}
+	return 0
+}
+
+func (x *MoleculeProgress) GetPercentComplete() int32 {
+	if x != nil {
+		return x.PercentComplete
+	}
+	return 0
+}
+
+func (x *MoleculeProgress) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *MoleculeProgress) GetCurrentStep() *MoleculeStep {
+	if x != nil {
+		return x.CurrentStep
+	}
+	return nil
+}
+
+func (x *MoleculeProgress) GetSteps() []*MoleculeStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *MoleculeProgress) GetBlockers() []*MoleculeStep {
+	if x != nil {
+		return x.Blockers
+	}
+	return nil
+}
+
+// A step bead in a molecule
+type MoleculeStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // Bead status
+	State         MoleculeStepState      `protobuf:"varint,4,opt,name=state,proto3,enum=gastown.v1.MoleculeStepState" json:"state,omitempty"`
+	BlockedBy     []string               `protobuf:"bytes,5,rep,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty"` // Open beads blocking this step
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoleculeStep) Reset() {
+	*x = MoleculeStep{}
+	mi := &file_gastown_v1_molecule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoleculeStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoleculeStep) ProtoMessage() {}
+
+func (x *MoleculeStep) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_molecule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoleculeStep.ProtoReflect.Descriptor instead.
+func (*MoleculeStep) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_molecule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MoleculeStep) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoleculeStep) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MoleculeStep) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MoleculeStep) GetState() MoleculeStepState {
+	if x != nil {
+		return x.State
+	}
+	return MoleculeStepState_MOLECULE_STEP_STATE_UNSPECIFIED
+}
+
+func (x *MoleculeStep) GetBlockedBy() []string {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return nil
+}
+
+var File_gastown_v1_molecule_proto protoreflect.FileDescriptor
+
+const file_gastown_v1_molecule_proto_rawDesc = "" +
+	"\n" +
+	"\x19gastown/v1/molecule.proto\x12\n" +
+	"gastown.v1\"5\n" +
+	"\x1aGetMoleculeProgressRequest\x12\x17\n" +
+	"\abead_id\x18\x01 \x01(\tR\x06beadId\"W\n" +
+	"\x1bGetMoleculeProgressResponse\x128\n" +
+	"\bprogress\x18\x01 \x01(\v2\x1c.gastown.v1.MoleculeProgressR\bprogress\"\xda\x03\n" +
+	"\x10MoleculeProgress\x12\x17\n" +
+	"\abead_id\x18\x01 \x01(\tR\x06beadId\x12\x17\n" +
+	"\aroot_id\x18\x02 \x01(\tR\x06rootId\x12\x1d\n" +
+	"\n" +
+	"root_title\x18\x03 \x01(\tR\trootTitle\x12\x1f\n" +
+	"\vmolecule_id\x18\x04 \x01(\tR\n" +
+	"moleculeId\x12\x1f\n" +
+	"\vtotal_steps\x18\x05 \x01(\x05R\n" +
+	"totalSteps\x12\x1d\n" +
+	"\n" +
+	"done_steps\x18\x06 \x01(\x05R\tdoneSteps\x12*\n" +
+	"\x11in_progress_steps\x18\a \x01(\x05R\x0finProgressSteps\x12)\n" +
+	"\x10percent_complete\x18\b \x01(\x05R\x0fpercentComplete\x12\x1a\n" +
+	"\bcomplete\x18\t \x01(\bR\bcomplete\x12;\n" +
+	"\fcurrent_step\x18\n" +
+	" \x01(\v2\x18.gastown.v1.MoleculeStepR\vcurrentStep\x12.\n" +
+	"\x05steps\x18\v \x03(\v2\x18.gastown.v1.MoleculeStepR\x05steps\x124\n" +
+	"\bblockers\x18\f \x03(\v2\x18.gastown.v1.MoleculeStepR\bblockers\"\xa0\x01\n" +
+	"\fMoleculeStep\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x123\n" +
+	"\x05state\x18\x04 \x01(\x0e2\x1d.gastown.v1.MoleculeStepStateR\x05state\x12\x1d\n" +
+	"\n" +
+	"blocked_by\x18\x05 \x03(\tR\tblockedBy*\xbb\x01\n" +
+	"\x11MoleculeStepState\x12#\n" +
+	"\x1fMOLECULE_STEP_STATE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18MOLECULE_STEP_STATE_DONE\x10\x01\x12#\n" +
+	"\x1fMOLECULE_STEP_STATE_IN_PROGRESS\x10\x02\x12\x1d\n" +
+	"\x19MOLECULE_STEP_STATE_READY\x10\x03\x12\x1f\n" +
+	"\x1bMOLECULE_STEP_STATE_BLOCKED\x10\x042q\n" +
+	"\x0fMoleculeService\x12^\n" +
+	"\vGetProgress\x12&.gastown.v1.GetMoleculeProgressRequest\x1a'.gastown.v1.GetMoleculeProgressResponseB\xa0\x01\n" +
+	"\x0ecom.gastown.v1B\rMoleculeProtoP\x01Z6github.com/steveyegge/gastown/gen/gastown/v1;gastownv1\xa2\x02\x03GXX\xaa\x02\n" +
+	"Gastown.V1\xca\x02\n" +
+	"Gastown\\V1\xe2\x02\x16Gastown\\V1\\GPBMetadata\xea\x02\vGastown::V1b\x06proto3"
+
+var (
+	file_gastown_v1_molecule_proto_rawDescOnce sync.Once
+	file_gastown_v1_molecule_proto_rawDescData []byte
+)
+
+func file_gastown_v1_molecule_proto_rawDescGZIP() []byte {
+	file_gastown_v1_molecule_proto_rawDescOnce.Do(func() {
+		file_gastown_v1_molecule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gastown_v1_molecule_proto_rawDesc), len(file_gastown_v1_molecule_proto_rawDesc)))
+	})
+	return file_gastown_v1_molecule_proto_rawDescData
+}
+
+var file_gastown_v1_molecule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gastown_v1_molecule_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_gastown_v1_molecule_proto_goTypes = []any{
+	(MoleculeStepState)(0),              // 0: gastown.v1.MoleculeStepState
+	(*GetMoleculeProgressRequest)(nil),  // 1: gastown.v1.GetMoleculeProgressRequest
+	(*GetMoleculeProgressResponse)(nil), // 2: gastown.v1.GetMoleculeProgressResponse
+	(*MoleculeProgress)(nil),            // 3: gastown.v1.MoleculeProgress
+	(*MoleculeStep)(nil),                // 4: gastown.v1.MoleculeStep
+}
+var file_gastown_v1_molecule_proto_depIdxs = []int32{
+	3, // 0: gastown.v1.GetMoleculeProgressResponse.progress:type_name -> gastown.v1.MoleculeProgress
+	4, // 1: gastown.v1.MoleculeProgress.current_step:type_name -> gastown.v1.MoleculeStep
+	4, // 2: gastown.v1.MoleculeProgress.steps:type_name -> gastown.v1.MoleculeStep
+	4, // 3: gastown.v1.MoleculeProgress.blockers:type_name -> gastown.v1.MoleculeStep
+	0, // 4: gastown.v1.MoleculeStep.state:type_name -> gastown.v1.MoleculeStepState
+	1, // 5: gastown.v1.MoleculeService.GetProgress:input_type -> gastown.v1.GetMoleculeProgressRequest
+	2, // 6: gastown.v1.MoleculeService.GetProgress:output_type -> gastown.v1.GetMoleculeProgressResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_gastown_v1_molecule_proto_init() }
+func file_gastown_v1_molecule_proto_init() {
+	if File_gastown_v1_molecule_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastown_v1_molecule_proto_rawDesc), len(file_gastown_v1_molecule_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gastown_v1_molecule_proto_goTypes,
+		DependencyIndexes: file_gastown_v1_molecule_proto_depIdxs,
+		EnumInfos:         file_gastown_v1_molecule_proto_enumTypes,
+		MessageInfos:      file_gastown_v1_molecule_proto_msgTypes,
+	}.Build()
+	File_gastown_v1_molecule_proto = out.File
+	file_gastown_v1_molecule_proto_goTypes = nil
+	file_gastown_v1_molecule_proto_depIdxs = nil
+}
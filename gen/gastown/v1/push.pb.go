@@ -0,0 +1,700 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: gastown/v1/push.proto
+
+package gastownv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PushPlatform int32
+
+const (
+	PushPlatform_PUSH_PLATFORM_UNSPECIFIED PushPlatform = 0
+	PushPlatform_PUSH_PLATFORM_APNS        PushPlatform = 1 // iOS
+	PushPlatform_PUSH_PLATFORM_FCM         PushPlatform = 2 // Android
+)
+
+// Enum value maps for PushPlatform.
+var (
+	PushPlatform_name = map[int32]string{
+		0: "PUSH_PLATFORM_UNSPECIFIED",
+		1: "PUSH_PLATFORM_APNS",
+		2: "PUSH_PLATFORM_FCM",
+	}
+	PushPlatform_value = map[string]int32{
+		"PUSH_PLATFORM_UNSPECIFIED": 0,
+		"PUSH_PLATFORM_APNS":        1,
+		"PUSH_PLATFORM_FCM":         2,
+	}
+)
+
+func (x PushPlatform) Enum() *PushPlatform {
+	p := new(PushPlatform)
+	*p = x
+	return p
+}
+
+func (x PushPlatform) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PushPlatform) Descriptor() protoreflect.EnumDescriptor {
+	return file_gastown_v1_push_proto_enumTypes[0].Descriptor()
+}
+
+func (PushPlatform) Type() protoreflect.EnumType {
+	return &file_gastown_v1_push_proto_enumTypes[0]
+}
+
+func (x PushPlatform) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PushPlatform.Descriptor instead.
+func (PushPlatform) EnumDescriptor() ([]byte, []int) {
+	return file_gastown_v1_push_proto_rawDescGZIP(), []int{0}
+}
+
+// A device registered for push notifications
+type Device struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Platform      PushPlatform           `protobuf:"varint,2,opt,name=platform,proto3,enum=gastown.v1.PushPlatform" json:"platform,omitempty"`
+	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"` // API key name or user
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`   // e.g. "Steve's iPhone"
+	AppVersion    string                 `protobuf:"bytes,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	RegisteredAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_gastown_v1_push_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_push_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_push_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Device) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Device) GetPlatform() PushPlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return PushPlatform_PUSH_PLATFORM_UNSPECIFIED
+}
+
+func (x *Device) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Device) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Device) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *Device) GetRegisteredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return nil
+}
+
+func (x *Device) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+type RegisterDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                     // Required: APNs device token or FCM registration token
+	Platform      PushPlatform           `protobuf:"varint,2,opt,name=platform,proto3,enum=gastown.v1.PushPlatform" json:"platform,omitempty"` // Required
+	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	AppVersion    string                 `protobuf:"bytes,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceRequest) Reset() {
+	*x = RegisterDeviceRequest{}
+	mi := &file_gastown_v1_push_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceRequest) ProtoMessage() {}
+
+func (x *RegisterDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_push_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_push_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterDeviceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RegisterDeviceRequest) GetPlatform() PushPlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return PushPlatform_PUSH_PLATFORM_UNSPECIFIED
+}
+
+func (x *RegisterDeviceRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *RegisterDeviceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterDeviceRequest) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+type RegisterDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *Device                `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	PushEnabled   bool                   `protobuf:"varint,2,opt,name=push_enabled,json=pushEnabled,proto3" json:"push_enabled,omitempty"` // False if the server has no credentials for the platform yet
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceResponse) Reset() {
+	*x = RegisterDeviceResponse{}
+	mi := &file_gastown_v1_push_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceResponse) ProtoMessage() {}
+
+func (x *RegisterDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_push_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceResponse.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_push_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RegisterDeviceResponse) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *RegisterDeviceResponse) GetPushEnabled() bool {
+	if x != nil {
+		return x.PushEnabled
+	}
+	return false
+}
+
+type UnregisterDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterDeviceRequest) Reset() {
+	*x = UnregisterDeviceRequest{}
+	mi := &file_gastown_v1_push_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterDeviceRequest) ProtoMessage() {}
+
+func (x *UnregisterDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_push_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterDeviceRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_push_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnregisterDeviceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UnregisterDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       bool                   `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"` // False if the token wasn't registered
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterDeviceResponse) Reset() {
+	*x = UnregisterDeviceResponse{}
+	mi := &file_gastown_v1_push_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterDeviceResponse) ProtoMessage() {}
+
+func (x *UnregisterDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_push_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterDeviceResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_push_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnregisterDeviceResponse) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+type ListDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"` // Required: only this owner's devices are listed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesRequest) Reset() {
+	*x = ListDevicesRequest{}
+	mi := &file_gastown_v1_push_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesRequest) ProtoMessage() {}
+
+func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_push_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_push_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListDevicesRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type ListDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*Device              `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"` // Oldest registration first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesResponse) Reset() {
+	*x = ListDevicesResponse{}
+	mi := &file_gastown_v1_push_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse) ProtoMessage() {}
+
+func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_push_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_push_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListDevicesResponse) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type SendTestPushRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Device to notify; empty for all devices
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestPushRequest) Reset() {
+	*x = SendTestPushRequest{}
+	mi := &file_gastown_v1_push_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestPushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestPushRequest) ProtoMessage() {}
+
+func (x *SendTestPushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_push_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestPushRequest.ProtoReflect.Descriptor instead.
+func (*SendTestPushRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_push_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SendTestPushRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SendTestPushResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sent          int32                  `protobuf:"varint,1,opt,name=sent,proto3" json:"sent,omitempty"`
+	Removed       int32                  `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"` // Devices unregistered because their token was rejected
+	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestPushResponse) Reset() {
+	*x = SendTestPushResponse{}
+	mi := &file_gastown_v1_push_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestPushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestPushResponse) ProtoMessage() {}
+
+func (x *SendTestPushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_push_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestPushResponse.ProtoReflect.Descriptor instead.
+func (*SendTestPushResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_push_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SendTestPushResponse) GetSent() int32 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *SendTestPushResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *SendTestPushResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+var File_gastown_v1_push_proto protoreflect.FileDescriptor
+
+const file_gastown_v1_push_proto_rawDesc = "" +
+	"\n" +
+	"\x15gastown/v1/push.proto\x12\n" +
+	"gastown.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9e\x02\n" +
+	"\x06Device\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x124\n" +
+	"\bplatform\x18\x02 \x01(\x0e2\x18.gastown.v1.PushPlatformR\bplatform\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1f\n" +
+	"\vapp_version\x18\x05 \x01(\tR\n" +
+	"appVersion\x12?\n" +
+	"\rregistered_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fregisteredAt\x12<\n" +
+	"\flast_seen_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\"\xae\x01\n" +
+	"\x15RegisterDeviceRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x124\n" +
+	"\bplatform\x18\x02 \x01(\x0e2\x18.gastown.v1.PushPlatformR\bplatform\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1f\n" +
+	"\vapp_version\x18\x05 \x01(\tR\n" +
+	"appVersion\"g\n" +
+	"\x16RegisterDeviceResponse\x12*\n" +
+	"\x06device\x18\x01 \x01(\v2\x12.gastown.v1.DeviceR\x06device\x12!\n" +
+	"\fpush_enabled\x18\x02 \x01(\bR\vpushEnabled\"/\n" +
+	"\x17UnregisterDeviceRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"4\n" +
+	"\x18UnregisterDeviceResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\bR\aremoved\"*\n" +
+	"\x12ListDevicesRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\"C\n" +
+	"\x13ListDevicesResponse\x12,\n" +
+	"\adevices\x18\x01 \x03(\v2\x12.gastown.v1.DeviceR\adevices\"+\n" +
+	"\x13SendTestPushRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\\\n" +
+	"\x14SendTestPushResponse\x12\x12\n" +
+	"\x04sent\x18\x01 \x01(\x05R\x04sent\x12\x18\n" +
+	"\aremoved\x18\x02 \x01(\x05R\aremoved\x12\x16\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errors*\\\n" +
+	"\fPushPlatform\x12\x1d\n" +
+	"\x19PUSH_PLATFORM_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PUSH_PLATFORM_APNS\x10\x01\x12\x15\n" +
+	"\x11PUSH_PLATFORM_FCM\x10\x022\xe8\x02\n" +
+	"\vPushService\x12W\n" +
+	"\x0eRegisterDevice\x12!.gastown.v1.RegisterDeviceRequest\x1a\".gastown.v1.RegisterDeviceResponse\x12]\n" +
+	"\x10UnregisterDevice\x12#.gastown.v1.UnregisterDeviceRequest\x1a$.gastown.v1.UnregisterDeviceResponse\x12N\n" +
+	"\vListDevices\x12\x1e.gastown.v1.ListDevicesRequest\x1a\x1f.gastown.v1.ListDevicesResponse\x12Q\n" +
+	"\fSendTestPush\x12\x1f.gastown.v1.SendTestPushRequest\x1a .gastown.v1.SendTestPushResponseB\x9c\x01\n" +
+	"\x0ecom.gastown.v1B\tPushProtoP\x01Z6github.com/steveyegge/gastown/gen/gastown/v1;gastownv1\xa2\x02\x03GXX\xaa\x02\n" +
+	"Gastown.V1\xca\x02\n" +
+	"Gastown\\V1\xe2\x02\x16Gastown\\V1\\GPBMetadata\xea\x02\vGastown::V1b\x06proto3"
+
+var (
+	file_gastown_v1_push_proto_rawDescOnce sync.Once
+	file_gastown_v1_push_proto_rawDescData []byte
+)
+
+func file_gastown_v1_push_proto_rawDescGZIP() []byte {
+	file_gastown_v1_push_proto_rawDescOnce.Do(func() {
+		file_gastown_v1_push_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gastown_v1_push_proto_rawDesc), len(file_gastown_v1_push_proto_rawDesc)))
+	})
+	return file_gastown_v1_push_proto_rawDescData
+}
+
+var file_gastown_v1_push_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gastown_v1_push_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_gastown_v1_push_proto_goTypes = []any{
+	(PushPlatform)(0),                // 0: gastown.v1.PushPlatform
+	(*Device)(nil),                   // 1: gastown.v1.Device
+	(*RegisterDeviceRequest)(nil),    // 2: gastown.v1.RegisterDeviceRequest
+	(*RegisterDeviceResponse)(nil),   // 3: gastown.v1.RegisterDeviceResponse
+	(*UnregisterDeviceRequest)(nil),  // 4: gastown.v1.UnregisterDeviceRequest
+	(*UnregisterDeviceResponse)(nil), // 5: gastown.v1.UnregisterDeviceResponse
+	(*ListDevicesRequest)(nil),       // 6: gastown.v1.ListDevicesRequest
+	(*ListDevicesResponse)(nil),      // 7: gastown.v1.ListDevicesResponse
+	(*SendTestPushRequest)(nil),      // 8: gastown.v1.SendTestPushRequest
+	(*SendTestPushResponse)(nil),     // 9: gastown.v1.SendTestPushResponse
+	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
+}
+var file_gastown_v1_push_proto_depIdxs = []int32{
+	0,  // 0: gastown.v1.Device.platform:type_name -> gastown.v1.PushPlatform
+	10, // 1: gastown.v1.Device.registered_at:type_name -> google.protobuf.Timestamp
+	10, // 2: gastown.v1.Device.last_seen_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: gastown.v1.RegisterDeviceRequest.platform:type_name -> gastown.v1.PushPlatform
+	1,  // 4: gastown.v1.RegisterDeviceResponse.device:type_name -> gastown.v1.Device
+	1,  // 5: gastown.v1.ListDevicesResponse.devices:type_name -> gastown.v1.Device
+	2,  // 6: gastown.v1.PushService.RegisterDevice:input_type -> gastown.v1.RegisterDeviceRequest
+	4,  // 7: gastown.v1.PushService.UnregisterDevice:input_type -> gastown.v1.UnregisterDeviceRequest
+	6,  // 8: gastown.v1.PushService.ListDevices:input_type -> gastown.v1.ListDevicesRequest
+	8,  // 9: gastown.v1.PushService.SendTestPush:input_type -> gastown.v1.SendTestPushRequest
+	3,  // 10: gastown.v1.PushService.RegisterDevice:output_type -> gastown.v1.RegisterDeviceResponse
+	5,  // 11: gastown.v1.PushService.UnregisterDevice:output_type -> gastown.v1.UnregisterDeviceResponse
+	7,  // 12: gastown.v1.PushService.ListDevices:output_type -> gastown.v1.ListDevicesResponse
+	9,  // 13: gastown.v1.PushService.SendTestPush:output_type -> gastown.v1.SendTestPushResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_gastown_v1_push_proto_init() }
+func file_gastown_v1_push_proto_init() {
+	if File_gastown_v1_push_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastown_v1_push_proto_rawDesc), len(file_gastown_v1_push_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gastown_v1_push_proto_goTypes,
+		DependencyIndexes: file_gastown_v1_push_proto_depIdxs,
+		EnumInfos:         file_gastown_v1_push_proto_enumTypes,
+		MessageInfos:      file_gastown_v1_push_proto_msgTypes,
+	}.Build()
+	File_gastown_v1_push_proto = out.File
+	file_gastown_v1_push_proto_goTypes = nil
+	file_gastown_v1_push_proto_depIdxs = nil
+}
@@ -22,6 +22,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// HealthProbe selects which components a HealthCheck covers.
+type HealthProbe int32
+
+const (
+	HealthProbe_HEALTH_PROBE_UNSPECIFIED HealthProbe = 0 // Every component
+	HealthProbe_HEALTH_PROBE_LIVENESS    HealthProbe = 1 // The process is up and serving
+	HealthProbe_HEALTH_PROBE_READINESS   HealthProbe = 2 // Dependencies needed to serve requests
+)
+
+// Enum value maps for HealthProbe.
+var (
+	HealthProbe_name = map[int32]string{
+		0: "HEALTH_PROBE_UNSPECIFIED",
+		1: "HEALTH_PROBE_LIVENESS",
+		2: "HEALTH_PROBE_READINESS",
+	}
+	HealthProbe_value = map[string]int32{
+		"HEALTH_PROBE_UNSPECIFIED": 0,
+		"HEALTH_PROBE_LIVENESS":    1,
+		"HEALTH_PROBE_READINESS":   2,
+	}
+)
+
+func (x HealthProbe) Enum() *HealthProbe {
+	p := new(HealthProbe)
+	*p = x
+	return p
+}
+
+func (x HealthProbe) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HealthProbe) Descriptor() protoreflect.EnumDescriptor {
+	return file_gastown_v1_status_proto_enumTypes[0].Descriptor()
+}
+
+func (HealthProbe) Type() protoreflect.EnumType {
+	return &file_gastown_v1_status_proto_enumTypes[0]
+}
+
+func (x HealthProbe) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthProbe.Descriptor instead.
+func (HealthProbe) EnumDescriptor() ([]byte, []int) {
+	return file_gastown_v1_status_proto_rawDescGZIP(), []int{0}
+}
+
 type GetTownStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Fast          bool                   `protobuf:"varint,1,opt,name=fast,proto3" json:"fast,omitempty"`       // Skip mail lookups for faster response
@@ -824,9 +874,10 @@ func (x *AgentHookInfo) GetBeadTitle() string {
 	return ""
 }
 
-// HealthCheckRequest has no parameters - checks all components.
+// HealthCheckRequest selects the probe; the default checks all components.
 type HealthCheckRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Probe         HealthProbe            `protobuf:"varint,1,opt,name=probe,proto3,enum=gastown.v1.HealthProbe" json:"probe,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -861,11 +912,19 @@ func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
 	return file_gastown_v1_status_proto_rawDescGZIP(), []int{12}
 }
 
+func (x *HealthCheckRequest) GetProbe() HealthProbe {
+	if x != nil {
+		return x.Probe
+	}
+	return HealthProbe_HEALTH_PROBE_UNSPECIFIED
+}
+
 // HealthCheckResponse returns overall status and per-component health.
 type HealthCheckResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "healthy", "degraded", or "unhealthy"
 	Components    []*ComponentHealth     `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	LatencyMs     int64                  `protobuf:"varint,3,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"` // Wall time for the whole check (components run concurrently)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -914,13 +973,21 @@ func (x *HealthCheckResponse) GetComponents() []*ComponentHealth {
 	return nil
 }
 
+func (x *HealthCheckResponse) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
 // ComponentHealth reports the health of a single system component.
 type ComponentHealth struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g. "daemon", "dolt", "tmux", "beads"
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g. "daemon", "dolt", "terminal", "beads"
 	Healthy       bool                   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
 	LatencyMs     int64                  `protobuf:"varint,3,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"` // Time taken to check this component
 	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`                       // Human-readable status or error message
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`                    // Readiness fails when this component is unhealthy
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -983,6 +1050,101 @@ func (x *ComponentHealth) GetMessage() string {
 	return ""
 }
 
+func (x *ComponentHealth) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type RefreshRigsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshRigsRequest) Reset() {
+	*x = RefreshRigsRequest{}
+	mi := &file_gastown_v1_status_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshRigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRigsRequest) ProtoMessage() {}
+
+func (x *RefreshRigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_status_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshRigsRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRigsRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_status_proto_rawDescGZIP(), []int{15}
+}
+
+type RefreshRigsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rigs          []string               `protobuf:"bytes,1,rep,name=rigs,proto3" json:"rigs,omitempty"`                                // Rig names, sorted
+	DurationMs    int64                  `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // Time taken to rescan
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshRigsResponse) Reset() {
+	*x = RefreshRigsResponse{}
+	mi := &file_gastown_v1_status_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshRigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRigsResponse) ProtoMessage() {}
+
+func (x *RefreshRigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_status_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshRigsResponse.ProtoReflect.Descriptor instead.
+func (*RefreshRigsResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_status_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RefreshRigsResponse) GetRigs() []string {
+	if x != nil {
+		return x.Rigs
+	}
+	return nil
+}
+
+func (x *RefreshRigsResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
 // Merge queue summary
 type MQSummary struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -996,7 +1158,7 @@ type MQSummary struct {
 
 func (x *MQSummary) Reset() {
 	*x = MQSummary{}
-	mi := &file_gastown_v1_status_proto_msgTypes[15]
+	mi := &file_gastown_v1_status_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1008,7 +1170,7 @@ func (x *MQSummary) String() string {
 func (*MQSummary) ProtoMessage() {}
 
 func (x *MQSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_gastown_v1_status_proto_msgTypes[15]
+	mi := &file_gastown_v1_status_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1021,7 +1183,7 @@ func (x *MQSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MQSummary.ProtoReflect.Descriptor instead.
 func (*MQSummary) Descriptor() ([]byte, []int) {
-	return file_gastown_v1_status_proto_rawDescGZIP(), []int{15}
+	return file_gastown_v1_status_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *MQSummary) GetPending() int32 {
@@ -1118,31 +1280,45 @@ const file_gastown_v1_status_proto_rawDesc = "" +
 	"\x05agent\x18\x01 \x01(\v2\x18.gastown.v1.AgentAddressR\x05agent\x12\x17\n" +
 	"\abead_id\x18\x02 \x01(\tR\x06beadId\x12\x1d\n" +
 	"\n" +
-	"bead_title\x18\x03 \x01(\tR\tbeadTitle\"\x14\n" +
-	"\x12HealthCheckRequest\"j\n" +
+	"bead_title\x18\x03 \x01(\tR\tbeadTitle\"C\n" +
+	"\x12HealthCheckRequest\x12-\n" +
+	"\x05probe\x18\x01 \x01(\x0e2\x17.gastown.v1.HealthProbeR\x05probe\"\x89\x01\n" +
 	"\x13HealthCheckResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12;\n" +
 	"\n" +
 	"components\x18\x02 \x03(\v2\x1b.gastown.v1.ComponentHealthR\n" +
-	"components\"x\n" +
+	"components\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x03 \x01(\x03R\tlatencyMs\"\x94\x01\n" +
 	"\x0fComponentHealth\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\ahealthy\x18\x02 \x01(\bR\ahealthy\x12\x1d\n" +
 	"\n" +
 	"latency_ms\x18\x03 \x01(\x03R\tlatencyMs\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"\x9a\x01\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\"\x14\n" +
+	"\x12RefreshRigsRequest\"J\n" +
+	"\x13RefreshRigsResponse\x12\x12\n" +
+	"\x04rigs\x18\x01 \x03(\tR\x04rigs\x12\x1f\n" +
+	"\vduration_ms\x18\x02 \x01(\x03R\n" +
+	"durationMs\"\x9a\x01\n" +
 	"\tMQSummary\x12\x18\n" +
 	"\apending\x18\x01 \x01(\x05R\apending\x12\x1f\n" +
 	"\vin_progress\x18\x02 \x01(\x05R\n" +
 	"inProgress\x12'\n" +
 	"\x0fcompleted_today\x18\x03 \x01(\x05R\x0ecompletedToday\x12)\n" +
-	"\x05queue\x18\x04 \x03(\v2\x13.gastown.v1.BeadRefR\x05queue2\xac\x03\n" +
+	"\x05queue\x18\x04 \x03(\v2\x13.gastown.v1.BeadRefR\x05queue*b\n" +
+	"\vHealthProbe\x12\x1c\n" +
+	"\x18HEALTH_PROBE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15HEALTH_PROBE_LIVENESS\x10\x01\x12\x1a\n" +
+	"\x16HEALTH_PROBE_READINESS\x10\x022\xfc\x03\n" +
 	"\rStatusService\x12T\n" +
 	"\rGetTownStatus\x12 .gastown.v1.GetTownStatusRequest\x1a!.gastown.v1.GetTownStatusResponse\x12Q\n" +
 	"\fGetRigStatus\x12\x1f.gastown.v1.GetRigStatusRequest\x1a .gastown.v1.GetRigStatusResponse\x12W\n" +
 	"\x0eGetAgentStatus\x12!.gastown.v1.GetAgentStatusRequest\x1a\".gastown.v1.GetAgentStatusResponse\x12I\n" +
 	"\vWatchStatus\x12\x1e.gastown.v1.WatchStatusRequest\x1a\x18.gastown.v1.StatusUpdate0\x01\x12N\n" +
-	"\vHealthCheck\x12\x1e.gastown.v1.HealthCheckRequest\x1a\x1f.gastown.v1.HealthCheckResponseB\x9e\x01\n" +
+	"\vHealthCheck\x12\x1e.gastown.v1.HealthCheckRequest\x1a\x1f.gastown.v1.HealthCheckResponse\x12N\n" +
+	"\vRefreshRigs\x12\x1e.gastown.v1.RefreshRigsRequest\x1a\x1f.gastown.v1.RefreshRigsResponseB\x9e\x01\n" +
 	"\x0ecom.gastown.v1B\vStatusProtoP\x01Z6github.com/steveyegge/gastown/gen/gastown/v1;gastownv1\xa2\x02\x03GXX\xaa\x02\n" +
 	"Gastown.V1\xca\x02\n" +
 	"Gastown\\V1\xe2\x02\x16Gastown\\V1\\GPBMetadata\xea\x02\vGastown::V1b\x06proto3"
@@ -1159,63 +1335,70 @@ func file_gastown_v1_status_proto_rawDescGZIP() []byte {
 	return file_gastown_v1_status_proto_rawDescData
 }
 
-var file_gastown_v1_status_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_gastown_v1_status_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gastown_v1_status_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_gastown_v1_status_proto_goTypes = []any{
-	(*GetTownStatusRequest)(nil),   // 0: gastown.v1.GetTownStatusRequest
-	(*GetTownStatusResponse)(nil),  // 1: gastown.v1.GetTownStatusResponse
-	(*GetRigStatusRequest)(nil),    // 2: gastown.v1.GetRigStatusRequest
-	(*GetRigStatusResponse)(nil),   // 3: gastown.v1.GetRigStatusResponse
-	(*GetAgentStatusRequest)(nil),  // 4: gastown.v1.GetAgentStatusRequest
-	(*GetAgentStatusResponse)(nil), // 5: gastown.v1.GetAgentStatusResponse
-	(*WatchStatusRequest)(nil),     // 6: gastown.v1.WatchStatusRequest
-	(*StatusUpdate)(nil),           // 7: gastown.v1.StatusUpdate
-	(*TownStatus)(nil),             // 8: gastown.v1.TownStatus
-	(*RigStatus)(nil),              // 9: gastown.v1.RigStatus
-	(*AgentRuntime)(nil),           // 10: gastown.v1.AgentRuntime
-	(*AgentHookInfo)(nil),          // 11: gastown.v1.AgentHookInfo
-	(*HealthCheckRequest)(nil),     // 12: gastown.v1.HealthCheckRequest
-	(*HealthCheckResponse)(nil),    // 13: gastown.v1.HealthCheckResponse
-	(*ComponentHealth)(nil),        // 14: gastown.v1.ComponentHealth
-	(*MQSummary)(nil),              // 15: gastown.v1.MQSummary
-	(*AgentAddress)(nil),           // 16: gastown.v1.AgentAddress
-	(*timestamppb.Timestamp)(nil),  // 17: google.protobuf.Timestamp
-	(*OverseerInfo)(nil),           // 18: gastown.v1.OverseerInfo
-	(*BeadRef)(nil),                // 19: gastown.v1.BeadRef
+	(HealthProbe)(0),               // 0: gastown.v1.HealthProbe
+	(*GetTownStatusRequest)(nil),   // 1: gastown.v1.GetTownStatusRequest
+	(*GetTownStatusResponse)(nil),  // 2: gastown.v1.GetTownStatusResponse
+	(*GetRigStatusRequest)(nil),    // 3: gastown.v1.GetRigStatusRequest
+	(*GetRigStatusResponse)(nil),   // 4: gastown.v1.GetRigStatusResponse
+	(*GetAgentStatusRequest)(nil),  // 5: gastown.v1.GetAgentStatusRequest
+	(*GetAgentStatusResponse)(nil), // 6: gastown.v1.GetAgentStatusResponse
+	(*WatchStatusRequest)(nil),     // 7: gastown.v1.WatchStatusRequest
+	(*StatusUpdate)(nil),           // 8: gastown.v1.StatusUpdate
+	(*TownStatus)(nil),             // 9: gastown.v1.TownStatus
+	(*RigStatus)(nil),              // 10: gastown.v1.RigStatus
+	(*AgentRuntime)(nil),           // 11: gastown.v1.AgentRuntime
+	(*AgentHookInfo)(nil),          // 12: gastown.v1.AgentHookInfo
+	(*HealthCheckRequest)(nil),     // 13: gastown.v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),    // 14: gastown.v1.HealthCheckResponse
+	(*ComponentHealth)(nil),        // 15: gastown.v1.ComponentHealth
+	(*RefreshRigsRequest)(nil),     // 16: gastown.v1.RefreshRigsRequest
+	(*RefreshRigsResponse)(nil),    // 17: gastown.v1.RefreshRigsResponse
+	(*MQSummary)(nil),              // 18: gastown.v1.MQSummary
+	(*AgentAddress)(nil),           // 19: gastown.v1.AgentAddress
+	(*timestamppb.Timestamp)(nil),  // 20: google.protobuf.Timestamp
+	(*OverseerInfo)(nil),           // 21: gastown.v1.OverseerInfo
+	(*BeadRef)(nil),                // 22: gastown.v1.BeadRef
 }
 var file_gastown_v1_status_proto_depIdxs = []int32{
-	8,  // 0: gastown.v1.GetTownStatusResponse.status:type_name -> gastown.v1.TownStatus
-	9,  // 1: gastown.v1.GetRigStatusResponse.status:type_name -> gastown.v1.RigStatus
-	16, // 2: gastown.v1.GetAgentStatusRequest.address:type_name -> gastown.v1.AgentAddress
-	10, // 3: gastown.v1.GetAgentStatusResponse.agent:type_name -> gastown.v1.AgentRuntime
-	17, // 4: gastown.v1.StatusUpdate.timestamp:type_name -> google.protobuf.Timestamp
-	8,  // 5: gastown.v1.StatusUpdate.town:type_name -> gastown.v1.TownStatus
-	9,  // 6: gastown.v1.StatusUpdate.rig:type_name -> gastown.v1.RigStatus
-	10, // 7: gastown.v1.StatusUpdate.agent:type_name -> gastown.v1.AgentRuntime
-	18, // 8: gastown.v1.TownStatus.overseer:type_name -> gastown.v1.OverseerInfo
-	10, // 9: gastown.v1.TownStatus.global_agents:type_name -> gastown.v1.AgentRuntime
-	9,  // 10: gastown.v1.TownStatus.rigs:type_name -> gastown.v1.RigStatus
-	10, // 11: gastown.v1.RigStatus.agents:type_name -> gastown.v1.AgentRuntime
-	11, // 12: gastown.v1.RigStatus.hooks:type_name -> gastown.v1.AgentHookInfo
-	15, // 13: gastown.v1.RigStatus.merge_queue:type_name -> gastown.v1.MQSummary
-	16, // 14: gastown.v1.AgentRuntime.address:type_name -> gastown.v1.AgentAddress
-	16, // 15: gastown.v1.AgentHookInfo.agent:type_name -> gastown.v1.AgentAddress
-	14, // 16: gastown.v1.HealthCheckResponse.components:type_name -> gastown.v1.ComponentHealth
-	19, // 17: gastown.v1.MQSummary.queue:type_name -> gastown.v1.BeadRef
-	0,  // 18: gastown.v1.StatusService.GetTownStatus:input_type -> gastown.v1.GetTownStatusRequest
-	2,  // 19: gastown.v1.StatusService.GetRigStatus:input_type -> gastown.v1.GetRigStatusRequest
-	4,  // 20: gastown.v1.StatusService.GetAgentStatus:input_type -> gastown.v1.GetAgentStatusRequest
-	6,  // 21: gastown.v1.StatusService.WatchStatus:input_type -> gastown.v1.WatchStatusRequest
-	12, // 22: gastown.v1.StatusService.HealthCheck:input_type -> gastown.v1.HealthCheckRequest
-	1,  // 23: gastown.v1.StatusService.GetTownStatus:output_type -> gastown.v1.GetTownStatusResponse
-	3,  // 24: gastown.v1.StatusService.GetRigStatus:output_type -> gastown.v1.GetRigStatusResponse
-	5,  // 25: gastown.v1.StatusService.GetAgentStatus:output_type -> gastown.v1.GetAgentStatusResponse
-	7,  // 26: gastown.v1.StatusService.WatchStatus:output_type -> gastown.v1.StatusUpdate
-	13, // 27: gastown.v1.StatusService.HealthCheck:output_type -> gastown.v1.HealthCheckResponse
-	23, // [23:28] is the sub-list for method output_type
-	18, // [18:23] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	9,  // 0: gastown.v1.GetTownStatusResponse.status:type_name -> gastown.v1.TownStatus
+	10, // 1: gastown.v1.GetRigStatusResponse.status:type_name -> gastown.v1.RigStatus
+	19, // 2: gastown.v1.GetAgentStatusRequest.address:type_name -> gastown.v1.AgentAddress
+	11, // 3: gastown.v1.GetAgentStatusResponse.agent:type_name -> gastown.v1.AgentRuntime
+	20, // 4: gastown.v1.StatusUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	9,  // 5: gastown.v1.StatusUpdate.town:type_name -> gastown.v1.TownStatus
+	10, // 6: gastown.v1.StatusUpdate.rig:type_name -> gastown.v1.RigStatus
+	11, // 7: gastown.v1.StatusUpdate.agent:type_name -> gastown.v1.AgentRuntime
+	21, // 8: gastown.v1.TownStatus.overseer:type_name -> gastown.v1.OverseerInfo
+	11, // 9: gastown.v1.TownStatus.global_agents:type_name -> gastown.v1.AgentRuntime
+	10, // 10: gastown.v1.TownStatus.rigs:type_name -> gastown.v1.RigStatus
+	11, // 11: gastown.v1.RigStatus.agents:type_name -> gastown.v1.AgentRuntime
+	12, // 12: gastown.v1.RigStatus.hooks:type_name -> gastown.v1.AgentHookInfo
+	18, // 13: gastown.v1.RigStatus.merge_queue:type_name -> gastown.v1.MQSummary
+	19, // 14: gastown.v1.AgentRuntime.address:type_name -> gastown.v1.AgentAddress
+	19, // 15: gastown.v1.AgentHookInfo.agent:type_name -> gastown.v1.AgentAddress
+	0,  // 16: gastown.v1.HealthCheckRequest.probe:type_name -> gastown.v1.HealthProbe
+	15, // 17: gastown.v1.HealthCheckResponse.components:type_name -> gastown.v1.ComponentHealth
+	22, // 18: gastown.v1.MQSummary.queue:type_name -> gastown.v1.BeadRef
+	1,  // 19: gastown.v1.StatusService.GetTownStatus:input_type -> gastown.v1.GetTownStatusRequest
+	3,  // 20: gastown.v1.StatusService.GetRigStatus:input_type -> gastown.v1.GetRigStatusRequest
+	5,  // 21: gastown.v1.StatusService.GetAgentStatus:input_type -> gastown.v1.GetAgentStatusRequest
+	7,  // 22: gastown.v1.StatusService.WatchStatus:input_type -> gastown.v1.WatchStatusRequest
+	13, // 23: gastown.v1.StatusService.HealthCheck:input_type -> gastown.v1.HealthCheckRequest
+	16, // 24: gastown.v1.StatusService.RefreshRigs:input_type -> gastown.v1.RefreshRigsRequest
+	2,  // 25: gastown.v1.StatusService.GetTownStatus:output_type -> gastown.v1.GetTownStatusResponse
+	4,  // 26: gastown.v1.StatusService.GetRigStatus:output_type -> gastown.v1.GetRigStatusResponse
+	6,  // 27: gastown.v1.StatusService.GetAgentStatus:output_type -> gastown.v1.GetAgentStatusResponse
+	8,  // 28: gastown.v1.StatusService.WatchStatus:output_type -> gastown.v1.StatusUpdate
+	14, // 29: gastown.v1.StatusService.HealthCheck:output_type -> gastown.v1.HealthCheckResponse
+	17, // 30: gastown.v1.StatusService.RefreshRigs:output_type -> gastown.v1.RefreshRigsResponse
+	25, // [25:31] is the sub-list for method output_type
+	19, // [19:25] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_gastown_v1_status_proto_init() }
@@ -1234,13 +1417,14 @@ func file_gastown_v1_status_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastown_v1_status_proto_rawDesc), len(file_gastown_v1_status_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   16,
+			NumEnums:      1,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_gastown_v1_status_proto_goTypes,
 		DependencyIndexes: file_gastown_v1_status_proto_depIdxs,
+		EnumInfos:         file_gastown_v1_status_proto_enumTypes,
 		MessageInfos:      file_gastown_v1_status_proto_msgTypes,
 	}.Build()
 	File_gastown_v1_status_proto = out.File
@@ -0,0 +1,467 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: gastown/v1/sync.proto
+
+package gastownv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SyncEntity int32
+
+const (
+	SyncEntity_SYNC_ENTITY_UNSPECIFIED SyncEntity = 0
+	SyncEntity_SYNC_ENTITY_MAIL        SyncEntity = 1 // Overseer inbox message
+	SyncEntity_SYNC_ENTITY_DECISION    SyncEntity = 2 // Pending or recently resolved decision
+	SyncEntity_SYNC_ENTITY_AGENT       SyncEntity = 3 // Agent runtime state
+)
+
+// Enum value maps for SyncEntity.
+var (
+	SyncEntity_name = map[int32]string{
+		0: "SYNC_ENTITY_UNSPECIFIED",
+		1: "SYNC_ENTITY_MAIL",
+		2: "SYNC_ENTITY_DECISION",
+		3: "SYNC_ENTITY_AGENT",
+	}
+	SyncEntity_value = map[string]int32{
+		"SYNC_ENTITY_UNSPECIFIED": 0,
+		"SYNC_ENTITY_MAIL":        1,
+		"SYNC_ENTITY_DECISION":    2,
+		"SYNC_ENTITY_AGENT":       3,
+	}
+)
+
+func (x SyncEntity) Enum() *SyncEntity {
+	p := new(SyncEntity)
+	*p = x
+	return p
+}
+
+func (x SyncEntity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SyncEntity) Descriptor() protoreflect.EnumDescriptor {
+	return file_gastown_v1_sync_proto_enumTypes[0].Descriptor()
+}
+
+func (SyncEntity) Type() protoreflect.EnumType {
+	return &file_gastown_v1_sync_proto_enumTypes[0]
+}
+
+func (x SyncEntity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SyncEntity.Descriptor instead.
+func (SyncEntity) EnumDescriptor() ([]byte, []int) {
+	return file_gastown_v1_sync_proto_rawDescGZIP(), []int{0}
+}
+
+type SyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`                                        // From the last SyncResponse; empty for a full sync
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                         // Max changes per response (default 500)
+	Entities      []SyncEntity           `protobuf:"varint,3,rep,packed,name=entities,proto3,enum=gastown.v1.SyncEntity" json:"entities,omitempty"` // Only these entity types (empty = all)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncRequest) Reset() {
+	*x = SyncRequest{}
+	mi := &file_gastown_v1_sync_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRequest) ProtoMessage() {}
+
+func (x *SyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_sync_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRequest.ProtoReflect.Descriptor instead.
+func (*SyncRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_sync_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SyncRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SyncRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SyncRequest) GetEntities() []SyncEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type SyncResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`                   // Pass to the next Sync
+	Resync        bool                   `protobuf:"varint,2,opt,name=resync,proto3" json:"resync,omitempty"`                  // Discard local state first: changes are a full snapshot
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"` // More changes are waiting; call again with cursor
+	Changes       []*SyncChange          `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`                 // Oldest change first
+	ServerTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncResponse) Reset() {
+	*x = SyncResponse{}
+	mi := &file_gastown_v1_sync_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncResponse) ProtoMessage() {}
+
+func (x *SyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_sync_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncResponse.ProtoReflect.Descriptor instead.
+func (*SyncResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_sync_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SyncResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SyncResponse) GetResync() bool {
+	if x != nil {
+		return x.Resync
+	}
+	return false
+}
+
+func (x *SyncResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *SyncResponse) GetChanges() []*SyncChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SyncResponse) GetServerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServerTime
+	}
+	return nil
+}
+
+// The current state of one changed entity
+type SyncChange struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Seq       uint64                 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Entity    SyncEntity             `protobuf:"varint,2,opt,name=entity,proto3,enum=gastown.v1.SyncEntity" json:"entity,omitempty"`
+	Id        string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`            // Message ID, decision ID, or agent session
+	Deleted   bool                   `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"` // Entity is gone; no payload
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*SyncChange_Message
+	//	*SyncChange_Decision
+	//	*SyncChange_Agent
+	Payload       isSyncChange_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncChange) Reset() {
+	*x = SyncChange{}
+	mi := &file_gastown_v1_sync_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncChange) ProtoMessage() {}
+
+func (x *SyncChange) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_sync_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncChange.ProtoReflect.Descriptor instead.
+func (*SyncChange) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_sync_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SyncChange) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *SyncChange) GetEntity() SyncEntity {
+	if x != nil {
+		return x.Entity
+	}
+	return SyncEntity_SYNC_ENTITY_UNSPECIFIED
+}
+
+func (x *SyncChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SyncChange) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *SyncChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *SyncChange) GetPayload() isSyncChange_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SyncChange) GetMessage() *Message {
+	if x != nil {
+		if x, ok := x.Payload.(*SyncChange_Message); ok {
+			return x.Message
+		}
+	}
+	return nil
+}
+
+func (x *SyncChange) GetDecision() *Decision {
+	if x != nil {
+		if x, ok := x.Payload.(*SyncChange_Decision); ok {
+			return x.Decision
+		}
+	}
+	return nil
+}
+
+func (x *SyncChange) GetAgent() *AgentRuntime {
+	if x != nil {
+		if x, ok := x.Payload.(*SyncChange_Agent); ok {
+			return x.Agent
+		}
+	}
+	return nil
+}
+
+type isSyncChange_Payload interface {
+	isSyncChange_Payload()
+}
+
+type SyncChange_Message struct {
+	Message *Message `protobuf:"bytes,10,opt,name=message,proto3,oneof"`
+}
+
+type SyncChange_Decision struct {
+	Decision *Decision `protobuf:"bytes,11,opt,name=decision,proto3,oneof"`
+}
+
+type SyncChange_Agent struct {
+	Agent *AgentRuntime `protobuf:"bytes,12,opt,name=agent,proto3,oneof"`
+}
+
+func (*SyncChange_Message) isSyncChange_Payload() {}
+
+func (*SyncChange_Decision) isSyncChange_Payload() {}
+
+func (*SyncChange_Agent) isSyncChange_Payload() {}
+
+var File_gastown_v1_sync_proto protoreflect.FileDescriptor
+
+const file_gastown_v1_sync_proto_rawDesc = "" +
+	"\n" +
+	"\x15gastown/v1/sync.proto\x12\n" +
+	"gastown.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19gastown/v1/decision.proto\x1a\x15gastown/v1/mail.proto\x1a\x17gastown/v1/status.proto\"o\n" +
+	"\vSyncRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x122\n" +
+	"\bentities\x18\x03 \x03(\x0e2\x16.gastown.v1.SyncEntityR\bentities\"\xc8\x01\n" +
+	"\fSyncResponse\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x16\n" +
+	"\x06resync\x18\x02 \x01(\bR\x06resync\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x120\n" +
+	"\achanges\x18\x04 \x03(\v2\x16.gastown.v1.SyncChangeR\achanges\x12;\n" +
+	"\vserver_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"serverTime\"\xd5\x02\n" +
+	"\n" +
+	"SyncChange\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x04R\x03seq\x12.\n" +
+	"\x06entity\x18\x02 \x01(\x0e2\x16.gastown.v1.SyncEntityR\x06entity\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12\x18\n" +
+	"\adeleted\x18\x04 \x01(\bR\adeleted\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12/\n" +
+	"\amessage\x18\n" +
+	" \x01(\v2\x13.gastown.v1.MessageH\x00R\amessage\x122\n" +
+	"\bdecision\x18\v \x01(\v2\x14.gastown.v1.DecisionH\x00R\bdecision\x120\n" +
+	"\x05agent\x18\f \x01(\v2\x18.gastown.v1.AgentRuntimeH\x00R\x05agentB\t\n" +
+	"\apayload*p\n" +
+	"\n" +
+	"SyncEntity\x12\x1b\n" +
+	"\x17SYNC_ENTITY_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10SYNC_ENTITY_MAIL\x10\x01\x12\x18\n" +
+	"\x14SYNC_ENTITY_DECISION\x10\x02\x12\x15\n" +
+	"\x11SYNC_ENTITY_AGENT\x10\x032H\n" +
+	"\vSyncService\x129\n" +
+	"\x04Sync\x12\x17.gastown.v1.SyncRequest\x1a\x18.gastown.v1.SyncResponseB\x9c\x01\n" +
+	"\x0ecom.gastown.v1B\tSyncProtoP\x01Z6github.com/steveyegge/gastown/gen/gastown/v1;gastownv1\xa2\x02\x03GXX\xaa\x02\n" +
+	"Gastown.V1\xca\x02\n" +
+	"Gastown\\V1\xe2\x02\x16Gastown\\V1\\GPBMetadata\xea\x02\vGastown::V1b\x06proto3"
+
+var (
+	file_gastown_v1_sync_proto_rawDescOnce sync.Once
+	file_gastown_v1_sync_proto_rawDescData []byte
+)
+
+func file_gastown_v1_sync_proto_rawDescGZIP() []byte {
+	file_gastown_v1_sync_proto_rawDescOnce.Do(func() {
+		file_gastown_v1_sync_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gastown_v1_sync_proto_rawDesc), len(file_gastown_v1_sync_proto_rawDesc)))
+	})
+	return file_gastown_v1_sync_proto_rawDescData
+}
+
+var file_gastown_v1_sync_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gastown_v1_sync_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_gastown_v1_sync_proto_goTypes = []any{
+	(SyncEntity)(0),               // 0: gastown.v1.SyncEntity
+	(*SyncRequest)(nil),           // 1: gastown.v1.SyncRequest
+	(*SyncResponse)(nil),          // 2: gastown.v1.SyncResponse
+	(*SyncChange)(nil),            // 3: gastown.v1.SyncChange
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*Message)(nil),               // 5: gastown.v1.Message
+	(*Decision)(nil),              // 6: gastown.v1.Decision
+	(*AgentRuntime)(nil),          // 7: gastown.v1.AgentRuntime
+}
+var file_gastown_v1_sync_proto_depIdxs = []int32{
+	0, // 0: gastown.v1.SyncRequest.entities:type_name -> gastown.v1.SyncEntity
+	3, // 1: gastown.v1.SyncResponse.changes:type_name -> gastown.v1.SyncChange
+	4, // 2: gastown.v1.SyncResponse.server_time:type_name -> google.protobuf.Timestamp
+	0, // 3: gastown.v1.SyncChange.entity:type_name -> gastown.v1.SyncEntity
+	4, // 4: gastown.v1.SyncChange.changed_at:type_name -> google.protobuf.Timestamp
+	5, // 5: gastown.v1.SyncChange.message:type_name -> gastown.v1.Message
+	6, // 6: gastown.v1.SyncChange.decision:type_name -> gastown.v1.Decision
+	7, // 7: gastown.v1.SyncChange.agent:type_name -> gastown.v1.AgentRuntime
+	1, // 8: gastown.v1.SyncService.Sync:input_type -> gastown.v1.SyncRequest
+	2, // 9: gastown.v1.SyncService.Sync:output_type -> gastown.v1.SyncResponse
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_gastown_v1_sync_proto_init() }
+func file_gastown_v1_sync_proto_init() {
+	if File_gastown_v1_sync_proto != nil {
+		return
+	}
+	file_gastown_v1_decision_proto_init()
+	file_gastown_v1_mail_proto_init()
+	file_gastown_v1_status_proto_init()
+	file_gastown_v1_sync_proto_msgTypes[2].OneofWrappers = []any{
+		(*SyncChange_Message)(nil),
+		(*SyncChange_Decision)(nil),
+		(*SyncChange_Agent)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastown_v1_sync_proto_rawDesc), len(file_gastown_v1_sync_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gastown_v1_sync_proto_goTypes,
+		DependencyIndexes: file_gastown_v1_sync_proto_depIdxs,
+		EnumInfos:         file_gastown_v1_sync_proto_enumTypes,
+		MessageInfos:      file_gastown_v1_sync_proto_msgTypes,
+	}.Build()
+	File_gastown_v1_sync_proto = out.File
+	file_gastown_v1_sync_proto_goTypes = nil
+	file_gastown_v1_sync_proto_depIdxs = nil
+}
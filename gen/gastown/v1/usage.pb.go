@@ -0,0 +1,631 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: gastown/v1/usage.proto
+
+package gastownv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`  // Default: start of today (server local time)
+	Rig           string                 `protobuf:"bytes,2,opt,name=rig,proto3" json:"rig,omitempty"`      // Only usage by agents in this rig
+	Agent         string                 `protobuf:"bytes,3,opt,name=agent,proto3" json:"agent,omitempty"`  // Only usage by this agent
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"` // Max rows per breakdown (0 = all)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_gastown_v1_usage_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_usage_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_usage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetUsageRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetUsageRequest) GetRig() string {
+	if x != nil {
+		return x.Rig
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Total         *TokenUsage            `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	ByAgent       []*UsageTotal          `protobuf:"bytes,3,rep,name=by_agent,json=byAgent,proto3" json:"by_agent,omitempty"` // Highest cost first
+	ByRig         []*UsageTotal          `protobuf:"bytes,4,rep,name=by_rig,json=byRig,proto3" json:"by_rig,omitempty"`
+	ByBead        []*UsageTotal          `protobuf:"bytes,5,rep,name=by_bead,json=byBead,proto3" json:"by_bead,omitempty"`
+	Budgets       []*BudgetStatus        `protobuf:"bytes,6,rep,name=budgets,proto3" json:"budgets,omitempty"` // Today's spend, regardless of since and filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_gastown_v1_usage_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_usage_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_usage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetUsageResponse) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetTotal() *TokenUsage {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetByAgent() []*UsageTotal {
+	if x != nil {
+		return x.ByAgent
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetByRig() []*UsageTotal {
+	if x != nil {
+		return x.ByRig
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetByBead() []*UsageTotal {
+	if x != nil {
+		return x.ByBead
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetBudgets() []*BudgetStatus {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type RecordUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agent         string                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`   // Required: agent address, e.g. "gastown/polecats/nux"
+	Bead          string                 `protobuf:"bytes,2,opt,name=bead,proto3" json:"bead,omitempty"`     // Bead to charge, usually the hooked bead
+	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`   // Used to estimate cost when the report has none
+	Output        string                 `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"` // CLI output containing a usage report (Claude /cost, Codex exit summary)
+	Usage         *TokenUsage            `protobuf:"bytes,5,opt,name=usage,proto3" json:"usage,omitempty"`   // Explicit usage, used when output is empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordUsageRequest) Reset() {
+	*x = RecordUsageRequest{}
+	mi := &file_gastown_v1_usage_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageRequest) ProtoMessage() {}
+
+func (x *RecordUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_usage_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageRequest.ProtoReflect.Descriptor instead.
+func (*RecordUsageRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_usage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecordUsageRequest) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *RecordUsageRequest) GetBead() string {
+	if x != nil {
+		return x.Bead
+	}
+	return ""
+}
+
+func (x *RecordUsageRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RecordUsageRequest) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *RecordUsageRequest) GetUsage() *TokenUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type RecordUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recorded      *TokenUsage            `protobuf:"bytes,1,opt,name=recorded,proto3" json:"recorded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordUsageResponse) Reset() {
+	*x = RecordUsageResponse{}
+	mi := &file_gastown_v1_usage_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageResponse) ProtoMessage() {}
+
+func (x *RecordUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_usage_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageResponse.ProtoReflect.Descriptor instead.
+func (*RecordUsageResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_usage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RecordUsageResponse) GetRecorded() *TokenUsage {
+	if x != nil {
+		return x.Recorded
+	}
+	return nil
+}
+
+// Tokens consumed and their cost
+type TokenUsage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	InputTokens      int64                  `protobuf:"varint,1,opt,name=input_tokens,json=inputTokens,proto3" json:"input_tokens,omitempty"`
+	OutputTokens     int64                  `protobuf:"varint,2,opt,name=output_tokens,json=outputTokens,proto3" json:"output_tokens,omitempty"`
+	CacheReadTokens  int64                  `protobuf:"varint,3,opt,name=cache_read_tokens,json=cacheReadTokens,proto3" json:"cache_read_tokens,omitempty"`
+	CacheWriteTokens int64                  `protobuf:"varint,4,opt,name=cache_write_tokens,json=cacheWriteTokens,proto3" json:"cache_write_tokens,omitempty"`
+	CostUsd          float64                `protobuf:"fixed64,5,opt,name=cost_usd,json=costUsd,proto3" json:"cost_usd,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TokenUsage) Reset() {
+	*x = TokenUsage{}
+	mi := &file_gastown_v1_usage_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenUsage) ProtoMessage() {}
+
+func (x *TokenUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_usage_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenUsage.ProtoReflect.Descriptor instead.
+func (*TokenUsage) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_usage_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TokenUsage) GetInputTokens() int64 {
+	if x != nil {
+		return x.InputTokens
+	}
+	return 0
+}
+
+func (x *TokenUsage) GetOutputTokens() int64 {
+	if x != nil {
+		return x.OutputTokens
+	}
+	return 0
+}
+
+func (x *TokenUsage) GetCacheReadTokens() int64 {
+	if x != nil {
+		return x.CacheReadTokens
+	}
+	return 0
+}
+
+func (x *TokenUsage) GetCacheWriteTokens() int64 {
+	if x != nil {
+		return x.CacheWriteTokens
+	}
+	return 0
+}
+
+func (x *TokenUsage) GetCostUsd() float64 {
+	if x != nil {
+		return x.CostUsd
+	}
+	return 0
+}
+
+// Usage for one agent, rig, or bead
+type UsageTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Agent address, rig name, or bead ID
+	Usage         *TokenUsage            `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageTotal) Reset() {
+	*x = UsageTotal{}
+	mi := &file_gastown_v1_usage_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageTotal) ProtoMessage() {}
+
+func (x *UsageTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_usage_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageTotal.ProtoReflect.Descriptor instead.
+func (*UsageTotal) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_usage_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UsageTotal) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UsageTotal) GetUsage() *TokenUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// Spend against one daily budget
+type BudgetStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"` // town, rig, or agent
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`     // Rig or agent; empty for the town
+	SpentUsd      float64                `protobuf:"fixed64,3,opt,name=spent_usd,json=spentUsd,proto3" json:"spent_usd,omitempty"`
+	LimitUsd      float64                `protobuf:"fixed64,4,opt,name=limit_usd,json=limitUsd,proto3" json:"limit_usd,omitempty"`
+	Exceeded      bool                   `protobuf:"varint,5,opt,name=exceeded,proto3" json:"exceeded,omitempty"`
+	Warning       bool                   `protobuf:"varint,6,opt,name=warning,proto3" json:"warning,omitempty"` // At 80% or more but not exceeded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetStatus) Reset() {
+	*x = BudgetStatus{}
+	mi := &file_gastown_v1_usage_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetStatus) ProtoMessage() {}
+
+func (x *BudgetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_usage_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetStatus.ProtoReflect.Descriptor instead.
+func (*BudgetStatus) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_usage_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BudgetStatus) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetSpentUsd() float64 {
+	if x != nil {
+		return x.SpentUsd
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetLimitUsd() float64 {
+	if x != nil {
+		return x.LimitUsd
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+func (x *BudgetStatus) GetWarning() bool {
+	if x != nil {
+		return x.Warning
+	}
+	return false
+}
+
+var File_gastown_v1_usage_proto protoreflect.FileDescriptor
+
+const file_gastown_v1_usage_proto_rawDesc = "" +
+	"\n" +
+	"\x16gastown/v1/usage.proto\x12\n" +
+	"gastown.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x81\x01\n" +
+	"\x0fGetUsageRequest\x120\n" +
+	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x10\n" +
+	"\x03rig\x18\x02 \x01(\tR\x03rig\x12\x14\n" +
+	"\x05agent\x18\x03 \x01(\tR\x05agent\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xb9\x02\n" +
+	"\x10GetUsageResponse\x120\n" +
+	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12,\n" +
+	"\x05total\x18\x02 \x01(\v2\x16.gastown.v1.TokenUsageR\x05total\x121\n" +
+	"\bby_agent\x18\x03 \x03(\v2\x16.gastown.v1.UsageTotalR\abyAgent\x12-\n" +
+	"\x06by_rig\x18\x04 \x03(\v2\x16.gastown.v1.UsageTotalR\x05byRig\x12/\n" +
+	"\aby_bead\x18\x05 \x03(\v2\x16.gastown.v1.UsageTotalR\x06byBead\x122\n" +
+	"\abudgets\x18\x06 \x03(\v2\x18.gastown.v1.BudgetStatusR\abudgets\"\x9a\x01\n" +
+	"\x12RecordUsageRequest\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\x12\n" +
+	"\x04bead\x18\x02 \x01(\tR\x04bead\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12\x16\n" +
+	"\x06output\x18\x04 \x01(\tR\x06output\x12,\n" +
+	"\x05usage\x18\x05 \x01(\v2\x16.gastown.v1.TokenUsageR\x05usage\"I\n" +
+	"\x13RecordUsageResponse\x122\n" +
+	"\brecorded\x18\x01 \x01(\v2\x16.gastown.v1.TokenUsageR\brecorded\"\xc9\x01\n" +
+	"\n" +
+	"TokenUsage\x12!\n" +
+	"\finput_tokens\x18\x01 \x01(\x03R\vinputTokens\x12#\n" +
+	"\routput_tokens\x18\x02 \x01(\x03R\foutputTokens\x12*\n" +
+	"\x11cache_read_tokens\x18\x03 \x01(\x03R\x0fcacheReadTokens\x12,\n" +
+	"\x12cache_write_tokens\x18\x04 \x01(\x03R\x10cacheWriteTokens\x12\x19\n" +
+	"\bcost_usd\x18\x05 \x01(\x01R\acostUsd\"L\n" +
+	"\n" +
+	"UsageTotal\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05usage\x18\x02 \x01(\v2\x16.gastown.v1.TokenUsageR\x05usage\"\xa6\x01\n" +
+	"\fBudgetStatus\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1b\n" +
+	"\tspent_usd\x18\x03 \x01(\x01R\bspentUsd\x12\x1b\n" +
+	"\tlimit_usd\x18\x04 \x01(\x01R\blimitUsd\x12\x1a\n" +
+	"\bexceeded\x18\x05 \x01(\bR\bexceeded\x12\x18\n" +
+	"\awarning\x18\x06 \x01(\bR\awarning2\xa5\x01\n" +
+	"\fUsageService\x12E\n" +
+	"\bGetUsage\x12\x1b.gastown.v1.GetUsageRequest\x1a\x1c.gastown.v1.GetUsageResponse\x12N\n" +
+	"\vRecordUsage\x12\x1e.gastown.v1.RecordUsageRequest\x1a\x1f.gastown.v1.RecordUsageResponseB\x9d\x01\n" +
+	"\x0ecom.gastown.v1B\n" +
+	"UsageProtoP\x01Z6github.com/steveyegge/gastown/gen/gastown/v1;gastownv1\xa2\x02\x03GXX\xaa\x02\n" +
+	"Gastown.V1\xca\x02\n" +
+	"Gastown\\V1\xe2\x02\x16Gastown\\V1\\GPBMetadata\xea\x02\vGastown::V1b\x06proto3"
+
+var (
+	file_gastown_v1_usage_proto_rawDescOnce sync.Once
+	file_gastown_v1_usage_proto_rawDescData []byte
+)
+
+func file_gastown_v1_usage_proto_rawDescGZIP() []byte {
+	file_gastown_v1_usage_proto_rawDescOnce.Do(func() {
+		file_gastown_v1_usage_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gastown_v1_usage_proto_rawDesc), len(file_gastown_v1_usage_proto_rawDesc)))
+	})
+	return file_gastown_v1_usage_proto_rawDescData
+}
+
+var file_gastown_v1_usage_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_gastown_v1_usage_proto_goTypes = []any{
+	(*GetUsageRequest)(nil),       // 0: gastown.v1.GetUsageRequest
+	(*GetUsageResponse)(nil),      // 1: gastown.v1.GetUsageResponse
+	(*RecordUsageRequest)(nil),    // 2: gastown.v1.RecordUsageRequest
+	(*RecordUsageResponse)(nil),   // 3: gastown.v1.RecordUsageResponse
+	(*TokenUsage)(nil),            // 4: gastown.v1.TokenUsage
+	(*UsageTotal)(nil),            // 5: gastown.v1.UsageTotal
+	(*BudgetStatus)(nil),          // 6: gastown.v1.BudgetStatus
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_gastown_v1_usage_proto_depIdxs = []int32{
+	7,  // 0: gastown.v1.GetUsageRequest.since:type_name -> google.protobuf.Timestamp
+	7,  // 1: gastown.v1.GetUsageResponse.since:type_name -> google.protobuf.Timestamp
+	4,  // 2: gastown.v1.GetUsageResponse.total:type_name -> gastown.v1.TokenUsage
+	5,  // 3: gastown.v1.GetUsageResponse.by_agent:type_name -> gastown.v1.UsageTotal
+	5,  // 4: gastown.v1.GetUsageResponse.by_rig:type_name -> gastown.v1.UsageTotal
+	5,  // 5: gastown.v1.GetUsageResponse.by_bead:type_name -> gastown.v1.UsageTotal
+	6,  // 6: gastown.v1.GetUsageResponse.budgets:type_name -> gastown.v1.BudgetStatus
+	4,  // 7: gastown.v1.RecordUsageRequest.usage:type_name -> gastown.v1.TokenUsage
+	4,  // 8: gastown.v1.RecordUsageResponse.recorded:type_name -> gastown.v1.TokenUsage
+	4,  // 9: gastown.v1.UsageTotal.usage:type_name -> gastown.v1.TokenUsage
+	0,  // 10: gastown.v1.UsageService.GetUsage:input_type -> gastown.v1.GetUsageRequest
+	2,  // 11: gastown.v1.UsageService.RecordUsage:input_type -> gastown.v1.RecordUsageRequest
+	1,  // 12: gastown.v1.UsageService.GetUsage:output_type -> gastown.v1.GetUsageResponse
+	3,  // 13: gastown.v1.UsageService.RecordUsage:output_type -> gastown.v1.RecordUsageResponse
+	12, // [12:14] is the sub-list for method output_type
+	10, // [10:12] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_gastown_v1_usage_proto_init() }
+func file_gastown_v1_usage_proto_init() {
+	if File_gastown_v1_usage_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastown_v1_usage_proto_rawDesc), len(file_gastown_v1_usage_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gastown_v1_usage_proto_goTypes,
+		DependencyIndexes: file_gastown_v1_usage_proto_depIdxs,
+		MessageInfos:      file_gastown_v1_usage_proto_msgTypes,
+	}.Build()
+	File_gastown_v1_usage_proto = out.File
+	file_gastown_v1_usage_proto_goTypes = nil
+	file_gastown_v1_usage_proto_depIdxs = nil
+}
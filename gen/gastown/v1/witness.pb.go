@@ -0,0 +1,715 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: gastown/v1/witness.proto
+
+package gastownv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Finding severity, most severe last.
+type FindingSeverity int32
+
+const (
+	FindingSeverity_FINDING_SEVERITY_UNSPECIFIED FindingSeverity = 0
+	FindingSeverity_FINDING_SEVERITY_LOW         FindingSeverity = 1
+	FindingSeverity_FINDING_SEVERITY_MEDIUM      FindingSeverity = 2
+	FindingSeverity_FINDING_SEVERITY_HIGH        FindingSeverity = 3
+	FindingSeverity_FINDING_SEVERITY_CRITICAL    FindingSeverity = 4
+)
+
+// Enum value maps for FindingSeverity.
+var (
+	FindingSeverity_name = map[int32]string{
+		0: "FINDING_SEVERITY_UNSPECIFIED",
+		1: "FINDING_SEVERITY_LOW",
+		2: "FINDING_SEVERITY_MEDIUM",
+		3: "FINDING_SEVERITY_HIGH",
+		4: "FINDING_SEVERITY_CRITICAL",
+	}
+	FindingSeverity_value = map[string]int32{
+		"FINDING_SEVERITY_UNSPECIFIED": 0,
+		"FINDING_SEVERITY_LOW":         1,
+		"FINDING_SEVERITY_MEDIUM":      2,
+		"FINDING_SEVERITY_HIGH":        3,
+		"FINDING_SEVERITY_CRITICAL":    4,
+	}
+)
+
+func (x FindingSeverity) Enum() *FindingSeverity {
+	p := new(FindingSeverity)
+	*p = x
+	return p
+}
+
+func (x FindingSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FindingSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_gastown_v1_witness_proto_enumTypes[0].Descriptor()
+}
+
+func (FindingSeverity) Type() protoreflect.EnumType {
+	return &file_gastown_v1_witness_proto_enumTypes[0]
+}
+
+func (x FindingSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FindingSeverity.Descriptor instead.
+func (FindingSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_gastown_v1_witness_proto_rawDescGZIP(), []int{0}
+}
+
+type ListFindingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agent         string                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`                                                                 // Only findings about this agent
+	Rig           string                 `protobuf:"bytes,2,opt,name=rig,proto3" json:"rig,omitempty"`                                                                     // Only findings about agents in this rig
+	MinSeverity   FindingSeverity        `protobuf:"varint,3,opt,name=min_severity,json=minSeverity,proto3,enum=gastown.v1.FindingSeverity" json:"min_severity,omitempty"` // Drop findings below this severity
+	IncludeClosed bool                   `protobuf:"varint,4,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"`                           // Include resolved findings (ranked last)
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                                                // Max findings to return (0 = all)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFindingsRequest) Reset() {
+	*x = ListFindingsRequest{}
+	mi := &file_gastown_v1_witness_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFindingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFindingsRequest) ProtoMessage() {}
+
+func (x *ListFindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_witness_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFindingsRequest.ProtoReflect.Descriptor instead.
+func (*ListFindingsRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_witness_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListFindingsRequest) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *ListFindingsRequest) GetRig() string {
+	if x != nil {
+		return x.Rig
+	}
+	return ""
+}
+
+func (x *ListFindingsRequest) GetMinSeverity() FindingSeverity {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return FindingSeverity_FINDING_SEVERITY_UNSPECIFIED
+}
+
+func (x *ListFindingsRequest) GetIncludeClosed() bool {
+	if x != nil {
+		return x.IncludeClosed
+	}
+	return false
+}
+
+func (x *ListFindingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFindingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Findings      []*Finding             `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Matching findings before limit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFindingsResponse) Reset() {
+	*x = ListFindingsResponse{}
+	mi := &file_gastown_v1_witness_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFindingsResponse) ProtoMessage() {}
+
+func (x *ListFindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_witness_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFindingsResponse.ProtoReflect.Descriptor instead.
+func (*ListFindingsResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_witness_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListFindingsResponse) GetFindings() []*Finding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *ListFindingsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ReportFindingRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Agent           string                 `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`                                        // Required: agent the finding is about
+	Category        string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                                  // Required: e.g., stuck, crash-loop, gupp-violation, zombie
+	Severity        FindingSeverity        `protobuf:"varint,4,opt,name=severity,proto3,enum=gastown.v1.FindingSeverity" json:"severity,omitempty"` // Defaults to medium
+	Evidence        string                 `protobuf:"bytes,5,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	SuggestedAction string                 `protobuf:"bytes,6,opt,name=suggested_action,json=suggestedAction,proto3" json:"suggested_action,omitempty"`
+	ReportedBy      string                 `protobuf:"bytes,7,opt,name=reported_by,json=reportedBy,proto3" json:"reported_by,omitempty"` // e.g., "gastown/witness"
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReportFindingRequest) Reset() {
+	*x = ReportFindingRequest{}
+	mi := &file_gastown_v1_witness_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportFindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportFindingRequest) ProtoMessage() {}
+
+func (x *ReportFindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_witness_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportFindingRequest.ProtoReflect.Descriptor instead.
+func (*ReportFindingRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_witness_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReportFindingRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ReportFindingRequest) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *ReportFindingRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ReportFindingRequest) GetSeverity() FindingSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return FindingSeverity_FINDING_SEVERITY_UNSPECIFIED
+}
+
+func (x *ReportFindingRequest) GetEvidence() string {
+	if x != nil {
+		return x.Evidence
+	}
+	return ""
+}
+
+func (x *ReportFindingRequest) GetSuggestedAction() string {
+	if x != nil {
+		return x.SuggestedAction
+	}
+	return ""
+}
+
+func (x *ReportFindingRequest) GetReportedBy() string {
+	if x != nil {
+		return x.ReportedBy
+	}
+	return ""
+}
+
+type ReportFindingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Finding       *Finding               `protobuf:"bytes,1,opt,name=finding,proto3" json:"finding,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // False if an open finding was updated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportFindingResponse) Reset() {
+	*x = ReportFindingResponse{}
+	mi := &file_gastown_v1_witness_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportFindingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportFindingResponse) ProtoMessage() {}
+
+func (x *ReportFindingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_witness_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportFindingResponse.ProtoReflect.Descriptor instead.
+func (*ReportFindingResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_witness_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReportFindingResponse) GetFinding() *Finding {
+	if x != nil {
+		return x.Finding
+	}
+	return nil
+}
+
+func (x *ReportFindingResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type ResolveFindingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FindingId     string                 `protobuf:"bytes,1,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ResolvedBy    string                 `protobuf:"bytes,3,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveFindingRequest) Reset() {
+	*x = ResolveFindingRequest{}
+	mi := &file_gastown_v1_witness_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveFindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFindingRequest) ProtoMessage() {}
+
+func (x *ResolveFindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_witness_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFindingRequest.ProtoReflect.Descriptor instead.
+func (*ResolveFindingRequest) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_witness_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ResolveFindingRequest) GetFindingId() string {
+	if x != nil {
+		return x.FindingId
+	}
+	return ""
+}
+
+func (x *ResolveFindingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ResolveFindingRequest) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+type ResolveFindingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FindingId     string                 `protobuf:"bytes,1,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveFindingResponse) Reset() {
+	*x = ResolveFindingResponse{}
+	mi := &file_gastown_v1_witness_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveFindingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveFindingResponse) ProtoMessage() {}
+
+func (x *ResolveFindingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_witness_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveFindingResponse.ProtoReflect.Descriptor instead.
+func (*ResolveFindingResponse) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_witness_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResolveFindingResponse) GetFindingId() string {
+	if x != nil {
+		return x.FindingId
+	}
+	return ""
+}
+
+// A structured witness finding about an agent
+type Finding struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // open, closed
+	Agent           string                 `protobuf:"bytes,4,opt,name=agent,proto3" json:"agent,omitempty"`
+	Category        string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Severity        FindingSeverity        `protobuf:"varint,6,opt,name=severity,proto3,enum=gastown.v1.FindingSeverity" json:"severity,omitempty"`
+	Evidence        string                 `protobuf:"bytes,7,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	SuggestedAction string                 `protobuf:"bytes,8,opt,name=suggested_action,json=suggestedAction,proto3" json:"suggested_action,omitempty"`
+	ReportedBy      string                 `protobuf:"bytes,9,opt,name=reported_by,json=reportedBy,proto3" json:"reported_by,omitempty"`
+	ReportedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	Occurrences     int32                  `protobuf:"varint,11,opt,name=occurrences,proto3" json:"occurrences,omitempty"` // Times reported while open
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Finding) Reset() {
+	*x = Finding{}
+	mi := &file_gastown_v1_witness_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Finding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Finding) ProtoMessage() {}
+
+func (x *Finding) ProtoReflect() protoreflect.Message {
+	mi := &file_gastown_v1_witness_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Finding.ProtoReflect.Descriptor instead.
+func (*Finding) Descriptor() ([]byte, []int) {
+	return file_gastown_v1_witness_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Finding) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Finding) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Finding) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Finding) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *Finding) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Finding) GetSeverity() FindingSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return FindingSeverity_FINDING_SEVERITY_UNSPECIFIED
+}
+
+func (x *Finding) GetEvidence() string {
+	if x != nil {
+		return x.Evidence
+	}
+	return ""
+}
+
+func (x *Finding) GetSuggestedAction() string {
+	if x != nil {
+		return x.SuggestedAction
+	}
+	return ""
+}
+
+func (x *Finding) GetReportedBy() string {
+	if x != nil {
+		return x.ReportedBy
+	}
+	return ""
+}
+
+func (x *Finding) GetReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return nil
+}
+
+func (x *Finding) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+var File_gastown_v1_witness_proto protoreflect.FileDescriptor
+
+const file_gastown_v1_witness_proto_rawDesc = "" +
+	"\n" +
+	"\x18gastown/v1/witness.proto\x12\n" +
+	"gastown.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xba\x01\n" +
+	"\x13ListFindingsRequest\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\x10\n" +
+	"\x03rig\x18\x02 \x01(\tR\x03rig\x12>\n" +
+	"\fmin_severity\x18\x03 \x01(\x0e2\x1b.gastown.v1.FindingSeverityR\vminSeverity\x12%\n" +
+	"\x0einclude_closed\x18\x04 \x01(\bR\rincludeClosed\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"]\n" +
+	"\x14ListFindingsResponse\x12/\n" +
+	"\bfindings\x18\x01 \x03(\v2\x13.gastown.v1.FindingR\bfindings\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xff\x01\n" +
+	"\x14ReportFindingRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
+	"\x05agent\x18\x02 \x01(\tR\x05agent\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x127\n" +
+	"\bseverity\x18\x04 \x01(\x0e2\x1b.gastown.v1.FindingSeverityR\bseverity\x12\x1a\n" +
+	"\bevidence\x18\x05 \x01(\tR\bevidence\x12)\n" +
+	"\x10suggested_action\x18\x06 \x01(\tR\x0fsuggestedAction\x12\x1f\n" +
+	"\vreported_by\x18\a \x01(\tR\n" +
+	"reportedBy\"`\n" +
+	"\x15ReportFindingResponse\x12-\n" +
+	"\afinding\x18\x01 \x01(\v2\x13.gastown.v1.FindingR\afinding\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"o\n" +
+	"\x15ResolveFindingRequest\x12\x1d\n" +
+	"\n" +
+	"finding_id\x18\x01 \x01(\tR\tfindingId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vresolved_by\x18\x03 \x01(\tR\n" +
+	"resolvedBy\"7\n" +
+	"\x16ResolveFindingResponse\x12\x1d\n" +
+	"\n" +
+	"finding_id\x18\x01 \x01(\tR\tfindingId\"\xf9\x02\n" +
+	"\aFinding\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05agent\x18\x04 \x01(\tR\x05agent\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x127\n" +
+	"\bseverity\x18\x06 \x01(\x0e2\x1b.gastown.v1.FindingSeverityR\bseverity\x12\x1a\n" +
+	"\bevidence\x18\a \x01(\tR\bevidence\x12)\n" +
+	"\x10suggested_action\x18\b \x01(\tR\x0fsuggestedAction\x12\x1f\n" +
+	"\vreported_by\x18\t \x01(\tR\n" +
+	"reportedBy\x12;\n" +
+	"\vreported_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reportedAt\x12 \n" +
+	"\voccurrences\x18\v \x01(\x05R\voccurrences*\xa4\x01\n" +
+	"\x0fFindingSeverity\x12 \n" +
+	"\x1cFINDING_SEVERITY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14FINDING_SEVERITY_LOW\x10\x01\x12\x1b\n" +
+	"\x17FINDING_SEVERITY_MEDIUM\x10\x02\x12\x19\n" +
+	"\x15FINDING_SEVERITY_HIGH\x10\x03\x12\x1d\n" +
+	"\x19FINDING_SEVERITY_CRITICAL\x10\x042\x92\x02\n" +
+	"\x0eWitnessService\x12Q\n" +
+	"\fListFindings\x12\x1f.gastown.v1.ListFindingsRequest\x1a .gastown.v1.ListFindingsResponse\x12T\n" +
+	"\rReportFinding\x12 .gastown.v1.ReportFindingRequest\x1a!.gastown.v1.ReportFindingResponse\x12W\n" +
+	"\x0eResolveFinding\x12!.gastown.v1.ResolveFindingRequest\x1a\".gastown.v1.ResolveFindingResponseB\x9f\x01\n" +
+	"\x0ecom.gastown.v1B\fWitnessProtoP\x01Z6github.com/steveyegge/gastown/gen/gastown/v1;gastownv1\xa2\x02\x03GXX\xaa\x02\n" +
+	"Gastown.V1\xca\x02\n" +
+	"Gastown\\V1\xe2\x02\x16Gastown\\V1\\GPBMetadata\xea\x02\vGastown::V1b\x06proto3"
+
+var (
+	file_gastown_v1_witness_proto_rawDescOnce sync.Once
+	file_gastown_v1_witness_proto_rawDescData []byte
+)
+
+func file_gastown_v1_witness_proto_rawDescGZIP() []byte {
+	file_gastown_v1_witness_proto_rawDescOnce.Do(func() {
+		file_gastown_v1_witness_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gastown_v1_witness_proto_rawDesc), len(file_gastown_v1_witness_proto_rawDesc)))
+	})
+	return file_gastown_v1_witness_proto_rawDescData
+}
+
+var file_gastown_v1_witness_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gastown_v1_witness_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_gastown_v1_witness_proto_goTypes = []any{
+	(FindingSeverity)(0),           // 0: gastown.v1.FindingSeverity
+	(*ListFindingsRequest)(nil),    // 1: gastown.v1.ListFindingsRequest
+	(*ListFindingsResponse)(nil),   // 2: gastown.v1.ListFindingsResponse
+	(*ReportFindingRequest)(nil),   // 3: gastown.v1.ReportFindingRequest
+	(*ReportFindingResponse)(nil),  // 4: gastown.v1.ReportFindingResponse
+	(*ResolveFindingRequest)(nil),  // 5: gastown.v1.ResolveFindingRequest
+	(*ResolveFindingResponse)(nil), // 6: gastown.v1.ResolveFindingResponse
+	(*Finding)(nil),                // 7: gastown.v1.Finding
+	(*timestamppb.Timestamp)(nil),  // 8: google.protobuf.Timestamp
+}
+var file_gastown_v1_witness_proto_depIdxs = []int32{
+	0, // 0: gastown.v1.ListFindingsRequest.min_severity:type_name -> gastown.v1.FindingSeverity
+	7, // 1: gastown.v1.ListFindingsResponse.findings:type_name -> gastown.v1.Finding
+	0, // 2: gastown.v1.ReportFindingRequest.severity:type_name -> gastown.v1.FindingSeverity
+	7, // 3: gastown.v1.ReportFindingResponse.finding:type_name -> gastown.v1.Finding
+	0, // 4: gastown.v1.Finding.severity:type_name -> gastown.v1.FindingSeverity
+	8, // 5: gastown.v1.Finding.reported_at:type_name -> google.protobuf.Timestamp
+	1, // 6: gastown.v1.WitnessService.ListFindings:input_type -> gastown.v1.ListFindingsRequest
+	3, // 7: gastown.v1.WitnessService.ReportFinding:input_type -> gastown.v1.ReportFindingRequest
+	5, // 8: gastown.v1.WitnessService.ResolveFinding:input_type -> gastown.v1.ResolveFindingRequest
+	2, // 9: gastown.v1.WitnessService.ListFindings:output_type -> gastown.v1.ListFindingsResponse
+	4, // 10: gastown.v1.WitnessService.ReportFinding:output_type -> gastown.v1.ReportFindingResponse
+	6, // 11: gastown.v1.WitnessService.ResolveFinding:output_type -> gastown.v1.ResolveFindingResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_gastown_v1_witness_proto_init() }
+func file_gastown_v1_witness_proto_init() {
+	if File_gastown_v1_witness_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gastown_v1_witness_proto_rawDesc), len(file_gastown_v1_witness_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gastown_v1_witness_proto_goTypes,
+		DependencyIndexes: file_gastown_v1_witness_proto_depIdxs,
+		EnumInfos:         file_gastown_v1_witness_proto_enumTypes,
+		MessageInfos:      file_gastown_v1_witness_proto_msgTypes,
+	}.Build()
+	File_gastown_v1_witness_proto = out.File
+	file_gastown_v1_witness_proto_goTypes = nil
+	file_gastown_v1_witness_proto_depIdxs = nil
+}
@@ -0,0 +1,290 @@
+package advice
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// HooksConfigFile is the name of the advice hooks configuration file. The
+// town file holds defaults for every agent; a rig file adds hooks for that
+// rig's agents and overrides town hooks with the same ID.
+const HooksConfigFile = "hooks.json"
+
+// CurrentHooksConfigVersion is the current schema version for HooksConfig.
+const CurrentHooksConfigVersion = 1
+
+// HooksConfig is an advice hooks configuration file (settings/hooks.json).
+type HooksConfig struct {
+	Type    string       `json:"type"`    // "advice-hooks"
+	Version int          `json:"version"` // schema version
+	Hooks   []HookConfig `json:"hooks"`
+}
+
+// HookConfig is a hook defined in a hooks configuration file.
+type HookConfig struct {
+	ID        string   `json:"id"`
+	Title     string   `json:"title,omitempty"`
+	Command   string   `json:"command,omitempty"`
+	Trigger   string   `json:"trigger,omitempty"`
+	Timeout   int      `json:"timeout,omitempty"`
+	OnFailure string   `json:"on_failure,omitempty"`
+	Priority  int      `json:"priority,omitempty"`
+	DependsOn []string `json:"depends_on,omitempty"`
+
+	// Roles limits the hook to agents with these roles (e.g., "polecat",
+	// "crew"). Empty applies to every role.
+	Roles []string `json:"roles,omitempty"`
+
+	// Disabled turns off a town hook with the same ID in a rig file.
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// TownHooksPath returns the path of the town-wide hooks configuration.
+func TownHooksPath(townRoot string) string {
+	return filepath.Join(townRoot, "settings", HooksConfigFile)
+}
+
+// RigHooksPath returns the path of a rig's hooks configuration.
+func RigHooksPath(rigPath string) string {
+	return filepath.Join(rigPath, "settings", HooksConfigFile)
+}
+
+// NewHooksConfig creates an empty hooks configuration.
+func NewHooksConfig() *HooksConfig {
+	return &HooksConfig{
+		Type:    "advice-hooks",
+		Version: CurrentHooksConfigVersion,
+	}
+}
+
+// LoadHooksConfig loads and validates a hooks configuration file.
+// A missing file yields an empty configuration.
+func LoadHooksConfig(path string) (*HooksConfig, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return NewHooksConfig(), nil
+		}
+		return nil, fmt.Errorf("reading hooks config: %w", err)
+	}
+
+	var cfg HooksConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing hooks config %s: %w", path, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// SaveHooksConfig validates and writes a hooks configuration file.
+func SaveHooksConfig(path string, cfg *HooksConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding hooks config: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil { //nolint:gosec // G306: hooks config doesn't contain secrets
+		return fmt.Errorf("writing hooks config: %w", err)
+	}
+	return nil
+}
+
+// Validate checks the configuration for missing fields, duplicate IDs and
+// invalid hook settings.
+func (c *HooksConfig) Validate() error {
+	if c.Type != "advice-hooks" && c.Type != "" {
+		return fmt.Errorf("invalid hooks config type %q", c.Type)
+	}
+	if c.Version > CurrentHooksConfigVersion {
+		return fmt.Errorf("unsupported hooks config version %d (max %d)", c.Version, CurrentHooksConfigVersion)
+	}
+
+	seen := make(map[string]bool, len(c.Hooks))
+	for i, h := range c.Hooks {
+		if h.ID == "" {
+			return fmt.Errorf("hooks[%d]: id is required", i)
+		}
+		if seen[h.ID] {
+			return fmt.Errorf("hooks[%d]: duplicate id %q", i, h.ID)
+		}
+		seen[h.ID] = true
+		if h.Disabled {
+			continue
+		}
+
+		if h.Trigger == "" {
+			return fmt.Errorf("hook %q: trigger is required", h.ID)
+		}
+		if err := ValidateHook(h.toHook()); err != nil {
+			return fmt.Errorf("hook %q: %w", h.ID, err)
+		}
+		if h.Timeout > MaxTimeout {
+			return fmt.Errorf("hook %q: timeout exceeds maximum of %d seconds", h.ID, MaxTimeout)
+		}
+		for _, role := range h.Roles {
+			if !isAgentRole(role) {
+				return fmt.Errorf("hook %q: unknown role %q", h.ID, role)
+			}
+		}
+	}
+	return nil
+}
+
+// Set adds a hook, replacing any existing hook with the same ID.
+func (c *HooksConfig) Set(hook HookConfig) {
+	for i := range c.Hooks {
+		if c.Hooks[i].ID == hook.ID {
+			c.Hooks[i] = hook
+			return
+		}
+	}
+	c.Hooks = append(c.Hooks, hook)
+}
+
+// Remove deletes the hook with the given ID, reporting whether it existed.
+func (c *HooksConfig) Remove(id string) bool {
+	for i := range c.Hooks {
+		if c.Hooks[i].ID == id {
+			c.Hooks = append(c.Hooks[:i], c.Hooks[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Get returns the hook with the given ID, or nil.
+func (c *HooksConfig) Get(id string) *HookConfig {
+	for i := range c.Hooks {
+		if c.Hooks[i].ID == id {
+			return &c.Hooks[i]
+		}
+	}
+	return nil
+}
+
+// MergeHooksConfigs merges town defaults with a rig's configuration. Rig
+// hooks replace town hooks with the same ID, and disabled rig entries
+// remove them. rig may be nil.
+func MergeHooksConfigs(town, rig *HooksConfig) []HookConfig {
+	var merged []HookConfig
+	overrides := make(map[string]bool)
+	if rig != nil {
+		for _, h := range rig.Hooks {
+			overrides[h.ID] = true
+		}
+	}
+	if town != nil {
+		for _, h := range town.Hooks {
+			if !overrides[h.ID] && !h.Disabled {
+				merged = append(merged, h)
+			}
+		}
+	}
+	if rig != nil {
+		for _, h := range rig.Hooks {
+			if !h.Disabled {
+				merged = append(merged, h)
+			}
+		}
+	}
+	return merged
+}
+
+// AppliesTo reports whether the hook runs for an agent with the given role.
+func (h HookConfig) AppliesTo(role string) bool {
+	return len(h.Roles) == 0 || slices.Contains(h.Roles, role)
+}
+
+// ConfiguredHooks returns the hooks from the town and rig configuration
+// files that apply to agentID at trigger, sorted by priority. townRoot is
+// the town the agent belongs to; the rig comes from the agent's address.
+func ConfiguredHooks(townRoot, agentID, trigger string) ([]*Hook, error) {
+	town, err := LoadHooksConfig(TownHooksPath(townRoot))
+	if err != nil {
+		return nil, err
+	}
+
+	var role string
+	var rig *HooksConfig
+	if id, err := session.ParseAddress(agentID); err == nil {
+		role = string(id.Role)
+		if id.Rig != "" {
+			rig, err = LoadHooksConfig(RigHooksPath(filepath.Join(townRoot, id.Rig)))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var hooks []*Hook
+	for _, h := range MergeHooksConfigs(town, rig) {
+		if h.Trigger != trigger || !h.AppliesTo(role) {
+			continue
+		}
+		hooks = append(hooks, h.toHook())
+	}
+	sort.SliceStable(hooks, func(i, j int) bool {
+		return hooks[i].Priority < hooks[j].Priority
+	})
+	return hooks, nil
+}
+
+// configuredHooksFromWorkDir is ConfiguredHooks for the town containing
+// workDir. Outside a town there are no configured hooks.
+func configuredHooksFromWorkDir(workDir, agentID, trigger string) ([]*Hook, error) {
+	townRoot, err := workspace.Find(workDir)
+	if err != nil || townRoot == "" {
+		return nil, nil
+	}
+	return ConfiguredHooks(townRoot, agentID, trigger)
+}
+
+// toHook converts a configured hook to an executable hook with defaults.
+func (h HookConfig) toHook() *Hook {
+	hook := &Hook{
+		ID:        h.ID,
+		Title:     h.Title,
+		Command:   h.Command,
+		Trigger:   h.Trigger,
+		Timeout:   h.Timeout,
+		OnFailure: h.OnFailure,
+		Priority:  h.Priority,
+		DependsOn: h.DependsOn,
+	}
+	if hook.Title == "" {
+		hook.Title = h.ID
+	}
+	if hook.Timeout <= 0 {
+		hook.Timeout = DefaultTimeout
+	}
+	if hook.OnFailure == "" {
+		hook.OnFailure = OnFailureWarn
+	}
+	return hook
+}
+
+// isAgentRole reports whether role names a Gas Town agent role.
+func isAgentRole(role string) bool {
+	switch session.Role(role) {
+	case session.RoleMayor, session.RoleDeacon, session.RoleWitness,
+		session.RoleRefinery, session.RoleCrew, session.RolePolecat:
+		return true
+	}
+	return false
+}
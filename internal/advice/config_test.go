@@ -0,0 +1,163 @@
+package advice
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHooksConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		hooks   []HookConfig
+		wantErr bool
+	}{
+		{"empty", nil, false},
+		{"valid", []HookConfig{{ID: "lint", Command: "make lint", Trigger: TriggerBeforeCommit, Roles: []string{"polecat"}}}, false},
+		{"missing id", []HookConfig{{Command: "make lint", Trigger: TriggerBeforeCommit}}, true},
+		{"duplicate id", []HookConfig{
+			{ID: "lint", Command: "make lint", Trigger: TriggerBeforeCommit},
+			{ID: "lint", Command: "make vet", Trigger: TriggerBeforePush},
+		}, true},
+		{"missing command", []HookConfig{{ID: "lint", Trigger: TriggerBeforeCommit}}, true},
+		{"missing trigger", []HookConfig{{ID: "lint", Command: "make lint"}}, true},
+		{"bad trigger", []HookConfig{{ID: "lint", Command: "make lint", Trigger: "someday"}}, true},
+		{"bad on_failure", []HookConfig{{ID: "lint", Command: "make lint", Trigger: TriggerBeforeCommit, OnFailure: "panic"}}, true},
+		{"timeout too long", []HookConfig{{ID: "lint", Command: "make lint", Trigger: TriggerBeforeCommit, Timeout: MaxTimeout + 1}}, true},
+		{"unknown role", []HookConfig{{ID: "lint", Command: "make lint", Trigger: TriggerBeforeCommit, Roles: []string{"janitor"}}}, true},
+		{"disabled needs only id", []HookConfig{{ID: "lint", Disabled: true}}, false},
+	}
+
+	for _, tt := range tests {
+		cfg := NewHooksConfig()
+		cfg.Hooks = tt.hooks
+		err := cfg.Validate()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestHooksConfigSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings", HooksConfigFile)
+
+	cfg, err := LoadHooksConfig(path)
+	if err != nil {
+		t.Fatalf("LoadHooksConfig(missing) error: %v", err)
+	}
+	if len(cfg.Hooks) != 0 {
+		t.Fatalf("missing file should load empty, got %d hooks", len(cfg.Hooks))
+	}
+
+	cfg.Set(HookConfig{ID: "lint", Command: "make lint", Trigger: TriggerBeforeCommit})
+	cfg.Set(HookConfig{ID: "lint", Command: "golangci-lint run", Trigger: TriggerBeforeCommit})
+	if err := SaveHooksConfig(path, cfg); err != nil {
+		t.Fatalf("SaveHooksConfig() error: %v", err)
+	}
+
+	loaded, err := LoadHooksConfig(path)
+	if err != nil {
+		t.Fatalf("LoadHooksConfig() error: %v", err)
+	}
+	if len(loaded.Hooks) != 1 || loaded.Hooks[0].Command != "golangci-lint run" {
+		t.Errorf("Set should replace by ID, got %+v", loaded.Hooks)
+	}
+
+	if !loaded.Remove("lint") || loaded.Remove("lint") {
+		t.Error("Remove should report true once, then false")
+	}
+
+	if err := os.WriteFile(path, []byte(`{"hooks":[{"id":"x"}]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadHooksConfig(path); err == nil {
+		t.Error("LoadHooksConfig should reject an invalid hook")
+	}
+}
+
+func TestMergeHooksConfigs(t *testing.T) {
+	town := &HooksConfig{Hooks: []HookConfig{
+		{ID: "lint", Command: "make lint", Trigger: TriggerBeforeCommit},
+		{ID: "vet", Command: "go vet ./...", Trigger: TriggerBeforeCommit},
+		{ID: "notes", Command: "true", Trigger: TriggerSessionEnd},
+	}}
+	rig := &HooksConfig{Hooks: []HookConfig{
+		{ID: "lint", Command: "npm run lint", Trigger: TriggerBeforeCommit},
+		{ID: "notes", Disabled: true},
+		{ID: "tests", Command: "npm test", Trigger: TriggerBeforePush},
+	}}
+
+	merged := MergeHooksConfigs(town, rig)
+	got := make(map[string]string)
+	for _, h := range merged {
+		got[h.ID] = h.Command
+	}
+
+	want := map[string]string{"lint": "npm run lint", "vet": "go vet ./...", "tests": "npm test"}
+	if len(got) != len(want) {
+		t.Fatalf("merged = %v, want %v", got, want)
+	}
+	for id, cmd := range want {
+		if got[id] != cmd {
+			t.Errorf("hook %s command = %q, want %q", id, got[id], cmd)
+		}
+	}
+
+	if n := len(MergeHooksConfigs(town, nil)); n != 3 {
+		t.Errorf("town only: got %d hooks, want 3", n)
+	}
+}
+
+func TestConfiguredHooks(t *testing.T) {
+	townRoot := t.TempDir()
+	town := NewHooksConfig()
+	town.Hooks = []HookConfig{
+		{ID: "lint", Command: "make lint", Trigger: TriggerBeforeCommit, Priority: 2},
+		{ID: "polecat-only", Command: "true", Trigger: TriggerBeforeCommit, Priority: 1, Roles: []string{"polecat"}},
+		{ID: "push", Command: "true", Trigger: TriggerBeforePush},
+	}
+	if err := SaveHooksConfig(TownHooksPath(townRoot), town); err != nil {
+		t.Fatal(err)
+	}
+	rig := NewHooksConfig()
+	rig.Hooks = []HookConfig{{ID: "rig-check", Command: "true", Trigger: TriggerBeforeCommit, Priority: 3}}
+	if err := SaveHooksConfig(RigHooksPath(filepath.Join(townRoot, "gastown")), rig); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		agent string
+		want  []string
+	}{
+		{"gastown/polecats/furiosa", []string{"polecat-only", "lint", "rig-check"}},
+		{"gastown/crew/max", []string{"lint", "rig-check"}},
+		{"otherrig/polecats/nux", []string{"polecat-only", "lint"}},
+		{"mayor", []string{"lint"}},
+	}
+
+	for _, tt := range tests {
+		hooks, err := ConfiguredHooks(townRoot, tt.agent, TriggerBeforeCommit)
+		if err != nil {
+			t.Fatalf("%s: ConfiguredHooks() error: %v", tt.agent, err)
+		}
+		var got []string
+		for _, h := range hooks {
+			got = append(got, h.ID)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.agent, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: got %v, want %v", tt.agent, got, tt.want)
+				break
+			}
+		}
+	}
+
+	hooks, _ := ConfiguredHooks(townRoot, "mayor", TriggerBeforeCommit)
+	if h := hooks[0]; h.Timeout != DefaultTimeout || h.OnFailure != OnFailureWarn || h.Title != "lint" {
+		t.Errorf("defaults not applied: %+v", h)
+	}
+}
@@ -7,12 +7,19 @@ package advice
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 // Trigger constants define when advice hooks should execute.
@@ -32,9 +39,10 @@ const (
 
 // Default values
 const (
-	DefaultTimeout   = 30   // seconds
-	MaxTimeout       = 300  // seconds (5 minutes)
-	MaxCommandLength = 1000 // characters
+	DefaultTimeout   = 30       // seconds
+	MaxTimeout       = 300      // seconds (5 minutes)
+	MaxCommandLength = 1000     // characters
+	DefaultMaxOutput = 64 << 10 // bytes of output kept in HookResult.Output
 )
 
 // ValidTriggers lists all valid hook trigger values.
@@ -74,6 +82,10 @@ type Hook struct {
 
 	// Title is the advice bead title for logging
 	Title string
+
+	// DependsOn lists IDs of hooks that must finish successfully before this
+	// one starts. IDs not present in the same run are ignored.
+	DependsOn []string
 }
 
 // HookResult represents the outcome of executing a hook.
@@ -87,9 +99,16 @@ type HookResult struct {
 	// ExitCode is the command's exit code
 	ExitCode int
 
-	// Output is the combined stdout and stderr
+	// Output is the combined stdout and stderr, truncated in the middle
+	// if it exceeded the runner's MaxOutput
 	Output string
 
+	// Truncated is true if Output omits part of the hook's output
+	Truncated bool
+
+	// LogPath is the file holding the full output, if one was written
+	LogPath string
+
 	// Duration is how long the hook took to execute
 	Duration time.Duration
 
@@ -98,6 +117,10 @@ type HookResult struct {
 
 	// TimedOut is true if the hook was killed due to timeout
 	TimedOut bool
+
+	// Skipped is true if the hook never ran because a dependency did not
+	// succeed, it was part of a dependency cycle, or the run timed out
+	Skipped bool
 }
 
 // Runner executes advice hooks with timeout and failure handling.
@@ -113,16 +136,40 @@ type Runner struct {
 
 	// Shell is the shell to use (default: sh)
 	Shell string
+
+	// Parallel runs hooks concurrently once their dependencies finish.
+	// When false, hooks run one at a time in priority order.
+	Parallel bool
+
+	// MaxParallel caps concurrently running hooks in parallel mode
+	// (0 = no limit).
+	MaxParallel int
+
+	// Timeout bounds the whole RunAll call (0 = no limit). Hooks still
+	// running when it expires are killed and hooks not yet started are skipped.
+	Timeout time.Duration
+
+	// MaxOutput caps the bytes of output kept in memory per hook
+	// (default: DefaultMaxOutput).
+	MaxOutput int
+
+	// LogDir receives a log file with the full output of each hook run.
+	// NewRunner sets it to <town>/.runtime/hooks; empty disables logs.
+	LogDir string
 }
 
 // NewRunner creates a new advice hook runner.
 func NewRunner(workDir string, agentID string) *Runner {
-	return &Runner{
+	r := &Runner{
 		WorkDir: workDir,
 		AgentID: agentID,
 		EnvVars: make(map[string]string),
 		Shell:   "sh",
 	}
+	if townRoot, err := workspace.Find(workDir); err == nil && townRoot != "" {
+		r.LogDir = filepath.Join(townRoot, constants.DirRuntime, "hooks")
+	}
+	return r
 }
 
 // SetEnv adds an environment variable for hook execution.
@@ -132,6 +179,21 @@ func (r *Runner) SetEnv(key, value string) {
 
 // Execute runs a single hook with timeout and returns the result.
 func (r *Runner) Execute(hook *Hook) *HookResult {
+	return r.ExecuteContext(context.Background(), hook)
+}
+
+// ExecuteContext runs a single hook like Execute, also killing it if parent
+// is canceled or expires first. Each run is recorded as a hook_run event.
+func (r *Runner) ExecuteContext(parent context.Context, hook *Hook) *HookResult {
+	result := r.execute(parent, hook)
+	if hook != nil {
+		r.recordRun(result)
+	}
+	return result
+}
+
+// execute runs a hook and captures its output.
+func (r *Runner) execute(parent context.Context, hook *Hook) *HookResult {
 	result := &HookResult{
 		Hook: hook,
 	}
@@ -155,7 +217,7 @@ func (r *Runner) Execute(hook *Hook) *HookResult {
 	}
 
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	ctx, cancel := context.WithTimeout(parent, time.Duration(timeout)*time.Second)
 	defer cancel()
 
 	// Create command - don't use CommandContext because we need to handle
@@ -177,14 +239,13 @@ func (r *Runner) Execute(hook *Hook) *HookResult {
 	cmd.Env = append(cmd.Env, fmt.Sprintf("GT_AGENT_ID=%s", r.AgentID))
 	cmd.Env = append(cmd.Env, fmt.Sprintf("GT_WORK_DIR=%s", r.WorkDir))
 
-	// Capture output using a single wrapper shared by stdout and stderr.
+	// Capture output using a single writer shared by stdout and stderr.
 	// The exec package reads both pipes concurrently in separate goroutines,
-	// so they must share one mutex-protected writer to avoid a data race on
-	// the underlying strings.Builder.
-	var outputBuf strings.Builder
-	outputWrapper := &writerWrapper{sb: &outputBuf}
-	cmd.Stdout = outputWrapper
-	cmd.Stderr = outputWrapper
+	// so they must share one mutex-protected writer to avoid a data race.
+	output := r.newOutputCapture(hook, start)
+	defer output.finish(result)
+	cmd.Stdout = output
+	cmd.Stderr = output
 
 	// Start the command
 	if err := cmd.Start(); err != nil {
@@ -213,15 +274,16 @@ func (r *Runner) Execute(hook *Hook) *HookResult {
 		// Wait for process to actually exit
 		<-done
 		result.Duration = time.Since(start)
-		result.Output = outputBuf.String()
 		result.TimedOut = true
 		result.Error = fmt.Errorf("hook timed out after %d seconds", timeout)
+		if parent.Err() != nil {
+			result.Error = fmt.Errorf("hook stopped: %w", runStopReason(parent))
+		}
 		result.ExitCode = -1
 		return result
 
 	case wr := <-done:
 		result.Duration = time.Since(start)
-		result.Output = outputBuf.String()
 
 		// Check for errors
 		if wr.err != nil {
@@ -241,29 +303,146 @@ func (r *Runner) Execute(hook *Hook) *HookResult {
 	}
 }
 
-// writerWrapper wraps a strings.Builder to implement io.Writer.
-// The mutex ensures concurrent writes from stdout and stderr goroutines
-// (both managed by the exec package) do not race on the shared Builder.
-type writerWrapper struct {
-	mu sync.Mutex
-	sb *strings.Builder
+// outputCapture collects hook output. The full output goes to a log file,
+// while memory keeps only the first and last MaxOutput/2 bytes. The mutex
+// ensures concurrent writes from the stdout and stderr goroutines (both
+// managed by the exec package) do not race.
+type outputCapture struct {
+	mu      sync.Mutex
+	limit   int // bytes kept from each end
+	head    []byte
+	tail    []byte
+	omitted int64
+	log     *os.File
+	logPath string
+}
+
+// newOutputCapture prepares output capture for one run of hook, opening
+// its log file if the runner has a log directory (best-effort).
+func (r *Runner) newOutputCapture(hook *Hook, start time.Time) *outputCapture {
+	maxOutput := r.MaxOutput
+	if maxOutput <= 0 {
+		maxOutput = DefaultMaxOutput
+	}
+	c := &outputCapture{limit: maxOutput / 2}
+
+	if r.LogDir != "" {
+		if err := os.MkdirAll(r.LogDir, 0755); err == nil {
+			name := fmt.Sprintf("%s-%s.log", start.UTC().Format("20060102T150405.000"), logSafeName(hook.ID))
+			path := filepath.Join(r.LogDir, name)
+			if f, err := os.Create(path); err == nil { //nolint:gosec // G304: path is built from the runner's log dir
+				c.log = f
+				c.logPath = path
+			}
+		}
+	}
+	return c
 }
 
-func (w *writerWrapper) Write(p []byte) (n int, err error) {
-	w.mu.Lock()
-	defer w.mu.Unlock()
-	return w.sb.Write(p)
+func (c *outputCapture) Write(p []byte) (n int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.log != nil {
+		if _, err := c.log.Write(p); err != nil {
+			_ = c.log.Close()
+			c.log = nil // keep capturing in memory
+		}
+	}
+
+	data := p
+	if room := c.limit - len(c.head); room > 0 {
+		take := min(room, len(data))
+		c.head = append(c.head, data[:take]...)
+		data = data[take:]
+	}
+	if len(data) == 0 {
+		return len(p), nil
+	}
+
+	c.tail = append(c.tail, data...)
+	if over := len(c.tail) - c.limit; over > 0 {
+		c.omitted += int64(over)
+		c.tail = append(c.tail[:0], c.tail[over:]...)
+	}
+	return len(p), nil
+}
+
+// finish closes the log and stores the captured output in result.
+func (c *outputCapture) finish(result *HookResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.log != nil {
+		_ = c.log.Close()
+		result.LogPath = c.logPath
+	}
+	if c.omitted == 0 {
+		result.Output = string(c.head) + string(c.tail)
+		return
+	}
+
+	marker := fmt.Sprintf("\n[... %d bytes of output truncated ...]\n", c.omitted)
+	if result.LogPath != "" {
+		marker = fmt.Sprintf("\n[... %d bytes of output truncated; full output in %s ...]\n", c.omitted, result.LogPath)
+	}
+	result.Output = string(c.head) + marker + string(c.tail)
+	result.Truncated = true
+}
+
+// logSafeName makes a hook ID usable in a file name.
+func logSafeName(id string) string {
+	if id == "" {
+		return "hook"
+	}
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ':' || r == ' ' {
+			return '_'
+		}
+		return r
+	}, id)
+}
+
+// recordRun emits a hook_run event for a finished hook. Failures appear in
+// the activity feed; successful runs go to the audit log only.
+func (r *Runner) recordRun(result *HookResult) {
+	payload := events.HookRunPayload(result.Hook.ID, result.Hook.Trigger, result.ExitCode,
+		result.Duration, result.Success, result.TimedOut, result.LogPath)
+	if result.Hook.Title != "" {
+		payload["title"] = result.Hook.Title
+	}
+	if result.Hook.OnFailure != "" {
+		payload["on_failure"] = result.Hook.OnFailure
+	}
+	if result.Success {
+		_ = events.LogAudit(events.TypeHookRun, r.AgentID, payload)
+	} else {
+		_ = events.LogFeed(events.TypeHookRun, r.AgentID, payload)
+	}
 }
 
-// RunAll executes multiple hooks in priority order and returns all results.
+// RunAll executes hooks and returns their results in the order the hooks
+// were given. Hooks run one at a time in that order unless r.Parallel is set.
+// Either way a hook starts only after the hooks in its DependsOn have
+// finished, and is skipped if any of them did not succeed.
 // Returns an error if any hook with on_failure=block fails.
 func (r *Runner) RunAll(hooks []*Hook) ([]*HookResult, error) {
-	var results []*HookResult
-	var blockingError error
+	if len(hooks) == 0 {
+		return nil, nil
+	}
+
+	ctx := context.Background()
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
+	}
+
+	results := r.schedule(ctx, hooks)
 
-	for _, hook := range hooks {
-		result := r.Execute(hook)
-		results = append(results, result)
+	var blockingError error
+	for i, hook := range hooks {
+		result := results[i]
 
 		// Handle failure based on on_failure setting
 		if !result.Success && result.Error == nil {
@@ -279,7 +458,7 @@ func (r *Runner) RunAll(hooks []*Hook) ([]*HookResult, error) {
 			}
 		}
 
-		// Also block on execution errors (malformed command, etc.)
+		// Also block on execution errors (malformed command, skipped, etc.)
 		if result.Error != nil && hook.OnFailure == OnFailureBlock && blockingError == nil {
 			blockingError = fmt.Errorf("hook %q execution error: %w", hook.ID, result.Error)
 		}
@@ -288,6 +467,134 @@ func (r *Runner) RunAll(hooks []*Hook) ([]*HookResult, error) {
 	return results, blockingError
 }
 
+// schedule runs hooks respecting DependsOn, starting ready hooks in input
+// order, and returns one result per hook in input order.
+func (r *Runner) schedule(ctx context.Context, hooks []*Hook) []*HookResult {
+	n := len(hooks)
+	index := make(map[string]int, n)
+	for i := n - 1; i >= 0; i-- {
+		index[hooks[i].ID] = i // first hook wins on duplicate IDs
+	}
+
+	deps := make([][]int, n)
+	dependents := make([][]int, n)
+	waiting := make([]int, n)
+	for i, hook := range hooks {
+		for _, id := range hook.DependsOn {
+			j, ok := index[id]
+			if !ok || j == i {
+				continue
+			}
+			deps[i] = append(deps[i], j)
+			dependents[j] = append(dependents[j], i)
+			waiting[i]++
+		}
+	}
+
+	var ready []int
+	for i := range hooks {
+		if waiting[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	limit := 1
+	if r.Parallel {
+		limit = n
+		if r.MaxParallel > 0 && r.MaxParallel < n {
+			limit = r.MaxParallel
+		}
+	}
+
+	results := make([]*HookResult, n)
+	finish := func(i int) {
+		for _, k := range dependents[i] {
+			waiting[k]--
+			if waiting[k] == 0 {
+				ready = insertSorted(ready, k)
+			}
+		}
+	}
+
+	done := make(chan int)
+	running := 0
+	for {
+		for running < limit && len(ready) > 0 {
+			i := ready[0]
+			ready = ready[1:]
+
+			if err := dependencyFailure(hooks, results, deps[i]); err != nil {
+				results[i] = skippedResult(hooks[i], err)
+				finish(i)
+				continue
+			}
+			if ctx.Err() != nil {
+				results[i] = skippedResult(hooks[i], runStopReason(ctx))
+				finish(i)
+				continue
+			}
+
+			running++
+			go func(i int) {
+				results[i] = r.ExecuteContext(ctx, hooks[i])
+				done <- i
+			}(i)
+		}
+		if running == 0 {
+			break
+		}
+		i := <-done
+		running--
+		finish(i)
+	}
+
+	// Hooks that never became ready depend on each other.
+	for i := range results {
+		if results[i] == nil {
+			results[i] = skippedResult(hooks[i], errors.New("dependency cycle"))
+		}
+	}
+	return results
+}
+
+// dependencyFailure returns an error naming the first dependency that did
+// not succeed, or nil if all succeeded.
+func dependencyFailure(hooks []*Hook, results []*HookResult, deps []int) error {
+	for _, j := range deps {
+		if !results[j].Success {
+			return fmt.Errorf("dependency %q did not succeed", hooks[j].ID)
+		}
+	}
+	return nil
+}
+
+// skippedResult is the result for a hook that was never started.
+func skippedResult(hook *Hook, reason error) *HookResult {
+	return &HookResult{
+		Hook:     hook,
+		ExitCode: -1,
+		Skipped:  true,
+		Error:    fmt.Errorf("skipped: %w", reason),
+	}
+}
+
+// runStopReason describes why a run context ended.
+func runStopReason(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return errors.New("aggregate hook timeout reached")
+	}
+	return ctx.Err()
+}
+
+// insertSorted inserts v into the ascending slice s.
+func insertSorted(s []int, v int) []int {
+	i := sort.SearchInts(s, v)
+	s = append(s, 0)
+	copy(s[i+1:], s[i:])
+	s[i] = v
+	return s
+}
+
 // ValidateHook checks if a hook is valid for execution.
 func ValidateHook(hook *Hook) error {
 	if hook == nil {
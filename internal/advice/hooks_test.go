@@ -688,6 +688,145 @@ func TestRunAll_NilHooksList(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// Parallel Execution and Dependency Tests
+// ============================================================================
+
+func TestRunAll_ParallelRunsConcurrently(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sleep not available on Windows")
+	}
+	runner := NewRunner(t.TempDir(), "test-agent")
+	runner.Parallel = true
+
+	hooks := []*Hook{
+		{ID: "a", Command: "sleep 1", Timeout: 5},
+		{ID: "b", Command: "sleep 1", Timeout: 5},
+		{ID: "c", Command: "sleep 1", Timeout: 5},
+	}
+
+	start := time.Now()
+	results, err := runner.RunAll(hooks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2500*time.Millisecond {
+		t.Errorf("parallel run took %v, expected hooks to overlap", elapsed)
+	}
+	for i, result := range results {
+		if result.Hook != hooks[i] {
+			t.Errorf("results[%d] is for hook %s, want %s", i, result.Hook.ID, hooks[i].ID)
+		}
+		if !result.Success {
+			t.Errorf("hook %s should succeed", hooks[i].ID)
+		}
+	}
+}
+
+func TestRunAll_DependsOnOrdering(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sleep not available on Windows")
+	}
+	for _, parallel := range []bool{false, true} {
+		dir := t.TempDir()
+		runner := NewRunner(dir, "test-agent")
+		runner.Parallel = parallel
+
+		// "test" is listed first but must wait for "build".
+		hooks := []*Hook{
+			{ID: "test", Command: "test -f built && echo tested >> order", DependsOn: []string{"build"}},
+			{ID: "build", Command: "sleep 0.2; touch built; echo built >> order"},
+		}
+
+		results, err := runner.RunAll(hooks)
+		if err != nil {
+			t.Fatalf("parallel=%v: unexpected error: %v", parallel, err)
+		}
+		if results[0].Hook.ID != "test" || results[1].Hook.ID != "build" {
+			t.Errorf("parallel=%v: results not in input order", parallel)
+		}
+		order, _ := os.ReadFile(filepath.Join(dir, "order"))
+		if got := strings.Fields(string(order)); strings.Join(got, ",") != "built,tested" {
+			t.Errorf("parallel=%v: execution order = %v, want [built tested]", parallel, got)
+		}
+	}
+}
+
+func TestRunAll_DependencyFailureSkips(t *testing.T) {
+	runner := NewRunner(t.TempDir(), "test-agent")
+	runner.Parallel = true
+
+	hooks := []*Hook{
+		{ID: "lint", Command: "exit 1", OnFailure: OnFailureWarn},
+		{ID: "test", Command: "echo test", OnFailure: OnFailureBlock, DependsOn: []string{"lint"}},
+		{ID: "docs", Command: "echo docs", OnFailure: OnFailureIgnore, DependsOn: []string{"lint"}},
+	}
+
+	results, err := runner.RunAll(hooks)
+	if err == nil || !strings.Contains(err.Error(), "test") {
+		t.Fatalf("expected blocking error for skipped block hook, got %v", err)
+	}
+	for _, result := range results[1:] {
+		if !result.Skipped {
+			t.Errorf("hook %s should be skipped", result.Hook.ID)
+		}
+		if result.Error == nil || !strings.Contains(result.Error.Error(), `"lint"`) {
+			t.Errorf("hook %s error = %v, want mention of lint", result.Hook.ID, result.Error)
+		}
+	}
+}
+
+func TestRunAll_DependencyCycle(t *testing.T) {
+	runner := NewRunner(t.TempDir(), "test-agent")
+
+	hooks := []*Hook{
+		{ID: "a", Command: "echo a", DependsOn: []string{"b"}},
+		{ID: "b", Command: "echo b", DependsOn: []string{"a"}},
+		{ID: "c", Command: "echo c", DependsOn: []string{"missing"}},
+	}
+
+	results, err := runner.RunAll(hooks)
+	if err != nil {
+		t.Fatalf("warn hooks should not block: %v", err)
+	}
+	for _, result := range results[:2] {
+		if !result.Skipped || !strings.Contains(result.Error.Error(), "cycle") {
+			t.Errorf("hook %s: Skipped=%v Error=%v, want dependency cycle", result.Hook.ID, result.Skipped, result.Error)
+		}
+	}
+	if !results[2].Success {
+		t.Error("hook with unknown dependency should still run")
+	}
+}
+
+func TestRunAll_AggregateTimeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sleep not available on Windows")
+	}
+	runner := NewRunner(t.TempDir(), "test-agent")
+	runner.Timeout = 500 * time.Millisecond
+
+	hooks := []*Hook{
+		{ID: "slow", Command: "sleep 10", Timeout: 30},
+		{ID: "next", Command: "echo next", OnFailure: OnFailureBlock},
+	}
+
+	start := time.Now()
+	results, err := runner.RunAll(hooks)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("aggregate timeout not enforced, took %v", elapsed)
+	}
+	if !results[0].TimedOut {
+		t.Error("running hook should be killed at the aggregate timeout")
+	}
+	if !results[1].Skipped {
+		t.Error("pending hook should be skipped after the aggregate timeout")
+	}
+	if err == nil {
+		t.Error("skipped block hook should produce a blocking error")
+	}
+}
+
 // ============================================================================
 // Security Tests - Shell Injection Attempts (gt-uwohce)
 // ============================================================================
@@ -1195,3 +1334,56 @@ func TestExecute_StderrCapture(t *testing.T) {
 		t.Error("expected stderr in output")
 	}
 }
+
+// ============================================================================
+// Output Capture Tests
+// ============================================================================
+
+func TestExecute_OutputTruncatedWithFullLog(t *testing.T) {
+	runner := NewRunner(t.TempDir(), "test-agent")
+	runner.MaxOutput = 1000
+	runner.LogDir = filepath.Join(t.TempDir(), "hooks")
+
+	hook := &Hook{ID: "gt-abc/lint", Command: "seq 1 10000", Timeout: 10}
+	result := runner.Execute(hook)
+
+	if !result.Success {
+		t.Fatalf("expected success: %v", result.Error)
+	}
+	if !result.Truncated {
+		t.Error("expected output to be truncated")
+	}
+	if len(result.Output) > 1200 {
+		t.Errorf("output is %d bytes, want about 1000 plus marker", len(result.Output))
+	}
+	if !strings.HasPrefix(result.Output, "1\n2\n") || !strings.HasSuffix(result.Output, "10000\n") {
+		t.Error("truncated output should keep the beginning and end")
+	}
+	if !strings.Contains(result.Output, "truncated; full output in "+result.LogPath) {
+		t.Errorf("output missing truncation marker: %q", result.Output)
+	}
+
+	if filepath.Dir(result.LogPath) != runner.LogDir || strings.Contains(filepath.Base(result.LogPath), "/") {
+		t.Errorf("LogPath = %q, want file in %s", result.LogPath, runner.LogDir)
+	}
+	full, err := os.ReadFile(result.LogPath)
+	if err != nil {
+		t.Fatalf("reading hook log: %v", err)
+	}
+	if lines := strings.Count(string(full), "\n"); lines != 10000 {
+		t.Errorf("log has %d lines, want 10000", lines)
+	}
+}
+
+func TestExecute_SmallOutputNotTruncated(t *testing.T) {
+	runner := NewRunner(t.TempDir(), "test-agent")
+	runner.LogDir = ""
+
+	result := runner.Execute(&Hook{ID: "small", Command: "echo hello"})
+	if result.Truncated || result.Output != "hello\n" {
+		t.Errorf("Output = %q, Truncated = %v", result.Output, result.Truncated)
+	}
+	if result.LogPath != "" {
+		t.Errorf("LogPath = %q, want none without a log dir", result.LogPath)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os/exec"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/bdcmd"
 )
@@ -14,13 +15,14 @@ import (
 // AdviceBead represents an advice bead from bd advice list --json.
 // We only include the fields we need for hook execution.
 type AdviceBead struct {
-	ID                  string `json:"id"`
-	Title               string `json:"title"`
-	Priority            int    `json:"priority"`
-	AdviceHookCommand   string `json:"advice_hook_command"`
-	AdviceHookTrigger   string `json:"advice_hook_trigger"`
-	AdviceHookTimeout   int    `json:"advice_hook_timeout"`
-	AdviceHookOnFailure string `json:"advice_hook_on_failure"`
+	ID                  string   `json:"id"`
+	Title               string   `json:"title"`
+	Priority            int      `json:"priority"`
+	AdviceHookCommand   string   `json:"advice_hook_command"`
+	AdviceHookTrigger   string   `json:"advice_hook_trigger"`
+	AdviceHookTimeout   int      `json:"advice_hook_timeout"`
+	AdviceHookOnFailure string   `json:"advice_hook_on_failure"`
+	AdviceHookDependsOn []string `json:"advice_hook_depends_on,omitempty"`
 }
 
 // QueryHooks queries advice hooks for an agent at a specific trigger point.
@@ -83,6 +85,7 @@ func QueryHooks(agentID, trigger string) ([]*Hook, error) {
 			Timeout:   bead.AdviceHookTimeout,
 			OnFailure: bead.AdviceHookOnFailure,
 			Priority:  bead.Priority,
+			DependsOn: bead.AdviceHookDependsOn,
 		}
 
 		// Apply defaults
@@ -111,18 +114,67 @@ func QueryHooks(agentID, trigger string) ([]*Hook, error) {
 // agentID is the agent's identifier (e.g., "gastown/polecats/furiosa").
 // trigger is the lifecycle trigger (e.g., TriggerBeforeCommit).
 func RunHooksForTrigger(workDir, agentID, trigger string) ([]*HookResult, error) {
-	hooks, err := QueryHooks(agentID, trigger)
+	return RunHooksForTriggerWithOptions(workDir, agentID, trigger, RunOptions{})
+}
+
+// RunOptions controls how RunHooksForTriggerWithOptions executes hooks.
+type RunOptions struct {
+	// Parallel runs independent hooks concurrently (see Runner.Parallel).
+	Parallel bool
+
+	// MaxParallel caps concurrently running hooks (0 = no limit).
+	MaxParallel int
+
+	// Timeout bounds the whole run (0 = no limit).
+	Timeout time.Duration
+}
+
+// RunHooksForTriggerWithOptions is RunHooksForTrigger with control over
+// parallelism and an aggregate timeout.
+func RunHooksForTriggerWithOptions(workDir, agentID, trigger string, opts RunOptions) ([]*HookResult, error) {
+	hooks := ResolveHooks(workDir, agentID, trigger)
+	if len(hooks) == 0 {
+		return nil, nil
+	}
+
+	runner := NewRunner(workDir, agentID)
+	runner.Parallel = opts.Parallel
+	runner.MaxParallel = opts.MaxParallel
+	runner.Timeout = opts.Timeout
+	return runner.RunAll(hooks)
+}
+
+// ResolveHooks returns every hook that runs for agentID at trigger: hooks
+// from the town and rig hooks.json files plus advice bead hooks, sorted by
+// priority. Failures to read either source are reported as warnings and
+// that source is skipped, so a broken config never blocks the workflow.
+func ResolveHooks(workDir, agentID, trigger string) []*Hook {
+	configured, err := configuredHooksFromWorkDir(workDir, agentID, trigger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: loading hooks config (configured hooks skipped): %v\n", err)
+	}
+
+	queried, err := QueryHooks(agentID, trigger)
 	if err != nil {
 		// Advice query failed (daemon unreachable, bd not installed, etc.).
 		// Degrade gracefully — don't block the calling workflow. (gt-bgkru6)
 		fmt.Fprintf(os.Stderr, "Warning: advice query failed (hooks skipped): %v\n", err)
-		return nil, nil
 	}
 
-	if len(hooks) == 0 {
-		return nil, nil
+	// An advice bead with the same ID as a configured hook replaces it.
+	fromBeads := make(map[string]bool, len(queried))
+	for _, h := range queried {
+		fromBeads[h.ID] = true
+	}
+	hooks := queried
+	for _, h := range configured {
+		if !fromBeads[h.ID] {
+			hooks = append(hooks, h)
+		}
 	}
 
-	runner := NewRunner(workDir, agentID)
-	return runner.RunAll(hooks)
+	sort.SliceStable(hooks, func(i, j int) bool {
+		return hooks[i].Priority < hooks[j].Priority
+	})
+	return hooks
 }
@@ -0,0 +1,27 @@
+package advice
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+// TestMain runs the tests from an empty directory. Event logging finds the
+// town from the working directory, and this repo checkout looks like one,
+// so without this the tests would append to the repo's .events.jsonl.
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "gt-advice-test-*")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "create work dir: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.Chdir(dir); err != nil {
+		fmt.Fprintf(os.Stderr, "chdir: %v\n", err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
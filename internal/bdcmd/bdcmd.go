@@ -127,6 +127,17 @@ func propagateDaemonEnv(cmd *exec.Cmd) {
 	}
 }
 
+// DaemonHost returns the beads daemon address bd commands connect to:
+// BD_DAEMON_HOST if set, else daemon-host from .beads/config.yaml. Empty
+// means bd uses its local database.
+func DaemonHost() string {
+	if host := os.Getenv("BD_DAEMON_HOST"); host != "" {
+		return host
+	}
+	host, _ := readDaemonConfigFromAll()
+	return host
+}
+
 // envHasKey checks if an env slice contains a key (case-sensitive).
 func envHasKey(env []string, key string) bool {
 	prefix := key + "="
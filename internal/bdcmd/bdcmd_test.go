@@ -143,3 +143,24 @@ func TestCommandInDir(t *testing.T) {
 		t.Errorf("Dir = %q, want /tmp/work", cmd.Dir)
 	}
 }
+
+func TestDaemonHost(t *testing.T) {
+	t.Setenv("BD_DAEMON_HOST", "http://daemon:9080")
+	if got := DaemonHost(); got != "http://daemon:9080" {
+		t.Errorf("DaemonHost() = %q, want the env value", got)
+	}
+
+	t.Setenv("BD_DAEMON_HOST", "")
+	t.Setenv("HOME", t.TempDir())
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".beads", "config.yaml"), []byte("daemon-host: localhost:9876\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+	if got := DaemonHost(); got != "localhost:9876" {
+		t.Errorf("DaemonHost() = %q, want localhost:9876 from config", got)
+	}
+}
@@ -10,9 +10,11 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	yaml "go.yaml.in/yaml/v2"
 
+	"github.com/steveyegge/gastown/internal/metrics"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/state"
 )
@@ -141,6 +143,7 @@ type UpdateOptions struct {
 	Priority     *int
 	Description  *string
 	Assignee     *string
+	Notes        *string  // Backend metadata (see Issue.Notes)
 	AddLabels    []string // Labels to add
 	RemoveLabels []string // Labels to remove
 	SetLabels    []string // Labels to set (replaces all existing)
@@ -213,6 +216,20 @@ func (b *Beads) Init(prefix string) error {
 	return err
 }
 
+// bdDuration times bd subprocesses, labeled by subcommand.
+var bdDuration = metrics.NewHistogram("gt_bd_command_duration_seconds",
+	"Duration of bd subprocess invocations.", nil, "command")
+
+// bdSubcommand is the metrics label for a bd invocation.
+func bdSubcommand(args []string) string {
+	for _, a := range args {
+		if !strings.HasPrefix(a, "-") {
+			return a
+		}
+	}
+	return "bd"
+}
+
 // run executes a bd command and returns stdout.
 func (b *Beads) run(args ...string) ([]byte, error) {
 	// Use --allow-stale to prevent failures when db is out of sync with JSONL
@@ -275,7 +292,9 @@ func (b *Beads) run(args ...string) ([]byte, error) {
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
+	start := time.Now()
 	err := cmd.Run()
+	bdDuration.ObserveSince(start, bdSubcommand(args))
 	if err != nil {
 		// When using --json flag, bd outputs errors as JSON to stdout instead of stderr.
 		// Check stdout for JSON error responses containing "not found" patterns.
@@ -722,6 +741,9 @@ func (b *Beads) Update(id string, opts UpdateOptions) error {
 	if opts.Assignee != nil {
 		args = append(args, "--assignee="+*opts.Assignee)
 	}
+	if opts.Notes != nil {
+		args = append(args, "--notes="+*opts.Notes)
+	}
 	// Label operations: set-labels replaces all, otherwise use add/remove
 	if len(opts.SetLabels) > 0 {
 		for _, label := range opts.SetLabels {
@@ -2,6 +2,7 @@
 package beads
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -76,6 +77,9 @@ type AgentFields struct {
 	ActiveMR          string   // Currently active merge request bead ID (for traceability)
 	NotificationLevel string   // DND mode: verbose, normal, muted (default: normal)
 	OwnedFormulas     []string // Formulas this agent owns/maintains (crew workers)
+	AgentPreset       string   // Agent preset the session was started with, after any fallback
+	SessionID         string   // Agent CLI's own session ID, for resuming after a crash
+	Capabilities      []string // Capability tags for gt sling --auto, e.g. lang:go, repo:beads, role:review
 	// Note: RoleBead field removed - role definitions are now config-based.
 	// See internal/config/roles/*.toml and config-based-roles.md.
 }
@@ -87,6 +91,10 @@ const (
 	NotifyMuted   = "muted"   // Silent/DND mode - batch for later
 )
 
+// LabelLimitOverride prefixes the agent bead label naming the decision that
+// let a K8s polecat past its rig's limits ("limit_override:<decision-id>").
+const LabelLimitOverride = "limit_override"
+
 // FormatAgentDescription creates a description string from agent fields.
 func FormatAgentDescription(title string, fields *AgentFields) string {
 	if fields == nil {
@@ -139,6 +147,24 @@ func FormatAgentDescription(title string, fields *AgentFields) string {
 		lines = append(lines, "owned_formulas: null")
 	}
 
+	if fields.AgentPreset != "" {
+		lines = append(lines, fmt.Sprintf("agent_preset: %s", fields.AgentPreset))
+	} else {
+		lines = append(lines, "agent_preset: null")
+	}
+
+	if fields.SessionID != "" {
+		lines = append(lines, fmt.Sprintf("session_id: %s", fields.SessionID))
+	} else {
+		lines = append(lines, "session_id: null")
+	}
+
+	if len(fields.Capabilities) > 0 {
+		lines = append(lines, fmt.Sprintf("capabilities: %s", strings.Join(fields.Capabilities, ",")))
+	} else {
+		lines = append(lines, "capabilities: null")
+	}
+
 	return strings.Join(lines, "\n")
 }
 
@@ -189,6 +215,16 @@ func ParseAgentFields(description string) *AgentFields {
 				}
 				fields.OwnedFormulas = formulas
 			}
+		case "agent_preset":
+			fields.AgentPreset = value
+		case "session_id":
+			fields.SessionID = value
+		case "capabilities":
+			for _, tag := range strings.Split(value, ",") {
+				if tag = strings.TrimSpace(tag); tag != "" {
+					fields.Capabilities = append(fields.Capabilities, tag)
+				}
+			}
 		}
 	}
 
@@ -403,6 +439,16 @@ func (b *Beads) CreateOrReopenAgentBead(id, title string, fields *AgentFields) (
 		}
 	}
 
+	// Drop a limit override left from the previous lifecycle. Only the sling
+	// reopening the bead may grant a new one.
+	if prev, err := b.Show(id); err == nil {
+		for _, label := range prev.Labels {
+			if strings.HasPrefix(label, LabelLimitOverride+":") {
+				_, _ = b.run("label", "remove", id, label)
+			}
+		}
+	}
+
 	// Clear any existing hook slot (handles stale state from previous lifecycle).
 	// In daemon mode, use workDir — the subprocess routes via BD_DAEMON_HOST.
 	slotDir := targetDir
@@ -476,6 +522,14 @@ func (b *Beads) UpdateAgentState(id string, state string, hookBead *string) erro
 // Per gt-zecmc: agent_state ("running", "dead", "idle") is observable
 // and should not be recorded in beads ("discover, don't track" principle).
 func (b *Beads) SetHookBead(agentBeadID, hookBeadID string) error {
+	// In daemon mode, set the slot over the daemon's HTTP API. Any failure,
+	// including an occupied slot, falls back to bd, which can clear it.
+	if client := NewDaemonClientFromEnv(); client != nil {
+		if err := client.SlotSet(context.Background(), agentBeadID, "hook", hookBeadID); err == nil {
+			return nil
+		}
+	}
+
 	// Set the hook using bd slot set
 	// This updates the hook_bead column directly in SQLite
 	_, err := b.run("slot", "set", agentBeadID, "hook", hookBeadID)
@@ -558,6 +612,68 @@ func (b *Beads) UpdateAgentActiveMR(id string, activeMR string) error {
 	return b.Update(id, UpdateOptions{Description: &description})
 }
 
+// UpdateAgentPreset records which agent preset an agent's session was
+// started with. Differs from the role's configured agent when spawning
+// fell back along role_agent_fallbacks.
+func (b *Beads) UpdateAgentPreset(id string, preset string) error {
+	// First get current issue to preserve other fields
+	issue, err := b.Show(id)
+	if err != nil {
+		return err
+	}
+
+	// Parse existing fields
+	fields := ParseAgentFields(issue.Description)
+	fields.AgentPreset = preset
+
+	// Format new description
+	description := FormatAgentDescription(issue.Title, fields)
+
+	return b.Update(id, UpdateOptions{Description: &description})
+}
+
+// UpdateAgentSessionID records the agent CLI's session ID (e.g. Claude's
+// conversation UUID) so a crashed session can be resumed instead of
+// started cold.
+func (b *Beads) UpdateAgentSessionID(id string, sessionID string) error {
+	// First get current issue to preserve other fields
+	issue, err := b.Show(id)
+	if err != nil {
+		return err
+	}
+
+	// Parse existing fields
+	fields := ParseAgentFields(issue.Description)
+	if fields.SessionID == sessionID {
+		return nil
+	}
+	fields.SessionID = sessionID
+
+	// Format new description
+	description := FormatAgentDescription(issue.Title, fields)
+
+	return b.Update(id, UpdateOptions{Description: &description})
+}
+
+// UpdateAgentCapabilities replaces an agent's capability tags, which
+// gt sling --auto matches against a bead's labels.
+func (b *Beads) UpdateAgentCapabilities(id string, capabilities []string) error {
+	// First get current issue to preserve other fields
+	issue, err := b.Show(id)
+	if err != nil {
+		return err
+	}
+
+	// Parse existing fields
+	fields := ParseAgentFields(issue.Description)
+	fields.Capabilities = capabilities
+
+	// Format new description
+	description := FormatAgentDescription(issue.Title, fields)
+
+	return b.Update(id, UpdateOptions{Description: &description})
+}
+
 // UpdateAgentNotificationLevel updates the notification_level field in an agent bead.
 // Valid levels: verbose, normal, muted (DND mode).
 // Pass empty string to reset to default (normal).
@@ -644,6 +760,8 @@ func (b *Beads) CloseAndClearAgentBead(id, reason string) error {
 	fields.HookBead = ""     // Clear hook_bead
 	fields.ActiveMR = ""     // Clear active_mr
 	fields.CleanupStatus = "" // Clear cleanup_status
+	fields.AgentPreset = ""   // Clear agent_preset
+	fields.SessionID = ""     // Clear session_id
 	fields.AgentState = "closed"
 
 	// Update description with cleared fields
@@ -37,6 +37,8 @@ const (
 	ConfigCategoryMessaging      = "messaging"
 	ConfigCategoryEscalation     = "escalation"
 	ConfigCategoryFormula        = "formula"
+	ConfigCategorySettings       = "settings"
+	ConfigCategoryNotifications  = "notifications"
 )
 
 // ValidConfigCategories maps valid config category names to true.
@@ -53,6 +55,8 @@ var ValidConfigCategories = map[string]bool{
 	ConfigCategoryMessaging:      true,
 	ConfigCategoryEscalation:     true,
 	ConfigCategoryFormula:        true,
+	ConfigCategorySettings:       true,
+	ConfigCategoryNotifications:  true,
 }
 
 // ConfigBeadID returns the bead ID for a config slug.
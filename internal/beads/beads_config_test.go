@@ -542,7 +542,7 @@ func TestValidConfigCategories(t *testing.T) {
 	expected := []string{
 		"identity", "claude-hooks", "mcp", "rig-registry",
 		"agent-preset", "role-definition", "slack-routing",
-		"accounts", "daemon", "messaging", "escalation",
+		"accounts", "daemon", "messaging", "escalation", "settings", "notifications",
 	}
 
 	for _, cat := range expected {
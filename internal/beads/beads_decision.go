@@ -76,6 +76,8 @@ type DecisionFields struct {
 	ParentBeadID    string           `json:"parent_bead_id,omitempty"`    // Parent bead ID (e.g., epic) for hierarchy
 	ParentBeadTitle string           `json:"parent_bead_title,omitempty"` // Parent bead title for channel derivation
 	SessionID       string           `json:"session_id,omitempty"`        // Claude Code session ID for turn enforcement
+	Deadline        string           `json:"deadline,omitempty"`          // RFC3339 time after which the default applies
+	DefaultOption   int              `json:"default_option,omitempty"`    // Option chosen at the deadline (1-indexed, 0 = recommended)
 }
 
 // DecisionState constants for decision status tracking.
@@ -614,12 +616,14 @@ func (b *Beads) GetDecisionBead(id string) (*Issue, *DecisionFields, error) {
 			fields.Rationale = bdDecision.DecisionPoint.ResponseText
 		}
 
+		applyDecisionDeadlineLabels(fields, issue.Labels)
 		return issue, fields, nil
 	}
 
 	// Fall back to gt decision (markdown-based, legacy)
 	if HasLabel(issue, "gt:decision") {
 		fields := ParseDecisionFields(issue.Description)
+		applyDecisionDeadlineLabels(fields, issue.Labels)
 		return issue, fields, nil
 	}
 
@@ -0,0 +1,102 @@
+package beads
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Decision deadline labels. Deadlines are stored as labels rather than in
+// the description so they work for both bd decisions (decision_points
+// table) and legacy gt decisions (markdown).
+const (
+	decisionDeadlineLabelPrefix = "deadline:"
+	decisionDefaultLabelPrefix  = "default:"
+
+	// DecisionDeadlineEscalatedLabel marks an expired decision that had no
+	// default option and has already been escalated.
+	DecisionDeadlineEscalatedLabel = "decision:deadline-escalated"
+)
+
+// SetDecisionDeadline records when a pending decision expires and which
+// option to choose if nobody answers by then. A defaultOption of 0 falls
+// back to the recommended option at expiry.
+func (b *Beads) SetDecisionDeadline(id string, deadline time.Time, defaultOption int) error {
+	labels := []string{decisionDeadlineLabelPrefix + deadline.UTC().Format(time.RFC3339)}
+	if defaultOption > 0 {
+		labels = append(labels, fmt.Sprintf("%s%d", decisionDefaultLabelPrefix, defaultOption))
+	}
+	return b.Update(id, UpdateOptions{AddLabels: labels})
+}
+
+// DecisionDeadline returns the deadline recorded on a decision issue.
+func DecisionDeadline(issue *Issue) (time.Time, bool) {
+	if issue == nil {
+		return time.Time{}, false
+	}
+	for _, label := range issue.Labels {
+		if v, ok := strings.CutPrefix(label, decisionDeadlineLabelPrefix); ok {
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// applyDecisionDeadlineLabels fills the deadline fields from issue labels.
+func applyDecisionDeadlineLabels(fields *DecisionFields, labels []string) {
+	for _, label := range labels {
+		if v, ok := strings.CutPrefix(label, decisionDeadlineLabelPrefix); ok {
+			if _, err := time.Parse(time.RFC3339, v); err == nil {
+				fields.Deadline = v
+			}
+		} else if v, ok := strings.CutPrefix(label, decisionDefaultLabelPrefix); ok {
+			if n, err := strconv.Atoi(v); err == nil && n > 0 {
+				fields.DefaultOption = n
+			}
+		}
+	}
+}
+
+// DefaultChoice returns the option to choose when the decision expires:
+// the explicit default if set, otherwise the first recommended option.
+// Returns 0 if there is nothing to fall back to.
+func (f *DecisionFields) DefaultChoice() int {
+	if f.DefaultOption > 0 && f.DefaultOption <= len(f.Options) {
+		return f.DefaultOption
+	}
+	for i, opt := range f.Options {
+		if opt.Recommended {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// ListExpiredDecisions returns pending decisions whose deadline is before
+// now. Decisions already escalated for lack of a default are skipped.
+func (b *Beads) ListExpiredDecisions(now time.Time) ([]*Issue, error) {
+	decisions, err := b.ListAllPendingDecisions()
+	if err != nil {
+		return nil, err
+	}
+
+	var expired []*Issue
+	for _, issue := range decisions {
+		if len(issue.Labels) == 0 {
+			// bd decision list may omit labels; fetch the full issue.
+			if full, err := b.Show(issue.ID); err == nil {
+				issue = full
+			}
+		}
+		if HasLabel(issue, DecisionDeadlineEscalatedLabel) {
+			continue
+		}
+		if deadline, ok := DecisionDeadline(issue); ok && deadline.Before(now) {
+			expired = append(expired, issue)
+		}
+	}
+	return expired, nil
+}
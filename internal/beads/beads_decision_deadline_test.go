@@ -0,0 +1,57 @@
+package beads
+
+import (
+	"testing"
+	"time"
+)
+
+// TestDecisionDefaultChoice tests the fallback order for expired decisions.
+func TestDecisionDefaultChoice(t *testing.T) {
+	options := []DecisionOption{
+		{Label: "A"},
+		{Label: "B", Recommended: true},
+		{Label: "C"},
+	}
+	tests := []struct {
+		name   string
+		fields DecisionFields
+		want   int
+	}{
+		{"explicit default", DecisionFields{Options: options, DefaultOption: 3}, 3},
+		{"recommended", DecisionFields{Options: options}, 2},
+		{"default out of range", DecisionFields{Options: options, DefaultOption: 7}, 2},
+		{"nothing to fall back to", DecisionFields{Options: []DecisionOption{{Label: "A"}, {Label: "B"}}}, 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.fields.DefaultChoice(); got != tt.want {
+			t.Errorf("%s: DefaultChoice() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestDecisionDeadlineLabels tests reading deadline fields back from labels.
+func TestDecisionDeadlineLabels(t *testing.T) {
+	issue := &Issue{Labels: []string{"gt:decision", "deadline:2026-01-02T15:04:05Z", "default:2", DecisionDeadlineEscalatedLabel}}
+
+	deadline, ok := DecisionDeadline(issue)
+	if !ok {
+		t.Fatal("DecisionDeadline() found no deadline")
+	}
+	if want := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC); !deadline.Equal(want) {
+		t.Errorf("DecisionDeadline() = %v, want %v", deadline, want)
+	}
+
+	fields := &DecisionFields{}
+	applyDecisionDeadlineLabels(fields, issue.Labels)
+	if fields.Deadline != "2026-01-02T15:04:05Z" {
+		t.Errorf("Deadline = %q, want 2026-01-02T15:04:05Z", fields.Deadline)
+	}
+	if fields.DefaultOption != 2 {
+		t.Errorf("DefaultOption = %d, want 2", fields.DefaultOption)
+	}
+
+	if _, ok := DecisionDeadline(&Issue{Labels: []string{"deadline:soon"}}); ok {
+		t.Error("DecisionDeadline() accepted a malformed deadline label")
+	}
+}
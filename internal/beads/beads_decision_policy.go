@@ -0,0 +1,43 @@
+package beads
+
+import "strings"
+
+// Decision delegation labels. A delegated decision carries the address of
+// the agent allowed to resolve it and the policy that delegated it; once
+// the delegate resolves it, the authorizing policy is recorded as well.
+const (
+	decisionDelegateLabelPrefix       = "delegate:"
+	decisionPolicyLabelPrefix         = "policy:"
+	decisionPolicyResolvedLabelPrefix = "policy-resolved:"
+)
+
+// SetDecisionDelegate records that delegate may resolve the decision under
+// the named policy.
+func (b *Beads) SetDecisionDelegate(id, delegate, policy string) error {
+	return b.Update(id, UpdateOptions{AddLabels: []string{
+		decisionDelegateLabelPrefix + delegate,
+		decisionPolicyLabelPrefix + policy,
+	}})
+}
+
+// MarkDecisionPolicyResolved records the policy that authorized an agent
+// to resolve the decision.
+func (b *Beads) MarkDecisionPolicyResolved(id, policy string) error {
+	return b.Update(id, UpdateOptions{AddLabels: []string{decisionPolicyResolvedLabelPrefix + policy}})
+}
+
+// DecisionDelegate returns the delegate address and policy name recorded
+// on a decision issue. Both are empty for decisions left to the overseer.
+func DecisionDelegate(issue *Issue) (delegate, policy string) {
+	if issue == nil {
+		return "", ""
+	}
+	for _, label := range issue.Labels {
+		if v, ok := strings.CutPrefix(label, decisionDelegateLabelPrefix); ok {
+			delegate = v
+		} else if v, ok := strings.CutPrefix(label, decisionPolicyLabelPrefix); ok {
+			policy = v
+		}
+	}
+	return delegate, policy
+}
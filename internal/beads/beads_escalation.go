@@ -20,6 +20,8 @@ type EscalationFields struct {
 	EscalatedAt        string // ISO 8601 timestamp
 	AckedBy            string // Agent that acknowledged (empty if not acked)
 	AckedAt            string // When acknowledged (empty if not acked)
+	AssignedTo         string // Who owns resolving it (empty if unassigned)
+	AssignedAt         string // When assigned (empty if unassigned)
 	ClosedBy           string // Agent that closed (empty if not closed)
 	ClosedReason       string // Resolution reason (empty if not closed)
 	RelatedBead        string // Optional: related bead ID (task, bug, etc.)
@@ -27,15 +29,57 @@ type EscalationFields struct {
 	ReescalationCount  int    // Number of times this has been re-escalated
 	LastReescalatedAt  string // When last re-escalated (empty if never)
 	LastReescalatedBy  string // Who last re-escalated (empty if never)
+	SLABreachedAt      string // When the SLA was last breached (empty if never)
 }
 
 // EscalationState constants for bead status tracking.
 const (
-	EscalationOpen   = "open"   // Unacknowledged
-	EscalationAcked  = "acked"  // Acknowledged but not resolved
-	EscalationClosed = "closed" // Resolved/closed
+	EscalationOpen     = "open"     // Unacknowledged
+	EscalationAcked    = "acked"    // Acknowledged but not resolved
+	EscalationAssigned = "assigned" // Acknowledged and owned by someone
+	EscalationClosed   = "closed"   // Resolved/closed
 )
 
+// ErrEscalationClosed is returned when acknowledging or assigning an
+// escalation that has already been resolved.
+var ErrEscalationClosed = errors.New("escalation is closed")
+
+// EscalationState returns the lifecycle state of an escalation bead:
+// open → acked → assigned → closed. Assigning implies acknowledgement.
+func EscalationState(issue *Issue, fields *EscalationFields) string {
+	switch {
+	case issue.Status == "closed":
+		return EscalationClosed
+	case fields != nil && fields.AssignedTo != "":
+		return EscalationAssigned
+	case HasLabel(issue, "acked") || (fields != nil && fields.AckedBy != ""):
+		return EscalationAcked
+	default:
+		return EscalationOpen
+	}
+}
+
+// SLAStart returns when the escalation's current SLA timer started: its
+// last re-escalation or SLA breach, or else when it was raised. Each breach
+// restarts the timer, at the new severity if it was bumped.
+func (f *EscalationFields) SLAStart(createdAt string) time.Time {
+	var start time.Time
+	for _, ts := range []string{f.LastReescalatedAt, f.SLABreachedAt} {
+		if t, err := time.Parse(time.RFC3339, ts); err == nil && t.After(start) {
+			start = t
+		}
+	}
+	if !start.IsZero() {
+		return start
+	}
+	for _, ts := range []string{f.EscalatedAt, createdAt} {
+		if t, err := time.Parse(time.RFC3339, ts); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
 // FormatEscalationDescription creates a description string from escalation fields.
 func FormatEscalationDescription(title string, fields *EscalationFields) string {
 	if fields == nil {
@@ -67,6 +111,11 @@ func FormatEscalationDescription(title string, fields *EscalationFields) string
 		lines = append(lines, "acked_at: null")
 	}
 
+	if fields.AssignedTo != "" {
+		lines = append(lines, fmt.Sprintf("assigned_to: %s", fields.AssignedTo))
+		lines = append(lines, fmt.Sprintf("assigned_at: %s", fields.AssignedAt))
+	}
+
 	if fields.ClosedBy != "" {
 		lines = append(lines, fmt.Sprintf("closed_by: %s", fields.ClosedBy))
 	} else {
@@ -102,6 +151,9 @@ func FormatEscalationDescription(title string, fields *EscalationFields) string
 	} else {
 		lines = append(lines, "last_reescalated_by: null")
 	}
+	if fields.SLABreachedAt != "" {
+		lines = append(lines, fmt.Sprintf("sla_breached_at: %s", fields.SLABreachedAt))
+	}
 
 	return strings.Join(lines, "\n")
 }
@@ -142,6 +194,10 @@ func ParseEscalationFields(description string) *EscalationFields {
 			fields.AckedBy = value
 		case "acked_at":
 			fields.AckedAt = value
+		case "assigned_to":
+			fields.AssignedTo = value
+		case "assigned_at":
+			fields.AssignedAt = value
 		case "closed_by":
 			fields.ClosedBy = value
 		case "closed_reason":
@@ -158,6 +214,8 @@ func ParseEscalationFields(description string) *EscalationFields {
 			fields.LastReescalatedAt = value
 		case "last_reescalated_by":
 			fields.LastReescalatedBy = value
+		case "sla_breached_at":
+			fields.SLABreachedAt = value
 		}
 	}
 
@@ -213,6 +271,9 @@ func (b *Beads) AckEscalation(id, ackedBy string) error {
 	if !HasLabel(issue, "gt:escalation") {
 		return fmt.Errorf("issue %s is not an escalation bead (missing gt:escalation label)", id)
 	}
+	if issue.Status == "closed" {
+		return fmt.Errorf("%s: %w", id, ErrEscalationClosed)
+	}
 
 	// Parse existing fields
 	fields := ParseEscalationFields(issue.Description)
@@ -228,6 +289,37 @@ func (b *Beads) AckEscalation(id, ackedBy string) error {
 	})
 }
 
+// AssignEscalation makes assignee the owner of an escalation, and
+// acknowledges it on their behalf if nobody has yet. The bead's assignee is
+// set too, so the escalation shows up in the owner's work.
+func (b *Beads) AssignEscalation(id, assignee, assignedBy string) error {
+	issue, fields, err := b.GetEscalationBead(id)
+	if err != nil {
+		return err
+	}
+	if issue == nil {
+		return fmt.Errorf("escalation %s: %w", id, ErrNotFound)
+	}
+	if issue.Status == "closed" {
+		return fmt.Errorf("%s: %w", id, ErrEscalationClosed)
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	if fields.AckedBy == "" {
+		fields.AckedBy = assignedBy
+		fields.AckedAt = now
+	}
+	fields.AssignedTo = assignee
+	fields.AssignedAt = now
+
+	description := FormatEscalationDescription(issue.Title, fields)
+	return b.Update(id, UpdateOptions{
+		Description: &description,
+		Assignee:    &assignee,
+		AddLabels:   []string{"acked", "assigned"},
+	})
+}
+
 // CloseEscalation closes an escalation bead with a resolution reason.
 // Sets closed_by and closed_reason fields, closes the issue.
 func (b *Beads) CloseEscalation(id, closedBy, reason string) error {
@@ -349,6 +441,24 @@ func (b *Beads) ListStaleEscalations(threshold time.Duration) ([]*Issue, error)
 	return stale, nil
 }
 
+// MarkEscalationBreached records an SLA breach on an escalation that could
+// not be re-escalated further, restarting its SLA timer.
+func (b *Beads) MarkEscalationBreached(id string) error {
+	issue, fields, err := b.GetEscalationBead(id)
+	if err != nil {
+		return err
+	}
+	if issue == nil {
+		return fmt.Errorf("escalation %s: %w", id, ErrNotFound)
+	}
+	fields.SLABreachedAt = time.Now().Format(time.RFC3339)
+	description := FormatEscalationDescription(issue.Title, fields)
+	return b.Update(id, UpdateOptions{
+		Description: &description,
+		AddLabels:   []string{"sla-breached"},
+	})
+}
+
 // ReescalationResult holds the result of a reescalation operation.
 type ReescalationResult struct {
 	ID              string
@@ -0,0 +1,76 @@
+package beads
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEscalationDescriptionRoundTrip(t *testing.T) {
+	fields := &EscalationFields{
+		Severity:          "high",
+		Reason:            "merge queue wedged",
+		Source:            "patrol:witness",
+		EscalatedBy:       "gastown/witness",
+		EscalatedAt:       "2026-01-02T15:04:05Z",
+		AckedBy:           "overseer",
+		AckedAt:           "2026-01-02T15:10:00Z",
+		AssignedTo:        "gastown/crew/max",
+		AssignedAt:        "2026-01-02T15:11:00Z",
+		RelatedBead:       "gt-abc",
+		OriginalSeverity:  "medium",
+		ReescalationCount: 1,
+		LastReescalatedAt: "2026-01-02T15:05:00Z",
+		LastReescalatedBy: "daemon/escalation-sla",
+		SLABreachedAt:     "2026-01-02T15:06:00Z",
+	}
+
+	got := ParseEscalationFields(FormatEscalationDescription("Escalation", fields))
+	if *got != *fields {
+		t.Errorf("round trip = %+v, want %+v", got, fields)
+	}
+}
+
+func TestEscalationState(t *testing.T) {
+	tests := []struct {
+		name   string
+		issue  *Issue
+		fields *EscalationFields
+		want   string
+	}{
+		{"open", &Issue{Status: "open"}, &EscalationFields{}, EscalationOpen},
+		{"acked by label", &Issue{Status: "open", Labels: []string{"acked"}}, &EscalationFields{}, EscalationAcked},
+		{"acked by field", &Issue{Status: "open"}, &EscalationFields{AckedBy: "mayor"}, EscalationAcked},
+		{"assigned", &Issue{Status: "open"}, &EscalationFields{AckedBy: "mayor", AssignedTo: "gastown/crew/max"}, EscalationAssigned},
+		{"closed", &Issue{Status: "closed"}, &EscalationFields{AssignedTo: "gastown/crew/max"}, EscalationClosed},
+	}
+	for _, tt := range tests {
+		if got := EscalationState(tt.issue, tt.fields); got != tt.want {
+			t.Errorf("%s: EscalationState = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEscalationSLAStart(t *testing.T) {
+	created := "2026-01-02T15:00:00Z"
+	at := func(s string) time.Time {
+		t, _ := time.Parse(time.RFC3339, s)
+		return t
+	}
+
+	f := &EscalationFields{}
+	if got := f.SLAStart(created); !got.Equal(at(created)) {
+		t.Errorf("no fields: SLAStart = %v, want created time", got)
+	}
+
+	f.EscalatedAt = "2026-01-02T15:01:00Z"
+	if got := f.SLAStart(created); !got.Equal(at(f.EscalatedAt)) {
+		t.Errorf("escalated: SLAStart = %v, want %s", got, f.EscalatedAt)
+	}
+
+	// The latest breach or re-escalation restarts the timer.
+	f.LastReescalatedAt = "2026-01-02T16:00:00Z"
+	f.SLABreachedAt = "2026-01-02T17:00:00Z"
+	if got := f.SLAStart(created); !got.Equal(at(f.SLABreachedAt)) {
+		t.Errorf("breached: SLAStart = %v, want %s", got, f.SLABreachedAt)
+	}
+}
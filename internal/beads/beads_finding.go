@@ -0,0 +1,265 @@
+// Package beads provides witness finding bead management.
+package beads
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FindingLabel marks beads that record witness anomaly findings.
+const FindingLabel = "gt:finding"
+
+// findingEvidenceHeader separates the key/value fields from the free-form
+// evidence in a finding description.
+const findingEvidenceHeader = "## Evidence"
+
+// FindingFields holds structured fields for witness finding beads.
+// The key fields are stored as "key: value" lines in the description,
+// followed by the evidence, which may span multiple lines.
+type FindingFields struct {
+	Agent           string // Agent the finding is about (e.g., "gastown/polecats/nux")
+	Category        string // Kind of anomaly (e.g., stuck, crash-loop, gupp-violation, zombie)
+	Severity        string // critical, high, medium, low
+	SuggestedAction string // What the overseer should do about it
+	ReportedBy      string // Agent that reported it (e.g., "gastown/witness")
+	ReportedAt      string // ISO 8601 timestamp of the latest report
+	Occurrences     int    // Times this finding has been reported while open
+	Evidence        string // Free-form evidence (log excerpts, timings, etc.)
+}
+
+// Finding is a finding bead with its parsed fields.
+type Finding struct {
+	ID        string
+	Title     string
+	Status    string
+	CreatedAt string
+	FindingFields
+}
+
+// FindingSeverityRank orders severities for ranking: critical findings rank
+// first (0) and unknown severities last.
+func FindingSeverityRank(severity string) int {
+	switch severity {
+	case "critical":
+		return 0
+	case "high":
+		return 1
+	case "medium":
+		return 2
+	case "low":
+		return 3
+	default:
+		return 4
+	}
+}
+
+// FormatFindingDescription creates a description string from finding fields.
+func FormatFindingDescription(title string, fields *FindingFields) string {
+	if fields == nil {
+		return title
+	}
+
+	lines := []string{title, ""}
+	lines = append(lines, fmt.Sprintf("agent: %s", fields.Agent))
+	lines = append(lines, fmt.Sprintf("category: %s", fields.Category))
+	lines = append(lines, fmt.Sprintf("severity: %s", fields.Severity))
+	if fields.SuggestedAction != "" {
+		lines = append(lines, fmt.Sprintf("suggested_action: %s", fields.SuggestedAction))
+	} else {
+		lines = append(lines, "suggested_action: null")
+	}
+	lines = append(lines, fmt.Sprintf("reported_by: %s", fields.ReportedBy))
+	lines = append(lines, fmt.Sprintf("reported_at: %s", fields.ReportedAt))
+	lines = append(lines, fmt.Sprintf("occurrences: %d", fields.Occurrences))
+	if fields.Evidence != "" {
+		lines = append(lines, "", findingEvidenceHeader, fields.Evidence)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// ParseFindingFields extracts finding fields from an issue's description.
+func ParseFindingFields(description string) *FindingFields {
+	fields := &FindingFields{}
+
+	head, evidence, _ := strings.Cut(description, findingEvidenceHeader)
+	fields.Evidence = strings.TrimSpace(evidence)
+
+	for _, line := range strings.Split(head, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if value == "null" {
+			value = ""
+		}
+
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "agent":
+			fields.Agent = value
+		case "category":
+			fields.Category = value
+		case "severity":
+			fields.Severity = value
+		case "suggested_action":
+			fields.SuggestedAction = value
+		case "reported_by":
+			fields.ReportedBy = value
+		case "reported_at":
+			fields.ReportedAt = value
+		case "occurrences":
+			_, _ = fmt.Sscanf(value, "%d", &fields.Occurrences)
+		}
+	}
+
+	return fields
+}
+
+// newFinding builds a Finding from a finding bead.
+func newFinding(issue *Issue) *Finding {
+	return &Finding{
+		ID:            issue.ID,
+		Title:         issue.Title,
+		Status:        issue.Status,
+		CreatedAt:     issue.CreatedAt,
+		FindingFields: *ParseFindingFields(issue.Description),
+	}
+}
+
+// ReportFinding records a finding. If an open finding already exists for the
+// same agent and category it is updated in place (severity, evidence and
+// suggested action are replaced and the occurrence count is bumped) rather
+// than filing a duplicate. Returns the finding and whether it was new.
+func (b *Beads) ReportFinding(title string, fields *FindingFields) (*Finding, bool, error) {
+	if fields == nil || fields.Agent == "" || fields.Category == "" {
+		return nil, false, errors.New("finding requires an agent and a category")
+	}
+	if fields.Severity == "" {
+		fields.Severity = "medium"
+	}
+	if fields.ReportedAt == "" {
+		fields.ReportedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	open, err := b.ListFindings(fields.Agent, false)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, existing := range open {
+		if existing.Category != fields.Category {
+			continue
+		}
+		fields.Occurrences = existing.Occurrences + 1
+		description := FormatFindingDescription(title, fields)
+		opts := UpdateOptions{Title: &title, Description: &description}
+		if existing.Severity != fields.Severity {
+			opts.RemoveLabels = []string{"severity:" + existing.Severity}
+			opts.AddLabels = []string{"severity:" + fields.Severity}
+		}
+		if err := b.Update(existing.ID, opts); err != nil {
+			return nil, false, fmt.Errorf("updating finding %s: %w", existing.ID, err)
+		}
+		return &Finding{ID: existing.ID, Title: title, Status: existing.Status, CreatedAt: existing.CreatedAt, FindingFields: *fields}, false, nil
+	}
+
+	fields.Occurrences = 1
+	args := []string{"create", "--json",
+		"--title=" + title,
+		"--description=" + FormatFindingDescription(title, fields),
+		"--type=task",
+		"--labels=" + FindingLabel,
+		"--labels=severity:" + fields.Severity,
+		"--labels=category:" + fields.Category,
+	}
+	if actor := b.getActor(); actor != "" {
+		args = append(args, "--actor="+actor)
+	}
+
+	out, err := b.run(args...)
+	if err != nil {
+		return nil, false, err
+	}
+	var issue Issue
+	if err := json.Unmarshal(out, &issue); err != nil {
+		return nil, false, fmt.Errorf("parsing bd create output: %w", err)
+	}
+	return &Finding{ID: issue.ID, Title: title, Status: issue.Status, CreatedAt: issue.CreatedAt, FindingFields: *fields}, true, nil
+}
+
+// ListFindings returns findings ranked by severity (most severe first), then
+// by most recent report. An empty agent lists findings for all agents.
+func (b *Beads) ListFindings(agent string, includeClosed bool) ([]*Finding, error) {
+	status := "open"
+	if includeClosed {
+		status = "all"
+	}
+	issues, err := b.List(ListOptions{Label: FindingLabel, Status: status, Priority: -1})
+	if err != nil {
+		return nil, err
+	}
+
+	findings := make([]*Finding, 0, len(issues))
+	for _, issue := range issues {
+		f := newFinding(issue)
+		if agent != "" && f.Agent != agent {
+			continue
+		}
+		findings = append(findings, f)
+	}
+	RankFindings(findings)
+	return findings, nil
+}
+
+// RankFindings sorts findings for the overseer: open before closed, then by
+// severity, then most recently reported first.
+func RankFindings(findings []*Finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		ci, cj := findings[i].Status == "closed", findings[j].Status == "closed"
+		if ci != cj {
+			return !ci
+		}
+		ri, rj := FindingSeverityRank(findings[i].Severity), FindingSeverityRank(findings[j].Severity)
+		if ri != rj {
+			return ri < rj
+		}
+		return findings[i].ReportedAt > findings[j].ReportedAt
+	})
+}
+
+// GetFinding retrieves a finding bead by ID. Returns nil if not found.
+func (b *Beads) GetFinding(id string) (*Finding, error) {
+	issue, err := b.Show(id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !HasLabel(issue, FindingLabel) {
+		return nil, fmt.Errorf("issue %s is not a finding bead (missing %s label)", id, FindingLabel)
+	}
+	return newFinding(issue), nil
+}
+
+// ResolveFinding closes a finding with a resolution reason.
+func (b *Beads) ResolveFinding(id, resolvedBy, reason string) error {
+	f, err := b.GetFinding(id)
+	if err != nil {
+		return err
+	}
+	if f == nil {
+		return fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	if reason == "" {
+		reason = "resolved"
+	}
+	if resolvedBy != "" {
+		reason = fmt.Sprintf("%s (by %s)", reason, resolvedBy)
+	}
+	return b.CloseWithReason(reason, id)
+}
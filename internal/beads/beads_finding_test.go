@@ -0,0 +1,39 @@
+package beads
+
+import "testing"
+
+func TestFindingDescriptionRoundTrip(t *testing.T) {
+	fields := &FindingFields{
+		Agent:       "gastown/polecats/nux",
+		Category:    "stuck",
+		Severity:    "high",
+		ReportedBy:  "gastown/witness",
+		ReportedAt:  "2026-01-02T15:04:05Z",
+		Occurrences: 3,
+		Evidence:    "no output for 45m\nlast tool call: go test ./...",
+	}
+
+	got := ParseFindingFields(FormatFindingDescription("nux is stuck", fields))
+	if *got != *fields {
+		t.Errorf("round trip = %+v, want %+v", got, fields)
+	}
+}
+
+func TestRankFindings(t *testing.T) {
+	findings := []*Finding{
+		{ID: "low", Status: "open", FindingFields: FindingFields{Severity: "low", ReportedAt: "2026-01-03T00:00:00Z"}},
+		{ID: "closed-crit", Status: "closed", FindingFields: FindingFields{Severity: "critical"}},
+		{ID: "high-old", Status: "open", FindingFields: FindingFields{Severity: "high", ReportedAt: "2026-01-01T00:00:00Z"}},
+		{ID: "high-new", Status: "open", FindingFields: FindingFields{Severity: "high", ReportedAt: "2026-01-02T00:00:00Z"}},
+		{ID: "crit", Status: "open", FindingFields: FindingFields{Severity: "critical"}},
+	}
+
+	RankFindings(findings)
+
+	want := []string{"crit", "high-new", "high-old", "low", "closed-crit"}
+	for i, f := range findings {
+		if f.ID != want[i] {
+			t.Fatalf("rank %d = %s, want order %v", i, f.ID, want)
+		}
+	}
+}
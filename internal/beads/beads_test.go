@@ -643,6 +643,34 @@ func TestMRFieldsRoundTrip(t *testing.T) {
 	}
 }
 
+// TestMRFieldsManualOrdering tests round-tripping bump and hold fields.
+func TestMRFieldsManualOrdering(t *testing.T) {
+	issue := &Issue{Description: "branch: polecat/Nux/gt-xyz\nSome notes"}
+	issue.Description = SetMRFields(issue, &MRFields{
+		Branch:        "polecat/Nux/gt-xyz",
+		QueuePriority: 2,
+		Held:          true,
+		HoldReason:    "waiting on release freeze",
+	})
+
+	parsed := ParseMRFields(issue)
+	if parsed == nil {
+		t.Fatal("parse returned nil")
+	}
+	if parsed.QueuePriority != 2 || !parsed.Held || parsed.HoldReason != "waiting on release freeze" {
+		t.Errorf("manual ordering fields = %+v", parsed)
+	}
+	if !strings.Contains(issue.Description, "Some notes") {
+		t.Errorf("prose lost: %q", issue.Description)
+	}
+
+	// Releasing clears the fields entirely
+	issue.Description = SetMRFields(issue, &MRFields{Branch: "polecat/Nux/gt-xyz"})
+	if strings.Contains(issue.Description, "held") || strings.Contains(issue.Description, "queue_priority") {
+		t.Errorf("released description still has ordering fields: %q", issue.Description)
+	}
+}
+
 // TestParseMRFieldsFromDesignDoc tests the example from the design doc.
 func TestParseMRFieldsFromDesignDoc(t *testing.T) {
 	// Example from docs/merge-queue-design.md
@@ -2609,3 +2637,53 @@ func TestCloseAndClearAgentBead_ReasonVariations(t *testing.T) {
 		})
 	}
 }
+
+func TestAgentPresetFieldRoundTrip(t *testing.T) {
+	desc := FormatAgentDescription("Polecat Toast", &AgentFields{
+		RoleType:    "polecat",
+		Rig:         "gastown",
+		AgentState:  "working",
+		AgentPreset: "gemini",
+	})
+	if !strings.Contains(desc, "agent_preset: gemini") {
+		t.Errorf("description missing agent_preset:\n%s", desc)
+	}
+	if got := ParseAgentFields(desc).AgentPreset; got != "gemini" {
+		t.Errorf("AgentPreset = %q, want gemini", got)
+	}
+	if got := ParseAgentFields(FormatAgentDescription("Polecat Toast", &AgentFields{})).AgentPreset; got != "" {
+		t.Errorf("unset AgentPreset = %q, want empty", got)
+	}
+}
+
+func TestAgentCapabilitiesFieldRoundTrip(t *testing.T) {
+	desc := FormatAgentDescription("Crew max", &AgentFields{
+		RoleType:     "crew",
+		Rig:          "gastown",
+		Capabilities: []string{"lang:go", "repo:beads", "role:review"},
+	})
+	if !strings.Contains(desc, "capabilities: lang:go,repo:beads,role:review") {
+		t.Errorf("description missing capabilities:\n%s", desc)
+	}
+	got := ParseAgentFields(desc).Capabilities
+	if strings.Join(got, ",") != "lang:go,repo:beads,role:review" {
+		t.Errorf("Capabilities = %v, want [lang:go repo:beads role:review]", got)
+	}
+	if got := ParseAgentFields(FormatAgentDescription("Crew max", &AgentFields{})).Capabilities; got != nil {
+		t.Errorf("unset Capabilities = %v, want nil", got)
+	}
+}
+
+func TestAgentSessionIDFieldRoundTrip(t *testing.T) {
+	desc := FormatAgentDescription("Polecat Toast", &AgentFields{
+		RoleType:  "polecat",
+		Rig:       "gastown",
+		SessionID: "8f14e45f-ceea-467f-a8d1-ed0f2f1c2b3a",
+	})
+	if got := ParseAgentFields(desc).SessionID; got != "8f14e45f-ceea-467f-a8d1-ed0f2f1c2b3a" {
+		t.Errorf("SessionID = %q, want the recorded ID", got)
+	}
+	if got := ParseAgentFields(FormatAgentDescription("Polecat Toast", &AgentFields{})).SessionID; got != "" {
+		t.Errorf("unset SessionID = %q, want empty", got)
+	}
+}
@@ -0,0 +1,299 @@
+package beads
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Daemon client defaults.
+const (
+	DefaultDaemonHTTPPort = "9080"
+	defaultDaemonTimeout  = 15 * time.Second
+	defaultDaemonRetries  = 2
+	defaultDaemonBackoff  = 100 * time.Millisecond
+)
+
+// daemonTransport is shared by every DaemonClient so requests to the daemon
+// reuse keep-alive connections instead of dialing per call.
+var daemonTransport = &http.Transport{
+	Proxy: http.ProxyFromEnvironment,
+	DialContext: (&net.Dialer{
+		Timeout:   5 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}).DialContext,
+	MaxIdleConns:        64,
+	MaxIdleConnsPerHost: 16,
+	IdleConnTimeout:     90 * time.Second,
+}
+
+// DaemonClient talks to the beads daemon's HTTP API (bd.v1.BeadsService)
+// directly, avoiding a bd subprocess and JSON round-trip per call.
+// It is safe for concurrent use.
+type DaemonClient struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+	retries    int
+	backoff    time.Duration
+}
+
+// DaemonOption configures a DaemonClient.
+type DaemonOption func(*DaemonClient)
+
+// WithDaemonToken sets the Bearer token sent with each request.
+func WithDaemonToken(token string) DaemonOption {
+	return func(c *DaemonClient) {
+		c.token = token
+	}
+}
+
+// WithDaemonTimeout sets the per-request timeout.
+func WithDaemonTimeout(d time.Duration) DaemonOption {
+	return func(c *DaemonClient) {
+		c.httpClient.Timeout = d
+	}
+}
+
+// WithDaemonRetries sets how many times a failed request is retried and the
+// initial backoff between attempts (doubled after each retry).
+func WithDaemonRetries(retries int, backoff time.Duration) DaemonOption {
+	return func(c *DaemonClient) {
+		c.retries = retries
+		c.backoff = backoff
+	}
+}
+
+// NewDaemonClient creates a client for the daemon HTTP API at baseURL
+// (e.g., "http://daemon:9080").
+func NewDaemonClient(baseURL string, opts ...DaemonOption) *DaemonClient {
+	c := &DaemonClient{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{
+			Transport: daemonTransport,
+			Timeout:   defaultDaemonTimeout,
+		},
+		retries: defaultDaemonRetries,
+		backoff: defaultDaemonBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+var (
+	envDaemonClientMu sync.Mutex
+	envDaemonClients  = map[string]*DaemonClient{}
+)
+
+// NewDaemonClientFromEnv returns a client for the daemon configured in the
+// environment, or nil when not in daemon mode (callers fall back to bd).
+// Prefers BD_DAEMON_HTTP_URL, falling back to BD_DAEMON_HOST plus
+// BD_DAEMON_HTTP_PORT. Clients are cached per URL and token.
+func NewDaemonClientFromEnv() *DaemonClient {
+	if !IsDaemonMode() {
+		return nil
+	}
+	baseURL := os.Getenv("BD_DAEMON_HTTP_URL")
+	if baseURL == "" {
+		host := os.Getenv("BD_DAEMON_HOST")
+		if strings.HasPrefix(host, "http://") || strings.HasPrefix(host, "https://") {
+			baseURL = host
+		} else {
+			port := os.Getenv("BD_DAEMON_HTTP_PORT")
+			if port == "" {
+				port = DefaultDaemonHTTPPort
+			}
+			baseURL = "http://" + host + ":" + port
+		}
+	}
+	token := os.Getenv("BD_DAEMON_TOKEN")
+
+	key := baseURL + "\x00" + token
+	envDaemonClientMu.Lock()
+	defer envDaemonClientMu.Unlock()
+	if c, ok := envDaemonClients[key]; ok {
+		return c
+	}
+	c := NewDaemonClient(baseURL, WithDaemonToken(token))
+	envDaemonClients[key] = c
+	return c
+}
+
+// DaemonError is returned when the daemon answers with a non-200 status.
+type DaemonError struct {
+	Method     string
+	StatusCode int
+	Message    string
+}
+
+func (e *DaemonError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("beads daemon %s: %s", e.Method, e.Message)
+	}
+	return fmt.Sprintf("beads daemon %s: status %d", e.Method, e.StatusCode)
+}
+
+// DaemonListOptions filters ListIssues. Zero values mean no filter.
+type DaemonListOptions struct {
+	Status        string   // e.g., "open", "hooked"
+	ExcludeStatus []string // e.g., []string{"closed"}
+	Type          string   // issue_type, e.g., "convoy", "agent"
+	Labels        []string // all must match
+	Assignee      string
+	Limit         int
+}
+
+// ListIssues lists issues matching opts.
+func (c *DaemonClient) ListIssues(ctx context.Context, opts DaemonListOptions) ([]*Issue, error) {
+	body := map[string]interface{}{}
+	if opts.Status != "" {
+		body["status"] = opts.Status
+	}
+	if len(opts.ExcludeStatus) > 0 {
+		body["exclude_status"] = opts.ExcludeStatus
+	}
+	if opts.Type != "" {
+		body["issue_type"] = opts.Type
+	}
+	if len(opts.Labels) > 0 {
+		body["labels"] = opts.Labels
+	}
+	if opts.Assignee != "" {
+		body["assignee"] = opts.Assignee
+	}
+	if opts.Limit > 0 {
+		body["limit"] = opts.Limit
+	}
+
+	var issues []*Issue
+	if err := c.call(ctx, "List", body, &issues); err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// Show returns a single issue. Returns ErrNotFound if the issue doesn't exist.
+func (c *DaemonClient) Show(ctx context.Context, id string) (*Issue, error) {
+	var issue Issue
+	if err := c.call(ctx, "Show", map[string]interface{}{"id": id}, &issue); err != nil {
+		var de *DaemonError
+		if errors.As(err, &de) && (de.StatusCode == http.StatusNotFound || strings.Contains(strings.ToLower(de.Message), "not found")) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+		}
+		return nil, err
+	}
+	if issue.ID == "" {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	return &issue, nil
+}
+
+// Update applies opts to an issue in a single request.
+func (c *DaemonClient) Update(ctx context.Context, id string, opts UpdateOptions) error {
+	body := map[string]interface{}{"id": id}
+	if opts.Title != nil {
+		body["title"] = *opts.Title
+	}
+	if opts.Status != nil {
+		body["status"] = *opts.Status
+	}
+	if opts.Priority != nil {
+		body["priority"] = *opts.Priority
+	}
+	if opts.Description != nil {
+		body["description"] = *opts.Description
+	}
+	if opts.Assignee != nil {
+		body["assignee"] = *opts.Assignee
+	}
+	if len(opts.AddLabels) > 0 {
+		body["add_labels"] = opts.AddLabels
+	}
+	if len(opts.RemoveLabels) > 0 {
+		body["remove_labels"] = opts.RemoveLabels
+	}
+	if len(opts.SetLabels) > 0 {
+		body["set_labels"] = opts.SetLabels
+	}
+	return c.call(ctx, "Update", body, nil)
+}
+
+// SlotSet sets a named slot (e.g., "hook") on an agent bead.
+func (c *DaemonClient) SlotSet(ctx context.Context, id, slot, value string) error {
+	return c.call(ctx, "SlotSet", map[string]interface{}{
+		"id":    id,
+		"slot":  slot,
+		"value": value,
+	}, nil)
+}
+
+// call POSTs body to /bd.v1.BeadsService/{method} and decodes the response
+// into dst. Connection failures and 429/502/503/504 responses are retried
+// with exponential backoff; other errors are returned immediately.
+func (c *DaemonClient) call(ctx context.Context, method string, body, dst interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("encoding %s request: %w", method, err)
+	}
+
+	backoff := c.backoff
+	for attempt := 0; ; attempt++ {
+		retry, err := c.do(ctx, method, payload, dst)
+		if err == nil || !retry || attempt >= c.retries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// do performs a single request. retry reports whether the failure is transient.
+func (c *DaemonClient) do(ctx context.Context, method string, payload []byte, dst interface{}) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/bd.v1.BeadsService/"+method, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, fmt.Errorf("beads daemon %s: %w", method, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		var errBody struct {
+			Error string `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&errBody)
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			retry = true
+		}
+		return retry, &DaemonError{Method: method, StatusCode: resp.StatusCode, Message: errBody.Error}
+	}
+
+	if dst != nil {
+		if err := json.NewDecoder(resp.Body).Decode(dst); err != nil {
+			return false, fmt.Errorf("decoding %s response: %w", method, err)
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,154 @@
+package beads
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDaemonClientShowAndList(t *testing.T) {
+	var gotAuth string
+	var listBody map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		switch r.URL.Path {
+		case "/bd.v1.BeadsService/Show":
+			var req map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if req["id"] == "gt-missing" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":"issue gt-missing not found"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":"gt-abc","title":"Fix it","status":"hooked","assignee":"gastown/polecats/nux","issue_type":"task"}`))
+		case "/bd.v1.BeadsService/List":
+			_ = json.NewDecoder(r.Body).Decode(&listBody)
+			_, _ = w.Write([]byte(`[{"id":"hq-cv-1","title":"Convoy","status":"open","issue_type":"convoy"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewDaemonClient(srv.URL+"/", WithDaemonToken("tok"))
+	ctx := context.Background()
+
+	issue, err := c.Show(ctx, "gt-abc")
+	if err != nil {
+		t.Fatalf("Show: %v", err)
+	}
+	if issue.Status != "hooked" || issue.Assignee != "gastown/polecats/nux" || issue.Type != "task" {
+		t.Errorf("Show = %+v", issue)
+	}
+	if gotAuth != "Bearer tok" {
+		t.Errorf("Authorization = %q, want Bearer tok", gotAuth)
+	}
+
+	if _, err := c.Show(ctx, "gt-missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Show(missing) error = %v, want ErrNotFound", err)
+	}
+
+	issues, err := c.ListIssues(ctx, DaemonListOptions{Type: "convoy", Status: "open", Limit: 10})
+	if err != nil {
+		t.Fatalf("ListIssues: %v", err)
+	}
+	if len(issues) != 1 || issues[0].ID != "hq-cv-1" {
+		t.Errorf("ListIssues = %+v", issues)
+	}
+	if listBody["issue_type"] != "convoy" || listBody["status"] != "open" || listBody["limit"] != float64(10) {
+		t.Errorf("List request body = %v", listBody)
+	}
+	if _, ok := listBody["labels"]; ok {
+		t.Errorf("empty labels should be omitted: %v", listBody)
+	}
+}
+
+func TestDaemonClientRetries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		switch r.URL.Path {
+		case "/bd.v1.BeadsService/Update":
+			if n < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"bad slot"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := NewDaemonClient(srv.URL, WithDaemonRetries(2, time.Millisecond))
+	status := "hooked"
+	if err := c.Update(context.Background(), "gt-abc", UpdateOptions{Status: &status}); err != nil {
+		t.Fatalf("Update should succeed on the third attempt: %v", err)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("Update attempts = %d, want 3", got)
+	}
+
+	calls.Store(0)
+	err := c.SlotSet(context.Background(), "hq-mayor", "hook", "gt-abc")
+	var de *DaemonError
+	if !errors.As(err, &de) || de.StatusCode != http.StatusBadRequest || de.Message != "bad slot" {
+		t.Errorf("SlotSet error = %v, want DaemonError 400 bad slot", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("client errors should not be retried, got %d attempts", got)
+	}
+}
+
+func TestNewDaemonClientFromEnv(t *testing.T) {
+	t.Setenv("BD_DAEMON_HOST", "")
+	t.Setenv("BD_DAEMON_HTTP_URL", "http://ignored:9080")
+	if c := NewDaemonClientFromEnv(); c != nil {
+		t.Errorf("expected nil client outside daemon mode, got %s", c.baseURL)
+	}
+
+	t.Setenv("BD_DAEMON_HOST", "daemon.svc")
+	t.Setenv("BD_DAEMON_HTTP_URL", "")
+	t.Setenv("BD_DAEMON_HTTP_PORT", "")
+	t.Setenv("BD_DAEMON_TOKEN", "tok")
+	c := NewDaemonClientFromEnv()
+	if c == nil || c.baseURL != "http://daemon.svc:9080" || c.token != "tok" {
+		t.Fatalf("client = %+v", c)
+	}
+	if again := NewDaemonClientFromEnv(); again != c {
+		t.Error("clients should be cached per URL and token")
+	}
+
+	t.Setenv("BD_DAEMON_HOST", "https://daemon.example.com/")
+	if c := NewDaemonClientFromEnv(); c.baseURL != "https://daemon.example.com" {
+		t.Errorf("baseURL = %q", c.baseURL)
+	}
+}
+
+func TestSetHookBeadViaDaemon(t *testing.T) {
+	var slot map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bd.v1.BeadsService/SlotSet" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&slot)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	t.Setenv("BD_DAEMON_HOST", srv.URL)
+	t.Setenv("BD_DAEMON_HTTP_URL", "")
+
+	if err := New(t.TempDir()).SetHookBead("gt-gastown-polecat-nux", "gt-abc"); err != nil {
+		t.Fatalf("SetHookBead: %v", err)
+	}
+	if slot["id"] != "gt-gastown-polecat-nux" || slot["slot"] != "hook" || slot["value"] != "gt-abc" {
+		t.Errorf("SlotSet body = %v", slot)
+	}
+}
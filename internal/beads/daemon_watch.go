@@ -0,0 +1,160 @@
+package beads
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ChangeType identifies the kind of mutation in a ChangeEvent.
+type ChangeType string
+
+// Mutation types emitted by the daemon's /events stream.
+const (
+	ChangeCreate  ChangeType = "create"
+	ChangeUpdate  ChangeType = "update"
+	ChangeStatus  ChangeType = "status"
+	ChangeDelete  ChangeType = "delete"
+	ChangeComment ChangeType = "comment"
+)
+
+// ChangeEvent is a single bead mutation reported by the daemon.
+type ChangeEvent struct {
+	Type       ChangeType `json:"Type"`
+	IssueID    string     `json:"IssueID"`
+	Title      string     `json:"Title,omitempty"`
+	Assignee   string     `json:"Assignee,omitempty"`
+	Actor      string     `json:"Actor,omitempty"`
+	Timestamp  time.Time  `json:"Timestamp"`
+	OldStatus  string     `json:"old_status,omitempty"`
+	NewStatus  string     `json:"new_status,omitempty"`
+	IssueType  string     `json:"issue_type,omitempty"`
+	Labels     []string   `json:"labels,omitempty"`
+	AgentState string     `json:"agent_state,omitempty"`
+}
+
+// IsHookChange reports whether the event moves a bead on or off a hook.
+func (e ChangeEvent) IsHookChange() bool {
+	return e.Type == ChangeStatus && (e.NewStatus == StatusHooked || e.OldStatus == StatusHooked)
+}
+
+// HasLabel reports whether the bead carried label at the time of the change.
+func (e ChangeEvent) HasLabel(label string) bool {
+	return slices.Contains(e.Labels, label)
+}
+
+// WatchFilter selects the events a Watch subscriber receives.
+// Empty fields match everything; within a field any value matches.
+type WatchFilter struct {
+	Types      []ChangeType
+	IssueTypes []string
+	IssueIDs   []string
+	Labels     []string
+}
+
+// Match reports whether ev passes the filter.
+func (f WatchFilter) Match(ev ChangeEvent) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, ev.Type) {
+		return false
+	}
+	if len(f.IssueTypes) > 0 && !slices.Contains(f.IssueTypes, ev.IssueType) {
+		return false
+	}
+	if len(f.IssueIDs) > 0 && !slices.Contains(f.IssueIDs, ev.IssueID) {
+		return false
+	}
+	if len(f.Labels) > 0 && !slices.ContainsFunc(f.Labels, ev.HasLabel) {
+		return false
+	}
+	return true
+}
+
+// Watch subscribes to the daemon's /events stream and sends matching change
+// events on the returned channel until ctx is canceled, when the channel is
+// closed. Dropped connections are retried with exponential backoff (1s to
+// 30s); events that occur while disconnected are not replayed, so callers
+// that need a consistent view should re-list after a gap.
+func (c *DaemonClient) Watch(ctx context.Context, filter WatchFilter) <-chan ChangeEvent {
+	out := make(chan ChangeEvent, 64)
+	go func() {
+		defer close(out)
+		backoff := time.Second
+		const maxBackoff = 30 * time.Second
+		for ctx.Err() == nil {
+			connected, _ := c.stream(ctx, filter, out)
+			if connected {
+				backoff = time.Second
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			if backoff *= 2; backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+		}
+	}()
+	return out
+}
+
+// stream reads one SSE connection until it ends. connected reports whether
+// the daemon accepted the connection, so Watch can reset its backoff.
+func (c *DaemonClient) stream(ctx context.Context, filter WatchFilter, out chan<- ChangeEvent) (connected bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/events", nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	// The stream is long-lived: no client timeout, same pooled transport.
+	resp, err := (&http.Client{Transport: c.httpClient.Transport}).Do(req)
+	if err != nil {
+		return false, fmt.Errorf("beads daemon events: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return false, &DaemonError{Method: "events", StatusCode: resp.StatusCode}
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			// Blank line ends an SSE frame.
+			if data.Len() > 0 {
+				var ev ChangeEvent
+				if json.Unmarshal([]byte(data.String()), &ev) == nil && filter.Match(ev) {
+					select {
+					case out <- ev:
+					case <-ctx.Done():
+						return true, ctx.Err()
+					}
+				}
+				data.Reset()
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "data:") {
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+		// id:, event: and comment lines carry nothing we need.
+	}
+	if err := scanner.Err(); err != nil {
+		return true, fmt.Errorf("beads daemon events: %w", err)
+	}
+	return true, fmt.Errorf("beads daemon events: stream closed")
+}
@@ -0,0 +1,90 @@
+package beads
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDaemonClientWatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/events" || r.Header.Get("Accept") != "text/event-stream" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		frames := []string{
+			`{"Type":"comment","IssueID":"gt-1"}`,
+			`{"Type":"status","IssueID":"gt-2","old_status":"open","new_status":"hooked","Assignee":"gastown/polecats/nux"}`,
+			"malformed",
+			`{"Type":"update","IssueID":"gt-3","labels":["gt:agent"]}`,
+		}
+		for _, f := range frames {
+			fmt.Fprintf(w, "id: 1\nevent: mutation\ndata: %s\n\n", f)
+		}
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := NewDaemonClient(srv.URL).Watch(ctx, WatchFilter{
+		Types: []ChangeType{ChangeStatus, ChangeUpdate},
+	})
+
+	var got []ChangeEvent
+	timeout := time.After(5 * time.Second)
+	for len(got) < 2 {
+		select {
+		case ev := <-changes:
+			got = append(got, ev)
+		case <-timeout:
+			t.Fatalf("timed out waiting for events, got %+v", got)
+		}
+	}
+	if got[0].IssueID != "gt-2" || !got[0].IsHookChange() || got[0].Assignee != "gastown/polecats/nux" {
+		t.Errorf("first event = %+v, want hook change on gt-2", got[0])
+	}
+	if got[1].IssueID != "gt-3" || !got[1].HasLabel("gt:agent") {
+		t.Errorf("second event = %+v, want agent update on gt-3", got[1])
+	}
+
+	cancel()
+	closed := make(chan struct{})
+	go func() {
+		for range changes {
+		}
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("channel not closed after cancel")
+	}
+}
+
+func TestWatchFilterMatch(t *testing.T) {
+	ev := ChangeEvent{Type: ChangeStatus, IssueID: "gt-1", IssueType: "task", Labels: []string{"gt:escalation"}}
+	tests := []struct {
+		name   string
+		filter WatchFilter
+		want   bool
+	}{
+		{"empty", WatchFilter{}, true},
+		{"type match", WatchFilter{Types: []ChangeType{ChangeCreate, ChangeStatus}}, true},
+		{"type miss", WatchFilter{Types: []ChangeType{ChangeDelete}}, false},
+		{"issue type miss", WatchFilter{IssueTypes: []string{"agent"}}, false},
+		{"id match", WatchFilter{IssueIDs: []string{"gt-1"}}, true},
+		{"any label", WatchFilter{Labels: []string{"gt:agent", "gt:escalation"}}, true},
+		{"label miss", WatchFilter{Labels: []string{"gt:agent"}}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.Match(ev); got != tt.want {
+			t.Errorf("%s: Match() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
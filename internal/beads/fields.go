@@ -228,10 +228,16 @@ type MRFields struct {
 	RetryCount      int    // Number of conflict-resolution cycles
 	LastConflictSHA string // SHA of main when conflict occurred
 	ConflictTaskID  string // Link to conflict-resolution task (if any)
+	ConflictHeadSHA string // Branch head when the conflict occurred; a new push means a fix
 
 	// Convoy tracking (for priority scoring - convoy starvation prevention)
 	ConvoyID        string // Parent convoy ID if part of a convoy
 	ConvoyCreatedAt string // Convoy creation time (ISO 8601) for starvation prevention
+
+	// Manual ordering (set by gt mq bump/hold/release)
+	QueuePriority int    // Manual bump level; higher jumps ahead of organic scoring
+	Held          bool   // Held MRs are skipped by the refinery until released
+	HoldReason    string // Why the MR is held
 }
 
 // ParseMRFields extracts structured merge-request fields from an issue's description.
@@ -300,6 +306,9 @@ func ParseMRFields(issue *Issue) *MRFields {
 		case "conflict_task_id", "conflict-task-id", "conflicttaskid":
 			fields.ConflictTaskID = value
 			hasFields = true
+		case "conflict_head_sha", "conflict-head-sha", "conflictheadsha":
+			fields.ConflictHeadSHA = value
+			hasFields = true
 		case "convoy_id", "convoy-id", "convoyid", "convoy":
 			fields.ConvoyID = value
 			hasFields = true
@@ -317,6 +326,17 @@ func ParseMRFields(issue *Issue) *MRFields {
 		case "pr_state", "pr-state", "prstate":
 			fields.PRState = value
 			hasFields = true
+		case "queue_priority", "queue-priority", "queuepriority":
+			if n, err := parseIntField(value); err == nil {
+				fields.QueuePriority = n
+				hasFields = true
+			}
+		case "held":
+			fields.Held = strings.EqualFold(value, "true")
+			hasFields = true
+		case "hold_reason", "hold-reason", "holdreason":
+			fields.HoldReason = value
+			hasFields = true
 		}
 	}
 
@@ -384,12 +404,24 @@ func FormatMRFields(fields *MRFields) string {
 	if fields.ConflictTaskID != "" {
 		lines = append(lines, "conflict_task_id: "+fields.ConflictTaskID)
 	}
+	if fields.ConflictHeadSHA != "" {
+		lines = append(lines, "conflict_head_sha: "+fields.ConflictHeadSHA)
+	}
 	if fields.ConvoyID != "" {
 		lines = append(lines, "convoy_id: "+fields.ConvoyID)
 	}
 	if fields.ConvoyCreatedAt != "" {
 		lines = append(lines, "convoy_created_at: "+fields.ConvoyCreatedAt)
 	}
+	if fields.QueuePriority > 0 {
+		lines = append(lines, fmt.Sprintf("queue_priority: %d", fields.QueuePriority))
+	}
+	if fields.Held {
+		lines = append(lines, "held: true")
+	}
+	if fields.HoldReason != "" {
+		lines = append(lines, "hold_reason: "+fields.HoldReason)
+	}
 
 	return strings.Join(lines, "\n")
 }
@@ -429,6 +461,9 @@ func SetMRFields(issue *Issue, fields *MRFields) string {
 		"conflict_task_id":   true,
 		"conflict-task-id":   true,
 		"conflicttaskid":     true,
+		"conflict_head_sha":  true,
+		"conflict-head-sha":  true,
+		"conflictheadsha":    true,
 		"convoy_id":          true,
 		"convoy-id":          true,
 		"convoyid":           true,
@@ -445,6 +480,13 @@ func SetMRFields(issue *Issue, fields *MRFields) string {
 		"pr_state":           true,
 		"pr-state":           true,
 		"prstate":            true,
+		"queue_priority":     true,
+		"queue-priority":     true,
+		"queuepriority":      true,
+		"held":               true,
+		"hold_reason":        true,
+		"hold-reason":        true,
+		"holdreason":         true,
 	}
 
 	// Collect non-MR lines from existing description
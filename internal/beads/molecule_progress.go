@@ -0,0 +1,187 @@
+package beads
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Step states in a molecule's progress.
+const (
+	StepDone       = "done"
+	StepInProgress = "in_progress"
+	StepReady      = "ready"   // Open with every blocker closed
+	StepBlocked    = "blocked" // Open with at least one open blocker
+)
+
+// StepProgress is one step of a molecule instance.
+type StepProgress struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"` // Bead status
+	State  string `json:"state"`  // StepDone, StepInProgress, StepReady or StepBlocked
+	// BlockedBy lists the open beads blocking a blocked step.
+	BlockedBy []string `json:"blocked_by,omitempty"`
+}
+
+// MoleculeProgress is how far a molecule instance has got, resolved from the
+// status of the step beads bonded under its root.
+type MoleculeProgress struct {
+	RootID     string         `json:"root_id"`
+	RootTitle  string         `json:"root_title"`
+	MoleculeID string         `json:"molecule_id,omitempty"` // Proto the steps were instantiated from
+	Steps      []StepProgress `json:"steps"`
+	TotalSteps int            `json:"total_steps"`
+	DoneSteps  int            `json:"done_steps"`
+	InProgress int            `json:"in_progress_steps"`
+	Percent    int            `json:"percent_complete"`
+	Complete   bool           `json:"complete"`
+}
+
+// CurrentStep returns the step being worked on: the first in-progress step,
+// else the first ready one. It returns nil when the molecule is complete or
+// every open step is blocked.
+func (p *MoleculeProgress) CurrentStep() *StepProgress {
+	for _, state := range []string{StepInProgress, StepReady} {
+		for i := range p.Steps {
+			if p.Steps[i].State == state {
+				return &p.Steps[i]
+			}
+		}
+	}
+	return nil
+}
+
+// StepIDs returns the IDs of the steps in state.
+func (p *MoleculeProgress) StepIDs(state string) []string {
+	var ids []string
+	for _, s := range p.Steps {
+		if s.State == state {
+			ids = append(ids, s.ID)
+		}
+	}
+	return ids
+}
+
+// Blockers returns the blocked steps.
+func (p *MoleculeProgress) Blockers() []StepProgress {
+	var blocked []StepProgress
+	for _, s := range p.Steps {
+		if s.State == StepBlocked {
+			blocked = append(blocked, s)
+		}
+	}
+	return blocked
+}
+
+// NewMoleculeProgress computes progress from a molecule root and its steps.
+// details holds bd show output for open steps; bd list leaves out
+// dependencies, so a step missing from details counts as ready. Only
+// "blocks" dependencies block a step.
+func NewMoleculeProgress(root *Issue, steps []*Issue, details map[string]*Issue) *MoleculeProgress {
+	p := &MoleculeProgress{
+		RootID:    root.ID,
+		RootTitle: root.Title,
+		Steps:     make([]StepProgress, 0, len(steps)),
+	}
+
+	closed := make(map[string]bool)
+	for _, step := range steps {
+		if step.Status == "closed" {
+			closed[step.ID] = true
+		}
+		if p.MoleculeID == "" {
+			p.MoleculeID = instantiatedFrom(step.Description)
+		}
+	}
+
+	for _, step := range steps {
+		s := StepProgress{ID: step.ID, Title: step.Title, Status: step.Status}
+		switch step.Status {
+		case "closed":
+			s.State = StepDone
+			p.DoneSteps++
+		case "in_progress", StatusHooked:
+			s.State = StepInProgress
+			p.InProgress++
+		default:
+			s.State = StepReady
+			if full := details[step.ID]; full != nil {
+				for _, dep := range full.Dependencies {
+					if dep.DependencyType == "blocks" && !closed[dep.ID] && dep.Status != "closed" {
+						s.BlockedBy = append(s.BlockedBy, dep.ID)
+					}
+				}
+			}
+			if len(s.BlockedBy) > 0 {
+				s.State = StepBlocked
+			}
+		}
+		p.Steps = append(p.Steps, s)
+	}
+
+	p.TotalSteps = len(steps)
+	if p.TotalSteps > 0 {
+		p.Percent = (p.DoneSteps * 100) / p.TotalSteps
+	}
+	p.Complete = p.DoneSteps == p.TotalSteps
+	return p
+}
+
+// instantiatedFrom returns the proto ID recorded in a step's description.
+func instantiatedFrom(description string) string {
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "instantiated_from:") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "instantiated_from:"))
+		}
+	}
+	return ""
+}
+
+// MoleculeProgress returns the progress of the molecule rooted at rootID.
+// It returns nil, nil when the root has no steps (a plain issue).
+func (b *Beads) MoleculeProgress(rootID string) (*MoleculeProgress, error) {
+	root, err := b.Show(rootID)
+	if err != nil {
+		return nil, fmt.Errorf("getting molecule root: %w", err)
+	}
+	steps, err := b.List(ListOptions{Parent: rootID, Status: "all", Priority: -1})
+	if err != nil {
+		return nil, fmt.Errorf("listing children: %w", err)
+	}
+	if len(steps) == 0 {
+		return nil, nil
+	}
+
+	var openIDs []string
+	for _, step := range steps {
+		if step.Status == "open" || step.Status == "blocked" {
+			openIDs = append(openIDs, step.ID)
+		}
+	}
+	details, err := b.ShowMultiple(openIDs)
+	if err != nil {
+		// Non-fatal: without dependency info every open step is ready.
+		details = nil
+	}
+	return NewMoleculeProgress(root, steps, details), nil
+}
+
+// BeadMoleculeProgress returns the progress of the molecule bonded to a bead:
+// the molecule attached to it, or the bead itself when it is a molecule root.
+// It returns nil progress when the bead has neither.
+func (b *Beads) BeadMoleculeProgress(beadID string) (*Issue, *MoleculeProgress, error) {
+	issue, err := b.Show(beadID)
+	if err != nil {
+		return nil, nil, err
+	}
+	rootID := beadID
+	if a := ParseAttachmentFields(issue); a != nil && a.AttachedMolecule != "" {
+		rootID = a.AttachedMolecule
+	}
+	progress, err := b.MoleculeProgress(rootID)
+	if err != nil {
+		return issue, nil, err
+	}
+	return issue, progress, nil
+}
@@ -0,0 +1,55 @@
+package beads
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewMoleculeProgress(t *testing.T) {
+	root := &Issue{ID: "gt-wisp", Title: "Ship feature"}
+	steps := []*Issue{
+		{ID: "gt-wisp.1", Title: "Design", Status: "closed", Description: "instantiated_from: mol-feature"},
+		{ID: "gt-wisp.2", Title: "Build", Status: "open"},
+		{ID: "gt-wisp.3", Title: "Review", Status: "open"},
+		{ID: "gt-wisp.4", Title: "Release", Status: "open"},
+	}
+	details := map[string]*Issue{
+		"gt-wisp.2": {ID: "gt-wisp.2", Dependencies: []IssueDep{
+			{ID: "gt-wisp.1", DependencyType: "blocks"},
+			{ID: "gt-wisp", DependencyType: "parent-child"},
+		}},
+		"gt-wisp.3": {ID: "gt-wisp.3", Dependencies: []IssueDep{{ID: "gt-wisp.2", DependencyType: "blocks"}}},
+		"gt-wisp.4": {ID: "gt-wisp.4", Dependencies: []IssueDep{
+			{ID: "gt-wisp.3", DependencyType: "blocks"},
+			{ID: "gt-ext", DependencyType: "blocks", Status: "closed"},
+		}},
+	}
+
+	p := NewMoleculeProgress(root, steps, details)
+	if p.MoleculeID != "mol-feature" || p.TotalSteps != 4 || p.DoneSteps != 1 || p.Percent != 25 || p.Complete {
+		t.Fatalf("progress = %+v", p)
+	}
+	if cur := p.CurrentStep(); cur == nil || cur.ID != "gt-wisp.2" || cur.State != StepReady {
+		t.Errorf("current step = %+v, want ready gt-wisp.2", cur)
+	}
+	if got := p.StepIDs(StepBlocked); !reflect.DeepEqual(got, []string{"gt-wisp.3", "gt-wisp.4"}) {
+		t.Errorf("blocked = %v", got)
+	}
+	if b := p.Blockers(); len(b) != 2 || !reflect.DeepEqual(b[1].BlockedBy, []string{"gt-wisp.3"}) {
+		t.Errorf("blockers = %+v, want closed external blocker ignored", b)
+	}
+
+	// An in-progress step is current even when a later step is ready.
+	steps[2].Status = "in_progress"
+	if cur := NewMoleculeProgress(root, steps, details).CurrentStep(); cur == nil || cur.ID != "gt-wisp.3" {
+		t.Errorf("current step = %+v, want in-progress gt-wisp.3", cur)
+	}
+
+	for _, s := range steps {
+		s.Status = "closed"
+	}
+	p = NewMoleculeProgress(root, steps, nil)
+	if !p.Complete || p.Percent != 100 || p.CurrentStep() != nil {
+		t.Errorf("complete progress = %+v", p)
+	}
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/advice"
@@ -12,7 +13,13 @@ import (
 var adviceCmd = &cobra.Command{
 	Use:   "advice",
 	Short: "Manage advice hooks",
-	Long:  `Commands for working with advice hooks.`,
+	Long: `Commands for working with advice hooks.
+
+Hooks come from two places: advice beads with a hook command, and
+hooks.json configuration files. The town file (settings/hooks.json)
+applies to every agent; a rig file (<rig>/settings/hooks.json) adds hooks
+for that rig's agents and overrides town hooks with the same ID. A
+configured hook can be limited to specific roles.`,
 }
 
 var adviceRunCmd = &cobra.Command{
@@ -26,22 +33,35 @@ Triggers:
   before-push      Run before pushing (gt done)
   before-handoff   Run before handoff (gt handoff)
 
+Hooks run one at a time in priority order. With --parallel, hooks run
+concurrently, except that a hook waits for the hooks listed in its
+depends_on field and is skipped if any of them fail. Results are always
+reported in priority order, and each hook keeps its own block/warn/ignore
+behavior. --timeout bounds the whole run.
+
 Examples:
   gt advice run --trigger session-end
   gt advice run --trigger before-commit
+  gt advice run --trigger before-handoff --parallel --timeout 2m
 
 This command is typically called by Claude Code hooks, not directly by users.`,
 	RunE: runAdviceRun,
 }
 
 var (
-	adviceRunTrigger string
-	adviceRunQuiet   bool
+	adviceRunTrigger     string
+	adviceRunQuiet       bool
+	adviceRunParallel    bool
+	adviceRunMaxParallel int
+	adviceRunTimeout     time.Duration
 )
 
 func init() {
 	adviceRunCmd.Flags().StringVar(&adviceRunTrigger, "trigger", "", "Hook trigger (session-end, before-commit, before-push, before-handoff)")
 	adviceRunCmd.Flags().BoolVarP(&adviceRunQuiet, "quiet", "q", false, "Suppress output except for errors")
+	adviceRunCmd.Flags().BoolVar(&adviceRunParallel, "parallel", false, "Run independent hooks concurrently")
+	adviceRunCmd.Flags().IntVar(&adviceRunMaxParallel, "max-parallel", 0, "Maximum hooks running at once with --parallel (0 = no limit)")
+	adviceRunCmd.Flags().DurationVar(&adviceRunTimeout, "timeout", 0, "Aggregate timeout for all hooks (e.g., 2m; 0 = no limit)")
 	_ = adviceRunCmd.MarkFlagRequired("trigger")
 
 	adviceCmd.AddCommand(adviceRunCmd)
@@ -54,14 +74,7 @@ func runAdviceRun(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid trigger: %s (valid: %v)", adviceRunTrigger, advice.ValidTriggers)
 	}
 
-	// Get agent ID from environment
-	agentID := os.Getenv("BD_ACTOR")
-	if agentID == "" {
-		// Try to detect from role info
-		if roleInfo, err := GetRole(); err == nil {
-			agentID = buildAgentID(roleInfo)
-		}
-	}
+	agentID := adviceAgentID()
 	if agentID == "" {
 		// Non-fatal: no agent context means no hooks to run
 		if !adviceRunQuiet {
@@ -77,31 +90,57 @@ func runAdviceRun(cmd *cobra.Command, args []string) error {
 	}
 
 	// Query and run hooks
-	results, blockErr := advice.RunHooksForTrigger(cwd, agentID, adviceRunTrigger)
+	results, blockErr := advice.RunHooksForTriggerWithOptions(cwd, agentID, adviceRunTrigger, advice.RunOptions{
+		Parallel:    adviceRunParallel,
+		MaxParallel: adviceRunMaxParallel,
+		Timeout:     adviceRunTimeout,
+	})
 
 	// Report results
-	if len(results) > 0 && !adviceRunQuiet {
-		for _, result := range results {
-			if result.Success {
-				fmt.Printf("%s Hook %s completed (%v)\n",
-					style.Bold.Render("✓"), result.Hook.Title, result.Duration)
-			} else if result.TimedOut {
-				style.PrintWarning("hook %s timed out after %v", result.Hook.Title, result.Duration)
-			} else if result.Error != nil {
-				style.PrintWarning("hook %s error: %v", result.Hook.Title, result.Error)
-			} else {
-				// Command failed (non-zero exit)
-				if result.Hook.OnFailure == advice.OnFailureBlock {
-					fmt.Printf("%s Hook %s BLOCKED (exit %d)\n",
-						style.Bold.Render("✗"), result.Hook.Title, result.ExitCode)
-				} else {
-					style.PrintWarning("hook %s failed (exit %d): %s",
-						result.Hook.Title, result.ExitCode, advice.TruncateOutput(result.Output, 100))
-				}
-			}
-		}
+	if !adviceRunQuiet {
+		printHookResults(results)
 	}
 
 	// Return blocking error if any
 	return blockErr
 }
+
+// adviceAgentID returns the current agent's address from BD_ACTOR, falling
+// back to the detected role. Returns "" without agent context.
+func adviceAgentID() string {
+	if agentID := os.Getenv("BD_ACTOR"); agentID != "" {
+		return agentID
+	}
+	if roleInfo, err := GetRole(); err == nil {
+		return buildAgentID(roleInfo)
+	}
+	return ""
+}
+
+// printHookResults reports the outcome of each hook in a run.
+func printHookResults(results []*advice.HookResult) {
+	for _, result := range results {
+		if result.Success {
+			fmt.Printf("%s Hook %s completed (%v)\n",
+				style.Bold.Render("✓"), result.Hook.Title, result.Duration)
+		} else if result.Skipped {
+			style.PrintWarning("hook %s %v", result.Hook.Title, result.Error)
+		} else if result.TimedOut {
+			style.PrintWarning("hook %s timed out after %v", result.Hook.Title, result.Duration)
+		} else if result.Error != nil {
+			style.PrintWarning("hook %s error: %v", result.Hook.Title, result.Error)
+		} else {
+			// Command failed (non-zero exit)
+			if result.Hook.OnFailure == advice.OnFailureBlock {
+				fmt.Printf("%s Hook %s BLOCKED (exit %d)\n",
+					style.Bold.Render("✗"), result.Hook.Title, result.ExitCode)
+			} else {
+				style.PrintWarning("hook %s failed (exit %d): %s",
+					result.Hook.Title, result.ExitCode, advice.TruncateOutput(result.Output, 100))
+			}
+		}
+		if !result.Success && result.LogPath != "" {
+			fmt.Printf("  Full output: %s\n", result.LogPath)
+		}
+	}
+}
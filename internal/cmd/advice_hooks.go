@@ -0,0 +1,362 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/advice"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var adviceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List configured advice hooks",
+	Long: `List hooks defined in hooks.json configuration files.
+
+Without --rig, shows the town hooks. With --rig, shows the effective hooks
+for that rig: town hooks merged with the rig's own file, where rig hooks
+override town hooks with the same ID.
+
+Hooks from advice beads are not included; use 'gt advice test' to see
+everything that would run for an agent.
+
+Examples:
+  gt advice list
+  gt advice list --rig gastown --role polecat
+  gt advice list --trigger before-commit --json`,
+	RunE: runAdviceList,
+}
+
+var adviceAddCmd = &cobra.Command{
+	Use:   "add <id>",
+	Short: "Add or replace a configured advice hook",
+	Long: `Add a hook to the town or rig hooks.json, replacing any hook with the same ID.
+
+With --disabled in a rig, the entry turns off the town hook with the same
+ID for that rig; --command and --trigger are not needed.
+
+Examples:
+  gt advice add lint --trigger before-commit --command "make lint" --on-failure block
+  gt advice add tests --rig gastown --role polecat --trigger before-push \
+    --command "go test ./..." --timeout 120 --depends-on lint
+  gt advice add lint --rig scratch --disabled`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAdviceAdd,
+}
+
+var adviceRmCmd = &cobra.Command{
+	Use:   "rm <id>",
+	Short: "Remove a configured advice hook",
+	Long: `Remove a hook from the town or rig hooks.json.
+
+To turn off a town hook for a single rig, use 'gt advice add <id> --rig <rig> --disabled'.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAdviceRm,
+}
+
+var adviceTestCmd = &cobra.Command{
+	Use:   "test",
+	Short: "Dry-run the advice hooks for a trigger",
+	Long: `Run the hooks an agent would run for a trigger, outside the lifecycle event.
+
+Hooks from hooks.json files and advice beads are resolved for the agent
+(BD_ACTOR or the detected role, or --agent) and run in the current
+directory. A blocking failure is reported but does not fail the command.
+With --plan, only the resolved hooks are listed and nothing is executed.
+
+Examples:
+  gt advice test --trigger before-commit
+  gt advice test --trigger before-push --agent gastown/polecats/furiosa --plan`,
+	RunE: runAdviceTest,
+}
+
+var (
+	adviceHooksRig     string
+	adviceHooksRoles   []string
+	adviceHooksTrigger string
+	adviceListJSON     bool
+
+	adviceAddCommand   string
+	adviceAddTitle     string
+	adviceAddTimeout   int
+	adviceAddOnFailure string
+	adviceAddPriority  int
+	adviceAddDependsOn []string
+	adviceAddDisabled  bool
+
+	adviceTestAgent    string
+	adviceTestPlan     bool
+	adviceTestParallel bool
+)
+
+func init() {
+	adviceListCmd.Flags().StringVar(&adviceHooksRig, "rig", "", "Show effective hooks for this rig")
+	adviceListCmd.Flags().StringSliceVar(&adviceHooksRoles, "role", nil, "Only show hooks that apply to this role")
+	adviceListCmd.Flags().StringVar(&adviceHooksTrigger, "trigger", "", "Only show hooks for this trigger")
+	adviceListCmd.Flags().BoolVar(&adviceListJSON, "json", false, "Output as JSON")
+
+	adviceAddCmd.Flags().StringVar(&adviceHooksRig, "rig", "", "Add to this rig's hooks.json instead of the town's")
+	adviceAddCmd.Flags().StringSliceVar(&adviceHooksRoles, "role", nil, "Limit the hook to these roles (repeatable)")
+	adviceAddCmd.Flags().StringVar(&adviceHooksTrigger, "trigger", "", "Hook trigger (session-end, before-commit, before-push, before-handoff)")
+	adviceAddCmd.Flags().StringVar(&adviceAddCommand, "command", "", "Shell command to run")
+	adviceAddCmd.Flags().StringVar(&adviceAddTitle, "title", "", "Display name (defaults to the ID)")
+	adviceAddCmd.Flags().IntVar(&adviceAddTimeout, "timeout", 0, "Timeout in seconds (default 30)")
+	adviceAddCmd.Flags().StringVar(&adviceAddOnFailure, "on-failure", "", "Failure behavior: block, warn, ignore (default warn)")
+	adviceAddCmd.Flags().IntVar(&adviceAddPriority, "priority", 0, "Run order (lower first)")
+	adviceAddCmd.Flags().StringSliceVar(&adviceAddDependsOn, "depends-on", nil, "IDs of hooks that must succeed first")
+	adviceAddCmd.Flags().BoolVar(&adviceAddDisabled, "disabled", false, "Turn off the town hook with this ID for --rig")
+
+	adviceRmCmd.Flags().StringVar(&adviceHooksRig, "rig", "", "Remove from this rig's hooks.json instead of the town's")
+
+	adviceTestCmd.Flags().StringVar(&adviceHooksTrigger, "trigger", "", "Hook trigger to test")
+	adviceTestCmd.Flags().StringVar(&adviceTestAgent, "agent", "", "Agent address to resolve hooks for (default: current agent)")
+	adviceTestCmd.Flags().BoolVar(&adviceTestPlan, "plan", false, "List the resolved hooks without running them")
+	adviceTestCmd.Flags().BoolVar(&adviceTestParallel, "parallel", false, "Run independent hooks concurrently")
+	_ = adviceTestCmd.MarkFlagRequired("trigger")
+
+	adviceCmd.AddCommand(adviceListCmd)
+	adviceCmd.AddCommand(adviceAddCmd)
+	adviceCmd.AddCommand(adviceRmCmd)
+	adviceCmd.AddCommand(adviceTestCmd)
+}
+
+// adviceHooksPath returns the hooks.json to edit: the rig's when rig is
+// set, otherwise the town's.
+func adviceHooksPath(townRoot, rig string) (string, error) {
+	if rig == "" {
+		return advice.TownHooksPath(townRoot), nil
+	}
+	rigPath := filepath.Join(townRoot, rig)
+	if info, err := os.Stat(rigPath); err != nil || !info.IsDir() {
+		return "", fmt.Errorf("rig '%s' not found", rig)
+	}
+	return advice.RigHooksPath(rigPath), nil
+}
+
+// adviceListEntry is a configured hook with the file it came from.
+type adviceListEntry struct {
+	advice.HookConfig
+	Source string `json:"source"` // "town" or the rig name
+}
+
+func runAdviceList(cmd *cobra.Command, args []string) error {
+	if adviceHooksTrigger != "" && !advice.IsValidTrigger(adviceHooksTrigger) {
+		return fmt.Errorf("invalid trigger: %s (valid: %v)", adviceHooksTrigger, advice.ValidTriggers)
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	town, err := advice.LoadHooksConfig(advice.TownHooksPath(townRoot))
+	if err != nil {
+		return err
+	}
+	var rig *advice.HooksConfig
+	if adviceHooksRig != "" {
+		path, err := adviceHooksPath(townRoot, adviceHooksRig)
+		if err != nil {
+			return err
+		}
+		if rig, err = advice.LoadHooksConfig(path); err != nil {
+			return err
+		}
+	}
+
+	var entries []adviceListEntry
+	for _, h := range advice.MergeHooksConfigs(town, rig) {
+		if adviceHooksTrigger != "" && h.Trigger != adviceHooksTrigger {
+			continue
+		}
+		if !hookAppliesToAnyRole(h, adviceHooksRoles) {
+			continue
+		}
+		source := "town"
+		if rig != nil && rig.Get(h.ID) != nil {
+			source = adviceHooksRig
+		}
+		entries = append(entries, adviceListEntry{HookConfig: h, Source: source})
+	}
+
+	if adviceListJSON {
+		if entries == nil {
+			entries = []adviceListEntry{}
+		}
+		out, _ := json.MarshalIndent(entries, "", "  ")
+		fmt.Println(string(out))
+		return nil
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("No configured advice hooks")
+		return nil
+	}
+	for _, e := range entries {
+		fmt.Printf("%s %s %s\n", style.Bold.Render(e.ID), e.Trigger, style.Dim.Render("("+e.Source+")"))
+		fmt.Printf("  command: %s\n", e.Command)
+		details := []string{"on-failure " + orDefault(e.OnFailure, advice.OnFailureWarn)}
+		if e.Timeout > 0 {
+			details = append(details, fmt.Sprintf("timeout %ds", e.Timeout))
+		}
+		if e.Priority != 0 {
+			details = append(details, fmt.Sprintf("priority %d", e.Priority))
+		}
+		if len(e.Roles) > 0 {
+			details = append(details, "roles "+strings.Join(e.Roles, ","))
+		}
+		if len(e.DependsOn) > 0 {
+			details = append(details, "depends on "+strings.Join(e.DependsOn, ","))
+		}
+		fmt.Printf("  %s\n", style.Dim.Render(strings.Join(details, ", ")))
+	}
+	return nil
+}
+
+// hookAppliesToAnyRole reports whether h runs for any of roles. An empty
+// role filter matches every hook.
+func hookAppliesToAnyRole(h advice.HookConfig, roles []string) bool {
+	if len(roles) == 0 {
+		return true
+	}
+	for _, role := range roles {
+		if h.AppliesTo(role) {
+			return true
+		}
+	}
+	return false
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+func runAdviceAdd(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	if adviceAddDisabled && adviceHooksRig == "" {
+		return fmt.Errorf("--disabled requires --rig (use 'gt advice rm' to remove a town hook)")
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	path, err := adviceHooksPath(townRoot, adviceHooksRig)
+	if err != nil {
+		return err
+	}
+	cfg, err := advice.LoadHooksConfig(path)
+	if err != nil {
+		return err
+	}
+
+	hook := advice.HookConfig{ID: id, Disabled: adviceAddDisabled}
+	if !adviceAddDisabled {
+		if adviceAddCommand == "" || adviceHooksTrigger == "" {
+			return fmt.Errorf("--command and --trigger are required")
+		}
+		hook.Title = adviceAddTitle
+		hook.Command = adviceAddCommand
+		hook.Trigger = adviceHooksTrigger
+		hook.Timeout = adviceAddTimeout
+		hook.OnFailure = adviceAddOnFailure
+		hook.Priority = adviceAddPriority
+		hook.DependsOn = adviceAddDependsOn
+		hook.Roles = adviceHooksRoles
+	}
+
+	replaced := cfg.Get(id) != nil
+	cfg.Set(hook)
+	if err := advice.SaveHooksConfig(path, cfg); err != nil {
+		return err
+	}
+
+	verb := "Added"
+	if replaced {
+		verb = "Updated"
+	}
+	if adviceAddDisabled {
+		verb = "Disabled"
+	}
+	fmt.Printf("%s %s hook %s in %s\n", style.SuccessPrefix, verb, style.Bold.Render(id), path)
+	return nil
+}
+
+func runAdviceRm(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	path, err := adviceHooksPath(townRoot, adviceHooksRig)
+	if err != nil {
+		return err
+	}
+	cfg, err := advice.LoadHooksConfig(path)
+	if err != nil {
+		return err
+	}
+
+	if !cfg.Remove(id) {
+		return fmt.Errorf("hook %q not found in %s", id, path)
+	}
+	if err := advice.SaveHooksConfig(path, cfg); err != nil {
+		return err
+	}
+	fmt.Printf("%s Removed hook %s from %s\n", style.SuccessPrefix, style.Bold.Render(id), path)
+	return nil
+}
+
+func runAdviceTest(cmd *cobra.Command, args []string) error {
+	if !advice.IsValidTrigger(adviceHooksTrigger) {
+		return fmt.Errorf("invalid trigger: %s (valid: %v)", adviceHooksTrigger, advice.ValidTriggers)
+	}
+	agentID := adviceTestAgent
+	if agentID == "" {
+		agentID = adviceAgentID()
+	}
+	if agentID == "" {
+		return fmt.Errorf("no agent context (BD_ACTOR not set); pass --agent")
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working directory: %w", err)
+	}
+
+	hooks := advice.ResolveHooks(cwd, agentID, adviceHooksTrigger)
+	if len(hooks) == 0 {
+		fmt.Printf("No %s hooks for %s\n", adviceHooksTrigger, agentID)
+		return nil
+	}
+
+	fmt.Printf("%s hooks for %s:\n", adviceHooksTrigger, agentID)
+	for i, h := range hooks {
+		line := fmt.Sprintf("  %d. %s [%s, %ds]", i+1, h.Title, h.OnFailure, h.Timeout)
+		if len(h.DependsOn) > 0 {
+			line += " after " + strings.Join(h.DependsOn, ", ")
+		}
+		fmt.Println(line)
+		fmt.Printf("     %s\n", style.Dim.Render(h.Command))
+	}
+	if adviceTestPlan {
+		return nil
+	}
+
+	fmt.Println()
+	runner := advice.NewRunner(cwd, agentID)
+	runner.Parallel = adviceTestParallel
+	results, blockErr := runner.RunAll(hooks)
+	printHookResults(results)
+	if blockErr != nil {
+		fmt.Printf("\n%s Would block %s: %v\n", style.Bold.Render("✗"), adviceHooksTrigger, blockErr)
+	}
+	return nil
+}
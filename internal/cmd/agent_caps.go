@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/sling"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	agentCapsAdd    []string
+	agentCapsRemove []string
+	agentCapsClear  bool
+	agentCapsJSON   bool
+)
+
+var agentCapsCmd = &cobra.Command{
+	Use:   "caps <agent-bead>",
+	Short: "Get or set an agent's capability tags",
+	Long: `Get or set the capability tags on an agent bead.
+
+Capability tags say what an agent is good at. gt sling <bead> --auto matches
+them against the bead's labels of the same kinds to pick a target.
+
+TAG KINDS:
+  lang:<language>  - e.g. lang:go, lang:typescript
+  repo:<rig>       - Repos the agent can work in besides its own rig
+  role:<role>      - e.g. role:review, role:docs
+
+Crew members are only considered by --auto once they have tags. Polecat
+capabilities come from the rig instead:
+  gt rig config set <rig> capabilities lang:go,role:review
+
+EXAMPLES:
+  gt agents caps gt-gastown-crew-max
+  gt agents caps gt-gastown-crew-max --add lang:go --add role:review
+  gt agents caps gt-gastown-crew-max --remove role:review
+  gt agents caps gt-gastown-crew-max --clear`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAgentCaps,
+}
+
+func init() {
+	agentCapsCmd.Flags().StringArrayVar(&agentCapsAdd, "add", nil,
+		"Add a capability tag (kind:value, repeatable)")
+	agentCapsCmd.Flags().StringArrayVar(&agentCapsRemove, "remove", nil,
+		"Remove a capability tag (repeatable)")
+	agentCapsCmd.Flags().BoolVar(&agentCapsClear, "clear", false,
+		"Remove all capability tags")
+	agentCapsCmd.Flags().BoolVar(&agentCapsJSON, "json", false,
+		"Output as JSON")
+
+	agentsCmd.AddCommand(agentCapsCmd)
+}
+
+func runAgentCaps(cmd *cobra.Command, args []string) error {
+	agentBead := args[0]
+	for _, tag := range agentCapsAdd {
+		if !sling.IsCapabilityTag(tag) {
+			return fmt.Errorf("invalid capability tag %q: want <kind>:<value> with kind one of %s",
+				tag, strings.Join(sling.CapabilityKinds, ", "))
+		}
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	bd := beads.New(townRoot)
+	issue, err := bd.Show(agentBead)
+	if err != nil {
+		return fmt.Errorf("reading agent bead %s: %w", agentBead, err)
+	}
+	caps := beads.ParseAgentFields(issue.Description).Capabilities
+
+	if len(agentCapsAdd) > 0 || len(agentCapsRemove) > 0 || agentCapsClear {
+		caps = editCapabilities(caps, agentCapsAdd, agentCapsRemove, agentCapsClear)
+		if err := bd.UpdateAgentCapabilities(agentBead, caps); err != nil {
+			return fmt.Errorf("updating capabilities: %w", err)
+		}
+		if !agentCapsJSON {
+			fmt.Printf("%s Updated capabilities for %s\n", style.Success.Render("✓"), agentBead)
+		}
+	}
+
+	if agentCapsJSON {
+		if caps == nil {
+			caps = []string{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(map[string]interface{}{"agent_bead": agentBead, "capabilities": caps})
+	}
+	if len(caps) == 0 {
+		fmt.Printf("%s has no capability tags\n", agentBead)
+		return nil
+	}
+	fmt.Printf("%s %s\n", style.Bold.Render(agentBead+":"), strings.Join(caps, ", "))
+	return nil
+}
+
+// editCapabilities applies --clear, then --remove, then --add to tags,
+// keeping order and dropping duplicates.
+func editCapabilities(tags, add, remove []string, clearAll bool) []string {
+	if clearAll {
+		tags = nil
+	}
+	drop := make(map[string]bool, len(remove))
+	for _, t := range remove {
+		drop[t] = true
+	}
+	seen := make(map[string]bool)
+	var out []string
+	for _, t := range append(tags, add...) {
+		if drop[t] || seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
@@ -0,0 +1,17 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEditCapabilities(t *testing.T) {
+	tags := []string{"lang:go", "role:review"}
+	got := editCapabilities(tags, []string{"repo:beads", "lang:go"}, []string{"role:review"}, false)
+	if want := []string{"lang:go", "repo:beads"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("editCapabilities() = %v, want %v", got, want)
+	}
+	if got := editCapabilities(tags, []string{"lang:rust"}, nil, true); !reflect.DeepEqual(got, []string{"lang:rust"}) {
+		t.Errorf("editCapabilities(clear) = %v, want [lang:rust]", got)
+	}
+}
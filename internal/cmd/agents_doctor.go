@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var agentsDoctorJSON bool
+
+var agentsDoctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check which agent presets can run on this host",
+	Long: `Check every agent preset before agents are spawned with it.
+
+For each preset (built-in, settings/agents.json, and custom agents in
+settings/config.json) this checks that:
+  - the binary is in PATH
+  - its health check command succeeds (e.g. 'claude --version')
+  - credentials are available, from an auth env var or a login file
+
+Presets used by default_agent or role_agents are marked. Missing
+credentials only warn, since some CLIs keep their login in the OS
+keychain. Exits non-zero if a preset in use can't run.
+
+Add a health check to a custom preset in settings/agents.json:
+
+  "my-agent": {"command": "my-agent", "health_check": ["my-agent", "--version"],
+               "auth_env": ["MY_AGENT_API_KEY"]}`,
+	Args: cobra.NoArgs,
+	RunE: runAgentsDoctor,
+}
+
+func init() {
+	agentsDoctorCmd.Flags().BoolVar(&agentsDoctorJSON, "json", false, "Output as JSON")
+
+	agentsCmd.AddCommand(agentsDoctorCmd)
+}
+
+// agentPresetCheck is a preset's health plus the roles that use it.
+type agentPresetCheck struct {
+	*config.PresetHealth
+	UsedBy []string `json:"used_by,omitempty"`
+}
+
+func runAgentsDoctor(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	presets, usedBy := townAgentPresets(townRoot)
+	checks := checkAgentPresets(cmd.Context(), presets, usedBy)
+
+	unusable := 0
+	for _, c := range checks {
+		if len(c.UsedBy) > 0 && !c.Usable() {
+			unusable++
+		}
+	}
+
+	if agentsDoctorJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(checks); err != nil {
+			return err
+		}
+	} else {
+		printAgentPresetChecks(checks)
+	}
+
+	if unusable > 0 {
+		return fmt.Errorf("%d agent preset(s) in use can't run on this host", unusable)
+	}
+	return nil
+}
+
+// townAgentPresets returns every agent the town can spawn, keyed by name,
+// and which roles use each one. Custom agents in town settings override
+// registry presets of the same name, as they do when agents are started.
+// Agents named in settings but defined nowhere map to nil.
+func townAgentPresets(townRoot string) (map[string]*config.AgentPresetInfo, map[string][]string) {
+	_ = config.LoadAgentRegistry(config.DefaultAgentRegistryPath(townRoot))
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		settings = config.NewTownSettings()
+	}
+
+	presets := make(map[string]*config.AgentPresetInfo)
+	for _, name := range config.ListAgentPresets() {
+		presets[name] = config.GetAgentPresetByName(name)
+	}
+	for name, rc := range settings.Agents {
+		if rc != nil {
+			presets[name] = config.AgentPresetForRuntime(name, rc)
+		}
+	}
+
+	usedBy := make(map[string][]string)
+	defaultAgent := settings.DefaultAgent
+	if defaultAgent == "" {
+		defaultAgent = string(config.DefaultAgentPreset())
+	}
+	usedBy[defaultAgent] = append(usedBy[defaultAgent], "default")
+	for role, name := range settings.RoleAgents {
+		if name != "" {
+			usedBy[name] = append(usedBy[name], role)
+		}
+	}
+	for name, roles := range usedBy {
+		sort.Strings(roles)
+		if _, ok := presets[name]; !ok {
+			presets[name] = nil
+		}
+	}
+	return presets, usedBy
+}
+
+// checkAgentPresets health-checks presets in parallel, since each check
+// can take seconds. Results are sorted with presets in use first.
+func checkAgentPresets(ctx context.Context, presets map[string]*config.AgentPresetInfo, usedBy map[string][]string) []agentPresetCheck {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	checks := make([]agentPresetCheck, 0, len(presets))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, info := range presets {
+		wg.Add(1)
+		go func(name string, info *config.AgentPresetInfo) {
+			defer wg.Done()
+			var health *config.PresetHealth
+			if info == nil {
+				health = &config.PresetHealth{
+					Name:     name,
+					Problems: []string{"not defined in settings/config.json, settings/agents.json, or built-in presets"},
+				}
+			} else {
+				health = config.CheckAgentPreset(ctx, info)
+				health.Name = name
+			}
+			mu.Lock()
+			checks = append(checks, agentPresetCheck{PresetHealth: health, UsedBy: usedBy[name]})
+			mu.Unlock()
+		}(name, info)
+	}
+	wg.Wait()
+
+	sort.Slice(checks, func(i, j int) bool {
+		if (len(checks[i].UsedBy) > 0) != (len(checks[j].UsedBy) > 0) {
+			return len(checks[i].UsedBy) > 0
+		}
+		return checks[i].Name < checks[j].Name
+	})
+	return checks
+}
+
+func printAgentPresetChecks(checks []agentPresetCheck) {
+	usable := 0
+	for _, c := range checks {
+		icon := style.Success.Render("✓")
+		switch {
+		case !c.Usable():
+			icon = style.Error.Render("✗")
+		case len(c.Warnings) > 0:
+			icon = style.Warning.Render("⚠")
+		}
+		if c.Usable() {
+			usable++
+		}
+
+		line := fmt.Sprintf("%s %-14s", icon, c.Name)
+		if c.Version != "" {
+			line += " " + c.Version
+		}
+		if len(c.UsedBy) > 0 {
+			line += " " + style.Dim.Render("("+strings.Join(c.UsedBy, ", ")+")")
+		}
+		fmt.Println(strings.TrimRight(line, " "))
+
+		if c.Auth != "" {
+			fmt.Printf("    auth: %s\n", c.Auth)
+		}
+		for _, p := range c.Problems {
+			fmt.Printf("    %s\n", style.Error.Render(p))
+		}
+		for _, w := range c.Warnings {
+			fmt.Printf("    %s\n", style.Warning.Render(w))
+		}
+	}
+	fmt.Printf("\n%d of %d agent presets usable on this host\n", usable, len(checks))
+}
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/rpcclient"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var (
+	agentFilesRPCURL    string
+	agentFilesRPCAPIKey string
+	agentFilesJSON      bool
+	agentFetchOutput    string
+	agentFetchTimeout   time.Duration
+)
+
+var agentsFilesCmd = &cobra.Command{
+	Use:   "files <agent> [path]",
+	Short: "List files in an agent's pod",
+	Long: `List a directory inside a K8s agent's pod through the gt RPC server.
+
+Paths must be absolute and under the agent's workspace (/home/agent/gt) or
+/tmp. With no path, the workspace root is listed.
+
+Examples:
+  gt agents files gastown/polecats/nux
+  gt agents files gastown/polecats/nux /home/agent/gt/gastown/polecats/nux
+  gt agents files gastown/polecats/nux /tmp --rpc-url http://hq:8443`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runAgentsFiles,
+}
+
+var agentsFetchCmd = &cobra.Command{
+	Use:   "fetch <agent> <path>",
+	Short: "Copy a file out of an agent's pod",
+	Long: `Copy a file out of a K8s agent's pod through the gt RPC server.
+
+The file is streamed to disk as it arrives. By default it is written to
+the current directory under its own name; use -o to pick the destination,
+or -o - to write to stdout. Files over 64 MB are refused by the server.
+
+Examples:
+  gt agents fetch gastown/polecats/nux /tmp/build.log
+  gt agents fetch gastown/polecats/nux /home/agent/gt/out.diff -o nux.diff
+  gt agents fetch gastown/polecats/nux /tmp/trace.json -o - | jq .`,
+	Args: cobra.ExactArgs(2),
+	RunE: runAgentsFetch,
+}
+
+func init() {
+	for _, c := range []*cobra.Command{agentsFilesCmd, agentsFetchCmd} {
+		c.Flags().StringVar(&agentFilesRPCURL, "rpc-url", "", "gt RPC server URL (default $GT_RPC_URL or http://localhost:8443)")
+		c.Flags().StringVar(&agentFilesRPCAPIKey, "rpc-api-key", "", "gt RPC server API key (default $GT_RPC_API_KEY)")
+	}
+	agentsFilesCmd.Flags().BoolVar(&agentFilesJSON, "json", false, "Output as JSON")
+	agentsFetchCmd.Flags().StringVarP(&agentFetchOutput, "output", "o", "", "Destination file, or - for stdout (default: the file's name)")
+	agentsFetchCmd.Flags().DurationVar(&agentFetchTimeout, "timeout", 10*time.Minute, "Give up if the transfer takes longer")
+
+	agentsCmd.AddCommand(agentsFilesCmd)
+	agentsCmd.AddCommand(agentsFetchCmd)
+}
+
+// agentFilesClient builds a client for the gt RPC server that serves the
+// agent file RPCs.
+func agentFilesClient(timeout time.Duration) *rpcclient.Client {
+	url := flagOrEnv(agentFilesRPCURL, "GT_RPC_URL")
+	if url == "" {
+		url = "http://localhost:8443"
+	}
+	opts := []rpcclient.Option{rpcclient.WithTimeout(timeout)}
+	if key := flagOrEnv(agentFilesRPCAPIKey, "GT_RPC_API_KEY"); key != "" {
+		opts = append(opts, rpcclient.WithAPIKey(key))
+	}
+	return rpcclient.NewClient(url, daemonTLSOptions(opts)...)
+}
+
+func runAgentsFiles(cmd *cobra.Command, args []string) error {
+	var dir string
+	if len(args) > 1 {
+		dir = args[1]
+	}
+	client := agentFilesClient(30 * time.Second)
+	resolved, files, err := client.ListAgentFiles(context.Background(), args[0], dir)
+	if err != nil {
+		return fmt.Errorf("listing files for %s: %w", args[0], err)
+	}
+
+	if agentFilesJSON {
+		return outputJSON(files)
+	}
+
+	fmt.Printf("%s %s:%s\n\n", style.Bold.Render("📁"), args[0], resolved)
+	if len(files) == 0 {
+		fmt.Printf("  %s\n", style.Dim.Render("(empty)"))
+		return nil
+	}
+	for _, f := range files {
+		name := f.Name
+		if f.IsDir {
+			name += "/"
+		}
+		fmt.Printf("  %s  %10d  %s  %s\n", os.FileMode(f.Mode).Perm(), f.Size,
+			f.Modified.Local().Format("2006-01-02 15:04"), name)
+	}
+	return nil
+}
+
+func runAgentsFetch(cmd *cobra.Command, args []string) error {
+	agent, src := args[0], args[1]
+	dest := agentFetchOutput
+	if dest == "" {
+		dest = path.Base(src)
+	}
+
+	var w io.Writer = os.Stdout
+	var tmp string
+	if dest != "-" {
+		// Stream into a temp file and rename on success, so a failed
+		// transfer never leaves a truncated file under the real name.
+		f, err := os.CreateTemp(filepath.Dir(dest), ".gt-fetch-*")
+		if err != nil {
+			return err
+		}
+		tmp = f.Name()
+		defer func() {
+			_ = f.Close()
+			_ = os.Remove(tmp) // no-op once renamed
+		}()
+		w = f
+	}
+
+	client := agentFilesClient(agentFetchTimeout)
+	info, err := client.FetchAgentFile(context.Background(), agent, src, w)
+	if err != nil {
+		return fmt.Errorf("fetching %s from %s: %w", src, agent, err)
+	}
+	if tmp == "" {
+		return nil
+	}
+
+	if err := w.(*os.File).Close(); err != nil {
+		return err
+	}
+	if mode := os.FileMode(info.Mode).Perm(); mode != 0 {
+		_ = os.Chmod(tmp, mode) // best-effort: keep the pod's permissions
+	}
+	if err := os.Rename(tmp, dest); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%s Fetched %s (%d bytes) to %s\n", style.Success.Render("✓"), info.Path, info.Size, dest)
+	return nil
+}
@@ -0,0 +1,218 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/rpcserver"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// API key command flags
+var (
+	apikeyRole        string
+	apikeyGrace       time.Duration
+	apikeyListJSON    bool
+	apikeyRevokeForce bool
+)
+
+var apikeyCmd = &cobra.Command{
+	Use:     "apikey",
+	GroupID: GroupServices,
+	Short:   "Manage API keys for the RPC server",
+	RunE:    requireSubcommand,
+	Long: `Create, rotate, and revoke the API keys accepted by 'gt rpc serve'.
+
+Each key has a role that limits which RPC methods it may call:
+
+  read-only  List, Get, Watch, Search, Read, and Peek methods
+  operator   Everything read-only can do, plus sending mail, slinging,
+             resolving decisions, and editing beads
+  admin      Everything, including creating, starting, and removing crew,
+             spawning polecats, stopping agents,
+             reading agent files, and sending terminal input
+
+Keys are stored hashed in mayor/apikeys.json; a secret is printed once when
+it is created or rotated and cannot be recovered. A running server picks up
+changes without a restart. The legacy 'gt rpc serve --api-key' key is still
+accepted and acts as admin.
+
+Examples:
+  gt apikey create phone --role read-only
+  gt apikey create west-town --role operator
+  gt apikey rotate west-town --grace 24h    # old secret works for a day
+  gt apikey set-role phone operator
+  gt apikey revoke phone
+  gt apikey list`,
+}
+
+var apikeyCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a key and print its secret",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runAPIKeyCreate,
+}
+
+var apikeyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List keys and their roles",
+	Args:  cobra.NoArgs,
+	RunE:  runAPIKeyList,
+}
+
+var apikeyRotateCmd = &cobra.Command{
+	Use:   "rotate <name>",
+	Short: "Issue a new secret for a key",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runAPIKeyRotate,
+}
+
+var apikeySetRoleCmd = &cobra.Command{
+	Use:   "set-role <name> <role>",
+	Short: "Change a key's role",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runAPIKeySetRole,
+}
+
+var apikeyRevokeCmd = &cobra.Command{
+	Use:   "revoke <name>",
+	Short: "Delete a key",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runAPIKeyRevoke,
+}
+
+func init() {
+	apikeyCreateCmd.Flags().StringVar(&apikeyRole, "role", string(rpcserver.RoleReadOnly), "Role: read-only, operator, or admin")
+	apikeyRotateCmd.Flags().DurationVar(&apikeyGrace, "grace", 0, "Keep the old secret valid this long (e.g., 24h)")
+	apikeyListCmd.Flags().BoolVar(&apikeyListJSON, "json", false, "Output as JSON")
+	apikeyRevokeCmd.Flags().BoolVar(&apikeyRevokeForce, "force", false, "Revoke even if it is the last key")
+
+	apikeyCmd.AddCommand(apikeyCreateCmd)
+	apikeyCmd.AddCommand(apikeyListCmd)
+	apikeyCmd.AddCommand(apikeyRotateCmd)
+	apikeyCmd.AddCommand(apikeySetRoleCmd)
+	apikeyCmd.AddCommand(apikeyRevokeCmd)
+	rootCmd.AddCommand(apikeyCmd)
+}
+
+func loadAPIKeyStore() (*rpcserver.APIKeyStore, error) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return nil, fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	return rpcserver.LoadAPIKeyStore(townRoot)
+}
+
+func runAPIKeyCreate(cmd *cobra.Command, args []string) error {
+	store, err := loadAPIKeyStore()
+	if err != nil {
+		return err
+	}
+	secret, err := store.Create(args[0], rpcserver.Role(apikeyRole))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s Created %s key %s\n\n", style.Bold.Render("✓"), apikeyRole, args[0])
+	printAPIKeySecret(secret)
+	return nil
+}
+
+func runAPIKeyList(cmd *cobra.Command, args []string) error {
+	store, err := loadAPIKeyStore()
+	if err != nil {
+		return err
+	}
+	keys := store.List()
+
+	if apikeyListJSON {
+		type keyJSON struct {
+			Name        string     `json:"name"`
+			Role        string     `json:"role"`
+			CreatedAt   time.Time  `json:"created_at"`
+			RotatedAt   *time.Time `json:"rotated_at,omitempty"`
+			PrevExpires *time.Time `json:"prev_expires,omitempty"`
+		}
+		out := make([]keyJSON, 0, len(keys))
+		for _, k := range keys {
+			kj := keyJSON{Name: k.Name, Role: string(k.Role), CreatedAt: k.CreatedAt}
+			if !k.RotatedAt.IsZero() {
+				kj.RotatedAt = &k.RotatedAt
+			}
+			if k.PrevHash != "" {
+				kj.PrevExpires = &k.PrevExpires
+			}
+			out = append(out, kj)
+		}
+		return outputJSON(out)
+	}
+
+	if len(keys) == 0 {
+		fmt.Println("No API keys. Create one with: gt apikey create <name> --role <role>")
+		return nil
+	}
+	for _, k := range keys {
+		line := fmt.Sprintf("  %-20s %-10s created %s", k.Name, k.Role, k.CreatedAt.Format("2006-01-02"))
+		if !k.RotatedAt.IsZero() {
+			line += ", rotated " + k.RotatedAt.Format("2006-01-02")
+		}
+		if k.PrevHash != "" && time.Now().Before(k.PrevExpires) {
+			line += style.Dim.Render(fmt.Sprintf(" (old secret valid until %s)", k.PrevExpires.Local().Format("2006-01-02 15:04")))
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+func runAPIKeyRotate(cmd *cobra.Command, args []string) error {
+	store, err := loadAPIKeyStore()
+	if err != nil {
+		return err
+	}
+	secret, err := store.Rotate(args[0], apikeyGrace)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s Rotated key %s\n", style.Bold.Render("✓"), args[0])
+	if apikeyGrace > 0 {
+		fmt.Printf("  Old secret stays valid for %s\n", apikeyGrace)
+	} else {
+		fmt.Printf("  Old secret no longer works\n")
+	}
+	fmt.Println()
+	printAPIKeySecret(secret)
+	return nil
+}
+
+func runAPIKeySetRole(cmd *cobra.Command, args []string) error {
+	store, err := loadAPIKeyStore()
+	if err != nil {
+		return err
+	}
+	if err := store.SetRole(args[0], rpcserver.Role(args[1])); err != nil {
+		return err
+	}
+	fmt.Printf("%s Key %s is now %s\n", style.Bold.Render("✓"), args[0], args[1])
+	return nil
+}
+
+func runAPIKeyRevoke(cmd *cobra.Command, args []string) error {
+	store, err := loadAPIKeyStore()
+	if err != nil {
+		return err
+	}
+	if keys := store.List(); !apikeyRevokeForce && len(keys) == 1 && keys[0].Name == args[0] {
+		return fmt.Errorf("%s is the last API key; a running server will reject every call until a new key is created (use --force to revoke anyway)", args[0])
+	}
+	if err := store.Revoke(args[0]); err != nil {
+		return err
+	}
+	fmt.Printf("%s Revoked key %s\n", style.Bold.Render("✓"), args[0])
+	return nil
+}
+
+func printAPIKeySecret(secret string) {
+	fmt.Printf("  %s\n\n", secret)
+	fmt.Printf("%s\n", style.Dim.Render("Store this now; it will not be shown again. Clients send it as X-GT-API-Key."))
+}
@@ -301,7 +301,7 @@ func TestBeadsDbInitAfterClone(t *testing.T) {
 		}
 
 		// Add rig WITHOUT --prefix - should derive from rig name "testrig"
-		// deriveBeadsPrefix("testrig") should produce some abbreviation
+		// DeriveBeadsPrefix("testrig") should produce some abbreviation
 		cmd = exec.Command(gtBinary, "rig", "add", "testrig", derivedRepo)
 		cmd.Dir = townRoot
 		cmd.Env = append(os.Environ(), "HOME="+tmpDir)
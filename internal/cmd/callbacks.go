@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -11,7 +12,9 @@ import (
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/townlog"
+	"github.com/steveyegge/gastown/internal/tracing"
 	"github.com/steveyegge/gastown/internal/workspace"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Callback message subject patterns for routing.
@@ -339,6 +342,11 @@ func handleMergeCompleted(townRoot string, msg *mail.Message, dryRun bool) (stri
 
 	// Close the source issue if we have it
 	if sourceIssue != "" {
+		// Record the landed merge in the issue's trace
+		_, span := tracing.Start(context.Background(), sourceIssue, "merge_completed",
+			attribute.String("gt.mr.id", mrID), attribute.String("gt.merge.commit", mergeCommit))
+		tracing.End(span, nil)
+
 		cwd, _ := os.Getwd()
 		bd := beads.New(cwd)
 		reason := fmt.Sprintf("Merged in %s", mergeCommit)
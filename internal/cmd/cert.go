@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/mtls"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Cert command flags
+var (
+	certAgents   []string
+	certDNS      []string
+	certIPs      []string
+	certOut      string
+	certCADir    string
+	certValidity time.Duration
+)
+
+var certCmd = &cobra.Command{
+	Use:     "cert",
+	GroupID: GroupServices,
+	Short:   "Manage TLS certificates for the RPC server and agents",
+	RunE:    requireSubcommand,
+	Long: `Issue certificates for mutual TLS between agents and 'gt rpc serve'.
+
+This is a helper for local and development clusters. It keeps a small CA in
+mayor/tls (created on first use) and signs certificates with it. Production
+clusters should issue certificates from their own CA, e.g. cert-manager,
+using the same layout and SANs.`,
+}
+
+var certIssueCmd = &cobra.Command{
+	Use:   "issue <name>",
+	Short: "Issue a server or agent certificate signed by the town CA",
+	Long: `Issue a certificate signed by the town CA.
+
+The certificate is written to --out (default mayor/tls/<name>) as tls.crt,
+tls.key, and ca.crt, the layout of a Kubernetes TLS secret. Point
+'gt rpc serve --tls-dir' or an agent's GT_TLS_DIR at the directory, or load
+it into the cluster with the printed kubectl command.
+
+--agent adds a gt://agent/<address> URI SAN. The RPC server only lets a
+client send as the agents named in its certificate; a certificate with no
+--agent SANs may send as anyone, so reserve those for the daemon and
+operators.
+
+Examples:
+  gt cert issue daemon --dns gt-daemon.gastown.svc --dns localhost --ip 127.0.0.1
+  gt cert issue nux --agent gastown/polecats/nux
+  gt cert issue witness --agent gastown/witness --out ./witness-tls`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCertIssue,
+}
+
+func init() {
+	certIssueCmd.Flags().StringArrayVar(&certAgents, "agent", nil, "Agent address the holder may act as (repeatable)")
+	certIssueCmd.Flags().StringArrayVar(&certDNS, "dns", nil, "DNS name SAN (repeatable)")
+	certIssueCmd.Flags().StringArrayVar(&certIPs, "ip", nil, "IP address SAN (repeatable)")
+	certIssueCmd.Flags().StringVar(&certOut, "out", "", "Output directory (default: mayor/tls/<name>)")
+	certIssueCmd.Flags().StringVar(&certCADir, "ca-dir", "", "CA directory (default: mayor/tls)")
+	certIssueCmd.Flags().DurationVar(&certValidity, "validity", 90*24*time.Hour, "How long the certificate is valid")
+
+	certCmd.AddCommand(certIssueCmd)
+	rootCmd.AddCommand(certCmd)
+}
+
+func runCertIssue(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	var ips []net.IP
+	for _, s := range certIPs {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return fmt.Errorf("invalid --ip %q", s)
+		}
+		ips = append(ips, ip)
+	}
+
+	caDir := certCADir
+	if caDir == "" {
+		caDir = filepath.Join(townRoot, "mayor", "tls")
+	}
+	out := certOut
+	if out == "" {
+		out = filepath.Join(townRoot, "mayor", "tls", name)
+	}
+
+	caName := "Gas Town CA"
+	if townName, err := workspace.GetTownName(townRoot); err == nil && townName != "" {
+		caName = fmt.Sprintf("Gas Town %s CA", townName)
+	}
+	ca, created, err := mtls.LoadOrCreateCA(caDir, caName)
+	if err != nil {
+		return err
+	}
+	if created {
+		fmt.Printf("%s Created town CA in %s\n", style.Bold.Render("✓"), caDir)
+	}
+
+	certPEM, keyPEM, err := ca.Issue(mtls.IssueOptions{
+		CommonName: name,
+		DNSNames:   certDNS,
+		IPs:        ips,
+		Agents:     certAgents,
+		Validity:   certValidity,
+	})
+	if err != nil {
+		return err
+	}
+	if err := mtls.WriteDir(out, certPEM, keyPEM, ca.CertPEM); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Issued certificate %s in %s\n", style.Bold.Render("✓"), name, out)
+	for _, a := range certAgents {
+		fmt.Printf("  agent: %s\n", a)
+	}
+	if len(certAgents) == 0 {
+		fmt.Printf("  %s\n", style.Dim.Render("no --agent SANs: holder may send as any agent"))
+	}
+	fmt.Println()
+	fmt.Println("Load into Kubernetes with:")
+	fmt.Printf("  kubectl create secret generic gt-tls-%s --from-file=%s\n", name, out)
+	return nil
+}
@@ -29,7 +29,9 @@ for your Gas Town workspace, including agent aliases, defaults,
 and config beads (the "Everything Is Beads" configuration system).
 
 Commands:
-  gt config agent list              List all agents (built-in and custom)
+  gt config show [key...]            Show effective settings and their source
+  gt config validate                 Check town and rig config files
+  gt config agent list               List all agents (built-in and custom)
   gt config agent get <name>         Show agent configuration
   gt config agent set <name> <cmd>   Set custom agent command
   gt config agent remove <name>      Remove custom agent
@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/configbeads"
+	"github.com/steveyegge/gastown/internal/notify"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	configNotificationsJSON  bool
+	configNotificationsFlush bool
+)
+
+var configNotificationsCmd = &cobra.Command{
+	Use:   "notifications",
+	Short: "Manage the overseer's notification preferences",
+	Long: `Manage how and when the overseer is notified.
+
+Escalations, SLA breaches, mail to the overseer and landed convoys are
+delivered through a notification dispatcher that applies these preferences:
+
+  mode                immediate (default) or digest
+  digest_interval     How long digest mode batches notifications (default 1h)
+  immediate_severity  Lowest severity still delivered at once in digest mode
+                      (default critical)
+  channels            Comma-separated: slack, mail, push (default slack,mail)
+  slack_channel       Slack channel or user ID for the overseer's notifications
+                      (default: the channel settings/slack.json routes to)
+  push_url            Push gateway receiving notifications as JSON POSTs
+  event.<type>        on or off, for escalation, sla_breach, decision, mail,
+                      convoy and usage_budget
+
+Push notifications are also published on the event feed as "notification"
+events, which mobile clients watch. In digest mode the daemon sends held
+notifications as one summary once the digest interval has passed.
+
+Preferences are stored in a notifications config bead per overseer
+(hq-cfg-notifications-<overseer>).
+
+Examples:
+  gt config notifications show
+  gt config notifications set mode digest
+  gt config notifications set immediate_severity high
+  gt config notifications set channels slack,push
+  gt config notifications set event.convoy off
+  gt config notifications digest --flush`,
+	RunE: requireSubcommand,
+}
+
+var configNotificationsShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the overseer's notification preferences",
+	Args:  cobra.NoArgs,
+	RunE:  runConfigNotificationsShow,
+}
+
+var configNotificationsSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Change a notification preference",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runConfigNotificationsSet,
+}
+
+var configNotificationsDigestCmd = &cobra.Command{
+	Use:   "digest",
+	Short: "Show notifications held for the next digest",
+	Args:  cobra.NoArgs,
+	RunE:  runConfigNotificationsDigest,
+}
+
+func init() {
+	configNotificationsShowCmd.Flags().BoolVar(&configNotificationsJSON, "json", false, "Output as JSON")
+	configNotificationsDigestCmd.Flags().BoolVar(&configNotificationsFlush, "flush", false, "Send the digest now")
+
+	configNotificationsCmd.AddCommand(configNotificationsShowCmd)
+	configNotificationsCmd.AddCommand(configNotificationsSetCmd)
+	configNotificationsCmd.AddCommand(configNotificationsDigestCmd)
+	configCmd.AddCommand(configNotificationsCmd)
+}
+
+func runConfigNotificationsShow(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	overseer := notify.OverseerKey(townRoot)
+	prefs, err := configbeads.LoadNotificationPrefs(beads.New(townRoot), overseer)
+	if err != nil {
+		return err
+	}
+	if configNotificationsJSON {
+		return outputJSON(prefs)
+	}
+
+	mode := prefs.Mode
+	if mode == config.NotifyModeDigest {
+		threshold := prefs.ImmediateSeverity
+		if threshold == "" {
+			threshold = config.SeverityCritical
+		}
+		mode = fmt.Sprintf("digest every %s (%s and above immediately)", prefs.GetDigestInterval(), threshold)
+	}
+	fmt.Printf("Notifications for %s\n", style.Bold.Render(overseer))
+	fmt.Printf("  Mode:     %s\n", mode)
+	fmt.Printf("  Channels: %s\n", strings.Join(prefs.Channels, ", "))
+	if prefs.SlackChannel != "" {
+		fmt.Printf("  Slack:    %s\n", prefs.SlackChannel)
+	}
+	if prefs.PushURL != "" {
+		fmt.Printf("  Push URL: %s\n", prefs.PushURL)
+	}
+	fmt.Println()
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  EVENT\tNOTIFY")
+	for _, e := range config.NotifyEvents() {
+		state := "on"
+		if !prefs.EventEnabled(e) {
+			state = style.Dim.Render("off")
+		}
+		fmt.Fprintf(tw, "  %s\t%s\n", e, state)
+	}
+	return tw.Flush()
+}
+
+func runConfigNotificationsSet(cmd *cobra.Command, args []string) error {
+	key, value := args[0], args[1]
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	townName, err := workspace.GetTownName(townRoot)
+	if err != nil {
+		return err
+	}
+	bd := beads.New(townRoot)
+	overseer := notify.OverseerKey(townRoot)
+	prefs, err := configbeads.LoadNotificationPrefs(bd, overseer)
+	if err != nil {
+		return err
+	}
+
+	switch key {
+	case "mode":
+		prefs.Mode = value
+	case "digest_interval":
+		prefs.DigestInterval = value
+	case "immediate_severity":
+		prefs.ImmediateSeverity = strings.ToLower(value)
+	case "channels":
+		prefs.Channels = nil
+		for _, c := range strings.Split(value, ",") {
+			if c = strings.TrimSpace(c); c != "" {
+				prefs.Channels = append(prefs.Channels, c)
+			}
+		}
+	case "slack_channel":
+		prefs.SlackChannel = value
+	case "push_url":
+		prefs.PushURL = value
+	default:
+		event, ok := strings.CutPrefix(key, "event.")
+		if !ok {
+			return fmt.Errorf("unknown preference %q (see 'gt config notifications --help')", key)
+		}
+		on, err := parseOnOff(value)
+		if err != nil {
+			return err
+		}
+		if prefs.Events == nil {
+			prefs.Events = make(map[string]bool)
+		}
+		prefs.Events[event] = on
+	}
+
+	if err := configbeads.SaveNotificationPrefs(bd, townName, overseer, prefs); err != nil {
+		return err
+	}
+	fmt.Printf("%s %s = %s\n", style.Success.Render("✓"), key, value)
+	return nil
+}
+
+// parseOnOff parses an on/off preference value.
+func parseOnOff(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "on", "yes":
+		return true, nil
+	case "off", "no":
+		return false, nil
+	}
+	on, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid value %q: must be on or off", value)
+	}
+	return on, nil
+}
+
+func runConfigNotificationsDigest(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	d := notify.NewDispatcher(townRoot)
+
+	if configNotificationsFlush {
+		n, delivery, err := d.FlushDigest(true)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			fmt.Println("No notifications waiting for the digest")
+			return nil
+		}
+		for _, e := range delivery.Errors {
+			style.PrintWarning("%s", e)
+		}
+		fmt.Printf("%s Sent digest of %d notification(s) to %s\n",
+			style.Success.Render("✓"), n, strings.Join(delivery.Notified, ", "))
+		return nil
+	}
+
+	pending, err := d.Pending()
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		fmt.Println("No notifications waiting for the digest")
+		return nil
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tSEVERITY\tEVENT\tTITLE")
+	for _, n := range pending {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", n.At.Local().Format("15:04"), n.Severity, n.Event, n.Title)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/configbeads"
+	"github.com/steveyegge/gastown/internal/eventbus"
+	"github.com/steveyegge/gastown/internal/settings"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var configSettingsJSON bool
+
+var configSettingsCmd = &cobra.Command{
+	Use:   "settings",
+	Short: "Manage operational settings that apply without restarts",
+	Long: `Manage the town's operational settings: timeouts, pool sizes and sling
+policies that running processes read while they work.
+
+Settings are stored in a settings config bead (hq-cfg-settings-<town>) and
+cached in .runtime/settings.json. A running daemon notices changes made here
+within seconds, and changes made from other hosts within a minute, without
+a restart.
+
+Examples:
+  gt config settings list
+  gt config settings get warm_pool.interval
+  gt config settings set warm_pool.max_size 4
+  gt config settings unset warm_pool.max_size`,
+	RunE: requireSubcommand,
+}
+
+var configSettingsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List every setting with its value and default",
+	Args:  cobra.NoArgs,
+	RunE:  runConfigSettingsList,
+}
+
+var configSettingsGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Show a setting's effective value",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConfigSettingsGet,
+}
+
+var configSettingsSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Change a setting",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runConfigSettingsSet,
+}
+
+var configSettingsUnsetCmd = &cobra.Command{
+	Use:   "unset <key>",
+	Short: "Return a setting to its default",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConfigSettingsUnset,
+}
+
+func init() {
+	configSettingsListCmd.Flags().BoolVar(&configSettingsJSON, "json", false, "Output as JSON")
+
+	configSettingsCmd.AddCommand(configSettingsListCmd)
+	configSettingsCmd.AddCommand(configSettingsGetCmd)
+	configSettingsCmd.AddCommand(configSettingsSetCmd)
+	configSettingsCmd.AddCommand(configSettingsUnsetCmd)
+	configCmd.AddCommand(configSettingsCmd)
+}
+
+// settingsEntry is one row of gt config settings list.
+type settingsEntry struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Default     string `json:"default"`
+	Set         bool   `json:"set"`
+	Description string `json:"description"`
+}
+
+func runConfigSettingsList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	store := settings.NewStore(townRoot, settings.BeadsLoader(townRoot), nil)
+	if _, err := store.Reload(); err != nil {
+		fmt.Fprintf(os.Stderr, "%s could not read settings beads, showing cached values: %v\n", style.Warning.Render("⚠"), err)
+	}
+
+	explicit := store.Values()
+	var entries []settingsEntry
+	for _, k := range config.Knobs() {
+		_, set := explicit[k.Key]
+		entries = append(entries, settingsEntry{
+			Key:         k.Key,
+			Value:       store.Get(k.Key),
+			Default:     k.Default,
+			Set:         set,
+			Description: k.Doc,
+		})
+	}
+
+	if configSettingsJSON {
+		return outputJSON(entries)
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tVALUE\tDESCRIPTION")
+	for _, e := range entries {
+		value := e.Value
+		if !e.Set {
+			value = style.Dim.Render(value + " (default)")
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", e.Key, value, e.Description)
+	}
+	return tw.Flush()
+}
+
+func runConfigSettingsGet(cmd *cobra.Command, args []string) error {
+	if _, ok := config.LookupKnob(args[0]); !ok {
+		return fmt.Errorf("unknown setting %q (see 'gt config settings list')", args[0])
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	fmt.Println(settings.Current(townRoot).Get(args[0]))
+	return nil
+}
+
+func runConfigSettingsSet(cmd *cobra.Command, args []string) error {
+	key, value := args[0], args[1]
+	if err := config.ValidateKnob(key, value); err != nil {
+		return err
+	}
+	return updateSetting(key, value, func(bd *beads.Beads, townName string) error {
+		return configbeads.SetKnob(bd, townName, key, value)
+	})
+}
+
+func runConfigSettingsUnset(cmd *cobra.Command, args []string) error {
+	key := args[0]
+	if _, ok := config.LookupKnob(key); !ok {
+		return fmt.Errorf("unknown setting %q (see 'gt config settings list')", key)
+	}
+	return updateSetting(key, "", func(bd *beads.Beads, townName string) error {
+		return configbeads.UnsetKnob(bd, townName, key)
+	})
+}
+
+// updateSetting applies a change to the town's settings bead, then refreshes
+// the settings cache so the local daemon sees it on its next poll.
+func updateSetting(key, value string, update func(bd *beads.Beads, townName string) error) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	townName, err := workspace.GetTownName(townRoot)
+	if err != nil {
+		return err
+	}
+	if err := update(beads.New(townRoot), townName); err != nil {
+		return fmt.Errorf("updating settings bead: %w", err)
+	}
+
+	store := settings.NewStore(townRoot, settings.BeadsLoader(townRoot), nil)
+	if _, err := store.Reload(); err != nil {
+		return fmt.Errorf("refreshing settings cache: %w", err)
+	}
+	_ = eventbus.Publish(detectActor(), eventbus.SettingsChanged{Key: key, Value: value, Scope: townName})
+
+	if value == "" {
+		fmt.Printf("%s %s reset to default (%s)\n", style.Success.Render("✓"), key, store.Get(key))
+	} else {
+		fmt.Printf("%s %s = %s\n", style.Success.Render("✓"), key, value)
+	}
+	return nil
+}
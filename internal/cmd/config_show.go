@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	configShowJSON     bool
+	configShowSet      []string
+	configValidateJSON bool
+)
+
+var configShowCmd = &cobra.Command{
+	Use:   "show [key...]",
+	Short: "Show effective town settings and where each comes from",
+	Long: `Show the town's effective settings after layering, and the layer each
+value came from. Later layers override earlier ones:
+
+  default   built into gt
+  file      mayor/town.json, mayor/config.json, mayor/accounts.json,
+            settings/config.json
+  env       environment variables (GT_THEME, GT_ACCOUNT)
+  flag      --set key=value
+
+Use --set to preview an override without changing any file.
+
+Examples:
+  gt config show
+  gt config show deacon.gc_retention cli_theme
+  gt config show --set cli_theme=light --json`,
+	RunE: runConfigShow,
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check town and rig config files for errors",
+	Long: `Validate the town's configuration: every town config file, the rigs
+registry, and each local rig's config.json and settings/config.json, plus
+the effective value of every setting (see 'gt config show').
+
+Errors name the file and, for malformed JSON, the line. Unknown fields are
+reported as warnings, since gt ignores them and they are usually typos.
+Exits non-zero if there are errors.
+
+Examples:
+  gt config validate
+  GT_THEME=solarized gt config validate`,
+	RunE:         runConfigValidate,
+	SilenceUsage: true, // errors are configuration problems, not usage mistakes
+}
+
+func init() {
+	configShowCmd.Flags().BoolVar(&configShowJSON, "json", false, "Output as JSON")
+	configShowCmd.Flags().StringArrayVar(&configShowSet, "set", nil, "Override a setting (key=value, repeatable)")
+	configValidateCmd.Flags().BoolVar(&configValidateJSON, "json", false, "Output as JSON")
+	configValidateCmd.Flags().StringArrayVar(&configShowSet, "set", nil, "Override a setting (key=value, repeatable)")
+
+	configCmd.AddCommand(configShowCmd)
+	configCmd.AddCommand(configValidateCmd)
+}
+
+// loadLayeredConfig loads the town's layered config with --set overrides.
+func loadLayeredConfig() (*config.Layered, error) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return nil, fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	flags := make(map[string]string, len(configShowSet))
+	for _, kv := range configShowSet {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --set %q: expected key=value", kv)
+		}
+		flags[key] = value
+	}
+	return config.LoadLayered(townRoot, config.LayerOptions{Flags: flags}), nil
+}
+
+func runConfigShow(cmd *cobra.Command, args []string) error {
+	l, err := loadLayeredConfig()
+	if err != nil {
+		return err
+	}
+
+	settings := l.Settings()
+	if len(args) > 0 {
+		settings = settings[:0]
+		for _, key := range args {
+			s, ok := l.Setting(key)
+			if !ok {
+				return fmt.Errorf("unknown setting %q (known: %s)", key, strings.Join(config.SettingKeys(), ", "))
+			}
+			settings = append(settings, s)
+		}
+	}
+
+	if configShowJSON {
+		return outputJSON(settings)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tVALUE\tSOURCE")
+	for _, s := range settings {
+		source := string(s.Source)
+		if s.Origin != "" {
+			source += " (" + s.Origin + ")"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", s.Key, s.Value, source)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if n := len(l.Problems); n > 0 {
+		fmt.Printf("\n%s %d problem(s) found; run 'gt config validate' for details\n", style.Warning.Render("⚠"), n)
+	}
+	return nil
+}
+
+func runConfigValidate(cmd *cobra.Command, args []string) error {
+	l, err := loadLayeredConfig()
+	if err != nil {
+		return err
+	}
+
+	if configValidateJSON {
+		problems := l.Problems
+		if problems == nil {
+			problems = []config.Problem{}
+		}
+		if err := outputJSON(problems); err != nil {
+			return err
+		}
+	} else {
+		for _, p := range l.Problems {
+			if p.Warning {
+				fmt.Printf("%s %s\n", style.Warning.Render("⚠"), p.Error())
+			} else {
+				fmt.Printf("%s %s\n", style.Error.Render("✗"), p.Error())
+			}
+		}
+	}
+
+	if l.Err() != nil {
+		n := 0
+		for _, p := range l.Problems {
+			if !p.Warning {
+				n++
+			}
+		}
+		return fmt.Errorf("configuration has %d error(s)", n)
+	}
+	if !configValidateJSON {
+		fmt.Printf("%s Configuration is valid\n", style.Success.Render("✓"))
+	}
+	return nil
+}
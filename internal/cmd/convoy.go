@@ -170,8 +170,23 @@ Examples:
   gt convoy create "Release prep" gt-abc --notify ops/      # notify ops/
   gt convoy create "Feature rollout" gt-a gt-b --owner mayor/ --notify ops/
   gt convoy create "Feature rollout" gt-a gt-b gt-c --molecule mol-release
-  gt convoy create "Quick fix" gt-xyz --owned --merge=direct  # caller-owned, direct push`,
-	Args: cobra.MinimumNArgs(1),
+  gt convoy create "Quick fix" gt-xyz --owned --merge=direct  # caller-owned, direct push
+
+TEMPLATES (--from-template):
+  A convoy-template formula describes a reusable multi-bead work plan. Each
+  [[beads]] entry becomes a bead tracked by the new convoy, with its needs
+  wired as dependencies and its labels and default assignee applied. Titles,
+  descriptions, labels and assignees may reference {{var}} placeholders.
+  The convoy name defaults to the template's description.
+
+  gt convoy create --from-template release --var version=2.1
+  gt convoy create "Onboard acme" --from-template onboarding --rig gastown`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 && convoyFromTemplate == "" {
+			return fmt.Errorf("requires a convoy name or --from-template")
+		}
+		return nil
+	},
 	RunE: runConvoyCreate,
 }
 
@@ -316,6 +331,9 @@ func init() {
 	convoyCreateCmd.Flags().BoolVar(&convoyOwned, "owned", false, "Caller-owned convoy (caller manages lifecycle, no witness/refinery)")
 	convoyCreateCmd.Flags().StringVar(&convoyMergeStrategy, "merge", "", "Merge strategy for tracked work: direct (push to main), mr (refinery), local (merge locally)")
 	convoyCreateCmd.Flags().Lookup("merge").NoOptDefVal = "mr"
+	convoyCreateCmd.Flags().StringVar(&convoyFromTemplate, "from-template", "", "Expand a convoy-template formula into tracked beads")
+	convoyCreateCmd.Flags().StringArrayVar(&convoyTemplateVars, "var", nil, "Template variable (key=value, repeatable)")
+	convoyCreateCmd.Flags().StringVar(&convoyTemplateRig, "rig", "", "Rig to create template beads in (default: town beads)")
 
 	// Status flags
 	convoyStatusCmd.Flags().BoolVar(&convoyStatusJSON, "json", false, "Output as JSON")
@@ -367,12 +385,16 @@ func getTownBeadsDir() (string, error) {
 }
 
 func runConvoyCreate(cmd *cobra.Command, args []string) error {
-	name := args[0]
-	trackedIssues := args[1:]
+	var name string
+	var trackedIssues []string
+	if len(args) > 0 {
+		name = args[0]
+		trackedIssues = args[1:]
+	}
 
 	// If first arg looks like an issue ID (has beads prefix), treat all args as issues
 	// and auto-generate a name from the first issue's title
-	if looksLikeIssueID(name) {
+	if name != "" && looksLikeIssueID(name) {
 		trackedIssues = args // All args are issue IDs
 		// Get the first issue's title to use as convoy name
 		if details := getIssueDetails(args[0]); details != nil && details.Title != "" {
@@ -393,6 +415,19 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("ensuring custom types: %w", err)
 	}
 
+	// Expand the template first so the convoy tracks the beads it created
+	if convoyFromTemplate != "" {
+		fmt.Printf("%s Expanding template %s...\n", style.Bold.Render("📋"), convoyFromTemplate)
+		expanded, err := expandConvoyTemplate(filepath.Dir(townBeads), convoyFromTemplate, convoyTemplateRig, convoyTemplateVars)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			name = expanded.Name
+		}
+		trackedIssues = append(trackedIssues, expanded.IssueIDs...)
+	}
+
 	// Create convoy issue in town beads
 	description := fmt.Sprintf("Convoy tracking %d issues", len(trackedIssues))
 
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/formula"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Convoy template flags
+var (
+	convoyFromTemplate string
+	convoyTemplateVars []string
+	convoyTemplateRig  string
+)
+
+// expandedConvoyTemplate is the result of expanding a convoy template.
+type expandedConvoyTemplate struct {
+	Name     string   // default convoy name
+	IssueIDs []string // created beads, in dependency order
+}
+
+// loadConvoyTemplate finds and parses a convoy-template formula by name.
+func loadConvoyTemplate(name string) (*formula.Formula, error) {
+	path, err := findFormulaFile(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := formula.ParseFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template %s: %w", name, err)
+	}
+	if f.Type != formula.TypeConvoyTemplate {
+		return nil, fmt.Errorf("formula %s is a %s formula, not a convoy-template", name, f.Type)
+	}
+	return f, nil
+}
+
+// expandConvoyTemplate creates one bead per template entry, in dependency
+// order, wiring each entry's needs as blocking dependencies. Beads are
+// created in the rig's beads when rig is set, otherwise in town beads.
+func expandConvoyTemplate(townRoot, templateName, rig string, varFlags []string) (*expandedConvoyTemplate, error) {
+	f, err := loadConvoyTemplate(templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	provided := parseFormulaVars(varFlags)
+	if _, ok := provided["rig"]; !ok && rig != "" {
+		provided["rig"] = rig
+	}
+	vars, err := f.ResolveVars(provided)
+	if err != nil {
+		return nil, fmt.Errorf("template %s: %w", templateName, err)
+	}
+
+	order, err := f.TopologicalSort()
+	if err != nil {
+		return nil, fmt.Errorf("template %s: %w", templateName, err)
+	}
+
+	beadsDir := filepath.Join(townRoot, ".beads")
+	if rig != "" {
+		beadsDir = beads.ResolveBeadsDir(filepath.Join(townRoot, rig))
+	}
+	b := beads.New(beadsDir)
+
+	expanded := &expandedConvoyTemplate{Name: f.Name}
+	if f.Description != "" {
+		expanded.Name = strings.TrimSpace(formula.SubstituteVars(f.Description, vars))
+	}
+
+	created := make(map[string]string, len(order)) // template ID -> bead ID
+	for _, id := range order {
+		tb := f.GetBead(id)
+		issue, err := createConvoyTemplateBead(b, tb, vars)
+		if err != nil {
+			return nil, fmt.Errorf("creating %s from template %s: %w", id, templateName, err)
+		}
+		created[id] = issue.ID
+		expanded.IssueIDs = append(expanded.IssueIDs, issue.ID)
+
+		for _, need := range tb.Needs {
+			if err := b.AddDependency(issue.ID, created[need]); err != nil {
+				style.PrintWarning("couldn't add dependency %s -> %s: %v", issue.ID, created[need], err)
+			}
+		}
+		fmt.Printf("  %s %s: %s\n", style.Dim.Render("+"), issue.ID, issue.Title)
+	}
+
+	return expanded, nil
+}
+
+// createConvoyTemplateBead creates a single bead from a template entry and
+// applies its labels and default assignee.
+func createConvoyTemplateBead(b *beads.Beads, tb *formula.TemplateBead, vars map[string]string) (*beads.Issue, error) {
+	opts := beads.CreateOptions{
+		Title:       formula.SubstituteVars(tb.Title, vars),
+		Type:        tb.Type,
+		Priority:    -1,
+		Description: formula.SubstituteVars(tb.Description, vars),
+	}
+	if opts.Type == "" {
+		opts.Type = "task"
+	}
+	if tb.Priority != nil {
+		opts.Priority = *tb.Priority
+	}
+
+	issue, err := b.Create(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var update beads.UpdateOptions
+	for _, label := range tb.Labels {
+		update.AddLabels = append(update.AddLabels, formula.SubstituteVars(label, vars))
+	}
+	if tb.Assignee != "" {
+		assignee := formula.SubstituteVars(tb.Assignee, vars)
+		update.Assignee = &assignee
+	}
+	if len(update.AddLabels) > 0 || update.Assignee != nil {
+		if err := b.Update(issue.ID, update); err != nil {
+			style.PrintWarning("couldn't set labels/assignee on %s: %v", issue.ID, err)
+		}
+	}
+	return issue, nil
+}
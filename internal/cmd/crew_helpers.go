@@ -16,6 +16,7 @@ import (
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/crew"
 	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mtls"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/rpcclient"
 	"github.com/steveyegge/gastown/internal/style"
@@ -103,7 +104,22 @@ func newDaemonRPCClient() *rpcclient.Client {
 	if townName != "" {
 		opts = append(opts, rpcclient.WithTownName(townName))
 	}
-	return rpcclient.NewClient(baseURL, opts...)
+	return rpcclient.NewClient(baseURL, daemonTLSOptions(opts)...)
+}
+
+// daemonTLSOptions appends the client certificate from GT_TLS_DIR (or
+// GT_TLS_CERT/GT_TLS_KEY/GT_TLS_CA) so daemons requiring mutual TLS accept
+// the connection.
+func daemonTLSOptions(opts []rpcclient.Option) []rpcclient.Option {
+	tlsCfg, err := mtls.ClientConfigFromEnv()
+	if err != nil {
+		style.PrintWarning("ignoring daemon TLS config: %v", err)
+		return opts
+	}
+	if tlsCfg != nil {
+		opts = append(opts, rpcclient.WithTLSConfig(tlsCfg))
+	}
+	return opts
 }
 
 // newConnectedDaemonClient creates an RPC client from either BD_DAEMON_HOST
@@ -128,7 +144,7 @@ func newConnectedDaemonClient() *rpcclient.Client {
 	if cfg.TownName != "" {
 		opts = append(opts, rpcclient.WithTownName(cfg.TownName))
 	}
-	return rpcclient.NewClient(cfg.DaemonHost, opts...)
+	return rpcclient.NewClient(cfg.DaemonHost, daemonTLSOptions(opts)...)
 }
 
 // crewSessionName generates the session name for a crew worker.
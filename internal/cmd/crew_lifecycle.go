@@ -17,6 +17,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/crew"
+	"github.com/steveyegge/gastown/internal/eventbus"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/rpcclient"
 	"github.com/steveyegge/gastown/internal/runtime"
@@ -798,6 +799,11 @@ func runCrewStop(cmd *cobra.Command, args []string) error {
 			logger := townlog.NewLogger(townRoot)
 			_ = logger.Log(townlog.EventKill, agent, "gt crew stop")
 		}
+		_ = eventbus.Publish(detectActor(), eventbus.Kill{
+			Rig:    r.Name,
+			Target: fmt.Sprintf("%s/crew/%s", r.Name, name),
+			Reason: "gt crew stop",
+		})
 
 		// Log captured output (truncated)
 		if len(output) > 200 {
@@ -946,6 +952,11 @@ func runCrewStopAll() error {
 			logger := townlog.NewLogger(townRoot)
 			_ = logger.Log(townlog.EventKill, agentName, "gt crew stop --all")
 		}
+		_ = eventbus.Publish(detectActor(), eventbus.Kill{
+			Rig:    agent.Rig,
+			Target: agentName,
+			Reason: "gt crew stop --all",
+		})
 
 		// Log captured output (truncated)
 		if len(output) > 200 {
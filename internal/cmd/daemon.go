@@ -33,7 +33,12 @@ var daemonStartCmd = &cobra.Command{
 	Short: "Start the daemon",
 	Long: `Start the Gas Town daemon in the background.
 
-The daemon will run until stopped with 'gt daemon stop'.`,
+The daemon will run until stopped with 'gt daemon stop'.
+
+Use --metrics-addr to serve Prometheus metrics (bd latencies, hook
+durations, mail throughput, heartbeat timings) on /metrics:
+
+  gt daemon start --metrics-addr :9102`,
 	RunE: runDaemonStart,
 }
 
@@ -66,8 +71,9 @@ var daemonRunCmd = &cobra.Command{
 }
 
 var (
-	daemonLogLines int
-	daemonLogFollow bool
+	daemonLogLines    int
+	daemonLogFollow   bool
+	daemonMetricsAddr string
 )
 
 func init() {
@@ -79,6 +85,8 @@ func init() {
 
 	daemonLogsCmd.Flags().IntVarP(&daemonLogLines, "lines", "n", 50, "Number of lines to show")
 	daemonLogsCmd.Flags().BoolVarP(&daemonLogFollow, "follow", "f", false, "Follow log output")
+	daemonStartCmd.Flags().StringVar(&daemonMetricsAddr, "metrics-addr", "", "Serve Prometheus /metrics on this address (e.g. :9102)")
+	daemonRunCmd.Flags().StringVar(&daemonMetricsAddr, "metrics-addr", "", "Serve Prometheus /metrics on this address")
 
 	rootCmd.AddCommand(daemonCmd)
 }
@@ -105,7 +113,11 @@ func runDaemonStart(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("finding executable: %w", err)
 	}
 
-	daemonCmd := exec.Command(gtPath, "daemon", "run")
+	runArgs := []string{"daemon", "run"}
+	if daemonMetricsAddr != "" {
+		runArgs = append(runArgs, "--metrics-addr", daemonMetricsAddr)
+	}
+	daemonCmd := exec.Command(gtPath, runArgs...)
 	daemonCmd.Dir = townRoot
 
 	// Detach from terminal
@@ -258,6 +270,7 @@ func runDaemonRun(cmd *cobra.Command, args []string) error {
 	}
 
 	config := daemon.DefaultConfig(townRoot)
+	config.MetricsAddr = daemonMetricsAddr
 	d, err := daemon.New(config)
 	if err != nil {
 		return fmt.Errorf("creating daemon: %w", err)
@@ -13,8 +13,9 @@ import (
 )
 
 var (
-	dashboardPort int
-	dashboardOpen bool
+	dashboardPort            int
+	dashboardOpen            bool
+	dashboardHistoryInterval time.Duration
 )
 
 var dashboardCmd = &cobra.Command{
@@ -29,6 +30,11 @@ The dashboard shows real-time convoy status with:
 - Last activity indicator (green/yellow/red)
 - Auto-refresh every 30 seconds via htmx
 
+Convoy progress is also snapshotted every --history-interval into
+.runtime/convoy-history.jsonl, which is rotated to convoy-history.jsonl.1 at
+4 MiB; GET /api/convoys/burndown?id=<convoy>&days=14 returns the series for
+burndown charts.
+
 Example:
   gt dashboard              # Start on default port 8080
   gt dashboard --port 3000  # Start on port 3000
@@ -39,6 +45,7 @@ Example:
 func init() {
 	dashboardCmd.Flags().IntVar(&dashboardPort, "port", 8080, "HTTP port to listen on")
 	dashboardCmd.Flags().BoolVar(&dashboardOpen, "open", false, "Open browser automatically")
+	dashboardCmd.Flags().DurationVar(&dashboardHistoryInterval, "history-interval", web.DefaultHistoryInterval, "How often to snapshot convoy progress for burndown charts")
 	rootCmd.AddCommand(dashboardCmd)
 }
 
@@ -57,6 +64,9 @@ func runDashboard(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("creating dashboard handler: %w", err)
 	}
 
+	// Snapshot convoy progress in the background for burndown charts.
+	go web.RunConvoyHistoryRecorder(cmd.Context(), fetcher, fetcher.History(), dashboardHistoryInterval)
+
 	// Build the URL
 	url := fmt.Sprintf("http://localhost:%d", dashboardPort)
 
@@ -15,9 +15,13 @@ var (
 	decisionPredecessor        string   // Predecessor decision for chaining
 	decisionType               string   // Decision type for validation
 	decisionUrgency            string
+	decisionDeadline           string   // Deadline duration (e.g., "2h")
+	decisionDefault            int      // Option chosen when the deadline passes
 	decisionJSON               bool
+	decisionTown               string // Peer town to create the decision in
 	decisionListJSON           bool
 	decisionListAll            bool
+	decisionListDelegated      bool // Include decisions delegated by policy
 	decisionChoice             int
 	decisionRationale          string
 	decisionAwaitTimeout       string   // For await command
@@ -78,6 +82,9 @@ FLAGS:
   --parent        Parent bead for hierarchy
   --predecessor   ID of predecessor decision (for chaining)
   --urgency       Priority level: high, medium, low (default: medium)
+  --deadline      Auto-resolve after this long without a response (e.g., 2h)
+  --default       Option N to choose at the deadline (default: --recommend)
+  --town          Create the decision in a federated town instead of this one
 
 CONTEXT FORMAT:
   Context must be valid JSON. Good context helps humans make informed decisions
@@ -104,6 +111,24 @@ CONTEXT FORMAT:
     --context '{"status": "need decision"}'  # Too vague
     --context '{}'                            # Empty/useless
 
+DELEGATION:
+  Policies in settings/decision-policy.json can hand low-stakes decisions
+  to an agent instead of the overseer, e.g. letting the rig's witness
+  resolve low-urgency questions from polecats:
+
+    {"type": "decision-policy", "version": 1, "policies": [
+      {"name": "polecat-low", "roles": ["polecat"], "max_urgency": "low",
+       "delegate": "witness"}
+    ]}
+
+  Only the delegate (or a human) can resolve a delegated decision, and the
+  authorizing policy is recorded on the decision when it does.
+
+DEADLINES:
+  With --deadline, the daemon resolves the decision once the deadline passes,
+  choosing the --default option or, failing that, the recommended one. A
+  decision with neither is escalated instead so a human still answers it.
+
 DECISION CHAINING:
   Use --predecessor to link decisions in a chain:
     --predecessor hq-dec-abc123
@@ -133,7 +158,15 @@ Examples:
     --option "Add retry logic" \
     --option "Fix underlying bug" \
     --predecessor hq-dec-abc123 \
-    --context '{"diagnosis": "rate limiting"}'`,
+    --context '{"diagnosis": "rate limiting"}'
+
+  # Proceed with the recommendation if nobody answers within 2 hours
+  gt decision request \
+    --prompt "Upgrade the base image?" \
+    --option "Upgrade now" \
+    --option "Wait for next release" \
+    --recommend 2 \
+    --deadline 2h`,
 	RunE: runDecisionRequest,
 }
 
@@ -145,9 +178,14 @@ var decisionListCmd = &cobra.Command{
 Shows decisions that haven't been resolved yet. Use --all to include
 resolved decisions.
 
+Decisions delegated to an agent by a policy in settings/decision-policy.json
+are hidden from everyone but the delegate; use --include-delegated to see
+them.
+
 Examples:
-  gt decision list              # Pending only
-  gt decision list --all        # Include resolved
+  gt decision list                      # Pending only
+  gt decision list --all                # Include resolved
+  gt decision list --include-delegated  # Include agent-delegated decisions
   gt decision list --json       # JSON output`,
 	RunE: runDecisionList,
 }
@@ -437,11 +475,14 @@ func init() {
 	decisionRequestCmd.Flags().StringVar(&decisionParent, "parent", "", "Parent bead for hierarchy")
 	decisionRequestCmd.Flags().StringVar(&decisionPredecessor, "predecessor", "", "Predecessor decision ID for chaining")
 	decisionRequestCmd.Flags().StringVarP(&decisionUrgency, "urgency", "u", "medium", "Urgency level: high, medium, low")
+	decisionRequestCmd.Flags().StringVar(&decisionDeadline, "deadline", "", "Auto-resolve after this duration without a response (e.g., '30m', '2h')")
+	decisionRequestCmd.Flags().IntVar(&decisionDefault, "default", 0, "Option N to choose when the deadline passes (default: recommended option)")
 	decisionRequestCmd.Flags().BoolVar(&decisionJSON, "json", false, "Output as JSON")
 	decisionRequestCmd.Flags().BoolVar(&decisionNoFileCheck, "no-file-check", false, "Skip FILE option validation for failure contexts")
 	decisionRequestCmd.Flags().BoolVar(&decisionNoBeadCheck, "no-bead-check", false, "Skip validation of referenced bead descriptions in context")
 	decisionRequestCmd.Flags().BoolVar(&decisionIgnoreSuggestedType, "ignore-suggested-type", false, "Override predecessor's suggested successor type")
 	decisionRequestCmd.Flags().BoolVar(&decisionAutoContext, "auto-context", false, "Auto-fetch descriptions for referenced beads")
+	decisionRequestCmd.Flags().StringVar(&decisionTown, "town", "", "Create the decision in a federated town (see gt federation)")
 	decisionRequestCmd.Flags().StringVar(&decisionType, "type", "", "Decision type (validated by create-decision-type-{name} script if present)")
 
 	// Aliases for backward compatibility
@@ -453,6 +494,7 @@ func init() {
 	// List subcommand flags
 	decisionListCmd.Flags().BoolVar(&decisionListJSON, "json", false, "Output as JSON")
 	decisionListCmd.Flags().BoolVar(&decisionListAll, "all", false, "Include resolved decisions")
+	decisionListCmd.Flags().BoolVar(&decisionListDelegated, "include-delegated", false, "Include decisions delegated to agents by policy")
 
 	// Show subcommand flags
 	decisionShowCmd.Flags().BoolVar(&decisionJSON, "json", false, "Output as JSON")
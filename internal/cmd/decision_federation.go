@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/federation"
+	"github.com/steveyegge/gastown/internal/rpcclient"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// requestFederatedDecision creates the decision described by the request
+// flags in the peer town named by --town. The requester is qualified with
+// this town's name so the resolver knows who is waiting on it.
+func requestFederatedDecision(townRoot, agentID, urgency string) error {
+	reg, err := federation.Load(townRoot)
+	if err != nil {
+		return err
+	}
+	town, err := reg.Get(decisionTown)
+	if err != nil {
+		return err
+	}
+	localTown := localTownName(townRoot)
+
+	req := rpcclient.CreateDecisionRequest{
+		Question:      decisionPrompt,
+		Context:       decisionContext,
+		RequestedBy:   federation.Qualify(localTown, agentID),
+		Urgency:       urgency,
+		ParentBead:    decisionParent,
+		PredecessorID: decisionPredecessor,
+	}
+	if decisionBlocks != "" {
+		req.Blockers = []string{decisionBlocks}
+	}
+	for _, opt := range parseDecisionOptions(decisionOptions, decisionRecommend) {
+		req.Options = append(req.Options, rpcclient.DecisionOption{
+			Label:       opt.Label,
+			Description: opt.Description,
+			Recommended: opt.Recommended,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	d, err := town.Client(localTown).CreateDecision(ctx, req)
+	if err != nil {
+		return fmt.Errorf("creating decision in town %s: %w", town.Name, err)
+	}
+
+	id := town.Name + ":" + d.ID
+	if decisionJSON {
+		out, _ := json.MarshalIndent(map[string]interface{}{"id": id, "town": town.Name, "question": d.Question}, "", "  ")
+		fmt.Println(string(out))
+		return nil
+	}
+	fmt.Printf("%s Created decision %s\n", style.Bold.Render("✓"), id)
+	fmt.Printf("  Question: %s\n", decisionPrompt)
+	fmt.Printf("\nTo check: gt decision show %s\n", id)
+	return nil
+}
+
+// showFederatedDecision prints a decision held by a peer town.
+func showFederatedDecision(remote *federatedTarget) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	d, err := remote.client().GetDecision(ctx, remote.local)
+	if err != nil {
+		return fmt.Errorf("getting decision from town %s: %w", remote.town.Name, err)
+	}
+
+	if decisionJSON {
+		out, _ := json.MarshalIndent(map[string]interface{}{
+			"id":           remote.String(),
+			"question":     d.Question,
+			"context":      d.Context,
+			"options":      d.Options,
+			"chosen_index": d.ChosenIndex,
+			"rationale":    d.Rationale,
+			"urgency":      d.Urgency,
+			"requested_by": d.RequestedBy,
+			"resolved_by":  d.ResolvedBy,
+			"resolved":     d.Resolved,
+		}, "", "  ")
+		fmt.Println(string(out))
+		return nil
+	}
+
+	status := "PENDING"
+	if d.Resolved {
+		status = "RESOLVED"
+	}
+	fmt.Printf("%s Decision: %s [%s]\n\n", urgencyEmoji(d.Urgency), remote, status)
+	fmt.Printf("Question: %s\n\n", d.Question)
+	fmt.Printf("Options:\n")
+	for i, opt := range d.Options {
+		marker := ""
+		if opt.Recommended {
+			marker = " (Recommended)"
+		}
+		if d.ChosenIndex == i+1 {
+			marker += " ✓ CHOSEN"
+		}
+		fmt.Printf("  %d. %s%s\n", i+1, opt.Label, marker)
+		if opt.Description != "" {
+			fmt.Printf("     %s\n", opt.Description)
+		}
+	}
+	fmt.Println()
+	fmt.Printf("Requested by: %s\n", d.RequestedBy)
+	fmt.Printf("Urgency: %s\n", d.Urgency)
+	if d.Resolved && d.Rationale != "" {
+		fmt.Printf("Rationale: %s\n", d.Rationale)
+	}
+	return nil
+}
+
+// resolveFederatedDecision resolves a decision held by a peer town.
+func resolveFederatedDecision(remote *federatedTarget, resolvedBy string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	d, err := remote.client().ResolveDecision(ctx, remote.local, decisionChoice, decisionRationale,
+		federation.Qualify(remote.localTown, resolvedBy))
+	if err != nil {
+		return fmt.Errorf("resolving decision in town %s: %w", remote.town.Name, err)
+	}
+
+	fmt.Printf("%s Resolved %s: chose option %d\n", style.Bold.Render("✓"), remote, d.ChosenIndex)
+	if decisionRationale != "" {
+		fmt.Printf("  Rationale: %s\n", decisionRationale)
+	}
+	return nil
+}
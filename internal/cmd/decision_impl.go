@@ -21,6 +21,7 @@ import (
 	"github.com/steveyegge/gastown/internal/notify"
 
 	"github.com/steveyegge/gastown/internal/runtime"
+	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	decisionTUI "github.com/steveyegge/gastown/internal/tui/decision"
 	"github.com/steveyegge/gastown/internal/util"
@@ -78,6 +79,24 @@ func runDecisionRequest(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("--recommend must be between 1 and %d", len(decisionOptions))
 	}
 
+	// Validate deadline and default option
+	var deadline time.Time
+	if decisionDeadline != "" {
+		d, err := time.ParseDuration(decisionDeadline)
+		if err != nil || d <= 0 {
+			return fmt.Errorf("invalid --deadline %q: must be a positive duration (e.g., '30m', '2h')", decisionDeadline)
+		}
+		deadline = time.Now().Add(d)
+	}
+	if decisionDefault != 0 {
+		if decisionDeadline == "" {
+			return fmt.Errorf("--default requires --deadline")
+		}
+		if decisionDefault < 1 || decisionDefault > len(decisionOptions) {
+			return fmt.Errorf("--default must be between 1 and %d", len(decisionOptions))
+		}
+	}
+
 	// Find workspace
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
@@ -90,6 +109,11 @@ func runDecisionRequest(cmd *cobra.Command, args []string) error {
 		agentID = "unknown"
 	}
 
+	// Decisions for a peer town are created there; local checks don't apply
+	if decisionTown != "" {
+		return requestFederatedDecision(townRoot, agentID, urgency)
+	}
+
 	bd := beads.New(beads.ResolveBeadsDir(townRoot))
 
 	// Validate --parent if specified
@@ -117,26 +141,7 @@ func runDecisionRequest(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Parse options
-	var options []beads.DecisionOption
-	for i, optStr := range decisionOptions {
-		opt := beads.DecisionOption{}
-
-		// Parse "Label: Description" format
-		if colonIdx := strings.Index(optStr, ":"); colonIdx != -1 {
-			opt.Label = strings.TrimSpace(optStr[:colonIdx])
-			opt.Description = strings.TrimSpace(optStr[colonIdx+1:])
-		} else {
-			opt.Label = strings.TrimSpace(optStr)
-		}
-
-		// Mark as recommended if specified
-		if decisionRecommend == i+1 {
-			opt.Recommended = true
-		}
-
-		options = append(options, opt)
-	}
+	options := parseDecisionOptions(decisionOptions, decisionRecommend)
 
 	// Run script-based validators (unless --no-file-check skips all validation)
 	if !decisionNoFileCheck {
@@ -266,17 +271,45 @@ func runDecisionRequest(cmd *cobra.Command, args []string) error {
 	}
 
 	// Verify the decision was persisted (gt-3vqgi4: guard against false success)
-	if _, verifyErr := bd.Show(issue.ID); verifyErr != nil {
+	persisted, verifyErr := bd.Show(issue.ID)
+	if verifyErr != nil {
 		return fmt.Errorf("decision created but not persisted: %s (verify error: %w)", issue.ID, verifyErr)
 	}
 
 	// Note: blocker dependency is now handled by bd decision create --blocks flag
 
-	// Send notification mail to human/overseer
+	// Record the deadline; the daemon applies the default once it passes.
+	// The recommended option is stored as the default because bd decisions
+	// don't keep the recommendation.
+	if !deadline.IsZero() {
+		fields.Deadline = deadline.UTC().Format(time.RFC3339)
+		fields.DefaultOption = decisionDefault
+		if fields.DefaultOption == 0 {
+			fields.DefaultOption = decisionRecommend
+		}
+		if err := bd.SetDecisionDeadline(issue.ID, deadline, fields.DefaultOption); err != nil {
+			style.PrintWarning("failed to set decision deadline: %v", err)
+		}
+	}
+
+	// Hand low-stakes decisions to the agent a delegation policy names,
+	// so the overseer only sees the ones no policy covers.
+	notifyTarget := "overseer"
+	delegation := matchDecisionPolicy(townRoot, agentID, urgency, persisted.Labels)
+	if delegation != nil {
+		if err := bd.SetDecisionDelegate(issue.ID, delegation.Delegate, delegation.Policy); err != nil {
+			style.PrintWarning("failed to delegate decision to %s: %v", delegation.Delegate, err)
+			delegation = nil
+		} else {
+			notifyTarget = delegation.Delegate
+		}
+	}
+
+	// Send notification mail to the overseer (or delegate)
 	router := mail.NewRouter(townRoot)
 	msg := &mail.Message{
 		From:    agentID,
-		To:      "overseer",
+		To:      notifyTarget,
 		Subject: fmt.Sprintf("[DECISION] %s", decisionPrompt),
 		Body:    formatDecisionMailBody(issue.ID, fields),
 		Type:    mail.TypeTask,
@@ -313,6 +346,10 @@ func runDecisionRequest(cmd *cobra.Command, args []string) error {
 	if decisionParent != "" {
 		payload["parent_id"] = decisionParent
 	}
+	if delegation != nil {
+		payload["delegated_to"] = delegation.Delegate
+		payload["policy"] = delegation.Policy
+	}
 	_ = events.LogFeed(events.TypeDecisionRequested, agentID, payload)
 
 	// Emit decision.created event on bd bus (best-effort, enables real-time subscriptions)
@@ -345,6 +382,14 @@ func runDecisionRequest(cmd *cobra.Command, args []string) error {
 		if decisionParent != "" {
 			result["parent_id"] = decisionParent
 		}
+		if fields.Deadline != "" {
+			result["deadline"] = fields.Deadline
+			result["default_option"] = fields.DefaultOption
+		}
+		if delegation != nil {
+			result["delegated_to"] = delegation.Delegate
+			result["policy"] = delegation.Policy
+		}
 		out, _ := json.MarshalIndent(result, "", "  ")
 		fmt.Println(string(out))
 	} else {
@@ -361,7 +406,18 @@ func runDecisionRequest(cmd *cobra.Command, args []string) error {
 		if decisionParent != "" {
 			fmt.Printf("   Parent: %s\n", decisionParent)
 		}
-		fmt.Printf("\n→ Notified human (overseer)\n")
+		if fields.Deadline != "" {
+			if fields.DefaultOption > 0 {
+				fmt.Printf("   Deadline: %s (then option %d)\n", fields.Deadline, fields.DefaultOption)
+			} else {
+				fmt.Printf("   Deadline: %s (then escalate)\n", fields.Deadline)
+			}
+		}
+		if delegation != nil {
+			fmt.Printf("\n→ Delegated to %s (policy %s)\n", delegation.Delegate, delegation.Policy)
+		} else {
+			fmt.Printf("\n→ Notified human (overseer)\n")
+		}
 		fmt.Printf("\nTo resolve: gt decision resolve %s --choice N --rationale \"...\"\n", issue.ID)
 	}
 
@@ -387,6 +443,22 @@ func runDecisionList(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("listing decisions: %w", err)
 	}
 
+	// Decisions delegated by policy are left to their delegate; only the
+	// delegate itself sees them unless asked.
+	var delegated int
+	if !decisionListAll && !decisionListDelegated {
+		viewer := detectSender()
+		var kept []*beads.Issue
+		for _, issue := range issues {
+			if delegate, _ := beads.DecisionDelegate(issue); delegate != "" && !sameAgentAddress(delegate, viewer) {
+				delegated++
+				continue
+			}
+			kept = append(kept, issue)
+		}
+		issues = kept
+	}
+
 	if decisionListJSON {
 		out, _ := json.MarshalIndent(issues, "", "  ")
 		fmt.Println(string(out))
@@ -395,6 +467,9 @@ func runDecisionList(cmd *cobra.Command, args []string) error {
 
 	if len(issues) == 0 {
 		fmt.Println("No pending decisions")
+		if delegated > 0 {
+			fmt.Printf("(%d delegated to agents by policy; use --include-delegated to show)\n", delegated)
+		}
 		return nil
 	}
 
@@ -422,16 +497,50 @@ func runDecisionList(cmd *cobra.Command, args []string) error {
 		if len(fields.Blockers) > 0 {
 			fmt.Printf("     Blocking: %s\n", strings.Join(fields.Blockers, ", "))
 		}
+		if delegate, policy := beads.DecisionDelegate(issue); delegate != "" {
+			fmt.Printf("     Delegated to: %s (policy %s)\n", delegate, policy)
+		}
 		if fields.ChosenIndex > 0 && fields.ChosenIndex <= len(fields.Options) {
 			fmt.Printf("     → Chose: %s\n", fields.Options[fields.ChosenIndex-1].Label)
 		}
 		fmt.Println()
 	}
+	if delegated > 0 {
+		fmt.Printf("(%d more delegated to agents by policy; use --include-delegated to show)\n", delegated)
+	}
 
 	return nil
 }
 
+// parseDecisionOptions parses "Label: Description" option flags, marking
+// the recommended one (1-indexed, 0 for none).
+func parseDecisionOptions(optStrs []string, recommend int) []beads.DecisionOption {
+	var options []beads.DecisionOption
+	for i, optStr := range optStrs {
+		opt := beads.DecisionOption{}
+
+		// Parse "Label: Description" format
+		if colonIdx := strings.Index(optStr, ":"); colonIdx != -1 {
+			opt.Label = strings.TrimSpace(optStr[:colonIdx])
+			opt.Description = strings.TrimSpace(optStr[colonIdx+1:])
+		} else {
+			opt.Label = strings.TrimSpace(optStr)
+		}
+
+		// Mark as recommended if specified
+		if recommend == i+1 {
+			opt.Recommended = true
+		}
+
+		options = append(options, opt)
+	}
+	return options
+}
+
 func runDecisionShow(cmd *cobra.Command, args []string) error {
+	if remote, ok := resolveFederated(args[0]); ok {
+		return showFederatedDecision(remote)
+	}
 	decisionID := util.ResolveSemanticSlug(args[0])
 
 	townRoot, err := workspace.FindFromCwdOrError()
@@ -551,6 +660,10 @@ func runDecisionResolve(cmd *cobra.Command, args []string) error {
 		resolvedBy = "human"
 	}
 
+	if remote, ok := resolveFederated(args[0]); ok {
+		return resolveFederatedDecision(remote, resolvedBy)
+	}
+
 	bd := beads.New(beads.ResolveBeadsDir(townRoot))
 
 	// Get the decision first to validate and get info for notifications
@@ -567,6 +680,18 @@ func runDecisionResolve(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid choice %d: only %d options available", decisionChoice, len(fields.Options))
 	}
 
+	// A delegated decision may be resolved by its delegate or a human, but
+	// not by other agents.
+	delegate, policy := beads.DecisionDelegate(issue)
+	authorizingPolicy := ""
+	if delegate != "" {
+		if sameAgentAddress(resolvedBy, delegate) {
+			authorizingPolicy = policy
+		} else if _, err := session.ParseAddress(resolvedBy); err == nil {
+			return fmt.Errorf("decision %s is delegated to %s (policy %s)", decisionID, delegate, policy)
+		}
+	}
+
 	chosenOption := fields.Options[decisionChoice-1]
 
 	// Use option description as fallback rationale if none provided
@@ -580,6 +705,13 @@ func runDecisionResolve(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("resolving decision: %w", err)
 	}
 
+	// Record the policy that let an agent answer in the overseer's place
+	if authorizingPolicy != "" {
+		if err := bd.MarkDecisionPolicyResolved(decisionID, authorizingPolicy); err != nil {
+			style.PrintWarning("failed to record authorizing policy: %v", err)
+		}
+	}
+
 	// Notify requestor: mail + nudge + unblock + activity log
 	notify.DecisionResolved(townRoot, decisionID, *fields, chosenOption.Label, effectiveRationale, resolvedBy)
 
@@ -600,6 +732,9 @@ func runDecisionResolve(cmd *cobra.Command, args []string) error {
 	if assignedBeadID != "" {
 		busPayload["auto_assigned_bead"] = assignedBeadID
 	}
+	if authorizingPolicy != "" {
+		busPayload["policy"] = authorizingPolicy
+	}
 	emitDecisionBusEvent(events.BusDecisionResponded, busPayload)
 
 	// Output
@@ -615,6 +750,9 @@ func runDecisionResolve(cmd *cobra.Command, args []string) error {
 		if assignedBeadID != "" {
 			result["auto_assigned_bead"] = assignedBeadID
 		}
+		if authorizingPolicy != "" {
+			result["policy"] = authorizingPolicy
+		}
 		out, _ := json.MarshalIndent(result, "", "  ")
 		fmt.Println(string(out))
 	} else {
@@ -622,6 +760,9 @@ func runDecisionResolve(cmd *cobra.Command, args []string) error {
 		if effectiveRationale != "" {
 			fmt.Printf("  Rationale: %s\n", effectiveRationale)
 		}
+		if authorizingPolicy != "" {
+			fmt.Printf("  Authorized by policy: %s\n", authorizingPolicy)
+		}
 		if len(fields.Blockers) > 0 {
 			fmt.Printf("\n→ Unblocked: %s\n", strings.Join(fields.Blockers, ", "))
 		}
@@ -813,6 +954,15 @@ func formatDecisionMailBody(beadID string, fields *beads.DecisionFields) string
 		lines = append(lines, fmt.Sprintf("Blocking: %s", strings.Join(fields.Blockers, ", ")))
 	}
 
+	if fields.Deadline != "" {
+		lines = append(lines, "")
+		if fields.DefaultOption > 0 {
+			lines = append(lines, fmt.Sprintf("Deadline: %s (option %d is chosen if unanswered)", fields.Deadline, fields.DefaultOption))
+		} else {
+			lines = append(lines, fmt.Sprintf("Deadline: %s (escalated if unanswered)", fields.Deadline))
+		}
+	}
+
 	lines = append(lines, "")
 	lines = append(lines, "---")
 	lines = append(lines, fmt.Sprintf("To resolve: gt decision resolve %s --choice N --rationale \"...\"", beadID))
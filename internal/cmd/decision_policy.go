@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// decisionDelegation is a decision handed to an agent by policy.
+type decisionDelegation struct {
+	Policy   string // name of the authorizing policy
+	Delegate string // mail address of the agent allowed to resolve
+}
+
+// matchDecisionPolicy finds the delegation policy covering a decision from
+// requester. Returns nil when the overseer should handle the decision,
+// including when the policy config can't be loaded.
+func matchDecisionPolicy(townRoot, requester, urgency string, labels []string) *decisionDelegation {
+	cfg, err := config.LoadOrCreateDecisionPolicyConfig(config.DecisionPolicyConfigPath(townRoot))
+	if err != nil || len(cfg.Policies) == 0 {
+		return nil
+	}
+	id, err := session.ParseAddress(requester)
+	if err != nil {
+		return nil // only agent requests are delegated
+	}
+
+	policy := cfg.MatchDecision(id.Rig, string(id.Role), urgency, labels)
+	if policy == nil {
+		return nil
+	}
+	delegate := delegateAddress(policy.Delegate, id.Rig)
+	if delegate == "" || sameAgentAddress(delegate, requester) {
+		return nil // nobody to delegate to, or the requester would answer itself
+	}
+	return &decisionDelegation{Policy: policy.Name, Delegate: delegate}
+}
+
+// delegateAddress returns the mail address of a delegate role for a
+// decision from rig. Rig roles need the requester to belong to a rig.
+func delegateAddress(role, rig string) string {
+	switch role {
+	case "mayor", "deacon":
+		return role + "/"
+	case "witness", "refinery":
+		if rig == "" {
+			return ""
+		}
+		return rig + "/" + role
+	}
+	return ""
+}
+
+// sameAgentAddress compares agent addresses, ignoring a trailing slash.
+func sameAgentAddress(a, b string) bool {
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
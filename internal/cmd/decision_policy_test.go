@@ -0,0 +1,29 @@
+package cmd
+
+import "testing"
+
+func TestDelegateAddress(t *testing.T) {
+	tests := []struct {
+		role, rig string
+		want      string
+	}{
+		{"witness", "gastown", "gastown/witness"},
+		{"refinery", "beads", "beads/refinery"},
+		{"witness", "", ""}, // town-level requester has no witness
+		{"mayor", "gastown", "mayor/"},
+		{"deacon", "", "deacon/"},
+		{"polecat", "gastown", ""},
+	}
+
+	for _, tt := range tests {
+		if got := delegateAddress(tt.role, tt.rig); got != tt.want {
+			t.Errorf("delegateAddress(%q, %q) = %q, want %q", tt.role, tt.rig, got, tt.want)
+		}
+	}
+}
+
+func TestMatchDecisionPolicyWithoutConfig(t *testing.T) {
+	if got := matchDecisionPolicy(t.TempDir(), "gastown/polecats/nux", "low", nil); got != nil {
+		t.Errorf("matchDecisionPolicy() = %+v, want nil without a policy file", got)
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -9,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/advice"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/eventbus"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mail"
@@ -17,7 +19,9 @@ import (
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/terminal"
 	"github.com/steveyegge/gastown/internal/townlog"
+	"github.com/steveyegge/gastown/internal/tracing"
 	"github.com/steveyegge/gastown/internal/workspace"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var doneCmd = &cobra.Command{
@@ -81,7 +85,7 @@ func init() {
 	rootCmd.AddCommand(doneCmd)
 }
 
-func runDone(cmd *cobra.Command, args []string) error {
+func runDone(cmd *cobra.Command, args []string) (err error) {
 	// Guard: Only polecats should call gt done
 	// Crew, deacons, witnesses etc. don't use gt done - they persist across tasks.
 	// Polecats are ephemeral workers that self-destruct after completing work.
@@ -235,6 +239,11 @@ func runDone(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Completion joins the issue's trace, after its sling and spawn spans
+	traceCtx, span := tracing.Start(context.Background(), issueID, "done",
+		tracing.AttrAgent.String(sender), attribute.String("gt.exit_type", exitType))
+	defer func() { tracing.End(span, err) }()
+
 	// Get configured default branch for this rig
 	defaultBranch := "main" // fallback
 	if rigCfg, err := rig.LoadRigConfig(filepath.Join(townRoot, rigName)); err == nil && rigCfg.DefaultBranch != "" {
@@ -378,28 +387,49 @@ func runDone(cmd *cobra.Command, args []string) error {
 			if attachmentFields != nil && attachmentFields.MergeStrategy != "" {
 				mergeStrategy := attachmentFields.MergeStrategy
 				if mergeStrategy == "direct" || mergeStrategy == "local" {
+					// The rig's merge policy picks how the branch lands. Checks
+					// and approvals need a pull request, which a direct merge
+					// never has, so such policies send the work through the MR.
+					policy, err := loadRigMergePolicy(filepath.Join(townRoot, rigName))
+					if err != nil {
+						return fmt.Errorf("loading merge policy: %w", err)
+					}
+
 					// Direct/local merge requires checkout + merge + push on the local worktree.
 					// In K8s mode this is not supported — agent pods use MR strategy only.
 					if isRunningInK8s() {
 						style.PrintWarning("merge_strategy %q not supported in K8s mode; falling through to MR strategy", mergeStrategy)
+					} else if policy.RequiresPR() {
+						style.PrintWarning("merge policy for %s requires checks or approvals; falling through to MR strategy", rigName)
 					} else {
-						fmt.Printf("%s Merge strategy: %s (direct push to main)\n", style.Bold.Render("→"), mergeStrategy)
+						method := policy.EffectiveMethod()
+						fmt.Printf("%s Merge strategy: %s (direct push to main, method: %s)\n", style.Bold.Render("→"), mergeStrategy, method)
 						fmt.Printf("  Branch: %s\n", branch)
 						fmt.Printf("  Issue: %s\n", issueID)
 						fmt.Println()
 
-						// Checkout main, merge feature branch, push
-						if err := g.Checkout(defaultBranch); err != nil {
-							return fmt.Errorf("checkout %s: %w", defaultBranch, err)
-						}
-						if err := g.Pull("origin", defaultBranch); err != nil {
-							style.PrintWarning("could not pull latest %s: %v", defaultBranch, err)
-						}
-						if err := g.Merge(branch); err != nil {
-							return fmt.Errorf("merge %s into %s: %w\nResolve conflicts manually and retry.", branch, defaultBranch, err)
-						}
-						if err := g.Push("origin", defaultBranch, false); err != nil {
-							return fmt.Errorf("push %s to origin: %w", defaultBranch, err)
+						// Checkout main, land feature branch, push
+						_, mergeSpan := tracing.Start(traceCtx, issueID, "merge",
+							attribute.String("gt.merge.strategy", mergeStrategy),
+							attribute.String("gt.merge.method", method))
+						mergeErr := func() error {
+							if err := g.Checkout(defaultBranch); err != nil {
+								return fmt.Errorf("checkout %s: %w", defaultBranch, err)
+							}
+							if err := g.Pull("origin", defaultBranch); err != nil {
+								style.PrintWarning("could not pull latest %s: %v", defaultBranch, err)
+							}
+							if err := landBranch(g, method, branch, defaultBranch); err != nil {
+								return fmt.Errorf("%w\nResolve conflicts manually and retry.", err)
+							}
+							if err := g.Push("origin", defaultBranch, false); err != nil {
+								return fmt.Errorf("push %s to origin: %w", defaultBranch, err)
+							}
+							return nil
+						}()
+						tracing.End(mergeSpan, mergeErr)
+						if mergeErr != nil {
+							return mergeErr
 						}
 
 						fmt.Printf("%s Merged and pushed directly to %s\n", style.Bold.Render("✓"), defaultBranch)
@@ -488,6 +518,7 @@ func runDone(cmd *cobra.Command, args []string) error {
 			// Nudge refinery to pick up the new MR
 			nudgeRefinery(rigName, fmt.Sprintf("MR submitted: %s branch=%s", mrID, branch))
 		}
+		span.SetAttributes(attribute.String("gt.mr.id", mrID))
 		fmt.Printf("  Source: %s\n", branch)
 		fmt.Printf("  Target: %s\n", target)
 		fmt.Printf("  Issue: %s\n", issueID)
@@ -594,7 +625,7 @@ notifyWitness:
 
 	// Log done event (townlog and activity feed)
 	_ = LogDone(townRoot, sender, issueID)
-	_ = events.LogFeed(events.TypeDone, sender, events.DonePayload(issueID, branch))
+	_ = eventbus.Publish(sender, eventbus.Done{Bead: issueID, Branch: branch})
 
 	// Update agent bead state (ZFC: self-report completion)
 	updateAgentStateOnDone(cwd, townRoot, exitType, issueID)
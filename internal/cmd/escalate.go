@@ -39,14 +39,21 @@ WORKFLOW:
   2. Runs: gt escalate "Description" --severity high --reason "details"
   3. Escalation is routed based on settings/escalation.json
   4. Recipient acknowledges with: gt escalate ack <id>
+     (or hands it to someone with: gt escalate assign <id> <address>)
   5. After resolution: gt escalate close <id> --reason "fixed"
 
+An escalation left unacknowledged past its severity's SLA is re-escalated:
+its severity is bumped, it is routed again, and Slack and the overseer are
+pinged. The daemon checks SLAs on every heartbeat.
+
 CONFIGURATION:
   Routing is configured in ~/gt/settings/escalation.json:
   - routes: Map severity to action lists (bead, mail:mayor, email:human, sms:human)
   - contacts: Human email/SMS for external notifications
-  - stale_threshold: When unacked escalations are re-escalated (default: 4h)
+  - sla: Per-severity time to acknowledge (e.g. {"critical": "15m"})
+  - stale_threshold: SLA for severities without one (default: 4h)
   - max_reescalations: How many times to bump severity (default: 2)
+  - on_breach: Extra actions on an SLA breach (default: slack, mail:overseer)
 
 Examples:
   gt escalate "Build failing" --severity critical --reason "CI blocked"
@@ -54,8 +61,9 @@ Examples:
   gt escalate "Code review requested" --reason "PR #123 ready"
   gt escalate list                          # Show open escalations
   gt escalate ack hq-abc123                 # Acknowledge
+  gt escalate assign hq-abc123 gastown/crew/max
   gt escalate close hq-abc123 --reason "Fixed in commit abc"
-  gt escalate stale                         # Re-escalate stale escalations`,
+  gt escalate stale                         # Enforce SLAs now`,
 }
 
 var escalateListCmd = &cobra.Command{
@@ -87,9 +95,25 @@ Examples:
 	RunE: runEscalateAck,
 }
 
+var escalateAssignCmd = &cobra.Command{
+	Use:   "assign <escalation-id> <address>",
+	Short: "Assign an escalation to an owner",
+	Long: `Make someone responsible for resolving an escalation.
+
+Assigning acknowledges the escalation if nobody has yet, which stops its
+SLA timer, and mails the assignee.
+
+Examples:
+  gt escalate assign hq-abc123 gastown/crew/max
+  gt escalate assign hq-abc123 overseer`,
+	Args: cobra.ExactArgs(2),
+	RunE: runEscalateAssign,
+}
+
 var escalateCloseCmd = &cobra.Command{
-	Use:   "close <escalation-id>",
-	Short: "Close a resolved escalation",
+	Use:     "close <escalation-id>",
+	Aliases: []string{"resolve"},
+	Short:   "Close a resolved escalation",
 	Long: `Close an escalation after the issue is resolved.
 
 Records who closed it and the resolution reason.
@@ -103,18 +127,23 @@ Examples:
 
 var escalateStaleCmd = &cobra.Command{
 	Use:   "stale",
-	Short: "Re-escalate stale unacknowledged escalations",
-	Long: `Find and re-escalate escalations that haven't been acknowledged within the threshold.
+	Short: "Re-escalate escalations past their SLA",
+	Long: `Find and re-escalate escalations that haven't been acknowledged within
+their severity's SLA. The daemon does this on every heartbeat; run it by
+hand to enforce SLAs immediately.
 
 When run without --dry-run, this command:
-1. Finds escalations older than the stale threshold (default: 4h)
+1. Finds unacknowledged escalations past their SLA deadline
 2. Bumps their severity: low→medium→high→critical
 3. Re-routes them according to the new severity level
-4. Sends mail to the new routing targets
+4. Runs the on_breach actions (default: Slack ping, mail to overseer)
 
-Respects max_reescalations from config (default: 2) to prevent infinite escalation.
+The SLA timer restarts at each breach, so an escalation that stays
+unacknowledged is re-escalated again one SLA later. Respects
+max_reescalations from config (default: 2); past it, and at critical,
+breaches still notify but no longer bump severity.
 
-The threshold is configured in settings/escalation.json.
+SLAs are configured in settings/escalation.json (sla, stale_threshold).
 
 Examples:
   gt escalate stale              # Re-escalate stale escalations
@@ -162,6 +191,7 @@ func init() {
 	// Add subcommands
 	escalateCmd.AddCommand(escalateListCmd)
 	escalateCmd.AddCommand(escalateAckCmd)
+	escalateCmd.AddCommand(escalateAssignCmd)
 	escalateCmd.AddCommand(escalateCloseCmd)
 	escalateCmd.AddCommand(escalateStaleCmd)
 	escalateCmd.AddCommand(escalateShowCmd)
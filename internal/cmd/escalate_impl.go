@@ -3,6 +3,7 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -12,8 +13,10 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/escalation"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/notify"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/util"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -92,6 +95,22 @@ func runEscalate(cmd *cobra.Command, args []string) error {
 	// Send mail to each target (actions with "mail:" prefix)
 	router := mail.NewRouter(townRoot)
 	for _, target := range targets {
+		if target == "overseer" {
+			// The overseer's notification preferences decide how (and
+			// whether yet) they hear about it.
+			delivery := notify.NewDispatcher(townRoot).Notify(notify.Notification{
+				Event:    config.NotifyEventEscalation,
+				Severity: severity,
+				From:     agentID,
+				Title:    description,
+				Body:     formatEscalationMailBody(issue.ID, severity, escalateReason, agentID, escalateRelatedBead),
+				Bead:     issue.ID,
+			})
+			for _, e := range delivery.Errors {
+				style.PrintWarning("notifying overseer: %s", e)
+			}
+			continue
+		}
 		msg := &mail.Message{
 			From:    agentID,
 			To:      target,
@@ -118,7 +137,7 @@ func runEscalate(cmd *cobra.Command, args []string) error {
 	}
 
 	// Process external notification actions (email:, sms:, slack)
-	executeExternalActions(actions, escalationConfig, issue.ID, severity, description)
+	executeExternalActions(actions, escalationConfig, townRoot, agentID, issue.ID, severity, description)
 
 	// Log to activity feed
 	payload := events.EscalationPayload(issue.ID, agentID, strings.Join(targets, ","), description)
@@ -157,57 +176,41 @@ func runEscalate(cmd *cobra.Command, args []string) error {
 }
 
 func runEscalateList(cmd *cobra.Command, args []string) error {
-	townRoot, err := workspace.FindFromCwdOrError()
+	svc, err := newEscalationService()
 	if err != nil {
-		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		return err
 	}
-
-	bd := beads.New(beads.ResolveBeadsDir(townRoot))
-
-	var issues []*beads.Issue
-	if escalateListAll {
-		// List all (open and closed)
-		out, err := bd.Run("list", "--label=gt:escalation", "--status=all", "--json")
-		if err != nil {
-			return fmt.Errorf("listing escalations: %w", err)
-		}
-		if err := json.Unmarshal(out, &issues); err != nil {
-			return fmt.Errorf("parsing escalations: %w", err)
-		}
-	} else {
-		issues, err = bd.ListEscalations()
-		if err != nil {
-			return fmt.Errorf("listing escalations: %w", err)
-		}
+	list, err := svc.List(escalateListAll)
+	if err != nil {
+		return fmt.Errorf("listing escalations: %w", err)
 	}
 
 	if escalateListJSON {
-		out, _ := json.MarshalIndent(issues, "", "  ")
+		out, _ := json.MarshalIndent(list, "", "  ")
 		fmt.Println(string(out))
 		return nil
 	}
 
-	if len(issues) == 0 {
+	if len(list) == 0 {
 		fmt.Println("No escalations found")
 		return nil
 	}
 
-	fmt.Printf("Escalations (%d):\n\n", len(issues))
-	for _, issue := range issues {
-		fields := beads.ParseEscalationFields(issue.Description)
-		emoji := severityEmoji(fields.Severity)
-
-		status := issue.Status
-		if beads.HasLabel(issue, "acked") {
-			status = "acked"
-		}
-
-		slug := util.GenerateEscalationSlug(issue.ID, issue.Title)
-		fmt.Printf("  %s %s [%s] %s\n", emoji, slug, status, issue.Title)
+	now := time.Now()
+	fmt.Printf("Escalations (%d):\n\n", len(list))
+	for _, e := range list {
+		emoji := severityEmoji(e.Severity)
+		slug := util.GenerateEscalationSlug(e.ID, e.Title)
+		fmt.Printf("  %s %s [%s] %s\n", emoji, slug, e.State, e.Title)
 		fmt.Printf("     Severity: %s | From: %s | %s\n",
-			fields.Severity, fields.EscalatedBy, formatRelativeTimeSimple(issue.CreatedAt))
-		if fields.AckedBy != "" {
-			fmt.Printf("     Acked by: %s\n", fields.AckedBy)
+			e.Severity, e.EscalatedBy, formatRelativeTimeSimple(e.CreatedAt.Format(time.RFC3339)))
+		if e.AssignedTo != "" {
+			fmt.Printf("     Assigned to: %s\n", e.AssignedTo)
+		} else if e.AckedBy != "" {
+			fmt.Printf("     Acked by: %s\n", e.AckedBy)
+		}
+		if line := formatSLA(e, now); line != "" {
+			fmt.Printf("     SLA: %s\n", line)
 		}
 		fmt.Println()
 	}
@@ -215,223 +218,160 @@ func runEscalateList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runEscalateAck(cmd *cobra.Command, args []string) error {
-	escalationID := util.ResolveSemanticSlug(args[0])
+// formatSLA describes an open escalation's SLA deadline.
+func formatSLA(e *escalation.Escalation, now time.Time) string {
+	switch {
+	case e.SLADeadline.IsZero():
+		return ""
+	case e.Breached(now):
+		return style.Error.Render(fmt.Sprintf("breached %s ago", now.Sub(e.SLADeadline).Round(time.Minute)))
+	default:
+		return fmt.Sprintf("ack within %s", e.SLADeadline.Sub(now).Round(time.Minute))
+	}
+}
 
+// newEscalationService opens the escalation service for the current town.
+func newEscalationService() (*escalation.Service, error) {
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
-		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		return nil, fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
+	return escalation.NewService(townRoot)
+}
 
-	// Detect who is acknowledging
-	ackedBy := detectSender()
-	if ackedBy == "" {
-		ackedBy = "unknown"
+// escalationActor returns who is acting on an escalation, or fallback.
+func escalationActor(fallback string) string {
+	if actor := detectSender(); actor != "" {
+		return actor
 	}
+	return fallback
+}
 
-	bd := beads.New(beads.ResolveBeadsDir(townRoot))
-	if err := bd.AckEscalation(escalationID, ackedBy); err != nil {
+func runEscalateAck(cmd *cobra.Command, args []string) error {
+	escalationID := util.ResolveSemanticSlug(args[0])
+
+	svc, err := newEscalationService()
+	if err != nil {
+		return err
+	}
+	if err := svc.Ack(escalationID, escalationActor("unknown")); err != nil {
 		return fmt.Errorf("acknowledging escalation: %w", err)
 	}
 
-	// Log to activity feed
-	_ = events.LogFeed(events.TypeEscalationAcked, ackedBy, map[string]interface{}{
-		"escalation_id": escalationID,
-		"acked_by":      ackedBy,
-	})
-
 	fmt.Printf("%s Escalation acknowledged: %s\n", style.Bold.Render("✓"), escalationID)
 	return nil
 }
 
-func runEscalateClose(cmd *cobra.Command, args []string) error {
+func runEscalateAssign(cmd *cobra.Command, args []string) error {
 	escalationID := util.ResolveSemanticSlug(args[0])
+	assignee := args[1]
 
-	townRoot, err := workspace.FindFromCwdOrError()
+	svc, err := newEscalationService()
 	if err != nil {
-		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		return err
 	}
-
-	// Detect who is closing
-	closedBy := detectSender()
-	if closedBy == "" {
-		closedBy = "unknown"
+	if err := svc.Assign(escalationID, assignee, escalationActor("unknown")); err != nil {
+		return fmt.Errorf("assigning escalation: %w", err)
 	}
 
-	bd := beads.New(beads.ResolveBeadsDir(townRoot))
-	if err := bd.CloseEscalation(escalationID, closedBy, escalateCloseReason); err != nil {
+	fmt.Printf("%s Escalation %s assigned to %s\n", style.Bold.Render("✓"), escalationID, assignee)
+	return nil
+}
+
+func runEscalateClose(cmd *cobra.Command, args []string) error {
+	escalationID := util.ResolveSemanticSlug(args[0])
+
+	svc, err := newEscalationService()
+	if err != nil {
+		return err
+	}
+	if err := svc.Resolve(escalationID, escalationActor("unknown"), escalateCloseReason); err != nil {
 		return fmt.Errorf("closing escalation: %w", err)
 	}
 
-	// Log to activity feed
-	_ = events.LogFeed(events.TypeEscalationClosed, closedBy, map[string]interface{}{
-		"escalation_id": escalationID,
-		"closed_by":     closedBy,
-		"reason":        escalateCloseReason,
-	})
-
 	fmt.Printf("%s Escalation closed: %s\n", style.Bold.Render("✓"), escalationID)
 	fmt.Printf("  Reason: %s\n", escalateCloseReason)
 	return nil
 }
 
 func runEscalateStale(cmd *cobra.Command, args []string) error {
-	townRoot, err := workspace.FindFromCwdOrError()
-	if err != nil {
-		return fmt.Errorf("not in a Gas Town workspace: %w", err)
-	}
-
-	// Load escalation config for threshold and max reescalations
-	escalationConfig, err := config.LoadOrCreateEscalationConfig(config.EscalationConfigPath(townRoot))
+	svc, err := newEscalationService()
 	if err != nil {
-		return fmt.Errorf("loading escalation config: %w", err)
+		return err
 	}
+	cfg := svc.Config()
+	maxReescalations := cfg.GetMaxReescalations()
+	now := time.Now()
 
-	threshold := escalationConfig.GetStaleThreshold()
-	maxReescalations := escalationConfig.GetMaxReescalations()
-
-	bd := beads.New(beads.ResolveBeadsDir(townRoot))
-	stale, err := bd.ListStaleEscalations(threshold)
+	breached, err := svc.Breaches(now)
 	if err != nil {
 		return fmt.Errorf("listing stale escalations: %w", err)
 	}
 
-	if len(stale) == 0 {
+	if len(breached) == 0 {
 		if !escalateStaleJSON {
-			fmt.Printf("No stale escalations (threshold: %s)\n", threshold)
+			fmt.Println("No escalations past their SLA")
 		} else {
 			fmt.Println("[]")
 		}
 		return nil
 	}
 
-	// Detect who is reescalating
-	reescalatedBy := detectSender()
-	if reescalatedBy == "" {
-		reescalatedBy = "system"
-	}
-
 	// Dry run mode - just show what would happen
 	if escalateDryRun {
-		fmt.Printf("Would re-escalate %d stale escalations (threshold: %s):\n\n", len(stale), threshold)
-		for _, issue := range stale {
-			fields := beads.ParseEscalationFields(issue.Description)
-			newSeverity := getNextSeverity(fields.Severity)
-			willSkip := maxReescalations > 0 && fields.ReescalationCount >= maxReescalations
-			if fields.Severity == "critical" {
-				willSkip = true
-			}
-
-			emoji := severityEmoji(fields.Severity)
-			slug := util.GenerateEscalationSlug(issue.ID, issue.Title)
-			if willSkip {
-				fmt.Printf("  %s %s [SKIP] %s\n", emoji, slug, issue.Title)
-				if fields.Severity == "critical" {
-					fmt.Printf("     Already at critical severity\n")
-				} else {
-					fmt.Printf("     Already at max reescalations (%d)\n", maxReescalations)
-				}
-			} else {
-				fmt.Printf("  %s %s %s\n", emoji, slug, issue.Title)
+		fmt.Printf("Would re-escalate %d escalations past their SLA:\n\n", len(breached))
+		for _, e := range breached {
+			willSkip := e.Severity == config.SeverityCritical ||
+				(maxReescalations > 0 && e.ReescalationCount >= maxReescalations)
+
+			emoji := severityEmoji(e.Severity)
+			slug := util.GenerateEscalationSlug(e.ID, e.Title)
+			fmt.Printf("  %s %s %s\n", emoji, slug, e.Title)
+			fmt.Printf("     SLA %s breached %s ago\n", cfg.GetSLA(e.Severity), now.Sub(e.SLADeadline).Round(time.Minute))
+			switch {
+			case e.Severity == config.SeverityCritical:
+				fmt.Printf("     Already at critical severity (notify only)\n")
+			case willSkip:
+				fmt.Printf("     Already at max reescalations (%d) (notify only)\n", maxReescalations)
+			default:
 				fmt.Printf("     %s → %s (reescalation %d/%d)\n",
-					fields.Severity, newSeverity, fields.ReescalationCount+1, maxReescalations)
+					e.Severity, getNextSeverity(e.Severity), e.ReescalationCount+1, maxReescalations)
 			}
 			fmt.Println()
 		}
 		return nil
 	}
 
-	// Perform re-escalation
-	var results []*beads.ReescalationResult
-	router := mail.NewRouter(townRoot)
-
-	for _, issue := range stale {
-		result, err := bd.ReescalateEscalation(issue.ID, reescalatedBy, maxReescalations)
-		if err != nil {
-			style.PrintWarning("failed to reescalate %s: %v", issue.ID, err)
-			continue
-		}
-		results = append(results, result)
-
-		// If not skipped, re-route to new severity targets
-		if !result.Skipped {
-			actions := escalationConfig.GetRouteForSeverity(result.NewSeverity)
-			targets := extractMailTargetsFromActions(actions)
-
-			// Send mail to each target about the reescalation
-			for _, target := range targets {
-				msg := &mail.Message{
-					From:    reescalatedBy,
-					To:      target,
-					Subject: fmt.Sprintf("[%s→%s] Re-escalated: %s", strings.ToUpper(result.OldSeverity), strings.ToUpper(result.NewSeverity), result.Title),
-					Body:    formatReescalationMailBody(result, reescalatedBy),
-					Type:    mail.TypeTask,
-				}
-
-				// Set priority based on new severity
-				switch result.NewSeverity {
-				case config.SeverityCritical:
-					msg.Priority = mail.PriorityUrgent
-				case config.SeverityHigh:
-					msg.Priority = mail.PriorityHigh
-				case config.SeverityMedium:
-					msg.Priority = mail.PriorityNormal
-				default:
-					msg.Priority = mail.PriorityLow
-				}
-
-				if err := router.Send(msg); err != nil {
-					style.PrintWarning("failed to send reescalation to %s: %v", target, err)
-				}
-			}
-
-			// Log to activity feed
-			_ = events.LogFeed(events.TypeEscalationSent, reescalatedBy, map[string]interface{}{
-				"escalation_id":    result.ID,
-				"reescalated":      true,
-				"old_severity":     result.OldSeverity,
-				"new_severity":     result.NewSeverity,
-				"reescalation_num": result.ReescalationNum,
-				"targets":          strings.Join(targets, ","),
-			})
+	breaches, err := svc.EnforceSLA(escalationActor("system"), now)
+	if err != nil {
+		return fmt.Errorf("enforcing escalation SLAs: %w", err)
+	}
+	for _, b := range breaches {
+		for _, msg := range b.Errors {
+			style.PrintWarning("%s: %s", b.Escalation.ID, msg)
 		}
 	}
 
-	// Output results
 	if escalateStaleJSON {
-		out, _ := json.MarshalIndent(results, "", "  ")
+		out, _ := json.MarshalIndent(breaches, "", "  ")
 		fmt.Println(string(out))
 		return nil
 	}
 
-	reescalated := 0
-	skipped := 0
-	for _, r := range results {
-		if r.Skipped {
-			skipped++
-		} else {
-			reescalated++
+	fmt.Printf("🔄 %d escalations past their SLA:\n\n", len(breaches))
+	for _, b := range breaches {
+		if b.Result == nil {
+			continue
 		}
-	}
-
-	if reescalated == 0 && skipped > 0 {
-		fmt.Printf("No escalations re-escalated (%d at max level)\n", skipped)
-		return nil
-	}
-
-	fmt.Printf("🔄 Re-escalated %d stale escalations:\n\n", reescalated)
-	for _, result := range results {
-		if result.Skipped {
+		slug := util.GenerateEscalationSlug(b.Escalation.ID, b.Escalation.Title)
+		if b.Result.Skipped {
+			fmt.Printf("  %s %s: %s, notified %s\n",
+				severityEmoji(b.Escalation.Severity), slug, b.Result.SkipReason, strings.Join(b.Notified, ", "))
 			continue
 		}
-		emoji := severityEmoji(result.NewSeverity)
-		slug := util.GenerateEscalationSlug(result.ID, result.Title)
-		fmt.Printf("  %s %s: %s → %s (reescalation %d)\n",
-			emoji, slug, result.OldSeverity, result.NewSeverity, result.ReescalationNum)
-	}
-
-	if skipped > 0 {
-		fmt.Printf("\n  (%d skipped - at max level)\n", skipped)
+		fmt.Printf("  %s %s: %s → %s (reescalation %d), notified %s\n",
+			severityEmoji(b.Result.NewSeverity), slug, b.Result.OldSeverity, b.Result.NewSeverity,
+			b.Result.ReescalationNum, strings.Join(b.Notified, ", "))
 	}
 
 	return nil
@@ -450,79 +390,56 @@ func getNextSeverity(severity string) string {
 	}
 }
 
-func formatReescalationMailBody(result *beads.ReescalationResult, reescalatedBy string) string {
-	var lines []string
-	lines = append(lines, fmt.Sprintf("Escalation ID: %s", result.ID))
-	lines = append(lines, fmt.Sprintf("Severity bumped: %s → %s", result.OldSeverity, result.NewSeverity))
-	lines = append(lines, fmt.Sprintf("Reescalation #%d", result.ReescalationNum))
-	lines = append(lines, fmt.Sprintf("Reescalated by: %s", reescalatedBy))
-	lines = append(lines, "")
-	lines = append(lines, "This escalation was not acknowledged within the stale threshold and has been automatically re-escalated to a higher severity.")
-	lines = append(lines, "")
-	lines = append(lines, "---")
-	lines = append(lines, "To acknowledge: gt escalate ack "+result.ID)
-	lines = append(lines, "To close: gt escalate close "+result.ID+" --reason \"resolution\"")
-	return strings.Join(lines, "\n")
-}
-
 func runEscalateShow(cmd *cobra.Command, args []string) error {
 	escalationID := util.ResolveSemanticSlug(args[0])
 
-	townRoot, err := workspace.FindFromCwdOrError()
+	svc, err := newEscalationService()
 	if err != nil {
-		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		return err
 	}
-
-	bd := beads.New(beads.ResolveBeadsDir(townRoot))
-	issue, fields, err := bd.GetEscalationBead(escalationID)
+	e, err := svc.Get(escalationID)
 	if err != nil {
+		if errors.Is(err, beads.ErrNotFound) {
+			return fmt.Errorf("escalation not found: %s", escalationID)
+		}
 		return fmt.Errorf("getting escalation: %w", err)
 	}
-	if issue == nil {
-		return fmt.Errorf("escalation not found: %s", escalationID)
-	}
 
 	if escalateJSON {
-		data := map[string]interface{}{
-			"id":          issue.ID,
-			"title":       issue.Title,
-			"status":      issue.Status,
-			"created_at":  issue.CreatedAt,
-			"severity":    fields.Severity,
-			"reason":      fields.Reason,
-			"escalatedBy": fields.EscalatedBy,
-			"escalatedAt": fields.EscalatedAt,
-			"ackedBy":     fields.AckedBy,
-			"ackedAt":     fields.AckedAt,
-			"closedBy":    fields.ClosedBy,
-			"closedReason": fields.ClosedReason,
-			"relatedBead": fields.RelatedBead,
-		}
-		out, _ := json.MarshalIndent(data, "", "  ")
+		out, _ := json.MarshalIndent(e, "", "  ")
 		fmt.Println(string(out))
 		return nil
 	}
 
-	emoji := severityEmoji(fields.Severity)
-	slug := util.GenerateEscalationSlug(issue.ID, issue.Title)
+	emoji := severityEmoji(e.Severity)
+	slug := util.GenerateEscalationSlug(e.ID, e.Title)
 	fmt.Printf("%s Escalation: %s\n", emoji, slug)
-	fmt.Printf("  Title: %s\n", issue.Title)
-	fmt.Printf("  Status: %s\n", issue.Status)
-	fmt.Printf("  Severity: %s\n", fields.Severity)
-	fmt.Printf("  Created: %s\n", formatRelativeTimeSimple(issue.CreatedAt))
-	fmt.Printf("  Escalated by: %s\n", fields.EscalatedBy)
-	if fields.Reason != "" {
-		fmt.Printf("  Reason: %s\n", fields.Reason)
+	fmt.Printf("  Title: %s\n", e.Title)
+	fmt.Printf("  State: %s\n", e.State)
+	fmt.Printf("  Severity: %s\n", e.Severity)
+	fmt.Printf("  Created: %s\n", formatRelativeTimeSimple(e.CreatedAt.Format(time.RFC3339)))
+	fmt.Printf("  Escalated by: %s\n", e.EscalatedBy)
+	if e.Reason != "" {
+		fmt.Printf("  Reason: %s\n", e.Reason)
+	}
+	if e.AckedBy != "" {
+		fmt.Printf("  Acknowledged by: %s at %s\n", e.AckedBy, e.AckedAt.Format(time.RFC3339))
 	}
-	if fields.AckedBy != "" {
-		fmt.Printf("  Acknowledged by: %s at %s\n", fields.AckedBy, fields.AckedAt)
+	if e.AssignedTo != "" {
+		fmt.Printf("  Assigned to: %s at %s\n", e.AssignedTo, e.AssignedAt.Format(time.RFC3339))
 	}
-	if fields.ClosedBy != "" {
-		fmt.Printf("  Closed by: %s\n", fields.ClosedBy)
-		fmt.Printf("  Resolution: %s\n", fields.ClosedReason)
+	if line := formatSLA(e, time.Now()); line != "" {
+		fmt.Printf("  SLA: %s\n", line)
 	}
-	if fields.RelatedBead != "" {
-		fmt.Printf("  Related: %s\n", fields.RelatedBead)
+	if e.ReescalationCount > 0 {
+		fmt.Printf("  Re-escalated: %d time(s)\n", e.ReescalationCount)
+	}
+	if e.ClosedBy != "" {
+		fmt.Printf("  Closed by: %s\n", e.ClosedBy)
+		fmt.Printf("  Resolution: %s\n", e.ClosedReason)
+	}
+	if e.RelatedBead != "" {
+		fmt.Printf("  Related: %s\n", e.RelatedBead)
 	}
 
 	return nil
@@ -547,7 +464,7 @@ func extractMailTargetsFromActions(actions []string) []string {
 }
 
 // executeExternalActions processes external notification actions (email:, sms:, slack).
-func executeExternalActions(actions []string, cfg *config.EscalationConfig, beadID, severity, description string) {
+func executeExternalActions(actions []string, cfg *config.EscalationConfig, townRoot, agentID, beadID, severity, description string) {
 	for _, action := range actions {
 		switch {
 		case strings.HasPrefix(action, "email:"):
@@ -567,9 +484,19 @@ func executeExternalActions(actions []string, cfg *config.EscalationConfig, bead
 			}
 
 		case action == "slack":
-			if cfg.Contacts.SlackWebhook == "" {
-				style.PrintWarning("slack action skipped: contacts.slack_webhook not configured in settings/escalation.json")
-			} else {
+			// Prefer the bot's per-rig channel routing (settings/slack.json),
+			// falling back to the single escalation webhook.
+			route, err := escalation.PostSlack(townRoot, agentID, beadID, severity, description)
+			switch {
+			case err != nil:
+				style.PrintWarning("slack post failed: %v", err)
+			case route.Quiet:
+				fmt.Printf("  💤 Slack notification for %s held (quiet hours)\n", route.Channel)
+			case route.Channel != "":
+				fmt.Printf("  💬 Posted escalation to Slack %s\n", route.Channel)
+			case cfg.Contacts.SlackWebhook == "":
+				style.PrintWarning("slack action skipped: no route in settings/slack.json and contacts.slack_webhook not configured in settings/escalation.json")
+			default:
 				if err := postSlackWebhook(cfg.Contacts.SlackWebhook, beadID, severity, description); err != nil {
 					style.PrintWarning("slack webhook failed: %v", err)
 				} else {
@@ -587,42 +514,8 @@ func executeExternalActions(actions []string, cfg *config.EscalationConfig, bead
 
 // postSlackWebhook sends an escalation notification to a Slack incoming webhook.
 func postSlackWebhook(webhookURL, beadID, severity, description string) error {
-	urgencyEmoji := map[string]string{
-		"critical": "🔴",
-		"high":     "🟠",
-		"medium":   "🟡",
-		"low":      "🟢",
-	}
-	emoji := urgencyEmoji[severity]
-	if emoji == "" {
-		emoji = "⚪"
-	}
-
 	payload := map[string]interface{}{
-		"blocks": []map[string]interface{}{
-			{
-				"type": "header",
-				"text": map[string]string{
-					"type":  "plain_text",
-					"text":  fmt.Sprintf("%s Escalation: %s", emoji, beadID),
-					"emoji": "true",
-				},
-			},
-			{
-				"type": "section",
-				"fields": []map[string]string{
-					{"type": "mrkdwn", "text": fmt.Sprintf("*Severity:*\n%s %s", emoji, severity)},
-					{"type": "mrkdwn", "text": fmt.Sprintf("*Bead:*\n%s", beadID)},
-				},
-			},
-			{
-				"type": "section",
-				"text": map[string]string{
-					"type": "mrkdwn",
-					"text": fmt.Sprintf("*Description:*\n%s", description),
-				},
-			},
-		},
+		"blocks": escalation.SlackBlocks(beadID, severity, description),
 	}
 
 	body, err := json.Marshal(payload)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/eventbus"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mail"
@@ -126,7 +127,7 @@ func runExit(cmd *cobra.Command, args []string) error {
 	}
 
 	// Log exit event
-	_ = events.LogFeed(events.TypeDone, sender, events.DonePayload("", ""))
+	_ = eventbus.Publish(sender, eventbus.Done{})
 
 	// Self-cleaning for polecats
 	if roleInfo, err := GetRoleWithContext(cwd, townRoot); err == nil && roleInfo.Role == RolePolecat {
@@ -15,6 +15,7 @@ func TestSelfKillExitSession_UsesBackendResolution(t *testing.T) {
 	// Set env vars that selfKillExitSession reads for identity
 	t.Setenv("GT_RIG", "testrig")
 	t.Setenv("GT_POLECAT", "alpha")
+	t.Chdir(t.TempDir()) // keep the session_death event out of the repo's event log
 
 	roleInfo := RoleInfo{
 		Role:    RolePolecat,
@@ -43,6 +44,7 @@ func TestSelfKillExitSession_EnvOverridesRoleInfo(t *testing.T) {
 	// The function should prefer env vars when available (lines 215-222 of exit.go).
 	t.Setenv("GT_RIG", "env-rig")
 	t.Setenv("GT_POLECAT", "env-polecat")
+	t.Chdir(t.TempDir()) // keep the session_death event out of the repo's event log
 
 	roleInfo := RoleInfo{
 		Role:    RolePolecat,
@@ -0,0 +1,242 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/federation"
+	"github.com/steveyegge/gastown/internal/rpcclient"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Federation command flags
+var (
+	federationToken    string
+	federationTokenEnv string
+	federationListJSON bool
+)
+
+var federationCmd = &cobra.Command{
+	Use:     "federation",
+	GroupID: GroupWorkspace,
+	Short:   "Route mail, slings, and decisions to other towns",
+	RunE:    requireSubcommand,
+	Long: `Manage the registry of peer towns this town can route work to.
+
+Each peer is a name, the URL of its RPC server ('gt rpc serve'), and the
+API key that server expects. Once registered, a town name can prefix any address:
+
+  gt mail send west:gastown/polecats/nux -s "Hi" -m "..."
+  gt sling gt-abc west:gastown
+  gt decision request --town west --prompt "..." --option A --option B
+  gt decision resolve west:hq-dec-abc --choice 1
+
+Forwarded mail carries a return address of <this-town>:<sender>, so the
+peer can reply if it has this town registered too. The registry is stored
+in mayor/federation.json (mode 0600). Use --token-env to keep the key out
+of the file and read it from the environment instead.
+
+Examples:
+  gt federation add west https://west.example.com:8443 --token-env WEST_GT_KEY
+  gt federation list
+  gt federation ping west
+  gt federation remove west`,
+}
+
+var federationAddCmd = &cobra.Command{
+	Use:   "add <name> <url>",
+	Short: "Register a peer town",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runFederationAdd,
+}
+
+var federationListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List peer towns",
+	Args:  cobra.NoArgs,
+	RunE:  runFederationList,
+}
+
+var federationRemoveCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Unregister a peer town",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runFederationRemove,
+}
+
+var federationPingCmd = &cobra.Command{
+	Use:   "ping <name>",
+	Short: "Check that a peer town is reachable and accepts our key",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runFederationPing,
+}
+
+func init() {
+	federationAddCmd.Flags().StringVar(&federationToken, "token", "", "API key for the peer's RPC server")
+	federationAddCmd.Flags().StringVar(&federationTokenEnv, "token-env", "", "Environment variable holding the API key")
+	federationAddCmd.MarkFlagsMutuallyExclusive("token", "token-env")
+
+	federationListCmd.Flags().BoolVar(&federationListJSON, "json", false, "Output as JSON")
+
+	federationCmd.AddCommand(federationAddCmd)
+	federationCmd.AddCommand(federationListCmd)
+	federationCmd.AddCommand(federationRemoveCmd)
+	federationCmd.AddCommand(federationPingCmd)
+	rootCmd.AddCommand(federationCmd)
+}
+
+func loadFederation() (*federation.Registry, string, error) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return nil, "", fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	reg, err := federation.Load(townRoot)
+	if err != nil {
+		return nil, "", err
+	}
+	return reg, townRoot, nil
+}
+
+func runFederationAdd(cmd *cobra.Command, args []string) error {
+	reg, townRoot, err := loadFederation()
+	if err != nil {
+		return err
+	}
+	if local, _ := workspace.GetTownName(townRoot); local == args[0] {
+		return fmt.Errorf("%s is this town's own name", args[0])
+	}
+
+	town := &federation.Town{
+		Name:     args[0],
+		URL:      args[1],
+		Token:    federationToken,
+		TokenEnv: federationTokenEnv,
+	}
+	if err := reg.Add(town); err != nil {
+		return err
+	}
+	fmt.Printf("%s Added town %s (%s)\n", style.Bold.Render("✓"), town.Name, town.URL)
+	if town.AuthToken() == "" {
+		fmt.Printf("  %s\n", style.Dim.Render("No API key set; the peer must allow unauthenticated RPC"))
+	}
+	return nil
+}
+
+func runFederationList(cmd *cobra.Command, args []string) error {
+	reg, _, err := loadFederation()
+	if err != nil {
+		return err
+	}
+	towns := reg.List()
+
+	if federationListJSON {
+		type townJSON struct {
+			Name     string `json:"name"`
+			URL      string `json:"url"`
+			TokenEnv string `json:"token_env,omitempty"`
+			HasToken bool   `json:"has_token"`
+		}
+		out := make([]townJSON, 0, len(towns))
+		for _, t := range towns {
+			out = append(out, townJSON{Name: t.Name, URL: t.URL, TokenEnv: t.TokenEnv, HasToken: t.AuthToken() != ""})
+		}
+		return outputJSON(out)
+	}
+
+	if len(towns) == 0 {
+		fmt.Println("No federated towns. Add one with: gt federation add <name> <url>")
+		return nil
+	}
+	for _, t := range towns {
+		auth := "no key"
+		switch {
+		case t.TokenEnv != "":
+			auth = "key from $" + t.TokenEnv
+		case t.Token != "":
+			auth = "key stored"
+		}
+		fmt.Printf("  %s  %s  %s\n", style.Bold.Render(t.Name), t.URL, style.Dim.Render(auth))
+	}
+	return nil
+}
+
+func runFederationRemove(cmd *cobra.Command, args []string) error {
+	reg, _, err := loadFederation()
+	if err != nil {
+		return err
+	}
+	if err := reg.Remove(args[0]); err != nil {
+		return err
+	}
+	fmt.Printf("%s Removed town %s\n", style.Bold.Render("✓"), args[0])
+	return nil
+}
+
+func runFederationPing(cmd *cobra.Command, args []string) error {
+	reg, townRoot, err := loadFederation()
+	if err != nil {
+		return err
+	}
+	town, err := reg.Get(args[0])
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client := town.Client(localTownName(townRoot))
+	if !client.IsAvailable(ctx) {
+		return fmt.Errorf("%s is not reachable at %s", town.Name, town.URL)
+	}
+	// Health is unauthenticated; make a cheap authenticated call to check the key
+	if _, err := client.ListPendingDecisions(ctx); err != nil {
+		return fmt.Errorf("%s is reachable but rejected the request: %w", town.Name, err)
+	}
+	fmt.Printf("%s %s is reachable at %s\n", style.Bold.Render("✓"), town.Name, town.URL)
+	return nil
+}
+
+// localTownName returns this town's name, used as the return-address prefix
+// on work forwarded to peers.
+func localTownName(townRoot string) string {
+	name, _ := workspace.GetTownName(townRoot)
+	return name
+}
+
+// federatedTarget is an address that resolved to a peer town.
+type federatedTarget struct {
+	town      *federation.Town
+	local     string // address within the peer town
+	localTown string // this town's name
+}
+
+// resolveFederated reports whether addr names a registered peer town.
+// Any failure to read the registry means the address is handled locally.
+func resolveFederated(addr string) (*federatedTarget, bool) {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return nil, false
+	}
+	reg, err := federation.Load(townRoot)
+	if err != nil {
+		return nil, false
+	}
+	town, local, ok := reg.Resolve(addr)
+	if !ok {
+		return nil, false
+	}
+	return &federatedTarget{town: town, local: local, localTown: localTownName(townRoot)}, true
+}
+
+// client returns an RPC client for the target's town.
+func (f *federatedTarget) client() *rpcclient.Client {
+	return f.town.Client(f.localTown)
+}
+
+// String renders the target as a federated address.
+func (f *federatedTarget) String() string {
+	return f.town.Name + ":" + f.local
+}
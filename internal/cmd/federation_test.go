@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestFederatedDescription(t *testing.T) {
+	issue := &beads.Issue{ID: "gt-abc", Description: "Fix the thing"}
+	got := federatedDescription(issue, "east")
+	if !strings.HasPrefix(got, "federated_from: east:gt-abc\n") {
+		t.Errorf("description should start with the origin, got %q", got)
+	}
+	if !strings.HasSuffix(got, "Fix the thing") {
+		t.Errorf("description should keep the original text, got %q", got)
+	}
+}
+
+func TestParseDecisionOptions(t *testing.T) {
+	opts := parseDecisionOptions([]string{"JWT: Stateless", "Session"}, 2)
+	if len(opts) != 2 {
+		t.Fatalf("got %d options, want 2", len(opts))
+	}
+	if opts[0].Label != "JWT" || opts[0].Description != "Stateless" || opts[0].Recommended {
+		t.Errorf("option 1 = %+v", opts[0])
+	}
+	if opts[1].Label != "Session" || !opts[1].Recommended {
+		t.Errorf("option 2 = %+v", opts[1])
+	}
+}
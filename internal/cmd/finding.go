@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Finding command flags
+var (
+	findingCategory string
+	findingSeverity string
+	findingEvidence string
+	findingAction   string
+	findingTitle    string
+	findingAgent    string
+	findingListAll  bool
+	findingListJSON bool
+	findingReason   string
+)
+
+var findingCmd = &cobra.Command{
+	Use:     "finding",
+	GroupID: GroupDiag,
+	Short:   "Structured anomaly findings reported by witnesses",
+	RunE:    requireSubcommand,
+	Long: `Report and review structured findings about misbehaving agents.
+
+Witnesses record what they observe while patrolling as findings rather than
+free-form notes. Each finding names the agent, a category, a severity, the
+evidence, and a suggested action, and is stored as a bead (gt:finding) in
+town beads. The overseer sees them ranked by severity on the dashboard, in
+'gt finding list', and via the WitnessService RPC.
+
+Reporting the same agent and category again updates the open finding and
+bumps its occurrence count instead of filing a duplicate.
+
+CATEGORIES (conventional):
+  stuck           No progress for longer than expected
+  crash-loop      Session keeps dying and restarting
+  gupp-violation  Work on hook but agent not running it
+  zombie          Session alive but agent unresponsive
+  dirty-state     Uncommitted or unpushed work on a dead polecat
+
+Examples:
+  gt finding report gastown/polecats/nux --category stuck --severity high \
+      --evidence "no output for 45m" --action "gt resling gt-abc --kill"
+  gt finding list
+  gt finding list --agent gastown/polecats/nux --all
+  gt finding resolve hq-abc123 --reason "reslung to Toast"`,
+}
+
+var findingReportCmd = &cobra.Command{
+	Use:   "report <agent>",
+	Short: "Report a finding about an agent",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runFindingReport,
+}
+
+var findingListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List findings, most severe first",
+	Args:  cobra.NoArgs,
+	RunE:  runFindingList,
+}
+
+var findingResolveCmd = &cobra.Command{
+	Use:   "resolve <finding-id>",
+	Short: "Resolve (close) a finding",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runFindingResolve,
+}
+
+func init() {
+	findingReportCmd.Flags().StringVarP(&findingCategory, "category", "c", "", "Kind of anomaly (e.g., stuck, crash-loop, zombie)")
+	findingReportCmd.Flags().StringVarP(&findingSeverity, "severity", "s", config.SeverityMedium, "Severity: critical, high, medium, low")
+	findingReportCmd.Flags().StringVarP(&findingEvidence, "evidence", "e", "", "What was observed (log excerpts, timings, etc.)")
+	findingReportCmd.Flags().StringVarP(&findingAction, "action", "a", "", "Suggested action for the overseer")
+	findingReportCmd.Flags().StringVarP(&findingTitle, "title", "t", "", "Title (default: <agent>: <category>)")
+	_ = findingReportCmd.MarkFlagRequired("category")
+
+	findingListCmd.Flags().StringVar(&findingAgent, "agent", "", "Only findings about this agent")
+	findingListCmd.Flags().BoolVar(&findingListAll, "all", false, "Include resolved findings")
+	findingListCmd.Flags().BoolVar(&findingListJSON, "json", false, "Output as JSON")
+
+	findingResolveCmd.Flags().StringVarP(&findingReason, "reason", "r", "", "Resolution reason")
+
+	findingCmd.AddCommand(findingReportCmd)
+	findingCmd.AddCommand(findingListCmd)
+	findingCmd.AddCommand(findingResolveCmd)
+	rootCmd.AddCommand(findingCmd)
+}
+
+func runFindingReport(cmd *cobra.Command, args []string) error {
+	agent := args[0]
+	if !config.IsValidSeverity(findingSeverity) {
+		return fmt.Errorf("invalid severity %q: must be critical, high, medium, or low", findingSeverity)
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	title := findingTitle
+	if title == "" {
+		title = fmt.Sprintf("%s: %s", agent, findingCategory)
+	}
+
+	bd := beads.New(beads.ResolveBeadsDir(townRoot))
+	finding, created, err := bd.ReportFinding(title, &beads.FindingFields{
+		Agent:           agent,
+		Category:        findingCategory,
+		Severity:        findingSeverity,
+		Evidence:        findingEvidence,
+		SuggestedAction: findingAction,
+		ReportedBy:      detectSender(),
+	})
+	if err != nil {
+		return fmt.Errorf("reporting finding: %w", err)
+	}
+
+	if created {
+		fmt.Printf("%s Reported finding %s: %s\n", style.Bold.Render("✓"), finding.ID, title)
+	} else {
+		fmt.Printf("%s Updated finding %s (seen %d times): %s\n", style.Bold.Render("✓"), finding.ID, finding.Occurrences, title)
+	}
+	return nil
+}
+
+func runFindingList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	bd := beads.New(beads.ResolveBeadsDir(townRoot))
+	findings, err := bd.ListFindings(findingAgent, findingListAll)
+	if err != nil {
+		return fmt.Errorf("listing findings: %w", err)
+	}
+
+	if findingListJSON {
+		out, _ := json.MarshalIndent(findings, "", "  ")
+		fmt.Println(string(out))
+		return nil
+	}
+
+	if len(findings) == 0 {
+		fmt.Println("No findings")
+		return nil
+	}
+
+	fmt.Printf("Findings (%d):\n\n", len(findings))
+	for _, f := range findings {
+		fmt.Printf("  %s %s [%s] %s\n", severityEmoji(f.Severity), f.ID, f.Status, f.Title)
+		fmt.Printf("     Agent: %s | Category: %s | Severity: %s | Seen: %d | %s\n",
+			f.Agent, f.Category, f.Severity, f.Occurrences, formatRelativeTimeSimple(f.ReportedAt))
+		if f.SuggestedAction != "" {
+			fmt.Printf("     Suggested: %s\n", f.SuggestedAction)
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+func runFindingResolve(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	bd := beads.New(beads.ResolveBeadsDir(townRoot))
+	if err := bd.ResolveFinding(args[0], detectSender(), findingReason); err != nil {
+		return fmt.Errorf("resolving finding: %w", err)
+	}
+	fmt.Printf("%s Resolved finding %s\n", style.Bold.Render("✓"), args[0])
+	return nil
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var (
+	gcDryRun    bool
+	gcRetention time.Duration
+	gcVerbose   bool
+)
+
+var gcCmd = &cobra.Command{
+	Use:     "gc [rig...]",
+	GroupID: GroupWork,
+	Short:   "Remove finished polecat worktrees and merged branches",
+	Long: `Garbage collect finished polecat workspaces.
+
+A polecat is collected when all of the following hold:
+  - its session is not running
+  - it has no hooked or open assigned bead
+  - its worktree has no uncommitted changes or stashes
+  - its branch is fully merged into origin/<default-branch>
+  - it finished longer ago than the retention window
+
+Collected worktrees are removed (agent bead closed, name released) and
+their branches deleted. Orphaned polecat branches already merged into the
+default branch are pruned too. Anything else is left alone.
+
+The retention window defaults to deacon.gc_retention in mayor/config.json
+(24h if unset). Add {"job": "gc", "cron": "@hourly"} to deacon.schedule to
+collect automatically.
+
+Examples:
+  gt gc                      # All rigs
+  gt gc gastown --dry-run    # Show what would be collected
+  gt gc --retention 0        # Collect finished polecats immediately`,
+	RunE: runGC,
+}
+
+func init() {
+	gcCmd.Flags().BoolVar(&gcDryRun, "dry-run", false, "Show what would be collected without removing anything")
+	gcCmd.Flags().DurationVar(&gcRetention, "retention", 0, "Keep finished polecats this long (default: deacon.gc_retention, or 24h)")
+	gcCmd.Flags().BoolVarP(&gcVerbose, "verbose", "v", false, "Also list polecats that were kept, and why")
+
+	rootCmd.AddCommand(gcCmd)
+}
+
+func runGC(cmd *cobra.Command, args []string) error {
+	var rigs []*rig.Rig
+	var townRoot string
+	if len(args) == 0 {
+		allRigs, root, err := getAllRigs()
+		if err != nil {
+			return err
+		}
+		rigs, townRoot = allRigs, root
+	} else {
+		for _, name := range args {
+			root, r, err := getRig(name)
+			if err != nil {
+				return err
+			}
+			rigs, townRoot = append(rigs, r), root
+		}
+	}
+
+	retention := gcRetention
+	if !cmd.Flags().Changed("retention") {
+		var deaconCfg *config.DeaconConfig
+		if cfg, err := config.LoadMayorConfig(constants.MayorConfigPath(townRoot)); err == nil {
+			deaconCfg = cfg.Deacon
+		}
+		retention = deaconCfg.GCRetentionDuration()
+	}
+
+	opts := polecat.GCOptions{Retention: retention, DryRun: gcDryRun}
+	var collected, pruned int
+	var reclaimed int64
+	for _, r := range rigs {
+		report, err := polecat.NewManager(r, git.NewGit(r.Path)).CollectGarbage(opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: %s: %v\n", r.Name, err)
+			continue
+		}
+		printGCReport(report, gcDryRun, gcVerbose)
+		collected += report.Collected(gcDryRun)
+		pruned += len(report.BranchesPruned)
+		reclaimed += report.BytesReclaimed
+	}
+
+	// The last line is the summary the deacon's gc job records.
+	verb := "Reclaimed"
+	if gcDryRun {
+		verb = "Would reclaim"
+	}
+	fmt.Printf("%s %s from %d polecat(s), %d branch(es) pruned (retention %v)\n",
+		verb, formatBytes(reclaimed), collected, pruned, retention)
+	return nil
+}
+
+// printGCReport prints one rig's collection results.
+func printGCReport(report *polecat.GCReport, dryRun, verbose bool) {
+	var lines []string
+	for _, e := range report.Entries {
+		switch {
+		case e.Removed:
+			lines = append(lines, fmt.Sprintf("  %s %s %s", style.Success.Render("✓"), e.Name, style.Dim.Render(formatBytes(e.Bytes))))
+		case dryRun && e.Collect:
+			lines = append(lines, fmt.Sprintf("  Would remove %s %s", e.Name, style.Dim.Render(formatBytes(e.Bytes))))
+		case e.Collect:
+			lines = append(lines, fmt.Sprintf("  %s %s: %s", style.Warning.Render("⚠"), e.Name, e.Reason))
+		case verbose:
+			lines = append(lines, fmt.Sprintf("  Keep %s: %s", e.Name, style.Dim.Render(e.Reason)))
+		}
+	}
+	for _, b := range report.BranchesPruned {
+		if dryRun {
+			lines = append(lines, fmt.Sprintf("  Would delete branch %s", style.Dim.Render(b)))
+		} else if verbose {
+			lines = append(lines, fmt.Sprintf("  Deleted branch %s", style.Dim.Render(b)))
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Printf("%s:\n", style.Bold.Render(report.Rig))
+	for _, l := range lines {
+		fmt.Println(l)
+	}
+}
@@ -0,0 +1,307 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/handoff"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	handoffSectionFlags = make(map[string]*string) // section key -> flag value
+	handoffSubmitFile   string
+	handoffCheckHook    bool
+)
+
+var handoffCmd = &cobra.Command{
+	Use:     "handoff [bead]",
+	GroupID: GroupWork,
+	Short:   "Write a structured handoff for the next agent on a bead",
+	Long: `Write a structured handoff for whoever picks up a bead after you.
+
+A handoff has five sections:
+  State       - Where things stand right now
+  Done        - What you finished this session
+  Remaining   - What is left before the bead can close
+  Gotchas     - Traps and dead ends (optional)
+  Next Steps  - What the next agent should do first
+
+Handoffs are stored as comments on the bead, so earlier ones stay in its
+history. The latest is shown by gt prime to the next agent that hooks it.
+
+With no section flags, gt handoff starts a draft in .runtime/handoff.md with
+a prompt for each section. Fill it in, then run gt handoff submit. The Stop
+hook checks the draft when your session ends: a complete draft is submitted
+automatically, an incomplete one blocks the stop until it is fixed.
+
+The bead defaults to the one on your hook.
+
+EXAMPLES:
+  gt handoff                         # Start a draft for the hooked bead
+  gt handoff submit                  # Validate and store the draft
+  gt handoff gt-abc --state "Tests green on polecat/toast" \
+    --done "Parser and CLI" --remaining "Docs" --next "Update docs/reference.md"
+  gt handoff show gt-abc             # Read the latest handoff on a bead`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runHandoff,
+}
+
+var handoffSubmitCmd = &cobra.Command{
+	Use:   "submit",
+	Short: "Validate the draft handoff and store it on its bead",
+	Args:  cobra.NoArgs,
+	RunE:  runHandoffSubmit,
+}
+
+var handoffCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check the draft handoff (used by the Stop hook)",
+	Long: `Check the draft handoff in .runtime/handoff.md.
+
+Exits 0 when there is no draft. Exits 2 with the problems on stderr when the
+draft is incomplete.
+
+With --hook, reads the Stop hook input from stdin and submits a complete
+draft. An incomplete draft blocks the stop once; if the agent stops again
+without fixing it, the stop is allowed and the draft is left in place.`,
+	Args: cobra.NoArgs,
+	RunE: runHandoffCheck,
+}
+
+var handoffShowCmd = &cobra.Command{
+	Use:   "show [bead]",
+	Short: "Show the latest handoff on a bead",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runHandoffShow,
+}
+
+func init() {
+	for _, s := range handoff.Sections {
+		v := new(string)
+		handoffSectionFlags[s.Key] = v
+		handoffCmd.Flags().StringVar(v, s.Key, "", s.Prompt)
+	}
+	handoffSubmitCmd.Flags().StringVarP(&handoffSubmitFile, "file", "f", "",
+		"Submit this file instead of the draft")
+	handoffCheckCmd.Flags().BoolVar(&handoffCheckHook, "hook", false,
+		"Run as a Claude Stop hook (reads hook JSON from stdin)")
+
+	handoffCmd.AddCommand(handoffSubmitCmd)
+	handoffCmd.AddCommand(handoffCheckCmd)
+	handoffCmd.AddCommand(handoffShowCmd)
+	rootCmd.AddCommand(handoffCmd)
+}
+
+// handoffBead returns explicit if set, otherwise the bead hooked by the
+// agent working in cwd.
+func handoffBead(cwd, explicit string) (string, error) {
+	if explicit != "" {
+		return explicit, nil
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return "", err
+	}
+	roleInfo, err := GetRoleWithContext(cwd, townRoot)
+	if err != nil {
+		return "", fmt.Errorf("detecting role: %w", err)
+	}
+	if id := detectHookedBead(cwd, roleInfo); id != "" {
+		return id, nil
+	}
+	return "", fmt.Errorf("nothing on your hook; name the bead: gt handoff <bead>")
+}
+
+func runHandoff(cmd *cobra.Command, args []string) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting current directory: %w", err)
+	}
+	var explicit string
+	if len(args) > 0 {
+		explicit = args[0]
+	}
+	beadID, err := handoffBead(cwd, explicit)
+	if err != nil {
+		return err
+	}
+
+	// Section flags given: write the handoff in one go, no draft.
+	body := make(map[string]string)
+	for key, v := range handoffSectionFlags {
+		if *v != "" {
+			body[key] = strings.TrimSpace(*v)
+		}
+	}
+	if len(body) > 0 {
+		return submitHandoff(cwd, &handoff.Document{Bead: beadID, Body: body}, "")
+	}
+
+	path := handoff.DraftPath(cwd)
+	if data, err := os.ReadFile(path); err == nil {
+		if d, err := handoff.Parse(string(data)); err == nil && d.Bead != beadID {
+			return fmt.Errorf("%s already holds a draft for %s; submit or delete it first", path, d.Bead)
+		}
+		fmt.Printf("%s Draft already started: %s\n", style.Dim.Render("○"), path)
+	} else {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("creating %s: %w", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(handoff.Template(beadID)), 0644); err != nil {
+			return fmt.Errorf("writing draft: %w", err)
+		}
+		fmt.Printf("%s Started handoff draft for %s: %s\n", style.Success.Render("✓"), beadID, path)
+	}
+
+	fmt.Println()
+	for _, s := range handoff.Sections {
+		name := s.Heading
+		if !s.Required {
+			name += " (optional)"
+		}
+		fmt.Printf("  %s %s\n", style.Bold.Render(name+":"), s.Prompt)
+	}
+	fmt.Println()
+	fmt.Printf("Fill in the draft, then run %s.\n", style.Bold.Render("gt handoff submit"))
+	fmt.Println(style.Dim.Render("A complete draft is also submitted automatically when your session stops."))
+	return nil
+}
+
+func runHandoffSubmit(cmd *cobra.Command, args []string) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting current directory: %w", err)
+	}
+	path := handoffSubmitFile
+	if path == "" {
+		path = handoff.DraftPath(cwd)
+	}
+	d, err := readHandoffFile(path)
+	if err != nil {
+		return err
+	}
+	if d == nil {
+		return fmt.Errorf("no draft at %s; start one with gt handoff", path)
+	}
+	return submitHandoff(cwd, d, path)
+}
+
+// readHandoffFile parses the handoff at path. A missing file is (nil, nil).
+func readHandoffFile(path string) (*handoff.Document, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	d, err := handoff.Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return d, nil
+}
+
+// submitHandoff stores d on its bead and, when it came from a file, removes
+// the file.
+func submitHandoff(cwd string, d *handoff.Document, path string) error {
+	d.From = detectSender()
+	if err := handoff.Save(beads.New(cwd), d); err != nil {
+		return err
+	}
+	if path != "" {
+		_ = os.Remove(path)
+	}
+
+	payload := events.HandoffPayload("", false)
+	payload["bead"] = d.Bead
+	_ = events.LogFeed(events.TypeHandoff, d.From, payload)
+
+	fmt.Printf("%s Handoff stored on %s\n", style.Success.Render("✓"), d.Bead)
+	return nil
+}
+
+func runHandoffCheck(cmd *cobra.Command, args []string) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting current directory: %w", err)
+	}
+	path := handoff.DraftPath(cwd)
+
+	if !handoffCheckHook {
+		d, err := readHandoffFile(path)
+		if err != nil {
+			return err
+		}
+		if d == nil {
+			fmt.Printf("%s No draft handoff\n", style.Dim.Render("○"))
+			return nil
+		}
+		if err := d.Validate(); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			return NewSilentExit(2)
+		}
+		fmt.Printf("%s Draft handoff for %s is complete\n", style.Success.Render("✓"), d.Bead)
+		return nil
+	}
+
+	// Hook mode never fails the stop for its own errors; only an incomplete
+	// draft blocks it (exit 2, problems on stderr for the agent to read).
+	var stopHookActive bool
+	if input, err := readTurnHookInput(); err == nil {
+		stopHookActive = input.StopHookActive
+	}
+	d, err := readHandoffFile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[handoff] %v\n", err)
+		return nil
+	}
+	if d == nil {
+		return nil
+	}
+	if verr := d.Validate(); verr != nil {
+		if stopHookActive {
+			fmt.Fprintf(os.Stderr, "[handoff] Draft still incomplete, leaving it at %s\n", path)
+			return nil
+		}
+		fmt.Fprintf(os.Stderr, "Your handoff draft at %s is not ready.\n%v\n\nFix these sections, then run 'gt handoff submit'.\n", path, verr)
+		return NewSilentExit(2)
+	}
+	if err := submitHandoff(cwd, d, path); err != nil {
+		fmt.Fprintf(os.Stderr, "[handoff] %v\n", err)
+	}
+	return nil
+}
+
+func runHandoffShow(cmd *cobra.Command, args []string) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting current directory: %w", err)
+	}
+	var explicit string
+	if len(args) > 0 {
+		explicit = args[0]
+	}
+	beadID, err := handoffBead(cwd, explicit)
+	if err != nil {
+		return err
+	}
+	d, err := handoff.Latest(beads.New(cwd), beadID)
+	if err != nil {
+		return err
+	}
+	if d == nil {
+		fmt.Printf("%s has no handoff\n", beadID)
+		return nil
+	}
+	fmt.Print(d.Markdown())
+	return nil
+}
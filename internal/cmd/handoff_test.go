@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/handoff"
+)
+
+func TestHandoffCheck_Draft(t *testing.T) {
+	dir := t.TempDir()
+	originalWd, _ := os.Getwd()
+	defer os.Chdir(originalWd)
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	handoffCheckHook = false
+
+	// No draft: nothing to check.
+	if err := runHandoffCheck(handoffCheckCmd, nil); err != nil {
+		t.Fatalf("no draft: %v", err)
+	}
+
+	path := handoff.DraftPath(dir)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(handoff.Template("gt-abc")), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if code, ok := IsSilentExit(runHandoffCheck(handoffCheckCmd, nil)); !ok || code != 2 {
+		t.Errorf("blank template: exit %d (silent=%v), want 2", code, ok)
+	}
+
+	if err := os.WriteFile(path, []byte("notes to self"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readHandoffFile(path); err == nil {
+		t.Error("readHandoffFile() on a non-handoff file: want error")
+	}
+}
@@ -0,0 +1,304 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/eventbus"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/terminal"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var killCmd = &cobra.Command{
+	Use:     "kill <agent>",
+	GroupID: GroupAgents,
+	Short:   "Stop an agent without losing its work in progress",
+	Long: `Stop an agent's session, preserving what it was doing.
+
+Kill takes an agent down in order, so half-done work is not lost silently:
+  1. Saves the session's full output under .runtime/kills/
+  2. Asks the agent to commit or stash its work in progress and waits
+     until it goes idle, up to --timeout
+  3. Stops the session (for K8s agents, the pod's agent process)
+  4. Returns the hooked bead to open and leaves a note on it saying who
+     killed the agent, why, and where the output was saved
+  5. Records a kill event in the event log
+
+If the agent doesn't finish saving in time it is stopped anyway; the note
+on the bead says so. Use --no-save to skip asking.
+
+Examples:
+  gt kill gastown/polecats/Toast --reason "stuck in a loop"
+  gt kill gastown/crew/max --timeout 5m
+  gt kill gastown/polecats/Toast --no-save
+  gt kill gastown/witness --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runKill,
+}
+
+var (
+	killReason  string
+	killTimeout time.Duration
+	killNoSave  bool
+	killDryRun  bool
+)
+
+// killPollInterval is how often kill checks whether the agent has finished
+// saving its work.
+const killPollInterval = 2 * time.Second
+
+// killSaveRequest is the nudge asking an agent to save its work.
+const killSaveRequest = "gt kill: this session is about to be stopped. Commit your work in progress now " +
+	"(or git stash it if it doesn't build), then stop and wait. Do not start anything new."
+
+func init() {
+	killCmd.Flags().StringVar(&killReason, "reason", "", "Why the agent is being killed (recorded on the bead and in the event log)")
+	killCmd.Flags().DurationVar(&killTimeout, "timeout", 2*time.Minute, "How long to wait for the agent to save its work")
+	killCmd.Flags().BoolVar(&killNoSave, "no-save", false, "Stop immediately without asking the agent to save its work")
+	killCmd.Flags().BoolVarP(&killDryRun, "dry-run", "n", false, "Show what would be done")
+	rootCmd.AddCommand(killCmd)
+}
+
+func runKill(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	identity, err := session.ParseAddress(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid agent %q: %w", args[0], err)
+	}
+	address := identity.Address()
+
+	// The hooked bead comes from the agent bead. An agent with nothing on
+	// its hook is still killed; there is just no bead to return.
+	agentBeadID := agentIDToBeadID(address, townRoot)
+	hookBead := ""
+	if agentBeadID != "" {
+		b := beads.New(beads.ResolveHookDir(townRoot, agentBeadID, townRoot))
+		if agentBead, err := b.Show(agentBeadID); err == nil {
+			hookBead = agentBead.HookBead
+		}
+	}
+
+	backend, closeBackend, err := openKillBackend(identity)
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+	const sessionKey = "claude"
+	running, _ := backend.HasSession(sessionKey)
+
+	fmt.Printf("%s Killing %s...\n", style.Bold.Render("💀"), address)
+
+	if killDryRun {
+		if running {
+			fmt.Printf("Would save session output to %s\n", killCapturePath(townRoot, identity, time.Now()))
+			if !killNoSave {
+				fmt.Printf("Would ask %s to commit or stash its work (waiting up to %s)\n", address, killTimeout)
+			}
+			fmt.Printf("Would stop session for %s\n", address)
+		} else {
+			fmt.Printf("Session for %s is not running\n", address)
+		}
+		if hookBead != "" {
+			fmt.Printf("Would clear hook_bead on %s\n", address)
+			fmt.Printf("Would run: bd update %s --status=open --assignee=\n", hookBead)
+			fmt.Printf("Would add a kill note to %s\n", hookBead)
+		}
+		fmt.Printf("Would log kill event for %s\n", address)
+		return nil
+	}
+
+	// Steps 1-3: save what we can, then stop the session.
+	capturePath, saved := "", killSaveSkipped
+	if running {
+		if path, err := saveKillCapture(backend, sessionKey, townRoot, identity); err != nil {
+			fmt.Printf("%s Could not save session output: %v\n", style.Dim.Render("Warning:"), err)
+		} else {
+			capturePath = path
+			fmt.Printf("%s Saved session output to %s\n", style.Bold.Render("✓"), path)
+		}
+
+		if !killNoSave {
+			saved = requestKillSave(backend, sessionKey, address, killTimeout)
+		}
+
+		if err := backend.KillSession(sessionKey); err != nil {
+			return fmt.Errorf("stopping %s: %w", address, err)
+		}
+		fmt.Printf("%s Stopped session for %s\n", style.Bold.Render("✓"), address)
+	} else {
+		fmt.Printf("%s Session for %s is not running\n", style.Dim.Render("○"), address)
+	}
+
+	// Step 4: return the work so it can be slung again.
+	if hookBead != "" {
+		clearReslingAgentHook(townRoot, address, hookBead)
+		b := beads.New(beads.ResolveHookDir(townRoot, hookBead, ""))
+		openStatus := "open"
+		emptyAssignee := ""
+		if err := b.Update(hookBead, beads.UpdateOptions{Status: &openStatus, Assignee: &emptyAssignee}); err != nil {
+			fmt.Printf("%s Could not unhook %s: %v\n", style.Warning.Render("⚠"), hookBead, err)
+		} else {
+			fmt.Printf("%s Unhooked %s\n", style.Bold.Render("✓"), hookBead)
+		}
+		note := killNote(address, detectActor(), killReason, saved, capturePath)
+		if _, err := b.Run("comments", "add", hookBead, note); err != nil {
+			fmt.Printf("%s Could not add kill note to %s: %v\n", style.Dim.Render("Warning:"), hookBead, err)
+		}
+	}
+
+	// Step 5: record the kill.
+	_ = eventbus.Publish(detectActor(), eventbus.Kill{
+		Rig:    identity.Rig,
+		Target: address,
+		Reason: killReason,
+	})
+
+	fmt.Printf("%s Killed %s\n", style.Bold.Render("✓"), address)
+	if hookBead != "" {
+		fmt.Printf("  Reassign with: gt sling %s <target>\n", hookBead)
+	}
+	return nil
+}
+
+// openKillBackend returns the backend for an agent's session and a func
+// that releases it. Container agents use docker; K8s agents use coop,
+// through a port-forward when running outside the cluster (see gt peek).
+func openKillBackend(identity *session.AgentIdentity) (terminal.Backend, func(), error) {
+	backend := terminal.ResolveBackend(identity.BeadID())
+	if _, ok := backend.(*terminal.DockerBackend); ok || os.Getenv("KUBERNETES_SERVICE_HOST") != "" {
+		return backend, func() {}, nil
+	}
+
+	address := identity.Address()
+	podName, ns := resolveCoopTarget(address)
+	if podName == "" {
+		podInfo, err := terminal.ResolveAgentPodInfo(address)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot find pod for %q: run 'gt connect <namespace>' or ensure agent bead has pod metadata", address)
+		}
+		podName, ns = podInfo.PodName, podInfo.Namespace
+	}
+	conn := terminal.NewCoopPodConnection(terminal.CoopPodConnectionConfig{
+		PodName:   podName,
+		Namespace: ns,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := conn.Open(ctx); err != nil {
+		return nil, nil, fmt.Errorf("connecting to pod %s: %w", podName, err)
+	}
+	coop := terminal.NewCoopBackend(terminal.CoopConfig{})
+	coop.AddSession("claude", conn.LocalURL())
+	return coop, func() { conn.Close() }, nil
+}
+
+// killCapturePath returns where the output of a killed session is saved.
+func killCapturePath(townRoot string, identity *session.AgentIdentity, at time.Time) string {
+	name := fmt.Sprintf("%s-%s.log", identity.BeadID(), at.UTC().Format("20060102T150405Z"))
+	return filepath.Join(townRoot, constants.DirRuntime, "kills", name)
+}
+
+// saveKillCapture writes a session's full scrollback to the kills
+// directory and returns the file's path.
+func saveKillCapture(backend terminal.Backend, sessionKey, townRoot string, identity *session.AgentIdentity) (string, error) {
+	output, err := backend.CapturePaneAll(sessionKey)
+	if err != nil {
+		return "", err
+	}
+	path := killCapturePath(townRoot, identity, time.Now())
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// killSaveResult is how the agent responded to being asked to save.
+type killSaveResult string
+
+const (
+	killSaveSkipped  killSaveResult = "skipped"   // Not asked (--no-save or not running)
+	killSaveDone     killSaveResult = "saved"     // Went idle after working on the request
+	killSaveTimedOut killSaveResult = "timed out" // Still busy, or never responded, at the timeout
+	killSaveFailed   killSaveResult = "failed"    // The request could not be delivered
+)
+
+// requestKillSave nudges the agent to commit or stash its work and waits
+// for it to go back to idle.
+func requestKillSave(backend terminal.Backend, sessionKey, address string, timeout time.Duration) killSaveResult {
+	if err := backend.NudgeSession(sessionKey, killSaveRequest); err != nil {
+		fmt.Printf("%s Could not ask %s to save its work: %v\n", style.Dim.Render("Warning:"), address, err)
+		return killSaveFailed
+	}
+	fmt.Printf("%s Asked %s to commit or stash its work (waiting up to %s)\n", style.Bold.Render("→"), address, timeout)
+
+	deadline := time.Now().Add(timeout)
+	sawWorking := false
+	for time.Now().Before(deadline) {
+		time.Sleep(killPollInterval)
+		state, err := backend.GetAgentState(sessionKey)
+		if err != nil {
+			continue
+		}
+		if state == "working" {
+			sawWorking = true
+		}
+		if killSaveSettled(state, sawWorking) {
+			fmt.Printf("%s %s saved its work\n", style.Bold.Render("✓"), address)
+			return killSaveDone
+		}
+	}
+	fmt.Printf("%s %s did not finish saving within %s; stopping anyway\n", style.Warning.Render("⚠"), address, timeout)
+	return killSaveTimedOut
+}
+
+// killSaveSettled reports whether an agent asked to save its work is done:
+// it has exited, or it is idle again after having started working on the
+// request. Idle before any work was seen means the nudge hasn't landed yet.
+func killSaveSettled(state string, sawWorking bool) bool {
+	switch state {
+	case "exited", "crashed":
+		return true
+	case "idle", "waiting_for_input":
+		return sawWorking
+	default:
+		return false
+	}
+}
+
+// killNote is the comment left on a killed agent's hooked bead.
+func killNote(address, actor, reason string, saved killSaveResult, capturePath string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Killed %s (by %s). Bead returned to open.\n", address, orDefault(actor, "unknown"))
+	if reason != "" {
+		fmt.Fprintf(&b, "Reason: %s\n", reason)
+	}
+	switch saved {
+	case killSaveDone:
+		b.WriteString("Work in progress: agent committed or stashed it before stopping.\n")
+	case killSaveTimedOut:
+		b.WriteString("Work in progress: agent did not finish saving in time; check its branch and stash for partial work.\n")
+	case killSaveFailed:
+		b.WriteString("Work in progress: save request could not be delivered; check its branch for uncommitted work.\n")
+	default:
+		b.WriteString("Work in progress: agent was not asked to save.\n")
+	}
+	if capturePath != "" {
+		fmt.Fprintf(&b, "Session output: %s\n", capturePath)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
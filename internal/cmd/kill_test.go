@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+func TestKillSaveSettled(t *testing.T) {
+	tests := []struct {
+		state      string
+		sawWorking bool
+		want       bool
+	}{
+		{"idle", false, false}, // nudge not picked up yet
+		{"working", true, false},
+		{"idle", true, true},
+		{"waiting_for_input", true, true},
+		{"exited", false, true},
+		{"crashed", false, true},
+		{"running", true, false}, // docker has no idle state
+	}
+	for _, tt := range tests {
+		if got := killSaveSettled(tt.state, tt.sawWorking); got != tt.want {
+			t.Errorf("killSaveSettled(%q, %v) = %v, want %v", tt.state, tt.sawWorking, got, tt.want)
+		}
+	}
+}
+
+func TestKillCapturePath(t *testing.T) {
+	identity := &session.AgentIdentity{Role: session.RolePolecat, Rig: "gastown", Name: "Toast"}
+	at := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	got := killCapturePath("/town", identity, at)
+	want := filepath.Join("/town", ".runtime", "kills", "gt-gastown-polecat-Toast-20260304T050607Z.log")
+	if got != want {
+		t.Errorf("killCapturePath = %q, want %q", got, want)
+	}
+}
+
+func TestKillNote(t *testing.T) {
+	note := killNote("gastown/polecats/Toast", "mayor", "stuck in a loop", killSaveTimedOut, "/town/.runtime/kills/x.log")
+	for _, want := range []string{
+		"Killed gastown/polecats/Toast (by mayor)",
+		"Reason: stuck in a loop",
+		"did not finish saving",
+		"Session output: /town/.runtime/kills/x.log",
+	} {
+		if !strings.Contains(note, want) {
+			t.Errorf("killNote missing %q:\n%s", want, note)
+		}
+	}
+
+	note = killNote("gastown/crew/max", "", "", killSaveSkipped, "")
+	if strings.Contains(note, "Reason:") || strings.Contains(note, "Session output:") {
+		t.Errorf("killNote included empty fields:\n%s", note)
+	}
+	if !strings.Contains(note, "not asked to save") {
+		t.Errorf("killNote = %q, want skipped save noted", note)
+	}
+}
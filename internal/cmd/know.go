@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/knowledge"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Know command flags
+var (
+	knowKind   string
+	knowBody   string
+	knowTags   []string
+	knowRig    string
+	knowTown   bool
+	knowLimit  int
+	knowJSON   bool
+	knowReason string
+)
+
+var knowCmd = &cobra.Command{
+	Use:     "know",
+	GroupID: GroupWork,
+	Short:   "Shared knowledge base of agent learnings",
+	RunE:    requireSubcommand,
+	Long: `Record and search learnings that should outlive the agent that made them.
+
+When you discover a repo quirk, a command line that works, or make a decision
+the next agent should know about, record it with 'gt know add'. Entries are
+stored as beads (gt:knowledge) in town beads, so every rig shares them, and
+gt prime shows the entries most relevant to an agent's hooked work.
+
+Entries belong to the rig you are in unless --town is given. Searches from a
+rig cover that rig's entries and town-wide ones.
+
+KINDS:
+  quirk     Something surprising about a repo or tool
+  command   A command line that works
+  decision  A choice made, and why
+  note      Anything else (default)
+
+Examples:
+  gt know add "Integration tests need the dolt server" --kind quirk \
+      --body "Run 'gt dolt start' first or every test times out after 30s"
+  gt know add "Regenerate protos with make proto" --kind command --tag proto
+  gt know search dolt tests
+  gt know list --rig gastown
+  gt know forget hq-abc123 --reason "fixed in gt-xyz"`,
+}
+
+var knowAddCmd = &cobra.Command{
+	Use:   "add <title>",
+	Short: "Record a learning",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runKnowAdd,
+}
+
+var knowSearchCmd = &cobra.Command{
+	Use:   "search <query>...",
+	Short: "Search learnings by keyword, best match first",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runKnowSearch,
+}
+
+var knowListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List learnings, newest first",
+	Args:  cobra.NoArgs,
+	RunE:  runKnowList,
+}
+
+var knowForgetCmd = &cobra.Command{
+	Use:   "forget <id>",
+	Short: "Retire a learning that is wrong or out of date",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runKnowForget,
+}
+
+func init() {
+	knowAddCmd.Flags().StringVarP(&knowKind, "kind", "k", knowledge.KindNote, "Kind: quirk, command, decision, note")
+	knowAddCmd.Flags().StringVarP(&knowBody, "body", "b", "", "Details (commands, context, why)")
+	knowAddCmd.Flags().StringArrayVarP(&knowTags, "tag", "t", nil, "Keyword to find it by (repeatable)")
+	knowAddCmd.Flags().StringVar(&knowRig, "rig", "", "Rig the learning applies to (default: current rig)")
+	knowAddCmd.Flags().BoolVar(&knowTown, "town", false, "Applies to every rig")
+
+	for _, c := range []*cobra.Command{knowSearchCmd, knowListCmd} {
+		c.Flags().StringVar(&knowRig, "rig", "", "Only this rig's and town-wide learnings (default: current rig)")
+		c.Flags().BoolVar(&knowTown, "all", false, "Include every rig's learnings")
+		c.Flags().BoolVar(&knowJSON, "json", false, "Output as JSON")
+	}
+	knowSearchCmd.Flags().IntVarP(&knowLimit, "limit", "n", 10, "Maximum results")
+
+	knowForgetCmd.Flags().StringVarP(&knowReason, "reason", "r", "", "Why it no longer applies")
+
+	knowCmd.AddCommand(knowAddCmd)
+	knowCmd.AddCommand(knowSearchCmd)
+	knowCmd.AddCommand(knowListCmd)
+	knowCmd.AddCommand(knowForgetCmd)
+	rootCmd.AddCommand(knowCmd)
+}
+
+// knowScope returns the town root and the rig to scope to: --rig, else the
+// rig of the current directory. all (--town/--all) means no rig.
+func knowScope(all bool) (string, string, error) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return "", "", fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if all {
+		return townRoot, "", nil
+	}
+	if knowRig != "" {
+		return townRoot, knowRig, nil
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return townRoot, "", nil
+	}
+	roleInfo, err := GetRoleWithContext(cwd, townRoot)
+	if err != nil {
+		return townRoot, "", nil
+	}
+	return townRoot, roleInfo.Rig, nil
+}
+
+func runKnowAdd(cmd *cobra.Command, args []string) error {
+	if !knowledge.IsKind(knowKind) {
+		return fmt.Errorf("invalid kind %q: must be one of %s", knowKind, strings.Join(knowledge.Kinds, ", "))
+	}
+	townRoot, rig, err := knowScope(knowTown)
+	if err != nil {
+		return err
+	}
+
+	entry, created, err := knowledge.NewStore(townRoot).Add(&knowledge.Entry{
+		Title:  args[0],
+		Body:   knowBody,
+		Kind:   knowKind,
+		Rig:    rig,
+		Tags:   knowTags,
+		Author: detectSender(),
+	})
+	if err != nil {
+		return fmt.Errorf("recording learning: %w", err)
+	}
+	if !created {
+		fmt.Printf("%s Already known as %s: %s\n", style.Dim.Render("○"), entry.ID, entry.Title)
+		return nil
+	}
+	fmt.Printf("%s Recorded %s %s (%s)\n", style.Success.Render("✓"), entry.Kind, entry.ID, knowScopeName(entry.Rig))
+	return nil
+}
+
+func runKnowSearch(cmd *cobra.Command, args []string) error {
+	townRoot, rig, err := knowScope(knowTown)
+	if err != nil {
+		return err
+	}
+	hits, err := knowledge.NewStore(townRoot).Search(strings.Join(args, " "), rig, knowLimit)
+	if err != nil {
+		return fmt.Errorf("searching knowledge: %w", err)
+	}
+
+	if knowJSON {
+		if hits == nil {
+			hits = []knowledge.Hit{}
+		}
+		out, _ := json.MarshalIndent(hits, "", "  ")
+		fmt.Println(string(out))
+		return nil
+	}
+	if len(hits) == 0 {
+		fmt.Println("No matching learnings")
+		return nil
+	}
+	for _, h := range hits {
+		printKnowledgeEntry(h.Entry, "  ")
+	}
+	return nil
+}
+
+func runKnowList(cmd *cobra.Command, args []string) error {
+	townRoot, rig, err := knowScope(knowTown)
+	if err != nil {
+		return err
+	}
+	entries, err := knowledge.NewStore(townRoot).List(rig)
+	if err != nil {
+		return fmt.Errorf("listing knowledge: %w", err)
+	}
+
+	if knowJSON {
+		out, _ := json.MarshalIndent(entries, "", "  ")
+		fmt.Println(string(out))
+		return nil
+	}
+	if len(entries) == 0 {
+		fmt.Println("No learnings recorded")
+		return nil
+	}
+	fmt.Printf("Learnings (%d):\n\n", len(entries))
+	for _, e := range entries {
+		printKnowledgeEntry(e, "  ")
+	}
+	return nil
+}
+
+func runKnowForget(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if err := knowledge.NewStore(townRoot).Forget(args[0], knowReason); err != nil {
+		return fmt.Errorf("forgetting %s: %w", args[0], err)
+	}
+	fmt.Printf("%s Forgot %s\n", style.Success.Render("✓"), args[0])
+	return nil
+}
+
+func knowScopeName(rig string) string {
+	if rig == "" {
+		return "town-wide"
+	}
+	return rig
+}
+
+// printKnowledgeEntry prints an entry with its body indented under it.
+func printKnowledgeEntry(e *knowledge.Entry, indent string) {
+	fmt.Printf("%s%s %s %s\n", indent, style.Bold.Render("["+e.Kind+"]"), e.Title, style.Dim.Render(e.ID))
+	meta := knowScopeName(e.Rig)
+	if e.Author != "" {
+		meta += " · " + e.Author
+	}
+	if len(e.Tags) > 0 {
+		meta += " · " + strings.Join(e.Tags, ", ")
+	}
+	fmt.Printf("%s  %s\n", indent, style.Dim.Render(meta))
+	for _, line := range strings.Split(e.Body, "\n") {
+		if line != "" {
+			fmt.Printf("%s  %s\n", indent, line)
+		}
+	}
+	fmt.Println()
+}
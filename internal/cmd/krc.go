@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/krc"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -24,12 +25,14 @@ and other operational data that decays in forensic value over days.
 KRC provides:
   - Configurable TTLs per event type
   - Auto-pruning of expired events
+  - Rotation of .events.jsonl into gzipped archive segments
   - Statistics on ephemeral data lifecycle
 
 Examples:
   gt krc stats              # Show event statistics
   gt krc prune              # Remove expired events
   gt krc prune --dry-run    # Preview what would be pruned
+  gt krc rotate             # Archive the events log if due
   gt krc config             # Show TTL configuration
   gt krc config set patrol_* 12h   # Set TTL for patrol events`,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -61,6 +64,17 @@ Use --dry-run to preview what would be pruned without making changes.`,
 	RunE: runKrcPrune,
 }
 
+var krcRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Rotate the events log into the archive",
+	Long: `Rotate .events.jsonl into .events-archive/ when it exceeds the configured
+size or age, gzip rotated segments, and delete segments beyond the retention
+limit. The daemon does this after every prune.
+
+Use --force to rotate now regardless of size and age.`,
+	RunE: runKrcRotate,
+}
+
 var krcConfigCmd = &cobra.Command{
 	Use:   "config [subcommand]",
 	Short: "View or modify TTL configuration",
@@ -102,18 +116,21 @@ var krcConfigResetCmd = &cobra.Command{
 var (
 	krcPruneDryRun bool
 	krcStatsJSON   bool
+	krcRotateForce bool
 )
 
 func init() {
 	rootCmd.AddCommand(krcCmd)
 	krcCmd.AddCommand(krcStatsCmd)
 	krcCmd.AddCommand(krcPruneCmd)
+	krcCmd.AddCommand(krcRotateCmd)
 	krcCmd.AddCommand(krcConfigCmd)
 	krcConfigCmd.AddCommand(krcConfigSetCmd)
 	krcConfigCmd.AddCommand(krcConfigResetCmd)
 
 	krcPruneCmd.Flags().BoolVar(&krcPruneDryRun, "dry-run", false, "Preview changes without modifying files")
 	krcStatsCmd.Flags().BoolVar(&krcStatsJSON, "json", false, "Output in JSON format")
+	krcRotateCmd.Flags().BoolVar(&krcRotateForce, "force", false, "Rotate even if the log is below the size and age limits")
 }
 
 func runKrcStats(cmd *cobra.Command, args []string) error {
@@ -291,6 +308,42 @@ func runKrcPrune(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runKrcRotate(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	config, err := krc.LoadConfig(townRoot)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	policy := config.Rotate
+	if krcRotateForce {
+		policy.MaxSize = 1
+	}
+	result, err := events.Rotate(townRoot, policy, time.Now())
+	if err != nil {
+		return fmt.Errorf("rotating: %w", err)
+	}
+
+	if result.Rotated == "" && len(result.Compressed) == 0 && len(result.Removed) == 0 {
+		fmt.Println("Events log is not due for rotation.")
+		return nil
+	}
+	if result.Rotated != "" {
+		fmt.Printf("%s Rotated events log to %s/%s\n", style.Success.Render("✓"), events.ArchiveDir, result.Rotated)
+	}
+	for _, seg := range result.Compressed {
+		fmt.Printf("  Compressed %s (%d events, %s)\n", seg.Name, seg.Count, formatBytes(seg.Bytes))
+	}
+	for _, name := range result.Removed {
+		fmt.Printf("  Removed %s\n", name)
+	}
+	return nil
+}
+
 func runKrcConfig(cmd *cobra.Command, args []string) error {
 	townRoot, err := workspace.FindFromCwd()
 	if err != nil {
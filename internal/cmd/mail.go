@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/mail"
 )
 
 // Mail command flags
@@ -18,6 +19,9 @@ var (
 	mailNotify        bool
 	mailSendSelf      bool
 	mailCC            []string // CC recipients
+	mailAttach        []string // Files attached by reference
+	mailAttachInline  []string // Files embedded in the message
+	mailReceipt       string   // Receipt mode: event or mail
 	mailInboxJSON     bool
 	mailReadJSON      bool
 	mailInboxUnread   bool
@@ -128,16 +132,31 @@ Examples:
   gt mail send greenplace/Toast -s "Task" -m "Fix bug" --type task --priority 1
   gt mail send greenplace/Toast -s "Urgent" -m "Help!" --urgent
   gt mail send mayor/ -s "Re: Status" -m "Done" --reply-to msg-abc123
+  gt mail send mayor/ -s "Review" -m "Diff attached" --attach-inline fix.diff
+  gt mail send overseer -s "Crash" -m "Full log" --attach /tmp/build.log
+  gt mail send overseer -s "Need approval" -m "Deploy?" --receipt=mail
   gt mail send --self -s "Handoff" -m "Context for next session"
   gt mail send greenplace/Toast -s "Update" -m "Progress report" --cc overseer
-  gt mail send list:oncall -s "Alert" -m "System down"`,
+  gt mail send list:oncall -s "Alert" -m "System down"
+
+Attachments:
+  --attach stores a reference to the file, which must stay in place.
+  --attach-inline embeds the file in the message (max 48KiB each, 64KiB
+  per message); use it for diffs, short logs and small screenshots.
+
+Receipts:
+  --receipt records delivery and read events in the activity feed.
+  --receipt=mail also mails you a read notification when the recipient
+  reads the message. Receipts apply to direct messages, not queues or
+  channels.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runMailSend,
 }
 
 var mailInboxCmd = &cobra.Command{
-	Use:   "inbox [address]",
-	Short: "Check inbox",
+	Use:     "inbox [address]",
+	Aliases: []string{"list"},
+	Short:   "Check inbox",
 	Long: `Check messages in an inbox.
 
 If no address is specified, shows the current context's inbox.
@@ -279,14 +298,17 @@ Examples:
 }
 
 var mailThreadCmd = &cobra.Command{
-	Use:   "thread <thread-id>",
+	Use:   "thread <thread-id|message-id>",
 	Short: "View a message thread",
 	Long: `View all messages in a conversation thread.
 
-Shows messages in chronological order (oldest first).
+Shows messages in conversation order: each reply is indented under the
+message it answers, and replies to the same message are oldest first.
+Any message ID in the thread can be given instead of the thread ID.
 
 Examples:
-  gt mail thread thread-abc123`,
+  gt mail thread thread-abc123
+  gt mail thread hq-msg-x7k2`,
 	Args: cobra.ExactArgs(1),
 	RunE: runMailThread,
 }
@@ -462,6 +484,10 @@ func init() {
 	mailSendCmd.Flags().BoolVar(&mailPermanent, "permanent", false, "Send as permanent (not ephemeral, synced to remote)")
 	mailSendCmd.Flags().BoolVar(&mailSendSelf, "self", false, "Send to self (auto-detect from cwd)")
 	mailSendCmd.Flags().StringArrayVar(&mailCC, "cc", nil, "CC recipients (can be used multiple times)")
+	mailSendCmd.Flags().StringArrayVar(&mailAttach, "attach", nil, "Attach a file by reference (can be used multiple times)")
+	mailSendCmd.Flags().StringArrayVar(&mailAttachInline, "attach-inline", nil, "Embed a small file in the message (can be used multiple times)")
+	mailSendCmd.Flags().StringVar(&mailReceipt, "receipt", "", "Request delivery/read receipts: event (default) or mail")
+	mailSendCmd.Flags().Lookup("receipt").NoOptDefVal = string(mail.ReceiptEvent)
 	_ = mailSendCmd.MarkFlagRequired("subject") // cobra flags: error only at runtime if missing
 
 	// Inbox flags
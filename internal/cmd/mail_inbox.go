@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
@@ -32,6 +33,24 @@ func getMailbox(address string) (*mail.Mailbox, error) {
 	return mailbox, nil
 }
 
+// acknowledgeMailRead sends the read receipt for a message the reader just
+// marked read, if its sender asked for one. Failures only warn: the message
+// itself was read fine.
+func acknowledgeMailRead(msg *mail.Message, reader string) {
+	if msg.Receipt == mail.ReceiptNone {
+		return
+	}
+	workDir, err := findMailWorkDir()
+	if err != nil {
+		return
+	}
+	if err := mail.NewRouter(workDir).AcknowledgeRead(msg, reader); err != nil {
+		style.PrintWarning("could not send read receipt: %v", err)
+		return
+	}
+	emitMailBusEvent(events.BusMailRead, reader, msg.From, msg.Subject)
+}
+
 func runMailInbox(cmd *cobra.Command, args []string) error {
 	// Check for mutually exclusive flags
 	if mailInboxAll && mailInboxUnread {
@@ -155,6 +174,8 @@ func runMailRead(cmd *cobra.Command, args []string) error {
 	if err := mailbox.MarkReadOnly(msgID); err != nil {
 		// Non-fatal: message was retrieved, just couldn't mark
 		style.PrintWarning("could not mark message as read: %v", err)
+	} else {
+		acknowledgeMailRead(msg, address)
 	}
 
 	// JSON output
@@ -194,6 +215,17 @@ func runMailRead(cmd *cobra.Command, args []string) error {
 		fmt.Printf("\n%s\n", msg.Body)
 	}
 
+	if len(msg.Attachments) > 0 {
+		fmt.Printf("\n%s\n", style.Bold.Render("Attachments:"))
+		for _, a := range msg.Attachments {
+			where := "inline"
+			if !a.IsInline() {
+				where = a.Path
+			}
+			fmt.Printf("  📎 %s (%s, %d bytes) %s\n", a.Name, a.ContentType, a.Size, style.Dim.Render(where))
+		}
+	}
+
 	return nil
 }
 
@@ -435,10 +467,14 @@ func runMailMarkRead(cmd *cobra.Command, args []string) error {
 	marked := 0
 	var errors []string
 	for _, msgID := range args {
+		msg, getErr := mailbox.Get(msgID)
 		if err := mailbox.MarkReadOnly(msgID); err != nil {
 			errors = append(errors, fmt.Sprintf("%s: %v", msgID, err))
 		} else {
 			marked++
+			if getErr == nil {
+				acknowledgeMailRead(msg, address)
+			}
 		}
 	}
 
@@ -1,19 +1,24 @@
 package cmd
 
 import (
+	"context"
 	"crypto/rand"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/bdcmd"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/federation"
 	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/rpcclient"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/terminal"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -95,6 +100,18 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 	// Set CC recipients
 	msg.CC = mailCC
 
+	attachments, err := buildMailAttachments(mailAttach, mailAttachInline)
+	if err != nil {
+		return err
+	}
+	msg.Attachments = attachments
+
+	receipt, err := mail.ParseReceipt(mailReceipt)
+	if err != nil {
+		return err
+	}
+	msg.Receipt = receipt
+
 	// Handle reply-to: auto-set type to reply and look up thread
 	if mailReplyTo != "" {
 		msg.ReplyTo = mailReplyTo
@@ -117,6 +134,11 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 		msg.ThreadID = generateThreadID()
 	}
 
+	// Addresses prefixed with a federated town go to that town's RPC server
+	if remote, ok := resolveFederated(to); ok {
+		return sendFederatedMail(remote, msg)
+	}
+
 	// Use address resolver for new address types
 	townRoot, _ := workspace.FindFromCwd()
 	b := beads.New(townRoot)
@@ -193,6 +215,12 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 	if len(msg.CC) > 0 {
 		fmt.Printf("  CC: %s\n", strings.Join(msg.CC, ", "))
 	}
+	if len(msg.Attachments) > 0 {
+		fmt.Printf("  Attachments: %d\n", len(msg.Attachments))
+	}
+	if msg.Receipt != mail.ReceiptNone {
+		fmt.Printf("  Receipt: %s\n", msg.Receipt)
+	}
 	if msg.Type != mail.TypeNotification {
 		fmt.Printf("  Type: %s\n", msg.Type)
 	}
@@ -245,9 +273,9 @@ func nudgeMailRecipient(recipientAddr, from, subject string) {
 
 	backend := terminal.ResolveBackend(target)
 	switch b := backend.(type) {
-	case *terminal.CoopBackend:
-		// Coop agent: nudge with mail notification message.
-		// Session name is always "claude" for coop agents.
+	case *terminal.CoopBackend, *terminal.DockerBackend:
+		// Coop or container agent: nudge with mail notification message.
+		// ResolveBackend registers the session as "claude" for both.
 		message := fmt.Sprintf("[mail from %s] %s", from, subject)
 		if err := b.NudgeSession("claude", message); err != nil {
 			fmt.Fprintf(os.Stderr, "mail-nudge: %s: %v\n", target, err)
@@ -310,3 +338,79 @@ func expandMailShortcut(address string) string {
 		return address
 	}
 }
+
+// buildMailAttachments creates attachments for --attach (by reference) and
+// --attach-inline (embedded) files, checking the message-wide limits.
+func buildMailAttachments(refs, inline []string) ([]mail.Attachment, error) {
+	var attachments []mail.Attachment
+	for _, path := range refs {
+		a, err := mail.NewFileAttachment(path)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, a)
+	}
+	for _, path := range inline {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading attachment: %w", err)
+		}
+		a, err := mail.NewInlineAttachment(path, data)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, a)
+	}
+	if err := mail.ValidateAttachments(attachments); err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+// sendFederatedMail forwards a message to a peer town's MailService. The
+// sender is qualified with this town's name so replies can find their way back.
+func sendFederatedMail(remote *federatedTarget, msg *mail.Message) error {
+	req := rpcclient.SendMailRequest{
+		To:       remote.local,
+		Subject:  msg.Subject,
+		Body:     msg.Body,
+		Priority: string(msg.Priority),
+		Type:     string(msg.Type),
+		CC:       msg.CC,
+		From:     federation.Qualify(remote.localTown, msg.From),
+	}
+	switch msg.Receipt {
+	case mail.ReceiptEvent:
+		req.Receipt = "event"
+	case mail.ReceiptMail:
+		req.Receipt = "mail"
+	}
+	for _, a := range msg.Attachments {
+		// Referenced files live on this machine; only embedded content travels
+		if a.Data == "" {
+			return fmt.Errorf("attachment %s is a file reference; use --attach-inline when mailing another town", a.Name)
+		}
+		data, err := base64.StdEncoding.DecodeString(a.Data)
+		if err != nil {
+			return fmt.Errorf("decoding attachment %s: %w", a.Name, err)
+		}
+		req.Attachments = append(req.Attachments, rpcclient.MailAttachment{
+			Name:        a.Name,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			Data:        data,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if _, err := remote.client().SendMail(ctx, req); err != nil {
+		return fmt.Errorf("sending to town %s: %w", remote.town.Name, err)
+	}
+
+	_ = events.LogFeed(events.TypeMail, msg.From, events.MailPayload(remote.String(), msg.Subject))
+	fmt.Printf("%s Message sent to %s\n", style.Bold.Render("✓"), remote)
+	fmt.Printf("  Subject: %s\n", msg.Subject)
+	fmt.Printf("  Via: %s\n", remote.town.URL)
+	return nil
+}
@@ -30,6 +30,13 @@ func runMailThread(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("getting mailbox: %w", err)
 	}
 
+	// Accept any message ID in the thread as well as the thread ID.
+	if !strings.HasPrefix(threadID, "thread-") {
+		if msg, err := mailbox.Get(threadID); err == nil && msg.ThreadID != "" {
+			threadID = msg.ThreadID
+		}
+	}
+
 	messages, err := mailbox.ListByThread(threadID)
 	if err != nil {
 		return fmt.Errorf("getting thread: %w", err)
@@ -51,7 +58,11 @@ func runMailThread(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	for i, msg := range messages {
+	// Replies are indented under the message they answer.
+	for i, entry := range mail.OrderThread(messages) {
+		msg := entry.Message
+		indent := "  " + strings.Repeat("  ", entry.Depth)
+
 		typeMarker := ""
 		if msg.Type != "" && msg.Type != mail.TypeNotification {
 			typeMarker = fmt.Sprintf(" [%s]", msg.Type)
@@ -62,17 +73,17 @@ func runMailThread(cmd *cobra.Command, args []string) error {
 		}
 
 		if i > 0 {
-			fmt.Printf("  %s\n", style.Dim.Render("│"))
+			fmt.Printf("%s%s\n", indent, style.Dim.Render("│"))
 		}
-		fmt.Printf("  %s %s%s%s\n", style.Bold.Render("●"), msg.Subject, typeMarker, priorityMarker)
-		fmt.Printf("    %s from %s to %s\n",
+		fmt.Printf("%s%s %s%s%s\n", indent, style.Bold.Render("●"), msg.Subject, typeMarker, priorityMarker)
+		fmt.Printf("%s  %s from %s to %s\n", indent,
 			style.Dim.Render(msg.ID),
 			msg.From, msg.To)
-		fmt.Printf("    %s\n",
+		fmt.Printf("%s  %s\n", indent,
 			style.Dim.Render(msg.Timestamp.Format("2006-01-02 15:04")))
 
 		if msg.Body != "" {
-			fmt.Printf("    %s\n", msg.Body)
+			fmt.Printf("%s  %s\n", indent, strings.ReplaceAll(msg.Body, "\n", "\n"+indent+"  "))
 		}
 	}
 
@@ -6,7 +6,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
-	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/eventbus"
 	"github.com/steveyegge/gastown/internal/mayor"
 	"github.com/steveyegge/gastown/internal/rpcclient"
 	"github.com/steveyegge/gastown/internal/style"
@@ -185,11 +185,7 @@ func runMayorStartK8s() error {
 	// Emit spawn event with payload fields the controller's watcher can parse.
 	// The watcher's extractAgentInfo reads payload["rig"], payload["role"],
 	// payload["agent"] when the actor field doesn't have 3 parts.
-	_ = events.LogFeed(events.TypeSpawn, "mayor", map[string]interface{}{
-		"rig":   "town",
-		"role":  "mayor",
-		"agent": "hq",
-	})
+	_ = eventbus.Publish("mayor", eventbus.Spawn{Rig: "town", Role: "mayor", Agent: "hq"})
 
 	fmt.Printf("%s Mayor dispatched to K8s (agent_state=spawning, bead=%s)\n",
 		style.Bold.Render("✓"), agentBeadID)
@@ -125,13 +125,17 @@ Example:
 }
 
 var moleculeStatusCmd = &cobra.Command{
-	Use:   "status [target]",
-	Short: "Show what's on an agent's hook",
+	Use:   "status [target|bead]",
+	Short: "Show what's on an agent's hook, or a bead's molecule progress",
 	Long: `Show what's slung on an agent's hook.
 
 If no target is specified, shows the current agent's status based on
 the working directory (polecat, crew member, witness, etc.).
 
+Given a bead ID instead of an agent, shows the progress of the molecule
+attached to the bead (or rooted at it): the current step, percent done,
+and which steps are blocked and by what.
+
 Output includes:
 - What's slung (molecule name, associated issue)
 - Current phase and progress
@@ -141,7 +145,8 @@ Output includes:
 Examples:
   gt mol status                       # Show current agent's hook
   gt mol status greenplace/nux        # Show specific polecat's hook
-  gt mol status greenplace/witness    # Show witness's hook`,
+  gt mol status greenplace/witness    # Show witness's hook
+  gt mol status gt-abc                # Show molecule progress for a bead`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runMoleculeStatus,
 }
@@ -149,111 +149,14 @@ func runMoleculeProgress(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a beads workspace: %w", err)
 	}
 
-	b := beads.New(workDir)
-
-	// Get the root issue
-	root, err := b.Show(rootID)
-	if err != nil {
-		return fmt.Errorf("getting root issue: %w", err)
-	}
-
-	// Find all children of the root issue
-	children, err := b.List(beads.ListOptions{
-		Parent:   rootID,
-		Status:   "all",
-		Priority: -1,
-	})
+	progress, err := getMoleculeProgressInfo(beads.New(workDir), rootID)
 	if err != nil {
-		return fmt.Errorf("listing children: %w", err)
+		return err
 	}
-
-	if len(children) == 0 {
+	if progress == nil {
 		return fmt.Errorf("no steps found for %s (not a molecule root?)", rootID)
 	}
 
-	// Build progress info
-	progress := MoleculeProgressInfo{
-		RootID:    rootID,
-		RootTitle: root.Title,
-	}
-
-	// Try to find molecule ID from first child's description
-	for _, child := range children {
-		if molID := extractMoleculeID(child.Description); molID != "" {
-			progress.MoleculeID = molID
-			break
-		}
-	}
-
-	// Build set of closed issue IDs and collect open step IDs for dependency checking
-	closedIDs := make(map[string]bool)
-	var openStepIDs []string
-	for _, child := range children {
-		if child.Status == "closed" {
-			closedIDs[child.ID] = true
-		} else if child.Status == "open" {
-			openStepIDs = append(openStepIDs, child.ID)
-		}
-	}
-
-	// Fetch full details for open steps to get dependency info.
-	// bd list doesn't return dependencies, but bd show does.
-	var openStepsMap map[string]*beads.Issue
-	if len(openStepIDs) > 0 {
-		openStepsMap, err = b.ShowMultiple(openStepIDs)
-		if err != nil {
-			// Non-fatal: continue without dependency info (all open steps will be "ready")
-			openStepsMap = make(map[string]*beads.Issue)
-		}
-	}
-
-	// Categorize steps
-	for _, child := range children {
-		progress.TotalSteps++
-
-		switch child.Status {
-		case "closed":
-			progress.DoneSteps++
-		case "in_progress":
-			progress.InProgress++
-		case "open":
-			// Get full step info with dependencies
-			step := openStepsMap[child.ID]
-
-			// Check if all dependencies are closed using Dependencies field
-			// (from bd show), not DependsOn (which is empty from bd list).
-			// Only "blocks" type dependencies block progress - ignore "parent-child".
-			allDepsClosed := true
-			hasBlockingDeps := false
-			var deps []beads.IssueDep
-			if step != nil {
-				deps = step.Dependencies
-			}
-			for _, dep := range deps {
-				if dep.DependencyType != "blocks" {
-					continue // Skip parent-child and other non-blocking relationships
-				}
-				hasBlockingDeps = true
-				if !closedIDs[dep.ID] {
-					allDepsClosed = false
-					break
-				}
-			}
-
-			if !hasBlockingDeps || allDepsClosed {
-				progress.ReadySteps = append(progress.ReadySteps, child.ID)
-			} else {
-				progress.BlockedSteps = append(progress.BlockedSteps, child.ID)
-			}
-		}
-	}
-
-	// Calculate completion percentage
-	if progress.TotalSteps > 0 {
-		progress.Percent = (progress.DoneSteps * 100) / progress.TotalSteps
-	}
-	progress.Complete = progress.DoneSteps == progress.TotalSteps
-
 	// JSON output
 	if moleculeJSON {
 		enc := json.NewEncoder(os.Stdout)
@@ -262,7 +165,7 @@ func runMoleculeProgress(cmd *cobra.Command, args []string) error {
 	}
 
 	// Human-readable output
-	fmt.Printf("\n%s %s\n\n", style.Bold.Render("🧬 Molecule Progress:"), root.Title)
+	fmt.Printf("\n%s %s\n\n", style.Bold.Render("🧬 Molecule Progress:"), progress.RootTitle)
 	fmt.Printf("  Root: %s\n", rootID)
 	if progress.MoleculeID != "" {
 		fmt.Printf("  Molecule: %s\n", progress.MoleculeID)
@@ -270,10 +173,7 @@ func runMoleculeProgress(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 
 	// Progress bar
-	barWidth := 20
-	filled := (progress.Percent * barWidth) / 100
-	bar := strings.Repeat("█", filled) + strings.Repeat("░", barWidth-filled)
-	fmt.Printf("  [%s] %d%% (%d/%d)\n\n", bar, progress.Percent, progress.DoneSteps, progress.TotalSteps)
+	fmt.Printf("  [%s] %d%% (%d/%d)\n\n", progressBar(progress.Percent), progress.Percent, progress.DoneSteps, progress.TotalSteps)
 
 	// Step status
 	fmt.Printf("  Done:        %d\n", progress.DoneSteps)
@@ -292,18 +192,6 @@ func runMoleculeProgress(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// extractMoleculeID extracts the molecule ID from an issue's description.
-func extractMoleculeID(description string) string {
-	lines := strings.Split(description, "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if strings.HasPrefix(line, "instantiated_from:") {
-			return strings.TrimSpace(strings.TrimPrefix(line, "instantiated_from:"))
-		}
-	}
-	return ""
-}
-
 func runMoleculeStatus(cmd *cobra.Command, args []string) error {
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -324,8 +212,12 @@ func runMoleculeStatus(cmd *cobra.Command, args []string) error {
 	var roleCtx RoleContext
 
 	if len(args) > 0 {
-		// Explicit target provided
+		// Explicit target provided: a bead shows its molecule's progress,
+		// anything else is an agent whose hook to show.
 		target = args[0]
+		if !strings.Contains(target, "/") && looksLikeBeadID(target) {
+			return runMoleculeBeadStatus(target)
+		}
 	} else {
 		// Use GetRoleWithContext which checks GT_ROLE env var first (authoritative),
 		// then falls back to cwd detection. This is critical for K8s agents where
@@ -517,6 +409,82 @@ func runMoleculeStatus(cmd *cobra.Command, args []string) error {
 	return outputMoleculeStatus(status)
 }
 
+// MoleculeBeadStatus is the progress of the molecule bonded to a bead.
+type MoleculeBeadStatus struct {
+	BeadID      string                  `json:"bead_id"`
+	BeadTitle   string                  `json:"bead_title"`
+	Progress    *beads.MoleculeProgress `json:"progress,omitempty"`
+	CurrentStep *beads.StepProgress     `json:"current_step,omitempty"`
+	Blockers    []beads.StepProgress    `json:"blockers,omitempty"`
+}
+
+// runMoleculeBeadStatus shows the current step, percent done and blockers
+// of the molecule attached to (or rooted at) beadID.
+func runMoleculeBeadStatus(beadID string) error {
+	workDir, err := findLocalBeadsDir()
+	if err != nil {
+		return fmt.Errorf("not in a beads workspace: %w", err)
+	}
+
+	issue, progress, err := beads.New(workDir).BeadMoleculeProgress(beadID)
+	if err != nil {
+		return fmt.Errorf("getting progress for %s: %w", beadID, err)
+	}
+	status := MoleculeBeadStatus{BeadID: issue.ID, BeadTitle: issue.Title, Progress: progress}
+	if progress != nil {
+		status.CurrentStep = progress.CurrentStep()
+		status.Blockers = progress.Blockers()
+	}
+
+	if moleculeJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(status)
+	}
+
+	fmt.Printf("\n%s %s: %s\n", style.Bold.Render("🧬 Molecule Status:"), status.BeadID, status.BeadTitle)
+	if progress == nil {
+		fmt.Printf("%s\n", style.Dim.Render("No molecule attached and no steps under this bead"))
+		return nil
+	}
+	if progress.RootID != status.BeadID {
+		fmt.Printf("   Molecule: %s", progress.RootID)
+		if progress.MoleculeID != "" {
+			fmt.Printf(" (from %s)", progress.MoleculeID)
+		}
+		fmt.Println()
+	}
+	fmt.Println()
+	fmt.Printf("Progress: [%s] %d%% (%d/%d steps)\n",
+		progressBar(progress.Percent), progress.Percent, progress.DoneSteps, progress.TotalSteps)
+
+	if progress.Complete {
+		fmt.Printf("\n%s\n", style.Bold.Render("✓ Molecule complete!"))
+		return nil
+	}
+
+	if step := status.CurrentStep; step != nil {
+		fmt.Printf("\n%s %s: %s (%s)\n", style.Bold.Render("Current step:"), step.ID, step.Title, step.State)
+	} else {
+		fmt.Printf("\n%s\n", style.Dim.Render("No step can start: every open step is blocked"))
+	}
+
+	if len(status.Blockers) > 0 {
+		fmt.Printf("\n%s\n", style.Bold.Render("Blocked:"))
+		for _, s := range status.Blockers {
+			fmt.Printf("  %s: %s ← %s\n", s.ID, s.Title, strings.Join(s.BlockedBy, ", "))
+		}
+	}
+	return nil
+}
+
+// progressBar renders percent as a 20-cell bar.
+func progressBar(percent int) string {
+	const width = 20
+	filled := (percent * width) / 100
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
+
 // buildAgentIdentity constructs the agent identity string from role context.
 // Town-level agents (mayor, deacon) use trailing slash to match the format
 // used when setting assignee on hooked beads (see resolveSelfTarget in sling.go).
@@ -540,112 +508,24 @@ func buildAgentIdentity(ctx RoleContext) string {
 }
 
 // getMoleculeProgressInfo gets progress info for a molecule instance.
+// Returns nil, nil when the root has no steps.
 func getMoleculeProgressInfo(b *beads.Beads, moleculeRootID string) (*MoleculeProgressInfo, error) {
-	// Get the molecule root issue
-	root, err := b.Show(moleculeRootID)
-	if err != nil {
-		return nil, fmt.Errorf("getting molecule root: %w", err)
-	}
-
-	// Find all children of the root issue
-	children, err := b.List(beads.ListOptions{
-		Parent:   moleculeRootID,
-		Status:   "all",
-		Priority: -1,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("listing children: %w", err)
-	}
-
-	if len(children) == 0 {
-		// No children - might be a simple issue, not a molecule
-		return nil, nil
-	}
-
-	// Build progress info
-	progress := &MoleculeProgressInfo{
-		RootID:    moleculeRootID,
-		RootTitle: root.Title,
-	}
-
-	// Try to find molecule ID from first child's description
-	for _, child := range children {
-		if molID := extractMoleculeID(child.Description); molID != "" {
-			progress.MoleculeID = molID
-			break
-		}
-	}
-
-	// Build set of closed issue IDs and collect open step IDs for dependency checking
-	closedIDs := make(map[string]bool)
-	var openStepIDs []string
-	for _, child := range children {
-		if child.Status == "closed" {
-			closedIDs[child.ID] = true
-		} else if child.Status == "open" {
-			openStepIDs = append(openStepIDs, child.ID)
-		}
-	}
-
-	// Fetch full details for open steps to get dependency info.
-	// bd list doesn't return dependencies, but bd show does.
-	var openStepsMap map[string]*beads.Issue
-	if len(openStepIDs) > 0 {
-		openStepsMap, err = b.ShowMultiple(openStepIDs)
-		if err != nil {
-			// Non-fatal: continue without dependency info (all open steps will be "ready")
-			openStepsMap = make(map[string]*beads.Issue)
-		}
-	}
-
-	// Categorize steps
-	for _, child := range children {
-		progress.TotalSteps++
-
-		switch child.Status {
-		case "closed":
-			progress.DoneSteps++
-		case "in_progress":
-			progress.InProgress++
-		case "open":
-			// Get full step info with dependencies
-			step := openStepsMap[child.ID]
-
-			// Check if all dependencies are closed using Dependencies field
-			// (from bd show), not DependsOn (which is empty from bd list).
-			// Only "blocks" type dependencies block progress - ignore "parent-child".
-			allDepsClosed := true
-			hasBlockingDeps := false
-			var deps []beads.IssueDep
-			if step != nil {
-				deps = step.Dependencies
-			}
-			for _, dep := range deps {
-				if dep.DependencyType != "blocks" {
-					continue // Skip parent-child and other non-blocking relationships
-				}
-				hasBlockingDeps = true
-				if !closedIDs[dep.ID] {
-					allDepsClosed = false
-					break
-				}
-			}
-
-			if !hasBlockingDeps || allDepsClosed {
-				progress.ReadySteps = append(progress.ReadySteps, child.ID)
-			} else {
-				progress.BlockedSteps = append(progress.BlockedSteps, child.ID)
-			}
-		}
-	}
-
-	// Calculate completion percentage
-	if progress.TotalSteps > 0 {
-		progress.Percent = (progress.DoneSteps * 100) / progress.TotalSteps
-	}
-	progress.Complete = progress.DoneSteps == progress.TotalSteps
-
-	return progress, nil
+	p, err := b.MoleculeProgress(moleculeRootID)
+	if err != nil || p == nil {
+		return nil, err
+	}
+	return &MoleculeProgressInfo{
+		RootID:       p.RootID,
+		RootTitle:    p.RootTitle,
+		MoleculeID:   p.MoleculeID,
+		TotalSteps:   p.TotalSteps,
+		DoneSteps:    p.DoneSteps,
+		InProgress:   p.InProgress,
+		ReadySteps:   p.StepIDs(beads.StepReady),
+		BlockedSteps: p.StepIDs(beads.StepBlocked),
+		Percent:      p.Percent,
+		Complete:     p.Complete,
+	}, nil
 }
 
 // determineNextAction suggests the next action based on status.
@@ -742,11 +622,8 @@ func outputMoleculeStatus(status MoleculeStatusInfo) error {
 		fmt.Println()
 
 		// Progress bar
-		barWidth := 20
-		filled := (status.Progress.Percent * barWidth) / 100
-		bar := strings.Repeat("█", filled) + strings.Repeat("░", barWidth-filled)
 		fmt.Printf("Progress: [%s] %d%% (%d/%d steps)\n",
-			bar, status.Progress.Percent, status.Progress.DoneSteps, status.Progress.TotalSteps)
+			progressBar(status.Progress.Percent), status.Progress.Percent, status.Progress.DoneSteps, status.Progress.TotalSteps)
 
 		// Step breakdown
 		fmt.Printf("  Done:        %d\n", status.Progress.DoneSteps)
@@ -791,8 +668,12 @@ func runMoleculeCurrent(cmd *cobra.Command, args []string) error {
 	var roleCtx RoleContext
 
 	if len(args) > 0 {
-		// Explicit target provided
+		// Explicit target provided: a bead shows its molecule's progress,
+		// anything else is an agent whose hook to show.
 		target = args[0]
+		if !strings.Contains(target, "/") && looksLikeBeadID(target) {
+			return runMoleculeBeadStatus(target)
+		}
 	} else {
 		// Use GetRoleWithContext which checks GT_ROLE env var first (authoritative),
 		// then falls back to cwd detection. This is critical for K8s agents where
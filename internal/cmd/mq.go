@@ -64,7 +64,8 @@ var mqCmd = &cobra.Command{
 Alias: 'gt mr' is equivalent to 'gt mq' (merge request vs merge queue).
 
 The merge queue tracks work branches from polecats waiting to be merged.
-Use these commands to view, submit, retry, and manage merge requests.`,
+Use these commands to view, submit, retry, and manage merge requests.
+Use bump, hold, and release to change the order the refinery processes MRs.`,
 }
 
 var mqSubmitCmd = &cobra.Command{
@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// MRNeedsRebaseLabel marks an MR whose branch conflicts with its target.
+// The MR is skipped by 'gt mq next' until a new commit is pushed to the branch.
+const MRNeedsRebaseLabel = "mq:needs-rebase"
+
+// conflictResolveFormula is slung with the conflict task when a fixer polecat is spawned.
+const conflictResolveFormula = "mol-polecat-conflict-resolve"
+
+// MQ conflict command flags
+var (
+	mqConflictFiles    []string
+	mqConflictStrategy string
+)
+
+var mqConflictCmd = &cobra.Command{
+	Use:   "conflict <rig> <mr-id>",
+	Short: "Record a merge conflict and route it for rebase",
+	Long: `Record that a merge request conflicts with its target branch.
+
+Called by the refinery when a rebase fails. Instead of just logging the
+failure, this:
+  1. Marks the MR bead needs-rebase and records the conflicting SHAs
+  2. Routes the conflict according to the rig's on_conflict setting:
+       assign_back  Nudge the originating polecat with the conflict details
+                    (falls back to auto_rebase if its session is gone)
+       auto_rebase  Create a conflict-resolution task, block the MR on it,
+                    and sling it to a fresh polecat (mol-polecat-conflict-resolve)
+  3. Leaves the MR in the queue. 'gt mq next' skips it until a new commit
+     is pushed to the branch (or the blocking task closes), then retries it.
+
+Examples:
+  gt mq conflict gastown gt-mr-abc123
+  gt mq conflict gastown gt-mr-abc123 --files internal/cmd/mq.go,go.mod
+  gt mq conflict gastown gt-mr-abc123 --strategy auto_rebase`,
+	Args: cobra.ExactArgs(2),
+	RunE: runMQConflict,
+}
+
+func init() {
+	mqConflictCmd.Flags().StringSliceVar(&mqConflictFiles, "files", nil, "Files with conflicts (comma-separated)")
+	mqConflictCmd.Flags().StringVar(&mqConflictStrategy, "strategy", "", "Override on_conflict: assign_back or auto_rebase")
+
+	mqCmd.AddCommand(mqConflictCmd)
+}
+
+func runMQConflict(cmd *cobra.Command, args []string) error {
+	rigName, mrID := args[0], args[1]
+
+	strategy := mqConflictStrategy
+	if strategy != "" && strategy != config.OnConflictAssignBack && strategy != config.OnConflictAutoRebase {
+		return fmt.Errorf("invalid --strategy %q: want %s or %s", strategy, config.OnConflictAssignBack, config.OnConflictAutoRebase)
+	}
+
+	_, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+	if strategy == "" {
+		strategy = rigOnConflict(r.Path)
+	}
+
+	b := beads.New(r.BeadsPath())
+	mr, err := b.Show(mrID)
+	if err != nil {
+		if err == beads.ErrNotFound {
+			return fmt.Errorf("merge request '%s' not found", mrID)
+		}
+		return fmt.Errorf("fetching merge request: %w", err)
+	}
+	fields := beads.ParseMRFields(mr)
+	if fields == nil || fields.Branch == "" {
+		return fmt.Errorf("merge request %s has no branch", mrID)
+	}
+	target := fields.Target
+	if target == "" {
+		target = r.TargetBranch()
+	}
+
+	// Record where the branch and target were, so a later push can be detected
+	g := git.NewGit(r.Path)
+	targetSHA, _ := g.Rev("origin/" + target)
+	fields.RetryCount++
+	fields.LastConflictSHA = targetSHA
+	fields.ConflictHeadSHA, _ = g.Rev("origin/" + fields.Branch)
+
+	conflict := mrConflict{
+		rig:      rigName,
+		mr:       mr,
+		fields:   fields,
+		target:   target,
+		files:    mqConflictFiles,
+		townRoot: filepath.Dir(r.Path),
+	}
+
+	routed := ""
+	if strategy == config.OnConflictAssignBack {
+		if conflict.nudgeWorker() {
+			routed = "nudged " + fields.Worker
+		} else {
+			fmt.Printf("  %s\n", style.Dim.Render("Originating polecat is gone, spawning a fixer instead"))
+			strategy = config.OnConflictAutoRebase
+		}
+	}
+	if strategy == config.OnConflictAutoRebase {
+		taskID, err := conflict.spawnFixer(b)
+		if err != nil {
+			return err
+		}
+		fields.ConflictTaskID = taskID
+		routed = "conflict task " + taskID
+	}
+
+	desc := beads.SetMRFields(mr, fields)
+	if err := b.Update(mrID, beads.UpdateOptions{
+		Description: &desc,
+		AddLabels:   []string{MRNeedsRebaseLabel},
+	}); err != nil {
+		return fmt.Errorf("updating merge request: %w", err)
+	}
+
+	reason := fmt.Sprintf("conflict with %s, %s", target, routed)
+	_ = events.LogFeed(events.TypeMergeFailed, rigName+"/refinery",
+		events.MergePayload(mrID, fields.Worker, fields.Branch, reason))
+
+	fmt.Printf("%s %s needs rebase onto %s (retry %d): %s\n",
+		style.Bold.Render("⚠"), mrID, target, fields.RetryCount, routed)
+	return nil
+}
+
+// rigOnConflict returns the rig's on_conflict setting, defaulting to assign_back.
+func rigOnConflict(rigPath string) string {
+	settings, err := config.LoadRigSettings(filepath.Join(rigPath, "settings", "config.json"))
+	if err != nil || settings.MergeQueue == nil || settings.MergeQueue.OnConflict == "" {
+		return config.OnConflictAssignBack
+	}
+	return settings.MergeQueue.OnConflict
+}
+
+// mrConflict holds what the refinery knows about a failed rebase.
+type mrConflict struct {
+	rig      string
+	mr       *beads.Issue
+	fields   *beads.MRFields
+	target   string
+	files    []string
+	townRoot string
+}
+
+// nudgeWorker tells the polecat that submitted the MR to rebase.
+// Returns false if the polecat's session is not running.
+func (c *mrConflict) nudgeWorker() bool {
+	if c.fields.Worker == "" {
+		return false
+	}
+	backend, sessionKey := resolveBackendForSession(session.PolecatSessionName(c.rig, c.fields.Worker))
+	if alive, err := backend.HasSession(sessionKey); err != nil || !alive {
+		return false
+	}
+
+	msg := fmt.Sprintf("REWORK_REQUEST: %s conflicts with %s. Rebase %s onto origin/%s, resolve conflicts, and push; the refinery retries once a new commit lands.",
+		c.mr.ID, c.target, c.fields.Branch, c.target)
+	if len(c.files) > 0 {
+		msg += " Conflicting files: " + strings.Join(c.files, ", ")
+	}
+	return backend.NudgeSession(sessionKey, msg) == nil
+}
+
+// spawnFixer creates a conflict-resolution task, blocks the MR on it, and
+// slings it to a fresh polecat. Returns the task ID.
+func (c *mrConflict) spawnFixer(b *beads.Beads) (string, error) {
+	task, err := b.Create(beads.CreateOptions{
+		Title:       "Resolve merge conflicts: " + c.mr.Title,
+		Type:        "task",
+		Priority:    c.mr.Priority,
+		Description: c.taskDescription(),
+		Actor:       c.rig + "/refinery",
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating conflict task: %w", err)
+	}
+
+	if err := b.AddDependency(c.mr.ID, task.ID); err != nil {
+		fmt.Printf("  %s could not block %s on %s: %v\n", style.Dim.Render("Warning:"), c.mr.ID, task.ID, err)
+	}
+
+	slingCmd := exec.Command("gt", "sling", conflictResolveFormula, "--on", task.ID, c.rig)
+	slingCmd.Dir = c.townRoot
+	slingCmd.Stdout = os.Stdout
+	slingCmd.Stderr = os.Stderr
+	if err := slingCmd.Run(); err != nil {
+		fmt.Printf("  %s failed to sling %s: %v (task left open for manual dispatch)\n",
+			style.Dim.Render("Warning:"), task.ID, err)
+	}
+	return task.ID, nil
+}
+
+// taskDescription formats the metadata block mol-polecat-conflict-resolve parses.
+func (c *mrConflict) taskDescription() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Merge request %s no longer rebases cleanly onto %s.\n\n", c.mr.ID, c.target))
+	sb.WriteString("## Metadata\n")
+	sb.WriteString(fmt.Sprintf("- Original MR: %s\n", c.mr.ID))
+	sb.WriteString(fmt.Sprintf("- Branch: %s\n", c.fields.Branch))
+	sb.WriteString(fmt.Sprintf("- Conflict with: %s@%s\n", c.target, c.fields.LastConflictSHA))
+	if c.fields.SourceIssue != "" {
+		sb.WriteString(fmt.Sprintf("- Original issue: %s\n", c.fields.SourceIssue))
+	}
+	sb.WriteString(fmt.Sprintf("- Retry count: %d\n", c.fields.RetryCount))
+	if len(c.files) > 0 {
+		sb.WriteString(fmt.Sprintf("- Conflict files: %s\n", strings.Join(c.files, ", ")))
+	}
+	return sb.String()
+}
+
+// awaitingRebase reports whether an MR marked needs-rebase is still waiting
+// for a fix: its branch head is unchanged since the conflict was recorded.
+// A branch that cannot be resolved is treated as still waiting.
+func awaitingRebase(g *git.Git, issue *beads.Issue, fields *beads.MRFields) bool {
+	if !beads.HasLabel(issue, MRNeedsRebaseLabel) || fields == nil {
+		return false
+	}
+	head, err := g.Rev("origin/" + fields.Branch)
+	if err != nil {
+		return true
+	}
+	return head == fields.ConflictHeadSHA
+}
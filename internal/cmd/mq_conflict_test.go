@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+func TestMRConflictTaskDescription(t *testing.T) {
+	c := &mrConflict{
+		rig:    "gastown",
+		mr:     &beads.Issue{ID: "gt-mr-abc", Title: "Add widget"},
+		target: "main",
+		files:  []string{"go.mod", "internal/cmd/mq.go"},
+		fields: &beads.MRFields{
+			Branch:          "polecat/Nux/gt-xyz",
+			SourceIssue:     "gt-xyz",
+			LastConflictSHA: "deadbeef",
+			RetryCount:      2,
+		},
+	}
+
+	desc := c.taskDescription()
+	for _, want := range []string{
+		"## Metadata",
+		"- Original MR: gt-mr-abc",
+		"- Branch: polecat/Nux/gt-xyz",
+		"- Conflict with: main@deadbeef",
+		"- Original issue: gt-xyz",
+		"- Retry count: 2",
+		"- Conflict files: go.mod, internal/cmd/mq.go",
+	} {
+		if !strings.Contains(desc, want) {
+			t.Errorf("task description missing %q:\n%s", want, desc)
+		}
+	}
+}
+
+func TestRigOnConflict(t *testing.T) {
+	rigPath := t.TempDir()
+	if got := rigOnConflict(rigPath); got != config.OnConflictAssignBack {
+		t.Errorf("default on_conflict = %q, want %q", got, config.OnConflictAssignBack)
+	}
+
+	settingsDir := filepath.Join(rigPath, "settings")
+	if err := os.MkdirAll(settingsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := `{"type":"rig-settings","version":1,"merge_queue":{"on_conflict":"auto_rebase"}}`
+	if err := os.WriteFile(filepath.Join(settingsDir, "config.json"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := rigOnConflict(rigPath); got != config.OnConflictAutoRebase {
+		t.Errorf("on_conflict = %q, want %q", got, config.OnConflictAutoRebase)
+	}
+}
+
+func TestAwaitingRebase_RequiresLabel(t *testing.T) {
+	g := git.NewGit(t.TempDir())
+	fields := &beads.MRFields{Branch: "polecat/Nux/gt-xyz", ConflictHeadSHA: "abc"}
+
+	if awaitingRebase(g, &beads.Issue{ID: "gt-mr-1"}, fields) {
+		t.Error("MR without needs-rebase label should not be waiting")
+	}
+	// Labelled but branch can't be resolved: keep waiting rather than retry blindly
+	labelled := &beads.Issue{ID: "gt-mr-2", Labels: []string{MRNeedsRebaseLabel}}
+	if !awaitingRebase(g, labelled, fields) {
+		t.Error("labelled MR with unresolvable branch should be waiting")
+	}
+}
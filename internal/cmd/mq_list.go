@@ -10,6 +10,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/style"
 )
 
@@ -160,6 +161,7 @@ func runMQList(cmd *cobra.Command, args []string) error {
 	)
 
 	// Add rows using scored items (already sorted by score)
+	g := git.NewGit(r.Path)
 	for _, item := range scored {
 		issue := item.issue
 		fields := item.fields
@@ -173,6 +175,13 @@ func runMQList(cmd *cobra.Command, args []string) error {
 				displayStatus = "ready"
 			}
 		}
+		if issue.Status != "closed" {
+			if isMRHeld(fields) {
+				displayStatus = "held"
+			} else if awaitingRebase(g, issue, fields) {
+				displayStatus = "rebase"
+			}
+		}
 
 		// Format status with styling
 		styledStatus := displayStatus
@@ -183,6 +192,10 @@ func runMQList(cmd *cobra.Command, args []string) error {
 			styledStatus = style.Warning.Render("active")
 		case "blocked":
 			styledStatus = style.Dim.Render("blocked")
+		case "held":
+			styledStatus = style.Warning.Render("held")
+		case "rebase":
+			styledStatus = style.Error.Render("rebase")
 		case "closed":
 			styledStatus = style.Dim.Render("closed")
 		}
@@ -212,6 +225,9 @@ func runMQList(cmd *cobra.Command, args []string) error {
 		} else if issue.Priority == 2 {
 			priority = style.Warning.Render(priority)
 		}
+		if fields != nil && fields.QueuePriority > 0 {
+			priority += "⏫"
+		}
 
 		// Format score
 		scoreStr := fmt.Sprintf("%.1f", item.score)
@@ -245,6 +261,14 @@ func runMQList(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  %s %s\n", style.Dim.Render(displayID+":"),
 				style.Dim.Render(fmt.Sprintf("waiting on %s", issue.BlockedBy[0])))
 		}
+		if isMRHeld(item.fields) && item.fields.HoldReason != "" {
+			displayID := issue.ID
+			if len(displayID) > 12 {
+				displayID = displayID[:12]
+			}
+			fmt.Printf("  %s %s\n", style.Dim.Render(displayID+":"),
+				style.Dim.Render("held: "+item.fields.HoldReason))
+		}
 	}
 
 	return nil
@@ -291,6 +315,7 @@ func outputJSON(data interface{}) error {
 //   - Convoy age: hours * 10 (older convoys get higher priority)
 //   - MR age: hours * 1.0 (FIFO tiebreaker)
 //   - Retry penalty: min(retryCount * 50, 300)
+//   - Manual bump: queuePriority * 10000 (set by gt mq bump)
 func calculateMRScore(issue *beads.Issue, fields *beads.MRFields, now time.Time) float64 {
 	// Parse MR creation time
 	mrCreatedAt, err := time.Parse(time.RFC3339, issue.CreatedAt)
@@ -325,6 +350,9 @@ func calculateMRScore(issue *beads.Issue, fields *beads.MRFields, now time.Time)
 			retryPenalty = 300.0
 		}
 		score -= retryPenalty
+
+		// Manual bump: jumps ahead of all organic scoring
+		score += float64(fields.QueuePriority) * mqBumpScore
 	}
 
 	return score
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/style"
 )
 
@@ -27,6 +28,11 @@ The priority scoring function considers:
   - Issue priority: P0 > P1 > P2 > P3 > P4
   - Retry count: MRs that fail repeatedly get deprioritized
   - MR age: FIFO tiebreaker for same priority/convoy
+  - Manual bumps: MRs bumped with 'gt mq bump' jump ahead of the rest
+
+MRs held with 'gt mq hold' are skipped until released. MRs marked
+needs-rebase by 'gt mq conflict' are skipped until a new commit is pushed
+to their branch.
 
 Use --strategy=fifo for first-in-first-out ordering instead.
 
@@ -71,12 +77,18 @@ func runMQNext(cmd *cobra.Command, args []string) error {
 	}
 
 	// Filter to only ready MRs (no blockers)
+	g := git.NewGit(r.Path)
 	var ready []*beads.Issue
 	for _, issue := range issues {
 		// Skip closed MRs (workaround for bd list not respecting --status filter)
 		if issue.Status != "open" {
 			continue
 		}
+		// Held MRs wait until gt mq release; conflicted MRs wait for a new push
+		fields := beads.ParseMRFields(issue)
+		if isMRHeld(fields) || awaitingRebase(g, issue, fields) {
+			continue
+		}
 		if len(issue.BlockedBy) == 0 && issue.BlockedByCount == 0 {
 			ready = append(ready, issue)
 		}
@@ -94,8 +106,12 @@ func runMQNext(cmd *cobra.Command, args []string) error {
 
 	// Sort based on strategy
 	if mqNextStrategy == "fifo" {
-		// FIFO: oldest first by creation time
+		// FIFO: bumped MRs first, then oldest first by creation time
 		sort.Slice(ready, func(i, j int) bool {
+			bi, bj := mrQueuePriority(ready[i]), mrQueuePriority(ready[j])
+			if bi != bj {
+				return bi > bj
+			}
 			ti, _ := time.Parse(time.RFC3339, ready[i].CreatedAt)
 			tj, _ := time.Parse(time.RFC3339, ready[j].CreatedAt)
 			return ti.Before(tj)
@@ -159,6 +175,9 @@ func runMQNext(cmd *cobra.Command, args []string) error {
 		if fields.RetryCount > 0 {
 			fmt.Printf("  Retries:  %d\n", fields.RetryCount)
 		}
+		if fields.QueuePriority > 0 {
+			fmt.Printf("  Bumped:   level %d\n", fields.QueuePriority)
+		}
 	}
 
 	fmt.Printf("  Age:      %s\n", formatMRAge(next.CreatedAt))
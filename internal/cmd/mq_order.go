@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// mqBumpScore is added to an MR's score per bump level. It exceeds any
+// realistic organic score so a bumped MR is processed before routine ones.
+const mqBumpScore = 10000.0
+
+// MQ ordering command flags
+var (
+	mqHoldReason string
+)
+
+var mqBumpCmd = &cobra.Command{
+	Use:   "bump <mr-id>",
+	Short: "Move a merge request ahead of the queue",
+	Long: `Bump a merge request so the refinery processes it next.
+
+Each bump raises the MR's queue priority by one level. Any bumped MR sorts
+ahead of every unbumped MR; among bumped MRs the higher level wins, then
+the normal priority score. Use this for urgent fixes that should not wait
+behind routine work.
+
+Examples:
+  gt mq bump gp-mr-abc123`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMQBump,
+}
+
+var mqHoldCmd = &cobra.Command{
+	Use:   "hold <mr-id>",
+	Short: "Hold a merge request in the queue",
+	Long: `Hold a merge request so the refinery skips it.
+
+A held MR stays open and visible in 'gt mq list' but is never returned by
+'gt mq next' until it is released.
+
+Examples:
+  gt mq hold gp-mr-abc123 --reason "wait for release branch cut"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMQHold,
+}
+
+var mqReleaseCmd = &cobra.Command{
+	Use:   "release <mr-id>",
+	Short: "Release a held or bumped merge request",
+	Long: `Return a merge request to normal ordering.
+
+Clears any hold and bump on the MR so it is scored like every other MR.
+
+Examples:
+  gt mq release gp-mr-abc123`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMQRelease,
+}
+
+func init() {
+	mqHoldCmd.Flags().StringVarP(&mqHoldReason, "reason", "r", "", "Why the MR is held")
+
+	mqCmd.AddCommand(mqBumpCmd)
+	mqCmd.AddCommand(mqHoldCmd)
+	mqCmd.AddCommand(mqReleaseCmd)
+}
+
+func runMQBump(cmd *cobra.Command, args []string) error {
+	fields, err := updateMROrdering(args[0], func(f *beads.MRFields) {
+		f.QueuePriority++
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s Bumped %s (queue priority %d)\n", style.Bold.Render("⏫"), args[0], fields.QueuePriority)
+	if fields.Held {
+		fmt.Printf("  %s\n", style.Dim.Render("MR is still held; run 'gt mq release' to let the refinery pick it up"))
+	}
+	return nil
+}
+
+func runMQHold(cmd *cobra.Command, args []string) error {
+	if _, err := updateMROrdering(args[0], func(f *beads.MRFields) {
+		f.Held = true
+		f.HoldReason = mqHoldReason
+	}); err != nil {
+		return err
+	}
+	fmt.Printf("%s Held %s\n", style.Bold.Render("⏸"), args[0])
+	return nil
+}
+
+func runMQRelease(cmd *cobra.Command, args []string) error {
+	if _, err := updateMROrdering(args[0], func(f *beads.MRFields) {
+		f.QueuePriority = 0
+		f.Held = false
+		f.HoldReason = ""
+	}); err != nil {
+		return err
+	}
+	fmt.Printf("%s Released %s to normal ordering\n", style.Bold.Render("▶"), args[0])
+	return nil
+}
+
+// updateMROrdering applies change to an open MR's fields and saves them.
+func updateMROrdering(mrID string, change func(*beads.MRFields)) (*beads.MRFields, error) {
+	// Beads repos are per-rig; resolve from the current directory like gt mq status
+	workDir, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("getting current directory: %w", err)
+	}
+	bd := beads.New(workDir)
+
+	issue, err := bd.Show(mrID)
+	if err != nil {
+		if err == beads.ErrNotFound {
+			return nil, fmt.Errorf("merge request '%s' not found", mrID)
+		}
+		return nil, fmt.Errorf("fetching merge request: %w", err)
+	}
+	if !beads.HasLabel(issue, "gt:merge-request") && issue.Type != "merge-request" {
+		return nil, fmt.Errorf("%s is not a merge request", mrID)
+	}
+	if issue.Status == "closed" {
+		return nil, fmt.Errorf("merge request %s is already closed", mrID)
+	}
+
+	fields := beads.ParseMRFields(issue)
+	if fields == nil {
+		fields = &beads.MRFields{}
+	}
+	change(fields)
+
+	desc := beads.SetMRFields(issue, fields)
+	if err := bd.Update(mrID, beads.UpdateOptions{Description: &desc}); err != nil {
+		return nil, fmt.Errorf("updating merge request: %w", err)
+	}
+	return fields, nil
+}
+
+// isMRHeld reports whether an MR has been held with gt mq hold.
+func isMRHeld(fields *beads.MRFields) bool {
+	return fields != nil && fields.Held
+}
+
+// mrQueuePriority returns the manual bump level of an MR (0 if never bumped).
+func mrQueuePriority(issue *beads.Issue) int {
+	if fields := beads.ParseMRFields(issue); fields != nil {
+		return fields.QueuePriority
+	}
+	return 0
+}
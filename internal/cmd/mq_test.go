@@ -865,3 +865,22 @@ func TestMRFilteringByLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateMRScore_BumpOutranksPriority(t *testing.T) {
+	now := time.Now()
+	created := now.Add(-time.Hour).Format(time.RFC3339)
+
+	urgent := &beads.Issue{ID: "gt-mr-p0", Priority: 0, CreatedAt: created}
+	bumped := &beads.Issue{ID: "gt-mr-p4", Priority: 4, CreatedAt: created}
+
+	urgentScore := calculateMRScore(urgent, &beads.MRFields{RetryCount: 0}, now)
+	bumpedScore := calculateMRScore(bumped, &beads.MRFields{QueuePriority: 1}, now)
+	if bumpedScore <= urgentScore {
+		t.Errorf("bumped P4 score %.1f should exceed unbumped P0 score %.1f", bumpedScore, urgentScore)
+	}
+
+	twice := calculateMRScore(bumped, &beads.MRFields{QueuePriority: 2}, now)
+	if twice <= bumpedScore {
+		t.Errorf("second bump score %.1f should exceed first %.1f", twice, bumpedScore)
+	}
+}
@@ -260,6 +260,19 @@ func runNudge(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// Agents in local containers are nudged with docker exec, in or out of K8s.
+	if docker, ok := terminal.ResolveBackend(target).(*terminal.DockerBackend); ok {
+		if err := docker.NudgeSession("claude", message); err != nil {
+			return fmt.Errorf("nudging container session: %w", err)
+		}
+		fmt.Printf("%s Nudged %s (docker)\n", style.Bold.Render("✓"), target)
+		if townRoot != "" {
+			_ = LogNudge(townRoot, target, message)
+		}
+		_ = events.LogFeed(events.TypeNudge, sender, events.NudgePayload("", target, message))
+		return nil
+	}
+
 	// Try remote backend (K8s-hosted agents via Coop).
 	// When running outside K8s (developer workstation), ResolveBackend returns
 	// a CoopBackend with the internal pod IP which is unreachable. Auto-detect
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// outputEnvVar selects the default output format for read commands.
+// GT_OUTPUT=json behaves as if --json were passed to every command that
+// has a --json flag; commands without one are unaffected.
+const outputEnvVar = "GT_OUTPUT"
+
+// applyOutputEnv turns on cmd's --json flag when GT_OUTPUT=json, unless the
+// flag was given explicitly (so --json=false still forces human output).
+func applyOutputEnv(cmd *cobra.Command) {
+	if !strings.EqualFold(strings.TrimSpace(os.Getenv(outputEnvVar)), "json") {
+		return
+	}
+	flag := cmd.Flags().Lookup("json")
+	if flag == nil || flag.Changed || flag.Value.Type() != "bool" {
+		return
+	}
+	_ = flag.Value.Set("true")
+}
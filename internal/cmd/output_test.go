@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestApplyOutputEnv(t *testing.T) {
+	newCmd := func() (*cobra.Command, *bool) {
+		var asJSON bool
+		c := &cobra.Command{Use: "x"}
+		c.Flags().BoolVar(&asJSON, "json", false, "")
+		return c, &asJSON
+	}
+
+	t.Setenv(outputEnvVar, "")
+	c, asJSON := newCmd()
+	applyOutputEnv(c)
+	if *asJSON {
+		t.Error("--json set without GT_OUTPUT")
+	}
+
+	t.Setenv(outputEnvVar, "JSON")
+	c, asJSON = newCmd()
+	applyOutputEnv(c)
+	if !*asJSON {
+		t.Error("GT_OUTPUT=json should turn on --json")
+	}
+
+	// An explicit --json=false wins over the environment.
+	c, asJSON = newCmd()
+	if err := c.Flags().Parse([]string{"--json=false"}); err != nil {
+		t.Fatal(err)
+	}
+	applyOutputEnv(c)
+	if *asJSON {
+		t.Error("explicit --json=false should be kept")
+	}
+
+	// Commands without --json are left alone.
+	applyOutputEnv(&cobra.Command{Use: "y"})
+}
@@ -28,15 +28,21 @@ var (
 var patrolCmd = &cobra.Command{
 	Use:     "patrol",
 	GroupID: GroupDiag,
-	Short:   "Patrol digest management",
-	Long: `Manage patrol cycle digests.
+	Short:   "Patrol digests and reports",
+	Long: `Manage patrol cycle digests and reports.
 
 Patrol cycles (Deacon, Witness, Refinery) create ephemeral per-cycle digests
 to avoid JSONL pollution. This command aggregates them into daily summaries.
 
+Patrol reports record what each patrol saw, agent by agent, so changes in
+agent health can be traced across patrols.
+
 Examples:
-  gt patrol digest --yesterday  # Aggregate yesterday's patrol digests
-  gt patrol digest --dry-run    # Preview what would be aggregated`,
+  gt patrol digest --yesterday            # Aggregate yesterday's patrol digests
+  gt patrol digest --dry-run              # Preview what would be aggregated
+  gt patrol report                        # Record this patrol's findings
+  gt patrol history gastown/polecats/nux  # One agent across patrols
+  gt patrol diff                          # Your last two patrols compared`,
 }
 
 var patrolCleanupCmd = &cobra.Command{
@@ -0,0 +1,376 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/patrol"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	// Patrol report flags
+	patrolReportRig       string
+	patrolReportAgents    []string
+	patrolReportAnomalies []string
+	patrolReportActions   []string
+	patrolReportNote      string
+	patrolReportNoScan    bool
+
+	// Patrol history/diff flags
+	patrolHistoryLimit int
+	patrolHistoryJSON  bool
+	patrolDiffJSON     bool
+)
+
+var patrolReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Record what this patrol saw",
+	Long: `Record a patrol report: the status of each agent checked, the anomalies
+found, and the actions taken.
+
+By default the report starts from a scan of the rig: each agent's state and
+hooked bead from its agent bead, and its open witness findings as
+anomalies. Flags add to or override the scan:
+
+  --agent <agent>=<state>     Set an agent's state (adds the agent if new)
+  --anomaly "<agent>: text"   Record an anomaly
+  --action "<agent>: text"    Record an action taken
+
+Reports are kept in .runtime/patrol-reports.jsonl in the town. After
+recording, the changes since your previous report are shown.
+
+Examples:
+  gt patrol report
+  gt patrol report --action "gastown/polecats/nux: nudged after 30m idle"
+  gt patrol report --agent gastown/polecats/toast=dead \
+      --anomaly "gastown/polecats/toast: session gone" \
+      --action "gastown/polecats/toast: reslung gt-abc to nux"`,
+	Args: cobra.NoArgs,
+	RunE: runPatrolReport,
+}
+
+var patrolHistoryCmd = &cobra.Command{
+	Use:   "history <agent>",
+	Short: "Show an agent's status across patrols",
+	Long: `Show an agent's status in each patrol report, oldest first, with what
+changed since the patrol before:
+
+  ~  state or hooked bead changed
+  +  new anomaly
+  -  anomaly cleared
+  !  action taken
+
+Examples:
+  gt patrol history gastown/polecats/nux
+  gt patrol history gastown/polecats/nux --limit 50 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPatrolHistory,
+}
+
+var patrolDiffCmd = &cobra.Command{
+	Use:   "diff [patroller]",
+	Short: "Show what changed between a patroller's last two reports",
+	Long: `Compare a patroller's last two reports, agent by agent.
+
+The patroller defaults to you.
+
+Examples:
+  gt patrol diff
+  gt patrol diff gastown/witness`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runPatrolDiff,
+}
+
+func init() {
+	patrolReportCmd.Flags().StringVar(&patrolReportRig, "rig", "", "Rig to scan (default: the patroller's rig)")
+	patrolReportCmd.Flags().StringArrayVar(&patrolReportAgents, "agent", nil, "Agent state as <agent>=<state> (repeatable)")
+	patrolReportCmd.Flags().StringArrayVar(&patrolReportAnomalies, "anomaly", nil, "Anomaly as \"<agent>: text\" (repeatable)")
+	patrolReportCmd.Flags().StringArrayVar(&patrolReportActions, "action", nil, "Action taken as \"<agent>: text\" (repeatable)")
+	patrolReportCmd.Flags().StringVar(&patrolReportNote, "note", "", "Free-form note for the whole patrol")
+	patrolReportCmd.Flags().BoolVar(&patrolReportNoScan, "no-scan", false, "Record only the agents given by flags")
+
+	patrolHistoryCmd.Flags().IntVarP(&patrolHistoryLimit, "limit", "n", 20, "Most recent patrols to show (0 = all)")
+	patrolHistoryCmd.Flags().BoolVar(&patrolHistoryJSON, "json", false, "Output as JSON")
+
+	patrolDiffCmd.Flags().BoolVar(&patrolDiffJSON, "json", false, "Output as JSON")
+
+	patrolCmd.AddCommand(patrolReportCmd)
+	patrolCmd.AddCommand(patrolHistoryCmd)
+	patrolCmd.AddCommand(patrolDiffCmd)
+}
+
+func runPatrolReport(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	patroller := detectSender()
+	if patroller == "" {
+		return fmt.Errorf("cannot determine who is patrolling; run from an agent session")
+	}
+	rig := patrolReportRig
+	if rig == "" {
+		rig, _, _ = strings.Cut(patroller, "/")
+	}
+
+	report := &patrol.Report{Patroller: patroller, Rig: rig, Note: patrolReportNote}
+	if !patrolReportNoScan {
+		agents, err := scanPatrolAgents(townRoot, rig, patroller)
+		if err != nil {
+			return fmt.Errorf("scanning %s: %w", rig, err)
+		}
+		report.Agents = agents
+	}
+	if err := applyPatrolFlags(report); err != nil {
+		return err
+	}
+	if len(report.Agents) == 0 {
+		return fmt.Errorf("no agents to report; pass --agent or check --rig")
+	}
+
+	log := patrol.NewLog(townRoot)
+	previous, err := log.Reports(patroller, 1)
+	if err != nil {
+		return err
+	}
+	if err := log.Append(report); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Recorded patrol report: %d agents\n", style.Success.Render("✓"), len(report.Agents))
+	if len(previous) == 0 {
+		fmt.Printf("%s\n", style.Dim.Render("First report from "+patroller))
+		return nil
+	}
+	diffs := patrol.DiffReports(previous[0], report)
+	if len(diffs) == 0 {
+		fmt.Printf("%s\n", style.Dim.Render("No changes since the last patrol"))
+		return nil
+	}
+	fmt.Println()
+	printPatrolDiffs(diffs)
+	return nil
+}
+
+// scanPatrolAgents returns the status of every agent in rig except the
+// patroller, from agent beads and open witness findings.
+func scanPatrolAgents(townRoot, rig, patroller string) ([]patrol.AgentStatus, error) {
+	bd := beads.New(beads.ResolveBeadsDir(townRoot))
+	agentBeads, err := bd.ListAgentBeadsForRig(rig)
+	if err != nil {
+		return nil, err
+	}
+
+	byAgent := make(map[string]*patrol.AgentStatus)
+	var agents []patrol.AgentStatus
+	for id, issue := range agentBeads {
+		addr := patrolAgentAddress(id)
+		if addr == "" || addr == patroller || !strings.HasPrefix(addr, rig+"/") {
+			continue
+		}
+		s := patrol.AgentStatus{Agent: addr, State: issue.AgentState, HookBead: issue.HookBead}
+		if fields := beads.ParseAgentFields(issue.Description); fields != nil {
+			if s.State == "" {
+				s.State = fields.AgentState
+			}
+			if s.HookBead == "" {
+				s.HookBead = fields.HookBead
+			}
+		}
+		agents = append(agents, s)
+	}
+	for i := range agents {
+		byAgent[agents[i].Agent] = &agents[i]
+	}
+
+	// Findings are best effort: a patrol report without them still shows
+	// state changes.
+	if findings, err := bd.ListFindings("", false); err == nil {
+		for _, f := range findings {
+			if s := byAgent[f.Agent]; s != nil {
+				s.Anomalies = append(s.Anomalies, fmt.Sprintf("%s [%s]", f.Category, f.Severity))
+			}
+		}
+	}
+	return agents, nil
+}
+
+// patrolAgentAddress converts an agent bead ID to the address findings and
+// hooks use (rig/polecats/name, rig/crew/name, rig/witness).
+func patrolAgentAddress(beadID string) string {
+	rig, role, name, ok := beads.ParseAgentBeadID(beadID)
+	if !ok || rig == "" {
+		return ""
+	}
+	switch {
+	case name == "":
+		return rig + "/" + role
+	case role == "polecat":
+		return rig + "/polecats/" + name
+	default:
+		return rig + "/" + role + "/" + name
+	}
+}
+
+// applyPatrolFlags merges --agent, --anomaly and --action into r.
+func applyPatrolFlags(r *patrol.Report) error {
+	agent := func(addr string) *patrol.AgentStatus {
+		if s := r.Agent(addr); s != nil {
+			return s
+		}
+		r.Agents = append(r.Agents, patrol.AgentStatus{Agent: addr})
+		return &r.Agents[len(r.Agents)-1]
+	}
+	for _, v := range patrolReportAgents {
+		addr, state, ok := strings.Cut(v, "=")
+		if !ok || addr == "" || state == "" {
+			return fmt.Errorf("invalid --agent %q: want <agent>=<state>", v)
+		}
+		agent(strings.TrimSpace(addr)).State = strings.TrimSpace(state)
+	}
+	for _, v := range patrolReportAnomalies {
+		addr, text, err := splitPatrolNote("anomaly", v)
+		if err != nil {
+			return err
+		}
+		s := agent(addr)
+		s.Anomalies = append(s.Anomalies, text)
+	}
+	for _, v := range patrolReportActions {
+		addr, text, err := splitPatrolNote("action", v)
+		if err != nil {
+			return err
+		}
+		s := agent(addr)
+		s.Actions = append(s.Actions, text)
+	}
+	return nil
+}
+
+func splitPatrolNote(flag, v string) (agent, text string, err error) {
+	agent, text, ok := strings.Cut(v, ":")
+	agent, text = strings.TrimSpace(agent), strings.TrimSpace(text)
+	if !ok || agent == "" || text == "" {
+		return "", "", fmt.Errorf("invalid --%s %q: want \"<agent>: text\"", flag, v)
+	}
+	return agent, text, nil
+}
+
+func runPatrolHistory(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	agent := args[0]
+	entries, err := patrol.NewLog(townRoot).History(agent, patrolHistoryLimit)
+	if err != nil {
+		return err
+	}
+
+	if patrolHistoryJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	}
+
+	if len(entries) == 0 {
+		fmt.Printf("No patrol reports mention %s\n", agent)
+		return nil
+	}
+	fmt.Printf("%s %s (%d patrols)\n\n", style.Bold.Render("Patrol history:"), agent, len(entries))
+	for _, e := range entries {
+		hook := e.Status.HookBead
+		if hook == "" {
+			hook = "-"
+		}
+		fmt.Printf("  %s  %-20s %-10s %s\n", e.Time.Local().Format("2006-01-02 15:04"), e.Patroller, patrolState(e.Status.State), hook)
+		for _, c := range e.Changes {
+			if c.Field != patrol.FieldAgent {
+				fmt.Printf("      %s\n", formatPatrolChange(c))
+			}
+		}
+	}
+	return nil
+}
+
+func runPatrolDiff(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	patroller := detectSender()
+	if len(args) > 0 {
+		patroller = args[0]
+	}
+	if patroller == "" {
+		return fmt.Errorf("name the patroller: gt patrol diff <patroller>")
+	}
+
+	reports, err := patrol.NewLog(townRoot).Reports(patroller, 2)
+	if err != nil {
+		return err
+	}
+	if len(reports) < 2 {
+		return fmt.Errorf("%s has %d patrol report(s); need two to diff", patroller, len(reports))
+	}
+	prev, cur := reports[0], reports[1]
+	diffs := patrol.DiffReports(prev, cur)
+
+	if patrolDiffJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(diffs)
+	}
+
+	fmt.Printf("%s %s: %s → %s\n\n", style.Bold.Render("Patrol diff:"), patroller,
+		prev.Time.Local().Format("2006-01-02 15:04"), cur.Time.Local().Format("2006-01-02 15:04"))
+	if len(diffs) == 0 {
+		fmt.Printf("%s\n", style.Dim.Render("No changes"))
+		return nil
+	}
+	printPatrolDiffs(diffs)
+	return nil
+}
+
+func printPatrolDiffs(diffs []patrol.AgentDiff) {
+	for _, d := range diffs {
+		fmt.Printf("  %s\n", style.Bold.Render(d.Agent))
+		for _, c := range d.Changes {
+			fmt.Printf("      %s\n", formatPatrolChange(c))
+		}
+	}
+}
+
+// formatPatrolChange renders one change with the markers listed in
+// gt patrol history --help.
+func formatPatrolChange(c patrol.Change) string {
+	switch c.Field {
+	case patrol.FieldAgent:
+		if c.From == "" {
+			return style.Success.Render("+") + " new agent (" + patrolState(c.To) + ")"
+		}
+		return style.Dim.Render("-") + " no longer patrolled (was " + patrolState(c.From) + ")"
+	case patrol.FieldAnomaly:
+		if c.From == "" {
+			return style.Warning.Render("+") + " anomaly: " + c.To
+		}
+		return style.Success.Render("-") + " cleared: " + c.From
+	case patrol.FieldAction:
+		return style.Bold.Render("!") + " action: " + c.To
+	default:
+		return fmt.Sprintf("~ %s: %s → %s", c.Field, patrolState(c.From), patrolState(c.To))
+	}
+}
+
+func patrolState(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
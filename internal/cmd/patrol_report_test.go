@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/patrol"
+)
+
+func TestPatrolAgentAddress(t *testing.T) {
+	tests := map[string]string{
+		"gt-gastown-polecat-nux":  "gastown/polecats/nux",
+		"gt-gastown-crew-max":     "gastown/crew/max",
+		"gt-gastown-witness":      "gastown/witness",
+		"bd-beads-polecat-my-cat": "beads/polecats/my-cat",
+		"gt-mayor":                "", // Town-level, not in a rig
+		"x-mayor":                 "",
+	}
+	for id, want := range tests {
+		if got := patrolAgentAddress(id); got != want {
+			t.Errorf("patrolAgentAddress(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestApplyPatrolFlags(t *testing.T) {
+	defer func() {
+		patrolReportAgents, patrolReportAnomalies, patrolReportActions = nil, nil, nil
+	}()
+
+	r := &patrol.Report{Agents: []patrol.AgentStatus{{Agent: "gastown/polecats/nux", State: "working"}}}
+	patrolReportAgents = []string{"gastown/polecats/nux=stuck", "gastown/polecats/toast=dead"}
+	patrolReportAnomalies = []string{"gastown/polecats/toast: session gone"}
+	patrolReportActions = []string{"gastown/polecats/nux: nudged"}
+	if err := applyPatrolFlags(r); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Agents) != 2 {
+		t.Fatalf("got %d agents, want 2", len(r.Agents))
+	}
+	nux, toast := r.Agent("gastown/polecats/nux"), r.Agent("gastown/polecats/toast")
+	if nux.State != "stuck" || len(nux.Actions) != 1 || nux.Actions[0] != "nudged" {
+		t.Errorf("nux = %+v", nux)
+	}
+	if toast.State != "dead" || len(toast.Anomalies) != 1 || toast.Anomalies[0] != "session gone" {
+		t.Errorf("toast = %+v", toast)
+	}
+
+	for _, bad := range [][]string{{"gastown/polecats/nux"}, {"=idle"}} {
+		patrolReportAgents, patrolReportAnomalies, patrolReportActions = bad, nil, nil
+		if err := applyPatrolFlags(&patrol.Report{}); err == nil {
+			t.Errorf("--agent %q should be rejected", bad[0])
+		}
+	}
+	patrolReportAgents, patrolReportActions = nil, []string{"no agent here"}
+	if err := applyPatrolFlags(&patrol.Report{}); err == nil {
+		t.Error("--action without agent should be rejected")
+	}
+}
@@ -0,0 +1,350 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/checkpoint"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	pauseDrain   bool
+	pauseReason  string
+	pauseTimeout time.Duration
+	pausePoll    time.Duration
+)
+
+var pauseCmd = &cobra.Command{
+	Use:     "pause",
+	GroupID: GroupServices,
+	Short:   "Pause the town: stop new slings, optionally drain and suspend polecats",
+	Long: `Pause the town.
+
+A plain pause pauses the Deacon and makes gt sling refuse new work. Agents
+already running keep going.
+
+With --drain, the town is wound down gracefully:
+  1. New slings are refused
+  2. Every running polecat is nudged to finish or checkpoint its current
+     step and hand off
+  3. gt waits until each polecat has finished its work, written a
+     checkpoint, or exited, reporting progress as it goes (up to --timeout)
+  4. Remaining polecat sessions are stopped. Polecats running in K8s pods
+     are marked suspended on their agent beads, and the controller removes
+     their pods
+
+The daemon does not restart drained polecats. Run 'gt resume --town' to
+restart the suspended sessions and unpause; polecats pick their hooked work
+back up on startup.
+
+Examples:
+  gt pause --reason "upgrading dolt"
+  gt pause --drain
+  gt pause --drain --timeout 30m`,
+	Args: cobra.NoArgs,
+	RunE: runPause,
+}
+
+func init() {
+	pauseCmd.Flags().BoolVar(&pauseDrain, "drain", false, "Wind down polecats and suspend their sessions")
+	pauseCmd.Flags().StringVar(&pauseReason, "reason", "", "Why the town is paused (shown to agents and in the dashboard)")
+	pauseCmd.Flags().DurationVar(&pauseTimeout, "timeout", 15*time.Minute, "With --drain, how long to wait for polecats before suspending anyway")
+	pauseCmd.Flags().DurationVar(&pausePoll, "poll", 10*time.Second, "With --drain, how often to check on polecats")
+	rootCmd.AddCommand(pauseCmd)
+}
+
+// drainNudge is sent to each running polecat when a drain starts.
+const drainNudge = "TOWN PAUSING: finish your current step or stop at a safe point. " +
+	"Commit and push your work, run 'gt checkpoint write', then stop. " +
+	"Your session will be suspended and restarted later with your hook intact."
+
+func runPause(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	paused, state, err := deacon.IsPaused(townRoot)
+	if err != nil {
+		return fmt.Errorf("reading pause state: %w", err)
+	}
+	if !paused {
+		state = &deacon.PauseState{
+			Paused:   true,
+			Reason:   pauseReason,
+			PausedAt: time.Now().UTC(),
+			PausedBy: detectSender(),
+		}
+	} else if pauseReason != "" {
+		state.Reason = pauseReason
+	}
+
+	if !pauseDrain {
+		if err := deacon.SavePauseState(townRoot, state); err != nil {
+			return fmt.Errorf("writing pause state: %w", err)
+		}
+		fmt.Printf("%s Town paused; new slings will be refused. Running agents continue.\n", style.SuccessPrefix)
+		fmt.Printf("  Resume with: %s\n", style.Dim.Render("gt resume --town"))
+		return nil
+	}
+
+	if state.Drain != nil && state.Drain.Phase == deacon.DrainPhaseSuspended {
+		fmt.Printf("Town is already drained (%d polecat(s) suspended).\n", len(state.Drain.Suspended))
+		return nil
+	}
+	if state.Drain == nil {
+		state.Drain = &deacon.DrainState{StartedAt: time.Now().UTC(), Phase: deacon.DrainPhaseDraining}
+	}
+	if err := deacon.SavePauseState(townRoot, state); err != nil {
+		return fmt.Errorf("writing pause state: %w", err)
+	}
+	fmt.Printf("%s Town paused; new slings will be refused.\n", style.SuccessPrefix)
+
+	targets, err := expandSpecs(nil)
+	if err != nil {
+		return err
+	}
+	targets = filterAwake(targets)
+	pods := k8sPolecatBeads(townRoot, false)
+
+	// Ask everyone to wind down.
+	for _, t := range targets {
+		sm := polecat.NewSessionManager(t.Rig)
+		if err := sm.Inject(t.PolecatName, drainNudge); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: nudging %s/%s: %v\n", t.RigName, t.PolecatName, err)
+		}
+	}
+	fmt.Printf("Draining %d running polecat(s) (timeout %v)...\n", len(targets), pauseTimeout)
+
+	waitForDrain(targets, state.Drain.StartedAt, pauseTimeout, pausePoll)
+
+	// Suspend whatever is still running, remembering it for resume.
+	suspended := make(map[string]bool, len(state.Drain.Suspended))
+	for _, addr := range state.Drain.Suspended {
+		suspended[addr] = true
+	}
+	results := runForAll(filterAwake(targets), func(t expandedPolecat) error {
+		return polecat.NewSessionManager(t.Rig).Stop(t.PolecatName, false)
+	})
+	results = append(results, setK8sAgentStates(townRoot, pods, agentStateSuspended)...)
+	for _, r := range results {
+		if r.Err == nil {
+			suspended[r.Address] = true
+		}
+	}
+	// Polecats that exited on their own during the drain still need their
+	// sessions back if they have work hooked.
+	for _, t := range targets {
+		if drainedWithWork(t) {
+			suspended[t.RigName+"/"+t.PolecatName] = true
+		}
+	}
+
+	state.Drain.Suspended = state.Drain.Suspended[:0]
+	for addr := range suspended {
+		state.Drain.Suspended = append(state.Drain.Suspended, addr)
+	}
+	sort.Strings(state.Drain.Suspended)
+	state.Drain.Phase = deacon.DrainPhaseSuspended
+	if err := deacon.SavePauseState(townRoot, state); err != nil {
+		return fmt.Errorf("writing pause state: %w", err)
+	}
+
+	if len(results) > 0 {
+		if err := reportResults("Suspended", results); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: %v; those sessions are still running\n", err)
+		}
+	}
+	fmt.Printf("%s Town drained. %d polecat(s) will restart on resume.\n", style.SuccessPrefix, len(state.Drain.Suspended))
+	fmt.Printf("  Resume with: %s\n", style.Dim.Render("gt resume --town"))
+	return nil
+}
+
+// waitForDrain polls until every target has settled or the timeout passes,
+// printing progress each time the count changes.
+func waitForDrain(targets []expandedPolecat, since time.Time, timeout, poll time.Duration) {
+	start := time.Now()
+	lastSettled := -1
+	for {
+		var waiting []string
+		settled := 0
+		for _, t := range targets {
+			if drainSettled(t, since) {
+				settled++
+			} else {
+				waiting = append(waiting, t.RigName+"/"+t.PolecatName)
+			}
+		}
+		elapsed := time.Since(start).Round(time.Second)
+		if settled != lastSettled || len(waiting) == 0 {
+			line := fmt.Sprintf("  [%v] %d/%d settled", elapsed, settled, len(targets))
+			if len(waiting) > 0 {
+				line += " — waiting on " + strings.Join(waiting, ", ")
+			}
+			fmt.Println(line)
+			lastSettled = settled
+		}
+		if len(waiting) == 0 {
+			return
+		}
+		if elapsed >= timeout {
+			fmt.Printf("  %s timed out; suspending %d polecat(s) mid-work\n", style.Warning.Render("⚠"), len(waiting))
+			return
+		}
+		time.Sleep(poll)
+	}
+}
+
+// drainSettled reports whether a polecat can be suspended without losing
+// progress: its session is gone, its work is done, or it has written a
+// checkpoint since the drain began.
+func drainSettled(t expandedPolecat, since time.Time) bool {
+	sm := polecat.NewSessionManager(t.Rig)
+	if running, _ := sm.IsRunning(t.PolecatName); !running {
+		return true
+	}
+	p, err := polecat.NewManager(t.Rig, git.NewGit(t.Rig.Path)).Get(t.PolecatName)
+	if err != nil {
+		return false
+	}
+	if p.Issue == "" || p.State == polecat.StateDone {
+		return true
+	}
+	cp, err := checkpoint.Read(p.ClonePath)
+	return err == nil && cp != nil && cp.Timestamp.After(since)
+}
+
+// drainedWithWork reports whether a polecat that is no longer running
+// still has an issue assigned, so resume should restart it.
+func drainedWithWork(t expandedPolecat) bool {
+	sm := polecat.NewSessionManager(t.Rig)
+	if running, _ := sm.IsRunning(t.PolecatName); running {
+		return false
+	}
+	p, err := polecat.NewManager(t.Rig, git.NewGit(t.Rig.Path)).Get(t.PolecatName)
+	return err == nil && p.Issue != "" && p.State != polecat.StateDone
+}
+
+// runTownResume restarts the polecats a drain suspended and unpauses the
+// town.
+func runTownResume() error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	paused, state, err := deacon.IsPaused(townRoot)
+	if err != nil {
+		return fmt.Errorf("reading pause state: %w", err)
+	}
+	if !paused {
+		fmt.Println("Town is not paused.")
+		return nil
+	}
+
+	if state.Drain != nil && len(state.Drain.Suspended) > 0 {
+		pods := k8sPolecatBeads(townRoot, true)
+		var targets []expandedPolecat
+		for _, addr := range state.Drain.Suspended {
+			if pods[addr] != nil {
+				continue
+			}
+			expanded, err := expandOneSpec(addr)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "warning: skipping %s: %v\n", addr, err)
+				continue
+			}
+			targets = append(targets, expanded...)
+		}
+		fmt.Printf("Restarting %d suspended polecat(s)...\n", len(targets)+len(pods))
+		results := runForAll(targets, func(t expandedPolecat) error {
+			return polecat.NewSessionManager(t.Rig).Start(t.PolecatName, polecat.SessionStartOptions{})
+		})
+		// Spawning brings a K8s polecat's pod back; it picks up its hook.
+		results = append(results, setK8sAgentStates(townRoot, pods, "spawning")...)
+		if err := reportResults("Restarted", results); err != nil {
+			// Stay paused so the failed polecats are retried on the next resume.
+			var failed []string
+			for _, r := range results {
+				if r.Err != nil {
+					failed = append(failed, r.Address)
+				}
+			}
+			state.Drain.Suspended = failed
+			if saveErr := deacon.SavePauseState(townRoot, state); saveErr != nil {
+				return fmt.Errorf("writing pause state: %w", saveErr)
+			}
+			return fmt.Errorf("%w; town left paused, rerun 'gt resume --town'", err)
+		}
+	}
+
+	if err := deacon.Resume(townRoot); err != nil {
+		return fmt.Errorf("removing pause state: %w", err)
+	}
+	fmt.Printf("%s Town resumed.\n", style.SuccessPrefix)
+	return nil
+}
+
+// agentStateSuspended is the agent_state a drain gives polecats running in
+// K8s pods. The controller runs no pod for a suspended agent bead.
+const agentStateSuspended = "suspended"
+
+// k8sPolecatBeads returns the open agent beads of polecats running in K8s
+// pods, keyed by rig/name: those with work hooked when suspended is false,
+// or those a drain suspended when it is true. Their sessions live in pods
+// the controller owns, so drains and resumes go through their beads.
+func k8sPolecatBeads(townRoot string, suspended bool) map[string]*beads.Issue {
+	agents, err := beads.New(townRoot).ListAgentBeads()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: listing agent beads: %v\n", err)
+		return nil
+	}
+	pods := make(map[string]*beads.Issue)
+	for _, issue := range agents {
+		if issue.Status == "closed" || (issue.AgentState == agentStateSuspended) != suspended {
+			continue
+		}
+		if !suspended && issue.HookBead == "" {
+			continue
+		}
+		var rigName, name string
+		var k8s, isPolecat bool
+		for _, label := range issue.Labels {
+			key, value, _ := strings.Cut(label, ":")
+			switch key {
+			case "rig":
+				rigName = value
+			case "agent":
+				name = value
+			case "role":
+				isPolecat = value == "polecat"
+			case "execution_target":
+				k8s = value == string(config.ExecutionTargetK8s)
+			}
+		}
+		if k8s && isPolecat && rigName != "" && name != "" {
+			pods[rigName+"/"+name] = issue
+		}
+	}
+	return pods
+}
+
+// setK8sAgentStates sets agent_state on each K8s polecat's agent bead.
+func setK8sAgentStates(townRoot string, pods map[string]*beads.Issue, state string) []allResult {
+	bd := beads.New(townRoot)
+	var results []allResult
+	for addr, issue := range pods {
+		results = append(results, allResult{Address: addr, Err: bd.UpdateAgentState(issue.ID, state, nil)})
+	}
+	return results
+}
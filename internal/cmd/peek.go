@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -14,11 +15,21 @@ import (
 )
 
 // Peek command flags
-var peekLines int
+var (
+	peekLines      int
+	peekGrep       string
+	peekBefore     int
+	peekAfter      int
+	peekMaxMatches int
+)
 
 func init() {
 	rootCmd.AddCommand(peekCmd)
 	peekCmd.Flags().IntVarP(&peekLines, "lines", "n", 100, "Number of lines to capture")
+	peekCmd.Flags().StringVar(&peekGrep, "grep", "", "Search the full scrollback for a regular expression")
+	peekCmd.Flags().IntVarP(&peekBefore, "before", "B", 0, "Lines of context before each --grep match")
+	peekCmd.Flags().IntVarP(&peekAfter, "after", "A", 0, "Lines of context after each --grep match")
+	peekCmd.Flags().IntVar(&peekMaxMatches, "max-matches", 20, "Maximum --grep matches to show (0 for all)")
 }
 
 var peekCmd = &cobra.Command{
@@ -48,7 +59,10 @@ Examples:
   gt peek greenplace/furiosa         # Polecat: last 100 lines (default)
   gt peek greenplace/furiosa 50      # Polecat: last 50 lines
   gt peek beads/crew/dave            # Crew: last 100 lines
-  gt peek beads/crew/dave -n 200     # Crew: last 200 lines`,
+  gt peek beads/crew/dave -n 200     # Crew: last 200 lines
+
+Search the whole scrollback instead of reading the tail:
+  gt peek greenplace/furiosa --grep 'FAIL|panic:' -B 5 -A 20`,
 	Args: cobra.RangeArgs(1, 2),
 	RunE: runPeek,
 }
@@ -66,6 +80,20 @@ func runPeek(cmd *cobra.Command, args []string) error {
 		lines = n
 	}
 
+	// Agents in local containers are peeked with docker, in or out of K8s.
+	// A stopped container still has its output, so skip the running check.
+	identity, err := session.ParseAddress(address)
+	if err == nil {
+		if docker, ok := terminal.ResolveBackend(identity.BeadID()).(*terminal.DockerBackend); ok {
+			output, err := capturePeek(docker, "claude", lines)
+			if err != nil {
+				return fmt.Errorf("capturing output: %w", err)
+			}
+			fmt.Print(output)
+			return nil
+		}
+	}
+
 	// When running outside K8s, use kubectl port-forward to reach agent pods.
 	// ResolveBackend returns coop_url with internal pod IPs (e.g., 10.x.x.x)
 	// which are unreachable from a developer workstation.
@@ -74,7 +102,6 @@ func runPeek(cmd *cobra.Command, args []string) error {
 	}
 
 	// Inside K8s: use ResolveBackend which can hit pod IPs directly.
-	identity, err := session.ParseAddress(address)
 	if err == nil {
 		beadID := identity.BeadID()
 		backend := terminal.ResolveBackend(beadID)
@@ -156,10 +183,47 @@ func peekViaBackend(backend terminal.Backend, sessionName string, lines int) err
 	if !exists {
 		return fmt.Errorf("session %q not running", sessionName)
 	}
-	output, err := backend.CapturePane(sessionName, lines)
+	output, err := capturePeek(backend, sessionName, lines)
 	if err != nil {
 		return fmt.Errorf("capturing output: %w", err)
 	}
 	fmt.Print(output)
 	return nil
 }
+
+// capturePeek returns the last lines of a session's output or, with
+// --grep, the scrollback lines that match, formatted like grep -n:
+// "N:" marks a match, "N-" its context, and "--" separates groups.
+func capturePeek(backend terminal.Backend, sessionName string, lines int) (string, error) {
+	if peekGrep == "" {
+		return backend.CapturePane(sessionName, lines)
+	}
+	re, err := regexp.Compile(peekGrep)
+	if err != nil {
+		return "", fmt.Errorf("invalid --grep pattern: %w", err)
+	}
+	output, err := backend.CapturePaneAll(sessionName)
+	if err != nil {
+		return "", err
+	}
+	matches, truncated := terminal.SearchLines(terminal.SplitCapture(output), re, peekBefore, peekAfter, peekMaxMatches)
+
+	var b strings.Builder
+	for i, m := range matches {
+		if i > 0 && (peekBefore > 0 || peekAfter > 0) {
+			b.WriteString("--\n")
+		}
+		for j, line := range m.Context {
+			n := m.Start + j
+			sep := "-"
+			if n == m.Line {
+				sep = ":"
+			}
+			fmt.Fprintf(&b, "%d%s%s\n", n, sep, line)
+		}
+	}
+	if truncated {
+		fmt.Fprintf(&b, "(stopped after %d matches; use --max-matches for more)\n", len(matches))
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,419 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Labels the agent controller puts on every agent pod.
+const (
+	podLabelApp   = "app.kubernetes.io/name=gastown"
+	podLabelRig   = "gastown.io/rig"
+	podLabelRole  = "gastown.io/role"
+	podLabelAgent = "gastown.io/agent"
+)
+
+var (
+	podsNamespace   string
+	podsContext     string
+	podsRig         string
+	podsJSON        bool
+	podsFollow      bool
+	podsTail        int
+	podsContainer   string
+	podsPrevious    bool
+	podsDeleteForce bool
+)
+
+var podsCmd = &cobra.Command{
+	Use:     "pods",
+	GroupID: GroupAgents,
+	Short:   "List and manage K8s agent pods",
+	Long: `List and manage the Kubernetes pods running Gas Town agents.
+
+Pods are found by the gastown.io labels the agent controller sets, and are
+shown by agent address instead of pod name. Commands run kubectl against the
+namespace from 'gt connect' unless --namespace is given.
+
+Target formats:
+  mayor                        Town-level mayor
+  nux                          Bare agent name
+  gastown/witness              Rig witness
+  gastown/polecats/nux         Polecat by name
+  gastown/crew/max             Crew member
+  gt-gastown-polecat-nux       Direct pod name
+
+Examples:
+  gt pods                              # List all agent pods
+  gt pods --rig gastown                # Only one rig
+  gt pods describe gastown/crew/max
+  gt pods logs gastown/polecats/nux -f
+  gt pods delete gastown/polecats/nux  # Controller recreates it if still active`,
+	Args: cobra.NoArgs,
+	RunE: runPodsList,
+}
+
+var podsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List agent pods",
+	Args:  cobra.NoArgs,
+	RunE:  runPodsList,
+}
+
+var podsDescribeCmd = &cobra.Command{
+	Use:   "describe <target>",
+	Short: "Show kubectl describe output for an agent's pod",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPodsDescribe,
+}
+
+var podsLogsCmd = &cobra.Command{
+	Use:   "logs <target>",
+	Short: "Show logs from an agent's pod",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPodsLogs,
+}
+
+var podsDeleteCmd = &cobra.Command{
+	Use:   "delete <target>",
+	Short: "Delete an agent's pod",
+	Long: `Delete an agent's pod.
+
+The agent bead is left alone, so the controller recreates the pod on its
+next reconcile if the agent is still active. Use 'gt crew stop' or
+'gt polecat nuke' to stop an agent for good.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPodsDelete,
+}
+
+func init() {
+	podsCmd.PersistentFlags().StringVarP(&podsNamespace, "namespace", "n", "", "K8s namespace (default: from gt connect)")
+	podsCmd.PersistentFlags().StringVar(&podsContext, "context", "", "kubectl context to use")
+
+	for _, c := range []*cobra.Command{podsCmd, podsListCmd} {
+		c.Flags().StringVar(&podsRig, "rig", "", "Only show pods for this rig")
+		c.Flags().BoolVar(&podsJSON, "json", false, "Output as JSON")
+	}
+
+	podsLogsCmd.Flags().BoolVarP(&podsFollow, "follow", "f", false, "Stream logs")
+	podsLogsCmd.Flags().IntVar(&podsTail, "tail", 200, "Lines of recent log output to show (-1 for all)")
+	podsLogsCmd.Flags().StringVarP(&podsContainer, "container", "c", "", "Container name (default: the agent container)")
+	podsLogsCmd.Flags().BoolVarP(&podsPrevious, "previous", "p", false, "Show logs from the previous container instance")
+
+	podsDeleteCmd.Flags().BoolVar(&podsDeleteForce, "force", false, "Delete immediately without a grace period")
+
+	podsCmd.AddCommand(podsListCmd, podsDescribeCmd, podsLogsCmd, podsDeleteCmd)
+	rootCmd.AddCommand(podsCmd)
+}
+
+// AgentPod is an agent pod as shown by gt pods.
+type AgentPod struct {
+	Address   string    `json:"address"`
+	Pod       string    `json:"pod"`
+	Namespace string    `json:"namespace"`
+	Rig       string    `json:"rig"`
+	Role      string    `json:"role"`
+	Agent     string    `json:"agent"`
+	Phase     string    `json:"phase"`
+	Ready     bool      `json:"ready"`
+	Restarts  int       `json:"restarts"`
+	Node      string    `json:"node,omitempty"`
+	IP        string    `json:"ip,omitempty"`
+	Created   time.Time `json:"created"`
+}
+
+// k8sPodList is the subset of `kubectl get pods -o json` that gt pods reads.
+type k8sPodList struct {
+	Items []struct {
+		Metadata struct {
+			Name              string            `json:"name"`
+			Namespace         string            `json:"namespace"`
+			Labels            map[string]string `json:"labels"`
+			CreationTimestamp time.Time         `json:"creationTimestamp"`
+			DeletionTimestamp *time.Time        `json:"deletionTimestamp"`
+		} `json:"metadata"`
+		Spec struct {
+			NodeName string `json:"nodeName"`
+		} `json:"spec"`
+		Status struct {
+			Phase      string `json:"phase"`
+			PodIP      string `json:"podIP"`
+			Conditions []struct {
+				Type   string `json:"type"`
+				Status string `json:"status"`
+			} `json:"conditions"`
+			ContainerStatuses []struct {
+				RestartCount int `json:"restartCount"`
+			} `json:"containerStatuses"`
+		} `json:"status"`
+	} `json:"items"`
+}
+
+func runPodsList(cmd *cobra.Command, args []string) error {
+	ns, err := podsTargetNamespace()
+	if err != nil {
+		return err
+	}
+
+	selector := podLabelApp
+	if podsRig != "" {
+		selector += "," + podLabelRig + "=" + podsRig
+	}
+	pods, err := listAgentPods(ns, selector)
+	if err != nil {
+		return err
+	}
+
+	if podsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(pods)
+	}
+
+	if len(pods) == 0 {
+		fmt.Printf("No agent pods in namespace %s\n", ns)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "AGENT\tPOD\tPHASE\tREADY\tRESTARTS\tNODE\tAGE")
+	for _, p := range pods {
+		phase := p.Phase
+		if p.Phase == "Running" && p.Ready {
+			phase = style.Bold.Render(phase)
+		}
+		ready := "no"
+		if p.Ready {
+			ready = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			p.Address, p.Pod, phase, ready, p.Restarts, p.Node, formatWorkerAge(time.Since(p.Created)))
+	}
+	return w.Flush()
+}
+
+func runPodsDescribe(cmd *cobra.Command, args []string) error {
+	ns, pod, err := resolvePodTarget(args[0])
+	if err != nil {
+		return err
+	}
+	return runKubectlPassthrough("describe", "pod", pod, "-n", ns)
+}
+
+func runPodsLogs(cmd *cobra.Command, args []string) error {
+	ns, pod, err := resolvePodTarget(args[0])
+	if err != nil {
+		return err
+	}
+	kargs := []string{"logs", pod, "-n", ns, fmt.Sprintf("--tail=%d", podsTail)}
+	if podsContainer != "" {
+		kargs = append(kargs, "-c", podsContainer)
+	}
+	if podsFollow {
+		kargs = append(kargs, "-f")
+	}
+	if podsPrevious {
+		kargs = append(kargs, "-p")
+	}
+	return runKubectlPassthrough(kargs...)
+}
+
+func runPodsDelete(cmd *cobra.Command, args []string) error {
+	ns, pod, err := resolvePodTarget(args[0])
+	if err != nil {
+		return err
+	}
+	kargs := []string{"delete", "pod", pod, "-n", ns, "--wait=false"}
+	if podsDeleteForce {
+		kargs = append(kargs, "--grace-period=0", "--force")
+	}
+	if err := runKubectlPassthrough(kargs...); err != nil {
+		return err
+	}
+	fmt.Printf("%s Deleted %s; the controller will recreate it if the agent is still active\n",
+		style.Bold.Render("✓"), pod)
+	return nil
+}
+
+// podsTargetNamespace returns --namespace or the namespace from gt connect.
+func podsTargetNamespace() (string, error) {
+	if podsNamespace != "" {
+		return podsNamespace, nil
+	}
+	if ns := getConnectedNamespace(); ns != "" {
+		return ns, nil
+	}
+	return "", fmt.Errorf("no namespace — run 'gt connect <namespace>' first or pass --namespace")
+}
+
+// resolvePodTarget maps a target to (namespace, pod name). Direct pod names
+// are used as-is; everything else is looked up by label.
+func resolvePodTarget(target string) (string, string, error) {
+	ns, err := podsTargetNamespace()
+	if err != nil {
+		return "", "", err
+	}
+	if strings.HasPrefix(target, "gt-") {
+		return ns, target, nil
+	}
+
+	pods, err := listAgentPods(ns, podSelectorForTarget(target))
+	if err != nil {
+		return "", "", err
+	}
+	switch len(pods) {
+	case 0:
+		return "", "", fmt.Errorf("no pod found for %q in namespace %s", target, ns)
+	case 1:
+		return ns, pods[0].Pod, nil
+	default:
+		var names []string
+		for _, p := range pods {
+			names = append(names, p.Address)
+		}
+		return "", "", fmt.Errorf("%q matches %d pods (%s); use a full address", target, len(pods), strings.Join(names, ", "))
+	}
+}
+
+// podSelectorForTarget builds a label selector for an agent address.
+func podSelectorForTarget(target string) string {
+	labels := []string{podLabelApp}
+	parts := strings.Split(target, "/")
+	switch len(parts) {
+	case 1:
+		switch target {
+		case "mayor", "deacon":
+			labels = append(labels, podLabelRole+"="+target)
+		default:
+			labels = append(labels, podLabelAgent+"="+target)
+		}
+	case 2:
+		// rig/role for singletons, rig/name for polecats and crew.
+		labels = append(labels, podLabelRig+"="+parts[0])
+		switch parts[1] {
+		case "witness", "refinery":
+			labels = append(labels, podLabelRole+"="+parts[1])
+		default:
+			labels = append(labels, podLabelAgent+"="+parts[1])
+		}
+	default:
+		labels = append(labels,
+			podLabelRig+"="+parts[0],
+			podLabelRole+"="+podRoleLabel(parts[1]),
+			podLabelAgent+"="+parts[2])
+	}
+	return strings.Join(labels, ",")
+}
+
+// podRoleLabel converts an address role segment ("polecats") to the
+// singular form used in pod labels.
+func podRoleLabel(role string) string {
+	if role == "polecats" {
+		return "polecat"
+	}
+	return role
+}
+
+// podAddress converts pod labels back into an agent address such as
+// "gastown/polecats/furiosa".
+func podAddress(rig, role, agent string) string {
+	switch role {
+	case "mayor", "deacon":
+		return role
+	case "witness", "refinery":
+		return rig + "/" + role
+	case "polecat":
+		return rig + "/polecats/" + agent
+	default:
+		return rig + "/" + role + "/" + agent
+	}
+}
+
+// listAgentPods runs kubectl get pods with selector and returns the agent
+// pods sorted by address. Pods without the gastown.io agent labels or that
+// are being deleted are skipped.
+func listAgentPods(namespace, selector string) ([]AgentPod, error) {
+	out, err := exec.Command("kubectl", podsKubectlArgs("get", "pods", "-n", namespace, "-l", selector, "-o", "json")...).Output()
+	if err != nil {
+		if ee, ok := err.(*exec.ExitError); ok && len(ee.Stderr) > 0 {
+			return nil, fmt.Errorf("kubectl get pods: %s", strings.TrimSpace(string(ee.Stderr)))
+		}
+		return nil, fmt.Errorf("kubectl get pods: %w", err)
+	}
+	return parseAgentPods(out)
+}
+
+// parseAgentPods converts `kubectl get pods -o json` output into AgentPods.
+func parseAgentPods(data []byte) ([]AgentPod, error) {
+	var list k8sPodList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("parsing pod list: %w", err)
+	}
+
+	var pods []AgentPod
+	for _, item := range list.Items {
+		if item.Metadata.DeletionTimestamp != nil {
+			continue
+		}
+		rig := item.Metadata.Labels[podLabelRig]
+		role := item.Metadata.Labels[podLabelRole]
+		agent := item.Metadata.Labels[podLabelAgent]
+		if role == "" {
+			continue
+		}
+
+		p := AgentPod{
+			Address:   podAddress(rig, role, agent),
+			Pod:       item.Metadata.Name,
+			Namespace: item.Metadata.Namespace,
+			Rig:       rig,
+			Role:      role,
+			Agent:     agent,
+			Phase:     item.Status.Phase,
+			Node:      item.Spec.NodeName,
+			IP:        item.Status.PodIP,
+			Created:   item.Metadata.CreationTimestamp,
+		}
+		for _, c := range item.Status.Conditions {
+			if c.Type == "Ready" {
+				p.Ready = c.Status == "True"
+			}
+		}
+		for _, cs := range item.Status.ContainerStatuses {
+			p.Restarts += cs.RestartCount
+		}
+		pods = append(pods, p)
+	}
+
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Address < pods[j].Address })
+	return pods, nil
+}
+
+// runKubectlPassthrough runs kubectl with the terminal attached.
+func runKubectlPassthrough(args ...string) error {
+	c := exec.Command("kubectl", podsKubectlArgs(args...)...)
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("kubectl %s: %w", args[0], err)
+	}
+	return nil
+}
+
+// podsKubectlArgs appends --context when one was given.
+func podsKubectlArgs(args ...string) []string {
+	if podsContext != "" {
+		args = append(args, "--context", podsContext)
+	}
+	return args
+}
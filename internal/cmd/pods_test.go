@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func TestPodAddress(t *testing.T) {
+	tests := []struct {
+		rig, role, agent string
+		want             string
+	}{
+		{"town", "mayor", "hq", "mayor"},
+		{"gastown", "witness", "", "gastown/witness"},
+		{"gastown", "polecat", "furiosa", "gastown/polecats/furiosa"},
+		{"gastown", "crew", "max", "gastown/crew/max"},
+	}
+	for _, tt := range tests {
+		if got := podAddress(tt.rig, tt.role, tt.agent); got != tt.want {
+			t.Errorf("podAddress(%q, %q, %q) = %q, want %q", tt.rig, tt.role, tt.agent, got, tt.want)
+		}
+	}
+}
+
+func TestPodSelectorForTarget(t *testing.T) {
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{"mayor", "app.kubernetes.io/name=gastown,gastown.io/role=mayor"},
+		{"nux", "app.kubernetes.io/name=gastown,gastown.io/agent=nux"},
+		{"gastown/witness", "app.kubernetes.io/name=gastown,gastown.io/rig=gastown,gastown.io/role=witness"},
+		{"gastown/nux", "app.kubernetes.io/name=gastown,gastown.io/rig=gastown,gastown.io/agent=nux"},
+		{"gastown/polecats/nux", "app.kubernetes.io/name=gastown,gastown.io/rig=gastown,gastown.io/role=polecat,gastown.io/agent=nux"},
+		{"gastown/crew/max", "app.kubernetes.io/name=gastown,gastown.io/rig=gastown,gastown.io/role=crew,gastown.io/agent=max"},
+	}
+	for _, tt := range tests {
+		if got := podSelectorForTarget(tt.target); got != tt.want {
+			t.Errorf("podSelectorForTarget(%q) = %q, want %q", tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestParseAgentPods(t *testing.T) {
+	data := []byte(`{"items": [
+	  {
+	    "metadata": {
+	      "name": "gt-gastown-polecat-nux",
+	      "namespace": "gastown",
+	      "labels": {"gastown.io/rig": "gastown", "gastown.io/role": "polecat", "gastown.io/agent": "nux"},
+	      "creationTimestamp": "2026-03-01T12:00:00Z"
+	    },
+	    "spec": {"nodeName": "node-a"},
+	    "status": {
+	      "phase": "Running",
+	      "podIP": "10.0.1.5",
+	      "conditions": [{"type": "Ready", "status": "True"}],
+	      "containerStatuses": [{"restartCount": 2}, {"restartCount": 1}]
+	    }
+	  },
+	  {
+	    "metadata": {
+	      "name": "gt-gastown-crew-max",
+	      "namespace": "gastown",
+	      "labels": {"gastown.io/rig": "gastown", "gastown.io/role": "crew", "gastown.io/agent": "max"},
+	      "creationTimestamp": "2026-03-01T12:00:00Z"
+	    },
+	    "status": {"phase": "Pending"}
+	  },
+	  {
+	    "metadata": {
+	      "name": "gt-gastown-crew-old",
+	      "labels": {"gastown.io/rig": "gastown", "gastown.io/role": "crew", "gastown.io/agent": "old"},
+	      "deletionTimestamp": "2026-03-01T12:05:00Z"
+	    }
+	  },
+	  {"metadata": {"name": "unrelated"}}
+	]}`)
+
+	pods, err := parseAgentPods(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pods) != 2 {
+		t.Fatalf("got %d pods, want 2: %+v", len(pods), pods)
+	}
+
+	// Sorted by address.
+	if pods[0].Address != "gastown/crew/max" || pods[1].Address != "gastown/polecats/nux" {
+		t.Errorf("addresses = %q, %q", pods[0].Address, pods[1].Address)
+	}
+	nux := pods[1]
+	if !nux.Ready || nux.Restarts != 3 || nux.Node != "node-a" || nux.IP != "10.0.1.5" {
+		t.Errorf("nux = %+v", nux)
+	}
+	if pods[0].Ready {
+		t.Error("pending pod without a Ready condition should not be ready")
+	}
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var (
+	polecatRunIssue string
+	polecatRunQuiet bool
+	polecatRunJSON  bool
+)
+
+var polecatRunCmd = &cobra.Command{
+	Use:   "run <rig>/<polecat>",
+	Short: "Run a polecat's agent headless, without a terminal session",
+	Long: `Run a polecat's agent once in its non-interactive mode and wait for it.
+
+Instead of starting a terminal session, the agent is run directly with its
+preset's non-interactive flags (e.g. 'claude -p --output-format stream-json',
+'codex exec --json', 'opencode run --format json') and the startup beacon as
+its prompt. Useful for CI-like batch work where nobody attaches.
+
+The agent's JSON output is streamed to stdout and saved to
+polecats/<name>/headless.jsonl. Start and finish are logged to the event
+feed. With --issue, the issue is hooked before the run and the agent's
+final message is posted to it as a comment.
+
+Exits non-zero if the agent does. Agents whose preset has no
+non-interactive mode can't run headless.
+
+Examples:
+  gt polecat run greenplace/Toast --issue gp-abc
+  gt polecat run greenplace/Toast --issue gp-abc --quiet --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPolecatRun,
+}
+
+func init() {
+	polecatRunCmd.Flags().StringVar(&polecatRunIssue, "issue", "", "Issue to hook and report the result to")
+	polecatRunCmd.Flags().BoolVarP(&polecatRunQuiet, "quiet", "q", false, "Don't stream the agent's output")
+	polecatRunCmd.Flags().BoolVar(&polecatRunJSON, "json", false, "Print the run summary as JSON")
+
+	polecatCmd.AddCommand(polecatRunCmd)
+}
+
+func runPolecatRun(cmd *cobra.Command, args []string) error {
+	rigName, polecatName, err := parseAddress(args[0])
+	if err != nil {
+		return err
+	}
+	sm, _, err := getSessionManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer
+	if !polecatRunQuiet {
+		out = os.Stdout
+	}
+	result, err := sm.RunHeadless(cmd.Context(), polecatName, polecat.SessionStartOptions{Issue: polecatRunIssue}, out)
+	if err != nil {
+		return err
+	}
+
+	if polecatRunJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+	} else {
+		icon := style.Success.Render("✓")
+		if result.ExitCode != 0 {
+			icon = style.Error.Render("✗")
+		}
+		fmt.Fprintf(os.Stderr, "%s %s/%s (%s) exited %d after %s\n",
+			icon, rigName, polecatName, result.Preset, result.ExitCode, result.Duration.Round(time.Second))
+		fmt.Fprintf(os.Stderr, "  transcript: %s\n", result.Transcript)
+	}
+
+	if result.ExitCode != 0 {
+		return fmt.Errorf("agent exited with code %d", result.ExitCode)
+	}
+	return nil
+}
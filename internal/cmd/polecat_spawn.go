@@ -5,15 +5,15 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
-	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/eventbus"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/sling"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -23,6 +23,7 @@ type SpawnedPolecatInfo struct {
 	PolecatName string // Polecat name (e.g., "Toast")
 	ClonePath   string // Path to polecat's git worktree
 	K8sSpawn    bool   // True when dispatched to K8s (no local worktree/session)
+	Warm        bool   // True when claimed from the rig's warm pool (already running; needs a nudge)
 }
 
 // AgentID returns the agent identifier (e.g., "gastown/polecats/Toast")
@@ -37,7 +38,8 @@ type SlingSpawnOptions struct {
 	Create          bool   // Create polecat if it doesn't exist (currently always true for sling)
 	HookBead        string // Bead ID to set as hook_bead at spawn time (atomic assignment)
 	Agent           string // Agent override for this spawn (e.g., "gemini", "codex", "claude-haiku")
-	ExecutionTarget string // "local" (default) or "k8s" — overrides rig config
+	ExecutionTarget string // "local" (default), "k8s" or "docker" — overrides rig config
+	LimitOverride   string // Decision bead approving a spawn past the rig's limits
 }
 
 // SpawnPolecatForSling creates a fresh polecat and optionally starts its session.
@@ -63,12 +65,31 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 		return nil, fmt.Errorf("rig '%s' not found", rigName)
 	}
 
-	// Resolve execution target: explicit override > rig settings > "local"
-	execTarget := resolveExecutionTarget(r.Path, opts.ExecutionTarget)
-	if execTarget != config.ExecutionTargetK8s {
-		return nil, fmt.Errorf("local execution is no longer supported (codebase is K8s-only since v0.7.0)")
+	// Per-rig limits (max_polecats, max_slings_per_hour, daily_token_budget)
+	if err := sling.AdmitSling(townRoot, r, opts.LimitOverride); err != nil {
+		return nil, err
 	}
-	return spawnPolecatForK8sCMD(townRoot, rigName, r, opts)
+
+	// Resolve execution target: explicit override > agent or rig settings > "local"
+	execTarget := polecat.ExecutionTarget(r, opts.Agent, opts.ExecutionTarget)
+	switch execTarget {
+	case config.ExecutionTargetK8s:
+		// Warm polecats run the rig's default agent and account.
+		if opts.Agent == "" && opts.Account == "" {
+			if res := sling.ClaimWarmPolecat(townRoot, r, opts.HookBead); res != nil {
+				return &SpawnedPolecatInfo{RigName: rigName, PolecatName: res.PolecatName, K8sSpawn: true, Warm: true}, nil
+			}
+		}
+		return spawnPolecatForK8sCMD(townRoot, rigName, r, opts)
+	case config.ExecutionTargetDocker:
+		p, err := polecat.SpawnInDocker(r, opts.HookBead, opts.Account, opts.Agent)
+		if err != nil {
+			return nil, err
+		}
+		_ = eventbus.Publish("gt", eventbus.Spawn{Rig: rigName, Polecat: p.Name})
+		return &SpawnedPolecatInfo{RigName: rigName, PolecatName: p.Name, ClonePath: p.ClonePath}, nil
+	}
+	return nil, fmt.Errorf("local execution is no longer supported (codebase is K8s-only since v0.7.0); use execution target \"k8s\" or \"docker\"")
 }
 
 // IsRigName checks if a target string is a rig name (not a role or path).
@@ -134,29 +155,6 @@ func verifyAndSetHookBead(townRoot, rigName, polecatName, hookBead string) error
 	return nil
 }
 
-// resolveExecutionTarget determines the execution target for a rig.
-// Priority: explicit override > rig settings > K8s auto-detect > "local".
-// When running inside a K8s pod (KUBERNETES_SERVICE_HOST is set), defaults
-// to "k8s" instead of "local" so agents spawn as pods, not sessions.
-func resolveExecutionTarget(rigPath, override string) config.ExecutionTarget {
-	if override != "" {
-		return config.ExecutionTarget(override)
-	}
-
-	settingsPath := filepath.Join(rigPath, "settings", "config.json")
-	settings, err := config.LoadRigSettings(settingsPath)
-	if err == nil && settings.Execution != nil && settings.Execution.Target != "" {
-		return settings.Execution.Target
-	}
-
-	// Auto-detect K8s: every pod gets KUBERNETES_SERVICE_HOST injected by kubelet.
-	if os.Getenv("KUBERNETES_SERVICE_HOST") != "" {
-		return config.ExecutionTargetK8s
-	}
-
-	return config.ExecutionTargetLocal
-}
-
 // spawnPolecatForK8sCMD creates an agent bead for a K8s polecat without creating
 // a local worktree or session. The K8s controller watches for agent beads
 // with agent_state=spawning and execution_target:k8s label, then creates pods.
@@ -206,10 +204,15 @@ func spawnPolecatForK8sCMD(townRoot, rigName string, r *rig.Rig, opts SlingSpawn
 	if err != nil {
 		return nil, fmt.Errorf("creating agent bead for K8s polecat: %w", err)
 	}
+	if opts.LimitOverride != "" {
+		if err := sling.MarkLimitOverride(beadsClient, agentBeadID, opts.LimitOverride); err != nil {
+			fmt.Printf("Warning: could not record limit override on %s: %v\n", agentBeadID, err)
+		}
+	}
 
 	fmt.Printf("✓ Polecat %s dispatched to K8s (agent_state=spawning)\n", polecatName)
 
-	_ = events.LogFeed(events.TypeSpawn, "gt", events.SpawnPayload(rigName, polecatName))
+	_ = eventbus.Publish("gt", eventbus.Spawn{Rig: rigName, Polecat: polecatName})
 
 	return &SpawnedPolecatInfo{
 		RigName:     rigName,
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/registry"
@@ -39,6 +40,7 @@ Preflight verifies:
   4. No stuck or stopped agents
   5. No orphaned Claude processes
   6. Rig health (polecats, refinery, witness present)
+  7. Agent presets in use can run (see 'gt agents doctor')
 
 Use --rig to check a specific rig. Without --rig, checks all rigs.
 Use --dry-run to show what would be cleaned without taking action.
@@ -61,19 +63,20 @@ func init() {
 
 // PreflightReport contains the results of all preflight checks.
 type PreflightReport struct {
-	GitClean       bool              `json:"git_clean"`
-	OnMainBranch   bool              `json:"on_main_branch"`
-	CurrentBranch  string            `json:"current_branch"`
-	MailCleaned    int               `json:"mail_cleaned"`
-	StaleMailCount int               `json:"stale_mail_count"`
-	StuckWorkers   []string          `json:"stuck_workers"`
-	StoppedAgents  []string          `json:"stopped_agents"`
-	OrphanCount    int               `json:"orphan_count"`
-	OrphansCleaned int               `json:"orphans_cleaned"`
-	RigHealth      []RigHealthStatus `json:"rig_health"`
-	Warnings       []string          `json:"warnings"`
-	Errors         []string          `json:"errors"`
-	DryRun         bool              `json:"dry_run"`
+	GitClean       bool               `json:"git_clean"`
+	OnMainBranch   bool               `json:"on_main_branch"`
+	CurrentBranch  string             `json:"current_branch"`
+	MailCleaned    int                `json:"mail_cleaned"`
+	StaleMailCount int                `json:"stale_mail_count"`
+	StuckWorkers   []string           `json:"stuck_workers"`
+	StoppedAgents  []string           `json:"stopped_agents"`
+	OrphanCount    int                `json:"orphan_count"`
+	OrphansCleaned int                `json:"orphans_cleaned"`
+	RigHealth      []RigHealthStatus  `json:"rig_health"`
+	AgentPresets   []agentPresetCheck `json:"agent_presets"`
+	Warnings       []string           `json:"warnings"`
+	Errors         []string           `json:"errors"`
+	DryRun         bool               `json:"dry_run"`
 }
 
 // RigHealthStatus reports health for a single rig.
@@ -86,7 +89,7 @@ type RigHealthStatus struct {
 	Issues     []string `json:"issues,omitempty"`
 }
 
-func runPreflight(_ *cobra.Command, _ []string) error {
+func runPreflight(cmd *cobra.Command, _ []string) error {
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
@@ -113,7 +116,10 @@ func runPreflight(_ *cobra.Command, _ []string) error {
 	// 5. Check rig health
 	checkRigHealth(townRoot, report)
 
-	// 6. Run bd export to ensure JSONL is current
+	// 6. Check the agent presets roles will be spawned with
+	checkAgentPresetsInUse(cmd.Context(), townRoot, report)
+
+	// 7. Run bd export to ensure JSONL is current
 	if !preflightDryRun {
 		syncBeads(townRoot, report)
 	}
@@ -403,6 +409,26 @@ func checkRigHealth(townRoot string, report *PreflightReport) {
 	}
 }
 
+// checkAgentPresetsInUse health-checks the presets used by default_agent
+// and role_agents. Unusable presets are errors; missing credentials warn.
+func checkAgentPresetsInUse(ctx context.Context, townRoot string, report *PreflightReport) {
+	presets, usedBy := townAgentPresets(townRoot)
+	inUse := make(map[string]*config.AgentPresetInfo)
+	for name := range usedBy {
+		inUse[name] = presets[name]
+	}
+
+	report.AgentPresets = checkAgentPresets(ctx, inUse, usedBy)
+	for _, c := range report.AgentPresets {
+		for _, p := range c.Problems {
+			report.Errors = append(report.Errors, fmt.Sprintf("agent %s: %s", c.Name, p))
+		}
+		for _, w := range c.Warnings {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("agent %s: %s", c.Name, w))
+		}
+	}
+}
+
 func syncBeads(townRoot string, report *PreflightReport) {
 	// Run bd export in town root to sync JSONL
 	bdPath, err := exec.LookPath("bd")
@@ -481,6 +507,21 @@ func printPreflightReport(report *PreflightReport) {
 		}
 	}
 
+	// Agent presets (problems are listed with the errors below)
+	var usable []string
+	for _, c := range report.AgentPresets {
+		if !c.Usable() {
+			continue
+		}
+		usable = append(usable, c.Name)
+		for _, w := range c.Warnings {
+			fmt.Printf("  %s Agent %s: %s\n", style.Warning.Render("⚠"), c.Name, w)
+		}
+	}
+	if len(usable) > 0 {
+		fmt.Printf("  %s Agent presets usable (%s)\n", style.Success.Render("✓"), strings.Join(usable, ", "))
+	}
+
 	// Errors
 	for _, e := range report.Errors {
 		fmt.Printf("  %s %s\n", style.Error.Render("✗"), e)
@@ -1,12 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
 )
 
 func TestPreflightReport_EmptyIsClean(t *testing.T) {
@@ -321,6 +324,32 @@ func TestSyncBeads_MissingBd(t *testing.T) {
 	}
 }
 
+func TestCheckAgentPresetsInUse_UnknownRoleAgent(t *testing.T) {
+	townRoot := t.TempDir()
+	t.Setenv("PATH", t.TempDir()) // no agent binaries
+	settings := config.NewTownSettings()
+	settings.RoleAgents = map[string]string{"polecat": "no-such-agent"}
+	if err := config.SaveTownSettings(config.TownSettingsPath(townRoot), settings); err != nil {
+		t.Fatal(err)
+	}
+
+	report := &PreflightReport{}
+	checkAgentPresetsInUse(context.Background(), townRoot, report)
+
+	// Only the default agent and the polecat agent are checked
+	if len(report.AgentPresets) != 2 {
+		t.Fatalf("expected 2 presets checked, got %d: %+v", len(report.AgentPresets), report.AgentPresets)
+	}
+	var sawUnknown, sawClaude bool
+	for _, e := range report.Errors {
+		sawUnknown = sawUnknown || strings.Contains(e, "agent no-such-agent: not defined")
+		sawClaude = sawClaude || strings.Contains(e, `agent claude: binary "claude" not found`)
+	}
+	if !sawUnknown || !sawClaude {
+		t.Errorf("expected errors for both agents in use, got: %v", report.Errors)
+	}
+}
+
 // setupTestGitRepo creates a minimal git repo at dir with an initial commit on 'main'.
 func setupTestGitRepo(t *testing.T, dir string) {
 	t.Helper()
@@ -26,6 +26,7 @@ var primeDryRun bool
 var primeState bool
 var primeStateJSON bool
 var primeExplain bool
+var primeBudget int
 
 // Role represents a detected agent role.
 type Role string
@@ -70,9 +71,11 @@ HOOK MODE (--hook):
 Output includes:
   - Role-specific context from templates
   - Agent Advice (filtered by role, rig, and agent identity)
-  - Handoff content from previous sessions
   - Auto-seance project context
-  - Hooked work details (for autonomous mode)
+  - Work context: hooked work, molecule step, handoffs, unread mail and
+    knowledge, kept within a token budget (--budget, or prime_context in
+    settings/config.json) in priority order and formatted for the runtime's
+    instruction file (CLAUDE.md or AGENTS.md)
 
 See docs/concepts/agent-advice.md for advice system documentation.
 
@@ -121,6 +124,8 @@ func init() {
 		"Output state as JSON (requires --state)")
 	primeCmd.Flags().BoolVar(&primeExplain, "explain", false,
 		"Show why each section was included")
+	primeCmd.Flags().IntVar(&primeBudget, "budget", 0,
+		"Token budget for the work context (default: settings prime_context, else 4000)")
 	rootCmd.AddCommand(primeCmd)
 }
 
@@ -162,8 +167,12 @@ func runPrime(cmd *cobra.Command, args []string) error {
 	}
 
 	// Handle hook mode: read session ID from stdin and persist it
+	var agentSessionID string
 	if primeHookMode {
-		sessionID, source := readHookSessionID()
+		sessionID, source, fromAgent := readHookSessionID()
+		if fromAgent {
+			agentSessionID = sessionID
+		}
 		if !primeDryRun {
 			persistSessionID(townRoot, sessionID)
 			if cwd != townRoot {
@@ -241,6 +250,9 @@ func runPrime(cmd *cobra.Command, args []string) error {
 	// Emit session_start event for seance discovery
 	if !primeDryRun {
 		emitSessionEvent(ctx)
+		if agentSessionID != "" {
+			recordAgentSessionID(ctx, agentSessionID)
+		}
 	}
 
 	// Output session metadata for seance discovery
@@ -256,9 +268,6 @@ func runPrime(cmd *cobra.Command, args []string) error {
 	// Output applicable advice for this agent
 	outputAdviceContext(ctx)
 
-	// Output handoff content if present
-	outputHandoffContent(ctx)
-
 	// Run auto-seance for cold project context recovery
 	// Order: Handoff Mail -> Auto-Seance -> Role Instructions
 	outputAutoSeanceContext(ctx)
@@ -267,9 +276,13 @@ func runPrime(cmd *cobra.Command, args []string) error {
 	outputAttachmentStatus(ctx)
 
 	// Check for slung work on hook (from gt sling)
-	// If found, we're in autonomous mode - skip normal startup directive
+	// If found, we're in autonomous mode - skip normal startup directive.
+	// checkSlungWork outputs the work context itself; otherwise output it here.
 	hasSlungWork := checkSlungWork(ctx)
 	explain(hasSlungWork, "Autonomous mode: hooked/in-progress work detected")
+	if !hasSlungWork {
+		outputWorkContext(ctx, nil)
+	}
 
 	// Output molecule context if working on a molecule step
 	outputMoleculeContext(ctx)
@@ -284,13 +297,6 @@ func runPrime(cmd *cobra.Command, args []string) error {
 		explain(true, "bd prime: skipped in dry-run mode")
 	}
 
-	// Run gt mail check --inject to inject any pending mail
-	if !primeDryRun {
-		runMailCheckInject(cwd)
-	} else {
-		explain(true, "gt mail check --inject: skipped in dry-run mode")
-	}
-
 	// For Mayor, check for pending escalations
 	if ctx.Role == RoleMayor {
 		checkPendingEscalations(ctx)
@@ -416,31 +422,6 @@ func runBdPrime(workDir string) {
 	}
 }
 
-// runMailCheckInject runs `gt mail check --inject` and outputs the result.
-// This injects any pending mail into the agent's context.
-func runMailCheckInject(workDir string) {
-	cmd := exec.Command("gt", "mail", "check", "--inject")
-	cmd.Dir = workDir
-
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	if err := cmd.Run(); err != nil {
-		// Skip if mail check fails, but log stderr for debugging
-		if errMsg := strings.TrimSpace(stderr.String()); errMsg != "" {
-			fmt.Fprintf(os.Stderr, "gt mail check: %s\n", errMsg)
-		}
-		return
-	}
-
-	output := strings.TrimSpace(stdout.String())
-	if output != "" {
-		fmt.Println()
-		fmt.Println(output)
-	}
-}
-
 // checkSlungWork checks for hooked work on the agent's hook.
 // If found, displays AUTONOMOUS WORK MODE and tells the agent to execute immediately.
 // Returns true if hooked work was found (caller should skip normal startup directive).
@@ -555,67 +536,8 @@ func checkSlungWork(ctx RoleContext) bool {
 	}
 	fmt.Println()
 
-	// Show the hooked work details
-	fmt.Printf("%s\n\n", style.Bold.Render("## Hooked Work"))
-	fmt.Printf("  Bead ID: %s\n", style.Bold.Render(hookedBead.ID))
-	fmt.Printf("  Title: %s\n", hookedBead.Title)
-	if hookedBead.Description != "" {
-		// Show first few lines of description
-		lines := strings.Split(hookedBead.Description, "\n")
-		maxLines := 5
-		if len(lines) > maxLines {
-			lines = lines[:maxLines]
-			lines = append(lines, "...")
-		}
-		fmt.Println("  Description:")
-		for _, line := range lines {
-			fmt.Printf("    %s\n", line)
-		}
-	}
-	fmt.Println()
-
-	// If molecule attached, show molecule context prominently INSTEAD of bd show
-	if hasMolecule {
-		fmt.Printf("%s\n\n", style.Bold.Render("## 🧬 ATTACHED MOLECULE (FORMULA WORKFLOW)"))
-		fmt.Printf("Molecule ID: %s\n", attachment.AttachedMolecule)
-		if attachment.AttachedArgs != "" {
-			fmt.Printf("\n%s\n", style.Bold.Render("📋 ARGS (use these to guide execution):"))
-			fmt.Printf("  %s\n", attachment.AttachedArgs)
-		}
-		fmt.Println()
-
-		// Show current step from molecule - THIS IS THE PRIMARY INSTRUCTION
-		showMoleculeExecutionPrompt(ctx.WorkDir, attachment.AttachedMolecule)
-
-		fmt.Println()
-		fmt.Printf("%s\n", style.Bold.Render("⚠️  IMPORTANT: Follow the molecule steps above, NOT the base bead."))
-		fmt.Println("The base bead is just a container. The molecule steps define your workflow.")
-	} else {
-		// No molecule - show bead preview using bd show
-		fmt.Println("**Bead details:**")
-		cmd := bdcmd.Command("show", hookedBead.ID)
-		var stdout, stderr bytes.Buffer
-		cmd.Stdout = &stdout
-		cmd.Stderr = &stderr
-		if err := cmd.Run(); err != nil {
-			if errMsg := strings.TrimSpace(stderr.String()); errMsg != "" {
-				fmt.Fprintf(os.Stderr, "  bd show %s: %s\n", hookedBead.ID, errMsg)
-			} else {
-				fmt.Fprintf(os.Stderr, "  bd show %s: %v\n", hookedBead.ID, err)
-			}
-		} else {
-			lines := strings.Split(stdout.String(), "\n")
-			maxLines := 15
-			if len(lines) > maxLines {
-				lines = lines[:maxLines]
-				lines = append(lines, "...")
-			}
-			for _, line := range lines {
-				fmt.Printf("  %s\n", line)
-			}
-		}
-		fmt.Println()
-	}
+	// Hooked bead, molecule step, handoffs, mail and knowledge, within budget
+	outputWorkContext(ctx, hookedBead)
 
 	return true
 }
@@ -0,0 +1,304 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/handoff"
+	"github.com/steveyegge/gastown/internal/knowledge"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/prime"
+)
+
+// Source limits: enough to be useful without burying the role context. The
+// budget trims further.
+const (
+	primeKnowledgeHits   = 5  // Entries matched against hooked work
+	primeKnowledgeRecent = 3  // Newest entries when nothing is hooked
+	primeMailMessages    = 10 // Unread messages listed
+)
+
+// outputWorkContext assembles and prints the agent's work context: hooked
+// work and its molecule step, handoffs, unread mail and knowledge, trimmed
+// to the configured token budget. hooked is nil when nothing is on the hook.
+func outputWorkContext(ctx RoleContext, hooked *beads.Issue) {
+	agent := &prime.Agent{
+		TownRoot: ctx.TownRoot,
+		WorkDir:  ctx.WorkDir,
+		Rig:      ctx.Rig,
+		Role:     string(ctx.Role),
+		Address:  getAgentIdentity(ctx),
+	}
+	if hooked != nil {
+		agent.HookBead = hooked.ID
+	}
+
+	assembler := &prime.ContextAssembler{
+		Sources: primeContextSources(ctx, hooked),
+		Budget:  primeContextBudget(ctx),
+	}
+	result := assembler.Assemble(agent)
+	for _, e := range result.Errors {
+		explain(true, fmt.Sprintf("Work context: %s source failed: %v", e.Source, e.Err))
+	}
+	for _, o := range result.Omitted {
+		explain(true, fmt.Sprintf("Work context: omitted %q (~%d tokens) over budget", o.Title, o.Tokens))
+	}
+	explain(true, fmt.Sprintf("Work context: %d sections, ~%d of %d tokens", len(result.Sections), result.Tokens, result.Budget))
+
+	if out := result.Render(primeContextFormat(ctx)); out != "" {
+		fmt.Print(out)
+		fmt.Println()
+	}
+}
+
+// primeContextBudget returns --budget, else the town's configured budget for
+// the role, else zero (the default).
+func primeContextBudget(ctx RoleContext) int {
+	if primeBudget > 0 {
+		return primeBudget
+	}
+	if ctx.TownRoot == "" {
+		return 0
+	}
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(ctx.TownRoot))
+	if err != nil {
+		return 0
+	}
+	return settings.PrimeContext.BudgetFor(string(ctx.Role))
+}
+
+// primeContextFormat follows the instruction file of the role's runtime:
+// AGENTS.md for Codex and OpenCode, CLAUDE.md otherwise.
+func primeContextFormat(ctx RoleContext) prime.Format {
+	if ctx.TownRoot == "" {
+		return prime.FormatClaude
+	}
+	var rigPath string
+	if ctx.Rig != "" {
+		rigPath = filepath.Join(ctx.TownRoot, ctx.Rig)
+	}
+	rc := config.ResolveRoleAgentConfig(string(ctx.Role), ctx.TownRoot, rigPath)
+	if rc == nil || rc.Instructions == nil {
+		return prime.FormatClaude
+	}
+	return prime.FormatForInstructions(rc.Instructions.File)
+}
+
+// primeContextSources returns the work context sources in display order.
+func primeContextSources(ctx RoleContext, hooked *beads.Issue) []prime.Source {
+	return []prime.Source{
+		prime.NewSource("hook", func(*prime.Agent) ([]prime.Section, error) {
+			return hookSections(hooked), nil
+		}),
+		prime.NewSource("molecule", func(a *prime.Agent) ([]prime.Section, error) {
+			return moleculeSections(a.WorkDir, hooked)
+		}),
+		prime.NewSource("handoff", func(a *prime.Agent) ([]prime.Section, error) {
+			return handoffSections(ctx, hooked)
+		}),
+		prime.NewSource("mail", func(*prime.Agent) ([]prime.Section, error) {
+			return mailSections(ctx)
+		}),
+		prime.NewSource("knowledge", func(*prime.Agent) ([]prime.Section, error) {
+			return knowledgeSections(ctx, hooked)
+		}),
+	}
+}
+
+// hookSections describes the hooked bead.
+func hookSections(hooked *beads.Issue) []prime.Section {
+	if hooked == nil {
+		return nil
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "**Bead ID:** %s\n**Title:** %s\n**Status:** %s\n", hooked.ID, hooked.Title, hooked.Status)
+	if a := beads.ParseAttachmentFields(hooked); a != nil && a.AttachedArgs != "" {
+		fmt.Fprintf(&b, "**Args (use these to guide execution):** %s\n", a.AttachedArgs)
+	}
+	if desc := strings.TrimSpace(hooked.Description); desc != "" {
+		fmt.Fprintf(&b, "\n%s\n", desc)
+	}
+	return []prime.Section{{
+		Title:    "Hooked Work",
+		Icon:     "🪝",
+		Priority: prime.PriorityHook,
+		Body:     b.String(),
+		Required: true,
+	}}
+}
+
+// moleculeSections shows the current step of the molecule attached to the
+// hooked bead. This is the agent's primary instruction when present.
+func moleculeSections(workDir string, hooked *beads.Issue) ([]prime.Section, error) {
+	if hooked == nil {
+		return nil, nil
+	}
+	attachment := beads.ParseAttachmentFields(hooked)
+	if attachment == nil || attachment.AttachedMolecule == "" {
+		return nil, nil
+	}
+	molID := attachment.AttachedMolecule
+
+	section := prime.Section{
+		Title:    "Attached Molecule " + molID,
+		Icon:     "🧬",
+		Priority: prime.PriorityMolecule,
+		Required: true,
+	}
+	current, err := moleculeCurrent(workDir, molID)
+	if err != nil {
+		section.Body = fmt.Sprintf("**→ PROPULSION PRINCIPLE: Work is on your hook. RUN IT.**\n"+
+			"Begin working on this molecule immediately. Check status with: bd mol current %s", molID)
+		return []prime.Section{section}, nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "**Progress:** %d/%d steps complete\n\n", current.Completed, current.Total)
+	if step := current.NextStep; step != nil {
+		section.Title = "CURRENT STEP: " + step.Title
+		section.Icon = "🎬"
+		fmt.Fprintf(&b, "**Step ID:** %s\n**Status:** %s (ready to execute)\n\n", step.ID, step.Status)
+		b.WriteString("**→ EXECUTE THIS STEP NOW.** When complete:\n")
+		fmt.Fprintf(&b, "1. Close the step: bd close %s\n2. Check for next step: bd ready\n3. Continue until molecule complete\n", step.ID)
+		b.WriteString("\nFollow the molecule steps, NOT the base bead; the base bead is just a container.\n")
+		if desc := strings.TrimSpace(step.Description); desc != "" {
+			fmt.Fprintf(&b, "\n### Instructions\n\n%s\n", desc)
+		}
+	} else {
+		b.WriteString("**✓ MOLECULE COMPLETE.** All steps are done. Report completion to your supervisor or check for new work with bd ready.\n")
+	}
+	section.Body = b.String()
+	return []prime.Section{section}, nil
+}
+
+// handoffSections returns the latest handoff on the hooked bead and the
+// role's pinned handoff bead.
+func handoffSections(ctx RoleContext, hooked *beads.Issue) ([]prime.Section, error) {
+	var sections []prime.Section
+	if hooked != nil {
+		if d, err := handoff.Latest(beads.New(ctx.WorkDir), hooked.ID); err == nil && d != nil {
+			sections = append(sections, prime.Section{
+				Title:    "Handoff from Previous Agent",
+				Icon:     "🤝",
+				Priority: prime.PriorityHandoff,
+				Body:     beadHandoffBody(d),
+			})
+		}
+	}
+	if ctx.Role != RoleUnknown && ctx.TownRoot != "" {
+		issue, err := beads.New(ctx.TownRoot).FindHandoffBead(string(ctx.Role))
+		if err == nil && issue != nil && issue.Description != "" {
+			sections = append(sections, prime.Section{
+				Title:    "Handoff from Previous Session",
+				Icon:     "🤝",
+				Priority: prime.PriorityHandoff,
+				Body:     issue.Description + "\n\n(Clear with: gt rig reset --handoff)",
+			})
+		}
+	}
+	return sections, nil
+}
+
+func beadHandoffBody(d *handoff.Document) string {
+	from := d.From
+	if from == "" {
+		from = "unknown"
+	}
+	if !d.CreatedAt.IsZero() {
+		from += ", " + d.CreatedAt.Local().Format("2006-01-02 15:04")
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "From %s. Read this before starting: it is where they left off.\n", from)
+	for _, s := range handoff.Sections {
+		if body := d.Body[s.Key]; body != "" {
+			fmt.Fprintf(&b, "\n### %s\n%s\n", s.Heading, body)
+		}
+	}
+	return b.String()
+}
+
+// mailSections lists unread mail, newest first.
+func mailSections(ctx RoleContext) ([]prime.Section, error) {
+	address := detectSender()
+	if ctx.TownRoot == "" || address == "" {
+		return nil, nil
+	}
+	mailbox, err := mail.NewRouter(ctx.TownRoot).GetMailbox(address)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := mailbox.ListUnread()
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	var b strings.Builder
+	for i, msg := range messages {
+		if i == primeMailMessages {
+			fmt.Fprintf(&b, "- … and %d more\n", len(messages)-i)
+			break
+		}
+		fmt.Fprintf(&b, "- %s from %s: %s", msg.ID, msg.From, msg.Subject)
+		if msg.Priority == mail.PriorityUrgent || msg.Priority == mail.PriorityHigh {
+			fmt.Fprintf(&b, " [%s]", msg.Priority)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\nRun 'gt mail inbox' to see your messages, or 'gt mail read <id>' for a specific message.\n")
+	return []prime.Section{{
+		Title:    fmt.Sprintf("Unread Mail (%d)", len(messages)),
+		Icon:     "📬",
+		Priority: prime.PriorityMail,
+		Body:     b.String(),
+	}}, nil
+}
+
+// knowledgeSections returns learnings relevant to the agent: the best
+// matches for its hooked bead, or the rig's newest when nothing is hooked.
+func knowledgeSections(ctx RoleContext, hooked *beads.Issue) ([]prime.Section, error) {
+	if ctx.TownRoot == "" {
+		return nil, nil
+	}
+	entries, err := knowledge.NewStore(ctx.TownRoot).List(ctx.Rig)
+	if err != nil || len(entries) == 0 {
+		return nil, err
+	}
+
+	title := "Knowledge for Your Hooked Work"
+	var picked []*knowledge.Entry
+	if hooked != nil {
+		for _, h := range knowledge.NewIndex(entries).Search(hooked.Title+"\n"+hooked.Description, primeKnowledgeHits) {
+			picked = append(picked, h.Entry)
+		}
+	} else {
+		title = "Recent Knowledge"
+		picked = entries[:min(len(entries), primeKnowledgeRecent)]
+	}
+	if len(picked) == 0 {
+		return nil, nil
+	}
+
+	var b strings.Builder
+	b.WriteString("Learnings other agents recorded. Search for more with `gt know search <words>`; record your own with `gt know add`.\n")
+	for _, e := range picked {
+		fmt.Fprintf(&b, "\n**[%s]** %s (%s)\n", e.Kind, e.Title, e.ID)
+		for _, line := range strings.Split(strings.TrimSpace(e.Body), "\n") {
+			if line != "" {
+				fmt.Fprintf(&b, "  %s\n", line)
+			}
+		}
+	}
+	return []prime.Section{{
+		Title:    title,
+		Icon:     "🧠",
+		Priority: prime.PriorityKnowledge,
+		Body:     b.String(),
+	}}, nil
+}
@@ -27,38 +27,43 @@ type MoleculeCurrentOutput struct {
 	Total     int `json:"total"`
 }
 
-// showMoleculeExecutionPrompt calls bd mol current and shows the current step
-// with execution instructions. This is the core of the Propulsion Principle.
-func showMoleculeExecutionPrompt(workDir, moleculeID string) {
-	// Call bd mol current with JSON output
+// moleculeCurrent runs bd mol current for a molecule and returns its
+// progress and next step.
+func moleculeCurrent(workDir, moleculeID string) (*MoleculeCurrentOutput, error) {
 	cmd := bdcmd.CommandInDir(workDir, "mol", "current", moleculeID, "--json")
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
-
 	if err := cmd.Run(); err != nil {
-		// Fall back to simple message if bd mol current fails
-		fmt.Println(style.Bold.Render("→ PROPULSION PRINCIPLE: Work is on your hook. RUN IT."))
-		fmt.Println("  Begin working on this molecule immediately.")
-		fmt.Printf("  Check status with: bd mol current %s\n", moleculeID)
-		return
+		return nil, fmt.Errorf("bd mol current %s: %w", moleculeID, err)
 	}
 	// Handle bd --no-daemon exit 0 bug: empty stdout means not found
 	if stdout.Len() == 0 {
-		fmt.Println(style.Bold.Render("→ PROPULSION PRINCIPLE: Work is on your hook. RUN IT."))
-		fmt.Println("  Begin working on this molecule immediately.")
-		return
+		return nil, fmt.Errorf("molecule %s not found", moleculeID)
 	}
 
 	// Parse JSON output - it's an array with one element
 	var outputs []MoleculeCurrentOutput
-	if err := json.Unmarshal(stdout.Bytes(), &outputs); err != nil || len(outputs) == 0 {
-		// Fall back to simple message
+	if err := json.Unmarshal(stdout.Bytes(), &outputs); err != nil {
+		return nil, fmt.Errorf("parsing bd mol current output: %w", err)
+	}
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("molecule %s not found", moleculeID)
+	}
+	return &outputs[0], nil
+}
+
+// showMoleculeExecutionPrompt calls bd mol current and shows the current step
+// with execution instructions. This is the core of the Propulsion Principle.
+func showMoleculeExecutionPrompt(workDir, moleculeID string) {
+	output, err := moleculeCurrent(workDir, moleculeID)
+	if err != nil {
+		// Fall back to simple message if bd mol current fails
 		fmt.Println(style.Bold.Render("→ PROPULSION PRINCIPLE: Work is on your hook. RUN IT."))
 		fmt.Println("  Begin working on this molecule immediately.")
+		fmt.Printf("  Check status with: bd mol current %s\n", moleculeID)
 		return
 	}
-	output := outputs[0]
 
 	// Show molecule progress
 	fmt.Printf("**Progress:** %d/%d steps complete\n\n",
@@ -309,34 +309,6 @@ func outputUnknownContext(ctx RoleContext) {
 	fmt.Printf("Town root: %s\n", style.Dim.Render(ctx.TownRoot))
 }
 
-// outputHandoffContent reads and displays the pinned handoff bead for the role.
-func outputHandoffContent(ctx RoleContext) {
-	if ctx.Role == RoleUnknown {
-		return
-	}
-
-	// Get role key for handoff bead lookup
-	roleKey := string(ctx.Role)
-
-	bd := beads.New(ctx.TownRoot)
-	issue, err := bd.FindHandoffBead(roleKey)
-	if err != nil {
-		// Silently skip if beads lookup fails (might not be a beads repo)
-		return
-	}
-	if issue == nil || issue.Description == "" {
-		// No handoff content
-		return
-	}
-
-	// Display handoff content
-	fmt.Println()
-	fmt.Printf("%s\n\n", style.Bold.Render("## 🤝 Handoff from Previous Session"))
-	fmt.Println(issue.Description)
-	fmt.Println()
-	fmt.Println(style.Dim.Render("(Clear with: gt rig reset --handoff)"))
-}
-
 // outputStartupDirective outputs role-specific instructions for the agent.
 // This tells agents like Mayor to announce themselves on startup.
 func outputStartupDirective(ctx RoleContext) {
@@ -606,7 +578,7 @@ func outputDeaconPausedMessage(state *deacon.PauseState) {
 		fmt.Printf("Paused by: %s\n", state.PausedBy)
 	}
 	fmt.Println()
-	fmt.Println("Wait for human to run `gt deacon resume` before working.")
+	fmt.Println("Wait for human to run `gt resume --town` before working.")
 	fmt.Println()
 	fmt.Println("**DO NOT:**")
 	fmt.Println("- Create patrol molecules")
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -24,25 +25,48 @@ type hookInput struct {
 }
 
 // readHookSessionID reads session ID from available sources in hook mode.
-// Priority: stdin JSON, GT_SESSION_ID env, CLAUDE_SESSION_ID env, auto-generate.
-func readHookSessionID() (sessionID, source string) {
+// Priority: stdin JSON, GT_SESSION_ID env, the runtime's session ID env
+// (GT_SESSION_ID_ENV, then CLAUDE_SESSION_ID), auto-generate.
+// fromAgent reports that the ID came from the agent CLI itself, so the CLI
+// can resume the session with it.
+func readHookSessionID() (sessionID, source string, fromAgent bool) {
 	// 1. Try reading stdin JSON (Claude Code format)
 	if input := readStdinJSON(); input != nil {
 		if input.SessionID != "" {
-			return input.SessionID, input.Source
+			return input.SessionID, input.Source, true
 		}
 	}
 
 	// 2. Environment variables
 	if id := os.Getenv("GT_SESSION_ID"); id != "" {
-		return id, ""
+		return id, "", false
+	}
+	if envName := os.Getenv("GT_SESSION_ID_ENV"); envName != "" {
+		if id := os.Getenv(envName); id != "" {
+			return id, "", true
+		}
 	}
 	if id := os.Getenv("CLAUDE_SESSION_ID"); id != "" {
-		return id, ""
+		return id, "", true
 	}
 
 	// 3. Auto-generate
-	return uuid.New().String(), ""
+	return uuid.New().String(), "", false
+}
+
+// recordAgentSessionID stores the agent CLI's session ID on the agent bead,
+// where the daemon finds it to resume the session if it crashes.
+func recordAgentSessionID(ctx RoleContext, sessionID string) {
+	agentBeadID := getAgentBeadID(ctx)
+	if agentBeadID == "" {
+		return
+	}
+	// Rig agents live in rig beads; mayor and deacon in town beads.
+	bd := beads.New(ctx.TownRoot)
+	if ctx.Rig != "" && ctx.Role != RoleMayor && ctx.Role != RoleDeacon {
+		bd = beads.New(filepath.Join(ctx.TownRoot, ctx.Rig))
+	}
+	_ = bd.UpdateAgentSessionID(agentBeadID, sessionID) // Non-fatal
 }
 
 // readStdinJSON attempts to read and parse JSON from stdin.
@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/terminal"
+	"github.com/steveyegge/gastown/internal/workspace"
+	"golang.org/x/term"
+)
+
+// replayMaxIdle caps the pause between frames during playback, so a
+// recording of an agent that sat idle for an hour doesn't take an hour.
+const replayMaxIdle = 2 * time.Second
+
+var (
+	replayAt        string
+	replaySpeed     float64
+	replayRecording string
+	replayList      bool
+)
+
+func init() {
+	rootCmd.AddCommand(replayCmd)
+	replayCmd.Flags().StringVar(&replayAt, "at", "", "Print the screen as of this time (offset like 5m, clock time like 14:32, or RFC3339)")
+	replayCmd.Flags().Float64Var(&replaySpeed, "speed", 1, "Playback speed multiplier")
+	replayCmd.Flags().StringVar(&replayRecording, "recording", "", "Recording ID to replay (default: latest)")
+	replayCmd.Flags().BoolVar(&replayList, "list", false, "List the agent's recordings")
+}
+
+var replayCmd = &cobra.Command{
+	Use:     "replay <agent>",
+	GroupID: GroupComm,
+	Short:   "Replay a recorded agent session",
+	Long: `Replay a recording of an agent's terminal session.
+
+When session recording is enabled in mayor/daemon.json, the daemon captures
+each polecat's pane every few seconds and stores what changed under
+.runtime/recordings/<session>/. Use replay for post-mortems: to see what an
+agent was doing before it got stuck, crashed, or handed back failed work.
+
+Without --at, the recording is played back in the terminal. Long idle
+stretches are shortened; --speed speeds up the rest. With --at, the screen
+as it was at that moment is printed instead.
+
+Examples:
+  gt replay greenplace/furiosa                    # Play the latest recording
+  gt replay greenplace/furiosa --speed 4          # ...four times as fast
+  gt replay greenplace/furiosa --at 12m           # Screen 12 minutes in
+  gt replay greenplace/furiosa --at 14:32         # Screen at 14:32
+  gt replay greenplace/furiosa --list             # List recordings`,
+	Args: cobra.ExactArgs(1),
+	RunE: runReplay,
+}
+
+func runReplay(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	identity, err := session.ParseAddress(args[0])
+	if err != nil {
+		return err
+	}
+	sessionName := identity.SessionName()
+
+	if replayList {
+		return listRecordings(townRoot, sessionName)
+	}
+
+	rec, err := terminal.LoadRecording(townRoot, sessionName, replayRecording)
+	if err != nil {
+		return err
+	}
+
+	if replayAt != "" {
+		at, err := parseReplayTime(replayAt, rec.Started)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "%s %s at %s\n", style.Dim.Render("▶"), args[0], at.Local().Format("2006-01-02 15:04:05"))
+		for _, line := range rec.ScreenAt(at) {
+			fmt.Println(line)
+		}
+		return nil
+	}
+
+	// Playback redraws the terminal; piped output gets the final screen.
+	if !term.IsTerminal(int(os.Stdout.Fd())) {
+		for _, line := range rec.ScreenAt(rec.End()) {
+			fmt.Println(line)
+		}
+		return nil
+	}
+	if replaySpeed <= 0 {
+		return fmt.Errorf("--speed must be positive")
+	}
+	playRecording(rec, replaySpeed)
+	return nil
+}
+
+// listRecordings prints a session's recordings, oldest first.
+func listRecordings(townRoot, sessionName string) error {
+	ids, err := terminal.ListRecordings(townRoot, sessionName)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		fmt.Printf("No recordings for %s\n", sessionName)
+		return nil
+	}
+	for _, id := range ids {
+		rec, err := terminal.LoadRecording(townRoot, sessionName, id)
+		if err != nil {
+			fmt.Printf("%s  %s\n", id, style.Dim.Render(err.Error()))
+			continue
+		}
+		fmt.Printf("%s  %s  %s  %d frames\n", id,
+			rec.Started.Local().Format("2006-01-02 15:04:05"),
+			rec.End().Sub(rec.Started).Round(time.Second), len(rec.Frames))
+	}
+	return nil
+}
+
+// parseReplayTime parses --at as an offset from the recording's start
+// ("90s", "12m"), a clock time on the day it started ("14:32",
+// "14:32:05"), or an RFC3339 timestamp.
+func parseReplayTime(s string, started time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return started.Add(d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	local := started.Local()
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.ParseInLocation(layout, s, local.Location()); err == nil {
+			return time.Date(local.Year(), local.Month(), local.Day(),
+				t.Hour(), t.Minute(), t.Second(), 0, local.Location()), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid --at %q: want an offset (12m), clock time (14:32), or RFC3339 time", s)
+}
+
+// playRecording redraws each frame as it happened: resets clear the
+// screen, and dropped lines are erased by moving the cursor back over them.
+func playRecording(rec *terminal.Recording, speed float64) {
+	var shown []string
+	prev := rec.Started
+	for _, f := range rec.Frames {
+		at := rec.FrameTime(f)
+		wait := time.Duration(float64(at.Sub(prev)) / speed)
+		if wait > replayMaxIdle {
+			wait = replayMaxIdle
+		}
+		time.Sleep(wait)
+		prev = at
+
+		var b strings.Builder
+		if f.Reset {
+			b.WriteString("\033[H\033[2J")
+		} else if drop := min(f.Drop, len(shown)); drop > 0 {
+			fmt.Fprintf(&b, "\033[%dA\033[J", drop)
+		}
+		for _, line := range f.Lines {
+			b.WriteString(line + "\n")
+		}
+		fmt.Print(b.String())
+		shown = terminal.ApplyDelta(shown, f.Delta())
+	}
+}
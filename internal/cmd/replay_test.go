@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseReplayTime(t *testing.T) {
+	started := time.Date(2026, 1, 2, 14, 0, 0, 0, time.Local)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"12m", started.Add(12 * time.Minute)},
+		{"14:32", time.Date(2026, 1, 2, 14, 32, 0, 0, time.Local)},
+		{"14:32:05", time.Date(2026, 1, 2, 14, 32, 5, 0, time.Local)},
+		{"2026-01-02T15:00:00Z", time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := parseReplayTime(tt.in, started)
+		if err != nil {
+			t.Errorf("parseReplayTime(%q): %v", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("parseReplayTime(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+	if _, err := parseReplayTime("yesterday", started); err == nil {
+		t.Error("expected error for invalid time")
+	}
+}
@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var reslingCmd = &cobra.Command{
+	Use:     "resling <bead>",
+	Aliases: []string{"requeue"},
+	GroupID: GroupWork,
+	Short:   "Move hooked work from a dead agent to a new target",
+	Long: `Requeue work that is stuck on an agent's hook and dispatch it again.
+
+Resling takes a hooked bead away from its current agent and slings it to a
+new target:
+  1. Optionally stops the old session (--kill) and asks the witness to clean
+     up the old polecat
+  2. Clears the old agent's hook and returns the bead to open
+  3. Clears stale attachment fields (attached_molecule, attached_at,
+     oj_job_id) and closes the old molecule wisp; dispatch settings such as
+     merge_strategy and args are kept
+  4. Slings the bead to the new target
+  5. Records the reassignment in the event log
+
+Without --to, work from a polecat goes to a fresh polecat in the same rig.
+Resling refuses to take work from a polecat whose session is still running
+unless --kill or --force is given.
+
+Examples:
+  gt resling gt-abc                      # Fresh polecat in the same rig
+  gt resling gt-abc --to greenplace/Toast
+  gt resling gt-abc --kill --reason "stuck in a loop"
+  gt resling gt-abc --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runResling,
+}
+
+var (
+	reslingTo     string
+	reslingKill   bool
+	reslingForce  bool
+	reslingReason string
+	reslingDryRun bool
+)
+
+func init() {
+	reslingCmd.Flags().StringVar(&reslingTo, "to", "", "New target (default: a fresh polecat in the old polecat's rig)")
+	reslingCmd.Flags().BoolVar(&reslingKill, "kill", false, "Stop the old agent's session and request cleanup")
+	reslingCmd.Flags().BoolVarP(&reslingForce, "force", "f", false, "Reassign even if the old session is still running or the bead isn't hooked")
+	reslingCmd.Flags().StringVar(&reslingReason, "reason", "", "Why the work is being reassigned (recorded in the event log)")
+	reslingCmd.Flags().BoolVarP(&reslingDryRun, "dry-run", "n", false, "Show what would be done")
+	rootCmd.AddCommand(reslingCmd)
+}
+
+func runResling(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	info, err := getBeadInfo(beadID)
+	if err != nil {
+		return fmt.Errorf("checking bead status: %w", err)
+	}
+	if info.Status == "closed" {
+		return fmt.Errorf("bead %s is already closed", beadID)
+	}
+	if info.Status != beads.StatusHooked && !reslingForce {
+		return fmt.Errorf("bead %s is %s, not hooked\n  Use 'gt sling' to dispatch it, or --force to resling anyway", beadID, info.Status)
+	}
+	from := strings.TrimSuffix(info.Assignee, "/")
+
+	// Work from a polecat can stay in its rig by default.
+	oldRig, oldPolecat := "", ""
+	if parts := strings.Split(from, "/"); len(parts) == 3 && parts[1] == "polecats" {
+		oldRig, oldPolecat = parts[0], parts[2]
+	}
+
+	target := strings.TrimRight(reslingTo, "/")
+	if target == "" {
+		if oldRig == "" {
+			return fmt.Errorf("bead %s is assigned to %q, not a polecat; use --to to choose a target", beadID, info.Assignee)
+		}
+		target = oldRig
+	}
+	if from != "" && target == from {
+		return fmt.Errorf("bead %s is already assigned to %s", beadID, from)
+	}
+
+	// Don't pull work out from under a live polecat by accident.
+	oldRunning := false
+	if oldPolecat != "" {
+		if sessMgr, _, err := getSessionManager(oldRig); err == nil {
+			oldRunning, _ = sessMgr.IsRunning(oldPolecat)
+		}
+	}
+	if oldRunning && !reslingKill && !reslingForce {
+		return fmt.Errorf("%s still has a running session\n  Use --kill to stop it, or --force to reassign anyway", from)
+	}
+
+	b := beads.New(beads.ResolveHookDir(townRoot, beadID, ""))
+	issue, err := b.Show(beadID)
+	if err != nil {
+		return fmt.Errorf("getting bead %s: %w", beadID, err)
+	}
+	attachment := beads.ParseAttachmentFields(issue)
+
+	fmt.Printf("%s Reslinging %s from %s to %s...\n", style.Bold.Render("🔁"), beadID, orDefault(from, "(unassigned)"), target)
+
+	if reslingDryRun {
+		if reslingKill && oldPolecat != "" {
+			if oldRunning {
+				fmt.Printf("Would stop session for %s\n", from)
+			}
+			fmt.Printf("Would send LIFECYCLE:Shutdown %s to %s/witness\n", oldPolecat, oldRig)
+		}
+		if from != "" {
+			fmt.Printf("Would clear hook_bead on %s\n", from)
+		}
+		fmt.Printf("Would run: bd update %s --status=open --assignee=\n", beadID)
+		if attachment != nil && (attachment.AttachedMolecule != "" || attachment.AttachedAt != "" || attachment.OjJobID != "") {
+			fmt.Printf("Would clear stale attachment fields on %s\n", beadID)
+			if attachment.AttachedMolecule != "" {
+				fmt.Printf("Would close stale molecule %s\n", attachment.AttachedMolecule)
+			}
+		}
+		fmt.Printf("Would run: gt sling %s %s\n", beadID, target)
+		return nil
+	}
+
+	// Step 1: stop the old agent and let the witness clean up its polecat.
+	killed := false
+	if reslingKill && oldPolecat != "" {
+		killed = stopReslingPolecat(townRoot, oldRig, oldPolecat, beadID, target, oldRunning)
+	}
+
+	// Step 2: take the bead off the old agent's hook.
+	if from != "" {
+		clearReslingAgentHook(townRoot, from, beadID)
+	}
+	openStatus := "open"
+	emptyAssignee := ""
+	if err := b.Update(beadID, beads.UpdateOptions{Status: &openStatus, Assignee: &emptyAssignee}); err != nil {
+		return fmt.Errorf("unhooking %s: %w", beadID, err)
+	}
+	fmt.Printf("%s Unhooked %s\n", style.Bold.Render("✓"), beadID)
+
+	// Step 3: the old molecule belongs to the old agent's attempt; sling
+	// attaches a fresh one.
+	if attachment != nil && (attachment.AttachedMolecule != "" || attachment.AttachedAt != "" || attachment.OjJobID != "") {
+		if attachment.AttachedMolecule != "" {
+			if err := b.CloseWithReason("superseded by resling of "+beadID, attachment.AttachedMolecule); err != nil {
+				fmt.Printf("%s Could not close stale molecule %s: %v\n", style.Dim.Render("Warning:"), attachment.AttachedMolecule, err)
+			}
+		}
+		attachment.AttachedMolecule = ""
+		attachment.AttachedAt = ""
+		attachment.OjJobID = ""
+		desc := beads.SetAttachmentFields(issue, attachment)
+		if err := b.Update(beadID, beads.UpdateOptions{Description: &desc}); err != nil {
+			fmt.Printf("%s Could not clear attachment fields: %v\n", style.Dim.Render("Warning:"), err)
+		} else {
+			fmt.Printf("%s Cleared stale attachment fields\n", style.Bold.Render("✓"))
+		}
+	}
+
+	// Step 4: dispatch to the new target.
+	if err := callSling([]string{beadID, target}); err != nil {
+		return fmt.Errorf("bead %s is unhooked but dispatch to %s failed: %w\n  Retry with: gt sling %s %s", beadID, target, err, beadID, target)
+	}
+
+	// Step 5: record the reassignment. The sling itself logs its own event.
+	_ = events.LogFeed(events.TypeResling, detectActor(), events.ReslingPayload(beadID, from, target, reslingReason, killed))
+
+	fmt.Printf("%s Reslung %s from %s to %s\n", style.Bold.Render("✓"), beadID, orDefault(from, "(unassigned)"), target)
+	return nil
+}
+
+// stopReslingPolecat stops a polecat's session and asks its witness to
+// clean it up. Returns true if the session was stopped.
+func stopReslingPolecat(townRoot, rigName, polecatName, beadID, newTarget string, running bool) bool {
+	killed := false
+	if running {
+		if sessMgr, _, err := getSessionManager(rigName); err == nil {
+			if err := sessMgr.Stop(polecatName, true); err != nil {
+				fmt.Printf("%s Could not stop %s/%s: %v\n", style.Warning.Render("⚠"), rigName, polecatName, err)
+			} else {
+				killed = true
+				fmt.Printf("%s Stopped session for %s/%s\n", style.Bold.Render("✓"), rigName, polecatName)
+			}
+		}
+	}
+
+	// The witness nukes the polecat (or its pod) if clean, otherwise it
+	// creates a cleanup wisp, same as a forced re-sling.
+	reason := "work_reassigned"
+	if reslingReason != "" {
+		reason = reslingReason
+	}
+	router := mail.NewRouter(townRoot)
+	msg := &mail.Message{
+		From:     "gt-resling",
+		To:       fmt.Sprintf("%s/witness", rigName),
+		Subject:  fmt.Sprintf("LIFECYCLE:Shutdown %s", polecatName),
+		Body:     fmt.Sprintf("Reason: %s\nRequestedBy: %s\nBead: %s\nNewAssignee: %s", reason, detectActor(), beadID, newTarget),
+		Type:     mail.TypeTask,
+		Priority: mail.PriorityHigh,
+	}
+	if err := router.Send(msg); err != nil {
+		fmt.Printf("%s Could not send shutdown to witness: %v\n", style.Dim.Render("Warning:"), err)
+	} else {
+		fmt.Printf("%s Sent LIFECYCLE:Shutdown to %s/witness for %s\n", style.Bold.Render("→"), rigName, polecatName)
+	}
+	return killed
+}
+
+// clearReslingAgentHook clears the old agent's hook_bead if it still points
+// at beadID. Failures are warnings: the agent may already be gone.
+func clearReslingAgentHook(townRoot, agentID, beadID string) {
+	agentBeadID := agentIDToBeadID(agentID, townRoot)
+	if agentBeadID == "" {
+		return
+	}
+	b := beads.New(beads.ResolveHookDir(townRoot, agentBeadID, townRoot))
+	agentBead, err := b.Show(agentBeadID)
+	if err != nil || agentBead.HookBead != beadID {
+		return
+	}
+	if err := b.ClearHookBead(agentBeadID); err != nil {
+		fmt.Printf("%s Could not clear hook on %s: %v\n", style.Dim.Render("Warning:"), agentBeadID, err)
+		return
+	}
+	fmt.Printf("%s Cleared hook on %s\n", style.Bold.Render("✓"), agentID)
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// setupReslingTown creates a minimal town with a stub bd that reports
+// gt-abc with the given status and assignee, logging every call to the
+// returned file.
+func setupReslingTown(t *testing.T, status, assignee string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("stub bd script is POSIX shell")
+	}
+
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor", "rig"), 0755); err != nil {
+		t.Fatalf("mkdir mayor/rig: %v", err)
+	}
+	binDir := filepath.Join(townRoot, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatalf("mkdir binDir: %v", err)
+	}
+	logPath := filepath.Join(townRoot, "bd.log")
+	bdScript := `#!/bin/sh
+echo "ARGS:$*" >> "${BD_LOG}"
+while [ "${1#--}" != "$1" ]; do shift; done
+cmd="$1"
+shift || true
+case "$cmd" in
+  show)
+    printf '%s\n' '[{"id":"gt-abc","title":"Test issue","status":"` + status + `","assignee":"` + assignee + `","description":"attached_molecule: gt-wisp-1\nmerge_strategy: direct"}]'
+    ;;
+esac
+exit 0
+`
+	_ = writeBDStub(t, binDir, bdScript, "")
+	isolateFromDaemon(t, binDir)
+
+	t.Setenv("BD_LOG", logPath)
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("GT_POLECAT", "")
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("getwd: %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(cwd) })
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+
+	prevTo, prevDryRun, prevForce := reslingTo, reslingDryRun, reslingForce
+	t.Cleanup(func() {
+		reslingTo, reslingDryRun, reslingForce = prevTo, prevDryRun, prevForce
+	})
+	reslingTo, reslingDryRun, reslingForce = "", false, false
+	return logPath
+}
+
+func TestReslingRefusesUnhookedBead(t *testing.T) {
+	setupReslingTown(t, "open", "")
+
+	err := runResling(nil, []string{"gt-abc"})
+	if err == nil || !strings.Contains(err.Error(), "not hooked") {
+		t.Fatalf("runResling() error = %v, want not hooked error", err)
+	}
+}
+
+func TestReslingNeedsTargetForNonPolecat(t *testing.T) {
+	setupReslingTown(t, "hooked", "gastown/crew/max")
+
+	err := runResling(nil, []string{"gt-abc"})
+	if err == nil || !strings.Contains(err.Error(), "--to") {
+		t.Fatalf("runResling() error = %v, want --to hint", err)
+	}
+}
+
+func TestReslingDryRunDefaultsToSameRig(t *testing.T) {
+	logPath := setupReslingTown(t, "hooked", "gastown/polecats/nux")
+	reslingDryRun = true
+
+	var runErr error
+	out := captureStdout(t, func() {
+		runErr = runResling(nil, []string{"gt-abc"})
+	})
+	if runErr != nil {
+		t.Fatalf("runResling() error: %v", runErr)
+	}
+
+	for _, want := range []string{"gt sling gt-abc gastown", "close stale molecule gt-wisp-1", "clear hook_bead on gastown/polecats/nux"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("dry-run output missing %q\nOutput:\n%s", want, out)
+		}
+	}
+
+	logBytes, _ := os.ReadFile(logPath)
+	if strings.Contains(string(logBytes), "update") || strings.Contains(string(logBytes), "close") {
+		t.Errorf("dry-run modified beads:\n%s", logBytes)
+	}
+}
@@ -26,6 +26,9 @@ its gate has cleared. If the gate is closed, it restores your work context.
 With --handoff, it checks the inbox for handoff messages (messages with
 "HANDOFF" in the subject) and displays them formatted for easy continuation.
 
+With --town, it unpauses a town paused with 'gt pause', restarting any
+polecat sessions that 'gt pause --drain' suspended.
+
 The resume command:
   1. Checks for parked work state (default) or handoff messages (--handoff)
   2. For parked work: verifies gate has closed
@@ -35,7 +38,8 @@ The resume command:
 Examples:
   gt resume              # Check for and resume parked work
   gt resume --status     # Just show parked work status without resuming
-  gt resume --handoff    # Check inbox for handoff messages`,
+  gt resume --handoff    # Check inbox for handoff messages
+  gt resume --town       # Unpause the town after gt pause`,
 	RunE: runResume,
 }
 
@@ -43,12 +47,14 @@ var (
 	resumeStatusOnly bool
 	resumeJSON       bool
 	resumeHandoff    bool
+	resumeTown       bool
 )
 
 func init() {
 	resumeCmd.Flags().BoolVar(&resumeStatusOnly, "status", false, "Just show parked work status")
 	resumeCmd.Flags().BoolVar(&resumeJSON, "json", false, "Output as JSON")
 	resumeCmd.Flags().BoolVar(&resumeHandoff, "handoff", false, "Check for handoff messages instead of parked work")
+	resumeCmd.Flags().BoolVar(&resumeTown, "town", false, "Unpause the town and restart polecats suspended by gt pause --drain")
 	rootCmd.AddCommand(resumeCmd)
 }
 
@@ -62,6 +68,10 @@ type ResumeStatus struct {
 }
 
 func runResume(cmd *cobra.Command, args []string) error {
+	if resumeTown {
+		return runTownResume()
+	}
+
 	// If --handoff flag, check for handoff messages instead
 	if resumeHandoff {
 		return checkHandoffMessages()
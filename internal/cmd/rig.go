@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/configbeads"
+	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/crew"
 	"github.com/steveyegge/gastown/internal/deps"
 	"github.com/steveyegge/gastown/internal/git"
@@ -22,6 +24,7 @@ import (
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/wisp"
 	"github.com/steveyegge/gastown/internal/workspace"
+	"golang.org/x/term"
 )
 
 var rigCmd = &cobra.Command{
@@ -40,10 +43,20 @@ A rig is a container for managing a project and its agents:
 }
 
 var rigAddCmd = &cobra.Command{
-	Use:   "add <name> <git-url>",
+	Use:   "add [name] <git-url>",
 	Short: "Add a new rig to the workspace",
 	Long: `Add a new rig by cloning a repository.
 
+The name is optional: given only a URL, the rig is named after the
+repository (my-project.git becomes my_project). Everything is checked
+before anything is created: the name, that the rig and its directory don't
+exist yet, that the beads prefix isn't used by another rig, and that the
+repository is reachable. The default branch is detected from the remote.
+
+Run without arguments on a terminal, or with --interactive, to be prompted
+for each setting. With --boot, the default crew workspace is created and
+started once the rig is ready.
+
 This creates a rig container with:
   - config.json           Rig configuration
   - .beads/               Rig-level issue tracking (initialized)
@@ -64,17 +77,25 @@ Use --adopt to register an existing directory instead of creating new:
   - Adds entry to mayor/rigs.json
 
 Example:
-  gt rig add gastown https://github.com/steveyegge/gastown
+  gt rig add https://github.com/steveyegge/gastown
+  gt rig add gastown https://github.com/steveyegge/gastown --boot
+  gt rig add -i
   gt rig add my-project git@github.com:user/repo.git --prefix mp
+  gt rig add my-project <url> --merge-method squash --required-check test
   gt rig add existing-rig --adopt`,
-	Args: cobra.RangeArgs(1, 2),
+	Args: cobra.RangeArgs(0, 2),
 	RunE: runRigAdd,
 }
 
 var rigListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all rigs in the workspace",
-	RunE:  runRigList,
+	Long: `List all rigs in the workspace.
+
+With --json, prints an array of rig objects whose field names follow the
+RigStatus message in the RPC API (name, path, polecats, crews, has_witness,
+has_refinery), plus git_url, prefix, and cloned.`,
+	RunE: runRigList,
 }
 
 var rigRemoveCmd = &cobra.Command{
@@ -283,11 +304,14 @@ Examples:
 // Flags
 var (
 	rigAddPrefix       string
+	rigListJSON        bool
 	rigAddLocalRepo    string
 	rigAddBranch       string
 	rigAddAdopt        bool
 	rigAddAdoptURL     string
 	rigAddAdoptForce   bool
+	rigAddInteractive  bool
+	rigAddBoot         bool
 	rigResetHandoff    bool
 	rigResetMail       bool
 	rigResetStale      bool
@@ -322,6 +346,13 @@ func init() {
 	rigAddCmd.Flags().BoolVar(&rigAddAdopt, "adopt", false, "Adopt an existing directory instead of creating new")
 	rigAddCmd.Flags().StringVar(&rigAddAdoptURL, "url", "", "Git remote URL for --adopt (default: auto-detected from origin)")
 	rigAddCmd.Flags().BoolVar(&rigAddAdoptForce, "force", false, "With --adopt, register even if git remote cannot be detected")
+	rigAddCmd.Flags().BoolVarP(&rigAddInteractive, "interactive", "i", false, "Prompt for each setting (default when run without arguments)")
+	rigAddCmd.Flags().BoolVar(&rigAddBoot, "boot", false, "Create and start the default crew workspace after adding")
+	rigAddCmd.Flags().StringVar(&rigPolicyMethod, "merge-method", "", "Merge policy method: squash, merge, or rebase")
+	rigAddCmd.Flags().StringArrayVar(&rigPolicyChecks, "required-check", nil, "Merge policy: required CI check (repeatable)")
+	rigAddCmd.Flags().IntVar(&rigPolicyApprovals, "required-approvals", 0, "Merge policy: required approving reviews")
+
+	rigListCmd.Flags().BoolVar(&rigListJSON, "json", false, "Output as JSON")
 
 	rigRegisterCmd.Flags().StringVar(&rigRegisterPrefix, "prefix", "", "Beads issue prefix (required)")
 	rigRegisterCmd.Flags().StringVar(&rigRegisterBranch, "branch", "main", "Default branch name")
@@ -345,18 +376,26 @@ func init() {
 }
 
 func runRigAdd(cmd *cobra.Command, args []string) error {
-	name := args[0]
-
 	// Handle --adopt mode: register existing directory
 	if rigAddAdopt {
+		if len(args) == 0 {
+			return fmt.Errorf("rig name is required with --adopt")
+		}
 		return runRigAdopt(cmd, args)
 	}
 
-	// Normal add mode requires git URL
-	if len(args) < 2 {
+	// Validate the merge policy before cloning anything
+	policy, err := rigAddMergePolicy(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Normal add mode requires git URL, asked for by the wizard if missing
+	name, gitURL := rigAddTarget(args)
+	interactive := rigAddInteractive || gitURL == ""
+	if interactive && !term.IsTerminal(int(os.Stdin.Fd())) {
 		return fmt.Errorf("git-url is required (or use --adopt to register an existing directory)")
 	}
-	gitURL := args[1]
 
 	// Ensure beads (bd) is available before proceeding
 	if err := deps.EnsureBeads(true); err != nil {
@@ -384,6 +423,36 @@ func runRigAdd(cmd *cobra.Command, args []string) error {
 	g := git.NewGit(townRoot)
 	mgr := rig.NewManager(townRoot, rigsConfig, g)
 
+	// Validate everything before cloning so a bad name or URL doesn't
+	// leave a half-created rig behind.
+	opts := rig.AddRigOptions{
+		Name:          name,
+		GitURL:        gitURL,
+		BeadsPrefix:   rigAddPrefix,
+		LocalRepo:     rigAddLocalRepo,
+		DefaultBranch: rigAddBranch,
+	}
+	boot := rigAddBoot
+	if interactive {
+		wizard := newRigAddWizard(os.Stdin, os.Stdout, mgr.CheckAddRig, g.RemoteHeadBranch)
+		if err := wizard.run(&opts, &boot); err != nil {
+			return err
+		}
+		fmt.Println()
+	} else {
+		if err := mgr.CheckAddRig(opts); err != nil {
+			return err
+		}
+		detected, err := g.RemoteHeadBranch(gitURL)
+		if err != nil {
+			return fmt.Errorf("cannot reach repository %s: %w", gitURL, err)
+		}
+		if opts.DefaultBranch == "" {
+			opts.DefaultBranch = detected
+		}
+	}
+	name, gitURL = opts.Name, opts.GitURL
+
 	fmt.Printf("Creating rig %s...\n", style.Bold.Render(name))
 	fmt.Printf("  Repository: %s\n", gitURL)
 	if rigAddLocalRepo != "" {
@@ -393,13 +462,7 @@ func runRigAdd(cmd *cobra.Command, args []string) error {
 	startTime := time.Now()
 
 	// Add the rig
-	newRig, err := mgr.AddRig(rig.AddRigOptions{
-		Name:          name,
-		GitURL:        gitURL,
-		BeadsPrefix:   rigAddPrefix,
-		LocalRepo:     rigAddLocalRepo,
-		DefaultBranch: rigAddBranch,
-	})
+	newRig, err := mgr.AddRig(opts)
 	if err != nil {
 		return fmt.Errorf("adding rig: %w", err)
 	}
@@ -480,6 +543,13 @@ func runRigAdd(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if policy != nil {
+		if err := saveRigMergePolicy(filepath.Join(townRoot, name), policy); err != nil {
+			return fmt.Errorf("saving merge policy: %w", err)
+		}
+		fmt.Printf("  ✓ Set merge policy (method: %s)\n", policy.EffectiveMethod())
+	}
+
 	elapsed := time.Since(startTime)
 
 	// Read default branch from rig config
@@ -500,6 +570,20 @@ func runRigAdd(cmd *cobra.Command, args []string) error {
 	fmt.Printf("  ├── witness/\n")
 	fmt.Printf("  └── polecats/\n")
 
+	if boot {
+		crewName := config.DefaultCrewName
+		if mayorCfg, err := config.LoadMayorConfig(constants.MayorConfigPath(townRoot)); err == nil && mayorCfg.DefaultCrewName != "" {
+			crewName = mayorCfg.DefaultCrewName
+		}
+		fmt.Printf("\nBooting rig %s...\n", style.Bold.Render(name))
+		if err := runCrewStart(cmd, []string{name, crewName}); err != nil {
+			return fmt.Errorf("rig %s was created but booting failed (retry with 'gt crew start %s %s'): %w", name, name, crewName, err)
+		}
+		fmt.Printf("\nNext steps:\n")
+		fmt.Printf("  gt crew at %s --rig %s   # Attach to the crew session\n", crewName, name)
+		return nil
+	}
+
 	fmt.Printf("\nNext steps:\n")
 	fmt.Printf("  gt crew add <name> --rig %s   # Create your personal workspace\n", name)
 	fmt.Printf("  cd %s/crew/<name>              # Start working\n", filepath.Join(townRoot, name))
@@ -507,6 +591,21 @@ func runRigAdd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// RigListEntry is one rig in gt rig list --json output. Field names match
+// the RPC RigStatus message so scripts can share decoders.
+type RigListEntry struct {
+	Name        string   `json:"name"`
+	Path        string   `json:"path,omitempty"`
+	GitURL      string   `json:"git_url,omitempty"`
+	Prefix      string   `json:"prefix,omitempty"`
+	Cloned      bool     `json:"cloned"`
+	Polecats    []string `json:"polecats"`
+	Crews       []string `json:"crews"`
+	HasWitness  bool     `json:"has_witness"`
+	HasRefinery bool     `json:"has_refinery"`
+	HasMayor    bool     `json:"has_mayor"`
+}
+
 func runRigList(cmd *cobra.Command, args []string) error {
 	// Find workspace
 	townRoot, err := workspace.FindFromCwdOrError()
@@ -517,6 +616,9 @@ func runRigList(cmd *cobra.Command, args []string) error {
 	// Use beads-first config loading (daemon config beads → rigs.json fallback).
 	rigsConfig, err := loadRigsConfigBeadsFirst(townRoot)
 	if err != nil || len(rigsConfig.Rigs) == 0 {
+		if rigListJSON {
+			return outputJSON([]RigListEntry{})
+		}
 		fmt.Println("No rigs configured.")
 		fmt.Printf("\nAdd one with: %s\n", style.Dim.Render("gt rig add <name> <git-url>"))
 		return nil
@@ -526,39 +628,67 @@ func runRigList(cmd *cobra.Command, args []string) error {
 	g := git.NewGit(townRoot)
 	mgr := rig.NewManager(townRoot, rigsConfig, g)
 
+	names := make([]string, 0, len(rigsConfig.Rigs))
+	for name := range rigsConfig.Rigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	entries := make([]RigListEntry, 0, len(names))
+	for _, name := range names {
+		entry := rigsConfig.Rigs[name]
+		e := RigListEntry{
+			Name:     name,
+			GitURL:   entry.GitURL,
+			Polecats: []string{},
+			Crews:    []string{},
+		}
+		if entry.BeadsConfig != nil {
+			e.Prefix = entry.BeadsConfig.Prefix
+		}
+		// Rig directory may not exist locally — then only registry info is known.
+		if r, err := mgr.GetRig(name); err == nil {
+			e.Cloned = true
+			e.Path = r.Path
+			e.Polecats = append(e.Polecats, r.Polecats...)
+			e.Crews = append(e.Crews, r.Crew...)
+			e.HasWitness = r.HasWitness
+			e.HasRefinery = r.HasRefinery
+			e.HasMayor = r.HasMayor
+		}
+		entries = append(entries, e)
+	}
+
+	if rigListJSON {
+		return outputJSON(entries)
+	}
+
 	fmt.Printf("Rigs in %s:\n\n", townRoot)
 
-	for name, entry := range rigsConfig.Rigs {
-		r, err := mgr.GetRig(name)
-		if err != nil {
-			// Rig directory doesn't exist locally — show registry info only.
-			fmt.Printf("  %s %s\n", style.Dim.Render("○"), style.Bold.Render(name))
-			if entry.GitURL != "" {
-				fmt.Printf("    Repo: %s\n", entry.GitURL)
-			}
-			if entry.BeadsConfig != nil && entry.BeadsConfig.Prefix != "" {
-				fmt.Printf("    Prefix: %s-\n", entry.BeadsConfig.Prefix)
-			}
+	for _, e := range entries {
+		if e.Cloned {
+			fmt.Printf("  %s %s\n", style.Success.Render("●"), style.Bold.Render(e.Name))
+		} else {
+			fmt.Printf("  %s %s\n", style.Dim.Render("○"), style.Bold.Render(e.Name))
+		}
+		if e.GitURL != "" {
+			fmt.Printf("    Repo: %s\n", e.GitURL)
+		}
+		if e.Prefix != "" {
+			fmt.Printf("    Prefix: %s-\n", e.Prefix)
+		}
+		if !e.Cloned {
 			fmt.Printf("    %s\n", style.Dim.Render("(not cloned locally)"))
 			fmt.Println()
 			continue
 		}
-
-		summary := r.Summary()
-		fmt.Printf("  %s %s\n", style.Success.Render("●"), style.Bold.Render(name))
-		if entry.GitURL != "" {
-			fmt.Printf("    Repo: %s\n", entry.GitURL)
-		}
-		if entry.BeadsConfig != nil && entry.BeadsConfig.Prefix != "" {
-			fmt.Printf("    Prefix: %s-\n", entry.BeadsConfig.Prefix)
-		}
-		fmt.Printf("    Polecats: %d  Crew: %d\n", summary.PolecatCount, summary.CrewCount)
+		fmt.Printf("    Polecats: %d  Crew: %d\n", len(e.Polecats), len(e.Crews))
 
 		agents := []string{}
-		if summary.HasWitness {
+		if e.HasWitness {
 			agents = append(agents, "witness")
 		}
-		if r.HasMayor {
+		if e.HasMayor {
 			agents = append(agents, "mayor")
 		}
 		if len(agents) > 0 {
@@ -628,7 +758,7 @@ func runRigRemove(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runRigAdopt(_ *cobra.Command, args []string) error {
+func runRigAdopt(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
 	// Find workspace
@@ -637,6 +767,15 @@ func runRigAdopt(_ *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
+	// Refuse to adopt a rig whose settings (including merge policy) are invalid
+	policy, err := rigAddMergePolicy(cmd)
+	if err != nil {
+		return err
+	}
+	if _, err := loadRigMergePolicy(filepath.Join(townRoot, name)); err != nil {
+		return fmt.Errorf("invalid rig settings: %w", err)
+	}
+
 	// Load rigs config
 	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
 	rigsConfig, err := config.LoadRigsConfig(rigsPath)
@@ -701,6 +840,12 @@ func runRigAdopt(_ *cobra.Command, args []string) error {
 		}
 	}
 
+	if policy != nil {
+		if err := saveRigMergePolicy(filepath.Join(townRoot, name), policy); err != nil {
+			return fmt.Errorf("saving merge policy: %w", err)
+		}
+	}
+
 	// Print results
 	fmt.Printf("\n%s Rig %s adopted\n", style.Success.Render("✓"), name)
 	if result.FromConfig {
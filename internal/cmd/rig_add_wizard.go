@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// errRigAddCancelled is returned when the wizard is declined or its input
+// ends before the rig is confirmed.
+var errRigAddCancelled = errors.New("rig add cancelled")
+
+// rigAddTarget splits gt rig add arguments into a rig name and git URL.
+// Rig names can't contain '/', ':' or '.', so a lone argument containing
+// any of them is the repository and the name is derived from it.
+func rigAddTarget(args []string) (name, gitURL string) {
+	switch {
+	case len(args) >= 2:
+		return args[0], args[1]
+	case len(args) == 1 && strings.ContainsAny(args[0], "/:."):
+		return rig.RigNameFromGitURL(args[0]), args[0]
+	case len(args) == 1:
+		return args[0], ""
+	}
+	return "", ""
+}
+
+// rigAddWizard prompts for the settings of a new rig. Every answer is
+// checked as it is given, so a typo is asked again instead of failing
+// after the clone.
+type rigAddWizard struct {
+	in  *bufio.Reader
+	out io.Writer
+
+	// check validates the rig options (rig.Manager.CheckAddRig).
+	check func(rig.AddRigOptions) error
+	// probe reaches the repository and returns its default branch
+	// (git.Git.RemoteHeadBranch).
+	probe func(gitURL string) (string, error)
+}
+
+func newRigAddWizard(in io.Reader, out io.Writer, check func(rig.AddRigOptions) error, probe func(string) (string, error)) *rigAddWizard {
+	return &rigAddWizard{in: bufio.NewReader(in), out: out, check: check, probe: probe}
+}
+
+// ask prompts for a value, returning def for an empty answer. It returns
+// errRigAddCancelled once input is exhausted.
+func (w *rigAddWizard) ask(label, def string) (string, error) {
+	if def != "" {
+		fmt.Fprintf(w.out, "%s [%s]: ", label, def)
+	} else {
+		fmt.Fprintf(w.out, "%s: ", label)
+	}
+	line, err := w.in.ReadString('\n')
+	if err != nil && line == "" {
+		fmt.Fprintln(w.out)
+		return "", errRigAddCancelled
+	}
+	if answer := strings.TrimSpace(line); answer != "" {
+		return answer, nil
+	}
+	return def, nil
+}
+
+// confirm asks a yes/no question.
+func (w *rigAddWizard) confirm(label string, def bool) (bool, error) {
+	hint := "y/N"
+	if def {
+		hint = "Y/n"
+	}
+	answer, err := w.ask(fmt.Sprintf("%s (%s)", label, hint), "")
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(answer) {
+	case "":
+		return def, nil
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
+
+// run fills in opts and boot, starting from the values given on the command
+// line, and asks for confirmation before anything is created.
+func (w *rigAddWizard) run(opts *rig.AddRigOptions, boot *bool) error {
+	var detected string
+	for {
+		url, err := w.ask("Repository URL", opts.GitURL)
+		if err != nil {
+			return err
+		}
+		if url == "" {
+			continue
+		}
+		fmt.Fprintf(w.out, "  Checking %s...\n", url)
+		detected, err = w.probe(url)
+		if err != nil {
+			fmt.Fprintf(w.out, "  %s Cannot reach repository: %v\n", style.Error.Render("✗"), err)
+			opts.GitURL = ""
+			continue
+		}
+		if opts.GitURL != url && opts.Name == rig.RigNameFromGitURL(opts.GitURL) {
+			opts.Name = "" // re-derive from the new URL
+		}
+		opts.GitURL = url
+		break
+	}
+
+	for {
+		def := opts.Name
+		if def == "" {
+			def = rig.RigNameFromGitURL(opts.GitURL)
+		}
+		name, err := w.ask("Rig name", def)
+		if err != nil {
+			return err
+		}
+		prefixDef := opts.BeadsPrefix
+		if prefixDef == "" {
+			prefixDef = rig.DeriveBeadsPrefix(name)
+		}
+		prefix, err := w.ask("Beads prefix", prefixDef)
+		if err != nil {
+			return err
+		}
+		candidate := *opts
+		candidate.Name, candidate.BeadsPrefix = name, prefix
+		if err := w.check(candidate); err != nil {
+			fmt.Fprintf(w.out, "  %s %v\n", style.Error.Render("✗"), err)
+			continue
+		}
+		*opts = candidate
+		break
+	}
+
+	branchDef := opts.DefaultBranch
+	if branchDef == "" {
+		branchDef = detected
+	}
+	branch, err := w.ask("Default branch", branchDef)
+	if err != nil {
+		return err
+	}
+	opts.DefaultBranch = branch
+
+	if *boot, err = w.confirm("Boot the rig (create and start the default crew)?", *boot); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w.out, "\nRig %s\n", style.Bold.Render(opts.Name))
+	fmt.Fprintf(w.out, "  Repository: %s\n", opts.GitURL)
+	fmt.Fprintf(w.out, "  Prefix:     %s-\n", opts.BeadsPrefix)
+	if opts.DefaultBranch != "" {
+		fmt.Fprintf(w.out, "  Branch:     %s\n", opts.DefaultBranch)
+	}
+	fmt.Fprintf(w.out, "  Boot:       %v\n\n", *boot)
+	ok, err := w.confirm("Create rig?", true)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errRigAddCancelled
+	}
+	return nil
+}
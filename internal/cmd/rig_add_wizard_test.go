@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestRigAddTarget(t *testing.T) {
+	tests := []struct {
+		args   []string
+		name   string
+		gitURL string
+	}{
+		{[]string{"gastown", "https://github.com/steveyegge/gastown"}, "gastown", "https://github.com/steveyegge/gastown"},
+		{[]string{"git@github.com:user/my-project.git"}, "my_project", "git@github.com:user/my-project.git"},
+		{[]string{"existing_rig"}, "existing_rig", ""},
+		{nil, "", ""},
+	}
+	for _, tt := range tests {
+		name, gitURL := rigAddTarget(tt.args)
+		if name != tt.name || gitURL != tt.gitURL {
+			t.Errorf("rigAddTarget(%q) = %q, %q; want %q, %q", tt.args, name, gitURL, tt.name, tt.gitURL)
+		}
+	}
+}
+
+func TestRigAddWizard(t *testing.T) {
+	probe := func(url string) (string, error) {
+		if strings.Contains(url, "typo") {
+			return "", fmt.Errorf("repository not found")
+		}
+		return "trunk", nil
+	}
+	check := func(opts rig.AddRigOptions) error {
+		if opts.Name == "taken" {
+			return rig.ErrRigExists
+		}
+		return nil
+	}
+
+	// A bad URL and a taken name are asked again; defaults are accepted
+	// with an empty answer.
+	input := strings.Join([]string{
+		"https://example.com/typo.git",
+		"https://example.com/my-app.git",
+		"taken", "",
+		"", "ma",
+		"",
+		"y",
+		"",
+	}, "\n") + "\n"
+	var out strings.Builder
+	w := newRigAddWizard(strings.NewReader(input), &out, check, probe)
+
+	var opts rig.AddRigOptions
+	boot := false
+	if err := w.run(&opts, &boot); err != nil {
+		t.Fatalf("run: %v\n%s", err, out.String())
+	}
+	want := rig.AddRigOptions{Name: "my_app", GitURL: "https://example.com/my-app.git", BeadsPrefix: "ma", DefaultBranch: "trunk"}
+	if opts != want {
+		t.Errorf("opts = %+v, want %+v", opts, want)
+	}
+	if !boot {
+		t.Error("boot not confirmed")
+	}
+	for _, msg := range []string{"Cannot reach repository", "rig already exists", "Rig name [my_app]", "Default branch [trunk]"} {
+		if !strings.Contains(out.String(), msg) {
+			t.Errorf("wizard output missing %q:\n%s", msg, out.String())
+		}
+	}
+
+	// Declining the summary, or running out of input, cancels the add.
+	for _, input := range []string{"https://example.com/app.git\n\n\n\n\nn\n", "https://example.com/app.git\n"} {
+		w := newRigAddWizard(strings.NewReader(input), io.Discard, check, probe)
+		opts := rig.AddRigOptions{}
+		if err := w.run(&opts, &boot); !errors.Is(err, errRigAddCancelled) {
+			t.Errorf("run(%q) = %v, want errRigAddCancelled", input, err)
+		}
+	}
+}
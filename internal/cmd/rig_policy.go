@@ -0,0 +1,411 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Rig policy flags (shared by gt rig policy set and gt rig add)
+var (
+	rigPolicyMethod      string
+	rigPolicyChecks      []string
+	rigPolicyApprovals   int
+	rigPolicyClearChecks bool
+	rigPolicyJSON        bool
+)
+
+var rigPolicyCmd = &cobra.Command{
+	Use:   "policy",
+	Short: "View and edit a rig's merge policy",
+	Long: `View and edit the merge policy the refinery enforces for a rig.
+
+A merge policy sets:
+  method              How branches land: squash, merge, or rebase (default)
+  required_checks     CI check names that must have passed
+  required_approvals  Minimum number of approving reviews
+
+The policy is stored under merge_queue.policy in the rig's
+settings/config.json and validated whenever it is written, including at
+'gt rig add' time. 'gt done' lands direct and local merges with the
+policy's method, and sends work to the merge queue instead when the policy
+requires checks or approvals. Whatever merges queued MRs runs
+'gt rig policy check' first; checks and approvals are read from the MR's
+pull request.
+
+Examples:
+  gt rig policy show gastown
+  gt rig policy set gastown --method squash --check test --check lint --approvals 1
+  gt rig policy check gastown gt-mr-abc123`,
+	RunE: requireSubcommand,
+}
+
+var rigPolicyShowCmd = &cobra.Command{
+	Use:   "show <rig>",
+	Short: "Show a rig's merge policy",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runRigPolicyShow,
+}
+
+var rigPolicySetCmd = &cobra.Command{
+	Use:   "set <rig>",
+	Short: "Update a rig's merge policy",
+	Long: `Update a rig's merge policy. Only the flags given are changed.
+
+Examples:
+  gt rig policy set gastown --method squash
+  gt rig policy set gastown --check test --check lint
+  gt rig policy set gastown --clear-checks --approvals 0`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRigPolicySet,
+}
+
+var rigPolicyCheckCmd = &cobra.Command{
+	Use:   "check <rig> <mr-id>",
+	Short: "Check whether a merge request satisfies the merge policy",
+	Long: `Check a merge request against the rig's merge policy.
+
+Prints the merge method to use and exits non-zero if a required check has
+not passed or the PR lacks the required approvals. MRs without a pull
+request pass only when the policy requires no checks or approvals.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runRigPolicyCheck,
+}
+
+func init() {
+	rigPolicySetCmd.Flags().StringVar(&rigPolicyMethod, "method", "", "Merge method: squash, merge, or rebase")
+	rigPolicySetCmd.Flags().StringArrayVar(&rigPolicyChecks, "check", nil, "Add a required CI check (repeatable)")
+	rigPolicySetCmd.Flags().IntVar(&rigPolicyApprovals, "approvals", -1, "Required approving reviews")
+	rigPolicySetCmd.Flags().BoolVar(&rigPolicyClearChecks, "clear-checks", false, "Remove all required checks before adding --check values")
+	rigPolicyShowCmd.Flags().BoolVar(&rigPolicyJSON, "json", false, "Output as JSON")
+	rigPolicyCheckCmd.Flags().BoolVar(&rigPolicyJSON, "json", false, "Output as JSON")
+
+	rigPolicyCmd.AddCommand(rigPolicyShowCmd)
+	rigPolicyCmd.AddCommand(rigPolicySetCmd)
+	rigPolicyCmd.AddCommand(rigPolicyCheckCmd)
+	rigCmd.AddCommand(rigPolicyCmd)
+}
+
+// loadRigMergePolicy returns the rig's merge policy, or an empty policy if none is set.
+func loadRigMergePolicy(rigPath string) (*config.MergePolicy, error) {
+	settings, err := config.LoadRigSettings(filepath.Join(rigPath, "settings", "config.json"))
+	if err != nil {
+		if errors.Is(err, config.ErrNotFound) {
+			return &config.MergePolicy{}, nil
+		}
+		return nil, fmt.Errorf("loading settings: %w", err)
+	}
+	if settings.MergeQueue == nil || settings.MergeQueue.Policy == nil {
+		return &config.MergePolicy{}, nil
+	}
+	return settings.MergeQueue.Policy, nil
+}
+
+// saveRigMergePolicy stores policy in the rig's settings, creating them if needed.
+// SaveRigSettings validates the policy before anything is written.
+func saveRigMergePolicy(rigPath string, policy *config.MergePolicy) error {
+	settingsPath := filepath.Join(rigPath, "settings", "config.json")
+	settings, err := config.LoadRigSettings(settingsPath)
+	if err != nil {
+		if !errors.Is(err, config.ErrNotFound) {
+			return fmt.Errorf("loading settings: %w", err)
+		}
+		settings = config.NewRigSettings()
+	}
+	if settings.MergeQueue == nil {
+		settings.MergeQueue = config.DefaultMergeQueueConfig()
+	}
+	settings.MergeQueue.Policy = policy
+	return config.SaveRigSettings(settingsPath, settings)
+}
+
+// rigAddMergePolicy builds and validates the merge policy given to gt rig add.
+// Returns nil if no policy flags were set.
+func rigAddMergePolicy(cmd *cobra.Command) (*config.MergePolicy, error) {
+	flags := cmd.Flags()
+	if !flags.Changed("merge-method") && !flags.Changed("required-check") && !flags.Changed("required-approvals") {
+		return nil, nil
+	}
+	policy := &config.MergePolicy{
+		Method:            rigPolicyMethod,
+		RequiredChecks:    rigPolicyChecks,
+		RequiredApprovals: rigPolicyApprovals,
+	}
+	if err := config.ValidateMergePolicy(policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func runRigPolicyShow(cmd *cobra.Command, args []string) error {
+	_, r, err := getRig(args[0])
+	if err != nil {
+		return err
+	}
+	policy, err := loadRigMergePolicy(r.Path)
+	if err != nil {
+		return err
+	}
+
+	if rigPolicyJSON {
+		return outputJSON(policy)
+	}
+
+	fmt.Printf("%s Merge policy for '%s':\n\n", style.Bold.Render("📜"), args[0])
+	fmt.Printf("  Method:     %s\n", policy.EffectiveMethod())
+	if len(policy.RequiredChecks) > 0 {
+		fmt.Printf("  Checks:     %s\n", strings.Join(policy.RequiredChecks, ", "))
+	} else {
+		fmt.Printf("  Checks:     %s\n", style.Dim.Render("(none)"))
+	}
+	fmt.Printf("  Approvals:  %d\n", policy.RequiredApprovals)
+	return nil
+}
+
+func runRigPolicySet(cmd *cobra.Command, args []string) error {
+	_, r, err := getRig(args[0])
+	if err != nil {
+		return err
+	}
+	policy, err := loadRigMergePolicy(r.Path)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("method") {
+		policy.Method = rigPolicyMethod
+	}
+	if rigPolicyClearChecks {
+		policy.RequiredChecks = nil
+	}
+	for _, check := range rigPolicyChecks {
+		if !containsString(policy.RequiredChecks, check) {
+			policy.RequiredChecks = append(policy.RequiredChecks, check)
+		}
+	}
+	if cmd.Flags().Changed("approvals") {
+		policy.RequiredApprovals = rigPolicyApprovals
+	}
+
+	if err := saveRigMergePolicy(r.Path, policy); err != nil {
+		return err
+	}
+	fmt.Printf("%s Updated merge policy for rig %s\n", style.Success.Render("✓"), args[0])
+	return nil
+}
+
+// policyCheckResult is the JSON output of gt rig policy check.
+type policyCheckResult struct {
+	MR     string   `json:"mr"`
+	PRUrl  string   `json:"pr_url,omitempty"`
+	Method string   `json:"method"`
+	OK     bool     `json:"ok"`
+	Unmet  []string `json:"unmet,omitempty"`
+}
+
+func runRigPolicyCheck(cmd *cobra.Command, args []string) error {
+	_, r, err := getRig(args[0])
+	if err != nil {
+		return err
+	}
+	policy, err := loadRigMergePolicy(r.Path)
+	if err != nil {
+		return err
+	}
+
+	mrID := args[1]
+	mr, err := beads.New(r.BeadsPath()).Show(mrID)
+	if err != nil {
+		if err == beads.ErrNotFound {
+			return fmt.Errorf("merge request '%s' not found", mrID)
+		}
+		return fmt.Errorf("fetching merge request: %w", err)
+	}
+
+	result := policyCheckResult{MR: mrID, Method: policy.EffectiveMethod()}
+	if fields := beads.ParseMRFields(mr); fields != nil {
+		result.PRUrl = fields.PRUrl
+	}
+
+	result.Unmet, err = unmetMergePolicy(policy, result.PRUrl)
+	if err != nil {
+		return err
+	}
+	result.OK = len(result.Unmet) == 0
+
+	if rigPolicyJSON {
+		if err := outputJSON(result); err != nil {
+			return err
+		}
+	} else if result.OK {
+		fmt.Printf("%s %s satisfies the merge policy (method: %s)\n", style.Success.Render("✓"), mrID, result.Method)
+	} else {
+		fmt.Printf("%s %s does not satisfy the merge policy:\n", style.Error.Render("✗"), mrID)
+		for _, reason := range result.Unmet {
+			fmt.Printf("  - %s\n", reason)
+		}
+	}
+
+	if !result.OK {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// unmetMergePolicy returns the policy requirements a change with the given
+// pull request (empty if it has none) does not meet.
+func unmetMergePolicy(policy *config.MergePolicy, prURL string) ([]string, error) {
+	if !policy.RequiresPR() {
+		return nil, nil
+	}
+	if prURL == "" {
+		return []string{"policy requires checks or approvals but there is no pull request"}, nil
+	}
+	status, err := fetchPRPolicyStatus(prURL)
+	if err != nil {
+		return nil, err
+	}
+	return evaluateMergePolicy(policy, status), nil
+}
+
+// landBranch lands branch on target, which must be checked out and up to
+// date, using the merge policy's method:
+//   - rebase replays branch onto target and fast-forwards target
+//   - squash lands branch as one commit with its head commit's message
+//   - merge creates a merge commit
+//
+// Target is left checked out.
+func landBranch(g *git.Git, method, branch, target string) error {
+	switch method {
+	case config.MergeMethodSquash:
+		msg, err := g.GetBranchCommitMessage(branch)
+		if err != nil {
+			return fmt.Errorf("reading %s commit message: %w", branch, err)
+		}
+		if err := g.MergeSquash(branch, msg); err != nil {
+			_ = g.AbortMerge() // best-effort: leave target clean
+			return fmt.Errorf("squash %s into %s: %w", branch, target, err)
+		}
+	case config.MergeMethodMerge:
+		if err := g.MergeNoFF(branch, fmt.Sprintf("Merge branch '%s'", branch)); err != nil {
+			_ = g.AbortMerge() // best-effort: leave target clean
+			return fmt.Errorf("merge %s into %s: %w", branch, target, err)
+		}
+	default:
+		if err := g.Checkout(branch); err != nil {
+			return fmt.Errorf("checkout %s: %w", branch, err)
+		}
+		if err := g.Rebase(target); err != nil {
+			_ = g.AbortRebase() // best-effort: leave branch as pushed
+			_ = g.Checkout(target)
+			return fmt.Errorf("rebase %s onto %s: %w", branch, target, err)
+		}
+		if err := g.Checkout(target); err != nil {
+			return fmt.Errorf("checkout %s: %w", target, err)
+		}
+		if err := g.MergeFFOnly(branch); err != nil {
+			return fmt.Errorf("fast-forward %s to %s: %w", target, branch, err)
+		}
+	}
+	return nil
+}
+
+// prPolicyStatus is the forge state a merge policy is evaluated against.
+type prPolicyStatus struct {
+	Checks    map[string]string // check name -> "pass", "fail", or "pending"
+	Approvals int               // distinct reviewers whose latest review approves
+}
+
+// fetchPRPolicyStatus reads check results and reviews for a PR with the gh CLI.
+func fetchPRPolicyStatus(prURL string) (*prPolicyStatus, error) {
+	out, err := exec.Command("gh", "pr", "view", prURL, "--json", "statusCheckRollup,reviews").Output()
+	if err != nil {
+		return nil, fmt.Errorf("fetching PR status for %s: %w", prURL, err)
+	}
+	return parsePRPolicyStatus(out)
+}
+
+// parsePRPolicyStatus parses `gh pr view --json statusCheckRollup,reviews` output.
+// Check runs report name/status/conclusion; commit statuses report context/state.
+func parsePRPolicyStatus(data []byte) (*prPolicyStatus, error) {
+	var raw struct {
+		StatusCheckRollup []struct {
+			Name       string `json:"name"`
+			Context    string `json:"context"`
+			Status     string `json:"status"`
+			Conclusion string `json:"conclusion"`
+			State      string `json:"state"`
+		} `json:"statusCheckRollup"`
+		Reviews []struct {
+			Author struct {
+				Login string `json:"login"`
+			} `json:"author"`
+			State string `json:"state"`
+		} `json:"reviews"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parsing PR status: %w", err)
+	}
+
+	status := &prPolicyStatus{Checks: make(map[string]string)}
+	for _, c := range raw.StatusCheckRollup {
+		name := c.Name
+		if name == "" {
+			name = c.Context
+		}
+		result := strings.ToUpper(c.Conclusion)
+		if result == "" {
+			result = strings.ToUpper(c.State)
+		}
+		switch result {
+		case "SUCCESS", "NEUTRAL", "SKIPPED":
+			status.Checks[name] = "pass"
+		case "FAILURE", "ERROR", "CANCELLED", "TIMED_OUT", "ACTION_REQUIRED":
+			status.Checks[name] = "fail"
+		default:
+			status.Checks[name] = "pending"
+		}
+	}
+
+	// Reviews are chronological; a reviewer's latest decisive review counts
+	latest := make(map[string]string)
+	for _, rv := range raw.Reviews {
+		switch rv.State {
+		case "APPROVED", "CHANGES_REQUESTED", "DISMISSED":
+			latest[rv.Author.Login] = rv.State
+		}
+	}
+	for _, state := range latest {
+		if state == "APPROVED" {
+			status.Approvals++
+		}
+	}
+	return status, nil
+}
+
+// evaluateMergePolicy returns the policy requirements the PR does not meet.
+func evaluateMergePolicy(policy *config.MergePolicy, status *prPolicyStatus) []string {
+	var unmet []string
+	for _, check := range policy.RequiredChecks {
+		switch status.Checks[check] {
+		case "pass":
+		case "":
+			unmet = append(unmet, fmt.Sprintf("required check %q has not reported", check))
+		default:
+			unmet = append(unmet, fmt.Sprintf("required check %q is %s", check, status.Checks[check]))
+		}
+	}
+	if status.Approvals < policy.RequiredApprovals {
+		unmet = append(unmet, fmt.Sprintf("%d of %d required approvals", status.Approvals, policy.RequiredApprovals))
+	}
+	return unmet
+}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+func TestParsePRPolicyStatus(t *testing.T) {
+	data := []byte(`{
+		"statusCheckRollup": [
+			{"name": "test", "status": "COMPLETED", "conclusion": "SUCCESS"},
+			{"name": "lint", "status": "COMPLETED", "conclusion": "FAILURE"},
+			{"name": "e2e", "status": "IN_PROGRESS", "conclusion": ""},
+			{"context": "ci/legacy", "state": "SUCCESS"}
+		],
+		"reviews": [
+			{"author": {"login": "alice"}, "state": "APPROVED"},
+			{"author": {"login": "bob"}, "state": "APPROVED"},
+			{"author": {"login": "bob"}, "state": "CHANGES_REQUESTED"},
+			{"author": {"login": "carol"}, "state": "COMMENTED"}
+		]
+	}`)
+
+	status, err := parsePRPolicyStatus(data)
+	if err != nil {
+		t.Fatalf("parsePRPolicyStatus: %v", err)
+	}
+
+	want := map[string]string{"test": "pass", "lint": "fail", "e2e": "pending", "ci/legacy": "pass"}
+	for name, result := range want {
+		if status.Checks[name] != result {
+			t.Errorf("check %s = %q, want %q", name, status.Checks[name], result)
+		}
+	}
+	// bob's later review supersedes the approval; carol only commented
+	if status.Approvals != 1 {
+		t.Errorf("Approvals = %d, want 1", status.Approvals)
+	}
+}
+
+func TestEvaluateMergePolicy(t *testing.T) {
+	status := &prPolicyStatus{
+		Checks:    map[string]string{"test": "pass", "lint": "fail"},
+		Approvals: 1,
+	}
+
+	if unmet := evaluateMergePolicy(&config.MergePolicy{RequiredChecks: []string{"test"}, RequiredApprovals: 1}, status); len(unmet) != 0 {
+		t.Errorf("satisfied policy reported unmet: %v", unmet)
+	}
+
+	unmet := evaluateMergePolicy(&config.MergePolicy{
+		RequiredChecks:    []string{"test", "lint", "security"},
+		RequiredApprovals: 2,
+	}, status)
+	if len(unmet) != 3 {
+		t.Fatalf("unmet = %v, want 3 entries", unmet)
+	}
+	joined := strings.Join(unmet, "\n")
+	for _, want := range []string{`"lint" is fail`, `"security" has not reported`, "1 of 2 required approvals"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("unmet missing %q: %v", want, unmet)
+		}
+	}
+}
+
+func TestUnmetMergePolicyWithoutPR(t *testing.T) {
+	unmet, err := unmetMergePolicy(&config.MergePolicy{Method: config.MergeMethodSquash}, "")
+	if err != nil || len(unmet) != 0 {
+		t.Errorf("method-only policy: unmet = %v, err = %v", unmet, err)
+	}
+	unmet, err = unmetMergePolicy(&config.MergePolicy{RequiredApprovals: 1}, "")
+	if err != nil || len(unmet) != 1 {
+		t.Errorf("approvals without a PR: unmet = %v, err = %v, want one entry", unmet, err)
+	}
+}
+
+func TestLandBranch(t *testing.T) {
+	tests := []struct {
+		method      string
+		wantCommits string // main's first-parent history, newest first
+		wantParents int    // parents of main's head commit
+	}{
+		{config.MergeMethodRebase, "feat: two\nfeat: one\nmain work\ninit", 1},
+		{config.MergeMethodSquash, "feat: two\nmain work\ninit", 1},
+		{config.MergeMethodMerge, "Merge branch 'feature'\nmain work\ninit", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			dir := t.TempDir()
+			commit := func(file, msg string) {
+				if err := os.WriteFile(filepath.Join(dir, file), []byte(msg), 0644); err != nil {
+					t.Fatal(err)
+				}
+				execGitCmd(t, dir, "add", ".")
+				execGitCmd(t, dir, "commit", "-m", msg)
+			}
+			execGitCmd(t, dir, "init", "-b", "main")
+			execGitCmd(t, dir, "config", "user.email", "test@test.com")
+			execGitCmd(t, dir, "config", "user.name", "test")
+			commit("README", "init")
+			execGitCmd(t, dir, "checkout", "-b", "feature")
+			commit("one.txt", "feat: one")
+			commit("two.txt", "feat: two")
+			execGitCmd(t, dir, "checkout", "main")
+			commit("main.txt", "main work")
+
+			g := git.NewGit(dir)
+			if err := landBranch(g, tt.method, "feature", "main"); err != nil {
+				t.Fatalf("landBranch: %v", err)
+			}
+
+			if branch, _ := g.CurrentBranch(); branch != "main" {
+				t.Errorf("checked out %q after landing, want main", branch)
+			}
+			out, err := exec.Command("git", "-C", dir, "log", "--first-parent", "--format=%s").Output()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.TrimSpace(string(out)); got != tt.wantCommits {
+				t.Errorf("main history:\n%s\nwant:\n%s", got, tt.wantCommits)
+			}
+			out, err = exec.Command("git", "-C", dir, "log", "-1", "--format=%p").Output()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := len(strings.Fields(string(out))); got != tt.wantParents {
+				t.Errorf("head commit has %d parents, want %d", got, tt.wantParents)
+			}
+			for _, f := range []string{"one.txt", "two.txt", "main.txt"} {
+				if _, err := os.Stat(filepath.Join(dir, f)); err != nil {
+					t.Errorf("%s missing after landing: %v", f, err)
+				}
+			}
+		})
+	}
+}
@@ -316,7 +316,7 @@ func setNestedValue(obj interface{}, keyPath string, value interface{}) error {
 				validKeys := []string{
 					"type", "version",
 					"merge_queue", "theme", "namepool", "crew", "workflow",
-					"runtime", "agent", "agents", "role_agents",
+					"runtime", "agent", "agents", "role_agents", "role_agent_fallbacks",
 				}
 				return fmt.Errorf("unknown key %q (valid top-level keys: %s)", keyPath, strings.Join(validKeys, ", "))
 			}
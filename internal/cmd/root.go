@@ -2,14 +2,17 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tracing"
 	"github.com/steveyegge/gastown/internal/ui"
 	"github.com/steveyegge/gastown/internal/version"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -55,6 +58,8 @@ var beadsExemptCommands = map[string]bool{
 	"dnd":        true,
 	"krc":        true, // KRC doesn't require beads
 	"connect":    true,
+	"pods":       true,
+	"deploy":     true, // gt town deploy targets a cluster, not local beads
 	"preflight":  true,
 	"postflight": true,
 }
@@ -83,6 +88,9 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 		os.Exit(1)
 	}
 
+	// GT_OUTPUT=json defaults --json on for commands that support it
+	applyOutputEnv(cmd)
+
 	// Initialize CLI theme (dark/light mode support)
 	initCLITheme()
 
@@ -220,6 +228,18 @@ func checkStaleBinaryWarning() {
 // Execute runs the root command and returns an exit code.
 // The caller (main) should call os.Exit with this code.
 func Execute() int {
+	// Export OpenTelemetry spans when OTEL_EXPORTER_OTLP_ENDPOINT is set.
+	// Flush before exiting so short-lived commands like gt sling deliver them.
+	shutdownTracing, err := tracing.Init(context.Background(), "gt")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s tracing disabled: %v\n", style.WarningPrefix, err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = shutdownTracing(ctx)
+	}()
+
 	if err := rootCmd.Execute(); err != nil {
 		// Check for silent exit (scripting commands that signal status via exit code)
 		if code, ok := IsSilentExit(err); ok {
@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/rpcserver"
@@ -25,7 +26,11 @@ via Connect-RPC protocol for mobile apps and other clients.
 Examples:
   gt rpc serve                    # Start RPC server on default port
   gt rpc serve --port 9443        # Start on custom port
-  gt rpc serve --api-key secret   # Enable API key authentication`,
+  gt rpc serve --api-key secret   # Enable API key authentication
+  gt rpc serve --tls-dir /etc/gt/tls --mtls   # Mutual TLS from a mounted secret
+
+Role-scoped keys are managed with 'gt apikey'; certificates for local
+clusters can be issued with 'gt cert issue'.`,
 	RunE: requireSubcommand,
 }
 
@@ -44,8 +49,47 @@ Endpoints:
   /gastown.v1.MailService/*       Mail API
   /gastown.v1.DecisionService/*   Decision API
   /events/decisions               SSE stream for decisions
-  /health                         Health check
-  /metrics                        Server metrics`,
+  /health                         Health check (all components)
+  /health/live                    Liveness probe (process serving)
+  /health/ready                   Readiness probe (bd daemon, beads, routing, terminal, NATS)
+  /metrics                        Prometheus metrics (?format=json for event bus stats)
+
+With --client-ca, or --mtls and a ca.crt in --tls-dir, every client must
+present a certificate signed by that CA. Certificates issued to agents carry
+their address as a gt://agent/<address> SAN, and a request claiming to be
+from any other agent is rejected, whether by X-GT-From or by an actor field
+in the request (actor, author, requestedBy, ackedBy, resolvedBy). Agents
+must call with the JSON codec.
+
+Every call that changes state is appended to mayor/audit.jsonl with the
+caller's key name, sender, arguments, result, and latency; admins can query
+it with AuditService.ListEntries. The log is rotated into mayor/audit-archive
+at 16 MiB. --audit-beads also files each entry as a closed event bead.
+
+--rate-limit caps each client (API key, or IP without one) to a steady
+request rate with bursts of --rate-burst; calls over the limit get
+ResourceExhausted (HTTP 429) with Retry-After. Per-key and per-method limits
+go in mayor/ratelimits.json:
+
+  {"default": {"rate": 20, "burst": 40},
+   "keys":    {"grafana": {"rate": 2, "burst": 5}},
+   "methods": {"StatusService/GetTownStatus": {"rate": 1, "burst": 3}}}
+
+Counts of limited calls are reported under rate_limit in /metrics?format=json.
+
+Fast GetTownStatus calls are answered from a town state kept in memory:
+sessions, agent states and hooks, updated from the event log as agents are
+slung, spawned and killed, and rebuilt every --status-reconcile to catch
+anything no event reported. SearchIssues is served from an in-memory index
+of every issue's ID, title and description, updated as issues are written
+through the server and rebuilt every --search-reindex.
+
+On SIGTERM the server shuts down gracefully: /health/ready reports
+"draining" (503) and, after --drain-delay, new requests are refused while
+in-flight calls and streams get --shutdown-timeout to finish before being
+cancelled.
+Audit entries still mirroring to beads are flushed before exit. Under a
+Type=notify systemd unit, readiness and stopping are reported via sd_notify.`,
 	RunE: runRPCServe,
 }
 
@@ -55,6 +99,19 @@ var (
 	rpcAPIKey   string
 	rpcCertFile string
 	rpcKeyFile  string
+	rpcClientCA string
+	rpcTLSDir   string
+	rpcMTLS     bool
+
+	rpcAuditBeads bool
+	rpcRateLimit  float64
+	rpcRateBurst  int
+
+	rpcShutdownTimeout time.Duration
+	rpcDrainDelay      time.Duration
+
+	rpcStatusReconcile time.Duration
+	rpcSearchReindex   time.Duration
 )
 
 func init() {
@@ -63,9 +120,19 @@ func init() {
 
 	rpcServeCmd.Flags().IntVar(&rpcPort, "port", 8443, "Server port")
 	rpcServeCmd.Flags().StringVar(&rpcTownRoot, "town", "", "Town root directory (auto-detected if not set)")
-	rpcServeCmd.Flags().StringVar(&rpcAPIKey, "api-key", "", "API key for authentication (optional)")
+	rpcServeCmd.Flags().StringVar(&rpcAPIKey, "api-key", "", "Single admin API key (optional; see gt apikey for role-scoped keys)")
 	rpcServeCmd.Flags().StringVar(&rpcCertFile, "cert", "", "TLS certificate file (optional)")
 	rpcServeCmd.Flags().StringVar(&rpcKeyFile, "key", "", "TLS key file (optional)")
+	rpcServeCmd.Flags().StringVar(&rpcClientCA, "client-ca", "", "CA for verifying client certificates; enables mutual TLS (optional)")
+	rpcServeCmd.Flags().Float64Var(&rpcRateLimit, "rate-limit", 0, "Requests per second allowed per client (0 = use mayor/ratelimits.json)")
+	rpcServeCmd.Flags().IntVar(&rpcRateBurst, "rate-burst", 0, "Burst size for --rate-limit (default: one second's worth)")
+	rpcServeCmd.Flags().BoolVar(&rpcAuditBeads, "audit-beads", false, "Also record audit log entries as event beads")
+	rpcServeCmd.Flags().StringVar(&rpcTLSDir, "tls-dir", "", "Directory with tls.crt, tls.key, and ca.crt, e.g. a mounted K8s secret (optional)")
+	rpcServeCmd.Flags().BoolVar(&rpcMTLS, "mtls", false, "Require client certificates signed by --client-ca or the ca.crt in --tls-dir")
+	rpcServeCmd.Flags().DurationVar(&rpcShutdownTimeout, "shutdown-timeout", rpcserver.DefaultShutdownTimeout, "How long in-flight requests and streams get to finish on SIGTERM")
+	rpcServeCmd.Flags().DurationVar(&rpcDrainDelay, "drain-delay", 0, "Keep serving this long after SIGTERM while /health reports draining (e.g. 5s behind a K8s Service)")
+	rpcServeCmd.Flags().DurationVar(&rpcStatusReconcile, "status-reconcile", rpcserver.DefaultReconcileInterval, "How often the in-memory town state behind fast status calls is rebuilt")
+	rpcServeCmd.Flags().DurationVar(&rpcSearchReindex, "search-reindex", rpcserver.DefaultSearchReindex, "How often the in-memory issue search index is rebuilt from bd")
 }
 
 func runRPCServe(cmd *cobra.Command, args []string) error {
@@ -104,6 +171,17 @@ func runRPCServe(cmd *cobra.Command, args []string) error {
 		APIKey:   rpcAPIKey,
 		CertFile: rpcCertFile,
 		KeyFile:  rpcKeyFile,
+
+		ClientCAFile:       rpcClientCA,
+		TLSDir:             rpcTLSDir,
+		RequireClientCerts: rpcMTLS || rpcClientCA != "",
+		AuditBeads:         rpcAuditBeads,
+		RateLimit:          rpcserver.RateLimit{Rate: rpcRateLimit, Burst: rpcRateBurst},
+
+		ShutdownTimeout: rpcShutdownTimeout,
+		DrainDelay:      rpcDrainDelay,
+		StatusReconcile: rpcStatusReconcile,
+		SearchReindex:   rpcSearchReindex,
 	}
 
 	if err := rpcserver.RunServer(cfg); err != nil {
@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/notify"
+	"github.com/steveyegge/gastown/internal/rpcclient"
+	"github.com/steveyegge/gastown/internal/slackbot"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var slackCmd = &cobra.Command{
+	Use:     "slack",
+	GroupID: GroupServices,
+	Short:   "Slack integration for decisions and operations",
+	Long: `Slack integration for resolving decisions.
+
+Decision messages posted to Slack carry one button per option. Clicking a
+button opens a confirmation dialog that captures an optional rationale;
+confirming resolves the decision through the RPC server and updates the
+message in place.
+
+The /gt slash command runs routine operations from Slack:
+  /gt status                  Town overview
+  /gt peek <agent> [lines]    Recent output from an agent's session
+  /gt sling <bead> <target>   Assign a bead to an agent or rig
+
+Notifications are routed to channels by rig and severity, with optional
+quiet hours, as configured in settings/slack.json. The file is re-read
+when it changes.
+
+Examples:
+  gt slack serve                          # Serve interactions on :3000
+  gt slack serve --rpc-url http://hq:8443 # Resolve via a remote RPC server
+  gt slack route gastown high             # Show where a gastown high escalation goes`,
+	RunE: requireSubcommand,
+}
+
+var slackServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve Slack interactive message callbacks",
+	Long: `Serve the Slack app's interactivity Request URL.
+
+Point the app's Interactivity & Shortcuts "Request URL" at
+  https://<host>/slack/interactions
+and the /gt slash command's Request URL at
+  https://<host>/slack/commands
+
+Pending decisions are posted as they are created, one message per
+decision with a button per option, to the channel settings/slack.json
+routes the requesting agent's rig and the decision's urgency to.
+Decisions routed into quiet hours are posted when the quiet hours end.
+
+With --convoy-channel, each open convoy gets one message in that channel,
+kept up to date with its progress; issue closes, merges and stalls are
+replied in the message's thread. Landed convoys are also passed to the
+overseer's notification preferences (gt config notifications).
+
+Credentials are read from flags or the environment:
+  SLACK_BOT_TOKEN       Bot token (xoxb-...), used to open dialogs and update messages
+  SLACK_SIGNING_SECRET  Signing secret, used to verify requests come from Slack
+  GT_RPC_API_KEY        API key for the gt RPC server, if it requires one`,
+	RunE: runSlackServe,
+}
+
+var slackRouteCmd = &cobra.Command{
+	Use:   "route <rig|agent> <severity>",
+	Short: "Show which channel a notification would be routed to",
+	Long: `Show which Slack channel a notification would be routed to.
+
+The first argument is a rig name or an agent address (gastown/polecats/nux);
+town-level agents route as rig "town". Severity is low, medium, high or
+critical. Quiet hours are evaluated at the current time.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runSlackRoute,
+}
+
+var (
+	slackPort           int
+	slackRPCURL         string
+	slackRPCAPIKey      string
+	slackBotToken       string
+	slackSigningSecret  string
+	slackConvoyChannel  string
+	slackConvoyInterval time.Duration
+	slackDecisionPoll   time.Duration
+)
+
+func init() {
+	rootCmd.AddCommand(slackCmd)
+	slackCmd.AddCommand(slackServeCmd)
+	slackCmd.AddCommand(slackRouteCmd)
+
+	slackServeCmd.Flags().IntVar(&slackPort, "port", 3000, "Port to listen on")
+	slackServeCmd.Flags().StringVar(&slackRPCURL, "rpc-url", "http://localhost:8443", "gt RPC server URL")
+	slackServeCmd.Flags().StringVar(&slackRPCAPIKey, "rpc-api-key", "", "gt RPC server API key (default $GT_RPC_API_KEY)")
+	slackServeCmd.Flags().StringVar(&slackBotToken, "bot-token", "", "Slack bot token (default $SLACK_BOT_TOKEN)")
+	slackServeCmd.Flags().StringVar(&slackSigningSecret, "signing-secret", "", "Slack signing secret (default $SLACK_SIGNING_SECRET)")
+	slackServeCmd.Flags().StringVar(&slackConvoyChannel, "convoy-channel", "", "Post convoy progress threads to this channel")
+	slackServeCmd.Flags().DurationVar(&slackConvoyInterval, "convoy-interval", 30*time.Second, "How often to poll convoy progress")
+	slackServeCmd.Flags().DurationVar(&slackDecisionPoll, "decision-interval", 5*time.Second, "How often to poll for new decisions to post")
+}
+
+func runSlackServe(cmd *cobra.Command, args []string) error {
+	botToken := flagOrEnv(slackBotToken, "SLACK_BOT_TOKEN")
+	signingSecret := flagOrEnv(slackSigningSecret, "SLACK_SIGNING_SECRET")
+	if botToken == "" {
+		return fmt.Errorf("slack bot token required (--bot-token or SLACK_BOT_TOKEN)")
+	}
+	if signingSecret == "" {
+		return fmt.Errorf("slack signing secret required (--signing-secret or SLACK_SIGNING_SECRET)")
+	}
+
+	var opts []rpcclient.Option
+	if key := flagOrEnv(slackRPCAPIKey, "GT_RPC_API_KEY"); key != "" {
+		opts = append(opts, rpcclient.WithAPIKey(key))
+	}
+	client := rpcclient.NewClient(slackRPCURL, opts...)
+	api := slackbot.NewAPI(botToken)
+
+	mux := http.NewServeMux()
+	mux.Handle("/slack/interactions", slackbot.NewHandler(client, api, signingSecret))
+	mux.Handle("/slack/commands", slackbot.NewCommandHandler(client, signingSecret))
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	townRoot, _ := workspace.FindFromCwd()
+	if townRoot != "" {
+		router, err := slackbot.NewRouter(config.SlackConfigPath(townRoot))
+		if err != nil {
+			return err
+		}
+		poster := slackbot.NewDecisionPoster(client, api, router)
+		poster.StatePath = filepath.Join(townRoot, ".runtime", "slack-decisions.json")
+		go func() {
+			if err := poster.Run(context.Background(), slackDecisionPoll); err != nil {
+				style.PrintWarning("decision poster stopped: %v", err)
+			}
+		}()
+		fmt.Printf("%s Posting decisions as routed by %s\n", style.Success.Render("✓"), config.SlackConfigPath(townRoot))
+	} else {
+		style.PrintWarning("not in a Gas Town workspace; decisions will not be posted")
+	}
+
+	if slackConvoyChannel != "" {
+		tracker := slackbot.NewConvoyTracker(client, api, slackConvoyChannel)
+		if townRoot != "" {
+			tracker.StatePath = filepath.Join(townRoot, ".runtime", "slack-convoys.json")
+			tracker.Notifier = notify.NewDispatcher(townRoot)
+		}
+		go func() {
+			if err := tracker.Run(context.Background(), slackConvoyInterval); err != nil {
+				style.PrintWarning("convoy tracker stopped: %v", err)
+			}
+		}()
+		fmt.Printf("%s Tracking convoys in %s\n", style.Success.Render("✓"), slackConvoyChannel)
+	}
+
+	addr := fmt.Sprintf(":%d", slackPort)
+	fmt.Printf("%s Serving Slack interactions and commands on %s/slack/ (RPC: %s)\n",
+		style.Success.Render("✓"), addr, slackRPCURL)
+
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server.ListenAndServe()
+}
+
+func runSlackRoute(cmd *cobra.Command, args []string) error {
+	severity := strings.ToLower(args[1])
+	if !config.IsValidSeverity(severity) {
+		return fmt.Errorf("invalid severity '%s': must be critical, high, medium, or low", args[1])
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	router, err := slackbot.NewRouter(config.SlackConfigPath(townRoot))
+	if err != nil {
+		return err
+	}
+
+	rig := args[0]
+	if strings.Contains(rig, "/") || rig == "mayor" || rig == "deacon" {
+		rig = slackbot.RigOf(rig)
+	}
+	route := router.Route(rig, severity, time.Now())
+	if !router.Config().Enabled {
+		fmt.Printf("Slack notifications are disabled in %s\n", config.SlackConfigPath(townRoot))
+		return nil
+	}
+	fmt.Printf("%s %s → %s\n", rig, severity, route.Describe())
+	return nil
+}
+
+// flagOrEnv returns the flag value, falling back to the environment variable.
+func flagOrEnv(flag, env string) string {
+	if flag != "" {
+		return flag
+	}
+	return os.Getenv(env)
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -13,12 +14,15 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/crew"
-	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/eventbus"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/sling"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tracing"
 	"github.com/steveyegge/gastown/internal/workspace"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var slingCmd = &cobra.Command{
@@ -56,6 +60,16 @@ Target Resolution:
   gt sling gt-abc deacon/dogs           # Auto-dispatch to idle dog
   gt sling gt-abc deacon/dogs/alpha     # Specific dog
 
+Auto Routing:
+  gt sling gt-abc --auto                # Best target for the bead's labels
+
+  --auto scores every rig (a fresh polecat) and every crew member with
+  capability tags by how well they match the bead's lang:, repo: and role:
+  labels, how loaded they are, and how often their past slings of beads
+  with the same labels ended in gt done. Beads are kept to their own repo
+  unless labelled repo:<rig>. Set tags with 'gt agents caps' (crew) or
+  'gt rig config set <rig> capabilities lang:go,role:review' (polecats).
+
 Spawning Options (when target is a rig):
   gt sling gp-abc greenplace --create               # Create polecat if missing
   gt sling gp-abc greenplace --force                # Ignore unread mail
@@ -77,6 +91,22 @@ Formula-on-Bead (--on flag):
   gt sling mol-review --on gt-abc       # Apply formula to existing work
   gt sling shiny --on gt-abc crew       # Apply formula, sling to crew
 
+Dry Run:
+  gt sling gt-abc gastown --dry-run   # Print the dispatch plan, change nothing
+
+  The plan shows the resolved target (and whether a polecat would be spawned
+  or reused), the formula steps, the bead fields that would be written
+  (dispatched_by, args, no_merge, merge_strategy, ...) and the mail and
+  nudges that would be sent.
+
+Scheduling:
+  gt sling gt-abc gastown --at 02:00        # Dispatch at 2am
+  gt sling gt-abc gastown --at +90m         # Dispatch in 90 minutes
+  gt sling gt-def gastown --after gt-abc    # Dispatch once gt-abc is closed
+
+  Scheduled slings wait in the dispatch queue until the daemon runs them.
+  See 'gt queue list' and 'gt queue cancel'.
+
 Compare:
   gt hook <bead>      # Just attach (no action)
   gt sling <bead>     # Attach + start now (keep context)
@@ -90,6 +120,32 @@ Batch Slinging:
   When multiple beads are provided with a rig target, each bead gets its own
   polecat. This parallelizes work dispatch without running gt sling N times.
 
+  gt sling --batch gt-abc gt-def gt-ghi gastown              # Track in a new convoy
+  gt sling gt-abc gt-def gastown --strategy round-robin      # Reuse existing polecats
+  gt sling --batch gt-abc gt-def gt-ghi gastown --strategy load
+
+  --batch creates a convoy tracking every bead that was dispatched. --strategy
+  chooses how beads are distributed:
+    spawn        A fresh polecat per bead (default)
+    round-robin  Cycle through the rig's working polecats
+    load         The working polecat with the fewest hooked beads first
+  If the rig has no working polecats, beads fall back to fresh polecats.
+  Failed beads don't stop the batch; they are listed in the dispatch summary
+  and the command exits non-zero.
+
+Rig Limits:
+  Spawning a polecat is refused when the rig is at a limit set with
+  'gt rig config set <rig> <key> <n>' (0 = unlimited):
+    max_polecats         Concurrent polecats (default 10)
+    max_slings_per_hour  Polecats spawned in any 60 minutes
+    daily_token_budget   Tokens the rig's agents may use per day
+
+  To go past a limit, get a decision approved whose first option allows it,
+  then pass it within an hour of approval:
+  gt decision request --prompt "Let gastown exceed max_polecats?" \
+    --option "Allow: <why>" --option "Keep the limit"
+  gt sling gt-abc gastown --override-limits hq-dec-xyz
+
 Ownership and Merge Strategy:
   gt sling gt-abc gastown --owned         # Caller-managed convoy (use gt convoy land)
   gt sling gt-abc gastown --merge=direct  # Push directly to main (no MR)
@@ -118,12 +174,16 @@ var (
 	slingMergeStrategy  string // --merge: merge strategy (direct/mr/local)
 	slingOwned          bool   // --owned: caller-owned convoy (no witness/refinery)
 	slingExecutionTarget string // --target: execution target (local/k8s)
+	slingBatch           bool   // --batch: track batch-slung beads in a new convoy
+	slingStrategy        string // --strategy: batch distribution (spawn/round-robin/load)
+	slingOverrideLimits  string // --override-limits: decision bead approving a spawn past rig limits
+	slingAuto            bool   // --auto: pick the target by capability, load and success rate
 )
 
 func init() {
 	slingCmd.Flags().StringVarP(&slingSubject, "subject", "s", "", "Context subject for the work")
 	slingCmd.Flags().StringVarP(&slingMessage, "message", "m", "", "Context message for the work")
-	slingCmd.Flags().BoolVarP(&slingDryRun, "dry-run", "n", false, "Show what would be done")
+	slingCmd.Flags().BoolVarP(&slingDryRun, "dry-run", "n", false, "Print the full dispatch plan without changing anything")
 	slingCmd.Flags().StringVar(&slingOnTarget, "on", "", "Apply formula to existing bead (implies wisp scaffolding)")
 	slingCmd.Flags().StringArrayVar(&slingVars, "var", nil, "Formula variable (key=value), can be repeated")
 	slingCmd.Flags().StringVarP(&slingArgs, "args", "a", "", "Natural language instructions for the executor (e.g., 'patch release')")
@@ -139,11 +199,15 @@ func init() {
 	slingCmd.Flags().StringVar(&slingMergeStrategy, "merge", "", "Merge strategy: direct (push to main), mr (refinery), local (merge locally)")
 	slingCmd.Flags().BoolVar(&slingOwned, "owned", false, "Create caller-owned convoy (caller manages lifecycle via gt convoy land)")
 	slingCmd.Flags().StringVar(&slingExecutionTarget, "target", "", "Execution target: local (default) or k8s (override rig config)")
+	slingCmd.Flags().BoolVar(&slingBatch, "batch", false, "Batch mode: create a convoy tracking all slung beads")
+	slingCmd.Flags().StringVar(&slingStrategy, "strategy", slingStrategySpawn, "Batch distribution: spawn, round-robin, load")
+	slingCmd.Flags().StringVar(&slingOverrideLimits, "override-limits", "", "Decision bead approving a spawn past the rig's limits")
+	slingCmd.Flags().BoolVar(&slingAuto, "auto", false, "Pick the target automatically by capability match, load and past success")
 
 	rootCmd.AddCommand(slingCmd)
 }
 
-func runSling(cmd *cobra.Command, args []string) error {
+func runSling(cmd *cobra.Command, args []string) (err error) {
 	// Polecats cannot sling - check early before writing anything
 	if polecatName := os.Getenv("GT_POLECAT"); polecatName != "" {
 		return fmt.Errorf("polecats cannot sling (use gt done for handoff)")
@@ -157,6 +221,20 @@ func runSling(cmd *cobra.Command, args []string) error {
 	}
 	townBeadsDir := filepath.Join(townRoot, ".beads")
 
+	// --at / --after queue the sling for the daemon instead of dispatching.
+	// A paused town still accepts these; they wait for it to resume.
+	if scheduleFlagsSet() {
+		for i := range args {
+			args[i] = strings.TrimRight(args[i], "/")
+		}
+		return runScheduledSling(cmd, args, townRoot)
+	}
+
+	// A paused town (gt pause) takes no new work.
+	if err := sling.CheckTownAcceptingWork(townRoot); err != nil {
+		return err
+	}
+
 	// Ensure beads.role=maintainer is set in the town root's git config.
 	// Without this, bd commands run from townRoot emit a confusing warning:
 	// "beads.role not configured. Run 'bd init' to set."
@@ -177,16 +255,39 @@ func runSling(cmd *cobra.Command, args []string) error {
 		args[i] = strings.TrimRight(args[i], "/")
 	}
 
+	// --auto: pick the target, then sling as if it had been given.
+	if slingAuto {
+		if len(args) != 1 || slingOnTarget != "" {
+			return fmt.Errorf("--auto picks the target itself; pass a single bead and no target")
+		}
+		target, err := autoSlingTarget(townRoot, args[0])
+		if err != nil {
+			return err
+		}
+		args = append(args, target)
+	}
+
 	// Batch mode detection: multiple beads with rig target
 	// Pattern: gt sling gt-abc gt-def gt-ghi gastown
-	// When len(args) > 2 and last arg is a rig, sling each bead to its own polecat
-	if len(args) > 2 {
+	// When len(args) > 2 and last arg is a rig, sling each bead to its own polecat.
+	// --batch also accepts a single bead so it still gets a convoy.
+	if len(args) > 2 || (slingBatch && len(args) == 2) {
 		lastArg := args[len(args)-1]
 		if rigName, isRig := IsRigName(lastArg); isRig {
 			return runBatchSling(args[:len(args)-1], rigName, townBeadsDir)
 		}
 	}
 
+	// Cross-town sling: gt sling <bead> <town>:<target>
+	if len(args) == 2 {
+		if remote, ok := resolveFederated(args[1]); ok {
+			if slingOnTarget != "" {
+				return fmt.Errorf("--on cannot be used with a target in another town")
+			}
+			return runFederatedSling(args[0], remote, townRoot)
+		}
+	}
+
 	// Determine mode based on flags and argument types
 	var beadID string
 	var formulaName string
@@ -239,6 +340,9 @@ func runSling(cmd *cobra.Command, args []string) error {
 	// This prevents orphan polecats when formula fails (GH #gt-e9o).
 	var deferredRigName string
 
+	// How the target is obtained, for the --dry-run dispatch plan.
+	var targetAction string
+
 	if len(args) > 1 {
 		target := args[1]
 
@@ -253,16 +357,14 @@ func runSling(cmd *cobra.Command, args []string) error {
 			if err != nil {
 				return fmt.Errorf("resolving self for '.' target: %w", err)
 			}
+			targetAction = "current agent"
 		} else if dogName, isDog := IsDogTarget(target); isDog {
 			if slingDryRun {
-				if dogName == "" {
-					fmt.Printf("Would dispatch to idle dog in kennel\n")
-				} else {
-					fmt.Printf("Would dispatch to dog '%s'\n", dogName)
-				}
 				targetAgent = fmt.Sprintf("deacon/dogs/%s", dogName)
+				targetAction = fmt.Sprintf("dispatch to dog '%s'", dogName)
 				if dogName == "" {
 					targetAgent = "deacon/dogs/<idle>"
+					targetAction = "dispatch to an idle dog in the kennel"
 				}
 			} else {
 				// Dispatch to dog with delayed session start
@@ -284,8 +386,9 @@ func runSling(cmd *cobra.Command, args []string) error {
 			// Check if target is a rig name (auto-spawn polecat)
 			if slingDryRun {
 				// Dry run - just indicate what would happen
-				fmt.Printf("Would spawn fresh polecat in rig '%s'\n", rigName)
+				deferredRigName = rigName
 				targetAgent = fmt.Sprintf("%s/polecats/<new>", rigName)
+				targetAction = fmt.Sprintf("spawn a fresh polecat in rig '%s'", rigName)
 			} else {
 				// DEFERRED SPAWN: Don't spawn polecat yet - we need to validate bead
 				// and instantiate formula first. This prevents orphan polecats when
@@ -312,12 +415,16 @@ func runSling(cmd *cobra.Command, args []string) error {
 						// formula first. This prevents orphan polecats (GH #gt-e9o).
 						fmt.Printf("Target polecat has no active session, will spawn fresh polecat after validation...\n")
 						deferredRigName = rigName
+						targetAction = fmt.Sprintf("spawn a fresh polecat in rig '%s' (%s has no active session)", rigName, target)
 						// Use placeholder values until spawn
 						targetAgent = fmt.Sprintf("%s/polecats/<pending>", rigName)
 						// hookWorkDir stays empty - formula instantiation will use townRoot
 					} else {
 						return fmt.Errorf("resolving target: %w", err)
 					}
+				} else if rigName, crewName, ok := parseCrewTarget(target); ok && slingDryRun {
+					targetAgent = fmt.Sprintf("%s/crew/%s", rigName, crewName)
+					targetAction = "start the crew session (not running)"
 				} else if rigName, crewName, ok := parseCrewTarget(target); ok {
 					// FIX (hq-cc7214.25): Auto-start crew session if not running
 					fmt.Printf("Target crew %s/%s has no active session, starting...\n", rigName, crewName)
@@ -375,6 +482,8 @@ func runSling(cmd *cobra.Command, args []string) error {
 				} else {
 					return fmt.Errorf("resolving target: %w", err)
 				}
+			} else {
+				targetAction = "reuse the running session"
 			}
 			// Use target's working directory for bd commands (needed for redirect-based routing)
 			if targetWorkDir != "" {
@@ -392,6 +501,7 @@ func runSling(cmd *cobra.Command, args []string) error {
 		if selfWorkDir != "" {
 			hookWorkDir = selfWorkDir
 		}
+		targetAction = "self (current agent)"
 	}
 
 	// Display what we're doing
@@ -419,7 +529,14 @@ func runSling(cmd *cobra.Command, args []string) error {
 	}
 
 	// Handle --force when bead is already hooked: send shutdown to old polecat and unhook
-	if info.Status == "hooked" && slingForce && info.Assignee != "" {
+	var reassignPlan []string
+	if info.Status == "hooked" && slingForce && info.Assignee != "" && slingDryRun {
+		assigneeParts := strings.Split(info.Assignee, "/")
+		if len(assigneeParts) >= 3 && assigneeParts[1] == "polecats" {
+			reassignPlan = append(reassignPlan, fmt.Sprintf("mail LIFECYCLE:Shutdown %s to %s/witness", assigneeParts[2], assigneeParts[0]))
+		}
+		reassignPlan = append(reassignPlan, fmt.Sprintf("unhook from %s (bd update %s --status=open --assignee=)", info.Assignee, beadID))
+	} else if info.Status == "hooked" && slingForce && info.Assignee != "" {
 		fmt.Printf("%s Bead already hooked to %s, forcing reassignment...\n", style.Warning.Render("⚠"), info.Assignee)
 
 		// Determine requester identity from env vars, fall back to "gt-sling"
@@ -476,16 +593,17 @@ func runSling(cmd *cobra.Command, args []string) error {
 		fmt.Printf("  Or use 'gt workload %s' to see full queue\n\n", targetAgent)
 	}
 
-	// Convoy handling: only add to existing convoy if explicitly requested via --convoy
+	planConvoy := ""
 	if slingConvoy != "" && formulaName == "" {
-		if slingDryRun {
-			fmt.Printf("Would add %s to convoy %s\n", beadID, slingConvoy)
+		planConvoy = slingConvoy
+	}
+
+	// Convoy handling: only add to existing convoy if explicitly requested via --convoy
+	if slingConvoy != "" && formulaName == "" && !slingDryRun {
+		if err := addToConvoy(slingConvoy, beadID); err != nil {
+			fmt.Printf("%s Could not add to convoy %s: %v\n", style.Dim.Render("Warning:"), slingConvoy, err)
 		} else {
-			if err := addToConvoy(slingConvoy, beadID); err != nil {
-				fmt.Printf("%s Could not add to convoy %s: %v\n", style.Dim.Render("Warning:"), slingConvoy, err)
-			} else {
-				fmt.Printf("%s Added to convoy %s\n", style.Bold.Render("→"), slingConvoy)
-			}
+			fmt.Printf("%s Added to convoy %s\n", style.Bold.Render("→"), slingConvoy)
 		}
 	}
 
@@ -498,27 +616,48 @@ func runSling(cmd *cobra.Command, args []string) error {
 	}
 
 	if slingDryRun {
-		if formulaName != "" {
-			fmt.Printf("Would instantiate formula %s:\n", formulaName)
-			fmt.Printf("  1. bd cook %s\n", formulaName)
-			fmt.Printf("  2. bd mol wisp %s --var feature=\"%s\" --var issue=\"%s\"\n", formulaName, info.Title, beadID)
-			fmt.Printf("  3. bd mol bond <wisp-root> %s\n", beadID)
-			fmt.Printf("  4. bd update <compound-root> --status=hooked --assignee=%s\n", targetAgent)
-		} else {
-			fmt.Printf("Would run: bd update %s --status=hooked --assignee=%s\n", beadID, targetAgent)
+		plan := &slingPlan{
+			BeadID:       beadID,
+			BeadTitle:    info.Title,
+			BeadStatus:   info.Status,
+			Target:       targetAgent,
+			TargetAction: targetAction,
+			Reassign:     reassignPlan,
+			Convoy:       planConvoy,
+			Formula:      formulaName,
 		}
-		if slingSubject != "" {
-			fmt.Printf("  subject (in nudge): %s\n", slingSubject)
+		if formulaName != "" {
+			wispCmd := fmt.Sprintf("bd mol wisp %s --var feature=%q --var issue=%q", formulaName, info.Title, beadID)
+			for _, v := range slingVars {
+				wispCmd += " --var " + v
+			}
+			plan.FormulaSteps = []string{
+				"bd cook " + formulaName,
+				wispCmd,
+				fmt.Sprintf("bd mol bond <wisp-root> %s", beadID),
+			}
 		}
-		if slingMessage != "" {
-			fmt.Printf("  context: %s\n", slingMessage)
+		plan.addSlingFlagFields(detectActor())
+		if crewTargetName != "" {
+			plan.Notify = append(plan.Notify, fmt.Sprintf("mail %s/crew/%s: %q", crewTargetRig, crewTargetName, "WORK: "+info.Title))
 		}
-		if slingArgs != "" {
-			fmt.Printf("  args (in nudge): %s\n", slingArgs)
+		if deferredRigName != "" {
+			plan.Notify = append(plan.Notify, fmt.Sprintf("wake %s/witness and %s/refinery", deferredRigName, deferredRigName))
 		}
+		plan.Notify = append(plan.Notify, slingNudgePlan(targetAgent, beadID,
+			deferredRigName != "", deferredRigName != "" && ojSlingEnabled(), strings.HasPrefix(targetAgent, "deacon/dogs/")))
+		plan.print()
 		return nil
 	}
 
+	// Trace the dispatch under the bead; spans from the spawned agent and
+	// gt done join the same trace.
+	ctx, span := tracing.Start(context.Background(), beadID, "sling")
+	defer func() {
+		span.SetAttributes(tracing.AttrAgent.String(targetAgent))
+		tracing.End(span, err)
+	}()
+
 	// Formula-on-bead mode: instantiate formula and bond to original bead
 	if formulaName != "" {
 		fmt.Printf("  Instantiating formula %s...\n", formulaName)
@@ -552,6 +691,7 @@ func runSling(cmd *cobra.Command, args []string) error {
 	// Execute deferred polecat spawn if needed (for rig targets).
 	// This happens AFTER formula instantiation to prevent orphan polecats on failure (GH #gt-e9o).
 	var ojDispatch *OjDispatchInfo
+	var warmClaimed bool
 	if deferredRigName != "" && ojSlingEnabled() {
 		// OJ dispatch path: OJ daemon manages workspace creation, agent spawn,
 		// step execution, monitoring, crash recovery, and cleanup.
@@ -597,15 +737,25 @@ func runSling(cmd *cobra.Command, args []string) error {
 			HookBead:        beadID,
 			Agent:           slingAgent,
 			ExecutionTarget: slingExecutionTarget,
+			LimitOverride:   slingOverrideLimits,
 		}
 
 		fmt.Printf("  Spawning polecat in %s...\n", deferredRigName)
+		_, spawnSpan := tracing.Start(ctx, beadID, "spawn_polecat", tracing.AttrRig.String(deferredRigName))
 		spawnInfo, spawnErr := SpawnPolecatForSling(deferredRigName, spawnOpts)
+		if spawnErr == nil && spawnInfo.K8sSpawn {
+			// The controller traces pod creation under the agent bead
+			agentBeadID := sling.PolecatAgentBeadID(townRoot, deferredRigName, spawnInfo.PolecatName)
+			tracing.LinkBead(spawnSpan, agentBeadID)
+			spawnSpan.SetAttributes(tracing.AttrAgentBeadID.String(agentBeadID))
+		}
+		tracing.End(spawnSpan, spawnErr)
 		if spawnErr != nil {
 			return fmt.Errorf("spawning polecat: %w", spawnErr)
 		}
 		targetAgent = spawnInfo.AgentID()
 		hookWorkDir = spawnInfo.ClonePath
+		warmClaimed = spawnInfo.Warm
 
 		// Wake witness and refinery to monitor the new polecat
 		wakeRigAgents(deferredRigName)
@@ -695,66 +845,37 @@ func runSling(cmd *cobra.Command, args []string) error {
 
 	// Log sling event to activity feed
 	actor := detectActor()
-	_ = events.LogFeed(events.TypeSling, actor, events.SlingPayload(beadID, targetAgent))
+	_ = eventbus.Publish(actor, eventbus.Sling{Bead: beadID, Target: targetAgent})
 
 	// Update agent bead's hook_bead field (ZFC: agents track their current work)
 	updateAgentHookBead(targetAgent, beadID, hookWorkDir, townBeadsDir)
 
-	// Store dispatcher in bead description (enables completion notification to dispatcher)
-	if err := storeDispatcherInBead(beadID, actor); err != nil {
-		// Warn but don't fail - polecat will still complete work
-		fmt.Printf("%s Could not store dispatcher in bead: %v\n", style.Dim.Render("Warning:"), err)
-	}
-
-	// Store args in bead description (beads as data plane)
-	if slingArgs != "" {
-		if err := storeArgsInBead(beadID, slingArgs); err != nil {
-			// Warn but don't fail - args will still be in the nudge prompt
-			fmt.Printf("%s Could not store args in bead: %v\n", style.Dim.Render("Warning:"), err)
-		} else {
+	// Record dispatch metadata in the bead description (beads as data plane).
+	// All fields go through one mutator: a single bd show and bd update.
+	//   - dispatched_by: enables completion notification to the dispatcher
+	//   - attached_args: durable copy of --args
+	//   - no_merge / merge_strategy / convoy_owned: how gt done lands the work
+	//   - attached_molecule: points at the wisp (compound root) so gt hook,
+	//     gt prime and gt done can follow base bead -> wisp
+	if err := storeSlingFieldsInBead(beadID, actor, attachedMoleculeID); err != nil {
+		// Warn but don't fail - args are still in the nudge prompt and the
+		// polecat can still work through molecule steps
+		fmt.Printf("%s Could not store sling fields in bead: %v\n", style.Dim.Render("Warning:"), err)
+	} else {
+		if slingArgs != "" {
 			fmt.Printf("%s Args stored in bead (durable)\n", style.Bold.Render("✓"))
 		}
-	}
-
-	// Store no_merge flag in bead (skips merge queue on completion)
-	if slingNoMerge {
-		if err := storeNoMergeInBead(beadID, true); err != nil {
-			fmt.Printf("%s Could not store no_merge in bead: %v\n", style.Dim.Render("Warning:"), err)
-		} else {
+		if slingNoMerge {
 			fmt.Printf("%s No-merge mode enabled (work stays on feature branch)\n", style.Bold.Render("✓"))
 		}
-	}
-
-	// Store merge strategy in bead if specified
-	if slingMergeStrategy != "" {
-		if err := storeMergeStrategyInBead(beadID, slingMergeStrategy); err != nil {
-			fmt.Printf("%s Could not store merge strategy in bead: %v\n", style.Dim.Render("Warning:"), err)
-		} else {
+		if slingMergeStrategy != "" {
 			fmt.Printf("%s Merge strategy: %s\n", style.Bold.Render("✓"), slingMergeStrategy)
 		}
-	}
-
-	// Store convoy_owned flag in bead if specified
-	if slingOwned {
-		if err := storeConvoyOwnedInBead(beadID, true); err != nil {
-			fmt.Printf("%s Could not store convoy_owned in bead: %v\n", style.Dim.Render("Warning:"), err)
-		} else {
+		if slingOwned {
 			fmt.Printf("%s Convoy owned: caller-managed (use gt convoy land)\n", style.Bold.Render("✓"))
 		}
 	}
 
-	// Record the attached molecule in the BASE bead's description.
-	// This field points to the wisp (compound root) and enables:
-	// - gt hook/gt prime: follow attached_molecule to show molecule steps
-	// - gt done: close attached_molecule (wisp) before closing hooked bead
-	// - Compound resolution: base bead -> attached_molecule -> wisp
-	if attachedMoleculeID != "" {
-		if err := storeAttachedMoleculeInBead(beadID, attachedMoleculeID); err != nil {
-			// Warn but don't fail - polecat can still work through steps
-			fmt.Printf("%s Could not store attached_molecule: %v\n", style.Dim.Render("Warning:"), err)
-		}
-	}
-
 	// Start delayed dog session now that hook is set
 	// This ensures dog sees the hook when gt prime runs on session start
 	if delayedDogInfo != nil {
@@ -765,8 +886,9 @@ func runSling(cmd *cobra.Command, args []string) error {
 
 	// Send nudge to start working.
 	// Skip for freshly spawned polecats - SessionManager.Start() already sent StartupNudge.
+	// Warm pool polecats were already idle when claimed, so they do need one.
 	// Skip for OJ dispatch - OJ daemon owns the agent lifecycle.
-	freshlySpawned := deferredRigName != ""
+	freshlySpawned := deferredRigName != "" && !warmClaimed
 	if ojDispatch != nil {
 		// OJ dispatch: OJ daemon manages agent lifecycle, no nudge needed
 		fmt.Printf("%s OJ job dispatched: %s\n", style.Bold.Render("▶"), ojDispatch.JobID)
@@ -774,7 +896,11 @@ func runSling(cmd *cobra.Command, args []string) error {
 		// Fresh polecat already got StartupNudge from SessionManager.Start()
 	} else {
 		// Try nudging via backend (Coop/K8s)
-		if nudgeViaBackend(targetAgent, beadID, slingSubject, slingArgs) {
+		_, nudgeSpan := tracing.Start(ctx, beadID, "nudge", tracing.AttrAgent.String(targetAgent))
+		nudged := nudgeViaBackend(targetAgent, beadID, slingSubject, slingArgs)
+		nudgeSpan.SetAttributes(attribute.Bool("gt.nudge.delivered", nudged))
+		tracing.End(nudgeSpan, nil)
+		if nudged {
 			fmt.Printf("%s Start prompt sent (via backend)\n", style.Bold.Render("▶"))
 		} else {
 			fmt.Printf("%s No pane to nudge (agent will discover work via gt prime)\n", style.Dim.Render("○"))
@@ -789,13 +915,13 @@ func runSling(cmd *cobra.Command, args []string) error {
 func callSling(args []string) error {
 	// Save and restore flag state
 	saved := struct {
-		subject, message, onTarget, slingArgs, account, agent, convoy, merge, execTarget string
-		dryRun, hookRawBead, create, force, noMerge, owned                               bool
+		subject, message, onTarget, slingArgs, account, agent, convoy, merge, execTarget, strategy string
+		dryRun, hookRawBead, create, force, noMerge, owned, batch                                  bool
 		vars                                                                               []string
 	}{
 		slingSubject, slingMessage, slingOnTarget, slingArgs, slingAccount, slingAgent,
-		slingConvoy, slingMergeStrategy, slingExecutionTarget,
-		slingDryRun, slingHookRawBead, slingCreate, slingForce, slingNoMerge, slingOwned,
+		slingConvoy, slingMergeStrategy, slingExecutionTarget, slingStrategy,
+		slingDryRun, slingHookRawBead, slingCreate, slingForce, slingNoMerge, slingOwned, slingBatch,
 		slingVars,
 	}
 	defer func() {
@@ -808,12 +934,14 @@ func callSling(args []string) error {
 		slingConvoy = saved.convoy
 		slingMergeStrategy = saved.merge
 		slingExecutionTarget = saved.execTarget
+		slingStrategy = saved.strategy
 		slingDryRun = saved.dryRun
 		slingHookRawBead = saved.hookRawBead
 		slingCreate = saved.create
 		slingForce = saved.force
 		slingNoMerge = saved.noMerge
 		slingOwned = saved.owned
+		slingBatch = saved.batch
 		slingVars = saved.vars
 	}()
 
@@ -827,12 +955,14 @@ func callSling(args []string) error {
 	slingConvoy = ""
 	slingMergeStrategy = ""
 	slingExecutionTarget = ""
+	slingStrategy = slingStrategySpawn
 	slingDryRun = false
 	slingHookRawBead = false
 	slingCreate = false
 	slingForce = false
 	slingNoMerge = false
 	slingOwned = false
+	slingBatch = false
 	slingVars = nil
 
 	return runSling(nil, args)
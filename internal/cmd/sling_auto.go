@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/steveyegge/gastown/internal/sling"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// autoSlingShown is how many ranked targets gt sling --auto prints.
+const autoSlingShown = 5
+
+// autoSlingTarget ranks targets for a bead, prints the top of the ranking
+// and returns the winner.
+func autoSlingTarget(townRoot, beadID string) (string, error) {
+	ranked, err := sling.AutoRoute(townRoot, beadID)
+	if err != nil {
+		return "", err
+	}
+
+	fmt.Printf("%s Auto-routing %s:\n", style.Bold.Render("🎯"), beadID)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  TARGET\tSCORE\tMATCH\tLOAD\tSUCCESS")
+	for i, c := range ranked {
+		if i == autoSlingShown {
+			break
+		}
+		fmt.Fprintf(w, "  %s\t%.2f\t%.0f%%\t%.0f%%\t%.0f%% (%d slings)\n",
+			c.Target, c.Score, c.Match*100, c.Load*100, c.SuccessRate*100, c.Slings)
+	}
+	_ = w.Flush()
+	fmt.Printf("%s Picked %s\n", style.Success.Render("✓"), ranked[0].Target)
+	return ranked[0].Target, nil
+}
@@ -4,18 +4,108 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/bdcmd"
 	"github.com/steveyegge/gastown/internal/beads"
-	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/eventbus"
 	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/sling"
 	"github.com/steveyegge/gastown/internal/style"
 )
 
+// Batch sling distribution strategies.
+const (
+	// slingStrategySpawn gives each bead its own freshly spawned polecat.
+	slingStrategySpawn = "spawn"
+
+	// slingStrategyRoundRobin cycles beads across the rig's existing polecats.
+	slingStrategyRoundRobin = "round-robin"
+
+	// slingStrategyLoad gives each bead to the existing polecat with the
+	// fewest hooked beads.
+	slingStrategyLoad = "load"
+)
+
+// batchPolecat is an existing polecat that can receive batch work.
+type batchPolecat struct {
+	Name string
+	Load int // hooked beads already assigned
+}
+
+// planBatchAssignments picks a polecat for each bead. An empty name means
+// the bead should get a freshly spawned polecat, which is always the case
+// for the spawn strategy or when the rig has no available polecats.
+func planBatchAssignments(beadIDs []string, polecats []batchPolecat, strategy string) []string {
+	targets := make([]string, len(beadIDs))
+	if strategy == slingStrategySpawn || len(polecats) == 0 {
+		return targets
+	}
+
+	pool := make([]batchPolecat, len(polecats))
+	copy(pool, polecats)
+	sort.Slice(pool, func(i, j int) bool { return pool[i].Name < pool[j].Name })
+
+	for i := range beadIDs {
+		var pick int
+		switch strategy {
+		case slingStrategyRoundRobin:
+			pick = i % len(pool)
+		case slingStrategyLoad:
+			for j := range pool {
+				if pool[j].Load < pool[pick].Load {
+					pick = j
+				}
+			}
+		}
+		targets[i] = pool[pick].Name
+		pool[pick].Load++
+	}
+	return targets
+}
+
+// availableBatchPolecats lists the rig's polecats that can take more work,
+// with their current hooked-bead counts.
+func availableBatchPolecats(townRoot, rigName string) ([]batchPolecat, error) {
+	mgr, _, err := getPolecatManager(rigName)
+	if err != nil {
+		return nil, err
+	}
+	polecats, err := mgr.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing polecats in %s: %w", rigName, err)
+	}
+
+	var available []batchPolecat
+	for _, p := range polecats {
+		switch p.State {
+		case polecat.StateWorking, polecat.StateActive, polecat.StateSpawning:
+		default:
+			continue // done and stuck polecats shouldn't take new work
+		}
+		agentID := fmt.Sprintf("%s/polecats/%s", rigName, p.Name)
+		available = append(available, batchPolecat{
+			Name: p.Name,
+			Load: countHookedBeadsForAgent(townRoot, agentID),
+		})
+	}
+	return available, nil
+}
+
 // runBatchSling handles slinging multiple beads to a rig.
-// Each bead gets its own freshly spawned polecat.
+// With the default spawn strategy each bead gets its own freshly spawned
+// polecat; round-robin and load distribute beads across existing polecats.
+// With --batch, a convoy tracking the dispatched beads is created.
 func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error {
+	switch slingStrategy {
+	case slingStrategySpawn, slingStrategyRoundRobin, slingStrategyLoad:
+	default:
+		return fmt.Errorf("invalid --strategy %q (valid: %s, %s, %s)",
+			slingStrategy, slingStrategySpawn, slingStrategyRoundRobin, slingStrategyLoad)
+	}
+
 	// Validate all beads exist before spawning any polecats
 	for _, beadID := range beadIDs {
 		if err := verifyBeadExists(beadID); err != nil {
@@ -25,27 +115,47 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 
 	// Suggest convoy batching if slinging many beads without --convoy
 	const batchWarningThreshold = 3
-	if len(beadIDs) >= batchWarningThreshold && slingConvoy == "" {
+	if len(beadIDs) >= batchWarningThreshold && slingConvoy == "" && !slingBatch {
 		fmt.Printf("%s Slinging %d beads without a convoy — consider batching:\n", style.Dim.Render("tip:"), len(beadIDs))
-		fmt.Printf("    gt convoy create \"Batch name\" %s\n", strings.Join(beadIDs, " "))
+		fmt.Printf("    gt sling --batch %s %s\n", strings.Join(beadIDs, " "), rigName)
 		fmt.Printf("  Or use --convoy <convoy-id> to add all to existing convoy\n\n")
 	}
 
+	// Issue #288: Auto-apply mol-polecat-work for batch sling
+	// Cook once before the loop for efficiency
+	townRoot := filepath.Dir(townBeadsDir)
+
+	var polecats []batchPolecat
+	if slingStrategy != slingStrategySpawn {
+		var err error
+		polecats, err = availableBatchPolecats(townRoot, rigName)
+		if err != nil {
+			return err
+		}
+		if len(polecats) == 0 {
+			fmt.Printf("%s No available polecats in %s, spawning a polecat per bead\n", style.Dim.Render("○"), rigName)
+		}
+	}
+	targets := planBatchAssignments(beadIDs, polecats, slingStrategy)
+
 	if slingDryRun {
-		fmt.Printf("%s Batch slinging %d beads to rig '%s':\n", style.Bold.Render("🎯"), len(beadIDs), rigName)
+		fmt.Printf("%s Batch slinging %d beads to rig '%s' (strategy: %s):\n", style.Bold.Render("🎯"), len(beadIDs), rigName, slingStrategy)
+		if slingBatch && slingConvoy == "" {
+			fmt.Printf("  Would create a convoy tracking the dispatched beads\n")
+		}
 		fmt.Printf("  Would cook mol-polecat-work formula once\n")
-		for _, beadID := range beadIDs {
-			fmt.Printf("  Would spawn polecat and apply mol-polecat-work to: %s\n", beadID)
+		for i, beadID := range beadIDs {
+			if targets[i] == "" {
+				fmt.Printf("  Would spawn polecat and apply mol-polecat-work to: %s\n", beadID)
+			} else {
+				fmt.Printf("  Would sling %s to %s/polecats/%s\n", beadID, rigName, targets[i])
+			}
 		}
 		return nil
 	}
 
 	fmt.Printf("%s Batch slinging %d beads to rig '%s'...\n", style.Bold.Render("🎯"), len(beadIDs), rigName)
 
-	// Issue #288: Auto-apply mol-polecat-work for batch sling
-	// Cook once before the loop for efficiency
-	townRoot := filepath.Dir(townBeadsDir)
-
 	// Ensure beads.role=maintainer is set in the town root's git config.
 	// Without this, bd commands run from townRoot emit a confusing warning:
 	// "beads.role not configured. Run 'bd init' to set."
@@ -55,6 +165,34 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 	formulaName := "mol-polecat-work"
 	formulaCooked := false
 
+	// The convoy is created on the first successful dispatch so a batch
+	// that fails entirely doesn't leave an empty convoy behind.
+	convoyID := slingConvoy
+	trackInConvoy := func(beadID string) {
+		if convoyID == "" && slingBatch {
+			id, err := createAutoConvoyWithOptions(beadID, "", "", ConvoyOptions{
+				Owned:         slingOwned,
+				MergeStrategy: slingMergeStrategy,
+				Title:         fmt.Sprintf("Batch: %d beads to %s", len(beadIDs), rigName),
+			})
+			if err != nil {
+				fmt.Printf("  %s Could not create batch convoy: %v\n", style.Dim.Render("Warning:"), err)
+				return
+			}
+			convoyID = id
+			fmt.Printf("  %s Created convoy %s\n", style.Bold.Render("→"), convoyID)
+			return
+		}
+		if convoyID == "" {
+			return
+		}
+		if err := addToConvoy(convoyID, beadID); err != nil {
+			fmt.Printf("  %s Could not add to convoy %s: %v\n", style.Dim.Render("Warning:"), convoyID, err)
+		} else {
+			fmt.Printf("  %s Added to convoy %s\n", style.Bold.Render("→"), convoyID)
+		}
+	}
+
 	// Track results for summary
 	type slingResult struct {
 		beadID  string
@@ -64,10 +202,21 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 	}
 	results := make([]slingResult, 0, len(beadIDs))
 
-	// Spawn a polecat for each bead and sling it
+	// Sling each bead to its assigned polecat, spawning where needed
 	for i, beadID := range beadIDs {
 		fmt.Printf("\n[%d/%d] Slinging %s...\n", i+1, len(beadIDs), beadID)
 
+		if targets[i] != "" {
+			if err := slingToBatchPolecat(beadID, rigName, targets[i]); err != nil {
+				results = append(results, slingResult{beadID: beadID, polecat: targets[i], success: false, errMsg: err.Error()})
+				fmt.Printf("  %s Failed: %v\n", style.Dim.Render("✗"), err)
+				continue
+			}
+			trackInConvoy(beadID)
+			results = append(results, slingResult{beadID: beadID, polecat: targets[i], success: true})
+			continue
+		}
+
 		// Check bead status
 		info, err := getBeadInfo(beadID)
 		if err != nil {
@@ -90,6 +239,7 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 			HookBead:        beadID, // Set atomically at spawn time
 			Agent:           slingAgent,
 			ExecutionTarget: slingExecutionTarget,
+			LimitOverride:   slingOverrideLimits,
 		}
 		spawnInfo, err := SpawnPolecatForSling(rigName, spawnOpts)
 		if err != nil {
@@ -101,15 +251,6 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 		targetAgent := spawnInfo.AgentID()
 		hookWorkDir := spawnInfo.ClonePath
 
-		// Convoy handling: only add to specified convoy (no auto-create)
-		if slingConvoy != "" {
-			if err := addToConvoy(slingConvoy, beadID); err != nil {
-				fmt.Printf("  %s Could not add to convoy %s: %v\n", style.Dim.Render("Warning:"), slingConvoy, err)
-			} else {
-				fmt.Printf("  %s Added to convoy %s\n", style.Bold.Render("→"), slingConvoy)
-			}
-		}
-
 		// Issue #288: Apply mol-polecat-work via formula-on-bead pattern
 		// Cook once (lazy), then instantiate for each bead
 		if !formulaCooked {
@@ -136,7 +277,7 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 		}
 
 		// Hook the bead (or wisp compound if formula was applied)
-		hookCmd := bdcmd.Command( "update", beadToHook, "--status=hooked", "--assignee="+targetAgent)
+		hookCmd := bdcmd.Command("update", beadToHook, "--status=hooked", "--assignee="+targetAgent)
 		hookCmd.Dir = beads.ResolveHookDir(townRoot, beadToHook, hookWorkDir)
 		hookCmd.Stderr = os.Stderr
 		if err := hookCmd.Run(); err != nil {
@@ -146,25 +287,28 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 		}
 
 		fmt.Printf("  %s Work attached to %s\n", style.Bold.Render("✓"), spawnInfo.PolecatName)
+		trackInConvoy(beadID)
 
 		// Log sling event
 		actor := detectActor()
-		_ = events.LogFeed(events.TypeSling, actor, events.SlingPayload(beadToHook, targetAgent))
+		_ = eventbus.Publish(actor, eventbus.Sling{Bead: beadToHook, Target: targetAgent})
 
 		// Update agent bead state
 		updateAgentHookBead(targetAgent, beadToHook, hookWorkDir, townBeadsDir)
 
-		// Store attached molecule in the hooked bead
-		if attachedMoleculeID != "" {
-			if err := storeAttachedMoleculeInBead(beadToHook, attachedMoleculeID); err != nil {
-				fmt.Printf("  %s Could not store attached_molecule: %v\n", style.Dim.Render("Warning:"), err)
-			}
+		// Warm pool polecats are already running and waiting for a prompt.
+		if spawnInfo.Warm && !nudgeViaBackend(targetAgent, beadToHook, "", slingArgs) {
+			fmt.Printf("  %s Could not nudge %s (it will find the work via gt prime)\n", style.Dim.Render("○"), spawnInfo.PolecatName)
 		}
 
-		// Store args if provided
-		if slingArgs != "" {
-			if err := storeArgsInBead(beadID, slingArgs); err != nil {
-				fmt.Printf("  %s Could not store args: %v\n", style.Dim.Render("Warning:"), err)
+		// Store attached molecule and args in the hooked bead (one read, one write)
+		if attachedMoleculeID != "" || slingArgs != "" {
+			m, err := sling.NewBeadMutator(beadToHook)
+			if err == nil {
+				err = m.SetAttachedMolecule(attachedMoleculeID).SetArgs(slingArgs).Commit()
+			}
+			if err != nil {
+				fmt.Printf("  %s Could not store sling fields: %v\n", style.Dim.Render("Warning:"), err)
 			}
 		}
 
@@ -174,7 +318,7 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 	// Wake witness and refinery once at the end
 	wakeRigAgents(rigName)
 
-	// Print summary
+	// Print dispatch summary
 	successCount := 0
 	for _, r := range results {
 		if r.success {
@@ -183,13 +327,44 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 	}
 
 	fmt.Printf("\n%s Batch sling complete: %d/%d succeeded\n", style.Bold.Render("📊"), successCount, len(beadIDs))
+	for _, r := range results {
+		if r.success {
+			fmt.Printf("  %s %s → %s/polecats/%s\n", style.Bold.Render("✓"), r.beadID, rigName, r.polecat)
+		}
+	}
+	for _, r := range results {
+		if !r.success {
+			fmt.Printf("  %s %s: %s\n", style.Dim.Render("✗"), r.beadID, r.errMsg)
+		}
+	}
+	if convoyID != "" && successCount > 0 {
+		fmt.Printf("  Convoy: %s\n", convoyID)
+	}
+
 	if successCount < len(beadIDs) {
+		var failed []string
 		for _, r := range results {
 			if !r.success {
-				fmt.Printf("  %s %s: %s\n", style.Dim.Render("✗"), r.beadID, r.errMsg)
+				failed = append(failed, r.beadID)
 			}
 		}
+		fmt.Printf("\n  Retry failed beads with:\n    gt sling %s %s", strings.Join(failed, " "), rigName)
+		if convoyID != "" {
+			fmt.Printf(" --convoy %s", convoyID)
+		}
+		fmt.Println()
+		return fmt.Errorf("%d of %d beads failed to sling", len(beadIDs)-successCount, len(beadIDs))
 	}
 
 	return nil
 }
+
+// slingToBatchPolecat slings a single bead to an existing polecat using the
+// regular sling path. Convoy tracking is left to the batch caller.
+func slingToBatchPolecat(beadID, rigName, polecatName string) error {
+	savedConvoy := slingConvoy
+	slingConvoy = ""
+	defer func() { slingConvoy = savedConvoy }()
+
+	return runSling(nil, []string{beadID, fmt.Sprintf("%s/polecats/%s", rigName, polecatName)})
+}
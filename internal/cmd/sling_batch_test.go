@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPlanBatchAssignments(t *testing.T) {
+	beadIDs := []string{"gt-a", "gt-b", "gt-c", "gt-d"}
+	polecats := []batchPolecat{
+		{Name: "nux", Load: 2},
+		{Name: "furiosa", Load: 0},
+		{Name: "toast", Load: 1},
+	}
+
+	tests := []struct {
+		name     string
+		polecats []batchPolecat
+		strategy string
+		want     []string
+	}{
+		{"spawn ignores polecats", polecats, slingStrategySpawn, []string{"", "", "", ""}},
+		{"round-robin cycles by name", polecats, slingStrategyRoundRobin, []string{"furiosa", "nux", "toast", "furiosa"}},
+		{"load fills least busy first", polecats, slingStrategyLoad, []string{"furiosa", "furiosa", "toast", "furiosa"}},
+		{"no polecats spawns", nil, slingStrategyLoad, []string{"", "", "", ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := planBatchAssignments(beadIDs, tt.polecats, tt.strategy)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("planBatchAssignments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// The caller's load counts must not be modified.
+	if polecats[1].Load != 0 {
+		t.Errorf("planBatchAssignments mutated input load: %+v", polecats)
+	}
+}
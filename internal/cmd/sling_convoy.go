@@ -71,6 +71,7 @@ func isTrackedByConvoy(beadID string) string {
 type ConvoyOptions struct {
 	Owned         bool   // Caller-owned (no witness/refinery)
 	MergeStrategy string // direct, mr, or local
+	Title         string // Convoy title (default "Work: <issue-title>")
 }
 
 // createAutoConvoy creates an auto-convoy for a single issue and tracks it.
@@ -96,6 +97,9 @@ func createAutoConvoyWithOptions(beadID, beadTitle, assignee string, opts Convoy
 
 	// Create convoy with title "Work: <issue-title>"
 	convoyTitle := fmt.Sprintf("Work: %s", beadTitle)
+	if opts.Title != "" {
+		convoyTitle = opts.Title
+	}
 	description := fmt.Sprintf("Auto-created convoy tracking %s", beadID)
 
 	// Add ownership metadata if specified
@@ -110,7 +110,7 @@ func DispatchToDog(dogName string, opts DogDispatchOptions) (*DogDispatchInfo, e
 		if targetDog == nil {
 			if opts.Create {
 				// No idle dogs - create one
-				newName := generateDogName(mgr)
+				newName := mgr.NextName()
 				targetDog, err = mgr.Add(newName)
 				if err != nil {
 					return nil, fmt.Errorf("creating dog %s: %w", newName, err)
@@ -187,31 +187,3 @@ func (d *DogDispatchInfo) StartDelayedSession() (string, error) {
 	d.sessionDelayed = false
 	return pane, nil
 }
-
-// generateDogName creates a unique dog name for pool expansion.
-func generateDogName(mgr *dog.Manager) string {
-	// Use Greek alphabet for dog names
-	names := []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel"}
-
-	dogs, _ := mgr.List()
-	existing := make(map[string]bool)
-	for _, d := range dogs {
-		existing[d.Name] = true
-	}
-
-	for _, name := range names {
-		if !existing[name] {
-			return name
-		}
-	}
-
-	// Fallback: numbered dogs
-	for i := 1; i <= 100; i++ {
-		name := fmt.Sprintf("dog%d", i)
-		if !existing[name] {
-			return name
-		}
-	}
-
-	return fmt.Sprintf("dog%d", len(dogs)+1)
-}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/rpcclient"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// FederatedLabel marks a bead that was copied from another town by a
+// cross-town sling. The description carries a federated_from line.
+const FederatedLabel = "gt:federated"
+
+// runFederatedSling slings a bead to a target in a peer town.
+//
+// A bead that exists in this town is copied to the peer first, since the
+// peer cannot see our beads; the local bead is labeled with the peer so it
+// is clear where the work went. A bead ID unknown here is assumed to
+// already live in the peer town and is slung there as-is.
+func runFederatedSling(beadID string, remote *federatedTarget, townRoot string) error {
+	local, _ := beads.New(townRoot).Show(beadID)
+
+	if slingDryRun {
+		fmt.Printf("Would sling %s to %s via %s\n", beadID, remote, remote.town.URL)
+		if local != nil {
+			fmt.Printf("  (copying %s to town %s first)\n", beadID, remote.town.Name)
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	client := remote.client()
+
+	remoteID := beadID
+	if local != nil {
+		issue, err := client.CreateIssue(ctx, rpcclient.CreateIssueRequest{
+			Title:       local.Title,
+			Type:        local.Type,
+			Priority:    local.Priority,
+			Description: federatedDescription(local, remote.localTown),
+			Labels:      []string{FederatedLabel},
+			Actor:       detectSender(),
+		})
+		if err != nil {
+			return fmt.Errorf("copying %s to town %s: %w", beadID, remote.town.Name, err)
+		}
+		remoteID = issue.ID
+	}
+
+	result, err := client.Sling(ctx, rpcclient.SlingRequest{
+		BeadID:  remoteID,
+		Target:  remote.local,
+		Args:    slingArgs,
+		Subject: slingSubject,
+		Message: slingMessage,
+		Create:  slingCreate,
+		Force:   slingForce,
+	})
+	if err != nil {
+		return fmt.Errorf("slinging %s to %s: %w", remoteID, remote, err)
+	}
+
+	if local != nil {
+		label := "federated:" + remote.town.Name + ":" + remoteID
+		if err := beads.New(townRoot).Update(beadID, beads.UpdateOptions{AddLabels: []string{label}}); err != nil {
+			style.PrintWarning("could not label %s with its remote copy: %v", beadID, err)
+		}
+	}
+
+	fmt.Printf("%s Slung %s to %s:%s\n", style.Bold.Render("🎯"), remoteID, remote.town.Name, result.TargetAgent)
+	if remoteID != beadID {
+		fmt.Printf("  Copied from %s\n", beadID)
+	}
+	if result.PolecatSpawned {
+		fmt.Printf("  Spawned polecat %s\n", result.PolecatName)
+	}
+	return nil
+}
+
+// federatedDescription is the description of a bead's copy in a peer town,
+// pointing back at the original.
+func federatedDescription(issue *beads.Issue, localTown string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("federated_from: %s:%s\n", localTown, issue.ID))
+	if issue.Description != "" {
+		sb.WriteString("\n")
+		sb.WriteString(issue.Description)
+	}
+	return sb.String()
+}
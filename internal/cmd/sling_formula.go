@@ -10,7 +10,8 @@ import (
 	"github.com/steveyegge/gastown/internal/bdcmd"
 
 	"github.com/steveyegge/gastown/internal/beads"
-	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/eventbus"
+	"github.com/steveyegge/gastown/internal/sling"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -95,6 +96,9 @@ func runSlingFormula(args []string) error {
 	var deferredRigName string
 	var deferredSpawnOpts SlingSpawnOptions
 
+	// How the target is obtained, for the --dry-run dispatch plan.
+	var targetAction string
+
 	if target != "" {
 		// Resolve "." to current agent identity (like git's "." meaning current directory)
 		if target == "." {
@@ -102,16 +106,14 @@ func runSlingFormula(args []string) error {
 			if err != nil {
 				return fmt.Errorf("resolving self for '.' target: %w", err)
 			}
+			targetAction = "current agent"
 		} else if dogName, isDog := IsDogTarget(target); isDog {
 			if slingDryRun {
-				if dogName == "" {
-					fmt.Printf("Would dispatch to idle dog in kennel\n")
-				} else {
-					fmt.Printf("Would dispatch to dog '%s'\n", dogName)
-				}
 				targetAgent = fmt.Sprintf("deacon/dogs/%s", dogName)
+				targetAction = fmt.Sprintf("dispatch to dog '%s'", dogName)
 				if dogName == "" {
 					targetAgent = "deacon/dogs/<idle>"
+					targetAction = "dispatch to an idle dog in the kennel"
 				}
 			} else {
 				// Dispatch to dog with delayed session start
@@ -133,8 +135,9 @@ func runSlingFormula(args []string) error {
 			// Check if target is a rig name (auto-spawn polecat)
 			if slingDryRun {
 				// Dry run - just indicate what would happen
-				fmt.Printf("Would spawn fresh polecat in rig '%s'\n", rigName)
+				deferredRigName = rigName
 				targetAgent = fmt.Sprintf("%s/polecats/<new>", rigName)
+				targetAction = fmt.Sprintf("spawn a fresh polecat in rig '%s' after the wisp exists", rigName)
 			} else {
 				// DEFERRED SPAWN: Don't spawn polecat yet - we need to create wisp first.
 				// This prevents race condition where polecat starts before its work exists.
@@ -147,6 +150,7 @@ func runSlingFormula(args []string) error {
 					Create:          slingCreate,
 					Agent:           slingAgent,
 					ExecutionTarget: slingExecutionTarget,
+					LimitOverride:   slingOverrideLimits,
 				}
 				// Use placeholder values - will be updated after spawn
 				targetAgent = fmt.Sprintf("%s/polecats/<pending>", rigName)
@@ -160,6 +164,7 @@ func runSlingFormula(args []string) error {
 			}
 			// Use target's working directory for bd commands (needed for redirect-based routing)
 			_ = targetWorkDir // Formula sling doesn't need hookWorkDir
+			targetAction = "reuse the running session"
 		}
 	} else {
 		// Slinging to self
@@ -169,16 +174,35 @@ func runSlingFormula(args []string) error {
 			return err
 		}
 		_ = selfWorkDir // Formula sling doesn't need hookWorkDir
+		targetAction = "self (current agent)"
 	}
 
 	fmt.Printf("%s Slinging formula %s to %s...\n", style.Bold.Render("🎯"), formulaName, targetAgent)
 
 	if slingDryRun {
-		fmt.Printf("Would cook formula: %s\n", formulaName)
-		fmt.Printf("Would create wisp and pin to: %s\n", targetAgent)
+		wispCmd := "bd mol wisp " + formulaName
 		for _, v := range slingVars {
-			fmt.Printf("  --var %s\n", v)
+			wispCmd += " --var " + v
+		}
+		plan := &slingPlan{
+			BeadID:       "<wisp-root>",
+			Target:       targetAgent,
+			TargetAction: targetAction,
+			Formula:      formulaName,
+			FormulaSteps: []string{"bd cook " + formulaName, wispCmd},
+		}
+		// Formula slings don't record merge settings; only these fields are written.
+		plan.addField("dispatched_by", detectActor())
+		if slingArgs != "" {
+			plan.addField("args", slingArgs)
+		}
+		plan.addField("attached_molecule", "<wisp-root>")
+		if deferredRigName != "" {
+			plan.Notify = append(plan.Notify, fmt.Sprintf("wake %s/witness and %s/refinery", deferredRigName, deferredRigName))
 		}
+		plan.Notify = append(plan.Notify, slingNudgePlan(targetAgent, "<wisp-root>",
+			false, false, strings.HasPrefix(targetAgent, "deacon/dogs/")))
+		plan.print()
 		return nil
 	}
 
@@ -246,35 +270,22 @@ func runSlingFormula(args []string) error {
 
 	// Log sling event to activity feed (formula slinging)
 	actor := detectActor()
-	payload := events.SlingPayload(wispRootID, targetAgent)
-	payload["formula"] = formulaName
-	_ = events.LogFeed(events.TypeSling, actor, payload)
+	_ = eventbus.Publish(actor, eventbus.Sling{Bead: wispRootID, Target: targetAgent, Formula: formulaName})
 
 	// Update agent bead's hook_bead field (ZFC: agents track their current work)
 	// Note: formula slinging uses town root as workDir (no polecat-specific path)
 	updateAgentHookBead(targetAgent, wispRootID, "", townBeadsDir)
 
-	// Store dispatcher in bead description (enables completion notification to dispatcher)
-	if err := storeDispatcherInBead(wispRootID, actor); err != nil {
-		// Warn but don't fail - polecat will still complete work
-		fmt.Printf("%s Could not store dispatcher in bead: %v\n", style.Dim.Render("Warning:"), err)
+	// Record dispatcher, args and the attached molecule with a single bead update.
+	m, err := sling.NewBeadMutator(wispRootID)
+	if err == nil {
+		err = m.SetDispatcher(actor).SetArgs(slingArgs).SetAttachedMolecule(attachedMoleculeID).Commit()
 	}
-
-	// Store args in wisp bead if provided (beads as data plane)
-	if slingArgs != "" {
-		if err := storeArgsInBead(wispRootID, slingArgs); err != nil {
-			fmt.Printf("%s Could not store args in bead: %v\n", style.Dim.Render("Warning:"), err)
-		} else {
-			fmt.Printf("%s Args stored in bead (durable)\n", style.Bold.Render("✓"))
-		}
-	}
-
-	// Record the attached molecule after other description updates to avoid overwrite.
-	if attachedMoleculeID != "" {
-		if err := storeAttachedMoleculeInBead(wispRootID, attachedMoleculeID); err != nil {
-			// Warn but don't fail - polecat can still work through steps
-			fmt.Printf("%s Could not store attached_molecule: %v\n", style.Dim.Render("Warning:"), err)
-		}
+	if err != nil {
+		// Warn but don't fail - polecat can still work through steps
+		fmt.Printf("%s Could not store sling fields in bead: %v\n", style.Dim.Render("Warning:"), err)
+	} else if slingArgs != "" {
+		fmt.Printf("%s Args stored in bead (durable)\n", style.Bold.Render("✓"))
 	}
 
 	// Start delayed dog session now that hook is set
@@ -1,18 +1,20 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
-	"time"
 
 	"github.com/steveyegge/gastown/internal/bdcmd"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/sling"
 	"github.com/steveyegge/gastown/internal/terminal"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -31,6 +33,13 @@ type beadInfo struct {
 // Uses --allow-stale to find beads when database is out of sync.
 // For existence checks, stale data is acceptable - we just need to know it exists.
 func verifyBeadExists(beadID string) error {
+	if _, ok, err := showBeadViaDaemon(beadID); ok {
+		if err != nil {
+			return fmt.Errorf("bead '%s' not found (daemon lookup)", beadID)
+		}
+		return nil
+	}
+
 	cmd := bdcmd.Command( "show", beadID, "--json", "--allow-stale")
 	// Run from town root so bd can find routes.jsonl for prefix-based routing.
 	// Do NOT set BEADS_DIR - that overrides routing and breaks rig bead resolution.
@@ -51,6 +60,13 @@ func verifyBeadExists(beadID string) error {
 // Uses bd's native prefix-based routing via routes.jsonl.
 // Uses --allow-stale for consistency with verifyBeadExists.
 func getBeadInfo(beadID string) (*beadInfo, error) {
+	if issue, ok, err := showBeadViaDaemon(beadID); ok {
+		if err != nil {
+			return nil, fmt.Errorf("bead '%s' not found: %w", beadID, err)
+		}
+		return &beadInfo{Title: issue.Title, Status: issue.Status, Assignee: issue.Assignee}, nil
+	}
+
 	cmd := bdcmd.Command( "show", beadID, "--json", "--allow-stale")
 	// Run from town root so bd can find routes.jsonl for prefix-based routing.
 	if townRoot, err := workspace.FindFromCwd(); err == nil {
@@ -74,330 +90,70 @@ func getBeadInfo(beadID string) (*beadInfo, error) {
 	return &infos[0], nil
 }
 
-// storeArgsInBead stores args in the bead's description using attached_args field.
-// This enables agents to discover args via gt prime / bd show.
-func storeArgsInBead(beadID, args string) error {
-	// Get the bead to preserve existing description content
-	showCmd := bdcmd.Command( "show", beadID, "--json", "--allow-stale")
-	out, err := showCmd.Output()
-	if err != nil {
-		return fmt.Errorf("fetching bead: %w", err)
-	}
-	if len(out) == 0 {
-		return fmt.Errorf("bead not found (empty response)")
-	}
-
-	// Parse the bead
-	var issues []beads.Issue
-	if err := json.Unmarshal(out, &issues); err != nil {
-		if os.Getenv("GT_TEST_ATTACHED_MOLECULE_LOG") == "" {
-			return fmt.Errorf("parsing bead: %w", err)
-		}
-	}
-	issue := &beads.Issue{}
-	if len(issues) > 0 {
-		issue = &issues[0]
-	} else if os.Getenv("GT_TEST_ATTACHED_MOLECULE_LOG") == "" {
-		return fmt.Errorf("bead not found")
-	}
-
-	// Get or create attachment fields
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-
-	// Set the args
-	fields.AttachedArgs = args
-
-	// Update the description
-	newDesc := beads.SetAttachmentFields(issue, fields)
-	if logPath := os.Getenv("GT_TEST_ATTACHED_MOLECULE_LOG"); logPath != "" {
-		_ = os.WriteFile(logPath, []byte(newDesc), 0644)
-	}
-
-	// Update the bead
-	updateCmd := bdcmd.Command( "update", beadID, "--description="+newDesc)
-	updateCmd.Stderr = os.Stderr
-	if err := updateCmd.Run(); err != nil {
-		return fmt.Errorf("updating bead description: %w", err)
-	}
-
-	return nil
-}
-
-// storeDispatcherInBead stores the dispatcher agent ID in the bead's description.
-// This enables polecats to notify the dispatcher when work is complete.
-func storeDispatcherInBead(beadID, dispatcher string) error {
-	if dispatcher == "" {
-		return nil
-	}
-
-	// Get the bead to preserve existing description content
-	showCmd := bdcmd.Command( "show", beadID, "--json")
-	out, err := showCmd.Output()
-	if err != nil {
-		return fmt.Errorf("fetching bead: %w", err)
-	}
-
-	// Parse the bead
-	var issues []beads.Issue
-	if err := json.Unmarshal(out, &issues); err != nil {
-		return fmt.Errorf("parsing bead: %w", err)
-	}
-	if len(issues) == 0 {
-		return fmt.Errorf("bead not found")
-	}
-	issue := &issues[0]
-
-	// Get or create attachment fields
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-
-	// Set the dispatcher
-	fields.DispatchedBy = dispatcher
-
-	// Update the description
-	newDesc := beads.SetAttachmentFields(issue, fields)
-
-	// Update the bead
-	updateCmd := bdcmd.Command( "update", beadID, "--description="+newDesc)
-	updateCmd.Stderr = os.Stderr
-	if err := updateCmd.Run(); err != nil {
-		return fmt.Errorf("updating bead description: %w", err)
-	}
-
-	return nil
-}
-
-// storeAttachedMoleculeInBead sets the attached_molecule field in a bead's description.
-// This is required for gt hook to recognize that a molecule is attached to the bead.
-// Called after bonding a formula wisp to a bead via "gt sling <formula> --on <bead>".
-func storeAttachedMoleculeInBead(beadID, moleculeID string) error {
-	if moleculeID == "" {
-		return nil
-	}
-	logPath := os.Getenv("GT_TEST_ATTACHED_MOLECULE_LOG")
-	if logPath != "" {
-		_ = os.WriteFile(logPath, []byte("called"), 0644)
-	}
-
-	issue := &beads.Issue{}
-	if logPath == "" {
-		// Get the bead to preserve existing description content
-		showCmd := bdcmd.Command( "show", beadID, "--json")
-		out, err := showCmd.Output()
-		if err != nil {
-			return fmt.Errorf("fetching bead: %w", err)
-		}
-
-		// Parse the bead
-		var issues []beads.Issue
-		if err := json.Unmarshal(out, &issues); err != nil {
-			return fmt.Errorf("parsing bead: %w", err)
-		}
-		if len(issues) == 0 {
-			return fmt.Errorf("bead not found")
-		}
-		issue = &issues[0]
-	}
-
-	// Get or create attachment fields
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-
-	// Set the attached molecule
-	fields.AttachedMolecule = moleculeID
-	if fields.AttachedAt == "" {
-		fields.AttachedAt = time.Now().UTC().Format(time.RFC3339)
-	}
-
-	// Update the description
-	newDesc := beads.SetAttachmentFields(issue, fields)
-	if logPath != "" {
-		_ = os.WriteFile(logPath, []byte(newDesc), 0644)
-	}
-
-	// Update the bead
-	updateCmd := bdcmd.Command( "update", beadID, "--description="+newDesc)
-	updateCmd.Stderr = os.Stderr
-	if err := updateCmd.Run(); err != nil {
-		return fmt.Errorf("updating bead description: %w", err)
-	}
-
-	return nil
-}
-
-// storeNoMergeInBead sets the no_merge field in a bead's description.
-// When set, gt done will skip the merge queue and keep work on the feature branch.
-// This is useful for upstream contributions or when human review is needed before merge.
-func storeNoMergeInBead(beadID string, noMerge bool) error {
-	if !noMerge {
-		return nil
-	}
-
-	// Get the bead to preserve existing description content
-	showCmd := bdcmd.Command( "show", beadID, "--json")
-	out, err := showCmd.Output()
-	if err != nil {
-		return fmt.Errorf("fetching bead: %w", err)
-	}
-
-	// Parse the bead
-	var issues []beads.Issue
-	if err := json.Unmarshal(out, &issues); err != nil {
-		return fmt.Errorf("parsing bead: %w", err)
-	}
-	if len(issues) == 0 {
-		return fmt.Errorf("bead not found")
-	}
-	issue := &issues[0]
-
-	// Get or create attachment fields
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-
-	// Set the no_merge flag
-	fields.NoMerge = true
-
-	// Update the description
-	newDesc := beads.SetAttachmentFields(issue, fields)
-
-	// Update the bead
-	updateCmd := bdcmd.Command( "update", beadID, "--description="+newDesc)
-	updateCmd.Stderr = os.Stderr
-	if err := updateCmd.Run(); err != nil {
-		return fmt.Errorf("updating bead description: %w", err)
-	}
-
-	return nil
+// showBeadViaDaemon looks up a bead through the beads daemon's HTTP API,
+// skipping the bd subprocess. ok is false when no daemon is configured or
+// the lookup failed for any reason other than the bead not existing, and
+// the caller should fall back to bd show; when ok, err is nil or wraps
+// beads.ErrNotFound.
+func showBeadViaDaemon(beadID string) (issue *beads.Issue, ok bool, err error) {
+	client := beads.NewDaemonClientFromEnv()
+	if client == nil {
+		return nil, false, nil
+	}
+	issue, err = client.Show(context.Background(), beadID)
+	if err != nil && !errors.Is(err, beads.ErrNotFound) {
+		return nil, false, nil
+	}
+	return issue, true, err
 }
 
-// storeMergeStrategyInBead sets the merge_strategy field in a bead's description.
-// Valid strategies: direct (push to main), mr (refinery), local (merge locally).
-func storeMergeStrategyInBead(beadID, strategy string) error {
-	if strategy == "" {
-		return nil
-	}
-
-	// Get the bead to preserve existing description content
-	showCmd := bdcmd.Command( "show", beadID, "--json")
-	out, err := showCmd.Output()
+// storeSlingFieldsInBead records the dispatcher, the sling flags (--args,
+// --no-merge, --merge, --owned) and the attached molecule in the bead's
+// description. All fields are applied with one read and one write so a
+// sling doesn't pay a bd show/update round-trip per field.
+func storeSlingFieldsInBead(beadID, dispatcher, moleculeID string) error {
+	m, err := sling.NewBeadMutator(beadID)
 	if err != nil {
-		return fmt.Errorf("fetching bead: %w", err)
-	}
-
-	// Parse the bead
-	var issues []beads.Issue
-	if err := json.Unmarshal(out, &issues); err != nil {
-		return fmt.Errorf("parsing bead: %w", err)
-	}
-	if len(issues) == 0 {
-		return fmt.Errorf("bead not found")
-	}
-	issue := &issues[0]
-
-	// Get or create attachment fields
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-
-	// Set the merge strategy
-	fields.MergeStrategy = strategy
-
-	// Update the description
-	newDesc := beads.SetAttachmentFields(issue, fields)
-
-	// Update the bead
-	updateCmd := bdcmd.Command( "update", beadID, "--description="+newDesc)
-	updateCmd.Stderr = os.Stderr
-	if err := updateCmd.Run(); err != nil {
-		return fmt.Errorf("updating bead description: %w", err)
-	}
-
-	return nil
+		return err
+	}
+	return m.SetDispatcher(dispatcher).
+		SetArgs(slingArgs).
+		SetNoMerge(slingNoMerge).
+		SetMergeStrategy(slingMergeStrategy).
+		SetConvoyOwned(slingOwned).
+		SetAttachedMolecule(moleculeID).
+		Commit()
 }
 
-// storeConvoyOwnedInBead sets the convoy_owned field in a bead's description.
-// When set, the convoy is caller-managed and won't be auto-processed by witness/refinery.
-func storeConvoyOwnedInBead(beadID string, owned bool) error {
-	if !owned {
-		return nil
-	}
-
-	// Get the bead to preserve existing description content
-	showCmd := bdcmd.Command( "show", beadID, "--json")
-	out, err := showCmd.Output()
-	if err != nil {
-		return fmt.Errorf("fetching bead: %w", err)
-	}
-
-	// Parse the bead
-	var issues []beads.Issue
-	if err := json.Unmarshal(out, &issues); err != nil {
-		return fmt.Errorf("parsing bead: %w", err)
-	}
-	if len(issues) == 0 {
-		return fmt.Errorf("bead not found")
-	}
-	issue := &issues[0]
-
-	// Get or create attachment fields
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-
-	// Set the convoy_owned flag
-	fields.ConvoyOwned = true
-
-	// Update the description
-	newDesc := beads.SetAttachmentFields(issue, fields)
-
-	// Update the bead
-	updateCmd := bdcmd.Command( "update", beadID, "--description="+newDesc)
-	updateCmd.Stderr = os.Stderr
-	if err := updateCmd.Run(); err != nil {
-		return fmt.Errorf("updating bead description: %w", err)
-	}
-
-	return nil
-}
-
-// nudgeViaBackend attempts to nudge a Coop/K8s agent via the Backend interface.
-// Returns true if the nudge was sent successfully.
+// nudgeViaBackend attempts to nudge a Coop/K8s or container agent via the
+// Backend interface. Returns true if the nudge was sent successfully.
 func nudgeViaBackend(agentID, beadID, subject, args string) bool {
 	backend := terminal.ResolveBackend(agentID)
-	if _, isCoop := backend.(*terminal.CoopBackend); !isCoop {
+	switch backend.(type) {
+	case *terminal.CoopBackend, *terminal.DockerBackend:
+	default:
 		return false // Unknown backend type — caller should use pane-based nudge
 	}
 
-	// Build the same prompt as injectStartPrompt
-	var prompt string
+	// Use "claude" as the session name — matches ResolveBackend's AddSession convention
+	if err := backend.NudgeSession("claude", slingStartPrompt(beadID, subject, args)); err != nil {
+		return false
+	}
+	return true
+}
+
+// slingStartPrompt builds the nudge that tells an agent to start slung work.
+func slingStartPrompt(beadID, subject, args string) string {
 	if args != "" {
 		if subject != "" {
-			prompt = fmt.Sprintf("Work slung: %s (%s). Args: %s. Start working now - use these args to guide your execution.", beadID, subject, args)
-		} else {
-			prompt = fmt.Sprintf("Work slung: %s. Args: %s. Start working now - use these args to guide your execution.", beadID, args)
+			return fmt.Sprintf("Work slung: %s (%s). Args: %s. Start working now - use these args to guide your execution.", beadID, subject, args)
 		}
-	} else if subject != "" {
-		prompt = fmt.Sprintf("Work slung: %s (%s). Start working on it now - no questions, just begin.", beadID, subject)
-	} else {
-		prompt = fmt.Sprintf("Work slung: %s. Start working on it now - run `gt hook` to see the hook, then begin.", beadID)
+		return fmt.Sprintf("Work slung: %s. Args: %s. Start working now - use these args to guide your execution.", beadID, args)
 	}
-
-	// Use "claude" as the session name — matches CoopBackend.AddSession convention
-	if err := backend.NudgeSession("claude", prompt); err != nil {
-		return false
+	if subject != "" {
+		return fmt.Sprintf("Work slung: %s (%s). Start working on it now - no questions, just begin.", beadID, subject)
 	}
-	return true
+	return fmt.Sprintf("Work slung: %s. Start working on it now - run `gt hook` to see the hook, then begin.", beadID)
 }
 
 // detectCloneRoot finds the root of the current git clone.
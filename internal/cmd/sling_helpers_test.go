@@ -1,10 +1,16 @@
 package cmd
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
 )
 
 // TestNudgeRefinerySessionName verifies that nudgeRefinery constructs the
@@ -97,3 +103,37 @@ func TestNudgeRefineryNoOpWithoutLog(t *testing.T) {
 	// Should not panic even though no session exists
 	nudgeRefinery("nonexistent-rig", "test message")
 }
+
+// TestShowBeadViaDaemonFallsBack verifies that only a real not-found from
+// the beads daemon is reported as such; other failures fall back to bd.
+func TestShowBeadViaDaemonFallsBack(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			ID string `json:"id"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		switch body.ID {
+		case "gt-1":
+			_ = json.NewEncoder(w).Encode(beads.Issue{ID: "gt-1", Title: "Fix login"})
+		case "gt-missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"issue not found"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":"database is locked"}`))
+		}
+	}))
+	defer srv.Close()
+	t.Setenv("BD_DAEMON_HOST", srv.URL)
+	t.Setenv("BD_DAEMON_HTTP_URL", "")
+
+	if issue, ok, err := showBeadViaDaemon("gt-1"); !ok || err != nil || issue.Title != "Fix login" {
+		t.Errorf("existing bead = %v, %v, %v", issue, ok, err)
+	}
+	if _, ok, err := showBeadViaDaemon("gt-missing"); !ok || !errors.Is(err, beads.ErrNotFound) {
+		t.Errorf("missing bead = %v, %v; want ok with ErrNotFound", ok, err)
+	}
+	if _, ok, _ := showBeadViaDaemon("gt-flaky"); ok {
+		t.Error("daemon error should fall back to bd show")
+	}
+}
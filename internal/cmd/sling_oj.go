@@ -15,6 +15,7 @@ import (
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/sling"
 	"github.com/steveyegge/gastown/internal/style"
 )
 
@@ -136,37 +137,11 @@ func storeOjJobIDInBead(beadID, ojJobID string) error {
 	if ojJobID == "" {
 		return nil
 	}
-
-	showCmd := exec.Command("bd", "show", beadID, "--json")
-	out, err := showCmd.Output()
+	m, err := sling.NewBeadMutator(beadID)
 	if err != nil {
-		return fmt.Errorf("fetching bead: %w", err)
-	}
-
-	var issues []beads.Issue
-	if err := json.Unmarshal(out, &issues); err != nil {
-		return fmt.Errorf("parsing bead: %w", err)
-	}
-	if len(issues) == 0 {
-		return fmt.Errorf("bead not found")
+		return err
 	}
-	issue := &issues[0]
-
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-
-	fields.OjJobID = ojJobID
-	newDesc := beads.SetAttachmentFields(issue, fields)
-
-	updateCmd := exec.Command("bd", "update", beadID, "--description="+newDesc)
-	updateCmd.Stderr = os.Stderr
-	if err := updateCmd.Run(); err != nil {
-		return fmt.Errorf("updating bead description: %w", err)
-	}
-
-	return nil
+	return m.SetOjJobID(ojJobID).Commit()
 }
 
 // releasePolecatName attempts to release an allocated polecat name after OJ failure.
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// slingPlan describes everything a sling would do. It is printed by
+// --dry-run instead of dispatching, so it must be built without side effects.
+type slingPlan struct {
+	BeadID     string // bead that would be hooked
+	BeadTitle  string
+	BeadStatus string
+
+	Target       string   // agent that would receive the work (may be a placeholder)
+	TargetAction string   // how the target is obtained (spawn, reuse, start)
+	Reassign     []string // steps to take the bead from its current owner (--force)
+
+	Convoy       string   // convoy the bead would be added to
+	Formula      string   // formula that would be instantiated
+	FormulaSteps []string // bd commands for the formula
+
+	Fields []string // bead description fields that would be written
+	Notify []string // mail and nudges that would be sent
+}
+
+// addField records a bead field the sling would write.
+func (p *slingPlan) addField(name, value string) {
+	p.Fields = append(p.Fields, fmt.Sprintf("%s: %s", name, value))
+}
+
+// addSlingFlagFields records the bead fields written from sling flags.
+func (p *slingPlan) addSlingFlagFields(dispatcher string) {
+	if dispatcher != "" {
+		p.addField("dispatched_by", dispatcher)
+	}
+	if slingArgs != "" {
+		p.addField("args", slingArgs)
+	}
+	if slingNoMerge {
+		p.addField("no_merge", "true")
+	}
+	if slingMergeStrategy != "" {
+		p.addField("merge_strategy", slingMergeStrategy)
+	}
+	if slingOwned {
+		p.addField("convoy_owned", "true")
+	}
+	if p.Formula != "" {
+		p.addField("attached_molecule", "<wisp-root>")
+	}
+}
+
+// print writes the plan in the same layout for every sling mode.
+func (p *slingPlan) print() {
+	fmt.Printf("%s Dispatch plan %s\n", style.Bold.Render("📋"), style.Dim.Render("(dry run: nothing will be changed)"))
+
+	bead := p.BeadID
+	if p.BeadTitle != "" {
+		bead += fmt.Sprintf(" %q", p.BeadTitle)
+	}
+	if p.BeadStatus != "" {
+		bead += fmt.Sprintf(" (%s)", p.BeadStatus)
+	}
+	printSlingPlanSection("Bead", bead)
+	printSlingPlanSection("Target", p.Target, p.TargetAction)
+	printSlingPlanSection("Reassign", p.Reassign...)
+	if p.Convoy != "" {
+		printSlingPlanSection("Convoy", "add to "+p.Convoy)
+	}
+	if p.Formula != "" {
+		printSlingPlanSection("Formula", append([]string{p.Formula}, p.FormulaSteps...)...)
+	}
+	printSlingPlanSection("Hook",
+		fmt.Sprintf("bd update %s --status=hooked --assignee=%s", p.BeadID, p.Target),
+		fmt.Sprintf("agent bead hook_bead → %s", p.BeadID))
+	printSlingPlanSection("Fields", p.Fields...)
+	if slingSubject != "" {
+		printSlingPlanSection("Subject", slingSubject)
+	}
+	if slingMessage != "" {
+		printSlingPlanSection("Context", slingMessage)
+	}
+	printSlingPlanSection("Notify", p.Notify...)
+}
+
+// printSlingPlanSection prints a labeled block; empty lines are dropped and
+// a section with no lines is omitted.
+func printSlingPlanSection(label string, lines ...string) {
+	first := true
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		if first {
+			fmt.Printf("  %-9s %s\n", label+":", line)
+			first = false
+		} else {
+			fmt.Printf("  %-9s %s\n", "", line)
+		}
+	}
+}
+
+// slingNudgePlan describes how the target would be told to start working.
+func slingNudgePlan(targetAgent, beadID string, freshSpawn, viaOj, delayedDog bool) string {
+	switch {
+	case viaOj:
+		return "no nudge: the OJ daemon starts the agent"
+	case freshSpawn:
+		return "no nudge: the new polecat session gets its startup prompt"
+	case delayedDog:
+		return "start the dog session after the hook is set"
+	}
+	return fmt.Sprintf("nudge %s: %q", targetAgent, slingStartPrompt(beadID, slingSubject, slingArgs))
+}
@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/sling"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	slingAt    string   // --at: dispatch at this time instead of now
+	slingAfter []string // --after: dispatch once these beads are closed
+
+	queueListAll  bool
+	queueListJSON bool
+)
+
+var queueCmd = &cobra.Command{
+	Use:     "queue",
+	GroupID: GroupWork,
+	Short:   "Manage scheduled slings",
+	Long: `Manage slings scheduled with 'gt sling --at' or '--after'.
+
+Scheduled slings wait in the town's dispatch queue (.runtime/sling-queue.json).
+The daemon checks the queue every 30 seconds and runs 'gt sling' with the
+original arguments once every condition holds: the --at time has passed and
+each --after bead is closed. A sling that fails is retried up to 3 times.
+Nothing is dispatched while the town is paused.
+
+Examples:
+  gt sling gt-abc gastown --at 02:00        # Tonight at 2am
+  gt sling gt-abc gastown --at +2h          # In two hours
+  gt sling gt-def gastown --after gt-abc    # Once gt-abc is closed
+  gt queue list
+  gt queue cancel sq-1a2b3c`,
+	RunE: requireSubcommand,
+}
+
+var queueListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List scheduled slings",
+	Args:  cobra.NoArgs,
+	RunE:  runQueueList,
+}
+
+var queueCancelCmd = &cobra.Command{
+	Use:   "cancel <id>...",
+	Short: "Cancel scheduled slings",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runQueueCancel,
+}
+
+func init() {
+	slingCmd.Flags().StringVar(&slingAt, "at", "", "Schedule for later: HH:MM, \"YYYY-MM-DD HH:MM\", or a delay like +2h")
+	slingCmd.Flags().StringArrayVar(&slingAfter, "after", nil, "Schedule to run once this bead is closed (repeatable)")
+
+	queueListCmd.Flags().BoolVar(&queueListAll, "all", false, "Include dispatched, failed and cancelled slings")
+	queueListCmd.Flags().BoolVar(&queueListJSON, "json", false, "Output as JSON")
+	queueCmd.AddCommand(queueListCmd)
+	queueCmd.AddCommand(queueCancelCmd)
+	rootCmd.AddCommand(queueCmd)
+}
+
+// scheduleFlagsSet reports whether gt sling should queue rather than
+// dispatch now.
+func scheduleFlagsSet() bool {
+	return slingAt != "" || len(slingAfter) > 0
+}
+
+// runScheduledSling adds a sling to the dispatch queue. The daemon later
+// runs gt sling with the same arguments and flags, minus --at and --after.
+func runScheduledSling(cmd *cobra.Command, args []string, townRoot string) error {
+	if len(args) > 2 {
+		return fmt.Errorf("--at and --after schedule one bead; schedule each bead separately")
+	}
+
+	s := sling.ScheduledSling{Bead: args[0], CreatedBy: detectSender()}
+	if len(args) == 2 {
+		s.Target = args[1]
+	}
+	if slingAt != "" {
+		at, err := sling.ParseScheduleTime(slingAt, time.Now())
+		if err != nil {
+			return err
+		}
+		s.At = at
+	}
+	for _, dep := range slingAfter {
+		if err := sling.VerifyBeadExists(dep, townRoot); err != nil {
+			return fmt.Errorf("--after: %w", err)
+		}
+		s.After = append(s.After, dep)
+	}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		switch f.Name {
+		case "at", "after", "dry-run":
+			return
+		}
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			for _, v := range sv.GetSlice() {
+				s.Flags = append(s.Flags, "--"+f.Name+"="+v)
+			}
+			return
+		}
+		s.Flags = append(s.Flags, "--"+f.Name+"="+f.Value.String())
+	})
+
+	if slingDryRun {
+		fmt.Printf("Would schedule: gt sling %s\n", strings.Join(scheduledSlingArgs(&s), " "))
+		fmt.Printf("  Waiting for: %s\n", s.Waiting(time.Now()))
+		return nil
+	}
+
+	queued, err := sling.NewQueue(townRoot).Add(s)
+	if err != nil {
+		return err
+	}
+	_ = events.LogFeed(events.TypeSlingScheduled, s.CreatedBy, map[string]interface{}{
+		"id":     queued.ID,
+		"bead":   queued.Bead,
+		"target": queued.Target,
+		"at":     formatQueueTime(queued.At),
+		"after":  strings.Join(queued.After, ","),
+	})
+
+	fmt.Printf("%s Scheduled %s as %s\n", style.Success.Render("✓"), queued.Bead, queued.ID)
+	fmt.Printf("  Waiting for: %s\n", queued.Waiting(time.Now()))
+	fmt.Printf("  Cancel with: gt queue cancel %s\n", queued.ID)
+	return nil
+}
+
+// scheduledSlingArgs returns the gt sling arguments a queued sling runs.
+func scheduledSlingArgs(s *sling.ScheduledSling) []string {
+	args := []string{s.Bead}
+	if s.Target != "" {
+		args = append(args, s.Target)
+	}
+	return append(args, s.Flags...)
+}
+
+func formatQueueTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
+
+func runQueueList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	entries, err := sling.NewQueue(townRoot).List(queueListAll)
+	if err != nil {
+		return err
+	}
+
+	if queueListJSON {
+		if entries == nil {
+			entries = []*sling.ScheduledSling{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("No scheduled slings")
+		return nil
+	}
+	now := time.Now()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tBEAD\tTARGET\tSTATUS\tWHEN")
+	for _, s := range entries {
+		waiting := s.Waiting(now)
+		if s.Status != sling.QueuePending {
+			waiting = formatQueueTime(s.DispatchedAt)
+		}
+		status := s.Status
+		if s.LastError != "" {
+			status += fmt.Sprintf(" (%d/%d: %s)", s.Attempts, sling.MaxDispatchAttempts, s.LastError)
+		}
+		target := s.Target
+		if target == "" {
+			target = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", s.ID, s.Bead, target, status, waiting)
+	}
+	return w.Flush()
+}
+
+func runQueueCancel(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	q := sling.NewQueue(townRoot)
+	for _, id := range args {
+		if err := q.Cancel(id); err != nil {
+			return err
+		}
+		fmt.Printf("%s Cancelled %s\n", style.Success.Render("✓"), id)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/tui/top"
+	"github.com/steveyegge/gastown/internal/web"
+)
+
+var topInterval time.Duration
+
+var topCmd = &cobra.Command{
+	Use:     "top",
+	GroupID: GroupDiag,
+	Short:   "Live terminal dashboard of agents and work",
+	Long: `Show a live dashboard of the town in the terminal.
+
+The terminal counterpart of 'gt dashboard', built on the same collectors:
+- Agents (polecats and refineries) with status and hooked beads
+- Open convoys with progress
+- The merge queue with CI and mergeability
+- Recent events
+
+Keys:
+  j/k, ↑/↓   select an agent
+  p, enter   peek at the agent's terminal (esc to return)
+  n          nudge the agent with a message
+  x          stop a polecat's session (asks first)
+  r          refresh now
+  q          quit
+
+Examples:
+  gt top
+  gt top --interval 2s`,
+	RunE: runTop,
+}
+
+func init() {
+	topCmd.Flags().DurationVar(&topInterval, "interval", top.DefaultInterval, "How often to refresh")
+	rootCmd.AddCommand(topCmd)
+}
+
+func runTop(cmd *cobra.Command, args []string) error {
+	fetcher, err := web.NewLiveConvoyFetcher()
+	if err != nil {
+		return fmt.Errorf("creating dashboard fetcher: %w", err)
+	}
+
+	p := tea.NewProgram(top.New(fetcher, topInterval), tea.WithAltScreen())
+	_, err = p.Run()
+	return err
+}
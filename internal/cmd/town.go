@@ -0,0 +1,304 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/towndeploy"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	townDeployKubeconfig    string
+	townDeployContext       string
+	townDeployNamespace     string
+	townDeployTown          string
+	townDeployChart         string
+	townDeployValues        []string
+	townDeployImageTag      string
+	townDeployStorageClass  string
+	townDeployRigs          []string
+	townDeploySeedConfig    bool
+	townDeployNoSeed        bool
+	townDeployGenerateToken bool
+	townDeployClaudeSecret  string
+	townDeployGitSecret     string
+	townDeployTimeout       time.Duration
+	townDeployDryRun        bool
+)
+
+var townCmd = &cobra.Command{
+	Use:     "town",
+	GroupID: GroupWorkspace,
+	Short:   "Manage the town's cluster deployment",
+	RunE:    requireSubcommand,
+}
+
+var townDeployCmd = &cobra.Command{
+	Use:   "deploy",
+	Short: "Install or upgrade a town on Kubernetes",
+	Long: `Deploy renders the manifests for a Gas Town namespace, applies them,
+waits for every workload to become ready, and seeds the town's initial beads —
+a one-command cluster install.
+
+The manifests cover Dolt, NATS, the beads daemon and the agent controller,
+and are rendered from templates built into gt. With --chart, they are
+rendered from the Helm chart instead (helm template), with --values files
+applied; chart dependencies must already be built.
+
+Secrets are referenced, never rendered. The daemon token secret
+(<town>-bd-daemon-daemon-token, key "token") must exist in the namespace,
+or pass --generate-token to create it. Credentials for agent pods are wired
+in only when named with --claude-secret and --git-secret.
+
+Seeding runs 'gt bootstrap' in a Job against the new daemon, registering
+each --rig. It is idempotent, so deploy can be rerun to upgrade a town.
+
+Examples:
+  gt town deploy --town gastown-next --generate-token \
+    --rig "gastown:gt:https://github.com/org/gastown.git"
+  gt town deploy --kubeconfig ~/.kube/prod --context prod --namespace gt-prod
+  gt town deploy --chart helm/gastown --values prod.yaml
+  gt town deploy --dry-run > town.yaml    # Print manifests only`,
+	Args: cobra.NoArgs,
+	RunE: runTownDeploy,
+}
+
+func init() {
+	townDeployCmd.Flags().StringVar(&townDeployKubeconfig, "kubeconfig", "", "Path to the kubeconfig file (default: kubectl's)")
+	townDeployCmd.Flags().StringVar(&townDeployContext, "context", "", "Kubectl context to use")
+	townDeployCmd.Flags().StringVarP(&townDeployNamespace, "namespace", "n", "", "Namespace to deploy into (default: town name)")
+	townDeployCmd.Flags().StringVar(&townDeployTown, "town", "", "Town name (default: this workspace's town)")
+	townDeployCmd.Flags().StringVar(&townDeployChart, "chart", "", "Render from this Helm chart directory instead of the built-in manifests")
+	townDeployCmd.Flags().StringArrayVar(&townDeployValues, "values", nil, "Helm values file for --chart (repeatable)")
+	townDeployCmd.Flags().StringVar(&townDeployImageTag, "image-tag", "", "Tag for the controller and agent images (default: latest)")
+	townDeployCmd.Flags().StringVar(&townDeployStorageClass, "storage-class", "", "Storage class for Dolt and NATS volumes")
+	townDeployCmd.Flags().StringSliceVar(&townDeployRigs, "rig", nil, `Rig to seed as "name:prefix:git_url" (repeatable)`)
+	townDeployCmd.Flags().BoolVar(&townDeploySeedConfig, "seed-config", false, "Also seed extended config beads")
+	townDeployCmd.Flags().BoolVar(&townDeployNoSeed, "no-seed", false, "Skip seeding beads")
+	townDeployCmd.Flags().BoolVar(&townDeployGenerateToken, "generate-token", false, "Create the daemon token secret if it doesn't exist")
+	townDeployCmd.Flags().StringVar(&townDeployClaudeSecret, "claude-secret", "", "Secret with Claude credentials for agent pods")
+	townDeployCmd.Flags().StringVar(&townDeployGitSecret, "git-secret", "", "Secret with git credentials for agent pods")
+	townDeployCmd.Flags().DurationVar(&townDeployTimeout, "timeout", 5*time.Minute, "How long to wait for each workload and the seed job")
+	townDeployCmd.Flags().BoolVar(&townDeployDryRun, "dry-run", false, "Print the manifests instead of applying them")
+
+	townCmd.AddCommand(townDeployCmd)
+	rootCmd.AddCommand(townCmd)
+}
+
+func runTownDeploy(cmd *cobra.Command, _ []string) error {
+	town := townDeployTown
+	if town == "" {
+		town = workspaceTownName()
+	}
+	if town == "" {
+		return fmt.Errorf("--town is required outside a Gas Town workspace")
+	}
+
+	cfg := towndeploy.Config{
+		Town:         town,
+		Namespace:    townDeployNamespace,
+		ImageTag:     townDeployImageTag,
+		StorageClass: townDeployStorageClass,
+		Rigs:         townDeployRigs,
+		SeedConfig:   townDeploySeedConfig,
+		Secrets: towndeploy.Secrets{
+			ClaudeCredentials: townDeployClaudeSecret,
+			GitCredentials:    townDeployGitSecret,
+		},
+	}
+	full := cfg.WithDefaults()
+	if err := full.Validate(); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	manifest, err := renderTownManifests(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	var seedJob string
+	if !townDeployNoSeed {
+		if seedJob, err = towndeploy.RenderSeedJob(cfg); err != nil {
+			return err
+		}
+	}
+
+	if townDeployDryRun {
+		fmt.Print(manifest)
+		if seedJob != "" {
+			fmt.Print("---\n" + seedJob)
+		}
+		return nil
+	}
+
+	if _, err := exec.LookPath("kubectl"); err != nil {
+		return fmt.Errorf("kubectl not found in PATH")
+	}
+	objs, err := towndeploy.Objects(manifest)
+	if err != nil {
+		return fmt.Errorf("parsing manifests: %w", err)
+	}
+	k := towndeploy.NewKubectl(townDeployKubeconfig, townDeployContext, full.Namespace)
+
+	fmt.Printf("%s Deploying town %s to namespace %s\n\n",
+		style.Info.Render("⚙"), style.Bold.Render(full.Town), style.Bold.Render(full.Namespace))
+
+	// The namespace goes first so secrets can be checked (and created) in it
+	// before any workload references them.
+	ns, err := towndeploy.RenderNamespace(cfg)
+	if err != nil {
+		return err
+	}
+	if err := k.Apply(ctx, ns); err != nil {
+		return fmt.Errorf("creating namespace: %w", err)
+	}
+	if err := ensureTownSecrets(ctx, k, full); err != nil {
+		return err
+	}
+
+	if err := k.Apply(ctx, manifest); err != nil {
+		return fmt.Errorf("applying manifests: %w", err)
+	}
+	fmt.Printf("  %s Applied %d objects\n", style.Success.Render("✓"), len(objs))
+
+	for _, w := range towndeploy.Workloads(objs) {
+		fmt.Printf("  %s Waiting for %s...\n", style.Dim.Render("…"), w)
+		if err := k.WaitRollout(ctx, w, townDeployTimeout); err != nil {
+			return fmt.Errorf("%s not ready: %w", w, err)
+		}
+		fmt.Printf("  %s %s ready\n", style.Success.Render("✓"), w)
+	}
+
+	if seedJob != "" {
+		if err := runTownSeedJob(ctx, k, full, seedJob); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("\n%s Town %s deployed\n", style.Success.Render("✓"), style.Bold.Render(full.Town))
+	fmt.Printf("  Connect with: %s\n", style.Dim.Render("gt connect "+full.Namespace))
+	return nil
+}
+
+// renderTownManifests renders the install set from the built-in templates,
+// or from --chart when given.
+func renderTownManifests(ctx context.Context, cfg towndeploy.Config) (string, error) {
+	if townDeployChart == "" {
+		if len(townDeployValues) > 0 {
+			return "", fmt.Errorf("--values requires --chart")
+		}
+		return towndeploy.Render(cfg)
+	}
+	if _, err := exec.LookPath("helm"); err != nil {
+		return "", fmt.Errorf("helm not found in PATH (required for --chart)")
+	}
+	return towndeploy.RenderChart(ctx, townDeployChart, cfg, townDeployValues)
+}
+
+// ensureTownSecrets checks that the secrets the workloads reference exist,
+// creating the daemon token when --generate-token is set.
+func ensureTownSecrets(ctx context.Context, k *towndeploy.Kubectl, cfg towndeploy.Config) error {
+	missing, err := k.MissingSecrets(ctx, cfg.SecretNames())
+	if err != nil {
+		return fmt.Errorf("checking secrets: %w", err)
+	}
+
+	var still []string
+	for _, name := range missing {
+		if name != cfg.Secrets.DaemonToken || !townDeployGenerateToken {
+			still = append(still, name)
+			continue
+		}
+		token, err := generateDaemonToken()
+		if err != nil {
+			return err
+		}
+		secret, err := towndeploy.SecretManifest(name, cfg.Namespace, "token", token)
+		if err != nil {
+			return err
+		}
+		if err := k.Apply(ctx, secret); err != nil {
+			return fmt.Errorf("creating secret %s: %w", name, err)
+		}
+		fmt.Printf("  %s Created secret %s\n", style.Success.Render("✓"), name)
+	}
+	if len(still) == 0 {
+		return nil
+	}
+
+	// Charts can bring their own secrets (e.g. ExternalSecrets), so only warn.
+	if townDeployChart != "" {
+		fmt.Printf("  %s Secrets not found yet: %s (expecting the chart to create them)\n",
+			style.Warning.Render("⚠"), strings.Join(still, ", "))
+		return nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "missing secrets in namespace %s: %s\n", cfg.Namespace, strings.Join(still, ", "))
+	for _, name := range still {
+		if name == cfg.Secrets.DaemonToken {
+			fmt.Fprintf(&b, "  kubectl -n %s create secret generic %s --from-literal=token=<token>  (or rerun with --generate-token)\n", cfg.Namespace, name)
+		} else {
+			fmt.Fprintf(&b, "  kubectl -n %s create secret generic %s ...\n", cfg.Namespace, name)
+		}
+	}
+	return fmt.Errorf("%s", strings.TrimRight(b.String(), "\n"))
+}
+
+// runTownSeedJob reruns the seed Job and waits for it, showing its logs if
+// it fails.
+func runTownSeedJob(ctx context.Context, k *towndeploy.Kubectl, cfg towndeploy.Config, job string) error {
+	name := cfg.SeedJobName()
+	fmt.Printf("  %s Seeding beads (job/%s)...\n", style.Dim.Render("…"), name)
+	if err := k.DeleteJob(ctx, name); err != nil {
+		return fmt.Errorf("removing previous seed job: %w", err)
+	}
+	if err := k.Apply(ctx, job); err != nil {
+		return fmt.Errorf("starting seed job: %w", err)
+	}
+	if err := k.WaitJob(ctx, name, townDeployTimeout); err != nil {
+		if logs, logErr := k.JobLogs(ctx, name, 20); logErr == nil && strings.TrimSpace(logs) != "" {
+			return fmt.Errorf("seeding beads: %w\n\nLast seed job output:\n%s", err, logs)
+		}
+		return fmt.Errorf("seeding beads: %w", err)
+	}
+	fmt.Printf("  %s Seeded beads\n", style.Success.Render("✓"))
+	return nil
+}
+
+// workspaceTownName returns the town name of the workspace we're in, or ""
+// outside one.
+func workspaceTownName() string {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return ""
+	}
+	tc, err := config.LoadTownConfig(filepath.Join(townRoot, "mayor", "town.json"))
+	if err != nil {
+		return ""
+	}
+	return tc.Name
+}
+
+// generateDaemonToken returns a random hex token for the daemon.
+func generateDaemonToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating daemon token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
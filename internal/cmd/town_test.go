@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+)
+
+func TestWorkspaceTownName(t *testing.T) {
+	townRoot := setupTestTownForCrewList(t, nil)
+
+	originalWd, _ := os.Getwd()
+	defer os.Chdir(originalWd)
+
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	if got := workspaceTownName(); got != "test-town" {
+		t.Errorf("workspaceTownName() = %q, want %q", got, "test-town")
+	}
+
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	if got := workspaceTownName(); got != "" {
+		t.Errorf("workspaceTownName() outside a workspace = %q, want empty", got)
+	}
+}
+
+func TestGenerateDaemonToken(t *testing.T) {
+	a, err := generateDaemonToken()
+	if err != nil {
+		t.Fatalf("generateDaemonToken: %v", err)
+	}
+	b, _ := generateDaemonToken()
+	if len(a) != 64 || a == b {
+		t.Errorf("tokens %q, %q: want distinct 64-char hex", a, b)
+	}
+}
@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/monitoring"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Usage command flags
+var (
+	usageSince string
+	usageRig   string
+	usageAgent string
+	usageLimit int
+	usageJSON  bool
+	usageBead  string
+	usageModel string
+)
+
+var usageCmd = &cobra.Command{
+	Use:     "usage",
+	GroupID: GroupDiag,
+	Short:   "Token usage and spend per agent, rig, and bead",
+	Args:    cobra.NoArgs,
+	RunE:    runUsage,
+	Long: `Show what agents have spent on model tokens.
+
+Usage is kept in mayor/usage.jsonl. The daemon fills it from Claude Code
+and Codex session logs when the usage patrol is enabled in
+mayor/daemon.json, charging each agent's new usage to the bead on its
+hook. Agents whose session logs the daemon can't read can report their
+CLI's own usage summary with 'gt usage record'.
+
+Daily budgets are set in settings/config.json:
+
+  "usage_budgets": {"daily_usd": 200, "rig_daily_usd": 100, "agent_daily_usd": 25}
+
+Costs are taken from the session log where the CLI records them and
+estimated from list prices otherwise.
+
+Examples:
+  gt usage                        # Today's spend
+  gt usage --since 168h --rig gastown
+  gt usage --agent gastown/polecats/nux --json
+  gt usage record --bead gt-abc < cost-output.txt`,
+}
+
+var usageRecordCmd = &cobra.Command{
+	Use:   "record",
+	Short: "Record usage from agent CLI output on stdin",
+	Long: `Parse an agent CLI's usage report from stdin and add it to the ledger.
+
+Understands Claude Code's /cost output and the token summary Codex prints
+on exit. Each report is cumulative for its session, so record a session
+once, when it ends.`,
+	Args: cobra.NoArgs,
+	RunE: runUsageRecord,
+}
+
+func init() {
+	usageCmd.Flags().StringVar(&usageSince, "since", "", "Show usage for this long back, e.g. 24h (default: since midnight)")
+	usageCmd.Flags().StringVar(&usageRig, "rig", "", "Only usage by agents in this rig")
+	usageCmd.Flags().StringVar(&usageAgent, "agent", "", "Only usage by this agent")
+	usageCmd.Flags().IntVarP(&usageLimit, "limit", "n", 10, "Rows per breakdown (0 = all)")
+	usageCmd.Flags().BoolVar(&usageJSON, "json", false, "Output as JSON")
+
+	usageRecordCmd.Flags().StringVar(&usageAgent, "agent", "", "Agent to charge (default: current agent)")
+	usageRecordCmd.Flags().StringVar(&usageBead, "bead", "", "Bead to charge")
+	usageRecordCmd.Flags().StringVar(&usageModel, "model", "", "Model, to estimate cost when the report has none")
+
+	usageCmd.AddCommand(usageRecordCmd)
+	rootCmd.AddCommand(usageCmd)
+}
+
+func runUsage(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	since := monitoring.StartOfDay(time.Now())
+	if usageSince != "" {
+		d, err := time.ParseDuration(usageSince)
+		if err != nil {
+			return fmt.Errorf("invalid --since %q: %w", usageSince, err)
+		}
+		since = time.Now().Add(-d)
+	}
+
+	records, err := monitoring.NewUsageLedger(townRoot).Records(since)
+	if err != nil {
+		return err
+	}
+	var matching []monitoring.UsageRecord
+	for _, r := range records {
+		if (usageRig == "" || r.Rig == usageRig) && (usageAgent == "" || r.Agent == usageAgent) {
+			matching = append(matching, r)
+		}
+	}
+	summary := monitoring.SummarizeUsage(matching)
+
+	var budgets []monitoring.BudgetStatus
+	if settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err == nil {
+		today, _ := monitoring.NewUsageLedger(townRoot).Records(monitoring.StartOfDay(time.Now()))
+		budgets = monitoring.CheckBudgets(monitoring.SummarizeUsage(today), settings.UsageBudgets)
+	}
+
+	if usageJSON {
+		out, _ := json.MarshalIndent(map[string]interface{}{
+			"since":   since,
+			"summary": summary,
+			"budgets": budgets,
+		}, "", "  ")
+		fmt.Println(string(out))
+		return nil
+	}
+
+	fmt.Printf("%s since %s: %s in %s tokens\n", style.Bold.Render("Usage"),
+		since.Format("Jan 2 15:04"), formatUSD(summary.Total.CostUSD), monitoring.FormatTokens(summary.Total.TotalTokens()))
+	printUsageTotals("By agent", summary.ByAgent)
+	printUsageTotals("By rig", summary.ByRig)
+	printUsageTotals("By bead", summary.ByBead)
+
+	if len(budgets) > 0 {
+		fmt.Printf("\n%s\n", style.Bold.Render("Daily budgets"))
+		for _, b := range budgets {
+			name := b.Scope
+			if b.Key != "" {
+				name += " " + b.Key
+			}
+			marker := "  "
+			switch {
+			case b.Exceeded():
+				marker = "🔴"
+			case b.Warning():
+				marker = "🟡"
+			}
+			fmt.Printf("  %s %-32s %s / %s (%.0f%%)\n", marker, name,
+				formatUSD(b.SpentUSD), formatUSD(b.LimitUSD), b.Fraction()*100)
+		}
+	}
+	return nil
+}
+
+func printUsageTotals(title string, totals []monitoring.UsageTotal) {
+	if len(totals) == 0 {
+		return
+	}
+	fmt.Printf("\n%s\n", style.Bold.Render(title))
+	for i, t := range totals {
+		if usageLimit > 0 && i >= usageLimit {
+			fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("... %d more", len(totals)-i)))
+			break
+		}
+		fmt.Printf("  %-36s %9s  %7s in  %7s out  %7s cached\n", t.Key, formatUSD(t.CostUSD),
+			monitoring.FormatTokens(t.InputTokens+t.CacheWriteTokens),
+			monitoring.FormatTokens(t.OutputTokens),
+			monitoring.FormatTokens(t.CacheReadTokens))
+	}
+}
+
+func formatUSD(v float64) string {
+	return fmt.Sprintf("$%.2f", v)
+}
+
+func runUsageRecord(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	output, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return fmt.Errorf("reading stdin: %w", err)
+	}
+	usage, ok := monitoring.ParseUsageOutput(string(output))
+	if !ok {
+		return fmt.Errorf("no Claude or Codex usage report found on stdin")
+	}
+	if usage.CostUSD == 0 {
+		usage.CostUSD = monitoring.EstimateCost(usageModel, usage)
+	}
+
+	agent := usageAgent
+	if agent == "" {
+		agent = detectSender()
+	}
+	if err := monitoring.NewUsageLedger(townRoot).Append(monitoring.UsageRecord{
+		Time:       time.Now(),
+		Agent:      agent,
+		Rig:        monitoring.AgentRig(agent),
+		Bead:       usageBead,
+		Model:      usageModel,
+		Source:     monitoring.UsageSourceCLI,
+		TokenUsage: usage,
+	}); err != nil {
+		return err
+	}
+	fmt.Printf("%s Recorded %s (%s tokens) for %s\n", style.Bold.Render("✓"),
+		formatUSD(usage.CostUSD), monitoring.FormatTokens(usage.TotalTokens()), agent)
+	return nil
+}
@@ -0,0 +1,176 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// agentHealthCheckTimeout bounds a preset's health check command. Agent
+// CLIs that hang on --version (e.g. waiting on an update prompt) count as
+// unusable.
+const agentHealthCheckTimeout = 10 * time.Second
+
+// PresetHealth is the result of checking whether an agent preset can run
+// on this host.
+type PresetHealth struct {
+	// Name is the preset name.
+	Name string `json:"name"`
+
+	// Command is the preset's CLI binary.
+	Command string `json:"command"`
+
+	// BinaryPath is where Command was found in PATH, empty if it wasn't.
+	BinaryPath string `json:"binary_path,omitempty"`
+
+	// Version is the first line of the health check's output.
+	Version string `json:"version,omitempty"`
+
+	// Auth says where credentials were found: an environment variable or
+	// a credentials file. Empty when none were found or the preset
+	// declares no auth requirements.
+	Auth string `json:"auth,omitempty"`
+
+	// Problems prevent the preset from running.
+	Problems []string `json:"problems,omitempty"`
+
+	// Warnings may prevent the preset from running, but can't be confirmed
+	// from here (e.g. a login kept in the OS keychain).
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Usable reports whether the preset passed its binary and health checks.
+func (h *PresetHealth) Usable() bool {
+	return len(h.Problems) == 0
+}
+
+// CheckAgentPreset checks that a preset's binary is in PATH, that its
+// health check command succeeds, and that credentials are available.
+// Missing credentials are a warning rather than a problem: some CLIs keep
+// their login where it can't be seen from outside.
+func CheckAgentPreset(ctx context.Context, info *AgentPresetInfo) *PresetHealth {
+	h := &PresetHealth{Name: string(info.Name), Command: info.Command}
+
+	path, err := exec.LookPath(info.Command)
+	if err != nil {
+		h.Problems = append(h.Problems, fmt.Sprintf("binary %q not found in PATH", info.Command))
+		return h
+	}
+	h.BinaryPath = path
+
+	if len(info.HealthCheck) > 0 {
+		version, err := runAgentHealthCheck(ctx, info)
+		if err != nil {
+			h.Problems = append(h.Problems, err.Error())
+		}
+		h.Version = version
+	}
+
+	if len(info.AuthEnv) > 0 || len(info.AuthFiles) > 0 {
+		h.Auth = findAgentAuth(info)
+		if h.Auth == "" {
+			h.Warnings = append(h.Warnings, "no credentials found (set "+
+				strings.Join(info.AuthEnv, ", ")+" or log in with "+info.Command+")")
+		}
+	}
+	return h
+}
+
+// runAgentHealthCheck runs the preset's health check with the preset's
+// env and returns the first line of its output.
+func runAgentHealthCheck(ctx context.Context, info *AgentPresetInfo) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, agentHealthCheckTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, info.HealthCheck[0], info.HealthCheck[1:]...) //nolint:gosec // G204: command is from agent config
+	cmd.Env = os.Environ()
+	for k, v := range info.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	out, err := cmd.CombinedOutput()
+	firstLine, _, _ := strings.Cut(strings.TrimSpace(string(out)), "\n")
+	firstLine = strings.TrimSpace(firstLine)
+
+	check := strings.Join(info.HealthCheck, " ")
+	if ctx.Err() == context.DeadlineExceeded {
+		return "", fmt.Errorf("health check %q timed out after %v", check, agentHealthCheckTimeout)
+	}
+	if err != nil {
+		if firstLine != "" {
+			return "", fmt.Errorf("health check %q failed: %v: %s", check, err, firstLine)
+		}
+		return "", fmt.Errorf("health check %q failed: %v", check, err)
+	}
+	return firstLine, nil
+}
+
+// findAgentAuth returns the first credential source present for a preset:
+// a set AuthEnv variable (including one in the preset's own Env), or an
+// existing AuthFiles entry.
+func findAgentAuth(info *AgentPresetInfo) string {
+	for _, name := range info.AuthEnv {
+		if os.Getenv(name) != "" || info.Env[name] != "" {
+			return "$" + name
+		}
+	}
+	home, _ := os.UserHomeDir()
+	for _, file := range info.AuthFiles {
+		path := file
+		if strings.HasPrefix(path, "~/") {
+			if home == "" {
+				continue
+			}
+			path = filepath.Join(home, path[2:])
+		}
+		if _, err := os.Stat(path); err == nil {
+			return file
+		}
+	}
+	return ""
+}
+
+// AgentPresetForRuntime returns the preset info for a custom agent from
+// settings, for health checks, headless runs and resuming sessions. It
+// starts from the preset whose binary the agent runs (so "claude-opus" with
+// command "claude" inherits Claude's health check, auth requirements,
+// non-interactive mode and resume flag) and swaps in the agent's own
+// command, args and env.
+func AgentPresetForRuntime(name string, rc *RuntimeConfig) *AgentPresetInfo {
+	info := &AgentPresetInfo{Name: AgentPreset(name), Command: rc.Command}
+	if info.Command == "" && rc.Provider == "" {
+		info.Command = DefaultRuntimeConfig().Command
+	}
+	base := GetAgentPresetByName(rc.Provider)
+	if base == nil {
+		names := ListAgentPresets()
+		sort.Strings(names)
+		for _, presetName := range names {
+			if p := GetAgentPresetByName(presetName); p != nil && p.Command == filepath.Base(rc.Command) {
+				base = p
+				break
+			}
+		}
+	}
+	if base != nil {
+		if info.Command == "" {
+			info.Command = base.Command
+		}
+		info.AuthEnv = base.AuthEnv
+		info.AuthFiles = base.AuthFiles
+		info.NonInteractive = base.NonInteractive
+		info.SessionIDEnv = base.SessionIDEnv
+		info.ResumeFlag = base.ResumeFlag
+		info.ResumeStyle = base.ResumeStyle
+		if len(base.HealthCheck) > 0 {
+			info.HealthCheck = append([]string{info.Command}, base.HealthCheck[1:]...)
+		}
+	}
+	info.Args = rc.Args
+	info.Env = rc.Env
+	return info
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// writeFakeAgent puts an executable script named name on a fresh PATH.
+func writeFakeAgent(t *testing.T, name, script string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake agent binaries are shell scripts")
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script+"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir)
+}
+
+func TestCheckAgentPreset(t *testing.T) {
+	writeFakeAgent(t, "fake-agent", `echo "fake-agent 1.2.3"; echo "build abc"`)
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("FAKE_AGENT_KEY", "")
+
+	info := &AgentPresetInfo{
+		Name:        "fake",
+		Command:     "fake-agent",
+		HealthCheck: []string{"fake-agent", "--version"},
+		AuthEnv:     []string{"FAKE_AGENT_KEY"},
+		AuthFiles:   []string{"~/.fake/auth.json"},
+	}
+	h := CheckAgentPreset(context.Background(), info)
+	if !h.Usable() || h.Version != "fake-agent 1.2.3" {
+		t.Errorf("health = %+v, want usable with version from first line", h)
+	}
+	if h.Auth != "" || len(h.Warnings) != 1 {
+		t.Errorf("auth = %q, warnings = %v; want a missing credentials warning", h.Auth, h.Warnings)
+	}
+
+	t.Setenv("FAKE_AGENT_KEY", "secret")
+	if h := CheckAgentPreset(context.Background(), info); h.Auth != "$FAKE_AGENT_KEY" || len(h.Warnings) != 0 {
+		t.Errorf("auth = %q, warnings = %v; want credentials from env", h.Auth, h.Warnings)
+	}
+}
+
+func TestCheckAgentPresetProblems(t *testing.T) {
+	writeFakeAgent(t, "broken-agent", `echo "error: node not found" >&2; exit 1`)
+
+	h := CheckAgentPreset(context.Background(), &AgentPresetInfo{
+		Name:        "broken",
+		Command:     "broken-agent",
+		HealthCheck: []string{"broken-agent", "--version"},
+	})
+	if h.Usable() || !strings.Contains(h.Problems[0], "node not found") {
+		t.Errorf("problems = %v, want failed health check with its output", h.Problems)
+	}
+
+	h = CheckAgentPreset(context.Background(), &AgentPresetInfo{Name: "missing", Command: "no-such-agent"})
+	if h.Usable() || h.BinaryPath != "" {
+		t.Errorf("health = %+v, want unusable when the binary is missing", h)
+	}
+}
+
+func TestAgentPresetForRuntime(t *testing.T) {
+	info := AgentPresetForRuntime("claude-opus", &RuntimeConfig{
+		Command: "/opt/bin/claude",
+		Env:     map[string]string{"ANTHROPIC_API_KEY": "k"},
+	})
+	if info.Command != "/opt/bin/claude" {
+		t.Errorf("command = %q", info.Command)
+	}
+	if len(info.HealthCheck) != 2 || info.HealthCheck[0] != "/opt/bin/claude" || info.HealthCheck[1] != "--version" {
+		t.Errorf("health check = %v, want claude's check run with the agent's binary", info.HealthCheck)
+	}
+	if len(info.AuthEnv) == 0 || findAgentAuth(info) != "$ANTHROPIC_API_KEY" {
+		t.Errorf("auth env = %v, want claude's, satisfied by the agent's own env", info.AuthEnv)
+	}
+
+	if info := AgentPresetForRuntime("custom", &RuntimeConfig{Command: "my-cli"}); len(info.HealthCheck) != 0 || info.Command != "my-cli" {
+		t.Errorf("unknown binary: %+v, want command only", info)
+	}
+}
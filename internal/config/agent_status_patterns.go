@@ -0,0 +1,39 @@
+package config
+
+// Built-in status pattern packs for agents whose terminal UIs have
+// recognizable prompts, spinners, and error banners. They are matched
+// against captured pane output, so they target what the TUI renders rather
+// than structured logs.
+
+var claudeStatusPatterns = []StatusPattern{
+	{Pattern: `API Error: (?:\d{3}|Connection|Request)`, Status: "error"},
+	{Pattern: `(?i)credit balance is too low`, Status: "error"},
+	{Pattern: `(?i)usage limit reached`, Status: "blocked"},
+	// Permission prompt: "Do you want to proceed?" above a numbered menu
+	{Pattern: `Do you want to (?:proceed|make this edit|create|run)`, Status: "waiting"},
+	// Spinner line: "✻ Pondering… (12s · esc to interrupt)"
+	{Pattern: `\(.*esc to interrupt\)`, Status: "thinking"},
+}
+
+var geminiStatusPatterns = []StatusPattern{
+	{Pattern: `\[API Error:`, Status: "error"},
+	{Pattern: `(?i)quota exceeded`, Status: "blocked"},
+	{Pattern: `(?i)(?:Allow execution of: .*|Apply this change)\?`, Status: "waiting"},
+	{Pattern: `(?i)waiting for user confirmation`, Status: "waiting"},
+	// Spinner line: "⠏ Reading files (esc to cancel, 4s)"
+	{Pattern: `\(esc to cancel, \d+s\)`, Status: "thinking"},
+}
+
+var codexStatusPatterns = []StatusPattern{
+	{Pattern: `(?i)stream (?:error|disconnected)`, Status: "error"},
+	{Pattern: `(?i)you've hit your usage limit`, Status: "blocked"},
+	{Pattern: `Would you like to (?:run the following command|make the following edits)\?`, Status: "waiting"},
+	// Status line: "Working (5s • esc to interrupt)"
+	{Pattern: `Working \(\d+s • esc to interrupt\)`, Status: "thinking"},
+}
+
+var openCodeStatusPatterns = []StatusPattern{
+	{Pattern: `(?:ProviderAuthError|ProviderModelNotFoundError|AI_APICallError)`, Status: "error"},
+	{Pattern: `(?i)permission required`, Status: "waiting"},
+	{Pattern: `(?i)esc interrupt`, Status: "thinking"},
+}
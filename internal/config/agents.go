@@ -3,6 +3,7 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -76,6 +77,34 @@ type AgentPresetInfo struct {
 
 	// NonInteractive contains settings for non-interactive mode.
 	NonInteractive *NonInteractiveConfig `json:"non_interactive,omitempty"`
+
+	// StatusPatterns recognize this agent's own output formats when
+	// inferring status from a session pane. They are checked, in order,
+	// before the generic patterns in internal/monitoring.
+	StatusPatterns []StatusPattern `json:"status_patterns,omitempty"`
+
+	// HealthCheck is a command that exits zero when the agent CLI works on
+	// this host, e.g. ["claude", "--version"]. Its first line of output is
+	// reported as the version. Run by 'gt agents doctor'.
+	HealthCheck []string `json:"health_check,omitempty"`
+
+	// AuthEnv lists environment variables that can hold the agent's
+	// credentials. Setting any one of them is enough.
+	AuthEnv []string `json:"auth_env,omitempty"`
+
+	// AuthFiles lists files the CLI stores a login in, relative to the home
+	// directory when they start with "~/". Used when no AuthEnv is set.
+	AuthFiles []string `json:"auth_files,omitempty"`
+}
+
+// StatusPattern maps agent output to a monitoring status.
+type StatusPattern struct {
+	// Pattern is a Go regular expression matched against pane output.
+	Pattern string `json:"pattern"`
+
+	// Status is the inferred agent status: "error", "blocked", "waiting",
+	// "thinking", or "reviewing".
+	Status string `json:"status"`
 }
 
 // NonInteractiveConfig contains settings for running agents non-interactively.
@@ -116,6 +145,10 @@ var builtinPresets = map[AgentPreset]*AgentPresetInfo{
 		SupportsHooks:       true,
 		SupportsForkSession: true,
 		NonInteractive:      nil, // Claude is native non-interactive
+		StatusPatterns:      claudeStatusPatterns,
+		HealthCheck:         []string{"claude", "--version"},
+		AuthEnv:             []string{"ANTHROPIC_API_KEY", "ANTHROPIC_AUTH_TOKEN", "CLAUDE_CODE_OAUTH_TOKEN"},
+		AuthFiles:           []string{"~/.claude/.credentials.json"},
 	},
 	AgentGemini: {
 		Name:                AgentGemini,
@@ -131,6 +164,10 @@ var builtinPresets = map[AgentPreset]*AgentPresetInfo{
 			PromptFlag: "-p",
 			OutputFlag: "--output-format json",
 		},
+		StatusPatterns: geminiStatusPatterns,
+		HealthCheck:    []string{"gemini", "--version"},
+		AuthEnv:        []string{"GEMINI_API_KEY", "GOOGLE_API_KEY"},
+		AuthFiles:      []string{"~/.gemini/oauth_creds.json"},
 	},
 	AgentCodex: {
 		Name:                AgentCodex,
@@ -146,6 +183,10 @@ var builtinPresets = map[AgentPreset]*AgentPresetInfo{
 			Subcommand: "exec",
 			OutputFlag: "--json",
 		},
+		StatusPatterns: codexStatusPatterns,
+		HealthCheck:    []string{"codex", "--version"},
+		AuthEnv:        []string{"OPENAI_API_KEY"},
+		AuthFiles:      []string{"~/.codex/auth.json"},
 	},
 	AgentCursor: {
 		Name:                AgentCursor,
@@ -161,6 +202,7 @@ var builtinPresets = map[AgentPreset]*AgentPresetInfo{
 			PromptFlag: "-p",
 			OutputFlag: "--output-format json",
 		},
+		HealthCheck: []string{"cursor-agent", "--version"},
 	},
 	AgentAuggie: {
 		Name:                AgentAuggie,
@@ -172,6 +214,7 @@ var builtinPresets = map[AgentPreset]*AgentPresetInfo{
 		ResumeStyle:         "flag",
 		SupportsHooks:       false,
 		SupportsForkSession: false,
+		HealthCheck:         []string{"auggie", "--version"},
 	},
 	AgentAmp: {
 		Name:                AgentAmp,
@@ -183,6 +226,7 @@ var builtinPresets = map[AgentPreset]*AgentPresetInfo{
 		ResumeStyle:         "subcommand", // 'amp threads continue <threadId>'
 		SupportsHooks:       false,
 		SupportsForkSession: false,
+		HealthCheck:         []string{"amp", "--version"},
 	},
 	AgentOpenCode: {
 		Name:    AgentOpenCode,
@@ -202,6 +246,9 @@ var builtinPresets = map[AgentPreset]*AgentPresetInfo{
 			Subcommand: "run",
 			OutputFlag: "--format json",
 		},
+		StatusPatterns: openCodeStatusPatterns,
+		HealthCheck:    []string{"opencode", "--version"},
+		AuthFiles:      []string{"~/.local/share/opencode/auth.json"},
 	},
 }
 
@@ -215,6 +262,9 @@ var (
 	loadedPaths = make(map[string]bool)
 	// registryInitialized tracks if builtins have been copied.
 	registryInitialized bool
+	// beadsPresets are presets merged from config beads, kept so a reload
+	// doesn't drop them.
+	beadsPresets = make(map[string]*AgentPresetInfo)
 )
 
 // initRegistry initializes the global registry with built-in presets.
@@ -386,7 +436,16 @@ func BuildResumeCommand(agentName, sessionID string) string {
 	}
 
 	info := GetAgentPresetByName(agentName)
-	if info == nil || info.ResumeFlag == "" {
+	if info == nil {
+		return ""
+	}
+	return buildResumeCommand(info, sessionID)
+}
+
+// buildResumeCommand builds the resume command for a preset, or returns
+// empty string if it doesn't support resume.
+func buildResumeCommand(info *AgentPresetInfo, sessionID string) string {
+	if sessionID == "" || info.ResumeFlag == "" {
 		return ""
 	}
 
@@ -575,7 +634,51 @@ func MergeAgentPresets(presets map[string]*AgentPresetInfo) {
 	for name, preset := range presets {
 		preset.Name = AgentPreset(name)
 		globalRegistry.Agents[name] = preset
+		beadsPresets[name] = preset
+	}
+}
+
+// ReloadAgentRegistries rebuilds the registry from the built-in presets,
+// the given agents.json files in order, and presets merged from config
+// beads, then swaps it in. Unlike LoadAgentRegistry it rereads files that
+// were already loaded, so edited presets take effect and removed ones
+// disappear. If any file can't be read or parsed, the current registry is
+// kept and the error returned.
+func ReloadAgentRegistries(paths ...string) error {
+	agents := make(map[string]*AgentPresetInfo, len(builtinPresets))
+	for name, preset := range builtinPresets {
+		agents[string(name)] = preset
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path) //nolint:gosec // G304: path is from config
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		var userRegistry AgentRegistry
+		if err := json.Unmarshal(data, &userRegistry); err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+		for name, preset := range userRegistry.Agents {
+			preset.Name = AgentPreset(name)
+			agents[name] = preset
+		}
 	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for name, preset := range beadsPresets {
+		agents[name] = preset
+	}
+	globalRegistry = &AgentRegistry{Version: CurrentAgentRegistryVersion, Agents: agents}
+	registryInitialized = true
+	loadedPaths = make(map[string]bool, len(paths))
+	for _, path := range paths {
+		loadedPaths[path] = true
+	}
+	return nil
 }
 
 // ResetRegistryForTesting clears all registry state.
@@ -585,5 +688,6 @@ func ResetRegistryForTesting() {
 	defer registryMu.Unlock()
 	globalRegistry = nil
 	loadedPaths = make(map[string]bool)
+	beadsPresets = make(map[string]*AgentPresetInfo)
 	registryInitialized = false
 }
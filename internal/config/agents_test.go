@@ -198,6 +198,55 @@ func TestLoadAgentRegistry(t *testing.T) {
 	ResetRegistryForTesting()
 }
 
+func TestReloadAgentRegistries(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "agents.json")
+	writeRegistry := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ResetRegistryForTesting()
+	defer ResetRegistryForTesting()
+
+	writeRegistry(`{"version":1,"agents":{"my-agent":{"command":"v1"}}}`)
+	if err := LoadAgentRegistry(configPath); err != nil {
+		t.Fatal(err)
+	}
+	MergeAgentPresets(map[string]*AgentPresetInfo{"beads-agent": {Command: "from-beads"}})
+
+	// LoadAgentRegistry is load-once; a reload picks up the edit
+	writeRegistry(`{"version":1,"agents":{"other-agent":{"command":"v2"}}}`)
+	if err := LoadAgentRegistry(configPath); err != nil {
+		t.Fatal(err)
+	}
+	if GetAgentPresetByName("my-agent") == nil {
+		t.Fatal("LoadAgentRegistry should not reread a loaded file")
+	}
+	if err := ReloadAgentRegistries(configPath); err != nil {
+		t.Fatalf("ReloadAgentRegistries: %v", err)
+	}
+	if GetAgentPresetByName("my-agent") != nil {
+		t.Error("preset removed from agents.json still registered after reload")
+	}
+	if p := GetAgentPresetByName("other-agent"); p == nil || p.Command != "v2" {
+		t.Errorf("other-agent = %+v, want command v2", p)
+	}
+	if GetAgentPresetByName("beads-agent") == nil || GetAgentPresetByName("claude") == nil {
+		t.Error("reload dropped beads or built-in presets")
+	}
+
+	// A broken file keeps the current registry
+	writeRegistry(`{"agents":`)
+	if err := ReloadAgentRegistries(configPath); err == nil {
+		t.Error("expected parse error")
+	}
+	if GetAgentPresetByName("other-agent") == nil {
+		t.Error("failed reload replaced the registry")
+	}
+}
+
 func TestAgentPresetYOLOFlags(t *testing.T) {
 	t.Parallel()
 	// Verify YOLO flags are set correctly for each E2E tested agent
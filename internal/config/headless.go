@@ -0,0 +1,39 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// claudeNonInteractive is how Claude runs headless. Claude's preset leaves
+// NonInteractive unset because its default mode already accepts -p, but a
+// headless run also needs its streamed JSON output.
+var claudeNonInteractive = &NonInteractiveConfig{
+	PromptFlag: "-p",
+	OutputFlag: "--output-format stream-json --verbose",
+}
+
+// HeadlessCommand returns the argv that runs an agent once on prompt
+// without a terminal, printing structured output to stdout, e.g.
+// ["codex", "exec", "--json", "<prompt>"]. The preset's Args are kept so
+// permission flags like --dangerously-skip-permissions still apply.
+// Presets without a non-interactive mode return an error.
+func HeadlessCommand(info *AgentPresetInfo, prompt string) ([]string, error) {
+	ni := info.NonInteractive
+	if ni == nil && (info.Name == AgentClaude || filepath.Base(info.Command) == "claude") {
+		ni = claudeNonInteractive
+	}
+	if ni == nil {
+		return nil, fmt.Errorf("agent %q has no non-interactive mode", info.Name)
+	}
+
+	argv := []string{info.Command}
+	argv = append(argv, strings.Fields(ni.Subcommand)...)
+	argv = append(argv, info.Args...)
+	argv = append(argv, strings.Fields(ni.OutputFlag)...)
+	if ni.PromptFlag != "" {
+		argv = append(argv, ni.PromptFlag)
+	}
+	return append(argv, prompt), nil
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHeadlessCommand(t *testing.T) {
+	tests := []struct {
+		preset AgentPreset
+		want   string
+	}{
+		{AgentClaude, "claude --dangerously-skip-permissions --output-format stream-json --verbose -p PROMPT"},
+		{AgentCodex, "codex exec --yolo --json PROMPT"},
+		{AgentGemini, "gemini --approval-mode yolo --output-format json -p PROMPT"},
+	}
+	for _, tt := range tests {
+		argv, err := HeadlessCommand(GetAgentPreset(tt.preset), "PROMPT")
+		if err != nil {
+			t.Fatalf("%s: %v", tt.preset, err)
+		}
+		if got := strings.Join(argv, " "); got != tt.want {
+			t.Errorf("%s: argv = %q, want %q", tt.preset, got, tt.want)
+		}
+	}
+
+	if _, err := HeadlessCommand(&AgentPresetInfo{Name: "custom", Command: "my-cli"}, "PROMPT"); err == nil {
+		t.Error("preset without a non-interactive mode should fail")
+	}
+}
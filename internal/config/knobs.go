@@ -0,0 +1,132 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KnobKind is the value type of an operational knob.
+type KnobKind string
+
+const (
+	KnobDuration KnobKind = "duration" // Go duration, e.g. "30m"
+	KnobInt      KnobKind = "int"      // non-negative integer
+	KnobBool     KnobKind = "bool"     // true or false
+)
+
+// Knob keys read by running processes.
+const (
+	KnobGUPPViolationTimeout = "daemon.gupp_violation_timeout"
+	KnobDeaconNudgeAfter     = "daemon.deacon_nudge_after"
+	KnobDeaconRestartAfter   = "daemon.deacon_restart_after"
+	KnobWarmPoolInterval     = "warm_pool.interval"
+	KnobWarmPoolMaxSize      = "warm_pool.max_size"
+	KnobSlingUseWarmPool     = "sling.use_warm_pool"
+)
+
+// Knob is an operational setting that can change while the town runs.
+// Knob values live in "settings" config beads as a flat JSON object of
+// key to value, and running daemons pick up changes without a restart.
+type Knob struct {
+	Key     string
+	Kind    KnobKind
+	Default string
+	Doc     string
+}
+
+var knobs = []Knob{
+	{KnobGUPPViolationTimeout, KnobDuration, "30m", "Hooked work with no agent progress for this long is reported to the witness"},
+	{KnobDeaconNudgeAfter, KnobDuration, "15m", "Deacon heartbeat age at which the daemon nudges the Deacon"},
+	{KnobDeaconRestartAfter, KnobDuration, "30m", "Deacon heartbeat age at which the daemon restarts the Deacon"},
+	{KnobWarmPoolInterval, KnobDuration, "1m", "How often the daemon tops up warm polecat pools"},
+	{KnobWarmPoolMaxSize, KnobInt, "0", "Upper bound on every rig's warm pool size (0 = no bound)"},
+	{KnobSlingUseWarmPool, KnobBool, "true", "Whether gt sling hands work to warm pool polecats"},
+}
+
+// Knobs returns every known knob, sorted by key.
+func Knobs() []Knob {
+	out := append([]Knob(nil), knobs...)
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+// LookupKnob returns the knob with the given key.
+func LookupKnob(key string) (Knob, bool) {
+	for _, k := range knobs {
+		if k.Key == key {
+			return k, true
+		}
+	}
+	return Knob{}, false
+}
+
+// ValidateKnob checks that value is acceptable for the knob key.
+func ValidateKnob(key, value string) error {
+	k, ok := LookupKnob(key)
+	if !ok {
+		return fmt.Errorf("unknown setting %q", key)
+	}
+	switch k.Kind {
+	case KnobDuration:
+		d, err := time.ParseDuration(value)
+		if err != nil || d <= 0 {
+			return fmt.Errorf("%s: %q is not a positive duration", key, value)
+		}
+	case KnobInt:
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("%s: %q is not a non-negative integer", key, value)
+		}
+	case KnobBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%s: %q is not true or false", key, value)
+		}
+	}
+	return nil
+}
+
+// LoadKnobsFromBeads merges settings config bead metadata into knob values.
+// The metadataLayers are ordered least specific first, as returned by
+// beads.ListConfigBeadsForScope, so rig beads override town beads.
+//
+// Each layer is a JSON object such as {"warm_pool.max_size": 4}. Numbers
+// and booleans are accepted as well as strings. Unknown keys and invalid
+// values are skipped so one bad entry can't take the others down.
+// Returns nil if no layer sets a valid knob.
+func LoadKnobsFromBeads(metadataLayers []string) map[string]string {
+	values := make(map[string]string)
+	for _, raw := range metadataLayers {
+		var layer map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &layer); err != nil {
+			continue
+		}
+		for key, v := range layer {
+			value := knobString(v)
+			if ValidateKnob(key, value) != nil {
+				continue
+			}
+			values[key] = value
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// knobString renders a JSON value as a knob value string.
+func knobString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLoadKnobsFromBeads(t *testing.T) {
+	if got := LoadKnobsFromBeads(nil); got != nil {
+		t.Errorf("no layers = %v, want nil", got)
+	}
+
+	got := LoadKnobsFromBeads([]string{
+		`{"warm_pool.max_size": 4, "daemon.gupp_violation_timeout": "45m", "sling.use_warm_pool": false}`,
+		`not json`,
+		// The rig layer wins; its invalid and unknown entries are skipped.
+		`{"warm_pool.max_size": "2", "daemon.gupp_violation_timeout": "soon", "warm_pool.size": 9}`,
+	})
+	want := map[string]string{
+		KnobWarmPoolMaxSize:      "2",
+		KnobGUPPViolationTimeout: "45m",
+		KnobSlingUseWarmPool:     "false",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LoadKnobsFromBeads = %v, want %v", got, want)
+	}
+}
+
+func TestValidateKnob(t *testing.T) {
+	tests := []struct {
+		key, value string
+		ok         bool
+	}{
+		{KnobWarmPoolInterval, "30s", true},
+		{KnobWarmPoolInterval, "0s", false},
+		{KnobWarmPoolMaxSize, "0", true},
+		{KnobWarmPoolMaxSize, "-1", false},
+		{KnobSlingUseWarmPool, "true", true},
+		{KnobSlingUseWarmPool, "sometimes", false},
+		{"no.such.knob", "1", false},
+	}
+	for _, tt := range tests {
+		if err := ValidateKnob(tt.key, tt.value); (err == nil) != tt.ok {
+			t.Errorf("ValidateKnob(%q, %q) = %v, want ok=%v", tt.key, tt.value, err, tt.ok)
+		}
+	}
+
+	for _, k := range Knobs() {
+		if err := ValidateKnob(k.Key, k.Default); err != nil {
+			t.Errorf("default of %s is invalid: %v", k.Key, err)
+		}
+	}
+}
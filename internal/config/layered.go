@@ -0,0 +1,509 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// Source is the layer a setting was resolved from. Layers override each
+// other in order: defaults, then config files, then environment variables,
+// then command-line flags.
+type Source string
+
+const (
+	SourceDefault Source = "default"
+	SourceFile    Source = "file"
+	SourceEnv     Source = "env"
+	SourceFlag    Source = "flag"
+)
+
+// Setting is one resolved configuration value.
+type Setting struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Source Source `json:"source"`
+	Origin string `json:"origin,omitempty"` // file path or environment variable
+}
+
+// Problem is something wrong with a town's configuration. Warnings are
+// harmless to the loader but probably mistakes, such as a misspelled field
+// that is silently ignored.
+type Problem struct {
+	Path    string `json:"path,omitempty"`
+	Key     string `json:"key,omitempty"`
+	Message string `json:"message"`
+	Warning bool   `json:"warning,omitempty"`
+}
+
+func (p Problem) Error() string {
+	var where []string
+	if p.Path != "" {
+		where = append(where, p.Path)
+	}
+	if p.Key != "" {
+		where = append(where, p.Key)
+	}
+	if len(where) == 0 {
+		return p.Message
+	}
+	return strings.Join(where, ": ") + ": " + p.Message
+}
+
+// LayerOptions supplies the layers above the config files.
+type LayerOptions struct {
+	// Env looks up environment variables. Defaults to os.LookupEnv.
+	Env func(string) (string, bool)
+
+	// Flags holds values given on the command line, by setting key.
+	Flags map[string]string
+}
+
+// Layered is a town's configuration resolved across all layers, with the
+// problems found on the way. Use LoadLayered to build one.
+type Layered struct {
+	TownRoot string
+	Problems []Problem
+
+	settings map[string]Setting
+}
+
+// townFiles are the town-level config files; nil when missing or invalid.
+type townFiles struct {
+	town     *TownConfig
+	mayor    *MayorConfig
+	settings *TownSettings
+	accounts *AccountsConfig
+}
+
+// settingDef describes one key of the layered configuration.
+type settingDef struct {
+	key  string
+	doc  string
+	file func(townRoot string) string
+	read func(f *townFiles) string
+	env  string
+	def  string
+	// validate checks a resolved value; f gives access to the rest of the
+	// town's configuration for cross-references.
+	validate func(value string, f *townFiles) error
+}
+
+var settingDefs = []settingDef{
+	{
+		key:  "town.name",
+		doc:  "Town identifier",
+		file: constants.MayorTownPath,
+		read: func(f *townFiles) string {
+			if f.town == nil {
+				return ""
+			}
+			return f.town.Name
+		},
+	},
+	{
+		key:  "town.owner",
+		doc:  "Owner email",
+		file: constants.MayorTownPath,
+		read: func(f *townFiles) string {
+			if f.town == nil {
+				return ""
+			}
+			return f.town.Owner
+		},
+	},
+	{
+		key:  "cli_theme",
+		doc:  "CLI color scheme: dark, light, or auto",
+		file: TownSettingsPath,
+		read: func(f *townFiles) string {
+			if f.settings == nil {
+				return ""
+			}
+			return f.settings.CLITheme
+		},
+		env:      "GT_THEME",
+		def:      "auto",
+		validate: oneOf("dark", "light", "auto"),
+	},
+	{
+		key:  "default_agent",
+		doc:  "Agent preset used unless a role or rig overrides it",
+		file: TownSettingsPath,
+		read: func(f *townFiles) string {
+			if f.settings == nil {
+				return ""
+			}
+			return f.settings.DefaultAgent
+		},
+		def: "claude",
+		validate: func(v string, f *townFiles) error {
+			if lookupAgentConfigIfExists(v, f.settings, nil) == nil {
+				return fmt.Errorf("unknown agent %q; use a built-in preset (%s) or define it under agents in settings/config.json",
+					v, strings.Join(ListAgentPresets(), ", "))
+			}
+			return nil
+		},
+	},
+	{
+		key:  "agent_email_domain",
+		doc:  "Domain of agent git identity emails",
+		file: TownSettingsPath,
+		read: func(f *townFiles) string {
+			if f.settings == nil {
+				return ""
+			}
+			return f.settings.AgentEmailDomain
+		},
+		def: "gastown.local",
+		validate: func(v string, _ *townFiles) error {
+			if strings.ContainsAny(v, "@ ") {
+				return fmt.Errorf("%q is not a domain (drop the @ and any spaces)", v)
+			}
+			return nil
+		},
+	},
+	{
+		key:  "account",
+		doc:  "Default agent account handle",
+		file: constants.MayorAccountsPath,
+		read: func(f *townFiles) string {
+			if f.accounts == nil {
+				return ""
+			}
+			return f.accounts.Default
+		},
+		env: "GT_ACCOUNT",
+		validate: func(v string, f *townFiles) error {
+			if v == "" {
+				return nil
+			}
+			if f.accounts == nil || f.accounts.GetAccount(v) == nil {
+				return fmt.Errorf("account %q is not registered (see 'gt account list')", v)
+			}
+			return nil
+		},
+	},
+	{
+		key:  "default_crew_name",
+		doc:  "Crew workspace created by 'gt rig add --boot'",
+		file: constants.MayorConfigPath,
+		read: func(f *townFiles) string {
+			if f.mayor == nil {
+				return ""
+			}
+			return f.mayor.DefaultCrewName
+		},
+		def: DefaultCrewName,
+		validate: func(v string, _ *townFiles) error {
+			if v == "" || strings.ContainsAny(v, "/-. ") {
+				return fmt.Errorf("%q is not a valid crew name (letters, digits, and underscores)", v)
+			}
+			return nil
+		},
+	},
+	{
+		key:  "deacon.gc_retention",
+		doc:  "How long gt gc keeps finished polecat worktrees",
+		file: constants.MayorConfigPath,
+		read: func(f *townFiles) string {
+			if f.mayor == nil || f.mayor.Deacon == nil {
+				return ""
+			}
+			return f.mayor.Deacon.GCRetention
+		},
+		def:      "24h", // DefaultGCRetention
+		validate: isDuration,
+	},
+	{
+		key:  "usage_budgets.daily_usd",
+		doc:  "Daily spend limit for the town (0 = unlimited)",
+		file: TownSettingsPath,
+		read: func(f *townFiles) string {
+			return formatBudget(f, func(b *UsageBudgets) float64 { return b.DailyUSD })
+		},
+		def:      "0",
+		validate: isBudget,
+	},
+	{
+		key:  "usage_budgets.rig_daily_usd",
+		doc:  "Daily spend limit per rig (0 = unlimited)",
+		file: TownSettingsPath,
+		read: func(f *townFiles) string {
+			return formatBudget(f, func(b *UsageBudgets) float64 { return b.RigDailyUSD })
+		},
+		def:      "0",
+		validate: isBudget,
+	},
+	{
+		key:  "usage_budgets.agent_daily_usd",
+		doc:  "Daily spend limit per agent (0 = unlimited)",
+		file: TownSettingsPath,
+		read: func(f *townFiles) string {
+			return formatBudget(f, func(b *UsageBudgets) float64 { return b.AgentDailyUSD })
+		},
+		def:      "0",
+		validate: isBudget,
+	},
+}
+
+func oneOf(values ...string) func(string, *townFiles) error {
+	return func(v string, _ *townFiles) error {
+		for _, want := range values {
+			if strings.EqualFold(v, want) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not one of %s", v, strings.Join(values, ", "))
+	}
+}
+
+func isDuration(v string, _ *townFiles) error {
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return fmt.Errorf("%q is not a duration (e.g. \"30m\", \"24h\")", v)
+	}
+	if d < 0 {
+		return fmt.Errorf("%q must not be negative", v)
+	}
+	return nil
+}
+
+func isBudget(v string, _ *townFiles) error {
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		return fmt.Errorf("%q is not a dollar amount (e.g. \"25\" or \"7.50\")", v)
+	}
+	return nil
+}
+
+func formatBudget(f *townFiles, get func(*UsageBudgets) float64) string {
+	if f.settings == nil || f.settings.UsageBudgets == nil {
+		return ""
+	}
+	if v := get(f.settings.UsageBudgets); v != 0 {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
+
+// SettingKeys returns the keys LoadLayered resolves, in display order.
+func SettingKeys() []string {
+	keys := make([]string, len(settingDefs))
+	for i, d := range settingDefs {
+		keys[i] = d.key
+	}
+	return keys
+}
+
+// SettingInfo describes a key for help output: what it does, the
+// environment variable that overrides it (if any), and its default.
+func SettingInfo(key string) (doc, env, def string, ok bool) {
+	for _, d := range settingDefs {
+		if d.key == key {
+			return d.doc, d.env, d.def, true
+		}
+	}
+	return "", "", "", false
+}
+
+// LoadLayered resolves the town's configuration across all layers and
+// validates it. It never fails outright: unreadable files and invalid
+// values are recorded in Problems (see Err), and the affected keys fall
+// back to the layers below.
+//
+// Besides the town files behind the settings, LoadLayered checks
+// mayor/rigs.json and each registered rig's config.json and
+// settings/config.json, so one call validates everything gt reads at
+// startup.
+func LoadLayered(townRoot string, opts LayerOptions) *Layered {
+	if opts.Env == nil {
+		opts.Env = os.LookupEnv
+	}
+	l := &Layered{TownRoot: townRoot, settings: make(map[string]Setting)}
+
+	var f townFiles
+	if c := new(TownConfig); l.loadFile(constants.MayorTownPath(townRoot), c, true, func() error { return validateTownConfig(c) }) {
+		f.town = c
+	}
+	if c := new(MayorConfig); l.loadFile(constants.MayorConfigPath(townRoot), c, false, func() error { return validateMayorConfig(c) }) {
+		f.mayor = c
+	}
+	if c := new(TownSettings); l.loadFile(TownSettingsPath(townRoot), c, false, nil) {
+		f.settings = c
+	}
+	if c := new(AccountsConfig); l.loadFile(constants.MayorAccountsPath(townRoot), c, false, func() error { return validateAccountsConfig(c) }) {
+		f.accounts = c
+	}
+
+	for key := range opts.Flags {
+		if _, _, _, ok := SettingInfo(key); !ok {
+			l.Problems = append(l.Problems, Problem{Key: key, Message: fmt.Sprintf("unknown setting (known: %s)", strings.Join(SettingKeys(), ", "))})
+		}
+	}
+
+	for _, d := range settingDefs {
+		layers := []Setting{{Key: d.key, Value: d.def, Source: SourceDefault}}
+		if v := d.read(&f); v != "" {
+			layers = append(layers, Setting{Key: d.key, Value: v, Source: SourceFile, Origin: d.file(townRoot)})
+		}
+		if d.env != "" {
+			if v, ok := opts.Env(d.env); ok && v != "" {
+				layers = append(layers, Setting{Key: d.key, Value: v, Source: SourceEnv, Origin: d.env})
+			}
+		}
+		if v, ok := opts.Flags[d.key]; ok {
+			layers = append(layers, Setting{Key: d.key, Value: v, Source: SourceFlag})
+		}
+
+		// Take the highest valid layer; report every invalid one above it so
+		// a bad env var isn't masked by a good flag.
+		for i := len(layers) - 1; i >= 0; i-- {
+			s := layers[i]
+			if d.validate == nil {
+				l.settings[d.key] = s
+				break
+			}
+			err := d.validate(s.Value, &f)
+			if err == nil {
+				l.settings[d.key] = s
+				break
+			}
+			l.Problems = append(l.Problems, Problem{Path: s.Origin, Key: d.key, Message: err.Error()})
+			if i == 0 {
+				l.settings[d.key] = s
+			}
+		}
+	}
+
+	l.checkRigs()
+	return l
+}
+
+// checkRigs validates the rigs registry and each registered rig's config.
+func (l *Layered) checkRigs() {
+	rigsPath := constants.MayorRigsPath(l.TownRoot)
+	rigs := new(RigsConfig)
+	if !l.loadFile(rigsPath, rigs, false, func() error { return validateRigsConfig(rigs) }) {
+		return
+	}
+	names := make([]string, 0, len(rigs.Rigs))
+	for name := range rigs.Rigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		rigPath := filepath.Join(l.TownRoot, name)
+		if _, err := os.Stat(rigPath); err != nil {
+			continue // registered but not cloned here (e.g., K8s mode)
+		}
+		rc := new(RigConfig)
+		l.loadFile(filepath.Join(rigPath, "config.json"), rc, false, func() error { return validateRigConfig(rc) })
+		rs := new(RigSettings)
+		l.loadFile(RigSettingsPath(rigPath), rs, false, func() error { return validateRigSettings(rs) })
+	}
+}
+
+// loadFile decodes a JSON config file into v and runs validate on it,
+// recording problems. A missing file is only a problem if required. It
+// reports whether v was loaded and is valid.
+func (l *Layered) loadFile(path string, v any, required bool, validate func() error) bool {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			if required {
+				l.Problems = append(l.Problems, Problem{Path: path, Message: "missing (run 'gt install' to create a town)"})
+			}
+			return false
+		}
+		l.Problems = append(l.Problems, Problem{Path: path, Message: err.Error()})
+		return false
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		l.Problems = append(l.Problems, Problem{Path: path, Message: describeJSONError(data, err)})
+		return false
+	}
+
+	// Unknown fields are ignored by the loaders, so a typo silently does
+	// nothing. Decode again strictly to point them out.
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(reflect.New(reflect.TypeOf(v).Elem()).Interface()); err != nil {
+		if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+			l.Problems = append(l.Problems, Problem{Path: path, Message: "unknown field " + field + " is ignored", Warning: true})
+		}
+	}
+
+	if validate != nil {
+		if err := validate(); err != nil {
+			l.Problems = append(l.Problems, Problem{Path: path, Message: err.Error()})
+			return false
+		}
+	}
+	return true
+}
+
+// describeJSONError turns a decoding error into a message with a line
+// number, which encoding/json reports only as a byte offset.
+func describeJSONError(data []byte, err error) string {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		return fmt.Sprintf("line %d: invalid JSON: %v", lineOf(data, syntaxErr.Offset), syntaxErr)
+	case errors.As(err, &typeErr):
+		return fmt.Sprintf("line %d: field %s should be %s, not %s",
+			lineOf(data, typeErr.Offset), typeErr.Field, typeErr.Type, typeErr.Value)
+	}
+	return err.Error()
+}
+
+func lineOf(data []byte, offset int64) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+// Get returns the resolved value of a key, or "" for an unknown key.
+func (l *Layered) Get(key string) string {
+	return l.settings[key].Value
+}
+
+// Setting returns a resolved key with its source.
+func (l *Layered) Setting(key string) (Setting, bool) {
+	s, ok := l.settings[key]
+	return s, ok
+}
+
+// Settings returns every resolved key in display order.
+func (l *Layered) Settings() []Setting {
+	out := make([]Setting, 0, len(settingDefs))
+	for _, d := range settingDefs {
+		out = append(out, l.settings[d.key])
+	}
+	return out
+}
+
+// Err joins the problems that are errors, ignoring warnings. It is nil if
+// the configuration is valid.
+func (l *Layered) Err() error {
+	var errs []error
+	for _, p := range l.Problems {
+		if !p.Warning {
+			errs = append(errs, p)
+		}
+	}
+	return errors.Join(errs...)
+}
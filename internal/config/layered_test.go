@@ -0,0 +1,86 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadLayered(t *testing.T) {
+	townRoot := t.TempDir()
+	write := func(rel, content string) {
+		t.Helper()
+		path := filepath.Join(townRoot, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("mayor/town.json", `{"type":"town","version":2,"name":"gt11"}`)
+	write("mayor/config.json", `{"type":"mayor-config","deacon":{"gc_retention":"12h"},"default_crew":"joe"}`)
+	write("settings/config.json", `{"type":"town-settings","cli_theme":"dark","default_agent":"nope"}`)
+	write("mayor/rigs.json", `{"version":1,"rigs":{"gastown":{"git_url":"x"}}}`)
+	write("gastown/config.json", "{\n  \"type\": \"rig\",\n  \"name\": gastown\n}")
+
+	env := map[string]string{"GT_THEME": "light", "GT_ACCOUNT": "work"}
+	l := LoadLayered(townRoot, LayerOptions{
+		Env: func(k string) (string, bool) {
+			v, ok := env[k]
+			return v, ok
+		},
+		Flags: map[string]string{"deacon.gc_retention": "1h", "deacon.gc_retentoin": "2h"},
+	})
+
+	want := map[string]Setting{
+		"town.name":           {Value: "gt11", Source: SourceFile},
+		"cli_theme":           {Value: "light", Source: SourceEnv},
+		"deacon.gc_retention": {Value: "1h", Source: SourceFlag},
+		"default_crew_name":   {Value: DefaultCrewName, Source: SourceDefault},
+		// Invalid values fall back to the layer below.
+		"default_agent": {Value: "claude", Source: SourceDefault},
+		"account":       {Value: "", Source: SourceDefault},
+	}
+	for key, w := range want {
+		got, ok := l.Setting(key)
+		if !ok || got.Value != w.Value || got.Source != w.Source {
+			t.Errorf("%s = %q from %s, want %q from %s", key, got.Value, got.Source, w.Value, w.Source)
+		}
+	}
+
+	var errs, warnings []string
+	for _, p := range l.Problems {
+		if p.Warning {
+			warnings = append(warnings, p.Error())
+		} else {
+			errs = append(errs, p.Error())
+		}
+	}
+	for _, msg := range []string{
+		`default_agent: unknown agent "nope"`,
+		`GT_ACCOUNT: account: account "work" is not registered`,
+		`deacon.gc_retentoin: unknown setting`,
+		`config.json: line 3: invalid JSON`,
+	} {
+		if !containsSubstring(errs, msg) {
+			t.Errorf("errors missing %q:\n%s", msg, strings.Join(errs, "\n"))
+		}
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `unknown field "default_crew"`) {
+		t.Errorf("warnings = %q, want the misspelled default_crew field", warnings)
+	}
+	if l.Err() == nil {
+		t.Error("Err() = nil with errors present")
+	}
+}
+
+func containsSubstring(list []string, sub string) bool {
+	for _, s := range list {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
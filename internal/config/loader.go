@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -250,6 +251,12 @@ func validateMergeQueueConfig(c *MergeQueueConfig) error {
 		return fmt.Errorf("%w: max_concurrent must be non-negative", ErrMissingField)
 	}
 
+	if c.Policy != nil {
+		if err := ValidateMergePolicy(c.Policy); err != nil {
+			return err
+		}
+	}
+
 	// Validate PR options only apply to PR strategies
 	if c.PROptions != nil && !IsPRStrategy(c.Strategy) && c.Strategy != "" {
 		return fmt.Errorf("pr_options can only be used with PR strategies (pr_to_main, pr_to_branch), got strategy '%s'", c.Strategy)
@@ -258,6 +265,40 @@ func validateMergeQueueConfig(c *MergeQueueConfig) error {
 	return nil
 }
 
+// ErrInvalidMergePolicy indicates an invalid merge policy.
+var ErrInvalidMergePolicy = errors.New("invalid merge policy")
+
+// ValidateMergePolicy validates a MergePolicy.
+func ValidateMergePolicy(p *MergePolicy) error {
+	if p.Method != "" {
+		valid := false
+		for _, m := range ValidMergeMethods() {
+			if p.Method == m {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("%w: method '%s', valid values are: %v",
+				ErrInvalidMergePolicy, p.Method, ValidMergeMethods())
+		}
+	}
+	if p.RequiredApprovals < 0 {
+		return fmt.Errorf("%w: required_approvals must be non-negative", ErrInvalidMergePolicy)
+	}
+	seen := make(map[string]bool)
+	for _, check := range p.RequiredChecks {
+		if strings.TrimSpace(check) == "" {
+			return fmt.Errorf("%w: required_checks contains an empty name", ErrInvalidMergePolicy)
+		}
+		if seen[check] {
+			return fmt.Errorf("%w: required check '%s' listed twice", ErrInvalidMergePolicy, check)
+		}
+		seen[check] = true
+	}
+	return nil
+}
+
 // NewRigConfig creates a new RigConfig (identity only).
 func NewRigConfig(name, gitURL string) *RigConfig {
 	return &RigConfig{
@@ -1145,12 +1186,12 @@ func lookupAgentConfigIfExists(name string, townSettings *TownSettings, rigSetti
 // It checks role-specific agent assignments before falling back to the default agent.
 //
 // Resolution order:
-//  1. Rig's RoleAgents[role] - if set, look up that agent
-//  2. Town's RoleAgents[role] - if set, look up that agent
+//  1. Rig's RoleAgents[role], then rig's RoleAgentFallbacks[role]
+//  2. Town's RoleAgents[role], then town's RoleAgentFallbacks[role]
 //  3. Fall back to ResolveAgentConfig (rig's Agent → town's DefaultAgent → "claude")
 //
 // If a configured agent is not found or its binary doesn't exist, a warning is
-// printed to stderr and it falls back to the default agent.
+// printed to stderr and the next agent in the chain is tried.
 //
 // role is one of: "mayor", "deacon", "witness", "refinery", "polecat", "crew".
 // townRoot is the path to the town directory (e.g., ~/gt).
@@ -1178,30 +1219,91 @@ func ResolveRoleAgentConfig(role, townRoot, rigPath string) *RuntimeConfig {
 		_ = LoadRigAgentRegistry(RigAgentRegistryPath(rigPath))
 	}
 
-	// Check rig's RoleAgents first
-	if rigSettings != nil && rigSettings.RoleAgents != nil {
-		if agentName, ok := rigSettings.RoleAgents[role]; ok && agentName != "" {
-			if err := ValidateAgentConfig(agentName, townSettings, rigSettings); err != nil {
-				fmt.Fprintf(os.Stderr, "warning: role_agents[%s]=%s - %v, falling back to default\n", role, agentName, err)
-			} else {
-				return lookupAgentConfig(agentName, townSettings, rigSettings)
-			}
+	// Try the role's agent and its fallbacks, rig before town
+	for _, agentName := range roleAgentCandidates(role, townSettings, rigSettings) {
+		if err := ValidateAgentConfig(agentName, townSettings, rigSettings); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: role_agents[%s]=%s - %v, trying next agent\n", role, agentName, err)
+			continue
 		}
+		return lookupAgentConfig(agentName, townSettings, rigSettings)
 	}
 
-	// Check town's RoleAgents
-	if townSettings.RoleAgents != nil {
-		if agentName, ok := townSettings.RoleAgents[role]; ok && agentName != "" {
-			if err := ValidateAgentConfig(agentName, townSettings, rigSettings); err != nil {
-				fmt.Fprintf(os.Stderr, "warning: role_agents[%s]=%s - %v, falling back to default\n", role, agentName, err)
-			} else {
-				return lookupAgentConfig(agentName, townSettings, rigSettings)
-			}
+	// Fall back to existing resolution (rig's Agent → town's DefaultAgent → "claude")
+	return ResolveAgentConfig(townRoot, rigPath)
+}
+
+// roleAgentCandidates returns the agents configured for a role, in the
+// order they should be tried: the rig's role agent and fallbacks, then the
+// town's. Duplicates are dropped.
+func roleAgentCandidates(role string, townSettings *TownSettings, rigSettings *RigSettings) []string {
+	var names []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if rigSettings != nil {
+		add(rigSettings.RoleAgents[role])
+		for _, name := range rigSettings.RoleAgentFallbacks[role] {
+			add(name)
 		}
 	}
+	if townSettings != nil {
+		add(townSettings.RoleAgents[role])
+		for _, name := range townSettings.RoleAgentFallbacks[role] {
+			add(name)
+		}
+	}
+	return names
+}
 
-	// Fall back to existing resolution (rig's Agent → town's DefaultAgent → "claude")
-	return ResolveAgentConfig(townRoot, rigPath)
+// ResolveRoleAgentChain returns the agents a role can be started with, in
+// fallback order: the role's agents from role_agents and
+// role_agent_fallbacks, then the default agent. Agents that aren't defined
+// or whose binary isn't in PATH are left out, except the default agent,
+// which always ends the chain. The first entry is the agent
+// ResolveRoleAgentConfig picks; session managers move down the chain
+// when a session dies on startup.
+func ResolveRoleAgentChain(role, townRoot, rigPath string) []string {
+	var rigSettings *RigSettings
+	if rigPath != "" {
+		var err error
+		rigSettings, err = LoadRigSettings(RigSettingsPath(rigPath))
+		if err != nil {
+			rigSettings = nil
+		}
+	}
+	townSettings, err := LoadOrCreateTownSettings(TownSettingsPath(townRoot))
+	if err != nil {
+		townSettings = NewTownSettings()
+	}
+
+	_ = LoadAgentRegistry(DefaultAgentRegistryPath(townRoot))
+	if rigPath != "" {
+		_ = LoadRigAgentRegistry(RigAgentRegistryPath(rigPath))
+	}
+
+	var chain []string
+	for _, name := range roleAgentCandidates(role, townSettings, rigSettings) {
+		if ValidateAgentConfig(name, townSettings, rigSettings) == nil {
+			chain = append(chain, name)
+		}
+	}
+
+	defaultAgent := "claude"
+	if rigSettings != nil && rigSettings.Agent != "" {
+		defaultAgent = rigSettings.Agent
+	} else if townSettings.DefaultAgent != "" {
+		defaultAgent = townSettings.DefaultAgent
+	}
+	for _, name := range chain {
+		if name == defaultAgent {
+			return chain
+		}
+	}
+	return append(chain, defaultAgent)
 }
 
 // ResolveRoleAgentName returns the agent name that would be used for a specific role.
@@ -1535,6 +1637,21 @@ func BuildStartupCommand(envVars map[string]string, rigPath, prompt string) stri
 		}
 	}
 
+	cmd := startupEnvPrefix(envVars, townRoot, rc)
+
+	// Add runtime command
+	if prompt != "" {
+		cmd += rc.BuildCommandWithPrompt(prompt)
+	} else {
+		cmd += rc.BuildCommand()
+	}
+
+	return cmd
+}
+
+// startupEnvPrefix returns the "exec env K=V ... " prefix for a startup
+// command: envVars plus GT_ROOT, GT_SESSION_ID_ENV and the agent's own env.
+func startupEnvPrefix(envVars map[string]string, townRoot string, rc *RuntimeConfig) string {
 	// Copy env vars to avoid mutating caller map
 	resolvedEnv := make(map[string]string, len(envVars)+2)
 	for k, v := range envVars {
@@ -1561,23 +1678,38 @@ func BuildStartupCommand(envVars map[string]string, rigPath, prompt string) stri
 	// Sort for deterministic output
 	sort.Strings(exports)
 
-	var cmd string
-	if len(exports) > 0 {
-		// Use 'exec env' instead of 'export ... &&' so the agent process
-		// replaces the shell. This allows WaitForCommand to detect the
-		// running agent via pane_current_command (which shows the direct
-		// process, not child processes).
-		cmd = "exec env " + strings.Join(exports, " ") + " "
+	if len(exports) == 0 {
+		return ""
 	}
-
-	// Add runtime command
-	if prompt != "" {
-		cmd += rc.BuildCommandWithPrompt(prompt)
-	} else {
-		cmd += rc.BuildCommand()
+	// Use 'exec env' instead of 'export ... &&' so the agent process
+	// replaces the shell. This allows WaitForCommand to detect the
+	// running agent via pane_current_command (which shows the direct
+	// process, not child processes).
+	return "exec env " + strings.Join(exports, " ") + " "
+}
+
+// BuildResumeStartupCommand is like BuildStartupCommand, but resumes the
+// agent's previous session instead of starting a new one, e.g.
+// "exec env ... claude --dangerously-skip-permissions --resume <id>".
+// agent is the preset the session was started with; empty means the
+// role's agent from GT_ROLE. Returns empty string if the agent doesn't
+// support resume, so callers can fall back to a cold start.
+func BuildResumeStartupCommand(envVars map[string]string, townRoot, rigPath, agent, sessionID string) string {
+	if sessionID == "" {
+		return ""
 	}
-
-	return cmd
+	if agent == "" {
+		agent = ResolveRoleAgentChain(extractSimpleRole(envVars["GT_ROLE"]), townRoot, rigPath)[0]
+	}
+	rc, _, err := ResolveAgentConfigWithOverride(townRoot, rigPath, agent)
+	if err != nil {
+		return ""
+	}
+	resume := buildResumeCommand(AgentPresetForRuntime(agent, rc), sessionID)
+	if resume == "" {
+		return ""
+	}
+	return startupEnvPrefix(envVars, townRoot, rc) + resume
 }
 
 // PrependEnv prepends export statements to a command string.
@@ -1990,6 +2122,15 @@ func validateEscalationConfig(c *EscalationConfig) error {
 		}
 	}
 
+	for severity, sla := range c.SLA {
+		if !IsValidSeverity(severity) {
+			return fmt.Errorf("%w: unknown severity '%s' in sla (valid: low, medium, high, critical)", ErrMissingField, severity)
+		}
+		if d, err := time.ParseDuration(sla); err != nil || d <= 0 {
+			return fmt.Errorf("invalid sla for %s: %q is not a positive duration", severity, sla)
+		}
+	}
+
 	// Validate max_reescalations is non-negative
 	if c.MaxReescalations < 0 {
 		return fmt.Errorf("%w: max_reescalations must be non-negative", ErrMissingField)
@@ -1998,6 +2139,207 @@ func validateEscalationConfig(c *EscalationConfig) error {
 	return nil
 }
 
+// SlackConfigPath returns the standard path for Slack config in a town.
+func SlackConfigPath(townRoot string) string {
+	return filepath.Join(townRoot, "settings", "slack.json")
+}
+
+// LoadSlackConfig loads and validates a Slack configuration file.
+func LoadSlackConfig(path string) (*SlackConfig, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally, not from user input
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, path)
+		}
+		return nil, fmt.Errorf("reading slack config: %w", err)
+	}
+
+	var config SlackConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parsing slack config: %w", err)
+	}
+
+	if err := validateSlackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// validateSlackConfig validates a SlackConfig.
+func validateSlackConfig(c *SlackConfig) error {
+	if c.Type != "slack" && c.Type != "" {
+		return fmt.Errorf("%w: expected type 'slack', got '%s'", ErrInvalidType, c.Type)
+	}
+	if c.Version > CurrentSlackVersion {
+		return fmt.Errorf("%w: got %d, max supported %d", ErrInvalidVersion, c.Version, CurrentSlackVersion)
+	}
+
+	for i, route := range c.Routes {
+		if route.Channel == "" {
+			return fmt.Errorf("%w: routes[%d].channel", ErrMissingField, i)
+		}
+		if route.MinSeverity != "" && !IsValidSeverity(route.MinSeverity) {
+			return fmt.Errorf("routes[%d]: unknown min_severity '%s' (valid: low, medium, high, critical)", i, route.MinSeverity)
+		}
+	}
+
+	if q := c.QuietHours; q != nil {
+		for field, value := range map[string]string{"start": q.Start, "end": q.End} {
+			if _, err := time.Parse("15:04", value); err != nil {
+				return fmt.Errorf("invalid quiet_hours.%s %q: want HH:MM", field, value)
+			}
+		}
+		if q.Timezone != "" {
+			if _, err := time.LoadLocation(q.Timezone); err != nil {
+				return fmt.Errorf("invalid quiet_hours.timezone: %w", err)
+			}
+		}
+		if q.MinSeverity != "" && !IsValidSeverity(q.MinSeverity) {
+			return fmt.Errorf("quiet_hours: unknown min_severity '%s' (valid: low, medium, high, critical)", q.MinSeverity)
+		}
+	}
+
+	return nil
+}
+
+// DecisionPolicyConfigPath returns the standard path for decision policy config in a town.
+func DecisionPolicyConfigPath(townRoot string) string {
+	return filepath.Join(townRoot, "settings", "decision-policy.json")
+}
+
+// LoadDecisionPolicyConfig loads and validates a decision policy configuration file.
+func LoadDecisionPolicyConfig(path string) (*DecisionPolicyConfig, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally, not from user input
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, path)
+		}
+		return nil, fmt.Errorf("reading decision policy config: %w", err)
+	}
+
+	var config DecisionPolicyConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parsing decision policy config: %w", err)
+	}
+
+	if err := validateDecisionPolicyConfig(&config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// LoadOrCreateDecisionPolicyConfig loads the decision policy config,
+// returning an empty one (no delegation) if not found.
+func LoadOrCreateDecisionPolicyConfig(path string) (*DecisionPolicyConfig, error) {
+	config, err := LoadDecisionPolicyConfig(path)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return NewDecisionPolicyConfig(), nil
+		}
+		return nil, err
+	}
+	return config, nil
+}
+
+// validateDecisionPolicyConfig validates a DecisionPolicyConfig.
+func validateDecisionPolicyConfig(c *DecisionPolicyConfig) error {
+	if c.Type != "decision-policy" && c.Type != "" {
+		return fmt.Errorf("%w: expected type 'decision-policy', got '%s'", ErrInvalidType, c.Type)
+	}
+	if c.Version > CurrentDecisionPolicyVersion {
+		return fmt.Errorf("%w: got %d, max supported %d", ErrInvalidVersion, c.Version, CurrentDecisionPolicyVersion)
+	}
+
+	for i, p := range c.Policies {
+		if p.Name == "" {
+			return fmt.Errorf("%w: policies[%d].name", ErrMissingField, i)
+		}
+		if p.Delegate == "" {
+			return fmt.Errorf("%w: policies[%d].delegate", ErrMissingField, i)
+		}
+		switch p.Delegate {
+		case "mayor", "deacon", "witness", "refinery":
+		default:
+			return fmt.Errorf("policies[%d]: delegate must be mayor, deacon, witness or refinery, got '%s'", i, p.Delegate)
+		}
+		for _, role := range p.Roles {
+			if !IsValidRoleName(role) {
+				return fmt.Errorf("policies[%d]: unknown role '%s'", i, role)
+			}
+		}
+		if p.MaxUrgency != "" && decisionUrgencyRank(p.MaxUrgency) < 0 {
+			return fmt.Errorf("policies[%d]: unknown max_urgency '%s' (valid: low, medium, high)", i, p.MaxUrgency)
+		}
+	}
+
+	return nil
+}
+
+// MatchDecision returns the first policy covering a decision requested by
+// an agent with the given rig and role, or nil if the overseer should
+// handle it. Decisions with an unknown urgency are never delegated.
+func (c *DecisionPolicyConfig) MatchDecision(rig, role, urgency string, labels []string) *DecisionPolicy {
+	if c == nil {
+		return nil
+	}
+	rank := decisionUrgencyRank(urgency)
+	if rank < 0 {
+		return nil
+	}
+	if rig == "" {
+		rig = "town"
+	}
+
+	for i := range c.Policies {
+		p := &c.Policies[i]
+		if p.Rig != "" && p.Rig != "*" && p.Rig != rig {
+			continue
+		}
+		if len(p.Roles) > 0 && !slices.Contains(p.Roles, role) {
+			continue
+		}
+		maxUrgency := p.MaxUrgency
+		if maxUrgency == "" {
+			maxUrgency = "low"
+		}
+		if rank > decisionUrgencyRank(maxUrgency) {
+			continue
+		}
+		if !containsAll(labels, p.Labels) {
+			continue
+		}
+		return p
+	}
+	return nil
+}
+
+// decisionUrgencyRank orders decision urgencies from 0 (low) to 2 (high).
+// Returns -1 for unknown urgencies.
+func decisionUrgencyRank(urgency string) int {
+	switch urgency {
+	case "low":
+		return 0
+	case "medium":
+		return 1
+	case "high":
+		return 2
+	default:
+		return -1
+	}
+}
+
+// containsAll reports whether every element of want is in have.
+func containsAll(have, want []string) bool {
+	for _, w := range want {
+		if !slices.Contains(have, w) {
+			return false
+		}
+	}
+	return true
+}
+
 // GetStaleThreshold returns the stale threshold as a time.Duration.
 // Returns 4 hours if not configured or invalid.
 func (c *EscalationConfig) GetStaleThreshold() time.Duration {
@@ -2011,6 +2353,24 @@ func (c *EscalationConfig) GetStaleThreshold() time.Duration {
 	return d
 }
 
+// GetSLA returns how long an escalation of the given severity may go
+// unacknowledged before it is re-escalated, falling back to the stale
+// threshold when the severity has no SLA.
+func (c *EscalationConfig) GetSLA(severity string) time.Duration {
+	if d, err := time.ParseDuration(c.SLA[severity]); err == nil && d > 0 {
+		return d
+	}
+	return c.GetStaleThreshold()
+}
+
+// GetBreachActions returns the extra actions for an SLA breach.
+func (c *EscalationConfig) GetBreachActions() []string {
+	if len(c.OnBreach) > 0 {
+		return c.OnBreach
+	}
+	return []string{"slack", "mail:overseer"}
+}
+
 // GetRouteForSeverity returns the escalation route actions for a given severity.
 // Falls back to ["bead", "mail:mayor"] if no specific route is configured.
 func (c *EscalationConfig) GetRouteForSeverity(severity string) []string {
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -245,6 +246,43 @@ func TestRigSettingsWithCustomMergeQueue(t *testing.T) {
 	}
 }
 
+func TestValidateMergePolicy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		policy  *MergePolicy
+		wantErr bool
+	}{
+		{"empty", &MergePolicy{}, false},
+		{"squash with checks", &MergePolicy{Method: MergeMethodSquash, RequiredChecks: []string{"test", "lint"}, RequiredApprovals: 1}, false},
+		{"unknown method", &MergePolicy{Method: "octopus"}, true},
+		{"negative approvals", &MergePolicy{RequiredApprovals: -1}, true},
+		{"empty check", &MergePolicy{RequiredChecks: []string{" "}}, true},
+		{"duplicate check", &MergePolicy{RequiredChecks: []string{"test", "test"}}, true},
+	}
+	for _, tt := range tests {
+		err := ValidateMergePolicy(tt.policy)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: ValidateMergePolicy() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidMergePolicy) {
+			t.Errorf("%s: error %v does not wrap ErrInvalidMergePolicy", tt.name, err)
+		}
+	}
+
+	// Invalid policies are rejected when settings are saved
+	settings := NewRigSettings()
+	settings.MergeQueue.Policy = &MergePolicy{Method: "octopus"}
+	if err := SaveRigSettings(filepath.Join(t.TempDir(), "config.json"), settings); err == nil {
+		t.Error("SaveRigSettings accepted an invalid merge policy")
+	}
+
+	var nilPolicy *MergePolicy
+	if got := nilPolicy.EffectiveMethod(); got != MergeMethodRebase {
+		t.Errorf("nil policy method = %q, want %q", got, MergeMethodRebase)
+	}
+}
+
 func TestRigConfigValidation(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -1347,6 +1385,39 @@ func TestBuildPolecatStartupCommandWithAgentOverride(t *testing.T) {
 	}
 }
 
+func TestBuildResumeStartupCommand(t *testing.T) {
+	t.Parallel()
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "testrig")
+
+	townSettings := NewTownSettings()
+	townSettings.Agents = map[string]*RuntimeConfig{
+		"claude-opus": {Command: "claude", Args: []string{"--dangerously-skip-permissions", "--model", "opus"}},
+	}
+	if err := SaveTownSettings(TownSettingsPath(townRoot), townSettings); err != nil {
+		t.Fatalf("SaveTownSettings: %v", err)
+	}
+	if err := SaveRigSettings(RigSettingsPath(rigPath), NewRigSettings()); err != nil {
+		t.Fatalf("SaveRigSettings: %v", err)
+	}
+	envVars := AgentEnv(AgentEnvConfig{Role: "polecat", Rig: "testrig", AgentName: "toast", TownRoot: townRoot})
+
+	cmd := BuildResumeStartupCommand(envVars, townRoot, rigPath, "claude-opus", "sess-123")
+	if !strings.HasPrefix(cmd, "exec env ") || !strings.Contains(cmd, "GT_POLECAT=toast") {
+		t.Errorf("expected env prefix in command: %q", cmd)
+	}
+	if !strings.HasSuffix(cmd, "claude --dangerously-skip-permissions --model opus --resume sess-123") {
+		t.Errorf("expected custom agent resumed with its own args: %q", cmd)
+	}
+
+	if cmd := BuildResumeStartupCommand(envVars, townRoot, rigPath, "codex", "thread-1"); !strings.HasSuffix(cmd, "codex resume thread-1 --yolo") {
+		t.Errorf("expected codex resume subcommand: %q", cmd)
+	}
+	if cmd := BuildResumeStartupCommand(envVars, townRoot, rigPath, "opencode", "sess-123"); cmd != "" {
+		t.Errorf("opencode can't resume, got %q", cmd)
+	}
+}
+
 func TestBuildAgentStartupCommandWithAgentOverride(t *testing.T) {
 	townRoot := t.TempDir()
 
@@ -1660,6 +1731,44 @@ func TestResolveRoleAgentConfig_FallsBackOnInvalidAgent(t *testing.T) {
 	}
 }
 
+func TestResolveRoleAgentChain_SkipsUnusableAgents(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake agent binary is a shell script")
+	}
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "testrig")
+
+	backup := filepath.Join(t.TempDir(), "backup-agent")
+	if err := os.WriteFile(backup, []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	townSettings := NewTownSettings()
+	townSettings.Agents = map[string]*RuntimeConfig{
+		"primary": {Command: "/nonexistent/primary-agent"},
+		"backup":  {Command: backup},
+	}
+	townSettings.RoleAgents = map[string]string{constants.RolePolecat: "primary"}
+	townSettings.RoleAgentFallbacks = map[string][]string{
+		constants.RolePolecat: {"nonexistent-agent-xyz", "backup", "primary"},
+	}
+	if err := SaveTownSettings(TownSettingsPath(townRoot), townSettings); err != nil {
+		t.Fatalf("SaveTownSettings: %v", err)
+	}
+	if err := SaveRigSettings(RigSettingsPath(rigPath), NewRigSettings()); err != nil {
+		t.Fatalf("SaveRigSettings: %v", err)
+	}
+
+	chain := ResolveRoleAgentChain(constants.RolePolecat, townRoot, rigPath)
+	if len(chain) != 2 || chain[0] != "backup" || chain[1] != "claude" {
+		t.Errorf("chain = %v, want [backup claude]", chain)
+	}
+	if rc := ResolveRoleAgentConfig(constants.RolePolecat, townRoot, rigPath); rc.Command != backup {
+		t.Errorf("resolved command = %q, want the first usable fallback %q", rc.Command, backup)
+	}
+}
+
 func TestGetRuntimeCommand_UsesRigAgentWhenRigPathProvided(t *testing.T) {
 	t.Parallel()
 	townRoot := t.TempDir()
@@ -3330,6 +3439,26 @@ func TestEscalationConfigValidation(t *testing.T) {
 			wantErr: true,
 			errMsg:  "max_reescalations must be non-negative",
 		},
+		{
+			name: "invalid sla severity",
+			config: &EscalationConfig{
+				Type:    "escalation",
+				Version: 1,
+				SLA:     map[string]string{"urgent": "5m"},
+			},
+			wantErr: true,
+			errMsg:  "unknown severity 'urgent' in sla",
+		},
+		{
+			name: "invalid sla duration",
+			config: &EscalationConfig{
+				Type:    "escalation",
+				Version: 1,
+				SLA:     map[string]string{SeverityHigh: "soon"},
+			},
+			wantErr: true,
+			errMsg:  "invalid sla for high",
+		},
 	}
 
 	for _, tt := range tests {
@@ -3396,6 +3525,31 @@ func TestEscalationConfigGetStaleThreshold(t *testing.T) {
 	}
 }
 
+func TestEscalationConfigGetSLA(t *testing.T) {
+	t.Parallel()
+
+	defaults := NewEscalationConfig()
+	if got := defaults.GetSLA(SeverityCritical); got != 15*time.Minute {
+		t.Errorf("default critical SLA = %v, want 15m", got)
+	}
+	if got := defaults.GetSLA(SeverityLow); got != 24*time.Hour {
+		t.Errorf("default low SLA = %v, want 24h", got)
+	}
+
+	// Severities without an SLA fall back to the stale threshold.
+	cfg := &EscalationConfig{StaleThreshold: "2h", SLA: map[string]string{SeverityHigh: "30m"}}
+	if got := cfg.GetSLA(SeverityHigh); got != 30*time.Minute {
+		t.Errorf("GetSLA(high) = %v, want 30m", got)
+	}
+	if got := cfg.GetSLA(SeverityMedium); got != 2*time.Hour {
+		t.Errorf("GetSLA(medium) = %v, want stale threshold 2h", got)
+	}
+
+	if got := cfg.GetBreachActions(); len(got) != 2 || got[0] != "slack" || got[1] != "mail:overseer" {
+		t.Errorf("default GetBreachActions() = %v", got)
+	}
+}
+
 func TestEscalationConfigGetRouteForSeverity(t *testing.T) {
 	t.Parallel()
 
@@ -3530,6 +3684,158 @@ func TestEscalationConfigPath(t *testing.T) {
 	}
 }
 
+func TestSlackConfigValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config *SlackConfig
+		errMsg string
+	}{
+		{
+			name: "valid routing",
+			config: &SlackConfig{
+				Type:    "slack",
+				Version: 1,
+				Routes: []SlackRoute{
+					{Rig: "gastown", Channel: "C01", MinSeverity: SeverityHigh},
+					{Rig: "*", Channel: "#ops"},
+				},
+				QuietHours: &SlackQuietHours{Start: "22:00", End: "07:00", Timezone: "UTC"},
+			},
+		},
+		{
+			name:   "route without channel",
+			config: &SlackConfig{Routes: []SlackRoute{{Rig: "gastown"}}},
+			errMsg: "routes[0].channel",
+		},
+		{
+			name:   "unknown route severity",
+			config: &SlackConfig{Routes: []SlackRoute{{Channel: "C01", MinSeverity: "urgent"}}},
+			errMsg: "unknown min_severity",
+		},
+		{
+			name:   "bad quiet hours time",
+			config: &SlackConfig{QuietHours: &SlackQuietHours{Start: "10pm", End: "07:00"}},
+			errMsg: "invalid quiet_hours.start",
+		},
+		{
+			name:   "bad timezone",
+			config: &SlackConfig{QuietHours: &SlackQuietHours{Start: "22:00", End: "07:00", Timezone: "Mars/Olympus"}},
+			errMsg: "invalid quiet_hours.timezone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSlackConfig(tt.config)
+			if tt.errMsg == "" {
+				if err != nil {
+					t.Errorf("validateSlackConfig() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("validateSlackConfig() error = %v, want error containing %q", err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestDecisionPolicyConfigValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config *DecisionPolicyConfig
+		errMsg string
+	}{
+		{
+			name: "valid policies",
+			config: &DecisionPolicyConfig{
+				Type:    "decision-policy",
+				Version: 1,
+				Policies: []DecisionPolicy{
+					{Name: "polecat-low", Roles: []string{"polecat"}, Delegate: "witness"},
+					{Name: "docs", Rig: "*", MaxUrgency: "medium", Labels: []string{"docs"}, Delegate: "mayor"},
+				},
+			},
+		},
+		{
+			name:   "missing name",
+			config: &DecisionPolicyConfig{Policies: []DecisionPolicy{{Delegate: "witness"}}},
+			errMsg: "policies[0].name",
+		},
+		{
+			name:   "delegate is not a single agent",
+			config: &DecisionPolicyConfig{Policies: []DecisionPolicy{{Name: "p", Delegate: "polecat"}}},
+			errMsg: "delegate must be",
+		},
+		{
+			name:   "unknown urgency",
+			config: &DecisionPolicyConfig{Policies: []DecisionPolicy{{Name: "p", Delegate: "witness", MaxUrgency: "urgent"}}},
+			errMsg: "unknown max_urgency",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDecisionPolicyConfig(tt.config)
+			if tt.errMsg == "" {
+				if err != nil {
+					t.Errorf("validateDecisionPolicyConfig() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("validateDecisionPolicyConfig() error = %v, want error containing %q", err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestDecisionPolicyMatchDecision(t *testing.T) {
+	t.Parallel()
+
+	cfg := &DecisionPolicyConfig{Policies: []DecisionPolicy{
+		{Name: "gastown-docs", Rig: "gastown", MaxUrgency: "medium", Labels: []string{"docs"}, Delegate: "witness"},
+		{Name: "polecat-low", Roles: []string{"polecat"}, Delegate: "witness"},
+		{Name: "town-low", Rig: "town", Delegate: "deacon"},
+	}}
+
+	tests := []struct {
+		name               string
+		rig, role, urgency string
+		labels             []string
+		want               string
+	}{
+		{"labels match first", "gastown", "crew", "medium", []string{"gt:decision", "docs"}, "gastown-docs"},
+		{"low polecat decision", "beads", "polecat", "low", nil, "polecat-low"},
+		{"medium polecat decision", "beads", "polecat", "medium", nil, ""},
+		{"crew not covered", "beads", "crew", "low", nil, ""},
+		{"town agent", "", "mayor", "low", nil, "town-low"},
+		{"unknown urgency", "beads", "polecat", "", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cfg.MatchDecision(tt.rig, tt.role, tt.urgency, tt.labels)
+			name := ""
+			if got != nil {
+				name = got.Name
+			}
+			if name != tt.want {
+				t.Errorf("MatchDecision() = %q, want %q", name, tt.want)
+			}
+		})
+	}
+
+	var empty *DecisionPolicyConfig
+	if got := empty.MatchDecision("gastown", "polecat", "low", nil); got != nil {
+		t.Errorf("nil config MatchDecision() = %v, want nil", got)
+	}
+}
+
 func TestBuildStartupCommandWithAgentOverride_PriorityOverRoleAgents(t *testing.T) {
 	t.Parallel()
 	townRoot := t.TempDir()
@@ -4161,3 +4467,35 @@ func TestLoadRigsConfigWithFallback_FallsBackToFilesystem(t *testing.T) {
 		t.Error("expected 'fromfs' rig from filesystem fallback")
 	}
 }
+
+func TestResolveAgentExecutionTarget(t *testing.T) {
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	rigPath := t.TempDir()
+	settings := &RigSettings{
+		Type:    "rig-settings",
+		Version: 1,
+		Execution: &ExecutionConfig{
+			Target: ExecutionTargetK8s,
+			Agents: map[string]ExecutionTarget{"codex": ExecutionTargetDocker},
+			Docker: &DockerExecutionConfig{Image: "gastown/agent"},
+		},
+	}
+	if err := SaveRigSettings(RigSettingsPath(rigPath), settings); err != nil {
+		t.Fatalf("SaveRigSettings: %v", err)
+	}
+
+	tests := []struct {
+		agent, override string
+		want            ExecutionTarget
+	}{
+		{"codex", "", ExecutionTargetDocker},
+		{"claude", "", ExecutionTargetK8s},
+		{"", "", ExecutionTargetK8s},
+		{"codex", "local", ExecutionTargetLocal},
+	}
+	for _, tt := range tests {
+		if got := ResolveAgentExecutionTarget(rigPath, tt.agent, tt.override); got != tt.want {
+			t.Errorf("ResolveAgentExecutionTarget(%q, %q) = %q, want %q", tt.agent, tt.override, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Notification delivery modes.
+const (
+	NotifyModeImmediate = "immediate" // Deliver every notification as it happens
+	NotifyModeDigest    = "digest"    // Batch notifications below ImmediateSeverity
+)
+
+// Notification channels an overseer can receive notifications on.
+const (
+	NotifyChannelSlack = "slack" // Slack, via settings/slack.json routing or SlackChannel
+	NotifyChannelMail  = "mail"  // Gas Town mail to the overseer
+	NotifyChannelPush  = "push"  // Mobile clients watching the event feed, and PushURL
+)
+
+// Notification event types an overseer can turn on or off.
+const (
+	NotifyEventEscalation = "escalation"   // Escalations raised with gt escalate
+	NotifyEventSLABreach  = "sla_breach"   // Escalations re-escalated past their SLA
+	NotifyEventDecision   = "decision"     // Decisions waiting on the overseer
+	NotifyEventMail       = "mail"         // Mail delivered to the overseer
+	NotifyEventConvoy     = "convoy"       // Convoy progress
+	NotifyEventBudget     = "usage_budget" // Usage budgets crossing their limit
+)
+
+// NotifyChannels returns every notification channel.
+func NotifyChannels() []string {
+	return []string{NotifyChannelSlack, NotifyChannelMail, NotifyChannelPush}
+}
+
+// NotifyEvents returns every notification event type, sorted.
+func NotifyEvents() []string {
+	out := []string{
+		NotifyEventEscalation, NotifyEventSLABreach, NotifyEventDecision,
+		NotifyEventMail, NotifyEventConvoy, NotifyEventBudget,
+	}
+	sort.Strings(out)
+	return out
+}
+
+// NotificationPrefs is an overseer's notification preferences. They are
+// stored as JSON in a "notifications" config bead per overseer, and every
+// notification meant for the overseer passes through them: event types
+// can be turned off, channels chosen, and in digest mode anything below
+// ImmediateSeverity is held for an hourly summary instead of interrupting.
+type NotificationPrefs struct {
+	// Mode is "immediate" (default) or "digest".
+	Mode string `json:"mode,omitempty"`
+
+	// DigestInterval is how long notifications are batched in digest mode
+	// (default "1h").
+	DigestInterval string `json:"digest_interval,omitempty"`
+
+	// ImmediateSeverity is the lowest severity delivered at once in digest
+	// mode (default "critical").
+	ImmediateSeverity string `json:"immediate_severity,omitempty"`
+
+	// Channels are the channels to notify on (default slack and mail).
+	Channels []string `json:"channels,omitempty"`
+
+	// Events turns event types on or off. Types not listed are on.
+	Events map[string]bool `json:"events,omitempty"`
+
+	// SlackChannel, if set, receives the overseer's Slack notifications
+	// (a channel ID or a user ID for DMs) instead of the routed channel.
+	SlackChannel string `json:"slack_channel,omitempty"`
+
+	// PushURL, if set, receives push notifications as a JSON POST, e.g. an
+	// ntfy topic or a mobile push gateway.
+	PushURL string `json:"push_url,omitempty"`
+}
+
+// NewNotificationPrefs returns the default preferences: every event,
+// delivered immediately on Slack and mail.
+func NewNotificationPrefs() *NotificationPrefs {
+	return &NotificationPrefs{
+		Mode:     NotifyModeImmediate,
+		Channels: []string{NotifyChannelSlack, NotifyChannelMail},
+	}
+}
+
+// ParseNotificationPrefs parses preferences stored in a config bead,
+// filling in defaults for missing fields.
+func ParseNotificationPrefs(data string) (*NotificationPrefs, error) {
+	p := NewNotificationPrefs()
+	if strings.TrimSpace(data) == "" {
+		return p, nil
+	}
+	p.Channels = nil
+	if err := json.Unmarshal([]byte(data), p); err != nil {
+		return nil, fmt.Errorf("parsing notification preferences: %w", err)
+	}
+	if p.Channels == nil {
+		p.Channels = NewNotificationPrefs().Channels
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Validate checks the preferences for unknown modes, channels, event types
+// and severities.
+func (p *NotificationPrefs) Validate() error {
+	switch p.Mode {
+	case "", NotifyModeImmediate, NotifyModeDigest:
+	default:
+		return fmt.Errorf("invalid mode %q: must be %s or %s", p.Mode, NotifyModeImmediate, NotifyModeDigest)
+	}
+	if p.DigestInterval != "" {
+		if d, err := time.ParseDuration(p.DigestInterval); err != nil || d <= 0 {
+			return fmt.Errorf("invalid digest_interval %q: must be a positive duration", p.DigestInterval)
+		}
+	}
+	if p.ImmediateSeverity != "" && !IsValidSeverity(p.ImmediateSeverity) {
+		return fmt.Errorf("invalid immediate_severity %q: must be critical, high, medium, or low", p.ImmediateSeverity)
+	}
+	for _, c := range p.Channels {
+		if !contains(NotifyChannels(), c) {
+			return fmt.Errorf("unknown channel %q (valid: %s)", c, strings.Join(NotifyChannels(), ", "))
+		}
+	}
+	for e := range p.Events {
+		if !contains(NotifyEvents(), e) {
+			return fmt.Errorf("unknown event type %q (valid: %s)", e, strings.Join(NotifyEvents(), ", "))
+		}
+	}
+	return nil
+}
+
+// GetDigestInterval returns the digest batching interval, 1h by default.
+func (p *NotificationPrefs) GetDigestInterval() time.Duration {
+	if d, err := time.ParseDuration(p.DigestInterval); err == nil && d > 0 {
+		return d
+	}
+	return time.Hour
+}
+
+// ChannelEnabled reports whether the overseer wants notifications on channel.
+func (p *NotificationPrefs) ChannelEnabled(channel string) bool {
+	return contains(p.Channels, channel)
+}
+
+// EventEnabled reports whether the overseer wants notifications of an event
+// type. Types not mentioned in Events are enabled.
+func (p *NotificationPrefs) EventEnabled(event string) bool {
+	on, ok := p.Events[event]
+	return !ok || on
+}
+
+// Immediate reports whether a notification of the given severity is
+// delivered at once rather than held for the digest.
+func (p *NotificationPrefs) Immediate(severity string) bool {
+	if p.Mode != NotifyModeDigest {
+		return true
+	}
+	threshold := p.ImmediateSeverity
+	if threshold == "" {
+		threshold = SeverityCritical
+	}
+	return SeverityRank(severity) >= SeverityRank(threshold)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
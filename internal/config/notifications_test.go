@@ -0,0 +1,50 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseNotificationPrefs(t *testing.T) {
+	p, err := ParseNotificationPrefs("")
+	if err != nil {
+		t.Fatalf("empty: %v", err)
+	}
+	if !p.ChannelEnabled(NotifyChannelSlack) || !p.ChannelEnabled(NotifyChannelMail) || p.ChannelEnabled(NotifyChannelPush) {
+		t.Errorf("default channels = %v", p.Channels)
+	}
+	if !p.Immediate(SeverityLow) {
+		t.Error("immediate mode should deliver low severity at once")
+	}
+
+	p, err = ParseNotificationPrefs(`{"mode":"digest","immediate_severity":"high","channels":["push"],"events":{"convoy":false}}`)
+	if err != nil {
+		t.Fatalf("digest: %v", err)
+	}
+	if p.Immediate(SeverityMedium) || !p.Immediate(SeverityHigh) || !p.Immediate(SeverityCritical) {
+		t.Error("digest mode should hold back only severities below high")
+	}
+	if p.EventEnabled(NotifyEventConvoy) || !p.EventEnabled(NotifyEventEscalation) {
+		t.Errorf("events = %v", p.Events)
+	}
+	if p.ChannelEnabled(NotifyChannelSlack) || !p.ChannelEnabled(NotifyChannelPush) {
+		t.Errorf("channels = %v", p.Channels)
+	}
+	if p.GetDigestInterval() != time.Hour {
+		t.Errorf("default digest interval = %v", p.GetDigestInterval())
+	}
+}
+
+func TestNotificationPrefsValidate(t *testing.T) {
+	for _, data := range []string{
+		`{"mode":"sometimes"}`,
+		`{"digest_interval":"hourly"}`,
+		`{"immediate_severity":"urgent"}`,
+		`{"channels":["pager"]}`,
+		`{"events":{"lunch":true}}`,
+	} {
+		if _, err := ParseNotificationPrefs(data); err == nil {
+			t.Errorf("ParseNotificationPrefs(%s) accepted invalid preferences", data)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// PushConfig configures mobile push notifications (settings/push.json).
+// The RPC server pushes high-urgency decisions, escalations and merge
+// failures to the devices mobile clients register, through Apple Push
+// Notification service for iOS and Firebase Cloud Messaging for Android.
+type PushConfig struct {
+	Type    string `json:"type"`    // "push"
+	Version int    `json:"version"` // schema version
+
+	// APNs, if set, delivers to iOS devices.
+	APNs *APNsConfig `json:"apns,omitempty"`
+
+	// FCM, if set, delivers to Android devices.
+	FCM *FCMConfig `json:"fcm,omitempty"`
+
+	// MinSeverity is the lowest escalation severity pushed (default "high").
+	MinSeverity string `json:"min_severity,omitempty"`
+}
+
+// APNsConfig holds Apple Push Notification service token-based credentials.
+type APNsConfig struct {
+	KeyFile string `json:"key_file"` // .p8 signing key from the Apple developer account
+	KeyID   string `json:"key_id"`
+	TeamID  string `json:"team_id"`
+	Topic   string `json:"topic"`             // App bundle ID
+	Sandbox bool   `json:"sandbox,omitempty"` // Use the development environment
+}
+
+// FCMConfig holds Firebase Cloud Messaging credentials.
+type FCMConfig struct {
+	// CredentialsFile is a Google service account key (JSON) with the
+	// Firebase Cloud Messaging API enabled.
+	CredentialsFile string `json:"credentials_file"`
+
+	// ProjectID overrides the project in the credentials file.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// CurrentPushVersion is the current schema version for PushConfig.
+const CurrentPushVersion = 1
+
+// PushConfigPath returns the standard path for push config in a town.
+func PushConfigPath(townRoot string) string {
+	return filepath.Join(townRoot, "settings", "push.json")
+}
+
+// LoadPushConfig loads and validates a push configuration file.
+func LoadPushConfig(path string) (*PushConfig, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally, not from user input
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, path)
+		}
+		return nil, fmt.Errorf("reading push config: %w", err)
+	}
+
+	var config PushConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parsing push config: %w", err)
+	}
+	if err := validatePushConfig(&config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// validatePushConfig validates a PushConfig.
+func validatePushConfig(c *PushConfig) error {
+	if c.Type != "push" && c.Type != "" {
+		return fmt.Errorf("%w: expected type 'push', got '%s'", ErrInvalidType, c.Type)
+	}
+	if c.Version > CurrentPushVersion {
+		return fmt.Errorf("%w: got %d, max supported %d", ErrInvalidVersion, c.Version, CurrentPushVersion)
+	}
+	if c.MinSeverity != "" && !IsValidSeverity(c.MinSeverity) {
+		return fmt.Errorf("unknown min_severity '%s' (valid: low, medium, high, critical)", c.MinSeverity)
+	}
+	if a := c.APNs; a != nil {
+		switch {
+		case a.KeyFile == "":
+			return fmt.Errorf("%w: apns.key_file", ErrMissingField)
+		case a.KeyID == "":
+			return fmt.Errorf("%w: apns.key_id", ErrMissingField)
+		case a.TeamID == "":
+			return fmt.Errorf("%w: apns.team_id", ErrMissingField)
+		case a.Topic == "":
+			return fmt.Errorf("%w: apns.topic", ErrMissingField)
+		}
+	}
+	if c.FCM != nil && c.FCM.CredentialsFile == "" {
+		return fmt.Errorf("%w: fcm.credentials_file", ErrMissingField)
+	}
+	return nil
+}
+
+// GetMinSeverity returns the lowest escalation severity pushed.
+func (c *PushConfig) GetMinSeverity() string {
+	if c.MinSeverity == "" {
+		return SeverityHigh
+	}
+	return c.MinSeverity
+}
@@ -64,10 +64,61 @@ type TownSettings struct {
 	// Example: {"mayor": "claude-opus", "witness": "claude-haiku", "polecat": "claude-sonnet"}
 	RoleAgents map[string]string `json:"role_agents,omitempty"`
 
+	// RoleAgentFallbacks lists agents to try, in order, when a role's agent
+	// can't run: its binary is missing, or its session dies on startup
+	// (auth error, rate limit). The agent in RoleAgents is tried first,
+	// then these, then the default agent.
+	// Example: {"polecat": ["gemini", "codex"]}
+	RoleAgentFallbacks map[string][]string `json:"role_agent_fallbacks,omitempty"`
+
 	// AgentEmailDomain is the domain used for agent git identity emails.
 	// Agent addresses like "gastown/crew/jack" become "gastown.crew.jack@{domain}".
 	// Default: "gastown.local"
 	AgentEmailDomain string `json:"agent_email_domain,omitempty"`
+
+	// UsageBudgets sets daily spend limits for agent token usage.
+	// The dashboard and UsageService report spend against them, and the
+	// daemon's usage collector alerts when one is crossed.
+	UsageBudgets *UsageBudgets `json:"usage_budgets,omitempty"`
+
+	// PrimeContext sizes the work context gt prime assembles (hooked work,
+	// molecule step, handoffs, mail, knowledge).
+	PrimeContext *PrimeContextConfig `json:"prime_context,omitempty"`
+}
+
+// PrimeContextConfig sets token budgets for gt prime's work context. Zero
+// means the default (prime.DefaultBudget).
+type PrimeContextConfig struct {
+	// BudgetTokens applies to every role without its own budget.
+	BudgetTokens int `json:"budget_tokens,omitempty"`
+
+	// RoleBudgetTokens overrides BudgetTokens per role, keyed like
+	// RoleAgents (e.g. {"polecat": 6000, "witness": 2000}).
+	RoleBudgetTokens map[string]int `json:"role_budget_tokens,omitempty"`
+}
+
+// BudgetFor returns the configured budget for role, or zero.
+func (c *PrimeContextConfig) BudgetFor(role string) int {
+	if c == nil {
+		return 0
+	}
+	if n := c.RoleBudgetTokens[role]; n > 0 {
+		return n
+	}
+	return c.BudgetTokens
+}
+
+// UsageBudgets are daily spend limits in USD. A zero limit is unlimited.
+// Days start at local midnight.
+type UsageBudgets struct {
+	// DailyUSD limits the whole town.
+	DailyUSD float64 `json:"daily_usd,omitempty"`
+
+	// RigDailyUSD limits each rig.
+	RigDailyUSD float64 `json:"rig_daily_usd,omitempty"`
+
+	// AgentDailyUSD limits each agent.
+	AgentDailyUSD float64 `json:"agent_daily_usd,omitempty"`
 }
 
 // NewTownSettings creates a new TownSettings with defaults.
@@ -147,6 +198,65 @@ func NewDaemonPatrolConfig() *DaemonPatrolConfig {
 // DeaconConfig represents deacon process settings.
 type DeaconConfig struct {
 	PatrolInterval string `json:"patrol_interval,omitempty"` // e.g., "5m"
+
+	// Schedule lists maintenance jobs run on cron schedules.
+	Schedule []ScheduledJob `json:"schedule,omitempty"`
+
+	// GCRetention is how long gt gc keeps a finished polecat's worktree
+	// before collecting it (e.g., "24h"). Default: 24h.
+	GCRetention string `json:"gc_retention,omitempty"`
+}
+
+// DefaultGCRetention is how long finished polecat worktrees are kept when
+// DeaconConfig.GCRetention is unset.
+const DefaultGCRetention = 24 * time.Hour
+
+// GCRetentionDuration parses GCRetention, falling back to
+// DefaultGCRetention when unset or invalid. Safe to call on nil.
+func (c *DeaconConfig) GCRetentionDuration() time.Duration {
+	if c == nil || c.GCRetention == "" {
+		return DefaultGCRetention
+	}
+	d, err := time.ParseDuration(c.GCRetention)
+	if err != nil || d < 0 {
+		return DefaultGCRetention
+	}
+	return d
+}
+
+// ScheduledJob is a periodic deacon maintenance job.
+type ScheduledJob struct {
+	// Name identifies the job in status output. Defaults to Job.
+	Name string `json:"name,omitempty"`
+
+	// Cron is a five-field cron expression (minute hour day-of-month month
+	// day-of-week) or a macro such as "@daily", evaluated in local time.
+	Cron string `json:"cron"`
+
+	// Job names a built-in job: compact, stale-hooks, orphans,
+	// rotate-events, convoy-close, or gc.
+	Job string `json:"job,omitempty"`
+
+	// Command runs an arbitrary command in the town root instead of a
+	// built-in job (e.g., ["bd", "compact", "--auto"]).
+	Command []string `json:"command,omitempty"`
+
+	// Disabled keeps the job configured without running it.
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// JobName returns the name the job is reported under.
+func (j ScheduledJob) JobName() string {
+	if j.Name != "" {
+		return j.Name
+	}
+	if j.Job != "" {
+		return j.Job
+	}
+	if len(j.Command) > 0 {
+		return j.Command[0]
+	}
+	return ""
 }
 
 // CurrentMayorConfigVersion is the current schema version for MayorConfig.
@@ -221,6 +331,7 @@ type RigSettings struct {
 	MergeQueue *MergeQueueConfig `json:"merge_queue,omitempty"` // merge queue settings
 	Theme      *ThemeConfig      `json:"theme,omitempty"`       // theme settings
 	Namepool   *NamepoolConfig   `json:"namepool,omitempty"`    // polecat name pool settings
+	WarmPool   *WarmPoolConfig   `json:"warm_pool,omitempty"`   // pre-booted idle polecats for sling
 	Crew       *CrewConfig       `json:"crew,omitempty"`        // crew startup settings
 	Workflow   *WorkflowConfig   `json:"workflow,omitempty"`    // workflow settings
 	Runtime    *RuntimeConfig    `json:"runtime,omitempty"`     // LLM runtime settings (deprecated: use Agent)
@@ -244,6 +355,10 @@ type RigSettings struct {
 	// Example: {"witness": "claude-haiku", "polecat": "claude-sonnet"}
 	RoleAgents map[string]string `json:"role_agents,omitempty"`
 
+	// RoleAgentFallbacks lists agents to try after this rig's
+	// RoleAgents[role], before the town's role agents and fallbacks.
+	RoleAgentFallbacks map[string][]string `json:"role_agent_fallbacks,omitempty"`
+
 	// Execution configures where polecats run for this rig.
 	// Default is local. Set target to "k8s" for Kubernetes pods.
 	Execution *ExecutionConfig `json:"execution,omitempty"`
@@ -257,6 +372,8 @@ const (
 	ExecutionTargetLocal ExecutionTarget = "local"
 	// ExecutionTargetK8s runs polecats as Kubernetes pods via the controller.
 	ExecutionTargetK8s ExecutionTarget = "k8s"
+	// ExecutionTargetDocker runs polecats in local Docker containers.
+	ExecutionTargetDocker ExecutionTarget = "docker"
 )
 
 // ResolveExecutionTarget determines the execution target for a rig.
@@ -286,14 +403,56 @@ func ResolveExecutionTarget(rigPath, override string) ExecutionTarget {
 	return ExecutionTargetLocal
 }
 
+// ResolveAgentExecutionTarget is ResolveExecutionTarget for polecats that
+// run agent: the rig's execution.agents entry for the agent, if any, takes
+// precedence over the rig's target.
+func ResolveAgentExecutionTarget(rigPath, agent, override string) ExecutionTarget {
+	if override == "" && rigPath != "" && agent != "" {
+		settings, err := LoadRigSettings(filepath.Join(rigPath, "settings", "config.json"))
+		if err == nil && settings.Execution != nil {
+			if target := settings.Execution.Agents[agent]; target != "" {
+				return target
+			}
+		}
+	}
+	return ResolveExecutionTarget(rigPath, override)
+}
+
 // ExecutionConfig configures polecat execution target for a rig.
 type ExecutionConfig struct {
-	// Target is "local" (default) or "k8s".
+	// Target is "local" (default), "k8s" or "docker".
 	Target ExecutionTarget `json:"target,omitempty"`
 
+	// Agents overrides Target for polecats running particular agents,
+	// keyed by agent name (e.g. {"codex": "docker"}).
+	Agents map[string]ExecutionTarget `json:"agents,omitempty"`
+
 	// K8s contains Kubernetes-specific configuration.
 	// Only used when Target is "k8s".
 	K8s *K8sExecutionConfig `json:"k8s,omitempty"`
+
+	// Docker contains local container configuration.
+	// Used for polecats whose target is "docker".
+	Docker *DockerExecutionConfig `json:"docker,omitempty"`
+}
+
+// DockerExecutionConfig holds local container execution parameters.
+// The town root is always mounted at its host path, so worktrees, beads
+// and settings resolve the same inside the container as outside it.
+type DockerExecutionConfig struct {
+	// Image is the agent image. It must provide tmux, a shell, the agent
+	// CLI, gt and bd.
+	Image string `json:"image"`
+
+	// Network is the docker network to attach containers to.
+	Network string `json:"network,omitempty"`
+
+	// Mounts are extra bind mounts in docker's -v form, e.g. for agent
+	// credentials ("~/.claude:/home/agent/.claude").
+	Mounts []string `json:"mounts,omitempty"`
+
+	// Env is set in every container.
+	Env map[string]string `json:"env,omitempty"`
 }
 
 // K8sExecutionConfig holds Kubernetes execution parameters.
@@ -776,6 +935,8 @@ type MergeQueueConfig struct {
 	IntegrationBranchTemplate string `json:"integration_branch_template,omitempty"`
 
 	// OnConflict specifies conflict resolution strategy: "assign_back" or "auto_rebase".
+	// assign_back nudges the originating polecat; auto_rebase spawns a fixer polecat.
+	// Applied by `gt mq conflict`.
 	OnConflict string `json:"on_conflict"`
 
 	// RunTests controls whether to run tests before merging.
@@ -801,6 +962,49 @@ type MergeQueueConfig struct {
 	// PROptions contains settings for PR-based merge strategies.
 	// Only used when Strategy is "pr_to_main" or "pr_to_branch".
 	PROptions *PROptions `json:"pr_options,omitempty"`
+
+	// Policy gates merges on checks and approvals and picks how commits land.
+	// gt done lands direct merges with it; gt rig policy check gates the rest.
+	Policy *MergePolicy `json:"policy,omitempty"`
+}
+
+// MergePolicy is a rig's merge policy.
+type MergePolicy struct {
+	// Method is how the branch lands: "squash", "merge", or "rebase".
+	// Default: "rebase" (rebase onto the target, then fast-forward).
+	Method string `json:"method,omitempty"`
+
+	// RequiredChecks are CI check names that must have passed.
+	RequiredChecks []string `json:"required_checks,omitempty"`
+
+	// RequiredApprovals is the minimum number of approving reviews.
+	RequiredApprovals int `json:"required_approvals,omitempty"`
+}
+
+// Merge method constants for MergePolicy.Method.
+const (
+	MergeMethodSquash = "squash"
+	MergeMethodMerge  = "merge"
+	MergeMethodRebase = "rebase"
+)
+
+// ValidMergeMethods returns all valid merge method values.
+func ValidMergeMethods() []string {
+	return []string{MergeMethodSquash, MergeMethodMerge, MergeMethodRebase}
+}
+
+// EffectiveMethod returns the configured merge method, defaulting to rebase.
+func (p *MergePolicy) EffectiveMethod() string {
+	if p == nil || p.Method == "" {
+		return MergeMethodRebase
+	}
+	return p.Method
+}
+
+// RequiresPR reports whether the policy needs forge data (checks or
+// approvals) that only a pull request can provide.
+func (p *MergePolicy) RequiresPR() bool {
+	return p != nil && (len(p.RequiredChecks) > 0 || p.RequiredApprovals > 0)
 }
 
 // PROptions contains settings for PR-based merge strategies.
@@ -916,6 +1120,36 @@ func DefaultNamepoolConfig() *NamepoolConfig {
 	}
 }
 
+// DefaultWarmPoolIdleTimeout is how long a warm polecat waits for work
+// before it is reclaimed and replaced.
+const DefaultWarmPoolIdleTimeout = 2 * time.Hour
+
+// WarmPoolConfig keeps polecats spawned and booted ahead of demand, so gt
+// sling can hand one its work instead of waiting for a clone and agent start.
+// The daemon tops the pool back up after each claim.
+type WarmPoolConfig struct {
+	// Size is how many idle polecats to keep ready. 0 disables the pool.
+	Size int `json:"size"`
+
+	// IdleTimeout is how long a pool polecat may sit unclaimed before it is
+	// reclaimed and replaced with a fresh one (Go duration, default "2h").
+	// Recycling keeps pool workspaces close to the default branch.
+	IdleTimeout string `json:"idle_timeout,omitempty"`
+}
+
+// IdleTimeoutDuration parses IdleTimeout, falling back to
+// DefaultWarmPoolIdleTimeout when it is unset or invalid.
+func (c *WarmPoolConfig) IdleTimeoutDuration() time.Duration {
+	if c == nil || c.IdleTimeout == "" {
+		return DefaultWarmPoolIdleTimeout
+	}
+	d, err := time.ParseDuration(c.IdleTimeout)
+	if err != nil || d <= 0 {
+		return DefaultWarmPoolIdleTimeout
+	}
+	return d
+}
+
 // AccountsConfig represents Claude Code account configuration (mayor/accounts.json).
 // This enables Gas Town to manage multiple Claude Code accounts with easy switching.
 type AccountsConfig struct {
@@ -1033,6 +1267,16 @@ type EscalationConfig struct {
 	// MaxReescalations limits how many times an escalation can be
 	// re-escalated. Default: 2 (low→medium→high, then stops)
 	MaxReescalations int `json:"max_reescalations,omitempty"`
+
+	// SLA maps severity to how long an escalation may go unacknowledged
+	// (Go duration, e.g. "15m"). The timer restarts at each re-escalation.
+	// Severities without an entry use StaleThreshold.
+	SLA map[string]string `json:"sla,omitempty"`
+
+	// OnBreach lists the actions taken, in addition to the new severity's
+	// route, when an escalation breaches its SLA and is re-escalated.
+	// Accepts "slack" and "mail:<target>". Default: ["slack", "mail:overseer"]
+	OnBreach []string `json:"on_breach,omitempty"`
 }
 
 // EscalationContacts contains contact information for external notification channels.
@@ -1083,6 +1327,21 @@ func NextSeverity(severity string) string {
 	}
 }
 
+// SeverityRank orders severity levels from 0 (low) to 3 (critical).
+// Unknown severities rank as medium, the escalation default.
+func SeverityRank(severity string) int {
+	switch severity {
+	case SeverityLow:
+		return 0
+	case SeverityHigh:
+		return 2
+	case SeverityCritical:
+		return 3
+	default:
+		return 1
+	}
+}
+
 // NewEscalationConfig creates a new EscalationConfig with sensible defaults.
 func NewEscalationConfig() *EscalationConfig {
 	return &EscalationConfig{
@@ -1097,6 +1356,12 @@ func NewEscalationConfig() *EscalationConfig {
 		Contacts:         EscalationContacts{},
 		StaleThreshold:   "4h",
 		MaxReescalations: 2,
+		SLA: map[string]string{
+			SeverityCritical: "15m",
+			SeverityHigh:     "1h",
+			SeverityMedium:   "4h",
+			SeverityLow:      "24h",
+		},
 	}
 }
 
@@ -1133,6 +1398,45 @@ type SlackConfig struct {
 	// AppToken is the Slack app-level token for Socket Mode (xapp-...).
 	// Can also be set via SLACK_APP_TOKEN environment variable.
 	AppToken string `json:"app_token,omitempty"`
+
+	// Routes sends escalations to channels by rig and severity. Rules are
+	// evaluated in order and the first match wins; escalations matching no
+	// rule go to DefaultChannel.
+	Routes []SlackRoute `json:"routes,omitempty"`
+
+	// QuietHours holds back lower-severity notifications during off hours.
+	QuietHours *SlackQuietHours `json:"quiet_hours,omitempty"`
+}
+
+// SlackRoute routes a rig's notifications to a channel.
+type SlackRoute struct {
+	// Rig is the rig name, or "*" (or empty) for any rig. Town-level agents
+	// such as the mayor have rig "town".
+	Rig string `json:"rig,omitempty"`
+
+	// Channel is the Slack channel ID or name.
+	Channel string `json:"channel"`
+
+	// MinSeverity is the lowest severity this rule accepts (low, medium,
+	// high, critical). Empty accepts all severities.
+	MinSeverity string `json:"min_severity,omitempty"`
+}
+
+// SlackQuietHours is a daily window during which only severe notifications
+// are delivered.
+type SlackQuietHours struct {
+	// Start and End are "HH:MM" times. A window whose end is before its start
+	// spans midnight (e.g. "22:00" to "07:00").
+	Start string `json:"start"`
+	End   string `json:"end"`
+
+	// Timezone is an IANA zone name (e.g. "America/Los_Angeles"). Empty
+	// means the server's local time.
+	Timezone string `json:"timezone,omitempty"`
+
+	// MinSeverity is the lowest severity still delivered during quiet hours.
+	// Default: "critical".
+	MinSeverity string `json:"min_severity,omitempty"`
 }
 
 // CurrentSlackVersion is the current schema version for SlackConfig.
@@ -1148,3 +1452,53 @@ func NewSlackConfig() *SlackConfig {
 		ChannelNames: make(map[string]string),
 	}
 }
+
+// DecisionPolicyConfig represents decision delegation policies
+// (settings/decision-policy.json). Policies let designated agents resolve
+// routine decisions so the overseer only sees the high-stakes ones.
+type DecisionPolicyConfig struct {
+	Type    string `json:"type"`    // "decision-policy"
+	Version int    `json:"version"` // schema version
+
+	// Policies are evaluated in order and the first match wins. Decisions
+	// matching no policy go to the overseer.
+	Policies []DecisionPolicy `json:"policies"`
+}
+
+// DecisionPolicy delegates a class of decisions to an agent role.
+type DecisionPolicy struct {
+	// Name identifies the policy; it is recorded on decisions it authorizes.
+	Name string `json:"name"`
+
+	// Rig is the requester's rig, or "*" (or empty) for any rig. Town-level
+	// agents such as the mayor have rig "town".
+	Rig string `json:"rig,omitempty"`
+
+	// Roles limits the policy to decisions requested by these roles
+	// (e.g., "polecat", "crew"). Empty matches any role.
+	Roles []string `json:"roles,omitempty"`
+
+	// MaxUrgency is the highest urgency the policy covers (low, medium,
+	// high). Default: "low".
+	MaxUrgency string `json:"max_urgency,omitempty"`
+
+	// Labels must all be present on the decision. Empty matches any decision.
+	Labels []string `json:"labels,omitempty"`
+
+	// Delegate is the role allowed to resolve matching decisions: mayor,
+	// deacon, witness or refinery. Rig roles resolve decisions from their
+	// own rig.
+	Delegate string `json:"delegate"`
+}
+
+// CurrentDecisionPolicyVersion is the current schema version for DecisionPolicyConfig.
+const CurrentDecisionPolicyVersion = 1
+
+// NewDecisionPolicyConfig creates an empty DecisionPolicyConfig, which
+// delegates nothing.
+func NewDecisionPolicyConfig() *DecisionPolicyConfig {
+	return &DecisionPolicyConfig{
+		Type:    "decision-policy",
+		Version: CurrentDecisionPolicyVersion,
+	}
+}
@@ -0,0 +1,60 @@
+package configbeads
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// notificationsSlug is the config bead slug holding an overseer's
+// notification preferences.
+func notificationsSlug(overseer string) string {
+	return "notifications-" + overseer
+}
+
+// LoadNotificationPrefs reads an overseer's notification preferences from
+// their notifications config bead. Returns the defaults when the overseer
+// has none.
+func LoadNotificationPrefs(bd *beads.Beads, overseer string) (*config.NotificationPrefs, error) {
+	issue, fields, err := bd.GetConfigBeadBySlug(notificationsSlug(overseer))
+	if err != nil {
+		return nil, fmt.Errorf("reading notifications bead: %w", err)
+	}
+	if issue == nil {
+		return config.NewNotificationPrefs(), nil
+	}
+	prefs, err := config.ParseNotificationPrefs(fields.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("notifications bead %s: %w", issue.ID, err)
+	}
+	return prefs, nil
+}
+
+// SaveNotificationPrefs stores an overseer's notification preferences,
+// creating their notifications config bead on first use.
+func SaveNotificationPrefs(bd *beads.Beads, townName, overseer string, prefs *config.NotificationPrefs) error {
+	if err := prefs.Validate(); err != nil {
+		return err
+	}
+	metadata, err := json.Marshal(prefs)
+	if err != nil {
+		return err
+	}
+
+	slug := notificationsSlug(overseer)
+	issue, _, err := bd.GetConfigBeadBySlug(slug)
+	if err != nil {
+		return fmt.Errorf("reading notifications bead: %w", err)
+	}
+	if issue != nil {
+		return bd.UpdateConfigMetadata(issue.ID, string(metadata))
+	}
+	_, err = bd.CreateConfigBead(slug, &beads.ConfigFields{
+		Rig:      townName,
+		Category: beads.ConfigCategoryNotifications,
+		Metadata: string(metadata),
+	}, "", "")
+	return err
+}
@@ -0,0 +1,38 @@
+package configbeads
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestSaveNotificationPrefs(t *testing.T) {
+	dir := setupTestTown(t)
+	bd := setupTestBeads(t, dir)
+
+	prefs, err := LoadNotificationPrefs(bd, "steve")
+	if err != nil {
+		t.Skipf("bd query failed (known bd CLI issue): %v", err)
+	}
+	if prefs.Mode != config.NotifyModeImmediate {
+		t.Errorf("default mode = %q, want immediate", prefs.Mode)
+	}
+
+	if err := SaveNotificationPrefs(bd, "testtown", "steve", &config.NotificationPrefs{Mode: "sometimes"}); err == nil {
+		t.Error("SaveNotificationPrefs accepted an invalid mode")
+	}
+
+	prefs.Mode = config.NotifyModeDigest
+	prefs.Events = map[string]bool{config.NotifyEventConvoy: false}
+	if err := SaveNotificationPrefs(bd, "testtown", "steve", prefs); err != nil {
+		t.Skipf("bd create failed (known bd CLI issue): %v", err)
+	}
+
+	got, err := LoadNotificationPrefs(bd, "steve")
+	if err != nil {
+		t.Fatalf("LoadNotificationPrefs: %v", err)
+	}
+	if got.Mode != config.NotifyModeDigest || got.EventEnabled(config.NotifyEventConvoy) {
+		t.Errorf("prefs = %+v", got)
+	}
+}
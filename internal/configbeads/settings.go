@@ -0,0 +1,80 @@
+package configbeads
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// LoadKnobsFromBeadsScope queries settings config beads for the given scope
+// and returns the merged knob values, rig beads overriding town beads.
+// Returns nil, nil if no settings beads set a knob.
+func LoadKnobsFromBeadsScope(bd *beads.Beads, townName, rigName string) (map[string]string, error) {
+	_, fields, err := bd.ListConfigBeadsForScope(
+		beads.ConfigCategorySettings, townName, rigName, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("listing settings beads: %w", err)
+	}
+
+	var layers []string
+	for _, f := range fields {
+		if f.Metadata != "" {
+			layers = append(layers, f.Metadata)
+		}
+	}
+	return config.LoadKnobsFromBeads(layers), nil
+}
+
+// SetKnob stores a knob value in the town's settings bead, creating the
+// bead on first use.
+func SetKnob(bd *beads.Beads, townName, key, value string) error {
+	if err := config.ValidateKnob(key, value); err != nil {
+		return err
+	}
+	return updateKnobs(bd, townName, func(m map[string]interface{}) {
+		m[key] = value
+	})
+}
+
+// UnsetKnob removes a knob value from the town's settings bead, so the
+// default applies again.
+func UnsetKnob(bd *beads.Beads, townName, key string) error {
+	if _, ok := config.LookupKnob(key); !ok {
+		return fmt.Errorf("unknown setting %q", key)
+	}
+	return updateKnobs(bd, townName, func(m map[string]interface{}) {
+		delete(m, key)
+	})
+}
+
+func updateKnobs(bd *beads.Beads, townName string, edit func(map[string]interface{})) error {
+	slug := "settings-" + townName
+	issue, fields, err := bd.GetConfigBeadBySlug(slug)
+	if err != nil {
+		return fmt.Errorf("reading settings bead: %w", err)
+	}
+
+	values := make(map[string]interface{})
+	if issue != nil && fields.Metadata != "" {
+		if err := json.Unmarshal([]byte(fields.Metadata), &values); err != nil {
+			return fmt.Errorf("parsing settings bead %s: %w", issue.ID, err)
+		}
+	}
+	edit(values)
+	metadata, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	if issue != nil {
+		return bd.UpdateConfigMetadata(issue.ID, string(metadata))
+	}
+	_, err = bd.CreateConfigBead(slug, &beads.ConfigFields{
+		Rig:      townName,
+		Category: beads.ConfigCategorySettings,
+		Metadata: string(metadata),
+	}, "", "")
+	return err
+}
@@ -0,0 +1,41 @@
+package configbeads
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestSetKnob(t *testing.T) {
+	dir := setupTestTown(t)
+	bd := setupTestBeads(t, dir)
+
+	if err := SetKnob(bd, "testtown", config.KnobWarmPoolMaxSize, "lots"); err == nil {
+		t.Error("SetKnob accepted an invalid value")
+	}
+	if err := SetKnob(bd, "testtown", config.KnobWarmPoolMaxSize, "3"); err != nil {
+		t.Skipf("bd create failed (known bd CLI issue): %v", err)
+	}
+	if err := SetKnob(bd, "testtown", config.KnobSlingUseWarmPool, "false"); err != nil {
+		t.Fatalf("SetKnob on existing bead: %v", err)
+	}
+
+	values, err := LoadKnobsFromBeadsScope(bd, "testtown", "")
+	if err != nil {
+		t.Fatalf("LoadKnobsFromBeadsScope: %v", err)
+	}
+	if values[config.KnobWarmPoolMaxSize] != "3" || values[config.KnobSlingUseWarmPool] != "false" {
+		t.Errorf("values = %v", values)
+	}
+
+	if err := UnsetKnob(bd, "testtown", config.KnobWarmPoolMaxSize); err != nil {
+		t.Fatalf("UnsetKnob: %v", err)
+	}
+	values, err = LoadKnobsFromBeadsScope(bd, "testtown", "")
+	if err != nil {
+		t.Fatalf("LoadKnobsFromBeadsScope: %v", err)
+	}
+	if _, ok := values[config.KnobWarmPoolMaxSize]; ok {
+		t.Errorf("unset knob still present: %v", values)
+	}
+}
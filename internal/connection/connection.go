@@ -3,6 +3,7 @@
 package connection
 
 import (
+	"io"
 	"io/fs"
 	"time"
 )
@@ -23,6 +24,10 @@ type Connection interface {
 	// ReadFile reads the named file and returns its contents.
 	ReadFile(path string) ([]byte, error)
 
+	// OpenFile opens the named file for streaming reads. The caller must
+	// close the returned reader.
+	OpenFile(path string) (io.ReadCloser, error)
+
 	// WriteFile writes data to the named file with the given permissions.
 	WriteFile(path string, data []byte, perm fs.FileMode) error
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"io/fs"
 	"net/http"
 	"strconv"
@@ -102,8 +103,8 @@ func (c *K8sConnection) getRESTConfig() (*rest.Config, error) {
 	return c.restConfig, c.configErr
 }
 
-// podExec runs a command inside the pod via the Kubernetes exec API.
-func (c *K8sConnection) podExec(stdin []byte, cmd string, args ...string) ([]byte, error) {
+// newExecutor builds an exec request for a command inside the pod.
+func (c *K8sConnection) newExecutor(stdin bool, cmd string, args ...string) (remotecommand.Executor, error) {
 	config, err := c.getRESTConfig()
 	if err != nil {
 		return nil, &ConnectionError{
@@ -132,7 +133,7 @@ func (c *K8sConnection) podExec(stdin []byte, cmd string, args ...string) ([]byt
 		VersionedParams(&corev1.PodExecOptions{
 			Container: c.Container,
 			Command:   command,
-			Stdin:     len(stdin) > 0,
+			Stdin:     stdin,
 			Stdout:    true,
 			Stderr:    true,
 		}, scheme.ParameterCodec)
@@ -145,6 +146,31 @@ func (c *K8sConnection) podExec(stdin []byte, cmd string, args ...string) ([]byt
 			Err:     fmt.Errorf("creating SPDY executor: %w", err),
 		}
 	}
+	return executor, nil
+}
+
+// execError classifies a failed exec by the command's stderr.
+func (c *K8sConnection) execError(stderr string, err error, cmd string, args ...string) error {
+	errMsg := strings.TrimSpace(stderr)
+	if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "No such file") {
+		return &NotFoundError{Path: strings.Join(args, " ")}
+	}
+	if strings.Contains(errMsg, "Permission denied") {
+		return &PermissionError{Path: strings.Join(args, " "), Op: cmd}
+	}
+	return &ConnectionError{
+		Op:      "exec",
+		Machine: c.PodName,
+		Err:     fmt.Errorf("%s: %w", errMsg, err),
+	}
+}
+
+// podExec runs a command inside the pod via the Kubernetes exec API.
+func (c *K8sConnection) podExec(stdin []byte, cmd string, args ...string) ([]byte, error) {
+	executor, err := c.newExecutor(len(stdin) > 0, cmd, args...)
+	if err != nil {
+		return nil, err
+	}
 
 	var stdout, stderr bytes.Buffer
 	streamOpts := remotecommand.StreamOptions{
@@ -160,7 +186,6 @@ func (c *K8sConnection) podExec(stdin []byte, cmd string, args ...string) ([]byt
 
 	err = executor.StreamWithContext(ctx, streamOpts)
 	if err != nil {
-		errMsg := strings.TrimSpace(stderr.String())
 		if ctx.Err() != nil {
 			return nil, &ConnectionError{
 				Op:      "exec",
@@ -168,22 +193,49 @@ func (c *K8sConnection) podExec(stdin []byte, cmd string, args ...string) ([]byt
 				Err:     fmt.Errorf("timed out after %v", c.execTimeout),
 			}
 		}
-		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "No such file") {
-			return nil, &NotFoundError{Path: strings.Join(args, " ")}
-		}
-		if strings.Contains(errMsg, "Permission denied") {
-			return nil, &PermissionError{Path: strings.Join(args, " "), Op: cmd}
-		}
-		return nil, &ConnectionError{
-			Op:      "exec",
-			Machine: c.PodName,
-			Err:     fmt.Errorf("%s: %w", errMsg, err),
-		}
+		return nil, c.execError(stderr.String(), err, cmd, args...)
 	}
 
 	return stdout.Bytes(), nil
 }
 
+// execReader is the read end of a streaming exec. Closing it cancels the
+// exec if it is still running.
+type execReader struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+func (r *execReader) Close() error {
+	r.cancel()
+	return r.PipeReader.Close()
+}
+
+// OpenFile streams a file out of the pod as it is read, so large files are
+// never held in memory. There is no exec timeout; closing the reader ends
+// the exec.
+func (c *K8sConnection) OpenFile(path string) (io.ReadCloser, error) {
+	executor, err := c.newExecutor(false, "cat", path)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pr, pw := io.Pipe()
+	go func() {
+		var stderr bytes.Buffer
+		err := executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+			Stdout: pw,
+			Stderr: &stderr,
+		})
+		if err != nil && ctx.Err() == nil {
+			err = c.execError(stderr.String(), err, "cat", path)
+		}
+		_ = pw.CloseWithError(err) // nil err reads as EOF
+	}()
+	return &execReader{PipeReader: pr, cancel: cancel}, nil
+}
+
 // ReadFile reads a file from the pod.
 func (c *K8sConnection) ReadFile(path string) ([]byte, error) {
 	return c.podExec(nil, "cat", path)
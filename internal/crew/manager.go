@@ -92,6 +92,12 @@ func validateCrewName(name string) error {
 	return nil
 }
 
+// ValidateName checks that name can be used for a crew worker, so callers
+// outside the manager (e.g. RPC handlers) can reject it up front.
+func ValidateName(name string) error {
+	return validateCrewName(name)
+}
+
 // Manager handles crew worker lifecycle.
 type Manager struct {
 	rig     *rig.Rig
@@ -791,6 +797,31 @@ func (m *Manager) Stop(name string) error {
 	return nil
 }
 
+// Destroy tears down a crew worker: its session is stopped if running and
+// its workspace removed. Unless force is set, a workspace with uncommitted
+// changes is refused with ErrHasChanges before anything is stopped.
+func (m *Manager) Destroy(name string, force bool) error {
+	if err := validateCrewName(name); err != nil {
+		return err
+	}
+	if !m.exists(name) {
+		return ErrCrewNotFound
+	}
+
+	if !force {
+		hasChanges, err := git.NewGit(m.crewDir(name)).HasUncommittedChanges()
+		if err == nil && hasChanges {
+			return ErrHasChanges
+		}
+	}
+
+	if err := m.Stop(name); err != nil && !errors.Is(err, ErrSessionNotFound) {
+		return fmt.Errorf("stopping session: %w", err)
+	}
+
+	return m.Remove(name, true)
+}
+
 // IsRunning checks if a crew member's session is active.
 func (m *Manager) IsRunning(name string) (bool, error) {
 	sessionID := m.SessionName(name)
@@ -1,6 +1,7 @@
 package crew
 
 import (
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/terminal"
 )
 
 func TestManagerAddAndGet(t *testing.T) {
@@ -342,6 +344,71 @@ func TestManagerRemove(t *testing.T) {
 	}
 }
 
+// sessionBackend is a terminal.Backend that tracks live sessions by name.
+type sessionBackend struct {
+	terminal.Backend
+	sessions map[string]bool
+}
+
+func (b *sessionBackend) HasSession(session string) (bool, error) {
+	return b.sessions[session], nil
+}
+
+func (b *sessionBackend) KillSession(session string) error {
+	delete(b.sessions, session)
+	return nil
+}
+
+func TestManagerDestroy(t *testing.T) {
+	tmpDir := t.TempDir()
+	rigPath := filepath.Join(tmpDir, "test-rig")
+	if err := os.MkdirAll(rigPath, 0755); err != nil {
+		t.Fatalf("failed to create rig dir: %v", err)
+	}
+	bareRepoPath := filepath.Join(tmpDir, "bare-repo.git")
+	if err := runCmd("git", "init", "--bare", bareRepoPath); err != nil {
+		t.Fatalf("failed to create bare repo: %v", err)
+	}
+
+	r := &rig.Rig{Name: "test-rig", Path: rigPath, GitURL: bareRepoPath}
+	mgr := NewManager(r, git.NewGit(rigPath))
+	backend := &sessionBackend{sessions: map[string]bool{}}
+	mgr.SetBackend(backend)
+
+	if _, err := mgr.Add("dave", false); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if _, err := mgr.Add("dave", false); err != ErrCrewExists {
+		t.Errorf("second Add: expected ErrCrewExists, got %v", err)
+	}
+	backend.sessions[mgr.SessionName("dave")] = true
+
+	// CLAUDE.md is uncommitted, so an unforced destroy must leave everything alone.
+	if err := mgr.Destroy("dave", false); err != ErrHasChanges {
+		t.Fatalf("expected ErrHasChanges, got %v", err)
+	}
+	if running, _ := mgr.IsRunning("dave"); !running {
+		t.Error("refused destroy should not stop the session")
+	}
+
+	if err := mgr.Destroy("dave", true); err != nil {
+		t.Fatalf("Destroy failed: %v", err)
+	}
+	if running, _ := mgr.IsRunning("dave"); running {
+		t.Error("session still running after Destroy")
+	}
+	if _, err := mgr.Get("dave"); err != ErrCrewNotFound {
+		t.Errorf("expected ErrCrewNotFound, got %v", err)
+	}
+
+	if err := mgr.Destroy("dave", true); err != ErrCrewNotFound {
+		t.Errorf("destroying twice: expected ErrCrewNotFound, got %v", err)
+	}
+	if err := mgr.Destroy("../etc", true); !errors.Is(err, ErrInvalidCrewName) {
+		t.Errorf("expected ErrInvalidCrewName, got %v", err)
+	}
+}
+
 func TestManagerGetWithStaleStateName(t *testing.T) {
 	// Regression test: state.json with wrong name should not affect Get() result
 	// See: gt-h1w - gt crew list shows wrong names
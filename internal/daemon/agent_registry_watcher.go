@@ -0,0 +1,125 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// agentRegistryPollInterval is how often agents.json files are checked for
+// changes. Stat calls are cheap, so new presets show up within seconds.
+const agentRegistryPollInterval = 10 * time.Second
+
+// AgentRegistryWatcher reloads agent presets when the town's or a rig's
+// settings/agents.json changes, so the daemon picks up new and edited
+// presets without a restart. Role mappings live in settings/config.json,
+// which is read on every spawn and needs no watching.
+type AgentRegistryWatcher struct {
+	townRoot string
+	rigs     func() []string
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	logger   func(format string, args ...interface{})
+
+	// stamps holds the modification time and size of each agents.json seen
+	// at the last reload. Only accessed from the watcher goroutine.
+	stamps map[string]string
+}
+
+// NewAgentRegistryWatcher creates a watcher for the town's agents.json and
+// the agents.json of each rig returned by rigs.
+func NewAgentRegistryWatcher(townRoot string, rigs func() []string, logger func(format string, args ...interface{})) *AgentRegistryWatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &AgentRegistryWatcher{
+		townRoot: townRoot,
+		rigs:     rigs,
+		ctx:      ctx,
+		cancel:   cancel,
+		logger:   logger,
+	}
+}
+
+// Start loads the current registries and begins polling for changes.
+func (w *AgentRegistryWatcher) Start() error {
+	w.check()
+	w.wg.Add(1)
+	go w.run()
+	return nil
+}
+
+// Stop stops the watcher.
+func (w *AgentRegistryWatcher) Stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+func (w *AgentRegistryWatcher) run() {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(agentRegistryPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+			w.check()
+		}
+	}
+}
+
+// paths returns the registry files to watch, town first so rig presets
+// override town presets of the same name.
+func (w *AgentRegistryWatcher) paths() []string {
+	paths := []string{config.DefaultAgentRegistryPath(w.townRoot)}
+	for _, rigName := range w.rigs() {
+		paths = append(paths, config.RigAgentRegistryPath(filepath.Join(w.townRoot, rigName)))
+	}
+	return paths
+}
+
+// check reloads the registries if any agents.json was created, changed, or
+// removed since the last check. It reports whether a reload happened. A
+// file that fails to parse is logged once and retried when it changes
+// again; the previous registry stays in effect until then.
+func (w *AgentRegistryWatcher) check() bool {
+	paths := w.paths()
+	stamps := make(map[string]string, len(paths))
+	for _, path := range paths {
+		if info, err := os.Stat(path); err == nil {
+			stamps[path] = fmt.Sprintf("%d/%d", info.ModTime().UnixNano(), info.Size())
+		}
+	}
+	if w.stamps != nil && sameStamps(w.stamps, stamps) {
+		return false
+	}
+	first := w.stamps == nil
+	w.stamps = stamps
+
+	if err := config.ReloadAgentRegistries(paths...); err != nil {
+		w.logger("Warning: agent registry not reloaded: %v", err)
+		return false
+	}
+	if !first {
+		w.logger("Agent registry reloaded (%d presets)", len(config.ListAgentPresets()))
+	}
+	return true
+}
+
+func sameStamps(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for path, stamp := range a {
+		if b[path] != stamp {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package daemon
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestAgentRegistryWatcherReloadsOnChange(t *testing.T) {
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "gastown")
+	for _, dir := range []string{filepath.Join(townRoot, "settings"), filepath.Join(rigPath, "settings")} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	config.ResetRegistryForTesting()
+	defer config.ResetRegistryForTesting()
+
+	townFile := config.DefaultAgentRegistryPath(townRoot)
+	if err := os.WriteFile(townFile, []byte(`{"version":1,"agents":{"town-agent":{"command":"t"}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	w := NewAgentRegistryWatcher(townRoot, func() []string { return []string{"gastown"} }, t.Logf)
+
+	if !w.check() || config.GetAgentPresetByName("town-agent") == nil {
+		t.Fatal("first check should load the town registry")
+	}
+	if w.check() {
+		t.Error("unchanged files should not reload")
+	}
+
+	rigFile := config.RigAgentRegistryPath(rigPath)
+	if err := os.WriteFile(rigFile, []byte(`{"version":1,"agents":{"rig-agent":{"command":"r"}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !w.check() || config.GetAgentPresetByName("rig-agent") == nil {
+		t.Error("new rig agents.json should be loaded")
+	}
+
+	if err := os.Remove(townFile); err != nil {
+		t.Fatal(err)
+	}
+	if !w.check() || config.GetAgentPresetByName("town-agent") != nil {
+		t.Error("presets from a removed agents.json should be dropped")
+	}
+}
@@ -0,0 +1,194 @@
+package daemon
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// ConvoySupervisorConfig configures the convoy supervisor heartbeat step.
+type ConvoySupervisorConfig struct {
+	// Enabled controls whether the supervisor runs during heartbeat.
+	Enabled bool `json:"enabled"`
+
+	// StallThreshold is how long a convoy's tracked issues may go without
+	// any update before it is flagged as stalled (default "24h").
+	StallThreshold string `json:"stall_threshold,omitempty"`
+}
+
+// defaultConvoyStallThreshold applies when no stall_threshold is configured.
+const defaultConvoyStallThreshold = 24 * time.Hour
+
+// ConvoyStalledLabel marks a convoy that has been flagged as stalled and
+// escalated. It is removed when the convoy sees activity again, so a convoy
+// that stalls a second time is escalated again.
+const ConvoyStalledLabel = "convoy:stalled"
+
+// convoyStallThreshold returns the configured stall threshold, falling back
+// to the default when unset or invalid.
+func convoyStallThreshold(config *DaemonPatrolConfig) time.Duration {
+	if config == nil || config.Patrols == nil || config.Patrols.ConvoySupervisor == nil {
+		return defaultConvoyStallThreshold
+	}
+	if d, err := time.ParseDuration(config.Patrols.ConvoySupervisor.StallThreshold); err == nil && d > 0 {
+		return d
+	}
+	return defaultConvoyStallThreshold
+}
+
+// superviseConvoys is the periodic counterpart to the ConvoyWatcher. The
+// watcher only sees closes that happen while it is following bd activity, so
+// this sweep closes any convoy whose tracked issues all finished in the
+// meantime, then flags convoys that have stopped making progress.
+func (d *Daemon) superviseConvoys() {
+	d.closeCompletedConvoys()
+
+	bd := beads.New(beads.ResolveBeadsDir(d.config.TownRoot))
+	convoys, err := bd.List(beads.ListOptions{Type: "convoy", Status: "open", Priority: -1})
+	if err != nil {
+		d.logger.Printf("Warning: listing open convoys: %v", err)
+		return
+	}
+
+	threshold := convoyStallThreshold(d.patrolConfig)
+	now := time.Now()
+	for _, convoy := range convoys {
+		trackedIDs := d.trackedConvoyIssueIDs(convoy.ID)
+		if len(trackedIDs) == 0 {
+			continue
+		}
+		tracked, err := bd.ShowMultiple(trackedIDs)
+		if err != nil {
+			d.logger.Printf("Warning: loading issues tracked by convoy %s: %v", convoy.ID, err)
+			continue
+		}
+
+		stuck, lastActivity := convoyStall(convoy, tracked, trackedIDs)
+		stalled := len(stuck) > 0 && now.Sub(lastActivity) > threshold
+		flagged := beads.HasLabel(convoy, ConvoyStalledLabel)
+
+		switch {
+		case stalled && !flagged:
+			d.escalateStalledConvoy(bd, convoy, stuck, lastActivity)
+		case !stalled && flagged:
+			// Work resumed; clear the flag so a future stall escalates again.
+			if err := bd.Update(convoy.ID, beads.UpdateOptions{RemoveLabels: []string{ConvoyStalledLabel}}); err != nil {
+				d.logger.Printf("Warning: clearing stalled flag on convoy %s: %v", convoy.ID, err)
+			} else {
+				d.logger.Printf("Convoy %s is making progress again", convoy.ID)
+			}
+		}
+	}
+}
+
+// closeCompletedConvoys runs gt convoy check across all open convoys, which
+// closes those whose tracked issues are all closed and notifies their owners.
+func (d *Daemon) closeCompletedConvoys() {
+	cmd := exec.Command("gt", "convoy", "check")
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = os.Environ() // Inherit PATH to find gt executable
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		d.logger.Printf("Warning: gt convoy check failed: %v: %s", err, strings.TrimSpace(stderr.String()))
+		return
+	}
+	if output := strings.TrimSpace(stdout.String()); output != "" && !strings.Contains(output, "No convoys ready") {
+		d.logger.Printf("Convoy supervisor: %s", output)
+	}
+}
+
+// trackedConvoyIssueIDs returns the IDs of issues a convoy tracks, with
+// external references ("external:<rig>:<id>") reduced to the bead ID.
+func (d *Daemon) trackedConvoyIssueIDs(convoyID string) []string {
+	query := fmt.Sprintf(`SELECT depends_on_id FROM dependencies WHERE issue_id = '%s' AND type = 'tracks'`,
+		strings.ReplaceAll(convoyID, "'", "''"))
+	results, err := beads.RunQuery(filepath.Join(d.config.TownRoot, ".beads"), query)
+	if err != nil {
+		return nil
+	}
+
+	ids := make([]string, 0, len(results))
+	for _, r := range results {
+		id, ok := r["depends_on_id"].(string)
+		if !ok || id == "" {
+			continue
+		}
+		if strings.HasPrefix(id, "external:") {
+			if parts := strings.SplitN(id, ":", 3); len(parts) == 3 {
+				id = parts[2]
+			}
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// convoyStall returns a convoy's unfinished tracked issues and the time of
+// the most recent update to any of its tracked issues (or the convoy's
+// creation, if later). The convoy's own updated_at is ignored because the
+// supervisor's stalled label would otherwise count as activity. Tracked IDs
+// that can't be loaded are reported as stuck so they show up in escalations.
+func convoyStall(convoy *beads.Issue, tracked map[string]*beads.Issue, trackedIDs []string) (stuck []string, lastActivity time.Time) {
+	lastActivity = parseBeadTime(convoy.CreatedAt)
+	for _, id := range trackedIDs {
+		issue, ok := tracked[id]
+		if !ok {
+			stuck = append(stuck, id)
+			continue
+		}
+		if t := parseBeadTime(issue.UpdatedAt); t.After(lastActivity) {
+			lastActivity = t
+		}
+		if issue.Status != "closed" && issue.Status != "tombstone" {
+			stuck = append(stuck, id)
+		}
+	}
+	sort.Strings(stuck)
+	return stuck, lastActivity
+}
+
+// parseBeadTime parses a bead timestamp, returning the zero time if it is
+// missing or malformed.
+func parseBeadTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// escalateStalledConvoy files an escalation listing a stalled convoy's
+// unfinished issues and labels the convoy so it is escalated only once.
+func (d *Daemon) escalateStalledConvoy(bd *beads.Beads, convoy *beads.Issue, stuck []string, lastActivity time.Time) {
+	idle := "no recorded activity"
+	if !lastActivity.IsZero() {
+		idle = fmt.Sprintf("no activity since %s", lastActivity.UTC().Format(time.RFC3339))
+	}
+	description := fmt.Sprintf("Convoy %s (%s) has stalled: %s", convoy.ID, convoy.Title, idle)
+	cmd := exec.Command("gt", "escalate", //nolint:gosec // G204: args are constructed internally
+		"--severity", "medium",
+		"--source", "daemon:convoy-supervisor",
+		"--related", convoy.ID,
+		"--reason", "Stuck issues: "+strings.Join(stuck, ", "),
+		description)
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = os.Environ() // Inherit PATH to find gt executable
+	if out, err := cmd.CombinedOutput(); err != nil {
+		d.logger.Printf("Warning: escalating stalled convoy %s: %v (%s)", convoy.ID, err, out)
+		return
+	}
+
+	if err := bd.Update(convoy.ID, beads.UpdateOptions{AddLabels: []string{ConvoyStalledLabel}}); err != nil {
+		d.logger.Printf("Warning: flagging convoy %s as stalled: %v", convoy.ID, err)
+	}
+	d.logger.Printf("Convoy %s stalled (%s), escalated with %d stuck issue(s)", convoy.ID, idle, len(stuck))
+}
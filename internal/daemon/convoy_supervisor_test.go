@@ -0,0 +1,63 @@
+package daemon
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestConvoyStall(t *testing.T) {
+	convoy := &beads.Issue{
+		ID:        "hq-cv-1",
+		CreatedAt: "2026-01-01T00:00:00Z",
+		// Updated by the supervisor's own label; must not count as activity.
+		UpdatedAt: "2026-01-09T00:00:00Z",
+	}
+	tracked := map[string]*beads.Issue{
+		"gt-a": {ID: "gt-a", Status: "closed", UpdatedAt: "2026-01-03T00:00:00Z"},
+		"gt-b": {ID: "gt-b", Status: "hooked", UpdatedAt: "2026-01-02T00:00:00Z"},
+		"gt-c": {ID: "gt-c", Status: "open", UpdatedAt: "2026-01-01T12:00:00Z"},
+	}
+
+	stuck, last := convoyStall(convoy, tracked, []string{"gt-c", "gt-a", "gt-missing", "gt-b"})
+	if want := []string{"gt-b", "gt-c", "gt-missing"}; !reflect.DeepEqual(stuck, want) {
+		t.Errorf("stuck = %v, want %v", stuck, want)
+	}
+	if want := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC); !last.Equal(want) {
+		t.Errorf("lastActivity = %v, want %v", last, want)
+	}
+
+	// A fresh convoy over old issues is measured from its creation.
+	convoy.CreatedAt = "2026-01-05T00:00:00Z"
+	if _, last := convoyStall(convoy, tracked, []string{"gt-b"}); !last.Equal(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("lastActivity = %v, want convoy creation time", last)
+	}
+}
+
+func TestConvoyStallThreshold(t *testing.T) {
+	if got := convoyStallThreshold(nil); got != defaultConvoyStallThreshold {
+		t.Errorf("nil config threshold = %v, want default", got)
+	}
+
+	var config DaemonPatrolConfig
+	if err := json.Unmarshal([]byte(`{"patrols":{"convoy_supervisor":{"enabled":true,"stall_threshold":"6h"}}}`), &config); err != nil {
+		t.Fatal(err)
+	}
+	if got := convoyStallThreshold(&config); got != 6*time.Hour {
+		t.Errorf("threshold = %v, want 6h", got)
+	}
+	if !IsPatrolEnabled(&config, "convoy_supervisor") {
+		t.Error("expected convoy_supervisor to be enabled")
+	}
+
+	config.Patrols.ConvoySupervisor = &ConvoySupervisorConfig{Enabled: false, StallThreshold: "soon"}
+	if got := convoyStallThreshold(&config); got != defaultConvoyStallThreshold {
+		t.Errorf("invalid threshold = %v, want default", got)
+	}
+	if IsPatrolEnabled(&config, "convoy_supervisor") {
+		t.Error("expected convoy_supervisor to be disabled")
+	}
+}
@@ -0,0 +1,197 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/terminal"
+)
+
+// Crash recovery for coop-managed polecats. When the agent inside a pod
+// exits or crashes, coop stays up with the final screen. The daemon saves
+// that screen, then restarts the agent in place with a coop session switch.
+// Coop relaunches the agent with its conversation resumed. An agent that
+// keeps crashing is backed off and eventually left to the witness.
+const (
+	// coopCrashLogBytes is how much of the final screen each crash log keeps.
+	coopCrashLogBytes = 64 * 1024
+
+	// coopCrashLogsKept is how many crash logs are kept per polecat.
+	coopCrashLogsKept = 5
+
+	// coopCrashLimit crashes within coopCrashWindow stop automatic restarts.
+	coopCrashLimit  = 5
+	coopCrashWindow = 10 * time.Minute
+
+	// Restarts after repeated crashes wait coopRestartBackoffMin, doubling
+	// per recent crash up to coopRestartBackoffMax.
+	coopRestartBackoffMin = 5 * time.Second
+	coopRestartBackoffMax = 5 * time.Minute
+)
+
+// coopCrashState tracks one polecat's crashes across heartbeats.
+type coopCrashState struct {
+	recent      []time.Time // Crashes within coopCrashWindow
+	total       int         // Crashes since the daemon started
+	lastRestart time.Time
+	lastLog     string // Path of the newest crash log
+	pending     bool   // Crash seen, restart not yet done
+	gaveUp      bool   // Witness notified; no more automatic restarts
+}
+
+// backoff returns how long after the last restart the next one may run.
+func (s *coopCrashState) backoff() time.Duration {
+	if len(s.recent) <= 1 {
+		return 0
+	}
+	d := coopRestartBackoffMin
+	for i := 2; i < len(s.recent) && d < coopRestartBackoffMax; i++ {
+		d *= 2
+	}
+	return min(d, coopRestartBackoffMax)
+}
+
+// record counts a crash seen at now and prunes crashes outside the window.
+func (s *coopCrashState) record(now time.Time) {
+	cutoff := now.Add(-coopCrashWindow)
+	kept := s.recent[:0]
+	for _, t := range s.recent {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	s.recent = append(kept, now)
+	s.total++
+	s.pending = true
+}
+
+// recoverCoopPolecat handles a coop polecat whose agent exited or crashed:
+// it saves the screen on first sight of the crash, restarts the agent once
+// the backoff allows, and notifies the witness when the agent crashes too
+// often to keep restarting.
+func (d *Daemon) recoverCoopPolecat(rigName, polecatName, hookBead, coopURL, state string) {
+	agentID := rigName + "/polecats/" + polecatName
+	sessionName := fmt.Sprintf("gt-%s-%s", rigName, polecatName)
+	if coop, ok := d.backend.(*terminal.CoopBackend); ok {
+		coop.AddSession(sessionName, coopURL)
+	}
+
+	if d.coopCrashes == nil {
+		d.coopCrashes = make(map[string]*coopCrashState)
+	}
+	st := d.coopCrashes[agentID]
+	if st == nil {
+		st = &coopCrashState{}
+		d.coopCrashes[agentID] = st
+	}
+	if st.gaveUp {
+		return
+	}
+
+	now := time.Now()
+	if !st.pending {
+		st.record(now)
+		d.recordSessionDeath(fmt.Sprintf("coop-%s-%s", rigName, polecatName))
+
+		if path, err := d.saveCoopCrashLog(rigName, polecatName, sessionName, st.total); err != nil {
+			d.logger.Printf("Warning: saving crash output for %s: %v", agentID, err)
+		} else {
+			st.lastLog = path
+		}
+
+		reason := fmt.Sprintf("coop agent %s (crash %d, %d in %v)", state, st.total, len(st.recent), coopCrashWindow)
+		if st.lastLog != "" {
+			reason += "; output saved to " + st.lastLog
+		}
+		_ = events.LogTo(d.config.TownRoot, events.TypeSessionDeath, "daemon",
+			events.SessionDeathPayload(sessionName, agentID, reason, "daemon"), events.VisibilityFeed)
+
+		if len(st.recent) >= coopCrashLimit {
+			st.gaveUp = true
+			d.logger.Printf("COOP AGENT CRASH LOOP: polecat %s (%d crashes in %v), not restarting",
+				agentID, len(st.recent), coopCrashWindow)
+			d.notifyWitnessOfCrashedPolecat(rigName, polecatName, hookBead,
+				fmt.Errorf("coop agent %s %d times in %v at %s; last output in %s",
+					state, len(st.recent), coopCrashWindow, coopURL, st.lastLog))
+			return
+		}
+	}
+
+	if wait := st.lastRestart.Add(st.backoff()).Sub(now); wait > 0 {
+		d.logger.Printf("Coop agent %s %s; restarting in %v", agentID, state, wait.Round(time.Second))
+		return
+	}
+
+	d.logger.Printf("Restarting coop agent %s in place (crash %d)", agentID, st.total)
+	st.lastRestart = now
+	if err := d.backend.RespawnPane(sessionName); err != nil {
+		d.logger.Printf("Error restarting coop agent %s: %v", agentID, err)
+		d.notifyWitnessOfCrashedPolecat(rigName, polecatName, hookBead,
+			fmt.Errorf("coop agent %s at %s and restart failed: %w", state, coopURL, err))
+		return
+	}
+	st.pending = false
+}
+
+// coopPolecatHealthy clears a polecat's pending crash once its agent runs
+// again, so a later crash is counted and a crash-looped agent that was
+// fixed by hand is restarted automatically next time.
+func (d *Daemon) coopPolecatHealthy(rigName, polecatName string) {
+	st := d.coopCrashes[rigName+"/polecats/"+polecatName]
+	if st == nil {
+		return
+	}
+	st.pending = false
+	if st.gaveUp {
+		st.gaveUp = false
+		st.recent = nil
+	}
+}
+
+// coopCrashLogDir is where a polecat's crash logs are kept.
+func (d *Daemon) coopCrashLogDir(rigName, polecatName string) string {
+	return filepath.Join(d.config.TownRoot, rigName, "polecats", polecatName, ".runtime", "crashes")
+}
+
+// saveCoopCrashLog writes the last coopCrashLogBytes of the agent's final
+// screen to crash-<time>-<n>.log and prunes all but the newest coopCrashLogsKept.
+func (d *Daemon) saveCoopCrashLog(rigName, polecatName, sessionName string, n int) (string, error) {
+	output, err := d.backend.CapturePaneAll(sessionName)
+	if err != nil {
+		return "", fmt.Errorf("capturing screen: %w", err)
+	}
+	if len(output) > coopCrashLogBytes {
+		output = output[len(output)-coopCrashLogBytes:]
+	}
+
+	dir := d.coopCrashLogDir(rigName, polecatName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("crash-%s-%d.log", time.Now().UTC().Format("20060102T150405Z"), n))
+	if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+		return "", err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return path, nil
+	}
+	var logs []string
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), "crash-") && strings.HasSuffix(e.Name(), ".log") {
+			logs = append(logs, e.Name())
+		}
+	}
+	sort.Strings(logs) // Timestamped names sort oldest first
+	for len(logs) > coopCrashLogsKept {
+		_ = os.Remove(filepath.Join(dir, logs[0]))
+		logs = logs[1:]
+	}
+	return path, nil
+}
@@ -0,0 +1,160 @@
+package daemon
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/terminal"
+)
+
+// fakeCoop serves the coop endpoints crash recovery uses and counts
+// session switches.
+func fakeCoop(t *testing.T, screen string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var switches atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/screen/text":
+			fmt.Fprint(w, screen)
+		case "/api/v1/session/switch":
+			switches.Add(1)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &switches
+}
+
+func newRecoveryDaemon(t *testing.T) *Daemon {
+	t.Helper()
+	return &Daemon{
+		config:  &Config{TownRoot: t.TempDir()},
+		backend: terminal.NewCoopBackend(terminal.CoopConfig{Timeout: time.Second}),
+		logger:  log.New(os.Stderr, "", 0),
+	}
+}
+
+func TestRecoverCoopPolecat_SavesOutputAndRestarts(t *testing.T) {
+	screen := strings.Repeat("x", coopCrashLogBytes) + "panic: out of tokens\n"
+	srv, switches := fakeCoop(t, screen)
+	d := newRecoveryDaemon(t)
+
+	d.recoverCoopPolecat("gastown", "nux", "gt-1", srv.URL, "crashed")
+	if got := switches.Load(); got != 1 {
+		t.Fatalf("switches after first crash = %d, want 1", got)
+	}
+
+	st := d.coopCrashes["gastown/polecats/nux"]
+	if st == nil || st.total != 1 || st.pending || st.lastLog == "" {
+		t.Fatalf("crash state = %+v", st)
+	}
+	data, err := os.ReadFile(st.lastLog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != coopCrashLogBytes || !strings.HasSuffix(string(data), "panic: out of tokens\n") {
+		t.Errorf("crash log is %d bytes, want the last %d of the screen", len(data), coopCrashLogBytes)
+	}
+
+	// A second crash right away waits out the backoff, and is counted once
+	// however many heartbeats see it
+	d.recoverCoopPolecat("gastown", "nux", "gt-1", srv.URL, "crashed")
+	d.recoverCoopPolecat("gastown", "nux", "gt-1", srv.URL, "crashed")
+	if got := switches.Load(); got != 1 {
+		t.Errorf("switches during backoff = %d, want 1", got)
+	}
+	if st.total != 2 {
+		t.Errorf("crashes counted = %d, want 2", st.total)
+	}
+
+	st.lastRestart = time.Now().Add(-coopRestartBackoffMax)
+	d.recoverCoopPolecat("gastown", "nux", "gt-1", srv.URL, "crashed")
+	if got := switches.Load(); got != 2 {
+		t.Errorf("switches after backoff = %d, want 2", got)
+	}
+}
+
+func TestRecoverCoopPolecat_GivesUpOnCrashLoop(t *testing.T) {
+	srv, switches := fakeCoop(t, "boom\n")
+	d := newRecoveryDaemon(t)
+
+	for i := 0; i < coopCrashLimit+2; i++ {
+		d.recoverCoopPolecat("gastown", "nux", "gt-1", srv.URL, "exited")
+		if st := d.coopCrashes["gastown/polecats/nux"]; !st.gaveUp {
+			st.lastRestart = time.Time{}
+			d.coopPolecatHealthy("gastown", "nux")
+		}
+	}
+	st := d.coopCrashes["gastown/polecats/nux"]
+	if !st.gaveUp || st.total != coopCrashLimit {
+		t.Errorf("crash state = %+v, want gave up after %d", st, coopCrashLimit)
+	}
+	if got := switches.Load(); got != coopCrashLimit-1 {
+		t.Errorf("switches = %d, want %d", got, coopCrashLimit-1)
+	}
+
+	if len(d.recentDeaths) != 1 {
+		t.Errorf("recent deaths = %v, want the crash-looping agent counted once", d.recentDeaths)
+	}
+
+	entries, _ := os.ReadDir(d.coopCrashLogDir("gastown", "nux"))
+	if len(entries) != coopCrashLogsKept {
+		t.Errorf("crash logs kept = %d, want %d", len(entries), coopCrashLogsKept)
+	}
+
+	// Recovering by hand re-arms automatic restarts
+	d.coopPolecatHealthy("gastown", "nux")
+	d.recoverCoopPolecat("gastown", "nux", "gt-1", srv.URL, "exited")
+	if st.gaveUp {
+		t.Error("healthy agent should re-arm restarts")
+	}
+}
+
+func TestRecordSessionDeath_CountsEachSessionOnce(t *testing.T) {
+	d := newRecoveryDaemon(t)
+	for i := 0; i < massDeathThreshold+1; i++ {
+		d.recordSessionDeath("gt-gastown-nux")
+	}
+	if len(d.recentDeaths) != 1 {
+		t.Fatalf("recent deaths = %v, want one entry for the repeating session", d.recentDeaths)
+	}
+
+	for i := 1; i < massDeathThreshold; i++ {
+		d.recordSessionDeath(fmt.Sprintf("gt-gastown-p%d", i))
+	}
+	if len(d.recentDeaths) != 0 {
+		t.Errorf("recent deaths = %v, want cleared by the mass death alert", d.recentDeaths)
+	}
+}
+
+func TestCoopCrashState_Backoff(t *testing.T) {
+	now := time.Now()
+	s := &coopCrashState{}
+	want := []time.Duration{0, 5 * time.Second, 10 * time.Second, 20 * time.Second}
+	for i, w := range want {
+		s.record(now)
+		if got := s.backoff(); got != w {
+			t.Errorf("backoff after %d crashes = %v, want %v", i+1, got, w)
+		}
+	}
+	for i := 0; i < 20; i++ {
+		s.record(now)
+	}
+	if got := s.backoff(); got != coopRestartBackoffMax {
+		t.Errorf("backoff = %v, want cap %v", got, coopRestartBackoffMax)
+	}
+
+	// Crashes age out of the window
+	s.record(now.Add(coopCrashWindow + time.Second))
+	if len(s.recent) != 1 {
+		t.Errorf("recent crashes = %d, want 1 after the window", len(s.recent))
+	}
+}
@@ -20,13 +20,17 @@ import (
 	"github.com/gofrs/flock"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/boot"
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/eventbus"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/feed"
+	"github.com/steveyegge/gastown/internal/monitoring"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/settings"
 	"github.com/steveyegge/gastown/internal/terminal"
 	"github.com/steveyegge/gastown/internal/util"
 	"github.com/steveyegge/gastown/internal/wisp"
@@ -48,6 +52,22 @@ type Daemon struct {
 	convoyWatcher  *ConvoyWatcher
 	doltServer     *DoltServerManager
 	krcPruner          *KRCPruner
+	metricsServer      *http.Server
+	sessionMonitor     *monitoring.SessionMonitor
+	usageAlerts        map[string]bool // Budget alerts already sent, by day, scope, and level
+	registryWatcher    *AgentRegistryWatcher
+	sessionRecorder    *sessionRecorder
+	bus                *eventbus.Bus   // in-process notifications, e.g. settings changes
+	settings           *settings.Store // operational knobs; nil reads as defaults
+
+	// checkpoints tracks loops that persist state after each tick (deacon
+	// schedule, sling queue). Shutdown waits for them so a tick in progress
+	// writes its state rather than being cut off mid-dispatch.
+	checkpoints sync.WaitGroup
+
+	// Coop polecat crashes, by agent ID. Only accessed from the heartbeat
+	// loop goroutine - no sync needed.
+	coopCrashes map[string]*coopCrashState
 
 	// Mass death detection: track recent session deaths
 	deathsMu     sync.Mutex
@@ -208,6 +228,39 @@ func (d *Daemon) Run() error {
 		}
 	}
 
+	if d.config.MetricsAddr != "" {
+		d.startMetricsServer()
+	}
+
+	// Start agent registry watcher so agents.json edits apply without a restart
+	d.registryWatcher = NewAgentRegistryWatcher(d.config.TownRoot, d.getKnownRigs, d.logger.Printf)
+	if err := d.registryWatcher.Start(); err != nil {
+		d.logger.Printf("Warning: failed to start agent registry watcher: %v", err)
+	} else {
+		d.logger.Println("Agent registry watcher started")
+	}
+
+	// Load operational settings (settings beads) and watch for changes
+	d.startSettings()
+
+	// Start terminal-output stuck detection (opt-in via mayor/daemon.json)
+	d.startSessionMonitor()
+
+	// Start token usage collection (opt-in via mayor/daemon.json)
+	d.startUsageCollector()
+
+	// Start recording polecat sessions for replay (opt-in via mayor/daemon.json)
+	d.startSessionRecorder()
+
+	// Start cron-style maintenance jobs (configured in mayor/config.json)
+	d.startDeaconScheduler()
+
+	// Keep per-rig warm polecat pools topped up (configured in rig settings)
+	d.startWarmPools()
+
+	// Dispatch slings scheduled with gt sling --at/--after when due
+	d.startSlingQueue()
+
 	// Initial heartbeat
 	d.heartbeat(state)
 
@@ -259,6 +312,7 @@ func (d *Daemon) heartbeat(state *State) {
 	}
 
 	d.logger.Println("Heartbeat starting (recovery-focused)")
+	defer heartbeatDuration.ObserveSince(time.Now())
 
 	// 0. Ensure Dolt server is running (if configured)
 	// This must happen before beads operations that depend on Dolt.
@@ -280,29 +334,64 @@ func (d *Daemon) heartbeat(state *State) {
 		d.ensureBootRunning()
 	}
 
+	// While the town is drained (gt pause --drain), polecat sessions are
+	// stopped on purpose: skip the steps that would respawn or chase them.
+	draining := deacon.IsDraining(d.config.TownRoot)
+	if draining {
+		d.logger.Println("Town drained, skipping polecat recovery")
+	}
+
 	// 3. Trigger pending polecat spawns (bootstrap mode - ZFC violation acceptable)
 	// This ensures polecats get nudged even when Deacon isn't in a patrol cycle.
 	// Uses regex-based WaitForRuntimeReady, which is acceptable for daemon bootstrap.
-	d.triggerPendingSpawns()
+	if !draining {
+		d.triggerPendingSpawns()
+	}
 
 	// 6. Process lifecycle requests
 	d.processLifecycleRequests()
 
-	// 7. Check for GUPP violations (agents with work-on-hook not progressing)
-	d.checkGUPPViolations()
+	if !draining {
+		// 7. Check for GUPP violations (agents with work-on-hook not progressing)
+		d.checkGUPPViolations()
 
-	// 8. Check for orphaned work (assigned to dead agents)
-	d.checkOrphanedWork()
+		// 8. Check for orphaned work (assigned to dead agents)
+		d.checkOrphanedWork()
 
-	// 9. Check polecat session health (proactive crash detection)
-	// This validates sessions are still alive for polecats with work-on-hook
-	d.checkPolecatSessionHealth()
+		// 9. Check polecat session health (proactive crash detection)
+		// This validates sessions are still alive for polecats with work-on-hook
+		d.checkPolecatSessionHealth()
+	}
 
 	// 10. Clean up orphaned claude subagent processes (memory leak prevention)
 	// These are Task tool subagents that didn't clean up after completion.
 	// This is a safety net - Deacon patrol also does this more frequently.
 	d.cleanupOrphanedProcesses()
 
+	// 11. Apply default options to decisions past their deadline
+	d.checkDecisionDeadlines()
+
+	// 12. Close finished convoys and escalate stalled ones
+	if IsPatrolEnabled(d.patrolConfig, "convoy_supervisor") {
+		d.superviseConvoys()
+	}
+
+	// 13. Refresh the session monitor's watch list as polecats come and go
+	if d.sessionMonitor != nil {
+		d.syncMonitoredSessions()
+	}
+
+	// 14. Refresh the session recorder's list the same way
+	if d.sessionRecorder != nil {
+		d.syncRecordedSessions()
+	}
+
+	// 15. Re-escalate escalations nobody acknowledged within their SLA
+	d.enforceEscalationSLAs()
+
+	// 16. Send the overseer's notification digest once it is due
+	d.flushNotificationDigest()
+
 	// Update state
 	state.LastHeartbeat = time.Now()
 	state.HeartbeatCount++
@@ -396,7 +485,7 @@ func (d *Daemon) runDegradedBootTriage(b *boot.Boot) {
 	hb := deacon.ReadHeartbeat(d.config.TownRoot)
 	if hb != nil && hb.ShouldPoke() {
 		age := hb.Age()
-		if age > 30*time.Minute {
+		if age > d.settings.Duration(config.KnobDeaconRestartAfter) {
 			// Very stuck - restart the session
 			d.logger.Printf("Deacon heartbeat is %s old - restarting session", age.Round(time.Minute))
 			if err := d.backend.KillSession(deaconSession); err == nil {
@@ -407,7 +496,7 @@ func (d *Daemon) runDegradedBootTriage(b *boot.Boot) {
 				status.LastAction = "restart-failed"
 				status.Error = err.Error()
 			}
-		} else if age > 15*time.Minute {
+		} else if age > d.settings.Duration(config.KnobDeaconNudgeAfter) {
 			// Stuck but not critically - nudge to wake up
 			d.logger.Printf("Deacon heartbeat is %s old - nudging session", age.Round(time.Minute))
 			_ = d.backend.NudgeSession(deaconSession, "HEALTH_CHECK: heartbeat is stale, respond to confirm responsiveness")
@@ -499,7 +588,7 @@ func (d *Daemon) checkDeaconHeartbeat() {
 	}
 
 	// Session exists but heartbeat is stale - Deacon is stuck
-	if age > 30*time.Minute {
+	if age > d.settings.Duration(config.KnobDeaconRestartAfter) {
 		// Very stuck - restart the session.
 		d.logger.Printf("Deacon stuck for %s - restarting session", age.Round(time.Minute))
 		if err := d.backend.KillSession(sessionName); err != nil {
@@ -652,6 +741,15 @@ func (d *Daemon) processLifecycleRequests() {
 func (d *Daemon) shutdown(state *State) error { //nolint:unparam // error return kept for future use
 	d.logger.Println("Daemon shutting down")
 
+	// Stop background loops and let any tick in progress checkpoint its
+	// schedule or queue state.
+	d.cancel()
+	if waitTimeout(&d.checkpoints, shutdownCheckpointTimeout) {
+		d.logger.Println("Scheduler and sling queue state saved")
+	} else {
+		d.logger.Printf("Warning: scheduler or sling queue still busy after %v", shutdownCheckpointTimeout)
+	}
+
 	// Stop feed curator
 	if d.curator != nil {
 		d.curator.Stop()
@@ -670,6 +768,20 @@ func (d *Daemon) shutdown(state *State) error { //nolint:unparam // error return
 		d.logger.Println("KRC pruner stopped")
 	}
 
+	// Stop agent registry watcher
+	if d.registryWatcher != nil {
+		d.registryWatcher.Stop()
+		d.logger.Println("Agent registry watcher stopped")
+	}
+
+	if d.bus != nil {
+		d.bus.Close()
+	}
+
+	if d.metricsServer != nil {
+		_ = d.metricsServer.Close()
+	}
+
 	// Stop Dolt server if we're managing it
 	if d.doltServer != nil && d.doltServer.IsEnabled() && !d.doltServer.IsExternal() {
 		if err := d.doltServer.Stop(); err != nil {
@@ -688,6 +800,25 @@ func (d *Daemon) shutdown(state *State) error { //nolint:unparam // error return
 	return nil
 }
 
+// shutdownCheckpointTimeout bounds how long shutdown waits for loops to
+// save their state.
+const shutdownCheckpointTimeout = 15 * time.Second
+
+// waitTimeout waits for wg up to timeout and reports whether it finished.
+func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
 // Stop signals the daemon to stop.
 func (d *Daemon) Stop() {
 	d.cancel()
@@ -982,7 +1113,7 @@ func (d *Daemon) checkPolecatHealth(rigName, polecatName string) {
 	d.recordSessionDeath(sessionName)
 
 	// Auto-restart the polecat
-	if err := d.restartPolecatSession(rigName, polecatName, sessionName); err != nil {
+	if err := d.restartPolecatSession(rigName, polecatName, sessionName, info); err != nil {
 		d.logger.Printf("Error restarting polecat %s/%s: %v", rigName, polecatName, err)
 		// Notify witness as fallback
 		d.notifyWitnessOfCrashedPolecat(rigName, polecatName, info.HookBead, err)
@@ -1023,19 +1154,14 @@ func (d *Daemon) checkCoopPolecatHealth(rigName, polecatName, hookBead, coopURL
 	switch state {
 	case "working", "idle", "waiting_for_input":
 		// Agent is alive - nothing to do
+		d.coopPolecatHealthy(rigName, polecatName)
 		return
-	case "exited":
-		// Agent exited — this is the coop equivalent of a dead pane
-		d.logger.Printf("COOP AGENT EXITED: polecat %s/%s (hook_bead=%s, coop=%s)",
-			rigName, polecatName, hookBead, coopURL)
-		d.notifyWitnessOfCrashedPolecat(rigName, polecatName, hookBead,
-			fmt.Errorf("coop agent exited at %s", coopURL))
-	case "crashed":
-		d.logger.Printf("COOP AGENT CRASHED: polecat %s/%s (hook_bead=%s, coop=%s)",
-			rigName, polecatName, hookBead, coopURL)
-		d.recordSessionDeath(fmt.Sprintf("coop-%s-%s", rigName, polecatName))
-		d.notifyWitnessOfCrashedPolecat(rigName, polecatName, hookBead,
-			fmt.Errorf("coop agent crashed at %s", coopURL))
+	case "exited", "crashed":
+		// The coop equivalent of a dead pane: save the screen and restart
+		// the agent in place (see coop_recovery.go)
+		d.logger.Printf("COOP AGENT %s: polecat %s/%s (hook_bead=%s, coop=%s)",
+			strings.ToUpper(state), rigName, polecatName, hookBead, coopURL)
+		d.recoverCoopPolecat(rigName, polecatName, hookBead, coopURL, state)
 	default:
 		// Unknown state — log for investigation
 		d.logger.Printf("Unknown coop agent state %q for %s/%s at %s", state, rigName, polecatName, coopURL)
@@ -1153,27 +1279,28 @@ func (d *Daemon) queryOjJobState(jobID string) (string, error) {
 }
 
 // recordSessionDeath records a session death and checks for mass death pattern.
+// A session that dies again within the window is counted once, so a single
+// crash-looping agent can't look like a mass death.
 func (d *Daemon) recordSessionDeath(sessionName string) {
 	d.deathsMu.Lock()
 	defer d.deathsMu.Unlock()
 
 	now := time.Now()
 
-	// Add this death
-	d.recentDeaths = append(d.recentDeaths, sessionDeath{
-		sessionName: sessionName,
-		timestamp:   now,
-	})
-
 	// Prune deaths outside the window
 	cutoff := now.Add(-massDeathWindow)
 	var recent []sessionDeath
 	for _, death := range d.recentDeaths {
-		if death.timestamp.After(cutoff) {
+		if death.timestamp.After(cutoff) && death.sessionName != sessionName {
 			recent = append(recent, death)
 		}
 	}
-	d.recentDeaths = recent
+
+	// Add this death
+	d.recentDeaths = append(recent, sessionDeath{
+		sessionName: sessionName,
+		timestamp:   now,
+	})
 
 	// Check for mass death
 	if len(d.recentDeaths) >= massDeathThreshold {
@@ -1202,11 +1329,23 @@ func (d *Daemon) emitMassDeathEvent() {
 	d.recentDeaths = nil
 }
 
-// restartPolecatSession restarts a crashed polecat by notifying the witness.
-// In K8s mode, polecat lifecycle is managed through bead events and controller reconciliation.
-// Direct session creation is not supported -- the witness handles crash recovery.
-func (d *Daemon) restartPolecatSession(_, polecatName, _ string) error {
-	return fmt.Errorf("direct polecat session restart not supported (K8s mode); polecat %s should be recovered via bead lifecycle", polecatName)
+// restartPolecatSession restarts a crashed polecat's session. When its
+// agent bead recorded the agent CLI's session ID, the previous session is
+// resumed so the polecat continues its hooked work with its context intact.
+// Returns an error if no session came up (e.g. K8s polecats, whose pods are
+// managed by the controller), so the witness is notified instead.
+func (d *Daemon) restartPolecatSession(rigName, polecatName, sessionName string, info *AgentBeadInfo) error {
+	if err := d.restartSession(sessionName, rigName+"/polecats/"+polecatName, info); err != nil {
+		return err
+	}
+	running, err := d.hasSession(sessionName)
+	if err != nil {
+		return fmt.Errorf("checking restarted session: %w", err)
+	}
+	if !running {
+		return fmt.Errorf("session %s did not come back up; polecat %s should be recovered via bead lifecycle", sessionName, polecatName)
+	}
+	return nil
 }
 
 // notifyWitnessOfCrashedPolecat notifies the witness when a polecat restart fails.
@@ -0,0 +1,58 @@
+package daemon
+
+import (
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/deacon"
+)
+
+// scheduleTickInterval is how often due maintenance jobs are checked.
+// Cron resolution is one minute.
+const scheduleTickInterval = time.Minute
+
+// deaconSchedule reads the maintenance jobs from mayor/config.json. It is
+// re-read every tick so schedule edits apply without a restart.
+func deaconSchedule(townRoot string) []config.ScheduledJob {
+	cfg, err := config.LoadMayorConfig(constants.MayorConfigPath(townRoot))
+	if err != nil || cfg.Deacon == nil {
+		return nil
+	}
+	return cfg.Deacon.Schedule
+}
+
+// startDeaconScheduler runs the deacon's cron-style maintenance jobs.
+// Jobs are skipped while the deacon is paused; any that came due run once
+// after it resumes.
+func (d *Daemon) startDeaconScheduler() {
+	scheduler := deacon.NewScheduler(d.config.TownRoot, d.logger.Printf)
+	tick := func(now time.Time) {
+		jobs := deaconSchedule(d.config.TownRoot)
+		if len(jobs) == 0 {
+			return
+		}
+		if paused, _, _ := deacon.IsPaused(d.config.TownRoot); paused {
+			return
+		}
+		if err := scheduler.Tick(d.ctx, jobs, now); err != nil {
+			d.logger.Printf("Warning: saving schedule state: %v", err)
+		}
+	}
+
+	d.checkpoints.Add(1)
+	go func() {
+		defer d.checkpoints.Done()
+		ticker := time.NewTicker(scheduleTickInterval)
+		defer ticker.Stop()
+		tick(time.Now())
+		for {
+			select {
+			case <-d.ctx.Done():
+				return
+			case now := <-ticker.C:
+				tick(now)
+			}
+		}
+	}()
+}
@@ -0,0 +1,83 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/notify"
+)
+
+// decisionDeadlineActor is recorded as the resolver of decisions that were
+// answered by their default option after the deadline passed.
+const decisionDeadlineActor = "daemon/decision-deadline"
+
+// checkDecisionDeadlines resolves pending decisions whose deadline has passed.
+// Decisions fall back to their default option (or the recommended one); a
+// decision with neither is escalated once so a human picks it up.
+func (d *Daemon) checkDecisionDeadlines() {
+	bd := beads.New(beads.ResolveBeadsDir(d.config.TownRoot))
+	expired, err := bd.ListExpiredDecisions(time.Now())
+	if err != nil {
+		d.logger.Printf("Warning: listing expired decisions: %v", err)
+		return
+	}
+
+	for _, issue := range expired {
+		d.expireDecision(bd, issue.ID)
+	}
+}
+
+// expireDecision applies the deadline fallback to a single decision.
+func (d *Daemon) expireDecision(bd *beads.Beads, id string) {
+	_, fields, err := bd.GetDecisionBead(id)
+	if err != nil || fields == nil {
+		d.logger.Printf("Warning: loading expired decision %s: %v", id, err)
+		return
+	}
+	if fields.ResolvedAt != "" {
+		return // answered since it was listed
+	}
+
+	choice := fields.DefaultChoice()
+	if choice == 0 {
+		d.escalateExpiredDecision(bd, id, fields)
+		return
+	}
+
+	chosenLabel := fields.Options[choice-1].Label
+	rationale := fmt.Sprintf("Deadline %s passed without a response; defaulted to option %d", fields.Deadline, choice)
+	if err := bd.ResolveDecision(id, choice, rationale, decisionDeadlineActor); err != nil {
+		d.logger.Printf("Warning: auto-resolving expired decision %s: %v", id, err)
+		return
+	}
+	d.logger.Printf("Decision %s expired, resolved with default option %d (%s)", id, choice, chosenLabel)
+
+	// Unblock and wake the requester exactly as a human resolution would.
+	notify.DecisionResolved(d.config.TownRoot, id, *fields, chosenLabel, rationale, decisionDeadlineActor)
+}
+
+// escalateExpiredDecision raises an escalation for an expired decision that
+// has no option to fall back to, and labels it so it is escalated only once.
+func (d *Daemon) escalateExpiredDecision(bd *beads.Beads, id string, fields *beads.DecisionFields) {
+	description := fmt.Sprintf("Decision %s passed its deadline with no default option: %s", id, fields.Question)
+	cmd := exec.Command("gt", "escalate", //nolint:gosec // G204: args are constructed internally
+		"--severity", "high",
+		"--source", "daemon:decision-deadline",
+		"--related", id,
+		"--reason", fmt.Sprintf("Requested by %s, deadline %s", fields.RequestedBy, fields.Deadline),
+		description)
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = os.Environ() // Inherit PATH to find gt executable
+	if out, err := cmd.CombinedOutput(); err != nil {
+		d.logger.Printf("Warning: escalating expired decision %s: %v (%s)", id, err, out)
+		return
+	}
+
+	if err := bd.Update(id, beads.UpdateOptions{AddLabels: []string{beads.DecisionDeadlineEscalatedLabel}}); err != nil {
+		d.logger.Printf("Warning: marking decision %s escalated: %v", id, err)
+	}
+	d.logger.Printf("Decision %s expired with no default option, escalated", id)
+}
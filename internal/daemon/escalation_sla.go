@@ -0,0 +1,42 @@
+package daemon
+
+import (
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/escalation"
+)
+
+// escalationSLAActor is recorded as the re-escalator of escalations that
+// breached their SLA.
+const escalationSLAActor = "daemon/escalation-sla"
+
+// enforceEscalationSLAs re-escalates escalations that went unacknowledged
+// past their severity's SLA (settings/escalation.json sla).
+func (d *Daemon) enforceEscalationSLAs() {
+	svc, err := escalation.NewService(d.config.TownRoot)
+	if err != nil {
+		d.logger.Printf("Warning: escalation SLAs: %v", err)
+		return
+	}
+	breaches, err := svc.EnforceSLA(escalationSLAActor, time.Now())
+	if err != nil {
+		d.logger.Printf("Warning: checking escalation SLAs: %v", err)
+		return
+	}
+	for _, b := range breaches {
+		e := b.Escalation
+		switch {
+		case b.Result == nil:
+			d.logger.Printf("Warning: re-escalating %s: %s", e.ID, strings.Join(b.Errors, "; "))
+		case b.Result.Skipped:
+			d.logger.Printf("Escalation %s breached its SLA (%s), notified %v", e.ID, b.Result.SkipReason, b.Notified)
+		default:
+			d.logger.Printf("Escalation %s breached its SLA: %s → %s, notified %v",
+				e.ID, b.Result.OldSeverity, b.Result.NewSeverity, b.Notified)
+		}
+		if b.Result != nil && len(b.Errors) > 0 {
+			d.logger.Printf("Warning: notifying for %s: %s", e.ID, strings.Join(b.Errors, "; "))
+		}
+	}
+}
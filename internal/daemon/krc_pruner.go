@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/krc"
 )
 
@@ -87,4 +88,23 @@ func (p *KRCPruner) prune() {
 			result.BytesBefore-result.BytesAfter,
 			result.Duration.Round(time.Millisecond))
 	}
+
+	p.rotate()
+}
+
+// rotate archives the events log when it is due and compresses old segments.
+func (p *KRCPruner) rotate() {
+	result, err := events.Rotate(p.townRoot, p.config.Rotate, time.Now())
+	if err != nil {
+		p.logger("KRC event rotation error: %v", err)
+		return
+	}
+
+	if result.Rotated != "" {
+		p.logger("KRC rotated events log to %s", result.Rotated)
+	}
+	if n := len(result.Compressed) + len(result.Removed); n > 0 {
+		p.logger("KRC compressed %d and removed %d archived event segments",
+			len(result.Compressed), len(result.Removed))
+	}
 }
@@ -204,7 +204,7 @@ func (d *Daemon) executeLifecycleAction(request *LifecycleRequest) error {
 		}
 
 		// Restart the session
-		if err := d.restartSession(sessionName, request.From); err != nil {
+		if err := d.restartSession(sessionName, request.From, nil); err != nil {
 			return fmt.Errorf("restarting session: %w", err)
 		}
 		d.logger.Printf("Restarted session %s", sessionName)
@@ -342,7 +342,9 @@ func (d *Daemon) identityToSession(identity string) string {
 
 // restartSession starts a new session for the given agent.
 // Uses role config if available, falls back to hardcoded defaults.
-func (d *Daemon) restartSession(sessionName, identity string) error {
+// If resume is set and records the agent CLI's session ID, the previous
+// session is resumed instead of starting cold.
+func (d *Daemon) restartSession(sessionName, identity string, resume *AgentBeadInfo) error {
 	// Get role config for this identity
 	config, parsed, err := d.getRoleConfigForIdentity(identity)
 	if err != nil {
@@ -385,6 +387,10 @@ func (d *Daemon) restartSession(sessionName, identity string) error {
 
 	// Get and send startup command via backend
 	startCmd := d.getStartCommand(config, parsed)
+	if resumeCmd := d.getResumeCommand(config, parsed, resume); resumeCmd != "" {
+		d.logger.Printf("Resuming session %s for %s", resume.SessionID, identity)
+		startCmd = resumeCmd
+	}
 	if err := d.backend.SendKeys(sessionName, startCmd); err != nil {
 		// Non-fatal in K8s mode - session may be managed by controller
 		d.logger.Printf("Warning: could not send startup command to %s: %v", sessionName, err)
@@ -519,6 +525,32 @@ func (d *Daemon) getStartCommand(roleConfig *beads.RoleConfig, parsed *ParsedIde
 	return defaultCmd
 }
 
+// getResumeCommand returns the command that resumes an agent's previous
+// session from the session ID on its agent bead. Returns empty string to
+// start cold: when info is nil or has no session ID, when the role config
+// sets its own start command, or when the agent can't resume.
+func (d *Daemon) getResumeCommand(roleConfig *beads.RoleConfig, parsed *ParsedIdentity, info *AgentBeadInfo) string {
+	if info == nil || info.SessionID == "" {
+		return ""
+	}
+	if roleConfig != nil && roleConfig.StartCommand != "" {
+		return ""
+	}
+
+	rigPath := ""
+	if parsed.RigName != "" {
+		rigPath = filepath.Join(d.config.TownRoot, parsed.RigName)
+	}
+	envVars := config.AgentEnv(config.AgentEnvConfig{
+		Role:         parsed.RoleType,
+		Rig:          parsed.RigName,
+		AgentName:    parsed.AgentName,
+		TownRoot:     d.config.TownRoot,
+		BDDaemonHost: os.Getenv("BD_DAEMON_HOST"),
+	})
+	return config.BuildResumeStartupCommand(envVars, d.config.TownRoot, rigPath, info.AgentPreset, info.SessionID)
+}
+
 // setSessionEnvironment sets environment variables for the session.
 // Uses centralized AgentEnv for consistency, plus custom env vars from role config if available.
 func (d *Daemon) setSessionEnvironment(sessionName string, roleConfig *beads.RoleConfig, parsed *ParsedIdentity) {
@@ -621,14 +653,16 @@ func (d *Daemon) closeMessage(id string) error {
 
 // AgentBeadInfo represents the parsed fields from an agent bead.
 type AgentBeadInfo struct {
-	ID         string `json:"id"`
-	Type       string `json:"issue_type"`
-	State      string // Parsed from description: agent_state
-	HookBead   string // Parsed from description: hook_bead
-	RoleType   string // Parsed from description: role_type
-	Rig        string // Parsed from description: rig
-	Notes      string // Backend metadata (backend, coop_url, etc.)
-	LastUpdate string `json:"updated_at"`
+	ID          string `json:"id"`
+	Type        string `json:"issue_type"`
+	State       string // Parsed from description: agent_state
+	HookBead    string // Parsed from description: hook_bead
+	RoleType    string // Parsed from description: role_type
+	Rig         string // Parsed from description: rig
+	Notes       string // Backend metadata (backend, coop_url, etc.)
+	SessionID   string // Parsed from description: session_id (agent CLI's own)
+	AgentPreset string // Parsed from description: agent_preset
+	LastUpdate  string `json:"updated_at"`
 	// Note: RoleBead field removed - role definitions are now config-based
 }
 
@@ -691,6 +725,8 @@ func (d *Daemon) getAgentBeadInfo(agentBeadID string) (*AgentBeadInfo, error) {
 		info.State = fields.AgentState
 		info.RoleType = fields.RoleType
 		info.Rig = fields.Rig
+		info.SessionID = fields.SessionID
+		info.AgentPreset = fields.AgentPreset
 	}
 
 	// Use HookBead from database column directly (not from description)
@@ -768,6 +804,7 @@ func identityToBDActor(identity string) string {
 // GUPPViolationTimeout is how long an agent can have work on hook without
 // progressing before it's considered a GUPP (Gas Town Universal Propulsion
 // Principle) violation. GUPP states: if you have work on your hook, you run it.
+// This is the default; the daemon.gupp_violation_timeout setting overrides it.
 const GUPPViolationTimeout = 30 * time.Minute
 
 // checkGUPPViolations looks for agents that have work-on-hook but aren't
@@ -836,9 +873,9 @@ func (d *Daemon) checkRigGUPPViolations(rigName string) {
 			}
 
 			age := time.Since(updatedAt)
-			if age > GUPPViolationTimeout {
+			if timeout := d.settings.Duration(config.KnobGUPPViolationTimeout); age > timeout {
 				d.logger.Printf("GUPP violation: agent %s has hook_bead=%s but hasn't updated in %v (timeout: %v)",
-					agent.ID, agent.HookBead, age.Round(time.Minute), GUPPViolationTimeout)
+					agent.ID, agent.HookBead, age.Round(time.Minute), timeout)
 
 				// Notify the witness for this rig
 				d.notifyWitnessOfGUPP(rigName, agent.ID, agent.HookBead, age)
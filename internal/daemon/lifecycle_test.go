@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -252,3 +253,21 @@ func TestBeadsMessage_Serialization(t *testing.T) {
 		t.Errorf("From mismatch")
 	}
 }
+
+func TestGetResumeCommand(t *testing.T) {
+	d, cleanup := testDaemonWithTown(t, "testtown")
+	defer cleanup()
+	parsed := &ParsedIdentity{RoleType: "polecat", RigName: "gastown", AgentName: "nux"}
+
+	cmd := d.getResumeCommand(nil, parsed, &AgentBeadInfo{SessionID: "sess-123", AgentPreset: "claude"})
+	if !strings.Contains(cmd, "GT_POLECAT=nux") || !strings.HasSuffix(cmd, "--resume sess-123") {
+		t.Errorf("resume command = %q, want polecat env and claude --resume", cmd)
+	}
+
+	if cmd := d.getResumeCommand(nil, parsed, &AgentBeadInfo{AgentPreset: "claude"}); cmd != "" {
+		t.Errorf("no recorded session should cold start, got %q", cmd)
+	}
+	if cmd := d.getResumeCommand(nil, parsed, &AgentBeadInfo{SessionID: "sess-123", AgentPreset: "opencode"}); cmd != "" {
+		t.Errorf("agent without resume support should cold start, got %q", cmd)
+	}
+}
@@ -0,0 +1,32 @@
+package daemon
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/metrics"
+)
+
+var heartbeatDuration = metrics.NewHistogram("gt_daemon_heartbeat_duration_seconds",
+	"Duration of daemon recovery heartbeats.", []float64{1, 5, 15, 30, 60, 120, 300})
+
+// startMetricsServer serves /metrics on Config.MetricsAddr. Failure to
+// listen is logged and otherwise ignored; metrics are not worth stopping
+// the daemon over.
+func (d *Daemon) startMetricsServer() {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	d.metricsServer = &http.Server{
+		Addr:              d.config.MetricsAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		err := d.metricsServer.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			d.logger.Printf("Warning: metrics server on %s: %v", d.config.MetricsAddr, err)
+		}
+	}()
+	d.logger.Printf("Metrics server listening on %s", d.config.MetricsAddr)
+}
@@ -0,0 +1,24 @@
+package daemon
+
+import (
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/notify"
+)
+
+// flushNotificationDigest sends the notifications held for the overseer's
+// digest once their digest interval has passed.
+func (d *Daemon) flushNotificationDigest() {
+	n, delivery, err := notify.NewDispatcher(d.config.TownRoot).FlushDigest(false)
+	if err != nil {
+		d.logger.Printf("Warning: notification digest: %v", err)
+		return
+	}
+	if n == 0 {
+		return
+	}
+	d.logger.Printf("Sent notification digest of %d notification(s) to %v", n, delivery.Notified)
+	if len(delivery.Errors) > 0 {
+		d.logger.Printf("Warning: notification digest: %s", strings.Join(delivery.Errors, "; "))
+	}
+}
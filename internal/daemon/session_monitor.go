@@ -0,0 +1,211 @@
+package daemon
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/eventbus"
+	"github.com/steveyegge/gastown/internal/monitoring"
+	"github.com/steveyegge/gastown/internal/terminal"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// SessionMonitorConfig configures terminal-output stuck detection. Unlike
+// the other patrols it is off unless enabled: a stuck report gets K8s
+// agents restarted by the controller.
+type SessionMonitorConfig struct {
+	// Enabled turns the session monitor on.
+	Enabled bool `json:"enabled"`
+
+	// Interval is how often each polecat's pane is captured (default "30s").
+	Interval string `json:"interval,omitempty"`
+
+	// IdleTimeout, StaleTimeout and StuckTimeout are how long a pane's
+	// output may stay unchanged before the agent counts as idle, stale and
+	// stuck (defaults "2m", "5m", "15m").
+	IdleTimeout  string `json:"idle_timeout,omitempty"`
+	StaleTimeout string `json:"stale_timeout,omitempty"`
+	StuckTimeout string `json:"stuck_timeout,omitempty"`
+}
+
+// stuckEvidenceLines is how much of the last capture goes into a finding.
+const stuckEvidenceLines = 20
+
+// sessionMonitorConfig returns the session monitor config, or nil when the
+// monitor is not enabled.
+func sessionMonitorConfig(config *DaemonPatrolConfig) *SessionMonitorConfig {
+	if config == nil || config.Patrols == nil || config.Patrols.SessionMonitor == nil ||
+		!config.Patrols.SessionMonitor.Enabled {
+		return nil
+	}
+	return config.Patrols.SessionMonitor
+}
+
+// parseDurationOr parses s, falling back to def when unset or invalid.
+func parseDurationOr(s string, def time.Duration) time.Duration {
+	if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return d
+	}
+	return def
+}
+
+// newSessionMonitor builds a monitor from cfg that reports transitions to
+// the daemon.
+func (d *Daemon) newSessionMonitor(cfg *SessionMonitorConfig) *monitoring.SessionMonitor {
+	idle := monitoring.NewIdleDetector(
+		monitoring.WithIdleTimeout(parseDurationOr(cfg.IdleTimeout, monitoring.DefaultIdleTimeout)),
+		monitoring.WithStaleTimeout(parseDurationOr(cfg.StaleTimeout, monitoring.DefaultStaleTimeout)),
+		monitoring.WithStuckTimeout(parseDurationOr(cfg.StuckTimeout, monitoring.DefaultStuckTimeout)),
+	)
+	return monitoring.NewSessionMonitor(d.backend,
+		monitoring.WithMonitorIdleDetector(idle),
+		monitoring.WithPollInterval(parseDurationOr(cfg.Interval, monitoring.DefaultPollInterval)),
+		monitoring.WithTransitionHandler(d.handleSessionTransition),
+	)
+}
+
+// startSessionMonitor starts polling polecat panes if the monitor is
+// enabled in mayor/daemon.json.
+func (d *Daemon) startSessionMonitor() {
+	cfg := sessionMonitorConfig(d.patrolConfig)
+	if cfg == nil {
+		return
+	}
+	d.sessionMonitor = d.newSessionMonitor(cfg)
+	d.syncMonitoredSessions()
+	go d.sessionMonitor.Run(d.ctx)
+	d.logger.Printf("Session monitor started, watching %d session(s)", len(d.sessionMonitor.Watched()))
+}
+
+// syncMonitoredSessions watches every polecat with hooked work and drops
+// the rest.
+func (d *Daemon) syncMonitoredSessions() {
+	wanted := make(map[string]bool)
+	for _, p := range d.hookedPolecatSessions() {
+		d.sessionMonitor.Watch(p.agentID, p.session, p.preset)
+		wanted[p.agentID] = true
+	}
+	for _, agentID := range d.sessionMonitor.Watched() {
+		if !wanted[agentID] {
+			d.sessionMonitor.Unwatch(agentID)
+		}
+	}
+}
+
+// hookedPolecat is a polecat with hooked work, whose pane the daemon
+// watches.
+type hookedPolecat struct {
+	agentID string
+	session string
+	preset  string
+}
+
+// hookedPolecatSessions lists every polecat with hooked work. Coop-managed
+// polecats are registered with the coop backend so their panes can be
+// captured over HTTP.
+func (d *Daemon) hookedPolecatSessions() []hookedPolecat {
+	townName, _ := workspace.GetTownName(d.config.TownRoot)
+	var hooked []hookedPolecat
+	for _, rigName := range d.getKnownRigs() {
+		polecats, err := listPolecatWorktrees(filepath.Join(d.config.TownRoot, rigName, "polecats"))
+		if err != nil {
+			continue
+		}
+		preset, _ := config.ResolveRoleAgentName("polecat", d.config.TownRoot, filepath.Join(d.config.TownRoot, rigName))
+		for _, polecatName := range polecats {
+			info, err := d.getAgentBeadInfo(beads.PolecatBeadIDTown(townName, rigName, polecatName))
+			if err != nil || info.HookBead == "" {
+				continue
+			}
+			sessionName := fmt.Sprintf("gt-%s-%s", rigName, polecatName)
+			if coopURL := getCoopURLFromNotes(info.Notes); coopURL != "" {
+				if coop, ok := d.backend.(*terminal.CoopBackend); ok {
+					coop.AddSession(sessionName, coopURL)
+				}
+			}
+			hooked = append(hooked, hookedPolecat{
+				agentID: rigName + "/polecats/" + polecatName,
+				session: sessionName,
+				preset:  preset,
+			})
+		}
+	}
+	return hooked
+}
+
+// polecatAgentBeadID maps a "rig/polecats/name" agent ID to its agent bead.
+func (d *Daemon) polecatAgentBeadID(agentID string) string {
+	parts := strings.Split(agentID, "/")
+	if len(parts) != 3 || parts[1] != "polecats" {
+		return ""
+	}
+	townName, _ := workspace.GetTownName(d.config.TownRoot)
+	return beads.PolecatBeadIDTown(townName, parts[0], parts[2])
+}
+
+// handleSessionTransition acts on agents entering and leaving the stuck
+// level; the milder levels are only logged.
+func (d *Daemon) handleSessionTransition(t monitoring.Transition) {
+	switch {
+	case t.Stuck():
+		d.reportStuckAgent(t)
+	case t.Recovered():
+		d.logger.Printf("Session monitor: %s is producing output again", t.AgentID)
+		if beadID := d.polecatAgentBeadID(t.AgentID); beadID != "" {
+			if _, err := beads.New(d.config.TownRoot).Run("agent", "state", beadID, "working"); err != nil {
+				d.logger.Printf("Warning: clearing stuck state on %s: %v", beadID, err)
+			}
+		}
+	default:
+		d.logger.Printf("Session monitor: %s %s → %s", t.AgentID, t.From, t.To)
+	}
+}
+
+// reportStuckAgent feeds a stuck agent to the witness as a finding, marks
+// its agent bead stuck (which the K8s controller turns into a pod restart),
+// and publishes an agent_stuck event.
+func (d *Daemon) reportStuckAgent(t monitoring.Transition) {
+	idle := time.Since(t.LastActivity).Round(time.Second)
+	d.logger.Printf("STUCK DETECTED: %s output unchanged for %v (session %s)", t.AgentID, idle, t.Session)
+
+	lines := terminal.SplitCapture(t.Output)
+	if len(lines) > stuckEvidenceLines {
+		lines = lines[len(lines)-stuckEvidenceLines:]
+	}
+	evidence := fmt.Sprintf("Pane output unchanged since %s (%v).", t.LastActivity.UTC().Format(time.RFC3339), idle)
+	if t.Status != "" {
+		evidence += fmt.Sprintf(" Last inferred status: %s.", t.Status)
+	}
+	if len(lines) > 0 {
+		evidence += "\n\n```\n" + strings.Join(lines, "\n") + "\n```"
+	}
+
+	bd := beads.New(beads.ResolveBeadsDir(d.config.TownRoot))
+	if _, _, err := bd.ReportFinding(t.AgentID+": stuck", &beads.FindingFields{
+		Agent:           t.AgentID,
+		Category:        "stuck",
+		Severity:        "high",
+		SuggestedAction: "Nudge the agent, or restart its session if it doesn't respond",
+		ReportedBy:      "daemon:session-monitor",
+		Evidence:        evidence,
+	}); err != nil {
+		d.logger.Printf("Warning: reporting stuck finding for %s: %v", t.AgentID, err)
+	}
+
+	if beadID := d.polecatAgentBeadID(t.AgentID); beadID != "" {
+		if _, err := beads.New(d.config.TownRoot).Run("agent", "state", beadID, "stuck"); err != nil {
+			d.logger.Printf("Warning: marking %s stuck: %v", beadID, err)
+		}
+	}
+
+	_ = eventbus.Publish("daemon", eventbus.AgentStuck{
+		Agent:   t.AgentID,
+		Session: t.Session,
+		Idle:    idle,
+		Status:  string(t.Status),
+	})
+}
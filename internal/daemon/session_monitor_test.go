@@ -0,0 +1,45 @@
+package daemon
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestSessionMonitorConfig(t *testing.T) {
+	// Off unless explicitly enabled, unlike the default-on patrols.
+	if sessionMonitorConfig(nil) != nil {
+		t.Error("nil config should leave the session monitor off")
+	}
+	var config DaemonPatrolConfig
+	if err := json.Unmarshal([]byte(`{"patrols":{"deacon":{"enabled":true}}}`), &config); err != nil {
+		t.Fatal(err)
+	}
+	if sessionMonitorConfig(&config) != nil {
+		t.Error("session monitor should be off when not configured")
+	}
+
+	if err := json.Unmarshal([]byte(`{"patrols":{"session_monitor":{"enabled":true,"interval":"10s","stuck_timeout":"20m","idle_timeout":"soon"}}}`), &config); err != nil {
+		t.Fatal(err)
+	}
+	cfg := sessionMonitorConfig(&config)
+	if cfg == nil {
+		t.Fatal("session monitor should be on")
+	}
+	if got := parseDurationOr(cfg.StuckTimeout, time.Minute); got != 20*time.Minute {
+		t.Errorf("stuck timeout = %v, want 20m", got)
+	}
+	if got := parseDurationOr(cfg.IdleTimeout, 2*time.Minute); got != 2*time.Minute {
+		t.Errorf("invalid idle timeout = %v, want default", got)
+	}
+}
+
+func TestPolecatAgentBeadID(t *testing.T) {
+	d := &Daemon{config: &Config{TownRoot: t.TempDir()}}
+	if got := d.polecatAgentBeadID("gastown/witness"); got != "" {
+		t.Errorf("non-polecat agent = %q, want empty", got)
+	}
+	if got := d.polecatAgentBeadID("gastown/polecats/nux"); got == "" {
+		t.Error("polecat agent bead ID should not be empty")
+	}
+}
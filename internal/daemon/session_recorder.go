@@ -0,0 +1,165 @@
+package daemon
+
+import (
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/monitoring"
+	"github.com/steveyegge/gastown/internal/terminal"
+)
+
+// SessionRecordingConfig configures recording of polecat sessions for
+// `gt replay`. It is off unless enabled because recordings of busy panes
+// grow with every change.
+type SessionRecordingConfig struct {
+	// Enabled turns session recording on.
+	Enabled bool `json:"enabled"`
+
+	// Interval is how often each polecat's pane is captured (default "5s").
+	Interval string `json:"interval,omitempty"`
+
+	// Lines is how many lines of each pane are captured (default 200).
+	Lines int `json:"lines,omitempty"`
+
+	// Keep is how many recordings are kept per session (default 10).
+	Keep int `json:"keep,omitempty"`
+}
+
+// Session recording defaults, used when not configured.
+const (
+	defaultRecordingInterval = 5 * time.Second
+	defaultRecordingLines    = 200
+	defaultRecordingKeep     = 10
+)
+
+// sessionRecordingConfig returns the session recording config, or nil when
+// recording is not enabled.
+func sessionRecordingConfig(config *DaemonPatrolConfig) *SessionRecordingConfig {
+	if config == nil || config.Patrols == nil || config.Patrols.SessionRecording == nil ||
+		!config.Patrols.SessionRecording.Enabled {
+		return nil
+	}
+	return config.Patrols.SessionRecording
+}
+
+// sessionRecorder records the panes of polecats with hooked work. The
+// heartbeat sets which sessions to record; a ticker captures them.
+type sessionRecorder struct {
+	backend  monitoring.PaneCapturer
+	townRoot string
+	lines    int
+	keep     int
+	logf     func(format string, args ...interface{})
+
+	mu       sync.Mutex
+	sessions map[string]string             // agent ID → session name
+	active   map[string]*terminal.Recorder // agent ID → open recording
+}
+
+// startSessionRecorder starts recording polecat panes if enabled in
+// mayor/daemon.json.
+func (d *Daemon) startSessionRecorder() {
+	cfg := sessionRecordingConfig(d.patrolConfig)
+	if cfg == nil {
+		return
+	}
+	r := &sessionRecorder{
+		backend:  d.backend,
+		townRoot: d.config.TownRoot,
+		lines:    cfg.Lines,
+		keep:     cfg.Keep,
+		logf:     d.logger.Printf,
+		sessions: make(map[string]string),
+		active:   make(map[string]*terminal.Recorder),
+	}
+	if r.lines <= 0 {
+		r.lines = defaultRecordingLines
+	}
+	if r.keep <= 0 {
+		r.keep = defaultRecordingKeep
+	}
+	d.sessionRecorder = r
+	d.syncRecordedSessions()
+
+	interval := parseDurationOr(cfg.Interval, defaultRecordingInterval)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-d.ctx.Done():
+				r.closeAll()
+				return
+			case <-ticker.C:
+				r.capture(time.Now())
+			}
+		}
+	}()
+	d.logger.Printf("Session recorder started (every %v)", interval)
+}
+
+// syncRecordedSessions records every polecat with hooked work.
+func (d *Daemon) syncRecordedSessions() {
+	sessions := make(map[string]string)
+	for _, p := range d.hookedPolecatSessions() {
+		sessions[p.agentID] = p.session
+	}
+	d.sessionRecorder.setSessions(sessions)
+}
+
+// setSessions replaces the sessions to record. Recordings of sessions no
+// longer listed are closed.
+func (r *sessionRecorder) setSessions(sessions map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for agentID := range r.sessions {
+		if _, ok := sessions[agentID]; !ok {
+			r.stop(agentID)
+		}
+	}
+	r.sessions = sessions
+}
+
+// capture records each session's pane. A session whose pane can't be
+// captured has its recording closed; if it comes back, it gets a new one.
+func (r *sessionRecorder) capture(now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for agentID, session := range r.sessions {
+		output, err := r.backend.CapturePane(session, r.lines)
+		if err != nil {
+			r.stop(agentID)
+			continue
+		}
+		rec := r.active[agentID]
+		if rec == nil {
+			rec, err = terminal.StartRecording(r.townRoot, agentID, session, r.keep, now)
+			if err != nil {
+				r.logf("Warning: recording %s: %v", agentID, err)
+				continue
+			}
+			r.active[agentID] = rec
+		}
+		if err := rec.Record(output, now); err != nil {
+			r.logf("Warning: recording %s: %v", agentID, err)
+			r.stop(agentID)
+		}
+	}
+}
+
+// stop closes an agent's recording, if it has one. Callers hold mu.
+func (r *sessionRecorder) stop(agentID string) {
+	if rec := r.active[agentID]; rec != nil {
+		_ = rec.Close()
+		delete(r.active, agentID)
+	}
+}
+
+// closeAll closes every open recording.
+func (r *sessionRecorder) closeAll() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for agentID := range r.active {
+		r.stop(agentID)
+	}
+}
@@ -0,0 +1,86 @@
+package daemon
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/terminal"
+)
+
+func TestSessionRecordingConfig(t *testing.T) {
+	if sessionRecordingConfig(nil) != nil {
+		t.Error("nil config should leave recording off")
+	}
+	var config DaemonPatrolConfig
+	if err := json.Unmarshal([]byte(`{"patrols":{"session_recording":{"enabled":false,"interval":"1s"}}}`), &config); err != nil {
+		t.Fatal(err)
+	}
+	if sessionRecordingConfig(&config) != nil {
+		t.Error("recording should be off when not enabled")
+	}
+	if err := json.Unmarshal([]byte(`{"patrols":{"session_recording":{"enabled":true,"keep":3}}}`), &config); err != nil {
+		t.Fatal(err)
+	}
+	if cfg := sessionRecordingConfig(&config); cfg == nil || cfg.Keep != 3 {
+		t.Errorf("config = %+v, want enabled with keep 3", cfg)
+	}
+}
+
+// recorderPanes is a PaneCapturer whose sessions can be taken away.
+type recorderPanes map[string]string
+
+func (p recorderPanes) CapturePane(session string, _ int) (string, error) {
+	out, ok := p[session]
+	if !ok {
+		return "", errors.New("no such session")
+	}
+	return out, nil
+}
+
+func TestSessionRecorder_Capture(t *testing.T) {
+	town := t.TempDir()
+	panes := recorderPanes{"gt-gastown-nux": "working"}
+	r := &sessionRecorder{
+		backend:  panes,
+		townRoot: town,
+		lines:    defaultRecordingLines,
+		keep:     defaultRecordingKeep,
+		logf:     t.Logf,
+		active:   make(map[string]*terminal.Recorder),
+	}
+	r.setSessions(map[string]string{"gastown/polecats/nux": "gt-gastown-nux"})
+
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	r.capture(start)
+	panes["gt-gastown-nux"] = "working\ndone"
+	r.capture(start.Add(5 * time.Second))
+
+	// A session that goes away ends its recording; coming back starts another.
+	delete(panes, "gt-gastown-nux")
+	r.capture(start.Add(10 * time.Second))
+	if len(r.active) != 0 {
+		t.Errorf("dead session should have no open recording, got %d", len(r.active))
+	}
+	panes["gt-gastown-nux"] = "restarted"
+	r.capture(start.Add(15 * time.Second))
+
+	ids, err := terminal.ListRecordings(town, "gt-gastown-nux")
+	if err != nil || len(ids) != 2 {
+		t.Fatalf("ListRecordings = %v, %v; want 2 recordings", ids, err)
+	}
+	rec, err := terminal.LoadRecording(town, "gt-gastown-nux", ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if screen := rec.ScreenAt(rec.End()); len(screen) != 2 || screen[1] != "done" {
+		t.Errorf("first recording ends with %q", screen)
+	}
+
+	// Unhooked sessions stop being recorded.
+	r.setSessions(map[string]string{})
+	if len(r.active) != 0 {
+		t.Errorf("unhooked session should have no open recording, got %d", len(r.active))
+	}
+}
@@ -0,0 +1,58 @@
+package daemon
+
+import (
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/eventbus"
+	"github.com/steveyegge/gastown/internal/settings"
+)
+
+// settingsRefreshInterval is how often settings beads are re-read. Changes
+// made with gt config settings on this host arrive sooner, through the
+// settings cache.
+const settingsRefreshInterval = time.Minute
+
+// startSettings loads the town's operational settings and keeps them
+// current. Loops that hold on to a setting, rather than reading it each
+// pass, subscribe to d.bus for eventbus.EventSettingsChanged.
+func (d *Daemon) startSettings() {
+	d.bus = eventbus.New()
+	d.settings = settings.NewStore(d.config.TownRoot, settings.BeadsLoader(d.config.TownRoot), d.bus)
+	if _, err := d.settings.Reload(); err != nil {
+		d.logger.Printf("Warning: loading settings from beads, using cached values: %v", err)
+	}
+
+	events, unsubscribe := d.bus.Subscribe()
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case <-d.ctx.Done():
+				return
+			case ev, ok := <-events:
+				if !ok {
+					return
+				}
+				if keys, ok := ev.Data.([]string); ok && ev.Type == eventbus.EventSettingsChanged {
+					d.logger.Printf("Settings changed: %s", strings.Join(keys, ", "))
+				}
+			}
+		}
+	}()
+	go d.settings.Watch(d.ctx, settingsRefreshInterval, d.logger.Printf)
+}
+
+// settingsChanged reports whether ev announces a change to key.
+func settingsChanged(ev eventbus.Event, key string) bool {
+	if ev.Type != eventbus.EventSettingsChanged {
+		return false
+	}
+	keys, _ := ev.Data.([]string)
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package daemon
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/sling"
+)
+
+// slingQueueInterval is how often scheduled slings are checked.
+const slingQueueInterval = 30 * time.Second
+
+// startSlingQueue dispatches slings scheduled with gt sling --at/--after
+// once they are due. Nothing is dispatched while the town is paused.
+func (d *Daemon) startSlingQueue() {
+	queue := sling.NewQueue(d.config.TownRoot)
+	tick := func() {
+		if _, err := os.Stat(sling.QueuePath(d.config.TownRoot)); err != nil {
+			return // nothing has ever been scheduled
+		}
+		if paused, _, _ := deacon.IsPaused(d.config.TownRoot); paused {
+			return
+		}
+		handled, err := queue.DispatchDue(d.beadClosed, d.runScheduledSling)
+		if err != nil {
+			d.logger.Printf("Warning: sling queue: %v", err)
+		}
+		for _, s := range handled {
+			switch s.Status {
+			case sling.QueueDispatched:
+				d.logger.Printf("Dispatched scheduled sling %s: %s -> %s", s.ID, s.Bead, s.Target)
+			case sling.QueueFailed:
+				d.logger.Printf("Warning: scheduled sling %s failed after %d attempts: %s", s.ID, s.Attempts, s.LastError)
+			default:
+				d.logger.Printf("Warning: scheduled sling %s attempt %d failed: %s", s.ID, s.Attempts, s.LastError)
+			}
+		}
+	}
+
+	d.checkpoints.Add(1)
+	go func() {
+		defer d.checkpoints.Done()
+		ticker := time.NewTicker(slingQueueInterval)
+		defer ticker.Stop()
+		tick()
+		for {
+			select {
+			case <-d.ctx.Done():
+				return
+			case <-ticker.C:
+				tick()
+			}
+		}
+	}()
+}
+
+// beadClosed reports whether a bead a scheduled sling waits on is closed.
+// A bead that can't be read is treated as still open.
+func (d *Daemon) beadClosed(beadID string) bool {
+	info, err := sling.GetBeadInfo(beadID, d.config.TownRoot)
+	return err == nil && info.Status == "closed"
+}
+
+// runScheduledSling runs gt sling for a queued entry from the town root.
+func (d *Daemon) runScheduledSling(s *sling.ScheduledSling) error {
+	args := []string{"sling", s.Bead}
+	if s.Target != "" {
+		args = append(args, s.Target)
+	}
+	args = append(args, s.Flags...)
+	cmd := exec.CommandContext(d.ctx, "gt", args...) //nolint:gosec // G204: args come from the town's own queue file
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = os.Environ() // Inherit PATH to find gt executable
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			return err
+		}
+		if i := strings.LastIndex(msg, "\n"); i >= 0 {
+			msg = msg[i+1:]
+		}
+		return fmt.Errorf("%v: %s", err, msg)
+	}
+	return nil
+}
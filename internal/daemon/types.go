@@ -30,6 +30,10 @@ type Config struct {
 
 	// PidFile is the path to the PID file.
 	PidFile string `json:"pid_file"`
+
+	// MetricsAddr, if set, is the listen address for the Prometheus
+	// /metrics endpoint (e.g. ":9102").
+	MetricsAddr string `json:"metrics_addr,omitempty"`
 }
 
 // DefaultConfig returns the default daemon configuration.
@@ -114,6 +118,18 @@ type PatrolsConfig struct {
 	Witness    *PatrolConfig     `json:"witness,omitempty"`
 	Deacon     *PatrolConfig     `json:"deacon,omitempty"`
 	DoltServer *DoltServerConfig `json:"dolt_server,omitempty"`
+
+	// ConvoySupervisor auto-closes finished convoys and escalates stalled ones.
+	ConvoySupervisor *ConvoySupervisorConfig `json:"convoy_supervisor,omitempty"`
+
+	// SessionMonitor detects stuck polecats from unchanging pane output.
+	SessionMonitor *SessionMonitorConfig `json:"session_monitor,omitempty"`
+
+	// Usage records agent token spend from session logs and alerts on budgets.
+	Usage *UsageConfig `json:"usage,omitempty"`
+
+	// SessionRecording records polecat panes for replay with `gt replay`.
+	SessionRecording *SessionRecordingConfig `json:"session_recording,omitempty"`
 }
 
 // DaemonPatrolConfig is the structure of mayor/daemon.json.
@@ -165,6 +181,10 @@ func IsPatrolEnabled(config *DaemonPatrolConfig, patrol string) bool {
 		if config.Patrols.Deacon != nil {
 			return config.Patrols.Deacon.Enabled
 		}
+	case "convoy_supervisor":
+		if config.Patrols.ConvoySupervisor != nil {
+			return config.Patrols.ConvoySupervisor.Enabled
+		}
 	}
 	return true // Default: enabled
 }
@@ -0,0 +1,210 @@
+package daemon
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/eventbus"
+	"github.com/steveyegge/gastown/internal/monitoring"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// UsageConfig configures token usage collection. It is off unless enabled
+// because it reads every local Claude Code and Codex session log.
+type UsageConfig struct {
+	// Enabled turns usage collection on.
+	Enabled bool `json:"enabled"`
+
+	// Interval is how often session logs are read (default "5m").
+	Interval string `json:"interval,omitempty"`
+}
+
+// defaultUsageInterval is how often usage is collected when not configured.
+const defaultUsageInterval = 5 * time.Minute
+
+// usageConfig returns the usage config, or nil when collection is not
+// enabled.
+func usageConfig(config *DaemonPatrolConfig) *UsageConfig {
+	if config == nil || config.Patrols == nil || config.Patrols.Usage == nil ||
+		!config.Patrols.Usage.Enabled {
+		return nil
+	}
+	return config.Patrols.Usage
+}
+
+// startUsageCollector starts recording agent token usage if enabled in
+// mayor/daemon.json. Besides the default Claude config dir, it reads the
+// config dir of every account in mayor/accounts.json.
+func (d *Daemon) startUsageCollector() {
+	cfg := usageConfig(d.patrolConfig)
+	if cfg == nil {
+		return
+	}
+
+	claudeDirs := []string{monitoring.DefaultClaudeProjectsDir()}
+	if accounts, err := config.LoadAccountsConfig(constants.MayorAccountsPath(d.config.TownRoot)); err == nil {
+		for _, acct := range accounts.Accounts {
+			if acct.ConfigDir != "" {
+				claudeDirs = append(claudeDirs, filepath.Join(expandHome(acct.ConfigDir), "projects"))
+			}
+		}
+	}
+
+	collector := monitoring.NewUsageCollector(monitoring.NewUsageLedger(d.config.TownRoot), d.usageAgents,
+		monitoring.WithClaudeProjectsDirs(claudeDirs...))
+	interval := parseDurationOr(cfg.Interval, defaultUsageInterval)
+	d.usageAlerts = make(map[string]bool)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			d.collectUsage(collector)
+			select {
+			case <-d.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	d.logger.Printf("Usage collector started (every %v)", interval)
+}
+
+// collectUsage records new usage and alerts on budgets.
+func (d *Daemon) collectUsage(collector *monitoring.UsageCollector) {
+	records, err := collector.Collect()
+	if err != nil {
+		d.logger.Printf("Warning: collecting usage: %v", err)
+		return
+	}
+	if len(records) > 0 {
+		d.checkUsageBudgets()
+	}
+}
+
+// usageAgents lists the town's agents and their directories. Polecats and
+// crew are charged to the bead on their hook.
+func (d *Daemon) usageAgents() []monitoring.UsageAgent {
+	townRoot := d.config.TownRoot
+	townName, _ := workspace.GetTownName(townRoot)
+	agents := []monitoring.UsageAgent{
+		{ID: "mayor/", Dir: filepath.Join(townRoot, "mayor")},
+		{ID: "deacon/", Dir: filepath.Join(townRoot, "deacon")},
+	}
+	hookBead := func(beadID string) string {
+		if info, err := d.getAgentBeadInfo(beadID); err == nil {
+			return info.HookBead
+		}
+		return ""
+	}
+
+	for _, rigName := range d.getKnownRigs() {
+		rigPath := filepath.Join(townRoot, rigName)
+		agents = append(agents,
+			monitoring.UsageAgent{ID: rigName + "/witness", Dir: filepath.Join(rigPath, "witness")},
+			monitoring.UsageAgent{ID: rigName + "/refinery", Dir: filepath.Join(rigPath, "refinery")},
+		)
+		polecats, _ := listPolecatWorktrees(filepath.Join(rigPath, "polecats"))
+		for _, name := range polecats {
+			agents = append(agents, monitoring.UsageAgent{
+				ID:   rigName + "/polecats/" + name,
+				Dir:  filepath.Join(rigPath, "polecats", name),
+				Bead: hookBead(beads.PolecatBeadIDTown(townName, rigName, name)),
+			})
+		}
+		crew, _ := listPolecatWorktrees(filepath.Join(rigPath, "crew"))
+		for _, name := range crew {
+			agents = append(agents, monitoring.UsageAgent{
+				ID:   rigName + "/crew/" + name,
+				Dir:  filepath.Join(rigPath, "crew", name),
+				Bead: hookBead(beads.CrewBeadIDTown(townName, rigName, name)),
+			})
+		}
+	}
+	return agents
+}
+
+// checkUsageBudgets compares today's spend with the budgets in town
+// settings. Each budget warns once and alerts once per day: a feed event
+// for both, plus mail to the mayor when a budget is exceeded.
+func (d *Daemon) checkUsageBudgets() {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(d.config.TownRoot))
+	if err != nil || settings.UsageBudgets == nil {
+		return
+	}
+	today := monitoring.StartOfDay(time.Now())
+	records, err := monitoring.NewUsageLedger(d.config.TownRoot).Records(today)
+	if err != nil {
+		d.logger.Printf("Warning: reading usage ledger: %v", err)
+		return
+	}
+
+	for _, b := range monitoring.CheckBudgets(monitoring.SummarizeUsage(records), settings.UsageBudgets) {
+		if !b.Exceeded() && !b.Warning() {
+			continue
+		}
+		key := fmt.Sprintf("%s|%s|%s|%t", today.Format("2006-01-02"), b.Scope, b.Key, b.Exceeded())
+		if d.usageAlerts[key] {
+			continue
+		}
+		d.usageAlerts[key] = true
+
+		name := budgetName(b)
+		d.logger.Printf("Usage budget: %s spent $%.2f of $%.2f today", name, b.SpentUSD, b.LimitUSD)
+		_ = eventbus.Publish("daemon", eventbus.UsageBudget{
+			Scope:    b.Scope,
+			Key:      b.Key,
+			SpentUSD: b.SpentUSD,
+			LimitUSD: b.LimitUSD,
+			Exceeded: b.Exceeded(),
+		})
+		if b.Exceeded() {
+			d.notifyMayorOfBudget(name, b)
+		}
+	}
+}
+
+// budgetName describes a budget's scope for logs and mail.
+func budgetName(b monitoring.BudgetStatus) string {
+	if b.Key == "" {
+		return b.Scope
+	}
+	return fmt.Sprintf("%s %s", b.Scope, b.Key)
+}
+
+// notifyMayorOfBudget mails the mayor about an exceeded daily budget.
+func (d *Daemon) notifyMayorOfBudget(name string, b monitoring.BudgetStatus) {
+	subject := fmt.Sprintf("BUDGET_EXCEEDED: %s at $%.2f of $%.2f", name, b.SpentUSD, b.LimitUSD)
+	body := fmt.Sprintf(`Daily token spend for %s has passed its budget.
+
+spent_usd: %.2f
+limit_usd: %.2f
+
+Action needed: Check 'gt usage' for the agents and beads driving spend. Consider pausing work in this scope.`,
+		name, b.SpentUSD, b.LimitUSD)
+
+	cmd := exec.Command("gt", "mail", "send", "mayor/", "-s", subject, "-m", body)
+	cmd.Dir = d.config.TownRoot
+	cmd.Env = os.Environ() // Inherit PATH to find gt executable
+
+	if err := cmd.Run(); err != nil {
+		d.logger.Printf("Warning: failed to notify mayor of exceeded budget: %v", err)
+	}
+}
+
+// expandHome expands a leading ~/ to the user's home directory.
+func expandHome(path string) string {
+	if strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, path[2:])
+		}
+	}
+	return path
+}
@@ -0,0 +1,49 @@
+package daemon
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/monitoring"
+)
+
+func TestUsageConfig(t *testing.T) {
+	var cfg DaemonPatrolConfig
+	if err := json.Unmarshal([]byte(`{"patrols":{"usage":{"enabled":false}}}`), &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if usageConfig(&cfg) != nil {
+		t.Error("usage collection should be off unless enabled")
+	}
+	if err := json.Unmarshal([]byte(`{"patrols":{"usage":{"enabled":true,"interval":"1m"}}}`), &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if u := usageConfig(&cfg); u == nil || parseDurationOr(u.Interval, defaultUsageInterval) != time.Minute {
+		t.Errorf("usage config = %+v, want enabled every 1m", u)
+	}
+}
+
+func TestCheckUsageBudgetsWarnsOncePerDay(t *testing.T) {
+	d, _ := testDaemonWithTown(t, "test")
+	d.usageAlerts = make(map[string]bool)
+	settings := config.NewTownSettings()
+	settings.UsageBudgets = &config.UsageBudgets{RigDailyUSD: 10}
+	if err := config.SaveTownSettings(config.TownSettingsPath(d.config.TownRoot), settings); err != nil {
+		t.Fatal(err)
+	}
+	ledger := monitoring.NewUsageLedger(d.config.TownRoot)
+	if err := ledger.Append(monitoring.UsageRecord{
+		Time: time.Now(), Agent: "gastown/polecats/nux", Rig: "gastown",
+		TokenUsage: monitoring.TokenUsage{CostUSD: 9},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	d.checkUsageBudgets()
+	d.checkUsageBudgets()
+	if len(d.usageAlerts) != 1 {
+		t.Errorf("alerts = %v, want one warning for rig gastown", d.usageAlerts)
+	}
+}
@@ -0,0 +1,95 @@
+package daemon
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/eventbus"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/sling"
+)
+
+// startWarmPools keeps each rig's warm pool (settings/config.json
+// warm_pool) at size. Pools of parked or docked rigs, and pools that have
+// been disabled, are drained. Nothing changes while the town is paused.
+// Pools are topped up every warm_pool.interval (see gt config settings).
+func (d *Daemon) startWarmPools() {
+	go func() {
+		var changes <-chan eventbus.Event
+		if d.bus != nil {
+			var unsubscribe func()
+			changes, unsubscribe = d.bus.Subscribe()
+			defer unsubscribe()
+		}
+
+		ticker := time.NewTicker(d.settings.Duration(config.KnobWarmPoolInterval))
+		defer ticker.Stop()
+		d.tendWarmPools(time.Now())
+		for {
+			select {
+			case <-d.ctx.Done():
+				return
+			case now := <-ticker.C:
+				d.tendWarmPools(now)
+			case ev, ok := <-changes:
+				if !ok {
+					changes = nil
+					continue
+				}
+				if settingsChanged(ev, config.KnobWarmPoolInterval) {
+					ticker.Reset(d.settings.Duration(config.KnobWarmPoolInterval))
+				}
+				if settingsChanged(ev, config.KnobWarmPoolMaxSize) {
+					d.tendWarmPools(time.Now())
+				}
+			}
+		}
+	}()
+}
+
+// tendWarmPools runs one replenish pass over every rig with a warm pool.
+func (d *Daemon) tendWarmPools(now time.Time) {
+	if paused, _, _ := deacon.IsPaused(d.config.TownRoot); paused {
+		return
+	}
+
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(d.config.TownRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return
+	}
+	rigMgr := rig.NewManager(d.config.TownRoot, rigsConfig, git.NewGit(d.config.TownRoot))
+
+	for _, rigName := range d.getKnownRigs() {
+		r, err := rigMgr.GetRig(rigName)
+		if err != nil {
+			continue
+		}
+		cfg := sling.WarmPoolConfig(r)
+		if cfg != nil {
+			if ok, _ := d.isRigOperational(rigName); !ok {
+				cfg = nil
+			} else if limit := d.settings.Int(config.KnobWarmPoolMaxSize); limit > 0 && cfg.Size > limit {
+				capped := *cfg
+				capped.Size = limit
+				cfg = &capped
+			}
+		}
+		if cfg == nil {
+			if pool, err := polecat.LoadWarmPool(r.Path); err != nil || len(pool.Members) == 0 {
+				continue
+			}
+		}
+
+		spawned, reclaimed, err := sling.ReplenishWarmPool(d.config.TownRoot, r, cfg, now)
+		if len(spawned) > 0 || len(reclaimed) > 0 {
+			d.logger.Printf("Warm pool %s: spawned %v, reclaimed %v", rigName, spawned, reclaimed)
+		}
+		if err != nil {
+			d.logger.Printf("Warning: warm pool %s: %v", rigName, err)
+		}
+	}
+}
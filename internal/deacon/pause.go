@@ -22,6 +22,37 @@ type PauseState struct {
 
 	// PausedBy identifies who paused the Deacon (e.g., "human", "mayor").
 	PausedBy string `json:"paused_by,omitempty"`
+
+	// Drain is set when the whole town was paused with gt pause --drain.
+	Drain *DrainState `json:"drain,omitempty"`
+}
+
+// DrainState tracks a town-wide drain: polecats are asked to finish or
+// checkpoint, then their sessions are suspended until gt resume --town.
+type DrainState struct {
+	// StartedAt is when the drain began. Checkpoints written after this
+	// count as the agent having settled.
+	StartedAt time.Time `json:"started_at"`
+
+	// Phase is "draining" while waiting on agents, then "suspended".
+	Phase string `json:"phase"`
+
+	// Suspended lists the polecats (rig/name) whose sessions were stopped,
+	// to be restarted on resume.
+	Suspended []string `json:"suspended,omitempty"`
+}
+
+// Drain phases.
+const (
+	DrainPhaseDraining  = "draining"
+	DrainPhaseSuspended = "suspended"
+)
+
+// IsDraining reports whether a town drain is in progress or complete.
+// While it is, nothing should restart or dispatch work to polecats.
+func IsDraining(townRoot string) bool {
+	paused, state, err := IsPaused(townRoot)
+	return err == nil && paused && state.Drain != nil
 }
 
 // GetPauseFile returns the path to the Deacon pause file.
@@ -53,6 +84,16 @@ func IsPaused(townRoot string) (bool, *PauseState, error) {
 
 // Pause pauses the Deacon by creating the pause file.
 func Pause(townRoot, reason, pausedBy string) error {
+	return SavePauseState(townRoot, &PauseState{
+		Paused:   true,
+		Reason:   reason,
+		PausedAt: time.Now().UTC(),
+		PausedBy: pausedBy,
+	})
+}
+
+// SavePauseState writes the pause file.
+func SavePauseState(townRoot string, state *PauseState) error {
 	pauseFile := GetPauseFile(townRoot)
 
 	// Ensure parent directory exists
@@ -60,13 +101,6 @@ func Pause(townRoot, reason, pausedBy string) error {
 		return err
 	}
 
-	state := PauseState{
-		Paused:   true,
-		Reason:   reason,
-		PausedAt: time.Now().UTC(),
-		PausedBy: pausedBy,
-	}
-
 	data, err := json.MarshalIndent(state, "", "  ")
 	if err != nil {
 		return err
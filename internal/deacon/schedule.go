@@ -0,0 +1,241 @@
+package deacon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/bdcmd"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/krc"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// JobFunc runs one maintenance job and returns a one-line summary.
+type JobFunc func(ctx context.Context, townRoot string) (string, error)
+
+// jobTimeout bounds a single job run so a hung command can't stall the
+// rest of the schedule.
+const jobTimeout = 30 * time.Minute
+
+// MaintenanceJobs are the built-in jobs a schedule entry can name.
+var MaintenanceJobs = map[string]JobFunc{
+	"compact":       runCompactJob,
+	"stale-hooks":   runStaleHooksJob,
+	"orphans":       runOrphansJob,
+	"rotate-events": runRotateEventsJob,
+	"convoy-close":  runConvoyCloseJob,
+	"gc":            runGCJob,
+}
+
+// JobStatus is the last-run/next-run record for one scheduled job.
+type JobStatus struct {
+	Name      string    `json:"name"`
+	Cron      string    `json:"cron"`
+	Disabled  bool      `json:"disabled,omitempty"`
+	LastRun   time.Time `json:"last_run,omitempty"`
+	Duration  string    `json:"duration,omitempty"`
+	LastError string    `json:"last_error,omitempty"`
+	Result    string    `json:"result,omitempty"`
+	NextRun   time.Time `json:"next_run,omitempty"`
+}
+
+// ScheduleState is the persisted state of the maintenance schedule.
+type ScheduleState struct {
+	UpdatedAt time.Time    `json:"updated_at"`
+	Jobs      []*JobStatus `json:"jobs"`
+}
+
+// GetScheduleFile returns the path to the schedule state file.
+func GetScheduleFile(townRoot string) string {
+	return filepath.Join(townRoot, ".runtime", "deacon", "schedule.json")
+}
+
+// LoadScheduleState reads the schedule state. A missing file is an empty
+// schedule.
+func LoadScheduleState(townRoot string) (*ScheduleState, error) {
+	data, err := os.ReadFile(GetScheduleFile(townRoot)) //nolint:gosec // G304: path is constructed from trusted townRoot
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &ScheduleState{}, nil
+		}
+		return nil, err
+	}
+	var state ScheduleState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// Scheduler runs configured maintenance jobs when their cron schedules
+// come due. It keeps no state of its own between ticks; everything lives
+// in the schedule file, so a restarted daemon picks up where it left off.
+type Scheduler struct {
+	townRoot string
+	jobs     map[string]JobFunc
+	logf     func(format string, args ...interface{})
+}
+
+// NewScheduler creates a scheduler for the town using the built-in jobs.
+func NewScheduler(townRoot string, logf func(format string, args ...interface{})) *Scheduler {
+	return &Scheduler{townRoot: townRoot, jobs: MaintenanceJobs, logf: logf}
+}
+
+// Tick runs every job that has come due at now and records the results.
+// A job seen for the first time, or whose cron changed, is scheduled from
+// now rather than run immediately. A job that came due while the daemon
+// was down runs once on the next tick.
+func (s *Scheduler) Tick(ctx context.Context, jobs []config.ScheduledJob, now time.Time) error {
+	prev, err := LoadScheduleState(s.townRoot)
+	if err != nil {
+		s.logf("Warning: reading schedule state, starting fresh: %v", err)
+		prev = &ScheduleState{}
+	}
+	byName := make(map[string]*JobStatus, len(prev.Jobs))
+	for _, st := range prev.Jobs {
+		byName[st.Name] = st
+	}
+
+	state := &ScheduleState{UpdatedAt: now}
+	for _, job := range jobs {
+		name := job.JobName()
+		if name == "" {
+			continue
+		}
+		st := byName[name]
+		if st == nil || st.Cron != job.Cron {
+			st = &JobStatus{Name: name, Cron: job.Cron}
+		}
+		st.Disabled = job.Disabled
+		state.Jobs = append(state.Jobs, st)
+
+		cron, err := ParseCron(job.Cron)
+		if err != nil {
+			st.LastError = err.Error()
+			st.NextRun = time.Time{}
+			continue
+		}
+		if job.Disabled {
+			st.NextRun = time.Time{}
+			continue
+		}
+		if !st.NextRun.IsZero() && !now.Before(st.NextRun) {
+			s.run(ctx, job, st, now)
+		}
+		if st.NextRun.IsZero() || !now.Before(st.NextRun) {
+			st.NextRun = cron.Next(now)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(GetScheduleFile(s.townRoot)), 0755); err != nil {
+		return err
+	}
+	return util.AtomicWriteJSON(GetScheduleFile(s.townRoot), state)
+}
+
+// run executes one job and records its outcome in st.
+func (s *Scheduler) run(ctx context.Context, job config.ScheduledJob, st *JobStatus, now time.Time) {
+	ctx, cancel := context.WithTimeout(ctx, jobTimeout)
+	defer cancel()
+
+	start := time.Now()
+	result, err := s.runJob(ctx, job)
+	st.LastRun = now
+	st.Duration = time.Since(start).Round(time.Millisecond).String()
+	st.Result = result
+	st.LastError = ""
+	if err != nil {
+		st.LastError = err.Error()
+		s.logf("Scheduled job %s failed: %v", st.Name, err)
+		return
+	}
+	s.logf("Scheduled job %s: %s", st.Name, result)
+}
+
+// runJob dispatches to a built-in job or runs the job's command.
+func (s *Scheduler) runJob(ctx context.Context, job config.ScheduledJob) (string, error) {
+	if len(job.Command) > 0 {
+		cmd := exec.CommandContext(ctx, job.Command[0], job.Command[1:]...) //nolint:gosec // G204: command comes from town config
+		cmd.Dir = s.townRoot
+		return runJobCommand(cmd)
+	}
+	fn, ok := s.jobs[job.Job]
+	if !ok {
+		return "", fmt.Errorf("unknown job %q", job.Job)
+	}
+	return fn(ctx, s.townRoot)
+}
+
+// runJobCommand runs cmd and returns the last line of its output as the
+// summary.
+func runJobCommand(cmd *exec.Cmd) (string, error) {
+	out, err := cmd.CombinedOutput()
+	text := strings.TrimSpace(string(out))
+	if i := strings.LastIndex(text, "\n"); i >= 0 {
+		text = text[i+1:]
+	}
+	if err != nil {
+		if text != "" {
+			return "", fmt.Errorf("%w: %s", err, text)
+		}
+		return "", err
+	}
+	return text, nil
+}
+
+func runCompactJob(ctx context.Context, townRoot string) (string, error) {
+	return runJobCommand(bdcmd.CommandContextInDir(ctx, townRoot, "compact"))
+}
+
+func runStaleHooksJob(_ context.Context, townRoot string) (string, error) {
+	result, err := ScanStaleHooks(townRoot, nil)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d stale of %d hooked, %d unhooked", result.StaleCount, result.TotalHooked, result.Unhooked), nil
+}
+
+func runOrphansJob(_ context.Context, _ string) (string, error) {
+	results, err := util.CleanupOrphanedClaudeProcesses()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("signaled %d orphaned process(es)", len(results)), nil
+}
+
+func runRotateEventsJob(_ context.Context, townRoot string) (string, error) {
+	cfg, err := krc.LoadConfig(townRoot)
+	if err != nil {
+		return "", err
+	}
+	// The schedule decides when to rotate; rotate whatever has accumulated.
+	policy := cfg.Rotate
+	policy.MaxSize = 1
+	result, err := events.Rotate(townRoot, policy, time.Now())
+	if err != nil {
+		return "", err
+	}
+	if result.Rotated == "" {
+		return "nothing to rotate", nil
+	}
+	return fmt.Sprintf("rotated %s, removed %d old segment(s)", result.Rotated, len(result.Removed)), nil
+}
+
+func runConvoyCloseJob(ctx context.Context, townRoot string) (string, error) {
+	cmd := exec.CommandContext(ctx, "gt", "convoy", "check")
+	cmd.Dir = townRoot
+	return runJobCommand(cmd)
+}
+
+func runGCJob(ctx context.Context, townRoot string) (string, error) {
+	cmd := exec.CommandContext(ctx, "gt", "gc")
+	cmd.Dir = townRoot
+	return runJobCommand(cmd)
+}
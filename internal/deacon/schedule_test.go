@@ -0,0 +1,84 @@
+package deacon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestSchedulerTick(t *testing.T) {
+	townRoot := t.TempDir()
+	var runs []string
+	s := &Scheduler{
+		townRoot: townRoot,
+		jobs: map[string]JobFunc{
+			"ok": func(context.Context, string) (string, error) {
+				runs = append(runs, "ok")
+				return "did it", nil
+			},
+			"bad": func(context.Context, string) (string, error) {
+				runs = append(runs, "bad")
+				return "", errors.New("boom")
+			},
+		},
+		logf: t.Logf,
+	}
+	jobs := []config.ScheduledJob{
+		{Job: "ok", Cron: "0 * * * *"},
+		{Name: "nightly", Job: "bad", Cron: "0 3 * * *"},
+		{Job: "ok", Name: "off", Cron: "* * * * *", Disabled: true},
+		{Job: "ok", Name: "broken", Cron: "not a cron"},
+	}
+	ctx := context.Background()
+
+	// First tick only schedules.
+	start := time.Date(2026, 1, 14, 2, 30, 0, 0, time.UTC)
+	if err := s.Tick(ctx, jobs, start); err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 0 {
+		t.Fatalf("first tick ran %v", runs)
+	}
+
+	// An hour later both jobs are due; the disabled one stays idle.
+	if err := s.Tick(ctx, jobs, start.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 2 {
+		t.Fatalf("runs = %v, want ok and bad", runs)
+	}
+
+	state, err := LoadScheduleState(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(state.Jobs) != 4 {
+		t.Fatalf("got %d job statuses, want 4", len(state.Jobs))
+	}
+	ok, nightly, off, broken := state.Jobs[0], state.Jobs[1], state.Jobs[2], state.Jobs[3]
+	if ok.Result != "did it" || ok.LastError != "" || !ok.NextRun.Equal(time.Date(2026, 1, 14, 4, 0, 0, 0, time.UTC)) {
+		t.Errorf("ok = %+v", ok)
+	}
+	if nightly.LastError != "boom" || !nightly.NextRun.Equal(time.Date(2026, 1, 15, 3, 0, 0, 0, time.UTC)) {
+		t.Errorf("nightly = %+v", nightly)
+	}
+	if !off.Disabled || !off.NextRun.IsZero() || !off.LastRun.IsZero() {
+		t.Errorf("off = %+v", off)
+	}
+	if broken.LastError == "" {
+		t.Errorf("broken cron should report an error: %+v", broken)
+	}
+
+	// Changing a job's cron reschedules it instead of running it.
+	jobs[0].Cron = "30 * * * *"
+	runs = nil
+	if err := s.Tick(ctx, jobs, start.Add(2*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 0 {
+		t.Errorf("rescheduled job should not run, runs = %v", runs)
+	}
+}
@@ -320,7 +320,7 @@ func (c *PrefixConflictCheck) Run(ctx *CheckContext) *CheckResult {
 
 // PrefixMismatchCheck detects when rigs.json has a different prefix than what
 // routes.jsonl actually uses for a rig. This can happen when:
-// - deriveBeadsPrefix() generates a different prefix than what's in the beads DB
+// - DeriveBeadsPrefix() generates a different prefix than what's in the beads DB
 // - Someone manually edited rigs.json with the wrong prefix
 // - The beads were initialized before auto-derive existed with a different prefix
 type PrefixMismatchCheck struct {
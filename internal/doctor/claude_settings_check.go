@@ -390,7 +390,7 @@ func (c *ClaudeSettingsCheck) checkSettings(path, agentType string) []string {
 	// Check for required elements based on template
 	// All templates should have:
 	// 1. enabledPlugins
-	// 2. Stop hook with bd bus emit --hook=Stop
+	// 2. Stop hook with bd bus emit --hook=Stop and gt handoff check --hook
 	// 3. gt nudge deacon session-started in SessionStart
 
 	// Check enabledPlugins
@@ -417,6 +417,11 @@ func (c *ClaudeSettingsCheck) checkSettings(path, agentType string) []string {
 		missing = append(missing, "bus-emit stdin piping")
 	}
 
+	// Check Stop hook validates the draft handoff before the session ends
+	if !c.hookHasPattern(hooks, "Stop", "gt handoff check --hook") {
+		missing = append(missing, "handoff check hook")
+	}
+
 	// Check UserPromptSubmit hook has bd decision check --inject with stdin piping
 	// Must capture stdin and pipe it to bd decision check (gt-0g7zw4.4)
 	if !c.hookHasPattern(hooks, "UserPromptSubmit", "bd decision check --inject") {
@@ -104,7 +104,7 @@ func createStaleSettings(t *testing.T, path string, missingElements ...string) {
 					"hooks": []any{
 						map[string]any{
 							"type":    "command",
-							"command": "export PATH=\"$HOME/.local/bin:$HOME/go/bin:$PATH\" && _stdin=$(cat) && echo \"$_stdin\" | bd bus emit --hook=Stop",
+							"command": "export PATH=\"$HOME/.local/bin:$HOME/go/bin:$PATH\" && _stdin=$(cat) && (echo \"$_stdin\" | gt handoff check --hook; [ $? -ne 2 ] || exit 2) && echo \"$_stdin\" | bd bus emit --hook=Stop",
 						},
 					},
 				},
@@ -439,7 +439,7 @@ func TestClaudeSettingsCheck_MissingStdinPiping(t *testing.T) {
 					"hooks": []any{
 						map[string]any{
 							"type":    "command",
-							"command": "export PATH=\"$HOME/.local/bin:$HOME/go/bin:$PATH\" && _stdin=$(cat) && echo \"$_stdin\" | bd bus emit --hook=Stop",
+							"command": "export PATH=\"$HOME/.local/bin:$HOME/go/bin:$PATH\" && _stdin=$(cat) && (echo \"$_stdin\" | gt handoff check --hook; [ $? -ne 2 ] || exit 2) && echo \"$_stdin\" | bd bus emit --hook=Stop",
 						},
 					},
 				},
@@ -1317,7 +1317,7 @@ func TestClaudeSettingsCheck_TemplateDrift(t *testing.T) {
 					"hooks": []any{
 						map[string]any{
 							"type":    "command",
-							"command": "export PATH=\"$HOME/.local/bin:$HOME/go/bin:$PATH\" && _stdin=$(cat) && echo \"$_stdin\" | bd bus emit --hook=Stop",
+							"command": "export PATH=\"$HOME/.local/bin:$HOME/go/bin:$PATH\" && _stdin=$(cat) && (echo \"$_stdin\" | gt handoff check --hook; [ $? -ne 2 ] || exit 2) && echo \"$_stdin\" | bd bus emit --hook=Stop",
 						},
 					},
 				},
@@ -1406,7 +1406,7 @@ func TestClaudeSettingsCheck_TemplateDriftFixUpdatesFromTemplate(t *testing.T) {
 					"hooks": []any{
 						map[string]any{
 							"type":    "command",
-							"command": "export PATH=\"$HOME/.local/bin:$HOME/go/bin:$PATH\" && _stdin=$(cat) && echo \"$_stdin\" | bd bus emit --hook=Stop",
+							"command": "export PATH=\"$HOME/.local/bin:$HOME/go/bin:$PATH\" && _stdin=$(cat) && (echo \"$_stdin\" | gt handoff check --hook; [ $? -ne 2 ] || exit 2) && echo \"$_stdin\" | bd bus emit --hook=Stop",
 						},
 					},
 				},
@@ -0,0 +1,101 @@
+package dog
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// EventKind names what happened to a dog.
+type EventKind string
+
+const (
+	// EventAssigned means work was assigned to the dog.
+	EventAssigned EventKind = "assigned"
+	// EventRecalled means the dog was pulled off its work and set idle.
+	EventRecalled EventKind = "recalled"
+	// EventSessionStarted means the dog's session was started.
+	EventSessionStarted EventKind = "session_started"
+	// EventSessionStopped means the dog's session was stopped.
+	EventSessionStopped EventKind = "session_stopped"
+	// EventRefreshed means the dog's worktrees were recreated.
+	EventRefreshed EventKind = "refreshed"
+	// EventError records a failed kennel operation on the dog.
+	EventError EventKind = "error"
+)
+
+// Event is one entry in a dog's event log.
+type Event struct {
+	Time    time.Time `json:"time"`
+	Kind    EventKind `json:"kind"`
+	Work    string    `json:"work,omitempty"`    // Work involved, if any
+	Message string    `json:"message,omitempty"` // Reason or error text
+	By      string    `json:"by,omitempty"`      // Who asked (e.g., "deacon", "dashboard")
+}
+
+// eventsFilePath returns the path to a dog's event log.
+func (m *Manager) eventsFilePath(name string) string {
+	return filepath.Join(m.dogDir(name), ".dog-events.jsonl")
+}
+
+// RecordEvent appends an event to a dog's event log.
+func (m *Manager) RecordEvent(name string, ev Event) error {
+	if !m.exists(name) {
+		return ErrDogNotFound
+	}
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(m.eventsFilePath(name), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G302: dog events are non-sensitive operational data
+	if err != nil {
+		return fmt.Errorf("opening dog events: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("writing dog events: %w", err)
+	}
+	return nil
+}
+
+// Events returns the newest limit events for a dog, oldest first.
+// A limit of zero returns all events. Lines that don't parse are skipped.
+func (m *Manager) Events(name string, limit int) ([]Event, error) {
+	if !m.exists(name) {
+		return nil, ErrDogNotFound
+	}
+
+	f, err := os.Open(m.eventsFilePath(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening dog events: %w", err)
+	}
+	defer f.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var ev Event
+		if json.Unmarshal(scanner.Bytes(), &ev) == nil && ev.Kind != "" {
+			events = append(events, ev)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading dog events: %w", err)
+	}
+
+	if limit > 0 && len(events) > limit {
+		events = events[len(events)-limit:]
+	}
+	return events, nil
+}
@@ -0,0 +1,335 @@
+package dog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/terminal"
+)
+
+// Kennel errors
+var (
+	ErrDogBusy        = errors.New("dog is busy")
+	ErrNoIdleDog      = errors.New("no idle dogs available")
+	ErrInvalidDogName = errors.New("invalid dog name")
+	ErrWorkRequired   = errors.New("work is required")
+)
+
+// ValidateName checks that name is safe to use as a dog name, so callers
+// outside the kennel (e.g. RPC handlers) can reject it up front.
+func ValidateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: name cannot be empty", ErrInvalidDogName)
+	}
+	if name == "." || name == ".." || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("%w: %q is not allowed", ErrInvalidDogName, name)
+	}
+	if strings.ContainsAny(name, "/\\ ") {
+		return fmt.Errorf("%w: %q contains path separators or spaces", ErrInvalidDogName, name)
+	}
+	return nil
+}
+
+// Kennel is the Deacon-side handler for driving dogs: it assigns and
+// recalls work, keeping each dog's state file, worktrees and session in
+// step, and records what it did in the dog's event log.
+type Kennel struct {
+	dogs     *Manager
+	sessions *SessionManager
+}
+
+// NewKennel creates a kennel for a town. Without rigsConfig, adding dogs or
+// refreshing worktrees reports ErrNoRigs; backend may be nil for the default
+// coop backend.
+func NewKennel(townRoot string, rigsConfig *config.RigsConfig, backend terminal.Backend) *Kennel {
+	if rigsConfig == nil {
+		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	}
+	return &Kennel{
+		dogs:     NewManager(townRoot, rigsConfig),
+		sessions: NewSessionManager(backend, townRoot),
+	}
+}
+
+// Manager returns the kennel's dog manager.
+func (k *Kennel) Manager() *Manager {
+	return k.dogs
+}
+
+// Sessions returns the kennel's session manager.
+func (k *Kennel) Sessions() *SessionManager {
+	return k.sessions
+}
+
+// AssignOptions configures Kennel.Assign.
+type AssignOptions struct {
+	// Dog is the dog to assign; empty picks an idle dog.
+	Dog string
+
+	// Work is the bead ID or formula to work on. Required.
+	Work string
+
+	// Create adds the named dog if it doesn't exist, or a new dog if none
+	// is idle.
+	Create bool
+
+	// Force reassigns a dog that is already working on something else.
+	Force bool
+
+	// SkipSession records the assignment without starting the session.
+	SkipSession bool
+
+	// AgentOverride specifies an alternate agent for a new session.
+	AgentOverride string
+
+	// By identifies who asked, for the event log.
+	By string
+}
+
+// Assignment is the outcome of Kennel.Assign.
+type Assignment struct {
+	Dog      *Dog
+	Spawned  bool   // Dog was added to the kennel for this assignment
+	Replaced string // Work the dog was pulled off (with Force)
+	Session  string // Session ID; empty if not started
+
+	// SessionErr is set when the session could not be started. The
+	// assignment still stands; the session can be started later.
+	SessionErr error
+}
+
+// Assign gives work to a dog and makes sure its session is running.
+func (k *Kennel) Assign(opts AssignOptions) (*Assignment, error) {
+	if opts.Work == "" {
+		return nil, ErrWorkRequired
+	}
+
+	a := &Assignment{}
+	d, err := k.pick(opts, a)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.State == StateWorking && d.Work != "" && d.Work != opts.Work {
+		if !opts.Force {
+			return nil, fmt.Errorf("%w: %s is working on %s", ErrDogBusy, d.Name, d.Work)
+		}
+		a.Replaced = d.Work
+	}
+
+	if err := k.dogs.AssignWork(d.Name, opts.Work); err != nil {
+		return nil, fmt.Errorf("assigning work to %s: %w", d.Name, err)
+	}
+	msg := ""
+	if a.Replaced != "" {
+		msg = "replaced " + a.Replaced
+	}
+	_ = k.dogs.RecordEvent(d.Name, Event{Kind: EventAssigned, Work: opts.Work, Message: msg, By: opts.By})
+
+	if a.Dog, err = k.dogs.Get(d.Name); err != nil {
+		return nil, err
+	}
+
+	if opts.SkipSession {
+		return a, nil
+	}
+	wasRunning, _ := k.sessions.IsRunning(d.Name)
+	session, err := k.sessions.EnsureRunning(d.Name, SessionStartOptions{
+		WorkDesc:      opts.Work,
+		AgentOverride: opts.AgentOverride,
+	})
+	if err != nil {
+		a.SessionErr = err
+		_ = k.dogs.RecordEvent(d.Name, Event{Kind: EventError, Work: opts.Work, Message: "starting session: " + err.Error(), By: opts.By})
+		return a, nil
+	}
+	a.Session = session
+	if !wasRunning {
+		_ = k.dogs.RecordEvent(d.Name, Event{Kind: EventSessionStarted, Work: opts.Work, By: opts.By})
+	}
+	return a, nil
+}
+
+// pick returns the dog to assign, adding one if allowed.
+func (k *Kennel) pick(opts AssignOptions, a *Assignment) (*Dog, error) {
+	if opts.Dog != "" {
+		if err := ValidateName(opts.Dog); err != nil {
+			return nil, err
+		}
+		d, err := k.dogs.Get(opts.Dog)
+		if err == nil || !errors.Is(err, ErrDogNotFound) || !opts.Create {
+			return d, err
+		}
+		d, err = k.dogs.Add(opts.Dog)
+		if err != nil {
+			return nil, fmt.Errorf("creating dog %s: %w", opts.Dog, err)
+		}
+		a.Spawned = true
+		return d, nil
+	}
+
+	d, err := k.dogs.GetIdleDog()
+	if err != nil {
+		return nil, fmt.Errorf("finding idle dog: %w", err)
+	}
+	if d != nil {
+		return d, nil
+	}
+	if !opts.Create {
+		return nil, ErrNoIdleDog
+	}
+	name := k.dogs.NextName()
+	if d, err = k.dogs.Add(name); err != nil {
+		return nil, fmt.Errorf("creating dog %s: %w", name, err)
+	}
+	a.Spawned = true
+	return d, nil
+}
+
+// RecallOptions configures Kennel.Recall.
+type RecallOptions struct {
+	// Force kills the session without a graceful interrupt first.
+	Force bool
+
+	// KeepSession leaves the session running; only the assignment is
+	// cleared.
+	KeepSession bool
+
+	// Refresh recreates the dog's worktrees so the next assignment starts
+	// clean.
+	Refresh bool
+
+	// Reason is recorded in the event log.
+	Reason string
+
+	// By identifies who asked, for the event log.
+	By string
+}
+
+// Recalled is the outcome of Kennel.Recall.
+type Recalled struct {
+	Dog            *Dog
+	Work           string // Work the dog was pulled off, if any
+	SessionStopped bool
+	Refreshed      bool
+}
+
+// Recall pulls a dog off its work and returns it to idle, stopping its
+// session unless asked not to.
+func (k *Kennel) Recall(name string, opts RecallOptions) (*Recalled, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	d, err := k.dogs.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	r := &Recalled{Work: d.Work}
+
+	if !opts.KeepSession {
+		switch err := k.sessions.Stop(name, opts.Force); {
+		case err == nil:
+			r.SessionStopped = true
+			_ = k.dogs.RecordEvent(name, Event{Kind: EventSessionStopped, Work: d.Work, By: opts.By})
+		case !errors.Is(err, ErrSessionNotFound):
+			return nil, fmt.Errorf("stopping session for %s: %w", name, err)
+		}
+	}
+
+	if err := k.dogs.ClearWork(name); err != nil {
+		return nil, fmt.Errorf("clearing work for %s: %w", name, err)
+	}
+	_ = k.dogs.RecordEvent(name, Event{Kind: EventRecalled, Work: d.Work, Message: opts.Reason, By: opts.By})
+
+	if opts.Refresh {
+		if len(k.dogs.rigsConfig.Rigs) == 0 {
+			return nil, ErrNoRigs
+		}
+		if err := k.dogs.Refresh(name); err != nil {
+			_ = k.dogs.RecordEvent(name, Event{Kind: EventError, Message: "refreshing worktrees: " + err.Error(), By: opts.By})
+			return nil, fmt.Errorf("refreshing worktrees for %s: %w", name, err)
+		}
+		r.Refreshed = true
+		_ = k.dogs.RecordEvent(name, Event{Kind: EventRefreshed, By: opts.By})
+	}
+
+	if r.Dog, err = k.dogs.Get(name); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Status is a dog's state together with its session.
+type Status struct {
+	Dog     *Dog
+	Session *SessionInfo // Nil if the session could not be checked
+}
+
+// Status returns the status of one dog.
+func (k *Kennel) Status(name string) (*Status, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	d, err := k.dogs.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return k.status(d), nil
+}
+
+// StatusAll returns the status of every dog in the kennel, by name.
+func (k *Kennel) StatusAll() ([]*Status, error) {
+	dogs, err := k.dogs.List()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Status, 0, len(dogs))
+	for _, d := range dogs {
+		out = append(out, k.status(d))
+	}
+	return out, nil
+}
+
+func (k *Kennel) status(d *Dog) *Status {
+	info, err := k.sessions.Status(d.Name)
+	if err != nil {
+		info = nil
+	}
+	return &Status{Dog: d, Session: info}
+}
+
+// Logs is what a dog has been doing: its event log and recent session
+// output.
+type Logs struct {
+	Events  []Event
+	Output  string // Recent session output; empty if not running
+	Running bool
+}
+
+// Logs returns the newest events events and the last lines lines of
+// session output for a dog. A zero events limit returns all events.
+func (k *Kennel) Logs(name string, lines, events int) (*Logs, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	if _, err := k.dogs.Get(name); err != nil {
+		return nil, err
+	}
+	evs, err := k.dogs.Events(name, events)
+	if err != nil {
+		return nil, err
+	}
+	logs := &Logs{Events: evs}
+
+	output, err := k.sessions.Capture(name, lines)
+	switch {
+	case err == nil:
+		logs.Output = output
+		logs.Running = true
+	case !errors.Is(err, ErrSessionNotFound):
+		return nil, err
+	}
+	return logs, nil
+}
@@ -0,0 +1,197 @@
+package dog
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/terminal"
+)
+
+// fakeBackend is a terminal.Backend holding a fixed set of sessions.
+// Unimplemented methods panic via the nil embedded interface.
+type fakeBackend struct {
+	terminal.Backend
+
+	mu       sync.Mutex
+	sessions map[string]string // session -> captured output
+}
+
+func (b *fakeBackend) HasSession(session string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.sessions[session]
+	return ok, nil
+}
+
+func (b *fakeBackend) CapturePane(session string, _ int) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.sessions[session], nil
+}
+
+func (b *fakeBackend) SendKeys(string, string) error { return nil }
+
+func (b *fakeBackend) KillSession(session string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.sessions, session)
+	return nil
+}
+
+// testKennel creates a kennel with idle dog alpha and dog bravo working on
+// gt-old, whose session is running.
+func testKennel(t *testing.T) (*Kennel, *fakeBackend) {
+	t.Helper()
+	m, townRoot := testManager(t)
+	now := time.Now()
+	setupDogWithState(t, m, "alpha", &DogState{Name: "alpha", State: StateIdle, CreatedAt: now})
+	setupDogWithState(t, m, "bravo", &DogState{Name: "bravo", State: StateWorking, Work: "gt-old", CreatedAt: now})
+
+	backend := &fakeBackend{sessions: make(map[string]string)}
+	k := NewKennel(townRoot, m.rigsConfig, backend)
+	backend.sessions[k.Sessions().SessionName("bravo")] = "working on gt-old\n"
+	return k, backend
+}
+
+func TestKennel_AssignIdleDog(t *testing.T) {
+	k, _ := testKennel(t)
+
+	a, err := k.Assign(AssignOptions{Work: "gt-new", SkipSession: true, By: "deacon"})
+	if err != nil {
+		t.Fatalf("Assign() error = %v", err)
+	}
+	if a.Dog.Name != "alpha" || a.Dog.State != StateWorking || a.Dog.Work != "gt-new" {
+		t.Errorf("Assign() dog = %+v, want alpha working on gt-new", a.Dog)
+	}
+	if a.Spawned || a.Session != "" {
+		t.Errorf("Assign() = %+v, want no spawn and no session", a)
+	}
+
+	events, err := k.Manager().Events("alpha", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Kind != EventAssigned || events[0].Work != "gt-new" || events[0].By != "deacon" {
+		t.Errorf("events = %+v, want one assigned event", events)
+	}
+}
+
+func TestKennel_AssignBusyDog(t *testing.T) {
+	k, _ := testKennel(t)
+
+	_, err := k.Assign(AssignOptions{Dog: "bravo", Work: "gt-new", SkipSession: true})
+	if !errors.Is(err, ErrDogBusy) {
+		t.Fatalf("Assign() to busy dog error = %v, want ErrDogBusy", err)
+	}
+
+	a, err := k.Assign(AssignOptions{Dog: "bravo", Work: "gt-new", Force: true, SkipSession: true})
+	if err != nil {
+		t.Fatalf("Assign(Force) error = %v", err)
+	}
+	if a.Replaced != "gt-old" || a.Dog.Work != "gt-new" {
+		t.Errorf("Assign(Force) = %+v, want gt-old replaced by gt-new", a)
+	}
+}
+
+func TestKennel_AssignNoIdleDog(t *testing.T) {
+	k, _ := testKennel(t)
+	if _, err := k.Assign(AssignOptions{Work: "gt-1", SkipSession: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k.Assign(AssignOptions{Work: "gt-2", SkipSession: true}); !errors.Is(err, ErrNoIdleDog) {
+		t.Errorf("Assign() with no idle dogs error = %v, want ErrNoIdleDog", err)
+	}
+	if _, err := k.Assign(AssignOptions{SkipSession: true}); !errors.Is(err, ErrWorkRequired) {
+		t.Errorf("Assign() without work error = %v, want ErrWorkRequired", err)
+	}
+	if _, err := k.Assign(AssignOptions{Dog: "../x", Work: "gt-3"}); !errors.Is(err, ErrInvalidDogName) {
+		t.Errorf("Assign() with bad name error = %v, want ErrInvalidDogName", err)
+	}
+}
+
+func TestKennel_Recall(t *testing.T) {
+	k, backend := testKennel(t)
+
+	r, err := k.Recall("bravo", RecallOptions{Force: true, Reason: "stuck", By: "dashboard"})
+	if err != nil {
+		t.Fatalf("Recall() error = %v", err)
+	}
+	if r.Work != "gt-old" || !r.SessionStopped || r.Dog.State != StateIdle || r.Dog.Work != "" {
+		t.Errorf("Recall() = %+v, want gt-old recalled and session stopped", r)
+	}
+	if running, _ := backend.HasSession(k.Sessions().SessionName("bravo")); running {
+		t.Error("session still running after Recall()")
+	}
+
+	events, _ := k.Manager().Events("bravo", 0)
+	var kinds []EventKind
+	for _, ev := range events {
+		kinds = append(kinds, ev.Kind)
+	}
+	if len(kinds) != 2 || kinds[0] != EventSessionStopped || kinds[1] != EventRecalled {
+		t.Errorf("event kinds = %v, want [session_stopped recalled]", kinds)
+	}
+	if events[1].Message != "stuck" {
+		t.Errorf("recall reason = %q, want stuck", events[1].Message)
+	}
+
+	// Recalling an idle dog with no session is fine.
+	if r, err := k.Recall("alpha", RecallOptions{}); err != nil || r.SessionStopped {
+		t.Errorf("Recall(idle) = %+v, %v", r, err)
+	}
+	if _, err := k.Recall("zulu", RecallOptions{}); !errors.Is(err, ErrDogNotFound) {
+		t.Errorf("Recall(missing) error = %v, want ErrDogNotFound", err)
+	}
+}
+
+func TestKennel_StatusAndLogs(t *testing.T) {
+	k, _ := testKennel(t)
+
+	all, err := k.StatusAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("StatusAll() returned %d dogs, want 2", len(all))
+	}
+	for _, s := range all {
+		want := s.Dog.Name == "bravo"
+		if s.Session == nil || s.Session.Running != want {
+			t.Errorf("%s session = %+v, want running=%v", s.Dog.Name, s.Session, want)
+		}
+	}
+
+	logs, err := k.Logs("bravo", 50, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !logs.Running || logs.Output != "working on gt-old\n" {
+		t.Errorf("Logs(bravo) = %+v", logs)
+	}
+	logs, err = k.Logs("alpha", 50, 0)
+	if err != nil || logs.Running || logs.Output != "" {
+		t.Errorf("Logs(alpha) = %+v, %v", logs, err)
+	}
+}
+
+func TestManager_EventsLimit(t *testing.T) {
+	m, _ := testManager(t)
+	setupDogWithState(t, m, "alpha", &DogState{Name: "alpha", State: StateIdle})
+	for _, work := range []string{"gt-1", "gt-2", "gt-3"} {
+		if err := m.RecordEvent("alpha", Event{Kind: EventAssigned, Work: work}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	events, err := m.Events("alpha", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].Work != "gt-2" || events[1].Work != "gt-3" {
+		t.Errorf("Events(limit 2) = %+v, want gt-2, gt-3", events)
+	}
+	if err := m.RecordEvent("zulu", Event{Kind: EventAssigned}); !errors.Is(err, ErrDogNotFound) {
+		t.Errorf("RecordEvent(missing) error = %v, want ErrDogNotFound", err)
+	}
+}
@@ -567,3 +567,31 @@ func (m *Manager) WorkingCount() (int, error) {
 	}
 	return count, nil
 }
+
+// NextName returns an unused dog name for pool expansion: the first free
+// phonetic name, then dog1, dog2, ...
+func (m *Manager) NextName() string {
+	names := []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel"}
+
+	dogs, _ := m.List()
+	existing := make(map[string]bool)
+	for _, d := range dogs {
+		existing[d.Name] = true
+	}
+
+	for _, name := range names {
+		if !existing[name] {
+			return name
+		}
+	}
+
+	// Fallback: numbered dogs
+	for i := 1; i <= 100; i++ {
+		name := fmt.Sprintf("dog%d", i)
+		if !existing[name] {
+			return name
+		}
+	}
+
+	return fmt.Sprintf("dog%d", len(dogs)+1)
+}
@@ -247,6 +247,25 @@ func (m *SessionManager) GetPane(dogName string) (string, error) {
 	return sessionID, nil
 }
 
+// Capture returns the last lines of a dog session's output.
+func (m *SessionManager) Capture(dogName string, lines int) (string, error) {
+	sessionID := m.SessionName(dogName)
+
+	running, err := m.hasSession(sessionID)
+	if err != nil {
+		return "", fmt.Errorf("checking session: %w", err)
+	}
+	if !running {
+		return "", ErrSessionNotFound
+	}
+
+	output, err := m.backend.CapturePane(sessionID, lines)
+	if err != nil {
+		return "", fmt.Errorf("capturing session output: %w", err)
+	}
+	return output, nil
+}
+
 // EnsureRunning ensures a dog session is running, starting it if needed.
 // Returns the pane ID.
 func (m *SessionManager) EnsureRunning(dogName string, opts SessionStartOptions) (string, error) {
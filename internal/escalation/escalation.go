@@ -0,0 +1,232 @@
+// Package escalation implements the escalation lifecycle on top of
+// escalation beads (label gt:escalation): acknowledgement, assignment and
+// resolution, and per-severity SLA timers that re-escalate what nobody
+// picks up. The gt escalate CLI, the daemon and the EscalationService RPC
+// all go through a Service so transitions and notifications match.
+package escalation
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/mail"
+)
+
+// Escalation is an escalation bead with its parsed fields and SLA state.
+type Escalation struct {
+	ID                string    `json:"id"`
+	Title             string    `json:"title"`
+	State             string    `json:"state"` // open, acked, assigned, closed
+	Severity          string    `json:"severity"`
+	Reason            string    `json:"reason,omitempty"`
+	Source            string    `json:"source,omitempty"`
+	EscalatedBy       string    `json:"escalated_by,omitempty"`
+	AckedBy           string    `json:"acked_by,omitempty"`
+	AssignedTo        string    `json:"assigned_to,omitempty"`
+	ClosedBy          string    `json:"closed_by,omitempty"`
+	ClosedReason      string    `json:"closed_reason,omitempty"`
+	RelatedBead       string    `json:"related_bead,omitempty"`
+	ReescalationCount int       `json:"reescalation_count"`
+	CreatedAt         time.Time `json:"created_at"`
+	AckedAt           time.Time `json:"acked_at,omitempty"`
+	AssignedAt        time.Time `json:"assigned_at,omitempty"`
+
+	// SLADeadline is when an unacknowledged escalation breaches its SLA.
+	// It is zero once the escalation is acknowledged or closed.
+	SLADeadline time.Time `json:"sla_deadline,omitempty"`
+}
+
+// Breached reports whether the escalation is past its SLA deadline.
+func (e *Escalation) Breached(now time.Time) bool {
+	return !e.SLADeadline.IsZero() && now.After(e.SLADeadline)
+}
+
+// FromBead builds an Escalation from an escalation bead, computing its SLA
+// deadline from cfg.
+func FromBead(issue *beads.Issue, cfg *config.EscalationConfig) *Escalation {
+	fields := beads.ParseEscalationFields(issue.Description)
+	e := &Escalation{
+		ID:                issue.ID,
+		Title:             issue.Title,
+		State:             beads.EscalationState(issue, fields),
+		Severity:          fields.Severity,
+		Reason:            fields.Reason,
+		Source:            fields.Source,
+		EscalatedBy:       fields.EscalatedBy,
+		AckedBy:           fields.AckedBy,
+		AssignedTo:        fields.AssignedTo,
+		ClosedBy:          fields.ClosedBy,
+		ClosedReason:      fields.ClosedReason,
+		RelatedBead:       fields.RelatedBead,
+		ReescalationCount: fields.ReescalationCount,
+		CreatedAt:         parseTime(issue.CreatedAt),
+		AckedAt:           parseTime(fields.AckedAt),
+		AssignedAt:        parseTime(fields.AssignedAt),
+	}
+	if e.Severity == "" {
+		e.Severity = config.SeverityMedium
+	}
+	if e.EscalatedBy == "" {
+		e.EscalatedBy = issue.CreatedBy
+	}
+	if e.State == beads.EscalationOpen {
+		if start := fields.SLAStart(issue.CreatedAt); !start.IsZero() {
+			e.SLADeadline = start.Add(cfg.GetSLA(e.Severity))
+		}
+	}
+	return e
+}
+
+func parseTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return t
+}
+
+// Sort orders escalations most severe first, then oldest first, with
+// closed escalations last.
+func Sort(list []*Escalation) {
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if (a.State == beads.EscalationClosed) != (b.State == beads.EscalationClosed) {
+			return b.State == beads.EscalationClosed
+		}
+		if ra, rb := config.SeverityRank(a.Severity), config.SeverityRank(b.Severity); ra != rb {
+			return ra > rb
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+}
+
+// Service performs escalation transitions for a town.
+type Service struct {
+	townRoot string
+	bd       *beads.Beads
+	cfg      *config.EscalationConfig
+}
+
+// NewService loads the town's escalation config (settings/escalation.json,
+// or defaults) and returns a Service backed by the town beads.
+func NewService(townRoot string) (*Service, error) {
+	cfg, err := config.LoadOrCreateEscalationConfig(config.EscalationConfigPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading escalation config: %w", err)
+	}
+	return &Service{
+		townRoot: townRoot,
+		bd:       beads.New(beads.ResolveBeadsDir(townRoot)),
+		cfg:      cfg,
+	}, nil
+}
+
+// Config returns the escalation config the service uses.
+func (s *Service) Config() *config.EscalationConfig {
+	return s.cfg
+}
+
+// List returns escalations sorted by Sort. Closed escalations are included
+// only when includeClosed is set.
+func (s *Service) List(includeClosed bool) ([]*Escalation, error) {
+	var issues []*beads.Issue
+	if includeClosed {
+		out, err := s.bd.Run("list", "--label=gt:escalation", "--status=all", "--json")
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(out, &issues); err != nil {
+			return nil, fmt.Errorf("parsing escalations: %w", err)
+		}
+	} else {
+		var err error
+		if issues, err = s.bd.ListEscalations(); err != nil {
+			return nil, err
+		}
+	}
+
+	list := make([]*Escalation, 0, len(issues))
+	for _, issue := range issues {
+		list = append(list, FromBead(issue, s.cfg))
+	}
+	Sort(list)
+	return list, nil
+}
+
+// Get returns one escalation, or beads.ErrNotFound.
+func (s *Service) Get(id string) (*Escalation, error) {
+	issue, _, err := s.bd.GetEscalationBead(id)
+	if err != nil {
+		return nil, err
+	}
+	if issue == nil {
+		return nil, fmt.Errorf("escalation %s: %w", id, beads.ErrNotFound)
+	}
+	return FromBead(issue, s.cfg), nil
+}
+
+// Ack acknowledges an escalation, stopping its SLA timer.
+func (s *Service) Ack(id, by string) error {
+	if err := s.bd.AckEscalation(id, by); err != nil {
+		return err
+	}
+	_ = events.LogFeed(events.TypeEscalationAcked, by, map[string]interface{}{
+		"escalation_id": id,
+		"acked_by":      by,
+	})
+	return nil
+}
+
+// Assign makes assignee the owner of an escalation and mails them about it.
+func (s *Service) Assign(id, assignee, by string) error {
+	if err := s.bd.AssignEscalation(id, assignee, by); err != nil {
+		return err
+	}
+	_ = events.LogFeed(events.TypeEscalationAssigned, by, map[string]interface{}{
+		"escalation_id": id,
+		"assigned_to":   assignee,
+	})
+
+	if e, err := s.Get(id); err == nil && assignee != by {
+		msg := &mail.Message{
+			From:     by,
+			To:       assignee,
+			Subject:  fmt.Sprintf("[%s] Assigned to you: %s", strings.ToUpper(e.Severity), e.Title),
+			Body:     fmt.Sprintf("Escalation %s was assigned to you by %s.\n\nReason: %s\n\n---\nTo close: gt escalate close %s --reason \"resolution\"", id, by, e.Reason, id),
+			Type:     mail.TypeTask,
+			Priority: mailPriority(e.Severity),
+		}
+		_ = mail.NewRouter(s.townRoot).Send(msg)
+	}
+	return nil
+}
+
+// Resolve closes an escalation with a resolution reason.
+func (s *Service) Resolve(id, by, reason string) error {
+	if err := s.bd.CloseEscalation(id, by, reason); err != nil {
+		return err
+	}
+	_ = events.LogFeed(events.TypeEscalationClosed, by, map[string]interface{}{
+		"escalation_id": id,
+		"closed_by":     by,
+		"reason":        reason,
+	})
+	return nil
+}
+
+// mailPriority maps an escalation severity to a mail priority.
+func mailPriority(severity string) mail.Priority {
+	switch severity {
+	case config.SeverityCritical:
+		return mail.PriorityUrgent
+	case config.SeverityHigh:
+		return mail.PriorityHigh
+	case config.SeverityMedium:
+		return mail.PriorityNormal
+	default:
+		return mail.PriorityLow
+	}
+}
@@ -0,0 +1,76 @@
+package escalation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestFromBeadSLADeadline(t *testing.T) {
+	cfg := config.NewEscalationConfig()
+	created := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+	issue := &beads.Issue{
+		ID:        "hq-esc1",
+		Status:    "open",
+		CreatedAt: created.Format(time.RFC3339),
+		Description: beads.FormatEscalationDescription("Escalation", &beads.EscalationFields{
+			Severity:    config.SeverityCritical,
+			EscalatedBy: "gastown/witness",
+		}),
+	}
+
+	e := FromBead(issue, cfg)
+	if e.State != beads.EscalationOpen {
+		t.Fatalf("State = %q, want open", e.State)
+	}
+	if want := created.Add(15 * time.Minute); !e.SLADeadline.Equal(want) {
+		t.Errorf("SLADeadline = %v, want %v", e.SLADeadline, want)
+	}
+	if e.Breached(created.Add(10 * time.Minute)) {
+		t.Error("breached before the deadline")
+	}
+	if !e.Breached(created.Add(20 * time.Minute)) {
+		t.Error("not breached after the deadline")
+	}
+
+	// Acknowledging stops the timer.
+	issue.Labels = []string{"acked"}
+	if e := FromBead(issue, cfg); !e.SLADeadline.IsZero() || e.Breached(created.Add(time.Hour)) {
+		t.Errorf("acked escalation has deadline %v", e.SLADeadline)
+	}
+}
+
+func TestSort(t *testing.T) {
+	t0 := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	list := []*Escalation{
+		{ID: "low", State: beads.EscalationOpen, Severity: "low", CreatedAt: t0},
+		{ID: "closed-crit", State: beads.EscalationClosed, Severity: "critical", CreatedAt: t0},
+		{ID: "high-new", State: beads.EscalationOpen, Severity: "high", CreatedAt: t0.Add(time.Hour)},
+		{ID: "high-old", State: beads.EscalationAcked, Severity: "high", CreatedAt: t0},
+		{ID: "crit", State: beads.EscalationOpen, Severity: "critical", CreatedAt: t0},
+	}
+
+	Sort(list)
+
+	want := []string{"crit", "high-old", "high-new", "low", "closed-crit"}
+	for i, e := range list {
+		if e.ID != want[i] {
+			t.Fatalf("position %d = %s, want order %v", i, e.ID, want)
+		}
+	}
+}
+
+func TestBreached(t *testing.T) {
+	now := time.Now()
+	list := []*Escalation{
+		{ID: "late", SLADeadline: now.Add(-time.Minute)},
+		{ID: "ok", SLADeadline: now.Add(time.Minute)},
+		{ID: "acked"},
+	}
+	got := breached(list, now)
+	if len(got) != 1 || got[0].ID != "late" {
+		t.Errorf("breached = %v, want [late]", got)
+	}
+}
@@ -0,0 +1,252 @@
+package escalation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/notify"
+	"github.com/steveyegge/gastown/internal/slackbot"
+)
+
+// Breach is an escalation that went unacknowledged past its SLA, with what
+// was done about it.
+type Breach struct {
+	Escalation *Escalation               `json:"escalation"`
+	Result     *beads.ReescalationResult `json:"result"`
+	Notified   []string                  `json:"notified,omitempty"` // Mail targets and Slack channels
+	Errors     []string                  `json:"errors,omitempty"`
+}
+
+// Breaches returns the open escalations past their SLA deadline at now.
+func (s *Service) Breaches(now time.Time) ([]*Escalation, error) {
+	list, err := s.List(false)
+	if err != nil {
+		return nil, err
+	}
+	return breached(list, now), nil
+}
+
+func breached(list []*Escalation, now time.Time) []*Escalation {
+	var out []*Escalation
+	for _, e := range list {
+		if e.Breached(now) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// EnforceSLA re-escalates every breached escalation: its severity is bumped
+// (up to max_reescalations and critical), it is routed again at the new
+// severity, and the on_breach actions ping Slack and the overseer. An
+// escalation that can't be bumped further still pings, and its timer
+// restarts so it pings again if it stays unacknowledged.
+func (s *Service) EnforceSLA(actor string, now time.Time) ([]*Breach, error) {
+	list, err := s.Breaches(now)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*Breach
+	for _, e := range list {
+		result, err := s.bd.ReescalateEscalation(e.ID, actor, s.cfg.GetMaxReescalations())
+		if err != nil {
+			out = append(out, &Breach{Escalation: e, Errors: []string{err.Error()}})
+			continue
+		}
+		b := &Breach{Escalation: e, Result: result}
+		severity := result.NewSeverity
+		if result.Skipped {
+			severity = e.Severity
+			if err := s.bd.MarkEscalationBreached(e.ID); err != nil {
+				b.Errors = append(b.Errors, err.Error())
+			}
+		}
+		s.notifyBreach(b, severity, actor)
+
+		_ = events.LogFeed(events.TypeEscalationSent, actor, map[string]interface{}{
+			"escalation_id":    e.ID,
+			"reescalated":      !result.Skipped,
+			"sla_breached":     true,
+			"old_severity":     result.OldSeverity,
+			"new_severity":     severity,
+			"reescalation_num": result.ReescalationNum,
+			"targets":          strings.Join(b.Notified, ","),
+		})
+		out = append(out, b)
+	}
+	return out, nil
+}
+
+// notifyBreach mails the new severity's route targets and the on_breach
+// targets, and posts to Slack if either asks for it.
+func (s *Service) notifyBreach(b *Breach, severity, actor string) {
+	actions := append(append([]string(nil), s.cfg.GetRouteForSeverity(severity)...), s.cfg.GetBreachActions()...)
+
+	seen := map[string]bool{}
+	slack := false
+	router := mail.NewRouter(s.townRoot)
+	for _, action := range actions {
+		if action == "slack" {
+			slack = true
+			continue
+		}
+		target, ok := strings.CutPrefix(action, "mail:")
+		if !ok || target == "" || seen[target] {
+			continue
+		}
+		seen[target] = true
+		if target == "overseer" {
+			delivery := notify.NewDispatcher(s.townRoot).Notify(notify.Notification{
+				Event:    config.NotifyEventSLABreach,
+				Severity: severity,
+				From:     actor,
+				Title:    breachTitle(b),
+				Body:     breachMailBody(b, severity, s.cfg.GetSLA(b.Escalation.Severity), actor),
+				Bead:     b.Escalation.ID,
+			})
+			b.Errors = append(b.Errors, delivery.Errors...)
+			if len(delivery.Notified) > 0 || delivery.Digested {
+				b.Notified = append(b.Notified, target)
+			}
+			continue
+		}
+		msg := &mail.Message{
+			From:     actor,
+			To:       target,
+			Subject:  breachSubject(b, severity),
+			Body:     breachMailBody(b, severity, s.cfg.GetSLA(b.Escalation.Severity), actor),
+			Type:     mail.TypeTask,
+			Priority: mailPriority(severity),
+		}
+		if err := router.Send(msg); err != nil {
+			b.Errors = append(b.Errors, fmt.Sprintf("mail %s: %v", target, err))
+			continue
+		}
+		b.Notified = append(b.Notified, target)
+	}
+
+	if slack {
+		e := b.Escalation
+		route, err := PostSlack(s.townRoot, e.EscalatedBy, e.ID, severity, "SLA breached: "+e.Title)
+		switch {
+		case err != nil:
+			b.Errors = append(b.Errors, fmt.Sprintf("slack: %v", err))
+		case route.Channel != "" && !route.Quiet:
+			b.Notified = append(b.Notified, "slack:"+route.Channel)
+		}
+	}
+}
+
+func breachSubject(b *Breach, severity string) string {
+	if b.Result.Skipped {
+		return fmt.Sprintf("[%s] %s", strings.ToUpper(severity), breachTitle(b))
+	}
+	return fmt.Sprintf("[%s→%s] %s", strings.ToUpper(b.Result.OldSeverity), strings.ToUpper(severity), breachTitle(b))
+}
+
+func breachTitle(b *Breach) string {
+	if b.Result.Skipped {
+		return "SLA breached: " + b.Escalation.Title
+	}
+	return "Re-escalated: " + b.Escalation.Title
+}
+
+func breachMailBody(b *Breach, severity string, sla time.Duration, actor string) string {
+	e := b.Escalation
+	var lines []string
+	lines = append(lines, fmt.Sprintf("Escalation ID: %s", e.ID))
+	if b.Result.Skipped {
+		lines = append(lines, fmt.Sprintf("Severity: %s (%s)", severity, b.Result.SkipReason))
+	} else {
+		lines = append(lines, fmt.Sprintf("Severity bumped: %s → %s", b.Result.OldSeverity, severity))
+		lines = append(lines, fmt.Sprintf("Reescalation #%d", b.Result.ReescalationNum))
+	}
+	lines = append(lines, fmt.Sprintf("Reescalated by: %s", actor))
+	if e.Reason != "" {
+		lines = append(lines, fmt.Sprintf("Reason: %s", e.Reason))
+	}
+	lines = append(lines, "")
+	lines = append(lines, fmt.Sprintf("This escalation was not acknowledged within its %s SLA.", sla))
+	lines = append(lines, "")
+	lines = append(lines, "---")
+	lines = append(lines, "To acknowledge: gt escalate ack "+e.ID)
+	lines = append(lines, "To assign: gt escalate assign "+e.ID+" <address>")
+	lines = append(lines, "To close: gt escalate close "+e.ID+" --reason \"resolution\"")
+	return strings.Join(lines, "\n")
+}
+
+// PostSlack posts an escalation with the Slack bot to the channel
+// settings/slack.json routes the agent's rig and severity to. It returns an
+// empty route, without error, when the town has no bot routing configured.
+func PostSlack(townRoot, agentID, beadID, severity, description string) (slackbot.Route, error) {
+	router, err := slackbot.NewRouter(config.SlackConfigPath(townRoot))
+	if err != nil {
+		return slackbot.Route{}, err
+	}
+	token := router.Config().BotToken
+	if token == "" {
+		token = os.Getenv("SLACK_BOT_TOKEN")
+	}
+	if token == "" {
+		return slackbot.Route{}, nil
+	}
+
+	route := router.Route(slackbot.RigOf(agentID), severity, time.Now())
+	if route.Channel == "" || route.Quiet {
+		return route, nil
+	}
+	text := fmt.Sprintf("[%s] Escalation %s from %s: %s", strings.ToUpper(severity), beadID, agentID, description)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if _, err := slackbot.NewAPI(token).PostMessage(ctx, route.Channel, text, SlackBlocks(beadID, severity, description)); err != nil {
+		return slackbot.Route{}, err
+	}
+	return route, nil
+}
+
+// SlackBlocks renders an escalation as Slack Block Kit blocks.
+func SlackBlocks(beadID, severity, description string) []map[string]interface{} {
+	urgencyEmoji := map[string]string{
+		"critical": "🔴",
+		"high":     "🟠",
+		"medium":   "🟡",
+		"low":      "🟢",
+	}
+	emoji := urgencyEmoji[severity]
+	if emoji == "" {
+		emoji = "⚪"
+	}
+
+	return []map[string]interface{}{
+		{
+			"type": "header",
+			"text": map[string]string{
+				"type":  "plain_text",
+				"text":  fmt.Sprintf("%s Escalation: %s", emoji, beadID),
+				"emoji": "true",
+			},
+		},
+		{
+			"type": "section",
+			"fields": []map[string]string{
+				{"type": "mrkdwn", "text": fmt.Sprintf("*Severity:*\n%s %s", emoji, severity)},
+				{"type": "mrkdwn", "text": fmt.Sprintf("*Bead:*\n%s", beadID)},
+			},
+		},
+		{
+			"type": "section",
+			"text": map[string]string{
+				"type": "mrkdwn",
+				"text": fmt.Sprintf("*Description:*\n%s", description),
+			},
+		},
+	}
+}
@@ -1,6 +1,11 @@
 // Package eventbus provides an in-process pub/sub event bus for decision events.
 // This enables real-time notification of decision creation/resolution to subscribers
-// like the WatchDecisions RPC stream.
+// like the WatchDecisions RPC stream. The daemon also uses a bus to tell its
+// loops when operational settings change.
+//
+// It also provides the activity event Publisher, which writes typed events
+// (Sling, Done, Spawn, Kill) to pluggable sinks: the JSONL activity log, the
+// bd bus (NATS JetStream), and a gt RPC server's activity stream.
 package eventbus
 
 import (
@@ -14,6 +19,9 @@ const (
 	EventDecisionCreated  EventType = "decision_created"
 	EventDecisionResolved EventType = "decision_resolved"
 	EventDecisionCanceled EventType = "decision_canceled"
+
+	// EventSettingsChanged carries the changed knob keys ([]string) in Data.
+	EventSettingsChanged EventType = "settings_changed"
 )
 
 // Event represents a decision event in the bus.
@@ -148,6 +156,15 @@ func (b *Bus) PublishDecisionCanceled(decisionID string) {
 	})
 }
 
+// PublishSettingsChanged is a convenience method for publishing the keys of
+// operational settings whose values changed.
+func (b *Bus) PublishSettingsChanged(keys []string) {
+	b.Publish(Event{
+		Type: EventSettingsChanged,
+		Data: keys,
+	})
+}
+
 // Close shuts down the bus and closes all subscriber channels.
 func (b *Bus) Close() {
 	b.mu.Lock()
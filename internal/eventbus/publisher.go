@@ -0,0 +1,339 @@
+package eventbus
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/mtls"
+	"github.com/steveyegge/gastown/internal/rpcclient"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Activity is a typed activity event. Each implementation maps to one
+// events.Type* constant and builds that type's standard payload, so
+// emitters cannot drift from what the feed, curator and watchers parse.
+type Activity interface {
+	// EventType is the activity log type, e.g. events.TypeSling.
+	EventType() string
+	// Payload is the event's JSON payload.
+	Payload() map[string]interface{}
+}
+
+// Sling records work being slung onto an agent's hook.
+type Sling struct {
+	Bead    string
+	Target  string
+	Formula string // Set when a formula wisp was slung
+}
+
+func (Sling) EventType() string { return events.TypeSling }
+
+func (e Sling) Payload() map[string]interface{} {
+	p := events.SlingPayload(e.Bead, e.Target)
+	if e.Formula != "" {
+		p["formula"] = e.Formula
+	}
+	return p
+}
+
+// Done records an agent finishing its hooked work.
+type Done struct {
+	Bead   string
+	Branch string
+}
+
+func (Done) EventType() string { return events.TypeDone }
+
+func (e Done) Payload() map[string]interface{} {
+	return events.DonePayload(e.Bead, e.Branch)
+}
+
+// Spawn records an agent being started. Polecat spawns set Polecat; other
+// roles set Role and Agent, which the controller's watcher reads when the
+// actor address does not identify the agent.
+type Spawn struct {
+	Rig     string
+	Polecat string
+	Role    string
+	Agent   string
+}
+
+func (Spawn) EventType() string { return events.TypeSpawn }
+
+func (e Spawn) Payload() map[string]interface{} {
+	if e.Polecat != "" {
+		return events.SpawnPayload(e.Rig, e.Polecat)
+	}
+	return map[string]interface{}{
+		"rig":   e.Rig,
+		"role":  e.Role,
+		"agent": e.Agent,
+	}
+}
+
+// Kill records an agent being stopped intentionally.
+type Kill struct {
+	Rig    string
+	Target string
+	Reason string
+}
+
+func (Kill) EventType() string { return events.TypeKill }
+
+func (e Kill) Payload() map[string]interface{} {
+	return events.KillPayload(e.Rig, e.Target, e.Reason)
+}
+
+// AgentStuck records an agent whose terminal output has stopped changing
+// for longer than the stuck threshold.
+type AgentStuck struct {
+	Agent   string
+	Session string
+	Idle    time.Duration // How long the output has been unchanged
+	Status  string        // Status last inferred from the output, if any
+}
+
+func (AgentStuck) EventType() string { return events.TypeAgentStuck }
+
+func (e AgentStuck) Payload() map[string]interface{} {
+	p := map[string]interface{}{
+		"agent":        e.Agent,
+		"session":      e.Session,
+		"idle_seconds": int(e.Idle.Seconds()),
+	}
+	if e.Status != "" {
+		p["status"] = e.Status
+	}
+	return p
+}
+
+// UsageBudget records daily token spend nearing or passing a budget.
+type UsageBudget struct {
+	Scope    string // town, rig, or agent
+	Key      string // Rig or agent; empty for the town
+	SpentUSD float64
+	LimitUSD float64
+	Exceeded bool // False for the early warning
+}
+
+func (UsageBudget) EventType() string { return events.TypeUsageBudget }
+
+func (e UsageBudget) Payload() map[string]interface{} {
+	p := map[string]interface{}{
+		"scope":     e.Scope,
+		"spent_usd": e.SpentUSD,
+		"limit_usd": e.LimitUSD,
+		"exceeded":  e.Exceeded,
+	}
+	if e.Key != "" {
+		p["key"] = e.Key
+	}
+	return p
+}
+
+// HeadlessStart records a polecat starting a headless agent run.
+type HeadlessStart struct {
+	Agent  string // Polecat address
+	Bead   string // Issue being worked, if any
+	Preset string // Agent preset running the work
+}
+
+func (HeadlessStart) EventType() string { return events.TypeHeadlessStart }
+
+func (e HeadlessStart) Payload() map[string]interface{} {
+	return map[string]interface{}{
+		"agent":  e.Agent,
+		"bead":   e.Bead,
+		"preset": e.Preset,
+	}
+}
+
+// HeadlessDone records a headless agent run exiting.
+type HeadlessDone struct {
+	Agent      string
+	Bead       string
+	ExitCode   int
+	Duration   time.Duration
+	Transcript string // Path of the run's JSONL output
+}
+
+func (HeadlessDone) EventType() string { return events.TypeHeadlessDone }
+
+func (e HeadlessDone) Payload() map[string]interface{} {
+	return map[string]interface{}{
+		"agent":            e.Agent,
+		"bead":             e.Bead,
+		"exit_code":        e.ExitCode,
+		"success":          e.ExitCode == 0,
+		"duration_seconds": int(e.Duration.Seconds()),
+		"transcript":       e.Transcript,
+	}
+}
+
+// SettingsChanged records an operational knob being set or cleared.
+type SettingsChanged struct {
+	Key   string
+	Value string // Empty when the knob was unset
+	Scope string // Town, or town/rig
+}
+
+func (SettingsChanged) EventType() string { return events.TypeSettingsChanged }
+
+func (e SettingsChanged) Payload() map[string]interface{} {
+	p := map[string]interface{}{
+		"key":   e.Key,
+		"scope": e.Scope,
+	}
+	if e.Value != "" {
+		p["value"] = e.Value
+	}
+	return p
+}
+
+// Notification records a notification pushed to the overseer. Mobile
+// clients watching the feed for this type show it as a push notification.
+type Notification struct {
+	Event    string // Notification event type, e.g. "escalation"
+	Severity string
+	Title    string
+	Body     string
+	Bead     string // Related bead, if any
+	Digest   int    // Number of notifications summarized; 0 unless a digest
+}
+
+func (Notification) EventType() string { return events.TypeNotification }
+
+func (e Notification) Payload() map[string]interface{} {
+	p := map[string]interface{}{
+		"event":    e.Event,
+		"severity": e.Severity,
+		"title":    e.Title,
+	}
+	if e.Body != "" {
+		p["body"] = e.Body
+	}
+	if e.Bead != "" {
+		p["bead"] = e.Bead
+	}
+	if e.Digest > 0 {
+		p["digest"] = e.Digest
+	}
+	return p
+}
+
+// Sink is a destination for published activity events.
+type Sink interface {
+	// Name identifies the sink in errors.
+	Name() string
+	// Write delivers one event.
+	Write(event events.Event) error
+}
+
+// Publisher stamps activity events and fans them out to its sinks. Every
+// sink is tried even if an earlier one fails.
+type Publisher struct {
+	sinks []Sink
+	now   func() time.Time
+}
+
+// NewPublisher creates a publisher writing to the given sinks.
+func NewPublisher(sinks ...Sink) *Publisher {
+	return &Publisher{sinks: sinks, now: time.Now}
+}
+
+// Publish records a feed-visible activity event by actor.
+func (p *Publisher) Publish(actor string, a Activity) error {
+	return p.PublishWithVisibility(actor, a, events.VisibilityFeed)
+}
+
+// PublishWithVisibility records an activity event with an explicit
+// visibility (events.VisibilityAudit, VisibilityFeed or VisibilityBoth).
+func (p *Publisher) PublishWithVisibility(actor string, a Activity, visibility string) error {
+	event := events.Event{
+		Timestamp:  p.now().UTC().Format(time.RFC3339),
+		Source:     "gt",
+		Type:       a.EventType(),
+		Actor:      actor,
+		Payload:    a.Payload(),
+		Visibility: visibility,
+	}
+
+	var errs []error
+	for _, sink := range p.sinks {
+		if err := sink.Write(event); err != nil {
+			errs = append(errs, fmt.Errorf("%s sink: %w", sink.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Sinks returns the publisher's sinks.
+func (p *Publisher) Sinks() []Sink {
+	return p.sinks
+}
+
+var (
+	defaultOnce      sync.Once
+	defaultPublisher *Publisher
+)
+
+// Default returns the process-wide publisher, configured from the
+// environment on first use:
+//
+//   - GT_EVENT_SINKS: comma-separated sinks to enable (default "jsonl,nats").
+//     "jsonl" appends to the town's .events.jsonl, "nats" emits on the bd
+//     bus for JetStream subscribers, "rpc" sends to a gt RPC server.
+//   - GT_EVENTS_RPC_URL: base URL of the gt RPC server for the "rpc" sink,
+//     with GT_EVENTS_RPC_TOKEN as its API key.
+//
+// The jsonl sink is skipped outside a Gas Town workspace.
+func Default() *Publisher {
+	defaultOnce.Do(func() {
+		defaultPublisher = NewPublisher(sinksFromEnv()...)
+	})
+	return defaultPublisher
+}
+
+// Publish records a feed-visible activity event on the default publisher.
+// Publishing is best-effort, like the activity log it replaces: callers
+// normally ignore the error.
+func Publish(actor string, a Activity) error {
+	return Default().Publish(actor, a)
+}
+
+func sinksFromEnv() []Sink {
+	names := os.Getenv("GT_EVENT_SINKS")
+	if names == "" {
+		names = "jsonl,nats"
+	}
+
+	var sinks []Sink
+	for _, name := range strings.Split(names, ",") {
+		switch strings.TrimSpace(name) {
+		case "jsonl":
+			if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
+				sinks = append(sinks, NewFileSink(filepath.Join(townRoot, events.EventsFile)))
+			}
+		case "nats":
+			sinks = append(sinks, NewNATSSink())
+		case "rpc":
+			if url := os.Getenv("GT_EVENTS_RPC_URL"); url != "" {
+				var opts []rpcclient.Option
+				if token := os.Getenv("GT_EVENTS_RPC_TOKEN"); token != "" {
+					opts = append(opts, rpcclient.WithAPIKey(token))
+				}
+				if tlsCfg, err := mtls.ClientConfigFromEnv(); err == nil && tlsCfg != nil {
+					opts = append(opts, rpcclient.WithTLSConfig(tlsCfg))
+				}
+				sinks = append(sinks, NewRPCSink(rpcclient.NewClient(url, opts...)))
+			}
+		}
+	}
+	return sinks
+}
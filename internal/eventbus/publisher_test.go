@@ -0,0 +1,146 @@
+package eventbus
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/rpcclient"
+)
+
+type recordSink struct {
+	name   string
+	err    error
+	events []events.Event
+}
+
+func (s *recordSink) Name() string { return s.name }
+
+func (s *recordSink) Write(event events.Event) error {
+	s.events = append(s.events, event)
+	return s.err
+}
+
+func TestPublisher_FansOutToAllSinks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), events.EventsFile)
+	failing := &recordSink{name: "broken", err: errors.New("down")}
+	rec := &recordSink{name: "rec"}
+	p := NewPublisher(failing, NewFileSink(path), rec)
+	p.now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) }
+
+	err := p.Publish("mayor", Sling{Bead: "gt-abc", Target: "gastown/polecats/nux", Formula: "mol-review"})
+	if err == nil || !strings.Contains(err.Error(), "broken sink: down") {
+		t.Fatalf("Publish error = %v, want broken sink failure", err)
+	}
+	if len(rec.events) != 1 {
+		t.Fatalf("sink after failing one got %d events, want 1", len(rec.events))
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ev events.Event
+	if err := json.Unmarshal(data, &ev); err != nil {
+		t.Fatalf("jsonl line: %v", err)
+	}
+	if ev.Type != events.TypeSling || ev.Actor != "mayor" || ev.Visibility != events.VisibilityFeed {
+		t.Errorf("event = %+v", ev)
+	}
+	if ev.Timestamp != "2026-03-01T12:00:00Z" {
+		t.Errorf("timestamp = %q", ev.Timestamp)
+	}
+	if ev.Payload["bead"] != "gt-abc" || ev.Payload["target"] != "gastown/polecats/nux" || ev.Payload["formula"] != "mol-review" {
+		t.Errorf("payload = %v", ev.Payload)
+	}
+}
+
+func TestSpawn_Payload(t *testing.T) {
+	polecat := Spawn{Rig: "gastown", Polecat: "nux"}.Payload()
+	if polecat["rig"] != "gastown" || polecat["polecat"] != "nux" {
+		t.Errorf("polecat spawn payload = %v", polecat)
+	}
+	mayor := Spawn{Rig: "town", Role: "mayor", Agent: "hq"}.Payload()
+	if mayor["role"] != "mayor" || mayor["agent"] != "hq" {
+		t.Errorf("role spawn payload = %v", mayor)
+	}
+}
+
+func TestAgentStuck_Payload(t *testing.T) {
+	p := AgentStuck{Agent: "gastown/polecats/nux", Session: "gt-gastown-nux", Idle: 16 * time.Minute}.Payload()
+	if p["agent"] != "gastown/polecats/nux" || p["idle_seconds"] != 960 {
+		t.Errorf("payload = %v", p)
+	}
+	if _, ok := p["status"]; ok {
+		t.Errorf("status should be omitted when unknown: %v", p)
+	}
+}
+
+func TestNATSSink_MapsBusEvents(t *testing.T) {
+	type emitted struct {
+		name    string
+		payload []byte
+	}
+	var got []emitted
+	sink := &NATSSink{emit: func(name string, payload []byte) error {
+		got = append(got, emitted{name, payload})
+		return nil
+	}}
+	p := NewPublisher(sink)
+
+	_ = p.Publish("gastown/witness", Kill{Rig: "gastown", Target: "gastown/polecats/nux", Reason: "stuck"})
+	_ = p.Publish("gastown/polecats/nux", Done{Bead: "gt-abc", Branch: "polecat/nux"})
+	_ = p.Publish("daemon", AgentStuck{Agent: "gastown/polecats/nux", Session: "gt-gastown-nux"})
+	if err := sink.Write(events.Event{Type: events.TypeMail}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("emitted %d bus events, want 3 (mail is not bridged)", len(got))
+	}
+	if got[0].name != events.BusAgentKilled || got[1].name != events.BusWorkDone || got[2].name != events.BusAgentStuck {
+		t.Errorf("bus events = %s, %s, %s", got[0].name, got[1].name, got[2].name)
+	}
+	var ev events.Event
+	if err := json.Unmarshal(got[0].payload, &ev); err != nil {
+		t.Fatal(err)
+	}
+	if ev.Actor != "gastown/witness" || ev.Payload["reason"] != "stuck" {
+		t.Errorf("bus payload = %+v", ev)
+	}
+}
+
+func TestRPCSink(t *testing.T) {
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/gastown.v1.ActivityService/EmitEvent" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("X-GT-API-Key") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"timestamp":"2026-03-01T12:00:00Z","success":true}`))
+	}))
+	defer srv.Close()
+
+	sink := NewRPCSink(rpcclient.NewClient(srv.URL, rpcclient.WithAPIKey("secret")))
+	if err := NewPublisher(sink).Publish("gastown/crew/max", Done{Bead: "gt-abc"}); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if body["type"] != events.TypeDone || body["actor"] != "gastown/crew/max" {
+		t.Errorf("request body = %v", body)
+	}
+}
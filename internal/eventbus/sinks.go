@@ -0,0 +1,113 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/bdcmd"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/rpcclient"
+)
+
+// FileSink appends events to a JSONL activity log.
+type FileSink struct {
+	path string
+}
+
+// NewFileSink creates a sink appending to the JSONL file at path.
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+func (s *FileSink) Name() string { return "jsonl" }
+
+func (s *FileSink) Write(event events.Event) error {
+	return events.Append(s.path, event)
+}
+
+// busEventNames maps activity types to the bd bus event names published to
+// NATS JetStream. Types without an entry stay in the activity log only.
+var busEventNames = map[string]string{
+	events.TypeSling: events.BusWorkSlung,
+	events.TypeDone:  events.BusWorkDone,
+	events.TypeSpawn: events.BusAgentSpawned,
+	events.TypeKill:  events.BusAgentKilled,
+
+	events.TypeAgentStuck: events.BusAgentStuck,
+}
+
+// NATSSink emits events on the bd bus, which the beads daemon forwards to
+// NATS JetStream for subscribers such as the Slack bot.
+type NATSSink struct {
+	emit func(event string, payload []byte) error
+}
+
+// NewNATSSink creates a sink that emits through "bd bus emit".
+func NewNATSSink() *NATSSink {
+	return &NATSSink{emit: bdBusEmit}
+}
+
+func (s *NATSSink) Name() string { return "nats" }
+
+func (s *NATSSink) Write(event events.Event) error {
+	name, ok := busEventNames[event.Type]
+	if !ok {
+		return nil
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshaling event: %w", err)
+	}
+	return s.emit(name, payload)
+}
+
+// bdBusEmit runs "bd bus emit --event", falling back to --hook with the
+// payload on stdin for bd versions without --event.
+func bdBusEmit(event string, payload []byte) error {
+	cmd := bdcmd.Command("bus", "emit", "--event", event, "--payload", string(payload))
+	cmd.Stderr = io.Discard // suppress daemon-unavailable warnings
+	if err := cmd.Run(); err == nil {
+		return nil
+	}
+	fallback := bdcmd.Command("bus", "emit", "--hook", event)
+	fallback.Stdin = strings.NewReader(string(payload))
+	fallback.Stderr = io.Discard
+	if err := fallback.Run(); err != nil {
+		return fmt.Errorf("bd bus emit %s: %w", event, err)
+	}
+	return nil
+}
+
+// rpcSinkTimeout bounds each EmitEvent call so a slow server cannot stall
+// the command that published the event.
+const rpcSinkTimeout = 5 * time.Second
+
+// RPCSink sends events to a gt RPC server's ActivityService, which appends
+// them to the town log and streams them to WatchEvents clients. Agents
+// without the town filesystem, such as K8s pods, publish through it.
+type RPCSink struct {
+	client *rpcclient.Client
+}
+
+// NewRPCSink creates a sink emitting through client.
+func NewRPCSink(client *rpcclient.Client) *RPCSink {
+	return &RPCSink{client: client}
+}
+
+func (s *RPCSink) Name() string { return "rpc" }
+
+func (s *RPCSink) Write(event events.Event) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcSinkTimeout)
+	defer cancel()
+	_, err := s.client.EmitEvent(ctx, rpcclient.EmitEventRequest{
+		Type:       event.Type,
+		Actor:      event.Actor,
+		Payload:    event.Payload,
+		Visibility: event.Visibility,
+	})
+	return err
+}
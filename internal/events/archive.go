@@ -0,0 +1,427 @@
+package events
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ArchiveDir holds rotated segments of the events log, relative to the town
+// root. Each segment is named events-<first event time>.jsonl and is gzipped
+// once no writer can still be appending to it.
+const ArchiveDir = ".events-archive"
+
+// archiveIndexFile records the time range and size of each compressed
+// segment so queries can skip segments outside their range.
+const archiveIndexFile = "index.json"
+
+// rotateGrace is how long a freshly rotated segment is left uncompressed.
+// Writers open the log per event, so one that opened it just before the
+// rename finishes well within this window.
+const rotateGrace = 30 * time.Second
+
+// segmentStamp is the time layout used in segment names. It sorts
+// lexically in time order.
+const segmentStamp = "20060102T150405Z"
+
+// RotatePolicy controls when the live events log is rotated into the archive.
+type RotatePolicy struct {
+	MaxSize     int64         `json:"max_size"`     // Rotate at this many bytes (0 = no size limit)
+	MaxAge      time.Duration `json:"max_age"`      // Rotate once the oldest live event is this old (0 = no age limit)
+	MaxSegments int           `json:"max_segments"` // Archived segments to keep (0 = keep all)
+}
+
+// DefaultRotatePolicy returns the default rotation policy: rotate daily or at
+// 32 MiB, keeping 90 segments.
+func DefaultRotatePolicy() RotatePolicy {
+	return RotatePolicy{
+		MaxSize:     32 << 20,
+		MaxAge:      24 * time.Hour,
+		MaxSegments: 90,
+	}
+}
+
+// Segment describes an archived segment of the events log.
+type Segment struct {
+	Name  string    `json:"name"`
+	First time.Time `json:"first"`
+	Last  time.Time `json:"last"`
+	Count int       `json:"count"`
+	Bytes int64     `json:"bytes"`
+}
+
+// id returns the segment name without its extensions, which stays the same
+// when the segment is compressed.
+func (s Segment) id() string {
+	return segmentID(s.Name)
+}
+
+func segmentID(name string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(name, ".gz"), ".jsonl")
+}
+
+// RotateResult reports what a Rotate call did.
+type RotateResult struct {
+	Rotated    string    `json:"rotated,omitempty"` // Segment created from the live log
+	Compressed []Segment `json:"compressed,omitempty"`
+	Removed    []string  `json:"removed,omitempty"`
+}
+
+// Rotate moves the town's live events log into the archive when policy says
+// it is due, gzips segments past the grace period, and deletes segments
+// beyond the retention limit.
+func Rotate(townRoot string, policy RotatePolicy, now time.Time) (*RotateResult, error) {
+	livePath := filepath.Join(townRoot, EventsFile)
+	dir := filepath.Join(townRoot, ArchiveDir)
+	result := &RotateResult{}
+
+	name, err := rotateLive(livePath, dir, policy, now)
+	if err != nil {
+		return nil, err
+	}
+	result.Rotated = name
+
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return result, nil
+	}
+
+	index, err := loadArchiveIndex(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := filepath.Glob(filepath.Join(dir, "events-*.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range pending {
+		info, err := os.Stat(path)
+		if err != nil || now.Sub(info.ModTime()) < rotateGrace {
+			continue
+		}
+		seg, err := compressSegment(path)
+		if err != nil {
+			return nil, err
+		}
+		index[seg.id()] = seg
+		result.Compressed = append(result.Compressed, seg)
+	}
+
+	if policy.MaxSegments > 0 {
+		segs, err := listSegments(dir, index)
+		if err != nil {
+			return nil, err
+		}
+		// segs is newest first.
+		for _, seg := range segs[min(policy.MaxSegments, len(segs)):] {
+			if err := os.Remove(filepath.Join(dir, seg.Name)); err != nil && !os.IsNotExist(err) {
+				return nil, fmt.Errorf("removing segment: %w", err)
+			}
+			delete(index, seg.id())
+			result.Removed = append(result.Removed, seg.Name)
+		}
+	}
+
+	if len(result.Compressed) > 0 || len(result.Removed) > 0 {
+		if err := saveArchiveIndex(dir, index); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// rotateLive renames the live log into dir if it is due, returning the new
+// segment's name.
+func rotateLive(livePath, dir string, policy RotatePolicy, now time.Time) (string, error) {
+	info, err := os.Stat(livePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("stat events file: %w", err)
+	}
+	if info.Size() == 0 {
+		return "", nil
+	}
+
+	first, err := firstEventTime(livePath)
+	if err != nil {
+		return "", err
+	}
+	bySize := policy.MaxSize > 0 && info.Size() >= policy.MaxSize
+	byAge := policy.MaxAge > 0 && !first.IsZero() && now.Sub(first) >= policy.MaxAge
+	if !bySize && !byAge {
+		return "", nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating archive dir: %w", err)
+	}
+	if first.IsZero() {
+		first = info.ModTime()
+	}
+	base := "events-" + first.UTC().Format(segmentStamp)
+	name := base + ".jsonl"
+	for n := 1; segmentExists(dir, segmentID(name)); n++ {
+		name = fmt.Sprintf("%s-%d.jsonl", base, n)
+	}
+
+	// Hold the write lock so in-process writers see either the old file or
+	// a fresh one, never a half-renamed state.
+	mutex.Lock()
+	err = os.Rename(livePath, filepath.Join(dir, name))
+	mutex.Unlock()
+	if err != nil {
+		return "", fmt.Errorf("rotating events file: %w", err)
+	}
+	// Start the grace period now rather than at the last write.
+	_ = os.Chtimes(filepath.Join(dir, name), now, now)
+	return name, nil
+}
+
+func segmentExists(dir, id string) bool {
+	for _, ext := range []string{".jsonl", ".jsonl.gz"} {
+		if _, err := os.Stat(filepath.Join(dir, id+ext)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// firstEventTime returns the timestamp of the first parseable event in path.
+func firstEventTime(path string) (time.Time, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("opening events file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var ev Event
+		if json.Unmarshal(scanner.Bytes(), &ev) != nil {
+			continue
+		}
+		if ts, err := time.Parse(time.RFC3339, ev.Timestamp); err == nil {
+			return ts, nil
+		}
+	}
+	return time.Time{}, scanner.Err()
+}
+
+// compressSegment gzips an uncompressed segment in place and returns its
+// index entry.
+func compressSegment(path string) (Segment, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return Segment{}, fmt.Errorf("opening segment: %w", err)
+	}
+	defer src.Close()
+
+	gzPath := path + ".gz"
+	tmpPath := gzPath + ".tmp"
+	dst, err := os.Create(tmpPath)
+	if err != nil {
+		return Segment{}, fmt.Errorf("creating compressed segment: %w", err)
+	}
+	defer os.Remove(tmpPath) // no-op after the rename below
+
+	seg := Segment{Name: filepath.Base(gzPath)}
+	zw := gzip.NewWriter(dst)
+	scanner := bufio.NewScanner(src)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+		_, err := zw.Write(line)
+		if err == nil {
+			_, err = zw.Write([]byte{'\n'})
+		}
+		if err != nil {
+			dst.Close()
+			return Segment{}, fmt.Errorf("compressing segment: %w", err)
+		}
+		seg.Count++
+		var ev Event
+		if json.Unmarshal(line, &ev) != nil {
+			continue
+		}
+		if ts, err := time.Parse(time.RFC3339, ev.Timestamp); err == nil {
+			if seg.First.IsZero() || ts.Before(seg.First) {
+				seg.First = ts
+			}
+			if ts.After(seg.Last) {
+				seg.Last = ts
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		dst.Close()
+		return Segment{}, fmt.Errorf("reading segment: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		dst.Close()
+		return Segment{}, fmt.Errorf("compressing segment: %w", err)
+	}
+	info, err := dst.Stat()
+	if err == nil {
+		seg.Bytes = info.Size()
+	}
+	if err := dst.Close(); err != nil {
+		return Segment{}, fmt.Errorf("writing compressed segment: %w", err)
+	}
+	if err := os.Rename(tmpPath, gzPath); err != nil {
+		return Segment{}, fmt.Errorf("renaming compressed segment: %w", err)
+	}
+	src.Close()
+	if err := os.Remove(path); err != nil {
+		return Segment{}, fmt.Errorf("removing uncompressed segment: %w", err)
+	}
+	return seg, nil
+}
+
+// loadArchiveIndex reads the segment index, keyed by segment id. A missing
+// or corrupt index is treated as empty; it only speeds up queries.
+func loadArchiveIndex(dir string) (map[string]Segment, error) {
+	index := map[string]Segment{}
+	data, err := os.ReadFile(filepath.Join(dir, archiveIndexFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return index, nil
+		}
+		return nil, fmt.Errorf("reading archive index: %w", err)
+	}
+	var segs []Segment
+	if json.Unmarshal(data, &segs) != nil {
+		return index, nil
+	}
+	for _, seg := range segs {
+		index[seg.id()] = seg
+	}
+	return index, nil
+}
+
+func saveArchiveIndex(dir string, index map[string]Segment) error {
+	segs := make([]Segment, 0, len(index))
+	for _, seg := range index {
+		segs = append(segs, seg)
+	}
+	sort.Slice(segs, func(i, j int) bool { return segs[i].Name < segs[j].Name })
+	data, err := json.MarshalIndent(segs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling archive index: %w", err)
+	}
+	tmp := filepath.Join(dir, archiveIndexFile+".tmp")
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("writing archive index: %w", err)
+	}
+	return os.Rename(tmp, filepath.Join(dir, archiveIndexFile))
+}
+
+// listSegments returns the archived segments in dir, newest first. Time
+// ranges come from index; segments missing from it (not yet compressed)
+// have zero First and Last.
+func listSegments(dir string, index map[string]Segment) ([]Segment, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading archive dir: %w", err)
+	}
+
+	byID := map[string]Segment{}
+	for _, e := range entries {
+		name := e.Name()
+		if !strings.HasPrefix(name, "events-") || !(strings.HasSuffix(name, ".jsonl") || strings.HasSuffix(name, ".jsonl.gz")) {
+			continue
+		}
+		id := segmentID(name)
+		if prev, ok := byID[id]; ok && strings.HasSuffix(prev.Name, ".gz") {
+			continue // mid-compression: prefer the finished .gz
+		}
+		seg := Segment{Name: name}
+		if known, ok := index[id]; ok && known.Name == name {
+			seg = known
+		}
+		byID[id] = seg
+	}
+
+	segs := make([]Segment, 0, len(byID))
+	for _, seg := range byID {
+		segs = append(segs, seg)
+	}
+	sort.Slice(segs, func(i, j int) bool { return segs[i].id() > segs[j].id() })
+	return segs, nil
+}
+
+// overlaps reports whether seg may hold events in q's time range.
+func (s Segment) overlaps(q Query) bool {
+	if s.First.IsZero() {
+		return true // unknown range
+	}
+	if !q.Since.IsZero() && s.Last.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && s.First.After(q.Until) {
+		return false
+	}
+	return true
+}
+
+// scanSegment returns the events in a segment matching q whose line number
+// is below end (or all lines if end < 0), oldest first, with their line
+// numbers.
+func scanSegment(path string, q Query, end int) ([]Event, []int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening segment: %w", err)
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, nil, fmt.Errorf("opening compressed segment: %w", err)
+		}
+		defer zr.Close()
+		r = zr
+	}
+
+	var evs []Event
+	var lines []int
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	// Line numbers skip blank lines so they survive compression, which
+	// drops them.
+	n := -1
+	for scanner.Scan() {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		n++
+		if end >= 0 && n >= end {
+			break
+		}
+		var ev Event
+		if json.Unmarshal(scanner.Bytes(), &ev) != nil {
+			continue
+		}
+		if e := entryFor(ev); e.matches(q) {
+			evs = append(evs, ev)
+			lines = append(lines, n)
+		}
+	}
+	return evs, lines, scanner.Err()
+}
@@ -0,0 +1,138 @@
+package events
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRotate(t *testing.T) {
+	town := t.TempDir()
+	live := filepath.Join(town, EventsFile)
+	t0 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(m int) string { return t0.Add(time.Duration(m) * time.Minute).Format(time.RFC3339) }
+	policy := RotatePolicy{MaxAge: 24 * time.Hour, MaxSegments: 2}
+
+	appendEvents(t, live,
+		Event{Timestamp: at(0), Type: TypeSling, Actor: "mayor"},
+		Event{Timestamp: at(1), Type: TypeHook, Actor: "gastown/polecats/nux"},
+	)
+
+	// Not due yet.
+	res, err := Rotate(town, policy, t0.Add(time.Hour))
+	if err != nil || res.Rotated != "" {
+		t.Fatalf("early rotate = %+v, %v", res, err)
+	}
+
+	now := t0.Add(25 * time.Hour)
+	res, err = Rotate(town, policy, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Rotated != "events-20260301T120000Z.jsonl" || len(res.Compressed) != 0 {
+		t.Fatalf("rotate = %+v", res)
+	}
+	if _, err := os.Stat(live); !os.IsNotExist(err) {
+		t.Errorf("live log still present after rotation: %v", err)
+	}
+
+	// Compressed once the grace period has passed.
+	res, err = Rotate(town, policy, now.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Compressed) != 1 {
+		t.Fatalf("compressed = %+v", res.Compressed)
+	}
+	seg := res.Compressed[0]
+	if seg.Name != "events-20260301T120000Z.jsonl.gz" || seg.Count != 2 || !seg.First.Equal(t0) || !seg.Last.Equal(t0.Add(time.Minute)) {
+		t.Errorf("segment = %+v", seg)
+	}
+	index, err := loadArchiveIndex(filepath.Join(town, ArchiveDir))
+	if err != nil || len(index) != 1 {
+		t.Errorf("archive index = %+v, %v", index, err)
+	}
+
+	// Size-based rotation; retention keeps the newest two segments.
+	for day := 2; day <= 3; day++ {
+		ts := time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC).Format(time.RFC3339)
+		appendEvents(t, live, Event{Timestamp: ts, Type: TypeDone, Actor: "mayor"})
+		if _, err := Rotate(town, RotatePolicy{MaxSize: 1}, now.Add(time.Duration(day)*time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	res, err = Rotate(town, RotatePolicy{MaxSegments: 2}, now.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Removed) != 1 || res.Removed[0] != "events-20260301T120000Z.jsonl.gz" {
+		t.Errorf("removed = %v", res.Removed)
+	}
+	segs, _ := listSegments(filepath.Join(town, ArchiveDir), nil)
+	if len(segs) != 2 {
+		t.Errorf("segments after retention = %+v", segs)
+	}
+}
+
+func TestIndexQuery_PagesIntoArchive(t *testing.T) {
+	town := t.TempDir()
+	live := filepath.Join(town, EventsFile)
+	t0 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(m int) string { return t0.Add(time.Duration(m) * time.Minute).Format(time.RFC3339) }
+
+	for m := 0; m < 3; m++ {
+		appendEvents(t, live, Event{Timestamp: at(m), Type: TypeMail, Actor: "mayor"})
+	}
+	ix := NewIndex(live)
+	if page, _ := ix.Query(Query{}); page.Total != 3 {
+		t.Fatalf("before rotation: total = %d", page.Total)
+	}
+
+	if _, err := Rotate(town, RotatePolicy{MaxSize: 1}, t0.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Rotate(town, RotatePolicy{}, t0.Add(2*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	for m := 10; m < 12; m++ {
+		appendEvents(t, live, Event{Timestamp: at(m), Type: TypeMail, Actor: "mayor"})
+	}
+
+	var seen []string
+	q := Query{Limit: 2}
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("pagination did not terminate")
+		}
+		page, err := ix.Query(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.Total != 2 {
+			t.Errorf("total = %d, want 2 live events", page.Total)
+		}
+		for _, ev := range page.Events {
+			seen = append(seen, ev.Timestamp)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		q.Cursor = page.NextCursor
+	}
+	want := []string{at(11), at(10), at(2), at(1), at(0)}
+	if len(seen) != len(want) {
+		t.Fatalf("seen = %v, want %v", seen, want)
+	}
+	for i := range want {
+		if seen[i] != want[i] {
+			t.Fatalf("seen = %v, want %v", seen, want)
+		}
+	}
+
+	// A time range inside the live log does not point into the archive.
+	page, _ := ix.Query(Query{Since: t0.Add(10 * time.Minute)})
+	if len(page.Events) != 2 || page.NextCursor != "" {
+		t.Errorf("recent query = %+v", page)
+	}
+}
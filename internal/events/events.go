@@ -37,6 +37,7 @@ const (
 	TypeSling   = "sling"
 	TypeHook    = "hook"
 	TypeUnhook  = "unhook"
+	TypeResling = "resling"
 	TypeHandoff = "handoff"
 	TypeDone    = "done"
 	TypeMail    = "mail"
@@ -46,6 +47,9 @@ const (
 	TypeBoot    = "boot"
 	TypeHalt    = "halt"
 
+	// Scheduled dispatch (gt sling --at/--after)
+	TypeSlingScheduled = "sling_scheduled"
+
 	// Session events (for seance discovery)
 	TypeSessionStart = "session_start"
 	TypeSessionEnd   = "session_end"
@@ -54,12 +58,30 @@ const (
 	TypeSessionDeath = "session_death" // Feed-visible session termination
 	TypeMassDeath    = "mass_death"    // Multiple sessions died in short window
 
+	// Terminal-output stuck detection (daemon session monitor)
+	TypeAgentStuck = "agent_stuck"
+
+	// Daily usage budget warnings and overruns (daemon usage collector)
+	TypeUsageBudget = "usage_budget"
+
+	// Headless polecat runs (agent run without a terminal session)
+	TypeHeadlessStart = "headless_start"
+	TypeHeadlessDone  = "headless_done"
+
+	// Operational knob changes (gt config settings set/unset)
+	TypeSettingsChanged = "settings_changed"
+
+	// Overseer notifications sent on the push channel (notify dispatcher);
+	// mobile clients receive them by watching the event feed
+	TypeNotification = "notification"
+
 	// Witness patrol events
 	TypePatrolStarted   = "patrol_started"
 	TypePolecatChecked  = "polecat_checked"
 	TypePolecatNudged   = "polecat_nudged"
 	TypeEscalationSent   = "escalation_sent"
 	TypeEscalationAcked  = "escalation_acked"
+	TypeEscalationAssigned = "escalation_assigned"
 	TypeEscalationClosed = "escalation_closed"
 	TypePatrolComplete   = "patrol_complete"
 
@@ -86,8 +108,30 @@ const (
 	BusMailSent = "MailSent"
 	BusMailRead = "MailRead"
 
+	// Activity bus event types, emitted by the eventbus NATS sink alongside
+	// the activity log entry so JetStream subscribers see work and agent
+	// lifecycle changes in real time.
+	BusWorkSlung    = "WorkSlung"
+	BusWorkDone     = "WorkDone"
+	BusAgentSpawned = "AgentSpawned"
+	BusAgentKilled  = "AgentKilled"
+	BusAgentStuck   = "AgentStuck"
+
+	// Mail receipt events, recorded only for messages sent with a receipt
+	// request so senders can tell their message was delivered and seen.
+	TypeMailDelivered = "mail_delivered"
+	TypeMailRead      = "mail_read"
+
 	// Hook error events
 	TypeHookError = "hook_error"
+
+	// Advice hook runs (gt advice run, gt done)
+	TypeHookRun = "hook_run"
+
+	// Issue changes made through the RPC BeadsService
+	TypeIssueCreated = "issue_created"
+	TypeIssueUpdated = "issue_updated"
+	TypeIssueClosed  = "issue_closed"
 )
 
 // EventsFile is the name of the raw events log.
@@ -100,7 +144,18 @@ var mutex sync.Mutex
 // The event is appended to ~/gt/.events.jsonl.
 // Returns nil if logging fails (events are best-effort).
 func Log(eventType, actor string, payload map[string]interface{}, visibility string) error {
-	event := Event{
+	return write(newEvent(eventType, actor, payload, visibility))
+}
+
+// LogTo writes an event to the events log of the town at townRoot, for
+// long-running processes (like the RPC server) whose working directory
+// may be outside the town.
+func LogTo(townRoot, eventType, actor string, payload map[string]interface{}, visibility string) error {
+	return Append(filepath.Join(townRoot, EventsFile), newEvent(eventType, actor, payload, visibility))
+}
+
+func newEvent(eventType, actor string, payload map[string]interface{}, visibility string) Event {
+	return Event{
 		Timestamp:  time.Now().UTC().Format(time.RFC3339),
 		Source:     "gt",
 		Type:       eventType,
@@ -108,7 +163,6 @@ func Log(eventType, actor string, payload map[string]interface{}, visibility str
 		Payload:    payload,
 		Visibility: visibility,
 	}
-	return write(event)
 }
 
 // LogFeed is a convenience wrapper for feed-visible events.
@@ -130,8 +184,11 @@ func write(event Event) error {
 		return nil
 	}
 
-	eventsPath := filepath.Join(townRoot, EventsFile)
+	return Append(filepath.Join(townRoot, EventsFile), event)
+}
 
+// Append writes one event as a JSON line to the events file at path.
+func Append(path string, event Event) error {
 	// Marshal event to JSON
 	data, err := json.Marshal(event)
 	if err != nil {
@@ -143,7 +200,7 @@ func write(event Event) error {
 	mutex.Lock()
 	defer mutex.Unlock()
 
-	f, err := os.OpenFile(eventsPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G302: events file is non-sensitive operational data
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G302: events file is non-sensitive operational data
 	if err != nil {
 		return fmt.Errorf("opening events file: %w", err)
 	}
@@ -200,6 +257,20 @@ func MailPayload(to, subject string) map[string]interface{} {
 	}
 }
 
+// MailReceiptPayload creates a payload for mail delivery and read events.
+// messageID may be empty for deliveries, before beads has assigned one.
+func MailReceiptPayload(messageID, from, to, subject string) map[string]interface{} {
+	p := map[string]interface{}{
+		"from":    from,
+		"to":      to,
+		"subject": subject,
+	}
+	if messageID != "" {
+		p["message"] = messageID
+	}
+	return p
+}
+
 // SpawnPayload creates a payload for spawn events.
 func SpawnPayload(rig, polecat string) map[string]interface{} {
 	return map[string]interface{}{
@@ -284,6 +355,24 @@ func UnhookPayload(beadID string) map[string]interface{} {
 	}
 }
 
+// ReslingPayload creates a payload for resling events, which record work
+// moved from one agent to another. killed is set when the old session was
+// stopped as part of the reassignment.
+func ReslingPayload(beadID, from, to, reason string, killed bool) map[string]interface{} {
+	p := map[string]interface{}{
+		"bead":   beadID,
+		"from":   from,
+		"target": to,
+	}
+	if reason != "" {
+		p["reason"] = reason
+	}
+	if killed {
+		p["killed"] = true
+	}
+	return p
+}
+
 // KillPayload creates a payload for kill events.
 func KillPayload(rig, target, reason string) map[string]interface{} {
 	return map[string]interface{}{
@@ -293,6 +382,36 @@ func KillPayload(rig, target, reason string) map[string]interface{} {
 	}
 }
 
+// IssueCreatedPayload creates a payload for issue_created events.
+func IssueCreatedPayload(beadID, title, issueType string, priority int) map[string]interface{} {
+	return map[string]interface{}{
+		"bead":     beadID,
+		"title":    title,
+		"type":     issueType,
+		"priority": priority,
+	}
+}
+
+// IssueUpdatedPayload creates a payload for issue_updated events. fields
+// names what changed ("status", "labels", ...).
+func IssueUpdatedPayload(beadID string, fields []string) map[string]interface{} {
+	return map[string]interface{}{
+		"bead":   beadID,
+		"fields": fields,
+	}
+}
+
+// IssueClosedPayload creates a payload for issue_closed events.
+func IssueClosedPayload(beadID, reason string) map[string]interface{} {
+	p := map[string]interface{}{
+		"bead": beadID,
+	}
+	if reason != "" {
+		p["reason"] = reason
+	}
+	return p
+}
+
 // HaltPayload creates a payload for halt events.
 func HaltPayload(services []string) map[string]interface{} {
 	return map[string]interface{}{
@@ -373,3 +492,24 @@ func HookErrorPayload(hookType, command string, exitCode int, stderr, role strin
 	}
 	return p
 }
+
+// HookRunPayload creates a payload for advice hook run events.
+// logPath is the file holding the hook's full output, if one was written.
+func HookRunPayload(hookID, trigger string, exitCode int, duration time.Duration, success, timedOut bool, logPath string) map[string]interface{} {
+	p := map[string]interface{}{
+		"hook_id":     hookID,
+		"exit_code":   exitCode,
+		"duration_ms": duration.Milliseconds(),
+		"success":     success,
+	}
+	if trigger != "" {
+		p["trigger"] = trigger
+	}
+	if timedOut {
+		p["timed_out"] = true
+	}
+	if logPath != "" {
+		p["log"] = logPath
+	}
+	return p
+}
@@ -1,6 +1,9 @@
 package events
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -211,6 +214,58 @@ func TestNudgePayload(t *testing.T) {
 	}
 }
 
+func TestReslingPayload(t *testing.T) {
+	p := ReslingPayload("gt-abc", "gastown/polecats/nux", "gastown", "stuck", true)
+	if p["bead"] != "gt-abc" {
+		t.Errorf("bead = %v, want gt-abc", p["bead"])
+	}
+	if p["from"] != "gastown/polecats/nux" {
+		t.Errorf("from = %v, want gastown/polecats/nux", p["from"])
+	}
+	if p["target"] != "gastown" {
+		t.Errorf("target = %v, want gastown", p["target"])
+	}
+	if p["reason"] != "stuck" || p["killed"] != true {
+		t.Errorf("reason/killed = %v/%v, want stuck/true", p["reason"], p["killed"])
+	}
+
+	p = ReslingPayload("gt-abc", "gastown/polecats/nux", "gastown", "", false)
+	if _, ok := p["reason"]; ok {
+		t.Error("reason should be omitted when empty")
+	}
+	if _, ok := p["killed"]; ok {
+		t.Error("killed should be omitted when false")
+	}
+}
+
+func TestIssueClosedPayload(t *testing.T) {
+	p := IssueClosedPayload("gt-abc", "done in #42")
+	if p["bead"] != "gt-abc" || p["reason"] != "done in #42" {
+		t.Errorf("payload = %v, want bead gt-abc and reason", p)
+	}
+	if _, ok := IssueClosedPayload("gt-abc", "")["reason"]; ok {
+		t.Error("reason should be omitted when empty")
+	}
+}
+
+func TestLogTo(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := LogTo(townRoot, TypeIssueCreated, "mobile", IssueCreatedPayload("gt-abc", "Fix login", "bug", 1), VisibilityFeed); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(townRoot, EventsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ev Event
+	if err := json.Unmarshal(data, &ev); err != nil {
+		t.Fatal(err)
+	}
+	if ev.Type != TypeIssueCreated || ev.Actor != "mobile" || ev.Payload["title"] != "Fix login" || ev.Visibility != VisibilityFeed {
+		t.Errorf("event = %+v", ev)
+	}
+}
+
 func TestEscalationPayload(t *testing.T) {
 	p := EscalationPayload("gastown", "nux", "mayor", "unresponsive after 3 nudges")
 	if p["rig"] != "gastown" {
@@ -0,0 +1,113 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+)
+
+// Follower reads lines as they are appended to an events log, and keeps
+// following the log when it is rotated into the archive or rewritten by
+// pruning. It is not safe for concurrent use.
+type Follower struct {
+	path    string
+	file    *os.File
+	reader  *bufio.Reader
+	partial string
+
+	// last is the newest timestamp returned so far. After a rewrite the
+	// new file may repeat older lines; those before last are skipped.
+	last       time.Time
+	catchingUp bool
+}
+
+// Follow opens the events log at path for tailing, starting at its end so
+// only new lines are returned. The file is created if missing.
+func Follow(path string) (*Follower, error) {
+	file, err := os.OpenFile(path, os.O_RDONLY|os.O_CREATE, 0644) //nolint:gosec // G302: events file is non-sensitive operational data
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Seek(0, io.SeekEnd); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return &Follower{path: path, file: file, reader: bufio.NewReader(file)}, nil
+}
+
+// Next returns the next complete line, without its newline. ok is false
+// when no complete line is available yet; call again later.
+func (f *Follower) Next() (line string, ok bool) {
+	for {
+		chunk, err := f.reader.ReadString('\n')
+		if err == nil {
+			line = f.partial + chunk[:len(chunk)-1]
+			f.partial = ""
+			if f.skip(line) {
+				continue
+			}
+			return line, true
+		}
+		f.partial += chunk
+
+		if !f.rotated() {
+			return "", false
+		}
+		// The old file is drained and path now names a new file: switch to
+		// it from the start.
+		next, err := os.Open(f.path)
+		if err != nil {
+			return "", false
+		}
+		_ = f.file.Close()
+		f.file = next
+		f.reader = bufio.NewReader(next)
+		f.partial = ""
+		f.catchingUp = true
+	}
+}
+
+// rotated reports whether path no longer names the open file.
+func (f *Follower) rotated() bool {
+	open, err := f.file.Stat()
+	if err != nil {
+		return false
+	}
+	cur, err := os.Stat(f.path)
+	if err != nil {
+		return false // not recreated yet
+	}
+	return !os.SameFile(open, cur)
+}
+
+// skip tracks the newest timestamp seen and, right after switching files,
+// drops lines older than it.
+func (f *Follower) skip(line string) bool {
+	var ev struct {
+		Timestamp string `json:"ts"`
+	}
+	if json.Unmarshal([]byte(line), &ev) != nil {
+		return f.catchingUp
+	}
+	ts, err := time.Parse(time.RFC3339, ev.Timestamp)
+	if err != nil {
+		return f.catchingUp
+	}
+	if f.catchingUp {
+		if ts.Before(f.last) {
+			return true
+		}
+		f.catchingUp = false
+	}
+	if ts.After(f.last) {
+		f.last = ts
+	}
+	return false
+}
+
+// Close closes the underlying file.
+func (f *Follower) Close() error {
+	return f.file.Close()
+}
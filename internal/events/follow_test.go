@@ -0,0 +1,79 @@
+package events
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func drain(f *Follower) []string {
+	var types []string
+	for {
+		line, ok := f.Next()
+		if !ok {
+			return types
+		}
+		var ev Event
+		_ = json.Unmarshal([]byte(line), &ev)
+		types = append(types, ev.Type)
+	}
+}
+
+func TestFollower_FollowsRotationAndRewrite(t *testing.T) {
+	town := t.TempDir()
+	live := filepath.Join(town, EventsFile)
+	ts := func(m int) string {
+		return time.Date(2026, 3, 1, 12, m, 0, 0, time.UTC).Format(time.RFC3339)
+	}
+
+	appendEvents(t, live, Event{Timestamp: ts(0), Type: "old"})
+	f, err := Follow(live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if got := drain(f); len(got) != 0 {
+		t.Fatalf("existing lines returned: %v", got)
+	}
+
+	appendEvents(t, live, Event{Timestamp: ts(1), Type: "a"})
+	// A partial line waits for its newline.
+	w, _ := os.OpenFile(live, os.O_APPEND|os.O_WRONLY, 0644)
+	_, _ = w.WriteString(`{"ts":"` + ts(2) + `",`)
+	if got := drain(f); len(got) != 1 || got[0] != "a" {
+		t.Fatalf("got %v, want [a]", got)
+	}
+	_, _ = w.WriteString(`"type":"b"}` + "\n")
+	w.Close()
+	if got := drain(f); len(got) != 1 || got[0] != "b" {
+		t.Fatalf("got %v, want [b]", got)
+	}
+
+	// Rotation: the last lines of the old file, then the new file.
+	appendEvents(t, live, Event{Timestamp: ts(3), Type: "c"})
+	if _, err := Rotate(town, RotatePolicy{MaxSize: 1}, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	appendEvents(t, live, Event{Timestamp: ts(4), Type: "d"})
+	if got := drain(f); len(got) != 2 || got[0] != "c" || got[1] != "d" {
+		t.Fatalf("across rotation got %v, want [c d]", got)
+	}
+
+	// Rewrite that keeps older lines (as pruning does): only new ones come
+	// through.
+	tmp := live + ".tmp"
+	appendEvents(t, tmp,
+		Event{Timestamp: ts(1), Type: "a"},
+		Event{Timestamp: ts(3), Type: "c"},
+		Event{Timestamp: ts(5), Type: "e"},
+	)
+	if err := os.Rename(tmp, live); err != nil {
+		t.Fatal(err)
+	}
+	if got := drain(f); len(got) != 1 || got[0] != "e" {
+		t.Fatalf("after rewrite got %v, want [e]", got)
+	}
+}
@@ -0,0 +1,447 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Query limits.
+const (
+	DefaultQueryLimit = 50
+	MaxQueryLimit     = 1000
+)
+
+// ErrInvalidCursor is returned for a Query.Cursor that was not produced by
+// a previous page.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Query selects events from the log. Zero-valued fields match everything.
+type Query struct {
+	Types      []string  // Match any of these event types
+	Actor      string    // Exact actor address
+	Rig        string    // Events whose payload rig or actor prefix is this rig
+	Visibility string    // Exact visibility ("audit", "feed", "both")
+	FeedOnly   bool      // Exclude audit-only events
+	Since      time.Time // Only events at or after this time
+	Until      time.Time // Only events at or before this time
+	Limit      int       // Page size (default 50, max 1000)
+	Cursor     string    // NextCursor from a previous page
+}
+
+// Page is one page of query results, newest first.
+type Page struct {
+	Events []Event `json:"events"`
+	// NextCursor fetches the next (older) page; empty on the last page.
+	NextCursor string `json:"next_cursor,omitempty"`
+	// Total is the number of matching events in the live log. Archived
+	// events are reached by paging past the live log but are not counted.
+	Total int `json:"total"`
+}
+
+// archiveCursorPrefix marks cursors that point into the archive. They have
+// the form "a:<segment id>:<line>", or just "a:" for the newest segment.
+const archiveCursorPrefix = "a:"
+
+// Index is an in-memory index over an events JSONL file. It records the
+// offset, time, actor, type, rig and visibility of every line so queries
+// only read the lines they return. The index is refreshed incrementally as
+// the file grows and rebuilt when the file is rotated or rewritten, so its
+// cost tracks the live log rather than the full history. Paging past the
+// live log continues into the rotated segments in archiveDir. It is safe for
+// concurrent use.
+type Index struct {
+	path       string
+	archiveDir string
+
+	mu      sync.Mutex
+	file    os.FileInfo // identity of the indexed file
+	size    int64       // bytes indexed so far
+	entries []indexEntry
+	byActor map[string][]int
+	byType  map[string][]int
+	// ordered is true while timestamps are non-decreasing, which lets time
+	// bounds be found by binary search.
+	ordered bool
+}
+
+type indexEntry struct {
+	offset     int64
+	length     int
+	ts         time.Time
+	typ        string
+	actor      string
+	rig        string
+	visibility string
+}
+
+var (
+	indexesMu sync.Mutex
+	indexes   = map[string]*Index{}
+)
+
+// Open returns the shared index for a town's events log.
+func Open(townRoot string) *Index {
+	return OpenFile(filepath.Join(townRoot, EventsFile))
+}
+
+// OpenFile returns the shared index for the events file at path.
+func OpenFile(path string) *Index {
+	indexesMu.Lock()
+	defer indexesMu.Unlock()
+	ix, ok := indexes[path]
+	if !ok {
+		ix = NewIndex(path)
+		indexes[path] = ix
+	}
+	return ix
+}
+
+// NewIndex creates an unshared index for the events file at path, with
+// rotated segments in ArchiveDir beside it. Most callers should use Open so
+// the index is built once per process.
+func NewIndex(path string) *Index {
+	return &Index{path: path, archiveDir: filepath.Join(filepath.Dir(path), ArchiveDir)}
+}
+
+// Query returns the page of events matching q, newest first.
+func (ix *Index) Query(q Query) (Page, error) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	if err := ix.refresh(); err != nil {
+		return Page{}, err
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+	if limit > MaxQueryLimit {
+		limit = MaxQueryLimit
+	}
+
+	if strings.HasPrefix(q.Cursor, archiveCursorPrefix) {
+		page := Page{Total: ix.count(q), Events: []Event{}}
+		err := ix.queryArchive(q, &page, limit)
+		return page, err
+	}
+
+	// Entries at or after end are excluded: they are newer than the cursor
+	// or past the Until bound.
+	end := len(ix.entries)
+	if q.Cursor != "" {
+		n, err := strconv.Atoi(q.Cursor)
+		if err != nil || n < 0 {
+			return Page{}, fmt.Errorf("%w %q", ErrInvalidCursor, q.Cursor)
+		}
+		end = min(n, end)
+	}
+	start := 0
+	if ix.ordered {
+		if !q.Since.IsZero() {
+			start = sort.Search(len(ix.entries), func(i int) bool { return !ix.entries[i].ts.Before(q.Since) })
+		}
+		if !q.Until.IsZero() {
+			end = min(end, sort.Search(len(ix.entries), func(i int) bool { return ix.entries[i].ts.After(q.Until) }))
+		}
+	}
+
+	var matched []int
+	for _, i := range ix.candidates(q) {
+		if i < start || i >= end {
+			continue
+		}
+		if ix.entries[i].matches(q) {
+			matched = append(matched, i)
+		}
+	}
+
+	page := Page{Total: len(matched), Events: []Event{}}
+	if q.Cursor != "" {
+		// Total counts the whole result set, not just what is left after
+		// the cursor.
+		page.Total = ix.count(q)
+	}
+
+	if len(matched) > 0 {
+		f, err := os.Open(ix.path)
+		if err != nil {
+			return Page{}, fmt.Errorf("opening events file: %w", err)
+		}
+		defer f.Close()
+
+		for k := len(matched) - 1; k >= 0 && len(page.Events) < limit; k-- {
+			i := matched[k]
+			ev, err := ix.read(f, ix.entries[i])
+			if err != nil {
+				return Page{}, err
+			}
+			page.Events = append(page.Events, ev)
+			if len(page.Events) == limit && k > 0 {
+				page.NextCursor = strconv.Itoa(i)
+			}
+		}
+	}
+	if page.NextCursor != "" {
+		return page, nil
+	}
+
+	// The live log is exhausted. If the query's time range reaches back
+	// past it, point the caller at the archive; recent-events queries
+	// never read it.
+	if len(ix.entries) > 0 && ix.ordered && !q.Since.IsZero() && !q.Since.Before(ix.entries[0].ts) {
+		return page, nil
+	}
+	if segs, _ := listSegments(ix.archiveDir, nil); len(segs) > 0 {
+		page.NextCursor = archiveCursorPrefix
+	}
+	return page, nil
+}
+
+// queryArchive fills page from rotated segments, newest first, starting at
+// q.Cursor if it is an archive cursor.
+func (ix *Index) queryArchive(q Query, page *Page, limit int) error {
+	fromID, fromLine := "", -1
+	if rest, ok := strings.CutPrefix(q.Cursor, archiveCursorPrefix); ok && rest != "" {
+		id, line, ok := strings.Cut(rest, ":")
+		n, err := strconv.Atoi(line)
+		if !ok || err != nil || n < 0 {
+			return fmt.Errorf("%w %q", ErrInvalidCursor, q.Cursor)
+		}
+		fromID, fromLine = id, n
+	}
+
+	index, err := loadArchiveIndex(ix.archiveDir)
+	if err != nil {
+		return err
+	}
+	segs, err := listSegments(ix.archiveDir, index)
+	if err != nil {
+		return err
+	}
+
+	for si, seg := range segs {
+		end := -1
+		if fromID != "" {
+			if seg.id() > fromID {
+				continue // newer than the cursor
+			}
+			if seg.id() == fromID {
+				end = fromLine
+			}
+		}
+		if !seg.overlaps(q) {
+			continue
+		}
+
+		evs, lines, err := scanSegment(filepath.Join(ix.archiveDir, seg.Name), q, end)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue // removed by retention mid-query
+			}
+			return err
+		}
+		for k := len(evs) - 1; k >= 0; k-- {
+			page.Events = append(page.Events, evs[k])
+			if len(page.Events) == limit {
+				if k > 0 || si < len(segs)-1 {
+					page.NextCursor = fmt.Sprintf("%s%s:%d", archiveCursorPrefix, seg.id(), lines[k])
+				}
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// candidates returns the entry numbers worth checking for q in ascending
+// order, using the actor or type postings when the query names them.
+func (ix *Index) candidates(q Query) []int {
+	if q.Actor != "" {
+		return ix.byActor[q.Actor]
+	}
+	if len(q.Types) == 1 {
+		return ix.byType[q.Types[0]]
+	}
+	if len(q.Types) > 1 {
+		var all []int
+		for _, t := range q.Types {
+			all = append(all, ix.byType[t]...)
+		}
+		sort.Ints(all)
+		return all
+	}
+	all := make([]int, len(ix.entries))
+	for i := range all {
+		all[i] = i
+	}
+	return all
+}
+
+// count returns the number of entries matching q, ignoring the cursor.
+func (ix *Index) count(q Query) int {
+	n := 0
+	for _, i := range ix.candidates(q) {
+		if ix.entries[i].matches(q) {
+			n++
+		}
+	}
+	return n
+}
+
+func (e *indexEntry) matches(q Query) bool {
+	if len(q.Types) > 0 {
+		found := false
+		for _, t := range q.Types {
+			if e.typ == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if q.Actor != "" && e.actor != q.Actor {
+		return false
+	}
+	if q.Rig != "" && e.rig != q.Rig {
+		return false
+	}
+	if q.Visibility != "" && e.visibility != q.Visibility {
+		return false
+	}
+	if q.FeedOnly && e.visibility == VisibilityAudit {
+		return false
+	}
+	if !q.Since.IsZero() && e.ts.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && e.ts.After(q.Until) {
+		return false
+	}
+	return true
+}
+
+// read loads the event for an index entry.
+func (ix *Index) read(f *os.File, e indexEntry) (Event, error) {
+	buf := make([]byte, e.length)
+	if _, err := f.ReadAt(buf, e.offset); err != nil {
+		return Event{}, fmt.Errorf("reading events file: %w", err)
+	}
+	var ev Event
+	if err := json.Unmarshal(buf, &ev); err != nil {
+		return Event{}, fmt.Errorf("decoding event at offset %d: %w", e.offset, err)
+	}
+	return ev, nil
+}
+
+// refresh indexes any complete lines appended since the last call.
+func (ix *Index) refresh() error {
+	info, err := os.Stat(ix.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			ix.reset()
+			ix.file = nil
+			return nil
+		}
+		return fmt.Errorf("stat events file: %w", err)
+	}
+	if ix.byActor == nil || info.Size() < ix.size || (ix.file != nil && !os.SameFile(ix.file, info)) {
+		// First use, truncated, or rotated/rewritten: start over.
+		ix.reset()
+	}
+	ix.file = info
+	if info.Size() == ix.size {
+		return nil
+	}
+
+	f, err := os.Open(ix.path)
+	if err != nil {
+		return fmt.Errorf("opening events file: %w", err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.NewSectionReader(f, ix.size, info.Size()-ix.size))
+	if err != nil {
+		return fmt.Errorf("reading events file: %w", err)
+	}
+
+	// Only index complete lines; a partial trailing line is picked up once
+	// its writer finishes it.
+	for {
+		nl := bytes.IndexByte(data, '\n')
+		if nl < 0 {
+			break
+		}
+		ix.add(ix.size, data[:nl])
+		ix.size += int64(nl + 1)
+		data = data[nl+1:]
+	}
+	return nil
+}
+
+func (ix *Index) reset() {
+	ix.size = 0
+	ix.entries = nil
+	ix.byActor = map[string][]int{}
+	ix.byType = map[string][]int{}
+	ix.ordered = true
+}
+
+// add indexes one line. Blank and malformed lines are skipped.
+func (ix *Index) add(offset int64, line []byte) {
+	if len(bytes.TrimSpace(line)) == 0 {
+		return
+	}
+	var ev Event
+	if err := json.Unmarshal(line, &ev); err != nil {
+		return
+	}
+	e := entryFor(ev)
+	e.offset = offset
+	e.length = len(line)
+
+	n := len(ix.entries)
+	if n > 0 && e.ts.Before(ix.entries[n-1].ts) {
+		ix.ordered = false
+	}
+	ix.entries = append(ix.entries, e)
+	ix.byActor[e.actor] = append(ix.byActor[e.actor], n)
+	ix.byType[e.typ] = append(ix.byType[e.typ], n)
+}
+
+// entryFor returns the index fields of an event, without its location.
+func entryFor(ev Event) indexEntry {
+	ts, _ := time.Parse(time.RFC3339, ev.Timestamp)
+	return indexEntry{
+		ts:         ts,
+		typ:        ev.Type,
+		actor:      ev.Actor,
+		rig:        eventRig(ev),
+		visibility: ev.Visibility,
+	}
+}
+
+// eventRig returns the rig an event belongs to: the payload's "rig" field if
+// present, otherwise the first segment of a rig-scoped actor address such as
+// "gastown/crew/joe". Town-level actors like "mayor" have no rig.
+func eventRig(ev Event) string {
+	if rig, ok := ev.Payload["rig"].(string); ok && rig != "" {
+		return rig
+	}
+	if i := strings.Index(ev.Actor, "/"); i > 0 {
+		return ev.Actor[:i]
+	}
+	return ""
+}
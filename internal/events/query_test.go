@@ -0,0 +1,151 @@
+package events
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func appendEvents(t *testing.T, path string, evs ...Event) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	for _, ev := range evs {
+		data, _ := json.Marshal(ev)
+		if _, err := f.Write(append(data, '\n')); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestIndexQuery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), EventsFile)
+	t0 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(m int) string { return t0.Add(time.Duration(m) * time.Minute).Format(time.RFC3339) }
+
+	appendEvents(t, path,
+		Event{Timestamp: at(0), Type: TypeSling, Actor: "mayor", Payload: map[string]interface{}{"rig": "gastown"}, Visibility: VisibilityFeed},
+		Event{Timestamp: at(1), Type: TypeHook, Actor: "gastown/polecats/nux", Visibility: VisibilityFeed},
+		Event{Timestamp: at(2), Type: TypeNudge, Actor: "beads/witness", Visibility: VisibilityAudit},
+		Event{Timestamp: at(3), Type: TypeDone, Actor: "gastown/polecats/nux", Visibility: VisibilityFeed},
+	)
+	ix := NewIndex(path)
+
+	page, err := ix.Query(Query{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 4 || len(page.Events) != 4 || page.Events[0].Type != TypeDone {
+		t.Fatalf("all = %+v", page)
+	}
+
+	tests := []struct {
+		name string
+		q    Query
+		want []string // types, newest first
+	}{
+		{"actor", Query{Actor: "gastown/polecats/nux"}, []string{TypeDone, TypeHook}},
+		{"types", Query{Types: []string{TypeSling, TypeNudge}}, []string{TypeNudge, TypeSling}},
+		{"rig from payload and actor", Query{Rig: "gastown"}, []string{TypeDone, TypeHook, TypeSling}},
+		{"feed only", Query{FeedOnly: true}, []string{TypeDone, TypeHook, TypeSling}},
+		{"time range", Query{Since: t0.Add(time.Minute), Until: t0.Add(2 * time.Minute)}, []string{TypeNudge, TypeHook}},
+	}
+	for _, tt := range tests {
+		page, err := ix.Query(tt.q)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		var got []string
+		for _, ev := range page.Events {
+			got = append(got, ev.Type)
+		}
+		if len(got) != len(tt.want) || page.Total != len(tt.want) {
+			t.Errorf("%s: got %v (total %d), want %v", tt.name, got, page.Total, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestIndexQuery_Pagination(t *testing.T) {
+	path := filepath.Join(t.TempDir(), EventsFile)
+	t0 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		appendEvents(t, path, Event{Timestamp: t0.Add(time.Duration(i) * time.Second).Format(time.RFC3339), Type: TypeMail, Actor: "mayor"})
+	}
+	ix := NewIndex(path)
+
+	var seen []string
+	q := Query{Limit: 2}
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("pagination did not terminate")
+		}
+		page, err := ix.Query(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.Total != 5 {
+			t.Errorf("total = %d, want 5", page.Total)
+		}
+		for _, ev := range page.Events {
+			seen = append(seen, ev.Timestamp)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		q.Cursor = page.NextCursor
+	}
+	if len(seen) != 5 || seen[0] <= seen[4] {
+		t.Errorf("seen = %v, want 5 events newest first", seen)
+	}
+
+	if _, err := ix.Query(Query{Cursor: "bogus"}); err == nil {
+		t.Error("expected error for invalid cursor")
+	}
+}
+
+func TestIndex_IncrementalAndTruncate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), EventsFile)
+	ix := NewIndex(path)
+
+	if page, err := ix.Query(Query{}); err != nil || page.Total != 0 {
+		t.Fatalf("missing file: %+v, %v", page, err)
+	}
+
+	appendEvents(t, path, Event{Timestamp: "2026-03-01T12:00:00Z", Type: TypeSpawn, Actor: "mayor"})
+	// A partial trailing line is not indexed until it is complete.
+	f, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	_, _ = f.WriteString(`{"ts":"2026-03-01T12:01:00Z","type":"kill"`)
+	f.Close()
+
+	if page, _ := ix.Query(Query{}); page.Total != 1 {
+		t.Fatalf("total = %d, want 1", page.Total)
+	}
+
+	f, _ = os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	_, _ = f.WriteString(`,"actor":"mayor"}` + "\n")
+	f.Close()
+	if page, _ := ix.Query(Query{}); page.Total != 2 || page.Events[0].Type != TypeKill {
+		t.Fatalf("after completing line: %+v", page)
+	}
+
+	// Rewriting the file with less data rebuilds the index.
+	if err := os.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	appendEvents(t, path, Event{Timestamp: "2026-03-01T13:00:00Z", Type: TypeBoot, Actor: "deacon"})
+	if page, _ := ix.Query(Query{}); page.Total != 1 || page.Events[0].Type != TypeBoot {
+		t.Fatalf("after truncate: %+v", page)
+	}
+}
@@ -0,0 +1,234 @@
+// Package federation lets one town route work to other towns.
+//
+// Each town keeps a registry of peer towns (mayor/federation.json) naming
+// the RPC endpoint and auth token for each. Addresses prefixed with a
+// registered town name ("town:rig/polecats/name", "town:gt-abc") are
+// delivered through that town's RPC server instead of local beads.
+package federation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/steveyegge/gastown/internal/rpcclient"
+)
+
+// RegistryFile is the registry path relative to the town root.
+const RegistryFile = "mayor/federation.json"
+
+// reservedPrefixes are address prefixes the mail router already gives meaning
+// to; a town with one of these names could never be addressed.
+var reservedPrefixes = map[string]bool{
+	"list":     true,
+	"queue":    true,
+	"announce": true,
+	"channel":  true,
+	"group":    true,
+}
+
+var townNameRe = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// Town is a peer town reachable over RPC.
+type Town struct {
+	Name string `json:"-"`
+	URL  string `json:"url"`
+
+	// Token is the API key presented to the peer's RPC server.
+	// TokenEnv names an environment variable to read it from instead,
+	// so secrets need not be written to disk.
+	Token    string `json:"token,omitempty"`
+	TokenEnv string `json:"token_env,omitempty"`
+}
+
+// AuthToken returns the API key for the town, preferring TokenEnv.
+func (t *Town) AuthToken() string {
+	if t.TokenEnv != "" {
+		if v := os.Getenv(t.TokenEnv); v != "" {
+			return v
+		}
+	}
+	return t.Token
+}
+
+// Client returns an RPC client for the town. localTown is sent so the
+// peer can attribute generated bead IDs.
+func (t *Town) Client(localTown string) *rpcclient.Client {
+	opts := []rpcclient.Option{rpcclient.WithTownName(localTown)}
+	if token := t.AuthToken(); token != "" {
+		opts = append(opts, rpcclient.WithAPIKey(token))
+	}
+	return rpcclient.NewClient(t.URL, opts...)
+}
+
+// registryData is the JSON file structure.
+type registryData struct {
+	Version int              `json:"version"`
+	Towns   map[string]*Town `json:"towns"`
+}
+
+// Registry holds the peer towns known to this town.
+type Registry struct {
+	path  string
+	towns map[string]*Town
+	mu    sync.RWMutex
+}
+
+// Load reads the federation registry for a town.
+// A missing registry file yields an empty registry.
+func Load(townRoot string) (*Registry, error) {
+	r := &Registry{
+		path:  filepath.Join(townRoot, RegistryFile),
+		towns: make(map[string]*Town),
+	}
+
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return r, nil
+		}
+		return nil, fmt.Errorf("reading federation registry: %w", err)
+	}
+
+	var rd registryData
+	if err := json.Unmarshal(data, &rd); err != nil {
+		return nil, fmt.Errorf("parsing federation registry: %w", err)
+	}
+	for name, t := range rd.Towns {
+		t.Name = name
+		r.towns[name] = t
+	}
+	return r, nil
+}
+
+// save writes the registry to disk. The file may contain tokens, so it is
+// only readable by the owner.
+func (r *Registry) save() error {
+	data, err := json.MarshalIndent(registryData{Version: 1, Towns: r.towns}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling federation registry: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return fmt.Errorf("creating mayor directory: %w", err)
+	}
+	if err := os.WriteFile(r.path, data, 0600); err != nil {
+		return fmt.Errorf("writing federation registry: %w", err)
+	}
+	return nil
+}
+
+// Get returns a town by name.
+func (r *Registry) Get(name string) (*Town, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	t, ok := r.towns[name]
+	if !ok {
+		return nil, fmt.Errorf("town not in federation: %s", name)
+	}
+	return t, nil
+}
+
+// Add validates and adds (or replaces) a town, then saves the registry.
+func (r *Registry) Add(t *Town) error {
+	if err := ValidateTownName(t.Name); err != nil {
+		return err
+	}
+	u, err := url.Parse(t.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid URL %q: must be http(s)://host[:port]", t.URL)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.towns[t.Name] = t
+	return r.save()
+}
+
+// Remove deletes a town and saves the registry.
+func (r *Registry) Remove(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.towns[name]; !ok {
+		return fmt.Errorf("town not in federation: %s", name)
+	}
+	delete(r.towns, name)
+	return r.save()
+}
+
+// List returns all towns sorted by name.
+func (r *Registry) List() []*Town {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*Town, 0, len(r.towns))
+	for _, t := range r.towns {
+		result = append(result, t)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// Resolve splits a federated address and looks up its town.
+// ok is false when the address has no prefix or the prefix is not a
+// registered town, in which case the address should be handled locally.
+func (r *Registry) Resolve(addr string) (t *Town, local string, ok bool) {
+	name, local := ParseAddress(addr)
+	if name == "" {
+		return nil, addr, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	t, ok = r.towns[name]
+	if !ok {
+		return nil, addr, false
+	}
+	return t, local, true
+}
+
+// ParseAddress splits "town:rest" into its town and local parts.
+// Addresses without a town-shaped prefix return an empty town.
+func ParseAddress(addr string) (town, local string) {
+	idx := strings.Index(addr, ":")
+	if idx <= 0 {
+		return "", addr
+	}
+	prefix := addr[:idx]
+	if !townNameRe.MatchString(prefix) {
+		return "", addr
+	}
+	return prefix, addr[idx+1:]
+}
+
+// Qualify prefixes a local address with the town name, for use as a
+// return address on forwarded work.
+func Qualify(town, addr string) string {
+	if town == "" || addr == "" {
+		return addr
+	}
+	if t, _ := ParseAddress(addr); t != "" {
+		return addr
+	}
+	return town + ":" + addr
+}
+
+// ValidateTownName checks that a name can be used as an address prefix.
+func ValidateTownName(name string) error {
+	if !townNameRe.MatchString(name) {
+		return fmt.Errorf("invalid town name %q: use lowercase letters, digits, and dashes", name)
+	}
+	if reservedPrefixes[name] {
+		return fmt.Errorf("invalid town name %q: reserved mail address prefix", name)
+	}
+	return nil
+}
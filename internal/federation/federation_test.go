@@ -0,0 +1,99 @@
+package federation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		addr      string
+		wantTown  string
+		wantLocal string
+	}{
+		{"west:gastown/polecats/nux", "west", "gastown/polecats/nux"},
+		{"west:gt-abc", "west", "gt-abc"},
+		{"gastown/polecats/nux", "", "gastown/polecats/nux"},
+		{":gastown", "", ":gastown"},
+		{"Not_A_Town:x", "", "Not_A_Town:x"},
+		{"queue:work", "queue", "work"},
+	}
+	for _, tt := range tests {
+		town, local := ParseAddress(tt.addr)
+		if town != tt.wantTown || local != tt.wantLocal {
+			t.Errorf("ParseAddress(%q) = (%q, %q), want (%q, %q)", tt.addr, town, local, tt.wantTown, tt.wantLocal)
+		}
+	}
+}
+
+func TestRegistryRoundTripAndResolve(t *testing.T) {
+	townRoot := t.TempDir()
+
+	reg, err := Load(townRoot)
+	if err != nil {
+		t.Fatalf("Load empty: %v", err)
+	}
+	if len(reg.List()) != 0 {
+		t.Fatalf("expected empty registry")
+	}
+	if err := reg.Add(&Town{Name: "west", URL: "https://west.example:8443", TokenEnv: "WEST_TOKEN"}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	info, err := os.Stat(filepath.Join(townRoot, RegistryFile))
+	if err != nil {
+		t.Fatalf("registry not written: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("registry mode = %v, want 0600", info.Mode().Perm())
+	}
+
+	reg, err = Load(townRoot)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	town, local, ok := reg.Resolve("west:gastown/polecats/nux")
+	if !ok || town.Name != "west" || local != "gastown/polecats/nux" {
+		t.Fatalf("Resolve = (%v, %q, %v)", town, local, ok)
+	}
+	if _, _, ok := reg.Resolve("queue:work"); ok {
+		t.Error("unregistered prefix should resolve locally")
+	}
+
+	t.Setenv("WEST_TOKEN", "secret")
+	if got := town.AuthToken(); got != "secret" {
+		t.Errorf("AuthToken = %q, want secret", got)
+	}
+
+	if err := reg.Remove("west"); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	if _, err := reg.Get("west"); err == nil {
+		t.Error("expected town to be removed")
+	}
+}
+
+func TestAddValidation(t *testing.T) {
+	reg, _ := Load(t.TempDir())
+	bad := []*Town{
+		{Name: "queue", URL: "http://x:1"},
+		{Name: "West", URL: "http://x:1"},
+		{Name: "west", URL: "x:1"},
+		{Name: "west", URL: "ftp://x"},
+	}
+	for _, town := range bad {
+		if err := reg.Add(town); err == nil {
+			t.Errorf("Add(%+v) succeeded, want error", town)
+		}
+	}
+}
+
+func TestQualify(t *testing.T) {
+	if got := Qualify("east", "gastown/polecats/nux"); got != "east:gastown/polecats/nux" {
+		t.Errorf("Qualify = %q", got)
+	}
+	if got := Qualify("east", "west:mayor/"); got != "west:mayor/" {
+		t.Errorf("Qualify should keep an existing town prefix, got %q", got)
+	}
+}
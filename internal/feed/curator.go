@@ -9,11 +9,9 @@
 package feed
 
 import (
-	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -75,20 +73,15 @@ func NewCurator(townRoot string) *Curator {
 func (c *Curator) Start() error {
 	eventsPath := filepath.Join(c.townRoot, events.EventsFile)
 
-	// Open events file, creating if needed
-	file, err := os.OpenFile(eventsPath, os.O_RDONLY|os.O_CREATE, 0644) //nolint:gosec // G302: events file is non-sensitive operational data
+	// Follow the events file from its end, across rotations, so only new
+	// events are processed
+	follower, err := events.Follow(eventsPath)
 	if err != nil {
 		return fmt.Errorf("opening events file: %w", err)
 	}
 
-	// Seek to end to only process new events
-	if _, err := file.Seek(0, io.SeekEnd); err != nil {
-		_ = file.Close() //nolint:gosec // G104: best effort cleanup on error
-		return fmt.Errorf("seeking to end: %w", err)
-	}
-
 	c.wg.Add(1)
-	go c.run(file)
+	go c.run(follower)
 
 	return nil
 }
@@ -101,11 +94,10 @@ func (c *Curator) Stop() {
 
 // run is the main curator loop.
 // ZFC: No in-memory state to clean up - state is derived from the events file.
-func (c *Curator) run(file *os.File) {
+func (c *Curator) run(follower *events.Follower) {
 	defer c.wg.Done()
-	defer file.Close()
+	defer follower.Close()
 
-	reader := bufio.NewReader(file)
 	ticker := time.NewTicker(100 * time.Millisecond)
 	defer ticker.Stop()
 
@@ -117,8 +109,8 @@ func (c *Curator) run(file *os.File) {
 		case <-ticker.C:
 			// Read available lines
 			for {
-				line, err := reader.ReadString('\n')
-				if err != nil {
+				line, ok := follower.Next()
+				if !ok {
 					break // No more data available
 				}
 				c.processLine(line)
@@ -3,6 +3,8 @@ package formula
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 )
@@ -48,6 +50,8 @@ func (f *Formula) inferType() {
 		f.Type = TypeExpansion
 	} else if len(f.Aspects) > 0 {
 		f.Type = TypeAspect
+	} else if len(f.Beads) > 0 {
+		f.Type = TypeConvoyTemplate
 	}
 }
 
@@ -59,7 +63,7 @@ func (f *Formula) Validate() error {
 	}
 
 	if !f.Type.IsValid() {
-		return fmt.Errorf("invalid formula type %q (must be convoy, workflow, expansion, or aspect)", f.Type)
+		return fmt.Errorf("invalid formula type %q (must be convoy, workflow, expansion, aspect, or convoy-template)", f.Type)
 	}
 
 	// Type-specific validation
@@ -72,6 +76,8 @@ func (f *Formula) Validate() error {
 		return f.validateExpansion()
 	case TypeAspect:
 		return f.validateAspect()
+	case TypeConvoyTemplate:
+		return f.validateConvoyTemplate()
 	}
 
 	return nil
@@ -189,6 +195,43 @@ func (f *Formula) validateAspect() error {
 	return nil
 }
 
+func (f *Formula) validateConvoyTemplate() error {
+	if len(f.Beads) == 0 {
+		return fmt.Errorf("convoy-template formula requires at least one bead")
+	}
+
+	// Check bead IDs are unique and titled
+	seen := make(map[string]bool)
+	for _, b := range f.Beads {
+		if b.ID == "" {
+			return fmt.Errorf("bead missing required id field")
+		}
+		if b.Title == "" {
+			return fmt.Errorf("bead %q missing required title field", b.ID)
+		}
+		if seen[b.ID] {
+			return fmt.Errorf("duplicate bead id: %s", b.ID)
+		}
+		seen[b.ID] = true
+	}
+
+	// Validate bead needs references
+	for _, b := range f.Beads {
+		for _, need := range b.Needs {
+			if !seen[need] {
+				return fmt.Errorf("bead %q needs unknown bead: %s", b.ID, need)
+			}
+		}
+	}
+
+	// Check for cycles
+	if _, err := f.TopologicalSort(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // checkCycles detects circular dependencies in steps.
 func (f *Formula) checkCycles() error {
 	// Build adjacency list
@@ -255,6 +298,14 @@ func (f *Formula) TopologicalSort() ([]string, error) {
 		for _, tmpl := range f.Template {
 			deps[tmpl.ID] = tmpl.Needs
 		}
+	case TypeConvoyTemplate:
+		for _, b := range f.Beads {
+			items = append(items, b.ID)
+		}
+		deps = make(map[string][]string)
+		for _, b := range f.Beads {
+			deps[b.ID] = b.Needs
+		}
 	case TypeConvoy:
 		// Convoy legs are parallel; return all leg IDs
 		for _, leg := range f.Legs {
@@ -459,3 +510,48 @@ func (f *Formula) GetAspect(id string) *Aspect {
 	}
 	return nil
 }
+
+// GetBead returns a convoy-template bead by ID, or nil if not found.
+func (f *Formula) GetBead(id string) *TemplateBead {
+	for i := range f.Beads {
+		if f.Beads[i].ID == id {
+			return &f.Beads[i]
+		}
+	}
+	return nil
+}
+
+// ResolveVars merges the formula's var defaults with the provided values.
+// Returns an error naming the first required var that has no value.
+func (f *Formula) ResolveVars(provided map[string]string) (map[string]string, error) {
+	resolved := make(map[string]string, len(f.Vars)+len(provided))
+	for name, v := range f.Vars {
+		if v.Default != "" {
+			resolved[name] = v.Default
+		}
+	}
+	for name, value := range provided {
+		resolved[name] = value
+	}
+
+	names := make([]string, 0, len(f.Vars))
+	for name := range f.Vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if f.Vars[name].Required && resolved[name] == "" {
+			return nil, fmt.Errorf("missing required var %q (use --var %s=<value>)", name, name)
+		}
+	}
+	return resolved, nil
+}
+
+// SubstituteVars replaces {{name}} placeholders in s with their values.
+// Unknown placeholders are left as-is.
+func SubstituteVars(s string, vars map[string]string) string {
+	for name, value := range vars {
+		s = strings.ReplaceAll(s, "{{"+name+"}}", value)
+	}
+	return s
+}
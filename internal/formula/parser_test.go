@@ -353,3 +353,103 @@ title = "Leg 3"
 		t.Errorf("ReadySteps({leg1}) = %v, want 2 legs", ready)
 	}
 }
+
+func TestParse_ConvoyTemplate(t *testing.T) {
+	data := []byte(`
+formula = "release"
+description = "Release {{version}}"
+version = 1
+
+[vars.version]
+description = "Version to release"
+required = true
+
+[vars.owner]
+default = "mayor/"
+
+[[beads]]
+id = "changelog"
+title = "Write changelog for {{version}}"
+labels = ["release:{{version}}"]
+assignee = "{{owner}}"
+
+[[beads]]
+id = "tag"
+title = "Tag v{{version}}"
+type = "chore"
+priority = 1
+needs = ["build", "changelog"]
+
+[[beads]]
+id = "build"
+title = "Build artifacts"
+`)
+
+	f, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if f.Type != TypeConvoyTemplate {
+		t.Errorf("Type = %q, want %q (inferred from beads)", f.Type, TypeConvoyTemplate)
+	}
+
+	order, err := f.TopologicalSort()
+	if err != nil {
+		t.Fatalf("TopologicalSort failed: %v", err)
+	}
+	if order[len(order)-1] != "tag" {
+		t.Errorf("order = %v, want tag last", order)
+	}
+	if tag := f.GetBead("tag"); tag == nil || tag.Priority == nil || *tag.Priority != 1 {
+		t.Errorf("GetBead(tag) = %+v, want priority 1", tag)
+	}
+
+	if _, err := f.ResolveVars(nil); err == nil {
+		t.Error("expected error for missing required var")
+	}
+	vars, err := f.ResolveVars(map[string]string{"version": "2.1"})
+	if err != nil {
+		t.Fatalf("ResolveVars failed: %v", err)
+	}
+	if got := SubstituteVars(f.Beads[0].Assignee, vars); got != "mayor/" {
+		t.Errorf("assignee = %q, want default mayor/", got)
+	}
+	if got := SubstituteVars("Tag v{{version}} {{unknown}}", vars); got != "Tag v2.1 {{unknown}}" {
+		t.Errorf("SubstituteVars = %q", got)
+	}
+}
+
+func TestValidate_ConvoyTemplate(t *testing.T) {
+	tests := map[string]string{
+		"unknown need": `
+formula = "t"
+[[beads]]
+id = "a"
+title = "A"
+needs = ["b"]
+`,
+		"cycle": `
+formula = "t"
+[[beads]]
+id = "a"
+title = "A"
+needs = ["b"]
+[[beads]]
+id = "b"
+title = "B"
+needs = ["a"]
+`,
+		"missing title": `
+formula = "t"
+[[beads]]
+id = "a"
+`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse([]byte(data)); err == nil {
+				t.Error("expected validation error")
+			}
+		})
+	}
+}
@@ -1,11 +1,12 @@
 // Package formula provides parsing and validation for formula.toml files.
 //
 // Formulas define structured workflows that can be executed by agents.
-// There are four types of formulas:
+// There are five types of formulas:
 //   - convoy: Parallel execution of legs with synthesis
 //   - workflow: Sequential steps with dependencies
 //   - expansion: Template-based step generation
 //   - aspect: Multi-aspect parallel analysis (like convoy but for analysis)
+//   - convoy-template: Reusable work plan expanded into a convoy of beads
 package formula
 
 // FormulaType represents the type of formula.
@@ -20,6 +21,9 @@ const (
 	TypeExpansion FormulaType = "expansion"
 	// TypeAspect is an aspect-based formula for multi-aspect parallel analysis.
 	TypeAspect FormulaType = "aspect"
+	// TypeConvoyTemplate is a reusable work plan that expands into a convoy
+	// tracking one bead per entry (see gt convoy create --from-template).
+	TypeConvoyTemplate FormulaType = "convoy-template"
 )
 
 // Formula represents a parsed formula.toml file.
@@ -46,6 +50,9 @@ type Formula struct {
 
 	// Aspect-specific (similar to convoy but for analysis)
 	Aspects []Aspect `toml:"aspects"`
+
+	// Convoy-template-specific (uses Vars for substitution)
+	Beads []TemplateBead `toml:"beads"`
 }
 
 // Aspect represents a parallel analysis aspect in an aspect formula.
@@ -104,6 +111,19 @@ type Template struct {
 	Needs       []string `toml:"needs"`
 }
 
+// TemplateBead is one bead created when a convoy template is expanded.
+// Title, description and assignee may reference vars as {{name}}.
+type TemplateBead struct {
+	ID          string   `toml:"id"`
+	Title       string   `toml:"title"`
+	Description string   `toml:"description"`
+	Type        string   `toml:"type"`     // bead type (default "task")
+	Priority    *int     `toml:"priority"` // 0-4 (default: bd's default)
+	Labels      []string `toml:"labels"`
+	Assignee    string   `toml:"assignee"` // default assignee, e.g. "mayor/" or "{{rig}}/crew/max"
+	Needs       []string `toml:"needs"`
+}
+
 // Var represents a variable definition for formulas.
 type Var struct {
 	Description string `toml:"description"`
@@ -114,7 +134,7 @@ type Var struct {
 // IsValid returns true if the formula type is recognized.
 func (t FormulaType) IsValid() bool {
 	switch t {
-	case TypeConvoy, TypeWorkflow, TypeExpansion, TypeAspect:
+	case TypeConvoy, TypeWorkflow, TypeExpansion, TypeAspect, TypeConvoyTemplate:
 		return true
 	default:
 		return false
@@ -143,6 +163,12 @@ func (f *Formula) GetDependencies(id string) []string {
 		if f.Synthesis != nil && id == "synthesis" {
 			return f.Synthesis.DependsOn
 		}
+	case TypeConvoyTemplate:
+		for _, b := range f.Beads {
+			if b.ID == id {
+				return b.Needs
+			}
+		}
 	}
 	return nil
 }
@@ -167,6 +193,10 @@ func (f *Formula) GetAllIDs() []string {
 		for _, aspect := range f.Aspects {
 			ids = append(ids, aspect.ID)
 		}
+	case TypeConvoyTemplate:
+		for _, b := range f.Beads {
+			ids = append(ids, b.ID)
+		}
 	}
 	return ids
 }
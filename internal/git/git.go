@@ -3,6 +3,7 @@ package git
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -445,6 +446,25 @@ func (g *Git) DefaultBranch() string {
 	return "main"
 }
 
+// RemoteHeadBranch asks a repository URL which branch its HEAD points to,
+// without cloning. It doubles as a reachability check: an unreachable URL
+// or a failed authentication returns an error. An empty repository, or a
+// server that doesn't advertise symrefs, returns "".
+func (g *Git) RemoteHeadBranch(url string) (string, error) {
+	out, err := g.run("ls-remote", "--symref", url, "HEAD")
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(out, "\n") {
+		// ref: refs/heads/main	HEAD
+		if ref, ok := strings.CutPrefix(line, "ref: "); ok {
+			ref, _, _ = strings.Cut(ref, "\t")
+			return strings.TrimPrefix(ref, "refs/heads/"), nil
+		}
+	}
+	return "", nil
+}
+
 // RemoteDefaultBranch returns the default branch from the remote (origin).
 // This is useful in worktrees where HEAD may not reflect the repo's actual default.
 // Checks origin/HEAD first, then falls back to checking if master/main exists.
@@ -530,6 +550,13 @@ func (g *Git) MergeNoFF(branch, message string) error {
 	return err
 }
 
+// MergeFFOnly fast-forwards the current branch to the given branch,
+// failing if that would need a merge commit.
+func (g *Git) MergeFFOnly(branch string) error {
+	_, err := g.run("merge", "--ff-only", branch)
+	return err
+}
+
 // MergeSquash performs a squash merge of the given branch and commits with the provided message.
 // This stages all changes from the branch without creating a merge commit, then commits them
 // as a single commit with the given message. This eliminates redundant merge commits while
@@ -950,6 +977,8 @@ type Worktree struct {
 	Path   string
 	Branch string
 	Commit string
+	Bare   bool // the bare repository itself, not a checkout
+	Locked bool // protected from removal with git worktree lock
 }
 
 // WorktreeList returns all worktrees for this repository.
@@ -978,6 +1007,10 @@ func (g *Git) WorktreeList() ([]Worktree, error) {
 			current.Commit = strings.TrimPrefix(line, "HEAD ")
 		case strings.HasPrefix(line, "branch "):
 			current.Branch = strings.TrimPrefix(line, "branch refs/heads/")
+		case line == "bare":
+			current.Bare = true
+		case line == "locked" || strings.HasPrefix(line, "locked "):
+			current.Locked = true
 		}
 	}
 
@@ -989,6 +1022,57 @@ func (g *Git) WorktreeList() ([]Worktree, error) {
 	return worktrees, nil
 }
 
+// ErrNotLinkedWorktree is returned by CheckWorktreeRemovable for a path that
+// is not a linked worktree of the repository: the main worktree, the bare
+// repo, or a standalone clone with its own object store.
+var ErrNotLinkedWorktree = errors.New("not a linked worktree")
+
+// ErrWorktreeLocked is returned by CheckWorktreeRemovable for a worktree
+// protected with git worktree lock.
+var ErrWorktreeLocked = errors.New("worktree is locked")
+
+// CheckWorktreeRemovable verifies that path can be torn down as a linked
+// worktree of this repository without touching the shared object store.
+// Linked worktrees keep only a .git file pointing back at the repository;
+// deleting one (even with os.RemoveAll) loses nothing but its checkout.
+// Anything else at path holds objects that may not exist elsewhere.
+func (g *Git) CheckWorktreeRemovable(path string) error {
+	worktrees, err := g.WorktreeList()
+	if err != nil {
+		return err
+	}
+	target := canonicalPath(path)
+	for i, wt := range worktrees {
+		if canonicalPath(wt.Path) != target {
+			continue
+		}
+		// git lists the main worktree (or the bare repo) first.
+		if i == 0 || wt.Bare {
+			return fmt.Errorf("%s: %w (it is the main repository)", path, ErrNotLinkedWorktree)
+		}
+		if wt.Locked {
+			return fmt.Errorf("%s: %w", path, ErrWorktreeLocked)
+		}
+		if fi, err := os.Lstat(filepath.Join(path, ".git")); err == nil && fi.IsDir() {
+			return fmt.Errorf("%s: %w (it has its own .git directory)", path, ErrNotLinkedWorktree)
+		}
+		return nil
+	}
+	return fmt.Errorf("%s: %w", path, ErrNotLinkedWorktree)
+}
+
+// canonicalPath resolves symlinks so paths reported by git compare equal to
+// the ones gt constructs (e.g., /tmp vs /private/tmp on macOS).
+func canonicalPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+	return filepath.Clean(path)
+}
+
 // BranchCreatedDate returns the date when a branch was created.
 // This uses the committer date of the first commit on the branch.
 // Returns date in YYYY-MM-DD format.
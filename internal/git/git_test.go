@@ -1,6 +1,7 @@
 package git
 
 import (
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -569,3 +570,50 @@ func TestRefExists(t *testing.T) {
 		t.Error("expected origin/main to not exist (no remote)")
 	}
 }
+
+func TestCheckWorktreeRemovable(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	wtPath := filepath.Join(t.TempDir(), "polecat")
+	if err := g.WorktreeAdd(wtPath, "polecat/nux"); err != nil {
+		t.Fatalf("WorktreeAdd: %v", err)
+	}
+	if err := g.CheckWorktreeRemovable(wtPath); err != nil {
+		t.Errorf("linked worktree: %v", err)
+	}
+
+	if err := g.CheckWorktreeRemovable(dir); !errors.Is(err, ErrNotLinkedWorktree) {
+		t.Errorf("main worktree: got %v, want ErrNotLinkedWorktree", err)
+	}
+	if err := g.CheckWorktreeRemovable(t.TempDir()); !errors.Is(err, ErrNotLinkedWorktree) {
+		t.Errorf("unrelated dir: got %v, want ErrNotLinkedWorktree", err)
+	}
+
+	if out, err := exec.Command("git", "-C", dir, "worktree", "lock", "--reason", "in use", wtPath).CombinedOutput(); err != nil {
+		t.Fatalf("worktree lock: %v: %s", err, out)
+	}
+	if err := g.CheckWorktreeRemovable(wtPath); !errors.Is(err, ErrWorktreeLocked) {
+		t.Errorf("locked worktree: got %v, want ErrWorktreeLocked", err)
+	}
+}
+
+func TestRemoteHeadBranch(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+	if _, err := g.run("checkout", "-b", "trunk"); err != nil {
+		t.Fatal(err)
+	}
+
+	branch, err := NewGit(t.TempDir()).RemoteHeadBranch(dir)
+	if err != nil {
+		t.Fatalf("RemoteHeadBranch: %v", err)
+	}
+	if branch != "trunk" {
+		t.Errorf("RemoteHeadBranch = %q, want trunk", branch)
+	}
+
+	if _, err := NewGit(t.TempDir()).RemoteHeadBranch(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected an error for an unreachable repository")
+	}
+}
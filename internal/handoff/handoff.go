@@ -0,0 +1,247 @@
+// Package handoff provides structured handoff documents: what an agent
+// leaves on a bead for whoever picks the work up next.
+package handoff
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// Header starts every handoff document. Comments on a bead that begin with
+// it are handoffs.
+const Header = "## 🤝 Handoff"
+
+// DraftFile is the draft handoff in an agent's .runtime directory, written
+// by gt handoff and checked by the Stop hook.
+const DraftFile = "handoff.md"
+
+// Section is one part of a handoff document.
+type Section struct {
+	Key      string
+	Heading  string
+	Prompt   string // Template guidance for the writer
+	Required bool
+}
+
+// Sections are the parts of a handoff document, in order.
+var Sections = []Section{
+	{"state", "State", "Where things stand right now: branch, build/test status, anything half-applied.", true},
+	{"done", "Done", "What you finished this session. Name commits, files and closed beads.", true},
+	{"remaining", "Remaining", "What is left before the bead can close. Write \"Nothing\" if it is ready.", true},
+	{"gotchas", "Gotchas", "Traps you hit or suspect: flaky tests, misleading names, dead ends already tried.", false},
+	{"next", "Next Steps", "The first concrete things the next agent should do, in order.", true},
+}
+
+// Document is a structured handoff for one bead.
+type Document struct {
+	Bead      string
+	From      string    // Agent address of the writer
+	CreatedAt time.Time // Zero until submitted
+	Body      map[string]string
+}
+
+// DraftPath returns the draft handoff path for an agent working in workDir.
+func DraftPath(workDir string) string {
+	return filepath.Join(workDir, constants.DirRuntime, DraftFile)
+}
+
+// Template returns a blank handoff for beadID with each section's prompt
+// as an HTML comment, which Parse ignores.
+func Template(beadID string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s\n", Header, beadID)
+	for _, s := range Sections {
+		optional := ""
+		if !s.Required {
+			optional = " (optional)"
+		}
+		fmt.Fprintf(&b, "\n### %s\n<!-- %s%s -->\n\n", s.Heading, s.Prompt, optional)
+	}
+	return b.String()
+}
+
+var (
+	commentRe = regexp.MustCompile(`(?s)<!--.*?-->`)
+	fromRe    = regexp.MustCompile(`^From: (\S+)(?: · (\S+))?$`)
+)
+
+// Parse reads a handoff document. Headings it doesn't know are kept with
+// the section before them; a document without the handoff header is an
+// error.
+func Parse(text string) (*Document, error) {
+	text = strings.TrimSpace(commentRe.ReplaceAllString(text, ""))
+	if !strings.HasPrefix(text, Header) {
+		return nil, fmt.Errorf("not a handoff document (must start with %q)", Header)
+	}
+
+	d := &Document{Body: make(map[string]string)}
+	var current string
+	var lines []string
+	flush := func() {
+		if current != "" {
+			d.Body[current] = strings.TrimSpace(strings.Join(lines, "\n"))
+		}
+		lines = nil
+	}
+	for i, line := range strings.Split(text, "\n") {
+		if i == 0 {
+			d.Bead = strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(line, Header), ":"))
+			continue
+		}
+		if current == "" {
+			if m := fromRe.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+				d.From = m[1]
+				if t, err := time.Parse(time.RFC3339, m[2]); err == nil {
+					d.CreatedAt = t
+				}
+				continue
+			}
+		}
+		if heading, ok := strings.CutPrefix(line, "### "); ok {
+			if s := sectionByHeading(heading); s != nil {
+				flush()
+				current = s.Key
+				continue
+			}
+		}
+		lines = append(lines, line)
+	}
+	flush()
+	return d, nil
+}
+
+func sectionByHeading(heading string) *Section {
+	heading = strings.TrimSpace(heading)
+	for i := range Sections {
+		if strings.EqualFold(Sections[i].Heading, heading) {
+			return &Sections[i]
+		}
+	}
+	return nil
+}
+
+// placeholders are section bodies that say nothing.
+var placeholders = map[string]bool{"todo": true, "tbd": true, "...": true, "n/a": true, "-": true}
+
+// ValidationError lists what is wrong with a handoff document.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "incomplete handoff:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// Validate checks that the document names its bead and that every required
+// section has real content. It returns a *ValidationError or nil.
+func (d *Document) Validate() error {
+	var problems []string
+	if d.Bead == "" {
+		problems = append(problems, fmt.Sprintf("header must name the bead (%s: <bead-id>)", Header))
+	}
+	for _, s := range Sections {
+		body := d.Body[s.Key]
+		switch {
+		case body == "" && s.Required:
+			problems = append(problems, fmt.Sprintf("%s is empty: %s", s.Heading, s.Prompt))
+		case placeholders[strings.ToLower(body)]:
+			problems = append(problems, fmt.Sprintf("%s is a placeholder (%q): %s", s.Heading, body, s.Prompt))
+		}
+	}
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// Markdown renders the document as stored on the bead.
+func (d *Document) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s\n", Header, d.Bead)
+	if d.From != "" {
+		b.WriteString("From: " + d.From)
+		if !d.CreatedAt.IsZero() {
+			b.WriteString(" · " + d.CreatedAt.UTC().Format(time.RFC3339))
+		}
+		b.WriteString("\n")
+	}
+	for _, s := range Sections {
+		if body := d.Body[s.Key]; body != "" {
+			fmt.Fprintf(&b, "\n### %s\n%s\n", s.Heading, body)
+		}
+	}
+	return b.String()
+}
+
+// Save validates the document and stores it on its bead as a comment, so
+// earlier handoffs stay in the bead's history.
+func Save(bd *beads.Beads, d *Document) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = time.Now()
+	}
+	args := []string{"comments", "add", d.Bead, d.Markdown()}
+	if d.From != "" {
+		args = append(args, "--actor="+d.From)
+	}
+	if _, err := bd.Run(args...); err != nil {
+		return fmt.Errorf("storing handoff on %s: %w", d.Bead, err)
+	}
+	return nil
+}
+
+// beadComment is a comment as listed by bd comments list --json.
+type beadComment struct {
+	Text   string `json:"text"`
+	Author string `json:"author"`
+}
+
+// Latest returns the most recent handoff stored on a bead, or nil if it
+// has none.
+func Latest(bd *beads.Beads, beadID string) (*Document, error) {
+	out, err := bd.Run("comments", "list", beadID, "--json")
+	if err != nil {
+		return nil, fmt.Errorf("listing comments on %s: %w", beadID, err)
+	}
+	var comments []beadComment
+	if len(strings.TrimSpace(string(out))) > 0 {
+		if err := json.Unmarshal(out, &comments); err != nil {
+			return nil, fmt.Errorf("parsing comments on %s: %w", beadID, err)
+		}
+	}
+	return latestIn(beadID, comments), nil
+}
+
+// latestIn picks the newest handoff among a bead's comments by the time
+// in its From line, falling back to list order.
+func latestIn(beadID string, comments []beadComment) *Document {
+	var latest *Document
+	for _, c := range comments {
+		if !strings.HasPrefix(strings.TrimSpace(c.Text), Header) {
+			continue
+		}
+		d, err := Parse(c.Text)
+		if err != nil {
+			continue
+		}
+		if d.Bead == "" {
+			d.Bead = beadID
+		}
+		if d.From == "" {
+			d.From = c.Author
+		}
+		if latest == nil || !d.CreatedAt.Before(latest.CreatedAt) {
+			latest = d
+		}
+	}
+	return latest
+}
@@ -0,0 +1,83 @@
+package handoff
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTemplateIsIncomplete(t *testing.T) {
+	d, err := Parse(Template("gt-abc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Bead != "gt-abc" {
+		t.Errorf("Bead = %q, want gt-abc", d.Bead)
+	}
+	var verr *ValidationError
+	if err := d.Validate(); !errors.As(err, &verr) || len(verr.Problems) != 4 {
+		t.Errorf("Validate() = %v, want the 4 required sections reported", err)
+	}
+}
+
+func TestParseAndRoundTrip(t *testing.T) {
+	draft := strings.Replace(Template("gt-abc"), "### State\n", "### State\nOn branch polecat/toast, tests green.\n", 1)
+	draft = strings.Replace(draft, "### Done\n", "### Done\n- Added the parser\n- Closed gt-abd\n", 1)
+	draft = strings.Replace(draft, "### Remaining\n", "### Remaining\nWire up the CLI.\n", 1)
+	draft = strings.Replace(draft, "### Next Steps\n", "### Next Steps\n1. Read cmd/handoff.go\n### Not A Section\nstill next steps\n", 1)
+
+	d, err := Parse(draft)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Validate(); err != nil {
+		t.Fatalf("Validate() = %v", err)
+	}
+	if d.Body["done"] != "- Added the parser\n- Closed gt-abd" || d.Body["gotchas"] != "" {
+		t.Errorf("Body = %q", d.Body)
+	}
+	if !strings.Contains(d.Body["next"], "### Not A Section\nstill next steps") {
+		t.Errorf("unknown heading not kept with its section: %q", d.Body["next"])
+	}
+
+	d.From = "gastown/polecats/toast"
+	d.CreatedAt = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	again, err := Parse(d.Markdown())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.From != d.From || !again.CreatedAt.Equal(d.CreatedAt) || again.Body["state"] != d.Body["state"] {
+		t.Errorf("round trip = %+v, want %+v", again, d)
+	}
+}
+
+func TestValidatePlaceholders(t *testing.T) {
+	d := &Document{Bead: "gt-abc", Body: map[string]string{
+		"state": "ok", "done": "TODO", "remaining": "Nothing", "next": "Close it", "gotchas": "tbd",
+	}}
+	var verr *ValidationError
+	if err := d.Validate(); !errors.As(err, &verr) || len(verr.Problems) != 2 {
+		t.Errorf("Validate() = %v, want Done and Gotchas flagged", err)
+	}
+}
+
+func TestLatestIn(t *testing.T) {
+	older := &Document{Bead: "gt-abc", From: "gastown/crew/max", CreatedAt: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+		Body: map[string]string{"state": "old"}}
+	newer := &Document{Bead: "gt-abc", From: "gastown/crew/joe", CreatedAt: time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+		Body: map[string]string{"state": "new"}}
+
+	// Newest first, with an ordinary comment mixed in.
+	got := latestIn("gt-abc", []beadComment{
+		{Text: newer.Markdown()},
+		{Text: "looks good to me"},
+		{Text: older.Markdown()},
+	})
+	if got == nil || got.Body["state"] != "new" {
+		t.Errorf("latestIn() = %+v, want the newer handoff", got)
+	}
+	if latestIn("gt-abc", []beadComment{{Text: "no handoffs here"}}) != nil {
+		t.Error("latestIn() without handoffs should be nil")
+	}
+}
@@ -8,13 +8,19 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/steveyegge/gastown/internal/metrics"
 )
 
 // DefaultTimeout is the default timeout for hook execution.
 const DefaultTimeout = 30 * time.Second
 
+var hookDuration = metrics.NewHistogram("gt_hook_duration_seconds",
+	"Execution time of hook commands, by event and whether they succeeded.", nil, "event", "success")
+
 // HookEntry mirrors the Claude Code settings.json hook matcher structure.
 type HookEntry struct {
 	Matcher string       `json:"matcher"`
@@ -73,7 +79,9 @@ func (r *Runner) Fire(ctx context.Context, event Event) ([]HookResult, error) {
 			if hook.Type != "command" || hook.Command == "" {
 				continue
 			}
+			start := time.Now()
 			result := r.execHook(ctx, hook.Command, entry.Matcher)
+			hookDuration.ObserveSince(start, string(event), strconv.FormatBool(result.Success))
 			results = append(results, result)
 		}
 	}
@@ -0,0 +1,132 @@
+package knowledge
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// BM25 parameters: k1 caps how much repeating a term helps, b how much long
+// entries are penalized.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Field weights: a term in the title or tags counts as this many body terms.
+const (
+	titleWeight = 3
+	tagWeight   = 2
+)
+
+// Hit is a search result.
+type Hit struct {
+	Entry *Entry  `json:"entry"`
+	Score float64 `json:"score"`
+}
+
+// Index is a keyword index over entries, scored with BM25.
+type Index struct {
+	entries []*Entry
+	terms   []map[string]int // per entry: term -> weighted count
+	lengths []int
+	avgLen  float64
+	df      map[string]int // term -> entries containing it
+}
+
+// NewIndex indexes entries.
+func NewIndex(entries []*Entry) *Index {
+	idx := &Index{entries: entries, df: make(map[string]int)}
+	total := 0
+	for _, e := range entries {
+		tf := make(map[string]int)
+		add := func(text string, weight int) {
+			for _, t := range Tokenize(text) {
+				tf[t] += weight
+			}
+		}
+		add(e.Title, titleWeight)
+		add(strings.Join(e.Tags, " "), tagWeight)
+		add(e.Body, 1)
+
+		length := 0
+		for t, n := range tf {
+			idx.df[t]++
+			length += n
+		}
+		idx.terms = append(idx.terms, tf)
+		idx.lengths = append(idx.lengths, length)
+		total += length
+	}
+	if len(entries) > 0 {
+		idx.avgLen = float64(total) / float64(len(entries))
+	}
+	return idx
+}
+
+// Search returns up to limit entries matching any query term, best first.
+// A limit of 0 or less returns every match.
+func (idx *Index) Search(query string, limit int) []Hit {
+	qterms := Tokenize(query)
+	n := float64(len(idx.entries))
+	var hits []Hit
+	for i, tf := range idx.terms {
+		var score float64
+		seen := make(map[string]bool)
+		for _, t := range qterms {
+			f := float64(tf[t])
+			if f == 0 || seen[t] {
+				continue
+			}
+			seen[t] = true
+			df := float64(idx.df[t])
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			norm := 1 - bm25B + bm25B*float64(idx.lengths[i])/idx.avgLen
+			score += idf * f * (bm25K1 + 1) / (f + bm25K1*norm)
+		}
+		if score > 0 {
+			hits = append(hits, Hit{Entry: idx.entries[i], Score: score})
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Entry.CreatedAt.After(hits[j].Entry.CreatedAt)
+	})
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+// stopwords are too common to say anything about a learning.
+var stopwords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true,
+	"but": true, "by": true, "for": true, "from": true, "has": true, "have": true,
+	"if": true, "in": true, "into": true, "is": true, "it": true, "its": true, "not": true,
+	"of": true, "on": true, "or": true, "so": true, "that": true, "the": true, "then": true,
+	"this": true, "to": true, "use": true, "was": true, "when": true, "with": true, "you": true,
+}
+
+// Tokenize splits text into lowercase search terms. Words are split on
+// anything but letters, digits, '-' and '_', so flags and identifiers like
+// --no-verify or bd_actor stay whole; a trailing plural "s" is dropped.
+func Tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_'
+	})
+	var out []string
+	for _, f := range fields {
+		f = strings.Trim(f, "-_")
+		if len(f) > 3 && strings.HasSuffix(f, "s") && !strings.HasSuffix(f, "ss") {
+			f = f[:len(f)-1]
+		}
+		if len(f) < 2 || stopwords[f] {
+			continue
+		}
+		out = append(out, f)
+	}
+	return out
+}
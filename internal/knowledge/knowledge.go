@@ -0,0 +1,220 @@
+// Package knowledge is the town's shared memory: learnings agents record
+// (repo quirks, command incantations, decisions) so they outlive the
+// polecat that learned them. Entries are beads in town beads; search runs
+// over a keyword index built from them.
+package knowledge
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// Label marks knowledge beads.
+const Label = "gt:knowledge"
+
+// Entry kinds.
+const (
+	KindQuirk    = "quirk"    // Something surprising about a repo or tool
+	KindCommand  = "command"  // A command line that works
+	KindDecision = "decision" // A choice made, and why
+	KindNote     = "note"     // Anything else
+)
+
+// Kinds are the valid entry kinds.
+var Kinds = []string{KindQuirk, KindCommand, KindDecision, KindNote}
+
+// bodyHeader separates the key/value fields from the free-form body in a
+// knowledge bead description.
+const bodyHeader = "## Learning"
+
+// Entry is one learning.
+type Entry struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body,omitempty"`
+	Kind      string    `json:"kind"`
+	Rig       string    `json:"rig,omitempty"` // Empty for town-wide knowledge
+	Tags      []string  `json:"tags,omitempty"`
+	Author    string    `json:"author,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IsKind reports whether kind is a valid entry kind.
+func IsKind(kind string) bool {
+	for _, k := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatDescription renders an entry as a bead description.
+func FormatDescription(e *Entry) string {
+	lines := []string{e.Title, ""}
+	lines = append(lines, fmt.Sprintf("kind: %s", e.Kind))
+	lines = append(lines, fmt.Sprintf("rig: %s", nullIfEmpty(e.Rig)))
+	lines = append(lines, fmt.Sprintf("tags: %s", nullIfEmpty(strings.Join(e.Tags, ","))))
+	lines = append(lines, fmt.Sprintf("author: %s", nullIfEmpty(e.Author)))
+	if e.Body != "" {
+		lines = append(lines, "", bodyHeader, e.Body)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func nullIfEmpty(s string) string {
+	if s == "" {
+		return "null"
+	}
+	return s
+}
+
+// ParseEntry reads an entry from a knowledge bead.
+func ParseEntry(issue *beads.Issue) *Entry {
+	e := &Entry{ID: issue.ID, Title: issue.Title, Kind: KindNote}
+	if t, err := time.Parse(time.RFC3339, issue.CreatedAt); err == nil {
+		e.CreatedAt = t
+	}
+
+	head, body, _ := strings.Cut(issue.Description, bodyHeader)
+	e.Body = strings.TrimSpace(body)
+	for _, line := range strings.Split(head, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if value == "null" {
+			value = ""
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "kind":
+			if IsKind(value) {
+				e.Kind = value
+			}
+		case "rig":
+			e.Rig = value
+		case "tags":
+			if value != "" {
+				e.Tags = strings.Split(value, ",")
+			}
+		case "author":
+			e.Author = value
+		}
+	}
+	return e
+}
+
+// Store reads and writes knowledge beads.
+type Store struct {
+	bd *beads.Beads
+}
+
+// NewStore returns a store over the town's beads, so every rig shares one
+// knowledge base.
+func NewStore(townRoot string) *Store {
+	return &Store{bd: beads.New(beads.ResolveBeadsDir(townRoot))}
+}
+
+// Add records a learning. An open entry with the same title and rig is
+// returned instead of filing a duplicate; the bool reports whether e was
+// new.
+func (s *Store) Add(e *Entry) (*Entry, bool, error) {
+	if strings.TrimSpace(e.Title) == "" {
+		return nil, false, errors.New("knowledge entry requires a title")
+	}
+	if e.Kind == "" {
+		e.Kind = KindNote
+	}
+	if !IsKind(e.Kind) {
+		return nil, false, fmt.Errorf("invalid kind %q: want one of %s", e.Kind, strings.Join(Kinds, ", "))
+	}
+
+	existing, err := s.List("")
+	if err != nil {
+		return nil, false, err
+	}
+	for _, x := range existing {
+		if x.Rig == e.Rig && strings.EqualFold(x.Title, e.Title) {
+			return x, false, nil
+		}
+	}
+
+	args := []string{"create", "--json",
+		"--title=" + e.Title,
+		"--description=" + FormatDescription(e),
+		"--type=task",
+		"--labels=" + Label,
+		"--labels=kind:" + e.Kind,
+	}
+	if e.Author != "" {
+		args = append(args, "--actor="+e.Author)
+	}
+	out, err := s.bd.Run(args...)
+	if err != nil {
+		return nil, false, err
+	}
+	var issue beads.Issue
+	if err := json.Unmarshal(out, &issue); err != nil {
+		return nil, false, fmt.Errorf("parsing bd create output: %w", err)
+	}
+	e.ID = issue.ID
+	if t, err := time.Parse(time.RFC3339, issue.CreatedAt); err == nil {
+		e.CreatedAt = t
+	} else {
+		e.CreatedAt = time.Now()
+	}
+	return e, true, nil
+}
+
+// List returns open entries, newest first. A non-empty rig limits them to
+// that rig's entries and town-wide ones.
+func (s *Store) List(rig string) ([]*Entry, error) {
+	issues, err := s.bd.List(beads.ListOptions{Label: Label, Status: "open", Priority: -1})
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*Entry, 0, len(issues))
+	for _, issue := range issues {
+		e := ParseEntry(issue)
+		if rig != "" && e.Rig != "" && e.Rig != rig {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.After(entries[j].CreatedAt)
+	})
+	return entries, nil
+}
+
+// Search returns up to limit entries matching query, best first. A
+// non-empty rig limits the search as in List.
+func (s *Store) Search(query, rig string, limit int) ([]Hit, error) {
+	entries, err := s.List(rig)
+	if err != nil {
+		return nil, err
+	}
+	return NewIndex(entries).Search(query, limit), nil
+}
+
+// Forget closes an entry that is wrong or no longer true.
+func (s *Store) Forget(id, reason string) error {
+	issue, err := s.bd.Show(id)
+	if err != nil {
+		return err
+	}
+	if !beads.HasLabel(issue, Label) {
+		return fmt.Errorf("%s is not a knowledge bead (missing %s label)", id, Label)
+	}
+	if reason == "" {
+		reason = "forgotten"
+	}
+	return s.bd.CloseWithReason(reason, id)
+}
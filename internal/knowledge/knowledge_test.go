@@ -0,0 +1,73 @@
+package knowledge
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestEntryRoundTrip(t *testing.T) {
+	e := &Entry{
+		Title:  "Integration tests need the dolt server",
+		Body:   "Run `gt dolt start` first.\n\nOtherwise every test times out after 30s.",
+		Kind:   KindQuirk,
+		Rig:    "gastown",
+		Tags:   []string{"tests", "dolt"},
+		Author: "gastown/polecats/toast",
+	}
+	issue := &beads.Issue{ID: "hq-k1", Title: e.Title, Description: FormatDescription(e), CreatedAt: "2026-10-16T12:00:00Z"}
+
+	got := ParseEntry(issue)
+	want := *e
+	want.ID = "hq-k1"
+	want.CreatedAt = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseEntry() = %+v, want %+v", *got, want)
+	}
+
+	town := ParseEntry(&beads.Issue{ID: "hq-k2", Title: "x", Description: FormatDescription(&Entry{Title: "x", Kind: "bogus"})})
+	if town.Rig != "" || town.Tags != nil || town.Kind != KindNote {
+		t.Errorf("null fields and bad kind: %+v", town)
+	}
+}
+
+func TestIndexSearch(t *testing.T) {
+	entries := []*Entry{
+		{ID: "k1", Title: "Integration tests need the dolt server", Body: "Run gt dolt start first.", Tags: []string{"tests"}},
+		{ID: "k2", Title: "Use --no-verify for WIP commits on polecat branches", Kind: KindCommand},
+		{ID: "k3", Title: "We chose BM25 over embeddings", Body: "No model dependency; tests stay offline.", Kind: KindDecision},
+	}
+	idx := NewIndex(entries)
+
+	hits := idx.Search("why do the tests hang? dolt", 0)
+	if len(hits) != 2 || hits[0].Entry.ID != "k1" || hits[1].Entry.ID != "k3" {
+		t.Errorf("Search() = %v, want k1 then k3", hitIDs(hits))
+	}
+	if hits := idx.Search("--no-verify", 0); len(hits) != 1 || hits[0].Entry.ID != "k2" {
+		t.Errorf("flag search = %v, want k2", hitIDs(hits))
+	}
+	if hits := idx.Search("the and of", 0); len(hits) != 0 {
+		t.Errorf("stopword search = %v, want none", hitIDs(hits))
+	}
+	if hits := idx.Search("tests", 1); len(hits) != 1 {
+		t.Errorf("limit 1 returned %d hits", len(hits))
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	got := Tokenize("The `bd_actor` env var; use --no-verify on Tests!")
+	want := []string{"bd_actor", "env", "var", "no-verify", "test"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokenize() = %q, want %q", got, want)
+	}
+}
+
+func hitIDs(hits []Hit) []string {
+	var ids []string
+	for _, h := range hits {
+		ids = append(ids, h.Entry.ID)
+	}
+	return ids
+}
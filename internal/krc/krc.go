@@ -45,6 +45,11 @@ type Config struct {
 	// MinRetainCount keeps at least N events even if expired (for debugging).
 	// Default: 100
 	MinRetainCount int `json:"min_retain_count"`
+
+	// Rotate controls rotation of .events.jsonl into gzipped archive
+	// segments. Runs after each prune.
+	// Default: daily or at 32 MiB, keeping 90 segments
+	Rotate events.RotatePolicy `json:"rotate"`
 }
 
 // DefaultConfig returns the default KRC configuration.
@@ -53,6 +58,7 @@ func DefaultConfig() *Config {
 		DefaultTTL:    7 * 24 * time.Hour, // 7 days
 		PruneInterval: 1 * time.Hour,
 		MinRetainCount: 100,
+		Rotate:         events.DefaultRotatePolicy(),
 		TTLs: map[string]time.Duration{
 			// Patrol events decay fastest - low forensic value after hours
 			"patrol_*":       24 * time.Hour,  // 1 day
@@ -316,6 +322,12 @@ func (p *Pruner) pruneFile(filePath string) (*PruneResult, error) {
 		result.EventsRetained = len(retained)
 	}
 
+	// Nothing expired: leave the file alone so tailers keep their handle
+	if result.EventsPruned == 0 {
+		result.BytesAfter = result.BytesBefore
+		return result, nil
+	}
+
 	// Write retained events
 	for _, line := range retained {
 		if _, err := tmpFile.WriteString(line + "\n"); err != nil {
@@ -215,6 +215,30 @@ func TestPruner_Prune(t *testing.T) {
 	}
 }
 
+func TestPruner_NothingExpiredKeepsFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	eventsPath := filepath.Join(tmpDir, ".events.jsonl")
+	line := `{"ts":"` + time.Now().UTC().Format(time.RFC3339) + `","type":"sling","actor":"mayor"}` + "\n"
+	if err := os.WriteFile(eventsPath, []byte(line), 0644); err != nil {
+		t.Fatal(err)
+	}
+	before, _ := os.Stat(eventsPath)
+
+	result, err := NewPruner(tmpDir, DefaultConfig()).Prune()
+	if err != nil {
+		t.Fatalf("Prune failed: %v", err)
+	}
+	if result.EventsPruned != 0 || result.BytesAfter != result.BytesBefore {
+		t.Errorf("result = %+v", result)
+	}
+
+	// Tailers hold the file open; an unchanged log must not be replaced.
+	after, _ := os.Stat(eventsPath)
+	if !os.SameFile(before, after) {
+		t.Error("events file was rewritten although nothing expired")
+	}
+}
+
 func TestGetStats(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "krc-test-*")
 	if err != nil {
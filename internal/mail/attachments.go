@@ -0,0 +1,245 @@
+package mail
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Attachment size limits. Inline attachments are stored base64-encoded in
+// the message bead's description, so they are kept small; anything larger
+// should be sent as a file reference.
+const (
+	// MaxInlineAttachmentSize caps the decoded size of one inline attachment.
+	MaxInlineAttachmentSize = 48 << 10
+
+	// MaxInlineAttachmentsTotal caps the decoded size of all inline
+	// attachments on a message.
+	MaxInlineAttachmentsTotal = 64 << 10
+
+	// MaxAttachments caps the number of attachments on a message.
+	MaxAttachments = 10
+)
+
+// ErrAttachmentTooLarge is returned when an attachment exceeds a size limit.
+var ErrAttachmentTooLarge = errors.New("attachment too large")
+
+// Attachment is a file sent along with a message, either inline (Data) or
+// as a reference to a file on the town's filesystem (Path). Exactly one of
+// Data and Path is set.
+type Attachment struct {
+	// Name is the file name shown to the recipient.
+	Name string `json:"name"`
+
+	// ContentType is the MIME type (e.g., "text/x-diff", "image/png").
+	ContentType string `json:"content_type,omitempty"`
+
+	// Size is the content size in bytes.
+	Size int64 `json:"size"`
+
+	// Path is the absolute path of a referenced file.
+	Path string `json:"path,omitempty"`
+
+	// Data is the base64-encoded content of an inline attachment.
+	Data string `json:"data,omitempty"`
+}
+
+// NewInlineAttachment creates an inline attachment from content.
+func NewInlineAttachment(name string, content []byte) (Attachment, error) {
+	if len(content) > MaxInlineAttachmentSize {
+		return Attachment{}, fmt.Errorf("%w: %s is %d bytes, inline limit is %d (attach it by path instead)",
+			ErrAttachmentTooLarge, name, len(content), MaxInlineAttachmentSize)
+	}
+	return Attachment{
+		Name:        filepath.Base(name),
+		ContentType: detectContentType(name, content),
+		Size:        int64(len(content)),
+		Data:        base64.StdEncoding.EncodeToString(content),
+	}, nil
+}
+
+// NewFileAttachment creates an attachment referencing the file at path.
+// The file is not copied, so it must stay in place for the recipient.
+func NewFileAttachment(path string) (Attachment, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return Attachment{}, fmt.Errorf("resolving %s: %w", path, err)
+	}
+	info, err := os.Stat(abs)
+	if err != nil {
+		return Attachment{}, fmt.Errorf("attaching %s: %w", path, err)
+	}
+	if info.IsDir() {
+		return Attachment{}, fmt.Errorf("attaching %s: is a directory", path)
+	}
+
+	// Sniff the type from the first bytes only.
+	head := make([]byte, 512)
+	if f, err := os.Open(abs); err == nil {
+		n, _ := f.Read(head)
+		head = head[:n]
+		_ = f.Close()
+	}
+	return Attachment{
+		Name:        filepath.Base(abs),
+		ContentType: detectContentType(abs, head),
+		Size:        info.Size(),
+		Path:        abs,
+	}, nil
+}
+
+// AttachmentsDir is the only directory remote callers (the RPC server) may
+// attach files from by reference, so they can't probe or point recipients
+// at arbitrary host paths.
+func AttachmentsDir(townRoot string) string {
+	return filepath.Join(townRoot, ".runtime", "attachments")
+}
+
+// NewFileAttachmentIn is NewFileAttachment for a path that must lie inside
+// dir, after resolving symlinks. Paths outside dir are rejected before the
+// filesystem is touched.
+func NewFileAttachmentIn(dir, path string) (Attachment, error) {
+	outside := fmt.Errorf("attachment path must be under %s", dir)
+	if !filepath.IsAbs(path) || !within(dir, filepath.Clean(path)) {
+		return Attachment{}, outside
+	}
+	resolvedDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return Attachment{}, outside
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return Attachment{}, fmt.Errorf("attaching %s: %w", path, err)
+	}
+	if !within(resolvedDir, resolved) {
+		return Attachment{}, outside
+	}
+	return NewFileAttachment(resolved)
+}
+
+// within reports whether path is dir or lies under it.
+func within(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// IsInline reports whether the attachment content is stored in the message.
+func (a Attachment) IsInline() bool {
+	return a.Path == ""
+}
+
+// Content returns the attachment's bytes, decoding inline data or reading
+// the referenced file.
+func (a Attachment) Content() ([]byte, error) {
+	if a.IsInline() {
+		data, err := base64.StdEncoding.DecodeString(a.Data)
+		if err != nil {
+			return nil, fmt.Errorf("decoding attachment %s: %w", a.Name, err)
+		}
+		return data, nil
+	}
+	data, err := os.ReadFile(a.Path)
+	if err != nil {
+		return nil, fmt.Errorf("reading attachment %s: %w", a.Name, err)
+	}
+	return data, nil
+}
+
+// ValidateAttachments checks attachment count, shape and inline size limits.
+func ValidateAttachments(attachments []Attachment) error {
+	if len(attachments) > MaxAttachments {
+		return fmt.Errorf("too many attachments: %d (max %d)", len(attachments), MaxAttachments)
+	}
+	var inline int
+	for _, a := range attachments {
+		if a.Name == "" {
+			return errors.New("attachment name is required")
+		}
+		if (a.Path == "") == (a.Data == "") {
+			return fmt.Errorf("attachment %s: exactly one of path or data must be set", a.Name)
+		}
+		if a.Path != "" {
+			if !filepath.IsAbs(a.Path) {
+				return fmt.Errorf("attachment %s: path must be absolute", a.Name)
+			}
+			continue
+		}
+		data, err := base64.StdEncoding.DecodeString(a.Data)
+		if err != nil {
+			return fmt.Errorf("attachment %s: invalid base64 data: %w", a.Name, err)
+		}
+		if len(data) > MaxInlineAttachmentSize {
+			return fmt.Errorf("%w: %s is %d bytes, inline limit is %d", ErrAttachmentTooLarge, a.Name, len(data), MaxInlineAttachmentSize)
+		}
+		inline += len(data)
+	}
+	if inline > MaxInlineAttachmentsTotal {
+		return fmt.Errorf("%w: inline attachments total %d bytes, limit is %d", ErrAttachmentTooLarge, inline, MaxInlineAttachmentsTotal)
+	}
+	return nil
+}
+
+// detectContentType picks a MIME type from the file extension, falling
+// back to sniffing the content. Diffs and logs are pinned because system
+// MIME tables disagree about them.
+func detectContentType(name string, head []byte) string {
+	switch filepath.Ext(name) {
+	case ".diff", ".patch":
+		return "text/x-diff"
+	case ".log":
+		return "text/plain; charset=utf-8"
+	}
+	if t := mime.TypeByExtension(filepath.Ext(name)); t != "" {
+		return t
+	}
+	return http.DetectContentType(head)
+}
+
+// attachmentMarker prefixes the attachment trailer stored at the end of a
+// message bead's description. json.Marshal escapes '>' so the encoded
+// list can never close the comment early.
+const attachmentMarker = "<!-- gt:attachments "
+
+// beadsDescription returns the bead description for msg: the body, with
+// any attachments appended as a trailer that ToMessage strips again. A body
+// that itself contains the marker always gets a trailer, empty if need be,
+// so a trailer forged in the body is never the one that is parsed.
+func beadsDescription(msg *Message) string {
+	if len(msg.Attachments) == 0 && !strings.Contains(msg.Body, attachmentMarker) {
+		return msg.Body
+	}
+	data, err := json.Marshal(msg.Attachments)
+	if err != nil {
+		return msg.Body
+	}
+	return msg.Body + "\n\n" + attachmentMarker + string(data) + " -->"
+}
+
+// splitAttachments separates a bead description into the message body and
+// its attachments. Only the final trailer, which beadsDescription writes, is
+// parsed. Descriptions without a valid trailer, or whose attachments fail
+// ValidateAttachments, are returned whole.
+func splitAttachments(desc string) (string, []Attachment) {
+	idx := strings.LastIndex(desc, attachmentMarker)
+	if idx < 0 || !strings.HasSuffix(desc, " -->") {
+		return desc, nil
+	}
+	payload := strings.TrimSuffix(desc[idx+len(attachmentMarker):], " -->")
+	var attachments []Attachment
+	if err := json.Unmarshal([]byte(payload), &attachments); err != nil {
+		return desc, nil
+	}
+	if err := ValidateAttachments(attachments); err != nil {
+		return desc, nil
+	}
+	if len(attachments) == 0 {
+		attachments = nil
+	}
+	return strings.TrimSuffix(desc[:idx], "\n\n"), attachments
+}
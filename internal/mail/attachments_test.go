@@ -0,0 +1,153 @@
+package mail
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAttachmentsRoundTripThroughBeads(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "build.log")
+	if err := os.WriteFile(logPath, []byte("FAIL: TestParser\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ref, err := NewFileAttachment(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff := []byte("--- a/x.go\n+++ b/x.go\n@@ -1 +1 @@\n-<old>\n+<!-- new -->\n")
+	inline, err := NewInlineAttachment("fix.diff", diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := &Message{Body: "See attached.", Attachments: []Attachment{ref, inline}}
+	bm := &BeadsMessage{Description: beadsDescription(msg)}
+	got := bm.ToMessage()
+
+	if got.Body != "See attached." {
+		t.Errorf("Body = %q, want attachment trailer stripped", got.Body)
+	}
+	if len(got.Attachments) != 2 {
+		t.Fatalf("Attachments = %+v", got.Attachments)
+	}
+	if a := got.Attachments[0]; a.IsInline() || a.Path != logPath || a.Size != 17 || a.Name != "build.log" {
+		t.Errorf("file attachment = %+v", a)
+	}
+	a := got.Attachments[1]
+	if !a.IsInline() || a.ContentType != "text/x-diff" {
+		t.Errorf("inline attachment = %+v", a)
+	}
+	if content, err := a.Content(); err != nil || !bytes.Equal(content, diff) {
+		t.Errorf("Content() = %q, %v", content, err)
+	}
+}
+
+func TestSplitAttachmentsPlainBody(t *testing.T) {
+	for _, desc := range []string{
+		"",
+		"no attachments here",
+		"mentions <!-- gt:attachments in passing",
+		"broken <!-- gt:attachments [not json] -->",
+	} {
+		body, attachments := splitAttachments(desc)
+		if body != desc || attachments != nil {
+			t.Errorf("splitAttachments(%q) = %q, %v", desc, body, attachments)
+		}
+	}
+}
+
+func TestForgedTrailerInBodyIsNotParsed(t *testing.T) {
+	forged := "hi\n\n" + attachmentMarker + `[{"name":"passwd","size":1,"path":"/etc/passwd"}] -->`
+	got := (&BeadsMessage{Description: beadsDescription(&Message{Body: forged})}).ToMessage()
+	if got.Body != forged || got.Attachments != nil {
+		t.Errorf("forged trailer parsed: body %q, attachments %+v", got.Body, got.Attachments)
+	}
+
+	// A trailer that fails validation isn't stripped or parsed either
+	invalid := "x\n\n" + attachmentMarker + `[{"name":"x","path":"relative.log"}] -->`
+	if body, attachments := splitAttachments(invalid); body != invalid || attachments != nil {
+		t.Errorf("invalid trailer = %q, %+v", body, attachments)
+	}
+}
+
+func TestNewFileAttachmentIn(t *testing.T) {
+	townRoot := t.TempDir()
+	dir := AttachmentsDir(townRoot)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	inside := filepath.Join(dir, "build.log")
+	if err := os.WriteFile(inside, []byte("ok\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	secret := filepath.Join(townRoot, "secret.txt")
+	if err := os.WriteFile(secret, []byte("key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(secret, filepath.Join(dir, "link.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	if a, err := NewFileAttachmentIn(dir, inside); err != nil || a.Path != inside {
+		t.Errorf("NewFileAttachmentIn(inside) = %+v, %v", a, err)
+	}
+	for _, path := range []string{
+		secret,
+		filepath.Join(dir, "..", "secret.txt"),
+		filepath.Join(dir, "link.txt"),
+		"/etc/does-not-exist",
+		"build.log",
+	} {
+		_, err := NewFileAttachmentIn(dir, path)
+		if err == nil || !strings.Contains(err.Error(), "must be under") {
+			t.Errorf("NewFileAttachmentIn(%s) err = %v, want outside the attachments dir", path, err)
+		}
+	}
+}
+
+func TestAttachmentLimits(t *testing.T) {
+	if _, err := NewInlineAttachment("big.png", make([]byte, MaxInlineAttachmentSize+1)); !errors.Is(err, ErrAttachmentTooLarge) {
+		t.Errorf("oversized inline attachment: err = %v", err)
+	}
+
+	half, err := NewInlineAttachment("a.bin", make([]byte, MaxInlineAttachmentsTotal/2+1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateAttachments([]Attachment{half, half}); !errors.Is(err, ErrAttachmentTooLarge) {
+		t.Errorf("inline total over limit: err = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		attachment Attachment
+		wantErr    string
+	}{
+		{"no name", Attachment{Data: "eA=="}, "name is required"},
+		{"neither", Attachment{Name: "x"}, "exactly one"},
+		{"both", Attachment{Name: "x", Path: "/tmp/x", Data: "eA=="}, "exactly one"},
+		{"relative path", Attachment{Name: "x", Path: "x.log"}, "absolute"},
+		{"bad base64", Attachment{Name: "x", Data: "not base64!"}, "invalid base64"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAttachments([]Attachment{tt.attachment})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	many := make([]Attachment, MaxAttachments+1)
+	for i := range many {
+		many[i] = Attachment{Name: "x", Path: "/tmp/x"}
+	}
+	if err := ValidateAttachments(many); err == nil {
+		t.Error("expected error for too many attachments")
+	}
+}
@@ -0,0 +1,163 @@
+package mail
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListOptions filters and paginates a mailbox listing.
+// The zero value returns every open message, newest first.
+type ListOptions struct {
+	// UnreadOnly skips messages that have been read.
+	UnreadOnly bool
+
+	// From keeps only messages from this sender. A value ending in "/"
+	// matches every sender under that prefix (e.g., "gastown/").
+	From string
+
+	// MinPriority keeps only messages at or above this priority.
+	MinPriority Priority
+
+	// Since keeps only messages sent after this time.
+	Since time.Time
+
+	// Until keeps only messages sent at or before this time.
+	Until time.Time
+
+	// Cursor resumes a listing after the last message of a previous page.
+	Cursor string
+
+	// Limit caps the page size (0 = no limit).
+	Limit int
+}
+
+// ListPage is one page of a filtered mailbox listing.
+type ListPage struct {
+	// Messages on this page, newest first.
+	Messages []*Message
+
+	// Matched is the number of messages matching the filters across all pages.
+	Matched int
+
+	// NextCursor resumes the listing after this page. Empty on the last page.
+	NextCursor string
+}
+
+// ListPage returns one page of the mailbox filtered by opts.
+func (m *Mailbox) ListPage(opts ListOptions) (*ListPage, error) {
+	messages, err := m.List()
+	if err != nil {
+		return nil, err
+	}
+	return PageMessages(messages, opts)
+}
+
+// PageMessages applies opts to messages and returns the requested page.
+// Messages are ordered newest first with ID as a tie-breaker, and cursors
+// encode the position of the last message returned, so pages stay stable
+// while new mail arrives.
+func PageMessages(messages []*Message, opts ListOptions) (*ListPage, error) {
+	var after *pageCursor
+	if opts.Cursor != "" {
+		c, err := decodeCursor(opts.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = &c
+	}
+
+	var matched []*Message
+	for _, msg := range messages {
+		if opts.matches(msg) {
+			matched = append(matched, msg)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return pageLess(matched[i], matched[j])
+	})
+
+	page := &ListPage{Matched: len(matched)}
+	start := 0
+	if after != nil {
+		start = sort.Search(len(matched), func(i int) bool {
+			return after.before(matched[i])
+		})
+	}
+	end := len(matched)
+	if opts.Limit > 0 && start+opts.Limit < end {
+		end = start + opts.Limit
+		page.NextCursor = encodeCursor(matched[end-1])
+	}
+	page.Messages = matched[start:end]
+	return page, nil
+}
+
+func (o ListOptions) matches(msg *Message) bool {
+	if o.UnreadOnly && msg.Read {
+		return false
+	}
+	if o.From != "" {
+		if strings.HasSuffix(o.From, "/") {
+			if !strings.HasPrefix(msg.From, o.From) && msg.From != strings.TrimSuffix(o.From, "/") {
+				return false
+			}
+		} else if msg.From != o.From {
+			return false
+		}
+	}
+	if o.MinPriority != "" && PriorityToBeads(msg.Priority) > PriorityToBeads(o.MinPriority) {
+		return false
+	}
+	if !o.Since.IsZero() && !msg.Timestamp.After(o.Since) {
+		return false
+	}
+	if !o.Until.IsZero() && msg.Timestamp.After(o.Until) {
+		return false
+	}
+	return true
+}
+
+// pageLess orders messages newest first, breaking ties by descending ID.
+func pageLess(a, b *Message) bool {
+	if !a.Timestamp.Equal(b.Timestamp) {
+		return a.Timestamp.After(b.Timestamp)
+	}
+	return a.ID > b.ID
+}
+
+// pageCursor is the position of the last message on a page.
+type pageCursor struct {
+	ts time.Time
+	id string
+}
+
+// before reports whether the cursor position sorts before msg, i.e. msg
+// belongs on a later page.
+func (c pageCursor) before(msg *Message) bool {
+	return pageLess(&Message{Timestamp: c.ts, ID: c.id}, msg)
+}
+
+func encodeCursor(msg *Message) string {
+	raw := strconv.FormatInt(msg.Timestamp.UnixNano(), 10) + ":" + msg.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (pageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return pageCursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+	ts, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return pageCursor{}, fmt.Errorf("invalid cursor: %q", cursor)
+	}
+	nanos, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return pageCursor{}, fmt.Errorf("invalid cursor timestamp: %w", err)
+	}
+	return pageCursor{ts: time.Unix(0, nanos), id: id}, nil
+}
@@ -0,0 +1,115 @@
+package mail
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func pageFixture() []*Message {
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var msgs []*Message
+	for i := 0; i < 10; i++ {
+		msgs = append(msgs, &Message{
+			ID:        fmt.Sprintf("hq-%02d", i),
+			From:      "gastown/polecats/furiosa",
+			Subject:   fmt.Sprintf("msg %d", i),
+			Timestamp: base.Add(time.Duration(i) * time.Minute),
+			Priority:  PriorityNormal,
+			Read:      i%2 == 0,
+		})
+	}
+	msgs[3].From = "mayor/"
+	msgs[7].Priority = PriorityUrgent
+	msgs[8].Priority = PriorityHigh
+	return msgs
+}
+
+func ids(msgs []*Message) []string {
+	var out []string
+	for _, m := range msgs {
+		out = append(out, m.ID)
+	}
+	return out
+}
+
+func TestPageMessages_CursorWalk(t *testing.T) {
+	msgs := pageFixture()
+
+	var seen []string
+	cursor := ""
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("pagination did not terminate")
+		}
+		page, err := PageMessages(msgs, ListOptions{Limit: 4, Cursor: cursor})
+		if err != nil {
+			t.Fatalf("PageMessages: %v", err)
+		}
+		if page.Matched != 10 {
+			t.Errorf("Matched = %d, want 10", page.Matched)
+		}
+		seen = append(seen, ids(page.Messages)...)
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	want := []string{"hq-09", "hq-08", "hq-07", "hq-06", "hq-05", "hq-04", "hq-03", "hq-02", "hq-01", "hq-00"}
+	if fmt.Sprint(seen) != fmt.Sprint(want) {
+		t.Errorf("walked %v, want %v", seen, want)
+	}
+}
+
+func TestPageMessages_StableWhenNewMailArrives(t *testing.T) {
+	msgs := pageFixture()
+	page, err := PageMessages(msgs, ListOptions{Limit: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newer := &Message{ID: "hq-99", Timestamp: msgs[9].Timestamp.Add(time.Hour)}
+	next, err := PageMessages(append(msgs, newer), ListOptions{Limit: 3, Cursor: page.NextCursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ids(next.Messages); fmt.Sprint(got) != "[hq-06 hq-05 hq-04]" {
+		t.Errorf("second page = %v, want [hq-06 hq-05 hq-04]", got)
+	}
+}
+
+func TestPageMessages_Filters(t *testing.T) {
+	msgs := pageFixture()
+	base := msgs[0].Timestamp
+
+	tests := []struct {
+		name string
+		opts ListOptions
+		want string
+	}{
+		{"unread", ListOptions{UnreadOnly: true}, "[hq-09 hq-07 hq-05 hq-03 hq-01]"},
+		{"from exact", ListOptions{From: "mayor/"}, "[hq-03]"},
+		{"from prefix", ListOptions{From: "gastown/", Limit: 2}, "[hq-09 hq-08]"},
+		{"min priority", ListOptions{MinPriority: PriorityHigh}, "[hq-08 hq-07]"},
+		{"since", ListOptions{Since: base.Add(7 * time.Minute)}, "[hq-09 hq-08]"},
+		{"until", ListOptions{Until: base.Add(time.Minute)}, "[hq-01 hq-00]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := PageMessages(msgs, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := fmt.Sprint(ids(page.Messages)); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageMessages_InvalidCursor(t *testing.T) {
+	if _, err := PageMessages(pageFixture(), ListOptions{Cursor: "!!not-base64"}); err == nil {
+		t.Error("expected error for invalid cursor")
+	}
+}
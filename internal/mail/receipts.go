@@ -0,0 +1,74 @@
+package mail
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+// Receipt selects what a sender is told about a direct message's progress.
+type Receipt string
+
+const (
+	// ReceiptNone sends no receipts (default).
+	ReceiptNone Receipt = ""
+
+	// ReceiptEvent records delivery and read events in the activity log.
+	ReceiptEvent Receipt = "event"
+
+	// ReceiptMail records the events and also mails a read notification
+	// back to the sender.
+	ReceiptMail Receipt = "mail"
+)
+
+// ParseReceipt parses a receipt mode name ("", "none", "event", "mail").
+func ParseReceipt(s string) (Receipt, error) {
+	switch Receipt(s) {
+	case ReceiptNone, "none":
+		return ReceiptNone, nil
+	case ReceiptEvent, ReceiptMail:
+		return Receipt(s), nil
+	}
+	return ReceiptNone, fmt.Errorf("invalid receipt mode %q (want event or mail)", s)
+}
+
+// recordDelivery logs the delivery event for a message that requested a
+// receipt. Receipts apply to direct messages only.
+func recordDelivery(msg *Message) {
+	if msg.Receipt == ReceiptNone {
+		return
+	}
+	_ = events.LogAudit(events.TypeMailDelivered, msg.To,
+		events.MailReceiptPayload(msg.ID, msg.From, msg.To, msg.Subject))
+}
+
+// AcknowledgeRead sends the read receipt for msg, which reader has just
+// marked read. msg must reflect the state before it was marked, so that
+// re-reading an already read message does not notify the sender again.
+// Messages without a receipt request are ignored.
+func (r *Router) AcknowledgeRead(msg *Message, reader string) error {
+	if msg.Receipt == ReceiptNone || msg.Read {
+		return nil
+	}
+	_ = events.LogFeed(events.TypeMailRead, reader,
+		events.MailReceiptPayload(msg.ID, msg.From, msg.To, msg.Subject))
+
+	if msg.Receipt != ReceiptMail || msg.From == "" || isSelfMail(msg.From, reader) {
+		return nil
+	}
+	receipt := &Message{
+		From:     reader,
+		To:       msg.From,
+		Subject:  "Read: " + msg.Subject,
+		Body:     fmt.Sprintf("%s read your message %s.", reader, msg.ID),
+		Priority: PriorityLow,
+		Type:     TypeNotification,
+		ThreadID: msg.ThreadID,
+		ReplyTo:  msg.ID,
+		Wisp:     true,
+	}
+	if err := r.Send(receipt); err != nil {
+		return fmt.Errorf("sending read receipt for %s: %w", msg.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package mail
+
+import "testing"
+
+func TestParseReceipt(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Receipt
+		wantErr bool
+	}{
+		{"", ReceiptNone, false},
+		{"none", ReceiptNone, false},
+		{"event", ReceiptEvent, false},
+		{"mail", ReceiptMail, false},
+		{"sms", ReceiptNone, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseReceipt(tt.input)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseReceipt(%q) = %q, %v; want %q, err=%v", tt.input, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestBeadsMessageToMessageReceipt(t *testing.T) {
+	bm := &BeadsMessage{
+		ID:     "hq-msg-1",
+		Labels: []string{"from:gastown/Toast", "receipt:mail"},
+	}
+	if got := bm.ToMessage().Receipt; got != ReceiptMail {
+		t.Errorf("Receipt = %q, want %q", got, ReceiptMail)
+	}
+}
+
+func TestAcknowledgeReadSkipsWithoutRequest(t *testing.T) {
+	// Neither case may touch beads or the event log.
+	r := NewRouterWithTownRoot(t.TempDir(), "")
+	for _, msg := range []*Message{
+		{ID: "hq-msg-1", From: "gastown/Toast", Subject: "no receipt"},
+		{ID: "hq-msg-2", From: "gastown/Toast", Subject: "already read", Receipt: ReceiptMail, Read: true},
+	} {
+		if err := r.AcknowledgeRead(msg, "overseer"); err != nil {
+			t.Errorf("%s: %v", msg.Subject, err)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/configbeads"
+	"github.com/steveyegge/gastown/internal/metrics"
 	"github.com/steveyegge/gastown/internal/terminal"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -650,33 +651,58 @@ func (r *Router) shouldBeWisp(msg *Message) bool {
 // - Queues (queue:name) - stores single message for worker claiming
 // - Announces (announce:name) - bulletin board, no claiming, retention-limited
 func (r *Router) Send(msg *Message) error {
-	// Check for mailing list address
-	if isListAddress(msg.To) {
-		return r.sendToList(msg)
-	}
-
-	// Check for queue address - single message for claiming
-	if isQueueAddress(msg.To) {
-		return r.sendToQueue(msg)
-	}
-
-	// Check for announce address - bulletin board (single copy, no claiming)
-	if isAnnounceAddress(msg.To) {
-		return r.sendToAnnounce(msg)
+	if err := ValidateAttachments(msg.Attachments); err != nil {
+		return err
 	}
-
-	// Check for beads-native channel address - broadcast with retention
-	if isChannelAddress(msg.To) {
-		return r.sendToChannel(msg)
+	r.assignThread(msg)
+
+	kind := addressKind(msg.To)
+	var err error
+	switch kind {
+	case "list":
+		err = r.sendToList(msg)
+	case "queue":
+		// Single message for claiming
+		err = r.sendToQueue(msg)
+	case "announce":
+		// Bulletin board (single copy, no claiming)
+		err = r.sendToAnnounce(msg)
+	case "channel":
+		// Beads-native channel - broadcast with retention
+		err = r.sendToChannel(msg)
+	case "group":
+		// Resolve and fan-out
+		err = r.sendToGroup(msg)
+	default:
+		err = r.sendToSingle(msg)
 	}
 
-	// Check for @group address - resolve and fan-out
-	if isGroupAddress(msg.To) {
-		return r.sendToGroup(msg)
+	result := "ok"
+	if err != nil {
+		result = "error"
 	}
+	mailSent.Inc(kind, result)
+	return err
+}
 
-	// Single recipient - send directly
-	return r.sendToSingle(msg)
+var mailSent = metrics.NewCounter("gt_mail_messages_sent_total",
+	"Messages sent through the mail router, by address kind and result.", "kind", "result")
+
+// addressKind classifies a recipient address for routing and metrics.
+func addressKind(to string) string {
+	switch {
+	case isListAddress(to):
+		return "list"
+	case isQueueAddress(to):
+		return "queue"
+	case isAnnounceAddress(to):
+		return "announce"
+	case isChannelAddress(to):
+		return "channel"
+	case isGroupAddress(to):
+		return "group"
+	}
+	return "direct"
 }
 
 // sendToGroup resolves a @group address and sends individual messages to each member.
@@ -795,12 +821,15 @@ func (r *Router) sendToSingle(msg *Message) error {
 	if msg.PreRead {
 		labels = append(labels, "read")
 	}
+	if msg.Receipt != ReceiptNone {
+		labels = append(labels, "receipt:"+string(msg.Receipt))
+	}
 
 	// Build command: bd create <subject> --type=message --assignee=<recipient> -d <body>
 	args := []string{"create", msg.Subject,
 		"--type", "message",
 		"--assignee", toIdentity,
-		"-d", msg.Body,
+		"-d", beadsDescription(msg),
 	}
 
 	// Add priority flag
@@ -828,6 +857,7 @@ func (r *Router) sendToSingle(msg *Message) error {
 	if err != nil {
 		return fmt.Errorf("sending message: %w", err)
 	}
+	recordDelivery(msg)
 
 	// Notify recipient if they have an active session (best-effort notification)
 	// Skip notification for self-mail (handoffs to future-self don't need present-self notified)
@@ -915,7 +945,7 @@ func (r *Router) sendToQueue(msg *Message) error {
 	args := []string{"create", msg.Subject,
 		"--type", "message",
 		"--assignee", msg.To, // queue:name
-		"-d", msg.Body,
+		"-d", beadsDescription(msg),
 	}
 
 	// Add priority flag
@@ -989,7 +1019,7 @@ func (r *Router) sendToAnnounce(msg *Message) error {
 	args := []string{"create", msg.Subject,
 		"--type", "message",
 		"--assignee", msg.To, // announce:name
-		"-d", msg.Body,
+		"-d", beadsDescription(msg),
 	}
 
 	// Add priority flag
@@ -1065,7 +1095,7 @@ func (r *Router) sendToChannel(msg *Message) error {
 	args := []string{"create", msg.Subject,
 		"--type", "message",
 		"--assignee", msg.To, // channel:name
-		"-d", msg.Body,
+		"-d", beadsDescription(msg),
 	}
 
 	// Add priority flag
@@ -1188,6 +1218,12 @@ func (r *Router) GetMailbox(address string) (*Mailbox, error) {
 	return NewMailboxWithTownRoot(address, workDir, beadsDir, r.townRoot), nil
 }
 
+// OverseerNotifier, if set, is told about mail delivered to the overseer so
+// the notification dispatcher can reach them on the channels their
+// notification preferences choose. The notify package sets it; mail cannot
+// import the dispatcher, which sends mail itself.
+var OverseerNotifier func(townRoot string, msg *Message)
+
 // notifyRecipient sends a notification to a recipient's Coop session.
 // Uses terminal.ResolveBackend to discover the recipient's Coop sidecar from bead
 // metadata (coop_url, pod_name). Works in both local and K8s modes.
@@ -1197,14 +1233,25 @@ func (r *Router) notifyRecipient(msg *Message) error {
 	if target == "" {
 		return nil
 	}
+	if target == "overseer" {
+		// The overseer is a human with no session to nudge.
+		if OverseerNotifier != nil && r.townRoot != "" {
+			OverseerNotifier(r.townRoot, msg)
+		}
+		return nil
+	}
 
 	notification := fmt.Sprintf("📬 You have new mail from %s. Subject: %s. Run 'gt mail inbox' to read.", msg.From, msg.Subject)
 
 	// Resolve backend from agent bead metadata (discovers Coop URL in K8s)
 	resolved := terminal.ResolveBackend(target)
-	if b, ok := resolved.(*terminal.CoopBackend); ok {
+	switch b := resolved.(type) {
+	case *terminal.CoopBackend:
 		// Coop agent: session name is always "claude" for Coop agents
 		return b.NudgeSession("claude", notification)
+	case *terminal.DockerBackend:
+		// ResolveBackend registers container agents as "claude" too
+		return b.NudgeSession("claude", notification)
 	}
 
 	return nil // No active session found
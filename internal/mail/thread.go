@@ -0,0 +1,106 @@
+package mail
+
+import (
+	"path/filepath"
+	"sort"
+)
+
+// ThreadEntry is a message placed in its conversation. Depth counts reply
+// hops from the message that started the thread (0 for the first message).
+type ThreadEntry struct {
+	*Message
+	Depth int `json:"depth"`
+}
+
+// OrderThread arranges the messages of a thread as a conversation: each
+// reply follows the message it answers, and replies to the same message
+// appear oldest first. Messages whose parent is not in the thread start a
+// new branch at depth 0.
+func OrderThread(messages []*Message) []ThreadEntry {
+	byID := make(map[string]bool, len(messages))
+	for _, msg := range messages {
+		byID[msg.ID] = true
+	}
+
+	sorted := make([]*Message, len(messages))
+	copy(sorted, messages)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	children := make(map[string][]*Message)
+	var roots []*Message
+	for _, msg := range sorted {
+		if msg.ReplyTo != "" && msg.ReplyTo != msg.ID && byID[msg.ReplyTo] {
+			children[msg.ReplyTo] = append(children[msg.ReplyTo], msg)
+		} else {
+			roots = append(roots, msg)
+		}
+	}
+
+	entries := make([]ThreadEntry, 0, len(messages))
+	visited := make(map[string]bool, len(messages))
+	var walk func(msg *Message, depth int)
+	walk = func(msg *Message, depth int) {
+		if visited[msg.ID] {
+			return // reply cycle in corrupt data
+		}
+		visited[msg.ID] = true
+		entries = append(entries, ThreadEntry{Message: msg, Depth: depth})
+		for _, child := range children[msg.ID] {
+			walk(child, depth+1)
+		}
+	}
+	for _, root := range roots {
+		walk(root, 0)
+	}
+	// Anything left is part of a reply cycle; keep it visible at the top level.
+	for _, msg := range sorted {
+		if !visited[msg.ID] {
+			walk(msg, 0)
+		}
+	}
+	return entries
+}
+
+// GetThread returns the conversation for threadID in reply order.
+func (r *Router) GetThread(threadID string) ([]ThreadEntry, error) {
+	messages, err := r.townMailbox().ListByThread(threadID)
+	if err != nil {
+		return nil, err
+	}
+	return OrderThread(messages), nil
+}
+
+// assignThread gives msg a thread ID. A reply joins the thread of the
+// message it answers, so callers that only know the reply-to ID (RPC, web)
+// still produce threaded conversations; anything else starts a new thread.
+func (r *Router) assignThread(msg *Message) {
+	if msg.ThreadID != "" {
+		return
+	}
+	if msg.ReplyTo == "" {
+		msg.ThreadID = generateThreadID()
+		return
+	}
+
+	mailbox := r.townMailbox()
+	original, err := mailbox.Get(msg.ReplyTo)
+	if err == nil && original.ThreadID != "" {
+		msg.ThreadID = original.ThreadID
+		return
+	}
+	msg.ThreadID = generateThreadID()
+	if err == nil {
+		// Legacy message without a thread: pull it into the new one so the
+		// conversation includes it (best-effort).
+		_, _ = runBdCommand([]string{"label", "add", original.ID, "thread:" + msg.ThreadID}, mailbox.workDir, mailbox.beadsDir)
+	}
+}
+
+// townMailbox returns an identity-less mailbox on the town beads, for
+// lookups by message or thread ID.
+func (r *Router) townMailbox() *Mailbox {
+	beadsDir := r.resolveBeadsDir("")
+	return NewMailboxWithTownRoot("", filepath.Dir(beadsDir), beadsDir, r.townRoot)
+}
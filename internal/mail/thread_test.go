@@ -0,0 +1,55 @@
+package mail
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderThread(t *testing.T) {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	msg := func(id, replyTo string, minute int) *Message {
+		return &Message{ID: id, ReplyTo: replyTo, Timestamp: base.Add(time.Duration(minute) * time.Minute)}
+	}
+
+	// root ← a ← a1, root ← b, plus a reply to a message outside the thread.
+	// Input is newest first, as inbox listings are.
+	messages := []*Message{
+		msg("orphan", "gone", 5),
+		msg("a1", "a", 4),
+		msg("b", "root", 3),
+		msg("a", "root", 1),
+		msg("root", "", 0),
+	}
+
+	got := OrderThread(messages)
+	want := []struct {
+		id    string
+		depth int
+	}{
+		{"root", 0},
+		{"a", 1},
+		{"a1", 2},
+		{"b", 1},
+		{"orphan", 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("OrderThread returned %d entries, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].ID != w.id || got[i].Depth != w.depth {
+			t.Errorf("entry %d = %s@%d, want %s@%d", i, got[i].ID, got[i].Depth, w.id, w.depth)
+		}
+	}
+}
+
+func TestOrderThreadReplyCycle(t *testing.T) {
+	now := time.Now()
+	messages := []*Message{
+		{ID: "x", ReplyTo: "y", Timestamp: now},
+		{ID: "y", ReplyTo: "x", Timestamp: now.Add(time.Second)},
+	}
+	got := OrderThread(messages)
+	if len(got) != 2 || got[0].ID != "x" || got[1].ID != "y" || got[1].Depth != 1 {
+		t.Errorf("OrderThread(cycle) = %+v", got)
+	}
+}
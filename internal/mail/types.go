@@ -108,6 +108,13 @@ type Message struct {
 	// CC'd recipients see the message in their inbox but are not the primary recipient.
 	CC []string `json:"cc,omitempty"`
 
+	// Attachments are files sent with the message, inline or by reference.
+	// See ValidateAttachments for the size limits.
+	Attachments []Attachment `json:"attachments,omitempty"`
+
+	// Receipt requests delivery and read receipts for a direct message.
+	Receipt Receipt `json:"receipt,omitempty"`
+
 	// SkipNotify prevents the automatic notification when mail is delivered.
 	// Use this when you're sending a separate nudge to avoid double-notification (hq-t1wcr5).
 	SkipNotify bool `json:"skip_notify,omitempty"`
@@ -251,7 +258,7 @@ func (m *Message) Validate() error {
 		return fmt.Errorf("claimed_at is only valid for queue messages")
 	}
 
-	return nil
+	return ValidateAttachments(m.Attachments)
 }
 
 // generateID creates a random message ID.
@@ -286,7 +293,7 @@ type BeadsMessage struct {
 	Priority    int       `json:"priority"`    // 0=urgent, 1=high, 2=normal, 3=low
 	Status      string    `json:"status"`      // open=unread, closed=read
 	CreatedAt   time.Time `json:"created_at"`
-	Labels      []string  `json:"labels"` // Metadata labels (from:X, thread:X, reply-to:X, msg-type:X, cc:X, queue:X, channel:X, claimed-by:X, claimed-at:X)
+	Labels      []string  `json:"labels"` // Metadata labels (from:X, thread:X, reply-to:X, msg-type:X, cc:X, queue:X, channel:X, claimed-by:X, claimed-at:X, receipt:X)
 	Pinned      bool      `json:"pinned,omitempty"`
 	Wisp        bool      `json:"wisp,omitempty"` // Ephemeral message (filtered from JSONL export)
 
@@ -300,6 +307,7 @@ type BeadsMessage struct {
 	channel   string     // Channel name (for broadcast messages)
 	claimedBy string     // Who claimed the queue message
 	claimedAt *time.Time // When the queue message was claimed
+	receipt   Receipt    // Requested receipt mode
 }
 
 // ParseLabels extracts metadata from the labels array.
@@ -321,6 +329,8 @@ func (bm *BeadsMessage) ParseLabels() {
 			bm.channel = strings.TrimPrefix(label, "channel:")
 		} else if strings.HasPrefix(label, "claimed-by:") {
 			bm.claimedBy = strings.TrimPrefix(label, "claimed-by:")
+		} else if strings.HasPrefix(label, "receipt:") {
+			bm.receipt = Receipt(strings.TrimPrefix(label, "receipt:"))
 		} else if strings.HasPrefix(label, "claimed-at:") {
 			ts := strings.TrimPrefix(label, "claimed-at:")
 			if t, err := time.Parse(time.RFC3339, ts); err == nil {
@@ -376,12 +386,14 @@ func (bm *BeadsMessage) ToMessage() *Message {
 		ccAddrs = append(ccAddrs, identityToAddress(cc))
 	}
 
+	body, attachments := splitAttachments(bm.Description)
+
 	return &Message{
 		ID:        bm.ID,
 		From:      identityToAddress(bm.sender),
 		To:        identityToAddress(bm.Assignee),
 		Subject:   bm.Title,
-		Body:      bm.Description,
+		Body:      body,
 		Timestamp: bm.CreatedAt,
 		Read:      bm.Status == "closed" || bm.HasLabel("read"),
 		Priority:  priority,
@@ -394,6 +406,9 @@ func (bm *BeadsMessage) ToMessage() *Message {
 		Channel:   bm.channel,
 		ClaimedBy: bm.claimedBy,
 		ClaimedAt: bm.claimedAt,
+		Receipt:   bm.receipt,
+
+		Attachments: attachments,
 	}
 }
 
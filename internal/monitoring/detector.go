@@ -1,8 +1,12 @@
 package monitoring
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
 )
 
 // Pattern maps output text patterns to agent statuses.
@@ -12,30 +16,46 @@ type Pattern struct {
 }
 
 // PatternRegistry holds patterns for detecting agent status from output.
+// Besides the generic patterns it keeps a pack of patterns per agent preset
+// (claude, gemini, ...) for output formats only that agent produces.
 type PatternRegistry struct {
 	patterns []Pattern
+	packs    map[string][]Pattern
 }
 
-// NewPatternRegistry creates a registry with the default detection patterns.
+// NewPatternRegistry creates a registry with the default detection patterns
+// and the pattern packs defined in the agent registry. Pack patterns that
+// don't compile are left out; LoadAgentPacks reports them.
 func NewPatternRegistry() *PatternRegistry {
-	return &PatternRegistry{
+	r := &PatternRegistry{
 		patterns: defaultPatterns(),
 	}
+	_ = r.LoadAgentPacks()
+	return r
 }
 
 // Detect examines output text and returns the most relevant status match.
 // Returns StatusWorking if no specific pattern matches but output is present.
 // Returns empty string if output is empty.
 func (r *PatternRegistry) Detect(output string) AgentStatus {
+	return r.DetectFor("", output)
+}
+
+// DetectFor is like Detect for output from a session running the given agent
+// preset: that preset's pack is checked before the generic patterns. An
+// unknown or empty preset uses the generic patterns alone.
+func (r *PatternRegistry) DetectFor(preset, output string) AgentStatus {
 	output = strings.TrimSpace(output)
 	if output == "" {
 		return ""
 	}
 
 	// Check patterns in priority order (first match wins)
-	for _, p := range r.patterns {
-		if p.Regex.MatchString(output) {
-			return p.Status
+	for _, patterns := range [][]Pattern{r.packs[preset], r.patterns} {
+		for _, p := range patterns {
+			if p.Regex.MatchString(output) {
+				return p.Status
+			}
 		}
 	}
 
@@ -53,6 +73,44 @@ func (r *PatternRegistry) AddPattern(pattern string, status AgentStatus) error {
 	return nil
 }
 
+// AddPackPattern adds a detection pattern to the pack for an agent preset,
+// after the patterns the pack already has.
+func (r *PatternRegistry) AddPackPattern(preset, pattern string, status AgentStatus) error {
+	if !status.isKnown() {
+		return fmt.Errorf("unknown status %q", status)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	if r.packs == nil {
+		r.packs = make(map[string][]Pattern)
+	}
+	r.packs[preset] = append(r.packs[preset], Pattern{Regex: re, Status: status})
+	return nil
+}
+
+// LoadAgentPacks replaces the registry's pattern packs with the
+// status_patterns of every preset in the agent registry. Call it again after
+// loading a town or rig agents.json so user-defined packs take effect. Every
+// pattern that compiles is loaded; the rest are returned as one error.
+func (r *PatternRegistry) LoadAgentPacks() error {
+	r.packs = make(map[string][]Pattern)
+	var errs []error
+	for _, name := range config.ListAgentPresets() {
+		info := config.GetAgentPresetByName(name)
+		if info == nil {
+			continue
+		}
+		for _, sp := range info.StatusPatterns {
+			if err := r.AddPackPattern(name, sp.Pattern, AgentStatus(sp.Status)); err != nil {
+				errs = append(errs, fmt.Errorf("agent %s: status pattern %q: %w", name, sp.Pattern, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // defaultPatterns returns the built-in status detection patterns.
 // Order matters — first match wins, so more specific patterns come first.
 func defaultPatterns() []Pattern {
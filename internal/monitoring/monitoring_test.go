@@ -1,8 +1,16 @@
 package monitoring
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
 )
 
 // ---------------------------------------------------------------------------
@@ -472,6 +480,146 @@ func TestNewPatternRegistry(t *testing.T) {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// detector.go — agent pattern packs
+// ---------------------------------------------------------------------------
+
+type packCase struct {
+	name  string
+	input string
+	want  AgentStatus
+}
+
+func checkPack(t *testing.T, preset string, tests []packCase) {
+	t.Helper()
+	reg := NewPatternRegistry()
+	if len(reg.packs[preset]) == 0 {
+		t.Fatalf("no pattern pack for %s", preset)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reg.DetectFor(preset, tt.input); got != tt.want {
+				t.Errorf("DetectFor(%q, %q) = %q, want %q", preset, tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatternPackClaude(t *testing.T) {
+	checkPack(t, "claude", []packCase{
+		{"api error", "⎿  API Error: 529 {\"type\":\"overloaded_error\"}", StatusError},
+		{"connection error", "API Error: Connection error.", StatusError},
+		{"credits", "Credit balance is too low", StatusError},
+		{"usage limit", "Claude usage limit reached. Your limit will reset at 5pm.", StatusBlocked},
+		{"permission prompt", "Do you want to proceed?\n❯ 1. Yes\n  2. No", StatusWaiting},
+		{"edit prompt", "Do you want to make this edit to main.go?", StatusWaiting},
+		{"spinner", "✻ Pondering… (12s · ↑ 1.2k tokens · esc to interrupt)", StatusThinking},
+		{"plain output", "⏺ Read 3 files", StatusWorking},
+	})
+}
+
+func TestPatternPackGemini(t *testing.T) {
+	checkPack(t, "gemini", []packCase{
+		{"api error", "✕ [API Error: got status: 500 Internal Server Error]", StatusError},
+		{"quota", "Quota exceeded for quota metric 'Gemini 2.5 Pro Requests'", StatusBlocked},
+		{"shell confirm", "Allow execution of: 'go test'?", StatusWaiting},
+		{"edit confirm", "Apply this change?", StatusWaiting},
+		{"spinner", "⠏ Reading the codebase (esc to cancel, 4s)", StatusThinking},
+		{"plain output", "✔ ReadFile main.go", StatusWorking},
+	})
+}
+
+func TestPatternPackCodex(t *testing.T) {
+	checkPack(t, "codex", []packCase{
+		{"stream error", "stream error: stream disconnected before completion; retrying 1/5", StatusError},
+		{"usage limit", "You've hit your usage limit. Try again in 2 hours.", StatusBlocked},
+		{"command approval", "Would you like to run the following command?\n$ make test", StatusWaiting},
+		{"edit approval", "Would you like to make the following edits?", StatusWaiting},
+		{"status line", "• Working (5s • esc to interrupt)", StatusThinking},
+		{"plain output", "• Ran go build ./...", StatusWorking},
+	})
+}
+
+func TestPatternPackOpenCode(t *testing.T) {
+	checkPack(t, "opencode", []packCase{
+		{"auth error", "ProviderAuthError: missing API key", StatusError},
+		{"api error", "AI_APICallError: Overloaded", StatusError},
+		{"permission", "Permission required: bash", StatusWaiting},
+		{"working", "⠋ working  esc interrupt", StatusThinking},
+		{"plain output", "Edit src/main.ts", StatusWorking},
+	})
+}
+
+func TestDetectForFallsBackToGeneric(t *testing.T) {
+	reg := NewPatternRegistry()
+	// Generic patterns still apply under a pack
+	if got := reg.DetectFor("claude", "BLOCKED: need credentials"); got != StatusBlocked {
+		t.Errorf("DetectFor(claude, BLOCKED) = %q, want %q", got, StatusBlocked)
+	}
+	// Unknown presets use only the generic patterns
+	if got := reg.DetectFor("no-such-agent", "esc to interrupt"); got != StatusWorking {
+		t.Errorf("DetectFor(unknown) = %q, want %q", got, StatusWorking)
+	}
+	// A pack's patterns don't leak to other presets
+	if got := reg.DetectFor("gemini", "Do you want to proceed?"); got != StatusWorking {
+		t.Errorf("DetectFor(gemini, claude prompt) = %q, want %q", got, StatusWorking)
+	}
+}
+
+func TestAddPackPatternUnknownStatus(t *testing.T) {
+	reg := NewPatternRegistry()
+	if err := reg.AddPackPattern("claude", `x`, AgentStatus("busy")); err == nil {
+		t.Error("AddPackPattern with unknown status should return error")
+	}
+	if err := reg.AddPackPattern("claude", `(?P<invalid`, StatusError); err == nil {
+		t.Error("AddPackPattern with invalid regex should return error")
+	}
+}
+
+func TestLoadAgentPacksFromRegistry(t *testing.T) {
+	config.ResetRegistryForTesting()
+	t.Cleanup(config.ResetRegistryForTesting)
+
+	path := filepath.Join(t.TempDir(), "agents.json")
+	data := `{"version":1,"agents":{"aider":{"command":"aider","status_patterns":[
+		{"pattern":"Tokens: .* sent","status":"thinking"},
+		{"pattern":"(","status":"error"}]}}}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.LoadAgentRegistry(path); err != nil {
+		t.Fatal(err)
+	}
+
+	reg := NewPatternRegistry()
+	err := reg.LoadAgentPacks()
+	if err == nil || !strings.Contains(err.Error(), "agent aider") {
+		t.Errorf("LoadAgentPacks error = %v, want the invalid aider pattern", err)
+	}
+	if got := reg.DetectFor("aider", "Tokens: 2.1k sent, 340 received"); got != StatusThinking {
+		t.Errorf("DetectFor(aider) = %q, want %q", got, StatusThinking)
+	}
+	// Built-in packs are still loaded alongside
+	if len(reg.packs["claude"]) == 0 {
+		t.Error("claude pack missing after loading agents.json")
+	}
+}
+
+func TestTrackerUsesAgentPreset(t *testing.T) {
+	tr := NewTracker()
+	tr.SetAgentPreset("gastown/polecats/nux", "codex")
+	tr.UpdateActivity("gastown/polecats/nux", "Would you like to run the following command?")
+	if got := tr.GetStatus("gastown/polecats/nux").Status; got != StatusWaiting {
+		t.Errorf("status = %q, want %q", got, StatusWaiting)
+	}
+
+	// Without a preset the same output is just activity
+	tr.UpdateActivity("gastown/polecats/slit", "Would you like to run the following command?")
+	if got := tr.GetStatus("gastown/polecats/slit").Status; got != StatusWorking {
+		t.Errorf("status = %q, want %q", got, StatusWorking)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // idle.go — IdleLevel.String
 // ---------------------------------------------------------------------------
@@ -867,3 +1015,344 @@ func TestPatternRegistryWithStatusReport(t *testing.T) {
 		t.Error("thinking status should be healthy")
 	}
 }
+
+// ---------------------------------------------------------------------------
+// session_monitor.go — SessionMonitor
+// ---------------------------------------------------------------------------
+
+// fakePanes is a PaneCapturer serving fixed output per session.
+type fakePanes struct {
+	mu   sync.Mutex
+	out  map[string]string
+	fail map[string]bool
+}
+
+func (f *fakePanes) CapturePane(session string, _ int) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.fail[session] {
+		return "", errors.New("no such session")
+	}
+	return f.out[session], nil
+}
+
+func (f *fakePanes) set(session, out string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.out[session] = out
+}
+
+func newTestMonitor(panes *fakePanes, handler func(Transition)) *SessionMonitor {
+	idle := NewIdleDetector(
+		WithIdleTimeout(20*time.Millisecond),
+		WithStaleTimeout(40*time.Millisecond),
+		WithStuckTimeout(60*time.Millisecond),
+	)
+	return NewSessionMonitor(panes, WithMonitorIdleDetector(idle), WithTransitionHandler(handler))
+}
+
+func TestSessionMonitorStuckAndRecovery(t *testing.T) {
+	panes := &fakePanes{out: map[string]string{"gt-gastown-nux": "⏺ Running tests"}}
+	var handled []Transition
+	m := newTestMonitor(panes, func(tr Transition) { handled = append(handled, tr) })
+	m.Watch("gastown/polecats/nux", "gt-gastown-nux", "claude")
+
+	if got := m.Poll(); len(got) != 0 {
+		t.Fatalf("first poll transitions = %v, want none", got)
+	}
+
+	time.Sleep(70 * time.Millisecond)
+	got := m.Poll()
+	if len(got) != 1 || !got[0].Stuck() || got[0].From != IdleLevelActive {
+		t.Fatalf("transitions after stuck timeout = %+v, want active → stuck", got)
+	}
+	if got[0].Output != "⏺ Running tests" || got[0].Session != "gt-gastown-nux" {
+		t.Errorf("transition = %+v", got[0])
+	}
+	if level, _ := m.Level("gastown/polecats/nux"); level != IdleLevelStuck {
+		t.Errorf("Level = %v, want stuck", level)
+	}
+	// Stuck is reported once, not on every poll
+	if got := m.Poll(); len(got) != 0 {
+		t.Errorf("repeat poll transitions = %v, want none", got)
+	}
+
+	panes.set("gt-gastown-nux", "⏺ Running tests\n✓ 42 passed")
+	got = m.Poll()
+	if len(got) != 1 || !got[0].Recovered() || got[0].To != IdleLevelActive {
+		t.Fatalf("transitions after new output = %+v, want stuck → active", got)
+	}
+	if len(handled) != 2 {
+		t.Errorf("handler saw %d transitions, want 2", len(handled))
+	}
+	if s := m.Tracker().GetStatus("gastown/polecats/nux").Status; s != StatusWorking {
+		t.Errorf("tracker status = %q, want %q", s, StatusWorking)
+	}
+}
+
+func TestSessionMonitorWaitingStopsAtStale(t *testing.T) {
+	panes := &fakePanes{out: map[string]string{"s": "Do you want to proceed?\n❯ 1. Yes"}}
+	m := newTestMonitor(panes, nil)
+	m.Watch("gastown/polecats/nux", "s", "claude")
+	m.Poll()
+
+	time.Sleep(70 * time.Millisecond)
+	got := m.Poll()
+	if len(got) != 1 || got[0].To != IdleLevelStale || got[0].Status != StatusWaiting {
+		t.Fatalf("transitions = %+v, want active → stale while waiting", got)
+	}
+}
+
+func TestSessionMonitorIgnoresUncapturedSessions(t *testing.T) {
+	panes := &fakePanes{fail: map[string]bool{"gone": true}}
+	m := newTestMonitor(panes, nil)
+	m.Watch("gastown/polecats/nux", "gone", "")
+	m.Poll()
+	time.Sleep(70 * time.Millisecond)
+	if got := m.Poll(); len(got) != 0 {
+		t.Errorf("transitions = %+v, want none for a session never captured", got)
+	}
+
+	m.Unwatch("gastown/polecats/nux")
+	if len(m.Watched()) != 0 {
+		t.Errorf("Watched = %v after Unwatch", m.Watched())
+	}
+}
+
+// ---------------------------------------------------------------------------
+// usage.go — usage parsing
+// ---------------------------------------------------------------------------
+
+func TestParseClaudeSessionLog(t *testing.T) {
+	log := `{"type":"user","cwd":"/town/gastown/polecats/nux/gastown","message":{"role":"user"}}
+{"type":"assistant","cwd":"/town/gastown/polecats/nux/gastown","message":{"id":"msg_1","model":"claude-sonnet-4-5","usage":{"input_tokens":1000,"output_tokens":100,"cache_creation_input_tokens":2000,"cache_read_input_tokens":10000}}}
+{"type":"assistant","cwd":"/town/gastown/polecats/nux/gastown","message":{"id":"msg_1","model":"claude-sonnet-4-5","usage":{"input_tokens":1000,"output_tokens":100,"cache_creation_input_tokens":2000,"cache_read_input_tokens":10000}}}
+not json
+{"type":"assistant","costUSD":0.5,"message":{"id":"msg_2","model":"claude-opus-4-1","usage":{"input_tokens":10,"output_tokens":20}}}
+`
+	su, err := ParseClaudeSessionLog(strings.NewReader(log))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if su.Cwd != "/town/gastown/polecats/nux/gastown" || su.Model != "claude-opus-4-1" {
+		t.Errorf("cwd, model = %q, %q", su.Cwd, su.Model)
+	}
+	want := TokenUsage{InputTokens: 1010, OutputTokens: 120, CacheReadTokens: 10000, CacheWriteTokens: 2000}
+	if su.InputTokens != want.InputTokens || su.OutputTokens != want.OutputTokens ||
+		su.CacheReadTokens != want.CacheReadTokens || su.CacheWriteTokens != want.CacheWriteTokens {
+		t.Errorf("usage = %+v, want %+v (duplicate message counted once)", su.TokenUsage, want)
+	}
+	// sonnet: 1000*3 + 100*15 + 2000*3.75 + 10000*0.3 = 15000 per Mtok; plus the logged 0.5
+	if diff := su.CostUSD - 0.515; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("cost = %v, want 0.515", su.CostUSD)
+	}
+}
+
+func TestParseCodexSessionLog(t *testing.T) {
+	log := `{"type":"session_meta","payload":{"id":"s1","cwd":"/town/gastown/crew/joe"}}
+{"type":"turn_context","payload":{"cwd":"/town/gastown/crew/joe","model":"gpt-5-codex"}}
+{"type":"event_msg","payload":{"type":"token_count","info":null}}
+{"type":"event_msg","payload":{"type":"token_count","info":{"total_token_usage":{"input_tokens":500,"cached_input_tokens":100,"output_tokens":50}}}}
+{"type":"event_msg","payload":{"type":"token_count","info":{"total_token_usage":{"input_tokens":3000,"cached_input_tokens":1000,"output_tokens":400}}}}
+`
+	su, err := ParseCodexSessionLog(strings.NewReader(log))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if su.Cwd != "/town/gastown/crew/joe" || su.Model != "gpt-5-codex" {
+		t.Errorf("cwd, model = %q, %q", su.Cwd, su.Model)
+	}
+	if su.InputTokens != 2000 || su.CacheReadTokens != 1000 || su.OutputTokens != 400 {
+		t.Errorf("usage = %+v, want last running total with cached input split out", su.TokenUsage)
+	}
+	if su.CostUSD <= 0 {
+		t.Errorf("cost = %v, want an estimate for gpt-5", su.CostUSD)
+	}
+}
+
+func TestParseUsageOutput(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   TokenUsage
+		ok     bool
+	}{
+		{
+			name: "claude cost",
+			output: `> /cost
+  ⎿  Total cost:            $0.10
+  ⎿  Total cost:            $1.2345
+     Total duration (API):  2m 3s
+     Usage by model:
+         claude-sonnet:  12.5k input, 1.2k output, 300.0k cache read, 20k cache write ($1.20)
+          claude-haiku:  1,000 input, 50 output, 0 cache read, 0 cache write ($0.0345)`,
+			want: TokenUsage{InputTokens: 13500, OutputTokens: 1250, CacheReadTokens: 300000, CacheWriteTokens: 20000, CostUSD: 1.2345},
+			ok:   true,
+		},
+		{
+			name:   "codex exit",
+			output: "Token usage: total=1,500 input=1,200 (+ 400 cached) output=300 (reasoning 120)",
+			want:   TokenUsage{InputTokens: 800, OutputTokens: 300, CacheReadTokens: 400},
+			ok:     true,
+		},
+		{name: "nothing", output: "all tests pass", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseUsageOutput(tt.output)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("ParseUsageOutput = %+v, %v; want %+v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	u := TokenUsage{InputTokens: 1_000_000, OutputTokens: 1_000_000}
+	if got := EstimateCost("claude-opus-4-5-20251101", u); got != 30 {
+		t.Errorf("opus 4.5 = %v, want 30", got)
+	}
+	if got := EstimateCost("claude-opus-4-1", u); got != 90 {
+		t.Errorf("opus 4.1 = %v, want 90", got)
+	}
+	if got := EstimateCost("some-local-model", u); got != 0 {
+		t.Errorf("unknown model = %v, want 0", got)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// usage_ledger.go — UsageLedger, SummarizeUsage, CheckBudgets
+// ---------------------------------------------------------------------------
+
+func TestUsageLedgerSummaryAndBudgets(t *testing.T) {
+	ledger := NewUsageLedger(t.TempDir())
+	now := time.Now()
+	if err := ledger.Append(
+		UsageRecord{Time: now.Add(-48 * time.Hour), Agent: "gastown/polecats/nux", Rig: "gastown", TokenUsage: TokenUsage{CostUSD: 100}},
+		UsageRecord{Time: now, Agent: "gastown/polecats/nux", Rig: "gastown", Bead: "gt-abc", TokenUsage: TokenUsage{OutputTokens: 10, CostUSD: 9}},
+		UsageRecord{Time: now, Agent: "gastown/polecats/toast", Rig: "gastown", Bead: "gt-abc", TokenUsage: TokenUsage{OutputTokens: 5, CostUSD: 2}},
+		UsageRecord{Time: now, Agent: "mayor/", TokenUsage: TokenUsage{CostUSD: 1}},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := ledger.Records(now.Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := SummarizeUsage(records)
+	if s.Total.CostUSD != 12 || s.Total.OutputTokens != 15 {
+		t.Errorf("total = %+v, want today's records only", s.Total)
+	}
+	if len(s.ByAgent) != 3 || s.ByAgent[0].Key != "gastown/polecats/nux" {
+		t.Errorf("by agent = %+v, want nux first", s.ByAgent)
+	}
+	if len(s.ByRig) != 1 || s.ByRig[0].CostUSD != 11 {
+		t.Errorf("by rig = %+v, want gastown only", s.ByRig)
+	}
+	if len(s.ByBead) != 1 || s.ByBead[0].Key != "gt-abc" || s.ByBead[0].CostUSD != 11 {
+		t.Errorf("by bead = %+v", s.ByBead)
+	}
+
+	budgets := CheckBudgets(s, &config.UsageBudgets{DailyUSD: 100, AgentDailyUSD: 10})
+	if len(budgets) != 2 {
+		t.Fatalf("budgets = %+v, want nux warning and town", budgets)
+	}
+	if b := budgets[0]; b.Scope != BudgetScopeAgent || b.Key != "gastown/polecats/nux" || !b.Warning() || b.Exceeded() {
+		t.Errorf("budgets[0] = %+v, want nux at 90%%", b)
+	}
+	if b := budgets[1]; b.Scope != BudgetScopeTown || b.Warning() || b.Exceeded() {
+		t.Errorf("budgets[1] = %+v, want town under budget", b)
+	}
+	if CheckBudgets(s, nil) != nil {
+		t.Error("no budgets configured should report nothing")
+	}
+}
+
+func TestAgentRig(t *testing.T) {
+	for agent, want := range map[string]string{
+		"gastown/polecats/nux": "gastown",
+		"gastown/witness":      "gastown",
+		"mayor/":               "",
+		"deacon/":              "",
+		"overseer":             "",
+	} {
+		if got := AgentRig(agent); got != want {
+			t.Errorf("AgentRig(%q) = %q, want %q", agent, got, want)
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------
+// usage_collector.go — UsageCollector
+// ---------------------------------------------------------------------------
+
+func TestUsageCollectorRecordsGrowth(t *testing.T) {
+	town := t.TempDir()
+	claudeDir := t.TempDir()
+	polecatDir := filepath.Join(town, "gastown", "polecats", "nux")
+	project := filepath.Join(claudeDir, claudeProjectName(filepath.Join(polecatDir, "gastown")))
+	if err := os.MkdirAll(project, 0755); err != nil {
+		t.Fatal(err)
+	}
+	// A session from some other directory is never read
+	other := filepath.Join(claudeDir, "-home-someone-project")
+	if err := os.MkdirAll(other, 0755); err != nil {
+		t.Fatal(err)
+	}
+	line := func(id string, in int) string {
+		return `{"type":"assistant","cwd":"` + filepath.Join(polecatDir, "gastown") + `","message":{"id":"` + id +
+			`","model":"claude-sonnet-4-5","usage":{"input_tokens":` + strconv.Itoa(in) + `,"output_tokens":0}}}` + "\n"
+	}
+	logPath := filepath.Join(project, "session.jsonl")
+	if err := os.WriteFile(logPath, []byte(line("m1", 1000)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(other, "session.jsonl"), []byte(line("x", 5)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	bead := "gt-abc"
+	agents := func() []UsageAgent {
+		return []UsageAgent{
+			{ID: "gastown/polecats/nux", Dir: polecatDir, Bead: bead},
+			{ID: "gastown/polecats/nu", Dir: filepath.Join(town, "gastown", "polecats", "nu")},
+		}
+	}
+	ledger := NewUsageLedger(town)
+	newCollector := func() *UsageCollector {
+		return NewUsageCollector(ledger, agents, WithClaudeProjectsDirs(claudeDir), WithCodexSessionsDir(""))
+	}
+	c := newCollector()
+
+	got, err := c.Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Agent != "gastown/polecats/nux" || got[0].Rig != "gastown" ||
+		got[0].Bead != "gt-abc" || got[0].InputTokens != 1000 || got[0].Source != logPath {
+		t.Fatalf("first collect = %+v", got)
+	}
+	if got, _ := c.Collect(); len(got) != 0 {
+		t.Errorf("unchanged log recorded again: %+v", got)
+	}
+
+	// Growth goes to the bead hooked now
+	bead = "gt-def"
+	f, _ := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0644)
+	_, _ = f.WriteString(line("m2", 500))
+	f.Close()
+	got, _ = c.Collect()
+	if len(got) != 1 || got[0].InputTokens != 500 || got[0].Bead != "gt-def" {
+		t.Fatalf("second collect = %+v, want only the growth", got)
+	}
+
+	// A new collector resumes from the ledger
+	if got, _ := newCollector().Collect(); len(got) != 0 {
+		t.Errorf("restarted collector recorded again: %+v", got)
+	}
+	records, _ := ledger.Records(time.Time{})
+	if s := SummarizeUsage(records); s.Total.InputTokens != 1500 {
+		t.Errorf("ledger total = %+v, want 1500 input tokens", s.Total)
+	}
+}
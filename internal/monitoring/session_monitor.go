@@ -0,0 +1,260 @@
+package monitoring
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Default SessionMonitor settings.
+const (
+	DefaultPollInterval = 30 * time.Second
+	DefaultCaptureLines = 50
+)
+
+// PaneCapturer captures recent terminal output from a session.
+// terminal.Backend satisfies it.
+type PaneCapturer interface {
+	CapturePane(session string, lines int) (string, error)
+}
+
+// Transition records an agent's output moving between idle levels.
+type Transition struct {
+	AgentID      string
+	Session      string
+	From         IdleLevel
+	To           IdleLevel
+	Status       AgentStatus // Status detected from the last output that changed
+	LastActivity time.Time   // When the output last changed
+	Output       string      // Last capture
+}
+
+// Stuck reports whether the transition is an agent becoming stuck.
+func (t Transition) Stuck() bool {
+	return t.To == IdleLevelStuck
+}
+
+// Recovered reports whether the transition is a stuck agent producing
+// output again.
+func (t Transition) Recovered() bool {
+	return t.From == IdleLevelStuck
+}
+
+// watchedSession is the rolling state for one monitored agent.
+type watchedSession struct {
+	session    string
+	preset     string
+	output     string
+	status     AgentStatus
+	lastChange time.Time
+	level      IdleLevel
+	captured   bool // at least one capture has succeeded
+}
+
+// SessionMonitor periodically captures each watched agent's pane, classifies
+// its output, and tracks how long the output has gone unchanged. Each agent
+// moves working → idle → stale → stuck as the IdleDetector thresholds pass
+// and drops back to active as soon as its output changes. Every level change
+// is passed to the transition handler.
+//
+// An agent whose last output shows it waiting or blocked (a permission
+// prompt, a decision point) stops at stale: it needs an answer, not a
+// restart.
+type SessionMonitor struct {
+	capturer     PaneCapturer
+	tracker      *Tracker
+	idle         *IdleDetector
+	lines        int
+	interval     time.Duration
+	onTransition func(Transition)
+
+	mu       sync.Mutex
+	sessions map[string]*watchedSession
+}
+
+// SessionMonitorOption configures a SessionMonitor.
+type SessionMonitorOption func(*SessionMonitor)
+
+// WithMonitorTracker sets the Tracker that receives captured output.
+func WithMonitorTracker(t *Tracker) SessionMonitorOption {
+	return func(m *SessionMonitor) { m.tracker = t }
+}
+
+// WithMonitorIdleDetector sets the idle, stale, and stuck thresholds.
+func WithMonitorIdleDetector(d *IdleDetector) SessionMonitorOption {
+	return func(m *SessionMonitor) { m.idle = d }
+}
+
+// WithPollInterval sets how often Run captures every pane.
+func WithPollInterval(d time.Duration) SessionMonitorOption {
+	return func(m *SessionMonitor) { m.interval = d }
+}
+
+// WithCaptureLines sets how many trailing pane lines are captured.
+func WithCaptureLines(n int) SessionMonitorOption {
+	return func(m *SessionMonitor) { m.lines = n }
+}
+
+// WithTransitionHandler sets a function called for every level change.
+// It runs on the polling goroutine, so it should not block for long.
+func WithTransitionHandler(fn func(Transition)) SessionMonitorOption {
+	return func(m *SessionMonitor) { m.onTransition = fn }
+}
+
+// NewSessionMonitor creates a SessionMonitor capturing panes through
+// capturer. Without options it uses NewIdleDetector thresholds and a
+// Tracker sharing them.
+func NewSessionMonitor(capturer PaneCapturer, opts ...SessionMonitorOption) *SessionMonitor {
+	m := &SessionMonitor{
+		capturer: capturer,
+		lines:    DefaultCaptureLines,
+		interval: DefaultPollInterval,
+		sessions: make(map[string]*watchedSession),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if m.idle == nil {
+		m.idle = NewIdleDetector()
+	}
+	if m.tracker == nil {
+		m.tracker = NewTracker(WithIdleDetector(m.idle))
+	}
+	return m
+}
+
+// Tracker returns the Tracker fed by the monitor.
+func (m *SessionMonitor) Tracker() *Tracker {
+	return m.tracker
+}
+
+// Watch starts monitoring agentID's session, classifying its output with
+// the pattern pack for preset. Watching an agent again updates its session
+// and preset but keeps its history. A newly watched agent counts as active.
+func (m *SessionMonitor) Watch(agentID, session, preset string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if ws, ok := m.sessions[agentID]; ok {
+		ws.session, ws.preset = session, preset
+	} else {
+		m.sessions[agentID] = &watchedSession{
+			session:    session,
+			preset:     preset,
+			lastChange: time.Now(),
+		}
+	}
+	m.tracker.SetAgentPreset(agentID, preset)
+}
+
+// Unwatch stops monitoring agentID and forgets its state.
+func (m *SessionMonitor) Unwatch(agentID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.sessions, agentID)
+	m.tracker.RemoveAgent(agentID)
+}
+
+// Watched returns the IDs of the monitored agents.
+func (m *SessionMonitor) Watched() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ids := make([]string, 0, len(m.sessions))
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Level returns agentID's current idle level, and false if it is not watched.
+func (m *SessionMonitor) Level(agentID string) (IdleLevel, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ws, ok := m.sessions[agentID]
+	if !ok {
+		return IdleLevelActive, false
+	}
+	return ws.level, true
+}
+
+// Poll captures every watched pane once, updates each agent's level, and
+// returns the transitions, which have also been passed to the handler.
+// A session that can't be captured keeps its previous output, so an agent
+// that becomes unreachable ages towards stuck like a silent one; a session
+// that has never been captured is left alone.
+func (m *SessionMonitor) Poll() []Transition {
+	m.mu.Lock()
+	targets := make(map[string]string, len(m.sessions))
+	for id, ws := range m.sessions {
+		targets[id] = ws.session
+	}
+	m.mu.Unlock()
+
+	// Capture without the lock; coop captures are HTTP calls.
+	captures := make(map[string]string, len(targets))
+	for id, session := range targets {
+		if out, err := m.capturer.CapturePane(session, m.lines); err == nil {
+			captures[id] = out
+		}
+	}
+
+	m.mu.Lock()
+	var transitions []Transition
+	for id, ws := range m.sessions {
+		out, captured := captures[id]
+		if captured && (!ws.captured || out != ws.output) {
+			ws.captured = true
+			ws.output = out
+			ws.lastChange = time.Now()
+			ws.status = m.tracker.patterns.DetectFor(ws.preset, out)
+			m.tracker.UpdateActivity(id, out)
+		}
+
+		if !ws.captured {
+			continue
+		}
+		level := m.idle.Classify(ws.lastChange)
+		if level == IdleLevelStuck && (ws.status == StatusWaiting || ws.status == StatusBlocked) {
+			level = IdleLevelStale
+		}
+		if level == ws.level {
+			continue
+		}
+		transitions = append(transitions, Transition{
+			AgentID:      id,
+			Session:      ws.session,
+			From:         ws.level,
+			To:           level,
+			Status:       ws.status,
+			LastActivity: ws.lastChange,
+			Output:       ws.output,
+		})
+		ws.level = level
+	}
+	m.mu.Unlock()
+
+	if m.onTransition != nil {
+		for _, t := range transitions {
+			m.onTransition(t)
+		}
+	}
+	return transitions
+}
+
+// Run polls every interval until ctx is canceled.
+func (m *SessionMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.Poll()
+		}
+	}
+}
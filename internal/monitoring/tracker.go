@@ -12,6 +12,7 @@ type agentState struct {
 	lastActivity  time.Time
 	lastOutput    string      // most recent output for pattern detection
 	patternStatus AgentStatus // last detected pattern status
+	preset        string      // agent preset, selects the pattern pack
 }
 
 // Tracker manages per-agent status tracking with thread-safe access.
@@ -75,11 +76,20 @@ func (t *Tracker) UpdateActivity(agentID string, output string) {
 	s.lastActivity = time.Now()
 	s.lastOutput = output
 
-	if detected := t.patterns.Detect(output); detected != "" {
+	if detected := t.patterns.DetectFor(s.preset, output); detected != "" {
 		s.patternStatus = detected
 	}
 }
 
+// SetAgentPreset records which agent preset (claude, gemini, ...) a session
+// runs, so its output is classified with that preset's pattern pack.
+func (t *Tracker) SetAgentPreset(agentID, preset string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.getOrCreate(agentID).preset = preset
+}
+
 // SetStatus sets an agent's status from the given source.
 func (t *Tracker) SetStatus(agentID string, status AgentStatus, source StatusSource, message string) {
 	t.mu.Lock()
@@ -44,6 +44,17 @@ func (s AgentStatus) NeedsAttention() bool {
 	}
 }
 
+// isKnown reports whether s is one of the defined statuses.
+func (s AgentStatus) isKnown() bool {
+	switch s {
+	case StatusAvailable, StatusWorking, StatusThinking, StatusBlocked, StatusWaiting,
+		StatusReviewing, StatusIdle, StatusPaused, StatusError, StatusOffline:
+		return true
+	default:
+		return false
+	}
+}
+
 // StatusSource indicates how a status was determined, in priority order.
 type StatusSource string
 
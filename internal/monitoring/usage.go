@@ -0,0 +1,285 @@
+package monitoring
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TokenUsage counts the tokens an agent consumed and what they cost.
+type TokenUsage struct {
+	InputTokens      int64   `json:"input_tokens,omitempty"`
+	OutputTokens     int64   `json:"output_tokens,omitempty"`
+	CacheReadTokens  int64   `json:"cache_read_tokens,omitempty"`
+	CacheWriteTokens int64   `json:"cache_write_tokens,omitempty"`
+	CostUSD          float64 `json:"cost_usd,omitempty"`
+}
+
+// Add adds o to u.
+func (u *TokenUsage) Add(o TokenUsage) {
+	u.InputTokens += o.InputTokens
+	u.OutputTokens += o.OutputTokens
+	u.CacheReadTokens += o.CacheReadTokens
+	u.CacheWriteTokens += o.CacheWriteTokens
+	u.CostUSD += o.CostUSD
+}
+
+// Sub returns u minus o, with each count floored at zero.
+func (u TokenUsage) Sub(o TokenUsage) TokenUsage {
+	return TokenUsage{
+		InputTokens:      max(u.InputTokens-o.InputTokens, 0),
+		OutputTokens:     max(u.OutputTokens-o.OutputTokens, 0),
+		CacheReadTokens:  max(u.CacheReadTokens-o.CacheReadTokens, 0),
+		CacheWriteTokens: max(u.CacheWriteTokens-o.CacheWriteTokens, 0),
+		CostUSD:          max(u.CostUSD-o.CostUSD, 0),
+	}
+}
+
+// TotalTokens is the sum of all token counts.
+func (u TokenUsage) TotalTokens() int64 {
+	return u.InputTokens + u.OutputTokens + u.CacheReadTokens + u.CacheWriteTokens
+}
+
+// IsZero reports whether no tokens or cost were recorded.
+func (u TokenUsage) IsZero() bool {
+	return u.TotalTokens() == 0 && u.CostUSD == 0
+}
+
+// modelPrice is list pricing in USD per million tokens.
+type modelPrice struct {
+	match                                string // Substring of the model name
+	input, output, cacheWrite, cacheRead float64
+}
+
+// modelPrices is checked in order, so more specific names come first.
+// Cache writes for OpenAI models are billed as input.
+var modelPrices = []modelPrice{
+	{"opus-4-5", 5, 25, 6.25, 0.50},
+	{"opus", 15, 75, 18.75, 1.50},
+	{"sonnet", 3, 15, 3.75, 0.30},
+	{"haiku-4-5", 1, 5, 1.25, 0.10},
+	{"haiku", 0.80, 4, 1, 0.08},
+	{"gpt-5-mini", 0.25, 2, 0.25, 0.025},
+	{"gpt-5", 1.25, 10, 1.25, 0.125},
+}
+
+// EstimateCost prices u at list rates for model. Unknown models cost zero,
+// so their tokens are still counted but never trip a budget.
+func EstimateCost(model string, u TokenUsage) float64 {
+	model = strings.ToLower(model)
+	for _, p := range modelPrices {
+		if strings.Contains(model, p.match) {
+			return (float64(u.InputTokens)*p.input +
+				float64(u.OutputTokens)*p.output +
+				float64(u.CacheWriteTokens)*p.cacheWrite +
+				float64(u.CacheReadTokens)*p.cacheRead) / 1e6
+		}
+	}
+	return 0
+}
+
+// SessionUsage is the usage read from one agent session log.
+type SessionUsage struct {
+	Cwd   string // Working directory the session ran in
+	Model string // Last model the session used
+	TokenUsage
+}
+
+// claudeLogLine is the subset of a Claude Code session log entry we read.
+type claudeLogLine struct {
+	Type    string   `json:"type"`
+	Cwd     string   `json:"cwd"`
+	CostUSD *float64 `json:"costUSD"`
+	Message struct {
+		ID    string `json:"id"`
+		Model string `json:"model"`
+		Usage *struct {
+			InputTokens              int64 `json:"input_tokens"`
+			OutputTokens             int64 `json:"output_tokens"`
+			CacheCreationInputTokens int64 `json:"cache_creation_input_tokens"`
+			CacheReadInputTokens     int64 `json:"cache_read_input_tokens"`
+		} `json:"usage"`
+	} `json:"message"`
+}
+
+// ParseClaudeSessionLog totals a Claude Code session log
+// (~/.claude/projects/<project>/<session>.jsonl). Claude Code writes one
+// line per content block, each repeating its message's usage, so messages
+// are counted once by ID. Cost comes from the log when it has it and is
+// estimated from the model otherwise.
+func ParseClaudeSessionLog(r io.Reader) (SessionUsage, error) {
+	var su SessionUsage
+	seen := make(map[string]bool)
+	err := scanJSONLines(r, func(line []byte) {
+		var entry claudeLogLine
+		if json.Unmarshal(line, &entry) != nil {
+			return
+		}
+		if su.Cwd == "" {
+			su.Cwd = entry.Cwd
+		}
+		if entry.Type != "assistant" || entry.Message.Usage == nil {
+			return
+		}
+		if id := entry.Message.ID; id != "" {
+			if seen[id] {
+				return
+			}
+			seen[id] = true
+		}
+		u := TokenUsage{
+			InputTokens:      entry.Message.Usage.InputTokens,
+			OutputTokens:     entry.Message.Usage.OutputTokens,
+			CacheReadTokens:  entry.Message.Usage.CacheReadInputTokens,
+			CacheWriteTokens: entry.Message.Usage.CacheCreationInputTokens,
+		}
+		if entry.CostUSD != nil {
+			u.CostUSD = *entry.CostUSD
+		} else {
+			u.CostUSD = EstimateCost(entry.Message.Model, u)
+		}
+		if entry.Message.Model != "" {
+			su.Model = entry.Message.Model
+		}
+		su.Add(u)
+	})
+	return su, err
+}
+
+// codexLogLine is the subset of a Codex rollout log entry we read.
+type codexLogLine struct {
+	Type    string `json:"type"`
+	Payload struct {
+		Type  string `json:"type"`
+		Cwd   string `json:"cwd"`
+		Model string `json:"model"`
+		Info  *struct {
+			TotalTokenUsage struct {
+				InputTokens       int64 `json:"input_tokens"`
+				CachedInputTokens int64 `json:"cached_input_tokens"`
+				OutputTokens      int64 `json:"output_tokens"`
+			} `json:"total_token_usage"`
+		} `json:"info"`
+	} `json:"payload"`
+}
+
+// ParseCodexSessionLog totals a Codex rollout log
+// (~/.codex/sessions/YYYY/MM/DD/rollout-*.jsonl). Codex logs running
+// totals, so the last token_count event wins. Its input count includes
+// cached input, which is split out here. Cost is estimated from the model.
+func ParseCodexSessionLog(r io.Reader) (SessionUsage, error) {
+	var su SessionUsage
+	err := scanJSONLines(r, func(line []byte) {
+		var entry codexLogLine
+		if json.Unmarshal(line, &entry) != nil {
+			return
+		}
+		switch {
+		case entry.Type == "session_meta" || entry.Type == "turn_context":
+			if su.Cwd == "" {
+				su.Cwd = entry.Payload.Cwd
+			}
+			if entry.Payload.Model != "" {
+				su.Model = entry.Payload.Model
+			}
+		case entry.Payload.Type == "token_count" && entry.Payload.Info != nil:
+			total := entry.Payload.Info.TotalTokenUsage
+			su.TokenUsage = TokenUsage{
+				InputTokens:     total.InputTokens - total.CachedInputTokens,
+				OutputTokens:    total.OutputTokens,
+				CacheReadTokens: total.CachedInputTokens,
+			}
+		}
+	})
+	su.CostUSD = EstimateCost(su.Model, su.TokenUsage)
+	return su, err
+}
+
+// scanJSONLines calls fn for each non-empty line of r. Lines can be long
+// (tool results are logged inline), so the buffer grows up to 16MB.
+func scanJSONLines(r io.Reader, fn func([]byte)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if line := scanner.Bytes(); len(line) > 0 {
+			fn(line)
+		}
+	}
+	return scanner.Err()
+}
+
+var (
+	// Claude /cost: "Total cost:            $0.0345"
+	claudeCostPattern = regexp.MustCompile(`Total cost:\s+\$([0-9]+(?:\.[0-9]+)?)`)
+	// Claude /cost, one line per model:
+	// "claude-sonnet:  1.2k input, 345 output, 12.3k cache read, 1.0k cache write"
+	claudeModelUsagePattern = regexp.MustCompile(`([0-9.,]+[kmKM]?) input, ([0-9.,]+[kmKM]?) output(?:, ([0-9.,]+[kmKM]?) cache read, ([0-9.,]+[kmKM]?) cache write)?`)
+	// Codex exit summary:
+	// "Token usage: total=1,234 input=1,000 (+ 500 cached) output=234"
+	codexUsagePattern = regexp.MustCompile(`Token usage: total=[0-9,]+ input=([0-9,]+)(?: \(\+ ([0-9,]+) cached\))? output=([0-9,]+)`)
+)
+
+// ParseUsageOutput extracts usage from an agent CLI's own report: Claude
+// Code's /cost output or the token summary Codex prints on exit. Only the
+// last report in output is used, since each one is cumulative for the
+// session. It returns false if output contains no report.
+func ParseUsageOutput(output string) (TokenUsage, bool) {
+	if locs := claudeCostPattern.FindAllStringSubmatchIndex(output, -1); locs != nil {
+		last := locs[len(locs)-1]
+		var u TokenUsage
+		u.CostUSD, _ = strconv.ParseFloat(output[last[2]:last[3]], 64)
+		for _, m := range claudeModelUsagePattern.FindAllStringSubmatch(output[last[1]:], -1) {
+			u.InputTokens += parseTokenCount(m[1])
+			u.OutputTokens += parseTokenCount(m[2])
+			u.CacheReadTokens += parseTokenCount(m[3])
+			u.CacheWriteTokens += parseTokenCount(m[4])
+		}
+		return u, true
+	}
+
+	if matches := codexUsagePattern.FindAllStringSubmatch(output, -1); matches != nil {
+		m := matches[len(matches)-1]
+		cached := parseTokenCount(m[2])
+		return TokenUsage{
+			InputTokens:     parseTokenCount(m[1]) - cached,
+			OutputTokens:    parseTokenCount(m[3]),
+			CacheReadTokens: cached,
+		}, true
+	}
+	return TokenUsage{}, false
+}
+
+// parseTokenCount parses counts like "1,234", "12.3k" and "1.2M".
+func parseTokenCount(s string) int64 {
+	s = strings.ReplaceAll(s, ",", "")
+	if s == "" {
+		return 0
+	}
+	mult := 1.0
+	switch s[len(s)-1] {
+	case 'k', 'K':
+		mult, s = 1e3, s[:len(s)-1]
+	case 'm', 'M':
+		mult, s = 1e6, s[:len(s)-1]
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return int64(n*mult + 0.5)
+}
+
+// FormatTokens renders a token count compactly: 950, 12.3k, 4.5M.
+func FormatTokens(n int64) string {
+	switch {
+	case n >= 1_000_000:
+		return strconv.FormatFloat(float64(n)/1e6, 'f', 1, 64) + "M"
+	case n >= 1_000:
+		return strconv.FormatFloat(float64(n)/1e3, 'f', 1, 64) + "k"
+	default:
+		return strconv.FormatInt(n, 10)
+	}
+}
@@ -0,0 +1,274 @@
+package monitoring
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UsageSourceCLI marks records parsed from an agent CLI's own usage report
+// rather than read from a session log.
+const UsageSourceCLI = "cli"
+
+// UsageAgent is an agent whose session logs the collector reads.
+type UsageAgent struct {
+	ID   string // Agent address, e.g. "gastown/polecats/nux"
+	Dir  string // Directory the agent's sessions run in or under
+	Bead string // Bead on the agent's hook, charged with new usage
+}
+
+// fileStamp identifies a version of a session log.
+type fileStamp struct {
+	size    int64
+	modTime time.Time
+}
+
+// UsageCollector reads Claude Code and Codex session logs and appends the
+// usage of known agents to the usage ledger.
+//
+// Session logs hold running totals for a session, so each collection
+// records only the growth since the ledger last saw the log. That growth
+// is charged to the bead the agent has hooked at collection time; usage
+// from before the first collection is charged to whatever is hooked then.
+type UsageCollector struct {
+	ledger     *UsageLedger
+	agents     func() []UsageAgent
+	claudeDirs []string
+	codexDir   string
+	now        func() time.Time
+
+	mu       sync.Mutex
+	loaded   bool
+	recorded map[string]TokenUsage // Usage already in the ledger, by source
+	stamps   map[string]fileStamp  // Log versions already collected
+}
+
+// UsageCollectorOption configures a UsageCollector.
+type UsageCollectorOption func(*UsageCollector)
+
+// WithClaudeProjectsDirs sets the Claude Code projects directories to
+// read, e.g. one per account config dir.
+func WithClaudeProjectsDirs(dirs ...string) UsageCollectorOption {
+	return func(c *UsageCollector) { c.claudeDirs = dirs }
+}
+
+// WithCodexSessionsDir sets the Codex sessions directory to read.
+func WithCodexSessionsDir(dir string) UsageCollectorOption {
+	return func(c *UsageCollector) { c.codexDir = dir }
+}
+
+// NewUsageCollector creates a collector that charges usage to the agents
+// returned by agents. By default it reads $CLAUDE_CONFIG_DIR/projects (or
+// ~/.claude/projects) and $CODEX_HOME/sessions (or ~/.codex/sessions).
+func NewUsageCollector(ledger *UsageLedger, agents func() []UsageAgent, opts ...UsageCollectorOption) *UsageCollector {
+	c := &UsageCollector{
+		ledger:     ledger,
+		agents:     agents,
+		claudeDirs: []string{DefaultClaudeProjectsDir()},
+		codexDir:   defaultCodexSessionsDir(),
+		now:        time.Now,
+		recorded:   make(map[string]TokenUsage),
+		stamps:     make(map[string]fileStamp),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// DefaultClaudeProjectsDir is where Claude Code keeps session logs for the
+// current user.
+func DefaultClaudeProjectsDir() string {
+	if dir := os.Getenv("CLAUDE_CONFIG_DIR"); dir != "" {
+		return filepath.Join(dir, "projects")
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".claude", "projects")
+}
+
+func defaultCodexSessionsDir() string {
+	if dir := os.Getenv("CODEX_HOME"); dir != "" {
+		return filepath.Join(dir, "sessions")
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".codex", "sessions")
+}
+
+// Collect reads session logs changed since the last collection and
+// appends new usage to the ledger. It returns the records appended.
+func (c *UsageCollector) Collect() ([]UsageRecord, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		records, err := c.ledger.Records(time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range records {
+			if r.Source != "" && r.Source != UsageSourceCLI {
+				u := c.recorded[r.Source]
+				u.Add(r.TokenUsage)
+				c.recorded[r.Source] = u
+			}
+		}
+		c.loaded = true
+	}
+
+	agents := c.agents()
+	if len(agents) == 0 {
+		return nil, nil
+	}
+
+	var records []UsageRecord
+	stamps := make(map[string]fileStamp)
+	for _, f := range c.changedLogs(agents) {
+		su, err := f.parse(f.path)
+		if err != nil {
+			continue
+		}
+		stamps[f.path] = f.stamp
+		agent, ok := agentForDir(agents, su.Cwd)
+		if !ok {
+			continue
+		}
+		delta := su.TokenUsage.Sub(c.recorded[f.path])
+		if delta.IsZero() {
+			continue
+		}
+		records = append(records, UsageRecord{
+			Time:       c.now(),
+			Agent:      agent.ID,
+			Rig:        AgentRig(agent.ID),
+			Bead:       agent.Bead,
+			Model:      su.Model,
+			Source:     f.path,
+			TokenUsage: delta,
+		})
+	}
+
+	if err := c.ledger.Append(records...); err != nil {
+		return nil, err
+	}
+	for _, r := range records {
+		u := c.recorded[r.Source]
+		u.Add(r.TokenUsage)
+		c.recorded[r.Source] = u
+	}
+	for path, stamp := range stamps {
+		c.stamps[path] = stamp
+	}
+	return records, nil
+}
+
+// sessionLog is a session log file to parse.
+type sessionLog struct {
+	path  string
+	stamp fileStamp
+	parse func(path string) (SessionUsage, error)
+}
+
+// changedLogs lists session logs that changed since they were last
+// collected. Claude project directories are named after the session's
+// working directory, so only those under an agent's directory are read.
+func (c *UsageCollector) changedLogs(agents []UsageAgent) []sessionLog {
+	var prefixes []string
+	for _, a := range agents {
+		prefixes = append(prefixes, claudeProjectName(a.Dir))
+	}
+
+	var logs []sessionLog
+	add := func(path string, d fs.DirEntry, parse func(string) (SessionUsage, error)) {
+		info, err := d.Info()
+		if err != nil {
+			return
+		}
+		stamp := fileStamp{size: info.Size(), modTime: info.ModTime()}
+		if c.stamps[path] != stamp {
+			logs = append(logs, sessionLog{path: path, stamp: stamp, parse: parse})
+		}
+	}
+
+	for _, root := range c.claudeDirs {
+		projects, err := os.ReadDir(root)
+		if err != nil {
+			continue
+		}
+		for _, p := range projects {
+			if !p.IsDir() || !hasAnyPrefix(p.Name(), prefixes) {
+				continue
+			}
+			_ = filepath.WalkDir(filepath.Join(root, p.Name()), func(path string, d fs.DirEntry, err error) error {
+				if err == nil && !d.IsDir() && strings.HasSuffix(path, ".jsonl") {
+					add(path, d, parseClaudeLogFile)
+				}
+				return nil
+			})
+		}
+	}
+
+	if c.codexDir != "" {
+		_ = filepath.WalkDir(c.codexDir, func(path string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() && strings.HasPrefix(d.Name(), "rollout-") && strings.HasSuffix(path, ".jsonl") {
+				add(path, d, parseCodexLogFile)
+			}
+			return nil
+		})
+	}
+	return logs
+}
+
+func parseClaudeLogFile(path string) (SessionUsage, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return SessionUsage{}, err
+	}
+	defer f.Close()
+	return ParseClaudeSessionLog(f)
+}
+
+func parseCodexLogFile(path string) (SessionUsage, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return SessionUsage{}, err
+	}
+	defer f.Close()
+	return ParseCodexSessionLog(f)
+}
+
+// agentForDir returns the agent whose directory most specifically
+// contains dir.
+func agentForDir(agents []UsageAgent, dir string) (UsageAgent, bool) {
+	var best UsageAgent
+	found := false
+	for _, a := range agents {
+		if a.Dir == "" || (dir != a.Dir && !strings.HasPrefix(dir, a.Dir+string(filepath.Separator))) {
+			continue
+		}
+		if !found || len(a.Dir) > len(best.Dir) {
+			best, found = a, true
+		}
+	}
+	return best, found
+}
+
+var claudeProjectNameChars = regexp.MustCompile(`[^a-zA-Z0-9]`)
+
+// claudeProjectName is the projects subdirectory Claude Code uses for
+// sessions started in dir.
+func claudeProjectName(dir string) string {
+	return claudeProjectNameChars.ReplaceAllString(dir, "-")
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
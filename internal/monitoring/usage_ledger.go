@@ -0,0 +1,243 @@
+package monitoring
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// UsageLedgerFile is the usage ledger, relative to the town root.
+const UsageLedgerFile = "mayor/usage.jsonl"
+
+// BudgetWarnFraction is the share of a budget at which spend is flagged
+// before the budget is exceeded.
+const BudgetWarnFraction = 0.8
+
+// UsageRecord is one line of the usage ledger: tokens an agent used since
+// the previous record for the same source, charged to the bead it had
+// hooked when they were collected.
+type UsageRecord struct {
+	Time   time.Time `json:"time"`
+	Agent  string    `json:"agent"`
+	Rig    string    `json:"rig,omitempty"`
+	Bead   string    `json:"bead,omitempty"`
+	Model  string    `json:"model,omitempty"`
+	Source string    `json:"source,omitempty"` // Session log path, or "cli" for reported output
+	TokenUsage
+}
+
+// AgentRig returns the rig an agent address belongs to, or "" for
+// town-level agents such as "mayor/" and "deacon/".
+func AgentRig(agent string) string {
+	rig, _, ok := strings.Cut(agent, "/")
+	if !ok || rig == "mayor" || rig == "deacon" {
+		return ""
+	}
+	return rig
+}
+
+// UsageLedger appends usage records to mayor/usage.jsonl and reads them
+// back for summaries.
+type UsageLedger struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewUsageLedger creates the usage ledger for a town.
+func NewUsageLedger(townRoot string) *UsageLedger {
+	return &UsageLedger{path: filepath.Join(townRoot, UsageLedgerFile)}
+}
+
+// Append writes records to the end of the ledger.
+func (l *UsageLedger) Append(records ...UsageRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	var buf []byte
+	for _, r := range records {
+		data, err := json.Marshal(r)
+		if err != nil {
+			return fmt.Errorf("encoding usage record: %w", err)
+		}
+		buf = append(append(buf, data...), '\n')
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return fmt.Errorf("creating usage ledger directory: %w", err)
+	}
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening usage ledger: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(buf); err != nil {
+		return fmt.Errorf("writing usage ledger: %w", err)
+	}
+	return nil
+}
+
+// Records returns the records at or after since, oldest first. A missing
+// ledger has no records. Malformed lines are skipped.
+func (l *UsageLedger) Records(since time.Time) ([]UsageRecord, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.Open(l.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening usage ledger: %w", err)
+	}
+	defer f.Close()
+
+	var records []UsageRecord
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var r UsageRecord
+		if json.Unmarshal(scanner.Bytes(), &r) != nil || r.Time.Before(since) {
+			continue
+		}
+		records = append(records, r)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading usage ledger: %w", err)
+	}
+	return records, nil
+}
+
+// UsageTotal is the usage for one agent, rig, or bead.
+type UsageTotal struct {
+	Key string
+	TokenUsage
+}
+
+// UsageSummary aggregates usage records. Each breakdown is sorted by cost,
+// highest first; usage without a rig or bead is left out of that breakdown.
+type UsageSummary struct {
+	Total   TokenUsage
+	ByAgent []UsageTotal
+	ByRig   []UsageTotal
+	ByBead  []UsageTotal
+}
+
+// SummarizeUsage totals records per agent, rig, and bead.
+func SummarizeUsage(records []UsageRecord) *UsageSummary {
+	agents := make(map[string]*TokenUsage)
+	rigs := make(map[string]*TokenUsage)
+	beads := make(map[string]*TokenUsage)
+	add := func(m map[string]*TokenUsage, key string, u TokenUsage) {
+		if key == "" {
+			return
+		}
+		if m[key] == nil {
+			m[key] = &TokenUsage{}
+		}
+		m[key].Add(u)
+	}
+
+	s := &UsageSummary{}
+	for _, r := range records {
+		s.Total.Add(r.TokenUsage)
+		add(agents, r.Agent, r.TokenUsage)
+		add(rigs, r.Rig, r.TokenUsage)
+		add(beads, r.Bead, r.TokenUsage)
+	}
+	s.ByAgent = sortedUsageTotals(agents)
+	s.ByRig = sortedUsageTotals(rigs)
+	s.ByBead = sortedUsageTotals(beads)
+	return s
+}
+
+func sortedUsageTotals(m map[string]*TokenUsage) []UsageTotal {
+	totals := make([]UsageTotal, 0, len(m))
+	for key, u := range m {
+		totals = append(totals, UsageTotal{Key: key, TokenUsage: *u})
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].CostUSD != totals[j].CostUSD {
+			return totals[i].CostUSD > totals[j].CostUSD
+		}
+		return totals[i].Key < totals[j].Key
+	})
+	return totals
+}
+
+// Budget scopes.
+const (
+	BudgetScopeTown  = "town"
+	BudgetScopeRig   = "rig"
+	BudgetScopeAgent = "agent"
+)
+
+// BudgetStatus is spend against one daily budget.
+type BudgetStatus struct {
+	Scope    string // town, rig, or agent
+	Key      string // Rig or agent name; empty for the town
+	SpentUSD float64
+	LimitUSD float64
+}
+
+// Fraction is the share of the budget spent.
+func (b BudgetStatus) Fraction() float64 {
+	return b.SpentUSD / b.LimitUSD
+}
+
+// Exceeded reports whether spend has reached the limit.
+func (b BudgetStatus) Exceeded() bool {
+	return b.SpentUSD >= b.LimitUSD
+}
+
+// Warning reports whether spend is near the limit but under it.
+func (b BudgetStatus) Warning() bool {
+	return !b.Exceeded() && b.Fraction() >= BudgetWarnFraction
+}
+
+// CheckBudgets compares a summary of today's usage with the configured
+// budgets. The town budget is always reported when set; rig and agent
+// budgets only for rigs and agents at or over the warning threshold.
+// Results are sorted by the share of budget spent, highest first.
+func CheckBudgets(today *UsageSummary, budgets *config.UsageBudgets) []BudgetStatus {
+	if budgets == nil {
+		return nil
+	}
+	var out []BudgetStatus
+	if budgets.DailyUSD > 0 {
+		out = append(out, BudgetStatus{Scope: BudgetScopeTown, SpentUSD: today.Total.CostUSD, LimitUSD: budgets.DailyUSD})
+	}
+	check := func(scope string, totals []UsageTotal, limit float64) {
+		if limit <= 0 {
+			return
+		}
+		for _, t := range totals {
+			b := BudgetStatus{Scope: scope, Key: t.Key, SpentUSD: t.CostUSD, LimitUSD: limit}
+			if b.Fraction() >= BudgetWarnFraction {
+				out = append(out, b)
+			}
+		}
+	}
+	check(BudgetScopeRig, today.ByRig, budgets.RigDailyUSD)
+	check(BudgetScopeAgent, today.ByAgent, budgets.AgentDailyUSD)
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Fraction() > out[j].Fraction()
+	})
+	return out
+}
+
+// StartOfDay returns local midnight on t's day, where daily budgets reset.
+func StartOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
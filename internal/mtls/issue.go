@@ -0,0 +1,187 @@
+package mtls
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CAKeyFileName is the CA private key inside a CA directory.
+const CAKeyFileName = "ca.key"
+
+// CA is a local certificate authority for development clusters.
+type CA struct {
+	Cert    *x509.Certificate
+	CertPEM []byte
+	key     crypto.Signer
+}
+
+// LoadOrCreateCA loads the CA in dir (ca.crt, ca.key), creating a new one
+// valid for ten years if none exists. created reports which happened.
+func LoadOrCreateCA(dir, name string) (ca *CA, created bool, err error) {
+	certPath := filepath.Join(dir, CAFileName)
+	keyPath := filepath.Join(dir, CAKeyFileName)
+
+	if fileExists(certPath) && fileExists(keyPath) {
+		ca, err := loadCA(certPath, keyPath)
+		return ca, false, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, false, fmt.Errorf("generating CA key: %w", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          newSerial(),
+		Subject:               pkix.Name{CommonName: name, Organization: []string{"Gas Town"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, false, fmt.Errorf("creating CA certificate: %w", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, false, fmt.Errorf("creating CA directory: %w", err)
+	}
+	if err := os.WriteFile(keyPath, keyPEM, 0600); err != nil {
+		return nil, false, fmt.Errorf("writing CA key: %w", err)
+	}
+	if err := os.WriteFile(certPath, certPEM, 0644); err != nil {
+		return nil, false, fmt.Errorf("writing CA certificate: %w", err)
+	}
+	return &CA{Cert: cert, CertPEM: certPEM, key: key}, true, nil
+}
+
+func loadCA(certPath, keyPath string) (*CA, error) {
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA certificate: %w", err)
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM certificate in %s", certPath)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CA certificate: %w", err)
+	}
+
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA key: %w", err)
+	}
+	block, _ = pem.Decode(keyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM key in %s", keyPath)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing CA key: %w", err)
+	}
+	key, ok := parsed.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("CA key in %s cannot sign", keyPath)
+	}
+	return &CA{Cert: cert, CertPEM: certPEM, key: key}, nil
+}
+
+// IssueOptions describes a leaf certificate.
+type IssueOptions struct {
+	CommonName string
+	DNSNames   []string
+	IPs        []net.IP
+	// Agents are agent addresses the holder may act as (URI SANs).
+	Agents   []string
+	Validity time.Duration
+}
+
+// Issue signs a certificate usable for both server and client auth and
+// returns it with its private key, PEM-encoded.
+func (ca *CA) Issue(opts IssueOptions) (certPEM, keyPEM []byte, err error) {
+	if opts.Validity <= 0 {
+		opts.Validity = 90 * 24 * time.Hour
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generating key: %w", err)
+	}
+
+	var uris []*url.URL
+	for _, a := range opts.Agents {
+		uris = append(uris, AgentURI(a))
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: newSerial(),
+		Subject:      pkix.Name{CommonName: opts.CommonName, Organization: []string{"Gas Town"}},
+		DNSNames:     opts.DNSNames,
+		IPAddresses:  opts.IPs,
+		URIs:         uris,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(opts.Validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.Cert, &key.PublicKey, ca.key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("signing certificate: %w", err)
+	}
+	keyPEM, err = encodeKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), keyPEM, nil
+}
+
+// WriteDir writes a certificate, key, and CA in the Kubernetes TLS secret
+// layout, so the directory can be used with GT_TLS_DIR or turned into a
+// secret with 'kubectl create secret generic --from-file=<dir>'.
+func WriteDir(dir string, certPEM, keyPEM, caPEM []byte) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("creating %s: %w", dir, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, KeyFileName), keyPEM, 0600); err != nil {
+		return fmt.Errorf("writing key: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, CertFileName), certPEM, 0644); err != nil {
+		return fmt.Errorf("writing certificate: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, CAFileName), caPEM, 0644); err != nil {
+		return fmt.Errorf("writing CA certificate: %w", err)
+	}
+	return nil
+}
+
+func encodeKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("encoding key: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+func newSerial() *big.Int {
+	serial, _ := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 127))
+	return serial
+}
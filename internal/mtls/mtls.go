@@ -0,0 +1,339 @@
+// Package mtls loads certificates for mutual TLS between agents and the
+// Gas Town RPC server, and ties client certificates to agent identities.
+//
+// Certificates come from explicit files or from a directory laid out like a
+// mounted Kubernetes TLS secret (tls.crt, tls.key, ca.crt). An agent's
+// certificate names the agent it was issued to in a URI SAN of the form
+// gt://agent/<address>, e.g. gt://agent/gastown/polecats/nux.
+package mtls
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// File names inside a Kubernetes TLS secret mount.
+const (
+	CertFileName = "tls.crt"
+	KeyFileName  = "tls.key"
+	CAFileName   = "ca.crt"
+)
+
+// Environment variables read by FromEnv. GT_TLS_DIR names a secret-style
+// directory; the per-file variables override entries in it.
+const (
+	EnvDir  = "GT_TLS_DIR"
+	EnvCert = "GT_TLS_CERT"
+	EnvKey  = "GT_TLS_KEY"
+	EnvCA   = "GT_TLS_CA"
+)
+
+// agentURIHost is the host of the URI SAN that carries an agent address.
+const agentURIHost = "agent"
+
+// Files names the PEM files for one side of a TLS connection.
+// Any field may be empty.
+type Files struct {
+	Cert string
+	Key  string
+	CA   string
+}
+
+// FromDir returns the files of a Kubernetes TLS secret mounted at dir.
+// Files that don't exist are left empty.
+func FromDir(dir string) Files {
+	var f Files
+	if dir == "" {
+		return f
+	}
+	if p := filepath.Join(dir, CertFileName); fileExists(p) {
+		f.Cert = p
+	}
+	if p := filepath.Join(dir, KeyFileName); fileExists(p) {
+		f.Key = p
+	}
+	if p := filepath.Join(dir, CAFileName); fileExists(p) {
+		f.CA = p
+	}
+	return f
+}
+
+// FromEnv returns the files named by GT_TLS_DIR, GT_TLS_CERT, GT_TLS_KEY
+// and GT_TLS_CA.
+func FromEnv() Files {
+	f := FromDir(os.Getenv(EnvDir))
+	if v := os.Getenv(EnvCert); v != "" {
+		f.Cert = v
+	}
+	if v := os.Getenv(EnvKey); v != "" {
+		f.Key = v
+	}
+	if v := os.Getenv(EnvCA); v != "" {
+		f.CA = v
+	}
+	return f
+}
+
+// IsZero reports whether no files are configured.
+func (f Files) IsZero() bool {
+	return f.Cert == "" && f.Key == "" && f.CA == ""
+}
+
+// ServerConfig builds a server TLS config. When f.CA is set, clients must
+// present a certificate signed by it.
+func (f Files) ServerConfig() (*tls.Config, error) {
+	if f.Cert == "" || f.Key == "" {
+		return nil, fmt.Errorf("server TLS needs both a certificate and a key")
+	}
+	cert, err := tls.LoadX509KeyPair(f.Cert, f.Key)
+	if err != nil {
+		return nil, fmt.Errorf("loading TLS cert: %w", err)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if f.CA != "" {
+		pool, err := loadPool(f.CA)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+// ClientConfig builds a client TLS config: f.CA verifies the server and
+// f.Cert/f.Key, if set, are presented as the client certificate.
+func (f Files) ClientConfig() (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if f.CA != "" {
+		pool, err := loadPool(f.CA)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+	if f.Cert != "" || f.Key != "" {
+		if f.Cert == "" || f.Key == "" {
+			return nil, fmt.Errorf("client TLS needs both a certificate and a key")
+		}
+		cert, err := tls.LoadX509KeyPair(f.Cert, f.Key)
+		if err != nil {
+			return nil, fmt.Errorf("loading TLS client cert: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// ClientConfigFromEnv returns the client config for FromEnv, or nil when
+// no TLS files are configured in the environment.
+func ClientConfigFromEnv() (*tls.Config, error) {
+	f := FromEnv()
+	if f.IsZero() {
+		return nil, nil
+	}
+	return f.ClientConfig()
+}
+
+func loadPool(caFile string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	return pool, nil
+}
+
+// AgentURI returns the URI SAN naming an agent address.
+func AgentURI(address string) *url.URL {
+	return &url.URL{Scheme: "gt", Host: agentURIHost, Path: "/" + strings.TrimPrefix(address, "/")}
+}
+
+// AgentsFromCert returns the agent addresses a certificate was issued to.
+func AgentsFromCert(cert *x509.Certificate) []string {
+	var agents []string
+	for _, u := range cert.URIs {
+		if u.Scheme == "gt" && u.Host == agentURIHost && len(u.Path) > 1 {
+			agents = append(agents, strings.TrimPrefix(u.Path, "/"))
+		}
+	}
+	return agents
+}
+
+// CertAllowsAgent reports whether a client certificate may act as address.
+// Certificates without agent SANs (operators, other towns) may act as
+// anyone; agent certificates only as the agents they name.
+func CertAllowsAgent(cert *x509.Certificate, address string) bool {
+	agents := AgentsFromCert(cert)
+	if len(agents) == 0 || address == "" {
+		return true
+	}
+	want := strings.TrimSuffix(address, "/")
+	for _, a := range agents {
+		if strings.TrimSuffix(a, "/") == want {
+			return true
+		}
+	}
+	return false
+}
+
+type peerCertKey struct{}
+
+// PeerCertificate returns the client certificate recorded in ctx by
+// AgentIdentityMiddleware, or nil.
+func PeerCertificate(ctx context.Context) *x509.Certificate {
+	cert, _ := ctx.Value(peerCertKey{}).(*x509.Certificate)
+	return cert
+}
+
+// actorFields are the request fields in which a caller names who it is
+// acting as, in both their JSON and proto spellings. Fields naming the
+// agent a request is about (agent, assignee) are not claims of identity.
+var actorFields = []string{
+	"actor", "author",
+	"requestedBy", "requested_by",
+	"ackedBy", "acked_by",
+	"resolvedBy", "resolved_by",
+}
+
+// maxClaimBody bounds how much of a request body is buffered to check its
+// actor fields.
+const maxClaimBody = 4 << 20
+
+// AgentIdentityMiddleware rejects requests whose claimed sender is not
+// among the agents named in the client certificate, so one pod cannot
+// speak for another. The sender is claimed by the X-GT-From header and by
+// actor fields in the request body (actor, author, requestedBy, ackedBy,
+// resolvedBy). Agent certificates must call RPCs with the JSON codec so
+// those fields can be read. The certificate is made available to handlers
+// through PeerCertificate.
+func AgentIdentityMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		cert := r.TLS.PeerCertificates[0]
+		if len(AgentsFromCert(cert)) > 0 {
+			claims, status, err := requestClaims(r)
+			if err != nil {
+				http.Error(w, err.Error(), status)
+				return
+			}
+			for _, claimed := range claims {
+				if !CertAllowsAgent(cert, claimed) {
+					http.Error(w, fmt.Sprintf("client certificate is not valid for agent %q", claimed), http.StatusForbidden)
+					return
+				}
+			}
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), peerCertKey{}, cert)))
+	})
+}
+
+// requestClaims returns the agent addresses a request claims to act as.
+// The body is read and replaced so the handler still sees it. On error it
+// also returns the HTTP status to reject the request with.
+func requestClaims(r *http.Request) ([]string, int, error) {
+	claims := []string{r.Header.Get("X-GT-From")}
+	if r.Method != http.MethodPost || r.Body == nil {
+		return claims, 0, nil
+	}
+
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch {
+	case contentType == "application/json", contentType == "application/connect+json":
+	case contentType == "application/proto", contentType == "application/connect+proto",
+		strings.HasPrefix(contentType, "application/grpc"):
+		return nil, http.StatusUnsupportedMediaType, fmt.Errorf("agent certificates must call RPCs with the JSON codec")
+	default:
+		return claims, 0, nil // Not an RPC
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxClaimBody+1))
+	_ = r.Body.Close()
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("reading request: %w", err)
+	}
+	if len(data) > maxClaimBody {
+		return nil, http.StatusRequestEntityTooLarge, fmt.Errorf("request body over %d bytes", maxClaimBody)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(data))
+
+	msg := data
+	if contentType == "application/connect+json" {
+		// A streaming request is one enveloped message: a flags byte and a
+		// big-endian length, then the JSON.
+		if len(msg) < 5 || int(binary.BigEndian.Uint32(msg[1:5])) > len(msg)-5 {
+			return nil, http.StatusBadRequest, fmt.Errorf("malformed streaming request")
+		}
+		msg = msg[5 : 5+binary.BigEndian.Uint32(msg[1:5])]
+	}
+	if len(bytes.TrimSpace(msg)) == 0 {
+		return claims, 0, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &fields); err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("parsing request: %w", err)
+	}
+	for _, name := range actorFields {
+		raw, ok := fields[name]
+		if !ok {
+			continue
+		}
+		claimed, err := actorAddress(raw)
+		if err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("parsing %s: %w", name, err)
+		}
+		claims = append(claims, claimed)
+	}
+	return claims, 0, nil
+}
+
+// actorAddress returns the address in an actor field, which is either a
+// string or an AgentAddress object.
+func actorAddress(raw json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	var addr struct {
+		Rig  string `json:"rig"`
+		Role string `json:"role"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(raw, &addr); err != nil {
+		return "", err
+	}
+	switch {
+	case addr.Rig != "" && addr.Role != "" && addr.Name != "":
+		return addr.Rig + "/" + addr.Role + "/" + addr.Name, nil
+	case addr.Rig != "" && addr.Role != "":
+		return addr.Rig + "/" + addr.Role, nil
+	}
+	return addr.Name, nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,208 @@
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// issueDir issues a certificate from ca into a fresh directory.
+func issueDir(t *testing.T, ca *CA, opts IssueOptions) Files {
+	t.Helper()
+	certPEM, keyPEM, err := ca.Issue(opts)
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	dir := t.TempDir()
+	if err := WriteDir(dir, certPEM, keyPEM, ca.CertPEM); err != nil {
+		t.Fatalf("WriteDir: %v", err)
+	}
+	return FromDir(dir)
+}
+
+func TestLoadOrCreateCA(t *testing.T) {
+	dir := t.TempDir()
+	ca, created, err := LoadOrCreateCA(dir, "test CA")
+	if err != nil || !created {
+		t.Fatalf("LoadOrCreateCA = %v, %v", created, err)
+	}
+	if info, err := os.Stat(filepath.Join(dir, CAKeyFileName)); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("CA key should be written 0600: %v %v", info, err)
+	}
+
+	again, created, err := LoadOrCreateCA(dir, "test CA")
+	if err != nil || created {
+		t.Fatalf("second LoadOrCreateCA = %v, %v", created, err)
+	}
+	if !again.Cert.Equal(ca.Cert) {
+		t.Error("second load should return the existing CA")
+	}
+}
+
+func TestFromDirAndEnv(t *testing.T) {
+	ca, _, _ := LoadOrCreateCA(t.TempDir(), "test CA")
+	files := issueDir(t, ca, IssueOptions{CommonName: "daemon"})
+	if files.Cert == "" || files.Key == "" || files.CA == "" {
+		t.Fatalf("FromDir = %+v, want all files", files)
+	}
+	if !FromDir(t.TempDir()).IsZero() {
+		t.Error("FromDir of an empty directory should be zero")
+	}
+
+	t.Setenv(EnvDir, filepath.Dir(files.Cert))
+	t.Setenv(EnvCA, "/custom/ca.crt")
+	env := FromEnv()
+	if env.Cert != files.Cert || env.CA != "/custom/ca.crt" {
+		t.Errorf("FromEnv = %+v", env)
+	}
+}
+
+func TestCertAllowsAgent(t *testing.T) {
+	ca, _, _ := LoadOrCreateCA(t.TempDir(), "test CA")
+	certPEM, _, err := ca.Issue(IssueOptions{CommonName: "nux", Agents: []string{"gastown/polecats/nux"}})
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	cert := parseCert(t, certPEM)
+
+	if got := AgentsFromCert(cert); len(got) != 1 || got[0] != "gastown/polecats/nux" {
+		t.Errorf("AgentsFromCert = %v", got)
+	}
+	tests := map[string]bool{
+		"gastown/polecats/nux":  true,
+		"gastown/polecats/nux/": true,
+		"":                      true,
+		"gastown/polecats/furi": false,
+		"mayor/":                false,
+	}
+	for addr, want := range tests {
+		if got := CertAllowsAgent(cert, addr); got != want {
+			t.Errorf("CertAllowsAgent(%q) = %v, want %v", addr, got, want)
+		}
+	}
+
+	operatorPEM, _, _ := ca.Issue(IssueOptions{CommonName: "operator"})
+	if !CertAllowsAgent(parseCert(t, operatorPEM), "mayor/") {
+		t.Error("certificate without agent SANs should allow any sender")
+	}
+}
+
+func TestMutualTLSHandshake(t *testing.T) {
+	ca, _, _ := LoadOrCreateCA(t.TempDir(), "test CA")
+	serverFiles := issueDir(t, ca, IssueOptions{
+		CommonName: "daemon",
+		IPs:        []net.IP{net.ParseIP("127.0.0.1")},
+	})
+	clientFiles := issueDir(t, ca, IssueOptions{CommonName: "nux", Agents: []string{"gastown/polecats/nux"}})
+
+	serverCfg, err := serverFiles.ServerConfig()
+	if err != nil {
+		t.Fatalf("ServerConfig: %v", err)
+	}
+	srv := httptest.NewUnstartedServer(AgentIdentityMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cert := PeerCertificate(r.Context()); cert == nil || cert.Subject.CommonName != "nux" {
+			http.Error(w, "peer certificate not recorded", http.StatusInternalServerError)
+			return
+		}
+		io.WriteString(w, "ok")
+	})))
+	srv.TLS = serverCfg
+	srv.StartTLS()
+	defer srv.Close()
+
+	get := func(files Files, from string) (int, error) {
+		cfg, err := files.ClientConfig()
+		if err != nil {
+			t.Fatalf("ClientConfig: %v", err)
+		}
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: cfg}}
+		req, _ := http.NewRequest("GET", srv.URL, nil)
+		if from != "" {
+			req.Header.Set("X-GT-From", from)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return 0, err
+		}
+		resp.Body.Close()
+		return resp.StatusCode, nil
+	}
+
+	if code, err := get(clientFiles, "gastown/polecats/nux"); err != nil || code != http.StatusOK {
+		t.Errorf("own address: %d, %v", code, err)
+	}
+	if code, err := get(clientFiles, "gastown/polecats/furi"); err != nil || code != http.StatusForbidden {
+		t.Errorf("other agent's address: %d, %v; want 403", code, err)
+	}
+	if _, err := get(Files{CA: clientFiles.CA}, ""); err == nil {
+		t.Error("client without a certificate should fail the handshake")
+	}
+}
+
+func TestAgentIdentityMiddlewareBodyClaims(t *testing.T) {
+	ca, _, _ := LoadOrCreateCA(t.TempDir(), "test CA")
+	agentPEM, _, _ := ca.Issue(IssueOptions{CommonName: "nux", Agents: []string{"gastown/polecats/nux"}})
+	operatorPEM, _, _ := ca.Issue(IssueOptions{CommonName: "operator"})
+
+	handler := AgentIdentityMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Write(body)
+	}))
+	post := func(certPEM []byte, contentType, body string) (int, string) {
+		req := httptest.NewRequest("POST", "/gastown.v1.BeadsService/CreateIssue", strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{parseCert(t, certPEM)}}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code, rec.Body.String()
+	}
+
+	tests := []struct {
+		name        string
+		cert        []byte
+		contentType string
+		body        string
+		want        int
+	}{
+		{"own actor", agentPEM, "application/json", `{"title":"x","actor":"gastown/polecats/nux"}`, http.StatusOK},
+		{"other actor", agentPEM, "application/json", `{"title":"x","actor":"mayor/"}`, http.StatusForbidden},
+		{"proto field name", agentPEM, "application/json", `{"resolved_by":"gastown/polecats/furi"}`, http.StatusForbidden},
+		{"agent address", agentPEM, "application/json", `{"requestedBy":{"rig":"gastown","role":"polecats","name":"furi"}}`, http.StatusForbidden},
+		{"own agent address", agentPEM, "application/json", `{"requestedBy":{"rig":"gastown","role":"polecats","name":"nux"}}`, http.StatusOK},
+		{"streaming envelope", agentPEM, "application/connect+json", "\x00\x00\x00\x00\x13" + `{"author":"mayor/"}`, http.StatusForbidden},
+		{"protobuf codec", agentPEM, "application/proto", "", http.StatusUnsupportedMediaType},
+		{"operator", operatorPEM, "application/json", `{"actor":"mayor/"}`, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, body := post(tt.cert, tt.contentType, tt.body)
+			if code != tt.want {
+				t.Fatalf("status = %d, want %d (%s)", code, tt.want, body)
+			}
+			if code == http.StatusOK && body != tt.body {
+				t.Errorf("handler saw body %q, want %q", body, tt.body)
+			}
+		})
+	}
+}
+
+func parseCert(t *testing.T, certPEM []byte) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		t.Fatal("no PEM block")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("ParseCertificate: %v", err)
+	}
+	return cert
+}
@@ -0,0 +1,438 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/configbeads"
+	"github.com/steveyegge/gastown/internal/eventbus"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/slackbot"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// Notification is something the overseer may want to hear about.
+type Notification struct {
+	Event    string    `json:"event"`          // config.NotifyEvent*
+	Severity string    `json:"severity"`       // config.Severity*
+	From     string    `json:"from,omitempty"` // Sender address, routes Slack by rig
+	Title    string    `json:"title"`          // One-line summary
+	Body     string    `json:"body,omitempty"` // Details for mail
+	Bead     string    `json:"bead,omitempty"` // Related bead, if any
+	At       time.Time `json:"at"`             // When it happened
+}
+
+// Delivery reports what the dispatcher did with a notification.
+type Delivery struct {
+	Suppressed bool     `json:"suppressed,omitempty"` // Event type turned off
+	Digested   bool     `json:"digested,omitempty"`   // Held for the next digest
+	Notified   []string `json:"notified,omitempty"`   // Channels delivered to, e.g. "slack:C123"
+	Errors     []string `json:"errors,omitempty"`
+}
+
+// Dispatcher delivers notifications to the overseer according to their
+// notification preferences: event types they turned off are dropped,
+// low-severity notifications are held for an hourly digest in digest mode,
+// and the rest go out on the channels they chose. Escalations, the mail
+// router and the Slack bot all notify the overseer through it.
+type Dispatcher struct {
+	townRoot string
+	overseer string
+
+	once     sync.Once
+	prefs    *config.NotificationPrefs
+	loadErr  error
+	loadFunc func() (*config.NotificationPrefs, error)
+
+	sendMail  func(msg *mail.Message) error
+	postSlack func(channel, text string, blocks []map[string]interface{}) error
+	publish   func(n eventbus.Notification) error
+	now       func() time.Time
+}
+
+// NewDispatcher returns a dispatcher for the town's overseer. Preferences
+// are read from the overseer's notifications config bead on first use.
+func NewDispatcher(townRoot string) *Dispatcher {
+	d := &Dispatcher{
+		townRoot: townRoot,
+		overseer: OverseerKey(townRoot),
+		now:      time.Now,
+	}
+	d.loadFunc = func() (*config.NotificationPrefs, error) {
+		return configbeads.LoadNotificationPrefs(beads.New(townRoot), d.overseer)
+	}
+	d.sendMail = func(msg *mail.Message) error {
+		return mail.NewRouterWithTownRoot(townRoot, townRoot).Send(msg)
+	}
+	d.publish = func(n eventbus.Notification) error {
+		return eventbus.Publish("overseer", n)
+	}
+	return d
+}
+
+// OverseerKey returns the key the town's overseer's preferences are stored
+// under: their username, else their name, else "overseer".
+func OverseerKey(townRoot string) string {
+	cfg, err := config.LoadOverseerConfig(config.OverseerConfigPath(townRoot))
+	if err != nil {
+		return "overseer"
+	}
+	key := cfg.Username
+	if key == "" {
+		key = cfg.Name
+	}
+	key = strings.ToLower(strings.Join(strings.Fields(key), "-"))
+	if key == "" {
+		return "overseer"
+	}
+	return key
+}
+
+// Prefs returns the overseer's preferences. If they can't be read, the
+// defaults are used so notifications are never lost to a beads outage.
+func (d *Dispatcher) Prefs() *config.NotificationPrefs {
+	d.once.Do(func() {
+		d.prefs, d.loadErr = d.loadFunc()
+		if d.loadErr != nil {
+			log.Printf("notify: reading notification preferences, using defaults: %v", d.loadErr)
+			d.prefs = config.NewNotificationPrefs()
+		}
+	})
+	return d.prefs
+}
+
+// Notify delivers n now, holds it for the digest, or drops it, as the
+// overseer's preferences say. Delivery is best-effort: failures are
+// reported in the result rather than returned.
+func (d *Dispatcher) Notify(n Notification) *Delivery {
+	if n.Severity == "" {
+		n.Severity = config.SeverityMedium
+	}
+	if n.At.IsZero() {
+		n.At = d.now()
+	}
+
+	prefs := d.Prefs()
+	result := &Delivery{}
+	if !prefs.EventEnabled(n.Event) {
+		result.Suppressed = true
+		return result
+	}
+	if !prefs.Immediate(n.Severity) {
+		if err := d.enqueue(n); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("digest: %v", err))
+		} else {
+			result.Digested = true
+		}
+		return result
+	}
+
+	d.deliver(result, n, 0)
+	return result
+}
+
+// NotifyOverseer notifies the overseer of an event, implementing
+// slackbot.OverseerNotifier.
+func (d *Dispatcher) NotifyOverseer(event, severity, title, body, bead string) {
+	result := d.Notify(Notification{Event: event, Severity: severity, Title: title, Body: body, Bead: bead})
+	for _, e := range result.Errors {
+		log.Printf("notify: %s: %s", event, e)
+	}
+}
+
+// deliver sends n on every enabled channel. digest is the number of
+// notifications n summarizes, 0 for a single notification.
+func (d *Dispatcher) deliver(result *Delivery, n Notification, digest int) {
+	prefs := d.Prefs()
+
+	// Mail delivered to the overseer is already in their inbox.
+	if prefs.ChannelEnabled(config.NotifyChannelMail) && n.Event != config.NotifyEventMail {
+		msg := &mail.Message{
+			From:       n.From,
+			To:         "overseer",
+			Subject:    subject(n),
+			Body:       n.Body,
+			Type:       mail.TypeNotification,
+			Priority:   mailPriority(n.Severity),
+			SkipNotify: true, // Don't hand the message back to the dispatcher
+		}
+		if msg.From == "" {
+			msg.From = "gt"
+		}
+		if err := d.sendMail(msg); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("mail: %v", err))
+		} else {
+			result.Notified = append(result.Notified, "mail:overseer")
+		}
+	}
+
+	if prefs.ChannelEnabled(config.NotifyChannelSlack) {
+		channel, err := d.slack(n, digest)
+		switch {
+		case err != nil:
+			result.Errors = append(result.Errors, fmt.Sprintf("slack: %v", err))
+		case channel != "":
+			result.Notified = append(result.Notified, "slack:"+channel)
+		}
+	}
+
+	if prefs.ChannelEnabled(config.NotifyChannelPush) {
+		event := eventbus.Notification{
+			Event:    n.Event,
+			Severity: n.Severity,
+			Title:    n.Title,
+			Body:     n.Body,
+			Bead:     n.Bead,
+			Digest:   digest,
+		}
+		if err := d.publish(event); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("push: %v", err))
+		} else {
+			result.Notified = append(result.Notified, "push")
+		}
+		if prefs.PushURL != "" {
+			if err := postPush(prefs.PushURL, event); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("push %s: %v", prefs.PushURL, err))
+			} else {
+				result.Notified = append(result.Notified, "push:"+prefs.PushURL)
+			}
+		}
+	}
+}
+
+// slack posts n to the overseer's Slack channel, or to the channel
+// settings/slack.json routes the sender's rig and severity to. It returns
+// an empty channel, without error, when there is nowhere to post or the
+// route is in quiet hours.
+func (d *Dispatcher) slack(n Notification, digest int) (string, error) {
+	router, err := slackbot.NewRouter(config.SlackConfigPath(d.townRoot))
+	if err != nil {
+		return "", err
+	}
+	channel := d.Prefs().SlackChannel
+	if channel == "" {
+		route := router.Route(slackbot.RigOf(n.From), n.Severity, n.At)
+		if route.Quiet {
+			return "", nil
+		}
+		channel = route.Channel
+	}
+	if channel == "" {
+		return "", nil
+	}
+
+	post := d.postSlack
+	if post == nil {
+		token := router.Config().BotToken
+		if token == "" {
+			token = os.Getenv("SLACK_BOT_TOKEN")
+		}
+		if token == "" {
+			return "", nil
+		}
+		post = func(channel, text string, blocks []map[string]interface{}) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			_, err := slackbot.NewAPI(token).PostMessage(ctx, channel, text, blocks)
+			return err
+		}
+	}
+
+	text := subject(n)
+	if digest > 0 {
+		text = n.Title
+	}
+	blocks := []map[string]interface{}{
+		{"type": "section", "text": map[string]string{"type": "mrkdwn", "text": "*" + text + "*"}},
+	}
+	if n.Body != "" {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "section",
+			"text": map[string]string{"type": "mrkdwn", "text": truncate(n.Body, 2900)},
+		})
+	}
+	if err := post(channel, text, blocks); err != nil {
+		return "", err
+	}
+	return channel, nil
+}
+
+// postPush sends a push notification as a JSON POST to a push gateway.
+func postPush(url string, n eventbus.Notification) error {
+	data, err := json.Marshal(n.Payload())
+	if err != nil {
+		return err
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(data)) //nolint:gosec // URL is the overseer's configured gateway
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("gateway returned %s", resp.Status)
+	}
+	return nil
+}
+
+// digestQueue is the notifications held for the next digest, persisted so
+// notifications raised by short-lived gt commands reach the daemon's flush.
+type digestQueue struct {
+	Since time.Time      `json:"since"`
+	Items []Notification `json:"items"`
+}
+
+func digestPath(townRoot string) string {
+	return filepath.Join(townRoot, ".runtime", "notify-digest.json")
+}
+
+// withDigestQueue runs fn on the digest queue under a file lock, saving
+// the queue afterwards if fn returns true.
+func (d *Dispatcher) withDigestQueue(fn func(q *digestQueue) bool) error {
+	path := digestPath(d.townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating runtime directory: %w", err)
+	}
+	lock := flock.New(path + ".lock")
+	if err := lock.Lock(); err != nil {
+		return fmt.Errorf("locking digest queue: %w", err)
+	}
+	defer func() { _ = lock.Unlock() }()
+
+	var q digestQueue
+	if data, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(data, &q); err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	if !fn(&q) {
+		return nil
+	}
+	return util.AtomicWriteJSON(path, &q)
+}
+
+func (d *Dispatcher) enqueue(n Notification) error {
+	return d.withDigestQueue(func(q *digestQueue) bool {
+		if len(q.Items) == 0 {
+			q.Since = n.At
+		}
+		q.Items = append(q.Items, n)
+		return true
+	})
+}
+
+// Pending returns the notifications held for the next digest.
+func (d *Dispatcher) Pending() ([]Notification, error) {
+	var items []Notification
+	err := d.withDigestQueue(func(q *digestQueue) bool {
+		items = q.Items
+		return false
+	})
+	return items, err
+}
+
+// FlushDigest sends the held notifications as one digest once the digest
+// interval has passed since the oldest of them, or at once if force is
+// set. It returns the number of notifications summarized.
+func (d *Dispatcher) FlushDigest(force bool) (int, *Delivery, error) {
+	var items []Notification
+	err := d.withDigestQueue(func(q *digestQueue) bool {
+		if len(q.Items) == 0 {
+			return false
+		}
+		if !force && d.now().Sub(q.Since) < d.Prefs().GetDigestInterval() {
+			return false
+		}
+		items = q.Items
+		*q = digestQueue{}
+		return true
+	})
+	if err != nil || len(items) == 0 {
+		return 0, nil, err
+	}
+
+	result := &Delivery{}
+	d.deliver(result, digestNotification(items, d.now()), len(items))
+	return len(items), result, nil
+}
+
+// digestNotification summarizes held notifications, most severe first.
+func digestNotification(items []Notification, now time.Time) Notification {
+	worst := config.SeverityLow
+	var lines []string
+	for _, n := range items {
+		if config.SeverityRank(n.Severity) > config.SeverityRank(worst) {
+			worst = n.Severity
+		}
+		line := fmt.Sprintf("• [%s] %s: %s", strings.ToUpper(n.Severity), n.Event, n.Title)
+		if n.Bead != "" {
+			line += " (" + n.Bead + ")"
+		}
+		lines = append(lines, line)
+	}
+	return Notification{
+		Event:    "digest",
+		Severity: worst,
+		Title:    fmt.Sprintf("Digest: %d notification(s) since %s", len(items), items[0].At.Local().Format("15:04")),
+		Body:     strings.Join(lines, "\n"),
+		At:       now,
+	}
+}
+
+func subject(n Notification) string {
+	return fmt.Sprintf("[%s] %s", strings.ToUpper(n.Severity), n.Title)
+}
+
+// mailPriority maps a notification severity to a mail priority.
+func mailPriority(severity string) mail.Priority {
+	switch severity {
+	case config.SeverityCritical:
+		return mail.PriorityUrgent
+	case config.SeverityHigh:
+		return mail.PriorityHigh
+	case config.SeverityLow:
+		return mail.PriorityLow
+	default:
+		return mail.PriorityNormal
+	}
+}
+
+// severityForPriority maps a mail priority to a notification severity.
+func severityForPriority(p mail.Priority) string {
+	switch p {
+	case mail.PriorityUrgent:
+		return config.SeverityCritical
+	case mail.PriorityHigh:
+		return config.SeverityHigh
+	case mail.PriorityLow:
+		return config.SeverityLow
+	default:
+		return config.SeverityMedium
+	}
+}
+
+func init() {
+	mail.OverseerNotifier = func(townRoot string, msg *mail.Message) {
+		NewDispatcher(townRoot).Notify(Notification{
+			Event:    config.NotifyEventMail,
+			Severity: severityForPriority(msg.Priority),
+			From:     msg.From,
+			Title:    fmt.Sprintf("Mail from %s: %s", msg.From, msg.Subject),
+			Body:     msg.Body,
+		})
+	}
+}
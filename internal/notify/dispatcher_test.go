@@ -0,0 +1,87 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/eventbus"
+	"github.com/steveyegge/gastown/internal/mail"
+)
+
+func testDispatcher(t *testing.T, prefs *config.NotificationPrefs) (*Dispatcher, *[]*mail.Message, *[]eventbus.Notification) {
+	t.Helper()
+	var mails []*mail.Message
+	var pushes []eventbus.Notification
+	now := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	d := &Dispatcher{
+		townRoot: t.TempDir(),
+		overseer: "steve",
+		loadFunc: func() (*config.NotificationPrefs, error) { return prefs, nil },
+		sendMail: func(msg *mail.Message) error {
+			mails = append(mails, msg)
+			return nil
+		},
+		publish: func(n eventbus.Notification) error {
+			pushes = append(pushes, n)
+			return nil
+		},
+		now: func() time.Time { return now },
+	}
+	return d, &mails, &pushes
+}
+
+func TestDispatcherDigest(t *testing.T) {
+	prefs := &config.NotificationPrefs{
+		Mode:              config.NotifyModeDigest,
+		ImmediateSeverity: config.SeverityHigh,
+		Channels:          []string{config.NotifyChannelMail, config.NotifyChannelPush},
+		Events:            map[string]bool{config.NotifyEventConvoy: false},
+	}
+	d, mails, pushes := testDispatcher(t, prefs)
+
+	if r := d.Notify(Notification{Event: config.NotifyEventConvoy, Severity: config.SeverityCritical, Title: "landed"}); !r.Suppressed {
+		t.Errorf("disabled event delivered: %+v", r)
+	}
+	if r := d.Notify(Notification{Event: config.NotifyEventEscalation, Severity: config.SeverityHigh, Title: "db down"}); len(r.Notified) != 2 {
+		t.Errorf("high escalation notified %v, want mail and push", r.Notified)
+	}
+	if r := d.Notify(Notification{Event: config.NotifyEventMail, Severity: config.SeverityLow, Title: "fyi"}); !r.Digested {
+		t.Errorf("low mail not digested: %+v", r)
+	}
+	d.Notify(Notification{Event: config.NotifyEventEscalation, Severity: config.SeverityMedium, Title: "flaky test", Bead: "hq-1"})
+	if len(*mails) != 1 || len(*pushes) != 1 {
+		t.Fatalf("before flush: %d mails, %d pushes", len(*mails), len(*pushes))
+	}
+
+	if n, _, err := d.FlushDigest(false); err != nil || n != 0 {
+		t.Fatalf("FlushDigest before interval = %d, %v", n, err)
+	}
+	d.now = func() time.Time { return time.Date(2026, 1, 2, 11, 0, 0, 0, time.UTC) }
+	n, delivery, err := d.FlushDigest(false)
+	if err != nil || n != 2 {
+		t.Fatalf("FlushDigest = %d, %v", n, err)
+	}
+	if len(delivery.Notified) != 2 {
+		t.Errorf("digest notified %v", delivery.Notified)
+	}
+	digest := (*mails)[1]
+	if !strings.Contains(digest.Body, "fyi") || !strings.Contains(digest.Body, "flaky test (hq-1)") {
+		t.Errorf("digest body = %q", digest.Body)
+	}
+	if (*pushes)[1].Digest != 2 {
+		t.Errorf("push digest count = %d", (*pushes)[1].Digest)
+	}
+	if pending, _ := d.Pending(); len(pending) != 0 {
+		t.Errorf("queue not cleared: %v", pending)
+	}
+}
+
+func TestDispatcherMailEventSkipsMail(t *testing.T) {
+	d, mails, _ := testDispatcher(t, config.NewNotificationPrefs())
+	r := d.Notify(Notification{Event: config.NotifyEventMail, Title: "hello"})
+	if len(*mails) != 0 {
+		t.Errorf("mail notification re-sent as mail: %v", r.Notified)
+	}
+}
@@ -0,0 +1,101 @@
+package patrol
+
+import "sort"
+
+// Change fields.
+const (
+	FieldAgent   = "agent" // Agent appeared in (To) or dropped out of (From) the patrol
+	FieldState   = "state"
+	FieldHook    = "hook"
+	FieldAnomaly = "anomaly" // Added (To set) or cleared (From set)
+	FieldAction  = "action"  // Taken this patrol (To set)
+)
+
+// Change is one difference between two patrols' views of an agent.
+type Change struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+}
+
+// Diff returns what changed for an agent between two patrols. prev or cur
+// is nil when the agent was not in that patrol. Actions are per patrol, so
+// every action in cur is a change.
+func Diff(prev, cur *AgentStatus) []Change {
+	switch {
+	case prev == nil && cur == nil:
+		return nil
+	case prev == nil:
+		return append([]Change{{Field: FieldAgent, To: cur.State}}, actionChanges(cur)...)
+	case cur == nil:
+		return []Change{{Field: FieldAgent, From: prev.State}}
+	}
+
+	var changes []Change
+	if prev.State != cur.State {
+		changes = append(changes, Change{Field: FieldState, From: prev.State, To: cur.State})
+	}
+	if prev.HookBead != cur.HookBead {
+		changes = append(changes, Change{Field: FieldHook, From: prev.HookBead, To: cur.HookBead})
+	}
+	for _, a := range missing(cur.Anomalies, prev.Anomalies) {
+		changes = append(changes, Change{Field: FieldAnomaly, To: a})
+	}
+	for _, a := range missing(prev.Anomalies, cur.Anomalies) {
+		changes = append(changes, Change{Field: FieldAnomaly, From: a})
+	}
+	return append(changes, actionChanges(cur)...)
+}
+
+func actionChanges(s *AgentStatus) []Change {
+	var changes []Change
+	for _, a := range s.Actions {
+		changes = append(changes, Change{Field: FieldAction, To: a})
+	}
+	return changes
+}
+
+// missing returns the items of a not in b.
+func missing(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, s := range b {
+		in[s] = true
+	}
+	var out []string
+	for _, s := range a {
+		if !in[s] {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// AgentDiff is what changed for one agent between two reports.
+type AgentDiff struct {
+	Agent   string   `json:"agent"`
+	Changes []Change `json:"changes"`
+}
+
+// DiffReports returns the agents that changed between two reports, in
+// agent order. Agents with no changes are left out.
+func DiffReports(prev, cur *Report) []AgentDiff {
+	seen := make(map[string]bool)
+	var agents []string
+	for _, r := range []*Report{prev, cur} {
+		for _, s := range r.Agents {
+			if !seen[s.Agent] {
+				seen[s.Agent] = true
+				agents = append(agents, s.Agent)
+			}
+		}
+	}
+	sort.Strings(agents)
+
+	var diffs []AgentDiff
+	for _, agent := range agents {
+		if changes := Diff(prev.Agent(agent), cur.Agent(agent)); len(changes) > 0 {
+			diffs = append(diffs, AgentDiff{Agent: agent, Changes: changes})
+		}
+	}
+	return diffs
+}
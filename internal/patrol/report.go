@@ -0,0 +1,222 @@
+// Package patrol records what each patrol saw, so changes in agent health
+// can be traced from one patrol to the next.
+//
+// A witness files one Report per patrol: the status of every agent it
+// checked, the anomalies it found, and what it did about them. Reports are
+// appended to a JSONL log in the town; History and Diff read them back.
+package patrol
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ReportsFile is the patrol report log, relative to the town root.
+const ReportsFile = ".runtime/patrol-reports.jsonl"
+
+// MaxLogBytes is the size past which Append compacts the log to its newer
+// half. A witness patrolling every few minutes stays well under it for
+// weeks.
+const MaxLogBytes = 8 << 20
+
+// AgentStatus is one agent as seen by one patrol.
+type AgentStatus struct {
+	Agent     string   `json:"agent"`               // e.g., gastown/polecats/nux
+	State     string   `json:"state"`               // e.g., working, idle, stuck, done
+	HookBead  string   `json:"hook_bead,omitempty"` // Work on the agent's hook
+	Anomalies []string `json:"anomalies,omitempty"` // Problems seen this patrol
+	Actions   []string `json:"actions,omitempty"`   // What the patroller did about them
+}
+
+// Report is the outcome of one patrol.
+type Report struct {
+	Time      time.Time     `json:"time"`
+	Patroller string        `json:"patroller"` // e.g., gastown/witness
+	Rig       string        `json:"rig,omitempty"`
+	Agents    []AgentStatus `json:"agents"`
+	Note      string        `json:"note,omitempty"`
+}
+
+// Agent returns the status of agent in r, or nil if r did not check it.
+func (r *Report) Agent(agent string) *AgentStatus {
+	for i := range r.Agents {
+		if r.Agents[i].Agent == agent {
+			return &r.Agents[i]
+		}
+	}
+	return nil
+}
+
+// Log is the append-only patrol report log of a town.
+type Log struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewLog returns the patrol report log for a town.
+func NewLog(townRoot string) *Log {
+	return &Log{path: filepath.Join(townRoot, ReportsFile)}
+}
+
+// Append adds r to the log, stamping it with the current time if unset.
+func (l *Log) Append(r *Report) error {
+	if r.Patroller == "" {
+		return errors.New("report has no patroller")
+	}
+	if r.Time.IsZero() {
+		r.Time = time.Now().UTC()
+	}
+	sort.Slice(r.Agents, func(i, j int) bool { return r.Agents[i].Agent < r.Agents[j].Agent })
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("encoding patrol report: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return fmt.Errorf("creating patrol log directory: %w", err)
+	}
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening patrol log: %w", err)
+	}
+	_, err = f.Write(append(data, '\n'))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("writing patrol log: %w", err)
+	}
+
+	if info, err := os.Stat(l.path); err == nil && info.Size() > MaxLogBytes {
+		return l.compact()
+	}
+	return nil
+}
+
+// compact rewrites the log keeping its newer half. Callers hold l.mu.
+func (l *Log) compact() error {
+	reports, err := l.read()
+	if err != nil {
+		return err
+	}
+	reports = reports[len(reports)/2:]
+
+	tmp := l.path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("compacting patrol log: %w", err)
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, r := range reports {
+		if err := enc.Encode(r); err != nil {
+			f.Close()
+			os.Remove(tmp)
+			return fmt.Errorf("compacting patrol log: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return fmt.Errorf("compacting patrol log: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("compacting patrol log: %w", err)
+	}
+	return os.Rename(tmp, l.path)
+}
+
+// Read returns every report in the log, oldest first. Lines that don't
+// parse are skipped.
+func (l *Log) Read() ([]*Report, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.read()
+}
+
+func (l *Log) read() ([]*Report, error) {
+	f, err := os.Open(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening patrol log: %w", err)
+	}
+	defer f.Close()
+
+	var reports []*Report
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var r Report
+		if json.Unmarshal(scanner.Bytes(), &r) == nil && r.Patroller != "" {
+			reports = append(reports, &r)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading patrol log: %w", err)
+	}
+	return reports, nil
+}
+
+// Reports returns the newest limit reports filed by patroller (all
+// patrollers if empty), oldest first. A limit of zero means no limit.
+func (l *Log) Reports(patroller string, limit int) ([]*Report, error) {
+	all, err := l.Read()
+	if err != nil {
+		return nil, err
+	}
+	var out []*Report
+	for _, r := range all {
+		if patroller == "" || r.Patroller == patroller {
+			out = append(out, r)
+		}
+	}
+	if limit > 0 && len(out) > limit {
+		out = out[len(out)-limit:]
+	}
+	return out, nil
+}
+
+// Entry is an agent's status in one patrol report.
+type Entry struct {
+	Time      time.Time   `json:"time"`
+	Patroller string      `json:"patroller"`
+	Status    AgentStatus `json:"status"`
+	// Changes is what differs from the agent's previous entry, if any.
+	Changes []Change `json:"changes,omitempty"`
+}
+
+// History returns the newest limit patrol entries for agent, oldest first,
+// each with what changed since the one before. A limit of zero means no
+// limit.
+func (l *Log) History(agent string, limit int) ([]Entry, error) {
+	all, err := l.Read()
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	for _, r := range all {
+		if s := r.Agent(agent); s != nil {
+			entries = append(entries, Entry{Time: r.Time, Patroller: r.Patroller, Status: *s})
+		}
+	}
+	for i := 1; i < len(entries); i++ {
+		entries[i].Changes = Diff(&entries[i-1].Status, &entries[i].Status)
+	}
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	return entries, nil
+}
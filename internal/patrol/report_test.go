@@ -0,0 +1,146 @@
+package patrol
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLogAppendAndReports(t *testing.T) {
+	log := NewLog(t.TempDir())
+
+	if reports, err := log.Reports("", 0); err != nil || len(reports) != 0 {
+		t.Fatalf("empty log: got %d reports, err %v", len(reports), err)
+	}
+	if err := log.Append(&Report{}); err == nil {
+		t.Error("Append without patroller should fail")
+	}
+
+	for _, p := range []string{"gastown/witness", "beads/witness", "gastown/witness"} {
+		r := &Report{Patroller: p, Agents: []AgentStatus{
+			{Agent: p + "-b", State: "idle"},
+			{Agent: p + "-a", State: "working"},
+		}}
+		if err := log.Append(r); err != nil {
+			t.Fatalf("Append: %v", err)
+		}
+		if r.Time.IsZero() {
+			t.Error("Append should stamp the time")
+		}
+		if r.Agents[0].Agent != p+"-a" {
+			t.Errorf("agents not sorted: %v", r.Agents)
+		}
+	}
+
+	all, _ := log.Reports("", 0)
+	if len(all) != 3 {
+		t.Fatalf("got %d reports, want 3", len(all))
+	}
+	mine, _ := log.Reports("gastown/witness", 1)
+	if len(mine) != 1 || mine[0].Patroller != "gastown/witness" {
+		t.Fatalf("Reports(gastown/witness, 1) = %v", mine)
+	}
+}
+
+func TestLogSkipsBadLines(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, ReportsFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := "not json\n{\"patroller\":\"gastown/witness\",\"agents\":[]}\n{}\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	reports, err := NewLog(root).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 {
+		t.Errorf("got %d reports, want 1", len(reports))
+	}
+}
+
+func TestHistory(t *testing.T) {
+	log := NewLog(t.TempDir())
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	nux := "gastown/polecats/nux"
+	patrols := []AgentStatus{
+		{Agent: nux, State: "working", HookBead: "gt-1"},
+		{Agent: nux, State: "stuck", HookBead: "gt-1", Anomalies: []string{"idle 30m"}, Actions: []string{"nudged"}},
+		{Agent: nux, State: "working", HookBead: "gt-1"},
+	}
+	for i, s := range patrols {
+		r := &Report{Time: start.Add(time.Duration(i) * time.Minute), Patroller: "gastown/witness", Agents: []AgentStatus{s}}
+		if err := log.Append(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A patrol that did not see nux is not part of its history.
+	if err := log.Append(&Report{Patroller: "gastown/witness", Agents: []AgentStatus{{Agent: "gastown/polecats/toast"}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := log.History(nux, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(entries))
+	}
+	if len(entries[0].Changes) != 0 {
+		t.Errorf("first entry changes = %v, want none", entries[0].Changes)
+	}
+	want := []Change{
+		{Field: FieldState, From: "working", To: "stuck"},
+		{Field: FieldAnomaly, To: "idle 30m"},
+		{Field: FieldAction, To: "nudged"},
+	}
+	assertChanges(t, entries[1].Changes, want)
+	assertChanges(t, entries[2].Changes, []Change{
+		{Field: FieldState, From: "stuck", To: "working"},
+		{Field: FieldAnomaly, From: "idle 30m"},
+	})
+
+	limited, _ := log.History(nux, 1)
+	if len(limited) != 1 || len(limited[0].Changes) != 2 {
+		t.Errorf("History(limit 1) should keep the last entry's changes, got %+v", limited)
+	}
+}
+
+func TestDiffReports(t *testing.T) {
+	prev := &Report{Agents: []AgentStatus{
+		{Agent: "a", State: "working", HookBead: "gt-1"},
+		{Agent: "b", State: "idle"},
+		{Agent: "c", State: "working"},
+	}}
+	cur := &Report{Agents: []AgentStatus{
+		{Agent: "a", State: "working", HookBead: "gt-2"},
+		{Agent: "c", State: "working"},
+		{Agent: "d", State: "spawning", Actions: []string{"slung gt-3"}},
+	}}
+
+	diffs := DiffReports(prev, cur)
+	if len(diffs) != 3 {
+		t.Fatalf("got %d diffs, want 3 (c unchanged): %+v", len(diffs), diffs)
+	}
+	assertChanges(t, diffs[0].Changes, []Change{{Field: FieldHook, From: "gt-1", To: "gt-2"}})
+	assertChanges(t, diffs[1].Changes, []Change{{Field: FieldAgent, From: "idle"}})
+	assertChanges(t, diffs[2].Changes, []Change{
+		{Field: FieldAgent, To: "spawning"},
+		{Field: FieldAction, To: "slung gt-3"},
+	})
+}
+
+func assertChanges(t *testing.T, got, want []Change) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("changes = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
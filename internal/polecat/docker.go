@@ -0,0 +1,116 @@
+package polecat
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/terminal"
+)
+
+// ExecutionTarget returns where a rig's polecat running agent goes: an
+// explicit override, else the target set for the agent in
+// execution.agents, else the rig's own. An empty agent is the rig's
+// polecat agent.
+func ExecutionTarget(r *rig.Rig, agent, override string) config.ExecutionTarget {
+	if agent == "" {
+		agent = config.ResolveRoleAgentChain("polecat", filepath.Dir(r.Path), r.Path)[0]
+	}
+	return config.ResolveAgentExecutionTarget(r.Path, agent, override)
+}
+
+// polecatBackend returns the backend a rig's polecats running agent run
+// on: Docker when they run in local containers, Coop otherwise.
+func polecatBackend(r *rig.Rig, agent string) terminal.Backend {
+	settings, err := config.LoadRigSettings(config.RigSettingsPath(r.Path))
+	if err == nil && settings.Execution != nil && ExecutionTarget(r, agent, "") == config.ExecutionTargetDocker {
+		return terminal.NewDockerBackend(terminal.DockerConfig{})
+	}
+	return terminal.NewCoopBackend(terminal.CoopConfig{})
+}
+
+// SpawnInDocker allocates a polecat, creates its worktree and starts its
+// session in a local container running agent (the rig's polecat agent if
+// empty). Unlike a K8s spawn, which leaves the session to the controller,
+// the polecat is running when this returns.
+func SpawnInDocker(r *rig.Rig, hookBead, account, agent string) (*Polecat, error) {
+	mgr := NewManager(r, git.NewGit(r.Path))
+	name, err := mgr.AllocateName()
+	if err != nil {
+		return nil, fmt.Errorf("allocating polecat name: %w", err)
+	}
+	fmt.Printf("Allocated polecat: %s (docker)\n", name)
+
+	p, err := mgr.AddWithOptions(name, AddOptions{HookBead: hookBead})
+	if err != nil {
+		return nil, fmt.Errorf("creating polecat %s: %w", name, err)
+	}
+
+	sm := NewSessionManager(r)
+	sm.SetBackend(polecatBackend(r, agent))
+	if err := sm.Start(name, SessionStartOptions{Account: account, Agent: agent}); err != nil {
+		return nil, fmt.Errorf("starting polecat %s: %w", name, err)
+	}
+	fmt.Printf("✓ Polecat %s started in container %s\n", name, sm.SessionName(name))
+	return p, nil
+}
+
+// startContainer starts the container a Docker-backed polecat's session
+// runs in, and records it on the polecat's agent bead so peek, nudge and
+// mail can reach the agent. Other backends need no container.
+func (m *SessionManager) startContainer(polecat, sessionID, workDir string) error {
+	docker, ok := m.backend.(*terminal.DockerBackend)
+	if !ok {
+		return nil
+	}
+	opts, err := m.dockerRunOptions(workDir)
+	if err != nil {
+		return err
+	}
+	if err := docker.StartContainer(sessionID, opts); err != nil {
+		return fmt.Errorf("starting container: %w", err)
+	}
+
+	notes := "backend: docker\ndocker_container: " + sessionID
+	bd, agentBeadID := m.agentBead(polecat)
+	debugSession("RecordDockerBackend", bd.Update(agentBeadID, beads.UpdateOptions{Notes: &notes}))
+	return nil
+}
+
+// dockerRunOptions builds a polecat container from the rig's
+// execution.docker settings. The town root is mounted at its host path and
+// the container runs as the current user, so the polecat's worktree and
+// the town's beads look the same inside as out.
+func (m *SessionManager) dockerRunOptions(workDir string) (terminal.DockerRunOptions, error) {
+	settings, err := config.LoadRigSettings(config.RigSettingsPath(m.rig.Path))
+	if err != nil {
+		return terminal.DockerRunOptions{}, fmt.Errorf("loading rig settings: %w", err)
+	}
+	if settings.Execution == nil || settings.Execution.Docker == nil || settings.Execution.Docker.Image == "" {
+		return terminal.DockerRunOptions{}, fmt.Errorf("rig %s runs polecats in docker but has no execution.docker.image", m.rig.Name)
+	}
+	dc := settings.Execution.Docker
+
+	townRoot := filepath.Dir(m.rig.Path)
+	opts := terminal.DockerRunOptions{
+		Image:   dc.Image,
+		WorkDir: workDir,
+		Mounts:  []string{townRoot + ":" + townRoot},
+		Env:     dc.Env,
+		Network: dc.Network,
+		User:    fmt.Sprintf("%d:%d", os.Getuid(), os.Getgid()),
+	}
+	home, _ := os.UserHomeDir()
+	for _, mount := range dc.Mounts {
+		if strings.HasPrefix(mount, "~/") && home != "" {
+			mount = filepath.Join(home, mount[2:])
+		}
+		opts.Mounts = append(opts.Mounts, mount)
+	}
+	return opts, nil
+}
@@ -0,0 +1,44 @@
+package polecat
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/terminal"
+)
+
+func TestExecutionTargetFollowsAgent(t *testing.T) {
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	r := &rig.Rig{Name: "gastown", Path: filepath.Join(t.TempDir(), "gastown")}
+	settings := &config.RigSettings{
+		Type:    "rig-settings",
+		Version: 1,
+		Execution: &config.ExecutionConfig{
+			Target: config.ExecutionTargetK8s,
+			Agents: map[string]config.ExecutionTarget{"codex": config.ExecutionTargetDocker},
+			Docker: &config.DockerExecutionConfig{Image: "gastown/agent"},
+		},
+	}
+	if err := config.SaveRigSettings(config.RigSettingsPath(r.Path), settings); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := ExecutionTarget(r, "codex", ""); got != config.ExecutionTargetDocker {
+		t.Errorf("ExecutionTarget(codex) = %q, want docker", got)
+	}
+	if got := ExecutionTarget(r, "codex", "k8s"); got != config.ExecutionTargetK8s {
+		t.Errorf("ExecutionTarget(codex, k8s) = %q, want the override", got)
+	}
+	if got := ExecutionTarget(r, "", ""); got != config.ExecutionTargetK8s {
+		t.Errorf("ExecutionTarget(default agent) = %q, want k8s", got)
+	}
+
+	if _, ok := polecatBackend(r, "codex").(*terminal.DockerBackend); !ok {
+		t.Error("codex polecats should run on the docker backend")
+	}
+	if _, ok := polecatBackend(r, "").(*terminal.CoopBackend); !ok {
+		t.Error("default polecats should run on the coop backend")
+	}
+}
@@ -0,0 +1,219 @@
+package polecat
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// GCOptions controls a workspace garbage collection pass.
+type GCOptions struct {
+	// Retention is how long a finished polecat's worktree is kept before it
+	// is collected.
+	Retention time.Duration
+
+	// DryRun reports what would be collected without removing anything.
+	DryRun bool
+
+	// Now is the reference time for the retention window. Zero means
+	// time.Now().
+	Now time.Time
+}
+
+// GCEntry is the collector's verdict on one polecat.
+type GCEntry struct {
+	Name       string
+	Branch     string
+	FinishedAt time.Time
+	Bytes      int64  // disk used by the polecat directory
+	Collect    bool   // finished, merged, and past retention
+	Removed    bool   // actually removed (false in dry runs or on error)
+	Reason     string // why it was kept, or why removal failed
+}
+
+// GCReport summarizes a garbage collection pass over one rig.
+type GCReport struct {
+	Rig            string
+	Entries        []GCEntry
+	BranchesPruned []string
+	BytesReclaimed int64 // in a dry run, what would be reclaimed
+}
+
+// Collected returns the number of polecats removed, or in a dry run the
+// number that would be.
+func (r *GCReport) Collected(dryRun bool) int {
+	n := 0
+	for _, e := range r.Entries {
+		if e.Removed || (dryRun && e.Collect) {
+			n++
+		}
+	}
+	return n
+}
+
+// gcInfo is the evidence assessGC decides on.
+type gcInfo struct {
+	HasActiveSession   bool
+	HasWork            bool // hooked or assigned bead still open
+	HasUncommittedWork bool
+	Merged             bool // no commits beyond origin/<default>
+	FinishedAt         time.Time
+}
+
+// assessGC decides whether a polecat's worktree can be collected.
+func assessGC(info gcInfo, retention time.Duration, now time.Time) (bool, string) {
+	switch {
+	case info.HasActiveSession:
+		return false, "session active"
+	case info.HasWork:
+		return false, "bead still open"
+	case info.HasUncommittedWork:
+		return false, "has uncommitted work"
+	case !info.Merged:
+		return false, "branch not merged"
+	}
+	if age := now.Sub(info.FinishedAt); age < retention {
+		return false, fmt.Sprintf("within retention (%s left)", (retention - age).Round(time.Minute))
+	}
+	return true, ""
+}
+
+// CollectGarbage removes the worktrees of polecats whose work is finished:
+// no session, no open bead, a clean worktree, and a branch fully merged into
+// origin/<default>, finished longer ago than opts.Retention. The branches
+// of collected polecats, and any orphaned polecat branches already merged,
+// are pruned from the repo base.
+func (m *Manager) CollectGarbage(opts GCOptions) (*GCReport, error) {
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	polecats, err := m.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing polecats: %w", err)
+	}
+
+	defaultBranch := "main"
+	if rigCfg, err := rig.LoadRigConfig(m.rig.Path); err == nil && rigCfg.DefaultBranch != "" {
+		defaultBranch = rigCfg.DefaultBranch
+	}
+	base := "origin/" + defaultBranch
+
+	report := &GCReport{Rig: m.rig.Name}
+	inUse := make(map[string]bool)
+	for _, p := range polecats {
+		entry := GCEntry{Name: p.Name, Branch: p.Branch}
+		info := m.gcInfo(p, base)
+		entry.FinishedAt = info.FinishedAt
+		entry.Bytes = dirSize(m.polecatDir(p.Name))
+		entry.Collect, entry.Reason = assessGC(info, opts.Retention, now)
+
+		switch {
+		case !entry.Collect:
+			inUse[p.Branch] = true
+		case opts.DryRun:
+			report.BytesReclaimed += entry.Bytes
+		default:
+			if err := m.RemoveWithOptions(p.Name, false, false, false); err != nil {
+				entry.Reason = err.Error()
+				inUse[p.Branch] = true
+			} else {
+				entry.Removed = true
+				report.BytesReclaimed += entry.Bytes
+			}
+		}
+		report.Entries = append(report.Entries, entry)
+	}
+
+	report.BranchesPruned = m.pruneMergedBranches(base, inUse, opts.DryRun)
+	return report, nil
+}
+
+// gcInfo gathers the collector's evidence for one polecat.
+func (m *Manager) gcInfo(p *Polecat, base string) gcInfo {
+	sessionName := fmt.Sprintf("gt-%s-%s", m.rig.Name, p.Name)
+	agentPath := fmt.Sprintf("%s/%s", m.rig.Name, p.Name)
+	info := gcInfo{
+		HasActiveSession: checkAgentSession(agentPath, sessionName),
+		HasWork:          p.Issue != "" || p.State != StateDone,
+	}
+
+	polecatGit := git.NewGit(p.ClonePath)
+	if status, err := polecatGit.CheckUncommittedWork(); err == nil {
+		info.HasUncommittedWork = status.HasUncommittedChanges || status.StashCount > 0
+	} else {
+		info.HasUncommittedWork = true
+	}
+	if ahead, err := polecatGit.CommitsAhead(base, "HEAD"); err == nil {
+		info.Merged = ahead == 0
+	}
+
+	// The agent bead is last touched when the polecat finishes (gt done
+	// clears its hook), which makes it the best record of when work ended.
+	if issue, _, err := m.beads.GetAgentBead(m.agentBeadID(p.Name)); err == nil && issue != nil {
+		if t, err := time.Parse(time.RFC3339, issue.UpdatedAt); err == nil {
+			info.FinishedAt = t
+		}
+	}
+	if info.FinishedAt.IsZero() {
+		if fi, err := os.Stat(m.polecatDir(p.Name)); err == nil {
+			info.FinishedAt = fi.ModTime()
+		}
+	}
+	return info
+}
+
+// pruneMergedBranches deletes polecat branches that are merged into base and
+// not in use, returning the branches deleted (or, in a dry run, those that
+// would be).
+func (m *Manager) pruneMergedBranches(base string, inUse map[string]bool, dryRun bool) []string {
+	repoGit, err := m.repoBase()
+	if err != nil {
+		return nil
+	}
+	branches, err := repoGit.ListBranches("polecat/*")
+	if err != nil {
+		return nil
+	}
+
+	var pruned []string
+	for _, branch := range branches {
+		if inUse[branch] || !strings.HasPrefix(branch, "polecat/") {
+			continue
+		}
+		if merged, err := repoGit.IsAncestor(branch, base); err != nil || !merged {
+			continue
+		}
+		if !dryRun {
+			if err := repoGit.DeleteBranch(branch, true); err != nil {
+				continue
+			}
+		}
+		pruned = append(pruned, branch)
+	}
+	return pruned
+}
+
+// dirSize returns the total size of the regular files under path.
+func dirSize(path string) int64 {
+	var size int64
+	_ = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+		return nil
+	})
+	return size
+}
@@ -0,0 +1,35 @@
+package polecat
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAssessGC(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	finished := gcInfo{Merged: true, FinishedAt: now.Add(-48 * time.Hour)}
+
+	tests := []struct {
+		name    string
+		mutate  func(*gcInfo)
+		collect bool
+		reason  string
+	}{
+		{"finished and merged", func(*gcInfo) {}, true, ""},
+		{"session active", func(i *gcInfo) { i.HasActiveSession = true }, false, "session active"},
+		{"open bead", func(i *gcInfo) { i.HasWork = true }, false, "bead still open"},
+		{"dirty worktree", func(i *gcInfo) { i.HasUncommittedWork = true }, false, "has uncommitted work"},
+		{"unmerged", func(i *gcInfo) { i.Merged = false }, false, "branch not merged"},
+		{"within retention", func(i *gcInfo) { i.FinishedAt = now.Add(-time.Hour) }, false, "within retention (23h0m0s left)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := finished
+			tt.mutate(&info)
+			collect, reason := assessGC(info, 24*time.Hour, now)
+			if collect != tt.collect || reason != tt.reason {
+				t.Errorf("assessGC = %v, %q; want %v, %q", collect, reason, tt.collect, tt.reason)
+			}
+		})
+	}
+}
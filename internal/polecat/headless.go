@@ -0,0 +1,299 @@
+package polecat
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/bdcmd"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/eventbus"
+	"github.com/steveyegge/gastown/internal/ratelimit"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// headlessTranscriptFile is where a polecat's last headless run writes its
+// JSONL output, in the polecat's home directory.
+const headlessTranscriptFile = "headless.jsonl"
+
+// headlessCommentLimit caps how much of the agent's final message is
+// posted to the issue.
+const headlessCommentLimit = 4000
+
+// HeadlessResult describes a finished headless run.
+type HeadlessResult struct {
+	// Preset is the agent that ran the work.
+	Preset string `json:"preset"`
+
+	// ExitCode is the agent's exit code.
+	ExitCode int `json:"exit_code"`
+
+	// Duration is how long the agent ran.
+	Duration time.Duration `json:"duration"`
+
+	// Transcript is the path of the agent's JSONL output.
+	Transcript string `json:"transcript"`
+
+	// Result is the agent's final message, if its output reported one.
+	Result string `json:"result,omitempty"`
+
+	// SessionID is the agent CLI's session ID, if its output reported one.
+	// It is recorded on the agent bead so the session can be resumed.
+	SessionID string `json:"session_id,omitempty"`
+}
+
+// RunHeadless runs a polecat's agent once, without a terminal session, and
+// waits for it to exit. The agent gets the startup beacon as its prompt and
+// runs in its non-interactive mode (e.g. "codex exec --json"). Each line of
+// its structured output is written to the polecat's transcript and copied
+// to out, if set. When opts.Issue is set the issue is hooked first, and the
+// outcome is posted to it as a comment when the run ends.
+//
+// opts.Command is ignored: headless runs always use the role's agent. An
+// agent that exits non-zero is reported in the result, not as an error.
+func (m *SessionManager) RunHeadless(ctx context.Context, polecat string, opts SessionStartOptions, out io.Writer) (*HeadlessResult, error) {
+	if !m.hasPolecat(polecat) {
+		return nil, fmt.Errorf("%w: %s", ErrPolecatNotFound, polecat)
+	}
+	if err := m.checkRateLimit(); err != nil {
+		return nil, err
+	}
+
+	workDir := opts.WorkDir
+	if workDir == "" {
+		workDir = m.clonePath(polecat)
+	}
+	if opts.Issue != "" {
+		if err := m.validateIssue(opts.Issue, workDir); err != nil {
+			return nil, err
+		}
+	}
+
+	townRoot := filepath.Dir(m.rig.Path)
+	preset := config.ResolveRoleAgentChain("polecat", townRoot, m.rig.Path)[0]
+	runtimeConfig, _, err := config.ResolveAgentConfigWithOverride(townRoot, m.rig.Path, preset)
+	if err != nil {
+		return nil, err
+	}
+
+	polecatHomeDir := m.polecatDir(polecat)
+	if err := polecatMaterializeOrEnsureSettings(polecatHomeDir, "polecat", m.rig.Path, m.rig.Name, polecat, runtimeConfig); err != nil {
+		return nil, fmt.Errorf("ensuring runtime settings: %w", err)
+	}
+
+	address := fmt.Sprintf("%s/polecats/%s", m.rig.Name, polecat)
+	prompt := session.FormatStartupBeacon(session.BeaconConfig{
+		Recipient:               address,
+		Sender:                  "witness",
+		Topic:                   "assigned",
+		MolID:                   opts.Issue,
+		IncludePrimeInstruction: true,
+	})
+	argv, err := config.HeadlessCommand(config.AgentPresetForRuntime(preset, runtimeConfig), prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Issue != "" {
+		if err := m.hookIssue(opts.Issue, address, workDir); err != nil {
+			fmt.Printf("Warning: could not hook issue %s: %v\n", opts.Issue, err)
+		}
+	}
+
+	transcriptPath := filepath.Join(polecatHomeDir, headlessTranscriptFile)
+	transcript, err := os.Create(transcriptPath)
+	if err != nil {
+		return nil, fmt.Errorf("creating transcript: %w", err)
+	}
+	defer transcript.Close()
+
+	cmd := exec.CommandContext(ctx, argv[0], argv[1:]...) //nolint:gosec // G204: command is from agent config
+	cmd.Dir = workDir
+	cmd.Env = os.Environ()
+	agentEnv := config.AgentEnv(config.AgentEnvConfig{
+		Role:             "polecat",
+		Rig:              m.rig.Name,
+		AgentName:        polecat,
+		TownRoot:         townRoot,
+		RuntimeConfigDir: opts.RuntimeConfigDir,
+		AuthToken:        opts.AuthToken,
+		BaseURL:          opts.BaseURL,
+	})
+	for k, v := range agentEnv {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	for k, v := range runtimeConfig.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("starting %s: %w", argv[0], err)
+	}
+	m.recordAgentPreset(polecat, preset)
+	_ = eventbus.Publish(address, eventbus.HeadlessStart{Agent: address, Bead: opts.Issue, Preset: preset})
+
+	started := time.Now()
+	result := &HeadlessResult{Preset: preset, Transcript: transcriptPath}
+	reader := bufio.NewReader(stdout)
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			_, _ = transcript.Write(line)
+			if out != nil {
+				_, _ = out.Write(line)
+			}
+			if text := headlessText(line); text != "" {
+				result.Result = text
+			}
+			if id := headlessSessionID(line); id != "" && result.SessionID == "" {
+				result.SessionID = id
+				bd, agentBeadID := m.agentBead(polecat)
+				debugSession("RecordSessionID", bd.UpdateAgentSessionID(agentBeadID, id))
+			}
+		}
+		if readErr != nil {
+			break
+		}
+	}
+
+	waitErr := cmd.Wait()
+	result.Duration = time.Since(started)
+	var exitErr *exec.ExitError
+	switch {
+	case waitErr == nil:
+	case errors.As(waitErr, &exitErr):
+		result.ExitCode = exitErr.ExitCode()
+	default:
+		return nil, fmt.Errorf("running %s: %w", argv[0], waitErr)
+	}
+
+	if result.ExitCode != 0 && ratelimit.DetectRateLimit(stderr.String()+"\n"+result.Result) {
+		tracker := ratelimit.NewTracker(m.rig.Path)
+		_ = tracker.Load()
+		tracker.RecordRateLimit(fmt.Sprintf("polecat:%s", polecat), opts.Account)
+		_ = tracker.Save()
+	}
+
+	_ = eventbus.Publish(address, eventbus.HeadlessDone{
+		Agent:      address,
+		Bead:       opts.Issue,
+		ExitCode:   result.ExitCode,
+		Duration:   result.Duration,
+		Transcript: transcriptPath,
+	})
+	if opts.Issue != "" {
+		comment := headlessComment(address, result, stderr.String())
+		cmd := bdcmd.Command("comments", "add", opts.Issue, comment) //nolint:gosec // args are internal constants, not user input
+		cmd.Dir = workDir
+		debugSession("HeadlessComment", cmd.Run())
+	}
+	return result, nil
+}
+
+// checkRateLimit returns ErrRateLimited while the rig is backing off from
+// a provider rate limit.
+func (m *SessionManager) checkRateLimit() error {
+	tracker := ratelimit.NewTracker(m.rig.Path)
+	if err := tracker.Load(); err == nil {
+		if tracker.ShouldDefer() {
+			waitTime := tracker.TimeUntilReady()
+			return fmt.Errorf("%w: backoff active, retry in %v", ErrRateLimited, waitTime.Round(time.Second))
+		}
+	}
+	return nil
+}
+
+// headlessText returns the message text in one line of an agent's JSON
+// output, covering the final result of Claude and Gemini and the message
+// events of Codex and OpenCode. Other lines return "".
+func headlessText(line []byte) string {
+	var event struct {
+		Result   string `json:"result"`
+		Response string `json:"response"`
+		Item     struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		} `json:"item"`
+		Part struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		} `json:"part"`
+	}
+	if err := json.Unmarshal(line, &event); err != nil {
+		return ""
+	}
+	switch {
+	case event.Result != "":
+		return event.Result
+	case event.Response != "":
+		return event.Response
+	case event.Item.Type == "agent_message":
+		return event.Item.Text
+	case event.Part.Type == "text":
+		return event.Part.Text
+	}
+	return ""
+}
+
+// headlessSessionID returns the agent CLI's session ID from one line of its
+// JSON output: session_id in Claude's and Gemini's events, thread_id in
+// Codex's thread.started, sessionID in OpenCode's. Other lines return "".
+func headlessSessionID(line []byte) string {
+	var event struct {
+		SessionID  string `json:"session_id"`
+		ThreadID   string `json:"thread_id"`
+		OpenCodeID string `json:"sessionID"`
+	}
+	if err := json.Unmarshal(line, &event); err != nil {
+		return ""
+	}
+	switch {
+	case event.SessionID != "":
+		return event.SessionID
+	case event.ThreadID != "":
+		return event.ThreadID
+	}
+	return event.OpenCodeID
+}
+
+// headlessComment formats a headless run's outcome for its issue.
+func headlessComment(address string, result *HeadlessResult, stderr string) string {
+	status := "completed"
+	if result.ExitCode != 0 {
+		status = fmt.Sprintf("failed (exit %d)", result.ExitCode)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Headless run by %s (%s) %s after %s.",
+		address, result.Preset, status, result.Duration.Round(time.Second))
+
+	text := result.Result
+	if len(text) > headlessCommentLimit {
+		text = text[:headlessCommentLimit] + "..."
+	}
+	if text == "" && result.ExitCode != 0 {
+		// The end of stderr is where the agent's error usually is.
+		text = strings.TrimSpace(stderr)
+		if len(text) > headlessCommentLimit {
+			text = "..." + text[len(text)-headlessCommentLimit:]
+		}
+	}
+	if text != "" {
+		b.WriteString("\n\n" + text)
+	}
+	return b.String()
+}
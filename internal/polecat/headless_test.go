@@ -0,0 +1,46 @@
+package polecat
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHeadlessText(t *testing.T) {
+	tests := map[string]string{
+		`{"type":"result","subtype":"success","result":"Done: fixed the bug"}`:                 "Done: fixed the bug",
+		`{"response":"All tests pass"}`:                                                        "All tests pass",
+		`{"type":"item.completed","item":{"type":"agent_message","text":"Pushed the branch"}}`: "Pushed the branch",
+		`{"type":"text","part":{"type":"text","text":"Submitted to merge queue"}}`:             "Submitted to merge queue",
+		`{"type":"item.completed","item":{"type":"command_execution","text":"ls"}}`:            "",
+		`not json`: "",
+	}
+	for line, want := range tests {
+		if got := headlessText([]byte(line)); got != want {
+			t.Errorf("headlessText(%s) = %q, want %q", line, got, want)
+		}
+	}
+}
+
+func TestHeadlessComment(t *testing.T) {
+	failed := &HeadlessResult{Preset: "codex", ExitCode: 1, Duration: 90 * time.Second}
+	got := headlessComment("gastown/polecats/nux", failed, "warning\nerror: 401 Unauthorized\n")
+	if !strings.HasPrefix(got, "Headless run by gastown/polecats/nux (codex) failed (exit 1) after 1m30s.") ||
+		!strings.HasSuffix(got, "error: 401 Unauthorized") {
+		t.Errorf("comment = %q, want failure summary ending with stderr", got)
+	}
+}
+
+func TestHeadlessSessionID(t *testing.T) {
+	tests := map[string]string{
+		`{"type":"system","subtype":"init","session_id":"8f14e45f-ceea"}`: "8f14e45f-ceea",
+		`{"type":"thread.started","thread_id":"0199a213-81c0"}`:           "0199a213-81c0",
+		`{"type":"step_start","sessionID":"ses_6b2f"}`:                    "ses_6b2f",
+		`{"type":"result","result":"done"}`:                               "",
+	}
+	for line, want := range tests {
+		if got := headlessSessionID([]byte(line)); got != want {
+			t.Errorf("headlessSessionID(%s) = %q, want %q", line, got, want)
+		}
+	}
+}
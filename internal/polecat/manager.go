@@ -664,6 +664,13 @@ func (m *Manager) RemoveWithOptions(name string, force, nuclear, selfNuke bool)
 		return os.RemoveAll(polecatDir)
 	}
 
+	// Only ever delete a linked worktree (or an old-style standalone clone).
+	// The repo base holds the objects every polecat, the refinery and crew
+	// share, and a locked worktree was protected deliberately.
+	if err := m.checkTeardownSafe(repoGit, clonePath, nuclear); err != nil {
+		return err
+	}
+
 	// Try to remove as a worktree first (use force flag for worktree removal too)
 	if err := repoGit.WorktreeRemove(clonePath, force); err != nil {
 		// Fall back to direct removal if worktree removal fails
@@ -714,6 +721,42 @@ func (m *Manager) RemoveWithOptions(name string, force, nuclear, selfNuke bool)
 	return nil
 }
 
+// checkTeardownSafe refuses to remove clonePath if it is (or contains) the
+// repo base, or is a locked worktree and nuclear is not set. A path git
+// doesn't list as a worktree is an old-style standalone clone, or already
+// half-removed, and is fine to delete.
+func (m *Manager) checkTeardownSafe(repoGit *git.Git, clonePath string, nuclear bool) error {
+	err := repoGit.CheckWorktreeRemovable(clonePath)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, git.ErrWorktreeLocked):
+		if nuclear {
+			return nil
+		}
+		return fmt.Errorf("%w; run 'git worktree unlock %s' first, or nuke the polecat", err, clonePath)
+	case errors.Is(err, git.ErrNotLinkedWorktree):
+		for _, base := range []string{
+			filepath.Join(m.rig.Path, ".repo.git"),
+			filepath.Join(m.rig.Path, "mayor", "rig"),
+		} {
+			if isWithin(clonePath, base) {
+				return err
+			}
+		}
+		return nil
+	default:
+		// Can't list worktrees; keep the legacy behavior.
+		return nil
+	}
+}
+
+// isWithin reports whether path is dir or somewhere under it.
+func isWithin(dir, path string) bool {
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(path))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
 // verifyRemovalComplete checks that polecat directories were actually removed.
 // If they still exist, it attempts more aggressive cleanup and returns an error
 // describing what couldn't be removed.
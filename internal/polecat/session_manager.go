@@ -43,11 +43,12 @@ type SessionManager struct {
 }
 
 // NewSessionManager creates a new polecat session manager for a rig.
-// Uses CoopBackend by default; use SetBackend to override.
+// Uses CoopBackend by default, or DockerBackend when the rig runs its
+// polecats in local containers; use SetBackend to override.
 func NewSessionManager(r *rig.Rig) *SessionManager {
 	return &SessionManager{
 		rig:     r,
-		backend: terminal.NewCoopBackend(terminal.CoopConfig{}),
+		backend: polecatBackend(r, ""),
 	}
 }
 
@@ -76,6 +77,10 @@ type SessionStartOptions struct {
 	// Account specifies the account handle to use (overrides default).
 	Account string
 
+	// Agent runs this agent instead of the role's configured one, with no
+	// fallback to role_agent_fallbacks.
+	Agent string
+
 	// RuntimeConfigDir is resolved config directory for the runtime account.
 	// If set, this is injected as an environment variable.
 	RuntimeConfigDir string
@@ -168,12 +173,8 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 	}
 
 	// Check for rate limit backoff before starting
-	tracker := ratelimit.NewTracker(m.rig.Path)
-	if err := tracker.Load(); err == nil {
-		if tracker.ShouldDefer() {
-			waitTime := tracker.TimeUntilReady()
-			return fmt.Errorf("%w: backoff active, retry in %v", ErrRateLimited, waitTime.Round(time.Second))
-		}
+	if err := m.checkRateLimit(); err != nil {
+		return err
 	}
 
 	sessionID := m.SessionName(polecat)
@@ -203,7 +204,52 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 		}
 	}
 
+	// Start with the role's agent. If the session dies on startup (auth
+	// error, rate limit), move down role_agent_fallbacks. An explicit
+	// command or agent opts out of fallback.
+	var chain []string
+	if opts.Agent != "" {
+		chain = []string{opts.Agent}
+	} else if opts.Command == "" {
+		chain = config.ResolveRoleAgentChain("polecat", filepath.Dir(m.rig.Path), m.rig.Path)
+	}
+	agents := []string{opts.Agent}
+	if len(chain) > 1 {
+		agents = append(agents, chain[1:]...)
+	}
+
+	var startErr error
+	for i, agent := range agents {
+		if i > 0 {
+			fmt.Printf("Session %s failed to start with %s, falling back to %s\n", sessionID, chain[i-1], agent)
+		}
+		var died bool
+		died, startErr = m.startAgent(polecat, sessionID, workDir, opts, agent)
+		if startErr == nil {
+			if len(chain) > 0 {
+				m.recordAgentPreset(polecat, chain[i])
+			}
+			return nil
+		}
+		if !died {
+			return startErr
+		}
+	}
+	return startErr
+}
+
+// startAgent starts a polecat's agent in its session. agent names a
+// fallback agent to run instead of the role's configured one. died reports
+// that the session started but the agent exited during startup, which
+// another agent may survive.
+func (m *SessionManager) startAgent(polecat, sessionID, workDir string, opts SessionStartOptions, agent string) (died bool, err error) {
 	runtimeConfig := config.LoadRuntimeConfig(m.rig.Path)
+	if agent != "" {
+		runtimeConfig, _, err = config.ResolveAgentConfigWithOverride(filepath.Dir(m.rig.Path), m.rig.Path, agent)
+		if err != nil {
+			return false, err
+		}
+	}
 
 	// Ensure runtime settings exist in polecat's home directory (polecats/<name>/).
 	// This keeps settings out of the git worktree while allowing runtime to find them
@@ -212,7 +258,7 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 	// Try to materialize Claude hooks from config beads first; fall back to templates.
 	polecatHomeDir := m.polecatDir(polecat)
 	if err := polecatMaterializeOrEnsureSettings(polecatHomeDir, "polecat", m.rig.Path, m.rig.Name, polecat, runtimeConfig); err != nil {
-		return fmt.Errorf("ensuring runtime settings: %w", err)
+		return false, fmt.Errorf("ensuring runtime settings: %w", err)
 	}
 
 	// Get fallback info to determine beacon content based on agent capabilities.
@@ -233,7 +279,12 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 	beacon := session.FormatStartupBeacon(beaconConfig)
 
 	command := opts.Command
-	if command == "" {
+	if command == "" && agent != "" {
+		command, err = config.BuildPolecatStartupCommandWithAgentOverride(m.rig.Name, polecat, m.rig.Path, beacon, agent)
+		if err != nil {
+			return false, err
+		}
+	} else if command == "" {
 		command = config.BuildPolecatStartupCommand(m.rig.Name, polecat, m.rig.Path, beacon)
 	}
 	// Prepend account-related env vars if needed
@@ -251,9 +302,14 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 		command = config.PrependEnv(command, prependEnvVars)
 	}
 
-	// Send command to coop backend to start the agent session.
+	// Docker-backed sessions need their container before they can take input.
+	if err := m.startContainer(polecat, sessionID, workDir); err != nil {
+		return false, err
+	}
+
+	// Send command to the backend to start the agent session.
 	if err := m.backend.SendInput(sessionID, command, true); err != nil {
-		return fmt.Errorf("creating session: %w", err)
+		return false, fmt.Errorf("creating session: %w", err)
 	}
 
 	// Hook the issue to the polecat if provided via --issue flag
@@ -291,9 +347,9 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 	}
 
 	// Verify session survived startup
-	running, err = m.hasSession(sessionID)
+	running, err := m.hasSession(sessionID)
 	if err != nil {
-		return fmt.Errorf("verifying session: %w", err)
+		return false, fmt.Errorf("verifying session: %w", err)
 	}
 
 	if running {
@@ -310,18 +366,18 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 				_ = tracker.Load()
 				tracker.RecordRateLimit(fmt.Sprintf("polecat:%s", polecat), opts.Account)
 				_ = tracker.Save()
-				return fmt.Errorf("%w: session %s died due to rate limiting. Diagnostic output:\n%s", ErrRateLimited, sessionID, diagnosticOutput)
+				return true, fmt.Errorf("%w: session %s died due to rate limiting. Diagnostic output:\n%s", ErrRateLimited, sessionID, diagnosticOutput)
 			}
 
 			if diagnosticOutput != "" {
-				return fmt.Errorf("session %s died during startup. Diagnostic output:\n%s", sessionID, diagnosticOutput)
+				return true, fmt.Errorf("session %s died during startup. Diagnostic output:\n%s", sessionID, diagnosticOutput)
 			}
-			return fmt.Errorf("session %s died during startup (no diagnostic output - check agent binary and credentials)", sessionID)
+			return true, fmt.Errorf("session %s died during startup (no diagnostic output - check agent binary and credentials)", sessionID)
 		}
-		return nil
+		return false, nil
 	}
 
-	return fmt.Errorf("session %s died during startup (session destroyed)", sessionID)
+	return true, fmt.Errorf("session %s died during startup (session destroyed)", sessionID)
 }
 
 // Stop terminates a polecat session.
@@ -514,6 +570,24 @@ func (m *SessionManager) validateIssue(issueID, workDir string) error {
 	return nil
 }
 
+// recordAgentPreset records the agent a polecat's session was started with
+// on its agent bead, so a fallback shows which agent is actually working.
+func (m *SessionManager) recordAgentPreset(polecat, preset string) {
+	bd, agentBeadID := m.agentBead(polecat)
+	debugSession("RecordAgentPreset", bd.UpdateAgentPreset(agentBeadID, preset))
+}
+
+// agentBead returns the rig's beads and the ID of a polecat's agent bead.
+func (m *SessionManager) agentBead(polecat string) (*beads.Beads, string) {
+	resolvedBeads := beads.ResolveBeadsDir(m.rig.Path)
+	bd := beads.NewWithBeadsDir(filepath.Dir(resolvedBeads), resolvedBeads)
+	prefix := "gt"
+	if m.rig.Config != nil && m.rig.Config.Prefix != "" {
+		prefix = m.rig.Config.Prefix
+	}
+	return bd, beads.PolecatBeadIDWithPrefix(prefix, m.rig.Name, polecat)
+}
+
 // hookIssue pins an issue to a polecat's hook using bd update.
 func (m *SessionManager) hookIssue(issueID, agentID, workDir string) error {
 	cmd := bdcmd.Command( "update", issueID, "--status=hooked", "--assignee="+agentID) //nolint:gosec // args are internal constants, not user input
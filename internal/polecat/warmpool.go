@@ -0,0 +1,116 @@
+package polecat
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// WarmPolecat is an idle polecat waiting in a rig's warm pool.
+type WarmPolecat struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WarmPool tracks the pre-spawned polecats of one rig. Members have an agent
+// bead and a session (or pod) but nothing on their hook; gt sling claims one
+// instead of spawning, and the daemon replaces what was claimed.
+//
+// State lives in <rig>/.runtime/warm-pool.json. Change it through
+// UpdateWarmPool so sling and the daemon never hand out the same polecat twice.
+type WarmPool struct {
+	Members   []WarmPolecat `json:"members"`
+	LastClaim time.Time     `json:"last_claim,omitempty"`
+}
+
+// warmPoolPath returns the state file for a rig's warm pool.
+func warmPoolPath(rigPath string) string {
+	return filepath.Join(rigPath, ".runtime", "warm-pool.json")
+}
+
+// LoadWarmPool reads a rig's warm pool. A missing file is an empty pool.
+func LoadWarmPool(rigPath string) (*WarmPool, error) {
+	data, err := os.ReadFile(warmPoolPath(rigPath))
+	if os.IsNotExist(err) {
+		return &WarmPool{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var p WarmPool
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing warm pool: %w", err)
+	}
+	return &p, nil
+}
+
+// UpdateWarmPool loads a rig's warm pool under a file lock, applies fn, and
+// saves the result if fn succeeds.
+func UpdateWarmPool(rigPath string, fn func(*WarmPool) error) error {
+	lock := util.NewFileLock(filepath.Join(rigPath, ".runtime", "warm-pool.lock"))
+	return lock.WithLock(func() error {
+		p, err := LoadWarmPool(rigPath)
+		if err != nil {
+			return err
+		}
+		if err := fn(p); err != nil {
+			return err
+		}
+		return util.AtomicWriteJSON(warmPoolPath(rigPath), p)
+	})
+}
+
+// Add records a newly spawned member.
+func (p *WarmPool) Add(name string, now time.Time) {
+	p.Members = append(p.Members, WarmPolecat{Name: name, CreatedAt: now})
+}
+
+// Remove drops a member by name, reporting whether it was in the pool.
+func (p *WarmPool) Remove(name string) bool {
+	for i, m := range p.Members {
+		if m.Name == name {
+			p.Members = append(p.Members[:i], p.Members[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Claim takes the longest-waiting member out of the pool. It returns false
+// if the pool is empty.
+func (p *WarmPool) Claim(now time.Time) (WarmPolecat, bool) {
+	if len(p.Members) == 0 {
+		return WarmPolecat{}, false
+	}
+	sort.SliceStable(p.Members, func(i, j int) bool {
+		return p.Members[i].CreatedAt.Before(p.Members[j].CreatedAt)
+	})
+	m := p.Members[0]
+	p.Members = p.Members[1:]
+	p.LastClaim = now
+	return m, true
+}
+
+// Plan works out how to bring the pool to size: members that have waited
+// longer than idleTimeout, and any beyond size, are returned for reclaiming;
+// spawn is how many new members are needed once they are gone. Plan does
+// not change the pool.
+func (p *WarmPool) Plan(size int, idleTimeout time.Duration, now time.Time) (reclaim []WarmPolecat, spawn int) {
+	keep := 0
+	for _, m := range p.Members {
+		if now.Sub(m.CreatedAt) > idleTimeout || keep >= size {
+			reclaim = append(reclaim, m)
+			continue
+		}
+		keep++
+	}
+	if keep < size {
+		spawn = size - keep
+	}
+	return reclaim, spawn
+}
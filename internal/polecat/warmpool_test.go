@@ -0,0 +1,72 @@
+package polecat
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWarmPoolClaimAndPlan(t *testing.T) {
+	rigPath := t.TempDir()
+	start := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	err := UpdateWarmPool(rigPath, func(p *WarmPool) error {
+		p.Add("nux", start.Add(time.Minute))
+		p.Add("furiosa", start)
+		p.Add("slit", start.Add(2*time.Minute))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var claimed WarmPolecat
+	err = UpdateWarmPool(rigPath, func(p *WarmPool) error {
+		var ok bool
+		claimed, ok = p.Claim(start.Add(time.Hour))
+		if !ok {
+			t.Fatal("claim from a full pool failed")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claimed.Name != "furiosa" {
+		t.Errorf("claimed %s, want the longest-waiting member", claimed.Name)
+	}
+
+	p, err := LoadWarmPool(rigPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Members) != 2 || !p.LastClaim.Equal(start.Add(time.Hour)) {
+		t.Fatalf("pool after claim = %+v", p)
+	}
+
+	// Both members are fresh: top up by one.
+	reclaim, spawn := p.Plan(3, 2*time.Hour, start.Add(time.Hour))
+	if len(reclaim) != 0 || spawn != 1 {
+		t.Errorf("Plan(3) = reclaim %v, spawn %d; want none, 1", reclaim, spawn)
+	}
+
+	// Shrinking the pool reclaims the excess.
+	reclaim, spawn = p.Plan(1, 2*time.Hour, start.Add(time.Hour))
+	if len(reclaim) != 1 || spawn != 0 {
+		t.Errorf("Plan(1) = reclaim %v, spawn %d; want 1, 0", reclaim, spawn)
+	}
+
+	// Past the idle timeout everything is recycled.
+	reclaim, spawn = p.Plan(2, 2*time.Hour, start.Add(3*time.Hour))
+	if len(reclaim) != 2 || spawn != 2 {
+		t.Errorf("idle Plan = reclaim %v, spawn %d; want 2, 2", reclaim, spawn)
+	}
+
+	if !p.Remove("nux") || p.Remove("nux") {
+		t.Error("Remove should drop a member exactly once")
+	}
+
+	empty := &WarmPool{}
+	if _, ok := empty.Claim(start); ok {
+		t.Error("claim from an empty pool succeeded")
+	}
+}
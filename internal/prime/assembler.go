@@ -0,0 +1,214 @@
+// Package prime assembles the dynamic part of an agent's priming context:
+// hooked work, molecule steps, handoffs, mail and knowledge. Sources gather
+// sections, the ContextAssembler fits them into a token budget by priority,
+// and the result renders in the conventions of the agent's instruction file.
+package prime
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultBudget is the token budget when none is configured. It is sized for
+// a few screens of context on top of the role template, not for a full bead
+// history.
+const DefaultBudget = 4000
+
+// minTruncateTokens is the smallest remainder worth truncating a section
+// into; below it, optional sections are omitted instead.
+const minTruncateTokens = 64
+
+// Section priorities used by the built-in sources. Lower is more important
+// and is kept first when the budget runs out.
+const (
+	PriorityHook      = 0
+	PriorityMolecule  = 10
+	PriorityHandoff   = 20
+	PriorityMail      = 30
+	PriorityKnowledge = 40
+)
+
+// Section is one block of priming context.
+type Section struct {
+	Source   string // Name of the source that produced it
+	Title    string
+	Icon     string // Shown before the title in the Claude format
+	Priority int
+	Body     string // Markdown
+	// Required sections are never omitted, only truncated.
+	Required bool
+	// Truncated is set by the assembler when Body was cut to fit.
+	Truncated bool
+}
+
+// Agent identifies the agent being primed.
+type Agent struct {
+	TownRoot string
+	WorkDir  string
+	Rig      string
+	Role     string
+	Address  string // e.g. "gastown/polecats/toast"
+	HookBead string // Hooked bead ID, if any
+}
+
+// Source gathers sections for an agent. A source with nothing to say
+// returns no sections and no error.
+type Source interface {
+	Name() string
+	Gather(a *Agent) ([]Section, error)
+}
+
+type sourceFunc struct {
+	name string
+	fn   func(a *Agent) ([]Section, error)
+}
+
+func (s sourceFunc) Name() string                       { return s.name }
+func (s sourceFunc) Gather(a *Agent) ([]Section, error) { return s.fn(a) }
+
+// NewSource adapts a function to a Source.
+func NewSource(name string, fn func(a *Agent) ([]Section, error)) Source {
+	return sourceFunc{name: name, fn: fn}
+}
+
+// Omission records a section left out of the assembled context.
+type Omission struct {
+	Source string
+	Title  string
+	Tokens int
+}
+
+// SourceError records a source that failed. Failures don't stop assembly.
+type SourceError struct {
+	Source string
+	Err    error
+}
+
+// Result is assembled context.
+type Result struct {
+	Sections []Section
+	Omitted  []Omission
+	Errors   []SourceError
+	Tokens   int // Estimated tokens used by Sections
+	Budget   int
+}
+
+// ContextAssembler gathers sections from its sources and keeps the most
+// important ones within a token budget.
+type ContextAssembler struct {
+	Sources []Source
+	Budget  int // Tokens; DefaultBudget when zero or less
+}
+
+// Assemble gathers every source and fits the sections into the budget in
+// priority order (source order breaks ties). A section that doesn't fit is
+// truncated when enough budget remains, otherwise omitted; required sections
+// are always kept, truncated if need be.
+func (c *ContextAssembler) Assemble(a *Agent) *Result {
+	budget := c.Budget
+	if budget <= 0 {
+		budget = DefaultBudget
+	}
+	r := &Result{Budget: budget}
+
+	var sections []Section
+	for _, src := range c.Sources {
+		got, err := src.Gather(a)
+		if err != nil {
+			r.Errors = append(r.Errors, SourceError{Source: src.Name(), Err: err})
+			continue
+		}
+		for _, s := range got {
+			if s.Source == "" {
+				s.Source = src.Name()
+			}
+			sections = append(sections, s)
+		}
+	}
+	sort.SliceStable(sections, func(i, j int) bool {
+		return sections[i].Priority < sections[j].Priority
+	})
+
+	// Reserve room for required sections so optional ones ahead of them
+	// can't starve them.
+	reserved := 0
+	for _, s := range sections {
+		if s.Required {
+			reserved += min(sectionTokens(s), minTruncateTokens)
+		}
+	}
+
+	for _, s := range sections {
+		cost := sectionTokens(s)
+		if s.Required {
+			reserved -= min(cost, minTruncateTokens)
+		}
+		remaining := budget - r.Tokens - reserved
+		switch {
+		case cost <= remaining:
+		case s.Required || remaining >= minTruncateTokens:
+			s = truncateSection(s, max(remaining, minTruncateTokens))
+			cost = sectionTokens(s)
+		default:
+			r.Omitted = append(r.Omitted, Omission{Source: s.Source, Title: s.Title, Tokens: cost})
+			continue
+		}
+		r.Sections = append(r.Sections, s)
+		r.Tokens += cost
+	}
+	return r
+}
+
+// EstimateTokens approximates the token count of text at four bytes per
+// token, close enough for English and code to budget with.
+func EstimateTokens(text string) int {
+	return (len(text) + 3) / 4
+}
+
+// headingOverhead covers the rendered heading, icon and spacing.
+const headingOverhead = 4
+
+func sectionTokens(s Section) int {
+	return EstimateTokens(s.Title) + EstimateTokens(s.Body) + headingOverhead
+}
+
+// truncateSection cuts s's body at a line boundary so the section fits in
+// tokens, noting how much was dropped.
+func truncateSection(s Section, tokens int) Section {
+	lines := strings.Split(s.Body, "\n")
+	allowed := tokens - EstimateTokens(s.Title) - headingOverhead - truncationNoteTokens
+	used, keep := 0, 0
+	for _, line := range lines {
+		t := EstimateTokens(line + "\n")
+		if used+t > allowed {
+			break
+		}
+		used += t
+		keep++
+	}
+	cutFirst := false
+	if keep == 0 {
+		// Keep at least part of the first line so the section says something.
+		if n := max(allowed, 8) * 4; len(lines[0]) > n {
+			lines[0] = lines[0][:n]
+			cutFirst = true
+		}
+		keep = 1
+	}
+	dropped := len(lines) - keep
+	s.Body = strings.Join(lines[:keep], "\n")
+	switch {
+	case dropped > 0:
+		s.Body += fmt.Sprintf("\n[… %d more line(s) trimmed to fit the context budget]", dropped)
+	case cutFirst:
+		s.Body += "\n[… trimmed to fit the context budget]"
+	default:
+		return s
+	}
+	s.Truncated = true
+	return s
+}
+
+// truncationNoteTokens is the cost of the note truncateSection appends.
+var truncationNoteTokens = EstimateTokens("\n[… 999 more line(s) trimmed to fit the context budget]")
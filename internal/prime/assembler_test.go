@@ -0,0 +1,84 @@
+package prime
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func staticSource(name string, sections ...Section) Source {
+	return NewSource(name, func(*Agent) ([]Section, error) { return sections, nil })
+}
+
+func TestAssemble_PriorityAndBudget(t *testing.T) {
+	big := strings.Repeat("line of mail text here\n", 100) // ~600 tokens
+	c := &ContextAssembler{
+		Budget: 300,
+		Sources: []Source{
+			staticSource("knowledge", Section{Title: "Knowledge", Priority: PriorityKnowledge, Body: "short tip"}),
+			staticSource("mail", Section{Title: "Mail", Priority: PriorityMail, Body: big}),
+			staticSource("hook", Section{Title: "Hooked Work", Priority: PriorityHook, Body: "gt-abc: Fix it", Required: true}),
+			NewSource("broken", func(*Agent) ([]Section, error) { return nil, errors.New("bd down") }),
+		},
+	}
+	r := c.Assemble(&Agent{})
+
+	var titles []string
+	for _, s := range r.Sections {
+		titles = append(titles, s.Title)
+	}
+	if strings.Join(titles, ",") != "Hooked Work,Mail,Knowledge" {
+		t.Fatalf("sections = %v, want priority order", titles)
+	}
+	if !r.Sections[1].Truncated || !strings.Contains(r.Sections[1].Body, "trimmed to fit") {
+		t.Errorf("mail should be truncated: %+v", r.Sections[1])
+	}
+	if r.Tokens > r.Budget {
+		t.Errorf("used %d tokens, budget %d", r.Tokens, r.Budget)
+	}
+	if len(r.Errors) != 1 || r.Errors[0].Source != "broken" {
+		t.Errorf("errors = %+v", r.Errors)
+	}
+}
+
+func TestAssemble_OmitsAndKeepsRequired(t *testing.T) {
+	huge := strings.Repeat("x", 2000) // 500 tokens
+	c := &ContextAssembler{
+		Budget: 100,
+		Sources: []Source{
+			staticSource("mail", Section{Title: "Mail", Priority: PriorityMail, Body: huge}),
+			staticSource("molecule", Section{Title: "Current Step", Priority: PriorityMolecule, Body: huge, Required: true}),
+			staticSource("knowledge", Section{Title: "Knowledge", Priority: PriorityKnowledge, Body: huge}),
+		},
+	}
+	r := c.Assemble(&Agent{})
+	if len(r.Sections) != 1 || r.Sections[0].Title != "Current Step" || !r.Sections[0].Truncated {
+		t.Fatalf("sections = %+v, want only the truncated required step", r.Sections)
+	}
+	if len(r.Omitted) != 2 {
+		t.Errorf("omitted = %+v, want mail and knowledge", r.Omitted)
+	}
+	if out := r.Render(FormatClaude); !strings.Contains(out, "Omitted to stay within the 100-token context budget: Mail, Knowledge.") {
+		t.Errorf("render missing omission note:\n%s", out)
+	}
+}
+
+func TestRenderFormats(t *testing.T) {
+	r := &Result{Budget: DefaultBudget, Sections: []Section{{Title: "Hooked Work", Icon: "🪝", Body: "gt-abc"}}}
+
+	claude := r.Render(FormatClaude)
+	if !strings.Contains(claude, "## 🪝 Hooked Work\n\ngt-abc") || strings.Contains(claude, "# Work Context") {
+		t.Errorf("claude format:\n%s", claude)
+	}
+	agents := r.Render(FormatAgents)
+	if !strings.HasPrefix(agents, "# Work Context\n") || strings.Contains(agents, "🪝") {
+		t.Errorf("agents format:\n%s", agents)
+	}
+
+	if FormatForInstructions("AGENTS.md") != FormatAgents || FormatForInstructions("CLAUDE.md") != FormatClaude {
+		t.Error("FormatForInstructions mismatch")
+	}
+	if (&Result{}).Render(FormatClaude) != "" {
+		t.Error("empty result should render nothing")
+	}
+}
@@ -0,0 +1,60 @@
+package prime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format is how assembled context is rendered, following the conventions of
+// the instruction file the agent's runtime reads.
+type Format string
+
+const (
+	// FormatClaude follows CLAUDE.md conventions: emoji-marked second-level
+	// headings, like the rest of gt prime's output.
+	FormatClaude Format = "claude"
+
+	// FormatAgents follows AGENTS.md conventions: one plain top-level
+	// heading with plain sections under it, no decoration.
+	FormatAgents Format = "agents"
+)
+
+// FormatForInstructions picks the format for a runtime's instruction file
+// (RuntimeConfig.Instructions.File).
+func FormatForInstructions(file string) Format {
+	if strings.EqualFold(file, "AGENTS.md") {
+		return FormatAgents
+	}
+	return FormatClaude
+}
+
+// Render renders the assembled sections, with a note on anything omitted.
+func (r *Result) Render(f Format) string {
+	if len(r.Sections) == 0 && len(r.Omitted) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	if f == FormatAgents {
+		b.WriteString("# Work Context\n")
+	}
+	for _, s := range r.Sections {
+		heading := s.Title
+		if f != FormatAgents && s.Icon != "" {
+			heading = s.Icon + " " + heading
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n%s\n", heading, strings.TrimRight(s.Body, "\n"))
+	}
+	if len(r.Omitted) > 0 {
+		var names []string
+		for _, o := range r.Omitted {
+			names = append(names, o.Title)
+		}
+		note := fmt.Sprintf("Omitted to stay within the %d-token context budget: %s.", r.Budget, strings.Join(names, ", "))
+		if f == FormatAgents {
+			fmt.Fprintf(&b, "\nNote: %s\n", note)
+		} else {
+			fmt.Fprintf(&b, "\n_%s_\n", note)
+		}
+	}
+	return b.String()
+}
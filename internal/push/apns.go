@@ -0,0 +1,181 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+const (
+	apnsProductionURL = "https://api.push.apple.com"
+	apnsSandboxURL    = "https://api.sandbox.push.apple.com"
+
+	// APNs rejects provider tokens older than an hour and throttles ones
+	// refreshed more often than every 20 minutes.
+	apnsTokenLifetime = 50 * time.Minute
+)
+
+// APNs sends notifications through Apple Push Notification service using
+// token-based (.p8 key) authentication.
+type APNs struct {
+	cfg     *config.APNsConfig
+	key     *ecdsa.PrivateKey
+	baseURL string
+	client  *http.Client
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+// NewAPNs loads the signing key named by cfg.
+func NewAPNs(cfg *config.APNsConfig) (*APNs, error) {
+	data, err := os.ReadFile(cfg.KeyFile) //nolint:gosec // G304: path comes from settings/push.json
+	if err != nil {
+		return nil, fmt.Errorf("reading APNs key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("APNs key %s: no PEM data", cfg.KeyFile)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing APNs key: %w", err)
+	}
+	key, ok := parsed.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("APNs key %s is not an ECDSA key", cfg.KeyFile)
+	}
+	baseURL := apnsProductionURL
+	if cfg.Sandbox {
+		baseURL = apnsSandboxURL
+	}
+	return &APNs{
+		cfg:     cfg,
+		key:     key,
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: 15 * time.Second},
+	}, nil
+}
+
+// Platform implements Sender.
+func (a *APNs) Platform() string { return PlatformAPNs }
+
+// Send implements Sender.
+func (a *APNs) Send(ctx context.Context, token string, n *Notification) error {
+	body, err := json.Marshal(apnsPayload(n))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.baseURL+"/3/device/"+token, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	jwt, err := a.providerToken()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("authorization", "bearer "+jwt)
+	req.Header.Set("apns-topic", a.cfg.Topic)
+	req.Header.Set("apns-push-type", "alert")
+	if n.Urgent {
+		req.Header.Set("apns-priority", "10")
+	} else {
+		req.Header.Set("apns-priority", "5")
+	}
+	if n.CollapseID != "" {
+		req.Header.Set("apns-collapse-id", n.CollapseID)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	var reply struct {
+		Reason string `json:"reason"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 4096)).Decode(&reply)
+	switch reply.Reason {
+	case "BadDeviceToken", "Unregistered", "DeviceTokenNotForTopic":
+		return fmt.Errorf("%w: %s", ErrInvalidToken, reply.Reason)
+	}
+	return fmt.Errorf("APNs returned %s: %s", resp.Status, reply.Reason)
+}
+
+// apnsPayload builds the APNs JSON body. The deep link and notification
+// kind ride alongside the aps dictionary for the app to route on.
+func apnsPayload(n *Notification) map[string]interface{} {
+	aps := map[string]interface{}{
+		"alert": map[string]string{"title": n.Title, "body": n.Body},
+		"sound": "default",
+	}
+	if n.Urgent {
+		aps["interruption-level"] = "time-sensitive"
+	}
+	return map[string]interface{}{
+		"aps":       aps,
+		"kind":      n.Kind,
+		"id":        n.ID,
+		"deep_link": n.DeepLink,
+	}
+}
+
+// providerToken returns the cached ES256 provider token, signing a new one
+// when it is close to expiring.
+func (a *APNs) providerToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.token != "" && time.Since(a.issuedAt) < apnsTokenLifetime {
+		return a.token, nil
+	}
+	now := time.Now()
+	header := map[string]string{"alg": "ES256", "kid": a.cfg.KeyID}
+	claims := map[string]interface{}{"iss": a.cfg.TeamID, "iat": now.Unix()}
+	signingInput, err := jwtSigningInput(header, claims)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, a.key, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("signing APNs token: %w", err)
+	}
+	// JWS ES256 signatures are r||s, each left-padded to 32 bytes.
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	a.token = signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)
+	a.issuedAt = now
+	return a.token, nil
+}
+
+// jwtSigningInput encodes a JWT header and claims as header.claims.
+func jwtSigningInput(header, claims interface{}) (string, error) {
+	h, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	c, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c), nil
+}
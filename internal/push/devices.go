@@ -0,0 +1,146 @@
+package push
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// Device platforms.
+const (
+	PlatformAPNs = "apns" // iOS, via Apple Push Notification service
+	PlatformFCM  = "fcm"  // Android, via Firebase Cloud Messaging
+)
+
+// Device is a mobile client registered for push notifications.
+type Device struct {
+	Token        string    `json:"token"`
+	Platform     string    `json:"platform"`
+	Owner        string    `json:"owner,omitempty"` // API key name or user the device belongs to
+	Name         string    `json:"name,omitempty"`  // e.g. "Steve's iPhone"
+	AppVersion   string    `json:"app_version,omitempty"`
+	RegisteredAt time.Time `json:"registered_at"`
+	LastSeenAt   time.Time `json:"last_seen_at"`
+}
+
+// DevicesPath returns where push device tokens are stored in a town.
+func DevicesPath(townRoot string) string {
+	return filepath.Join(townRoot, ".runtime", "push-devices.json")
+}
+
+// Store persists registered devices. Tokens are credentials for reaching a
+// phone, so the file is only readable by its owner.
+type Store struct {
+	path string
+	now  func() time.Time
+}
+
+// NewStore returns the town's device store.
+func NewStore(townRoot string) *Store {
+	return &Store{path: DevicesPath(townRoot), now: time.Now}
+}
+
+// update runs fn on the stored devices under a file lock, saving them
+// afterwards if fn returns true.
+func (s *Store) update(fn func(devices map[string]*Device) bool) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("creating runtime directory: %w", err)
+	}
+	lock := flock.New(s.path + ".lock")
+	if err := lock.Lock(); err != nil {
+		return fmt.Errorf("locking device store: %w", err)
+	}
+	defer func() { _ = lock.Unlock() }()
+
+	devices := make(map[string]*Device)
+	if data, err := os.ReadFile(s.path); err == nil {
+		var list []*Device
+		if err := json.Unmarshal(data, &list); err != nil {
+			return fmt.Errorf("parsing %s: %w", s.path, err)
+		}
+		for _, d := range list {
+			devices[d.Token] = d
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	if !fn(devices) {
+		return nil
+	}
+
+	list := sortedDevices(devices)
+	data, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+	return util.AtomicWriteFile(s.path, data, 0600)
+}
+
+func sortedDevices(devices map[string]*Device) []*Device {
+	list := make([]*Device, 0, len(devices))
+	for _, d := range devices {
+		list = append(list, d)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].RegisteredAt.Before(list[j].RegisteredAt)
+	})
+	return list
+}
+
+// Register adds a device, or refreshes it if its token is already known.
+func (s *Store) Register(d Device) (*Device, error) {
+	if d.Token == "" {
+		return nil, fmt.Errorf("device token required")
+	}
+	if d.Platform != PlatformAPNs && d.Platform != PlatformFCM {
+		return nil, fmt.Errorf("unknown platform %q: must be %s or %s", d.Platform, PlatformAPNs, PlatformFCM)
+	}
+	now := s.now()
+	var out Device
+	err := s.update(func(devices map[string]*Device) bool {
+		if prev := devices[d.Token]; prev != nil {
+			d.RegisteredAt = prev.RegisteredAt
+		} else {
+			d.RegisteredAt = now
+		}
+		d.LastSeenAt = now
+		devices[d.Token] = &d
+		out = d
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Unregister removes a device. It reports whether the token was registered.
+func (s *Store) Unregister(token string) (bool, error) {
+	found := false
+	err := s.update(func(devices map[string]*Device) bool {
+		_, found = devices[token]
+		delete(devices, token)
+		return found
+	})
+	return found, err
+}
+
+// List returns registered devices, oldest first. An empty owner lists all.
+func (s *Store) List(owner string) ([]*Device, error) {
+	var out []*Device
+	err := s.update(func(devices map[string]*Device) bool {
+		for _, d := range sortedDevices(devices) {
+			if owner == "" || d.Owner == owner {
+				out = append(out, d)
+			}
+		}
+		return false
+	})
+	return out, err
+}
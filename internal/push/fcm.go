@@ -0,0 +1,216 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+const fcmScope = "https://www.googleapis.com/auth/firebase.messaging"
+
+// FCM sends notifications through the Firebase Cloud Messaging HTTP v1 API,
+// authenticating as a Google service account.
+type FCM struct {
+	projectID   string
+	clientEmail string
+	tokenURI    string
+	key         *rsa.PrivateKey
+	sendURL     string
+	client      *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// serviceAccount is the subset of a Google service account key file FCM
+// needs.
+type serviceAccount struct {
+	ProjectID   string `json:"project_id"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// NewFCM loads the service account credentials named by cfg.
+func NewFCM(cfg *config.FCMConfig) (*FCM, error) {
+	data, err := os.ReadFile(cfg.CredentialsFile) //nolint:gosec // G304: path comes from settings/push.json
+	if err != nil {
+		return nil, fmt.Errorf("reading FCM credentials: %w", err)
+	}
+	var sa serviceAccount
+	if err := json.Unmarshal(data, &sa); err != nil {
+		return nil, fmt.Errorf("parsing FCM credentials: %w", err)
+	}
+	block, _ := pem.Decode([]byte(sa.PrivateKey))
+	if block == nil {
+		return nil, fmt.Errorf("FCM credentials %s: no private key", cfg.CredentialsFile)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing FCM private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("FCM private key is not an RSA key")
+	}
+
+	projectID := cfg.ProjectID
+	if projectID == "" {
+		projectID = sa.ProjectID
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("FCM project ID missing from %s; set fcm.project_id", cfg.CredentialsFile)
+	}
+	tokenURI := sa.TokenURI
+	if tokenURI == "" {
+		tokenURI = "https://oauth2.googleapis.com/token"
+	}
+	return &FCM{
+		projectID:   projectID,
+		clientEmail: sa.ClientEmail,
+		tokenURI:    tokenURI,
+		key:         key,
+		sendURL:     "https://fcm.googleapis.com/v1/projects/" + projectID + "/messages:send",
+		client:      &http.Client{Timeout: 15 * time.Second},
+	}, nil
+}
+
+// Platform implements Sender.
+func (f *FCM) Platform() string { return PlatformFCM }
+
+// Send implements Sender.
+func (f *FCM) Send(ctx context.Context, token string, n *Notification) error {
+	accessToken, err := f.token(ctx)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]interface{}{"message": fcmMessage(token, n)})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.sendURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	var reply struct {
+		Error struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 8192)).Decode(&reply)
+	if reply.Error.Status == "NOT_FOUND" || reply.Error.Status == "UNREGISTERED" ||
+		(reply.Error.Status == "INVALID_ARGUMENT" && strings.Contains(reply.Error.Message, "registration token")) {
+		return fmt.Errorf("%w: %s", ErrInvalidToken, reply.Error.Message)
+	}
+	return fmt.Errorf("FCM returned %s: %s", resp.Status, reply.Error.Message)
+}
+
+// fcmMessage builds an FCM v1 message. Data values must be strings; the
+// app reads the deep link from them.
+func fcmMessage(token string, n *Notification) map[string]interface{} {
+	priority := "NORMAL"
+	if n.Urgent {
+		priority = "HIGH"
+	}
+	android := map[string]interface{}{"priority": priority}
+	if n.CollapseID != "" {
+		android["collapse_key"] = n.CollapseID
+	}
+	return map[string]interface{}{
+		"token":        token,
+		"notification": map[string]string{"title": n.Title, "body": n.Body},
+		"data": map[string]string{
+			"kind":      n.Kind,
+			"id":        n.ID,
+			"deep_link": n.DeepLink,
+		},
+		"android": android,
+	}
+}
+
+// token returns a cached OAuth2 access token, exchanging a signed JWT
+// assertion for a new one when it is about to expire.
+func (f *FCM) token(ctx context.Context) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.accessToken != "" && time.Until(f.expiresAt) > time.Minute {
+		return f.accessToken, nil
+	}
+
+	now := time.Now()
+	signingInput, err := jwtSigningInput(
+		map[string]string{"alg": "RS256", "typ": "JWT"},
+		map[string]interface{}{
+			"iss":   f.clientEmail,
+			"scope": fcmScope,
+			"aud":   f.tokenURI,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		})
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256([]byte(signingInput))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, f.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("signing FCM assertion: %w", err)
+	}
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.tokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("fetching FCM access token: %w", err)
+	}
+	defer resp.Body.Close()
+	var reply struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+		Error       string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 8192)).Decode(&reply); err != nil {
+		return "", fmt.Errorf("fetching FCM access token: %s", resp.Status)
+	}
+	if resp.StatusCode != http.StatusOK || reply.AccessToken == "" {
+		return "", fmt.Errorf("fetching FCM access token: %s: %s", resp.Status, reply.Error)
+	}
+	f.accessToken = reply.AccessToken
+	f.expiresAt = now.Add(time.Duration(reply.ExpiresIn) * time.Second)
+	return f.accessToken, nil
+}
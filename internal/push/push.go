@@ -0,0 +1,308 @@
+// Package push delivers mobile push notifications for events that need a
+// human: high-urgency decisions, escalations at or above the configured
+// severity, and merge failures. Mobile clients register device tokens
+// through the RPC server; the server follows the town's event feed and fans
+// matching events out to every registered device through APNs or FCM.
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+// ErrInvalidToken is returned by a Sender when the push service reports a
+// device token is no longer valid. The device is unregistered.
+var ErrInvalidToken = errors.New("device token no longer valid")
+
+// Notification kinds. Each has a deep link the mobile client opens.
+const (
+	KindDecision     = "decision"
+	KindEscalation   = "escalation"
+	KindMergeFailure = "merge_failure"
+	KindNotification = "notification"
+)
+
+// Notification is a push notification for one event.
+type Notification struct {
+	Kind       string // Kind* constant
+	ID         string // Decision, escalation or merge request ID
+	Title      string
+	Body       string
+	DeepLink   string // e.g. gastown://decisions/hq-abc
+	Urgent     bool   // Deliver immediately, breaking through focus modes
+	CollapseID string // Newer notifications with the same ID replace older ones
+}
+
+// DeepLink returns the URL the mobile client opens for a notification.
+func DeepLink(kind, id string) string {
+	switch kind {
+	case KindDecision:
+		return "gastown://decisions/" + id
+	case KindEscalation:
+		return "gastown://escalations/" + id
+	case KindMergeFailure:
+		return "gastown://merge-requests/" + id
+	}
+	if id != "" {
+		return "gastown://beads/" + id
+	}
+	return "gastown://activity"
+}
+
+// Sender delivers notifications on one platform.
+type Sender interface {
+	Platform() string
+	Send(ctx context.Context, token string, n *Notification) error
+}
+
+// Result summarizes one fan-out.
+type Result struct {
+	Sent    int
+	Removed int // Devices unregistered because their token was invalid
+	Errors  []string
+}
+
+// Pusher fans notifications out to registered devices.
+type Pusher struct {
+	store   *Store
+	senders map[string]Sender
+}
+
+// NewPusher returns a pusher for the town's devices using the given
+// senders. Devices on a platform with no sender are skipped.
+func NewPusher(townRoot string, senders ...Sender) *Pusher {
+	p := &Pusher{store: NewStore(townRoot), senders: make(map[string]Sender)}
+	for _, s := range senders {
+		p.senders[s.Platform()] = s
+	}
+	return p
+}
+
+// NewPusherFromConfig builds a pusher with a sender for each platform cfg
+// configures.
+func NewPusherFromConfig(townRoot string, cfg *config.PushConfig) (*Pusher, error) {
+	var senders []Sender
+	if cfg.APNs != nil {
+		s, err := NewAPNs(cfg.APNs)
+		if err != nil {
+			return nil, err
+		}
+		senders = append(senders, s)
+	}
+	if cfg.FCM != nil {
+		s, err := NewFCM(cfg.FCM)
+		if err != nil {
+			return nil, err
+		}
+		senders = append(senders, s)
+	}
+	if len(senders) == 0 {
+		return nil, fmt.Errorf("push config has neither apns nor fcm credentials")
+	}
+	return NewPusher(townRoot, senders...), nil
+}
+
+// Platforms returns the platforms this pusher can deliver to.
+func (p *Pusher) Platforms() []string {
+	var out []string
+	for _, platform := range []string{PlatformAPNs, PlatformFCM} {
+		if p.senders[platform] != nil {
+			out = append(out, platform)
+		}
+	}
+	return out
+}
+
+// Push sends n to every registered device, or to owner's devices when
+// owner is set. Devices whose tokens the push service rejects are removed.
+func (p *Pusher) Push(ctx context.Context, owner string, n *Notification) (*Result, error) {
+	devices, err := p.store.List(owner)
+	if err != nil {
+		return nil, err
+	}
+	return p.pushDevices(ctx, devices, n), nil
+}
+
+// PushToken sends n to one registered device.
+func (p *Pusher) PushToken(ctx context.Context, token string, n *Notification) (*Result, error) {
+	devices, err := p.store.List("")
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range devices {
+		if d.Token == token {
+			return p.pushDevices(ctx, []*Device{d}, n), nil
+		}
+	}
+	return nil, fmt.Errorf("device %s not found", token)
+}
+
+func (p *Pusher) pushDevices(ctx context.Context, devices []*Device, n *Notification) *Result {
+	result := &Result{}
+	for _, d := range devices {
+		sender := p.senders[d.Platform]
+		if sender == nil {
+			continue
+		}
+		err := sender.Send(ctx, d.Token, n)
+		switch {
+		case err == nil:
+			result.Sent++
+		case errors.Is(err, ErrInvalidToken):
+			if removed, _ := p.store.Unregister(d.Token); removed {
+				result.Removed++
+			}
+		default:
+			result.Errors = append(result.Errors, fmt.Sprintf("%s (%s): %v", deviceLabel(d), d.Platform, err))
+		}
+	}
+	return result
+}
+
+func deviceLabel(d *Device) string {
+	if d.Name != "" {
+		return d.Name
+	}
+	if len(d.Token) > 8 {
+		return d.Token[:8] + "…"
+	}
+	return d.Token
+}
+
+// FromEvent maps a feed event to a push notification. ok is false for
+// events that don't warrant one. Escalations are pushed at minSeverity and
+// above; decisions only when their urgency is high.
+func FromEvent(ev events.Event, minSeverity string) (n *Notification, ok bool) {
+	str := func(key string) string {
+		s, _ := ev.Payload[key].(string)
+		return s
+	}
+
+	switch ev.Type {
+	case events.TypeDecisionRequested:
+		if str("urgency") != "high" {
+			return nil, false
+		}
+		id := str("decision_id")
+		return &Notification{
+			Kind:       KindDecision,
+			ID:         id,
+			Title:      "Decision needed from " + ev.Actor,
+			Body:       str("question"),
+			DeepLink:   DeepLink(KindDecision, id),
+			Urgent:     true,
+			CollapseID: id,
+		}, true
+
+	case events.TypeEscalationSent:
+		severity := str("new_severity")
+		if severity == "" {
+			severity = str("severity")
+		}
+		if config.SeverityRank(severity) < config.SeverityRank(minSeverity) {
+			return nil, false
+		}
+		id := str("escalation_id")
+		if id == "" {
+			id = str("rig") // gt escalate logs the escalation bead as "rig"
+		}
+		title := fmt.Sprintf("[%s] Escalation from %s", severity, ev.Actor)
+		body := str("reason")
+		if breached, _ := ev.Payload["sla_breached"].(bool); breached {
+			title = fmt.Sprintf("[%s] Escalation %s breached its SLA", severity, id)
+			body = "Re-escalated from " + str("old_severity")
+		}
+		return &Notification{
+			Kind:       KindEscalation,
+			ID:         id,
+			Title:      title,
+			Body:       body,
+			DeepLink:   DeepLink(KindEscalation, id),
+			Urgent:     config.SeverityRank(severity) >= config.SeverityRank(config.SeverityHigh),
+			CollapseID: id,
+		}, true
+
+	case events.TypeMergeFailed:
+		id := str("mr")
+		body := str("branch")
+		if reason := str("reason"); reason != "" {
+			body += ": " + reason
+		}
+		return &Notification{
+			Kind:       KindMergeFailure,
+			ID:         id,
+			Title:      "Merge failed for " + str("worker"),
+			Body:       body,
+			DeepLink:   DeepLink(KindMergeFailure, id),
+			CollapseID: id,
+		}, true
+
+	case events.TypeNotification:
+		// The overseer's push channel. Escalations, SLA breaches and
+		// decisions are pushed from their own events above.
+		switch str("event") {
+		case config.NotifyEventEscalation, config.NotifyEventSLABreach, config.NotifyEventDecision:
+			return nil, false
+		}
+		id := str("bead")
+		return &Notification{
+			Kind:     KindNotification,
+			ID:       id,
+			Title:    str("title"),
+			Body:     str("body"),
+			DeepLink: DeepLink(KindNotification, id),
+			Urgent:   config.SeverityRank(str("severity")) >= config.SeverityRank(config.SeverityHigh),
+		}, true
+	}
+	return nil, false
+}
+
+// Watch follows the town's event feed until ctx is done, pushing each
+// event FromEvent maps to a notification.
+func Watch(ctx context.Context, townRoot string, p *Pusher, minSeverity string) error {
+	follower, err := events.Follow(filepath.Join(townRoot, events.EventsFile))
+	if err != nil {
+		return fmt.Errorf("following events: %w", err)
+	}
+	defer follower.Close()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		for {
+			line, ok := follower.Next()
+			if !ok {
+				break
+			}
+			var ev events.Event
+			if err := json.Unmarshal([]byte(line), &ev); err != nil {
+				continue
+			}
+			n, ok := FromEvent(ev, minSeverity)
+			if !ok {
+				continue
+			}
+			result, err := p.Push(ctx, "", n)
+			if err != nil {
+				log.Printf("push: %s %s: %v", n.Kind, n.ID, err)
+				continue
+			}
+			for _, e := range result.Errors {
+				log.Printf("push: %s %s: %s", n.Kind, n.ID, e)
+			}
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package push
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+func TestStoreRegisterUpsertsByToken(t *testing.T) {
+	town := t.TempDir()
+	s := NewStore(town)
+	first, err := s.Register(Device{Token: "tok-1", Platform: PlatformAPNs, Owner: "steve", Name: "iPhone"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := s.Register(Device{Token: "tok-1", Platform: PlatformAPNs, Owner: "steve", AppVersion: "1.2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !again.RegisteredAt.Equal(first.RegisteredAt) || again.AppVersion != "1.2" {
+		t.Errorf("re-registering = %+v, want original registration time and new app version", again)
+	}
+	if _, err := s.Register(Device{Token: "tok-2", Platform: PlatformFCM, Owner: "alex"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Register(Device{Token: "tok-3", Platform: "webpush"}); err == nil {
+		t.Error("Register accepted an unknown platform")
+	}
+
+	all, _ := s.List("")
+	mine, _ := s.List("steve")
+	if len(all) != 2 || len(mine) != 1 {
+		t.Fatalf("List: all = %d, steve = %d; want 2 and 1", len(all), len(mine))
+	}
+	if info, err := os.Stat(DevicesPath(town)); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("device store mode = %v (%v), want 0600", info.Mode().Perm(), err)
+	}
+
+	if removed, _ := s.Unregister("tok-1"); !removed {
+		t.Error("Unregister(tok-1) = false")
+	}
+	if removed, _ := s.Unregister("tok-1"); removed {
+		t.Error("second Unregister(tok-1) = true")
+	}
+}
+
+func TestFromEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		ev       events.Event
+		wantOK   bool
+		wantLink string
+		urgent   bool
+	}{
+		{
+			name:     "high urgency decision",
+			ev:       events.Event{Type: events.TypeDecisionRequested, Actor: "gastown/crew/joe", Payload: map[string]interface{}{"decision_id": "hq-d1", "urgency": "high", "question": "Ship it?"}},
+			wantOK:   true,
+			wantLink: "gastown://decisions/hq-d1",
+			urgent:   true,
+		},
+		{
+			name: "medium urgency decision",
+			ev:   events.Event{Type: events.TypeDecisionRequested, Payload: map[string]interface{}{"decision_id": "hq-d2", "urgency": "medium"}},
+		},
+		{
+			name:     "high escalation",
+			ev:       events.Event{Type: events.TypeEscalationSent, Actor: "gastown/witness", Payload: map[string]interface{}{"rig": "hq-e1", "severity": "high", "reason": "stuck"}},
+			wantOK:   true,
+			wantLink: "gastown://escalations/hq-e1",
+			urgent:   true,
+		},
+		{
+			name: "medium escalation",
+			ev:   events.Event{Type: events.TypeEscalationSent, Payload: map[string]interface{}{"rig": "hq-e2", "severity": "medium"}},
+		},
+		{
+			name:     "SLA re-escalation",
+			ev:       events.Event{Type: events.TypeEscalationSent, Payload: map[string]interface{}{"escalation_id": "hq-e3", "sla_breached": true, "old_severity": "high", "new_severity": "critical"}},
+			wantOK:   true,
+			wantLink: "gastown://escalations/hq-e3",
+			urgent:   true,
+		},
+		{
+			name:     "merge failure",
+			ev:       events.Event{Type: events.TypeMergeFailed, Payload: events.MergePayload("gt-mr1", "nux", "polecat/nux", "conflicts")},
+			wantOK:   true,
+			wantLink: "gastown://merge-requests/gt-mr1",
+		},
+		{
+			name:     "overseer convoy notification",
+			ev:       events.Event{Type: events.TypeNotification, Payload: map[string]interface{}{"event": "convoy", "severity": "low", "title": "Convoy landed", "bead": "hq-c1"}},
+			wantOK:   true,
+			wantLink: "gastown://beads/hq-c1",
+		},
+		{
+			name: "overseer escalation notification is pushed from the escalation event",
+			ev:   events.Event{Type: events.TypeNotification, Payload: map[string]interface{}{"event": "escalation", "severity": "high"}},
+		},
+		{
+			name: "unrelated event",
+			ev:   events.Event{Type: events.TypeSling},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, ok := FromEvent(tt.ev, config.SeverityHigh)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if n.DeepLink != tt.wantLink || n.Urgent != tt.urgent {
+				t.Errorf("got link %q urgent %v, want %q %v", n.DeepLink, n.Urgent, tt.wantLink, tt.urgent)
+			}
+		})
+	}
+}
+
+type fakeSender struct {
+	platform string
+	invalid  map[string]bool
+	sent     []string
+}
+
+func (f *fakeSender) Platform() string { return f.platform }
+
+func (f *fakeSender) Send(_ context.Context, token string, _ *Notification) error {
+	if f.invalid[token] {
+		return ErrInvalidToken
+	}
+	f.sent = append(f.sent, token)
+	return nil
+}
+
+func TestPusherRemovesInvalidTokens(t *testing.T) {
+	town := t.TempDir()
+	store := NewStore(town)
+	for _, d := range []Device{
+		{Token: "good", Platform: PlatformAPNs},
+		{Token: "stale", Platform: PlatformAPNs},
+		{Token: "android", Platform: PlatformFCM},
+	} {
+		if _, err := store.Register(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	apns := &fakeSender{platform: PlatformAPNs, invalid: map[string]bool{"stale": true}}
+	p := NewPusher(town, apns) // no FCM sender: Android devices are skipped
+
+	result, err := p.Push(context.Background(), "", &Notification{Title: "hi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Sent != 1 || result.Removed != 1 || len(result.Errors) != 0 {
+		t.Errorf("result = %+v, want 1 sent and 1 removed", result)
+	}
+	devices, _ := store.List("")
+	if len(devices) != 2 {
+		t.Errorf("%d devices left, want 2 after removing the stale token", len(devices))
+	}
+	if _, err := p.PushToken(context.Background(), "missing", &Notification{}); err == nil {
+		t.Error("PushToken to an unregistered device succeeded")
+	}
+}
+
+func TestAPNsSend(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(t.TempDir(), "AuthKey.p8")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var gotPath, gotAuth, gotTopic string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotAuth, gotTopic = r.URL.Path, r.Header.Get("authorization"), r.Header.Get("apns-topic")
+		if strings.HasSuffix(r.URL.Path, "/dead") {
+			w.WriteHeader(http.StatusGone)
+			_, _ = w.Write([]byte(`{"reason":"Unregistered"}`))
+		}
+	}))
+	defer srv.Close()
+
+	a, err := NewAPNs(&config.APNsConfig{KeyFile: keyFile, KeyID: "K1", TeamID: "T1", Topic: "com.example.gastown"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.baseURL = srv.URL
+
+	if err := a.Send(context.Background(), "abc", &Notification{Title: "t", Urgent: true}); err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/3/device/abc" || gotTopic != "com.example.gastown" || strings.Count(gotAuth, ".") != 2 {
+		t.Errorf("request path %q topic %q auth %q", gotPath, gotTopic, gotAuth)
+	}
+	if err := a.Send(context.Background(), "dead", &Notification{}); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Send to unregistered token = %v, want ErrInvalidToken", err)
+	}
+}
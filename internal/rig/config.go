@@ -44,6 +44,8 @@ var SystemDefaults = map[string]interface{}{
 	"status":                  "operational",
 	"auto_restart":            true,
 	"max_polecats":            10,
+	"max_slings_per_hour":     0, // 0 = unlimited
+	"daily_token_budget":      0, // 0 = unlimited
 	"priority_adjustment":     0,
 	"dnd":                     false,
 	"polecat_branch_template": "", // Empty = use default behavior (polecat/{name}/...)
@@ -0,0 +1,192 @@
+package rig
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+// Index caches the rigs of a town for long-running processes such as the
+// RPC server, so status requests don't rescan every rig directory.
+//
+// The first call to Rigs scans the town; later calls return the cached rigs
+// until fsnotify reports a change under mayor/ (rigs.json), a rig directory,
+// or a rig's polecats/, crew/, refinery/ or mayor/ directory. If a watcher
+// can't be created (e.g., inotify limits), every call rescans.
+type Index struct {
+	townRoot string
+	scan     func() ([]*Rig, error)
+
+	mu         sync.Mutex
+	rigs       []*Rig
+	valid      bool
+	generation uint64 // bumped on every invalidation
+	watcher    *fsnotify.Watcher
+	watched    map[string]bool
+	closed     bool
+}
+
+// NewIndex creates an index for the town at townRoot. Rigs are read from
+// mayor/rigs.json.
+func NewIndex(townRoot string) *Index {
+	x := &Index{townRoot: townRoot, watched: make(map[string]bool)}
+	x.scan = x.discover
+	return x
+}
+
+// discover loads the rigs registry and scans every rig.
+func (x *Index) discover() ([]*Rig, error) {
+	rigsConfig, err := config.LoadRigsConfig(constants.MayorRigsPath(x.townRoot))
+	if err != nil {
+		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	}
+	return NewManager(x.townRoot, rigsConfig, git.NewGit(x.townRoot)).DiscoverRigs()
+}
+
+// Rigs returns the town's rigs, sorted by name, rescanning only if the
+// cache has been invalidated. The returned rigs are shared and must not be
+// modified.
+func (x *Index) Rigs() ([]*Rig, error) {
+	x.mu.Lock()
+	if x.valid {
+		rigs := x.rigs
+		x.mu.Unlock()
+		return append([]*Rig(nil), rigs...), nil
+	}
+	x.mu.Unlock()
+	return x.Refresh()
+}
+
+// Refresh rescans the town unconditionally and returns the result.
+func (x *Index) Refresh() ([]*Rig, error) {
+	x.mu.Lock()
+	gen := x.generation
+	x.mu.Unlock()
+
+	rigs, err := x.scan()
+	if err != nil {
+		return nil, err
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.rigs = rigs
+	// A change that landed mid-scan may not be reflected; leave the cache
+	// invalid so the next call rescans.
+	x.valid = gen == x.generation && x.watch(rigs)
+	return append([]*Rig(nil), rigs...), nil
+}
+
+// Invalidate drops the cached rigs so the next Rigs call rescans.
+func (x *Index) Invalidate() {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.valid = false
+	x.generation++
+}
+
+// Generation returns a counter that changes whenever the cache is
+// invalidated, for callers that layer their own caches on the index.
+func (x *Index) Generation() uint64 {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.generation
+}
+
+// Close stops watching the filesystem. The index keeps working, rescanning
+// on every call.
+func (x *Index) Close() error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.closed = true
+	x.valid = false
+	if x.watcher == nil {
+		return nil
+	}
+	err := x.watcher.Close()
+	x.watcher = nil
+	x.watched = make(map[string]bool)
+	return err
+}
+
+// watch brings the watch list in line with rigs, starting the watcher on
+// first use. It reports whether the cache can be trusted, i.e. whether
+// changes will be noticed. Called with mu held.
+func (x *Index) watch(rigs []*Rig) bool {
+	if x.closed {
+		return false
+	}
+	if x.watcher == nil {
+		w, err := fsnotify.NewWatcher()
+		if err != nil {
+			return false
+		}
+		x.watcher = w
+		go x.run(w)
+	}
+
+	want := map[string]bool{filepath.Join(x.townRoot, "mayor"): true}
+	for _, r := range rigs {
+		want[r.Path] = true
+		for _, sub := range []string{"polecats", "crew", "refinery", "mayor"} {
+			want[filepath.Join(r.Path, sub)] = true
+		}
+	}
+	for path := range x.watched {
+		if !want[path] {
+			_ = x.watcher.Remove(path)
+			delete(x.watched, path)
+		}
+	}
+	trusted := true
+	for path := range want {
+		if x.watched[path] {
+			continue
+		}
+		if err := x.watcher.Add(path); err == nil {
+			x.watched[path] = true
+		} else if _, statErr := os.Stat(path); statErr == nil {
+			// An existing directory we can't watch (e.g., out of inotify
+			// watches). Missing ones are fine: their parent is watched and
+			// creating them invalidates the cache.
+			trusted = false
+		}
+	}
+	return trusted
+}
+
+// run invalidates the cache on every filesystem event until the watcher is
+// closed.
+func (x *Index) run(w *fsnotify.Watcher) {
+	for {
+		select {
+		case ev, ok := <-w.Events:
+			if !ok {
+				return
+			}
+			if ev.Op == fsnotify.Chmod {
+				continue
+			}
+			x.mu.Lock()
+			if ev.Has(fsnotify.Remove) || ev.Has(fsnotify.Rename) {
+				// The kernel drops the watch with the directory; re-add it
+				// if the directory comes back.
+				delete(x.watched, ev.Name)
+			}
+			x.valid = false
+			x.generation++
+			x.mu.Unlock()
+		case _, ok := <-w.Errors:
+			if !ok {
+				return
+			}
+			// Events may have been dropped (queue overflow).
+			x.Invalidate()
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package rig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+func TestIndexCachesUntilChange(t *testing.T) {
+	root, rigsConfig := setupTestTown(t)
+	createTestRig(t, root, "gastown")
+	rigsConfig.Rigs["gastown"] = config.RigEntry{GitURL: "git@github.com:test/gastown.git"}
+	if err := config.SaveRigsConfig(constants.MayorRigsPath(root), rigsConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	x := NewIndex(root)
+	defer x.Close()
+	scans := 0
+	x.scan = func() ([]*Rig, error) {
+		scans++
+		return x.discover()
+	}
+
+	rigs, err := x.Rigs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rigs) != 1 || len(rigs[0].Polecats) != 2 {
+		t.Fatalf("Rigs() = %+v, want gastown with 2 polecats", rigs)
+	}
+	if _, err := x.Rigs(); err != nil || scans != 1 {
+		t.Fatalf("second Rigs() rescanned (scans=%d, err=%v)", scans, err)
+	}
+
+	// A new polecat directory invalidates the cache.
+	if err := os.MkdirAll(filepath.Join(root, "gastown", "polecats", "Nux"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		rigs, err = x.Rigs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rigs[0].Polecats) == 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("new polecat not picked up: %v", rigs[0].Polecats)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	before := scans
+	if _, err := x.Refresh(); err != nil || scans != before+1 {
+		t.Errorf("Refresh did not rescan (scans=%d, err=%v)", scans, err)
+	}
+}
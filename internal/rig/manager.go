@@ -7,7 +7,9 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/bdcmd"
@@ -108,20 +110,44 @@ func NewManager(townRoot string, rigsConfig *config.RigsConfig, g *git.Git) *Man
 	}
 }
 
-// DiscoverRigs returns all rigs registered in the workspace.
-// Rigs that fail to load are logged to stderr and skipped; partial results are returned.
+// discoverConcurrency bounds how many rigs DiscoverRigs scans at once.
+const discoverConcurrency = 8
+
+// DiscoverRigs returns all rigs registered in the workspace, sorted by name.
+// Rigs are scanned in parallel. Rigs that fail to load are logged to stderr
+// and skipped; partial results are returned.
 func (m *Manager) DiscoverRigs() ([]*Rig, error) {
-	var rigs []*Rig
+	names := make([]string, 0, len(m.config.Rigs))
+	for name := range m.config.Rigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	loaded := make([]*Rig, len(names))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, discoverConcurrency)
+	for i, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			rig, err := m.loadRig(name, m.config.Rigs[name])
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to load rig %q: %v\n", name, err)
+				return
+			}
+			loaded[i] = rig
+		}(i, name)
+	}
+	wg.Wait()
 
-	for name, entry := range m.config.Rigs {
-		rig, err := m.loadRig(name, entry)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: failed to load rig %q: %v\n", name, err)
-			continue
+	rigs := make([]*Rig, 0, len(loaded))
+	for _, r := range loaded {
+		if r != nil {
+			rigs = append(rigs, r)
 		}
-		rigs = append(rigs, rig)
 	}
-
 	return rigs, nil
 }
 
@@ -248,6 +274,71 @@ func resolveLocalRepo(path, gitURL string) (string, string) {
 	return absPath, ""
 }
 
+// ValidateRigName rejects rig names that would break agent ID parsing.
+// Agent IDs use the format <prefix>-<rig>-<role>[-<name>] with hyphens as
+// delimiters, so hyphens, dots, and spaces are reserved.
+func ValidateRigName(name string) error {
+	if name == "" {
+		return fmt.Errorf("rig name is required")
+	}
+	if strings.ContainsAny(name, "-. ") {
+		return fmt.Errorf("rig name %q contains invalid characters; hyphens, dots, and spaces are reserved for agent ID parsing. Try %q instead (underscores are allowed)", name, sanitizeRigName(name))
+	}
+	if strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("rig name %q must not contain path separators", name)
+	}
+	return nil
+}
+
+// sanitizeRigName maps reserved characters to underscores and lowercases.
+func sanitizeRigName(name string) string {
+	return strings.ToLower(strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace(name))
+}
+
+// RigNameFromGitURL suggests a rig name for a repository: the last path
+// element without .git, sanitized for agent IDs.
+// Examples: "https://github.com/steveyegge/gastown" -> "gastown",
+// "git@github.com:user/my-project.git" -> "my_project".
+func RigNameFromGitURL(gitURL string) string {
+	name := strings.TrimRight(strings.TrimSpace(gitURL), "/")
+	name = strings.TrimSuffix(name, ".git")
+	if i := strings.LastIndexAny(name, "/:"); i >= 0 {
+		name = name[i+1:]
+	}
+	return sanitizeRigName(name)
+}
+
+// CheckAddRig runs the checks AddRig would fail on, without touching the
+// filesystem, so callers can validate everything before cloning. A rig
+// whose beads prefix is already used by another registered rig is rejected
+// here too, since its issues would be routed to the other rig.
+func (m *Manager) CheckAddRig(opts AddRigOptions) error {
+	if err := ValidateRigName(opts.Name); err != nil {
+		return err
+	}
+	if m.RigExists(opts.Name) {
+		return ErrRigExists
+	}
+	rigPath := filepath.Join(m.townRoot, opts.Name)
+	if _, err := os.Stat(rigPath); err == nil {
+		return fmt.Errorf("directory already exists: %s\n\nTo adopt an existing directory, use --adopt:\n  gt rig add %s --adopt", rigPath, opts.Name)
+	}
+
+	prefix := opts.BeadsPrefix
+	if prefix == "" {
+		prefix = DeriveBeadsPrefix(opts.Name)
+	}
+	if !isValidBeadsPrefix(prefix) {
+		return fmt.Errorf("invalid beads prefix %q: must start with a letter and contain only letters, digits, and hyphens", prefix)
+	}
+	for name, entry := range m.config.Rigs {
+		if entry.BeadsConfig != nil && entry.BeadsConfig.Prefix == prefix {
+			return fmt.Errorf("beads prefix %q is already used by rig %s (choose another with --prefix)", prefix, name)
+		}
+	}
+	return nil
+}
+
 // AddRig creates a new rig as a container with clones for each agent.
 // The rig structure is:
 //
@@ -264,12 +355,8 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 		return nil, ErrRigExists
 	}
 
-	// Validate rig name: reject characters that break agent ID parsing
-	// Agent IDs use format <prefix>-<rig>-<role>[-<name>] with hyphens as delimiters
-	if strings.ContainsAny(opts.Name, "-. ") {
-		sanitized := strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace(opts.Name)
-		sanitized = strings.ToLower(sanitized)
-		return nil, fmt.Errorf("rig name %q contains invalid characters; hyphens, dots, and spaces are reserved for agent ID parsing. Try %q instead (underscores are allowed)", opts.Name, sanitized)
+	if err := ValidateRigName(opts.Name); err != nil {
+		return nil, err
 	}
 
 	rigPath := filepath.Join(m.townRoot, opts.Name)
@@ -284,7 +371,7 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 
 	// Derive defaults
 	if opts.BeadsPrefix == "" {
-		opts.BeadsPrefix = deriveBeadsPrefix(opts.Name)
+		opts.BeadsPrefix = DeriveBeadsPrefix(opts.Name)
 	}
 
 	localRepo, warn := resolveLocalRepo(opts.LocalRepo, opts.GitURL)
@@ -898,9 +985,9 @@ func (m *Manager) ensureGitignoreEntry(gitignorePath, entry string) error {
 	return err
 }
 
-// deriveBeadsPrefix generates a beads prefix from a rig name.
+// DeriveBeadsPrefix generates a beads prefix from a rig name.
 // Examples: "gastown" -> "gt", "my-project" -> "mp", "foo" -> "foo"
-func deriveBeadsPrefix(name string) string {
+func DeriveBeadsPrefix(name string) string {
 	// Remove common suffixes
 	name = strings.TrimSuffix(name, "-py")
 	name = strings.TrimSuffix(name, "-go")
@@ -1119,7 +1206,7 @@ func (m *Manager) RegisterRig(opts RegisterRigOptions) (*RegisterRigResult, erro
 
 	// Derive beads prefix
 	if result.BeadsPrefix == "" && opts.BeadsPrefix == "" {
-		result.BeadsPrefix = deriveBeadsPrefix(opts.Name)
+		result.BeadsPrefix = DeriveBeadsPrefix(opts.Name)
 	}
 	if opts.BeadsPrefix != "" {
 		result.BeadsPrefix = opts.BeadsPrefix
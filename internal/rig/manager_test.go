@@ -233,6 +233,65 @@ func TestAddRig_RejectsInvalidNames(t *testing.T) {
 	}
 }
 
+func TestRigNameFromGitURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://github.com/steveyegge/gastown", "gastown"},
+		{"https://github.com/steveyegge/gastown.git/", "gastown"},
+		{"git@github.com:user/my-project.git", "my_project"},
+		{"git@example.com:Repo.JS", "repo_js"},
+		{"/srv/git/beads", "beads"},
+	}
+	for _, tt := range tests {
+		if got := RigNameFromGitURL(tt.url); got != tt.want {
+			t.Errorf("RigNameFromGitURL(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+		if err := ValidateRigName(RigNameFromGitURL(tt.url)); err != nil {
+			t.Errorf("name derived from %q is invalid: %v", tt.url, err)
+		}
+	}
+}
+
+func TestCheckAddRig(t *testing.T) {
+	root, rigsConfig := setupTestTown(t)
+	rigsConfig.Rigs["gastown"] = config.RigEntry{BeadsConfig: &config.BeadsConfig{Prefix: "gt"}}
+	if err := os.MkdirAll(filepath.Join(root, "leftover"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	manager := NewManager(root, rigsConfig, git.NewGit(root))
+
+	tests := []struct {
+		opts      AddRigOptions
+		wantError string
+	}{
+		{AddRigOptions{Name: "beads"}, ""},
+		{AddRigOptions{Name: "my-rig"}, "contains invalid characters"},
+		{AddRigOptions{Name: "gastown"}, "already exists"},
+		{AddRigOptions{Name: "leftover"}, "--adopt"},
+		{AddRigOptions{Name: "greentown"}, `prefix "gt" is already used by rig gastown`},
+		{AddRigOptions{Name: "greentown", BeadsPrefix: "grt"}, ""},
+		{AddRigOptions{Name: "beads", BeadsPrefix: "b;rm"}, "invalid beads prefix"},
+	}
+	for _, tt := range tests {
+		err := manager.CheckAddRig(tt.opts)
+		if tt.wantError == "" {
+			if err != nil {
+				t.Errorf("CheckAddRig(%+v) = %v, want nil", tt.opts, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+			t.Errorf("CheckAddRig(%+v) = %v, want error containing %q", tt.opts, err, tt.wantError)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(root, "beads")); !os.IsNotExist(err) {
+		t.Error("CheckAddRig must not create the rig directory")
+	}
+}
+
 func TestListRigNames(t *testing.T) {
 	root, rigsConfig := setupTestTown(t)
 	rigsConfig.Rigs["rig1"] = config.RigEntry{}
@@ -819,9 +878,9 @@ func TestDeriveBeadsPrefix(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := deriveBeadsPrefix(tt.name)
+			got := DeriveBeadsPrefix(tt.name)
 			if got != tt.want {
-				t.Errorf("deriveBeadsPrefix(%q) = %q, want %q", tt.name, got, tt.want)
+				t.Errorf("DeriveBeadsPrefix(%q) = %q, want %q", tt.name, got, tt.want)
 			}
 		})
 	}
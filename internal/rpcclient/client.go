@@ -2,9 +2,13 @@
 package rpcclient
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -49,6 +53,17 @@ func WithTimeout(d time.Duration) Option {
 	}
 }
 
+// WithTLSConfig sets the TLS config used for https:// base URLs, e.g. to
+// present a client certificate to a server that requires mutual TLS.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c *Client) {
+		c.httpClient.Transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: cfg,
+		}
+	}
+}
+
 // WithTownName sets the town name used for bead ID generation.
 func WithTownName(name string) Option {
 	return func(c *Client) {
@@ -1206,19 +1221,40 @@ type EventFilter struct {
 
 // ListEventsRequest contains the parameters for listing events via RPC.
 type ListEventsRequest struct {
-	Filter  *EventFilter
-	Limit   int  // Maximum events (default: 100, max: 1000)
-	Curated bool // Read from curated feed (true) or raw events (false)
+	Filter    *EventFilter
+	Limit     int    // Maximum events (default: 100, max: 1000)
+	Curated   bool   // Read from curated feed (true) or raw events (false)
+	PageToken string // NextPageToken from a previous page (raw events only)
+}
+
+// EventsPage is one page of events from ListEventsPage.
+type EventsPage struct {
+	Events        []ActivityEvent
+	TotalCount    int
+	NextPageToken string // Empty on the last page
 }
 
 // ListEvents fetches events from the activity feed.
 func (c *Client) ListEvents(ctx context.Context, req ListEventsRequest) ([]ActivityEvent, int, error) {
+	page, err := c.ListEventsPage(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	return page.Events, page.TotalCount, nil
+}
+
+// ListEventsPage fetches one page of events from the activity feed. Pass
+// the returned NextPageToken as req.PageToken to fetch older events.
+func (c *Client) ListEventsPage(ctx context.Context, req ListEventsRequest) (*EventsPage, error) {
 	body := map[string]interface{}{
 		"curated": req.Curated,
 	}
 	if req.Limit > 0 {
 		body["limit"] = req.Limit
 	}
+	if req.PageToken != "" {
+		body["pageToken"] = req.PageToken
+	}
 	if req.Filter != nil {
 		filter := map[string]interface{}{}
 		if len(req.Filter.Types) > 0 {
@@ -1246,14 +1282,14 @@ func (c *Client) ListEvents(ctx context.Context, req ListEventsRequest) ([]Activ
 
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
-		return nil, 0, fmt.Errorf("encoding request: %w", err)
+		return nil, fmt.Errorf("encoding request: %w", err)
 	}
 
 	httpReq, err := http.NewRequestWithContext(ctx, "POST",
 		c.baseURL+"/gastown.v1.ActivityService/ListEvents",
 		strings.NewReader(string(jsonBody)))
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	httpReq.Header.Set("Content-Type", "application/json")
 	if c.apiKey != "" {
@@ -1262,12 +1298,12 @@ func (c *Client) ListEvents(ctx context.Context, req ListEventsRequest) ([]Activ
 
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, 0, fmt.Errorf("RPC error: %s", resp.Status)
+		return nil, fmt.Errorf("RPC error: %s", resp.Status)
 	}
 
 	var result struct {
@@ -1281,16 +1317,17 @@ func (c *Client) ListEvents(ctx context.Context, req ListEventsRequest) ([]Activ
 			Summary    string                 `json:"summary"`
 			Count      int                    `json:"count"`
 		} `json:"events"`
-		TotalCount int `json:"totalCount"`
+		TotalCount    int    `json:"totalCount"`
+		NextPageToken string `json:"nextPageToken"`
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, 0, fmt.Errorf("decoding response: %w", err)
+		return nil, fmt.Errorf("decoding response: %w", err)
 	}
 
-	var events []ActivityEvent
+	page := &EventsPage{TotalCount: result.TotalCount, NextPageToken: result.NextPageToken}
 	for _, e := range result.Events {
-		events = append(events, ActivityEvent{
+		page.Events = append(page.Events, ActivityEvent{
 			Timestamp:  e.Timestamp,
 			Source:     e.Source,
 			Type:       e.Type,
@@ -1302,7 +1339,7 @@ func (c *Client) ListEvents(ctx context.Context, req ListEventsRequest) ([]Activ
 		})
 	}
 
-	return events, result.TotalCount, nil
+	return page, nil
 }
 
 // WatchEvents streams events from the activity feed.
@@ -1465,17 +1502,63 @@ type MailMessage struct {
 	ReplyTo   string
 	Pinned    bool
 	CC        []string
+	Receipt   string // "event" or "mail" if the sender asked for receipts
+
+	Attachments []MailAttachment
+}
+
+// MailAttachment is a file sent with a mail message. Inline attachments
+// carry their content in Data; referenced files carry only Path.
+type MailAttachment struct {
+	Name        string
+	ContentType string
+	Size        int64
+	Path        string
+	Data        []byte
+}
+
+// mailAttachmentJSON is the wire form of an Attachment. Connect JSON encodes
+// int64 as a string and bytes as base64.
+type mailAttachmentJSON struct {
+	Name        string `json:"name"`
+	ContentType string `json:"contentType,omitempty"`
+	Size        int64  `json:"size,string,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Data        []byte `json:"data,omitempty"`
 }
 
 // ListInboxRequest contains the parameters for listing inbox messages.
 type ListInboxRequest struct {
-	Address    string // Recipient address (empty = overseer)
-	UnreadOnly bool
-	Limit      int
+	Address     string // Recipient address (empty = overseer)
+	UnreadOnly  bool
+	Limit       int       // Page size (0 = all)
+	From        string    // Sender filter; a trailing "/" matches a prefix
+	MinPriority string    // "urgent", "high", "normal", or "low"
+	Since       time.Time // Only messages sent after this time
+	Until       time.Time // Only messages sent at or before this time
+	Cursor      string    // NextCursor from a previous page
+}
+
+// InboxPage is one page of inbox messages.
+type InboxPage struct {
+	Messages   []MailMessage
+	Total      int    // All messages in the inbox
+	Unread     int    // Unread messages in the inbox
+	Matched    int    // Messages matching the filters across all pages
+	NextCursor string // Empty on the last page
 }
 
 // ListInbox fetches messages from an inbox via RPC.
 func (c *Client) ListInbox(ctx context.Context, req ListInboxRequest) ([]MailMessage, int, int, error) {
+	page, err := c.ListInboxPage(ctx, req)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return page.Messages, page.Total, page.Unread, nil
+}
+
+// ListInboxPage fetches one filtered page of an inbox via RPC.
+func (c *Client) ListInboxPage(ctx context.Context, req ListInboxRequest) (*InboxPage, error) {
 	body := map[string]interface{}{}
 	if req.Address != "" {
 		body["address"] = map[string]string{"name": req.Address}
@@ -1486,17 +1569,32 @@ func (c *Client) ListInbox(ctx context.Context, req ListInboxRequest) ([]MailMes
 	if req.Limit > 0 {
 		body["limit"] = req.Limit
 	}
+	if req.From != "" {
+		body["from"] = req.From
+	}
+	if req.MinPriority != "" {
+		body["minPriority"] = priorityToProto(req.MinPriority)
+	}
+	if !req.Since.IsZero() {
+		body["since"] = req.Since.UTC().Format(time.RFC3339Nano)
+	}
+	if !req.Until.IsZero() {
+		body["until"] = req.Until.UTC().Format(time.RFC3339Nano)
+	}
+	if req.Cursor != "" {
+		body["cursor"] = req.Cursor
+	}
 
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
-		return nil, 0, 0, fmt.Errorf("encoding request: %w", err)
+		return nil, fmt.Errorf("encoding request: %w", err)
 	}
 
 	httpReq, err := http.NewRequestWithContext(ctx, "POST",
 		c.baseURL+"/gastown.v1.MailService/ListInbox",
 		strings.NewReader(string(jsonBody)))
 	if err != nil {
-		return nil, 0, 0, err
+		return nil, err
 	}
 	httpReq.Header.Set("Content-Type", "application/json")
 	if c.apiKey != "" {
@@ -1505,12 +1603,12 @@ func (c *Client) ListInbox(ctx context.Context, req ListInboxRequest) ([]MailMes
 
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, 0, 0, err
+		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, 0, 0, fmt.Errorf("RPC error: %s", resp.Status)
+		return nil, fmt.Errorf("RPC error: %s", resp.Status)
 	}
 
 	var result struct {
@@ -1529,15 +1627,22 @@ func (c *Client) ListInbox(ctx context.Context, req ListInboxRequest) ([]MailMes
 			Pinned    bool   `json:"pinned"`
 			CC        []struct{ Name string } `json:"cc"`
 		} `json:"messages"`
-		Total  int `json:"total"`
-		Unread int `json:"unread"`
+		Total      int    `json:"total"`
+		Unread     int    `json:"unread"`
+		Matched    int    `json:"matched"`
+		NextCursor string `json:"nextCursor"`
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, 0, 0, fmt.Errorf("decoding response: %w", err)
+		return nil, fmt.Errorf("decoding response: %w", err)
 	}
 
-	var messages []MailMessage
+	page := &InboxPage{
+		Total:      result.Total,
+		Unread:     result.Unread,
+		Matched:    result.Matched,
+		NextCursor: result.NextCursor,
+	}
 	for _, m := range result.Messages {
 		ts, _ := time.Parse(time.RFC3339, m.Timestamp)
 		msg := MailMessage{
@@ -1557,10 +1662,10 @@ func (c *Client) ListInbox(ctx context.Context, req ListInboxRequest) ([]MailMes
 		for _, cc := range m.CC {
 			msg.CC = append(msg.CC, cc.Name)
 		}
-		messages = append(messages, msg)
+		page.Messages = append(page.Messages, msg)
 	}
 
-	return messages, result.Total, result.Unread, nil
+	return page, nil
 }
 
 // ReadMailMessage fetches a specific message by ID via RPC.
@@ -1596,28 +1701,36 @@ func (c *Client) ReadMailMessage(ctx context.Context, messageID string) (*MailMe
 	}
 
 	var result struct {
-		Message struct {
-			ID        string `json:"id"`
-			From      struct{ Name string } `json:"from"`
-			To        struct{ Name string } `json:"to"`
-			Subject   string `json:"subject"`
-			Body      string `json:"body"`
-			Timestamp string `json:"timestamp"`
-			Read      bool   `json:"read"`
-			Priority  string `json:"priority"`
-			Type      string `json:"type"`
-			ThreadID  string `json:"threadId"`
-			ReplyTo   string `json:"replyTo"`
-			Pinned    bool   `json:"pinned"`
-			CC        []struct{ Name string } `json:"cc"`
-		} `json:"message"`
+		Message mailMessageJSON `json:"message"`
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("decoding response: %w", err)
 	}
 
-	m := result.Message
+	return result.Message.toMailMessage(), nil
+}
+
+// mailMessageJSON is the wire form of a Message.
+type mailMessageJSON struct {
+	ID          string                  `json:"id"`
+	From        struct{ Name string }   `json:"from"`
+	To          struct{ Name string }   `json:"to"`
+	Subject     string                  `json:"subject"`
+	Body        string                  `json:"body"`
+	Timestamp   string                  `json:"timestamp"`
+	Read        bool                    `json:"read"`
+	Priority    string                  `json:"priority"`
+	Type        string                  `json:"type"`
+	ThreadID    string                  `json:"threadId"`
+	ReplyTo     string                  `json:"replyTo"`
+	Pinned      bool                    `json:"pinned"`
+	CC          []struct{ Name string } `json:"cc"`
+	Attachments []mailAttachmentJSON    `json:"attachments"`
+	Receipt     string                  `json:"receipt"`
+}
+
+func (m mailMessageJSON) toMailMessage() *MailMessage {
 	ts, _ := time.Parse(time.RFC3339, m.Timestamp)
 	msg := &MailMessage{
 		ID:        m.ID,
@@ -1632,12 +1745,77 @@ func (c *Client) ReadMailMessage(ctx context.Context, messageID string) (*MailMe
 		ThreadID:  m.ThreadID,
 		ReplyTo:   m.ReplyTo,
 		Pinned:    m.Pinned,
+		Receipt:   receiptToString(m.Receipt),
 	}
 	for _, cc := range m.CC {
 		msg.CC = append(msg.CC, cc.Name)
 	}
+	for _, a := range m.Attachments {
+		msg.Attachments = append(msg.Attachments, MailAttachment(a))
+	}
+	return msg
+}
 
-	return msg, nil
+// MailThreadEntry is a message in a conversation. Depth counts reply hops
+// from the message that started the thread.
+type MailThreadEntry struct {
+	MailMessage
+	Depth int
+}
+
+// GetMailThread fetches a conversation in reply order via RPC, by thread
+// ID or, if threadID is empty, by the ID of any message in it. It returns
+// the resolved thread ID along with the entries.
+func (c *Client) GetMailThread(ctx context.Context, threadID, messageID string) (string, []MailThreadEntry, error) {
+	body := map[string]interface{}{}
+	if threadID != "" {
+		body["threadId"] = threadID
+	} else {
+		body["messageId"] = messageID
+	}
+
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return "", nil, fmt.Errorf("encoding request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST",
+		c.baseURL+"/gastown.v1.MailService/GetThread",
+		strings.NewReader(string(jsonBody)))
+	if err != nil {
+		return "", nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		httpReq.Header.Set("X-GT-API-Key", c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return "", nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("RPC error: %s", resp.Status)
+	}
+
+	var result struct {
+		ThreadID string `json:"threadId"`
+		Entries  []struct {
+			Message mailMessageJSON `json:"message"`
+			Depth   int             `json:"depth"`
+		} `json:"entries"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", nil, fmt.Errorf("decoding response: %w", err)
+	}
+
+	var entries []MailThreadEntry
+	for _, e := range result.Entries {
+		entries = append(entries, MailThreadEntry{MailMessage: *e.Message.toMailMessage(), Depth: e.Depth})
+	}
+	return result.ThreadID, entries, nil
 }
 
 // SendMailRequest contains the parameters for sending a mail message via RPC.
@@ -1649,6 +1827,16 @@ type SendMailRequest struct {
 	Type     string
 	ReplyTo  string
 	CC       []string
+
+	// Attachments are sent inline when Data is set, or as references to
+	// files on the server's filesystem when Path is set.
+	Attachments []MailAttachment
+
+	// Receipt requests delivery/read receipts: "event" or "mail".
+	Receipt string
+
+	// From overrides the sender recorded by the server (X-GT-From).
+	From string
 }
 
 // SendMail sends a new mail message via RPC.
@@ -1674,6 +1862,19 @@ func (c *Client) SendMail(ctx context.Context, req SendMailRequest) (string, err
 		}
 		body["cc"] = ccAddrs
 	}
+	if len(req.Attachments) > 0 {
+		var attachments []mailAttachmentJSON
+		for _, a := range req.Attachments {
+			attachments = append(attachments, mailAttachmentJSON(a))
+		}
+		body["attachments"] = attachments
+	}
+	switch req.Receipt {
+	case "event":
+		body["receipt"] = "RECEIPT_EVENT"
+	case "mail":
+		body["receipt"] = "RECEIPT_MAIL"
+	}
 
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
@@ -1690,6 +1891,9 @@ func (c *Client) SendMail(ctx context.Context, req SendMailRequest) (string, err
 	if c.apiKey != "" {
 		httpReq.Header.Set("X-GT-API-Key", c.apiKey)
 	}
+	if req.From != "" {
+		httpReq.Header.Set("X-GT-From", req.From)
+	}
 
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
@@ -1815,6 +2019,17 @@ func priorityToProto(p string) string {
 	}
 }
 
+func receiptToString(r string) string {
+	switch r {
+	case "RECEIPT_EVENT":
+		return "event"
+	case "RECEIPT_MAIL":
+		return "mail"
+	default:
+		return ""
+	}
+}
+
 func messageTypeToString(t string) string {
 	switch t {
 	case "MESSAGE_TYPE_TASK":
@@ -1848,6 +2063,155 @@ func messageTypeToProto(t string) string {
 	}
 }
 
+// TownStatus is a snapshot of the town from the StatusService.
+type TownStatus struct {
+	Name         string
+	GlobalAgents []AgentRuntime // Mayor, Deacon, Boot
+	Rigs         []RigStatus
+}
+
+// RigStatus is the status of one rig.
+type RigStatus struct {
+	Name         string
+	Polecats     []string
+	Crews        []string
+	HasWitness   bool
+	HasRefinery  bool
+	Agents       []AgentRuntime
+	MQPending    int
+	MQInProgress int
+}
+
+// AgentRuntime is an agent's runtime state as reported by the StatusService.
+type AgentRuntime struct {
+	Name       string
+	Address    string // e.g. "gastown/polecats/nux", "mayor"
+	Session    string
+	Role       string
+	Running    bool
+	HasWork    bool
+	WorkTitle  string
+	HookBead   string
+	State      string
+	UnreadMail int
+}
+
+type agentRuntimeJSON struct {
+	Name    string `json:"name"`
+	Address struct {
+		Rig  string `json:"rig"`
+		Role string `json:"role"`
+		Name string `json:"name"`
+	} `json:"address"`
+	Session    string `json:"session"`
+	Role       string `json:"role"`
+	Running    bool   `json:"running"`
+	HasWork    bool   `json:"hasWork"`
+	WorkTitle  string `json:"workTitle"`
+	HookBead   string `json:"hookBead"`
+	State      string `json:"state"`
+	UnreadMail int    `json:"unreadMail"`
+}
+
+func (a agentRuntimeJSON) toAgentRuntime() AgentRuntime {
+	var parts []string
+	for _, p := range []string{a.Address.Rig, a.Address.Role, a.Address.Name} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return AgentRuntime{
+		Name:       a.Name,
+		Address:    strings.Join(parts, "/"),
+		Session:    a.Session,
+		Role:       a.Role,
+		Running:    a.Running,
+		HasWork:    a.HasWork,
+		WorkTitle:  a.WorkTitle,
+		HookBead:   a.HookBead,
+		State:      a.State,
+		UnreadMail: a.UnreadMail,
+	}
+}
+
+// GetTownStatus fetches the town status via RPC. fast skips mail lookups.
+func (c *Client) GetTownStatus(ctx context.Context, fast bool) (*TownStatus, error) {
+	body := map[string]interface{}{}
+	if fast {
+		body["fast"] = true
+	}
+
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("encoding request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST",
+		c.baseURL+"/gastown.v1.StatusService/GetTownStatus",
+		strings.NewReader(string(jsonBody)))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		httpReq.Header.Set("X-GT-API-Key", c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("RPC error: %s", resp.Status)
+	}
+
+	var result struct {
+		Status struct {
+			Name         string             `json:"name"`
+			GlobalAgents []agentRuntimeJSON `json:"globalAgents"`
+			Rigs         []struct {
+				Name        string             `json:"name"`
+				Polecats    []string           `json:"polecats"`
+				Crews       []string           `json:"crews"`
+				HasWitness  bool               `json:"hasWitness"`
+				HasRefinery bool               `json:"hasRefinery"`
+				Agents      []agentRuntimeJSON `json:"agents"`
+				MergeQueue  struct {
+					Pending    int `json:"pending"`
+					InProgress int `json:"inProgress"`
+				} `json:"mergeQueue"`
+			} `json:"rigs"`
+		} `json:"status"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+
+	status := &TownStatus{Name: result.Status.Name}
+	for _, a := range result.Status.GlobalAgents {
+		status.GlobalAgents = append(status.GlobalAgents, a.toAgentRuntime())
+	}
+	for _, r := range result.Status.Rigs {
+		rig := RigStatus{
+			Name:         r.Name,
+			Polecats:     r.Polecats,
+			Crews:        r.Crews,
+			HasWitness:   r.HasWitness,
+			HasRefinery:  r.HasRefinery,
+			MQPending:    r.MergeQueue.Pending,
+			MQInProgress: r.MergeQueue.InProgress,
+		}
+		for _, a := range r.Agents {
+			rig.Agents = append(rig.Agents, a.toAgentRuntime())
+		}
+		status.Rigs = append(status.Rigs, rig)
+	}
+	return status, nil
+}
+
 // PeekSession captures the last N lines from a session's pane via RPC.
 func (c *Client) PeekSession(ctx context.Context, session string, lines int, all bool) (string, []string, bool, error) {
 	body := map[string]interface{}{
@@ -3015,6 +3379,202 @@ func (c *Client) PeekAgent(ctx context.Context, agentAddr string, lines int, all
 	return result.Output, result.Lines, result.Exists, nil
 }
 
+// AgentFileInfo describes a file inside an agent's pod.
+type AgentFileInfo struct {
+	Name     string
+	Path     string
+	Size     int64
+	Mode     uint32
+	Modified time.Time
+	IsDir    bool
+}
+
+// agentFileInfoJSON is the JSON form of AgentFileInfo; int64 fields are
+// strings in the Connect JSON encoding.
+type agentFileInfoJSON struct {
+	Name     string    `json:"name"`
+	Path     string    `json:"path"`
+	Size     int64     `json:"size,string"`
+	Mode     uint32    `json:"mode"`
+	Modified time.Time `json:"modified"`
+	IsDir    bool      `json:"isDir"`
+}
+
+func (f agentFileInfoJSON) toAgentFileInfo() AgentFileInfo {
+	return AgentFileInfo(f)
+}
+
+// ListAgentFiles lists a directory inside an agent's pod. An empty path
+// lists the workspace root. Returns the resolved directory and its entries.
+func (c *Client) ListAgentFiles(ctx context.Context, agentAddr, path string) (string, []AgentFileInfo, error) {
+	body := map[string]interface{}{
+		"agent": agentAddr,
+	}
+	if path != "" {
+		body["path"] = path
+	}
+
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return "", nil, fmt.Errorf("encoding request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST",
+		c.baseURL+"/gastown.v1.AgentService/ListAgentFiles",
+		strings.NewReader(string(jsonBody)))
+	if err != nil {
+		return "", nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		httpReq.Header.Set("X-GT-API-Key", c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return "", nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, connectError(resp)
+	}
+
+	var result struct {
+		Path  string              `json:"path"`
+		Files []agentFileInfoJSON `json:"files"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", nil, fmt.Errorf("decoding response: %w", err)
+	}
+
+	files := make([]AgentFileInfo, 0, len(result.Files))
+	for _, f := range result.Files {
+		files = append(files, f.toAgentFileInfo())
+	}
+	return result.Path, files, nil
+}
+
+const (
+	// connectEndStream flags the final envelope of a Connect stream.
+	connectEndStream = 0x02
+
+	// maxStreamMessage bounds one streamed message; file chunks are 64 KiB
+	// before base64.
+	maxStreamMessage = 1 << 20
+)
+
+// FetchAgentFile copies a file out of an agent's pod into w, writing each
+// chunk as it arrives. Large files take longer than the default client
+// timeout; use WithTimeout for them.
+func (c *Client) FetchAgentFile(ctx context.Context, agentAddr, path string, w io.Writer) (*AgentFileInfo, error) {
+	jsonBody, err := json.Marshal(map[string]interface{}{
+		"agent": agentAddr,
+		"path":  path,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encoding request: %w", err)
+	}
+	// Server-streaming calls take one enveloped message: flags, length, body.
+	envelope := make([]byte, 5, 5+len(jsonBody))
+	binary.BigEndian.PutUint32(envelope[1:], uint32(len(jsonBody)))
+	envelope = append(envelope, jsonBody...)
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST",
+		c.baseURL+"/gastown.v1.AgentService/FetchAgentFile",
+		bytes.NewReader(envelope))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/connect+json")
+	if c.apiKey != "" {
+		httpReq.Header.Set("X-GT-API-Key", c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, connectError(resp)
+	}
+
+	var info *AgentFileInfo
+	var written int64
+	header := make([]byte, 5)
+	for {
+		if _, err := io.ReadFull(resp.Body, header); err != nil {
+			return nil, fmt.Errorf("reading stream: %w", err)
+		}
+		size := binary.BigEndian.Uint32(header[1:])
+		if size > maxStreamMessage {
+			return nil, fmt.Errorf("stream message of %d bytes exceeds %d", size, maxStreamMessage)
+		}
+		msg := make([]byte, size)
+		if _, err := io.ReadFull(resp.Body, msg); err != nil {
+			return nil, fmt.Errorf("reading stream: %w", err)
+		}
+
+		if header[0]&connectEndStream != 0 {
+			var end struct {
+				Error *connectErrorJSON `json:"error"`
+			}
+			if err := json.Unmarshal(msg, &end); err != nil {
+				return nil, fmt.Errorf("decoding end of stream: %w", err)
+			}
+			if end.Error != nil {
+				return nil, end.Error
+			}
+			if info == nil {
+				return nil, fmt.Errorf("stream ended without file info")
+			}
+			return info, nil
+		}
+
+		var chunk struct {
+			Info   *agentFileInfoJSON `json:"info"`
+			Data   []byte             `json:"data"`
+			Offset int64              `json:"offset,string"`
+		}
+		if err := json.Unmarshal(msg, &chunk); err != nil {
+			return nil, fmt.Errorf("decoding chunk: %w", err)
+		}
+		if chunk.Info != nil {
+			fi := chunk.Info.toAgentFileInfo()
+			info = &fi
+		}
+		if chunk.Offset != written {
+			return nil, fmt.Errorf("chunk at offset %d, expected %d", chunk.Offset, written)
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return nil, fmt.Errorf("writing %s: %w", path, err)
+		}
+		written += int64(len(chunk.Data))
+	}
+}
+
+// connectErrorJSON is the Connect protocol's JSON error body.
+type connectErrorJSON struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *connectErrorJSON) Error() string {
+	return fmt.Sprintf("RPC error: %s: %s", e.Code, e.Message)
+}
+
+// connectError builds an error from a non-200 Connect response, using the
+// error body when the server sent one.
+func connectError(resp *http.Response) error {
+	var body connectErrorJSON
+	if json.NewDecoder(resp.Body).Decode(&body) == nil && body.Message != "" {
+		return &body
+	}
+	return fmt.Errorf("RPC error: %s", resp.Status)
+}
+
 func agentTypeToProto(t string) string {
 	switch t {
 	case "crew":
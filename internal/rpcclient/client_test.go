@@ -1,8 +1,11 @@
 package rpcclient
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -10,6 +13,12 @@ import (
 	"sync/atomic"
 	"testing"
 	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
 )
 
 // TestNewClient tests client creation with options.
@@ -1944,3 +1953,113 @@ func TestStopAgent(t *testing.T) {
 		}
 	})
 }
+
+func TestSendMailFromHeader(t *testing.T) {
+	var gotFrom, gotKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFrom = r.Header.Get("X-GT-From")
+		gotKey = r.Header.Get("X-GT-API-Key")
+		_ = json.NewEncoder(w).Encode(map[string]string{"messageId": "hq-msg-1"})
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, WithAPIKey("peer-key"))
+	id, err := c.SendMail(context.Background(), SendMailRequest{
+		To:      "gastown/polecats/nux",
+		Subject: "hello",
+		From:    "east:mayor/",
+	})
+	if err != nil {
+		t.Fatalf("SendMail: %v", err)
+	}
+	if id != "hq-msg-1" {
+		t.Errorf("id = %q, want hq-msg-1", id)
+	}
+	if gotFrom != "east:mayor/" {
+		t.Errorf("X-GT-From = %q, want east:mayor/", gotFrom)
+	}
+	if gotKey != "peer-key" {
+		t.Errorf("X-GT-API-Key = %q, want peer-key", gotKey)
+	}
+}
+
+// fileService serves the agent file RPCs over the real Connect protocol.
+type fileService struct {
+	gastownv1connect.UnimplementedAgentServiceHandler
+	data []byte
+}
+
+func (s *fileService) ListAgentFiles(_ context.Context, req *connect.Request[gastownv1.ListAgentFilesRequest]) (*connect.Response[gastownv1.ListAgentFilesResponse], error) {
+	return connect.NewResponse(&gastownv1.ListAgentFilesResponse{
+		Path: "/home/agent/gt",
+		Files: []*gastownv1.AgentFileInfo{
+			{Name: "build.log", Path: "/home/agent/gt/build.log", Size: 1 << 40, Mode: 0644, Modified: timestamppb.New(time.Unix(1700000000, 0))},
+			{Name: "src", Path: "/home/agent/gt/src", Mode: 0755, IsDir: true},
+		},
+	}), nil
+}
+
+func (s *fileService) FetchAgentFile(_ context.Context, req *connect.Request[gastownv1.FetchAgentFileRequest], stream *connect.ServerStream[gastownv1.AgentFileChunk]) error {
+	if req.Msg.Path != "/tmp/out.bin" {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("outside the allowed roots"))
+	}
+	const chunk = 1000
+	for offset := 0; offset < len(s.data); offset += chunk {
+		msg := &gastownv1.AgentFileChunk{Data: s.data[offset:min(offset+chunk, len(s.data))], Offset: int64(offset)}
+		if offset == 0 {
+			msg.Info = &gastownv1.AgentFileInfo{Name: "out.bin", Path: req.Msg.Path, Size: int64(len(s.data))}
+		}
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newFileServiceClient(t *testing.T, svc *fileService) *Client {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(gastownv1connect.NewAgentServiceHandler(svc))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return NewClient(server.URL)
+}
+
+func TestListAgentFiles(t *testing.T) {
+	c := newFileServiceClient(t, &fileService{})
+	dir, files, err := c.ListAgentFiles(context.Background(), "gastown/polecats/nux", "")
+	if err != nil {
+		t.Fatalf("ListAgentFiles: %v", err)
+	}
+	if dir != "/home/agent/gt" || len(files) != 2 {
+		t.Fatalf("dir = %q, files = %+v", dir, files)
+	}
+	if f := files[0]; f.Size != 1<<40 || f.Mode != 0644 || !f.Modified.Equal(time.Unix(1700000000, 0)) || f.IsDir {
+		t.Errorf("first entry = %+v", f)
+	}
+	if !files[1].IsDir {
+		t.Error("expected src to be a directory")
+	}
+}
+
+func TestFetchAgentFile(t *testing.T) {
+	data := []byte(strings.Repeat("0123456789", 250))
+	c := newFileServiceClient(t, &fileService{data: data})
+
+	var buf bytes.Buffer
+	info, err := c.FetchAgentFile(context.Background(), "gastown/polecats/nux", "/tmp/out.bin", &buf)
+	if err != nil {
+		t.Fatalf("FetchAgentFile: %v", err)
+	}
+	if info.Name != "out.bin" || info.Size != int64(len(data)) {
+		t.Errorf("info = %+v", info)
+	}
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("received %d bytes, want %d", buf.Len(), len(data))
+	}
+
+	_, err = c.FetchAgentFile(context.Background(), "gastown/polecats/nux", "/etc/shadow", io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "permission_denied") {
+		t.Errorf("error = %v, want permission_denied", err)
+	}
+}
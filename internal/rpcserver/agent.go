@@ -2,8 +2,11 @@ package rpcserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os/exec"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
@@ -14,6 +17,7 @@ import (
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/crew"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
@@ -25,17 +29,26 @@ import (
 
 // AgentServer implements the AgentService.
 type AgentServer struct {
-	townRoot string
-	backend  terminal.Backend
+	townRoot     string
+	backend      terminal.Backend
+	collector    *StatusCollector    // optional; invalidated after lifecycle changes
+	podConnector PodConnector        // nil = resolve pods from agent bead metadata
+	outputs      *terminal.OutputHub // shared capture loops for WatchAgentOutput
+
+	// agentBeads lists agent beads for ListAgents hook lookups; nil lists
+	// them with bd.
+	agentBeads func(rigs []string) map[string]*beads.Issue
 }
 
 var _ gastownv1connect.AgentServiceHandler = (*AgentServer)(nil)
 
 // NewAgentServer creates a new AgentServer.
 func NewAgentServer(townRoot string) *AgentServer {
+	backend := terminal.NewCoopBackend(terminal.CoopConfig{})
 	return &AgentServer{
 		townRoot: townRoot,
-		backend:  terminal.NewCoopBackend(terminal.CoopConfig{}),
+		backend:  backend,
+		outputs:  terminal.NewOutputHub(backend),
 	}
 }
 
@@ -45,6 +58,26 @@ func NewAgentServerWithBackend(townRoot string, backend terminal.Backend) *Agent
 	return &AgentServer{
 		townRoot: townRoot,
 		backend:  backend,
+		outputs:  terminal.NewOutputHub(backend),
+	}
+}
+
+// SetOutputHub shares an output hub with other servers, so every watcher of
+// a session in this process shares one capture loop.
+func (s *AgentServer) SetOutputHub(h *terminal.OutputHub) {
+	s.outputs = h
+}
+
+// SetStatusCollector wires a shared StatusCollector so that spawning,
+// starting, or stopping agents drops cached town status.
+func (s *AgentServer) SetStatusCollector(c *StatusCollector) {
+	s.collector = c
+}
+
+// invalidateStatus drops cached town status after an agent lifecycle change.
+func (s *AgentServer) invalidateStatus() {
+	if s.collector != nil {
+		s.collector.Invalidate()
 	}
 }
 
@@ -52,8 +85,9 @@ func (s *AgentServer) ListAgents(
 	ctx context.Context,
 	req *connect.Request[gastownv1.ListAgentsRequest],
 ) (*connect.Response[gastownv1.ListAgentsResponse], error) {
+	// Discovery only probes sessions; filters, heavy fields, sorting and
+	// the field mask are applied afterwards by listAgents.
 	var agents []*gastownv1.Agent
-	runningCount := 0
 
 	// Helper to check if a session is running via the backend.
 	isSessionRunning := func(session string) bool {
@@ -97,30 +131,21 @@ func (s *AgentServer) ListAgents(
 			state := gastownv1.AgentState_AGENT_STATE_STOPPED
 			if running {
 				state = gastownv1.AgentState_AGENT_STATE_RUNNING
-				runningCount++
-			}
-			if running || req.Msg.IncludeStopped {
-				agents = append(agents, &gastownv1.Agent{
-					Address: ga.name,
-					Name:    ga.name,
-					Type:    ga.atype,
-					State:   state,
-					Session: ga.session,
-				})
 			}
+			agents = append(agents, &gastownv1.Agent{
+				Address: ga.name,
+				Name:    ga.name,
+				Type:    ga.atype,
+				State:   state,
+				Session: ga.session,
+			})
 		}
 	}
 
 	// Scan each rig for crew and polecats
 	for _, r := range rigsToScan {
-		// Skip if filtering by type and this isn't a match
-		if req.Msg.Type != gastownv1.AgentType_AGENT_TYPE_UNSPECIFIED {
-			// We'll filter below
-		}
-
 		// Crew workers
-		if req.Msg.Type == gastownv1.AgentType_AGENT_TYPE_UNSPECIFIED ||
-			req.Msg.Type == gastownv1.AgentType_AGENT_TYPE_CREW {
+		if wantAgentType(req.Msg, gastownv1.AgentType_AGENT_TYPE_CREW) {
 			crewGit := git.NewGit(r.Path)
 			crewMgr := crew.NewManager(r, crewGit)
 			workers, _ := crewMgr.List()
@@ -130,97 +155,78 @@ func (s *AgentServer) ListAgents(
 				state := gastownv1.AgentState_AGENT_STATE_STOPPED
 				if running {
 					state = gastownv1.AgentState_AGENT_STATE_RUNNING
-					runningCount++
-				}
-				if running || req.Msg.IncludeStopped {
-					agents = append(agents, &gastownv1.Agent{
-						Address: fmt.Sprintf("%s/crew/%s", r.Name, w.Name),
-						Name:    w.Name,
-						Rig:     r.Name,
-						Type:    gastownv1.AgentType_AGENT_TYPE_CREW,
-						State:   state,
-						Session: session,
-						WorkDir: w.ClonePath,
-						Branch:  w.Branch,
-					})
 				}
+				agents = append(agents, &gastownv1.Agent{
+					Address: fmt.Sprintf("%s/crew/%s", r.Name, w.Name),
+					Name:    w.Name,
+					Rig:     r.Name,
+					Type:    gastownv1.AgentType_AGENT_TYPE_CREW,
+					State:   state,
+					Session: session,
+					WorkDir: w.ClonePath,
+					Branch:  w.Branch,
+				})
 			}
 		}
 
 		// Polecats
-		if req.Msg.Type == gastownv1.AgentType_AGENT_TYPE_UNSPECIFIED ||
-			req.Msg.Type == gastownv1.AgentType_AGENT_TYPE_POLECAT {
+		if wantAgentType(req.Msg, gastownv1.AgentType_AGENT_TYPE_POLECAT) {
 			for _, p := range r.Polecats {
 				session := fmt.Sprintf("gt-%s-%s", r.Name, p)
 				running := isSessionRunning(session)
 				state := gastownv1.AgentState_AGENT_STATE_STOPPED
 				if running {
 					state = gastownv1.AgentState_AGENT_STATE_WORKING
-					runningCount++
-				}
-				if running || req.Msg.IncludeStopped {
-					agents = append(agents, &gastownv1.Agent{
-						Address: fmt.Sprintf("%s/polecats/%s", r.Name, p),
-						Name:    p,
-						Rig:     r.Name,
-						Type:    gastownv1.AgentType_AGENT_TYPE_POLECAT,
-						State:   state,
-						Session: session,
-					})
 				}
+				agents = append(agents, &gastownv1.Agent{
+					Address: fmt.Sprintf("%s/polecats/%s", r.Name, p),
+					Name:    p,
+					Rig:     r.Name,
+					Type:    gastownv1.AgentType_AGENT_TYPE_POLECAT,
+					State:   state,
+					Session: session,
+				})
 			}
 		}
 
 		// Witness
-		if (req.Msg.Type == gastownv1.AgentType_AGENT_TYPE_UNSPECIFIED ||
-			req.Msg.Type == gastownv1.AgentType_AGENT_TYPE_WITNESS) && r.HasWitness {
+		if wantAgentType(req.Msg, gastownv1.AgentType_AGENT_TYPE_WITNESS) && r.HasWitness {
 			session := fmt.Sprintf("gt-%s-witness", r.Name)
 			running := isSessionRunning(session)
 			state := gastownv1.AgentState_AGENT_STATE_STOPPED
 			if running {
 				state = gastownv1.AgentState_AGENT_STATE_RUNNING
-				runningCount++
-			}
-			if running || req.Msg.IncludeStopped {
-				agents = append(agents, &gastownv1.Agent{
-					Address: fmt.Sprintf("%s/witness", r.Name),
-					Name:    "witness",
-					Rig:     r.Name,
-					Type:    gastownv1.AgentType_AGENT_TYPE_WITNESS,
-					State:   state,
-					Session: session,
-				})
 			}
+			agents = append(agents, &gastownv1.Agent{
+				Address: fmt.Sprintf("%s/witness", r.Name),
+				Name:    "witness",
+				Rig:     r.Name,
+				Type:    gastownv1.AgentType_AGENT_TYPE_WITNESS,
+				State:   state,
+				Session: session,
+			})
 		}
 
 		// Refinery
-		if (req.Msg.Type == gastownv1.AgentType_AGENT_TYPE_UNSPECIFIED ||
-			req.Msg.Type == gastownv1.AgentType_AGENT_TYPE_REFINERY) && r.HasRefinery {
+		if wantAgentType(req.Msg, gastownv1.AgentType_AGENT_TYPE_REFINERY) && r.HasRefinery {
 			session := fmt.Sprintf("gt-%s-refinery", r.Name)
 			running := isSessionRunning(session)
 			state := gastownv1.AgentState_AGENT_STATE_STOPPED
 			if running {
 				state = gastownv1.AgentState_AGENT_STATE_RUNNING
-				runningCount++
-			}
-			if running || req.Msg.IncludeStopped {
-				agents = append(agents, &gastownv1.Agent{
-					Address: fmt.Sprintf("%s/refinery", r.Name),
-					Name:    "refinery",
-					Rig:     r.Name,
-					Type:    gastownv1.AgentType_AGENT_TYPE_REFINERY,
-					State:   state,
-					Session: session,
-				})
 			}
+			agents = append(agents, &gastownv1.Agent{
+				Address: fmt.Sprintf("%s/refinery", r.Name),
+				Name:    "refinery",
+				Rig:     r.Name,
+				Type:    gastownv1.AgentType_AGENT_TYPE_REFINERY,
+				State:   state,
+				Session: session,
+			})
 		}
 	}
 
-	return connect.NewResponse(&gastownv1.ListAgentsResponse{
-		Agents:  agents,
-		Total:   int32(len(agents)),
-		Running: int32(runningCount),
-	}), nil
+	return s.listAgents(req.Msg, agents)
 }
 
 func (s *AgentServer) GetAgent(
@@ -378,6 +384,7 @@ func (s *AgentServer) SpawnPolecat(
 		agent.HookedBead = req.Msg.HookBead
 	}
 
+	s.invalidateStatus()
 	return connect.NewResponse(&gastownv1.SpawnPolecatResponse{
 		Agent:   agent,
 		Session: session,
@@ -394,6 +401,15 @@ func (s *AgentServer) StartCrew(
 	if req.Msg.Name == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("crew name is required"))
 	}
+	if err := crew.ValidateName(req.Msg.Name); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if mgr, err := s.localCrewManager(req.Msg.Rig); err != nil {
+		return nil, err
+	} else if mgr != nil {
+		return s.startLocalCrew(mgr, req.Msg)
+	}
 
 	// Resolve town name for bead ID generation.
 	townName, err := workspace.GetTownName(s.townRoot)
@@ -444,6 +460,7 @@ func (s *AgentServer) StartCrew(
 		StartedAt: timestamppb.Now(),
 	}
 
+	s.invalidateStatus()
 	return connect.NewResponse(&gastownv1.StartCrewResponse{
 		Agent:   agent,
 		Created: created,
@@ -510,6 +527,7 @@ func (s *AgentServer) StopAgent(
 		State:   gastownv1.AgentState_AGENT_STATE_STOPPED,
 	}
 
+	s.invalidateStatus()
 	return connect.NewResponse(&gastownv1.StopAgentResponse{
 		Agent:             agent,
 		HadIncompleteWork: hadIncompleteWork,
@@ -606,25 +624,17 @@ func (s *AgentServer) PeekAgent(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("agent address is required"))
 	}
 
-	// Parse address to get session name
-	parts := strings.Split(req.Msg.Agent, "/")
-	var session string
+	session, err := agentSessionName(req.Msg.Agent)
+	if err != nil {
+		return nil, err
+	}
 
-	switch {
-	case req.Msg.Agent == "mayor":
-		session = "gt-mayor"
-	case req.Msg.Agent == "deacon":
-		session = "gt-deacon"
-	case len(parts) >= 2 && parts[1] == "witness":
-		session = fmt.Sprintf("gt-%s-witness", parts[0])
-	case len(parts) >= 2 && parts[1] == "refinery":
-		session = fmt.Sprintf("gt-%s-refinery", parts[0])
-	case len(parts) >= 3 && parts[1] == "crew":
-		session = fmt.Sprintf("gt-%s-crew-%s", parts[0], parts[2])
-	case len(parts) >= 3 && parts[1] == "polecats":
-		session = fmt.Sprintf("gt-%s-%s", parts[0], parts[2])
-	default:
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent address: %s", req.Msg.Agent))
+	var search *regexp.Regexp
+	if req.Msg.Search != "" {
+		search, err = regexp.Compile(req.Msg.Search)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid search pattern: %w", err))
+		}
 	}
 
 	exists, _ := s.backend.HasSession(session)
@@ -634,6 +644,10 @@ func (s *AgentServer) PeekAgent(
 		}), nil
 	}
 
+	if search != nil {
+		return s.searchAgentOutput(session, search, req.Msg)
+	}
+
 	lines := int(req.Msg.Lines)
 	if lines <= 0 {
 		lines = 50
@@ -643,7 +657,6 @@ func (s *AgentServer) PeekAgent(
 	}
 
 	var output string
-	var err error
 	if req.Msg.All {
 		output, err = s.backend.CapturePaneAll(session)
 	} else {
@@ -665,6 +678,199 @@ func (s *AgentServer) PeekAgent(
 	}), nil
 }
 
+// searchAgentOutput greps a session's full scrollback (or coop's output
+// buffer) for PeekAgent, so clients get only the matching lines.
+func (s *AgentServer) searchAgentOutput(session string, re *regexp.Regexp, req *gastownv1.PeekAgentRequest) (*connect.Response[gastownv1.PeekAgentResponse], error) {
+	before := min(max(int(req.Before), 0), 50)
+	after := min(max(int(req.After), 0), 50)
+	maxMatches := int(req.MaxMatches)
+	if maxMatches <= 0 {
+		maxMatches = 20
+	}
+	maxMatches = min(maxMatches, 200)
+
+	output, err := s.backend.CapturePaneAll(session)
+	if err != nil {
+		return nil, unavailableErr("capturing terminal output", err, 2)
+	}
+	lines := terminal.SplitCapture(output)
+	found, truncated := terminal.SearchLines(lines, re, before, after, maxMatches)
+
+	matches := make([]*gastownv1.PeekMatch, 0, len(found))
+	for _, m := range found {
+		matches = append(matches, &gastownv1.PeekMatch{
+			Line:  int32(m.Line),
+			Start: int32(m.Start),
+			Lines: m.Context,
+		})
+	}
+	return connect.NewResponse(&gastownv1.PeekAgentResponse{
+		Exists:        true,
+		Matches:       matches,
+		Truncated:     truncated,
+		SearchedLines: int32(len(lines)),
+	}), nil
+}
+
+// agentSessionName maps an agent address to its terminal session name.
+func agentSessionName(address string) (string, error) {
+	parts := strings.Split(address, "/")
+	switch {
+	case address == "mayor":
+		return "gt-mayor", nil
+	case address == "deacon":
+		return "gt-deacon", nil
+	case len(parts) >= 2 && parts[1] == "witness":
+		return fmt.Sprintf("gt-%s-witness", parts[0]), nil
+	case len(parts) >= 2 && parts[1] == "refinery":
+		return fmt.Sprintf("gt-%s-refinery", parts[0]), nil
+	case len(parts) >= 3 && parts[1] == "crew":
+		return fmt.Sprintf("gt-%s-crew-%s", parts[0], parts[2]), nil
+	case len(parts) >= 3 && parts[1] == "polecats":
+		return fmt.Sprintf("gt-%s-%s", parts[0], parts[2]), nil
+	default:
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent address: %s", address))
+	}
+}
+
+// WatchAgentOutput streams incremental terminal output for an agent.
+// Each tick captures the last window_lines lines and sends only what changed
+// since the previous capture (see terminal.DiffCapture).
+func (s *AgentServer) WatchAgentOutput(
+	ctx context.Context,
+	req *connect.Request[gastownv1.WatchAgentOutputRequest],
+	stream *connect.ServerStream[gastownv1.AgentOutputChunk],
+) error {
+	if req.Msg.Agent == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("agent address is required"))
+	}
+	session, err := agentSessionName(req.Msg.Agent)
+	if err != nil {
+		return err
+	}
+
+	backfill := min(max(int(req.Msg.BackfillLines), 0), 1000)
+	window := int(req.Msg.WindowLines)
+	if window <= 0 {
+		window = 200
+	}
+	window = min(max(window, backfill), 1000)
+
+	intervalMs := int(req.Msg.IntervalMs)
+	if intervalMs < 100 {
+		intervalMs = 1000
+	}
+
+	// Watchers of the same session share the hub's capture loop; a slow
+	// stream just diffs to the latest capture when it catches up.
+	sub, err := s.outputs.Subscribe(ctx, session, window, time.Duration(intervalMs)*time.Millisecond)
+	if err != nil {
+		return nil // client went away
+	}
+	defer sub.Close()
+
+	snap, _ := sub.Latest()
+	if snap.Err != nil {
+		return unavailableErr("capturing terminal output", snap.Err, 2)
+	}
+	if !snap.Exists {
+		return stream.Send(&gastownv1.AgentOutputChunk{
+			Timestamp: timestamppb.Now(),
+			Exists:    false,
+		})
+	}
+
+	prev := snap.Lines
+	if backfill > 0 {
+		if err := stream.Send(&gastownv1.AgentOutputChunk{
+			Timestamp: timestamppb.Now(),
+			Lines:     prev[max(len(prev)-backfill, 0):],
+			Backfill:  true,
+			Exists:    true,
+		}); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(sub.Interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			snap, changed := sub.Latest()
+			if !changed || snap.Err != nil {
+				continue
+			}
+			if !snap.Exists {
+				return stream.Send(&gastownv1.AgentOutputChunk{
+					Timestamp: timestamppb.Now(),
+					Exists:    false,
+				})
+			}
+
+			delta := terminal.DiffCapture(prev, snap.Lines)
+			prev = snap.Lines
+			if delta.Empty() {
+				continue
+			}
+
+			if err := stream.Send(&gastownv1.AgentOutputChunk{
+				Timestamp: timestamppb.Now(),
+				Lines:     delta.Lines,
+				DropLines: int32(delta.Drop),
+				Resync:    delta.Reset,
+				Exists:    true,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetAgentRecording returns one of an agent's session recordings as a
+// list of output chunks, for replaying what the agent saw.
+func (s *AgentServer) GetAgentRecording(
+	ctx context.Context,
+	req *connect.Request[gastownv1.GetAgentRecordingRequest],
+) (*connect.Response[gastownv1.GetAgentRecordingResponse], error) {
+	if req.Msg.Agent == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("agent address is required"))
+	}
+	session, err := agentSessionName(req.Msg.Agent)
+	if err != nil {
+		return nil, err
+	}
+
+	rec, err := terminal.LoadRecording(s.townRoot, session, req.Msg.Recording)
+	if err != nil {
+		if errors.Is(err, terminal.ErrNoRecording) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("loading recording: %w", err))
+	}
+	ids, _ := terminal.ListRecordings(s.townRoot, session)
+
+	frames := make([]*gastownv1.AgentOutputChunk, 0, len(rec.Frames))
+	for _, f := range rec.Frames {
+		frames = append(frames, &gastownv1.AgentOutputChunk{
+			Timestamp: timestamppb.New(rec.FrameTime(f)),
+			Lines:     f.Lines,
+			DropLines: int32(f.Drop),
+			Resync:    f.Reset,
+			Exists:    true,
+		})
+	}
+	return connect.NewResponse(&gastownv1.GetAgentRecordingResponse{
+		Recording:  rec.ID,
+		Started:    timestamppb.New(rec.Started),
+		Recordings: ids,
+		Frames:     frames,
+	}), nil
+}
+
 func (s *AgentServer) WatchAgents(
 	ctx context.Context,
 	req *connect.Request[gastownv1.WatchAgentsRequest],
@@ -749,6 +955,8 @@ func (s *AgentServer) WatchAgents(
 //
 // Flow: gt crew add UI -> CreateCrew RPC -> daemon creates agent bead
 //       -> controller watches bead event -> controller creates crew pod
+//
+// On rigs that run crew locally, the workspace is cloned directly instead.
 func (s *AgentServer) CreateCrew(
 	ctx context.Context,
 	req *connect.Request[gastownv1.CreateCrewRequest],
@@ -759,6 +967,22 @@ func (s *AgentServer) CreateCrew(
 	if req.Msg.Rig == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("rig is required"))
 	}
+	if err := crew.ValidateName(req.Msg.Name); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if mgr, err := s.localCrewManager(req.Msg.Rig); err != nil {
+		return nil, err
+	} else if mgr != nil {
+		worker, err := mgr.Add(req.Msg.Name, req.Msg.Branch)
+		if err != nil {
+			return nil, crewErr("creating crew", err)
+		}
+		s.invalidateStatus()
+		return connect.NewResponse(&gastownv1.CreateCrewResponse{
+			Agent: localCrewAgent(mgr, worker, false),
+		}), nil
+	}
 
 	// Resolve town name for bead ID generation.
 	townName, err := workspace.GetTownName(s.townRoot)
@@ -774,6 +998,12 @@ func (s *AgentServer) CreateCrew(
 	townBeadsPath := beads.GetTownBeadsPath(s.townRoot)
 	bd := beads.New(townBeadsPath)
 
+	// Crew names are unique per rig: refuse to create over a live crew.
+	// Closed beads are reopened below.
+	if existing, _, err := bd.GetAgentBead(crewID); err == nil && existing != nil && existing.Status != "closed" {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("crew %s/%s already exists", req.Msg.Rig, req.Msg.Name))
+	}
+
 	// Build agent fields. Setting agent_state=spawning marks this as a new crew.
 	// ExecutionTarget:k8s is set atomically at creation time so the controller
 	// always sees it (fix: beads-z21u — was completely missing in CreateCrew).
@@ -811,6 +1041,7 @@ func (s *AgentServer) CreateCrew(
 		StartedAt: timestamppb.Now(),
 	}
 
+	s.invalidateStatus()
 	return connect.NewResponse(&gastownv1.CreateCrewResponse{
 		BeadId:   crewID,
 		Agent:    agent,
@@ -878,8 +1109,185 @@ func (s *AgentServer) RemoveCrew(
 		}
 	}
 
+	s.invalidateStatus()
 	return connect.NewResponse(&gastownv1.RemoveCrewResponse{
 		BeadId:  crewID,
 		Deleted: deleted,
 	}), nil
 }
+
+// StopCrew stops a crew worker's session and leaves its workspace in place.
+// In K8s the agent bead is closed, so the controller deletes the pod; the
+// PVC survives for the next StartCrew.
+func (s *AgentServer) StopCrew(
+	ctx context.Context,
+	req *connect.Request[gastownv1.StopCrewRequest],
+) (*connect.Response[gastownv1.StopCrewResponse], error) {
+	if req.Msg.Rig == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("rig is required"))
+	}
+	if err := crew.ValidateName(req.Msg.Name); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	agent := &gastownv1.Agent{
+		Address: fmt.Sprintf("%s/crew/%s", req.Msg.Rig, req.Msg.Name),
+		Name:    req.Msg.Name,
+		Rig:     req.Msg.Rig,
+		Type:    gastownv1.AgentType_AGENT_TYPE_CREW,
+		State:   gastownv1.AgentState_AGENT_STATE_STOPPED,
+	}
+
+	mgr, err := s.localCrewManager(req.Msg.Rig)
+	if err != nil {
+		return nil, err
+	}
+	if mgr != nil {
+		if _, err := mgr.Get(req.Msg.Name); err != nil {
+			return nil, crewErr("stopping crew", err)
+		}
+		if err := mgr.Stop(req.Msg.Name); err != nil && !errors.Is(err, crew.ErrSessionNotFound) {
+			return nil, crewErr("stopping crew", err)
+		}
+		agent.Session = mgr.SessionName(req.Msg.Name)
+	} else {
+		townName, _ := workspace.GetTownName(s.townRoot)
+		crewID := beads.CrewBeadIDTown(townName, req.Msg.Rig, req.Msg.Name)
+		bd := beads.New(beads.GetTownBeadsPath(s.townRoot))
+		if _, _, err := bd.GetAgentBead(crewID); err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("agent bead not found: %s", crewID))
+		}
+		reason := req.Msg.Reason
+		if reason == "" {
+			reason = "crew stopped via RPC"
+		}
+		if err := bd.CloseAndClearAgentBead(crewID, reason); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("closing agent bead %s: %w", crewID, err))
+		}
+	}
+
+	s.invalidateStatus()
+	return connect.NewResponse(&gastownv1.StopCrewResponse{Agent: agent}), nil
+}
+
+// DestroyCrew stops a crew worker's session and deletes its workspace.
+// In K8s this is RemoveCrew with purge, so the PVC goes with the pod.
+func (s *AgentServer) DestroyCrew(
+	ctx context.Context,
+	req *connect.Request[gastownv1.DestroyCrewRequest],
+) (*connect.Response[gastownv1.DestroyCrewResponse], error) {
+	if req.Msg.Rig == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("rig is required"))
+	}
+	if err := crew.ValidateName(req.Msg.Name); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mgr, err := s.localCrewManager(req.Msg.Rig)
+	if err != nil {
+		return nil, err
+	}
+	if mgr == nil {
+		resp, err := s.RemoveCrew(ctx, connect.NewRequest(&gastownv1.RemoveCrewRequest{
+			Name:   req.Msg.Name,
+			Rig:    req.Msg.Rig,
+			Purge:  true,
+			Force:  req.Msg.Force,
+			Reason: req.Msg.Reason,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&gastownv1.DestroyCrewResponse{BeadId: resp.Msg.BeadId}), nil
+	}
+
+	if err := mgr.Destroy(req.Msg.Name, req.Msg.Force); err != nil {
+		return nil, crewErr("destroying crew", err)
+	}
+
+	s.invalidateStatus()
+	return connect.NewResponse(&gastownv1.DestroyCrewResponse{}), nil
+}
+
+// localCrewManager returns a crew manager for rigName when the rig runs crew
+// on this host. It returns nil for K8s rigs, whose crew lifecycle is driven
+// through agent beads and the controller instead.
+func (s *AgentServer) localCrewManager(rigName string) (*crew.Manager, error) {
+	if config.ResolveExecutionTarget(filepath.Join(s.townRoot, rigName), "") == config.ExecutionTargetK8s {
+		return nil, nil
+	}
+
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(s.townRoot, "mayor", "rigs.json"))
+	if err != nil {
+		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
+	}
+	r, err := rig.NewManager(s.townRoot, rigsConfig, git.NewGit(s.townRoot)).GetRig(rigName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("rig not found: %s", rigName))
+	}
+
+	mgr := crew.NewManager(r, git.NewGit(r.Path))
+	if s.backend != nil {
+		mgr.SetBackend(s.backend)
+	}
+	return mgr, nil
+}
+
+// startLocalCrew is StartCrew for a rig that runs crew on this host.
+func (s *AgentServer) startLocalCrew(mgr *crew.Manager, msg *gastownv1.StartCrewRequest) (*connect.Response[gastownv1.StartCrewResponse], error) {
+	created := false
+	worker, err := mgr.Get(msg.Name)
+	if errors.Is(err, crew.ErrCrewNotFound) && msg.Create {
+		worker, err = mgr.Add(msg.Name, false)
+		created = err == nil
+	}
+	if err != nil {
+		return nil, crewErr("starting crew", err)
+	}
+
+	account, err := config.ResolveAccount(constants.MayorAccountsPath(s.townRoot), msg.Account)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resolving account: %w", err))
+	}
+	if err := config.ValidateAccountAuth(account); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	opts := crew.StartOptions{
+		KillExisting:  true,
+		AgentOverride: msg.AgentOverride,
+	}
+	if account != nil {
+		opts.Account = msg.Account
+		opts.ClaudeConfigDir = account.ConfigDir
+		opts.AuthToken = account.AuthToken
+		opts.BaseURL = account.BaseURL
+	}
+	if err := mgr.Start(msg.Name, opts); err != nil {
+		return nil, crewErr("starting crew", err)
+	}
+
+	s.invalidateStatus()
+	return connect.NewResponse(&gastownv1.StartCrewResponse{
+		Agent:   localCrewAgent(mgr, worker, true),
+		Session: mgr.SessionName(msg.Name),
+		Created: created,
+	}), nil
+}
+
+// localCrewAgent describes a crew worker managed on this host.
+func localCrewAgent(mgr *crew.Manager, w *crew.CrewWorker, running bool) *gastownv1.Agent {
+	agent := &gastownv1.Agent{
+		Address: fmt.Sprintf("%s/crew/%s", w.Rig, w.Name),
+		Name:    w.Name,
+		Rig:     w.Rig,
+		Type:    gastownv1.AgentType_AGENT_TYPE_CREW,
+		State:   gastownv1.AgentState_AGENT_STATE_STOPPED,
+		Session: mgr.SessionName(w.Name),
+		WorkDir: w.ClonePath,
+	}
+	if running {
+		agent.State = gastownv1.AgentState_AGENT_STATE_RUNNING
+		agent.StartedAt = timestamppb.Now()
+	}
+	return agent
+}
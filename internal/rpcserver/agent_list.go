@@ -0,0 +1,230 @@
+package rpcserver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/mail"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Agent fields ListAgents only computes when a field mask names them:
+// they cost a bead or mailbox lookup per agent.
+const (
+	agentFieldHookedBead  = "hooked_bead"
+	agentFieldHookedTitle = "hooked_title"
+	agentFieldUnreadMail  = "unread_mail"
+)
+
+// wantAgentType reports whether a ListAgents request covers agents of type t.
+func wantAgentType(req *gastownv1.ListAgentsRequest, t gastownv1.AgentType) bool {
+	if req.Type == gastownv1.AgentType_AGENT_TYPE_UNSPECIFIED && len(req.Types) == 0 {
+		return true
+	}
+	return req.Type == t || slices.Contains(req.Types, t)
+}
+
+// agentFieldMask validates a ListAgents field mask and returns the fields
+// it names, or nil when there is no mask.
+func agentFieldMask(req *gastownv1.ListAgentsRequest) (map[string]bool, error) {
+	if req.FieldMask == nil || len(req.FieldMask.Paths) == 0 {
+		return nil, nil
+	}
+	if !req.FieldMask.IsValid(&gastownv1.Agent{}) {
+		return nil, fmt.Errorf("invalid field_mask %v: paths must be Agent fields", req.FieldMask.Paths)
+	}
+	fields := make(map[string]bool, len(req.FieldMask.Paths))
+	for _, p := range req.FieldMask.Paths {
+		fields[p] = true
+	}
+	return fields, nil
+}
+
+// listAgents turns the agents ListAgents discovered into its response:
+// it filters them, looks up the heavy fields the request needs, sorts,
+// and applies the field mask.
+func (s *AgentServer) listAgents(req *gastownv1.ListAgentsRequest, discovered []*gastownv1.Agent) (*connect.Response[gastownv1.ListAgentsResponse], error) {
+	fields, err := agentFieldMask(req)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	includeStopped := req.IncludeStopped || slices.Contains(req.States, gastownv1.AgentState_AGENT_STATE_STOPPED)
+	var agents []*gastownv1.Agent
+	for _, a := range discovered {
+		if !wantAgentType(req, a.Type) {
+			continue
+		}
+		if a.State == gastownv1.AgentState_AGENT_STATE_STOPPED && !includeStopped {
+			continue
+		}
+		if len(req.States) > 0 && !slices.Contains(req.States, a.State) {
+			continue
+		}
+		agents = append(agents, a)
+	}
+
+	// Hooks are needed to filter on has_work, even when not returned.
+	if req.HasWork != nil || fields[agentFieldHookedBead] || fields[agentFieldHookedTitle] {
+		s.addAgentHooks(agents, fields[agentFieldHookedTitle])
+	}
+	if req.HasWork != nil {
+		agents = slices.DeleteFunc(agents, func(a *gastownv1.Agent) bool {
+			return (a.HookedBead != "") != *req.HasWork
+		})
+	}
+	if fields[agentFieldUnreadMail] {
+		s.addAgentUnreadMail(agents)
+	}
+
+	sortAgents(agents, req.Sort, req.Descending)
+
+	running := 0
+	for _, a := range agents {
+		if a.State != gastownv1.AgentState_AGENT_STATE_STOPPED {
+			running++
+		}
+		if fields != nil {
+			maskAgent(a, fields)
+		}
+	}
+
+	return connect.NewResponse(&gastownv1.ListAgentsResponse{
+		Agents:  agents,
+		Total:   int32(len(agents)),
+		Running: int32(running),
+	}), nil
+}
+
+// addAgentHooks sets each agent's hooked bead from its agent bead, and
+// with titles, the hooked bead's title.
+func (s *AgentServer) addAgentHooks(agents []*gastownv1.Agent, titles bool) {
+	var rigs []string
+	for _, a := range agents {
+		if a.Rig != "" && !slices.Contains(rigs, a.Rig) {
+			rigs = append(rigs, a.Rig)
+		}
+	}
+	load := s.agentBeads
+	if load == nil {
+		load = func(rigs []string) map[string]*beads.Issue { return listAgentBeads(s.townRoot, rigs) }
+	}
+	agentBeads := load(rigs)
+
+	for _, a := range agents {
+		issue := agentBeads[agentAddressToBeadID(agentAddressOf(a))]
+		if issue == nil || issue.HookBead == "" {
+			continue
+		}
+		a.HookedBead = issue.HookBead
+		if titles {
+			if hooked, err := findTownBead(s.townRoot, issue.HookBead, a.Rig); err == nil && hooked != nil {
+				a.HookedTitle = hooked.Title
+			}
+		}
+	}
+}
+
+// addAgentUnreadMail sets each agent's unread mail count.
+func (s *AgentServer) addAgentUnreadMail(agents []*gastownv1.Agent) {
+	router := mail.NewRouterWithTownRoot(s.townRoot, s.townRoot)
+	for _, a := range agents {
+		mailAddr := agentAddressToMailAddress(agentAddressOf(a))
+		if mailAddr == "" {
+			continue
+		}
+		mb, err := router.GetMailbox(mailAddr)
+		if err != nil {
+			continue
+		}
+		if _, unread, err := mb.Count(); err == nil {
+			a.UnreadMail = int32(unread)
+		}
+	}
+}
+
+// agentAddressOf converts an Agent to the structured address the status
+// helpers take.
+func agentAddressOf(a *gastownv1.Agent) *gastownv1.AgentAddress {
+	if a.Rig == "" {
+		return &gastownv1.AgentAddress{Name: a.Name}
+	}
+	addr := &gastownv1.AgentAddress{Rig: a.Rig}
+	switch a.Type {
+	case gastownv1.AgentType_AGENT_TYPE_WITNESS:
+		addr.Role = "witness"
+	case gastownv1.AgentType_AGENT_TYPE_REFINERY:
+		addr.Role = "refinery"
+	case gastownv1.AgentType_AGENT_TYPE_POLECAT:
+		addr.Role, addr.Name = "polecats", a.Name
+	case gastownv1.AgentType_AGENT_TYPE_CREW:
+		addr.Role, addr.Name = "crew", a.Name
+	}
+	return addr
+}
+
+// agentStateRank orders states for AGENT_SORT_STATE: active agents first,
+// stopped agents last.
+var agentStateRank = map[gastownv1.AgentState]int{
+	gastownv1.AgentState_AGENT_STATE_WORKING: 0,
+	gastownv1.AgentState_AGENT_STATE_RUNNING: 1,
+	gastownv1.AgentState_AGENT_STATE_IDLE:    2,
+	gastownv1.AgentState_AGENT_STATE_STUCK:   3,
+	gastownv1.AgentState_AGENT_STATE_DONE:    4,
+	gastownv1.AgentState_AGENT_STATE_STOPPED: 5,
+}
+
+// sortAgents sorts agents in place. The default order is discovery order;
+// every other order falls back to address, so results are stable.
+func sortAgents(agents []*gastownv1.Agent, by gastownv1.AgentSort, descending bool) {
+	var key func(a, b *gastownv1.Agent) int
+	switch by {
+	case gastownv1.AgentSort_AGENT_SORT_ADDRESS:
+		key = func(a, b *gastownv1.Agent) int { return 0 }
+	case gastownv1.AgentSort_AGENT_SORT_NAME:
+		key = func(a, b *gastownv1.Agent) int { return strings.Compare(a.Name, b.Name) }
+	case gastownv1.AgentSort_AGENT_SORT_STATE:
+		key = func(a, b *gastownv1.Agent) int { return agentStateRank[a.State] - agentStateRank[b.State] }
+	case gastownv1.AgentSort_AGENT_SORT_TYPE:
+		key = func(a, b *gastownv1.Agent) int { return int(a.Type) - int(b.Type) }
+	default:
+		if descending {
+			slices.Reverse(agents)
+		}
+		return
+	}
+
+	slices.SortStableFunc(agents, func(a, b *gastownv1.Agent) int {
+		c := key(a, b)
+		if c == 0 {
+			c = strings.Compare(a.Address, b.Address)
+		}
+		if descending {
+			c = -c
+		}
+		return c
+	})
+}
+
+// maskAgent clears the fields of a not named in fields. Address is kept
+// so masked agents can still be told apart.
+func maskAgent(a *gastownv1.Agent, fields map[string]bool) {
+	m := a.ProtoReflect()
+	var unmasked []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if name := string(fd.Name()); name != "address" && !fields[name] {
+			unmasked = append(unmasked, fd)
+		}
+		return true
+	})
+	for _, fd := range unmasked {
+		m.Clear(fd)
+	}
+}
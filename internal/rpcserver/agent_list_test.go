@@ -0,0 +1,134 @@
+package rpcserver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/internal/beads"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// setupListAgentsServer returns an agent server for a town with a running
+// polecat (furiosa, hooked to gt-123) and a stopped one (nux).
+func setupListAgentsServer(t *testing.T) *AgentServer {
+	t.Helper()
+	root := setupCollectorTown(t)
+	if err := os.MkdirAll(filepath.Join(root, "alpha", "polecats", "nux"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	srv := NewAgentServerWithBackend(root, newFakeBackend("gt-alpha-furiosa"))
+	srv.agentBeads = func([]string) map[string]*beads.Issue {
+		return map[string]*beads.Issue{
+			"gt-alpha-polecat-furiosa": {ID: "gt-alpha-polecat-furiosa", HookBead: "gt-123"},
+		}
+	}
+	return srv
+}
+
+func listAgentAddresses(t *testing.T, srv *AgentServer, req *gastownv1.ListAgentsRequest) []string {
+	t.Helper()
+	resp, err := srv.ListAgents(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		t.Fatalf("ListAgents: %v", err)
+	}
+	if int(resp.Msg.Total) != len(resp.Msg.Agents) {
+		t.Errorf("total = %d, want %d", resp.Msg.Total, len(resp.Msg.Agents))
+	}
+	var addrs []string
+	for _, a := range resp.Msg.Agents {
+		addrs = append(addrs, a.Address)
+	}
+	return addrs
+}
+
+func TestListAgents_Filters(t *testing.T) {
+	srv := setupListAgentsServer(t)
+	hasWork, noWork := true, false
+
+	tests := []struct {
+		name string
+		req  *gastownv1.ListAgentsRequest
+		want []string
+	}{
+		{"default hides stopped", &gastownv1.ListAgentsRequest{}, []string{"alpha/polecats/furiosa"}},
+		{"state stopped", &gastownv1.ListAgentsRequest{
+			States: []gastownv1.AgentState{gastownv1.AgentState_AGENT_STATE_STOPPED},
+		}, []string{"alpha/polecats/nux"}},
+		{"other type", &gastownv1.ListAgentsRequest{
+			IncludeStopped: true,
+			Types:          []gastownv1.AgentType{gastownv1.AgentType_AGENT_TYPE_CREW},
+		}, nil},
+		{"has work", &gastownv1.ListAgentsRequest{IncludeStopped: true, HasWork: &hasWork}, []string{"alpha/polecats/furiosa"}},
+		{"no work", &gastownv1.ListAgentsRequest{IncludeStopped: true, HasWork: &noWork}, []string{"alpha/polecats/nux"}},
+		{"sort by name descending", &gastownv1.ListAgentsRequest{
+			IncludeStopped: true,
+			Sort:           gastownv1.AgentSort_AGENT_SORT_NAME,
+			Descending:     true,
+		}, []string{"alpha/polecats/nux", "alpha/polecats/furiosa"}},
+		{"sort by state", &gastownv1.ListAgentsRequest{
+			IncludeStopped: true,
+			Sort:           gastownv1.AgentSort_AGENT_SORT_STATE,
+		}, []string{"alpha/polecats/furiosa", "alpha/polecats/nux"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := listAgentAddresses(t, srv, tt.req)
+			if len(got) != len(tt.want) {
+				t.Fatalf("agents = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("agents = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestListAgents_FieldMask(t *testing.T) {
+	srv := setupListAgentsServer(t)
+
+	resp, err := srv.ListAgents(context.Background(), connect.NewRequest(&gastownv1.ListAgentsRequest{
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "hooked_bead"}},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Msg.Agents) != 1 {
+		t.Fatalf("agents = %v, want furiosa only", resp.Msg.Agents)
+	}
+	a := resp.Msg.Agents[0]
+	if a.Address != "alpha/polecats/furiosa" || a.Name != "furiosa" || a.HookedBead != "gt-123" {
+		t.Errorf("masked agent = %+v, want address, name and hooked_bead", a)
+	}
+	if a.Session != "" || a.Rig != "" || a.Type != gastownv1.AgentType_AGENT_TYPE_UNSPECIFIED || a.State != gastownv1.AgentState_AGENT_STATE_UNSPECIFIED {
+		t.Errorf("masked agent = %+v, want other fields cleared", a)
+	}
+	if resp.Msg.Running != 1 {
+		t.Errorf("running = %d, want 1 (counted before masking)", resp.Msg.Running)
+	}
+
+	// Without a mask, the heavy fields are left out.
+	resp, err = srv.ListAgents(context.Background(), connect.NewRequest(&gastownv1.ListAgentsRequest{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := resp.Msg.Agents[0]; a.HookedBead != "" || a.Session == "" {
+		t.Errorf("unmasked agent = %+v, want session without hooked_bead", a)
+	}
+
+	_, err = srv.ListAgents(context.Background(), connect.NewRequest(&gastownv1.ListAgentsRequest{
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"no_such_field"}},
+	}))
+	var cerr *connect.Error
+	if !errors.As(err, &cerr) || cerr.Code() != connect.CodeInvalidArgument {
+		t.Errorf("bad mask err = %v, want InvalidArgument", err)
+	}
+}
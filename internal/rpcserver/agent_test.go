@@ -0,0 +1,235 @@
+package rpcserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+	"github.com/steveyegge/gastown/internal/terminal"
+)
+
+func TestGetAgentRecording(t *testing.T) {
+	root := t.TempDir()
+	srv := NewAgentServerWithBackend(root, nil)
+	ctx := context.Background()
+
+	_, err := srv.GetAgentRecording(ctx, connect.NewRequest(&gastownv1.GetAgentRecordingRequest{Agent: "gastown/polecats/nux"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("no recordings: error = %v, want NotFound", err)
+	}
+
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	rec, err := terminal.StartRecording(root, "gastown/polecats/nux", "gt-gastown-nux", 0, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = rec.Record("building\n50%", start.Add(time.Second))
+	_ = rec.Record("building\n100%", start.Add(2*time.Second))
+	_ = rec.Close()
+
+	resp, err := srv.GetAgentRecording(ctx, connect.NewRequest(&gastownv1.GetAgentRecordingRequest{Agent: "gastown/polecats/nux"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Msg.Recording != "20260102T030405Z" || len(resp.Msg.Recordings) != 1 {
+		t.Errorf("recording = %q, recordings = %v", resp.Msg.Recording, resp.Msg.Recordings)
+	}
+	if len(resp.Msg.Frames) != 2 {
+		t.Fatalf("got %d frames, want 2", len(resp.Msg.Frames))
+	}
+	last := resp.Msg.Frames[1]
+	if last.DropLines != 1 || len(last.Lines) != 1 || last.Lines[0] != "100%" {
+		t.Errorf("last frame = %v, want the progress line redrawn", last)
+	}
+	if !last.Timestamp.AsTime().Equal(start.Add(2 * time.Second)) {
+		t.Errorf("last frame at %v", last.Timestamp.AsTime())
+	}
+}
+
+func TestPeekAgentSearch(t *testing.T) {
+	backend := newFakeBackend("gt-gastown-nux")
+	backend.sessions["gt-gastown-nux"] = "=== RUN TestA\n--- PASS: TestA\n=== RUN TestB\n    b_test.go:12: boom\n--- FAIL: TestB\nFAIL\n"
+	srv := NewAgentServerWithBackend(t.TempDir(), backend)
+	ctx := context.Background()
+
+	resp, err := srv.PeekAgent(ctx, connect.NewRequest(&gastownv1.PeekAgentRequest{
+		Agent:  "gastown/polecats/nux",
+		Search: `^--- FAIL`,
+		Before: 1,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Msg.SearchedLines != 6 || len(resp.Msg.Lines) != 0 {
+		t.Errorf("searched %d lines, returned %d tail lines", resp.Msg.SearchedLines, len(resp.Msg.Lines))
+	}
+	if len(resp.Msg.Matches) != 1 {
+		t.Fatalf("got %d matches, want 1", len(resp.Msg.Matches))
+	}
+	m := resp.Msg.Matches[0]
+	if m.Line != 5 || m.Start != 4 || len(m.Lines) != 2 || m.Lines[0] != "    b_test.go:12: boom" {
+		t.Errorf("match = %v", m)
+	}
+
+	resp, err = srv.PeekAgent(ctx, connect.NewRequest(&gastownv1.PeekAgentRequest{
+		Agent:      "gastown/polecats/nux",
+		Search:     `RUN`,
+		MaxMatches: 1,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Msg.Matches) != 1 || !resp.Msg.Truncated {
+		t.Errorf("max 1: %d matches, truncated = %v", len(resp.Msg.Matches), resp.Msg.Truncated)
+	}
+
+	_, err = srv.PeekAgent(ctx, connect.NewRequest(&gastownv1.PeekAgentRequest{Agent: "gastown/polecats/nux", Search: `(`}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("bad pattern: error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestLocalCrewLifecycle(t *testing.T) {
+	root := t.TempDir()
+	bare := filepath.Join(root, "upstream.git")
+	if err := exec.Command("git", "init", "--bare", bare).Run(); err != nil {
+		t.Fatalf("git init: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(root, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	rigs := `{"version":1,"rigs":{"gastown":{"git_url":"` + bare + `"}}}`
+	if err := os.WriteFile(filepath.Join(root, "mayor", "rigs.json"), []byte(rigs), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(root, "gastown"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+
+	backend := newFakeBackend()
+	srv := NewAgentServerWithBackend(root, backend)
+	ctx := context.Background()
+
+	_, err := srv.CreateCrew(ctx, connect.NewRequest(&gastownv1.CreateCrewRequest{Rig: "gastown", Name: "bad-name"}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("invalid name: error = %v, want InvalidArgument", err)
+	}
+	_, err = srv.CreateCrew(ctx, connect.NewRequest(&gastownv1.CreateCrewRequest{Rig: "nope", Name: "max"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("unknown rig: error = %v, want NotFound", err)
+	}
+
+	created, err := srv.CreateCrew(ctx, connect.NewRequest(&gastownv1.CreateCrewRequest{Rig: "gastown", Name: "max"}))
+	if err != nil {
+		t.Fatalf("CreateCrew: %v", err)
+	}
+	if created.Msg.Agent.Address != "gastown/crew/max" || created.Msg.Agent.WorkDir != filepath.Join(root, "gastown", "crew", "max") {
+		t.Errorf("created agent = %v", created.Msg.Agent)
+	}
+	_, err = srv.CreateCrew(ctx, connect.NewRequest(&gastownv1.CreateCrewRequest{Rig: "gastown", Name: "max"}))
+	if connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Errorf("duplicate name: error = %v, want AlreadyExists", err)
+	}
+
+	session := created.Msg.Agent.Session
+	backend.sessions[session] = ""
+	stopped, err := srv.StopCrew(ctx, connect.NewRequest(&gastownv1.StopCrewRequest{Rig: "gastown", Name: "max"}))
+	if err != nil {
+		t.Fatalf("StopCrew: %v", err)
+	}
+	if stopped.Msg.Agent.State != gastownv1.AgentState_AGENT_STATE_STOPPED {
+		t.Errorf("stopped agent state = %v", stopped.Msg.Agent.State)
+	}
+	if _, ok := backend.sessions[session]; ok {
+		t.Error("StopCrew left the session running")
+	}
+
+	// The fresh clone has an uncommitted CLAUDE.md, so destroy needs force.
+	_, err = srv.DestroyCrew(ctx, connect.NewRequest(&gastownv1.DestroyCrewRequest{Rig: "gastown", Name: "max"}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("dirty destroy: error = %v, want FailedPrecondition", err)
+	}
+	if _, err := srv.DestroyCrew(ctx, connect.NewRequest(&gastownv1.DestroyCrewRequest{Rig: "gastown", Name: "max", Force: true})); err != nil {
+		t.Fatalf("DestroyCrew: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "gastown", "crew", "max")); !os.IsNotExist(err) {
+		t.Errorf("workspace still present after DestroyCrew: %v", err)
+	}
+	_, err = srv.StopCrew(ctx, connect.NewRequest(&gastownv1.StopCrewRequest{Rig: "gastown", Name: "max"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("stopping destroyed crew: error = %v, want NotFound", err)
+	}
+}
+
+func TestWatchAgentOutput_SharesCaptureLoop(t *testing.T) {
+	backend := newFakeBackend()
+	backend.sessions["gt-alpha-furiosa"] = "one\ntwo\nthree"
+	srv := NewAgentServerWithBackend(t.TempDir(), backend)
+	mux := http.NewServeMux()
+	mux.Handle(gastownv1connect.NewAgentServiceHandler(srv))
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	client := gastownv1connect.NewAgentServiceClient(ts.Client(), ts.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Two watchers of the same agent with a long interval: only the first
+	// subscription captures, the second backfills from the shared buffer.
+	for i := 0; i < 2; i++ {
+		stream, err := client.WatchAgentOutput(ctx, connect.NewRequest(&gastownv1.WatchAgentOutputRequest{
+			Agent:         "alpha/polecats/furiosa",
+			BackfillLines: 2,
+			IntervalMs:    60000,
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !stream.Receive() {
+			t.Fatalf("watcher %d: no backfill: %v", i, stream.Err())
+		}
+		msg := stream.Msg()
+		if !msg.Backfill || !msg.Exists || strings.Join(msg.Lines, ",") != "two,three" {
+			t.Errorf("watcher %d backfill = %+v", i, msg)
+		}
+	}
+	if got := backend.probeCount(); got != 1 {
+		t.Errorf("session probes = %d, want 1 shared capture", got)
+	}
+}
+
+func TestWatchAgentOutput_MissingSession(t *testing.T) {
+	srv := NewAgentServerWithBackend(t.TempDir(), newFakeBackend())
+	mux := http.NewServeMux()
+	mux.Handle(gastownv1connect.NewAgentServiceHandler(srv))
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	client := gastownv1connect.NewAgentServiceClient(ts.Client(), ts.URL)
+
+	stream, err := client.WatchAgentOutput(context.Background(), connect.NewRequest(&gastownv1.WatchAgentOutputRequest{
+		Agent: "alpha/polecats/nux",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stream.Receive() || stream.Msg().Exists {
+		t.Fatalf("expected a single exists=false chunk, got %+v (err %v)", stream.Msg(), stream.Err())
+	}
+	if stream.Receive() {
+		t.Error("stream should end after reporting a missing session")
+	}
+	if got := srv.outputs.Watchers("gt-alpha-nux"); got != 0 {
+		t.Errorf("watchers left after stream ended = %d, want 0", got)
+	}
+}
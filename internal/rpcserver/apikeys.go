@@ -0,0 +1,317 @@
+package rpcserver
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// APIKeysFile is the key store path relative to the town root.
+const APIKeysFile = "mayor/apikeys.json"
+
+// apiKeyPrefix makes Gas Town keys recognizable in logs and secret scanners.
+const apiKeyPrefix = "gtk_"
+
+// Role is the access level granted to an API key.
+type Role string
+
+const (
+	// RoleReadOnly may call List/Get/Watch-style methods only.
+	RoleReadOnly Role = "read-only"
+	// RoleOperator may also mutate work: mail, slings, decisions, beads.
+	RoleOperator Role = "operator"
+	// RoleAdmin may also manage agents and type into sessions.
+	RoleAdmin Role = "admin"
+)
+
+// ValidRoles returns the roles in increasing order of privilege.
+func ValidRoles() []Role {
+	return []Role{RoleReadOnly, RoleOperator, RoleAdmin}
+}
+
+func (r Role) rank() int {
+	switch r {
+	case RoleReadOnly:
+		return 1
+	case RoleOperator:
+		return 2
+	case RoleAdmin:
+		return 3
+	}
+	return 0
+}
+
+// Valid reports whether r is a known role.
+func (r Role) Valid() bool {
+	return r.rank() > 0
+}
+
+// Allows reports whether a key with role r may call a method requiring need.
+func (r Role) Allows(need Role) bool {
+	return r.Valid() && r.rank() >= need.rank()
+}
+
+// APIKey is a stored key. Only the SHA-256 of the secret is kept; the secret
+// itself is shown once, when the key is created or rotated.
+type APIKey struct {
+	Name      string    `json:"-"`
+	Role      Role      `json:"role"`
+	Hash      string    `json:"hash"`
+	CreatedAt time.Time `json:"created_at"`
+	RotatedAt time.Time `json:"rotated_at,omitzero"`
+
+	// PrevHash is the secret replaced by the last rotation. It keeps working
+	// until PrevExpires so clients can be updated without downtime.
+	PrevHash    string    `json:"prev_hash,omitempty"`
+	PrevExpires time.Time `json:"prev_expires,omitzero"`
+}
+
+// matches reports whether hash authenticates this key at time now.
+func (k *APIKey) matches(hash string, now time.Time) bool {
+	if hash == k.Hash {
+		return true
+	}
+	return k.PrevHash != "" && hash == k.PrevHash && now.Before(k.PrevExpires)
+}
+
+// apiKeysData is the JSON file structure.
+type apiKeysData struct {
+	Version int                `json:"version"`
+	Keys    map[string]*APIKey `json:"keys"`
+}
+
+// APIKeyStore holds the API keys accepted by the RPC server. The server
+// re-reads the file when it changes, so keys created, rotated, or revoked
+// with 'gt apikey' take effect without a restart.
+type APIKeyStore struct {
+	path    string
+	keys    map[string]*APIKey
+	modTime time.Time
+	mu      sync.RWMutex
+}
+
+// LoadAPIKeyStore reads the key store for a town.
+// A missing file yields an empty store.
+func LoadAPIKeyStore(townRoot string) (*APIKeyStore, error) {
+	s := &APIKeyStore{
+		path: filepath.Join(townRoot, APIKeysFile),
+		keys: make(map[string]*APIKey),
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// load reads the store from disk, replacing the in-memory keys.
+func (s *APIKeyStore) load() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			s.keys = make(map[string]*APIKey)
+			s.modTime = time.Time{}
+			return nil
+		}
+		return fmt.Errorf("reading API key store: %w", err)
+	}
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("reading API key store: %w", err)
+	}
+
+	var d apiKeysData
+	if err := json.Unmarshal(data, &d); err != nil {
+		return fmt.Errorf("parsing API key store: %w", err)
+	}
+	keys := make(map[string]*APIKey, len(d.Keys))
+	for name, k := range d.Keys {
+		k.Name = name
+		keys[name] = k
+	}
+	s.keys = keys
+	s.modTime = info.ModTime()
+	return nil
+}
+
+// refresh reloads the store if the file changed since it was last read.
+// A store that fails to reload keeps its previous keys.
+func (s *APIKeyStore) refresh() {
+	info, err := os.Stat(s.path)
+	s.mu.RLock()
+	unchanged := (err != nil && os.IsNotExist(err) && s.modTime.IsZero()) ||
+		(err == nil && info.ModTime().Equal(s.modTime))
+	s.mu.RUnlock()
+	if unchanged {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_ = s.load()
+}
+
+// save writes the store to disk, readable only by the owner.
+func (s *APIKeyStore) save() error {
+	data, err := json.MarshalIndent(apiKeysData{Version: 1, Keys: s.keys}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling API key store: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("creating mayor directory: %w", err)
+	}
+	if err := os.WriteFile(s.path, data, 0600); err != nil {
+		return fmt.Errorf("writing API key store: %w", err)
+	}
+	if info, err := os.Stat(s.path); err == nil {
+		s.modTime = info.ModTime()
+	}
+	return nil
+}
+
+// Empty reports whether the store has no keys.
+func (s *APIKeyStore) Empty() bool {
+	s.refresh()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.keys) == 0
+}
+
+// Authenticate returns the key matching secret, if any.
+func (s *APIKeyStore) Authenticate(secret string) (*APIKey, bool) {
+	if secret == "" {
+		return nil, false
+	}
+	s.refresh()
+	hash := hashAPIKey(secret)
+	now := time.Now()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, k := range s.keys {
+		if k.matches(hash, now) {
+			return k, true
+		}
+	}
+	return nil, false
+}
+
+// Create adds a key and returns its secret.
+func (s *APIKeyStore) Create(name string, role Role) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("key name is required")
+	}
+	if !role.Valid() {
+		return "", fmt.Errorf("invalid role %q: must be read-only, operator, or admin", role)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.keys[name]; ok {
+		return "", fmt.Errorf("key %q already exists (use rotate to replace its secret)", name)
+	}
+
+	secret, err := newAPIKeySecret()
+	if err != nil {
+		return "", err
+	}
+	s.keys[name] = &APIKey{
+		Name:      name,
+		Role:      role,
+		Hash:      hashAPIKey(secret),
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.save(); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// Rotate issues a new secret for a key. The old secret keeps working for
+// grace (zero revokes it immediately).
+func (s *APIKeyStore) Rotate(name string, grace time.Duration) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, ok := s.keys[name]
+	if !ok {
+		return "", fmt.Errorf("API key not found: %s", name)
+	}
+	secret, err := newAPIKeySecret()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+	k.PrevHash, k.PrevExpires = "", time.Time{}
+	if grace > 0 {
+		k.PrevHash = k.Hash
+		k.PrevExpires = now.Add(grace)
+	}
+	k.Hash = hashAPIKey(secret)
+	k.RotatedAt = now
+	if err := s.save(); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// SetRole changes the role of an existing key.
+func (s *APIKeyStore) SetRole(name string, role Role) error {
+	if !role.Valid() {
+		return fmt.Errorf("invalid role %q: must be read-only, operator, or admin", role)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k, ok := s.keys[name]
+	if !ok {
+		return fmt.Errorf("API key not found: %s", name)
+	}
+	k.Role = role
+	return s.save()
+}
+
+// Revoke deletes a key.
+func (s *APIKeyStore) Revoke(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.keys[name]; !ok {
+		return fmt.Errorf("API key not found: %s", name)
+	}
+	delete(s.keys, name)
+	return s.save()
+}
+
+// List returns all keys sorted by name.
+func (s *APIKeyStore) List() []*APIKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]*APIKey, 0, len(s.keys))
+	for _, k := range s.keys {
+		result = append(result, k)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+func hashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func newAPIKeySecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating key: %w", err)
+	}
+	return apiKeyPrefix + hex.EncodeToString(b), nil
+}
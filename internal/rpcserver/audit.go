@@ -0,0 +1,571 @@
+package rpcserver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/mtls"
+)
+
+// AuditLogFile is the audit log, relative to the town root.
+const AuditLogFile = "mayor/audit.jsonl"
+
+// maxAuditArguments caps the request JSON kept per entry, so a large mail
+// body or file upload does not bloat the log.
+const maxAuditArguments = 4096
+
+// defaultAuditLimit is how many entries ListEntries returns by default.
+const defaultAuditLimit = 100
+
+// AuditArchiveDir holds rotated segments of the audit log, relative to the
+// town root. Each is named audit-<rotation time>.jsonl.gz.
+const AuditArchiveDir = "mayor/audit-archive"
+
+// maxAuditLogSize is the size at which the live log is rotated into the
+// archive; auditSegmentsKept is how many archived segments are kept.
+const (
+	maxAuditLogSize   = 16 << 20
+	auditSegmentsKept = 8
+)
+
+// auditSegmentStamp names segments so they sort lexically in time order.
+const auditSegmentStamp = "20060102T150405.000000000Z"
+
+// auditReadChunk is how much of the live log is read per step when walking
+// it backwards.
+const auditReadChunk = 64 * 1024
+
+// AuditEntry is one line of the audit log.
+type AuditEntry struct {
+	Time       time.Time `json:"time"`
+	Procedure  string    `json:"procedure"`
+	Caller     string    `json:"caller,omitempty"`
+	Role       Role      `json:"role,omitempty"`
+	Sender     string    `json:"sender,omitempty"`
+	ClientCert string    `json:"client_cert,omitempty"`
+	Peer       string    `json:"peer,omitempty"`
+	Arguments  string    `json:"arguments,omitempty"`
+	Code       string    `json:"code"`
+	Error      string    `json:"error,omitempty"`
+	LatencyMs  int64     `json:"latency_ms"`
+}
+
+// AuditLog appends entries to mayor/audit.jsonl and, optionally, mirrors
+// each one as a closed event bead. Once the live log reaches
+// maxAuditLogSize it is moved into AuditArchiveDir and gzipped.
+type AuditLog struct {
+	path       string
+	archiveDir string
+	maxSize    int64 // Rotate the live log at this many bytes
+	mu         sync.Mutex
+	beads      *beads.Beads
+	pending    sync.WaitGroup // mirrors and compressions still running
+}
+
+// NewAuditLog creates an audit log for a town.
+func NewAuditLog(townRoot string) *AuditLog {
+	return &AuditLog{
+		path:       filepath.Join(townRoot, AuditLogFile),
+		archiveDir: filepath.Join(townRoot, AuditArchiveDir),
+		maxSize:    maxAuditLogSize,
+	}
+}
+
+// MirrorToBeads also records every entry as a closed event bead (label
+// gt:event) in the given beads database. Bead creation runs in the
+// background and failures are only logged; the JSONL log is authoritative.
+func (l *AuditLog) MirrorToBeads(b *beads.Beads) {
+	l.beads = b
+}
+
+// Append writes an entry to the end of the log.
+func (l *AuditLog) Append(e AuditEntry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("encoding audit entry: %w", err)
+	}
+
+	l.mu.Lock()
+	size, err := l.appendLine(data)
+	var rotated string
+	if err == nil && size >= l.maxSize {
+		rotated, err = l.rotateLocked()
+	}
+	l.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if rotated != "" {
+		l.pending.Add(1)
+		go func() {
+			defer l.pending.Done()
+			if err := l.compress(rotated); err != nil {
+				log.Printf("audit: %v", err)
+			}
+		}()
+	}
+
+	if l.beads != nil {
+		l.pending.Add(1)
+		go func() {
+			defer l.pending.Done()
+			l.mirror(e, data)
+		}()
+	}
+	return nil
+}
+
+// Flush waits up to timeout for entries still being mirrored to beads and
+// segments still being compressed, and reports whether they all finished.
+func (l *AuditLog) Flush(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		l.pending.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// appendLine writes one line and returns the log's new size.
+func (l *AuditLog) appendLine(data []byte) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return 0, fmt.Errorf("creating audit log directory: %w", err)
+	}
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return 0, fmt.Errorf("opening audit log: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return 0, fmt.Errorf("writing audit log: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return 0, nil // Rotation waits for the next append
+	}
+	return info.Size(), nil
+}
+
+// rotateLocked moves the live log into the archive and returns the path of
+// the uncompressed segment. l.mu must be held.
+func (l *AuditLog) rotateLocked() (string, error) {
+	if err := os.MkdirAll(l.archiveDir, 0700); err != nil {
+		return "", fmt.Errorf("creating audit archive: %w", err)
+	}
+	base := "audit-" + time.Now().UTC().Format(auditSegmentStamp)
+	name := base + ".jsonl"
+	for n := 1; fileExists(filepath.Join(l.archiveDir, name)) || fileExists(filepath.Join(l.archiveDir, name+".gz")); n++ {
+		name = fmt.Sprintf("%s-%d.jsonl", base, n)
+	}
+	path := filepath.Join(l.archiveDir, name)
+	if err := os.Rename(l.path, path); err != nil {
+		return "", fmt.Errorf("rotating audit log: %w", err)
+	}
+	return path, nil
+}
+
+// compress gzips a rotated segment and drops segments beyond
+// auditSegmentsKept.
+func (l *AuditLog) compress(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading audit segment: %w", err)
+	}
+	tmp := path + ".gz.tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("creating compressed audit segment: %w", err)
+	}
+	defer os.Remove(tmp) // no-op after the rename below
+	zw := gzip.NewWriter(f)
+	_, err = zw.Write(data)
+	if err == nil {
+		err = zw.Close()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("compressing audit segment: %w", err)
+	}
+	if err := os.Rename(tmp, path+".gz"); err != nil {
+		return fmt.Errorf("renaming compressed audit segment: %w", err)
+	}
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("removing uncompressed audit segment: %w", err)
+	}
+
+	segs, err := l.segments()
+	if err != nil {
+		return err
+	}
+	for _, seg := range segs[min(auditSegmentsKept, len(segs)):] {
+		if err := os.Remove(seg); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing audit segment: %w", err)
+		}
+	}
+	return nil
+}
+
+// segments lists archived segments, newest first. A segment still being
+// compressed is listed once, uncompressed.
+func (l *AuditLog) segments() ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(l.archiveDir, "audit-*.jsonl*"))
+	if err != nil {
+		return nil, err
+	}
+	var segs []string
+	for _, p := range paths {
+		switch {
+		case strings.HasSuffix(p, ".jsonl.gz"):
+			if fileExists(strings.TrimSuffix(p, ".gz")) {
+				continue // Compression not finished yet
+			}
+		case strings.HasSuffix(p, ".jsonl"):
+		default:
+			continue
+		}
+		segs = append(segs, p)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(segs)))
+	return segs, nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func (l *AuditLog) mirror(e AuditEntry, data []byte) {
+	caller := e.Caller
+	if caller == "" {
+		caller = e.Sender
+	}
+	title := fmt.Sprintf("audit: %s", strings.TrimPrefix(e.Procedure, "/gastown.v1."))
+	if caller != "" {
+		title += " by " + caller
+	}
+	issue, err := l.beads.Create(beads.CreateOptions{
+		Title:       title,
+		Type:        "event",
+		Priority:    4,
+		Description: string(data),
+		Actor:       caller,
+	})
+	if err != nil {
+		log.Printf("audit: mirroring to beads: %v", err)
+		return
+	}
+	_ = l.beads.CloseWithReason("audit record", issue.ID)
+}
+
+// Scan calls fn with each entry, newest first, until fn returns false. The
+// live log is read backwards from its end, then the archived segments
+// newest first, so a caller that stops early does not read the whole
+// history. Lines that don't parse are skipped.
+func (l *AuditLog) Scan(fn func(AuditEntry) bool) error {
+	more := true
+	visit := func(line []byte) bool {
+		var e AuditEntry
+		if json.Unmarshal(line, &e) != nil || e.Procedure == "" {
+			return true
+		}
+		more = fn(e)
+		return more
+	}
+
+	// Open the live log before listing segments: if it is rotated in
+	// between, the open file still reads the old entries and they are
+	// visited twice rather than skipped.
+	f, err := os.Open(l.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	segs, err := l.segments()
+	if err != nil {
+		if f != nil {
+			f.Close()
+		}
+		return fmt.Errorf("listing audit segments: %w", err)
+	}
+	if f != nil {
+		info, err := f.Stat()
+		if err == nil {
+			err = eachLineReverse(f, info.Size(), visit)
+		}
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("reading audit log: %w", err)
+		}
+	}
+
+	for _, seg := range segs {
+		if !more {
+			return nil
+		}
+		data, err := readAuditSegment(seg)
+		if errors.Is(err, os.ErrNotExist) && !strings.HasSuffix(seg, ".gz") {
+			data, err = readAuditSegment(seg + ".gz") // Compressed since it was listed
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			continue // Pruned since it was listed
+		}
+		if err != nil {
+			return err
+		}
+		if err := eachLineReverse(bytes.NewReader(data), int64(len(data)), visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readAuditSegment returns the uncompressed contents of a segment.
+func readAuditSegment(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if !strings.HasSuffix(path, ".gz") {
+		return io.ReadAll(f)
+	}
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading audit segment %s: %w", filepath.Base(path), err)
+	}
+	defer zr.Close()
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("reading audit segment %s: %w", filepath.Base(path), err)
+	}
+	return data, nil
+}
+
+// eachLineReverse calls fn with each non-empty line of the first size bytes
+// of r, last line first, until fn returns false. It reads backwards in
+// auditReadChunk steps. The line passed to fn is only valid during the call.
+func eachLineReverse(r io.ReaderAt, size int64, fn func([]byte) bool) error {
+	buf := make([]byte, auditReadChunk)
+	var carry []byte // Start of the line that the previous chunk began in
+	for pos := size; pos > 0; {
+		n := min(int64(len(buf)), pos)
+		pos -= n
+		if _, err := r.ReadAt(buf[:n], pos); err != nil && err != io.EOF {
+			return err
+		}
+		chunk := append(buf[:n:n], carry...)
+		for {
+			i := bytes.LastIndexByte(chunk, '\n')
+			if i < 0 {
+				break
+			}
+			if line := chunk[i+1:]; len(line) > 0 && !fn(line) {
+				return nil
+			}
+			chunk = chunk[:i]
+		}
+		carry = append(carry[:0:0], chunk...)
+	}
+	if len(carry) > 0 {
+		fn(carry)
+	}
+	return nil
+}
+
+// AuditInterceptor records every call to a method that is not read-only
+// (see RequiredRole) in the audit log, including calls rejected by auth.
+// It should be installed before AuthInterceptor so it wraps it.
+type AuditInterceptor struct {
+	log  *AuditLog
+	auth *AuthInterceptor
+}
+
+var _ connect.Interceptor = (*AuditInterceptor)(nil)
+
+// NewAuditInterceptor creates an interceptor writing to log. auth, if not
+// nil, is used to name the API key each call was made with.
+func NewAuditInterceptor(log *AuditLog, auth *AuthInterceptor) *AuditInterceptor {
+	return &AuditInterceptor{log: log, auth: auth}
+}
+
+// record builds an entry for a finished call and appends it.
+func (a *AuditInterceptor) record(ctx context.Context, procedure string, header http.Header, peer string, msg any, err error, latency time.Duration) {
+	e := AuditEntry{
+		Time:      time.Now().UTC(),
+		Procedure: procedure,
+		Sender:    header.Get("X-GT-From"),
+		Peer:      peer,
+		Arguments: auditArguments(msg),
+		Code:      "ok",
+		LatencyMs: latency.Milliseconds(),
+	}
+	if a.auth != nil {
+		if caller, ok := a.auth.identify(header); ok {
+			e.Caller = caller.Name
+			e.Role = caller.Role
+		}
+	}
+	if cert := mtls.PeerCertificate(ctx); cert != nil {
+		e.ClientCert = cert.Subject.CommonName
+	}
+	if err != nil {
+		e.Code = connect.CodeOf(err).String()
+		e.Error = err.Error()
+	}
+	if werr := a.log.Append(e); werr != nil {
+		log.Printf("audit: %v", werr)
+	}
+}
+
+// auditArguments renders a request message as JSON, truncated.
+func auditArguments(msg any) string {
+	m, ok := msg.(proto.Message)
+	if !ok || m == nil {
+		return ""
+	}
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	if len(data) > maxAuditArguments {
+		return string(data[:maxAuditArguments]) + "…"
+	}
+	return string(data)
+}
+
+// WrapUnary implements connect.Interceptor.
+func (a *AuditInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient || RequiredRole(req.Spec().Procedure) == RoleReadOnly {
+			return next(ctx, req)
+		}
+		start := time.Now()
+		resp, err := next(ctx, req)
+		a.record(ctx, req.Spec().Procedure, req.Header(), req.Peer().Addr, req.Any(), err, time.Since(start))
+		return resp, err
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor; clients are not audited.
+func (a *AuditInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor. Streamed request
+// messages are not recorded.
+func (a *AuditInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if RequiredRole(conn.Spec().Procedure) == RoleReadOnly {
+			return next(ctx, conn)
+		}
+		start := time.Now()
+		err := next(ctx, conn)
+		a.record(ctx, conn.Spec().Procedure, conn.RequestHeader(), conn.Peer().Addr, nil, err, time.Since(start))
+		return err
+	}
+}
+
+// AuditServer implements the AuditService.
+type AuditServer struct {
+	log *AuditLog
+}
+
+var _ gastownv1connect.AuditServiceHandler = (*AuditServer)(nil)
+
+// NewAuditServer creates a new AuditServer.
+func NewAuditServer(townRoot string) *AuditServer {
+	return &AuditServer{log: NewAuditLog(townRoot)}
+}
+
+func (s *AuditServer) ListEntries(
+	ctx context.Context,
+	req *connect.Request[gastownv1.ListAuditEntriesRequest],
+) (*connect.Response[gastownv1.ListAuditEntriesResponse], error) {
+	var since time.Time
+	if req.Msg.Since != nil {
+		since = req.Msg.Since.AsTime()
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultAuditLimit
+	}
+
+	// Entries are appended in time order, so the walk stops at the first
+	// one before since, or at the first match past limit.
+	var out []*gastownv1.AuditEntry
+	hasMore := false
+	err := s.log.Scan(func(e AuditEntry) bool {
+		if !since.IsZero() && e.Time.Before(since) {
+			return false
+		}
+		if req.Msg.Procedure != "" && !strings.Contains(e.Procedure, req.Msg.Procedure) {
+			return true
+		}
+		if req.Msg.Caller != "" && e.Caller != req.Msg.Caller && e.Sender != req.Msg.Caller {
+			return true
+		}
+		if req.Msg.ErrorsOnly && e.Code == "ok" {
+			return true
+		}
+		if len(out) == limit {
+			hasMore = true
+			return false
+		}
+		out = append(out, auditEntryToProto(e))
+		return true
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gastownv1.ListAuditEntriesResponse{
+		Entries: out,
+		Total:   int32(len(out)),
+		HasMore: hasMore,
+	}), nil
+}
+
+func auditEntryToProto(e AuditEntry) *gastownv1.AuditEntry {
+	return &gastownv1.AuditEntry{
+		Time:       timestamppb.New(e.Time),
+		Procedure:  e.Procedure,
+		Caller:     e.Caller,
+		Role:       string(e.Role),
+		Sender:     e.Sender,
+		ClientCert: e.ClientCert,
+		Peer:       e.Peer,
+		Arguments:  e.Arguments,
+		Code:       e.Code,
+		Error:      e.Error,
+		LatencyMs:  e.LatencyMs,
+	}
+}
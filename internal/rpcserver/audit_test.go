@@ -0,0 +1,203 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+)
+
+func TestAuditInterceptor(t *testing.T) {
+	townRoot := t.TempDir()
+	store, _ := LoadAPIKeyStore(townRoot)
+	operator, _ := store.Create("ops", RoleOperator)
+	reader, _ := store.Create("phone", RoleReadOnly)
+
+	auth := NewAuthInterceptor("", store)
+	opts := connect.WithInterceptors(NewAuditInterceptor(NewAuditLog(townRoot), auth), auth)
+	mux := http.NewServeMux()
+	mux.Handle(gastownv1connect.NewWitnessServiceHandler(gastownv1connect.UnimplementedWitnessServiceHandler{}, opts))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	client := gastownv1connect.NewWitnessServiceClient(ts.Client(), ts.URL)
+	report := func(key string) {
+		req := connect.NewRequest(&gastownv1.ReportFindingRequest{Agent: "gastown/polecats/nux", Category: "stuck"})
+		req.Header().Set("X-GT-API-Key", key)
+		req.Header().Set("X-GT-From", "gastown/witness")
+		client.ReportFinding(context.Background(), req)
+	}
+
+	report(operator) // reaches the handler (unimplemented)
+	report(reader)   // denied by auth
+	list := connect.NewRequest(&gastownv1.ListFindingsRequest{})
+	list.Header().Set("X-GT-API-Key", reader)
+	client.ListFindings(context.Background(), list) // read-only: not audited
+
+	info, err := os.Stat(filepath.Join(townRoot, AuditLogFile))
+	if err != nil || info.Mode().Perm() != 0600 {
+		t.Fatalf("audit log should be written 0600: %v %v", info, err)
+	}
+
+	resp, err := NewAuditServer(townRoot).ListEntries(context.Background(),
+		connect.NewRequest(&gastownv1.ListAuditEntriesRequest{}))
+	if err != nil {
+		t.Fatalf("ListEntries: %v", err)
+	}
+	entries := resp.Msg.Entries
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2: %v", len(entries), entries)
+	}
+
+	// Newest first
+	denied, allowed := entries[0], entries[1]
+	if denied.Caller != "phone" || denied.Code != connect.CodePermissionDenied.String() {
+		t.Errorf("denied entry = %v", denied)
+	}
+	if allowed.Caller != "ops" || allowed.Role != string(RoleOperator) || allowed.Code != connect.CodeUnimplemented.String() {
+		t.Errorf("allowed entry = %v", allowed)
+	}
+	if allowed.Procedure != gastownv1connect.WitnessServiceReportFindingProcedure {
+		t.Errorf("procedure = %q", allowed.Procedure)
+	}
+	if allowed.Sender != "gastown/witness" || !strings.Contains(allowed.Arguments, "gastown/polecats/nux") {
+		t.Errorf("sender/arguments = %q / %q", allowed.Sender, allowed.Arguments)
+	}
+
+	// Filters
+	resp, _ = NewAuditServer(townRoot).ListEntries(context.Background(),
+		connect.NewRequest(&gastownv1.ListAuditEntriesRequest{Caller: "ops", Limit: 1}))
+	if resp.Msg.Total != 1 || resp.Msg.Entries[0].Caller != "ops" {
+		t.Errorf("caller filter = %v", resp.Msg)
+	}
+}
+
+func TestAuditArgumentsTruncated(t *testing.T) {
+	msg := &gastownv1.ReportFindingRequest{Evidence: strings.Repeat("x", 2*maxAuditArguments)}
+	got := auditArguments(msg)
+	if len(got) > maxAuditArguments+len("…") {
+		t.Errorf("arguments not truncated: %d bytes", len(got))
+	}
+	if auditArguments(nil) != "" {
+		t.Error("nil message should render empty")
+	}
+}
+
+func TestAuditFlush(t *testing.T) {
+	l := NewAuditLog(t.TempDir())
+	if !l.Flush(time.Second) {
+		t.Error("Flush with nothing pending = false")
+	}
+
+	l.pending.Add(1)
+	if l.Flush(10 * time.Millisecond) {
+		t.Error("Flush returned true with a mirror still pending")
+	}
+	l.pending.Done()
+	if !l.Flush(time.Second) {
+		t.Error("Flush after the mirror finished = false")
+	}
+}
+
+func TestEachLineReverse(t *testing.T) {
+	long := strings.Repeat("x", 2*auditReadChunk+7) // Spans chunk boundaries
+	data := "a\n" + long + "\n\nb\nc"
+	var got []string
+	err := eachLineReverse(strings.NewReader(data), int64(len(data)), func(line []byte) bool {
+		got = append(got, string(line))
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 4 || got[0] != "c" || got[1] != "b" || got[2] != long || got[3] != "a" {
+		t.Errorf("lines = %d %.10q", len(got), got)
+	}
+
+	got = nil
+	eachLineReverse(strings.NewReader(data), int64(len(data)), func(line []byte) bool {
+		got = append(got, string(line))
+		return len(got) < 2
+	})
+	if len(got) != 2 {
+		t.Errorf("stopping early read %d lines, want 2", len(got))
+	}
+}
+
+func TestAuditLogRotation(t *testing.T) {
+	townRoot := t.TempDir()
+	l := NewAuditLog(townRoot)
+	l.maxSize = 1024
+
+	start := time.Now().UTC().Add(-time.Hour)
+	const n = 200
+	for i := 0; i < n; i++ {
+		if err := l.Append(AuditEntry{
+			Time:      start.Add(time.Duration(i) * time.Second),
+			Procedure: fmt.Sprintf("/gastown.v1.MailService/SendMessage#%d", i),
+			Code:      "ok",
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !l.Flush(5 * time.Second) {
+		t.Fatal("compression did not finish")
+	}
+
+	if info, err := os.Stat(l.path); err == nil && info.Size() >= l.maxSize {
+		t.Errorf("live log is %d bytes, should have rotated at %d", info.Size(), l.maxSize)
+	}
+	segs, _ := l.segments()
+	if len(segs) != auditSegmentsKept {
+		t.Fatalf("segments = %d, want %d", len(segs), auditSegmentsKept)
+	}
+	for _, seg := range segs {
+		if !strings.HasSuffix(seg, ".jsonl.gz") {
+			t.Errorf("segment %s not compressed", seg)
+		}
+	}
+
+	// Entries come back newest first across the live log and segments,
+	// ending where pruning dropped the oldest
+	prev := n
+	count := 0
+	l.Scan(func(e AuditEntry) bool {
+		var i int
+		fmt.Sscanf(e.Procedure[strings.Index(e.Procedure, "#")+1:], "%d", &i)
+		if i != prev-1 {
+			t.Fatalf("entry %d followed %d", i, prev)
+		}
+		prev = i
+		count++
+		return true
+	})
+	if count == 0 || count == n {
+		t.Errorf("scanned %d entries; want some, but not the pruned ones", count)
+	}
+
+	resp, err := NewAuditServer(townRoot).ListEntries(context.Background(),
+		connect.NewRequest(&gastownv1.ListAuditEntriesRequest{Procedure: "SendMessage", Limit: 3}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Msg.Entries) != 3 || !resp.Msg.HasMore || !strings.HasSuffix(resp.Msg.Entries[0].Procedure, "#199") {
+		t.Errorf("ListEntries = %d entries, has_more %v", len(resp.Msg.Entries), resp.Msg.HasMore)
+	}
+
+	resp, _ = NewAuditServer(townRoot).ListEntries(context.Background(),
+		connect.NewRequest(&gastownv1.ListAuditEntriesRequest{Since: timestamppb.New(start.Add(195 * time.Second))}))
+	if len(resp.Msg.Entries) != 5 || resp.Msg.HasMore {
+		t.Errorf("since filter = %d entries, has_more %v; want 5", len(resp.Msg.Entries), resp.Msg.HasMore)
+	}
+}
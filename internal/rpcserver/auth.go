@@ -0,0 +1,175 @@
+package rpcserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"connectrpc.com/connect"
+)
+
+// adminProcedures need RoleAdmin: they create or destroy agents, read
+// arbitrary agent files or the audit log, or type directly into sessions.
+var adminProcedures = map[string]bool{
+	"/gastown.v1.AgentService/CreateCrew":     true,
+	"/gastown.v1.AgentService/StartCrew":      true,
+	"/gastown.v1.AgentService/SpawnPolecat":   true,
+	"/gastown.v1.AgentService/RemoveCrew":     true,
+	"/gastown.v1.AgentService/DestroyCrew":    true,
+	"/gastown.v1.AgentService/StopCrew":       true,
+	"/gastown.v1.AgentService/StopAgent":      true,
+	"/gastown.v1.AgentService/FetchAgentFile": true,
+	"/gastown.v1.TerminalService/SendInput":   true,
+	"/gastown.v1.AuditService/ListEntries":    true,
+}
+
+// readOnlyProcedures do not change state but are not named with one of the
+// readOnlyVerbs. MailService/ReadMessage is listed although "Read" matches:
+// it only fetches a message and does not mark it read.
+var readOnlyProcedures = map[string]bool{
+	"/gastown.v1.MailService/ReadMessage": true,
+}
+
+// readOnlyVerbs prefix the method names that do not change state.
+var readOnlyVerbs = []string{"List", "Get", "Watch", "Search", "Read", "Peek", "Has", "Stream", "HealthCheck"}
+
+// RequiredRole returns the least privileged role that may call a procedure
+// ("/gastown.v1.Service/Method"). Methods that are neither admin-only nor
+// read-only need RoleOperator.
+func RequiredRole(procedure string) Role {
+	if adminProcedures[procedure] {
+		return RoleAdmin
+	}
+	if readOnlyProcedures[procedure] {
+		return RoleReadOnly
+	}
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	for _, verb := range readOnlyVerbs {
+		if strings.HasPrefix(method, verb) {
+			return RoleReadOnly
+		}
+	}
+	return RoleOperator
+}
+
+// AuthInterceptor authenticates X-GT-API-Key against the key store and
+// authorizes each call against the key's role. It covers both unary and
+// streaming handlers.
+//
+// The legacy single key from 'gt rpc serve --api-key' is accepted as admin.
+// When neither it nor any stored key has ever been configured, calls are
+// not authenticated, matching the behavior before key stores existed. Once
+// a key has been seen, auth stays on: revoking the last key or deleting
+// apikeys.json denies every call rather than opening the server.
+type AuthInterceptor struct {
+	legacyKey string
+	store     *APIKeyStore
+	armed     atomic.Bool // A key has been configured since startup
+}
+
+var _ connect.Interceptor = (*AuthInterceptor)(nil)
+
+// NewAuthInterceptor creates an interceptor. store may be nil.
+func NewAuthInterceptor(legacyKey string, store *APIKeyStore) *AuthInterceptor {
+	a := &AuthInterceptor{legacyKey: legacyKey, store: store}
+	a.configured()
+	return a
+}
+
+// configured reports whether any key is configured now, and arms auth for
+// the life of the interceptor if so.
+func (a *AuthInterceptor) configured() bool {
+	if a.legacyKey == "" && (a.store == nil || a.store.Empty()) {
+		return false
+	}
+	a.armed.Store(true)
+	return true
+}
+
+// Enabled reports whether calls are authenticated.
+func (a *AuthInterceptor) Enabled() bool {
+	return a.configured() || a.armed.Load()
+}
+
+// Caller is the API key an RPC was made with.
+type Caller struct {
+	Name string // Key name; "legacy" for the --api-key key
+	Role Role
+}
+
+// legacyCallerName names the single key from 'gt rpc serve --api-key'.
+const legacyCallerName = "legacy"
+
+// identify returns the key that header's X-GT-API-Key authenticates as.
+// ok is false when no valid key is presented.
+func (a *AuthInterceptor) identify(header http.Header) (caller Caller, ok bool) {
+	secret := header.Get("X-GT-API-Key")
+	if secret == "" {
+		return Caller{}, false
+	}
+	if a.legacyKey != "" && subtle.ConstantTimeCompare([]byte(secret), []byte(a.legacyKey)) == 1 {
+		return Caller{Name: legacyCallerName, Role: RoleAdmin}, true
+	}
+	if a.store != nil && !a.store.Empty() {
+		if key, ok := a.store.Authenticate(secret); ok {
+			return Caller{Name: key.Name, Role: key.Role}, true
+		}
+	}
+	return Caller{}, false
+}
+
+// authorize checks the caller's key against the role procedure requires.
+func (a *AuthInterceptor) authorize(procedure string, header http.Header) error {
+	if !a.configured() {
+		if !a.armed.Load() {
+			return nil // No auth configured
+		}
+		return connect.NewError(connect.CodeUnauthenticated,
+			fmt.Errorf("no API keys configured; create one with 'gt apikey create'"))
+	}
+
+	if header.Get("X-GT-API-Key") == "" {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("missing API key"))
+	}
+	caller, ok := a.identify(header)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid API key"))
+	}
+
+	if need := RequiredRole(procedure); !caller.Role.Allows(need) {
+		return connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("%s requires %s role, key has %s", procedure, need, caller.Role))
+	}
+	return nil
+}
+
+// WrapUnary implements connect.Interceptor.
+func (a *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		if err := a.authorize(req.Spec().Procedure, req.Header()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor; clients are not checked.
+func (a *AuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor.
+func (a *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := a.authorize(conn.Spec().Procedure, conn.RequestHeader()); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
@@ -0,0 +1,187 @@
+package rpcserver
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+func TestRequiredRole(t *testing.T) {
+	tests := map[string]Role{
+		"/gastown.v1.StatusService/GetTownStatus": RoleReadOnly,
+		"/gastown.v1.MailService/WatchInbox":      RoleReadOnly,
+		"/gastown.v1.StatusService/HealthCheck":   RoleReadOnly,
+		"/gastown.v1.MailService/SendMessage":     RoleOperator,
+		"/gastown.v1.DecisionService/Resolve":     RoleOperator,
+		"/gastown.v1.MailService/ReadMessage":     RoleReadOnly,
+		"/gastown.v1.AgentService/RemoveCrew":     RoleAdmin,
+		"/gastown.v1.AgentService/StartCrew":      RoleAdmin,
+		"/gastown.v1.AgentService/SpawnPolecat":   RoleAdmin,
+		"/gastown.v1.AgentService/DestroyCrew":    RoleAdmin,
+		"/gastown.v1.TerminalService/SendInput":   RoleAdmin,
+		"/gastown.v1.AuditService/ListEntries":    RoleAdmin,
+	}
+	for proc, want := range tests {
+		if got := RequiredRole(proc); got != want {
+			t.Errorf("RequiredRole(%s) = %s, want %s", proc, got, want)
+		}
+	}
+}
+
+func TestAPIKeyStoreLifecycle(t *testing.T) {
+	townRoot := t.TempDir()
+	store, err := LoadAPIKeyStore(townRoot)
+	if err != nil {
+		t.Fatalf("LoadAPIKeyStore: %v", err)
+	}
+	if !store.Empty() {
+		t.Fatal("new store should be empty")
+	}
+
+	secret, err := store.Create("phone", RoleReadOnly)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := store.Create("phone", RoleAdmin); err == nil {
+		t.Error("duplicate Create should fail")
+	}
+	if _, err := store.Create("bad", Role("root")); err == nil {
+		t.Error("Create with unknown role should fail")
+	}
+
+	info, err := os.Stat(filepath.Join(townRoot, APIKeysFile))
+	if err != nil || info.Mode().Perm() != 0600 {
+		t.Fatalf("key store should be written 0600: %v %v", info, err)
+	}
+
+	// A second store sees the key, as a running server would
+	other, _ := LoadAPIKeyStore(townRoot)
+	if k, ok := other.Authenticate(secret); !ok || k.Role != RoleReadOnly {
+		t.Fatalf("Authenticate = %v, %v", k, ok)
+	}
+
+	newSecret, err := store.Rotate("phone", time.Hour)
+	if err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+	if _, ok := store.Authenticate(secret); !ok {
+		t.Error("old secret should work during grace")
+	}
+	if _, ok := store.Authenticate(newSecret); !ok {
+		t.Error("new secret should work")
+	}
+	if _, err := store.Rotate("phone", 0); err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+	if _, ok := store.Authenticate(newSecret); ok {
+		t.Error("secret rotated without grace should stop working")
+	}
+
+	if err := store.Revoke("phone"); err != nil {
+		t.Fatalf("Revoke: %v", err)
+	}
+	if !store.Empty() {
+		t.Error("store should be empty after revoke")
+	}
+}
+
+func TestAuthInterceptorAuthorize(t *testing.T) {
+	store, _ := LoadAPIKeyStore(t.TempDir())
+	header := func(key string) http.Header {
+		h := http.Header{}
+		if key != "" {
+			h.Set("X-GT-API-Key", key)
+		}
+		return h
+	}
+	send := "/gastown.v1.MailService/SendMessage"
+	list := "/gastown.v1.MailService/ListInbox"
+
+	// No keys configured: open
+	a := NewAuthInterceptor("", store)
+	if err := a.authorize(send, header("")); err != nil {
+		t.Errorf("unconfigured auth should allow, got %v", err)
+	}
+
+	reader, _ := store.Create("reader", RoleReadOnly)
+	if err := a.authorize(list, header("")); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("missing key error = %v, want Unauthenticated", err)
+	}
+	if err := a.authorize(list, header("gtk_wrong")); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("wrong key error = %v, want Unauthenticated", err)
+	}
+	if err := a.authorize(list, header(reader)); err != nil {
+		t.Errorf("read-only key on List: %v", err)
+	}
+	if err := a.authorize(send, header(reader)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("read-only key on Send error = %v, want PermissionDenied", err)
+	}
+
+	// The legacy single key acts as admin alongside stored keys
+	a = NewAuthInterceptor("legacy", store)
+	if err := a.authorize("/gastown.v1.AgentService/RemoveCrew", header("legacy")); err != nil {
+		t.Errorf("legacy key should be admin: %v", err)
+	}
+}
+
+func TestAuthInterceptorStaysArmed(t *testing.T) {
+	townRoot := t.TempDir()
+	store, _ := LoadAPIKeyStore(townRoot)
+	a := NewAuthInterceptor("", store)
+	list := "/gastown.v1.MailService/ListInbox"
+
+	secret, _ := store.Create("phone", RoleReadOnly)
+	h := http.Header{}
+	h.Set("X-GT-API-Key", secret)
+	if err := a.authorize(list, h); err != nil {
+		t.Fatalf("valid key: %v", err)
+	}
+
+	// Revoking the last key must not open the server
+	if err := store.Revoke("phone"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.authorize(list, http.Header{}); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("after last revoke err = %v, want Unauthenticated", err)
+	}
+	if !a.Enabled() {
+		t.Error("auth should stay enabled after the last key is revoked")
+	}
+
+	// Nor must deleting the file, as seen by a server started with keys
+	store.Create("phone", RoleReadOnly)
+	a = NewAuthInterceptor("", store)
+	if err := os.Remove(filepath.Join(townRoot, APIKeysFile)); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.authorize(list, http.Header{}); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("after file removal err = %v, want Unauthenticated", err)
+	}
+}
+
+func TestServerConfigTLSFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"tls.crt", "tls.key", "ca.crt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A ca.crt in the secret doesn't turn on client certificates by itself
+	files := ServerConfig{TLSDir: dir}.tlsFiles()
+	if files.Cert == "" || files.Key == "" || files.CA != "" {
+		t.Errorf("tlsFiles without mTLS = %+v, want cert and key only", files)
+	}
+	files = ServerConfig{TLSDir: dir, RequireClientCerts: true}.tlsFiles()
+	if files.CA != filepath.Join(dir, "ca.crt") {
+		t.Errorf("tlsFiles with mTLS CA = %q", files.CA)
+	}
+	files = ServerConfig{TLSDir: dir, ClientCAFile: "/etc/ca.pem", RequireClientCerts: true}.tlsFiles()
+	if files.CA != "/etc/ca.pem" {
+		t.Errorf("explicit client CA = %q, want /etc/ca.pem", files.CA)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
 
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -20,13 +21,23 @@ import (
 // BeadsServer implements the BeadsService.
 type BeadsServer struct {
 	townRoot string
+	index    *issueIndex // SearchIssues is served from here
 }
 
 var _ gastownv1connect.BeadsServiceHandler = (*BeadsServer)(nil)
 
 // NewBeadsServer creates a new BeadsServer.
 func NewBeadsServer(townRoot string) *BeadsServer {
-	return &BeadsServer{townRoot: townRoot}
+	return &BeadsServer{
+		townRoot: townRoot,
+		index:    newIssueIndex(func() ([]*beads.Issue, error) { return loadAllIssues(townRoot) }),
+	}
+}
+
+// RunSearchIndex keeps the search index fresh until ctx is done,
+// rebuilding it every interval (DefaultSearchReindex if zero).
+func (s *BeadsServer) RunSearchIndex(ctx context.Context, interval time.Duration) {
+	s.index.Run(ctx, interval)
 }
 
 // issueToProto converts a beads.Issue to a proto Issue.
@@ -229,8 +240,8 @@ func (s *BeadsServer) CreateIssue(
 	ctx context.Context,
 	req *connect.Request[gastownv1.CreateIssueRequest],
 ) (*connect.Response[gastownv1.CreateIssueResponse], error) {
-	if req.Msg.Title == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("title required"))
+	if err := validateCreateIssue(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
 	b := beads.New(s.townRoot)
@@ -238,11 +249,14 @@ func (s *BeadsServer) CreateIssue(
 	opts := beads.CreateOptions{
 		Title:       req.Msg.Title,
 		Description: req.Msg.Description,
-		Priority:    int(req.Msg.Priority),
+		Priority:    -1, // bd's default
 		Parent:      req.Msg.Parent,
 		Actor:       req.Msg.Actor,
 		Ephemeral:   req.Msg.Ephemeral,
 	}
+	if req.Msg.Priority != nil {
+		opts.Priority = int(*req.Msg.Priority)
+	}
 
 	// Handle type
 	if req.Msg.Type != gastownv1.IssueType_ISSUE_TYPE_UNSPECIFIED {
@@ -283,6 +297,10 @@ func (s *BeadsServer) CreateIssue(
 		issue, _ = b.Show(issue.ID)
 	}
 
+	s.index.upsert(issue)
+	s.logIssueEvent(events.TypeIssueCreated, requestedBy(req.Msg.Actor, req.Header()),
+		events.IssueCreatedPayload(issue.ID, issue.Title, issue.Type, issue.Priority))
+
 	return connect.NewResponse(&gastownv1.CreateIssueResponse{
 		Issue: issueToProto(issue),
 	}), nil
@@ -292,8 +310,9 @@ func (s *BeadsServer) UpdateIssue(
 	ctx context.Context,
 	req *connect.Request[gastownv1.UpdateIssueRequest],
 ) (*connect.Response[gastownv1.UpdateIssueResponse], error) {
-	if req.Msg.Id == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("issue ID required"))
+	fields, err := validateUpdateIssue(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
 	b := beads.New(s.townRoot)
@@ -331,11 +350,42 @@ func (s *BeadsServer) UpdateIssue(
 		return nil, notFoundOrInternal("fetching updated issue "+req.Msg.Id, err)
 	}
 
+	s.index.upsert(issue)
+	s.logIssueEvent(events.TypeIssueUpdated, requestedBy(req.Msg.Actor, req.Header()),
+		events.IssueUpdatedPayload(issue.ID, fields))
+
 	return connect.NewResponse(&gastownv1.UpdateIssueResponse{
 		Issue: issueToProto(issue),
 	}), nil
 }
 
+func (s *BeadsServer) CloseIssue(
+	ctx context.Context,
+	req *connect.Request[gastownv1.CloseIssueRequest],
+) (*connect.Response[gastownv1.CloseIssueResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("issue ID required"))
+	}
+
+	b := beads.New(s.townRoot)
+
+	if err := closeIssue(b, req.Msg.Id, req.Msg.Reason); err != nil {
+		return nil, notFoundOrInternal("closing issue "+req.Msg.Id, err)
+	}
+	s.logIssueEvent(events.TypeIssueClosed, requestedBy(req.Msg.Actor, req.Header()),
+		events.IssueClosedPayload(req.Msg.Id, req.Msg.Reason))
+
+	issue, err := b.Show(req.Msg.Id)
+	if err != nil {
+		return nil, notFoundOrInternal("fetching closed issue "+req.Msg.Id, err)
+	}
+	s.index.upsert(issue)
+
+	return connect.NewResponse(&gastownv1.CloseIssueResponse{
+		Issue: issueToProto(issue),
+	}), nil
+}
+
 func (s *BeadsServer) CloseIssues(
 	ctx context.Context,
 	req *connect.Request[gastownv1.CloseIssuesRequest],
@@ -346,29 +396,41 @@ func (s *BeadsServer) CloseIssues(
 
 	b := beads.New(s.townRoot)
 
+	actor := requestedBy(req.Msg.Actor, req.Header())
 	var closedCount int32
 	var failedIDs []string
 
 	for _, id := range req.Msg.Ids {
-		var err error
-		if req.Msg.Reason != "" {
-			err = b.CloseWithReason(req.Msg.Reason, id)
-		} else {
-			err = b.Close(id)
-		}
-		if err != nil {
+		if err := closeIssue(b, id, req.Msg.Reason); err != nil {
 			failedIDs = append(failedIDs, id)
 		} else {
 			closedCount++
+			s.logIssueEvent(events.TypeIssueClosed, actor, events.IssueClosedPayload(id, req.Msg.Reason))
 		}
 	}
 
+	s.index.requestRebuild()
+
 	return connect.NewResponse(&gastownv1.CloseIssuesResponse{
 		ClosedCount: closedCount,
 		FailedIds:   failedIDs,
 	}), nil
 }
 
+// closeIssue closes one issue, with a reason if one was given.
+func closeIssue(b *beads.Beads, id, reason string) error {
+	if reason != "" {
+		return b.CloseWithReason(reason, id)
+	}
+	return b.Close(id)
+}
+
+// logIssueEvent records an issue change made over RPC in the town's event
+// log. Events are best-effort, like everywhere else in gt.
+func (s *BeadsServer) logIssueEvent(eventType, actor string, payload map[string]interface{}) {
+	_ = events.LogTo(s.townRoot, eventType, actor, payload, events.VisibilityFeed)
+}
+
 func (s *BeadsServer) ReopenIssues(
 	ctx context.Context,
 	req *connect.Request[gastownv1.ReopenIssuesRequest],
@@ -391,6 +453,8 @@ func (s *BeadsServer) ReopenIssues(
 		}
 	}
 
+	s.index.requestRebuild()
+
 	return connect.NewResponse(&gastownv1.ReopenIssuesResponse{
 		ReopenedCount: reopenedCount,
 		FailedIds:     failedIDs,
@@ -401,51 +465,32 @@ func (s *BeadsServer) SearchIssues(
 	ctx context.Context,
 	req *connect.Request[gastownv1.SearchIssuesRequest],
 ) (*connect.Response[gastownv1.SearchIssuesResponse], error) {
-	if req.Msg.Query == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("search query required"))
+	q, err := parseSearchQuery(req.Msg.Query)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-
-	b := beads.New(s.townRoot)
-
-	// Build search arguments
-	args := []string{"search", req.Msg.Query, "--json"}
-
+	// The request's own filters combine with any in the query.
 	if req.Msg.Status != "" {
-		args = append(args, "--status="+req.Msg.Status)
+		_ = q.addFilter("status", req.Msg.Status)
 	}
 	if req.Msg.Type != gastownv1.IssueType_ISSUE_TYPE_UNSPECIFIED {
-		args = append(args, "--type="+protoToTypeString(req.Msg.Type))
+		_ = q.addFilter("type", protoToTypeString(req.Msg.Type))
 	}
 	if req.Msg.Label != "" {
-		args = append(args, "--label="+req.Msg.Label)
+		_ = q.addFilter("label", req.Msg.Label)
 	}
 	if req.Msg.Assignee != "" {
-		args = append(args, "--assignee="+req.Msg.Assignee)
-	}
-	if req.Msg.PriorityMin > 0 {
-		args = append(args, fmt.Sprintf("--priority-min=%d", req.Msg.PriorityMin))
+		_ = q.addFilter("assignee", req.Msg.Assignee)
 	}
-	if req.Msg.PriorityMax > 0 {
-		args = append(args, fmt.Sprintf("--priority-max=%d", req.Msg.PriorityMax))
-	}
-	if req.Msg.Limit > 0 {
-		args = append(args, fmt.Sprintf("--limit=%d", req.Msg.Limit))
-	}
-
-	out, err := b.Run(args...)
-	if err != nil {
-		return nil, classifyErr("searching issues", err)
+	q.priorityMin, q.priorityMax = int(req.Msg.PriorityMin), int(req.Msg.PriorityMax)
+	if q.empty() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("search query required"))
 	}
 
-	// Parse JSON output - bd search returns array of issues
-	var issues []*beads.Issue
-	if err := parseJSON(out, &issues); err != nil {
-		// Search might return empty results as text, not JSON error
-		return connect.NewResponse(&gastownv1.SearchIssuesResponse{
-			Issues: nil,
-			Total:  0,
-		}), nil
+	if err := s.index.ensureBuilt(); err != nil {
+		return nil, classifyErr("indexing issues", err)
 	}
+	issues, total := s.index.search(q, int(req.Msg.Limit))
 
 	var protoIssues []*gastownv1.Issue
 	for _, issue := range issues {
@@ -454,7 +499,7 @@ func (s *BeadsServer) SearchIssues(
 
 	return connect.NewResponse(&gastownv1.SearchIssuesResponse{
 		Issues: protoIssues,
-		Total:  int32(len(protoIssues)),
+		Total:  int32(total),
 	}), nil
 }
 
@@ -724,6 +769,8 @@ func (s *BeadsServer) ManageLabels(
 		return nil, notFoundOrInternal("fetching issue labels "+req.Msg.IssueId, err)
 	}
 
+	s.index.requestRebuild()
+
 	return connect.NewResponse(&gastownv1.ManageLabelsResponse{
 		Labels: issue.Labels,
 	}), nil
@@ -0,0 +1,126 @@
+package rpcserver
+
+import (
+	"fmt"
+	"strings"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// maxIssueTitleLength is Dolt's VARCHAR limit for issue titles.
+const maxIssueTitleLength = 500
+
+// issueStatuses are the statuses UpdateIssue may set.
+var issueStatuses = []string{
+	"open", "in_progress", "blocked", "deferred", "closed",
+	beads.StatusHooked, beads.StatusPinned,
+}
+
+func validateIssueTitle(title string) error {
+	switch {
+	case strings.TrimSpace(title) == "":
+		return fmt.Errorf("title required")
+	case len(title) > maxIssueTitleLength:
+		return fmt.Errorf("title is %d bytes, longer than %d", len(title), maxIssueTitleLength)
+	case strings.ContainsAny(title, "\r\n"):
+		return fmt.Errorf("title must be a single line")
+	}
+	return nil
+}
+
+func validateIssuePriority(p int32) error {
+	if p < 0 || p > 4 {
+		return fmt.Errorf("priority %d out of range 0-4", p)
+	}
+	return nil
+}
+
+func validateIssueStatus(status string) error {
+	for _, s := range issueStatuses {
+		if status == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown status %q (want one of %s)", status, strings.Join(issueStatuses, ", "))
+}
+
+// validateIssueLabels rejects labels bd would mangle: empty ones, and ones
+// with whitespace or commas (bd splits label lists on commas).
+func validateIssueLabels(labels []string) error {
+	for _, l := range labels {
+		if l == "" {
+			return fmt.Errorf("empty label")
+		}
+		if strings.ContainsAny(l, ", \t\r\n") {
+			return fmt.Errorf("label %q must not contain commas or whitespace", l)
+		}
+	}
+	return nil
+}
+
+// validateCreateIssue checks a CreateIssue request before anything is
+// written, so a bad request never leaves a half-made issue behind.
+func validateCreateIssue(req *gastownv1.CreateIssueRequest) error {
+	if err := validateIssueTitle(req.Title); err != nil {
+		return err
+	}
+	if req.Priority != nil {
+		if err := validateIssuePriority(*req.Priority); err != nil {
+			return err
+		}
+	}
+	if _, ok := gastownv1.IssueType_name[int32(req.Type)]; !ok {
+		return fmt.Errorf("unknown issue type %d", req.Type)
+	}
+	return validateIssueLabels(req.Labels)
+}
+
+// validateUpdateIssue checks an UpdateIssue request and returns the names
+// of the fields it changes.
+func validateUpdateIssue(req *gastownv1.UpdateIssueRequest) ([]string, error) {
+	if req.Id == "" {
+		return nil, fmt.Errorf("issue ID required")
+	}
+	var fields []string
+	if req.Title != nil {
+		if err := validateIssueTitle(*req.Title); err != nil {
+			return nil, err
+		}
+		fields = append(fields, "title")
+	}
+	if req.Status != nil {
+		if err := validateIssueStatus(*req.Status); err != nil {
+			return nil, err
+		}
+		fields = append(fields, "status")
+	}
+	if req.Priority != nil {
+		if err := validateIssuePriority(*req.Priority); err != nil {
+			return nil, err
+		}
+		fields = append(fields, "priority")
+	}
+	if req.Description != nil {
+		fields = append(fields, "description")
+	}
+	if req.Assignee != nil {
+		fields = append(fields, "assignee")
+	}
+	if len(req.SetLabels) > 0 && (len(req.AddLabels) > 0 || len(req.RemoveLabels) > 0) {
+		return nil, fmt.Errorf("set_labels cannot be combined with add_labels or remove_labels")
+	}
+	for _, labels := range [][]string{req.AddLabels, req.RemoveLabels, req.SetLabels} {
+		if err := validateIssueLabels(labels); err != nil {
+			return nil, err
+		}
+	}
+	if len(req.AddLabels)+len(req.RemoveLabels)+len(req.SetLabels) > 0 {
+		fields = append(fields, "labels")
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("nothing to update")
+	}
+	return fields, nil
+}
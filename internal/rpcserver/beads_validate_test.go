@@ -0,0 +1,99 @@
+package rpcserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+)
+
+func TestValidateCreateIssue(t *testing.T) {
+	p := func(v int32) *int32 { return &v }
+	tests := []struct {
+		name    string
+		req     *gastownv1.CreateIssueRequest
+		wantErr string
+	}{
+		{"ok", &gastownv1.CreateIssueRequest{Title: "Fix login", Priority: p(1), Labels: []string{"auth"}}, ""},
+		{"priority unset", &gastownv1.CreateIssueRequest{Title: "Fix login"}, ""},
+		{"no title", &gastownv1.CreateIssueRequest{Title: "  "}, "title required"},
+		{"multiline title", &gastownv1.CreateIssueRequest{Title: "a\nb"}, "single line"},
+		{"long title", &gastownv1.CreateIssueRequest{Title: strings.Repeat("x", maxIssueTitleLength+1)}, "longer than"},
+		{"priority too high", &gastownv1.CreateIssueRequest{Title: "t", Priority: p(5)}, "out of range"},
+		{"negative priority", &gastownv1.CreateIssueRequest{Title: "t", Priority: p(-1)}, "out of range"},
+		{"unknown type", &gastownv1.CreateIssueRequest{Title: "t", Type: gastownv1.IssueType(99)}, "unknown issue type"},
+		{"label with comma", &gastownv1.CreateIssueRequest{Title: "t", Labels: []string{"a,b"}}, "commas"},
+		{"empty label", &gastownv1.CreateIssueRequest{Title: "t", Labels: []string{""}}, "empty label"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCreateIssue(tt.req)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateIssue(t *testing.T) {
+	str := func(s string) *string { return &s }
+	p := func(v int32) *int32 { return &v }
+
+	fields, err := validateUpdateIssue(&gastownv1.UpdateIssueRequest{
+		Id:        "gt-1",
+		Status:    str("in_progress"),
+		Priority:  p(0),
+		Assignee:  str(""),
+		AddLabels: []string{"wip"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(fields, ","); got != "status,priority,assignee,labels" {
+		t.Errorf("fields = %s", got)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		req     *gastownv1.UpdateIssueRequest
+		wantErr string
+	}{
+		{"no id", &gastownv1.UpdateIssueRequest{Title: str("t")}, "issue ID required"},
+		{"nothing", &gastownv1.UpdateIssueRequest{Id: "gt-1"}, "nothing to update"},
+		{"bad status", &gastownv1.UpdateIssueRequest{Id: "gt-1", Status: str("done")}, "unknown status"},
+		{"bad priority", &gastownv1.UpdateIssueRequest{Id: "gt-1", Priority: p(9)}, "out of range"},
+		{"empty title", &gastownv1.UpdateIssueRequest{Id: "gt-1", Title: str("")}, "title required"},
+		{"set and add labels", &gastownv1.UpdateIssueRequest{Id: "gt-1", SetLabels: []string{"a"}, AddLabels: []string{"b"}}, "cannot be combined"},
+		{"bad label", &gastownv1.UpdateIssueRequest{Id: "gt-1", RemoveLabels: []string{"a b"}}, "whitespace"},
+	} {
+		if _, err := validateUpdateIssue(tt.req); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: err = %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestBeadsServer_RejectsInvalidWrites(t *testing.T) {
+	srv := NewBeadsServer(t.TempDir())
+	ctx := context.Background()
+	p := int32(7)
+
+	_, createErr := srv.CreateIssue(ctx, connect.NewRequest(&gastownv1.CreateIssueRequest{Title: "t", Priority: &p}))
+	_, updateErr := srv.UpdateIssue(ctx, connect.NewRequest(&gastownv1.UpdateIssueRequest{Id: "gt-1"}))
+	_, closeErr := srv.CloseIssue(ctx, connect.NewRequest(&gastownv1.CloseIssueRequest{}))
+	for name, err := range map[string]error{"CreateIssue": createErr, "UpdateIssue": updateErr, "CloseIssue": closeErr} {
+		var cerr *connect.Error
+		if !errors.As(err, &cerr) || cerr.Code() != connect.CodeInvalidArgument {
+			t.Errorf("%s err = %v, want InvalidArgument", name, err)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package rpcserver
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/crew"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/terminal"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultStatusCacheTTL is how long a collected TownStatus is served from
+// cache before it is rebuilt.
+const DefaultStatusCacheTTL = 2 * time.Second
+
+// StatusCollector builds TownStatus snapshots for the status RPCs.
+//
+// A snapshot re-reads town and rig config, walks every rig directory, and
+// probes each agent session, which gets slow on busy towns. The collector
+// caches snapshots for a short TTL and drops them early when the town layout
+// on disk changes (config files, polecat/crew directories) or when a caller
+// reports a session change via Invalidate. A single collector is shared by
+// all servers in the process so concurrent requests collect at most once.
+//
+// While Run is active, fast snapshots come from a live town state kept in
+// memory instead (see townstate.go).
+type StatusCollector struct {
+	townRoot string
+	backend  terminal.Backend
+	ttl      time.Duration
+	now      func() time.Time
+	rigs     *rig.Index
+
+	mu    sync.Mutex
+	cache map[bool]cachedTownStatus // keyed by fast
+
+	live         liveState
+	reconcileReq chan struct{}
+	agentBeads   func(*gastownv1.TownStatus) map[string]*beads.Issue
+}
+
+type cachedTownStatus struct {
+	status *gastownv1.TownStatus
+	at     time.Time
+	stamp  string
+}
+
+// NewStatusCollector creates a StatusCollector. A ttl of zero uses
+// DefaultStatusCacheTTL; a negative ttl disables caching.
+func NewStatusCollector(townRoot string, backend terminal.Backend, ttl time.Duration) *StatusCollector {
+	if ttl == 0 {
+		ttl = DefaultStatusCacheTTL
+	}
+	c := &StatusCollector{
+		townRoot:     townRoot,
+		backend:      backend,
+		ttl:          ttl,
+		now:          time.Now,
+		rigs:         rig.NewIndex(townRoot),
+		cache:        make(map[bool]cachedTownStatus),
+		reconcileReq: make(chan struct{}, 1),
+	}
+	c.agentBeads = c.loadAgentBeads
+	return c
+}
+
+// rigCollectConcurrency bounds how many rigs a snapshot collects at once.
+const rigCollectConcurrency = 8
+
+// TownStatus returns a town status snapshot, from cache when still valid.
+// A cached full snapshot also satisfies fast requests. The returned value
+// is a copy and may be modified by the caller. Fast requests are served
+// from the live state while Run is active.
+func (c *StatusCollector) TownStatus(fast bool) (*gastownv1.TownStatus, error) {
+	if fast {
+		if status := c.live.get(); status != nil {
+			return status, nil
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ttl > 0 {
+		stamp := c.layoutStamp()
+		for _, key := range []bool{false, fast} {
+			e, ok := c.cache[key]
+			if !ok {
+				continue
+			}
+			if e.stamp != stamp {
+				// The layout changed on disk; don't wait for the rig
+				// index's watcher to catch up.
+				c.rigs.Invalidate()
+				continue
+			}
+			if c.now().Sub(e.at) < c.ttl {
+				return proto.Clone(e.status).(*gastownv1.TownStatus), nil
+			}
+		}
+	}
+
+	status, err := c.collect(fast)
+	if err != nil {
+		return nil, err
+	}
+	if c.ttl > 0 {
+		// Stamp after collecting so rig directories discovered by this
+		// snapshot are part of the stamp.
+		c.cache[fast] = cachedTownStatus{status: status, at: c.now(), stamp: c.stampFor(status)}
+	}
+	return proto.Clone(status).(*gastownv1.TownStatus), nil
+}
+
+// Invalidate drops all cached snapshots. Call it after operations that
+// start or stop sessions so the next request observes the change. The
+// live state, if any, is dropped too and rebuilt in the background.
+func (c *StatusCollector) Invalidate() {
+	c.mu.Lock()
+	c.cache = make(map[bool]cachedTownStatus)
+	c.mu.Unlock()
+	c.live.reset()
+	c.requestReconcile()
+}
+
+// Close stops the collector's rig index from watching the filesystem.
+func (c *StatusCollector) Close() error {
+	return c.rigs.Close()
+}
+
+// RefreshRigs rescans the town's rigs, bypassing the rig index, and drops
+// cached snapshots. It returns the rigs found.
+func (c *StatusCollector) RefreshRigs() ([]*rig.Rig, error) {
+	rigs, err := c.rigs.Refresh()
+	if err != nil {
+		return nil, err
+	}
+	c.Invalidate()
+	return rigs, nil
+}
+
+// layoutStamp fingerprints the on-disk state a cached snapshot was built
+// from. Rig directories are taken from the most recent snapshot.
+func (c *StatusCollector) layoutStamp() string {
+	var latest *gastownv1.TownStatus
+	var latestAt time.Time
+	for _, e := range c.cache {
+		if latest == nil || e.at.After(latestAt) {
+			latest, latestAt = e.status, e.at
+		}
+	}
+	return c.stampFor(latest)
+}
+
+// stampFor fingerprints the rig index generation, the town config files,
+// and the directories of each rig in status by modification time. Adding or removing a polecat or crew
+// worktree changes the parent directory's mtime.
+func (c *StatusCollector) stampFor(status *gastownv1.TownStatus) string {
+	paths := []string{
+		constants.MayorTownPath(c.townRoot),
+		constants.MayorRigsPath(c.townRoot),
+	}
+	if status != nil {
+		for _, r := range status.Rigs {
+			paths = append(paths, r.Path, filepath.Join(r.Path, "polecats"), filepath.Join(r.Path, "crew"))
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d;", c.rigs.Generation())
+	for _, p := range paths {
+		if info, err := os.Stat(p); err == nil {
+			fmt.Fprintf(&b, "%d;", info.ModTime().UnixNano())
+		} else {
+			b.WriteString("-;")
+		}
+	}
+	return b.String()
+}
+
+// collect builds a fresh TownStatus snapshot. When fast is true, mail
+// lookups are skipped.
+func (c *StatusCollector) collect(fast bool) (*gastownv1.TownStatus, error) {
+	// Load configs
+	townConfigPath := constants.MayorTownPath(c.townRoot)
+	townConfig, err := config.LoadTownConfig(townConfigPath)
+	if err != nil {
+		townConfig = &config.TownConfig{Name: filepath.Base(c.townRoot)}
+	}
+
+	rigs, err := c.rigs.Rigs()
+	if err != nil {
+		return nil, fmt.Errorf("discovering rigs: %w", err)
+	}
+
+	// Helper to check if a session is running via the backend.
+	isSessionRunning := func(session string) bool {
+		exists, err := c.backend.HasSession(session)
+		return err == nil && exists
+	}
+
+	// Overseer info
+	var overseer *gastownv1.OverseerInfo
+	if oc, err := config.LoadOrDetectOverseer(c.townRoot); err == nil && oc != nil {
+		overseer = &gastownv1.OverseerInfo{
+			Name:     oc.Name,
+			Email:    oc.Email,
+			Username: oc.Username,
+		}
+		if !fast {
+			mailRouter := mail.NewRouter(c.townRoot)
+			if mb, err := mailRouter.GetMailbox("overseer"); err == nil {
+				_, unread, _ := mb.Count()
+				overseer.UnreadMail = int32(unread)
+			}
+		}
+	}
+
+	// Build status
+	status := &gastownv1.TownStatus{
+		Name:     townConfig.Name,
+		Location: c.townRoot,
+		Overseer: overseer,
+	}
+
+	// Global agents
+	for _, agent := range []struct{ name, session, role string }{
+		{"mayor", "gt-mayor", "mayor"},
+		{"deacon", "gt-deacon", "deacon"},
+	} {
+		status.GlobalAgents = append(status.GlobalAgents, &gastownv1.AgentRuntime{
+			Name:    agent.name,
+			Address: &gastownv1.AgentAddress{Name: agent.name},
+			Session: agent.session,
+			Role:    agent.role,
+			Running: isSessionRunning(agent.session),
+		})
+	}
+
+	// Rig status: each rig's crew listing and session probes are
+	// independent, so rigs are collected in parallel.
+	status.Rigs = make([]*gastownv1.RigStatus, len(rigs))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, rigCollectConcurrency)
+	for i, r := range rigs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, r *rig.Rig) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			status.Rigs[i] = rigStatus(r, isSessionRunning)
+		}(i, r)
+	}
+	wg.Wait()
+
+	return status, nil
+}
+
+// rigStatus builds the status of one rig: its crew and the agents it hosts.
+func rigStatus(r *rig.Rig, isSessionRunning func(string) bool) *gastownv1.RigStatus {
+	rs := &gastownv1.RigStatus{
+		Name:        r.Name,
+		Path:        r.Path,
+		Polecats:    append([]string(nil), r.Polecats...),
+		HasWitness:  r.HasWitness,
+		HasRefinery: r.HasRefinery,
+	}
+
+	// Crew workers
+	crewGit := git.NewGit(r.Path)
+	crewMgr := crew.NewManager(r, crewGit)
+	if workers, err := crewMgr.List(); err == nil {
+		for _, w := range workers {
+			rs.Crews = append(rs.Crews, w.Name)
+		}
+	}
+
+	// Rig agents
+	if r.HasWitness {
+		session := fmt.Sprintf("gt-%s-witness", r.Name)
+		rs.Agents = append(rs.Agents, &gastownv1.AgentRuntime{
+			Name:    "witness",
+			Address: &gastownv1.AgentAddress{Rig: r.Name, Role: "witness"},
+			Session: session,
+			Role:    "witness",
+			Running: isSessionRunning(session),
+		})
+	}
+	if r.HasRefinery {
+		session := fmt.Sprintf("gt-%s-refinery", r.Name)
+		rs.Agents = append(rs.Agents, &gastownv1.AgentRuntime{
+			Name:    "refinery",
+			Address: &gastownv1.AgentAddress{Rig: r.Name, Role: "refinery"},
+			Session: session,
+			Role:    "refinery",
+			Running: isSessionRunning(session),
+		})
+	}
+	for _, p := range r.Polecats {
+		session := fmt.Sprintf("gt-%s-%s", r.Name, p)
+		rs.Agents = append(rs.Agents, &gastownv1.AgentRuntime{
+			Name:    p,
+			Address: &gastownv1.AgentAddress{Rig: r.Name, Role: "polecats", Name: p},
+			Session: session,
+			Role:    "polecat",
+			Running: isSessionRunning(session),
+		})
+	}
+	for _, c := range rs.Crews {
+		session := fmt.Sprintf("gt-%s-crew-%s", r.Name, c)
+		rs.Agents = append(rs.Agents, &gastownv1.AgentRuntime{
+			Name:    c,
+			Address: &gastownv1.AgentAddress{Rig: r.Name, Role: "crew", Name: c},
+			Session: session,
+			Role:    "crew",
+			Running: isSessionRunning(session),
+		})
+	}
+
+	return rs
+}
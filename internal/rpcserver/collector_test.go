@@ -0,0 +1,236 @@
+package rpcserver
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/terminal"
+)
+
+// fakeBackend is a terminal.Backend that reports a fixed set of sessions
+// and counts HasSession probes. Unimplemented methods panic via the nil
+// embedded interface.
+type fakeBackend struct {
+	terminal.Backend
+
+	mu       sync.Mutex
+	sessions map[string]string // session -> captured output
+	probes   int
+}
+
+func newFakeBackend(sessions ...string) *fakeBackend {
+	b := &fakeBackend{sessions: make(map[string]string)}
+	for _, s := range sessions {
+		b.sessions[s] = ""
+	}
+	return b
+}
+
+func (b *fakeBackend) HasSession(session string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probes++
+	_, ok := b.sessions[session]
+	return ok, nil
+}
+
+func (b *fakeBackend) CapturePane(session string, _ int) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.sessions[session], nil
+}
+
+func (b *fakeBackend) CapturePaneAll(session string) (string, error) {
+	return b.CapturePane(session, 0)
+}
+
+func (b *fakeBackend) KillSession(session string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.sessions, session)
+	return nil
+}
+
+func (b *fakeBackend) probeCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.probes
+}
+
+// setupCollectorTown creates a minimal town with one rig and one polecat.
+func setupCollectorTown(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	mustWrite := func(path, content string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mustWrite(filepath.Join(root, "mayor", "town.json"), `{"type":"town","version":2,"name":"testtown"}`)
+	mustWrite(filepath.Join(root, "mayor", "rigs.json"), `{"version":1,"rigs":{"alpha":{"git_url":"https://example.com/alpha.git"}}}`)
+	if err := os.MkdirAll(filepath.Join(root, "alpha", "polecats", "furiosa"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+func TestStatusCollector_CachesWithinTTL(t *testing.T) {
+	root := setupCollectorTown(t)
+	backend := newFakeBackend("gt-alpha-furiosa")
+	c := NewStatusCollector(root, backend, time.Minute)
+
+	first, err := c.TownStatus(true)
+	if err != nil {
+		t.Fatalf("TownStatus: %v", err)
+	}
+	probes := backend.probeCount()
+	if probes == 0 {
+		t.Fatal("expected sessions to be probed on first collection")
+	}
+
+	second, err := c.TownStatus(true)
+	if err != nil {
+		t.Fatalf("TownStatus: %v", err)
+	}
+	if got := backend.probeCount(); got != probes {
+		t.Errorf("second call probed sessions again: %d probes, want %d", got, probes)
+	}
+	if len(second.Rigs) != 1 || len(second.Rigs[0].Agents) != 1 || !second.Rigs[0].Agents[0].Running {
+		t.Fatalf("unexpected cached status: %+v", second.Rigs)
+	}
+
+	// Returned snapshots are copies.
+	first.Rigs[0].Name = "mutated"
+	third, _ := c.TownStatus(true)
+	if third.Rigs[0].Name != "alpha" {
+		t.Errorf("cache was mutated through returned value: %q", third.Rigs[0].Name)
+	}
+}
+
+func TestStatusCollector_TTLExpiry(t *testing.T) {
+	root := setupCollectorTown(t)
+	backend := newFakeBackend()
+	c := NewStatusCollector(root, backend, time.Second)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	if _, err := c.TownStatus(true); err != nil {
+		t.Fatal(err)
+	}
+	probes := backend.probeCount()
+
+	now = now.Add(2 * time.Second)
+	if _, err := c.TownStatus(true); err != nil {
+		t.Fatal(err)
+	}
+	if backend.probeCount() == probes {
+		t.Error("expected re-collection after TTL expired")
+	}
+}
+
+func TestStatusCollector_InvalidatesOnLayoutChange(t *testing.T) {
+	root := setupCollectorTown(t)
+	backend := newFakeBackend()
+	c := NewStatusCollector(root, backend, time.Minute)
+
+	if _, err := c.TownStatus(true); err != nil {
+		t.Fatal(err)
+	}
+
+	// Adding a polecat directory changes the polecats dir mtime.
+	polecats := filepath.Join(root, "alpha", "polecats")
+	if err := os.Mkdir(filepath.Join(polecats, "nux"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	future := time.Now().Add(time.Hour)
+	if err := os.Chtimes(polecats, future, future); err != nil {
+		t.Fatal(err)
+	}
+
+	status, err := c.TownStatus(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(status.Rigs[0].Polecats); got != 2 {
+		t.Errorf("polecats = %d, want 2 after layout change", got)
+	}
+}
+
+func TestStatusCollector_Invalidate(t *testing.T) {
+	root := setupCollectorTown(t)
+	backend := newFakeBackend()
+	c := NewStatusCollector(root, backend, time.Minute)
+
+	if _, err := c.TownStatus(true); err != nil {
+		t.Fatal(err)
+	}
+	backend.mu.Lock()
+	backend.sessions["gt-alpha-furiosa"] = ""
+	backend.mu.Unlock()
+
+	c.Invalidate()
+	status, err := c.TownStatus(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.Rigs[0].Agents[0].Running {
+		t.Error("expected polecat to be running after invalidation")
+	}
+}
+
+func TestStatusCollector_FullSnapshotServesFast(t *testing.T) {
+	root := setupCollectorTown(t)
+	backend := newFakeBackend()
+	c := NewStatusCollector(root, backend, time.Minute)
+
+	if _, err := c.TownStatus(false); err != nil {
+		t.Fatal(err)
+	}
+	probes := backend.probeCount()
+	if _, err := c.TownStatus(true); err != nil {
+		t.Fatal(err)
+	}
+	if backend.probeCount() != probes {
+		t.Error("fast request should be served by cached full snapshot")
+	}
+}
+
+func TestStatusCollector_RefreshRigs(t *testing.T) {
+	root := setupCollectorTown(t)
+	c := NewStatusCollector(root, newFakeBackend(), time.Minute)
+	defer c.Close()
+
+	if _, err := c.TownStatus(true); err != nil {
+		t.Fatal(err)
+	}
+
+	rigsJSON := `{"version":1,"rigs":{"alpha":{"git_url":"https://example.com/alpha.git"},"beta":{"git_url":"https://example.com/beta.git"}}}`
+	if err := os.MkdirAll(filepath.Join(root, "beta"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "mayor", "rigs.json"), []byte(rigsJSON), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rigs, err := c.RefreshRigs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rigs) != 2 || rigs[0].Name != "alpha" || rigs[1].Name != "beta" {
+		t.Fatalf("RefreshRigs = %v, want alpha, beta", rigs)
+	}
+	status, err := c.TownStatus(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status.Rigs) != 2 {
+		t.Errorf("status after refresh has %d rigs, want 2", len(status.Rigs))
+	}
+}
@@ -0,0 +1,247 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/dog"
+	"github.com/steveyegge/gastown/internal/terminal"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DogServer implements the DogService.
+type DogServer struct {
+	townRoot string
+	backend  terminal.Backend
+}
+
+var _ gastownv1connect.DogServiceHandler = (*DogServer)(nil)
+
+// NewDogServer creates a new DogServer.
+func NewDogServer(townRoot string) *DogServer {
+	return &DogServer{
+		townRoot: townRoot,
+		backend:  terminal.NewCoopBackend(terminal.CoopConfig{}),
+	}
+}
+
+// NewDogServerWithBackend creates a DogServer with a custom terminal backend.
+func NewDogServerWithBackend(townRoot string, backend terminal.Backend) *DogServer {
+	return &DogServer{
+		townRoot: townRoot,
+		backend:  backend,
+	}
+}
+
+// kennel returns a kennel for the town. The rigs config is loaded per call
+// so dogs added after startup get worktrees in new rigs.
+func (s *DogServer) kennel() *dog.Kennel {
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(s.townRoot, "mayor", "rigs.json"))
+	if err != nil {
+		rigsConfig = nil // Assign and recall still work; adding or refreshing dogs reports ErrNoRigs
+	}
+	return dog.NewKennel(s.townRoot, rigsConfig, s.backend)
+}
+
+func (s *DogServer) ListDogs(
+	ctx context.Context,
+	req *connect.Request[gastownv1.ListDogsRequest],
+) (*connect.Response[gastownv1.ListDogsResponse], error) {
+	k := s.kennel()
+	statuses, err := k.StatusAll()
+	if err != nil {
+		return nil, dogErr("listing dogs", err)
+	}
+
+	resp := &gastownv1.ListDogsResponse{}
+	for _, st := range statuses {
+		d := dogToProto(k, st)
+		switch d.State {
+		case gastownv1.DogState_DOG_STATE_IDLE:
+			resp.Idle++
+		case gastownv1.DogState_DOG_STATE_WORKING:
+			resp.Working++
+		}
+		if req.Msg.State != gastownv1.DogState_DOG_STATE_UNSPECIFIED && d.State != req.Msg.State {
+			continue
+		}
+		resp.Dogs = append(resp.Dogs, d)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *DogServer) GetDog(
+	ctx context.Context,
+	req *connect.Request[gastownv1.GetDogRequest],
+) (*connect.Response[gastownv1.GetDogResponse], error) {
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+
+	k := s.kennel()
+	st, err := k.Status(req.Msg.Name)
+	if err != nil {
+		return nil, dogErr("getting dog", err)
+	}
+	return connect.NewResponse(&gastownv1.GetDogResponse{Dog: dogToProto(k, st)}), nil
+}
+
+func (s *DogServer) AssignDog(
+	ctx context.Context,
+	req *connect.Request[gastownv1.AssignDogRequest],
+) (*connect.Response[gastownv1.AssignDogResponse], error) {
+	if req.Msg.Work == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("work is required"))
+	}
+	if req.Msg.Name != "" {
+		if err := dog.ValidateName(req.Msg.Name); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	k := s.kennel()
+	a, err := k.Assign(dog.AssignOptions{
+		Dog:           req.Msg.Name,
+		Work:          req.Msg.Work,
+		Create:        req.Msg.Create,
+		Force:         req.Msg.Force,
+		SkipSession:   req.Msg.SkipSession,
+		AgentOverride: req.Msg.AgentOverride,
+		By:            requestedBy(req.Msg.RequestedBy, req.Header()),
+	})
+	if err != nil {
+		return nil, dogErr("assigning dog", err)
+	}
+
+	resp := &gastownv1.AssignDogResponse{
+		Dog:          dogToProto(k, &dog.Status{Dog: a.Dog, Session: &dog.SessionInfo{Running: a.Session != ""}}),
+		Spawned:      a.Spawned,
+		ReplacedWork: a.Replaced,
+	}
+	if a.SessionErr != nil {
+		resp.SessionError = a.SessionErr.Error()
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *DogServer) RecallDog(
+	ctx context.Context,
+	req *connect.Request[gastownv1.RecallDogRequest],
+) (*connect.Response[gastownv1.RecallDogResponse], error) {
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+
+	k := s.kennel()
+	r, err := k.Recall(req.Msg.Name, dog.RecallOptions{
+		Force:       req.Msg.Force,
+		KeepSession: req.Msg.KeepSession,
+		Refresh:     req.Msg.Refresh,
+		Reason:      req.Msg.Reason,
+		By:          requestedBy(req.Msg.RequestedBy, req.Header()),
+	})
+	if err != nil {
+		return nil, dogErr("recalling dog", err)
+	}
+
+	running := req.Msg.KeepSession
+	if running {
+		running, _ = k.Sessions().IsRunning(req.Msg.Name)
+	}
+	return connect.NewResponse(&gastownv1.RecallDogResponse{
+		Dog:            dogToProto(k, &dog.Status{Dog: r.Dog, Session: &dog.SessionInfo{Running: running}}),
+		RecalledWork:   r.Work,
+		SessionStopped: r.SessionStopped,
+		Refreshed:      r.Refreshed,
+	}), nil
+}
+
+func (s *DogServer) GetDogLogs(
+	ctx context.Context,
+	req *connect.Request[gastownv1.GetDogLogsRequest],
+) (*connect.Response[gastownv1.GetDogLogsResponse], error) {
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+
+	lines := int(req.Msg.Lines)
+	if lines <= 0 {
+		lines = 50
+	}
+	if lines > 1000 {
+		lines = 1000
+	}
+
+	logs, err := s.kennel().Logs(req.Msg.Name, lines, int(max(req.Msg.Events, 0)))
+	if err != nil {
+		return nil, dogErr("getting dog logs", err)
+	}
+
+	resp := &gastownv1.GetDogLogsResponse{
+		Output:  logs.Output,
+		Running: logs.Running,
+	}
+	for _, ev := range logs.Events {
+		resp.Events = append(resp.Events, &gastownv1.DogEvent{
+			Time:    timestamppb.New(ev.Time),
+			Kind:    string(ev.Kind),
+			Work:    ev.Work,
+			Message: ev.Message,
+			By:      ev.By,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// requestedBy names who made a request, for event logs: the request's own
+// field, else the X-GT-From sender, else "rpc".
+func requestedBy(by string, header http.Header) string {
+	if by != "" {
+		return by
+	}
+	if from := header.Get("X-GT-From"); from != "" {
+		return from
+	}
+	return "rpc"
+}
+
+func dogToProto(k *dog.Kennel, st *dog.Status) *gastownv1.Dog {
+	d := st.Dog
+	out := &gastownv1.Dog{
+		Name:      d.Name,
+		State:     dogStateToProto(d.State),
+		Work:      d.Work,
+		Worktrees: d.Worktrees,
+		Session:   k.Sessions().SessionName(d.Name),
+	}
+	if !d.LastActive.IsZero() {
+		out.LastActive = timestamppb.New(d.LastActive)
+	}
+	if !d.CreatedAt.IsZero() {
+		out.CreatedAt = timestamppb.New(d.CreatedAt)
+	}
+	if st.Session != nil {
+		out.SessionRunning = st.Session.Running
+	}
+	return out
+}
+
+func dogStateToProto(state dog.State) gastownv1.DogState {
+	switch state {
+	case dog.StateIdle:
+		return gastownv1.DogState_DOG_STATE_IDLE
+	case dog.StateWorking:
+		return gastownv1.DogState_DOG_STATE_WORKING
+	default:
+		return gastownv1.DogState_DOG_STATE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,138 @@
+package rpcserver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+)
+
+// setupKennel creates a town with idle dog alpha and dog bravo working on
+// gt-old in a running session.
+func setupKennel(t *testing.T) (*DogServer, *fakeBackend) {
+	t.Helper()
+	root := setupCollectorTown(t)
+	for name, state := range map[string]string{
+		"alpha": `{"name":"alpha","state":"idle"}`,
+		"bravo": `{"name":"bravo","state":"working","work":"gt-old"}`,
+	} {
+		dir := filepath.Join(root, "deacon", "dogs", name)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, ".dog.json"), []byte(state), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	backend := newFakeBackend("gt-testtown-deacon-bravo")
+	backend.sessions["gt-testtown-deacon-bravo"] = "sniffing gt-old"
+	return NewDogServerWithBackend(root, backend), backend
+}
+
+func TestDogServer_ListDogs(t *testing.T) {
+	srv, _ := setupKennel(t)
+	ctx := context.Background()
+
+	resp, err := srv.ListDogs(ctx, connect.NewRequest(&gastownv1.ListDogsRequest{}))
+	if err != nil {
+		t.Fatalf("ListDogs: %v", err)
+	}
+	if len(resp.Msg.Dogs) != 2 || resp.Msg.Idle != 1 || resp.Msg.Working != 1 {
+		t.Fatalf("ListDogs = %+v", resp.Msg)
+	}
+	bravo := resp.Msg.Dogs[1]
+	if bravo.Name != "bravo" || bravo.Work != "gt-old" || !bravo.SessionRunning {
+		t.Errorf("bravo = %+v", bravo)
+	}
+
+	resp, err = srv.ListDogs(ctx, connect.NewRequest(&gastownv1.ListDogsRequest{State: gastownv1.DogState_DOG_STATE_IDLE}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Msg.Dogs) != 1 || resp.Msg.Dogs[0].Name != "alpha" {
+		t.Errorf("ListDogs(idle) = %+v", resp.Msg.Dogs)
+	}
+}
+
+func TestDogServer_AssignAndRecall(t *testing.T) {
+	srv, _ := setupKennel(t)
+	ctx := context.Background()
+
+	_, err := srv.AssignDog(ctx, connect.NewRequest(&gastownv1.AssignDogRequest{Name: "bravo", Work: "gt-new", SkipSession: true}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("AssignDog(busy) error = %v, want FailedPrecondition", err)
+	}
+
+	assign, err := srv.AssignDog(ctx, connect.NewRequest(&gastownv1.AssignDogRequest{Work: "gt-new", SkipSession: true, RequestedBy: "deacon"}))
+	if err != nil {
+		t.Fatalf("AssignDog: %v", err)
+	}
+	if assign.Msg.Dog.Name != "alpha" || assign.Msg.Dog.State != gastownv1.DogState_DOG_STATE_WORKING {
+		t.Errorf("AssignDog = %+v", assign.Msg)
+	}
+
+	recall, err := srv.RecallDog(ctx, connect.NewRequest(&gastownv1.RecallDogRequest{Name: "bravo", Force: true, Reason: "stuck"}))
+	if err != nil {
+		t.Fatalf("RecallDog: %v", err)
+	}
+	if recall.Msg.RecalledWork != "gt-old" || !recall.Msg.SessionStopped || recall.Msg.Dog.SessionRunning ||
+		recall.Msg.Dog.State != gastownv1.DogState_DOG_STATE_IDLE {
+		t.Errorf("RecallDog = %+v", recall.Msg)
+	}
+
+	logs, err := srv.GetDogLogs(ctx, connect.NewRequest(&gastownv1.GetDogLogsRequest{Name: "alpha"}))
+	if err != nil {
+		t.Fatalf("GetDogLogs: %v", err)
+	}
+	if len(logs.Msg.Events) != 1 || logs.Msg.Events[0].Kind != "assigned" || logs.Msg.Events[0].By != "deacon" {
+		t.Errorf("alpha events = %+v", logs.Msg.Events)
+	}
+	logs, err = srv.GetDogLogs(ctx, connect.NewRequest(&gastownv1.GetDogLogsRequest{Name: "bravo"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if logs.Msg.Running || len(logs.Msg.Events) != 2 || logs.Msg.Events[1].Message != "stuck" || logs.Msg.Events[1].By != "rpc" {
+		t.Errorf("bravo logs = %+v", logs.Msg)
+	}
+}
+
+func TestDogServer_Errors(t *testing.T) {
+	srv, _ := setupKennel(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+		want connect.Code
+	}{
+		{"get without name", func() error {
+			_, err := srv.GetDog(ctx, connect.NewRequest(&gastownv1.GetDogRequest{}))
+			return err
+		}, connect.CodeInvalidArgument},
+		{"get missing dog", func() error {
+			_, err := srv.GetDog(ctx, connect.NewRequest(&gastownv1.GetDogRequest{Name: "zulu"}))
+			return err
+		}, connect.CodeNotFound},
+		{"assign without work", func() error {
+			_, err := srv.AssignDog(ctx, connect.NewRequest(&gastownv1.AssignDogRequest{Name: "alpha"}))
+			return err
+		}, connect.CodeInvalidArgument},
+		{"assign path traversal", func() error {
+			_, err := srv.AssignDog(ctx, connect.NewRequest(&gastownv1.AssignDogRequest{Name: "../mayor", Work: "gt-1"}))
+			return err
+		}, connect.CodeInvalidArgument},
+		{"recall missing dog", func() error {
+			_, err := srv.RecallDog(ctx, connect.NewRequest(&gastownv1.RecallDogRequest{Name: "zulu"}))
+			return err
+		}, connect.CodeNotFound},
+	}
+	for _, tt := range tests {
+		if got := connect.CodeOf(tt.call()); got != tt.want {
+			t.Errorf("%s: code = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
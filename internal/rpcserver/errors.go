@@ -11,7 +11,10 @@ import (
 	"connectrpc.com/connect"
 
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/crew"
+	"github.com/steveyegge/gastown/internal/dog"
 	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/sling"
 )
 
 // Error helpers for consistent RPC error handling across all services.
@@ -152,6 +155,51 @@ func classifyErr(operation string, err error) *connect.Error {
 	return connect.NewError(connect.CodeInternal, fmt.Errorf("%s: %w", operation, err))
 }
 
+// crewErr classifies errors from crew.Manager, mapping its sentinel errors
+// to codes a client can act on before falling back to classifyErr.
+func crewErr(operation string, err error) *connect.Error {
+	switch {
+	case errors.Is(err, crew.ErrInvalidCrewName):
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s: %w", operation, err))
+	case errors.Is(err, crew.ErrCrewExists), errors.Is(err, crew.ErrSessionRunning):
+		return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s: %w", operation, err))
+	case errors.Is(err, crew.ErrCrewNotFound), errors.Is(err, crew.ErrSessionNotFound):
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("%s: %w", operation, err))
+	case errors.Is(err, crew.ErrHasChanges):
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s: %w (set force to discard them)", operation, err))
+	}
+	return classifyErr(operation, err)
+}
+
+// dogErr classifies errors from the dog kennel, mapping its sentinel errors
+// to codes a client can act on before falling back to classifyErr.
+func dogErr(operation string, err error) *connect.Error {
+	switch {
+	case errors.Is(err, dog.ErrInvalidDogName), errors.Is(err, dog.ErrWorkRequired):
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s: %w", operation, err))
+	case errors.Is(err, dog.ErrDogNotFound):
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("%s: %w", operation, err))
+	case errors.Is(err, dog.ErrDogExists):
+		return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s: %w", operation, err))
+	case errors.Is(err, dog.ErrDogBusy):
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s: %w (set force to reassign)", operation, err))
+	case errors.Is(err, dog.ErrNoIdleDog):
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s: %w (set create to add one)", operation, err))
+	case errors.Is(err, dog.ErrNoRigs):
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s: %w", operation, err))
+	}
+	return classifyErr(operation, err)
+}
+
+// slingErr classifies errors from the sling package: a paused town is a
+// precondition the caller can wait out, not an internal failure.
+func slingErr(operation string, err error) *connect.Error {
+	if errors.Is(err, sling.ErrTownPaused) {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s: %w", operation, err))
+	}
+	return classifyErr(operation, err)
+}
+
 // notFoundOrInternal returns CodeNotFound if the error matches a not-found pattern,
 // otherwise CodeInternal. Use for operations where the primary expected error is
 // a missing resource (e.g., Show, Get).
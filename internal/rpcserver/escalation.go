@@ -0,0 +1,237 @@
+package rpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/escalation"
+)
+
+// EscalationServer implements the EscalationService on top of the
+// escalation package, so RPC transitions notify and log exactly like
+// gt escalate does.
+type EscalationServer struct {
+	townRoot string
+}
+
+var _ gastownv1connect.EscalationServiceHandler = (*EscalationServer)(nil)
+
+// NewEscalationServer creates a new EscalationServer.
+func NewEscalationServer(townRoot string) *EscalationServer {
+	return &EscalationServer{townRoot: townRoot}
+}
+
+// service loads the escalation config per request so edits to
+// settings/escalation.json apply without a restart.
+func (s *EscalationServer) service() (*escalation.Service, error) {
+	svc, err := escalation.NewService(s.townRoot)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return svc, nil
+}
+
+func (s *EscalationServer) ListEscalations(
+	ctx context.Context,
+	req *connect.Request[gastownv1.ListEscalationsRequest],
+) (*connect.Response[gastownv1.ListEscalationsResponse], error) {
+	if req.Msg.Severity != "" && !config.IsValidSeverity(req.Msg.Severity) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid severity %q", req.Msg.Severity))
+	}
+	svc, err := s.service()
+	if err != nil {
+		return nil, err
+	}
+	list, err := svc.List(req.Msg.IncludeClosed)
+	if err != nil {
+		return nil, classifyErr("listing escalations", err)
+	}
+
+	now := time.Now()
+	var out []*gastownv1.Escalation
+	for _, e := range list {
+		if req.Msg.Severity != "" && e.Severity != req.Msg.Severity {
+			continue
+		}
+		if req.Msg.AssignedTo != "" && e.AssignedTo != req.Msg.AssignedTo {
+			continue
+		}
+		if req.Msg.BreachedOnly && !e.Breached(now) {
+			continue
+		}
+		out = append(out, escalationToProto(e, now))
+	}
+
+	return connect.NewResponse(&gastownv1.ListEscalationsResponse{
+		Escalations: out,
+	}), nil
+}
+
+func (s *EscalationServer) GetEscalation(
+	ctx context.Context,
+	req *connect.Request[gastownv1.GetEscalationRequest],
+) (*connect.Response[gastownv1.GetEscalationResponse], error) {
+	if req.Msg.EscalationId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("escalation_id is required"))
+	}
+	svc, err := s.service()
+	if err != nil {
+		return nil, err
+	}
+	e, err := svc.Get(req.Msg.EscalationId)
+	if err != nil {
+		return nil, notFoundOrInternal("getting escalation "+req.Msg.EscalationId, err)
+	}
+	return connect.NewResponse(&gastownv1.GetEscalationResponse{
+		Escalation: escalationToProto(e, time.Now()),
+	}), nil
+}
+
+func (s *EscalationServer) AckEscalation(
+	ctx context.Context,
+	req *connect.Request[gastownv1.AckEscalationRequest],
+) (*connect.Response[gastownv1.AckEscalationResponse], error) {
+	if req.Msg.EscalationId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("escalation_id is required"))
+	}
+	svc, err := s.service()
+	if err != nil {
+		return nil, err
+	}
+	by := req.Msg.AckedBy
+	if by == "" {
+		by = "rpc"
+	}
+	if err := svc.Ack(req.Msg.EscalationId, by); err != nil {
+		return nil, escalationErr("acknowledging escalation "+req.Msg.EscalationId, err)
+	}
+	e, err := svc.Get(req.Msg.EscalationId)
+	if err != nil {
+		return nil, notFoundOrInternal("getting escalation "+req.Msg.EscalationId, err)
+	}
+	return connect.NewResponse(&gastownv1.AckEscalationResponse{
+		Escalation: escalationToProto(e, time.Now()),
+	}), nil
+}
+
+func (s *EscalationServer) AssignEscalation(
+	ctx context.Context,
+	req *connect.Request[gastownv1.AssignEscalationRequest],
+) (*connect.Response[gastownv1.AssignEscalationResponse], error) {
+	if req.Msg.EscalationId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("escalation_id is required"))
+	}
+	if req.Msg.Assignee == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("assignee is required"))
+	}
+	svc, err := s.service()
+	if err != nil {
+		return nil, err
+	}
+	by := req.Msg.AssignedBy
+	if by == "" {
+		by = "rpc"
+	}
+	if err := svc.Assign(req.Msg.EscalationId, req.Msg.Assignee, by); err != nil {
+		return nil, escalationErr("assigning escalation "+req.Msg.EscalationId, err)
+	}
+	e, err := svc.Get(req.Msg.EscalationId)
+	if err != nil {
+		return nil, notFoundOrInternal("getting escalation "+req.Msg.EscalationId, err)
+	}
+	return connect.NewResponse(&gastownv1.AssignEscalationResponse{
+		Escalation: escalationToProto(e, time.Now()),
+	}), nil
+}
+
+func (s *EscalationServer) ResolveEscalation(
+	ctx context.Context,
+	req *connect.Request[gastownv1.ResolveEscalationRequest],
+) (*connect.Response[gastownv1.ResolveEscalationResponse], error) {
+	if req.Msg.EscalationId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("escalation_id is required"))
+	}
+	if req.Msg.Reason == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("reason is required"))
+	}
+	svc, err := s.service()
+	if err != nil {
+		return nil, err
+	}
+	by := req.Msg.ResolvedBy
+	if by == "" {
+		by = "rpc"
+	}
+	if err := svc.Resolve(req.Msg.EscalationId, by, req.Msg.Reason); err != nil {
+		return nil, escalationErr("resolving escalation "+req.Msg.EscalationId, err)
+	}
+	return connect.NewResponse(&gastownv1.ResolveEscalationResponse{
+		EscalationId: req.Msg.EscalationId,
+	}), nil
+}
+
+// escalationErr maps transitions on closed escalations to FailedPrecondition.
+func escalationErr(operation string, err error) *connect.Error {
+	if errors.Is(err, beads.ErrEscalationClosed) {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s: %w", operation, err))
+	}
+	return notFoundOrInternal(operation, err)
+}
+
+func escalationToProto(e *escalation.Escalation, now time.Time) *gastownv1.Escalation {
+	out := &gastownv1.Escalation{
+		Id:                e.ID,
+		Title:             e.Title,
+		State:             escalationStateToProto(e.State),
+		Severity:          e.Severity,
+		Reason:            e.Reason,
+		Source:            e.Source,
+		EscalatedBy:       e.EscalatedBy,
+		AckedBy:           e.AckedBy,
+		AssignedTo:        e.AssignedTo,
+		ClosedBy:          e.ClosedBy,
+		ClosedReason:      e.ClosedReason,
+		RelatedBead:       e.RelatedBead,
+		ReescalationCount: int32(e.ReescalationCount),
+		SlaBreached:       e.Breached(now),
+	}
+	for _, ts := range []struct {
+		t   time.Time
+		dst **timestamppb.Timestamp
+	}{
+		{e.CreatedAt, &out.CreatedAt},
+		{e.AckedAt, &out.AckedAt},
+		{e.AssignedAt, &out.AssignedAt},
+		{e.SLADeadline, &out.SlaDeadline},
+	} {
+		if !ts.t.IsZero() {
+			*ts.dst = timestamppb.New(ts.t)
+		}
+	}
+	return out
+}
+
+func escalationStateToProto(state string) gastownv1.EscalationState {
+	switch state {
+	case beads.EscalationOpen:
+		return gastownv1.EscalationState_ESCALATION_STATE_OPEN
+	case beads.EscalationAcked:
+		return gastownv1.EscalationState_ESCALATION_STATE_ACKED
+	case beads.EscalationAssigned:
+		return gastownv1.EscalationState_ESCALATION_STATE_ASSIGNED
+	case beads.EscalationClosed:
+		return gastownv1.EscalationState_ESCALATION_STATE_CLOSED
+	default:
+		return gastownv1.EscalationState_ESCALATION_STATE_UNSPECIFIED
+	}
+}
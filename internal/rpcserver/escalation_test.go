@@ -0,0 +1,54 @@
+package rpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/internal/escalation"
+)
+
+func TestEscalationToProto(t *testing.T) {
+	now := time.Now()
+	e := &escalation.Escalation{
+		ID:          "hq-esc1",
+		State:       "open",
+		Severity:    "high",
+		CreatedAt:   now.Add(-2 * time.Hour),
+		SLADeadline: now.Add(-time.Hour),
+	}
+	got := escalationToProto(e, now)
+	if got.State != gastownv1.EscalationState_ESCALATION_STATE_OPEN {
+		t.Errorf("State = %v, want OPEN", got.State)
+	}
+	if !got.SlaBreached || got.SlaDeadline == nil {
+		t.Errorf("SlaBreached = %v, SlaDeadline = %v, want breached with deadline", got.SlaBreached, got.SlaDeadline)
+	}
+	if got.AckedAt != nil {
+		t.Errorf("AckedAt = %v, want unset", got.AckedAt)
+	}
+	if escalationStateToProto("bogus") != gastownv1.EscalationState_ESCALATION_STATE_UNSPECIFIED {
+		t.Error("unknown state should map to UNSPECIFIED")
+	}
+}
+
+func TestEscalationRequestValidation(t *testing.T) {
+	srv := NewEscalationServer(t.TempDir())
+	ctx := context.Background()
+
+	checks := map[string]error{}
+	_, checks["list bad severity"] = srv.ListEscalations(ctx, connect.NewRequest(&gastownv1.ListEscalationsRequest{Severity: "urgent"}))
+	_, checks["get no id"] = srv.GetEscalation(ctx, connect.NewRequest(&gastownv1.GetEscalationRequest{}))
+	_, checks["ack no id"] = srv.AckEscalation(ctx, connect.NewRequest(&gastownv1.AckEscalationRequest{}))
+	_, checks["assign no assignee"] = srv.AssignEscalation(ctx, connect.NewRequest(&gastownv1.AssignEscalationRequest{EscalationId: "hq-esc1"}))
+	_, checks["resolve no reason"] = srv.ResolveEscalation(ctx, connect.NewRequest(&gastownv1.ResolveEscalationRequest{EscalationId: "hq-esc1"}))
+
+	for name, err := range checks {
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("%s: error = %v, want InvalidArgument", name, err)
+		}
+	}
+}
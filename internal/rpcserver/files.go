@@ -0,0 +1,259 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+
+	"github.com/steveyegge/gastown/internal/connection"
+	"github.com/steveyegge/gastown/internal/terminal"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// agentFileRoots are the pod directories that ListAgentFiles and
+// FetchAgentFile may read. The first entry is the default listing root.
+var agentFileRoots = []string{"/home/agent/gt", "/tmp"}
+
+const (
+	// maxAgentFileSize is the largest file FetchAgentFile will transfer.
+	maxAgentFileSize = 64 << 20
+
+	// agentFileChunkSize is the payload size of each streamed chunk.
+	agentFileChunkSize = 64 << 10
+)
+
+// PodConnector opens a connection to the pod running an agent.
+type PodConnector func(agent string) (connection.Connection, error)
+
+// SetPodConnector overrides how the server reaches agent pods for file
+// access. Tests use this to substitute a fake connection.
+func (s *AgentServer) SetPodConnector(fn PodConnector) {
+	s.podConnector = fn
+}
+
+// defaultPodConnector resolves the agent's pod from its bead metadata and
+// execs into the agent container.
+func defaultPodConnector(agent string) (connection.Connection, error) {
+	info, err := terminal.ResolveAgentPodInfo(agent)
+	if err != nil {
+		return nil, err
+	}
+	namespace := info.Namespace
+	if namespace == "" {
+		namespace = os.Getenv("NAMESPACE")
+	}
+	return connection.NewK8sConnection(connection.K8sConnectionConfig{
+		PodName:   info.PodName,
+		Namespace: namespace,
+		Container: "agent",
+	}), nil
+}
+
+func (s *AgentServer) podConn(agent string) (connection.Connection, error) {
+	open := s.podConnector
+	if open == nil {
+		open = defaultPodConnector
+	}
+	conn, err := open(agent)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("resolving pod for %s: %w", agent, err))
+	}
+	return conn, nil
+}
+
+// allowedAgentPath cleans p and checks that it falls under agentFileRoots.
+// An empty path selects the workspace root.
+func allowedAgentPath(p string) (string, error) {
+	if p == "" {
+		return agentFileRoots[0], nil
+	}
+	if !path.IsAbs(p) {
+		return "", fmt.Errorf("path must be absolute: %s", p)
+	}
+	clean := path.Clean(p)
+	for _, root := range agentFileRoots {
+		if clean == root || strings.HasPrefix(clean, root+"/") {
+			return clean, nil
+		}
+	}
+	return "", fmt.Errorf("path %s is outside the allowed roots (%s)", clean, strings.Join(agentFileRoots, ", "))
+}
+
+// resolveAgentPath validates p, then resolves symlinks inside the pod and
+// validates the target again so links can't escape the allowed roots.
+func resolveAgentPath(conn connection.Connection, p string) (string, error) {
+	clean, err := allowedAgentPath(p)
+	if err != nil {
+		return "", connect.NewError(connect.CodePermissionDenied, err)
+	}
+	out, err := conn.Exec("readlink", "-f", clean)
+	if err != nil {
+		return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("resolving %s: %w", clean, err))
+	}
+	real, err := allowedAgentPath(strings.TrimSpace(string(out)))
+	if err != nil {
+		return "", connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return real, nil
+}
+
+// ListAgentFiles lists a directory inside an agent's pod.
+func (s *AgentServer) ListAgentFiles(
+	ctx context.Context,
+	req *connect.Request[gastownv1.ListAgentFilesRequest],
+) (*connect.Response[gastownv1.ListAgentFilesResponse], error) {
+	if req.Msg.Agent == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("agent address is required"))
+	}
+	conn, err := s.podConn(req.Msg.Agent)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := resolveAgentPath(conn, req.Msg.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := conn.Exec("find", dir, "-mindepth", "1", "-maxdepth", "1",
+		"-printf", `%f\t%s\t%m\t%T@\t%y\n`)
+	if err != nil {
+		return nil, unavailableErr(fmt.Sprintf("listing %s", dir), err, 2)
+	}
+	files, err := parseFindListing(dir, string(out))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&gastownv1.ListAgentFilesResponse{
+		Path:  dir,
+		Files: files,
+	}), nil
+}
+
+// parseFindListing parses find -printf '%f\t%s\t%m\t%T@\t%y\n' output.
+func parseFindListing(dir, output string) ([]*gastownv1.AgentFileInfo, error) {
+	var files []*gastownv1.AgentFileInfo
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("unexpected listing line: %q", line)
+		}
+		size, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing size: %w", err)
+		}
+		mode, err := strconv.ParseUint(fields[2], 8, 32)
+		if err != nil {
+			return nil, fmt.Errorf("parsing mode: %w", err)
+		}
+		mtime, err := strconv.ParseFloat(fields[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing mtime: %w", err)
+		}
+		sec := int64(mtime)
+		files = append(files, &gastownv1.AgentFileInfo{
+			Name:     fields[0],
+			Path:     path.Join(dir, fields[0]),
+			Size:     size,
+			Mode:     uint32(mode),
+			Modified: timestamppb.New(time.Unix(sec, int64((mtime-float64(sec))*1e9))),
+			IsDir:    fields[4] == "d",
+		})
+	}
+	return files, nil
+}
+
+// FetchAgentFile streams a file out of an agent's pod.
+func (s *AgentServer) FetchAgentFile(
+	ctx context.Context,
+	req *connect.Request[gastownv1.FetchAgentFileRequest],
+	stream *connect.ServerStream[gastownv1.AgentFileChunk],
+) error {
+	if req.Msg.Agent == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("agent address is required"))
+	}
+	if req.Msg.Path == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path is required"))
+	}
+	conn, err := s.podConn(req.Msg.Agent)
+	if err != nil {
+		return err
+	}
+	p, err := resolveAgentPath(conn, req.Msg.Path)
+	if err != nil {
+		return err
+	}
+
+	fi, err := conn.Stat(p)
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("stat %s: %w", p, err))
+	}
+	if fi.IsDir() {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s is a directory", p))
+	}
+	if fi.Size() > maxAgentFileSize {
+		return connect.NewError(connect.CodeResourceExhausted,
+			fmt.Errorf("%s is %d bytes, exceeds limit of %d", p, fi.Size(), maxAgentFileSize))
+	}
+
+	rc, err := conn.OpenFile(p)
+	if err != nil {
+		return unavailableErr(fmt.Sprintf("reading %s", p), err, 2)
+	}
+	defer func() { _ = rc.Close() }()
+
+	info := &gastownv1.AgentFileInfo{
+		Name:     path.Base(p),
+		Path:     p,
+		Size:     fi.Size(),
+		Mode:     uint32(fi.Mode().Perm()),
+		Modified: timestamppb.New(fi.ModTime()),
+	}
+	buf := make([]byte, agentFileChunkSize)
+	var offset int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		n, readErr := io.ReadFull(rc, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return unavailableErr(fmt.Sprintf("reading %s", p), readErr, 2)
+		}
+		if offset+int64(n) > maxAgentFileSize {
+			// File grew between stat and read.
+			return connect.NewError(connect.CodeResourceExhausted,
+				fmt.Errorf("%s exceeds limit of %d bytes", p, maxAgentFileSize))
+		}
+		// The first chunk always goes out, carrying the file info even
+		// for an empty file.
+		if n > 0 || offset == 0 {
+			chunk := &gastownv1.AgentFileChunk{
+				Data:   buf[:n],
+				Offset: offset,
+			}
+			if offset == 0 {
+				chunk.Info = info
+			}
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+		}
+		offset += int64(n)
+		if readErr != nil {
+			return nil
+		}
+	}
+}
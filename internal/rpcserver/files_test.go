@@ -0,0 +1,210 @@
+package rpcserver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+
+	"github.com/steveyegge/gastown/internal/connection"
+)
+
+// fakePodConn serves canned file contents and symlink targets.
+// Unimplemented methods panic via the nil embedded interface.
+type fakePodConn struct {
+	connection.Connection
+
+	files map[string][]byte
+	links map[string]string // path -> readlink -f result
+
+	closed bool // Set once an opened file is closed
+}
+
+func (c *fakePodConn) Exec(cmd string, args ...string) ([]byte, error) {
+	if cmd == "readlink" {
+		p := args[len(args)-1]
+		if target, ok := c.links[p]; ok {
+			return []byte(target + "\n"), nil
+		}
+		return []byte(p + "\n"), nil
+	}
+	return nil, errors.New("unexpected exec: " + cmd)
+}
+
+func (c *fakePodConn) Stat(path string) (connection.FileInfo, error) {
+	data, ok := c.files[path]
+	if !ok {
+		return nil, &connection.NotFoundError{Path: path}
+	}
+	return connection.BasicFileInfo{FileName: path, FileSize: int64(len(data)), FileMode: 0644, FileModTime: time.Unix(1700000000, 0)}, nil
+}
+
+func (c *fakePodConn) OpenFile(path string) (io.ReadCloser, error) {
+	return &trackedReader{Reader: bytes.NewReader(c.files[path]), closed: &c.closed}, nil
+}
+
+// trackedReader records when the server closes the file it streamed.
+type trackedReader struct {
+	io.Reader
+	closed *bool
+}
+
+func (r *trackedReader) Close() error {
+	*r.closed = true
+	return nil
+}
+
+func TestAllowedAgentPath(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"", "/home/agent/gt", false},
+		{"/home/agent/gt/gastown/polecats/nux/build.log", "/home/agent/gt/gastown/polecats/nux/build.log", false},
+		{"/tmp/out.diff", "/tmp/out.diff", false},
+		{"/home/agent/gt/../.ssh/id_rsa", "", true},
+		{"/home/agent/gtx/file", "", true},
+		{"/etc/passwd", "", true},
+		{"relative/path", "", true},
+	}
+	for _, tt := range tests {
+		got, err := allowedAgentPath(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("allowedAgentPath(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("allowedAgentPath(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseFindListing(t *testing.T) {
+	out := "build.log\t1234\t644\t1700000000.5000000000\tf\nsrc\t4096\t755\t1700000001.0000000000\td\n"
+	files, err := parseFindListing("/home/agent/gt", out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("got %d files, want 2", len(files))
+	}
+	if f := files[0]; f.Path != "/home/agent/gt/build.log" || f.Size != 1234 || f.Mode != 0644 || f.IsDir {
+		t.Errorf("unexpected first entry: %+v", f)
+	}
+	if !files[1].IsDir {
+		t.Error("expected src to be a directory")
+	}
+
+	if _, err := parseFindListing("/tmp", "garbage\n"); err == nil {
+		t.Error("expected error for malformed line")
+	}
+}
+
+func newFileTestClient(t *testing.T, conn connection.Connection) gastownv1connect.AgentServiceClient {
+	t.Helper()
+	srv := NewAgentServerWithBackend(t.TempDir(), newFakeBackend())
+	srv.SetPodConnector(func(string) (connection.Connection, error) { return conn, nil })
+	mux := http.NewServeMux()
+	mux.Handle(gastownv1connect.NewAgentServiceHandler(srv))
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return gastownv1connect.NewAgentServiceClient(ts.Client(), ts.URL)
+}
+
+func TestFetchAgentFile_StreamsChunks(t *testing.T) {
+	data := bytes.Repeat([]byte("x"), agentFileChunkSize*2+10)
+	conn := &fakePodConn{files: map[string][]byte{"/home/agent/gt/artifact.bin": data}}
+	client := newFileTestClient(t, conn)
+
+	stream, err := client.FetchAgentFile(context.Background(), connect.NewRequest(&gastownv1.FetchAgentFileRequest{
+		Agent: "gastown/polecats/nux",
+		Path:  "/home/agent/gt/artifact.bin",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []byte
+	chunks := 0
+	for stream.Receive() {
+		msg := stream.Msg()
+		if chunks == 0 && (msg.Info == nil || msg.Info.Size != int64(len(data))) {
+			t.Errorf("first chunk info = %+v", msg.Info)
+		}
+		if msg.Offset != int64(len(got)) {
+			t.Errorf("chunk offset = %d, want %d", msg.Offset, len(got))
+		}
+		got = append(got, msg.Data...)
+		chunks++
+	}
+	if err := stream.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if chunks != 3 || !bytes.Equal(got, data) {
+		t.Errorf("received %d chunks, %d bytes; want 3 chunks, %d bytes", chunks, len(got), len(data))
+	}
+	if !conn.closed {
+		t.Error("file reader was not closed")
+	}
+}
+
+func TestFetchAgentFile_EmptyFile(t *testing.T) {
+	conn := &fakePodConn{files: map[string][]byte{"/tmp/empty.log": {}}}
+	client := newFileTestClient(t, conn)
+
+	stream, err := client.FetchAgentFile(context.Background(), connect.NewRequest(&gastownv1.FetchAgentFileRequest{
+		Agent: "gastown/polecats/nux",
+		Path:  "/tmp/empty.log",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	chunks := 0
+	for stream.Receive() {
+		if stream.Msg().Info == nil || len(stream.Msg().Data) != 0 {
+			t.Errorf("chunk = %+v, want only file info", stream.Msg())
+		}
+		chunks++
+	}
+	if err := stream.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if chunks != 1 {
+		t.Errorf("received %d chunks, want 1", chunks)
+	}
+}
+
+func TestFetchAgentFile_RejectsSymlinkEscape(t *testing.T) {
+	conn := &fakePodConn{
+		files: map[string][]byte{"/etc/shadow": []byte("secret")},
+		links: map[string]string{"/home/agent/gt/link": "/etc/shadow"},
+	}
+	client := newFileTestClient(t, conn)
+
+	stream, err := client.FetchAgentFile(context.Background(), connect.NewRequest(&gastownv1.FetchAgentFileRequest{
+		Agent: "gastown/polecats/nux",
+		Path:  "/home/agent/gt/link",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for stream.Receive() {
+		t.Fatal("expected no data for escaping symlink")
+	}
+	if connect.CodeOf(stream.Err()) != connect.CodePermissionDenied {
+		t.Errorf("error = %v, want permission_denied", stream.Err())
+	}
+	if !strings.Contains(stream.Err().Error(), "outside the allowed roots") {
+		t.Errorf("unexpected error message: %v", stream.Err())
+	}
+}
@@ -0,0 +1,329 @@
+package rpcserver
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/internal/bdcmd"
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// healthCheckTimeout bounds each component check, so one hung dependency
+// reports as unhealthy instead of stalling the probe.
+const healthCheckTimeout = 3 * time.Second
+
+// defaultBDDaemonPort is the beads daemon's TCP port when BD_DAEMON_HOST
+// names only a host.
+const defaultBDDaemonPort = "9876"
+
+// processStart is when this process started serving, for liveness.
+var processStart = time.Now()
+
+// healthCheck is one component check. Required checks gate readiness.
+type healthCheck struct {
+	name     string
+	required bool
+	run      func(ctx context.Context) *gastownv1.ComponentHealth
+}
+
+// healthChecks returns the checks a probe covers, in report order.
+func (s *StatusServer) healthChecks(probe gastownv1.HealthProbe) []healthCheck {
+	live := healthCheck{"process", true, func(context.Context) *gastownv1.ComponentHealth { return checkProcess() }}
+	ready := []healthCheck{
+		{"bd-daemon", true, func(ctx context.Context) *gastownv1.ComponentHealth { return checkBDDaemon(ctx, bdcmd.DaemonHost()) }},
+		{"beads", true, func(context.Context) *gastownv1.ComponentHealth { return s.checkBeads() }},
+		{"routing", true, func(context.Context) *gastownv1.ComponentHealth { return s.checkRouting() }},
+		{"terminal", true, func(ctx context.Context) *gastownv1.ComponentHealth { return s.checkTerminal(ctx) }},
+		{"nats", true, func(ctx context.Context) *gastownv1.ComponentHealth { return checkNATS(ctx, natsURL()) }},
+	}
+
+	switch probe {
+	case gastownv1.HealthProbe_HEALTH_PROBE_LIVENESS:
+		return []healthCheck{live}
+	case gastownv1.HealthProbe_HEALTH_PROBE_READINESS:
+		return ready
+	}
+	// Everything: the gt daemon and dolt are reported but don't gate
+	// readiness, since the RPC server can serve without them.
+	all := append([]healthCheck{live}, ready...)
+	return append(all,
+		healthCheck{"daemon", false, func(context.Context) *gastownv1.ComponentHealth { return s.checkDaemon() }},
+		healthCheck{"dolt", false, func(context.Context) *gastownv1.ComponentHealth { return s.checkDolt() }},
+	)
+}
+
+// runHealthChecks runs checks concurrently, each bounded by timeout, and
+// returns their results in the order given.
+func runHealthChecks(ctx context.Context, checks []healthCheck, timeout time.Duration) []*gastownv1.ComponentHealth {
+	results := make([]*gastownv1.ComponentHealth, len(checks))
+	done := make(chan int, len(checks))
+	for i, c := range checks {
+		go func() {
+			cctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			start := time.Now()
+			ch := make(chan *gastownv1.ComponentHealth, 1)
+			go func() { ch <- c.run(cctx) }()
+			var r *gastownv1.ComponentHealth
+			select {
+			case r = <-ch:
+			case <-cctx.Done():
+				r = &gastownv1.ComponentHealth{
+					Healthy:   false,
+					LatencyMs: time.Since(start).Milliseconds(),
+					Message:   fmt.Sprintf("timed out after %v", timeout),
+				}
+			}
+			r.Name = c.name
+			r.Required = c.required
+			results[i] = r
+			done <- i
+		}()
+	}
+	for range checks {
+		<-done
+	}
+	return results
+}
+
+// healthStatus summarizes component results. Readiness is binary: any
+// required component down makes it "unhealthy". The full check is
+// "degraded" when anything is down.
+func healthStatus(probe gastownv1.HealthProbe, components []*gastownv1.ComponentHealth) string {
+	for _, c := range components {
+		if c.Healthy {
+			continue
+		}
+		if probe == gastownv1.HealthProbe_HEALTH_PROBE_UNSPECIFIED {
+			return "degraded"
+		}
+		if c.Required {
+			return "unhealthy"
+		}
+	}
+	return "healthy"
+}
+
+// checkProcess reports that the process is up and serving. If this handler
+// runs at all, the server is alive.
+func checkProcess() *gastownv1.ComponentHealth {
+	return &gastownv1.ComponentHealth{
+		Name:    "process",
+		Healthy: true,
+		Message: fmt.Sprintf("PID %d, up %s, %d goroutines",
+			os.Getpid(), time.Since(processStart).Round(time.Second), runtime.NumGoroutine()),
+	}
+}
+
+// checkBDDaemon checks that the beads daemon bd commands use accepts
+// connections. Without a daemon host, bd uses its local database and the
+// beads check covers it.
+func checkBDDaemon(ctx context.Context, host string) *gastownv1.ComponentHealth {
+	if host == "" {
+		return &gastownv1.ComponentHealth{Name: "bd-daemon", Healthy: true, Message: "local mode (BD_DAEMON_HOST not set)"}
+	}
+	addr, err := dialAddr(host, defaultBDDaemonPort)
+	if err != nil {
+		return &gastownv1.ComponentHealth{Name: "bd-daemon", Message: fmt.Sprintf("bad daemon host %q: %v", host, err)}
+	}
+	start := time.Now()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	latency := time.Since(start).Milliseconds()
+	if err != nil {
+		return &gastownv1.ComponentHealth{Name: "bd-daemon", LatencyMs: latency, Message: fmt.Sprintf("unreachable: %v", err)}
+	}
+	_ = conn.Close()
+	return &gastownv1.ComponentHealth{Name: "bd-daemon", Healthy: true, LatencyMs: latency, Message: "reachable at " + addr}
+}
+
+// checkRouting checks that the town's prefix routes load, include the
+// town's own hq- route, and map each prefix to one place.
+func (s *StatusServer) checkRouting() *gastownv1.ComponentHealth {
+	start := time.Now()
+	routes, err := beads.LoadRoutes(beads.GetTownBeadsPath(s.townRoot))
+	latency := time.Since(start).Milliseconds()
+	if err != nil {
+		return &gastownv1.ComponentHealth{Name: "routing", LatencyMs: latency, Message: fmt.Sprintf("loading routes: %v", err)}
+	}
+	if msg := routingProblem(routes); msg != "" {
+		return &gastownv1.ComponentHealth{Name: "routing", LatencyMs: latency, Message: msg}
+	}
+	return &gastownv1.ComponentHealth{Name: "routing", Healthy: true, LatencyMs: latency, Message: fmt.Sprintf("%d routes", len(routes))}
+}
+
+// routingProblem describes what is wrong with a route table, or returns ""
+// if it is intact.
+func routingProblem(routes []beads.Route) string {
+	paths := make(map[string][]string)
+	for _, r := range routes {
+		paths[r.Prefix] = append(paths[r.Prefix], r.Path)
+	}
+	if _, ok := paths["hq-"]; !ok {
+		return "no hq- route for town beads"
+	}
+	var conflicts []string
+	for prefix, p := range paths {
+		if len(p) > 1 {
+			conflicts = append(conflicts, fmt.Sprintf("%s -> %s", prefix, strings.Join(p, ", ")))
+		}
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return "conflicting routes: " + strings.Join(conflicts, "; ")
+	}
+	return ""
+}
+
+// checkTerminal checks the terminal session backend. In a pod, sessions
+// are reached through Coop agents the K8s API knows about, so the API
+// server must be reachable; elsewhere Coop sessions are addressed directly.
+func (s *StatusServer) checkTerminal(ctx context.Context) *gastownv1.ComponentHealth {
+	host := os.Getenv("KUBERNETES_SERVICE_HOST")
+	if host == "" {
+		return &gastownv1.ComponentHealth{Name: "terminal", Healthy: true, Message: "coop backend"}
+	}
+	port := os.Getenv("KUBERNETES_SERVICE_PORT")
+	if port == "" {
+		port = "443"
+	}
+	addr := net.JoinHostPort(host, port)
+	start := time.Now()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	latency := time.Since(start).Milliseconds()
+	if err != nil {
+		return &gastownv1.ComponentHealth{Name: "terminal", LatencyMs: latency, Message: fmt.Sprintf("coop backend (K8s mode): API server unreachable: %v", err)}
+	}
+	_ = conn.Close()
+	return &gastownv1.ComponentHealth{Name: "terminal", Healthy: true, LatencyMs: latency, Message: "coop backend (K8s mode)"}
+}
+
+// natsURL returns the NATS server the town's event bus runs on, if any.
+func natsURL() string {
+	if u := os.Getenv("BD_NATS_URL"); u != "" {
+		return u
+	}
+	return os.Getenv("NATS_URL")
+}
+
+// checkNATS checks that the NATS server accepts connections and greets
+// with its INFO line. Without a configured URL, events go only through bd
+// and there is nothing to check.
+func checkNATS(ctx context.Context, rawURL string) *gastownv1.ComponentHealth {
+	if rawURL == "" {
+		return &gastownv1.ComponentHealth{Name: "nats", Healthy: true, Message: "not configured"}
+	}
+	addr, err := dialAddr(rawURL, "4222")
+	if err != nil {
+		return &gastownv1.ComponentHealth{Name: "nats", Message: fmt.Sprintf("bad NATS URL %q: %v", rawURL, err)}
+	}
+	start := time.Now()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return &gastownv1.ComponentHealth{Name: "nats", LatencyMs: time.Since(start).Milliseconds(), Message: fmt.Sprintf("unreachable: %v", err)}
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetReadDeadline(deadline)
+	}
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	latency := time.Since(start).Milliseconds()
+	if err != nil || !strings.HasPrefix(line, "INFO ") {
+		return &gastownv1.ComponentHealth{Name: "nats", LatencyMs: latency, Message: fmt.Sprintf("no INFO from %s (not a NATS server?)", addr)}
+	}
+	var info struct {
+		Version string `json:"version"`
+	}
+	_ = json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, "INFO "))), &info)
+	msg := "connected to " + addr
+	if info.Version != "" {
+		msg += " (nats-server " + info.Version + ")"
+	}
+	return &gastownv1.ComponentHealth{Name: "nats", Healthy: true, LatencyMs: latency, Message: msg}
+}
+
+// dialAddr turns "host", "host:port", or "scheme://host[:port]" into a
+// host:port to dial. Bare hosts get defaultPort; URLs without a port get
+// their scheme's port, if it has a well-known one.
+func dialAddr(raw, defaultPort string) (string, error) {
+	if !strings.Contains(raw, "://") {
+		if _, _, err := net.SplitHostPort(raw); err == nil {
+			return raw, nil
+		}
+		return net.JoinHostPort(raw, defaultPort), nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("no host")
+	}
+	port := u.Port()
+	if port == "" {
+		switch u.Scheme {
+		case "http", "ws":
+			port = "80"
+		case "https", "wss":
+			port = "443"
+		default:
+			port = defaultPort
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port), nil
+}
+
+// newHealthHandler serves a probe as JSON, with 503 unless healthy. While
+// the server drains, readiness and the full check report "draining";
+// liveness stays up so the process isn't restarted mid-drain.
+func newHealthHandler(status *StatusServer, drainer *Drainer, probe gastownv1.HealthProbe) http.HandlerFunc {
+	type componentJSON struct {
+		Name      string `json:"name"`
+		Healthy   bool   `json:"healthy"`
+		Required  bool   `json:"required"`
+		LatencyMs int64  `json:"latency_ms"`
+		Message   string `json:"message"`
+	}
+	type healthJSON struct {
+		Status     string          `json:"status"`
+		LatencyMs  int64           `json:"latency_ms"`
+		Components []componentJSON `json:"components"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, _ := status.HealthCheck(r.Context(), connect.NewRequest(&gastownv1.HealthCheckRequest{Probe: probe}))
+		hc := resp.Msg
+
+		out := healthJSON{Status: hc.Status, LatencyMs: hc.LatencyMs}
+		if probe != gastownv1.HealthProbe_HEALTH_PROBE_LIVENESS && !drainer.Ready() {
+			out.Status = "draining"
+		}
+		for _, c := range hc.Components {
+			out.Components = append(out.Components, componentJSON{
+				Name:      c.Name,
+				Healthy:   c.Healthy,
+				Required:  c.Required,
+				LatencyMs: c.LatencyMs,
+				Message:   c.Message,
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if out.Status != "healthy" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(out)
+	}
+}
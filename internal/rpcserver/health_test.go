@@ -0,0 +1,190 @@
+package rpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestRoutingProblem(t *testing.T) {
+	tests := []struct {
+		name   string
+		routes []beads.Route
+		want   string
+	}{
+		{"intact", []beads.Route{{Prefix: "hq-", Path: "."}, {Prefix: "gt-", Path: "gastown/mayor/rig"}}, ""},
+		{"empty", nil, "no hq- route"},
+		{"conflict", []beads.Route{{Prefix: "hq-", Path: "."}, {Prefix: "gt-", Path: "a"}, {Prefix: "gt-", Path: "b"}}, "gt- -> a, b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := routingProblem(tt.routes)
+			if (tt.want == "") != (got == "") || !strings.Contains(got, tt.want) {
+				t.Errorf("routingProblem = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDialAddr(t *testing.T) {
+	tests := []struct{ raw, want string }{
+		{"gastown-bd-daemon", "gastown-bd-daemon:9876"},
+		{"localhost:9000", "localhost:9000"},
+		{"http://localhost:9080", "localhost:9080"},
+		{"https://daemon.example.com", "daemon.example.com:443"},
+		{"nats://nats.svc", "nats.svc:9876"},
+	}
+	for _, tt := range tests {
+		got, err := dialAddr(tt.raw, defaultBDDaemonPort)
+		if err != nil || got != tt.want {
+			t.Errorf("dialAddr(%q) = %q, %v; want %q", tt.raw, got, err, tt.want)
+		}
+	}
+	if _, err := dialAddr("http://", "1"); err == nil {
+		t.Error("dialAddr without a host succeeded")
+	}
+}
+
+// listen starts a TCP listener that greets each connection with greeting.
+func listen(t *testing.T, greeting string) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_, _ = conn.Write([]byte(greeting))
+			conn.Close()
+		}
+	}()
+	return ln.Addr().String()
+}
+
+// closedAddr returns an address nothing is listening on.
+func closedAddr(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+	return addr
+}
+
+func TestCheckNATS(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if c := checkNATS(ctx, ""); !c.Healthy || c.Message != "not configured" {
+		t.Errorf("unconfigured: %+v", c)
+	}
+
+	addr := listen(t, `INFO {"server_id":"x","version":"2.10.7"}`+"\r\n")
+	c := checkNATS(ctx, "nats://"+addr)
+	if !c.Healthy || !strings.Contains(c.Message, "2.10.7") {
+		t.Errorf("nats server: %+v", c)
+	}
+
+	if c := checkNATS(ctx, "nats://"+listen(t, "HTTP/1.1 400 Bad Request\r\n")); c.Healthy {
+		t.Errorf("non-NATS server reported healthy: %+v", c)
+	}
+	if c := checkNATS(ctx, "nats://"+closedAddr(t)); c.Healthy {
+		t.Errorf("closed port reported healthy: %+v", c)
+	}
+}
+
+func TestCheckBDDaemon(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if c := checkBDDaemon(ctx, ""); !c.Healthy || !strings.Contains(c.Message, "local mode") {
+		t.Errorf("local mode: %+v", c)
+	}
+	if c := checkBDDaemon(ctx, "http://"+listen(t, "")); !c.Healthy {
+		t.Errorf("listening daemon: %+v", c)
+	}
+	if c := checkBDDaemon(ctx, closedAddr(t)); c.Healthy {
+		t.Errorf("closed port reported healthy: %+v", c)
+	}
+}
+
+func TestRunHealthChecksTimesOut(t *testing.T) {
+	checks := []healthCheck{
+		{"fast", true, func(context.Context) *gastownv1.ComponentHealth {
+			return &gastownv1.ComponentHealth{Healthy: true, Message: "ok"}
+		}},
+		{"hung", false, func(context.Context) *gastownv1.ComponentHealth {
+			time.Sleep(time.Second)
+			return &gastownv1.ComponentHealth{Healthy: true}
+		}},
+	}
+
+	start := time.Now()
+	got := runHealthChecks(context.Background(), checks, 20*time.Millisecond)
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("runHealthChecks took %v, want bounded by the timeout", elapsed)
+	}
+	if got[0].Name != "fast" || !got[0].Healthy || !got[0].Required {
+		t.Errorf("fast = %+v", got[0])
+	}
+	if got[1].Name != "hung" || got[1].Healthy || !strings.Contains(got[1].Message, "timed out") {
+		t.Errorf("hung = %+v", got[1])
+	}
+
+	// An optional component down fails the full check but not readiness.
+	if s := healthStatus(gastownv1.HealthProbe_HEALTH_PROBE_READINESS, got); s != "healthy" {
+		t.Errorf("readiness status = %q, want healthy", s)
+	}
+	if s := healthStatus(gastownv1.HealthProbe_HEALTH_PROBE_UNSPECIFIED, got); s != "degraded" {
+		t.Errorf("full status = %q, want degraded", s)
+	}
+	got[0].Healthy = false
+	if s := healthStatus(gastownv1.HealthProbe_HEALTH_PROBE_READINESS, got); s != "unhealthy" {
+		t.Errorf("readiness status with a required component down = %q, want unhealthy", s)
+	}
+}
+
+func TestHealthHandlerLivenessWhileDraining(t *testing.T) {
+	status := NewStatusServer(t.TempDir())
+	drainer := NewDrainer()
+	drainer.Drain()
+
+	rec := httptest.NewRecorder()
+	newHealthHandler(status, drainer, gastownv1.HealthProbe_HEALTH_PROBE_LIVENESS).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/live", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("/health/live while draining = %d, want 200", rec.Code)
+	}
+	var out struct {
+		Status     string `json:"status"`
+		Components []struct {
+			Name string `json:"name"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &out); err != nil {
+		t.Fatalf("decoding: %v", err)
+	}
+	if out.Status != "healthy" || len(out.Components) != 1 || out.Components[0].Name != "process" {
+		t.Errorf("liveness = %+v", out)
+	}
+
+	rec = httptest.NewRecorder()
+	newHealthHandler(status, drainer, gastownv1.HealthProbe_HEALTH_PROBE_READINESS).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+	if rec.Code != http.StatusServiceUnavailable || !strings.Contains(rec.Body.String(), `"draining"`) {
+		t.Errorf("/health/ready while draining = %d %s", rec.Code, rec.Body.String())
+	}
+}
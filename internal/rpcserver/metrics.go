@@ -0,0 +1,103 @@
+package rpcserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/metrics"
+)
+
+var (
+	rpcRequests = metrics.NewCounter("gt_rpc_requests_total",
+		"RPC calls handled, by procedure and result code.", "procedure", "code")
+	rpcDuration = metrics.NewHistogram("gt_rpc_duration_seconds",
+		"Latency of unary RPC calls.", nil, "procedure")
+	slings = metrics.NewCounter("gt_slings_total",
+		"Beads slung to agents over RPC, by kind (bead, formula, batch).", "kind")
+)
+
+// stuckRefresh bounds how often the stuck-agent gauge queries beads.
+const stuckRefresh = 30 * time.Second
+
+// MetricsInterceptor records call counts and latencies for every RPC.
+type MetricsInterceptor struct{}
+
+var _ connect.Interceptor = MetricsInterceptor{}
+
+// rpcCode is the code label for a call result.
+func rpcCode(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	return connect.CodeOf(err).String()
+}
+
+// WrapUnary implements connect.Interceptor.
+func (MetricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		start := time.Now()
+		resp, err := next(ctx, req)
+		procedure := req.Spec().Procedure
+		rpcDuration.ObserveSince(start, procedure)
+		rpcRequests.Inc(procedure, rpcCode(err))
+		return resp, err
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor.
+func (MetricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor. Streams are counted
+// when they end; their lifetime is not a latency, so it is not observed.
+func (MetricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		err := next(ctx, conn)
+		rpcRequests.Inc(conn.Spec().Procedure, rpcCode(err))
+		return err
+	}
+}
+
+var stuckGaugeOnce sync.Once
+
+// registerStuckAgentsGauge exposes gt_agents_stuck: agents with an open
+// witness finding in the "stuck" category. The count is cached so frequent
+// scrapes don't each shell out to bd.
+func registerStuckAgentsGauge(townRoot string) {
+	stuckGaugeOnce.Do(func() {
+		var (
+			mu      sync.Mutex
+			value   float64
+			fetched time.Time
+		)
+		client := beads.New(beads.GetTownBeadsPath(townRoot))
+		metrics.NewGaugeFunc("gt_agents_stuck",
+			"Agents with an open witness finding in the stuck category.", func() float64 {
+				mu.Lock()
+				defer mu.Unlock()
+				if time.Since(fetched) < stuckRefresh {
+					return value
+				}
+				findings, err := client.ListFindings("", false)
+				if err != nil {
+					return value // keep the last good count
+				}
+				agents := make(map[string]bool)
+				for _, f := range findings {
+					if f.Category == "stuck" {
+						agents[f.Agent] = true
+					}
+				}
+				value, fetched = float64(len(agents)), time.Now()
+				return value
+			})
+	})
+}
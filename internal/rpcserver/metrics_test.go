@@ -0,0 +1,43 @@
+package rpcserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+)
+
+func TestMetricsInterceptor(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitConfig{Default: RateLimit{Rate: 0.1, Burst: 1}}, nil)
+	mux := http.NewServeMux()
+	mux.Handle(gastownv1connect.NewWitnessServiceHandler(gastownv1connect.UnimplementedWitnessServiceHandler{},
+		connect.WithInterceptors(MetricsInterceptor{}, limiter)))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	procedure := gastownv1connect.WitnessServiceListFindingsProcedure
+	unimplemented := rpcRequests.Value(procedure, connect.CodeUnimplemented.String())
+	exhausted := rpcRequests.Value(procedure, connect.CodeResourceExhausted.String())
+	observed := rpcDuration.Count(procedure)
+
+	client := gastownv1connect.NewWitnessServiceClient(ts.Client(), ts.URL)
+	for i := 0; i < 2; i++ {
+		client.ListFindings(context.Background(), connect.NewRequest(&gastownv1.ListFindingsRequest{}))
+	}
+
+	// The second call is rate limited, and still counted
+	if got := rpcRequests.Value(procedure, connect.CodeUnimplemented.String()) - unimplemented; got != 1 {
+		t.Errorf("unimplemented calls = %v, want 1", got)
+	}
+	if got := rpcRequests.Value(procedure, connect.CodeResourceExhausted.String()) - exhausted; got != 1 {
+		t.Errorf("limited calls = %v, want 1", got)
+	}
+	if got := rpcDuration.Count(procedure) - observed; got != 2 {
+		t.Errorf("latency observations = %d, want 2", got)
+	}
+}
@@ -0,0 +1,96 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// MoleculeServer implements the MoleculeService.
+type MoleculeServer struct {
+	townRoot string
+}
+
+var _ gastownv1connect.MoleculeServiceHandler = (*MoleculeServer)(nil)
+
+// NewMoleculeServer creates a new MoleculeServer.
+func NewMoleculeServer(townRoot string) *MoleculeServer {
+	return &MoleculeServer{townRoot: townRoot}
+}
+
+func (s *MoleculeServer) GetProgress(
+	ctx context.Context,
+	req *connect.Request[gastownv1.GetMoleculeProgressRequest],
+) (*connect.Response[gastownv1.GetMoleculeProgressResponse], error) {
+	if req.Msg.BeadId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("bead_id is required"))
+	}
+
+	issue, progress, err := beads.New(s.townRoot).BeadMoleculeProgress(req.Msg.BeadId)
+	if err != nil {
+		return nil, classifyErr("getting molecule progress", err)
+	}
+	if progress == nil {
+		return nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("bead %s has no attached molecule or steps", issue.ID))
+	}
+
+	return connect.NewResponse(&gastownv1.GetMoleculeProgressResponse{
+		Progress: moleculeProgressToProto(issue.ID, progress),
+	}), nil
+}
+
+func moleculeProgressToProto(beadID string, p *beads.MoleculeProgress) *gastownv1.MoleculeProgress {
+	out := &gastownv1.MoleculeProgress{
+		BeadId:          beadID,
+		RootId:          p.RootID,
+		RootTitle:       p.RootTitle,
+		MoleculeId:      p.MoleculeID,
+		TotalSteps:      int32(p.TotalSteps),
+		DoneSteps:       int32(p.DoneSteps),
+		InProgressSteps: int32(p.InProgress),
+		PercentComplete: int32(p.Percent),
+		Complete:        p.Complete,
+	}
+	if step := p.CurrentStep(); step != nil {
+		out.CurrentStep = moleculeStepToProto(*step)
+	}
+	for _, step := range p.Steps {
+		out.Steps = append(out.Steps, moleculeStepToProto(step))
+	}
+	for _, step := range p.Blockers() {
+		out.Blockers = append(out.Blockers, moleculeStepToProto(step))
+	}
+	return out
+}
+
+func moleculeStepToProto(s beads.StepProgress) *gastownv1.MoleculeStep {
+	return &gastownv1.MoleculeStep{
+		Id:        s.ID,
+		Title:     s.Title,
+		Status:    s.Status,
+		State:     moleculeStepStateToProto(s.State),
+		BlockedBy: s.BlockedBy,
+	}
+}
+
+func moleculeStepStateToProto(state string) gastownv1.MoleculeStepState {
+	switch state {
+	case beads.StepDone:
+		return gastownv1.MoleculeStepState_MOLECULE_STEP_STATE_DONE
+	case beads.StepInProgress:
+		return gastownv1.MoleculeStepState_MOLECULE_STEP_STATE_IN_PROGRESS
+	case beads.StepReady:
+		return gastownv1.MoleculeStepState_MOLECULE_STEP_STATE_READY
+	case beads.StepBlocked:
+		return gastownv1.MoleculeStepState_MOLECULE_STEP_STATE_BLOCKED
+	default:
+		return gastownv1.MoleculeStepState_MOLECULE_STEP_STATE_UNSPECIFIED
+	}
+}
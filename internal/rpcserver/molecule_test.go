@@ -0,0 +1,40 @@
+package rpcserver
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestGetMoleculeProgressRequiresBead(t *testing.T) {
+	srv := NewMoleculeServer(t.TempDir())
+	_, err := srv.GetProgress(context.Background(), connect.NewRequest(&gastownv1.GetMoleculeProgressRequest{}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("GetProgress error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestMoleculeProgressToProto(t *testing.T) {
+	p := &beads.MoleculeProgress{
+		RootID: "gt-wisp", TotalSteps: 3, DoneSteps: 1, Percent: 33,
+		Steps: []beads.StepProgress{
+			{ID: "gt-wisp.1", State: beads.StepDone},
+			{ID: "gt-wisp.2", State: beads.StepReady},
+			{ID: "gt-wisp.3", State: beads.StepBlocked, BlockedBy: []string{"gt-wisp.2"}},
+		},
+	}
+	out := moleculeProgressToProto("gt-abc", p)
+	if out.BeadId != "gt-abc" || out.RootId != "gt-wisp" || out.PercentComplete != 33 || len(out.Steps) != 3 {
+		t.Fatalf("proto = %+v", out)
+	}
+	if out.CurrentStep.GetId() != "gt-wisp.2" || out.CurrentStep.State != gastownv1.MoleculeStepState_MOLECULE_STEP_STATE_READY {
+		t.Errorf("current step = %+v", out.CurrentStep)
+	}
+	if len(out.Blockers) != 1 || out.Blockers[0].BlockedBy[0] != "gt-wisp.2" {
+		t.Errorf("blockers = %+v", out.Blockers)
+	}
+}
@@ -0,0 +1,190 @@
+package rpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/push"
+)
+
+// PushServer implements the PushService: device registration for mobile
+// push notifications.
+type PushServer struct {
+	townRoot string
+	store    *push.Store
+	pusher   *push.Pusher // nil when settings/push.json is absent or invalid
+}
+
+var _ gastownv1connect.PushServiceHandler = (*PushServer)(nil)
+
+// NewPushServer creates a new PushServer. Devices can register whether or
+// not push credentials are configured; they are notified once they are.
+func NewPushServer(townRoot string, pusher *push.Pusher) *PushServer {
+	return &PushServer{
+		townRoot: townRoot,
+		store:    push.NewStore(townRoot),
+		pusher:   pusher,
+	}
+}
+
+// startPushWatcher loads settings/push.json and, if present, follows the
+// event feed to push notifications until ctx is done. It returns the
+// pusher, or nil if push is not configured.
+func startPushWatcher(ctx context.Context, townRoot string) *push.Pusher {
+	cfg, err := config.LoadPushConfig(config.PushConfigPath(townRoot))
+	if err != nil {
+		if !errors.Is(err, config.ErrNotFound) {
+			log.Printf("Push notifications disabled: %v", err)
+		}
+		return nil
+	}
+	pusher, err := push.NewPusherFromConfig(townRoot, cfg)
+	if err != nil {
+		log.Printf("Push notifications disabled: %v", err)
+		return nil
+	}
+	go func() {
+		if err := push.Watch(ctx, townRoot, pusher, cfg.GetMinSeverity()); err != nil {
+			log.Printf("Push watcher stopped: %v", err)
+		}
+	}()
+	log.Printf("Push notifications enabled (%v, escalations at %s and above)", pusher.Platforms(), cfg.GetMinSeverity())
+	return pusher
+}
+
+func (s *PushServer) RegisterDevice(
+	ctx context.Context,
+	req *connect.Request[gastownv1.RegisterDeviceRequest],
+) (*connect.Response[gastownv1.RegisterDeviceResponse], error) {
+	if req.Msg.Token == "" {
+		return nil, invalidArg("token", "required")
+	}
+	platform, ok := pushPlatformFromProto(req.Msg.Platform)
+	if !ok {
+		return nil, invalidArg("platform", "must be APNS or FCM")
+	}
+	d, err := s.store.Register(push.Device{
+		Token:      req.Msg.Token,
+		Platform:   platform,
+		Owner:      req.Msg.Owner,
+		Name:       req.Msg.Name,
+		AppVersion: req.Msg.AppVersion,
+	})
+	if err != nil {
+		return nil, internalErr("registering device", err)
+	}
+
+	enabled := false
+	if s.pusher != nil {
+		for _, p := range s.pusher.Platforms() {
+			enabled = enabled || p == platform
+		}
+	}
+	return connect.NewResponse(&gastownv1.RegisterDeviceResponse{
+		Device:      deviceToProto(d),
+		PushEnabled: enabled,
+	}), nil
+}
+
+func (s *PushServer) UnregisterDevice(
+	ctx context.Context,
+	req *connect.Request[gastownv1.UnregisterDeviceRequest],
+) (*connect.Response[gastownv1.UnregisterDeviceResponse], error) {
+	if req.Msg.Token == "" {
+		return nil, invalidArg("token", "required")
+	}
+	removed, err := s.store.Unregister(req.Msg.Token)
+	if err != nil {
+		return nil, internalErr("unregistering device", err)
+	}
+	return connect.NewResponse(&gastownv1.UnregisterDeviceResponse{Removed: removed}), nil
+}
+
+func (s *PushServer) ListDevices(
+	ctx context.Context,
+	req *connect.Request[gastownv1.ListDevicesRequest],
+) (*connect.Response[gastownv1.ListDevicesResponse], error) {
+	// Device tokens are credentials; never hand out every owner's at once.
+	if req.Msg.Owner == "" {
+		return nil, invalidArg("owner", "required")
+	}
+	devices, err := s.store.List(req.Msg.Owner)
+	if err != nil {
+		return nil, internalErr("listing devices", err)
+	}
+	resp := &gastownv1.ListDevicesResponse{}
+	for _, d := range devices {
+		resp.Devices = append(resp.Devices, deviceToProto(d))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *PushServer) SendTestPush(
+	ctx context.Context,
+	req *connect.Request[gastownv1.SendTestPushRequest],
+) (*connect.Response[gastownv1.SendTestPushResponse], error) {
+	if s.pusher == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("push notifications are not configured (%s)", config.PushConfigPath(s.townRoot)))
+	}
+	n := &push.Notification{
+		Kind:     push.KindNotification,
+		Title:    "Gas Town",
+		Body:     "Test notification: push is working.",
+		DeepLink: push.DeepLink(push.KindNotification, ""),
+	}
+
+	var result *push.Result
+	var err error
+	if req.Msg.Token != "" {
+		result, err = s.pusher.PushToken(ctx, req.Msg.Token, n)
+	} else {
+		result, err = s.pusher.Push(ctx, "", n)
+	}
+	if err != nil {
+		return nil, classifyErr("sending test push", err)
+	}
+	return connect.NewResponse(&gastownv1.SendTestPushResponse{
+		Sent:    int32(result.Sent),    //nolint:gosec // device counts are small
+		Removed: int32(result.Removed), //nolint:gosec // device counts are small
+		Errors:  result.Errors,
+	}), nil
+}
+
+func pushPlatformFromProto(p gastownv1.PushPlatform) (string, bool) {
+	switch p {
+	case gastownv1.PushPlatform_PUSH_PLATFORM_APNS:
+		return push.PlatformAPNs, true
+	case gastownv1.PushPlatform_PUSH_PLATFORM_FCM:
+		return push.PlatformFCM, true
+	}
+	return "", false
+}
+
+func deviceToProto(d *push.Device) *gastownv1.Device {
+	platform := gastownv1.PushPlatform_PUSH_PLATFORM_UNSPECIFIED
+	switch d.Platform {
+	case push.PlatformAPNs:
+		platform = gastownv1.PushPlatform_PUSH_PLATFORM_APNS
+	case push.PlatformFCM:
+		platform = gastownv1.PushPlatform_PUSH_PLATFORM_FCM
+	}
+	return &gastownv1.Device{
+		Token:        d.Token,
+		Platform:     platform,
+		Owner:        d.Owner,
+		Name:         d.Name,
+		AppVersion:   d.AppVersion,
+		RegisteredAt: timestamppb.New(d.RegisteredAt),
+		LastSeenAt:   timestamppb.New(d.LastSeenAt),
+	}
+}
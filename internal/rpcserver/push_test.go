@@ -0,0 +1,66 @@
+package rpcserver
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+)
+
+func TestPushDeviceRegistration(t *testing.T) {
+	srv := NewPushServer(t.TempDir(), nil)
+	ctx := context.Background()
+
+	resp, err := srv.RegisterDevice(ctx, connect.NewRequest(&gastownv1.RegisterDeviceRequest{
+		Token:    "tok-1",
+		Platform: gastownv1.PushPlatform_PUSH_PLATFORM_APNS,
+		Owner:    "steve",
+		Name:     "iPhone",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Msg.PushEnabled {
+		t.Error("PushEnabled = true with no push config")
+	}
+	if resp.Msg.Device.GetRegisteredAt() == nil || resp.Msg.Device.Platform != gastownv1.PushPlatform_PUSH_PLATFORM_APNS {
+		t.Errorf("device = %v", resp.Msg.Device)
+	}
+
+	list, err := srv.ListDevices(ctx, connect.NewRequest(&gastownv1.ListDevicesRequest{Owner: "steve"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Msg.Devices) != 1 {
+		t.Errorf("ListDevices = %v, want 1 device", list.Msg.Devices)
+	}
+
+	if _, err := srv.ListDevices(ctx, connect.NewRequest(&gastownv1.ListDevicesRequest{})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("ListDevices without owner = %v, want InvalidArgument", err)
+	}
+
+	unreg, err := srv.UnregisterDevice(ctx, connect.NewRequest(&gastownv1.UnregisterDeviceRequest{Token: "tok-1"}))
+	if err != nil || !unreg.Msg.Removed {
+		t.Errorf("UnregisterDevice = %v, %v; want removed", unreg, err)
+	}
+
+	_, err = srv.SendTestPush(ctx, connect.NewRequest(&gastownv1.SendTestPushRequest{}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("SendTestPush without config = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestRegisterDeviceValidation(t *testing.T) {
+	srv := NewPushServer(t.TempDir(), nil)
+	for _, msg := range []*gastownv1.RegisterDeviceRequest{
+		{Platform: gastownv1.PushPlatform_PUSH_PLATFORM_FCM},
+		{Token: "tok"},
+	} {
+		_, err := srv.RegisterDevice(context.Background(), connect.NewRequest(msg))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("RegisterDevice(%v) = %v, want InvalidArgument", msg, err)
+		}
+	}
+}
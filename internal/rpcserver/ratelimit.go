@@ -0,0 +1,280 @@
+package rpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/time/rate"
+)
+
+// RateLimitsFile holds per-key and per-method limits, relative to the town root.
+const RateLimitsFile = "mayor/ratelimits.json"
+
+// bucketIdleTTL is how long an unused bucket is kept before it is dropped.
+const bucketIdleTTL = 10 * time.Minute
+
+// RateLimit is a token bucket: Rate requests per second on average, with
+// bursts of up to Burst. A zero Rate means unlimited.
+type RateLimit struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst,omitempty"`
+}
+
+func (l RateLimit) limiter() *rate.Limiter {
+	burst := l.Burst
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(l.Rate)))
+	}
+	return rate.NewLimiter(rate.Limit(l.Rate), burst)
+}
+
+// RateLimitConfig sets limits per client and per method. A client is an API
+// key (by name), or the remote IP for calls without a valid key.
+//
+// Every call spends a token from the client's bucket (Keys[name], else
+// Default). Calls to a method listed in Methods also spend from a bucket for
+// that client and method, so one hot method can be held to a lower rate
+// than the client overall.
+type RateLimitConfig struct {
+	Default RateLimit            `json:"default"`
+	Keys    map[string]RateLimit `json:"keys,omitempty"`
+	// Methods is keyed by "Service/Method", e.g. "StatusService/GetTownStatus".
+	Methods map[string]RateLimit `json:"methods,omitempty"`
+}
+
+// LoadRateLimitConfig reads mayor/ratelimits.json. A missing file yields an
+// empty config (no limits).
+func LoadRateLimitConfig(townRoot string) (RateLimitConfig, error) {
+	var cfg RateLimitConfig
+	data, err := os.ReadFile(filepath.Join(townRoot, RateLimitsFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("reading rate limits: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parsing %s: %w", RateLimitsFile, err)
+	}
+	for method := range cfg.Methods {
+		if strings.Count(method, "/") != 1 {
+			return cfg, fmt.Errorf("%s: method %q should be Service/Method", RateLimitsFile, method)
+		}
+	}
+	return cfg, nil
+}
+
+// Enabled reports whether any limit is configured.
+func (c RateLimitConfig) Enabled() bool {
+	return c.Default.Rate > 0 || len(c.Keys) > 0 || len(c.Methods) > 0
+}
+
+// clientLimit returns the bucket size for a client.
+func (c RateLimitConfig) clientLimit(client string) RateLimit {
+	if name, isKey := strings.CutPrefix(client, "key:"); isKey {
+		if l, ok := c.Keys[name]; ok {
+			return l
+		}
+	}
+	return c.Default
+}
+
+// methodLimit returns the per-method bucket size for a procedure, if any.
+func (c RateLimitConfig) methodLimit(procedure string) (RateLimit, bool) {
+	l, ok := c.Methods[strings.TrimPrefix(procedure, "/gastown.v1.")]
+	return l, ok
+}
+
+// RateLimitStats counts calls seen by a RateLimiter.
+type RateLimitStats struct {
+	Allowed  int64            `json:"allowed"`
+	Limited  int64            `json:"limited"`
+	Buckets  int              `json:"buckets"`
+	ByMethod map[string]int64 `json:"limited_by_method,omitempty"`
+	ByClient map[string]int64 `json:"limited_by_client,omitempty"` // IP clients drop out once idle
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// RateLimiter is a connect.Interceptor enforcing a RateLimitConfig. Calls
+// over the limit fail with CodeResourceExhausted (HTTP 429) and a
+// Retry-After header.
+type RateLimiter struct {
+	cfg  RateLimitConfig
+	auth *AuthInterceptor
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	stats     RateLimitStats
+}
+
+var _ connect.Interceptor = (*RateLimiter)(nil)
+
+// NewRateLimiter creates a limiter. auth, if not nil, is used to tell API
+// keys apart; otherwise clients are identified by IP.
+func NewRateLimiter(cfg RateLimitConfig, auth *AuthInterceptor) *RateLimiter {
+	return &RateLimiter{
+		cfg:     cfg,
+		auth:    auth,
+		buckets: make(map[string]*bucket),
+		stats: RateLimitStats{
+			ByMethod: make(map[string]int64),
+			ByClient: make(map[string]int64),
+		},
+	}
+}
+
+// clientID names the bucket owner for a call.
+func (l *RateLimiter) clientID(header http.Header, peer string) string {
+	if l.auth != nil {
+		if caller, ok := l.auth.identify(header); ok {
+			return "key:" + caller.Name
+		}
+	}
+	if host, _, err := net.SplitHostPort(peer); err == nil {
+		peer = host
+	}
+	return "ip:" + peer
+}
+
+// take spends a token from each bucket the call is subject to. It returns
+// how long to wait before retrying when a bucket is empty.
+func (l *RateLimiter) take(client, procedure string, now time.Time) (ok bool, retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) > bucketIdleTTL {
+		live := make(map[string]bool)
+		for k, b := range l.buckets {
+			if now.Sub(b.lastUsed) > bucketIdleTTL {
+				delete(l.buckets, k)
+				continue
+			}
+			client, _, _ := strings.Cut(k, " ")
+			live[client] = true
+		}
+		// Remote addresses are unbounded, so their counts go with their
+		// buckets; API keys are few and keep theirs
+		for client := range l.stats.ByClient {
+			if strings.HasPrefix(client, "ip:") && !live[client] {
+				delete(l.stats.ByClient, client)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	var limiters []*rate.Limiter
+	if cl := l.cfg.clientLimit(client); cl.Rate > 0 {
+		limiters = append(limiters, l.bucketLocked(client, cl, now))
+	}
+	if ml, ok := l.cfg.methodLimit(procedure); ok && ml.Rate > 0 {
+		limiters = append(limiters, l.bucketLocked(client+" "+procedure, ml, now))
+	}
+
+	// Reserve from every bucket; if any can't serve now, give all tokens back
+	var reservations []*rate.Reservation
+	for _, lim := range limiters {
+		r := lim.ReserveN(now, 1) // Burst is at least 1, so always OK
+		reservations = append(reservations, r)
+		if d := r.DelayFrom(now); d > retryAfter {
+			retryAfter = d
+		}
+	}
+	if retryAfter == 0 {
+		l.stats.Allowed++
+		return true, 0
+	}
+	for _, r := range reservations {
+		r.CancelAt(now)
+	}
+
+	l.stats.Limited++
+	l.stats.ByMethod[strings.TrimPrefix(procedure, "/gastown.v1.")]++
+	l.stats.ByClient[client]++
+	return false, retryAfter
+}
+
+func (l *RateLimiter) bucketLocked(key string, limit RateLimit, now time.Time) *rate.Limiter {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: limit.limiter()}
+		l.buckets[key] = b
+	}
+	b.lastUsed = now
+	return b.limiter
+}
+
+// Stats returns a snapshot of the limiter's counters.
+func (l *RateLimiter) Stats() RateLimitStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	s := l.stats
+	s.Buckets = len(l.buckets)
+	s.ByMethod = make(map[string]int64, len(l.stats.ByMethod))
+	for k, v := range l.stats.ByMethod {
+		s.ByMethod[k] = v
+	}
+	s.ByClient = make(map[string]int64, len(l.stats.ByClient))
+	for k, v := range l.stats.ByClient {
+		s.ByClient[k] = v
+	}
+	return s
+}
+
+// check applies the limits to one call.
+func (l *RateLimiter) check(procedure string, header http.Header, peer string) error {
+	client := l.clientID(header, peer)
+	ok, retryAfter := l.take(client, procedure, time.Now())
+	if ok {
+		return nil
+	}
+	err := connect.NewError(connect.CodeResourceExhausted,
+		fmt.Errorf("rate limit exceeded for %s; retry in %s", procedure, retryAfter.Round(time.Millisecond)))
+	err.Meta().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	return err
+}
+
+// WrapUnary implements connect.Interceptor.
+func (l *RateLimiter) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		if err := l.check(req.Spec().Procedure, req.Header(), req.Peer().Addr); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor; clients are not limited.
+func (l *RateLimiter) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor. Opening a stream
+// costs one token; messages on it are not counted.
+func (l *RateLimiter) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := l.check(conn.Spec().Procedure, conn.RequestHeader(), conn.Peer().Addr); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
@@ -0,0 +1,121 @@
+package rpcserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+)
+
+func TestRateLimiterTake(t *testing.T) {
+	l := NewRateLimiter(RateLimitConfig{
+		Default: RateLimit{Rate: 10, Burst: 3},
+		Keys:    map[string]RateLimit{"grafana": {Rate: 1, Burst: 1}},
+		Methods: map[string]RateLimit{"StatusService/GetTownStatus": {Rate: 1, Burst: 2}},
+	}, nil)
+	now := time.Now()
+	status := "/gastown.v1.StatusService/GetTownStatus"
+	list := "/gastown.v1.MailService/ListInbox"
+
+	// Per-method bucket is smaller than the client's
+	for i := 0; i < 2; i++ {
+		if ok, _ := l.take("ip:10.0.0.1", status, now); !ok {
+			t.Fatalf("call %d should be allowed", i)
+		}
+	}
+	ok, retry := l.take("ip:10.0.0.1", status, now)
+	if ok || retry <= 0 {
+		t.Fatalf("third GetTownStatus = %v, %v; want limited with retry", ok, retry)
+	}
+	// The rejected call gave its client token back, leaving one for another method
+	if ok, _ := l.take("ip:10.0.0.1", list, now); !ok {
+		t.Error("client bucket should still have a token")
+	}
+	if ok, _ := l.take("ip:10.0.0.1", list, now); ok {
+		t.Error("client bucket should now be empty")
+	}
+
+	// Other clients have their own buckets; key overrides apply by name
+	if ok, _ := l.take("ip:10.0.0.2", list, now); !ok {
+		t.Error("second client should not share the first's bucket")
+	}
+	if ok, _ := l.take("key:grafana", list, now); !ok {
+		t.Error("first grafana call should be allowed")
+	}
+	if ok, _ := l.take("key:grafana", list, now); ok {
+		t.Error("grafana key override should limit to burst 1")
+	}
+
+	// Buckets refill
+	if ok, _ := l.take("key:grafana", list, now.Add(time.Second)); !ok {
+		t.Error("bucket should refill after a second")
+	}
+
+	stats := l.Stats()
+	if stats.Limited != 3 || stats.ByMethod["StatusService/GetTownStatus"] != 1 || stats.ByClient["key:grafana"] != 1 {
+		t.Errorf("stats = %+v", stats)
+	}
+	// Idle IP clients are swept with their buckets; key counts stay
+	later := now.Add(2 * bucketIdleTTL)
+	l.take("ip:10.0.0.9", list, later)
+	stats = l.Stats()
+	if _, ok := stats.ByClient["ip:10.0.0.1"]; ok || stats.ByClient["key:grafana"] != 1 || stats.Buckets != 1 {
+		t.Errorf("after sweep stats = %+v", stats)
+	}
+}
+
+func TestRateLimiterResourceExhausted(t *testing.T) {
+	l := NewRateLimiter(RateLimitConfig{Default: RateLimit{Rate: 0.1, Burst: 1}}, nil)
+	mux := http.NewServeMux()
+	mux.Handle(gastownv1connect.NewWitnessServiceHandler(gastownv1connect.UnimplementedWitnessServiceHandler{},
+		connect.WithInterceptors(l)))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	client := gastownv1connect.NewWitnessServiceClient(ts.Client(), ts.URL)
+	list := func() error {
+		_, err := client.ListFindings(context.Background(), connect.NewRequest(&gastownv1.ListFindingsRequest{}))
+		return err
+	}
+	if err := list(); connect.CodeOf(err) != connect.CodeUnimplemented {
+		t.Fatalf("first call error = %v, want Unimplemented from the handler", err)
+	}
+	err := list()
+	if connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Fatalf("second call error = %v, want ResourceExhausted", err)
+	}
+	var cerr *connect.Error
+	if errors.As(err, &cerr) && cerr.Meta().Get("Retry-After") == "" {
+		t.Error("limited response should carry Retry-After")
+	}
+}
+
+func TestLoadRateLimitConfig(t *testing.T) {
+	townRoot := t.TempDir()
+	cfg, err := LoadRateLimitConfig(townRoot)
+	if err != nil || cfg.Enabled() {
+		t.Fatalf("missing file = %+v, %v; want empty", cfg, err)
+	}
+
+	path := filepath.Join(townRoot, RateLimitsFile)
+	os.MkdirAll(filepath.Dir(path), 0755)
+	os.WriteFile(path, []byte(`{"methods": {"GetTownStatus": {"rate": 1}}}`), 0644)
+	if _, err := LoadRateLimitConfig(townRoot); err == nil {
+		t.Error("method without service should be rejected")
+	}
+
+	os.WriteFile(path, []byte(`{"default": {"rate": 5, "burst": 10}, "keys": {"phone": {"rate": 1}}}`), 0644)
+	cfg, err = LoadRateLimitConfig(townRoot)
+	if err != nil || cfg.Default.Burst != 10 || cfg.clientLimit("key:phone").Rate != 1 || cfg.clientLimit("ip:phone").Rate != 5 {
+		t.Errorf("config = %+v, %v", cfg, err)
+	}
+}
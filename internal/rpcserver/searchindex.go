@@ -0,0 +1,461 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// DefaultSearchReindex is how often the issue search index is rebuilt from
+// bd, picking up issues changed outside this server (bd create in a rig,
+// agents closing their work).
+const DefaultSearchReindex = time.Minute
+
+// Search score weights: a term in an issue's title or ID counts for more
+// than one in its description.
+const (
+	searchTitleWeight       = 3
+	searchDescriptionWeight = 1
+)
+
+// issueIndex is the in-memory full-text index SearchIssues is served from:
+// the town's issues and an inverted index of the words in their IDs,
+// titles and descriptions.
+type issueIndex struct {
+	load       func() ([]*beads.Issue, error)
+	rebuildReq chan struct{}
+
+	mu       sync.RWMutex
+	interval time.Duration
+	builtAt  time.Time // zero until the first build
+	issues   map[string]*indexedIssue
+	postings map[string]map[string]bool // word -> IDs of issues containing it
+	words    []string                   // keys of postings, sorted for prefix lookups
+}
+
+// indexedIssue is one issue and the words it was indexed under.
+type indexedIssue struct {
+	issue       *beads.Issue
+	title       map[string]bool // words of the ID and title
+	description map[string]bool
+	text        string // lowercased title and description, for phrases
+}
+
+func newIssueIndex(load func() ([]*beads.Issue, error)) *issueIndex {
+	return &issueIndex{
+		load:       load,
+		rebuildReq: make(chan struct{}, 1),
+		interval:   DefaultSearchReindex,
+		issues:     make(map[string]*indexedIssue),
+		postings:   make(map[string]map[string]bool),
+	}
+}
+
+// loadAllIssues lists every issue in the town, open or closed.
+func loadAllIssues(townRoot string) ([]*beads.Issue, error) {
+	out, err := beads.New(townRoot).Run("list", "--json", "--status=all", "--limit=0")
+	if err != nil {
+		return nil, err
+	}
+	var issues []*beads.Issue
+	if err := parseJSON(out, &issues); err != nil {
+		return nil, fmt.Errorf("parsing bd list output: %w", err)
+	}
+	return issues, nil
+}
+
+// Run keeps the index fresh until ctx is done: it is rebuilt every
+// interval (DefaultSearchReindex if zero) and whenever requestRebuild is
+// called. Without Run, searches rebuild a stale index themselves.
+func (x *issueIndex) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultSearchReindex
+	}
+	x.mu.Lock()
+	x.interval = interval
+	x.mu.Unlock()
+
+	if err := x.rebuild(); err != nil {
+		log.Printf("Search index: build failed: %v", err)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-x.rebuildReq:
+		}
+		if err := x.rebuild(); err != nil {
+			log.Printf("Search index: rebuild failed: %v", err)
+		}
+	}
+}
+
+// requestRebuild asks Run to rebuild the index soon, after a change the
+// caller can't apply itself. It never blocks.
+func (x *issueIndex) requestRebuild() {
+	select {
+	case x.rebuildReq <- struct{}{}:
+	default:
+	}
+}
+
+// rebuild replaces the index with the issues load returns.
+func (x *issueIndex) rebuild() error {
+	issues, err := x.load()
+	if err != nil {
+		return err
+	}
+	fresh := newIssueIndex(x.load)
+	for _, issue := range issues {
+		fresh.add(issue)
+	}
+	sort.Strings(fresh.words)
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.issues, x.postings, x.words = fresh.issues, fresh.postings, fresh.words
+	x.builtAt = time.Now()
+	return nil
+}
+
+// ensureBuilt builds the index if it has never been built, or has gone
+// two intervals without a rebuild (Run isn't running).
+func (x *issueIndex) ensureBuilt() error {
+	x.mu.RLock()
+	fresh := !x.builtAt.IsZero() && time.Since(x.builtAt) < 2*x.interval
+	x.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+	return x.rebuild()
+}
+
+// upsert indexes one issue as it now is, replacing any older copy, so
+// writes made through this server are searchable at once.
+func (x *issueIndex) upsert(issue *beads.Issue) {
+	if issue == nil || issue.ID == "" {
+		return
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if old := x.issues[issue.ID]; old != nil {
+		x.remove(old)
+	}
+	x.add(issue)
+	sort.Strings(x.words)
+}
+
+// add indexes an issue. Callers hold mu and sort words afterwards.
+func (x *issueIndex) add(issue *beads.Issue) {
+	entry := &indexedIssue{
+		issue:       issue,
+		title:       searchWordSet(issue.ID + " " + issue.Title),
+		description: searchWordSet(issue.Description),
+		text:        strings.ToLower(issue.Title + "\n" + issue.Description),
+	}
+	x.issues[issue.ID] = entry
+	for _, words := range []map[string]bool{entry.title, entry.description} {
+		for w := range words {
+			ids := x.postings[w]
+			if ids == nil {
+				ids = make(map[string]bool)
+				x.postings[w] = ids
+				x.words = append(x.words, w)
+			}
+			ids[issue.ID] = true
+		}
+	}
+}
+
+// remove drops an indexed issue. Callers hold mu.
+func (x *issueIndex) remove(entry *indexedIssue) {
+	id := entry.issue.ID
+	delete(x.issues, id)
+	for _, words := range []map[string]bool{entry.title, entry.description} {
+		for w := range words {
+			ids := x.postings[w]
+			delete(ids, id)
+			if len(ids) == 0 {
+				delete(x.postings, w)
+				if i, ok := slices.BinarySearch(x.words, w); ok {
+					x.words = slices.Delete(x.words, i, i+1)
+				}
+			}
+		}
+	}
+}
+
+// search returns the issues matching q, best match first, and how many
+// matched before limit (0 for no limit) was applied.
+func (x *issueIndex) search(q *searchQuery, limit int) ([]*beads.Issue, int) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	// Narrow by each word's postings, then check filters and phrases.
+	var candidates map[string]bool
+	for _, term := range q.terms {
+		ids := make(map[string]bool)
+		for _, w := range x.wordsWithPrefix(term) {
+			for id := range x.postings[w] {
+				if candidates == nil || candidates[id] {
+					ids[id] = true
+				}
+			}
+		}
+		candidates = ids
+	}
+
+	type hit struct {
+		issue *beads.Issue
+		score int
+	}
+	var hits []hit
+	consider := func(entry *indexedIssue) {
+		if !q.matches(entry) {
+			return
+		}
+		hits = append(hits, hit{entry.issue, q.score(entry)})
+	}
+	if candidates != nil {
+		for id := range candidates {
+			consider(x.issues[id])
+		}
+	} else {
+		for _, entry := range x.issues {
+			consider(entry)
+		}
+	}
+
+	slices.SortFunc(hits, func(a, b hit) int {
+		if a.score != b.score {
+			return b.score - a.score
+		}
+		if a.issue.Priority != b.issue.Priority {
+			return a.issue.Priority - b.issue.Priority
+		}
+		if c := strings.Compare(b.issue.UpdatedAt, a.issue.UpdatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.issue.ID, b.issue.ID)
+	})
+
+	total := len(hits)
+	if limit > 0 && limit < len(hits) {
+		hits = hits[:limit]
+	}
+	issues := make([]*beads.Issue, len(hits))
+	for i, h := range hits {
+		issues[i] = h.issue
+	}
+	return issues, total
+}
+
+// wordsWithPrefix returns the indexed words starting with prefix. Callers
+// hold mu.
+func (x *issueIndex) wordsWithPrefix(prefix string) []string {
+	i := sort.SearchStrings(x.words, prefix)
+	j := i
+	for j < len(x.words) && strings.HasPrefix(x.words[j], prefix) {
+		j++
+	}
+	return x.words[i:j]
+}
+
+// searchWords splits text into lowercase words: runs of letters and
+// digits.
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func searchWordSet(text string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range searchWords(text) {
+		set[w] = true
+	}
+	return set
+}
+
+// searchQuery is a parsed SearchIssues query. Words match by prefix and
+// must all appear; phrases must appear verbatim. Each filter key matches
+// any of its values, except labels, which must all be present.
+type searchQuery struct {
+	terms     []string
+	phrases   []string
+	labels    []string
+	assignees []string
+	types     []string
+	statuses  []string
+
+	// Priority range from the request, inclusive; 0 means no bound.
+	priorityMin, priorityMax int
+}
+
+// parseSearchQuery parses a query like
+//
+//	login "session expired" label:auth status:open assignee:gastown/crew/max
+//
+// Filter values may be quoted (label:"needs review"). Words with any
+// other colon ("http://...") are searched as text.
+func parseSearchQuery(query string) (*searchQuery, error) {
+	q := &searchQuery{}
+	for _, f := range splitSearchQuery(query) {
+		if f.quoted && f.key == "" {
+			if phrase := strings.ToLower(strings.TrimSpace(f.value)); phrase != "" {
+				q.phrases = append(q.phrases, phrase)
+			}
+			continue
+		}
+		if err := q.addFilter(f.key, f.value); err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+// addFilter adds one key:value filter, or text when key is empty.
+func (q *searchQuery) addFilter(key, value string) error {
+	if key != "" && value == "" {
+		return fmt.Errorf("%s: needs a value", key)
+	}
+	switch key {
+	case "":
+		q.terms = append(q.terms, searchWords(value)...)
+	case "label":
+		q.labels = append(q.labels, value)
+	case "assignee":
+		q.assignees = append(q.assignees, value)
+	case "type":
+		q.types = append(q.types, strings.ToLower(value))
+	case "status":
+		q.statuses = append(q.statuses, strings.ToLower(value))
+	}
+	return nil
+}
+
+// empty reports whether the query matches everything.
+func (q *searchQuery) empty() bool {
+	return len(q.terms)+len(q.phrases)+len(q.labels)+len(q.assignees)+len(q.types)+len(q.statuses) == 0 &&
+		q.priorityMin == 0 && q.priorityMax == 0
+}
+
+// matches checks everything but the words, which the index has already
+// narrowed by.
+func (q *searchQuery) matches(entry *indexedIssue) bool {
+	issue := entry.issue
+	for _, l := range q.labels {
+		if !slices.Contains(issue.Labels, l) {
+			return false
+		}
+	}
+	if len(q.assignees) > 0 && !slices.ContainsFunc(q.assignees, func(a string) bool { return strings.EqualFold(a, issue.Assignee) }) {
+		return false
+	}
+	if len(q.types) > 0 && !slices.Contains(q.types, strings.ToLower(issue.Type)) {
+		return false
+	}
+	if len(q.statuses) > 0 && !slices.Contains(q.statuses, strings.ToLower(issue.Status)) {
+		return false
+	}
+	if (q.priorityMin > 0 && issue.Priority < q.priorityMin) || (q.priorityMax > 0 && issue.Priority > q.priorityMax) {
+		return false
+	}
+	for _, p := range q.phrases {
+		if !strings.Contains(entry.text, p) {
+			return false
+		}
+	}
+	return true
+}
+
+// score ranks a matching issue: each word or phrase found in the title
+// counts searchTitleWeight, in the description searchDescriptionWeight.
+func (q *searchQuery) score(entry *indexedIssue) int {
+	score := 0
+	hasPrefix := func(words map[string]bool, prefix string) bool {
+		for w := range words {
+			if strings.HasPrefix(w, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, t := range q.terms {
+		if hasPrefix(entry.title, t) {
+			score += searchTitleWeight
+		} else if hasPrefix(entry.description, t) {
+			score += searchDescriptionWeight
+		}
+	}
+	title := strings.ToLower(entry.issue.Title)
+	for _, p := range q.phrases {
+		if strings.Contains(title, p) {
+			score += searchTitleWeight
+		} else {
+			score += searchDescriptionWeight
+		}
+	}
+	return score
+}
+
+// searchField is one whitespace-separated part of a query.
+type searchField struct {
+	key    string // filter key, "" for text
+	value  string
+	quoted bool
+}
+
+// splitSearchQuery splits a query on whitespace, keeping quoted strings
+// (whole, or as a key's value) together. An unterminated quote runs to the
+// end of the query.
+func splitSearchQuery(query string) []searchField {
+	var fields []searchField
+	rest := strings.TrimSpace(query)
+	for rest != "" {
+		var f searchField
+		if i := strings.IndexAny(rest, ": \t\n\""); i > 0 && rest[i] == ':' {
+			if key := strings.ToLower(rest[:i]); searchKeys[key] {
+				f.key = key
+				rest = rest[i+1:]
+			}
+		}
+		if strings.HasPrefix(rest, `"`) {
+			f.quoted = true
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				f.value, rest = rest[1:], ""
+			} else {
+				f.value, rest = rest[1:end+1], rest[end+2:]
+			}
+		} else {
+			end := strings.IndexFunc(rest, unicode.IsSpace)
+			if end < 0 {
+				end = len(rest)
+			}
+			f.value, rest = rest[:end], rest[end:]
+		}
+		fields = append(fields, f)
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+	}
+	return fields
+}
+
+// searchKeys are the filter keys a query may use.
+var searchKeys = map[string]bool{
+	"label":    true,
+	"assignee": true,
+	"type":     true,
+	"status":   true,
+}
@@ -0,0 +1,145 @@
+package rpcserver
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func testIssues() []*beads.Issue {
+	return []*beads.Issue{
+		{ID: "gt-1", Title: "Login fails after session expired", Description: "Users see a blank page.", Status: "open", Type: "bug", Priority: 1, Labels: []string{"auth", "urgent"}, Assignee: "gastown/crew/max"},
+		{ID: "gt-2", Title: "Add dark mode", Description: "Requested by several users after the login redesign.", Status: "open", Type: "feature", Priority: 3, Labels: []string{"ui"}},
+		{ID: "gt-3", Title: "Authentication tokens leak into logs", Status: "closed", Type: "bug", Priority: 0, Labels: []string{"auth"}},
+	}
+}
+
+func newTestIndex(t *testing.T, issues []*beads.Issue) *issueIndex {
+	t.Helper()
+	x := newIssueIndex(func() ([]*beads.Issue, error) { return issues, nil })
+	if err := x.rebuild(); err != nil {
+		t.Fatal(err)
+	}
+	return x
+}
+
+func searchIDs(t *testing.T, x *issueIndex, query string) []string {
+	t.Helper()
+	q, err := parseSearchQuery(query)
+	if err != nil {
+		t.Fatalf("parseSearchQuery(%q): %v", query, err)
+	}
+	issues, total := x.search(q, 0)
+	if total != len(issues) {
+		t.Errorf("%q: total = %d, want %d", query, total, len(issues))
+	}
+	ids := []string{}
+	for _, issue := range issues {
+		ids = append(ids, issue.ID)
+	}
+	return ids
+}
+
+func TestIssueIndex_Search(t *testing.T) {
+	x := newTestIndex(t, testIssues())
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"login", []string{"gt-1", "gt-2"}}, // title match ranks above description
+		{"LOG", []string{"gt-3", "gt-1", "gt-2"}},
+		{"auth", []string{"gt-3"}},
+		{"label:auth", []string{"gt-3", "gt-1"}}, // no text: by priority
+		{"label:auth label:urgent", []string{"gt-1"}},
+		{"type:bug status:open", []string{"gt-1"}},
+		{"status:open status:closed type:BUG", []string{"gt-3", "gt-1"}},
+		{"assignee:gastown/crew/max", []string{"gt-1"}},
+		{`"session expired"`, []string{"gt-1"}},
+		{`"expired session"`, []string{}},
+		{"login users", []string{"gt-1", "gt-2"}},
+		{"gt-2", []string{"gt-2"}},
+		{"nothing-like-this", []string{}},
+		{`label:"auth"`, []string{"gt-3", "gt-1"}},
+	}
+	for _, tt := range tests {
+		if got := searchIDs(t, x, tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("search(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+
+	q, _ := parseSearchQuery("label:auth")
+	if issues, total := x.search(q, 1); len(issues) != 1 || total != 2 {
+		t.Errorf("limited search = %d issues, total %d; want 1, 2", len(issues), total)
+	}
+}
+
+func TestIssueIndex_Upsert(t *testing.T) {
+	x := newTestIndex(t, testIssues())
+
+	x.upsert(&beads.Issue{ID: "gt-2", Title: "Add light mode", Status: "open"})
+	if got := searchIDs(t, x, "dark"); len(got) != 0 {
+		t.Errorf("after upsert, dark matched %v", got)
+	}
+	if got := searchIDs(t, x, "light"); !reflect.DeepEqual(got, []string{"gt-2"}) {
+		t.Errorf("after upsert, light matched %v", got)
+	}
+
+	x.upsert(&beads.Issue{ID: "gt-4", Title: "Flaky login test", Status: "open", Priority: 2})
+	if got := searchIDs(t, x, "login"); !reflect.DeepEqual(got, []string{"gt-1", "gt-4"}) {
+		t.Errorf("after insert, login matched %v", got)
+	}
+}
+
+func TestParseSearchQuery(t *testing.T) {
+	q, err := parseSearchQuery(`Login-flow "Session Expired" label:"needs review" Status:open http://example.com`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &searchQuery{
+		terms:    []string{"login", "flow", "http", "example", "com"},
+		phrases:  []string{"session expired"},
+		labels:   []string{"needs review"},
+		statuses: []string{"open"},
+	}
+	if !reflect.DeepEqual(q, want) {
+		t.Errorf("parseSearchQuery = %+v, want %+v", q, want)
+	}
+
+	if _, err := parseSearchQuery("label: auth"); err == nil {
+		t.Error("empty label: value was accepted")
+	}
+	if q, _ := parseSearchQuery(`"unterminated phrase`); len(q.phrases) != 1 || q.phrases[0] != "unterminated phrase" {
+		t.Errorf("unterminated quote parsed as %+v", q)
+	}
+	if q, _ := parseSearchQuery("   "); !q.empty() {
+		t.Errorf("blank query parsed as %+v", q)
+	}
+}
+
+func TestBeadsServer_SearchIssues(t *testing.T) {
+	srv := NewBeadsServer(t.TempDir())
+	srv.index = newTestIndex(t, testIssues())
+
+	resp, err := srv.SearchIssues(context.Background(), connect.NewRequest(&gastownv1.SearchIssuesRequest{
+		Query:       "label:auth",
+		Status:      "closed",
+		PriorityMax: 2,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Msg.Total != 1 || len(resp.Msg.Issues) != 1 || resp.Msg.Issues[0].Id != "gt-3" {
+		t.Errorf("response = %v, want gt-3 only", resp.Msg)
+	}
+
+	_, err = srv.SearchIssues(context.Background(), connect.NewRequest(&gastownv1.SearchIssuesRequest{}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("empty search err = %v, want InvalidArgument", err)
+	}
+}
@@ -9,15 +9,18 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"runtime/debug"
 	"strings"
+	"syscall"
 	"time"
 
 	"connectrpc.com/connect"
@@ -26,15 +29,13 @@ import (
 	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
 
 	"github.com/steveyegge/gastown/internal/beads"
-	"github.com/steveyegge/gastown/internal/config"
-	"github.com/steveyegge/gastown/internal/constants"
-	"github.com/steveyegge/gastown/internal/crew"
 	"github.com/steveyegge/gastown/internal/daemon"
 	"github.com/steveyegge/gastown/internal/eventbus"
-	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/metrics"
+	"github.com/steveyegge/gastown/internal/mtls"
 	"github.com/steveyegge/gastown/internal/notify"
-	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/terminal"
 
 	"google.golang.org/protobuf/types/known/structpb"
@@ -55,32 +56,42 @@ func ToUrgencyProto(urgency string) gastownv1.Urgency {
 
 // StatusServer implements the StatusService.
 type StatusServer struct {
-	townRoot string
-	backend  terminal.Backend
+	townRoot  string
+	backend   terminal.Backend
+	collector *StatusCollector
 }
 
 var _ gastownv1connect.StatusServiceHandler = (*StatusServer)(nil)
 
 func NewStatusServer(townRoot string) *StatusServer {
-	return &StatusServer{
-		townRoot: townRoot,
-		backend:  terminal.NewCoopBackend(terminal.CoopConfig{}),
-	}
+	return NewStatusServerWithBackend(townRoot, terminal.NewCoopBackend(terminal.CoopConfig{}))
 }
 
 // NewStatusServerWithBackend creates a StatusServer with a custom terminal backend.
 func NewStatusServerWithBackend(townRoot string, backend terminal.Backend) *StatusServer {
+	return NewStatusServerWithCollector(townRoot, NewStatusCollector(townRoot, backend, 0))
+}
+
+// NewStatusServerWithCollector creates a StatusServer that shares an existing
+// StatusCollector (and its cache) with other servers in the process.
+func NewStatusServerWithCollector(townRoot string, collector *StatusCollector) *StatusServer {
 	return &StatusServer{
-		townRoot: townRoot,
-		backend:  backend,
+		townRoot:  townRoot,
+		backend:   collector.backend,
+		collector: collector,
 	}
 }
 
+// Collector returns the StatusCollector backing this server.
+func (s *StatusServer) Collector() *StatusCollector {
+	return s.collector
+}
+
 func (s *StatusServer) GetTownStatus(
 	ctx context.Context,
 	req *connect.Request[gastownv1.GetTownStatusRequest],
 ) (*connect.Response[gastownv1.GetTownStatusResponse], error) {
-	status, err := s.collectTownStatus(req.Msg.Fast)
+	status, err := s.collector.TownStatus(req.Msg.Fast)
 	if err != nil {
 		return nil, unavailableErr("collecting town status", err, 5)
 	}
@@ -91,7 +102,7 @@ func (s *StatusServer) GetRigStatus(
 	ctx context.Context,
 	req *connect.Request[gastownv1.GetRigStatusRequest],
 ) (*connect.Response[gastownv1.GetRigStatusResponse], error) {
-	status, err := s.collectTownStatus(false)
+	status, err := s.collector.TownStatus(false)
 	if err != nil {
 		return nil, unavailableErr("collecting rig status", err, 5)
 	}
@@ -113,7 +124,7 @@ func (s *StatusServer) GetAgentStatus(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("address is required"))
 	}
 
-	status, err := s.collectTownStatus(false)
+	status, err := s.collector.TownStatus(false)
 	if err != nil {
 		return nil, unavailableErr("collecting agent status", err, 5)
 	}
@@ -207,7 +218,7 @@ func (s *StatusServer) WatchStatus(
 		case <-ctx.Done():
 			return nil
 		case <-ticker.C:
-			status, err := s.collectTownStatus(true)
+			status, err := s.collector.TownStatus(true)
 			if err != nil {
 				log.Printf("WatchStatus error: %v", err)
 				continue
@@ -222,139 +233,6 @@ func (s *StatusServer) WatchStatus(
 	}
 }
 
-func (s *StatusServer) collectTownStatus(fast bool) (*gastownv1.TownStatus, error) {
-	// Load configs
-	townConfigPath := constants.MayorTownPath(s.townRoot)
-	townConfig, err := config.LoadTownConfig(townConfigPath)
-	if err != nil {
-		townConfig = &config.TownConfig{Name: filepath.Base(s.townRoot)}
-	}
-
-	rigsConfigPath := constants.MayorRigsPath(s.townRoot)
-	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
-	if err != nil {
-		rigsConfig = &config.RigsConfig{Rigs: make(map[string]config.RigEntry)}
-	}
-
-	// Discover rigs
-	g := git.NewGit(s.townRoot)
-	mgr := rig.NewManager(s.townRoot, rigsConfig, g)
-	rigs, err := mgr.DiscoverRigs()
-	if err != nil {
-		return nil, fmt.Errorf("discovering rigs: %w", err)
-	}
-
-	// Helper to check if a session is running via the backend.
-	isSessionRunning := func(session string) bool {
-		exists, err := s.backend.HasSession(session)
-		return err == nil && exists
-	}
-
-	// Overseer info
-	var overseer *gastownv1.OverseerInfo
-	if oc, err := config.LoadOrDetectOverseer(s.townRoot); err == nil && oc != nil {
-		overseer = &gastownv1.OverseerInfo{
-			Name:     oc.Name,
-			Email:    oc.Email,
-			Username: oc.Username,
-		}
-		if !fast {
-			mailRouter := mail.NewRouter(s.townRoot)
-			if mb, err := mailRouter.GetMailbox("overseer"); err == nil {
-				_, unread, _ := mb.Count()
-				overseer.UnreadMail = int32(unread)
-			}
-		}
-	}
-
-	// Build status
-	status := &gastownv1.TownStatus{
-		Name:     townConfig.Name,
-		Location: s.townRoot,
-		Overseer: overseer,
-	}
-
-	// Global agents
-	for _, agent := range []struct{ name, session, role string }{
-		{"mayor", "gt-mayor", "mayor"},
-		{"deacon", "gt-deacon", "deacon"},
-	} {
-		status.GlobalAgents = append(status.GlobalAgents, &gastownv1.AgentRuntime{
-			Name:    agent.name,
-			Address: &gastownv1.AgentAddress{Name: agent.name},
-			Session: agent.session,
-			Role:    agent.role,
-			Running: isSessionRunning(agent.session),
-		})
-	}
-
-	// Rig status
-	for _, r := range rigs {
-		rs := &gastownv1.RigStatus{
-			Name:        r.Name,
-			Path:        r.Path,
-			Polecats:    r.Polecats,
-			HasWitness:  r.HasWitness,
-			HasRefinery: r.HasRefinery,
-		}
-
-		// Crew workers
-		crewGit := git.NewGit(r.Path)
-		crewMgr := crew.NewManager(r, crewGit)
-		if workers, err := crewMgr.List(); err == nil {
-			for _, w := range workers {
-				rs.Crews = append(rs.Crews, w.Name)
-			}
-		}
-
-		// Rig agents
-		if r.HasWitness {
-			session := fmt.Sprintf("gt-%s-witness", r.Name)
-			rs.Agents = append(rs.Agents, &gastownv1.AgentRuntime{
-				Name:    "witness",
-				Address: &gastownv1.AgentAddress{Rig: r.Name, Role: "witness"},
-				Session: session,
-				Role:    "witness",
-				Running: isSessionRunning(session),
-			})
-		}
-		if r.HasRefinery {
-			session := fmt.Sprintf("gt-%s-refinery", r.Name)
-			rs.Agents = append(rs.Agents, &gastownv1.AgentRuntime{
-				Name:    "refinery",
-				Address: &gastownv1.AgentAddress{Rig: r.Name, Role: "refinery"},
-				Session: session,
-				Role:    "refinery",
-				Running: isSessionRunning(session),
-			})
-		}
-		for _, p := range r.Polecats {
-			session := fmt.Sprintf("gt-%s-%s", r.Name, p)
-			rs.Agents = append(rs.Agents, &gastownv1.AgentRuntime{
-				Name:    p,
-				Address: &gastownv1.AgentAddress{Rig: r.Name, Role: "polecats", Name: p},
-				Session: session,
-				Role:    "polecat",
-				Running: isSessionRunning(session),
-			})
-		}
-		for _, c := range rs.Crews {
-			session := fmt.Sprintf("gt-%s-crew-%s", r.Name, c)
-			rs.Agents = append(rs.Agents, &gastownv1.AgentRuntime{
-				Name:    c,
-				Address: &gastownv1.AgentAddress{Rig: r.Name, Role: "crew", Name: c},
-				Session: session,
-				Role:    "crew",
-				Running: isSessionRunning(session),
-			})
-		}
-
-		status.Rigs = append(status.Rigs, rs)
-	}
-
-	return status, nil
-}
-
 // enrichAgentRuntime populates the detailed fields of an AgentRuntime:
 // has_work, work_title, hook_bead, state, unread_mail, first_subject.
 // It queries the agent bead for hook info and the mail system for unread messages.
@@ -405,9 +283,15 @@ func (s *StatusServer) enrichAgentRuntime(agent *gastownv1.AgentRuntime) {
 
 // findBead looks up a bead by ID, checking rig beads and town beads.
 func (s *StatusServer) findBead(beadID, rigName string) (*beads.Issue, error) {
+	return findTownBead(s.townRoot, beadID, rigName)
+}
+
+// findTownBead looks up a bead by ID in a rig's beads, if rigName is set,
+// then in the town's.
+func findTownBead(townRoot, beadID, rigName string) (*beads.Issue, error) {
 	// Try rig beads first if we have a rig name
 	if rigName != "" {
-		rigBeadsPath := filepath.Join(s.townRoot, rigName, ".beads")
+		rigBeadsPath := filepath.Join(townRoot, rigName, ".beads")
 		rigClient := beads.New(rigBeadsPath)
 		if issue, err := rigClient.Show(beadID); err == nil && issue != nil {
 			return issue, nil
@@ -415,44 +299,43 @@ func (s *StatusServer) findBead(beadID, rigName string) (*beads.Issue, error) {
 	}
 
 	// Fall back to town beads
-	townClient := beads.New(beads.GetTownBeadsPath(s.townRoot))
+	townClient := beads.New(beads.GetTownBeadsPath(townRoot))
 	return townClient.Show(beadID)
 }
 
-// HealthCheck returns structured health information for all system components.
+// HealthCheck returns structured health for the components a probe covers.
 func (s *StatusServer) HealthCheck(
 	ctx context.Context,
 	req *connect.Request[gastownv1.HealthCheckRequest],
 ) (*connect.Response[gastownv1.HealthCheckResponse], error) {
-	var components []*gastownv1.ComponentHealth
-
-	// Check daemon
-	components = append(components, s.checkDaemon())
-
-	// Check dolt
-	components = append(components, s.checkDolt())
-
-	// Check terminal sessions
-	components = append(components, s.checkTerminal())
-
-	// Check beads
-	components = append(components, s.checkBeads())
-
-	// Determine overall status
-	overall := "healthy"
-	for _, c := range components {
-		if !c.Healthy {
-			overall = "degraded"
-			break
-		}
-	}
-
+	probe := req.Msg.GetProbe()
+	start := time.Now()
+	components := runHealthChecks(ctx, s.healthChecks(probe), healthCheckTimeout)
 	return connect.NewResponse(&gastownv1.HealthCheckResponse{
-		Status:     overall,
+		Status:     healthStatus(probe, components),
 		Components: components,
+		LatencyMs:  time.Since(start).Milliseconds(),
 	}), nil
 }
 
+// RefreshRigs rescans the town's rigs now rather than waiting for the rig
+// index to pick up a change, and drops cached status snapshots.
+func (s *StatusServer) RefreshRigs(
+	ctx context.Context,
+	req *connect.Request[gastownv1.RefreshRigsRequest],
+) (*connect.Response[gastownv1.RefreshRigsResponse], error) {
+	start := time.Now()
+	rigs, err := s.collector.RefreshRigs()
+	if err != nil {
+		return nil, unavailableErr("rescanning rigs", err, 5)
+	}
+	resp := &gastownv1.RefreshRigsResponse{DurationMs: time.Since(start).Milliseconds()}
+	for _, r := range rigs {
+		resp.Rigs = append(resp.Rigs, r.Name)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // checkDaemon checks whether the Gas Town daemon process is running.
 func (s *StatusServer) checkDaemon() *gastownv1.ComponentHealth {
 	start := time.Now()
@@ -520,16 +403,6 @@ func (s *StatusServer) checkDolt() *gastownv1.ComponentHealth {
 	}
 }
 
-// checkTerminal reports terminal session backend status (Coop backend in K8s mode).
-func (s *StatusServer) checkTerminal() *gastownv1.ComponentHealth {
-	return &gastownv1.ComponentHealth{
-		Name:      "terminal",
-		Healthy:   true,
-		LatencyMs: 0,
-		Message:   "coop backend (K8s mode)",
-	}
-}
-
 // checkBeads checks whether the beads database is accessible.
 func (s *StatusServer) checkBeads() *gastownv1.ComponentHealth {
 	start := time.Now()
@@ -711,6 +584,11 @@ func (s *DecisionServer) GetDecision(
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("decision not found: %s", req.Msg.DecisionId))
 	}
 
+	return connect.NewResponse(&gastownv1.GetDecisionResponse{Decision: decisionToProto(issue.ID, fields)}), nil
+}
+
+// decisionToProto converts a decision bead's fields to proto.
+func decisionToProto(id string, fields *beads.DecisionFields) *gastownv1.Decision {
 	var options []*gastownv1.DecisionOption
 	for _, opt := range fields.Options {
 		options = append(options, &gastownv1.DecisionOption{
@@ -721,8 +599,8 @@ func (s *DecisionServer) GetDecision(
 		})
 	}
 
-	decision := &gastownv1.Decision{
-		Id:              issue.ID,
+	return &gastownv1.Decision{
+		Id:              id,
 		Question:        fields.Question,
 		Context:         fields.Context,
 		Options:         options,
@@ -736,8 +614,6 @@ func (s *DecisionServer) GetDecision(
 		ParentBead:      fields.ParentBeadID,
 		ParentBeadTitle: fields.ParentBeadTitle,
 	}
-
-	return connect.NewResponse(&gastownv1.GetDecisionResponse{Decision: decision}), nil
 }
 
 func (s *DecisionServer) CreateDecision(
@@ -1229,17 +1105,39 @@ func (s *MailServer) ListInbox(
 		return nil, unavailableErr(fmt.Sprintf("accessing mailbox for %q", address), err, 5)
 	}
 
-	total, unread, _ := mailbox.Count()
 	msgs, err := mailbox.List()
 	if err != nil {
 		return nil, unavailableErr("listing inbox messages", err, 5)
 	}
-
-	var messages []*gastownv1.Message
+	unread := 0
 	for _, m := range msgs {
-		if req.Msg.UnreadOnly && m.Read {
-			continue
+		if !m.Read {
+			unread++
 		}
+	}
+
+	opts := mail.ListOptions{
+		UnreadOnly: req.Msg.UnreadOnly,
+		From:       req.Msg.From,
+		Cursor:     req.Msg.Cursor,
+		Limit:      int(req.Msg.Limit),
+	}
+	if req.Msg.MinPriority != gastownv1.Priority_PRIORITY_UNSPECIFIED {
+		opts.MinPriority = fromPriority(req.Msg.MinPriority)
+	}
+	if req.Msg.Since != nil {
+		opts.Since = req.Msg.Since.AsTime()
+	}
+	if req.Msg.Until != nil {
+		opts.Until = req.Msg.Until.AsTime()
+	}
+	page, err := mail.PageMessages(msgs, opts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var messages []*gastownv1.Message
+	for _, m := range page.Messages {
 		messages = append(messages, &gastownv1.Message{
 			Id:        m.ID,
 			From:      &gastownv1.AgentAddress{Name: m.From},
@@ -1250,15 +1148,14 @@ func (s *MailServer) ListInbox(
 			Read:      m.Read,
 			Priority:  toPriority(string(m.Priority)),
 		})
-		if req.Msg.Limit > 0 && int32(len(messages)) >= req.Msg.Limit {
-			break
-		}
 	}
 
 	return connect.NewResponse(&gastownv1.ListInboxResponse{
-		Messages: messages,
-		Total:    int32(total),
-		Unread:   int32(unread),
+		Messages:   messages,
+		Total:      int32(len(msgs)),
+		Unread:     int32(unread),
+		Matched:    int32(page.Matched),
+		NextCursor: page.NextCursor,
 	}), nil
 }
 
@@ -1308,15 +1205,25 @@ func (s *MailServer) SendMessage(
 		cc = append(cc, formatAgentAddress(ccAddr))
 	}
 
+	attachments, err := attachmentsFromProto(mail.AttachmentsDir(s.townRoot), req.Msg.Attachments)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// Create the mail message
 	msg := &mail.Message{
-		From:     from,
-		To:       formatAgentAddress(req.Msg.To),
-		Subject:  req.Msg.Subject,
-		Body:     req.Msg.Body,
-		Priority: fromPriority(req.Msg.Priority),
-		ReplyTo:  req.Msg.ReplyTo,
-		CC:       cc,
+		From:        from,
+		To:          formatAgentAddress(req.Msg.To),
+		Subject:     req.Msg.Subject,
+		Body:        req.Msg.Body,
+		Priority:    fromPriority(req.Msg.Priority),
+		ReplyTo:     req.Msg.ReplyTo,
+		CC:          cc,
+		Attachments: attachments,
+		Receipt:     fromReceipt(req.Msg.Receipt),
+	}
+	if msg.ReplyTo != "" {
+		msg.Type = mail.TypeReply
 	}
 
 	// Send via mail router
@@ -1342,10 +1249,20 @@ func (s *MailServer) MarkRead(
 	townBeadsPath := filepath.Join(s.townRoot, ".beads")
 	mailbox := mail.NewMailboxWithBeadsDir("", s.townRoot, townBeadsPath)
 
+	msg, getErr := mailbox.Get(req.Msg.MessageId)
 	if err := mailbox.MarkReadOnly(req.Msg.MessageId); err != nil {
 		return nil, notFoundOrInternal("marking message as read "+req.Msg.MessageId, err)
 	}
 
+	// Read receipts are best-effort; the message is read either way.
+	if getErr == nil {
+		reader := req.Header().Get("X-GT-From")
+		if reader == "" {
+			reader = "overseer"
+		}
+		_ = mail.NewRouterWithTownRoot(s.townRoot, s.townRoot).AcknowledgeRead(msg, reader)
+	}
+
 	return connect.NewResponse(&gastownv1.MarkReadResponse{}), nil
 }
 
@@ -1418,6 +1335,48 @@ func (s *MailServer) WatchInbox(
 	}
 }
 
+func (s *MailServer) GetThread(
+	ctx context.Context,
+	req *connect.Request[gastownv1.GetThreadRequest],
+) (*connect.Response[gastownv1.GetThreadResponse], error) {
+	threadID := req.Msg.ThreadId
+	if threadID == "" {
+		if req.Msg.MessageId == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("thread_id or message_id is required"))
+		}
+		townBeadsPath := filepath.Join(s.townRoot, ".beads")
+		mailbox := mail.NewMailboxWithBeadsDir("", s.townRoot, townBeadsPath)
+		msg, err := mailbox.Get(req.Msg.MessageId)
+		if err != nil {
+			return nil, notFoundOrInternal("reading message "+req.Msg.MessageId, err)
+		}
+		if msg.ThreadID == "" {
+			// Not part of a thread: the conversation is just this message.
+			return connect.NewResponse(&gastownv1.GetThreadResponse{
+				Entries: []*gastownv1.ThreadEntry{{Message: mailMessageToProto(msg)}},
+			}), nil
+		}
+		threadID = msg.ThreadID
+	}
+
+	entries, err := mail.NewRouterWithTownRoot(s.townRoot, s.townRoot).GetThread(threadID)
+	if err != nil {
+		return nil, classifyErr("getting thread "+threadID, err)
+	}
+	if len(entries) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("thread %s not found", threadID))
+	}
+
+	resp := &gastownv1.GetThreadResponse{ThreadId: threadID}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &gastownv1.ThreadEntry{
+			Message: mailMessageToProto(e.Message),
+			Depth:   int32(e.Depth),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func toPriority(s string) gastownv1.Priority {
 	switch s {
 	case "urgent":
@@ -1433,6 +1392,43 @@ func toPriority(s string) gastownv1.Priority {
 	}
 }
 
+func toMessageType(t mail.MessageType) gastownv1.MessageType {
+	switch t {
+	case mail.TypeTask:
+		return gastownv1.MessageType_MESSAGE_TYPE_TASK
+	case mail.TypeScavenge:
+		return gastownv1.MessageType_MESSAGE_TYPE_SCAVENGE
+	case mail.TypeNotification:
+		return gastownv1.MessageType_MESSAGE_TYPE_NOTIFICATION
+	case mail.TypeReply:
+		return gastownv1.MessageType_MESSAGE_TYPE_REPLY
+	default:
+		return gastownv1.MessageType_MESSAGE_TYPE_UNSPECIFIED
+	}
+}
+
+func toReceipt(r mail.Receipt) gastownv1.Receipt {
+	switch r {
+	case mail.ReceiptEvent:
+		return gastownv1.Receipt_RECEIPT_EVENT
+	case mail.ReceiptMail:
+		return gastownv1.Receipt_RECEIPT_MAIL
+	default:
+		return gastownv1.Receipt_RECEIPT_UNSPECIFIED
+	}
+}
+
+func fromReceipt(r gastownv1.Receipt) mail.Receipt {
+	switch r {
+	case gastownv1.Receipt_RECEIPT_EVENT:
+		return mail.ReceiptEvent
+	case gastownv1.Receipt_RECEIPT_MAIL:
+		return mail.ReceiptMail
+	default:
+		return mail.ReceiptNone
+	}
+}
+
 func fromPriority(p gastownv1.Priority) mail.Priority {
 	switch p {
 	case gastownv1.Priority_PRIORITY_URGENT:
@@ -1467,38 +1463,74 @@ func mailMessageToProto(m *mail.Message) *gastownv1.Message {
 		Timestamp: timestamppb.New(m.Timestamp),
 		Read:      m.Read,
 		Priority:  toPriority(string(m.Priority)),
+		Type:      toMessageType(m.Type),
 		ThreadId:  m.ThreadID,
 		ReplyTo:   m.ReplyTo,
+		Pinned:    m.Pinned,
 		Cc:        cc,
+		Receipt:   toReceipt(m.Receipt),
+
+		Attachments: attachmentsToProto(m.Attachments),
 	}
 }
 
-// APIKeyInterceptor validates API keys for authentication.
-func APIKeyInterceptor(apiKey string) connect.UnaryInterceptorFunc {
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			if apiKey == "" {
-				return next(ctx, req) // No auth configured
-			}
-			key := req.Header().Get("X-GT-API-Key")
-			if key != apiKey {
-				return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid API key"))
+// attachmentsToProto converts mail attachments to proto, decoding inline
+// data to raw bytes.
+func attachmentsToProto(attachments []mail.Attachment) []*gastownv1.Attachment {
+	var out []*gastownv1.Attachment
+	for _, a := range attachments {
+		pa := &gastownv1.Attachment{
+			Name:        a.Name,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			Path:        a.Path,
+		}
+		if a.IsInline() {
+			pa.Data, _ = a.Content()
+		}
+		out = append(out, pa)
+	}
+	return out
+}
+
+// attachmentsFromProto converts and validates attachments from a send
+// request. Inline content is re-encoded for storage; file references must
+// point at an existing file in dir.
+func attachmentsFromProto(dir string, attachments []*gastownv1.Attachment) ([]mail.Attachment, error) {
+	var out []mail.Attachment
+	for _, pa := range attachments {
+		var (
+			a   mail.Attachment
+			err error
+		)
+		if pa.Path != "" {
+			if len(pa.Data) > 0 {
+				return nil, fmt.Errorf("attachment %s: path and data are mutually exclusive", pa.Name)
 			}
-			return next(ctx, req)
+			a, err = mail.NewFileAttachmentIn(dir, pa.Path)
+		} else {
+			a, err = mail.NewInlineAttachment(pa.Name, pa.Data)
 		}
+		if err != nil {
+			return nil, err
+		}
+		if pa.Name != "" {
+			a.Name = pa.Name
+		}
+		if pa.ContentType != "" {
+			a.ContentType = pa.ContentType
+		}
+		out = append(out, a)
+	}
+	if err := mail.ValidateAttachments(out); err != nil {
+		return nil, err
 	}
+	return out, nil
 }
 
 // LoadTLSConfig loads TLS certificates for HTTPS.
 func LoadTLSConfig(certFile, keyFile string) (*tls.Config, error) {
-	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
-	if err != nil {
-		return nil, fmt.Errorf("loading TLS cert: %w", err)
-	}
-	return &tls.Config{
-		Certificates: []tls.Certificate{cert},
-		MinVersion:   tls.VersionTLS12,
-	}, nil
+	return mtls.Files{Cert: certFile, Key: keyFile}.ServerConfig()
 }
 
 // RecoveryMiddleware wraps an HTTP handler with panic recovery to prevent
@@ -2094,19 +2126,30 @@ func (s *ActivityServer) ListEvents(
 		limit = 100
 	}
 
-	var events []*gastownv1.ActivityEvent
-	var totalCount int
-
 	if req.Msg.Curated {
-		events, totalCount = s.readFeedEvents(req.Msg.Filter, limit)
-	} else {
-		events, totalCount = s.readRawEvents(req.Msg.Filter, limit)
+		events, totalCount := s.readFeedEvents(req.Msg.Filter, limit)
+		return connect.NewResponse(&gastownv1.ListEventsResponse{
+			Events:     events,
+			TotalCount: int32(totalCount),
+		}), nil
 	}
 
-	return connect.NewResponse(&gastownv1.ListEventsResponse{
-		Events:     events,
-		TotalCount: int32(totalCount),
-	}), nil
+	page, err := s.queryRawEvents(req.Msg.Filter, limit, req.Msg.PageToken)
+	if err != nil {
+		if errors.Is(err, events.ErrInvalidCursor) {
+			return nil, invalidArg("page_token", err.Error())
+		}
+		return nil, unavailableErr("reading events file", err, 5)
+	}
+
+	resp := &gastownv1.ListEventsResponse{
+		TotalCount:    int32(page.Total),
+		NextPageToken: page.NextCursor,
+	}
+	for _, ev := range page.Events {
+		resp.Events = append(resp.Events, eventToProto(ev))
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ActivityServer) WatchEvents(
@@ -2142,20 +2185,12 @@ func (s *ActivityServer) WatchEvents(
 		}
 	}
 
-	file, err := os.Open(eventsFile)
+	follower, err := events.Follow(eventsFile)
 	if err != nil {
-		file, err = os.OpenFile(eventsFile, os.O_RDONLY|os.O_CREATE, 0644)
-		if err != nil {
-			return unavailableErr("opening events file", err, 5)
-		}
-	}
-	defer file.Close()
-
-	if _, err := file.Seek(0, io.SeekEnd); err != nil {
-		return unavailableErr("seeking events file", err, 5)
+		return unavailableErr("opening events file", err, 5)
 	}
+	defer follower.Close()
 
-	reader := bufio.NewReader(file)
 	ticker := time.NewTicker(100 * time.Millisecond)
 	defer ticker.Stop()
 
@@ -2165,8 +2200,8 @@ func (s *ActivityServer) WatchEvents(
 			return nil
 		case <-ticker.C:
 			for {
-				line, err := reader.ReadString('\n')
-				if err != nil {
+				line, ok := follower.Next()
+				if !ok {
 					break
 				}
 
@@ -2544,8 +2579,74 @@ func (s *ActivityServer) parseDaemonLogLine(line string) *gastownv1.LogEntry {
 }
 
 func (s *ActivityServer) readRawEvents(filter *gastownv1.EventFilter, limit int) ([]*gastownv1.ActivityEvent, int) {
-	eventsPath := filepath.Join(s.townRoot, ".events.jsonl")
-	return s.readEventsFromFile(eventsPath, filter, limit, false)
+	page, err := s.queryRawEvents(filter, limit, "")
+	if err != nil {
+		return nil, 0
+	}
+	var result []*gastownv1.ActivityEvent
+	for _, ev := range page.Events {
+		result = append(result, eventToProto(ev))
+	}
+	return result, page.Total
+}
+
+// queryRawEvents runs filter against the indexed raw events log.
+func (s *ActivityServer) queryRawEvents(filter *gastownv1.EventFilter, limit int, pageToken string) (events.Page, error) {
+	q := events.Query{Limit: limit, Cursor: pageToken}
+	if filter != nil {
+		q.Types = filter.Types
+		q.Actor = filter.Actor
+		q.Rig = filter.Rig
+		q.Visibility = visibilityName(filter.Visibility)
+		if t, err := time.Parse(time.RFC3339, filter.After); err == nil {
+			q.Since = t
+		}
+		if t, err := time.Parse(time.RFC3339, filter.Before); err == nil {
+			q.Until = t
+		}
+	}
+	return events.Open(s.townRoot).Query(q)
+}
+
+// eventToProto converts a raw log event to its RPC form.
+func eventToProto(ev events.Event) *gastownv1.ActivityEvent {
+	event := &gastownv1.ActivityEvent{
+		Timestamp:  ev.Timestamp,
+		Source:     ev.Source,
+		Type:       ev.Type,
+		Actor:      ev.Actor,
+		Visibility: visibilityFromName(ev.Visibility),
+	}
+	if ev.Payload != nil {
+		event.Payload = mapToStruct(ev.Payload)
+	}
+	return event
+}
+
+// visibilityName maps a Visibility to the string stored in the events log,
+// or "" for UNSPECIFIED.
+func visibilityName(v gastownv1.Visibility) string {
+	switch v {
+	case gastownv1.Visibility_VISIBILITY_AUDIT:
+		return events.VisibilityAudit
+	case gastownv1.Visibility_VISIBILITY_FEED:
+		return events.VisibilityFeed
+	case gastownv1.Visibility_VISIBILITY_BOTH:
+		return events.VisibilityBoth
+	}
+	return ""
+}
+
+func visibilityFromName(name string) gastownv1.Visibility {
+	switch name {
+	case events.VisibilityAudit:
+		return gastownv1.Visibility_VISIBILITY_AUDIT
+	case events.VisibilityFeed:
+		return gastownv1.Visibility_VISIBILITY_FEED
+	case events.VisibilityBoth:
+		return gastownv1.Visibility_VISIBILITY_BOTH
+	}
+	return gastownv1.Visibility_VISIBILITY_UNSPECIFIED
 }
 
 func (s *ActivityServer) readFeedEvents(filter *gastownv1.EventFilter, limit int) ([]*gastownv1.ActivityEvent, int) {
@@ -2659,6 +2760,10 @@ func (s *ActivityServer) matchesFilter(event *gastownv1.ActivityEvent, filter *g
 		return false
 	}
 
+	if filter.Rig != "" && protoEventRig(event) != filter.Rig {
+		return false
+	}
+
 	if filter.Visibility != gastownv1.Visibility_VISIBILITY_UNSPECIFIED && event.Visibility != filter.Visibility {
 		return false
 	}
@@ -2686,6 +2791,18 @@ func (s *ActivityServer) matchesFilter(event *gastownv1.ActivityEvent, filter *g
 	return true
 }
 
+// protoEventRig returns the rig an event belongs to: its payload "rig" field,
+// or the first segment of a rig-scoped actor address.
+func protoEventRig(event *gastownv1.ActivityEvent) string {
+	if rig := event.GetPayload().GetFields()["rig"].GetStringValue(); rig != "" {
+		return rig
+	}
+	if i := strings.Index(event.Actor, "/"); i > 0 {
+		return event.Actor[:i]
+	}
+	return ""
+}
+
 func structToMap(s *structpb.Struct) map[string]interface{} {
 	if s == nil {
 		return nil
@@ -2737,15 +2854,14 @@ func mapToStruct(m map[string]interface{}) *structpb.Struct {
 // TerminalServer implements the TerminalService.
 type TerminalServer struct {
 	backend terminal.Backend
+	outputs *terminal.OutputHub // shared capture loops for WatchSession
 }
 
 var _ gastownv1connect.TerminalServiceHandler = (*TerminalServer)(nil)
 
 // NewTerminalServer creates a new TerminalServer.
 func NewTerminalServer() *TerminalServer {
-	return &TerminalServer{
-		backend: terminal.NewCoopBackend(terminal.CoopConfig{}),
-	}
+	return NewTerminalServerWithBackend(terminal.NewCoopBackend(terminal.CoopConfig{}))
 }
 
 // NewTerminalServerWithBackend creates a TerminalServer with a custom terminal backend.
@@ -2753,9 +2869,16 @@ func NewTerminalServer() *TerminalServer {
 func NewTerminalServerWithBackend(backend terminal.Backend) *TerminalServer {
 	return &TerminalServer{
 		backend: backend,
+		outputs: terminal.NewOutputHub(backend),
 	}
 }
 
+// SetOutputHub shares an output hub with other servers, so every watcher of
+// a session in this process shares one capture loop.
+func (s *TerminalServer) SetOutputHub(h *terminal.OutputHub) {
+	s.outputs = h
+}
+
 func (s *TerminalServer) PeekSession(
 	ctx context.Context,
 	req *connect.Request[gastownv1.PeekSessionRequest],
@@ -2857,7 +2980,13 @@ func (s *TerminalServer) WatchSession(
 		intervalMs = 1000
 	}
 
-	ticker := time.NewTicker(time.Duration(intervalMs) * time.Millisecond)
+	sub, err := s.outputs.Subscribe(ctx, session, lines, time.Duration(intervalMs)*time.Millisecond)
+	if err != nil {
+		return nil // client went away
+	}
+	defer sub.Close()
+
+	ticker := time.NewTicker(sub.Interval())
 	defer ticker.Stop()
 
 	for {
@@ -2865,8 +2994,8 @@ func (s *TerminalServer) WatchSession(
 		case <-ctx.Done():
 			return nil
 		case <-ticker.C:
-			exists, err := s.backend.HasSession(session)
-			if err != nil {
+			snap, _ := sub.Latest()
+			if snap.Err != nil {
 				if err := stream.Send(&gastownv1.TerminalUpdate{
 					Exists:    false,
 					Timestamp: time.Now().UTC().Format(time.RFC3339),
@@ -2876,7 +3005,7 @@ func (s *TerminalServer) WatchSession(
 				continue
 			}
 
-			if !exists {
+			if !snap.Exists {
 				if err := stream.Send(&gastownv1.TerminalUpdate{
 					Exists:    false,
 					Timestamp: time.Now().UTC().Format(time.RFC3339),
@@ -2886,21 +3015,11 @@ func (s *TerminalServer) WatchSession(
 				return nil
 			}
 
-			output, err := s.backend.CapturePane(session, lines)
-			if err != nil {
-				continue
-			}
-
-			var lineSlice []string
-			if output != "" {
-				lineSlice = strings.Split(output, "\n")
-			}
-
 			if err := stream.Send(&gastownv1.TerminalUpdate{
-				Output:    output,
-				Lines:     lineSlice,
+				Output:    strings.Join(snap.Lines, "\n"),
+				Lines:     snap.Lines,
 				Exists:    true,
-				Timestamp: time.Now().UTC().Format(time.RFC3339),
+				Timestamp: snap.Time.UTC().Format(time.RFC3339),
 			}); err != nil {
 				return err
 			}
@@ -2965,6 +3084,54 @@ type ServerConfig struct {
 	APIKey   string
 	CertFile string
 	KeyFile  string
+	// ClientCAFile enables mutual TLS: clients must present a certificate
+	// signed by this CA.
+	ClientCAFile string
+	// TLSDir is a mounted Kubernetes TLS secret (tls.crt, tls.key, ca.crt).
+	// Explicit files above take precedence over its entries. Its ca.crt is
+	// only used for client certificates when RequireClientCerts is set.
+	TLSDir string
+	// RequireClientCerts enables mutual TLS with the CA from ClientCAFile
+	// or TLSDir.
+	RequireClientCerts bool
+	// AuditBeads also records each audit log entry as a closed event bead.
+	AuditBeads bool
+	// RateLimit, when its Rate is set, overrides the default per-client
+	// limit from mayor/ratelimits.json.
+	RateLimit RateLimit
+	// ShutdownTimeout is how long in-flight requests and streams get to
+	// finish on SIGTERM before they are cancelled (default
+	// DefaultShutdownTimeout).
+	ShutdownTimeout time.Duration
+	// DrainDelay keeps serving for this long after SIGTERM while /health
+	// reports draining, so load balancers stop routing here first.
+	DrainDelay time.Duration
+	// StatusReconcile is how often the live town state behind fast
+	// GetTownStatus calls is rebuilt (default DefaultReconcileInterval).
+	StatusReconcile time.Duration
+	// SearchReindex is how often the SearchIssues index is rebuilt from bd
+	// (default DefaultSearchReindex).
+	SearchReindex time.Duration
+}
+
+// tlsFiles merges TLSDir with the explicit certificate files. The CA is
+// left empty unless mutual TLS was asked for, so a secret that happens to
+// carry a ca.crt doesn't start rejecting clients.
+func (cfg ServerConfig) tlsFiles() mtls.Files {
+	f := mtls.FromDir(cfg.TLSDir)
+	if !cfg.RequireClientCerts {
+		f.CA = ""
+	}
+	if cfg.CertFile != "" {
+		f.Cert = cfg.CertFile
+	}
+	if cfg.KeyFile != "" {
+		f.Key = cfg.KeyFile
+	}
+	if cfg.ClientCAFile != "" {
+		f.CA = cfg.ClientCAFile
+	}
+	return f
 }
 
 // RunServer starts the RPC server with the given configuration.
@@ -3009,22 +3176,74 @@ func RunServer(cfg ServerConfig) error {
 	defer decisionPoller.Stop()
 
 	// Create service handlers
-	statusServer := NewStatusServer(root)
+	// Shared status collector: one cache for every server in this process.
+	collector := NewStatusCollector(root, terminal.NewCoopBackend(terminal.CoopConfig{}), 0)
+	defer func() { _ = collector.Close() }()
+	// The collector also keeps the live town state fast status calls are
+	// served from, fed by the event log.
+	liveCtx, stopLive := context.WithCancel(context.Background())
+	defer stopLive()
+	go collector.Run(liveCtx, cfg.StatusReconcile)
+	statusServer := NewStatusServerWithCollector(root, collector)
 	mailServer := NewMailServer(root)
 	decisionServer := NewDecisionServer(root, decisionBus)
 	decisionServer.SetPoller(decisionPoller) // Wire up poller to prevent duplicates
 	convoyServer := NewConvoyServer(root)
 	activityServer := NewActivityServer(root)
+	// Shared output hub: one capture loop per watched session, however many
+	// terminal and agent output streams watch it.
+	outputHub := terminal.NewOutputHub(terminal.NewCoopBackend(terminal.CoopConfig{}))
 	terminalServer := NewTerminalServer()
+	terminalServer.SetOutputHub(outputHub)
 	slingServer := NewSlingServer(root)
 	agentServer := NewAgentServer(root)
+	agentServer.SetStatusCollector(collector)
+	agentServer.SetOutputHub(outputHub)
 	beadsServer := NewBeadsServer(root)
-
-	// Set up interceptors
-	var opts []connect.HandlerOption
-	if cfg.APIKey != "" {
-		opts = append(opts, connect.WithInterceptors(APIKeyInterceptor(cfg.APIKey)))
-		log.Printf("API key authentication enabled")
+	go beadsServer.RunSearchIndex(liveCtx, cfg.SearchReindex)
+	witnessServer := NewWitnessServer(root)
+	escalationServer := NewEscalationServer(root)
+	auditServer := NewAuditServer(root)
+	usageServer := NewUsageServer(root)
+	pushServer := NewPushServer(root, startPushWatcher(liveCtx, root)) // Stops with the server
+	syncServer := NewSyncServer(root, collector)
+	moleculeServer := NewMoleculeServer(root)
+	dogServer := NewDogServer(root)
+
+	// Set up interceptors. The key store is re-read when 'gt apikey' changes
+	// it, so auth applies as soon as the first key is created. The limiter
+	// runs before audit so a flood of rejected calls can't flood the audit
+	// log too; audit wraps auth so calls auth rejects are logged. Metrics
+	// wraps everything so limited and rejected calls are counted.
+	keyStore, err := LoadAPIKeyStore(root)
+	if err != nil {
+		return err
+	}
+	auth := NewAuthInterceptor(cfg.APIKey, keyStore)
+	auditLog := NewAuditLog(root)
+	if cfg.AuditBeads {
+		auditLog.MirrorToBeads(beads.New(townBeadsPath))
+	}
+	rateCfg, err := LoadRateLimitConfig(root)
+	if err != nil {
+		return err
+	}
+	if cfg.RateLimit.Rate > 0 {
+		rateCfg.Default = cfg.RateLimit
+	}
+	limiter := NewRateLimiter(rateCfg, auth)
+	opts := []connect.HandlerOption{
+		connect.WithInterceptors(MetricsInterceptor{}, limiter, NewAuditInterceptor(auditLog, auth), auth),
+	}
+	registerStuckAgentsGauge(root)
+	if auth.Enabled() {
+		log.Printf("API key authentication enabled (%d stored keys)", len(keyStore.List()))
+	} else {
+		log.Printf("WARNING: no API keys configured; RPCs are unauthenticated until 'gt apikey create'")
+	}
+	if rateCfg.Enabled() {
+		log.Printf("Rate limiting enabled (default %.1f/s burst %d, %d key and %d method overrides)",
+			rateCfg.Default.Rate, rateCfg.Default.Burst, len(rateCfg.Keys), len(rateCfg.Methods))
 	}
 
 	// Create HTTP mux with Connect handlers
@@ -3058,49 +3277,62 @@ func RunServer(cfg ServerConfig) error {
 	beadsPath, beadsHandler := gastownv1connect.NewBeadsServiceHandler(beadsServer, opts...)
 	mux.Handle(beadsPath, beadsHandler)
 
-	// Health check endpoint - structured health with component details
-	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		resp, _ := statusServer.HealthCheck(r.Context(), connect.NewRequest(&gastownv1.HealthCheckRequest{}))
-		hc := resp.Msg
-
-		type componentJSON struct {
-			Name      string `json:"name"`
-			Healthy   bool   `json:"healthy"`
-			LatencyMs int64  `json:"latency_ms"`
-			Message   string `json:"message"`
-		}
-		type healthJSON struct {
-			Status     string          `json:"status"`
-			Components []componentJSON `json:"components"`
-		}
-
-		out := healthJSON{Status: hc.Status}
-		for _, c := range hc.Components {
-			out.Components = append(out.Components, componentJSON{
-				Name:      c.Name,
-				Healthy:   c.Healthy,
-				LatencyMs: c.LatencyMs,
-				Message:   c.Message,
-			})
-		}
+	witnessPath, witnessHandler := gastownv1connect.NewWitnessServiceHandler(witnessServer, opts...)
+	mux.Handle(witnessPath, witnessHandler)
 
-		w.Header().Set("Content-Type", "application/json")
-		if hc.Status != "healthy" {
-			w.WriteHeader(http.StatusServiceUnavailable)
-		}
-		json.NewEncoder(w).Encode(out)
-	})
+	escalationPath, escalationHandler := gastownv1connect.NewEscalationServiceHandler(escalationServer, opts...)
+	mux.Handle(escalationPath, escalationHandler)
+
+	auditPath, auditHandler := gastownv1connect.NewAuditServiceHandler(auditServer, opts...)
+	mux.Handle(auditPath, auditHandler)
+
+	usagePath, usageHandler := gastownv1connect.NewUsageServiceHandler(usageServer, opts...)
+	mux.Handle(usagePath, usageHandler)
+
+	pushPath, pushHandler := gastownv1connect.NewPushServiceHandler(pushServer, opts...)
+	mux.Handle(pushPath, pushHandler)
+
+	syncPath, syncHandler := gastownv1connect.NewSyncServiceHandler(syncServer, opts...)
+	mux.Handle(syncPath, syncHandler)
+
+	moleculePath, moleculeHandler := gastownv1connect.NewMoleculeServiceHandler(moleculeServer, opts...)
+	mux.Handle(moleculePath, moleculeHandler)
+
+	dogPath, dogHandler := gastownv1connect.NewDogServiceHandler(dogServer, opts...)
+	mux.Handle(dogPath, dogHandler)
+
+	// Tracks in-flight requests so SIGTERM can drain them
+	drainer := NewDrainer()
+
+	// Health endpoints - structured health with component details. /health
+	// checks everything; /health/live and /health/ready are for K8s probes.
+	mux.HandleFunc("/health", newHealthHandler(statusServer, drainer, gastownv1.HealthProbe_HEALTH_PROBE_UNSPECIFIED))
+	mux.HandleFunc("/health/live", newHealthHandler(statusServer, drainer, gastownv1.HealthProbe_HEALTH_PROBE_LIVENESS))
+	mux.HandleFunc("/health/ready", newHealthHandler(statusServer, drainer, gastownv1.HealthProbe_HEALTH_PROBE_READINESS))
 
 	// SSE endpoint for decision events (browser-friendly streaming)
 	mux.HandleFunc("/events/decisions", NewSSEHandler(decisionBus, root))
 
-	// Metrics endpoint
+	// Metrics endpoint: Prometheus text by default; the event bus and rate
+	// limiter snapshot as JSON for ?format=json or Accept: application/json.
 	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
-		metrics := decisionBus.Metrics()
+		if r.URL.Query().Get("format") != "json" && !strings.Contains(r.Header.Get("Accept"), "application/json") {
+			metrics.Handler().ServeHTTP(w, r)
+			return
+		}
+		busMetrics := decisionBus.Metrics()
 		w.Header().Set("Content-Type", "application/json")
-		fmt.Fprintf(w, `{"events_published":%d,"events_delivered":%d,"events_dropped":%d,"subscribers_active":%d,"subscribers_total":%d}`,
-			metrics.EventsPublished, metrics.EventsDelivered, metrics.EventsDropped,
-			metrics.SubscribersActive, metrics.SubscribersTotal)
+		json.NewEncoder(w).Encode(struct {
+			EventsPublished   int64          `json:"events_published"`
+			EventsDelivered   int64          `json:"events_delivered"`
+			EventsDropped     int64          `json:"events_dropped"`
+			SubscribersActive int            `json:"subscribers_active"`
+			SubscribersTotal  int64          `json:"subscribers_total"`
+			RateLimit         RateLimitStats `json:"rate_limit"`
+		}{
+			busMetrics.EventsPublished, busMetrics.EventsDelivered, busMetrics.EventsDropped,
+			busMetrics.SubscribersActive, busMetrics.SubscribersTotal, limiter.Stats(),
+		})
 	})
 
 	addr := fmt.Sprintf(":%d", cfg.Port)
@@ -3116,36 +3348,87 @@ func RunServer(cfg ServerConfig) error {
 	log.Printf("  %s", slingPath)
 	log.Printf("  %s", agentPath)
 	log.Printf("  %s", beadsPath)
-	log.Printf("  /health")
+	log.Printf("  %s", witnessPath)
+	log.Printf("  %s", auditPath)
+	log.Printf("  %s", usagePath)
+	log.Printf("  %s", pushPath)
+	log.Printf("  %s", syncPath)
+	log.Printf("  /health, /health/live, /health/ready")
+	log.Printf("  /metrics")
 
 	// Wrap mux with panic recovery middleware
 	handler := RecoveryMiddleware(mux)
 
-	// Start server (TLS or plain HTTP)
-	if cfg.CertFile != "" && cfg.KeyFile != "" {
-		tlsConfig, err := LoadTLSConfig(cfg.CertFile, cfg.KeyFile)
-		if err != nil {
-			return fmt.Errorf("load TLS config: %w", err)
-		}
-		server := &http.Server{
-			Addr:           addr,
-			Handler:        handler,
-			TLSConfig:      tlsConfig,
-			ReadTimeout:    30 * time.Second,
-			WriteTimeout:   30 * time.Second,
-			IdleTimeout:    120 * time.Second,
-			MaxHeaderBytes: 1 << 20, // 1MB
-		}
-		log.Printf("TLS enabled")
-		return server.ListenAndServeTLS("", "")
-	}
+	// Set up TLS (or plain HTTP)
 	server := &http.Server{
 		Addr:           addr,
-		Handler:        handler,
 		ReadTimeout:    30 * time.Second,
 		WriteTimeout:   30 * time.Second,
 		IdleTimeout:    120 * time.Second,
 		MaxHeaderBytes: 1 << 20, // 1MB
 	}
-	return server.ListenAndServe()
+	serve := server.ListenAndServe
+	files := cfg.tlsFiles()
+	if files.CA != "" && (files.Cert == "" || files.Key == "") {
+		return fmt.Errorf("mutual TLS needs a server certificate and key")
+	}
+	if cfg.RequireClientCerts && files.CA == "" {
+		return fmt.Errorf("mutual TLS needs a client CA (--client-ca or ca.crt in --tls-dir)")
+	}
+	if files.Cert != "" && files.Key != "" {
+		tlsConfig, err := files.ServerConfig()
+		if err != nil {
+			return fmt.Errorf("load TLS config: %w", err)
+		}
+		if files.CA != "" {
+			// Agents may only send as the addresses in their certificate
+			handler = mtls.AgentIdentityMiddleware(handler)
+			log.Printf("Client certificates required (CA: %s)", files.CA)
+		}
+		server.TLSConfig = tlsConfig
+		serve = func() error { return server.ListenAndServeTLS("", "") }
+		log.Printf("TLS enabled")
+	}
+	server.Handler = drainer.Middleware(handler)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	errc := make(chan error, 1)
+	go func() { errc <- serve() }()
+	if err := sdNotify("READY=1"); err != nil {
+		log.Printf("Warning: notifying systemd: %v", err)
+	}
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+	stop() // a second signal kills the process
+
+	// Coordinated shutdown: go unready, refuse new requests, let in-flight
+	// requests and streams finish (aborting stragglers), then flush audit
+	// mirrors before the deferred closes stop the poller, bus, and collector.
+	timeout := cfg.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	log.Printf("Shutting down: %d requests in flight, waiting up to %v", drainer.InFlight(), timeout)
+	_ = sdNotify("STOPPING=1")
+	drainer.Unready()
+	if cfg.DrainDelay > 0 {
+		time.Sleep(cfg.DrainDelay)
+	}
+	aborted, err := shutdownServer(server, drainer, timeout)
+	if aborted > 0 {
+		log.Printf("Aborted %d requests still running after %v", aborted, timeout)
+	}
+	if !auditLog.Flush(abortGrace) {
+		log.Printf("Warning: audit entries still mirroring to beads at exit")
+	}
+	if err != nil {
+		return fmt.Errorf("shutting down: %w", err)
+	}
+	log.Printf("RPC server stopped")
+	return nil
 }
@@ -0,0 +1,149 @@
+package rpcserver
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultShutdownTimeout is how long a stopping server lets in-flight
+// requests and streams finish before cancelling them.
+const DefaultShutdownTimeout = 20 * time.Second
+
+// abortGrace is how long handlers get to return after their contexts are
+// cancelled before connections are closed outright.
+const abortGrace = 3 * time.Second
+
+// Drainer coordinates a graceful stop of the RPC server. Once unready,
+// health checks report the server as draining so load balancers and
+// readiness probes stop routing to it; once draining, new requests are
+// refused with 503 while in-flight ones, streams included, run on until
+// they finish or are aborted.
+type Drainer struct {
+	unready  atomic.Bool
+	draining atomic.Bool
+
+	mu       sync.Mutex
+	inflight map[*http.Request]context.CancelFunc
+}
+
+// NewDrainer creates a drainer for a server that is ready and accepting.
+func NewDrainer() *Drainer {
+	return &Drainer{inflight: make(map[*http.Request]context.CancelFunc)}
+}
+
+// Middleware tracks in-flight requests so they can be aborted, and refuses
+// new ones while draining. Health checks are always served, so probes can
+// watch the server drain.
+func (d *Drainer) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isHealthPath(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if d.draining.Load() {
+			w.Header().Set("Connection", "close")
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+
+		ctx, cancel := context.WithCancel(r.Context())
+		r = r.WithContext(ctx)
+		d.mu.Lock()
+		d.inflight[r] = cancel
+		d.mu.Unlock()
+		defer func() {
+			d.mu.Lock()
+			delete(d.inflight, r)
+			d.mu.Unlock()
+			cancel()
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isHealthPath reports whether a path is a health endpoint.
+func isHealthPath(path string) bool {
+	return path == "/health" || strings.HasPrefix(path, "/health/")
+}
+
+// Unready marks the server as going away without refusing requests yet.
+func (d *Drainer) Unready() {
+	d.unready.Store(true)
+}
+
+// Ready reports whether the server should receive new traffic.
+func (d *Drainer) Ready() bool {
+	return !d.unready.Load()
+}
+
+// Drain marks the server unready and refuses new requests.
+func (d *Drainer) Drain() {
+	d.unready.Store(true)
+	d.draining.Store(true)
+}
+
+// InFlight returns the number of requests being handled.
+func (d *Drainer) InFlight() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.inflight)
+}
+
+// Abort cancels the contexts of all in-flight requests, ending streams,
+// and returns how many there were.
+func (d *Drainer) Abort() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, cancel := range d.inflight {
+		cancel()
+	}
+	return len(d.inflight)
+}
+
+// shutdownServer stops srv gracefully: it refuses new requests, waits up to
+// timeout for in-flight ones to finish, then aborts whatever is left (in
+// practice, long-lived streams) and closes the remaining connections. It
+// returns how many requests were aborted.
+func shutdownServer(srv *http.Server, d *Drainer, timeout time.Duration) (int, error) {
+	d.Drain()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err == nil {
+		return 0, nil
+	}
+
+	aborted := d.Abort()
+	ctx, cancel = context.WithTimeout(context.Background(), abortGrace)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		return aborted, srv.Close()
+	}
+	return aborted, nil
+}
+
+// sdNotify sends a state change (e.g. "READY=1") to systemd when running
+// under a Type=notify unit. Outside systemd it does nothing.
+func sdNotify(state string) error {
+	socket := os.Getenv("NOTIFY_SOCKET")
+	if socket == "" {
+		return nil
+	}
+	if socket[0] == '@' {
+		socket = "\x00" + socket[1:] // abstract namespace
+	}
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Write([]byte(state))
+	return err
+}
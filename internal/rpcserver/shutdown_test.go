@@ -0,0 +1,163 @@
+package rpcserver
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDrainerRefusesWhileDraining(t *testing.T) {
+	d := NewDrainer()
+	h := d.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(path string) int {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+		return rec.Code
+	}
+
+	if got := serve("/gastown.v1.StatusService/GetTownStatus"); got != http.StatusOK {
+		t.Errorf("before drain: status %d, want 200", got)
+	}
+
+	d.Unready()
+	if d.Ready() {
+		t.Error("Ready after Unready")
+	}
+	if got := serve("/gastown.v1.StatusService/GetTownStatus"); got != http.StatusOK {
+		t.Errorf("unready: status %d, want 200 (still serving)", got)
+	}
+
+	d.Drain()
+	if got := serve("/gastown.v1.StatusService/GetTownStatus"); got != http.StatusServiceUnavailable {
+		t.Errorf("draining: status %d, want 503", got)
+	}
+	if got := serve("/health"); got != http.StatusOK {
+		t.Errorf("draining: /health status %d, want 200 (probes pass through)", got)
+	}
+}
+
+func TestShutdownServerAbortsStreams(t *testing.T) {
+	d := NewDrainer()
+	started := make(chan struct{})
+	ended := make(chan error, 1)
+	srv := &http.Server{Handler: d.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		close(started)
+		<-r.Context().Done() // a stream that only ends when cancelled
+		ended <- r.Context().Err()
+	}))}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Serve(ln)
+
+	resp, err := http.Get("http://" + ln.Addr().String() + "/stream")
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	defer resp.Body.Close()
+	<-started
+	if got := d.InFlight(); got != 1 {
+		t.Errorf("InFlight = %d, want 1", got)
+	}
+
+	start := time.Now()
+	aborted, err := shutdownServer(srv, d, 50*time.Millisecond)
+	if err != nil {
+		t.Fatalf("shutdownServer: %v", err)
+	}
+	if aborted != 1 {
+		t.Errorf("aborted = %d, want 1", aborted)
+	}
+	if elapsed := time.Since(start); elapsed > abortGrace {
+		t.Errorf("shutdown took %v", elapsed)
+	}
+	select {
+	case err := <-ended:
+		if err != context.Canceled {
+			t.Errorf("stream ended with %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("stream was not cancelled")
+	}
+}
+
+func TestShutdownServerWaitsForRequests(t *testing.T) {
+	d := NewDrainer()
+	release := make(chan struct{})
+	started := make(chan struct{})
+	srv := &http.Server{Handler: d.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusNoContent)
+	}))}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Serve(ln)
+
+	codes := make(chan int, 1)
+	go func() {
+		resp, err := http.Get("http://" + ln.Addr().String() + "/slow")
+		if err != nil {
+			codes <- 0
+			return
+		}
+		resp.Body.Close()
+		codes <- resp.StatusCode
+	}()
+	<-started
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+	}()
+	aborted, err := shutdownServer(srv, d, 5*time.Second)
+	if err != nil || aborted != 0 {
+		t.Errorf("shutdownServer = %d, %v; want 0, nil", aborted, err)
+	}
+	if got := <-codes; got != http.StatusNoContent {
+		t.Errorf("in-flight request got %d, want 204", got)
+	}
+}
+
+func TestSdNotify(t *testing.T) {
+	t.Setenv("NOTIFY_SOCKET", "")
+	if err := sdNotify("READY=1"); err != nil {
+		t.Errorf("sdNotify without a socket: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	if err != nil {
+		t.Skipf("unixgram sockets unavailable: %v", err)
+	}
+	defer conn.Close()
+	defer os.Remove(path)
+
+	t.Setenv("NOTIFY_SOCKET", path)
+	if err := sdNotify("STOPPING=1"); err != nil {
+		t.Fatalf("sdNotify: %v", err)
+	}
+	buf := make([]byte, 64)
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatalf("reading notification: %v", err)
+	}
+	if got := string(buf[:n]); got != "STOPPING=1" {
+		t.Errorf("notification = %q, want STOPPING=1", got)
+	}
+}
@@ -64,8 +64,9 @@ func (s *SlingServer) Sling(
 		Output:        io.Discard,
 	})
 	if err != nil {
-		return nil, classifyErr("sling", err)
+		return nil, slingErr("sling", err)
 	}
+	slings.Inc("bead")
 
 	return connect.NewResponse(&gastownv1.SlingResponse{
 		BeadId:         result.BeadID,
@@ -109,8 +110,9 @@ func (s *SlingServer) SlingFormula(
 		Output:   io.Discard,
 	})
 	if err != nil {
-		return nil, classifyErr("sling formula", err)
+		return nil, slingErr("sling formula", err)
 	}
+	slings.Inc("formula")
 
 	return connect.NewResponse(&gastownv1.SlingFormulaResponse{
 		WispId:         result.WispID,
@@ -145,8 +147,9 @@ func (s *SlingServer) SlingBatch(
 		Output:   io.Discard,
 	})
 	if err != nil {
-		return nil, classifyErr("batch sling", err)
+		return nil, slingErr("batch sling", err)
 	}
+	slings.Add(float64(result.SuccessCount), "batch")
 
 	// Map internal results to proto response
 	protoResults := make([]*gastownv1.BatchSlingResult, 0, len(result.Results))
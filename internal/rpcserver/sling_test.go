@@ -1,7 +1,15 @@
 package rpcserver
 
 import (
+	"context"
+	"errors"
 	"testing"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/sling"
 )
 
 func TestMergeStrategyToString(t *testing.T) {
@@ -44,3 +52,15 @@ func TestPriorityToString(t *testing.T) {
 		})
 	}
 }
+
+func TestSlingServerRefusesPausedTown(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := deacon.Pause(townRoot, "upgrading dolt", "overseer"); err != nil {
+		t.Fatal(err)
+	}
+	srv := NewSlingServer(townRoot)
+	_, err := srv.Sling(context.Background(), connect.NewRequest(&gastownv1.SlingRequest{BeadId: "gt-1", Target: "gastown"}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition || !errors.Is(err, sling.ErrTownPaused) {
+		t.Errorf("Sling() error = %v, want FailedPrecondition wrapping ErrTownPaused", err)
+	}
+}
@@ -0,0 +1,373 @@
+package rpcserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/mail"
+)
+
+const (
+	// syncRefreshInterval bounds how often Sync re-reads its sources;
+	// clients syncing in between share one snapshot.
+	syncRefreshInterval = 2 * time.Second
+
+	// syncRetention is how long deleted and resolved entities stay in the
+	// journal. A cursor older than that gets a full resync.
+	syncRetention = 7 * 24 * time.Hour
+
+	defaultSyncLimit = 500
+	maxSyncLimit     = 5000
+)
+
+// syncSource lists the current state of one entity type.
+type syncSource struct {
+	entity gastownv1.SyncEntity
+
+	// list returns every live entity by ID. On error the source is skipped
+	// for this refresh rather than treated as empty.
+	list func() (map[string]proto.Message, error)
+
+	// final, if set, returns the last state of an entity list no longer
+	// returns (e.g. a resolved decision). nil means it was deleted.
+	final func(id string) proto.Message
+}
+
+// syncEntry is the journal's latest record of one entity.
+type syncEntry struct {
+	seq       uint64
+	entity    gastownv1.SyncEntity
+	id        string
+	payload   proto.Message // nil when deleted
+	sum       [sha256.Size]byte
+	deleted   bool
+	retired   bool // No longer listed; payload is its final state
+	changedAt time.Time
+}
+
+// SyncJournal assigns sequence numbers to changes in the entities mobile
+// clients mirror. It keeps one entry per entity, holding its latest state
+// and the sequence number of its latest change, so a client that was
+// offline for a day gets each changed entity once rather than every
+// intermediate version.
+//
+// Changes are found by diffing snapshots of the sources, so they are
+// caught no matter which process made them. The journal lives in memory;
+// its epoch changes on restart, and cursors from another epoch resync.
+type SyncJournal struct {
+	sources []syncSource
+	now     func() time.Time
+
+	mu          sync.Mutex
+	epoch       string
+	seq         uint64
+	floor       uint64 // Cursors below this missed pruned deletions
+	entries     map[string]*syncEntry
+	refreshedAt time.Time
+}
+
+// NewSyncJournal creates a journal over the given sources.
+func NewSyncJournal(sources ...syncSource) *SyncJournal {
+	return &SyncJournal{
+		sources: sources,
+		now:     time.Now,
+		epoch:   strconv.FormatInt(time.Now().UnixNano(), 36),
+		entries: make(map[string]*syncEntry),
+	}
+}
+
+func syncKey(entity gastownv1.SyncEntity, id string) string {
+	return entity.String() + "/" + id
+}
+
+// refresh snapshots every source and records what changed since the last
+// snapshot. Callers hold j.mu.
+func (j *SyncJournal) refresh() {
+	now := j.now()
+	if !j.refreshedAt.IsZero() && now.Sub(j.refreshedAt) < syncRefreshInterval {
+		return
+	}
+	j.refreshedAt = now
+
+	for _, src := range j.sources {
+		live, err := src.list()
+		if err != nil {
+			log.Printf("sync: listing %s: %v", src.entity, err)
+			continue
+		}
+		ids := make([]string, 0, len(live))
+		for id := range live {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids) // stable sequence numbers within a refresh
+
+		for _, id := range ids {
+			payload := live[id]
+			sum := syncSum(payload)
+			key := syncKey(src.entity, id)
+			if e := j.entries[key]; e != nil && !e.deleted && !e.retired && e.sum == sum {
+				continue
+			}
+			j.record(&syncEntry{entity: src.entity, id: id, payload: payload, sum: sum})
+		}
+
+		var gone []*syncEntry
+		for _, e := range j.entries {
+			if e.entity != src.entity || e.deleted || e.retired {
+				continue
+			}
+			if _, ok := live[e.id]; !ok {
+				gone = append(gone, e)
+			}
+		}
+		sort.Slice(gone, func(a, b int) bool { return gone[a].id < gone[b].id })
+		for _, e := range gone {
+			next := &syncEntry{entity: e.entity, id: e.id, deleted: true}
+			if src.final != nil {
+				if payload := src.final(e.id); payload != nil {
+					next = &syncEntry{entity: e.entity, id: e.id, payload: payload, retired: true}
+				}
+			}
+			j.record(next)
+		}
+	}
+
+	// Forget deleted and retired entities past retention. Clients whose
+	// cursor predates one of them may still hold it, so they must resync.
+	for key, e := range j.entries {
+		if (e.deleted || e.retired) && now.Sub(e.changedAt) > syncRetention {
+			delete(j.entries, key)
+			if e.seq >= j.floor {
+				j.floor = e.seq + 1
+			}
+		}
+	}
+}
+
+func (j *SyncJournal) record(e *syncEntry) {
+	j.seq++
+	e.seq = j.seq
+	e.changedAt = j.now()
+	j.entries[syncKey(e.entity, e.id)] = e
+}
+
+func syncSum(m proto.Message) [sha256.Size]byte {
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	return sha256.Sum256(data)
+}
+
+// cursor encodes a position in this journal's epoch.
+func (j *SyncJournal) cursor(seq uint64) string {
+	return fmt.Sprintf("v1.%s.%d", j.epoch, seq)
+}
+
+// parseCursor returns the sequence number in cursor, and false if the
+// cursor is empty, malformed, from another epoch, or too old to honor.
+func (j *SyncJournal) parseCursor(cursor string) (uint64, bool) {
+	parts := strings.Split(cursor, ".")
+	if len(parts) != 3 || parts[0] != "v1" || parts[1] != j.epoch {
+		return 0, false
+	}
+	seq, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil || seq > j.seq || seq+1 < j.floor {
+		return 0, false
+	}
+	return seq, true
+}
+
+// Changes returns up to limit entries changed after cursor, oldest first.
+// When cursor can't be honored, Resync is set and the entries are a full
+// snapshot of every entity not deleted.
+func (j *SyncJournal) Changes(cursor string, limit int, want map[gastownv1.SyncEntity]bool) (*gastownv1.SyncResponse, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.refresh()
+
+	after, ok := j.parseCursor(cursor)
+	resync := !ok
+	if resync {
+		after = 0
+	}
+
+	var matched []*syncEntry
+	for _, e := range j.entries {
+		if e.seq <= after || (resync && e.deleted) {
+			continue
+		}
+		if len(want) > 0 && !want[e.entity] {
+			continue
+		}
+		matched = append(matched, e)
+	}
+	sort.Slice(matched, func(a, b int) bool { return matched[a].seq < matched[b].seq })
+
+	resp := &gastownv1.SyncResponse{
+		Resync:     resync,
+		ServerTime: timestamppb.New(j.now()),
+	}
+	next := j.seq
+	if len(matched) > limit {
+		matched = matched[:limit]
+		next = matched[limit-1].seq
+		resp.HasMore = true
+	}
+	for _, e := range matched {
+		resp.Changes = append(resp.Changes, syncEntryToProto(e))
+	}
+	resp.Cursor = j.cursor(next)
+	return resp, nil
+}
+
+func syncEntryToProto(e *syncEntry) *gastownv1.SyncChange {
+	c := &gastownv1.SyncChange{
+		Seq:       e.seq,
+		Entity:    e.entity,
+		Id:        e.id,
+		Deleted:   e.deleted,
+		ChangedAt: timestamppb.New(e.changedAt),
+	}
+	switch p := e.payload.(type) {
+	case *gastownv1.Message:
+		c.Payload = &gastownv1.SyncChange_Message{Message: p}
+	case *gastownv1.Decision:
+		c.Payload = &gastownv1.SyncChange_Decision{Decision: p}
+	case *gastownv1.AgentRuntime:
+		c.Payload = &gastownv1.SyncChange_Agent{Agent: p}
+	}
+	return c
+}
+
+// SyncServer implements the SyncService.
+type SyncServer struct {
+	journal *SyncJournal
+}
+
+var _ gastownv1connect.SyncServiceHandler = (*SyncServer)(nil)
+
+// NewSyncServer creates a SyncServer over the overseer's inbox, the town's
+// decisions, and the agents collector reports.
+func NewSyncServer(townRoot string, collector *StatusCollector) *SyncServer {
+	return &SyncServer{journal: NewSyncJournal(
+		overseerMailSource(townRoot),
+		decisionSource(townRoot),
+		agentSource(collector),
+	)}
+}
+
+func (s *SyncServer) Sync(
+	ctx context.Context,
+	req *connect.Request[gastownv1.SyncRequest],
+) (*connect.Response[gastownv1.SyncResponse], error) {
+	limit := int(req.Msg.Limit)
+	if limit < 0 {
+		return nil, invalidArg("limit", "must not be negative")
+	}
+	if limit == 0 {
+		limit = defaultSyncLimit
+	}
+	if limit > maxSyncLimit {
+		limit = maxSyncLimit
+	}
+	var want map[gastownv1.SyncEntity]bool
+	if len(req.Msg.Entities) > 0 {
+		want = make(map[gastownv1.SyncEntity]bool)
+		for _, e := range req.Msg.Entities {
+			want[e] = true
+		}
+	}
+	resp, err := s.journal.Changes(req.Msg.Cursor, limit, want)
+	if err != nil {
+		return nil, internalErr("syncing", err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func overseerMailSource(townRoot string) syncSource {
+	return syncSource{
+		entity: gastownv1.SyncEntity_SYNC_ENTITY_MAIL,
+		list: func() (map[string]proto.Message, error) {
+			mailbox, err := mail.NewRouter(townRoot).GetMailbox("overseer")
+			if err != nil {
+				return nil, err
+			}
+			msgs, err := mailbox.List()
+			if err != nil {
+				return nil, err
+			}
+			out := make(map[string]proto.Message, len(msgs))
+			for _, m := range msgs {
+				out[m.ID] = mailMessageToProto(m)
+			}
+			return out, nil
+		},
+	}
+}
+
+func decisionSource(townRoot string) syncSource {
+	client := beads.New(beads.GetTownBeadsPath(townRoot))
+	return syncSource{
+		entity: gastownv1.SyncEntity_SYNC_ENTITY_DECISION,
+		list: func() (map[string]proto.Message, error) {
+			issues, err := client.ListDecisions()
+			if err != nil {
+				return nil, err
+			}
+			out := make(map[string]proto.Message, len(issues))
+			for _, issue := range issues {
+				if fields := beads.ParseDecisionFields(issue.Description); fields != nil {
+					out[issue.ID] = decisionToProto(issue.ID, fields)
+				}
+			}
+			return out, nil
+		},
+		final: func(id string) proto.Message {
+			issue, fields, err := client.GetDecisionBead(id)
+			if err != nil || issue == nil || fields == nil {
+				return nil
+			}
+			return decisionToProto(issue.ID, fields)
+		},
+	}
+}
+
+func agentSource(collector *StatusCollector) syncSource {
+	return syncSource{
+		entity: gastownv1.SyncEntity_SYNC_ENTITY_AGENT,
+		list: func() (map[string]proto.Message, error) {
+			status, err := collector.TownStatus(true)
+			if err != nil {
+				return nil, err
+			}
+			agents := append([]*gastownv1.AgentRuntime(nil), status.GlobalAgents...)
+			for _, r := range status.Rigs {
+				agents = append(agents, r.Agents...)
+			}
+			out := make(map[string]proto.Message, len(agents))
+			for _, a := range agents {
+				id := a.Session
+				if id == "" {
+					id = formatAgentAddress(a.Address)
+				}
+				if id != "" {
+					out[id] = a
+				}
+			}
+			return out, nil
+		},
+	}
+}
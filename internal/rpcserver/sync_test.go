@@ -0,0 +1,144 @@
+package rpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+)
+
+// fakeSyncSource is a syncSource whose entities tests edit directly.
+type fakeSyncSource struct {
+	live  map[string]proto.Message
+	final map[string]proto.Message
+}
+
+func (f *fakeSyncSource) source(entity gastownv1.SyncEntity) syncSource {
+	return syncSource{
+		entity: entity,
+		list: func() (map[string]proto.Message, error) {
+			out := make(map[string]proto.Message, len(f.live))
+			for id, m := range f.live {
+				out[id] = proto.Clone(m)
+			}
+			return out, nil
+		},
+		final: func(id string) proto.Message { return f.final[id] },
+	}
+}
+
+func newTestSyncServer(sources ...syncSource) (*SyncServer, *time.Time) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	j := NewSyncJournal(sources...)
+	j.now = func() time.Time { return now }
+	return &SyncServer{journal: j}, &now
+}
+
+func doSync(t *testing.T, srv *SyncServer, cursor string, limit int32) *gastownv1.SyncResponse {
+	t.Helper()
+	resp, err := srv.Sync(context.Background(), connect.NewRequest(&gastownv1.SyncRequest{Cursor: cursor, Limit: limit}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.Msg
+}
+
+func TestSyncReturnsDeltasSinceCursor(t *testing.T) {
+	inbox := &fakeSyncSource{live: map[string]proto.Message{
+		"m1": &gastownv1.Message{Id: "m1", Subject: "hello"},
+		"m2": &gastownv1.Message{Id: "m2", Subject: "status"},
+	}}
+	decisions := &fakeSyncSource{
+		live:  map[string]proto.Message{"d1": &gastownv1.Decision{Id: "d1", Question: "ship?"}},
+		final: map[string]proto.Message{"d1": &gastownv1.Decision{Id: "d1", Question: "ship?", Resolved: true}},
+	}
+	srv, now := newTestSyncServer(
+		inbox.source(gastownv1.SyncEntity_SYNC_ENTITY_MAIL),
+		decisions.source(gastownv1.SyncEntity_SYNC_ENTITY_DECISION),
+	)
+
+	first := doSync(t, srv, "", 0)
+	if !first.Resync || len(first.Changes) != 3 {
+		t.Fatalf("first sync: resync=%v changes=%d, want a 3-entity snapshot", first.Resync, len(first.Changes))
+	}
+
+	// Nothing changed: an empty delta, and the cursor holds.
+	*now = now.Add(time.Minute)
+	idle := doSync(t, srv, first.Cursor, 0)
+	if idle.Resync || len(idle.Changes) != 0 || idle.Cursor != first.Cursor {
+		t.Fatalf("idle sync = %v, want no changes", idle)
+	}
+
+	// m1 read, m2 deleted, m3 arrives, d1 resolved.
+	inbox.live["m1"].(*gastownv1.Message).Read = true
+	delete(inbox.live, "m2")
+	inbox.live["m3"] = &gastownv1.Message{Id: "m3", Subject: "new"}
+	delete(decisions.live, "d1")
+	*now = now.Add(time.Minute)
+
+	delta := doSync(t, srv, idle.Cursor, 0)
+	if delta.Resync || len(delta.Changes) != 4 {
+		t.Fatalf("delta: resync=%v changes=%v, want 4 changes", delta.Resync, delta.Changes)
+	}
+	byID := make(map[string]*gastownv1.SyncChange)
+	for _, c := range delta.Changes {
+		byID[c.Id] = c
+	}
+	if !byID["m1"].GetMessage().GetRead() {
+		t.Error("m1 change does not carry the read flag")
+	}
+	if !byID["m2"].Deleted || byID["m2"].Payload != nil {
+		t.Errorf("m2 = %v, want a deletion without payload", byID["m2"])
+	}
+	if !byID["d1"].GetDecision().GetResolved() {
+		t.Errorf("d1 = %v, want its resolved state", byID["d1"])
+	}
+
+	// A full resync omits deletions but keeps the resolved decision.
+	fresh := doSync(t, srv, "", 0)
+	if len(fresh.Changes) != 3 {
+		t.Errorf("resync has %d changes, want m1, m3, d1", len(fresh.Changes))
+	}
+}
+
+func TestSyncPagesAndRejectsStaleCursors(t *testing.T) {
+	agents := &fakeSyncSource{live: map[string]proto.Message{}}
+	for _, id := range []string{"gt-a", "gt-b", "gt-c", "gt-d", "gt-e"} {
+		agents.live[id] = &gastownv1.AgentRuntime{Session: id, Running: true}
+	}
+	srv, now := newTestSyncServer(agents.source(gastownv1.SyncEntity_SYNC_ENTITY_AGENT))
+
+	var seen int
+	cursor := ""
+	for page := 0; ; page++ {
+		resp := doSync(t, srv, cursor, 2)
+		seen += len(resp.Changes)
+		cursor = resp.Cursor
+		if !resp.HasMore {
+			break
+		}
+		if page > 5 {
+			t.Fatal("paging did not terminate")
+		}
+	}
+	if seen != 5 {
+		t.Errorf("paged through %d agents, want 5", seen)
+	}
+
+	if resp := doSync(t, srv, "v1.someotherepoch.3", 0); !resp.Resync {
+		t.Error("cursor from another epoch did not resync")
+	}
+
+	// Once a deletion is pruned, cursors from before it must resync.
+	delete(agents.live, "gt-a")
+	*now = now.Add(time.Minute)
+	_ = doSync(t, srv, cursor, 0)
+	*now = now.Add(syncRetention + time.Hour)
+	if resp := doSync(t, srv, cursor, 0); !resp.Resync {
+		t.Error("cursor predating a pruned deletion did not resync")
+	}
+}
@@ -0,0 +1,314 @@
+package rpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultReconcileInterval is how often the live town state is rebuilt from
+// scratch, catching changes no event reported (a pod evicted, a session
+// that died without its witness noticing).
+const DefaultReconcileInterval = 15 * time.Second
+
+// liveEventPoll is how often the live state reads new events.
+const liveEventPoll = 250 * time.Millisecond
+
+// reconcileDebounce is how long the live state waits after a change before
+// reconciling, so a burst of events (a convoy slinging ten beads) costs one
+// rebuild.
+const reconcileDebounce = time.Second
+
+// liveStateEvents are the events that change what a fast town status
+// shows. Each one is applied to the live state as it arrives and also
+// schedules a reconcile.
+var liveStateEvents = map[string]bool{
+	events.TypeSling:        true,
+	events.TypeHook:         true,
+	events.TypeUnhook:       true,
+	events.TypeResling:      true,
+	events.TypeDone:         true,
+	events.TypeSpawn:        true,
+	events.TypeKill:         true,
+	events.TypeBoot:         true,
+	events.TypeHalt:         true,
+	events.TypeSessionStart: true,
+	events.TypeSessionEnd:   true,
+	events.TypeSessionDeath: true,
+	events.TypeMassDeath:    true,
+}
+
+// liveState is the in-memory town state kept by StatusCollector.Run: a
+// fast snapshot (sessions, agent states and hooks) updated from the event
+// log as things happen and rebuilt periodically.
+type liveState struct {
+	mu         sync.RWMutex
+	status     *gastownv1.TownStatus
+	generation uint64 // rig index generation the snapshot was built at
+}
+
+// get returns a copy of the live snapshot, or nil before the first
+// reconcile.
+func (l *liveState) get() *gastownv1.TownStatus {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.status == nil {
+		return nil
+	}
+	return proto.Clone(l.status).(*gastownv1.TownStatus)
+}
+
+// reset drops the snapshot, so fast requests collect until the next
+// reconcile.
+func (l *liveState) reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.status = nil
+}
+
+// Run keeps a live town state until ctx is done, so fast TownStatus
+// requests are answered from memory without probing sessions or stat-ing
+// the town. The state is rebuilt every interval (DefaultReconcileInterval
+// if zero), shortly after any event that changes an agent's session or
+// hook, and whenever the rig index changes or Invalidate is called. In
+// between, those events are applied to the snapshot directly.
+func (c *StatusCollector) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultReconcileInterval
+	}
+	follower, err := events.Follow(filepath.Join(c.townRoot, events.EventsFile))
+	if err != nil {
+		log.Printf("Town state: not following events (%v); reconciling every %v", err, interval)
+	} else {
+		defer follower.Close()
+	}
+
+	c.reconcile()
+	defer c.live.reset()
+
+	reconcileTicker := time.NewTicker(interval)
+	defer reconcileTicker.Stop()
+	pollTicker := time.NewTicker(liveEventPoll)
+	defer pollTicker.Stop()
+
+	var dirtySince time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-reconcileTicker.C:
+			dirtySince = time.Time{}
+			c.reconcile()
+			continue
+		case <-c.reconcileReq:
+			dirtySince = time.Time{}
+			c.reconcile()
+			continue
+		case <-pollTicker.C:
+		}
+
+		if follower != nil {
+			for {
+				line, ok := follower.Next()
+				if !ok {
+					break
+				}
+				var ev events.Event
+				if json.Unmarshal([]byte(line), &ev) != nil || !liveStateEvents[ev.Type] {
+					continue
+				}
+				c.applyEvent(ev)
+				if dirtySince.IsZero() {
+					dirtySince = c.now()
+				}
+			}
+		}
+		c.live.mu.RLock()
+		stale := c.live.generation != c.rigs.Generation()
+		c.live.mu.RUnlock()
+
+		if stale || (!dirtySince.IsZero() && c.now().Sub(dirtySince) >= reconcileDebounce) {
+			dirtySince = time.Time{}
+			c.reconcile()
+		}
+	}
+}
+
+// requestReconcile asks Run to rebuild the live state now. It never
+// blocks; requests made while one is pending are merged.
+func (c *StatusCollector) requestReconcile() {
+	select {
+	case c.reconcileReq <- struct{}{}:
+	default:
+	}
+}
+
+// reconcile rebuilds the live state: a fast snapshot plus each agent's
+// state and hook from its agent bead.
+func (c *StatusCollector) reconcile() {
+	status, err := c.collect(true)
+	if err != nil {
+		log.Printf("Town state: reconcile failed: %v", err)
+		return
+	}
+	// Read after collecting: the first collect builds the rig index.
+	generation := c.rigs.Generation()
+	agentBeads := c.agentBeads(status)
+	forEachAgent(status, func(agent *gastownv1.AgentRuntime) {
+		if issue := agentBeads[agentAddressToBeadID(agent.Address)]; issue != nil {
+			agent.State = issue.AgentState
+			agent.HookBead = issue.HookBead
+			agent.HasWork = issue.HookBead != ""
+		}
+	})
+
+	c.live.mu.Lock()
+	c.live.status = status
+	c.live.generation = generation
+	c.live.mu.Unlock()
+}
+
+// loadAgentBeads lists the agent beads of the town and of each rig in
+// status.
+func (c *StatusCollector) loadAgentBeads(status *gastownv1.TownStatus) map[string]*beads.Issue {
+	rigs := make([]string, 0, len(status.Rigs))
+	for _, r := range status.Rigs {
+		rigs = append(rigs, r.Name)
+	}
+	return listAgentBeads(c.townRoot, rigs)
+}
+
+// listAgentBeads lists the agent beads of the town and of the given rigs,
+// keyed by bead ID. Rigs whose beads can't be listed are skipped.
+func listAgentBeads(townRoot string, rigs []string) map[string]*beads.Issue {
+	b := beads.New(beads.GetTownBeadsPath(townRoot))
+	all := make(map[string]*beads.Issue)
+	if town, err := b.ListAgentBeads(); err == nil {
+		for id, issue := range town {
+			all[id] = issue
+		}
+	}
+	for _, r := range rigs {
+		rigBeads, err := b.ListAgentBeadsForRig(r)
+		if err != nil {
+			continue
+		}
+		for id, issue := range rigBeads {
+			all[id] = issue
+		}
+	}
+	return all
+}
+
+// applyEvent updates the live snapshot for one event. Events naming agents
+// the snapshot doesn't know (a polecat spawned since the last reconcile)
+// are left to the reconcile they schedule.
+func (c *StatusCollector) applyEvent(ev events.Event) {
+	c.live.mu.Lock()
+	defer c.live.mu.Unlock()
+	if c.live.status == nil {
+		return
+	}
+	status := c.live.status
+
+	str := func(key string) string {
+		s, _ := ev.Payload[key].(string)
+		return s
+	}
+	setHook := func(address, bead string) {
+		if agent := findLiveAgent(status, address); agent != nil {
+			agent.HookBead = bead
+			agent.HasWork = bead != ""
+			agent.WorkTitle = ""
+		}
+	}
+	setRunning := func(address string, running bool) {
+		if agent := findLiveAgent(status, address); agent != nil {
+			agent.Running = running
+		}
+	}
+
+	switch ev.Type {
+	case events.TypeSling:
+		setHook(str("target"), str("bead"))
+	case events.TypeResling:
+		setHook(str("from"), "")
+	case events.TypeHook:
+		setHook(ev.Actor, str("bead"))
+	case events.TypeUnhook, events.TypeDone:
+		setHook(ev.Actor, "")
+	case events.TypeSpawn:
+		if polecat := str("polecat"); polecat != "" {
+			setRunning(str("rig")+"/polecats/"+polecat, true)
+		} else {
+			setRunning(str("agent"), true)
+		}
+	case events.TypeSessionStart:
+		setRunning(ev.Actor, true)
+	case events.TypeKill:
+		setRunning(str("target"), false)
+	case events.TypeSessionDeath:
+		setRunning(str("agent"), false)
+	case events.TypeSessionEnd:
+		setRunning(ev.Actor, false)
+	}
+}
+
+// findLiveAgent finds an agent in a snapshot by mail-style address
+// ("mayor", "gastown/witness", "gastown/polecats/Toast").
+func findLiveAgent(status *gastownv1.TownStatus, address string) *gastownv1.AgentRuntime {
+	address = strings.Trim(address, "/")
+	if address == "" {
+		return nil
+	}
+	parts := strings.Split(address, "/")
+	if len(parts) == 1 {
+		for _, agent := range status.GlobalAgents {
+			if agent.Name == parts[0] {
+				return agent
+			}
+		}
+		return nil
+	}
+
+	want := &gastownv1.AgentAddress{Rig: parts[0], Role: parts[1]}
+	if len(parts) > 2 {
+		want.Name = parts[2]
+	}
+	if want.Role == "polecat" {
+		want.Role = "polecats"
+	}
+	for _, r := range status.Rigs {
+		if r.Name != want.Rig {
+			continue
+		}
+		for _, agent := range r.Agents {
+			if agent.Address != nil && agent.Address.Role == want.Role && agent.Address.Name == want.Name {
+				return agent
+			}
+		}
+	}
+	return nil
+}
+
+// forEachAgent calls fn for every agent in a snapshot, global and per rig.
+func forEachAgent(status *gastownv1.TownStatus, fn func(*gastownv1.AgentRuntime)) {
+	for _, agent := range status.GlobalAgents {
+		fn(agent)
+	}
+	for _, r := range status.Rigs {
+		for _, agent := range r.Agents {
+			fn(agent)
+		}
+	}
+}
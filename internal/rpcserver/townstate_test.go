@@ -0,0 +1,226 @@
+package rpcserver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+// startLive runs a collector's live state for the test and waits for the
+// first reconcile.
+func startLive(t *testing.T, c *StatusCollector) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Run(ctx, time.Hour)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	waitFor(t, "first reconcile", func() bool { return c.live.get() != nil })
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func appendEvent(t *testing.T, root, line string) {
+	t.Helper()
+	f, err := os.OpenFile(filepath.Join(root, events.EventsFile), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(line + "\n"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func furiosa(status *gastownv1.TownStatus) *gastownv1.AgentRuntime {
+	return findLiveAgent(status, "alpha/polecats/furiosa")
+}
+
+func TestLiveState_ServesFastFromMemory(t *testing.T) {
+	root := setupCollectorTown(t)
+	backend := newFakeBackend("gt-alpha-furiosa")
+	c := NewStatusCollector(root, backend, -1) // no TTL cache: only the live state can avoid probes
+	defer c.Close()
+	c.agentBeads = func(*gastownv1.TownStatus) map[string]*beads.Issue {
+		return map[string]*beads.Issue{
+			"gt-alpha-polecat-furiosa": {ID: "gt-alpha-polecat-furiosa", AgentState: "working", HookBead: "gt-123"},
+		}
+	}
+	startLive(t, c)
+
+	probes := backend.probeCount()
+	for i := 0; i < 5; i++ {
+		status, err := c.TownStatus(true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		agent := furiosa(status)
+		if agent == nil || !agent.Running || agent.HookBead != "gt-123" || !agent.HasWork || agent.State != "working" {
+			t.Fatalf("furiosa = %+v", agent)
+		}
+		agent.HookBead = "modified" // callers get copies
+	}
+	if got := backend.probeCount(); got != probes {
+		t.Errorf("fast requests probed sessions %d times, want 0", got-probes)
+	}
+
+	// Full requests still collect.
+	if _, err := c.TownStatus(false); err != nil {
+		t.Fatal(err)
+	}
+	if backend.probeCount() == probes {
+		t.Error("full request was served from the live state")
+	}
+}
+
+func TestLiveState_AppliesEvents(t *testing.T) {
+	root := setupCollectorTown(t)
+	backend := newFakeBackend("gt-alpha-furiosa")
+	c := NewStatusCollector(root, backend, -1)
+	defer c.Close()
+	// Agent beads follow the events, as they would in a real town, so a
+	// reconcile between events doesn't undo them.
+	var mu sync.Mutex
+	hook := ""
+	setHook := func(bead string) {
+		mu.Lock()
+		defer mu.Unlock()
+		hook = bead
+	}
+	c.agentBeads = func(*gastownv1.TownStatus) map[string]*beads.Issue {
+		mu.Lock()
+		defer mu.Unlock()
+		return map[string]*beads.Issue{"gt-alpha-polecat-furiosa": {HookBead: hook}}
+	}
+	startLive(t, c)
+
+	agent := func() *gastownv1.AgentRuntime {
+		status, _ := c.TownStatus(true)
+		if status == nil {
+			return nil
+		}
+		return furiosa(status)
+	}
+
+	setHook("gt-9")
+	appendEvent(t, root, `{"type":"sling","actor":"mayor","payload":{"bead":"gt-9","target":"alpha/polecats/furiosa"}}`)
+	waitFor(t, "sling applied", func() bool { a := agent(); return a != nil && a.HookBead == "gt-9" && a.HasWork })
+
+	backend.KillSession("gt-alpha-furiosa")
+	appendEvent(t, root, `{"type":"kill","actor":"mayor","payload":{"rig":"alpha","target":"alpha/polecats/furiosa","reason":"test"}}`)
+	waitFor(t, "kill applied", func() bool { a := agent(); return a != nil && !a.Running })
+
+	setHook("")
+	appendEvent(t, root, `{"type":"done","actor":"alpha/polecats/furiosa","payload":{"bead":"gt-9"}}`)
+	waitFor(t, "done applied", func() bool { a := agent(); return a != nil && a.HookBead == "" && !a.HasWork })
+}
+
+func TestLiveState_InvalidateFallsBackToCollect(t *testing.T) {
+	root := setupCollectorTown(t)
+	backend := newFakeBackend("gt-alpha-furiosa")
+	c := NewStatusCollector(root, backend, -1)
+	defer c.Close()
+	c.agentBeads = func(*gastownv1.TownStatus) map[string]*beads.Issue { return nil }
+	startLive(t, c)
+
+	// Stop the session without an event; Invalidate must not leave the
+	// stale live state in place.
+	backend.KillSession("gt-alpha-furiosa")
+	c.Invalidate()
+
+	status, err := c.TownStatus(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := furiosa(status); a == nil || a.Running {
+		t.Errorf("after Invalidate furiosa = %+v, want stopped", a)
+	}
+	waitFor(t, "live state rebuilt", func() bool { return c.live.get() != nil })
+}
+
+func TestFindLiveAgent(t *testing.T) {
+	status := &gastownv1.TownStatus{
+		GlobalAgents: []*gastownv1.AgentRuntime{{Name: "mayor", Address: &gastownv1.AgentAddress{Name: "mayor"}}},
+		Rigs: []*gastownv1.RigStatus{{
+			Name: "alpha",
+			Agents: []*gastownv1.AgentRuntime{
+				{Name: "witness", Address: &gastownv1.AgentAddress{Rig: "alpha", Role: "witness"}},
+				{Name: "furiosa", Address: &gastownv1.AgentAddress{Rig: "alpha", Role: "polecats", Name: "furiosa"}},
+				{Name: "max", Address: &gastownv1.AgentAddress{Rig: "alpha", Role: "crew", Name: "max"}},
+			},
+		}},
+	}
+	tests := []struct{ address, want string }{
+		{"mayor", "mayor"},
+		{"mayor/", "mayor"},
+		{"alpha/witness", "witness"},
+		{"alpha/polecats/furiosa", "furiosa"},
+		{"alpha/polecat/furiosa", "furiosa"},
+		{"alpha/crew/max", "max"},
+		{"alpha/crew/nobody", ""},
+		{"beta/witness", ""},
+		{"alpha", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		got := ""
+		if a := findLiveAgent(status, tt.address); a != nil {
+			got = a.Name
+		}
+		if got != tt.want {
+			t.Errorf("findLiveAgent(%q) = %q, want %q", tt.address, got, tt.want)
+		}
+	}
+}
+
+func TestApplyEvent(t *testing.T) {
+	c := NewStatusCollector(t.TempDir(), newFakeBackend(), -1)
+	defer c.Close()
+	c.live.status = &gastownv1.TownStatus{Rigs: []*gastownv1.RigStatus{{
+		Name: "alpha",
+		Agents: []*gastownv1.AgentRuntime{
+			{Name: "furiosa", Address: &gastownv1.AgentAddress{Rig: "alpha", Role: "polecats", Name: "furiosa"}},
+		},
+	}}}
+	agent := func() *gastownv1.AgentRuntime { return furiosa(c.live.status) }
+
+	steps := []struct {
+		ev      events.Event
+		running bool
+		hook    string
+	}{
+		{events.Event{Type: events.TypeSpawn, Payload: map[string]interface{}{"rig": "alpha", "polecat": "furiosa"}}, true, ""},
+		{events.Event{Type: events.TypeSling, Payload: map[string]interface{}{"bead": "gt-1", "target": "alpha/polecats/furiosa"}}, true, "gt-1"},
+		{events.Event{Type: events.TypeDone, Actor: "alpha/polecats/furiosa", Payload: map[string]interface{}{"bead": "gt-1"}}, true, ""},
+		{events.Event{Type: events.TypeHook, Actor: "alpha/polecats/furiosa", Payload: map[string]interface{}{"bead": "gt-2"}}, true, "gt-2"},
+		{events.Event{Type: events.TypeResling, Payload: map[string]interface{}{"bead": "gt-2", "from": "alpha/polecats/furiosa", "target": "alpha"}}, true, ""},
+		{events.Event{Type: events.TypeKill, Payload: map[string]interface{}{"rig": "alpha", "target": "alpha/polecats/furiosa"}}, false, ""},
+	}
+	for _, s := range steps {
+		c.applyEvent(s.ev)
+		if a := agent(); a.Running != s.running || a.HookBead != s.hook || a.HasWork != (s.hook != "") {
+			t.Errorf("after %s: running=%v hook=%q has_work=%v; want running=%v hook=%q", s.ev.Type, a.Running, a.HookBead, a.HasWork, s.running, s.hook)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/monitoring"
+)
+
+// UsageServer implements the UsageService over the town's usage ledger.
+type UsageServer struct {
+	townRoot string
+	ledger   *monitoring.UsageLedger
+	now      func() time.Time
+}
+
+var _ gastownv1connect.UsageServiceHandler = (*UsageServer)(nil)
+
+// NewUsageServer creates a new UsageServer.
+func NewUsageServer(townRoot string) *UsageServer {
+	return &UsageServer{
+		townRoot: townRoot,
+		ledger:   monitoring.NewUsageLedger(townRoot),
+		now:      time.Now,
+	}
+}
+
+func (s *UsageServer) GetUsage(
+	ctx context.Context,
+	req *connect.Request[gastownv1.GetUsageRequest],
+) (*connect.Response[gastownv1.GetUsageResponse], error) {
+	today := monitoring.StartOfDay(s.now())
+	since := today
+	if req.Msg.Since != nil {
+		since = req.Msg.Since.AsTime()
+	}
+	earliest := since
+	if today.Before(earliest) {
+		earliest = today
+	}
+
+	records, err := s.ledger.Records(earliest)
+	if err != nil {
+		return nil, classifyErr("reading usage ledger", err)
+	}
+
+	var matching, todays []monitoring.UsageRecord
+	for _, r := range records {
+		if !r.Time.Before(today) {
+			todays = append(todays, r)
+		}
+		if r.Time.Before(since) ||
+			(req.Msg.Rig != "" && r.Rig != req.Msg.Rig) ||
+			(req.Msg.Agent != "" && r.Agent != req.Msg.Agent) {
+			continue
+		}
+		matching = append(matching, r)
+	}
+
+	summary := monitoring.SummarizeUsage(matching)
+	resp := &gastownv1.GetUsageResponse{
+		Since:   timestamppb.New(since),
+		Total:   tokenUsageToProto(summary.Total),
+		ByAgent: usageTotalsToProto(summary.ByAgent, req.Msg.Limit),
+		ByRig:   usageTotalsToProto(summary.ByRig, req.Msg.Limit),
+		ByBead:  usageTotalsToProto(summary.ByBead, req.Msg.Limit),
+	}
+
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(s.townRoot))
+	if err == nil {
+		for _, b := range monitoring.CheckBudgets(monitoring.SummarizeUsage(todays), settings.UsageBudgets) {
+			resp.Budgets = append(resp.Budgets, &gastownv1.BudgetStatus{
+				Scope:    b.Scope,
+				Key:      b.Key,
+				SpentUsd: b.SpentUSD,
+				LimitUsd: b.LimitUSD,
+				Exceeded: b.Exceeded(),
+				Warning:  b.Warning(),
+			})
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *UsageServer) RecordUsage(
+	ctx context.Context,
+	req *connect.Request[gastownv1.RecordUsageRequest],
+) (*connect.Response[gastownv1.RecordUsageResponse], error) {
+	if req.Msg.Agent == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("agent is required"))
+	}
+
+	var usage monitoring.TokenUsage
+	if req.Msg.Output != "" {
+		var ok bool
+		if usage, ok = monitoring.ParseUsageOutput(req.Msg.Output); !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("output contains no usage report"))
+		}
+	} else {
+		usage = tokenUsageFromProto(req.Msg.Usage)
+	}
+	if usage.CostUSD == 0 {
+		usage.CostUSD = monitoring.EstimateCost(req.Msg.Model, usage)
+	}
+	if usage.IsZero() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no usage to record"))
+	}
+
+	agent := strings.TrimSpace(req.Msg.Agent)
+	if err := s.ledger.Append(monitoring.UsageRecord{
+		Time:       s.now(),
+		Agent:      agent,
+		Rig:        monitoring.AgentRig(agent),
+		Bead:       req.Msg.Bead,
+		Model:      req.Msg.Model,
+		Source:     monitoring.UsageSourceCLI,
+		TokenUsage: usage,
+	}); err != nil {
+		return nil, classifyErr("recording usage", err)
+	}
+
+	return connect.NewResponse(&gastownv1.RecordUsageResponse{
+		Recorded: tokenUsageToProto(usage),
+	}), nil
+}
+
+func tokenUsageToProto(u monitoring.TokenUsage) *gastownv1.TokenUsage {
+	return &gastownv1.TokenUsage{
+		InputTokens:      u.InputTokens,
+		OutputTokens:     u.OutputTokens,
+		CacheReadTokens:  u.CacheReadTokens,
+		CacheWriteTokens: u.CacheWriteTokens,
+		CostUsd:          u.CostUSD,
+	}
+}
+
+func tokenUsageFromProto(u *gastownv1.TokenUsage) monitoring.TokenUsage {
+	return monitoring.TokenUsage{
+		InputTokens:      u.GetInputTokens(),
+		OutputTokens:     u.GetOutputTokens(),
+		CacheReadTokens:  u.GetCacheReadTokens(),
+		CacheWriteTokens: u.GetCacheWriteTokens(),
+		CostUSD:          u.GetCostUsd(),
+	}
+}
+
+func usageTotalsToProto(totals []monitoring.UsageTotal, limit int32) []*gastownv1.UsageTotal {
+	if limit > 0 && len(totals) > int(limit) {
+		totals = totals[:limit]
+	}
+	out := make([]*gastownv1.UsageTotal, 0, len(totals))
+	for _, t := range totals {
+		out = append(out, &gastownv1.UsageTotal{Key: t.Key, Usage: tokenUsageToProto(t.TokenUsage)})
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+package rpcserver
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestUsageRecordAndGet(t *testing.T) {
+	root := t.TempDir()
+	settings := config.NewTownSettings()
+	settings.UsageBudgets = &config.UsageBudgets{AgentDailyUSD: 1}
+	if err := config.SaveTownSettings(config.TownSettingsPath(root), settings); err != nil {
+		t.Fatal(err)
+	}
+	srv := NewUsageServer(root)
+	ctx := context.Background()
+
+	for _, msg := range []*gastownv1.RecordUsageRequest{
+		{Agent: "gastown/polecats/nux", Bead: "gt-abc", Output: "Total cost:  $1.50"},
+		{Agent: "gastown/crew/joe", Model: "claude-sonnet-4-5", Usage: &gastownv1.TokenUsage{OutputTokens: 10000}},
+	} {
+		if _, err := srv.RecordUsage(ctx, connect.NewRequest(msg)); err != nil {
+			t.Fatalf("RecordUsage(%v): %v", msg, err)
+		}
+	}
+
+	resp, err := srv.GetUsage(ctx, connect.NewRequest(&gastownv1.GetUsageRequest{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.Msg.Total.CostUsd; got != 1.65 {
+		t.Errorf("total cost = %v, want 1.65 (joe's cost estimated from the model)", got)
+	}
+	if len(resp.Msg.ByRig) != 1 || resp.Msg.ByRig[0].Key != "gastown" {
+		t.Errorf("by rig = %v", resp.Msg.ByRig)
+	}
+	if len(resp.Msg.ByBead) != 1 || resp.Msg.ByBead[0].Key != "gt-abc" {
+		t.Errorf("by bead = %v", resp.Msg.ByBead)
+	}
+	if len(resp.Msg.Budgets) != 1 || resp.Msg.Budgets[0].Key != "gastown/polecats/nux" || !resp.Msg.Budgets[0].Exceeded {
+		t.Errorf("budgets = %v, want nux over its agent budget", resp.Msg.Budgets)
+	}
+
+	filtered, err := srv.GetUsage(ctx, connect.NewRequest(&gastownv1.GetUsageRequest{Agent: "gastown/crew/joe"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filtered.Msg.ByAgent) != 1 || len(filtered.Msg.Budgets) != 1 {
+		t.Errorf("agent filter: by agent = %v, budgets = %v; budgets should ignore filters", filtered.Msg.ByAgent, filtered.Msg.Budgets)
+	}
+}
+
+func TestRecordUsageRejectsEmptyReports(t *testing.T) {
+	srv := NewUsageServer(t.TempDir())
+	for _, msg := range []*gastownv1.RecordUsageRequest{
+		{Output: "Total cost: $1"},
+		{Agent: "gastown/polecats/nux", Output: "no report here"},
+		{Agent: "gastown/polecats/nux"},
+	} {
+		_, err := srv.RecordUsage(context.Background(), connect.NewRequest(msg))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("RecordUsage(%v) error = %v, want InvalidArgument", msg, err)
+		}
+	}
+}
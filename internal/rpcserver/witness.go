@@ -0,0 +1,162 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+	"github.com/steveyegge/gastown/gen/gastown/v1/gastownv1connect"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// WitnessServer implements the WitnessService. Findings live in town beads
+// so the overseer sees every rig's problems in one ranked list.
+type WitnessServer struct {
+	townRoot string
+}
+
+var _ gastownv1connect.WitnessServiceHandler = (*WitnessServer)(nil)
+
+// NewWitnessServer creates a new WitnessServer.
+func NewWitnessServer(townRoot string) *WitnessServer {
+	return &WitnessServer{townRoot: townRoot}
+}
+
+func (s *WitnessServer) ListFindings(
+	ctx context.Context,
+	req *connect.Request[gastownv1.ListFindingsRequest],
+) (*connect.Response[gastownv1.ListFindingsResponse], error) {
+	client := beads.New(beads.GetTownBeadsPath(s.townRoot))
+	findings, err := client.ListFindings(req.Msg.Agent, req.Msg.IncludeClosed)
+	if err != nil {
+		return nil, classifyErr("listing findings", err)
+	}
+
+	var out []*gastownv1.Finding
+	for _, f := range findings {
+		if req.Msg.Rig != "" && !strings.HasPrefix(f.Agent, req.Msg.Rig+"/") {
+			continue
+		}
+		if req.Msg.MinSeverity != gastownv1.FindingSeverity_FINDING_SEVERITY_UNSPECIFIED &&
+			findingSeverityToProto(f.Severity) < req.Msg.MinSeverity {
+			continue
+		}
+		out = append(out, findingToProto(f))
+	}
+
+	total := len(out)
+	if req.Msg.Limit > 0 && len(out) > int(req.Msg.Limit) {
+		out = out[:req.Msg.Limit]
+	}
+
+	return connect.NewResponse(&gastownv1.ListFindingsResponse{
+		Findings: out,
+		Total:    int32(total),
+	}), nil
+}
+
+func (s *WitnessServer) ReportFinding(
+	ctx context.Context,
+	req *connect.Request[gastownv1.ReportFindingRequest],
+) (*connect.Response[gastownv1.ReportFindingResponse], error) {
+	if req.Msg.Agent == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("agent is required"))
+	}
+	if req.Msg.Category == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("category is required"))
+	}
+
+	title := req.Msg.Title
+	if title == "" {
+		title = fmt.Sprintf("%s: %s", req.Msg.Agent, req.Msg.Category)
+	}
+
+	client := beads.New(beads.GetTownBeadsPath(s.townRoot))
+	finding, created, err := client.ReportFinding(title, &beads.FindingFields{
+		Agent:           req.Msg.Agent,
+		Category:        req.Msg.Category,
+		Severity:        findingSeverityFromProto(req.Msg.Severity),
+		Evidence:        req.Msg.Evidence,
+		SuggestedAction: req.Msg.SuggestedAction,
+		ReportedBy:      req.Msg.ReportedBy,
+	})
+	if err != nil {
+		return nil, classifyErr("reporting finding", err)
+	}
+
+	return connect.NewResponse(&gastownv1.ReportFindingResponse{
+		Finding: findingToProto(finding),
+		Created: created,
+	}), nil
+}
+
+func (s *WitnessServer) ResolveFinding(
+	ctx context.Context,
+	req *connect.Request[gastownv1.ResolveFindingRequest],
+) (*connect.Response[gastownv1.ResolveFindingResponse], error) {
+	if req.Msg.FindingId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("finding_id is required"))
+	}
+
+	client := beads.New(beads.GetTownBeadsPath(s.townRoot))
+	if err := client.ResolveFinding(req.Msg.FindingId, req.Msg.ResolvedBy, req.Msg.Reason); err != nil {
+		return nil, notFoundOrInternal("resolving finding "+req.Msg.FindingId, err)
+	}
+
+	return connect.NewResponse(&gastownv1.ResolveFindingResponse{
+		FindingId: req.Msg.FindingId,
+	}), nil
+}
+
+func findingToProto(f *beads.Finding) *gastownv1.Finding {
+	out := &gastownv1.Finding{
+		Id:              f.ID,
+		Title:           f.Title,
+		Status:          f.Status,
+		Agent:           f.Agent,
+		Category:        f.Category,
+		Severity:        findingSeverityToProto(f.Severity),
+		Evidence:        f.Evidence,
+		SuggestedAction: f.SuggestedAction,
+		ReportedBy:      f.ReportedBy,
+		Occurrences:     int32(f.Occurrences),
+	}
+	if t, err := time.Parse(time.RFC3339, f.ReportedAt); err == nil {
+		out.ReportedAt = timestamppb.New(t)
+	}
+	return out
+}
+
+func findingSeverityToProto(severity string) gastownv1.FindingSeverity {
+	switch severity {
+	case "critical":
+		return gastownv1.FindingSeverity_FINDING_SEVERITY_CRITICAL
+	case "high":
+		return gastownv1.FindingSeverity_FINDING_SEVERITY_HIGH
+	case "medium":
+		return gastownv1.FindingSeverity_FINDING_SEVERITY_MEDIUM
+	case "low":
+		return gastownv1.FindingSeverity_FINDING_SEVERITY_LOW
+	default:
+		return gastownv1.FindingSeverity_FINDING_SEVERITY_UNSPECIFIED
+	}
+}
+
+func findingSeverityFromProto(severity gastownv1.FindingSeverity) string {
+	switch severity {
+	case gastownv1.FindingSeverity_FINDING_SEVERITY_CRITICAL:
+		return "critical"
+	case gastownv1.FindingSeverity_FINDING_SEVERITY_HIGH:
+		return "high"
+	case gastownv1.FindingSeverity_FINDING_SEVERITY_LOW:
+		return "low"
+	default:
+		return "medium"
+	}
+}
@@ -0,0 +1,38 @@
+package rpcserver
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gastownv1 "github.com/steveyegge/gastown/gen/gastown/v1"
+)
+
+func TestFindingSeverityRoundTrip(t *testing.T) {
+	for _, sev := range []string{"critical", "high", "medium", "low"} {
+		if got := findingSeverityFromProto(findingSeverityToProto(sev)); got != sev {
+			t.Errorf("round trip %q = %q", sev, got)
+		}
+	}
+	if got := findingSeverityFromProto(gastownv1.FindingSeverity_FINDING_SEVERITY_UNSPECIFIED); got != "medium" {
+		t.Errorf("unspecified severity = %q, want medium", got)
+	}
+	// Ranking by proto value must match beads ranking (higher = more severe).
+	if findingSeverityToProto("critical") <= findingSeverityToProto("high") {
+		t.Error("critical should outrank high")
+	}
+}
+
+func TestReportFindingRequiresAgentAndCategory(t *testing.T) {
+	srv := NewWitnessServer(t.TempDir())
+	for _, msg := range []*gastownv1.ReportFindingRequest{
+		{Category: "stuck"},
+		{Agent: "gastown/polecats/nux"},
+	} {
+		_, err := srv.ReportFinding(context.Background(), connect.NewRequest(msg))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("ReportFinding(%v) error = %v, want InvalidArgument", msg, err)
+		}
+	}
+}
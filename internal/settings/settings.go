@@ -0,0 +1,247 @@
+// Package settings serves the town's operational knobs (see config.Knobs)
+// to running processes. Values live in settings config beads and are cached
+// in <town>/.runtime/settings.json, so reads are cheap and keep working
+// when beads are unreachable. A Store watching the cache announces changed
+// keys on an event bus, letting daemon loops pick up new values without a
+// restart.
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/configbeads"
+	"github.com/steveyegge/gastown/internal/eventbus"
+	"github.com/steveyegge/gastown/internal/util"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+const (
+	// cachePollInterval is how often Watch checks the cache file, which is
+	// rewritten by gt config settings on this host.
+	cachePollInterval = 10 * time.Second
+
+	// cacheMaxAge is how old the cache may be before Current re-reads the
+	// beads, picking up changes made from other hosts.
+	cacheMaxAge = 5 * time.Minute
+)
+
+// CachePath returns the path of the town's settings cache.
+func CachePath(townRoot string) string {
+	return filepath.Join(townRoot, ".runtime", "settings.json")
+}
+
+// Loader returns the current knob values from their source of truth.
+type Loader func() (map[string]string, error)
+
+// BeadsLoader returns a Loader reading the town's settings beads.
+func BeadsLoader(townRoot string) Loader {
+	return func() (map[string]string, error) {
+		townName, err := workspace.GetTownName(townRoot)
+		if err != nil {
+			return nil, err
+		}
+		return configbeads.LoadKnobsFromBeadsScope(beads.New(townRoot), townName, "")
+	}
+}
+
+// Store holds the town's knob values. A nil Store answers every knob with
+// its default, so callers need no separate "not configured" path.
+type Store struct {
+	townRoot string
+	load     Loader
+	bus      *eventbus.Bus
+
+	mu     sync.RWMutex
+	values map[string]string
+	stamp  string // modification time and size of the cache when last read or written
+}
+
+// NewStore creates a store primed from the cache. Changes found by Reload
+// or Watch are published on bus, which may be nil.
+func NewStore(townRoot string, load Loader, bus *eventbus.Bus) *Store {
+	s := &Store{townRoot: townRoot, load: load, bus: bus, values: map[string]string{}}
+	if values, stamp, err := readCache(townRoot); err == nil {
+		s.values, s.stamp = values, stamp
+	}
+	return s
+}
+
+// Current returns a store for one-shot commands, refreshed from beads when
+// the cache is missing or stale.
+func Current(townRoot string) *Store {
+	s := NewStore(townRoot, BeadsLoader(townRoot), nil)
+	if info, err := os.Stat(CachePath(townRoot)); err != nil || time.Since(info.ModTime()) > cacheMaxAge {
+		_, _ = s.Reload()
+	}
+	return s
+}
+
+// Get returns a knob's value, or its default when unset.
+func (s *Store) Get(key string) string {
+	if s != nil {
+		s.mu.RLock()
+		v, ok := s.values[key]
+		s.mu.RUnlock()
+		if ok {
+			return v
+		}
+	}
+	k, _ := config.LookupKnob(key)
+	return k.Default
+}
+
+// Values returns the knobs set explicitly, without defaults.
+func (s *Store) Values() map[string]string {
+	out := make(map[string]string)
+	if s == nil {
+		return out
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for k, v := range s.values {
+		out[k] = v
+	}
+	return out
+}
+
+// Duration returns a duration knob.
+func (s *Store) Duration(key string) time.Duration {
+	d, _ := time.ParseDuration(s.Get(key))
+	return d
+}
+
+// Int returns an integer knob.
+func (s *Store) Int(key string) int {
+	n, _ := strconv.Atoi(s.Get(key))
+	return n
+}
+
+// Bool returns a boolean knob.
+func (s *Store) Bool(key string) bool {
+	b, _ := strconv.ParseBool(s.Get(key))
+	return b
+}
+
+// Reload reads the knobs from the loader, rewrites the cache, and returns
+// the keys whose values changed.
+func (s *Store) Reload() ([]string, error) {
+	values, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	if values == nil {
+		values = map[string]string{}
+	}
+	path := CachePath(s.townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("creating runtime directory: %w", err)
+	}
+	if err := util.AtomicWriteJSON(path, values); err != nil {
+		return nil, fmt.Errorf("writing settings cache: %w", err)
+	}
+	stamp, _ := fileStamp(path)
+	return s.apply(values, stamp), nil
+}
+
+// Watch keeps the store current until ctx is done: it re-reads the cache
+// when another process rewrites it, and reloads from the loader every
+// refresh interval. Errors are passed to logf.
+func (s *Store) Watch(ctx context.Context, refresh time.Duration, logf func(format string, args ...interface{})) {
+	poll := time.NewTicker(cachePollInterval)
+	defer poll.Stop()
+	reload := time.NewTicker(refresh)
+	defer reload.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-poll.C:
+			s.checkCache()
+		case <-reload.C:
+			if _, err := s.Reload(); err != nil {
+				logf("Warning: reloading settings: %v", err)
+			}
+		}
+	}
+}
+
+// checkCache applies the cache file if it changed since it was last seen.
+func (s *Store) checkCache() {
+	stamp, err := fileStamp(CachePath(s.townRoot))
+	if err != nil {
+		return
+	}
+	s.mu.RLock()
+	seen := s.stamp
+	s.mu.RUnlock()
+	if stamp == seen {
+		return
+	}
+	if values, stamp, err := readCache(s.townRoot); err == nil {
+		s.apply(values, stamp)
+	}
+}
+
+// apply replaces the values, publishing and returning the changed keys.
+func (s *Store) apply(values map[string]string, stamp string) []string {
+	s.mu.Lock()
+	var changed []string
+	for k, v := range values {
+		if old, ok := s.values[k]; !ok || old != v {
+			changed = append(changed, k)
+		}
+	}
+	for k := range s.values {
+		if _, ok := values[k]; !ok {
+			changed = append(changed, k)
+		}
+	}
+	s.values, s.stamp = values, stamp
+	s.mu.Unlock()
+
+	sort.Strings(changed)
+	if len(changed) > 0 && s.bus != nil {
+		s.bus.PublishSettingsChanged(changed)
+	}
+	return changed
+}
+
+func readCache(townRoot string) (map[string]string, string, error) {
+	path := CachePath(townRoot)
+	stamp, err := fileStamp(path)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	values := map[string]string{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, "", err
+	}
+	for k, v := range values {
+		if config.ValidateKnob(k, v) != nil {
+			delete(values, k)
+		}
+	}
+	return values, stamp, nil
+}
+
+func fileStamp(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d:%d", info.ModTime().UnixNano(), info.Size()), nil
+}
@@ -0,0 +1,77 @@
+package settings
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/eventbus"
+)
+
+func TestStoreDefaults(t *testing.T) {
+	var s *Store
+	if got := s.Duration(config.KnobGUPPViolationTimeout); got != 30*time.Minute {
+		t.Errorf("nil store GUPP timeout = %v, want 30m", got)
+	}
+	if !s.Bool(config.KnobSlingUseWarmPool) {
+		t.Error("nil store sling.use_warm_pool = false, want true")
+	}
+}
+
+func TestStoreReload(t *testing.T) {
+	townRoot := t.TempDir()
+	bus := eventbus.New()
+	events, unsubscribe := bus.Subscribe()
+	defer unsubscribe()
+
+	values := map[string]string{config.KnobWarmPoolMaxSize: "3"}
+	load := func() (map[string]string, error) {
+		out := make(map[string]string, len(values))
+		for k, v := range values {
+			out[k] = v
+		}
+		return out, nil
+	}
+	s := NewStore(townRoot, load, bus)
+
+	changed, err := s.Reload()
+	if err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	if !reflect.DeepEqual(changed, []string{config.KnobWarmPoolMaxSize}) || s.Int(config.KnobWarmPoolMaxSize) != 3 {
+		t.Errorf("changed = %v, max size = %d", changed, s.Int(config.KnobWarmPoolMaxSize))
+	}
+	ev := <-events
+	if ev.Type != eventbus.EventSettingsChanged || !reflect.DeepEqual(ev.Data, changed) {
+		t.Errorf("event = %+v", ev)
+	}
+
+	// An unchanged reload publishes nothing.
+	if changed, _ := s.Reload(); len(changed) != 0 {
+		t.Errorf("unchanged reload reported %v", changed)
+	}
+
+	// Another process refreshing the cache is picked up from the file.
+	values = map[string]string{config.KnobWarmPoolInterval: "5m"}
+	other := NewStore(townRoot, load, nil)
+	if got := other.Int(config.KnobWarmPoolMaxSize); got != 3 {
+		t.Errorf("new store did not read the cache: max size = %d", got)
+	}
+	if _, err := other.Reload(); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	s.checkCache()
+	want := []string{config.KnobWarmPoolInterval, config.KnobWarmPoolMaxSize}
+	select {
+	case ev := <-events:
+		if !reflect.DeepEqual(ev.Data, want) {
+			t.Errorf("changed keys = %v, want %v", ev.Data, want)
+		}
+	default:
+		t.Fatal("no event after the cache changed")
+	}
+	if s.Duration(config.KnobWarmPoolInterval) != 5*time.Minute || s.Int(config.KnobWarmPoolMaxSize) != 0 {
+		t.Errorf("values after cache change = %v", s.Values())
+	}
+}
@@ -0,0 +1,119 @@
+package slackbot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultAPIURL is the base URL of the Slack Web API.
+const DefaultAPIURL = "https://slack.com/api/"
+
+// API is a minimal Slack Web API client covering the methods the decision
+// flow needs.
+type API struct {
+	token      string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewAPI creates a Web API client authenticated with a bot token (xoxb-...).
+func NewAPI(token string) *API {
+	return &API{
+		token:      token,
+		baseURL:    DefaultAPIURL,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// OpenView opens a modal in response to an interaction's trigger_id.
+func (a *API) OpenView(ctx context.Context, triggerID string, view map[string]interface{}) error {
+	return a.call(ctx, "views.open", map[string]interface{}{
+		"trigger_id": triggerID,
+		"view":       view,
+	}, nil)
+}
+
+// PostMessage posts a message to a channel and returns its timestamp,
+// which identifies it for updates and thread replies. text is the
+// notification fallback for the blocks.
+func (a *API) PostMessage(ctx context.Context, channel, text string, blocks []map[string]interface{}) (string, error) {
+	var result struct {
+		TS string `json:"ts"`
+	}
+	err := a.call(ctx, "chat.postMessage", map[string]interface{}{
+		"channel": channel,
+		"text":    text,
+		"blocks":  blocks,
+	}, &result)
+	return result.TS, err
+}
+
+// PostThreadReply posts a plain mrkdwn reply in the thread of the message
+// with timestamp threadTS.
+func (a *API) PostThreadReply(ctx context.Context, channel, threadTS, text string) error {
+	return a.call(ctx, "chat.postMessage", map[string]interface{}{
+		"channel":   channel,
+		"thread_ts": threadTS,
+		"text":      text,
+	}, nil)
+}
+
+// UpdateMessage replaces the text and blocks of a posted message.
+func (a *API) UpdateMessage(ctx context.Context, channel, ts, text string, blocks []map[string]interface{}) error {
+	return a.call(ctx, "chat.update", map[string]interface{}{
+		"channel": channel,
+		"ts":      ts,
+		"text":    text,
+		"blocks":  blocks,
+	}, nil)
+}
+
+// call POSTs a JSON body to a Web API method and checks the "ok" field of
+// the response. If dst is non-nil the response is also decoded into it.
+func (a *API) call(ctx context.Context, method string, body interface{}, dst interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("encoding %s request: %w", method, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.baseURL+method, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+a.token)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", method, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", method, resp.Status)
+	}
+	data, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading %s response: %w", method, err)
+	}
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("decoding %s response: %w", method, err)
+	}
+	if !result.OK {
+		return fmt.Errorf("%s: %s", method, result.Error)
+	}
+	if dst != nil {
+		if err := json.Unmarshal(data, dst); err != nil {
+			return fmt.Errorf("decoding %s response: %w", method, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package slackbot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/rpcclient"
+)
+
+// Block Kit identifiers shared by the decision message, the confirmation
+// modal and the interaction handler.
+const (
+	// resolveActionPrefix prefixes each option button's action_id. Slack
+	// requires action_ids to be unique within a block, so the option number
+	// is appended.
+	resolveActionPrefix = "resolve_decision_"
+
+	// resolveCallbackID identifies the confirmation modal's submission.
+	resolveCallbackID = "resolve_decision"
+
+	rationaleBlockID  = "rationale"
+	rationaleActionID = "rationale"
+)
+
+// Slack limits for button labels and the number of elements in an actions
+// block.
+const (
+	maxButtonText     = 75
+	maxActionElements = 25
+)
+
+var urgencyEmoji = map[string]string{
+	"high":   "🔴",
+	"medium": "🟡",
+	"low":    "🟢",
+}
+
+// DecisionBlocks renders a pending decision as Block Kit blocks with one
+// button per option. The recommended option is styled as primary.
+func DecisionBlocks(d rpcclient.Decision) []map[string]interface{} {
+	blocks := decisionHeader(d, "Decision needed")
+
+	var buttons []interface{}
+	for i, opt := range d.Options {
+		if len(buttons) == maxActionElements {
+			break
+		}
+		button := map[string]interface{}{
+			"type":      "button",
+			"action_id": fmt.Sprintf("%s%d", resolveActionPrefix, i+1),
+			"text": map[string]interface{}{
+				"type":  "plain_text",
+				"text":  truncate(fmt.Sprintf("%d. %s", i+1, opt.Label), maxButtonText),
+				"emoji": true,
+			},
+			"value": actionValue(d.ID, i+1),
+		}
+		if opt.Recommended {
+			button["style"] = "primary"
+		}
+		buttons = append(buttons, button)
+	}
+	if len(buttons) > 0 {
+		blocks = append(blocks, map[string]interface{}{
+			"type":     "actions",
+			"block_id": "decision_options",
+			"elements": buttons,
+		})
+	}
+	return blocks
+}
+
+// resolvedBlocks replaces the option buttons of a resolved decision's
+// message with the outcome. by is already formatted as mrkdwn.
+func resolvedBlocks(d rpcclient.Decision, label, rationale, by string) []map[string]interface{} {
+	blocks := decisionHeader(d, "Decision resolved")
+	text := fmt.Sprintf("✅ *%s* chosen by %s", label, by)
+	if rationale != "" {
+		text += "\n> " + strings.ReplaceAll(rationale, "\n", "\n> ")
+	}
+	return append(blocks, mrkdwnSection(text))
+}
+
+// decisionHeader renders the question, context and metadata shared by the
+// pending and resolved forms of a decision message.
+func decisionHeader(d rpcclient.Decision, title string) []map[string]interface{} {
+	emoji := urgencyEmoji[d.Urgency]
+	if emoji == "" {
+		emoji = "⚪"
+	}
+	blocks := []map[string]interface{}{
+		mrkdwnSection(fmt.Sprintf("%s *%s:* %s", emoji, title, d.Question)),
+	}
+	if d.Context != "" {
+		blocks = append(blocks, mrkdwnSection(d.Context))
+	}
+
+	var lines []string
+	for i, opt := range d.Options {
+		line := fmt.Sprintf("*%d. %s*", i+1, opt.Label)
+		if opt.Recommended {
+			line += " _(recommended)_"
+		}
+		if opt.Description != "" {
+			line += " — " + opt.Description
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) > 0 {
+		blocks = append(blocks, mrkdwnSection(strings.Join(lines, "\n")))
+	}
+
+	meta := fmt.Sprintf("`%s`", d.ID)
+	if d.RequestedBy != "" {
+		meta += " · requested by " + d.RequestedBy
+	}
+	blocks = append(blocks, map[string]interface{}{
+		"type": "context",
+		"elements": []interface{}{
+			map[string]interface{}{"type": "mrkdwn", "text": meta},
+		},
+	})
+	return blocks
+}
+
+// confirmView builds the modal opened when an option button is clicked. It
+// restates the choice and captures an optional rationale; submitting it
+// resolves the decision.
+func confirmView(d rpcclient.Decision, index int, metadata string) map[string]interface{} {
+	opt := d.Options[index-1]
+	choice := fmt.Sprintf("*%s*\n→ *%d. %s*", d.Question, index, opt.Label)
+	if opt.Description != "" {
+		choice += "\n" + opt.Description
+	}
+	return map[string]interface{}{
+		"type":             "modal",
+		"callback_id":      resolveCallbackID,
+		"private_metadata": metadata,
+		"title":            plainText("Resolve decision"),
+		"submit":           plainText("Resolve"),
+		"close":            plainText("Cancel"),
+		"blocks": []interface{}{
+			mrkdwnSection(choice),
+			map[string]interface{}{
+				"type":     "input",
+				"block_id": rationaleBlockID,
+				"optional": true,
+				"label":    plainText("Rationale"),
+				"element": map[string]interface{}{
+					"type":        "plain_text_input",
+					"action_id":   rationaleActionID,
+					"multiline":   true,
+					"placeholder": plainText("Why this option? Shared with the requesting agent."),
+				},
+			},
+		},
+	}
+}
+
+// actionValue encodes a decision ID and 1-based option number into a
+// button value.
+func actionValue(decisionID string, index int) string {
+	return fmt.Sprintf("%s:%d", decisionID, index)
+}
+
+// parseActionValue reverses actionValue.
+func parseActionValue(value string) (decisionID string, index int, err error) {
+	i := strings.LastIndex(value, ":")
+	if i <= 0 {
+		return "", 0, fmt.Errorf("malformed action value %q", value)
+	}
+	index, err = strconv.Atoi(value[i+1:])
+	if err != nil || index < 1 {
+		return "", 0, fmt.Errorf("malformed action value %q", value)
+	}
+	return value[:i], index, nil
+}
+
+func mrkdwnSection(text string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "section",
+		"text": map[string]interface{}{"type": "mrkdwn", "text": text},
+	}
+}
+
+func plainText(text string) map[string]interface{} {
+	return map[string]interface{}{"type": "plain_text", "text": text}
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
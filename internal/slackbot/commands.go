@@ -0,0 +1,349 @@
+package slackbot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rpcclient"
+)
+
+// Operations is the subset of the gt RPC services the slash commands use.
+// *rpcclient.Client implements it.
+type Operations interface {
+	GetTownStatus(ctx context.Context, fast bool) (*rpcclient.TownStatus, error)
+	PeekSession(ctx context.Context, session string, lines int, all bool) (string, []string, bool, error)
+	Sling(ctx context.Context, req rpcclient.SlingRequest) (*rpcclient.SlingResponse, error)
+}
+
+// commandTimeout bounds the RPC work behind one slash command. Slack
+// accepts delayed responses on response_url for 30 minutes, but an
+// operator waiting in a channel wants an answer or an error well before.
+const commandTimeout = 2 * time.Minute
+
+// Peek output limits: the default line count, and the most characters that
+// fit a section block (3000) alongside the code fence.
+const (
+	defaultPeekLines = 30
+	maxPeekLines     = 200
+	maxPeekChars     = 2900
+)
+
+const commandUsage = "*Usage:*\n" +
+	"• `/gt status` — town overview\n" +
+	"• `/gt peek <agent> [lines]` — recent output from an agent's session\n" +
+	"• `/gt sling <bead> <target>` — assign a bead to an agent or rig"
+
+// CommandHandler serves the Slack app's /gt slash command. Commands are
+// acknowledged immediately and answered on the request's response_url once
+// the RPC call completes, since Slack allows only three seconds for the
+// initial response.
+type CommandHandler struct {
+	ops           Operations
+	signingSecret string
+	now           func() time.Time
+	httpClient    *http.Client
+	// wait, when set, receives each command's completion (tests).
+	wait chan struct{}
+}
+
+// NewCommandHandler creates a slash command handler. Requests are verified
+// against the app's signing secret.
+func NewCommandHandler(ops Operations, signingSecret string) *CommandHandler {
+	return &CommandHandler{
+		ops:           ops,
+		signingSecret: signingSecret,
+		now:           time.Now,
+		httpClient:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// commandResponse is a slash command reply.
+type commandResponse struct {
+	ResponseType string                   `json:"response_type"` // "ephemeral" or "in_channel"
+	Text         string                   `json:"text"`
+	Blocks       []map[string]interface{} `json:"blocks,omitempty"`
+}
+
+func ephemeral(text string) commandResponse {
+	return commandResponse{ResponseType: "ephemeral", Text: text}
+}
+
+func (h *CommandHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, "reading body", http.StatusBadRequest)
+		return
+	}
+	if err := verifyRequest(h.signingSecret, h.now(), r.Header, body); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "invalid form body", http.StatusBadRequest)
+		return
+	}
+
+	args := strings.Fields(form.Get("text"))
+	run, ack := h.dispatch(args, form.Get("user_id"))
+	if run != nil {
+		responseURL := form.Get("response_url")
+		go h.respondLater(responseURL, run)
+	}
+	writeJSON(w, ack)
+}
+
+// dispatch validates a command's arguments. It returns the work to run
+// asynchronously, if any, and the immediate acknowledgement.
+func (h *CommandHandler) dispatch(args []string, userID string) (func(context.Context) commandResponse, commandResponse) {
+	if len(args) == 0 {
+		return nil, ephemeral(commandUsage)
+	}
+	switch args[0] {
+	case "status":
+		return h.status, ephemeral("⏳ Fetching town status…")
+
+	case "peek":
+		if len(args) < 2 || len(args) > 3 {
+			return nil, ephemeral("Usage: `/gt peek <agent> [lines]`")
+		}
+		lines := defaultPeekLines
+		if len(args) == 3 {
+			n, err := strconv.Atoi(args[2])
+			if err != nil || n < 1 {
+				return nil, ephemeral(fmt.Sprintf("Invalid line count %q", args[2]))
+			}
+			lines = min(n, maxPeekLines)
+		}
+		agent := args[1]
+		return func(ctx context.Context) commandResponse {
+			return h.peek(ctx, agent, lines)
+		}, ephemeral(fmt.Sprintf("⏳ Peeking at %s…", agent))
+
+	case "sling":
+		if len(args) != 3 {
+			return nil, ephemeral("Usage: `/gt sling <bead> <target>`")
+		}
+		bead, target := args[1], args[2]
+		return func(ctx context.Context) commandResponse {
+			return h.sling(ctx, bead, target, userID)
+		}, ephemeral(fmt.Sprintf("⏳ Slinging %s to %s…", bead, target))
+
+	default:
+		return nil, ephemeral(fmt.Sprintf("Unknown command %q.\n%s", args[0], commandUsage))
+	}
+}
+
+// respondLater runs a command and posts its result to response_url.
+func (h *CommandHandler) respondLater(responseURL string, run func(context.Context) commandResponse) {
+	if h.wait != nil {
+		defer func() { h.wait <- struct{}{} }()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	resp := run(ctx)
+	data, err := json.Marshal(map[string]interface{}{
+		"response_type": resp.ResponseType,
+		// An ephemeral result replaces the "working" acknowledgement; an
+		// in-channel one cannot, so it is posted as a new message.
+		"replace_original": resp.ResponseType == "ephemeral",
+		"text":             resp.Text,
+		"blocks":           resp.Blocks,
+	})
+	if err != nil {
+		log.Printf("slackbot: encoding command response: %v", err)
+		return
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, responseURL, bytes.NewReader(data))
+	if err != nil {
+		log.Printf("slackbot: command response: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	httpResp, err := h.httpClient.Do(req)
+	if err != nil {
+		log.Printf("slackbot: posting command response: %v", err)
+		return
+	}
+	_ = httpResp.Body.Close()
+	if httpResp.StatusCode != http.StatusOK {
+		log.Printf("slackbot: posting command response: %s", httpResp.Status)
+	}
+}
+
+func (h *CommandHandler) status(ctx context.Context) commandResponse {
+	status, err := h.ops.GetTownStatus(ctx, true)
+	if err != nil {
+		return ephemeral(fmt.Sprintf("❌ Status failed: %v", err))
+	}
+	return commandResponse{
+		ResponseType: "ephemeral",
+		Text:         fmt.Sprintf("Town %s: %d rigs", status.Name, len(status.Rigs)),
+		Blocks:       statusBlocks(status),
+	}
+}
+
+func (h *CommandHandler) peek(ctx context.Context, agent string, lines int) commandResponse {
+	status, err := h.ops.GetTownStatus(ctx, true)
+	if err != nil {
+		return ephemeral(fmt.Sprintf("❌ Looking up %s failed: %v", agent, err))
+	}
+	found, err := findAgent(status, agent)
+	if err != nil {
+		return ephemeral("❌ " + err.Error())
+	}
+	if !found.Running {
+		return ephemeral(fmt.Sprintf("%s is not running", found.Address))
+	}
+
+	output, _, exists, err := h.ops.PeekSession(ctx, found.Session, lines, false)
+	if err != nil {
+		return ephemeral(fmt.Sprintf("❌ Peek failed: %v", err))
+	}
+	if !exists {
+		return ephemeral(fmt.Sprintf("%s has no session", found.Address))
+	}
+	output = strings.TrimRight(output, "\n")
+	if r := []rune(output); len(r) > maxPeekChars {
+		// Keep the tail: the most recent output is what matters.
+		output = "…" + string(r[len(r)-maxPeekChars:])
+	}
+	if output == "" {
+		output = "(no output)"
+	}
+	return commandResponse{
+		ResponseType: "ephemeral",
+		Text:         fmt.Sprintf("Last %d lines of %s", lines, found.Address),
+		Blocks: []map[string]interface{}{
+			mrkdwnSection(fmt.Sprintf("*%s* — last %d lines", found.Address, lines)),
+			mrkdwnSection("```" + strings.ReplaceAll(output, "```", "ˋˋˋ") + "```"),
+		},
+	}
+}
+
+func (h *CommandHandler) sling(ctx context.Context, bead, target, userID string) commandResponse {
+	resp, err := h.ops.Sling(ctx, rpcclient.SlingRequest{BeadID: bead, Target: target})
+	if err != nil {
+		return ephemeral(fmt.Sprintf("❌ Sling %s → %s failed: %v", bead, target, err))
+	}
+	text := fmt.Sprintf("🎯 <@%s> slung *%s*", userID, resp.BeadID)
+	if resp.BeadTitle != "" {
+		text += " " + resp.BeadTitle
+	}
+	text += " → *" + resp.TargetAgent + "*"
+	var notes []string
+	if resp.PolecatSpawned {
+		notes = append(notes, "spawned polecat "+resp.PolecatName)
+	}
+	if resp.ConvoyID != "" {
+		convoy := "convoy " + resp.ConvoyID
+		if resp.ConvoyCreated {
+			convoy = "created " + convoy
+		}
+		notes = append(notes, convoy)
+	}
+	if len(notes) > 0 {
+		text += "\n" + strings.Join(notes, " · ")
+	}
+	// Work assignment is shared with the channel; lookups stay private.
+	return commandResponse{
+		ResponseType: "in_channel",
+		Text:         fmt.Sprintf("Slung %s to %s", resp.BeadID, resp.TargetAgent),
+		Blocks:       []map[string]interface{}{mrkdwnSection(text)},
+	}
+}
+
+// findAgent resolves an agent by address, session name, or unique name.
+func findAgent(status *rpcclient.TownStatus, query string) (rpcclient.AgentRuntime, error) {
+	query = strings.TrimSuffix(query, "/")
+	all := append([]rpcclient.AgentRuntime(nil), status.GlobalAgents...)
+	for _, rig := range status.Rigs {
+		all = append(all, rig.Agents...)
+	}
+
+	var byName []rpcclient.AgentRuntime
+	for _, a := range all {
+		if a.Address == query || a.Session == query {
+			return a, nil
+		}
+		if a.Name == query {
+			byName = append(byName, a)
+		}
+	}
+	switch len(byName) {
+	case 1:
+		return byName[0], nil
+	case 0:
+		return rpcclient.AgentRuntime{}, fmt.Errorf("no agent %q", query)
+	default:
+		var addrs []string
+		for _, a := range byName {
+			addrs = append(addrs, a.Address)
+		}
+		return rpcclient.AgentRuntime{}, fmt.Errorf("%q is ambiguous: %s", query, strings.Join(addrs, ", "))
+	}
+}
+
+// statusBlocks renders a town overview: global agents, then one section per
+// rig with its agents and merge queue.
+func statusBlocks(status *rpcclient.TownStatus) []map[string]interface{} {
+	blocks := []map[string]interface{}{
+		mrkdwnSection(fmt.Sprintf("🏘️ *%s*", status.Name)),
+	}
+	if len(status.GlobalAgents) > 0 {
+		var parts []string
+		for _, a := range status.GlobalAgents {
+			parts = append(parts, runningDot(a)+" "+a.Address)
+		}
+		blocks = append(blocks, mrkdwnSection(strings.Join(parts, "   ")))
+	}
+
+	for _, rig := range status.Rigs {
+		var lines []string
+		for _, a := range rig.Agents {
+			line := runningDot(a) + " " + a.Address
+			if a.HasWork {
+				work := a.HookBead
+				if a.WorkTitle != "" {
+					work += " " + a.WorkTitle
+				}
+				line += " — " + truncate(work, 80)
+			}
+			if a.State != "" && a.State != "working" {
+				line += fmt.Sprintf(" _(%s)_", a.State)
+			}
+			lines = append(lines, line)
+		}
+		if len(lines) == 0 {
+			lines = append(lines, "_no agents_")
+		}
+		header := fmt.Sprintf("*%s*", rig.Name)
+		if rig.MQPending > 0 || rig.MQInProgress > 0 {
+			header += fmt.Sprintf(" · MQ: %d pending, %d in progress", rig.MQPending, rig.MQInProgress)
+		}
+		blocks = append(blocks, map[string]interface{}{"type": "divider"},
+			mrkdwnSection(header+"\n"+strings.Join(lines, "\n")))
+	}
+	return blocks
+}
+
+func runningDot(a rpcclient.AgentRuntime) string {
+	if a.Running {
+		return "🟢"
+	}
+	return "⚪"
+}
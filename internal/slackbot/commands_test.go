@@ -0,0 +1,189 @@
+package slackbot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rpcclient"
+)
+
+type fakeOps struct {
+	status  *rpcclient.TownStatus
+	peeked  string
+	slingTo rpcclient.SlingRequest
+}
+
+func (f *fakeOps) GetTownStatus(context.Context, bool) (*rpcclient.TownStatus, error) {
+	return f.status, nil
+}
+
+func (f *fakeOps) PeekSession(_ context.Context, session string, lines int, _ bool) (string, []string, bool, error) {
+	f.peeked = session
+	return "line one\nline two\n", nil, true, nil
+}
+
+func (f *fakeOps) Sling(_ context.Context, req rpcclient.SlingRequest) (*rpcclient.SlingResponse, error) {
+	f.slingTo = req
+	return &rpcclient.SlingResponse{
+		BeadID:         req.BeadID,
+		BeadTitle:      "Fix the thing",
+		TargetAgent:    "gastown/polecats/nux",
+		PolecatSpawned: true,
+		PolecatName:    "nux",
+	}, nil
+}
+
+func testStatus() *rpcclient.TownStatus {
+	return &rpcclient.TownStatus{
+		Name:         "hq",
+		GlobalAgents: []rpcclient.AgentRuntime{{Name: "mayor", Address: "mayor", Session: "hq-mayor", Running: true}},
+		Rigs: []rpcclient.RigStatus{{
+			Name: "gastown",
+			Agents: []rpcclient.AgentRuntime{
+				{Name: "witness", Address: "gastown/witness", Session: "gt-gastown-witness", Running: true},
+				{Name: "nux", Address: "gastown/polecats/nux", Session: "gt-gastown-nux", Running: true, HasWork: true, HookBead: "gt-abc"},
+				{Name: "max", Address: "gastown/crew/max", Session: "gt-gastown-crew-max"},
+			},
+			MQPending: 2,
+		}},
+	}
+}
+
+// runCommand sends a signed /gt command and returns the immediate
+// acknowledgement and the delayed response posted to response_url.
+func runCommand(t *testing.T, h *CommandHandler, text string) (ack, delayed map[string]interface{}) {
+	t.Helper()
+	delivered := make(chan map[string]interface{}, 1)
+	responseSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		delivered <- body
+	}))
+	defer responseSrv.Close()
+	h.wait = make(chan struct{}, 1)
+
+	body := url.Values{
+		"command":      {"/gt"},
+		"text":         {text},
+		"user_id":      {"U123"},
+		"response_url": {responseSrv.URL},
+	}.Encode()
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req := httptest.NewRequest(http.MethodPost, "/slack/commands", strings.NewReader(body))
+	req.Header.Set("X-Slack-Request-Timestamp", ts)
+	req.Header.Set("X-Slack-Signature", sign(testSecret, ts, []byte(body)))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("%q: status %d", text, rec.Code)
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &ack); err != nil {
+		t.Fatalf("%q: ack %q: %v", text, rec.Body.String(), err)
+	}
+
+	select {
+	case <-h.wait:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("%q: command did not complete", text)
+	}
+	select {
+	case delayed = <-delivered:
+	default:
+	}
+	return ack, delayed
+}
+
+func TestCommandHandler_Status(t *testing.T) {
+	h := NewCommandHandler(&fakeOps{status: testStatus()}, testSecret)
+	ack, delayed := runCommand(t, h, "status")
+	if ack["response_type"] != "ephemeral" {
+		t.Errorf("ack = %v", ack)
+	}
+	if delayed == nil {
+		t.Fatal("no delayed response")
+	}
+	blocks, _ := json.Marshal(delayed["blocks"])
+	for _, want := range []string{"gastown/polecats/nux", "gt-abc", "MQ: 2 pending"} {
+		if !strings.Contains(string(blocks), want) {
+			t.Errorf("status blocks missing %q: %s", want, blocks)
+		}
+	}
+}
+
+func TestCommandHandler_Peek(t *testing.T) {
+	ops := &fakeOps{status: testStatus()}
+	h := NewCommandHandler(ops, testSecret)
+
+	_, delayed := runCommand(t, h, "peek nux 10")
+	if ops.peeked != "gt-gastown-nux" {
+		t.Errorf("peeked session %q, want gt-gastown-nux", ops.peeked)
+	}
+	if blocks, _ := json.Marshal(delayed["blocks"]); !strings.Contains(string(blocks), "line two") {
+		t.Errorf("peek blocks = %s", blocks)
+	}
+
+	ops.peeked = ""
+	_, delayed = runCommand(t, h, "peek gastown/crew/max")
+	if ops.peeked != "" || !strings.Contains(delayed["text"].(string), "not running") {
+		t.Errorf("stopped agent: peeked %q, response %v", ops.peeked, delayed["text"])
+	}
+}
+
+func TestCommandHandler_Sling(t *testing.T) {
+	ops := &fakeOps{status: testStatus()}
+	h := NewCommandHandler(ops, testSecret)
+
+	_, delayed := runCommand(t, h, "sling gt-abc gastown")
+	if ops.slingTo.BeadID != "gt-abc" || ops.slingTo.Target != "gastown" {
+		t.Errorf("sling request = %+v", ops.slingTo)
+	}
+	if delayed["response_type"] != "in_channel" {
+		t.Errorf("sling response type = %v", delayed["response_type"])
+	}
+	blocks, _ := json.Marshal(delayed["blocks"])
+	if !strings.Contains(string(blocks), "U123") || !strings.Contains(string(blocks), "spawned polecat nux") {
+		t.Errorf("sling blocks = %s", blocks)
+	}
+}
+
+func TestCommandHandler_UsageErrorsAnswerImmediately(t *testing.T) {
+	h := NewCommandHandler(&fakeOps{}, testSecret)
+	for _, args := range [][]string{nil, {"peek"}, {"peek", "nux", "zero"}, {"sling", "gt-abc"}, {"launch"}} {
+		run, ack := h.dispatch(args, "U123")
+		if run != nil {
+			t.Errorf("%v: scheduled work for invalid command", args)
+		}
+		if ack.ResponseType != "ephemeral" || ack.Text == "" {
+			t.Errorf("%v: ack = %+v", args, ack)
+		}
+	}
+}
+
+func TestFindAgent(t *testing.T) {
+	status := testStatus()
+	status.Rigs = append(status.Rigs, rpcclient.RigStatus{
+		Name:   "beads",
+		Agents: []rpcclient.AgentRuntime{{Name: "witness", Address: "beads/witness"}},
+	})
+	for query, want := range map[string]string{
+		"mayor/":             "mayor",
+		"nux":                "gastown/polecats/nux",
+		"gt-gastown-witness": "gastown/witness",
+		"beads/witness":      "beads/witness",
+	} {
+		got, err := findAgent(status, query)
+		if err != nil || got.Address != want {
+			t.Errorf("findAgent(%q) = %q, %v; want %q", query, got.Address, err, want)
+		}
+	}
+	if _, err := findAgent(status, "witness"); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("ambiguous name: err = %v", err)
+	}
+}
@@ -0,0 +1,356 @@
+package slackbot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/rpcclient"
+)
+
+// ConvoySource is the subset of the gt RPC services the convoy tracker
+// polls. *rpcclient.Client implements it.
+type ConvoySource interface {
+	ListConvoys(ctx context.Context, status string, tree bool) ([]rpcclient.Convoy, error)
+	GetConvoyStatus(ctx context.Context, convoyID string) (*rpcclient.Convoy, []rpcclient.TrackedIssue, error)
+	ListEventsPage(ctx context.Context, req rpcclient.ListEventsRequest) (*rpcclient.EventsPage, error)
+}
+
+// OverseerNotifier delivers a notification to the overseer according to
+// their notification preferences. *notify.Dispatcher implements it.
+type OverseerNotifier interface {
+	NotifyOverseer(event, severity, title, body, bead string)
+}
+
+// DefaultStuckAfter is how long a tracked issue may sit in progress
+// without a status change before its convoy thread is told it is stuck.
+const DefaultStuckAfter = 2 * time.Hour
+
+// ConvoyTracker mirrors convoy progress into Slack without flooding the
+// channel: each convoy gets one message when it starts, kept up to date
+// with its progress, and issue closes, merges and stalls are replied in
+// that message's thread.
+type ConvoyTracker struct {
+	source  ConvoySource
+	slack   *API
+	channel string
+
+	// StatePath, if set, persists thread timestamps and issue states so a
+	// restarted tracker continues existing threads instead of reposting.
+	StatePath string
+	// StuckAfter overrides DefaultStuckAfter.
+	StuckAfter time.Duration
+	// Notifier, if set, tells the overseer when a convoy lands.
+	Notifier OverseerNotifier
+
+	now   func() time.Time
+	state trackerState
+}
+
+type trackerState struct {
+	Convoys map[string]*trackedConvoy `json:"convoys"`
+	// LastMerge is the timestamp of the newest merge event handled.
+	LastMerge string `json:"last_merge,omitempty"`
+}
+
+type trackedConvoy struct {
+	ThreadTS string                 `json:"thread_ts"`
+	Title    string                 `json:"title"`
+	Issues   map[string]*issueState `json:"issues"`
+}
+
+type issueState struct {
+	Title    string    `json:"title"`
+	Status   string    `json:"status"`
+	Assignee string    `json:"assignee,omitempty"`
+	Since    time.Time `json:"since"` // last status change
+	Stuck    bool      `json:"stuck,omitempty"`
+}
+
+// NewConvoyTracker creates a tracker posting to channel.
+func NewConvoyTracker(source ConvoySource, slack *API, channel string) *ConvoyTracker {
+	return &ConvoyTracker{
+		source:  source,
+		slack:   slack,
+		channel: channel,
+		now:     time.Now,
+		state:   trackerState{Convoys: make(map[string]*trackedConvoy)},
+	}
+}
+
+// Run polls every interval until ctx is canceled.
+func (t *ConvoyTracker) Run(ctx context.Context, interval time.Duration) error {
+	if err := t.loadState(); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := t.Poll(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("slackbot: convoy poll: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll runs one tracking pass: new convoys get a message, tracked ones get
+// thread replies for changes, and landed ones are wrapped up.
+func (t *ConvoyTracker) Poll(ctx context.Context) error {
+	open, err := t.source.ListConvoys(ctx, "open", false)
+	if err != nil {
+		return fmt.Errorf("listing convoys: %w", err)
+	}
+
+	stillOpen := make(map[string]bool)
+	for _, c := range open {
+		stillOpen[c.ID] = true
+		convoy, issues, err := t.source.GetConvoyStatus(ctx, c.ID)
+		if err != nil {
+			log.Printf("slackbot: convoy %s: %v", c.ID, err)
+			continue
+		}
+		if tracked := t.state.Convoys[c.ID]; tracked != nil {
+			t.update(ctx, convoy, tracked, issues)
+		} else {
+			t.start(ctx, convoy, issues)
+		}
+	}
+
+	for id, tracked := range t.state.Convoys {
+		if stillOpen[id] {
+			continue
+		}
+		convoy, issues, err := t.source.GetConvoyStatus(ctx, id)
+		if err != nil {
+			log.Printf("slackbot: convoy %s: %v", id, err)
+			continue
+		}
+		t.update(ctx, convoy, tracked, issues)
+		t.reply(ctx, tracked, fmt.Sprintf("🏁 Convoy landed: %s", progressText(tracked)))
+		if t.Notifier != nil {
+			t.Notifier.NotifyOverseer("convoy", "low", "Convoy landed: "+tracked.Title, progressText(tracked), id)
+		}
+		delete(t.state.Convoys, id)
+	}
+
+	if err := t.pollMerges(ctx); err != nil {
+		log.Printf("slackbot: merge events: %v", err)
+	}
+	return t.saveState()
+}
+
+// start posts the message that anchors a new convoy's thread.
+func (t *ConvoyTracker) start(ctx context.Context, convoy *rpcclient.Convoy, issues []rpcclient.TrackedIssue) {
+	tracked := &trackedConvoy{Title: convoy.Title, Issues: make(map[string]*issueState)}
+	for _, issue := range issues {
+		tracked.Issues[issue.ID] = &issueState{
+			Title:    issue.Title,
+			Status:   issue.Status,
+			Assignee: issue.Assignee,
+			Since:    t.now(),
+		}
+	}
+	ts, err := t.slack.PostMessage(ctx, t.channel, "Convoy started: "+convoy.Title, convoyBlocks(convoy.ID, tracked))
+	if err != nil {
+		log.Printf("slackbot: posting convoy %s: %v", convoy.ID, err)
+		return // retried on the next poll
+	}
+	tracked.ThreadTS = ts
+	t.state.Convoys[convoy.ID] = tracked
+}
+
+// update replies in the thread for issues that closed or stalled since the
+// last poll and refreshes the progress in the anchor message.
+func (t *ConvoyTracker) update(ctx context.Context, convoy *rpcclient.Convoy, tracked *trackedConvoy, issues []rpcclient.TrackedIssue) {
+	now := t.now()
+	stuckAfter := t.StuckAfter
+	if stuckAfter <= 0 {
+		stuckAfter = DefaultStuckAfter
+	}
+
+	changed := false
+	for _, issue := range issues {
+		prev := tracked.Issues[issue.ID]
+		if prev == nil {
+			tracked.Issues[issue.ID] = &issueState{Title: issue.Title, Status: issue.Status, Assignee: issue.Assignee, Since: now}
+			t.reply(ctx, tracked, fmt.Sprintf("➕ Now tracking `%s` %s", issue.ID, issue.Title))
+			changed = true
+			continue
+		}
+		prev.Assignee = issue.Assignee
+		if issue.Status != prev.Status {
+			if issue.Status == "closed" {
+				t.reply(ctx, tracked, fmt.Sprintf("✅ `%s` %s closed (%s)", issue.ID, issue.Title, progressAfter(tracked, issue.ID)))
+			}
+			prev.Status, prev.Since, prev.Stuck = issue.Status, now, false
+			changed = true
+			continue
+		}
+		if isActive(prev.Status) && !prev.Stuck && now.Sub(prev.Since) >= stuckAfter {
+			who := ""
+			if prev.Assignee != "" {
+				who = " on " + prev.Assignee
+			}
+			t.reply(ctx, tracked, fmt.Sprintf("⚠️ `%s` %s looks stuck%s: no progress for %s",
+				issue.ID, issue.Title, who, now.Sub(prev.Since).Round(time.Minute)))
+			prev.Stuck = true
+		}
+	}
+
+	if changed {
+		if err := t.slack.UpdateMessage(ctx, t.channel, tracked.ThreadTS, "Convoy: "+tracked.Title, convoyBlocks(convoy.ID, tracked)); err != nil {
+			log.Printf("slackbot: updating convoy %s: %v", convoy.ID, err)
+		}
+	}
+}
+
+// pollMerges replies in convoy threads for merge results of tracked issues,
+// matched by the worker that submitted the merge request.
+func (t *ConvoyTracker) pollMerges(ctx context.Context) error {
+	if t.state.LastMerge == "" {
+		// First run: start from now rather than replaying history.
+		t.state.LastMerge = t.now().UTC().Format(time.RFC3339)
+		return nil
+	}
+	page, err := t.source.ListEventsPage(ctx, rpcclient.ListEventsRequest{
+		Filter: &rpcclient.EventFilter{
+			Types: []string{events.TypeMerged, events.TypeMergeFailed},
+			After: t.state.LastMerge,
+		},
+		Limit: 100,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Pages are newest first; reply in order.
+	merges := slices.Clone(page.Events)
+	slices.Reverse(merges)
+	for _, ev := range merges {
+		if ev.Timestamp <= t.state.LastMerge {
+			continue
+		}
+		t.state.LastMerge = ev.Timestamp
+		worker, _ := ev.Payload["worker"].(string)
+		tracked, issueID := t.issueForWorker(worker)
+		if tracked == nil {
+			continue
+		}
+		issue := tracked.Issues[issueID]
+		branch, _ := ev.Payload["branch"].(string)
+		if ev.Type == events.TypeMerged {
+			t.reply(ctx, tracked, fmt.Sprintf("🔀 `%s` %s merged (%s)", issueID, issue.Title, branch))
+		} else {
+			reason, _ := ev.Payload["reason"].(string)
+			t.reply(ctx, tracked, fmt.Sprintf("❌ Merge failed for `%s` %s: %s", issueID, issue.Title, reason))
+		}
+	}
+	return nil
+}
+
+// issueForWorker finds the open tracked issue assigned to worker.
+func (t *ConvoyTracker) issueForWorker(worker string) (*trackedConvoy, string) {
+	if worker == "" {
+		return nil, ""
+	}
+	for _, tracked := range t.state.Convoys {
+		for id, issue := range tracked.Issues {
+			if issue.Assignee == worker {
+				return tracked, id
+			}
+		}
+	}
+	return nil, ""
+}
+
+func (t *ConvoyTracker) reply(ctx context.Context, tracked *trackedConvoy, text string) {
+	if err := t.slack.PostThreadReply(ctx, t.channel, tracked.ThreadTS, text); err != nil {
+		log.Printf("slackbot: convoy thread reply: %v", err)
+	}
+}
+
+func (t *ConvoyTracker) loadState() error {
+	if t.StatePath == "" {
+		return nil
+	}
+	data, err := os.ReadFile(t.StatePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading convoy tracker state: %w", err)
+	}
+	var state trackerState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("parsing convoy tracker state: %w", err)
+	}
+	if state.Convoys == nil {
+		state.Convoys = make(map[string]*trackedConvoy)
+	}
+	t.state = state
+	return nil
+}
+
+func (t *ConvoyTracker) saveState() error {
+	if t.StatePath == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(t.state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(t.StatePath), 0755); err != nil {
+		return err
+	}
+	tmp := t.StatePath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil { //nolint:gosec // G306: tracker state is non-sensitive
+		return err
+	}
+	return os.Rename(tmp, t.StatePath)
+}
+
+// isActive reports whether an issue status means someone is working on it.
+func isActive(status string) bool {
+	return status == "in_progress" || status == "hooked"
+}
+
+func progressText(tracked *trackedConvoy) string {
+	done := 0
+	for _, issue := range tracked.Issues {
+		if issue.Status == "closed" {
+			done++
+		}
+	}
+	return fmt.Sprintf("%d/%d done", done, len(tracked.Issues))
+}
+
+// progressAfter is the progress once issueID counts as closed.
+func progressAfter(tracked *trackedConvoy, issueID string) string {
+	prev := tracked.Issues[issueID].Status
+	tracked.Issues[issueID].Status = "closed"
+	defer func() { tracked.Issues[issueID].Status = prev }()
+	return progressText(tracked)
+}
+
+// convoyBlocks renders a convoy's anchor message.
+func convoyBlocks(id string, tracked *trackedConvoy) []map[string]interface{} {
+	return []map[string]interface{}{
+		mrkdwnSection(fmt.Sprintf("🚚 *Convoy:* %s\n%s", tracked.Title, progressText(tracked))),
+		{
+			"type": "context",
+			"elements": []interface{}{
+				map[string]interface{}{"type": "mrkdwn", "text": fmt.Sprintf("`%s` · updates in thread", id)},
+			},
+		},
+	}
+}
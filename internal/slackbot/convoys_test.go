@@ -0,0 +1,171 @@
+package slackbot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/rpcclient"
+)
+
+type fakeConvoys struct {
+	open   []rpcclient.Convoy
+	issues map[string][]rpcclient.TrackedIssue
+	merges []rpcclient.ActivityEvent
+}
+
+func (f *fakeConvoys) ListConvoys(context.Context, string, bool) ([]rpcclient.Convoy, error) {
+	return f.open, nil
+}
+
+func (f *fakeConvoys) GetConvoyStatus(_ context.Context, id string) (*rpcclient.Convoy, []rpcclient.TrackedIssue, error) {
+	return &rpcclient.Convoy{ID: id, Title: "Ship " + id}, f.issues[id], nil
+}
+
+func (f *fakeConvoys) ListEventsPage(context.Context, rpcclient.ListEventsRequest) (*rpcclient.EventsPage, error) {
+	return &rpcclient.EventsPage{Events: f.merges}, nil
+}
+
+type slackCall struct {
+	method string
+	body   map[string]interface{}
+}
+
+// recordingSlack is a Web API stub that records every call and hands out
+// increasing message timestamps.
+type recordingSlack struct {
+	mu    sync.Mutex
+	calls []slackCall
+}
+
+func (s *recordingSlack) api(t *testing.T) *API {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		s.mu.Lock()
+		s.calls = append(s.calls, slackCall{strings.TrimPrefix(r.URL.Path, "/"), body})
+		n := len(s.calls)
+		s.mu.Unlock()
+		fmt.Fprintf(w, `{"ok":true,"ts":"1700000000.%06d"}`, n)
+	}))
+	t.Cleanup(srv.Close)
+	api := NewAPI("xoxb-test")
+	api.baseURL = srv.URL + "/"
+	return api
+}
+
+// take returns and clears the recorded calls.
+func (s *recordingSlack) take() []slackCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	calls := s.calls
+	s.calls = nil
+	return calls
+}
+
+func replies(calls []slackCall) []string {
+	var texts []string
+	for _, c := range calls {
+		if c.method == "chat.postMessage" && c.body["thread_ts"] != nil {
+			texts = append(texts, c.body["text"].(string))
+		}
+	}
+	return texts
+}
+
+func TestConvoyTracker(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	source := &fakeConvoys{
+		open: []rpcclient.Convoy{{ID: "hq-cv-1"}},
+		issues: map[string][]rpcclient.TrackedIssue{"hq-cv-1": {
+			{ID: "gt-a", Title: "Parser", Status: "in_progress", Assignee: "gastown/polecats/nux"},
+			{ID: "gt-b", Title: "Lexer", Status: "open"},
+		}},
+	}
+	slack := &recordingSlack{}
+	tracker := NewConvoyTracker(source, slack.api(t), "C42")
+	tracker.StatePath = filepath.Join(t.TempDir(), "state.json")
+	tracker.now = func() time.Time { return now }
+
+	// Start: one anchor message, no replies.
+	if err := tracker.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	calls := slack.take()
+	if len(calls) != 1 || calls[0].method != "chat.postMessage" || calls[0].body["thread_ts"] != nil {
+		t.Fatalf("start calls = %+v", calls)
+	}
+	threadTS := tracker.state.Convoys["hq-cv-1"].ThreadTS
+	if threadTS == "" {
+		t.Fatal("no thread recorded")
+	}
+
+	// Nothing changed: nothing posted.
+	if err := tracker.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if calls := slack.take(); len(calls) != 0 {
+		t.Fatalf("idle poll posted %+v", calls)
+	}
+
+	// A merge, a close, and a stall, all replied in-thread.
+	source.merges = []rpcclient.ActivityEvent{{
+		Timestamp: now.Add(time.Minute).Format(time.RFC3339),
+		Type:      events.TypeMerged,
+		Payload:   map[string]interface{}{"worker": "gastown/polecats/nux", "branch": "polecat/nux"},
+	}}
+	source.issues["hq-cv-1"][0].Status = "closed"
+	source.issues["hq-cv-1"][1].Status = "in_progress"
+	now = now.Add(5 * time.Minute)
+	if err := tracker.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	calls = slack.take()
+	got := replies(calls)
+	if len(got) != 2 || !strings.Contains(got[0], "gt-a") || !strings.Contains(got[0], "closed (1/2 done)") ||
+		!strings.Contains(got[1], "merged") {
+		t.Errorf("replies = %q", got)
+	}
+	for _, c := range calls {
+		if c.body["thread_ts"] != nil && c.body["thread_ts"] != threadTS {
+			t.Errorf("reply outside thread: %+v", c)
+		}
+	}
+
+	now = now.Add(DefaultStuckAfter)
+	if err := tracker.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := replies(slack.take()); len(got) != 1 || !strings.Contains(got[0], "gt-b") || !strings.Contains(got[0], "stuck") {
+		t.Errorf("stuck replies = %q", got)
+	}
+
+	// A restarted tracker continues the thread instead of reposting.
+	restarted := NewConvoyTracker(source, tracker.slack, "C42")
+	restarted.StatePath = tracker.StatePath
+	restarted.now = tracker.now
+	if err := restarted.loadState(); err != nil {
+		t.Fatal(err)
+	}
+	source.open = nil
+	source.issues["hq-cv-1"][1].Status = "closed"
+	if err := restarted.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	got = replies(slack.take())
+	if len(got) != 2 || !strings.Contains(got[1], "Convoy landed: 2/2 done") {
+		t.Errorf("landing replies = %q", got)
+	}
+	if len(restarted.state.Convoys) != 0 {
+		t.Errorf("landed convoy still tracked")
+	}
+}
@@ -0,0 +1,153 @@
+package slackbot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/rpcclient"
+)
+
+// PendingDecisions is the subset of the DecisionService the decision
+// poster polls. *rpcclient.Client implements it.
+type PendingDecisions interface {
+	ListPendingDecisions(ctx context.Context) ([]rpcclient.Decision, error)
+}
+
+// DecisionPoster posts each new pending decision to the channel the town's
+// Slack routing picks for the requesting agent's rig and the decision's
+// urgency, as a message carrying one button per option. The poll also
+// picks up decisions created from the CLI, which don't reach the RPC
+// server's event bus. Decisions routed into quiet hours are held and
+// posted once the quiet hours end.
+type DecisionPoster struct {
+	source PendingDecisions
+	slack  *API
+	router *Router
+
+	// StatePath, if set, persists which decisions were posted so a
+	// restarted poster doesn't post them again.
+	StatePath string
+
+	now    func() time.Time
+	posted map[string]postedDecision
+}
+
+type postedDecision struct {
+	Channel string `json:"channel"`
+	TS      string `json:"ts"`
+}
+
+// NewDecisionPoster creates a poster routing through router.
+func NewDecisionPoster(source PendingDecisions, slack *API, router *Router) *DecisionPoster {
+	return &DecisionPoster{
+		source: source,
+		slack:  slack,
+		router: router,
+		now:    time.Now,
+		posted: make(map[string]postedDecision),
+	}
+}
+
+// Run polls every interval until ctx is canceled.
+func (p *DecisionPoster) Run(ctx context.Context, interval time.Duration) error {
+	if err := p.loadState(); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := p.Poll(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("slackbot: decision poll: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll posts pending decisions not posted yet and forgets decisions that
+// are no longer pending.
+func (p *DecisionPoster) Poll(ctx context.Context) error {
+	pending, err := p.source.ListPendingDecisions(ctx)
+	if err != nil {
+		return fmt.Errorf("listing pending decisions: %w", err)
+	}
+
+	stillPending := make(map[string]bool, len(pending))
+	for _, d := range pending {
+		stillPending[d.ID] = true
+		if _, ok := p.posted[d.ID]; ok {
+			continue
+		}
+		route := p.router.Route(RigOf(d.RequestedBy), decisionSeverity(d.Urgency), p.now())
+		if route.Channel == "" || route.Quiet {
+			continue
+		}
+		ts, err := p.slack.PostMessage(ctx, route.Channel, "Decision needed: "+d.Question, DecisionBlocks(d))
+		if err != nil {
+			log.Printf("slackbot: posting decision %s: %v", d.ID, err)
+			continue
+		}
+		p.posted[d.ID] = postedDecision{Channel: route.Channel, TS: ts}
+	}
+
+	for id := range p.posted {
+		if !stillPending[id] {
+			delete(p.posted, id)
+		}
+	}
+	return p.saveState()
+}
+
+// decisionSeverity maps a decision's urgency onto a routing severity.
+func decisionSeverity(urgency string) string {
+	if config.IsValidSeverity(urgency) {
+		return urgency
+	}
+	return config.SeverityMedium
+}
+
+func (p *DecisionPoster) loadState() error {
+	if p.StatePath == "" {
+		return nil
+	}
+	data, err := os.ReadFile(p.StatePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading decision poster state: %w", err)
+	}
+	posted := make(map[string]postedDecision)
+	if err := json.Unmarshal(data, &posted); err != nil {
+		return fmt.Errorf("parsing decision poster state: %w", err)
+	}
+	p.posted = posted
+	return nil
+}
+
+func (p *DecisionPoster) saveState() error {
+	if p.StatePath == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(p.posted, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p.StatePath), 0755); err != nil {
+		return err
+	}
+	tmp := p.StatePath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil { //nolint:gosec // G306: poster state is non-sensitive
+		return err
+	}
+	return os.Rename(tmp, p.StatePath)
+}
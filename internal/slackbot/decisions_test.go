@@ -0,0 +1,122 @@
+package slackbot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rpcclient"
+)
+
+// fakeDecisionService serves DecisionService/ListPending with whatever
+// decisions it currently holds.
+type fakeDecisionService struct {
+	mu        sync.Mutex
+	decisions []map[string]interface{}
+}
+
+func (f *fakeDecisionService) client(t *testing.T) *rpcclient.Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/gastown.v1.DecisionService/ListPending" {
+			http.NotFound(w, r)
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"decisions": f.decisions})
+	}))
+	t.Cleanup(srv.Close)
+	return rpcclient.NewClient(srv.URL)
+}
+
+func (f *fakeDecisionService) set(decisions ...map[string]interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.decisions = decisions
+}
+
+func pendingDecision(id, requestedBy, urgency string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          id,
+		"question":    "Which cache for " + id + "?",
+		"options":     []map[string]interface{}{{"label": "Redis", "recommended": true}, {"label": "Memcached"}},
+		"requestedBy": map[string]interface{}{"name": requestedBy},
+		"urgency":     urgency,
+	}
+}
+
+func TestDecisionPoster(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, "slack.json")
+	writeSlackConfig(t, cfgPath, `{
+		"type": "slack", "version": 1, "enabled": true,
+		"default_channel": "#general",
+		"routes": [{"rig": "gastown", "channel": "#gastown"}],
+		"quiet_hours": {"start": "22:00", "end": "07:00", "timezone": "UTC", "min_severity": "high"}
+	}`, time.Now().Add(-time.Hour))
+	router, err := NewRouter(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := &fakeDecisionService{}
+	slack := &recordingSlack{}
+	poster := NewDecisionPoster(service.client(t), slack.api(t), router)
+	poster.StatePath = filepath.Join(dir, "state.json")
+	now := time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC)
+	poster.now = func() time.Time { return now }
+
+	// During quiet hours only the high-urgency decision goes out, to the
+	// requester's rig channel, with a button per option.
+	service.set(
+		pendingDecision("hq-dec-1", "gastown/polecats/nux", "URGENCY_HIGH"),
+		pendingDecision("hq-dec-2", "mayor/", "URGENCY_LOW"),
+	)
+	if err := poster.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	calls := slack.take()
+	if len(calls) != 1 || calls[0].method != "chat.postMessage" || calls[0].body["channel"] != "#gastown" {
+		t.Fatalf("quiet-hours calls = %+v", calls)
+	}
+	blocks, _ := json.Marshal(calls[0].body["blocks"])
+	if !strings.Contains(string(blocks), `"value":"hq-dec-1:1"`) || !strings.Contains(string(blocks), "Memcached") {
+		t.Errorf("decision blocks = %s", blocks)
+	}
+
+	// Once quiet hours end the held decision is posted, and nothing twice.
+	now = now.Add(8 * time.Hour)
+	if err := poster.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	calls = slack.take()
+	if len(calls) != 1 || calls[0].body["channel"] != "#general" ||
+		!strings.Contains(calls[0].body["text"].(string), "hq-dec-2") {
+		t.Fatalf("after quiet hours calls = %+v", calls)
+	}
+
+	// A restarted poster remembers what it posted; resolved decisions are
+	// forgotten.
+	service.set(pendingDecision("hq-dec-2", "mayor/", "URGENCY_LOW"))
+	restarted := NewDecisionPoster(service.client(t), slack.api(t), router)
+	restarted.StatePath = poster.StatePath
+	if err := restarted.loadState(); err != nil {
+		t.Fatal(err)
+	}
+	if err := restarted.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if calls := slack.take(); len(calls) != 0 {
+		t.Errorf("restarted poster reposted %+v", calls)
+	}
+	if _, ok := restarted.posted["hq-dec-1"]; ok || len(restarted.posted) != 1 {
+		t.Errorf("posted = %v, want only the pending hq-dec-2", restarted.posted)
+	}
+}
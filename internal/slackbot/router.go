@@ -0,0 +1,186 @@
+package slackbot
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// TownRig is the rig name routing rules use for town-level agents such as
+// the mayor and deacon.
+const TownRig = "town"
+
+// Route is where a notification should go.
+type Route struct {
+	// Channel is the destination channel; empty when Slack is disabled or
+	// no rule or default channel applies.
+	Channel string
+	// Quiet is set when the notification falls in quiet hours below the
+	// quiet-hours severity threshold and should be held back.
+	Quiet bool
+}
+
+// Router resolves notification channels from the town's Slack config
+// (settings/slack.json). The file is re-read whenever it changes, so edits
+// take effect in long-running processes without a restart.
+type Router struct {
+	path string
+
+	mu      sync.Mutex
+	cfg     *config.SlackConfig
+	loc     *time.Location
+	modTime time.Time
+	size    int64
+}
+
+// NewRouter loads the Slack config at path. A missing file yields a router
+// that routes nowhere until the file is created.
+func NewRouter(path string) (*Router, error) {
+	r := &Router{path: path}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload re-reads the config file. On error the previous config stays in
+// effect.
+func (r *Router) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.load()
+}
+
+// Config returns the config currently in effect.
+func (r *Router) Config() *config.SlackConfig {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reloadIfChanged()
+	return r.cfg
+}
+
+// Route picks the channel for a notification about rig at severity.
+func (r *Router) Route(rig, severity string, now time.Time) Route {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reloadIfChanged()
+
+	cfg := r.cfg
+	if !cfg.Enabled {
+		return Route{}
+	}
+
+	route := Route{Channel: cfg.DefaultChannel}
+	for _, rule := range cfg.Routes {
+		if rule.Rig != "" && rule.Rig != "*" && rule.Rig != rig {
+			continue
+		}
+		if rule.MinSeverity != "" && config.SeverityRank(severity) < config.SeverityRank(rule.MinSeverity) {
+			continue
+		}
+		route.Channel = rule.Channel
+		break
+	}
+
+	if q := cfg.QuietHours; q != nil && inQuietHours(q, now.In(r.loc)) {
+		threshold := q.MinSeverity
+		if threshold == "" {
+			threshold = config.SeverityCritical
+		}
+		route.Quiet = config.SeverityRank(severity) < config.SeverityRank(threshold)
+	}
+	return route
+}
+
+// reloadIfChanged reloads the config when the file's size or modification
+// time differs from the loaded version. Errors keep the current config.
+func (r *Router) reloadIfChanged() {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		if os.IsNotExist(err) && !r.modTime.IsZero() {
+			_ = r.load()
+		}
+		return
+	}
+	if !info.ModTime().Equal(r.modTime) || info.Size() != r.size {
+		_ = r.load()
+	}
+}
+
+// load reads the config file; callers hold mu.
+func (r *Router) load() error {
+	info, statErr := os.Stat(r.path)
+	cfg, err := config.LoadSlackConfig(r.path)
+	if errors.Is(err, config.ErrNotFound) {
+		r.cfg, r.loc = config.NewSlackConfig(), time.Local
+		r.modTime, r.size = time.Time{}, 0
+		return nil
+	}
+	if err != nil {
+		if r.cfg == nil {
+			return err
+		}
+		// Remember the broken version so it is not re-parsed on every call.
+		if statErr == nil {
+			r.modTime, r.size = info.ModTime(), info.Size()
+		}
+		return err
+	}
+
+	loc := time.Local
+	if cfg.QuietHours != nil && cfg.QuietHours.Timezone != "" {
+		loc, _ = time.LoadLocation(cfg.QuietHours.Timezone) // validated on load
+	}
+	r.cfg, r.loc = cfg, loc
+	if statErr == nil {
+		r.modTime, r.size = info.ModTime(), info.Size()
+	}
+	return nil
+}
+
+// inQuietHours reports whether now's wall-clock time falls in the window.
+// Windows ending before they start wrap past midnight.
+func inQuietHours(q *config.SlackQuietHours, now time.Time) bool {
+	start, err1 := time.Parse("15:04", q.Start)
+	end, err2 := time.Parse("15:04", q.End)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	minute := now.Hour()*60 + now.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+	if from <= to {
+		return minute >= from && minute < to
+	}
+	return minute >= from || minute < to
+}
+
+// RigOf returns the rig an agent address belongs to, e.g. "gastown" for
+// "gastown/polecats/nux". Town-level agents ("mayor", "deacon/") map to
+// TownRig.
+func RigOf(agent string) string {
+	rig, _, found := strings.Cut(strings.TrimSuffix(agent, "/"), "/")
+	switch {
+	case !found, rig == "mayor", rig == "deacon":
+		return TownRig
+	default:
+		return rig
+	}
+}
+
+// Describe summarizes a route for display.
+func (r Route) Describe() string {
+	switch {
+	case r.Channel == "":
+		return "not routed"
+	case r.Quiet:
+		return fmt.Sprintf("%s (held: quiet hours)", r.Channel)
+	default:
+		return r.Channel
+	}
+}
@@ -0,0 +1,101 @@
+package slackbot
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeSlackConfig(t *testing.T, path, body string, mtime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRouter_Route(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slack.json")
+	writeSlackConfig(t, path, `{
+		"type": "slack", "version": 1, "enabled": true,
+		"default_channel": "#general",
+		"routes": [
+			{"rig": "gastown", "channel": "#gastown-urgent", "min_severity": "high"},
+			{"rig": "gastown", "channel": "#gastown"},
+			{"rig": "town", "channel": "#hq"}
+		],
+		"quiet_hours": {"start": "22:00", "end": "07:00", "timezone": "UTC", "min_severity": "high"}
+	}`, time.Now().Add(-time.Hour))
+
+	r, err := NewRouter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	noon := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	night := time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		rig, severity string
+		now           time.Time
+		want          Route
+	}{
+		{"gastown", "critical", noon, Route{Channel: "#gastown-urgent"}},
+		{"gastown", "low", noon, Route{Channel: "#gastown"}},
+		{"beads", "medium", noon, Route{Channel: "#general"}},
+		{TownRig, "medium", noon, Route{Channel: "#hq"}},
+		{"gastown", "medium", night, Route{Channel: "#gastown", Quiet: true}},
+		{"gastown", "high", night, Route{Channel: "#gastown-urgent"}},
+	}
+	for _, tt := range tests {
+		if got := r.Route(tt.rig, tt.severity, tt.now); got != tt.want {
+			t.Errorf("Route(%s, %s, %s) = %+v, want %+v", tt.rig, tt.severity, tt.now.Format("15:04"), got, tt.want)
+		}
+	}
+}
+
+func TestRouter_ReloadsOnChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slack.json")
+	r, err := NewRouter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Route("gastown", "high", time.Now()); got.Channel != "" {
+		t.Fatalf("missing config routed to %q", got.Channel)
+	}
+
+	writeSlackConfig(t, path, `{"enabled": true, "default_channel": "#one"}`, time.Now().Add(-time.Hour))
+	if got := r.Route("gastown", "high", time.Now()); got.Channel != "#one" {
+		t.Fatalf("after create: %q", got.Channel)
+	}
+
+	writeSlackConfig(t, path, `{"enabled": true, "default_channel": "#two"}`, time.Now())
+	if got := r.Route("gastown", "high", time.Now()); got.Channel != "#two" {
+		t.Fatalf("after edit: %q", got.Channel)
+	}
+
+	// A broken edit keeps the last good config.
+	writeSlackConfig(t, path, `{"enabled": true, "routes": [{"rig": "x"}]}`, time.Now().Add(time.Minute))
+	if err := r.Reload(); err == nil {
+		t.Error("Reload accepted invalid config")
+	}
+	if got := r.Route("gastown", "high", time.Now()); got.Channel != "#two" {
+		t.Errorf("after invalid edit: %q", got.Channel)
+	}
+}
+
+func TestRigOf(t *testing.T) {
+	for agent, want := range map[string]string{
+		"gastown/polecats/nux": "gastown",
+		"beads/witness":        "beads",
+		"mayor":                TownRig,
+		"mayor/":               TownRig,
+		"deacon/dogs/alpha":    TownRig,
+	} {
+		if got := RigOf(agent); got != want {
+			t.Errorf("RigOf(%q) = %q, want %q", agent, got, want)
+		}
+	}
+}
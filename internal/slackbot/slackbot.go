@@ -0,0 +1,283 @@
+// Package slackbot resolves decisions from Slack. Decision messages carry
+// one button per option; clicking one opens a confirmation modal that
+// captures a rationale, and submitting the modal resolves the decision
+// through the DecisionService RPC and updates the original message.
+package slackbot
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rpcclient"
+)
+
+// maxRequestAge is how old a signed request may be before it is rejected
+// as a possible replay. Slack recommends five minutes.
+const maxRequestAge = 5 * time.Minute
+
+// maxPayloadSize bounds interaction request bodies.
+const maxPayloadSize = 1 << 20
+
+// Decisions is the subset of the DecisionService the bot uses.
+// *rpcclient.Client implements it.
+type Decisions interface {
+	GetDecision(ctx context.Context, decisionID string) (*rpcclient.Decision, error)
+	ResolveDecision(ctx context.Context, decisionID string, chosenIndex int, rationale, resolvedBy string) (*rpcclient.Decision, error)
+}
+
+// Handler serves Slack's interactivity Request URL.
+type Handler struct {
+	decisions     Decisions
+	slack         *API
+	signingSecret string
+	now           func() time.Time
+}
+
+// NewHandler creates an interactivity handler. Requests are verified
+// against the app's signing secret.
+func NewHandler(decisions Decisions, slack *API, signingSecret string) *Handler {
+	return &Handler{
+		decisions:     decisions,
+		slack:         slack,
+		signingSecret: signingSecret,
+		now:           time.Now,
+	}
+}
+
+// interaction is the subset of Slack's interaction payload the handler
+// reads, for both block_actions and view_submission.
+type interaction struct {
+	Type      string `json:"type"`
+	TriggerID string `json:"trigger_id"`
+	User      struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	Channel struct {
+		ID string `json:"id"`
+	} `json:"channel"`
+	Message struct {
+		TS string `json:"ts"`
+	} `json:"message"`
+	Actions []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+	View struct {
+		CallbackID      string `json:"callback_id"`
+		PrivateMetadata string `json:"private_metadata"`
+		State           struct {
+			Values map[string]map[string]struct {
+				Value string `json:"value"`
+			} `json:"values"`
+		} `json:"state"`
+	} `json:"view"`
+}
+
+// pendingResolution travels in the modal's private_metadata from the button
+// click to the submission.
+type pendingResolution struct {
+	DecisionID string `json:"decision_id"`
+	Index      int    `json:"index"`
+	Channel    string `json:"channel,omitempty"`
+	MessageTS  string `json:"message_ts,omitempty"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, "reading body", http.StatusBadRequest)
+		return
+	}
+	if err := h.verify(r.Header, body); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	form, err := parseForm(body)
+	if err != nil {
+		http.Error(w, "invalid form body", http.StatusBadRequest)
+		return
+	}
+	var in interaction
+	if err := json.Unmarshal([]byte(form), &in); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	switch in.Type {
+	case "block_actions":
+		h.handleAction(r.Context(), &in)
+		w.WriteHeader(http.StatusOK)
+	case "view_submission":
+		h.handleSubmission(r.Context(), w, &in)
+	default:
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// handleAction opens the confirmation modal for a clicked option button.
+func (h *Handler) handleAction(ctx context.Context, in *interaction) {
+	for _, action := range in.Actions {
+		if !strings.HasPrefix(action.ActionID, resolveActionPrefix) {
+			continue
+		}
+		decisionID, index, err := parseActionValue(action.Value)
+		if err != nil {
+			log.Printf("slackbot: %v", err)
+			return
+		}
+		d, err := h.decisions.GetDecision(ctx, decisionID)
+		if err != nil {
+			log.Printf("slackbot: fetching decision %s: %v", decisionID, err)
+			return
+		}
+		if d.Resolved {
+			// Someone got there first (possibly from a terminal): show the
+			// outcome instead of a modal.
+			h.markResolved(ctx, in.Channel.ID, in.Message.TS, *d, d.ResolvedBy)
+			return
+		}
+		if index > len(d.Options) {
+			log.Printf("slackbot: decision %s has no option %d", decisionID, index)
+			return
+		}
+
+		metadata, _ := json.Marshal(pendingResolution{
+			DecisionID: decisionID,
+			Index:      index,
+			Channel:    in.Channel.ID,
+			MessageTS:  in.Message.TS,
+		})
+		if err := h.slack.OpenView(ctx, in.TriggerID, confirmView(*d, index, string(metadata))); err != nil {
+			log.Printf("slackbot: opening confirmation for %s: %v", decisionID, err)
+		}
+		return
+	}
+}
+
+// handleSubmission resolves the decision confirmed in the modal. RPC
+// failures are reported on the modal's rationale field so the user can
+// retry; success closes the modal.
+func (h *Handler) handleSubmission(ctx context.Context, w http.ResponseWriter, in *interaction) {
+	if in.View.CallbackID != resolveCallbackID {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	var pending pendingResolution
+	if err := json.Unmarshal([]byte(in.View.PrivateMetadata), &pending); err != nil {
+		http.Error(w, "invalid view metadata", http.StatusBadRequest)
+		return
+	}
+	rationale := strings.TrimSpace(in.View.State.Values[rationaleBlockID][rationaleActionID].Value)
+
+	d, err := h.decisions.ResolveDecision(ctx, pending.DecisionID, pending.Index, rationale, "slack:"+in.User.ID)
+	if err != nil {
+		writeJSON(w, map[string]interface{}{
+			"response_action": "errors",
+			"errors": map[string]string{
+				rationaleBlockID: fmt.Sprintf("Could not resolve %s: %v", pending.DecisionID, err),
+			},
+		})
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+
+	// The Resolve response carries no options, so re-fetch the decision to
+	// render the updated message.
+	if full, err := h.decisions.GetDecision(ctx, pending.DecisionID); err == nil {
+		full.ChosenIndex = pending.Index
+		full.Rationale = d.Rationale
+		d = full
+	}
+	h.markResolved(ctx, pending.Channel, pending.MessageTS, *d, "slack:"+in.User.ID)
+}
+
+// markResolved replaces the decision message's buttons with the outcome.
+// It is best-effort: the decision is already resolved. resolvedBy is the
+// resolver identity recorded on the decision ("slack:U123", "overseer").
+func (h *Handler) markResolved(ctx context.Context, channel, ts string, d rpcclient.Decision, resolvedBy string) {
+	if channel == "" || ts == "" {
+		return
+	}
+	label := strconv.Itoa(d.ChosenIndex)
+	if d.ChosenIndex >= 1 && d.ChosenIndex <= len(d.Options) {
+		label = d.Options[d.ChosenIndex-1].Label
+	}
+	by := resolvedBy
+	if userID, ok := strings.CutPrefix(resolvedBy, "slack:"); ok {
+		by = fmt.Sprintf("<@%s>", userID)
+	}
+	text := fmt.Sprintf("Decision %s resolved: %s", d.ID, label)
+	if err := h.slack.UpdateMessage(ctx, channel, ts, text, resolvedBlocks(d, label, d.Rationale, by)); err != nil {
+		log.Printf("slackbot: updating message for %s: %v", d.ID, err)
+	}
+}
+
+// verify checks Slack's request signature.
+func (h *Handler) verify(header http.Header, body []byte) error {
+	return verifyRequest(h.signingSecret, h.now(), header, body)
+}
+
+// verifyRequest checks Slack's request signature: an HMAC-SHA256 of
+// "v0:<timestamp>:<body>" keyed with the signing secret.
+func verifyRequest(secret string, now time.Time, header http.Header, body []byte) error {
+	ts := header.Get("X-Slack-Request-Timestamp")
+	sig := header.Get("X-Slack-Signature")
+	if ts == "" || sig == "" {
+		return fmt.Errorf("missing Slack signature")
+	}
+	secs, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid Slack timestamp")
+	}
+	if age := now.Sub(time.Unix(secs, 0)); age > maxRequestAge || age < -maxRequestAge {
+		return fmt.Errorf("stale Slack request")
+	}
+	if !hmac.Equal([]byte(sig), []byte(sign(secret, ts, body))) {
+		return fmt.Errorf("invalid Slack signature")
+	}
+	return nil
+}
+
+// sign computes the v0 signature Slack sends in X-Slack-Signature.
+func sign(secret, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "v0:%s:", ts)
+	mac.Write(body)
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// parseForm extracts the "payload" field of an interaction request. The
+// body has already been read for signature verification, so it is parsed
+// directly rather than through Request.ParseForm.
+func parseForm(body []byte) (string, error) {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return "", err
+	}
+	payload := values.Get("payload")
+	if payload == "" {
+		return "", fmt.Errorf("missing payload")
+	}
+	return payload, nil
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
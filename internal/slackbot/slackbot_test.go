@@ -0,0 +1,235 @@
+package slackbot
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rpcclient"
+)
+
+const testSecret = "8f742231b10e8888abcd99yyyzzz85a5"
+
+type fakeDecisions struct {
+	decision rpcclient.Decision
+	resolved struct {
+		index      int
+		rationale  string
+		resolvedBy string
+	}
+	resolveErr error
+}
+
+func (f *fakeDecisions) GetDecision(_ context.Context, id string) (*rpcclient.Decision, error) {
+	d := f.decision
+	return &d, nil
+}
+
+func (f *fakeDecisions) ResolveDecision(_ context.Context, id string, index int, rationale, resolvedBy string) (*rpcclient.Decision, error) {
+	if f.resolveErr != nil {
+		return nil, f.resolveErr
+	}
+	f.resolved.index = index
+	f.resolved.rationale = rationale
+	f.resolved.resolvedBy = resolvedBy
+	return &rpcclient.Decision{ID: id, ChosenIndex: index, Rationale: rationale, Resolved: true}, nil
+}
+
+// fakeSlack records Web API calls.
+func fakeSlack(t *testing.T) (*API, map[string]map[string]interface{}) {
+	t.Helper()
+	calls := make(map[string]map[string]interface{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		calls[strings.TrimPrefix(r.URL.Path, "/")] = body
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	t.Cleanup(srv.Close)
+	api := NewAPI("xoxb-test")
+	api.baseURL = srv.URL + "/"
+	return api, calls
+}
+
+func signedRequest(t *testing.T, payload interface{}, now time.Time) *http.Request {
+	t.Helper()
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := url.Values{"payload": {string(data)}}.Encode()
+	ts := strconv.FormatInt(now.Unix(), 10)
+	req := httptest.NewRequest(http.MethodPost, "/slack/interactions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Slack-Request-Timestamp", ts)
+	req.Header.Set("X-Slack-Signature", sign(testSecret, ts, []byte(body)))
+	return req
+}
+
+func testDecision() rpcclient.Decision {
+	return rpcclient.Decision{
+		ID:       "gt-dec-cache_strategy",
+		Question: "Which cache?",
+		Options: []rpcclient.DecisionOption{
+			{Label: "Redis", Recommended: true},
+			{Label: "In-memory", Description: "Simpler, lost on restart"},
+		},
+		RequestedBy: "gastown/polecats/nux",
+		Urgency:     "high",
+	}
+}
+
+func TestDecisionBlocks(t *testing.T) {
+	blocks := DecisionBlocks(testDecision())
+	actions := blocks[len(blocks)-1]
+	if actions["type"] != "actions" {
+		t.Fatalf("last block = %v, want actions", actions["type"])
+	}
+	buttons := actions["elements"].([]interface{})
+	if len(buttons) != 2 {
+		t.Fatalf("got %d buttons, want 2", len(buttons))
+	}
+	first := buttons[0].(map[string]interface{})
+	if first["style"] != "primary" || first["value"] != "gt-dec-cache_strategy:1" {
+		t.Errorf("recommended button = %v", first)
+	}
+	second := buttons[1].(map[string]interface{})
+	if _, ok := second["style"]; ok || second["action_id"] == first["action_id"] {
+		t.Errorf("second button = %v", second)
+	}
+}
+
+func TestParseActionValue(t *testing.T) {
+	id, index, err := parseActionValue(actionValue("gt-dec-a.b:c", 3))
+	if err != nil || id != "gt-dec-a.b:c" || index != 3 {
+		t.Errorf("round trip = %q, %d, %v", id, index, err)
+	}
+	for _, bad := range []string{"", "gt-dec", ":1", "gt-dec:0", "gt-dec:x"} {
+		if _, _, err := parseActionValue(bad); err == nil {
+			t.Errorf("parseActionValue(%q) succeeded", bad)
+		}
+	}
+}
+
+func TestHandler_RejectsBadSignature(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	h := NewHandler(&fakeDecisions{}, NewAPI("xoxb-test"), testSecret)
+	h.now = func() time.Time { return now }
+
+	req := signedRequest(t, map[string]string{"type": "block_actions"}, now)
+	req.Header.Set("X-Slack-Signature", "v0=deadbeef")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("bad signature: status %d", rec.Code)
+	}
+
+	req = signedRequest(t, map[string]string{"type": "block_actions"}, now.Add(-10*time.Minute))
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("stale request: status %d", rec.Code)
+	}
+}
+
+func TestHandler_ButtonOpensConfirmationAndSubmitResolves(t *testing.T) {
+	now := time.Now()
+	decisions := &fakeDecisions{decision: testDecision()}
+	api, calls := fakeSlack(t)
+	h := NewHandler(decisions, api, testSecret)
+
+	click := map[string]interface{}{
+		"type":       "block_actions",
+		"trigger_id": "trig-1",
+		"user":       map[string]string{"id": "U123"},
+		"channel":    map[string]string{"id": "C42"},
+		"message":    map[string]string{"ts": "1700000000.000100"},
+		"actions": []map[string]string{
+			{"action_id": resolveActionPrefix + "2", "value": actionValue("gt-dec-cache_strategy", 2)},
+		},
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, signedRequest(t, click, now))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("click: status %d", rec.Code)
+	}
+	open := calls["views.open"]
+	if open == nil || open["trigger_id"] != "trig-1" {
+		t.Fatalf("views.open = %v", open)
+	}
+	view := open["view"].(map[string]interface{})
+	metadata := view["private_metadata"].(string)
+	if decisions.resolved.index != 0 {
+		t.Fatal("decision resolved before confirmation")
+	}
+
+	submit := map[string]interface{}{
+		"type": "view_submission",
+		"user": map[string]string{"id": "U123"},
+		"view": map[string]interface{}{
+			"callback_id":      resolveCallbackID,
+			"private_metadata": metadata,
+			"state": map[string]interface{}{
+				"values": map[string]interface{}{
+					rationaleBlockID: map[string]interface{}{
+						rationaleActionID: map[string]string{"value": "  restarts are rare  "},
+					},
+				},
+			},
+		},
+	}
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, signedRequest(t, submit, now))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("submit: status %d", rec.Code)
+	}
+	if got := decisions.resolved; got.index != 2 || got.rationale != "restarts are rare" || got.resolvedBy != "slack:U123" {
+		t.Errorf("resolved = %+v", got)
+	}
+	update := calls["chat.update"]
+	if update == nil || update["channel"] != "C42" || update["ts"] != "1700000000.000100" {
+		t.Fatalf("chat.update = %v", update)
+	}
+	if !strings.Contains(update["text"].(string), "In-memory") {
+		t.Errorf("update text = %q", update["text"])
+	}
+}
+
+func TestHandler_SubmitErrorKeepsModalOpen(t *testing.T) {
+	decisions := &fakeDecisions{decision: testDecision(), resolveErr: io.ErrUnexpectedEOF}
+	api, calls := fakeSlack(t)
+	h := NewHandler(decisions, api, testSecret)
+
+	metadata, _ := json.Marshal(pendingResolution{DecisionID: "gt-dec-cache_strategy", Index: 1})
+	submit := map[string]interface{}{
+		"type": "view_submission",
+		"user": map[string]string{"id": "U123"},
+		"view": map[string]interface{}{
+			"callback_id":      resolveCallbackID,
+			"private_metadata": string(metadata),
+		},
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, signedRequest(t, submit, time.Now()))
+
+	var resp struct {
+		ResponseAction string            `json:"response_action"`
+		Errors         map[string]string `json:"errors"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("response %q: %v", rec.Body.String(), err)
+	}
+	if resp.ResponseAction != "errors" || resp.Errors[rationaleBlockID] == "" {
+		t.Errorf("response = %+v", resp)
+	}
+	if _, ok := calls["chat.update"]; ok {
+		t.Error("message updated although resolve failed")
+	}
+}
@@ -0,0 +1,343 @@
+package sling
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// Capability tag kinds. Agents declare tags like lang:go, repo:beads or
+// role:review; a bead label of one of these kinds is a requirement that
+// gt sling --auto matches against them.
+var CapabilityKinds = []string{"lang", "repo", "role"}
+
+// RigCapabilitiesKey is the rig config key (gt rig config) listing the
+// comma-separated capability tags of the rig's polecats. Every rig also
+// has repo:<rig> implicitly.
+const RigCapabilitiesKey = "capabilities"
+
+// AutoRouteHistory is how far back past slings count toward success rates.
+const AutoRouteHistory = 30 * 24 * time.Hour
+
+// Score weights. Capability match dominates; among equally capable targets
+// the less loaded one wins, then the one with the better track record.
+const (
+	autoWeightMatch   = 0.5
+	autoWeightLoad    = 0.3
+	autoWeightSuccess = 0.2
+)
+
+// AutoCandidate is a target gt sling --auto considered for a bead.
+type AutoCandidate struct {
+	Target       string   // Sling target: a rig (fresh polecat) or <rig>/crew/<name>
+	Rig          string   // Rig the target works in
+	Capabilities []string // Declared tags plus the implicit repo:<rig>
+	Load         float64  // Share of capacity in use, 0 (idle) to 1 (full)
+	Match        float64  // Share of the bead's requirements the target has
+	SuccessRate  float64  // Smoothed share of past slings with the bead's labels that ended in gt done
+	Slings       int      // Past slings SuccessRate is based on
+	Score        float64
+}
+
+// IsCapabilityTag reports whether tag has one of the CapabilityKinds.
+func IsCapabilityTag(tag string) bool {
+	kind, value, ok := strings.Cut(tag, ":")
+	if !ok || value == "" {
+		return false
+	}
+	for _, k := range CapabilityKinds {
+		if kind == k {
+			return true
+		}
+	}
+	return false
+}
+
+// BeadRequirements returns the capability tags a bead asks for: its
+// capability-kind labels, plus repo:<rig> for the rig that owns its prefix
+// when no repo label is set.
+func BeadRequirements(labels []string, prefixRig string) []string {
+	var reqs []string
+	hasRepo := false
+	for _, l := range labels {
+		if IsCapabilityTag(l) {
+			reqs = append(reqs, l)
+			hasRepo = hasRepo || strings.HasPrefix(l, "repo:")
+		}
+	}
+	if !hasRepo && prefixRig != "" {
+		reqs = append(reqs, "repo:"+prefixRig)
+	}
+	return reqs
+}
+
+// AutoRoute ranks the town's rigs and capable crew members as targets for
+// beadID, best first. Crew members are only considered once they have
+// declared capabilities (gt agents caps). Targets that lack a required
+// repo, match none of the bead's requirements, or are at capacity are left
+// out; an empty result is an error.
+func AutoRoute(townRoot, beadID string) ([]*AutoCandidate, error) {
+	bead, err := beads.New(townRoot).Show(beadID)
+	if err != nil {
+		return nil, fmt.Errorf("reading bead %s: %w", beadID, err)
+	}
+	reqs := BeadRequirements(bead.Labels, prefixRig(townRoot, beadID))
+
+	candidates, err := loadAutoCandidates(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	history := loadSlingHistory(townRoot, time.Now().Add(-AutoRouteHistory))
+
+	ranked := rankCandidates(candidates, reqs, bead.Labels, history)
+	if len(ranked) == 0 {
+		if len(reqs) == 0 {
+			return nil, fmt.Errorf("no target can take %s: every rig is at its polecat limit", beadID)
+		}
+		return nil, fmt.Errorf("no target can take %s: none has capabilities %s with room for more work",
+			beadID, strings.Join(reqs, ", "))
+	}
+	return ranked, nil
+}
+
+// prefixRig returns the rig that owns a bead's prefix, or "" for town beads
+// and unknown prefixes.
+func prefixRig(townRoot, beadID string) string {
+	path := beads.GetRigPathForPrefix(townRoot, beads.ExtractPrefix(beadID))
+	if path == "" || path == townRoot {
+		return ""
+	}
+	return filepath.Base(path)
+}
+
+// loadAutoCandidates lists every rig, as a fresh polecat target, and every
+// crew member with declared capabilities, with their current load.
+func loadAutoCandidates(townRoot string) ([]*AutoCandidate, error) {
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return nil, fmt.Errorf("loading rigs: %w", err)
+	}
+	rigs, err := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot)).DiscoverRigs()
+	if err != nil {
+		return nil, fmt.Errorf("loading rigs: %w", err)
+	}
+
+	bd := beads.New(townRoot)
+	var candidates []*AutoCandidate
+	for _, r := range rigs {
+		rigCand := &AutoCandidate{
+			Target:       r.Name,
+			Rig:          r.Name,
+			Capabilities: append([]string{"repo:" + r.Name}, splitTags(r.GetStringConfig(RigCapabilitiesKey))...),
+		}
+		if limit := r.GetIntConfig(LimitMaxPolecats); limit > 0 {
+			rigCand.Load = float64(countActivePolecats(townRoot, r.Name)) / float64(limit)
+		}
+		candidates = append(candidates, rigCand)
+
+		agents, err := bd.ListAgentBeadsForRig(r.Name)
+		if err != nil {
+			continue
+		}
+		for id, issue := range agents {
+			rigName, role, name, ok := beads.ParseAgentBeadID(id)
+			if !ok || role != "crew" || issue.Status == "closed" {
+				continue
+			}
+			caps := beads.ParseAgentFields(issue.Description).Capabilities
+			if len(caps) == 0 {
+				continue
+			}
+			target := rigName + "/crew/" + name
+			hooked := CountHookedBeadsForAgent(townRoot, target)
+			candidates = append(candidates, &AutoCandidate{
+				Target:       target,
+				Rig:          rigName,
+				Capabilities: append([]string{"repo:" + rigName}, caps...),
+				Load:         float64(hooked) / float64(hooked+1), // crew work one bead at a time
+			})
+		}
+	}
+	return candidates, nil
+}
+
+// splitTags parses a comma-separated tag list.
+func splitTags(s string) []string {
+	var tags []string
+	for _, t := range strings.Split(s, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
+
+// rankCandidates scores candidates for a bead and returns the eligible
+// ones, best first.
+func rankCandidates(candidates []*AutoCandidate, reqs, labels []string, history *slingHistory) []*AutoCandidate {
+	var ranked []*AutoCandidate
+	for _, c := range candidates {
+		if c.Load >= 1 {
+			continue
+		}
+		match, ok := capabilityMatch(c.Capabilities, reqs)
+		if !ok {
+			continue
+		}
+		c.Match = match
+		c.SuccessRate, c.Slings = history.successRate(c.Target, labels)
+		c.Score = autoWeightMatch*c.Match + autoWeightLoad*(1-c.Load) + autoWeightSuccess*c.SuccessRate
+		ranked = append(ranked, c)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].Target < ranked[j].Target
+	})
+	return ranked
+}
+
+// capabilityMatch returns the share of reqs that caps satisfy. Repo
+// requirements are hard: a target without the repo can't do the work at
+// all. ok is false for ineligible targets.
+func capabilityMatch(caps, reqs []string) (match float64, ok bool) {
+	if len(reqs) == 0 {
+		return 1, true
+	}
+	have := make(map[string]bool, len(caps))
+	for _, c := range caps {
+		have[c] = true
+	}
+	n := 0
+	for _, r := range reqs {
+		if have[r] {
+			n++
+		} else if strings.HasPrefix(r, "repo:") {
+			return 0, false
+		}
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return float64(n) / float64(len(reqs)), true
+}
+
+// slingHistory is what became of past slings: for each sling target and
+// bead label, how many slings there were and how many ended in gt done by
+// the agent the bead was slung to. Slings to a rig's polecats count for
+// the rig.
+type slingHistory struct {
+	slings map[string]int // "<target>\x00<label>" -> slings
+	done   map[string]int // "<target>\x00<label>" -> completed slings
+}
+
+// anyLabel keys a target's slings regardless of label, for beads with no
+// labels.
+const anyLabel = ""
+
+// slingPool maps the agent a bead was slung to onto the auto-routing
+// target it counts for: a rig for its polecats, otherwise the agent.
+func slingPool(agent string) string {
+	if rigName, rest, ok := strings.Cut(agent, "/"); ok && strings.HasPrefix(rest, "polecats/") {
+		return rigName
+	}
+	return agent
+}
+
+// buildSlingHistory tallies sling and done events, using labelsOf for
+// each slung bead's labels.
+func buildSlingHistory(evts []events.Event, labelsOf map[string][]string) *slingHistory {
+	h := &slingHistory{slings: make(map[string]int), done: make(map[string]int)}
+
+	type sling struct{ bead, agent string }
+	var slings []sling
+	completed := make(map[sling]bool)
+	for _, e := range evts {
+		bead, _ := e.Payload["bead"].(string)
+		if bead == "" {
+			continue
+		}
+		switch e.Type {
+		case events.TypeSling:
+			if target, _ := e.Payload["target"].(string); target != "" {
+				slings = append(slings, sling{bead, target})
+			}
+		case events.TypeDone:
+			completed[sling{bead, e.Actor}] = true
+		}
+	}
+
+	for _, s := range slings {
+		pool := slingPool(s.agent)
+		for _, label := range append([]string{anyLabel}, labelsOf[s.bead]...) {
+			key := pool + "\x00" + label
+			h.slings[key]++
+			if completed[s] {
+				h.done[key]++
+			}
+		}
+	}
+	return h
+}
+
+// successRate returns the target's smoothed completion rate over past
+// slings of beads with any of labels (all of its slings when labels is
+// empty), and how many slings that covers. With no history the rate is
+// 0.5, so new targets are neither favored nor shunned.
+func (h *slingHistory) successRate(target string, labels []string) (float64, int) {
+	if len(labels) == 0 {
+		labels = []string{anyLabel}
+	}
+	var n, done int
+	for _, label := range labels {
+		key := target + "\x00" + label
+		n += h.slings[key]
+		done += h.done[key]
+	}
+	return float64(done+1) / float64(n+2), n
+}
+
+// loadSlingHistory reads sling and done events since the given time and
+// the labels of the beads they name. Failures just leave history empty.
+func loadSlingHistory(townRoot string, since time.Time) *slingHistory {
+	var evts []events.Event
+	q := events.Query{Types: []string{events.TypeSling, events.TypeDone}, Since: since, Limit: 1000}
+	for {
+		page, err := events.Open(townRoot).Query(q)
+		if err != nil {
+			break
+		}
+		evts = append(evts, page.Events...)
+		if page.NextCursor == "" {
+			break
+		}
+		q.Cursor = page.NextCursor
+	}
+
+	seen := make(map[string]bool)
+	var ids []string
+	for _, e := range evts {
+		if bead, _ := e.Payload["bead"].(string); e.Type == events.TypeSling && bead != "" && !seen[bead] {
+			seen[bead] = true
+			ids = append(ids, bead)
+		}
+	}
+	labelsOf := make(map[string][]string, len(ids))
+	if len(ids) > 0 {
+		if issues, err := beads.New(townRoot).ShowMultiple(ids); err == nil {
+			for id, issue := range issues {
+				labelsOf[id] = issue.Labels
+			}
+		}
+	}
+	return buildSlingHistory(evts, labelsOf)
+}
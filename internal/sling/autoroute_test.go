@@ -0,0 +1,101 @@
+package sling
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+func TestBeadRequirements(t *testing.T) {
+	tests := []struct {
+		name      string
+		labels    []string
+		prefixRig string
+		want      []string
+	}{
+		{"prefix repo", []string{"lang:go", "gt:task", "urgent"}, "gastown", []string{"lang:go", "repo:gastown"}},
+		{"explicit repo wins", []string{"repo:beads", "role:review"}, "gastown", []string{"repo:beads", "role:review"}},
+		{"town bead", []string{"lang:"}, "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BeadRequirements(tt.labels, tt.prefixRig); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BeadRequirements() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func slingEvent(bead, target string) events.Event {
+	return events.Event{Type: events.TypeSling, Actor: "mayor", Payload: events.SlingPayload(bead, target)}
+}
+
+func doneEvent(actor, bead string) events.Event {
+	return events.Event{Type: events.TypeDone, Actor: actor, Payload: events.DonePayload(bead, "")}
+}
+
+func TestSlingHistorySuccessRate(t *testing.T) {
+	h := buildSlingHistory([]events.Event{
+		slingEvent("gt-1", "gastown/polecats/Toast"),
+		doneEvent("gastown/polecats/Toast", "gt-1"),
+		slingEvent("gt-2", "gastown/polecats/Nux"),
+		doneEvent("gastown/polecats/Toast", "gt-2"), // not who it was slung to
+		slingEvent("gt-3", "gastown/crew/max"),
+		doneEvent("gastown/crew/max", "gt-3"),
+	}, map[string][]string{
+		"gt-1": {"lang:go"},
+		"gt-2": {"lang:go"},
+		"gt-3": {"lang:go"},
+	})
+
+	// Polecat slings count for the rig: 1 of 2 done, smoothed to 2/4.
+	if rate, n := h.successRate("gastown", []string{"lang:go"}); rate != 0.5 || n != 2 {
+		t.Errorf("gastown rate = %v over %d, want 0.5 over 2", rate, n)
+	}
+	if rate, n := h.successRate("gastown/crew/max", nil); rate != 2.0/3 || n != 1 {
+		t.Errorf("crew rate = %v over %d, want 2/3 over 1", rate, n)
+	}
+	if rate, n := h.successRate("beads", []string{"lang:go"}); rate != 0.5 || n != 0 {
+		t.Errorf("no history = %v over %d, want 0.5 over 0", rate, n)
+	}
+}
+
+func TestRankCandidates(t *testing.T) {
+	history := buildSlingHistory([]events.Event{
+		slingEvent("gt-1", "gastown/crew/max"),
+		doneEvent("gastown/crew/max", "gt-1"),
+		slingEvent("gt-2", "gastown/crew/max"),
+		doneEvent("gastown/crew/max", "gt-2"),
+	}, map[string][]string{"gt-1": {"lang:go"}, "gt-2": {"lang:go"}})
+
+	candidates := func() []*AutoCandidate {
+		return []*AutoCandidate{
+			{Target: "gastown", Capabilities: []string{"repo:gastown"}, Load: 0.5},
+			{Target: "gastown/crew/max", Capabilities: []string{"repo:gastown", "lang:go"}},
+			{Target: "gastown/crew/joe", Capabilities: []string{"repo:gastown", "lang:go"}, Load: 0.5},
+			{Target: "beads", Capabilities: []string{"repo:beads", "lang:go"}},
+			{Target: "full", Capabilities: []string{"repo:gastown", "lang:go"}, Load: 1},
+		}
+	}
+	targets := func(ranked []*AutoCandidate) []string {
+		var out []string
+		for _, c := range ranked {
+			out = append(out, c.Target)
+		}
+		return out
+	}
+
+	// Wrong repo and full targets drop out; the idle, proven Go crew member wins.
+	got := targets(rankCandidates(candidates(), []string{"lang:go", "repo:gastown"}, []string{"lang:go"}, history))
+	want := []string{"gastown/crew/max", "gastown/crew/joe", "gastown"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ranking = %v, want %v", got, want)
+	}
+
+	// With no requirements, load decides.
+	got = targets(rankCandidates(candidates(), nil, nil, history))
+	if got[0] != "gastown/crew/max" || got[len(got)-1] != "gastown/crew/joe" {
+		t.Errorf("unrequired ranking = %v, want idle targets first", got)
+	}
+}
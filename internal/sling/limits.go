@@ -0,0 +1,243 @@
+package sling
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/monitoring"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// Rig config keys (gt rig config) that limit how much work a rig takes on.
+// Zero means unlimited.
+const (
+	LimitMaxPolecats      = "max_polecats"        // Concurrent polecats
+	LimitMaxSlingsPerHour = "max_slings_per_hour" // Polecat spawns in any 60 minutes
+	LimitDailyTokenBudget = "daily_token_budget"  // Tokens the rig's agents may use per day
+)
+
+// LimitOverrideTTL is how long an approved override decision stays usable
+// after it was resolved. One approval covers a batch, not a standing waiver.
+const LimitOverrideTTL = time.Hour
+
+// RigLimits are a rig's work limits. Zero fields are unlimited.
+type RigLimits struct {
+	MaxPolecats      int
+	MaxSlingsPerHour int
+	DailyTokenBudget int64
+}
+
+// LoadRigLimits reads a rig's limits through its config layers.
+func LoadRigLimits(r *rig.Rig) RigLimits {
+	return RigLimits{
+		MaxPolecats:      r.GetIntConfig(LimitMaxPolecats),
+		MaxSlingsPerHour: r.GetIntConfig(LimitMaxSlingsPerHour),
+		DailyTokenBudget: int64(r.GetIntConfig(LimitDailyTokenBudget)),
+	}
+}
+
+// LimitError reports a sling refused because a rig is at one of its limits.
+type LimitError struct {
+	Rig     string
+	Key     string // The rig config key that was hit
+	Current int64
+	Max     int64
+}
+
+func (e *LimitError) Error() string {
+	var what string
+	switch e.Key {
+	case LimitMaxPolecats:
+		what = fmt.Sprintf("%d/%d concurrent polecats", e.Current, e.Max)
+	case LimitMaxSlingsPerHour:
+		what = fmt.Sprintf("%d/%d slings in the last hour", e.Current, e.Max)
+	case LimitDailyTokenBudget:
+		what = fmt.Sprintf("%d/%d tokens used today", e.Current, e.Max)
+	default:
+		what = fmt.Sprintf("%s %d/%d", e.Key, e.Current, e.Max)
+	}
+	return fmt.Sprintf("rig %s is at its limit (%s); raise it with 'gt rig config set %s %s <n>' or get an override decision approved and pass --override-limits <decision-id>",
+		e.Rig, what, e.Rig, e.Key)
+}
+
+// limitUsage is what a rig is currently using against its limits.
+type limitUsage struct {
+	polecats    int
+	tokensToday int64
+}
+
+// AdmitSling decides whether a rig may spawn another polecat. A refusal is
+// a *LimitError unless overrideDecision names a decision bead resolved with
+// its first option ("allow") within LimitOverrideTTL. Admitted slings are
+// counted toward max_slings_per_hour.
+func AdmitSling(townRoot string, r *rig.Rig, overrideDecision string) error {
+	limits := LoadRigLimits(r)
+	if overrideDecision != "" {
+		if err := CheckLimitOverride(townRoot, overrideDecision, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	var usage limitUsage
+	if overrideDecision == "" {
+		if limits.MaxPolecats > 0 {
+			usage.polecats = countActivePolecats(townRoot, r.Name)
+		}
+		if limits.DailyTokenBudget > 0 {
+			usage.tokensToday = rigTokensToday(townRoot, r.Name)
+		}
+	}
+	return newSlingRate(townRoot).admit(r.Name, limits, usage, overrideDecision != "", time.Now())
+}
+
+// check returns the first limit usage has reached, or nil.
+func (l RigLimits) check(rigName string, usage limitUsage, slingsLastHour int) error {
+	if l.MaxPolecats > 0 && usage.polecats >= l.MaxPolecats {
+		return &LimitError{Rig: rigName, Key: LimitMaxPolecats, Current: int64(usage.polecats), Max: int64(l.MaxPolecats)}
+	}
+	if l.MaxSlingsPerHour > 0 && slingsLastHour >= l.MaxSlingsPerHour {
+		return &LimitError{Rig: rigName, Key: LimitMaxSlingsPerHour, Current: int64(slingsLastHour), Max: int64(l.MaxSlingsPerHour)}
+	}
+	if l.DailyTokenBudget > 0 && usage.tokensToday >= l.DailyTokenBudget {
+		return &LimitError{Rig: rigName, Key: LimitDailyTokenBudget, Current: usage.tokensToday, Max: l.DailyTokenBudget}
+	}
+	return nil
+}
+
+// CheckLimitOverride verifies that decisionID approves exceeding a rig
+// limit: it must be resolved with option 1 no longer than LimitOverrideTTL
+// before now.
+func CheckLimitOverride(townRoot, decisionID string, now time.Time) error {
+	bd := beads.New(beads.ResolveBeadsDir(townRoot))
+	issue, fields, err := bd.GetDecisionBead(decisionID)
+	if err != nil {
+		return fmt.Errorf("reading override decision %s: %w", decisionID, err)
+	}
+	if issue == nil || fields == nil {
+		return fmt.Errorf("override decision %s not found", decisionID)
+	}
+	return overrideApproved(decisionID, fields, now)
+}
+
+func overrideApproved(decisionID string, fields *beads.DecisionFields, now time.Time) error {
+	if fields.ResolvedAt == "" {
+		return fmt.Errorf("override decision %s is still pending", decisionID)
+	}
+	if fields.ChosenIndex != 1 {
+		return fmt.Errorf("override decision %s was not approved (option 1 grants the override)", decisionID)
+	}
+	resolvedAt, err := time.Parse(time.RFC3339, fields.ResolvedAt)
+	if err != nil {
+		return fmt.Errorf("override decision %s: bad resolved time %q", decisionID, fields.ResolvedAt)
+	}
+	if now.Sub(resolvedAt) > LimitOverrideTTL {
+		return fmt.Errorf("override decision %s expired (approved %s ago; overrides last %s)",
+			decisionID, now.Sub(resolvedAt).Round(time.Minute), LimitOverrideTTL)
+	}
+	return nil
+}
+
+// countActivePolecats counts the rig's polecat agent beads that still hold
+// a polecat: open, and not done or nuked. Idle warm pool members count.
+func countActivePolecats(townRoot, rigName string) int {
+	agents, err := beads.New(townRoot).ListAgentBeadsForRig(rigName)
+	if err != nil {
+		return 0 // can't tell; don't block dispatch on a beads hiccup
+	}
+	n := 0
+	for id, issue := range agents {
+		if !strings.Contains(id, "-polecat-") || issue.Status == "closed" {
+			continue
+		}
+		switch issue.AgentState {
+		case "done", "nuked", "closed":
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// rigTokensToday totals the tokens the rig's agents used since midnight.
+func rigTokensToday(townRoot, rigName string) int64 {
+	records, err := monitoring.NewUsageLedger(townRoot).Records(monitoring.StartOfDay(time.Now()))
+	if err != nil {
+		return 0
+	}
+	var total int64
+	for _, rec := range records {
+		if rec.Rig == rigName {
+			total += rec.TotalTokens()
+		}
+	}
+	return total
+}
+
+// slingRate records when each rig last spawned polecats, for
+// max_slings_per_hour. It lives in .runtime/sling-rate.json.
+type slingRate struct {
+	path string
+}
+
+func newSlingRate(townRoot string) *slingRate {
+	return &slingRate{path: filepath.Join(townRoot, ".runtime", "sling-rate.json")}
+}
+
+// admit checks limits and, if the sling is admitted, records it. The check
+// and the record happen under one lock so concurrent slings can't both
+// take the last slot.
+func (s *slingRate) admit(rigName string, limits RigLimits, usage limitUsage, override bool, now time.Time) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("creating runtime directory: %w", err)
+	}
+	lock := flock.New(s.path + ".lock")
+	if err := lock.Lock(); err != nil {
+		return fmt.Errorf("locking sling rate: %w", err)
+	}
+	defer func() { _ = lock.Unlock() }()
+
+	slings := make(map[string][]time.Time)
+	if data, err := os.ReadFile(s.path); err == nil {
+		_ = json.Unmarshal(data, &slings) // a corrupt file only loses history
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	// Drop anything older than an hour, for every rig, as we go.
+	for name, times := range slings {
+		kept := times[:0]
+		for _, t := range times {
+			if now.Sub(t) < time.Hour {
+				kept = append(kept, t)
+			}
+		}
+		if len(kept) == 0 {
+			delete(slings, name)
+		} else {
+			slings[name] = kept
+		}
+	}
+
+	if !override {
+		if err := limits.check(rigName, usage, len(slings[rigName])); err != nil {
+			return err
+		}
+	}
+	slings[rigName] = append(slings[rigName], now)
+	return util.AtomicWriteJSON(s.path, slings)
+}
+
+// MarkLimitOverride labels a K8s polecat's agent bead with the decision
+// that let it past the rig's limits, so the controller creates its pod
+// rather than holding it to max_polecats. A label survives the controller
+// rewriting the bead's notes with backend metadata.
+func MarkLimitOverride(bd *beads.Beads, agentBeadID, decisionID string) error {
+	return bd.AddLabel(agentBeadID, beads.LabelLimitOverride+":"+decisionID)
+}
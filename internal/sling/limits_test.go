@@ -0,0 +1,91 @@
+package sling
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestSlingRateAdmit(t *testing.T) {
+	rate := newSlingRate(t.TempDir())
+	limits := RigLimits{MaxSlingsPerHour: 2}
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		if err := rate.admit("gastown", limits, limitUsage{}, false, now); err != nil {
+			t.Fatalf("sling %d: %v", i+1, err)
+		}
+	}
+	err := rate.admit("gastown", limits, limitUsage{}, false, now.Add(time.Minute))
+	var limitErr *LimitError
+	if !errors.As(err, &limitErr) || limitErr.Key != LimitMaxSlingsPerHour || limitErr.Current != 2 {
+		t.Fatalf("third sling: err = %v, want max_slings_per_hour limit at 2", err)
+	}
+
+	// Other rigs have their own count, and an override gets through.
+	if err := rate.admit("beads", limits, limitUsage{}, false, now); err != nil {
+		t.Errorf("other rig: %v", err)
+	}
+	if err := rate.admit("gastown", limits, limitUsage{}, true, now.Add(time.Minute)); err != nil {
+		t.Errorf("override: %v", err)
+	}
+
+	// An hour after the first two, only the overridden sling still counts.
+	if err := rate.admit("gastown", limits, limitUsage{}, false, now.Add(time.Hour)); err != nil {
+		t.Errorf("after an hour: %v", err)
+	}
+}
+
+func TestRigLimitsCheck(t *testing.T) {
+	limits := RigLimits{MaxPolecats: 3, DailyTokenBudget: 1000}
+	tests := []struct {
+		name  string
+		usage limitUsage
+		want  string
+	}{
+		{"under", limitUsage{polecats: 2, tokensToday: 999}, ""},
+		{"polecats", limitUsage{polecats: 3}, LimitMaxPolecats},
+		{"tokens", limitUsage{polecats: 1, tokensToday: 1000}, LimitDailyTokenBudget},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := limits.check("gastown", tt.usage, 0)
+			var limitErr *LimitError
+			switch {
+			case tt.want == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.want != "" && (!errors.As(err, &limitErr) || limitErr.Key != tt.want):
+				t.Errorf("err = %v, want %s limit", err, tt.want)
+			}
+		})
+	}
+	if err := (RigLimits{}).check("gastown", limitUsage{polecats: 100, tokensToday: 1 << 40}, 100); err != nil {
+		t.Errorf("zero limits should be unlimited, got %v", err)
+	}
+}
+
+func TestOverrideApproved(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	resolved := func(ago time.Duration) string { return now.Add(-ago).Format(time.RFC3339) }
+	tests := []struct {
+		name   string
+		fields beads.DecisionFields
+		ok     bool
+	}{
+		{"pending", beads.DecisionFields{}, false},
+		{"approved", beads.DecisionFields{ChosenIndex: 1, ResolvedAt: resolved(10 * time.Minute)}, true},
+		{"declined", beads.DecisionFields{ChosenIndex: 2, ResolvedAt: resolved(10 * time.Minute)}, false},
+		{"custom answer", beads.DecisionFields{ChosenIndex: 0, ResolvedAt: resolved(10 * time.Minute)}, false},
+		{"expired", beads.DecisionFields{ChosenIndex: 1, ResolvedAt: resolved(2 * time.Hour)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := overrideApproved("hq-dec-1", &tt.fields, now)
+			if (err == nil) != tt.ok {
+				t.Errorf("overrideApproved() = %v, want ok=%v", err, tt.ok)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package sling
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -10,97 +12,146 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 )
 
-// StoreArgsInBead stores args in the bead's description using attached_args field.
-func StoreArgsInBead(beadID, args string) error {
+// BeadMutator batches attachment-field changes to a single bead. The bead is
+// read once by NewBeadMutator and written once by Commit, so a sling that
+// records dispatcher, args, merge settings and the attached molecule costs two
+// beads calls instead of one show/update pair per field. Both go to the beads
+// daemon's HTTP API when one is configured, falling back to bd.
+//
+// Setters ignore zero values, matching the Store*InBead helpers: they never
+// clear a field that is already set.
+type BeadMutator struct {
+	beadID  string
+	issue   *beads.Issue
+	fields  *beads.AttachmentFields
+	changed bool
+}
+
+// NewBeadMutator fetches beadID and prepares its attachment fields for update.
+func NewBeadMutator(beadID string) (*BeadMutator, error) {
 	issue, err := fetchBeadIssue(beadID)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
 	fields := beads.ParseAttachmentFields(issue)
 	if fields == nil {
 		fields = &beads.AttachmentFields{}
 	}
-	fields.AttachedArgs = args
-
-	newDesc := beads.SetAttachmentFields(issue, fields)
-	return updateBeadDescription(beadID, newDesc)
+	return &BeadMutator{beadID: beadID, issue: issue, fields: fields}, nil
 }
 
-// StoreDispatcherInBead stores the dispatcher agent ID in the bead's description.
-func StoreDispatcherInBead(beadID, dispatcher string) error {
-	if dispatcher == "" {
-		return nil
-	}
-	issue, err := fetchBeadIssue(beadID)
-	if err != nil {
-		return err
+// SetDispatcher records the agent that dispatched the work.
+func (m *BeadMutator) SetDispatcher(dispatcher string) *BeadMutator {
+	if dispatcher != "" {
+		m.fields.DispatchedBy = dispatcher
+		m.changed = true
 	}
+	return m
+}
 
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
+// SetArgs records the natural-language args passed via --args.
+func (m *BeadMutator) SetArgs(args string) *BeadMutator {
+	if args != "" {
+		m.fields.AttachedArgs = args
+		m.changed = true
 	}
-	fields.DispatchedBy = dispatcher
+	return m
+}
 
-	newDesc := beads.SetAttachmentFields(issue, fields)
-	return updateBeadDescription(beadID, newDesc)
+// SetNoMerge marks the work to skip the merge queue.
+func (m *BeadMutator) SetNoMerge(noMerge bool) *BeadMutator {
+	if noMerge {
+		m.fields.NoMerge = true
+		m.changed = true
+	}
+	return m
 }
 
-// StoreAttachedMoleculeInBead sets the attached_molecule field in a bead's description.
-func StoreAttachedMoleculeInBead(beadID, moleculeID string) error {
-	if moleculeID == "" {
-		return nil
+// SetMergeStrategy records the merge strategy (direct, mr, local).
+func (m *BeadMutator) SetMergeStrategy(strategy string) *BeadMutator {
+	if strategy != "" {
+		m.fields.MergeStrategy = strategy
+		m.changed = true
 	}
+	return m
+}
 
-	logPath := os.Getenv("GT_TEST_ATTACHED_MOLECULE_LOG")
-	if logPath != "" {
-		_ = os.WriteFile(logPath, []byte("called"), 0644)
+// SetConvoyOwned marks the convoy as caller-managed.
+func (m *BeadMutator) SetConvoyOwned(owned bool) *BeadMutator {
+	if owned {
+		m.fields.ConvoyOwned = true
+		m.changed = true
 	}
+	return m
+}
 
-	issue := &beads.Issue{}
-	if logPath == "" {
-		var err error
-		issue, err = fetchBeadIssue(beadID)
-		if err != nil {
-			return err
+// SetAttachedMolecule records the attached molecule, stamping attached_at
+// the first time a molecule is attached.
+func (m *BeadMutator) SetAttachedMolecule(moleculeID string) *BeadMutator {
+	if moleculeID != "" {
+		m.fields.AttachedMolecule = moleculeID
+		if m.fields.AttachedAt == "" {
+			m.fields.AttachedAt = time.Now().UTC().Format(time.RFC3339)
 		}
+		m.changed = true
 	}
+	return m
+}
 
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-	fields.AttachedMolecule = moleculeID
-	if fields.AttachedAt == "" {
-		fields.AttachedAt = time.Now().UTC().Format(time.RFC3339)
+// SetOjJobID records the OJ job managing the polecat lifecycle.
+func (m *BeadMutator) SetOjJobID(jobID string) *BeadMutator {
+	if jobID != "" {
+		m.fields.OjJobID = jobID
+		m.changed = true
 	}
+	return m
+}
 
-	newDesc := beads.SetAttachmentFields(issue, fields)
-	if logPath != "" {
+// Commit writes the accumulated changes with a single update.
+// It is a no-op when no setter changed anything.
+func (m *BeadMutator) Commit() error {
+	if !m.changed {
+		return nil
+	}
+	newDesc := beads.SetAttachmentFields(m.issue, m.fields)
+	if logPath := os.Getenv("GT_TEST_ATTACHED_MOLECULE_LOG"); logPath != "" && m.fields.AttachedMolecule != "" {
 		_ = os.WriteFile(logPath, []byte(newDesc), 0644)
 	}
-	return updateBeadDescription(beadID, newDesc)
+	if err := updateBeadDescription(m.beadID, newDesc); err != nil {
+		return err
+	}
+	m.issue.Description = newDesc
+	m.changed = false
+	return nil
 }
 
-// StoreNoMergeInBead sets the no_merge field in a bead's description.
-func StoreNoMergeInBead(beadID string, noMerge bool) error {
-	if !noMerge {
+// StoreArgsInBead stores args in the bead's description using attached_args field.
+func StoreArgsInBead(beadID, args string) error {
+	return mutateBead(beadID, func(m *BeadMutator) { m.SetArgs(args) })
+}
+
+// StoreDispatcherInBead stores the dispatcher agent ID in the bead's description.
+func StoreDispatcherInBead(beadID, dispatcher string) error {
+	if dispatcher == "" {
 		return nil
 	}
-	issue, err := fetchBeadIssue(beadID)
-	if err != nil {
-		return err
-	}
+	return mutateBead(beadID, func(m *BeadMutator) { m.SetDispatcher(dispatcher) })
+}
 
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
+// StoreAttachedMoleculeInBead sets the attached_molecule field in a bead's description.
+func StoreAttachedMoleculeInBead(beadID, moleculeID string) error {
+	if moleculeID == "" {
+		return nil
 	}
-	fields.NoMerge = true
+	return mutateBead(beadID, func(m *BeadMutator) { m.SetAttachedMolecule(moleculeID) })
+}
 
-	newDesc := beads.SetAttachmentFields(issue, fields)
-	return updateBeadDescription(beadID, newDesc)
+// StoreNoMergeInBead sets the no_merge field in a bead's description.
+func StoreNoMergeInBead(beadID string, noMerge bool) error {
+	if !noMerge {
+		return nil
+	}
+	return mutateBead(beadID, func(m *BeadMutator) { m.SetNoMerge(true) })
 }
 
 // StoreMergeStrategyInBead sets the merge_strategy field in a bead's description.
@@ -108,19 +159,7 @@ func StoreMergeStrategyInBead(beadID, strategy string) error {
 	if strategy == "" {
 		return nil
 	}
-	issue, err := fetchBeadIssue(beadID)
-	if err != nil {
-		return err
-	}
-
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-	fields.MergeStrategy = strategy
-
-	newDesc := beads.SetAttachmentFields(issue, fields)
-	return updateBeadDescription(beadID, newDesc)
+	return mutateBead(beadID, func(m *BeadMutator) { m.SetMergeStrategy(strategy) })
 }
 
 // StoreConvoyOwnedInBead sets the convoy_owned field in a bead's description.
@@ -128,24 +167,34 @@ func StoreConvoyOwnedInBead(beadID string, owned bool) error {
 	if !owned {
 		return nil
 	}
-	issue, err := fetchBeadIssue(beadID)
+	return mutateBead(beadID, func(m *BeadMutator) { m.SetConvoyOwned(true) })
+}
+
+// mutateBead applies a single change through a BeadMutator.
+func mutateBead(beadID string, apply func(*BeadMutator)) error {
+	m, err := NewBeadMutator(beadID)
 	if err != nil {
 		return err
 	}
-
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-	fields.ConvoyOwned = true
-
-	newDesc := beads.SetAttachmentFields(issue, fields)
-	return updateBeadDescription(beadID, newDesc)
+	apply(m)
+	return m.Commit()
 }
 
-// fetchBeadIssue fetches a bead issue by ID.
+// fetchBeadIssue fetches a bead issue by ID, through the beads daemon's
+// HTTP API when one is configured. A daemon that fails for any reason other
+// than the bead not existing falls back to bd show.
 func fetchBeadIssue(beadID string) (*beads.Issue, error) {
-	showCmd := bdcmd.Command( "show", beadID, "--json")
+	if client := beads.NewDaemonClientFromEnv(); client != nil {
+		issue, err := client.Show(context.Background(), beadID)
+		if err == nil {
+			return issue, nil
+		}
+		if errors.Is(err, beads.ErrNotFound) {
+			return nil, fmt.Errorf("fetching bead: %w", err)
+		}
+	}
+
+	showCmd := bdcmd.Command("show", beadID, "--json")
 	out, err := showCmd.Output()
 	if err != nil {
 		return nil, fmt.Errorf("fetching bead: %w", err)
@@ -166,9 +215,18 @@ func fetchBeadIssue(beadID string) (*beads.Issue, error) {
 	return &issues[0], nil
 }
 
-// updateBeadDescription updates a bead's description field.
+// updateBeadDescription updates a bead's description field, through the
+// beads daemon when one is configured and bd update otherwise or if the
+// daemon request fails.
 func updateBeadDescription(beadID, newDesc string) error {
-	updateCmd := bdcmd.Command( "update", beadID, "--description="+newDesc)
+	if client := beads.NewDaemonClientFromEnv(); client != nil {
+		err := client.Update(context.Background(), beadID, beads.UpdateOptions{Description: &newDesc})
+		if err == nil {
+			return nil
+		}
+	}
+
+	updateCmd := bdcmd.Command("update", beadID, "--description="+newDesc)
 	updateCmd.Stderr = os.Stderr
 	if err := updateCmd.Run(); err != nil {
 		return fmt.Errorf("updating bead description: %w", err)
@@ -0,0 +1,133 @@
+package sling
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/bdcmd"
+)
+
+func TestBeadMutatorSingleRoundTrip(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell stub")
+	}
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "bd.log")
+	stub := filepath.Join(dir, "bd")
+	script := `#!/bin/sh
+printf 'CALL:%s\n' "$*" >> "` + logPath + `"
+case "$1" in
+  show) printf '%s\n' '[{"id":"gt-abc","description":"Fix the bug\n\nmerge_strategy: mr"}]' ;;
+esac
+exit 0
+`
+	if err := os.WriteFile(stub, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(bdcmd.SetBdPathForTest(stub))
+	t.Setenv("GT_TEST_ATTACHED_MOLECULE_LOG", "")
+
+	m, err := NewBeadMutator("gt-abc")
+	if err != nil {
+		t.Fatalf("NewBeadMutator: %v", err)
+	}
+	err = m.SetDispatcher("mayor").
+		SetArgs("be careful").
+		SetNoMerge(true).
+		SetMergeStrategy("").
+		SetConvoyOwned(false).
+		SetAttachedMolecule("gt-wisp-1").
+		Commit()
+	if err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+
+	logBytes, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := strings.Split(strings.TrimPrefix(string(logBytes), "CALL:"), "CALL:")
+	if len(calls) != 2 || !strings.HasPrefix(calls[0], "show") || !strings.HasPrefix(calls[1], "update") {
+		t.Fatalf("want one show and one update, got %q", calls)
+	}
+	for _, want := range []string{
+		"Fix the bug",
+		"dispatched_by: mayor",
+		"attached_args: be careful",
+		"no_merge: true",
+		"merge_strategy: mr",
+		"attached_molecule: gt-wisp-1",
+		"attached_at: ",
+	} {
+		if !strings.Contains(calls[1], want) {
+			t.Errorf("update missing %q:\n%s", want, calls[1])
+		}
+	}
+	if strings.Contains(calls[1], "convoy_owned") {
+		t.Errorf("zero-value setter should not write convoy_owned:\n%s", calls[1])
+	}
+
+	// Nothing changed since the last commit: no further bd calls.
+	if err := m.Commit(); err != nil {
+		t.Fatalf("second Commit: %v", err)
+	}
+	if after, _ := os.ReadFile(logPath); len(after) != len(logBytes) {
+		t.Errorf("no-op Commit should not call bd, log grew to:\n%s", after)
+	}
+}
+
+func TestBeadMutatorViaDaemon(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell stub")
+	}
+	var calls []string
+	var updated map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, path.Base(r.URL.Path))
+		switch r.URL.Path {
+		case "/bd.v1.BeadsService/Show":
+			_, _ = w.Write([]byte(`{"id":"gt-abc","description":"Fix the bug"}`))
+		case "/bd.v1.BeadsService/Update":
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	t.Setenv("BD_DAEMON_HOST", srv.URL)
+	t.Setenv("BD_DAEMON_HTTP_URL", "")
+	t.Setenv("GT_TEST_ATTACHED_MOLECULE_LOG", "")
+
+	// bd must not be needed while the daemon answers.
+	dir := t.TempDir()
+	stub := filepath.Join(dir, "bd")
+	if err := os.WriteFile(stub, []byte("#!/bin/sh\necho unexpected bd call >&2\nexit 1\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(bdcmd.SetBdPathForTest(stub))
+
+	m, err := NewBeadMutator("gt-abc")
+	if err != nil {
+		t.Fatalf("NewBeadMutator: %v", err)
+	}
+	if err := m.SetDispatcher("mayor").SetNoMerge(true).Commit(); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+
+	if strings.Join(calls, ",") != "Show,Update" {
+		t.Errorf("daemon calls = %v, want Show,Update", calls)
+	}
+	desc, _ := updated["description"].(string)
+	if updated["id"] != "gt-abc" || !strings.Contains(desc, "Fix the bug") ||
+		!strings.Contains(desc, "dispatched_by: mayor") || !strings.Contains(desc, "no_merge: true") {
+		t.Errorf("update body = %v", updated)
+	}
+}
@@ -0,0 +1,25 @@
+package sling
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/deacon"
+)
+
+// ErrTownPaused is returned when work is slung at a town paused with
+// gt pause.
+var ErrTownPaused = errors.New("town is paused")
+
+// CheckTownAcceptingWork returns an error wrapping ErrTownPaused if the
+// town has been paused with gt pause, so no new work is dispatched.
+func CheckTownAcceptingWork(townRoot string) error {
+	paused, state, err := deacon.IsPaused(townRoot)
+	if err != nil || !paused {
+		return nil
+	}
+	if state.Reason != "" {
+		return fmt.Errorf("%w (%s): not dispatching new work; run 'gt resume --town' first", ErrTownPaused, state.Reason)
+	}
+	return fmt.Errorf("%w: not dispatching new work; run 'gt resume --town' first", ErrTownPaused)
+}
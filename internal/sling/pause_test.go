@@ -0,0 +1,62 @@
+package sling
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/deacon"
+)
+
+func TestCheckTownAcceptingWork(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := CheckTownAcceptingWork(townRoot); err != nil {
+		t.Fatalf("unpaused town refused work: %v", err)
+	}
+
+	if err := deacon.Pause(townRoot, "upgrading dolt", "overseer"); err != nil {
+		t.Fatal(err)
+	}
+	err := CheckTownAcceptingWork(townRoot)
+	if !errors.Is(err, ErrTownPaused) || !strings.Contains(err.Error(), "upgrading dolt") {
+		t.Fatalf("paused town error = %v, want ErrTownPaused with the pause reason", err)
+	}
+	if deacon.IsDraining(townRoot) {
+		t.Error("a plain pause is not a drain")
+	}
+
+	_, state, _ := deacon.IsPaused(townRoot)
+	state.Drain = &deacon.DrainState{Phase: deacon.DrainPhaseSuspended, Suspended: []string{"gastown/nux"}}
+	if err := deacon.SavePauseState(townRoot, state); err != nil {
+		t.Fatal(err)
+	}
+	if !deacon.IsDraining(townRoot) {
+		t.Error("drain state not picked up")
+	}
+
+	if err := deacon.Resume(townRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckTownAcceptingWork(townRoot); err != nil {
+		t.Errorf("resumed town refused work: %v", err)
+	}
+}
+
+// Every dispatch entry point refuses work for a paused town before it
+// looks anything up, so RPC slings are held like CLI ones.
+func TestSlingRefusesPausedTown(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := deacon.Pause(townRoot, "", "overseer"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Sling(SlingOptions{TownRoot: townRoot, BeadID: "gt-1", Target: "gastown"}); !errors.Is(err, ErrTownPaused) {
+		t.Errorf("Sling() error = %v, want ErrTownPaused", err)
+	}
+	if _, err := SlingFormula(FormulaOptions{TownRoot: townRoot, Formula: "mol-review", Target: "gastown"}); !errors.Is(err, ErrTownPaused) {
+		t.Errorf("SlingFormula() error = %v, want ErrTownPaused", err)
+	}
+	if _, err := SlingBatch(BatchOptions{TownRoot: townRoot, BeadIDs: []string{"gt-1", "gt-2"}, Rig: "gastown"}); !errors.Is(err, ErrTownPaused) {
+		t.Errorf("SlingBatch() error = %v, want ErrTownPaused", err)
+	}
+}
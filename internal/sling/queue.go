@@ -0,0 +1,289 @@
+package sling
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// Scheduled sling states.
+const (
+	QueuePending    = "pending"
+	QueueDispatched = "dispatched"
+	QueueFailed     = "failed"
+	QueueCancelled  = "cancelled"
+)
+
+// MaxDispatchAttempts is how many times the daemon tries a due sling
+// before marking it failed.
+const MaxDispatchAttempts = 3
+
+// ScheduledSling is a sling held in the dispatch queue until it is due.
+type ScheduledSling struct {
+	ID        string   `json:"id"`
+	Bead      string   `json:"bead"`
+	Target    string   `json:"target,omitempty"`
+	Flags     []string `json:"flags,omitempty"` // Other gt sling flags, passed through at dispatch
+	CreatedBy string   `json:"created_by,omitempty"`
+
+	// Conditions: all must hold before the sling is dispatched.
+	At    time.Time `json:"at,omitzero"`     // Not before this time
+	After []string  `json:"after,omitempty"` // Not until these beads are closed
+
+	Status       string    `json:"status"`
+	CreatedAt    time.Time `json:"created_at"`
+	Attempts     int       `json:"attempts,omitempty"`
+	LastError    string    `json:"last_error,omitempty"`
+	DispatchedAt time.Time `json:"dispatched_at,omitzero"`
+}
+
+// Waiting describes what a pending sling is waiting for, e.g.
+// "02:00, gt-xyz closed".
+func (s *ScheduledSling) Waiting(now time.Time) string {
+	var parts []string
+	if !s.At.IsZero() && now.Before(s.At) {
+		parts = append(parts, s.At.Local().Format("2006-01-02 15:04"))
+	}
+	for _, dep := range s.After {
+		parts = append(parts, dep+" closed")
+	}
+	if len(parts) == 0 {
+		return "due"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// QueuePath returns where scheduled slings are stored in a town.
+func QueuePath(townRoot string) string {
+	return filepath.Join(townRoot, ".runtime", "sling-queue.json")
+}
+
+// Queue is the town's delayed dispatch queue. The CLI adds and cancels
+// entries; the daemon dispatches them when their conditions hold.
+type Queue struct {
+	path string
+	now  func() time.Time
+}
+
+// NewQueue returns the town's dispatch queue.
+func NewQueue(townRoot string) *Queue {
+	return &Queue{path: QueuePath(townRoot), now: time.Now}
+}
+
+// update runs fn on the queued entries under a file lock, saving them
+// afterwards if fn returns true.
+func (q *Queue) update(fn func(entries []*ScheduledSling) ([]*ScheduledSling, bool)) error {
+	if err := os.MkdirAll(filepath.Dir(q.path), 0755); err != nil {
+		return fmt.Errorf("creating runtime directory: %w", err)
+	}
+	lock := flock.New(q.path + ".lock")
+	if err := lock.Lock(); err != nil {
+		return fmt.Errorf("locking sling queue: %w", err)
+	}
+	defer func() { _ = lock.Unlock() }()
+
+	var entries []*ScheduledSling
+	if data, err := os.ReadFile(q.path); err == nil {
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return fmt.Errorf("parsing %s: %w", q.path, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	entries, changed := fn(entries)
+	if !changed {
+		return nil
+	}
+	return util.AtomicWriteJSON(q.path, entries)
+}
+
+// Add queues a sling. It must have a time or a dependency condition.
+func (q *Queue) Add(s ScheduledSling) (*ScheduledSling, error) {
+	if s.Bead == "" {
+		return nil, fmt.Errorf("bead required")
+	}
+	if s.At.IsZero() && len(s.After) == 0 {
+		return nil, fmt.Errorf("a scheduled sling needs --at or --after")
+	}
+	buf := make([]byte, 3)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	s.ID = "sq-" + hex.EncodeToString(buf)
+	s.Status = QueuePending
+	s.CreatedAt = q.now()
+	err := q.update(func(entries []*ScheduledSling) ([]*ScheduledSling, bool) {
+		return append(entries, &s), true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// List returns queued slings, next due first. Finished entries are
+// included only when all is set.
+func (q *Queue) List(all bool) ([]*ScheduledSling, error) {
+	var out []*ScheduledSling
+	err := q.update(func(entries []*ScheduledSling) ([]*ScheduledSling, bool) {
+		for _, s := range entries {
+			if all || s.Status == QueuePending {
+				out = append(out, s)
+			}
+		}
+		return entries, false
+	})
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if (a.Status == QueuePending) != (b.Status == QueuePending) {
+			return a.Status == QueuePending
+		}
+		if a.At.Equal(b.At) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.At.Before(b.At)
+	})
+	return out, err
+}
+
+// Cancel cancels a pending sling.
+func (q *Queue) Cancel(id string) error {
+	var err error
+	uerr := q.update(func(entries []*ScheduledSling) ([]*ScheduledSling, bool) {
+		for _, s := range entries {
+			if s.ID != id {
+				continue
+			}
+			if s.Status != QueuePending {
+				err = fmt.Errorf("%s is already %s", id, s.Status)
+				return entries, false
+			}
+			s.Status = QueueCancelled
+			return entries, true
+		}
+		err = fmt.Errorf("no scheduled sling %s", id)
+		return entries, false
+	})
+	if uerr != nil {
+		return uerr
+	}
+	return err
+}
+
+// DispatchDue dispatches every pending sling whose conditions hold.
+// isClosed reports whether a dependency bead is closed; dispatch runs the
+// sling. Entries that fail are retried on later calls until
+// MaxDispatchAttempts. Finished entries older than a week are dropped.
+//
+// Slings run outside the queue lock so the CLI isn't blocked while a
+// polecat spawns; only one caller (the daemon) should dispatch.
+func (q *Queue) DispatchDue(isClosed func(beadID string) bool, dispatch func(s *ScheduledSling) error) ([]*ScheduledSling, error) {
+	now := q.now()
+	var due []*ScheduledSling
+	err := q.update(func(entries []*ScheduledSling) ([]*ScheduledSling, bool) {
+		changed := false
+		kept := entries[:0]
+		for _, s := range entries {
+			if s.Status != QueuePending {
+				finished := s.DispatchedAt
+				if finished.IsZero() {
+					finished = s.CreatedAt
+				}
+				if now.Sub(finished) > 7*24*time.Hour {
+					changed = true
+					continue
+				}
+			} else if s.due(now, isClosed) {
+				copied := *s
+				due = append(due, &copied)
+			}
+			kept = append(kept, s)
+		}
+		return kept, changed
+	})
+	if err != nil || len(due) == 0 {
+		return nil, err
+	}
+
+	results := make(map[string]error, len(due))
+	for _, s := range due {
+		results[s.ID] = dispatch(s)
+	}
+
+	var handled []*ScheduledSling
+	err = q.update(func(entries []*ScheduledSling) ([]*ScheduledSling, bool) {
+		for _, s := range entries {
+			dispatchErr, ok := results[s.ID]
+			if !ok || s.Status != QueuePending {
+				continue // cancelled while dispatching
+			}
+			s.Attempts++
+			if dispatchErr != nil {
+				s.LastError = dispatchErr.Error()
+				if s.Attempts >= MaxDispatchAttempts {
+					s.Status = QueueFailed
+					s.DispatchedAt = now
+				}
+			} else {
+				s.Status = QueueDispatched
+				s.LastError = ""
+				s.DispatchedAt = now
+			}
+			copied := *s
+			handled = append(handled, &copied)
+		}
+		return entries, true
+	})
+	return handled, err
+}
+
+// due reports whether a pending sling's conditions all hold.
+func (s *ScheduledSling) due(now time.Time, isClosed func(beadID string) bool) bool {
+	if !s.At.IsZero() && now.Before(s.At) {
+		return false
+	}
+	for _, dep := range s.After {
+		if !isClosed(dep) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseScheduleTime parses a --at value relative to now: a clock time
+// ("02:00", the next time it comes round), a date and time
+// ("2026-10-17 02:00"), RFC 3339, or a delay ("+90m", "2h").
+func ParseScheduleTime(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if d, err := time.ParseDuration(strings.TrimPrefix(value, "+")); err == nil {
+		if d <= 0 {
+			return time.Time{}, fmt.Errorf("delay %q must be positive", value)
+		}
+		return now.Add(d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02T15:04"} {
+		if t, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.ParseInLocation("15:04", value, now.Location()); err == nil {
+		at := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+		if !at.After(now) {
+			at = at.AddDate(0, 0, 1)
+		}
+		return at, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: use HH:MM, \"YYYY-MM-DD HH:MM\", RFC 3339, or a delay like +2h", value)
+}
@@ -0,0 +1,101 @@
+package sling
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseScheduleTime(t *testing.T) {
+	now := time.Date(2026, 10, 16, 14, 30, 0, 0, time.Local)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"02:00", time.Date(2026, 10, 17, 2, 0, 0, 0, time.Local)},   // already past today
+		{"18:15", time.Date(2026, 10, 16, 18, 15, 0, 0, time.Local)}, // later today
+		{"+90m", now.Add(90 * time.Minute)},
+		{"2h", now.Add(2 * time.Hour)},
+		{"2026-10-20 09:00", time.Date(2026, 10, 20, 9, 0, 0, 0, time.Local)},
+	}
+	for _, tt := range tests {
+		got, err := ParseScheduleTime(tt.in, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("ParseScheduleTime(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+	for _, bad := range []string{"", "tomorrow", "-1h", "25:00"} {
+		if _, err := ParseScheduleTime(bad, now); err == nil {
+			t.Errorf("ParseScheduleTime(%q) succeeded", bad)
+		}
+	}
+}
+
+func TestQueueDispatchDue(t *testing.T) {
+	now := time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC)
+	q := NewQueue(t.TempDir())
+	q.now = func() time.Time { return now }
+
+	later, err := q.Add(ScheduledSling{Bead: "gt-later", Target: "gastown", At: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocked, _ := q.Add(ScheduledSling{Bead: "gt-blocked", After: []string{"gt-dep"}})
+	flaky, _ := q.Add(ScheduledSling{Bead: "gt-flaky", At: now.Add(-time.Minute)})
+	cancelled, _ := q.Add(ScheduledSling{Bead: "gt-cancel", At: now})
+	if err := q.Cancel(cancelled.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Cancel(cancelled.ID); err == nil {
+		t.Error("cancelling twice succeeded")
+	}
+	if _, err := q.Add(ScheduledSling{Bead: "gt-now"}); err == nil {
+		t.Error("Add accepted a sling with no condition")
+	}
+
+	closed := map[string]bool{}
+	var ran []string
+	dispatch := func(s *ScheduledSling) error {
+		ran = append(ran, s.Bead)
+		if s.Bead == "gt-flaky" {
+			return errors.New("rig parked")
+		}
+		return nil
+	}
+
+	// Only the flaky one is due; it fails and stays pending.
+	if _, err := q.DispatchDue(func(id string) bool { return closed[id] }, dispatch); err != nil {
+		t.Fatal(err)
+	}
+	if len(ran) != 1 || ran[0] != "gt-flaky" {
+		t.Fatalf("first pass ran %v, want only gt-flaky", ran)
+	}
+
+	// An hour later the dependency is closed: everything runs, and the
+	// flaky sling is retried.
+	now = now.Add(time.Hour)
+	closed["gt-dep"] = true
+	ran = nil
+	if _, err := q.DispatchDue(func(id string) bool { return closed[id] }, dispatch); err != nil {
+		t.Fatal(err)
+	}
+	if len(ran) != 3 {
+		t.Errorf("second pass ran %v, want gt-later, gt-blocked, gt-flaky", ran)
+	}
+	_, _ = q.DispatchDue(func(string) bool { return true }, dispatch)
+
+	all, _ := q.List(true)
+	status := map[string]string{}
+	for _, s := range all {
+		status[s.ID] = s.Status
+	}
+	if status[later.ID] != QueueDispatched || status[blocked.ID] != QueueDispatched {
+		t.Errorf("statuses = %v, want later and blocked dispatched", status)
+	}
+	if status[flaky.ID] != QueueFailed || status[cancelled.ID] != QueueCancelled {
+		t.Errorf("statuses = %v, want flaky failed after %d attempts and cancel kept", status, MaxDispatchAttempts)
+	}
+	if pending, _ := q.List(false); len(pending) != 0 {
+		t.Errorf("pending = %v, want none", pending)
+	}
+}
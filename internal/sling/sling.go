@@ -1,6 +1,7 @@
 package sling
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -10,15 +11,16 @@ import (
 
 	"github.com/steveyegge/gastown/internal/bdcmd"
 	"github.com/steveyegge/gastown/internal/beads"
-	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/eventbus"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/tracing"
 )
 
 // Sling hooks a bead to a target agent, optionally spawning a polecat.
 // This replaces the exec.Command("gt", "sling", ...) pattern in the RPC server
 // with direct function calls that return structured data.
-func Sling(opts SlingOptions) (*SlingResult, error) {
+func Sling(opts SlingOptions) (result *SlingResult, err error) {
 	out := opts.Output
 	if out == nil {
 		out = io.Discard
@@ -28,6 +30,9 @@ func Sling(opts SlingOptions) (*SlingResult, error) {
 	if townRoot == "" {
 		return nil, fmt.Errorf("town_root is required")
 	}
+	if err := CheckTownAcceptingWork(townRoot); err != nil {
+		return nil, err
+	}
 	townBeadsDir := filepath.Join(townRoot, ".beads")
 
 	// Ensure beads.role=maintainer is set
@@ -38,6 +43,14 @@ func Sling(opts SlingOptions) (*SlingResult, error) {
 	target := strings.TrimRight(opts.Target, "/")
 	beadID := opts.BeadID
 
+	ctx, span := tracing.Start(context.Background(), beadID, "sling")
+	defer func() {
+		if result != nil {
+			span.SetAttributes(tracing.AttrAgent.String(result.TargetAgent))
+		}
+		tracing.End(span, err)
+	}()
+
 	// Verify bead exists and check status
 	if err := VerifyBeadExists(beadID, townRoot); err != nil {
 		return nil, fmt.Errorf("bead not found: %w", err)
@@ -149,7 +162,7 @@ func Sling(opts SlingOptions) (*SlingResult, error) {
 		return nil, fmt.Errorf("target is required (no self-resolution available)")
 	}
 
-	result := &SlingResult{
+	result = &SlingResult{
 		BeadID:    beadID,
 		BeadTitle: info.Title,
 	}
@@ -178,11 +191,20 @@ func Sling(opts SlingOptions) (*SlingResult, error) {
 	}
 
 	// Execute deferred polecat spawn
+	var warmClaimed bool
 	if deferredRigName != "" {
 		deferredSpawnOpts.HookBead = beadID
 
 		fmt.Fprintf(out, "  Spawning polecat in %s...\n", deferredRigName)
+		_, spawnSpan := tracing.Start(ctx, beadID, "spawn_polecat", tracing.AttrRig.String(deferredRigName))
 		spawnInfo, spawnErr := SpawnPolecatForSling(deferredRigName, deferredSpawnOpts)
+		if spawnErr == nil && spawnInfo.K8sSpawn {
+			// The controller traces pod creation under the agent bead
+			agentBeadID := PolecatAgentBeadID(townRoot, deferredRigName, spawnInfo.PolecatName)
+			tracing.LinkBead(spawnSpan, agentBeadID)
+			spawnSpan.SetAttributes(tracing.AttrAgentBeadID.String(agentBeadID))
+		}
+		tracing.End(spawnSpan, spawnErr)
 		if spawnErr != nil {
 			return nil, fmt.Errorf("spawning polecat: %w", spawnErr)
 		}
@@ -191,6 +213,7 @@ func Sling(opts SlingOptions) (*SlingResult, error) {
 		hookSetAtomically = true
 		polecatSpawned = true
 		polecatName = spawnInfo.PolecatName
+		warmClaimed = spawnInfo.Warm
 
 		if spawnInfo.K8sSpawn {
 			result.K8sSpawn = true
@@ -209,6 +232,12 @@ func Sling(opts SlingOptions) (*SlingResult, error) {
 	}
 	fmt.Fprintf(out, "Work attached to hook (status=hooked)\n")
 
+	if warmClaimed {
+		if err := nudgeWarmPolecat(targetAgent, beadID); err != nil {
+			fmt.Fprintf(out, "Could not nudge %s (it will find the work via gt prime): %v\n", polecatName, err)
+		}
+	}
+
 	// Send crew mail notification
 	if crewTargetName != "" {
 		router := mail.NewRouter(townRoot)
@@ -228,7 +257,7 @@ func Sling(opts SlingOptions) (*SlingResult, error) {
 	}
 
 	// Log event
-	_ = events.LogFeed(events.TypeSling, "gt-rpc", events.SlingPayload(beadID, targetAgent))
+	_ = eventbus.Publish("gt-rpc", eventbus.Sling{Bead: beadID, Target: targetAgent})
 
 	// Update agent hook bead
 	if !hookSetAtomically {
@@ -252,6 +281,9 @@ func SlingFormula(opts FormulaOptions) (*FormulaResult, error) {
 	if townRoot == "" {
 		return nil, fmt.Errorf("town_root is required")
 	}
+	if err := CheckTownAcceptingWork(townRoot); err != nil {
+		return nil, err
+	}
 	townBeadsDir := filepath.Join(townRoot, ".beads")
 
 	formula := opts.Formula
@@ -334,6 +366,7 @@ func slingFormulaOnBead(opts FormulaOptions, out io.Writer, townRoot, townBeadsD
 	attachedMoleculeID := fResult.WispRootID
 
 	// Deferred spawn after formula instantiation
+	var warmClaimed bool
 	if deferredRigName != "" {
 		deferredSpawnOpts.HookBead = beadID
 		spawnInfo, spawnErr := SpawnPolecatForSling(deferredRigName, deferredSpawnOpts)
@@ -344,6 +377,7 @@ func slingFormulaOnBead(opts FormulaOptions, out io.Writer, townRoot, townBeadsD
 		hookWorkDir = spawnInfo.ClonePath
 		polecatSpawned = true
 		polecatNameResult = spawnInfo.PolecatName
+		warmClaimed = spawnInfo.Warm
 		WakeRigAgents(deferredRigName)
 	}
 
@@ -353,14 +387,19 @@ func slingFormulaOnBead(opts FormulaOptions, out io.Writer, townRoot, townBeadsD
 	if err := HookBead(beadID, targetAgent, townRoot, hookWorkDir, out); err != nil {
 		return nil, err
 	}
+	if warmClaimed {
+		if err := nudgeWarmPolecat(targetAgent, beadID); err != nil {
+			fmt.Fprintf(out, "Could not nudge %s (it will find the work via gt prime): %v\n", polecatNameResult, err)
+		}
+	}
 
 	// Store metadata
-	_ = events.LogFeed(events.TypeSling, "gt-rpc", events.SlingPayload(beadID, targetAgent))
+	_ = eventbus.Publish("gt-rpc", eventbus.Sling{Bead: beadID, Target: targetAgent})
 	UpdateAgentHookBead(targetAgent, beadID, hookWorkDir, townBeadsDir)
-	_ = StoreAttachedMoleculeInBead(beadID, attachedMoleculeID)
-
-	if opts.Args != "" {
-		_ = StoreArgsInBead(beadID, opts.Args)
+	if attachedMoleculeID != "" || opts.Args != "" {
+		if m, err := NewBeadMutator(beadID); err == nil {
+			_ = m.SetAttachedMolecule(attachedMoleculeID).SetArgs(opts.Args).Commit()
+		}
 	}
 
 	return &FormulaResult{
@@ -433,6 +472,7 @@ func slingStandaloneFormula(opts FormulaOptions, out io.Writer, townRoot, townBe
 	}
 
 	// Deferred spawn after wisp creation
+	var warmClaimed bool
 	if deferredRigName != "" {
 		deferredSpawnOpts.HookBead = wispRootID
 		spawnInfo, spawnErr := SpawnPolecatForSling(deferredRigName, deferredSpawnOpts)
@@ -442,6 +482,7 @@ func slingStandaloneFormula(opts FormulaOptions, out io.Writer, townRoot, townBe
 		targetAgent = spawnInfo.AgentID()
 		polecatSpawned = true
 		polecatNameResult = spawnInfo.PolecatName
+		warmClaimed = spawnInfo.Warm
 		WakeRigAgents(deferredRigName)
 	}
 
@@ -449,16 +490,18 @@ func slingStandaloneFormula(opts FormulaOptions, out io.Writer, townRoot, townBe
 	if err := HookBead(wispRootID, targetAgent, townRoot, "", out); err != nil {
 		return nil, fmt.Errorf("hooking wisp bead: %w", err)
 	}
+	if warmClaimed {
+		if err := nudgeWarmPolecat(targetAgent, wispRootID); err != nil {
+			fmt.Fprintf(out, "Could not nudge %s (it will find the work via gt prime): %v\n", polecatNameResult, err)
+		}
+	}
 
 	// Metadata
-	_ = events.LogFeed(events.TypeSling, "gt-rpc", events.SlingPayload(wispRootID, targetAgent))
+	_ = eventbus.Publish("gt-rpc", eventbus.Sling{Bead: wispRootID, Target: targetAgent})
 	UpdateAgentHookBead(targetAgent, wispRootID, "", townBeadsDir)
-	_ = StoreDispatcherInBead(wispRootID, "gt-rpc")
-
-	if opts.Args != "" {
-		_ = StoreArgsInBead(wispRootID, opts.Args)
+	if m, err := NewBeadMutator(wispRootID); err == nil {
+		_ = m.SetDispatcher("gt-rpc").SetArgs(opts.Args).SetAttachedMolecule(wispRootID).Commit()
 	}
-	_ = StoreAttachedMoleculeInBead(wispRootID, wispRootID)
 
 	return &FormulaResult{
 		WispID:         wispRootID,
@@ -479,6 +522,9 @@ func SlingBatch(opts BatchOptions) (*BatchResult, error) {
 	if townRoot == "" {
 		return nil, fmt.Errorf("town_root is required")
 	}
+	if err := CheckTownAcceptingWork(townRoot); err != nil {
+		return nil, err
+	}
 	townBeadsDir := filepath.Join(townRoot, ".beads")
 
 	// Ensure beads.role=maintainer
@@ -587,15 +633,20 @@ func SlingBatch(opts BatchOptions) (*BatchResult, error) {
 			continue
 		}
 
+		if spawnInfo.Warm {
+			if err := nudgeWarmPolecat(targetAgent, beadToHook); err != nil {
+				fmt.Fprintf(out, "Could not nudge %s (it will find the work via gt prime): %v\n", spawnInfo.PolecatName, err)
+			}
+		}
+
 		// Metadata
-		_ = events.LogFeed(events.TypeSling, "gt-rpc", events.SlingPayload(beadToHook, targetAgent))
+		_ = eventbus.Publish("gt-rpc", eventbus.Sling{Bead: beadToHook, Target: targetAgent})
 		UpdateAgentHookBead(targetAgent, beadToHook, hookWorkDir, townBeadsDir)
 
-		if attachedMoleculeID != "" {
-			_ = StoreAttachedMoleculeInBead(beadToHook, attachedMoleculeID)
-		}
-		if opts.Args != "" {
-			_ = StoreArgsInBead(beadID, opts.Args)
+		if attachedMoleculeID != "" || opts.Args != "" {
+			if m, err := NewBeadMutator(beadToHook); err == nil {
+				_ = m.SetAttachedMolecule(attachedMoleculeID).SetArgs(opts.Args).Commit()
+			}
 		}
 
 		bResult.Success = true
@@ -667,30 +718,18 @@ func handleConvoy(opts SlingOptions, beadID, title, targetAgent, townRoot string
 }
 
 func storeMetadata(opts SlingOptions, beadID, attachedMoleculeID string, out io.Writer) {
-	if err := StoreDispatcherInBead(beadID, "gt-rpc"); err != nil {
-		fmt.Fprintf(out, "Warning: could not store dispatcher: %v\n", err)
-	}
-
-	if opts.Args != "" {
-		if err := StoreArgsInBead(beadID, opts.Args); err != nil {
-			fmt.Fprintf(out, "Warning: could not store args: %v\n", err)
-		}
+	m, err := NewBeadMutator(beadID)
+	if err == nil {
+		err = m.SetDispatcher("gt-rpc").
+			SetArgs(opts.Args).
+			SetNoMerge(opts.NoMerge).
+			SetMergeStrategy(opts.MergeStrategy).
+			SetConvoyOwned(opts.Owned).
+			SetAttachedMolecule(attachedMoleculeID).
+			Commit()
 	}
-
-	if opts.NoMerge {
-		_ = StoreNoMergeInBead(beadID, true)
-	}
-
-	if opts.MergeStrategy != "" {
-		_ = StoreMergeStrategyInBead(beadID, opts.MergeStrategy)
-	}
-
-	if opts.Owned {
-		_ = StoreConvoyOwnedInBead(beadID, true)
-	}
-
-	if attachedMoleculeID != "" {
-		_ = StoreAttachedMoleculeInBead(beadID, attachedMoleculeID)
+	if err != nil {
+		fmt.Fprintf(out, "Warning: could not store sling metadata: %v\n", err)
 	}
 }
 
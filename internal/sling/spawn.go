@@ -8,7 +8,7 @@ import (
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
-	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/eventbus"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
@@ -61,12 +61,37 @@ func SpawnPolecatForSling(rigName string, opts SpawnOptions) (*SpawnResult, erro
 		return nil, fmt.Errorf("rig '%s' not found", rigName)
 	}
 
-	// Resolve execution target: explicit override > rig settings > "local"
-	execTarget := ResolveExecutionTarget(r.Path, opts.ExecutionTarget)
-	if execTarget != config.ExecutionTargetK8s {
-		return nil, fmt.Errorf("local execution is no longer supported (codebase is K8s-only since v0.7.0)")
+	// Per-rig limits (max_polecats, max_slings_per_hour, daily_token_budget)
+	if err := AdmitSling(townRoot, r, opts.LimitOverride); err != nil {
+		return nil, err
 	}
-	return spawnPolecatForK8s(townRoot, rigName, r, opts)
+
+	// Resolve execution target: explicit override > agent or rig settings > "local"
+	execTarget := polecat.ExecutionTarget(r, opts.Agent, opts.ExecutionTarget)
+	switch execTarget {
+	case config.ExecutionTargetK8s:
+		// Warm polecats run the rig's default agent and account.
+		if opts.Agent == "" && opts.Account == "" {
+			if res := ClaimWarmPolecat(townRoot, r, opts.HookBead); res != nil {
+				return res, nil
+			}
+		}
+		return spawnPolecatForK8s(townRoot, rigName, r, opts)
+	case config.ExecutionTargetDocker:
+		p, err := polecat.SpawnInDocker(r, opts.HookBead, opts.Account, opts.Agent)
+		if err != nil {
+			return nil, err
+		}
+		_ = eventbus.Publish("gt", eventbus.Spawn{Rig: rigName, Polecat: p.Name})
+		return &SpawnResult{
+			RigName:     rigName,
+			PolecatName: p.Name,
+			ClonePath:   p.ClonePath,
+			Account:     opts.Account,
+			Agent:       opts.Agent,
+		}, nil
+	}
+	return nil, fmt.Errorf("local execution is no longer supported (codebase is K8s-only since v0.7.0); use execution target \"k8s\" or \"docker\"")
 }
 
 // WakeRigAgents wakes the witness for a rig after polecat dispatch.
@@ -117,6 +142,12 @@ func notifyWitnessRateLimit(rigName, polecatName, account string) {
 	_ = cmd.Run()
 }
 
+// PolecatAgentBeadID returns the agent bead ID for a polecat, e.g.
+// "gt-gastown-polecat-nux".
+func PolecatAgentBeadID(townRoot, rigName, polecatName string) string {
+	return beads.PolecatBeadIDWithPrefix(beads.GetPrefixForRig(townRoot, rigName), rigName, polecatName)
+}
+
 // spawnPolecatForK8s creates an agent bead for a K8s polecat without creating
 // a local worktree or session. The K8s controller watches for agent beads
 // with agent_state=spawning and execution_target:k8s label, then creates pods.
@@ -134,8 +165,7 @@ func spawnPolecatForK8s(townRoot, rigName string, r *rig.Rig, opts SpawnOptions)
 	// Use rig beads (mayor/rig) to match where local polecats store their agent beads.
 	// The K8s controller detects agent_state=spawning + execution_target:k8s label
 	// and creates a pod for this polecat.
-	prefix := beads.GetPrefixForRig(townRoot, rigName)
-	agentBeadID := beads.PolecatBeadIDWithPrefix(prefix, rigName, polecatName)
+	agentBeadID := PolecatAgentBeadID(townRoot, rigName, polecatName)
 	rigBeadsPath := filepath.Join(r.Path, "mayor", "rig")
 	beadsClient := beads.New(rigBeadsPath)
 	_, err = beadsClient.CreateOrReopenAgentBead(agentBeadID, agentBeadID, &beads.AgentFields{
@@ -148,10 +178,15 @@ func spawnPolecatForK8s(townRoot, rigName string, r *rig.Rig, opts SpawnOptions)
 	if err != nil {
 		return nil, fmt.Errorf("creating agent bead for K8s polecat: %w", err)
 	}
+	if opts.LimitOverride != "" {
+		if err := MarkLimitOverride(beadsClient, agentBeadID, opts.LimitOverride); err != nil {
+			fmt.Printf("Warning: could not record limit override on %s: %v\n", agentBeadID, err)
+		}
+	}
 
 	fmt.Printf("✓ Polecat %s dispatched to K8s (agent_state=spawning)\n", polecatName)
 
-	_ = events.LogFeed(events.TypeSpawn, "gt", events.SpawnPayload(rigName, polecatName))
+	_ = eventbus.Publish("gt", eventbus.Spawn{Rig: rigName, Polecat: polecatName})
 
 	return &SpawnResult{
 		RigName:     rigName,
@@ -159,9 +159,14 @@ type SpawnOptions struct {
 	HookBead string
 	Agent    string
 
-	// ExecutionTarget is "local" (default) or "k8s".
+	// ExecutionTarget is "local" (default), "k8s" or "docker".
 	// When "k8s", skip worktree creation and set agent_state=spawning for the controller.
+	// When "docker", create the worktree and start the session in a local container.
 	ExecutionTarget string
+
+	// LimitOverride names a decision bead approving a spawn past the rig's
+	// limits. See AdmitSling.
+	LimitOverride string
 }
 
 // SpawnResult contains info about a spawned polecat.
@@ -174,6 +179,9 @@ type SpawnResult struct {
 	Agent   string
 	// K8sSpawn is true when the polecat was dispatched to K8s (no local worktree/session).
 	K8sSpawn bool
+	// Warm is true when an idle polecat was claimed from the rig's warm pool.
+	// It is already running, so it needs a nudge to pick up its hook.
+	Warm bool
 }
 
 // AgentID returns the agent identifier (e.g., "gastown/polecats/Toast").
@@ -0,0 +1,144 @@
+package sling
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/settings"
+	"github.com/steveyegge/gastown/internal/terminal"
+)
+
+// WarmPoolConfig returns a rig's warm pool settings, or nil if the pool is
+// not enabled.
+func WarmPoolConfig(r *rig.Rig) *config.WarmPoolConfig {
+	settings, err := config.LoadRigSettings(filepath.Join(r.Path, "settings", "config.json"))
+	if err != nil || settings.WarmPool == nil || settings.WarmPool.Size <= 0 {
+		return nil
+	}
+	return settings.WarmPool
+}
+
+// warmPolecatIdle reports whether a pool member can still take work: its
+// agent bead is open and nothing has been hooked to it.
+func warmPolecatIdle(bd *beads.Beads, agentBeadID string) bool {
+	issue, fields, err := bd.GetAgentBead(agentBeadID)
+	if err != nil || issue == nil || issue.Status == "closed" {
+		return false
+	}
+	return fields == nil || fields.HookBead == ""
+}
+
+// ClaimWarmPolecat hooks work onto an idle polecat from the rig's warm pool.
+// It returns nil when the pool is disabled, the sling.use_warm_pool setting
+// is off, or the pool has nothing usable, and the caller should spawn as usual.
+func ClaimWarmPolecat(townRoot string, r *rig.Rig, hookBead string) *SpawnResult {
+	if WarmPoolConfig(r) == nil || !settings.Current(townRoot).Bool(config.KnobSlingUseWarmPool) {
+		return nil
+	}
+
+	bd := beads.New(townRoot)
+	var claimed string
+	err := polecat.UpdateWarmPool(r.Path, func(pool *polecat.WarmPool) error {
+		for {
+			m, ok := pool.Claim(time.Now())
+			if !ok {
+				return nil
+			}
+			// Members the witness nuked or that were hooked by hand are dropped.
+			if warmPolecatIdle(bd, PolecatAgentBeadID(townRoot, r.Name, m.Name)) {
+				claimed = m.Name
+				return nil
+			}
+		}
+	})
+	if err != nil || claimed == "" {
+		return nil
+	}
+
+	agentBeadID := PolecatAgentBeadID(townRoot, r.Name, claimed)
+	if err := bd.SetHookBead(agentBeadID, hookBead); err != nil {
+		// Out of the pool but unusable: reclaim it rather than leave it idle.
+		_ = bd.CloseAndClearAgentBead(agentBeadID, "warm pool: claim failed")
+		return nil
+	}
+	fmt.Printf("✓ Claimed warm polecat %s\n", claimed)
+
+	return &SpawnResult{
+		RigName:     r.Name,
+		PolecatName: claimed,
+		K8sSpawn:    true,
+		Warm:        true,
+	}
+}
+
+// nudgeWarmPolecat tells a claimed warm polecat, idle until now, that work
+// is on its hook.
+func nudgeWarmPolecat(agentID, beadID string) error {
+	backend := terminal.ResolveBackend(agentID)
+	return backend.NudgeSession("claude", fmt.Sprintf("Work slung: %s. Start working on it now - run `gt hook` to see the hook, then begin.", beadID))
+}
+
+// ReplenishWarmPool brings a rig's warm pool to its configured size. Members
+// idle past the idle timeout are reclaimed (their agent beads closed, so the
+// controller deletes the pods) and replaced. Pass a nil cfg to drain a pool
+// that has been disabled. Only K8s rigs can keep a warm pool.
+func ReplenishWarmPool(townRoot string, r *rig.Rig, cfg *config.WarmPoolConfig, now time.Time) (spawned, reclaimed []string, err error) {
+	size := 0
+	if cfg != nil {
+		size = cfg.Size
+	}
+	if size > 0 && polecat.ExecutionTarget(r, "", "") != config.ExecutionTargetK8s {
+		return nil, nil, fmt.Errorf("warm pool needs execution target k8s, rig %s uses %s", r.Name, polecat.ExecutionTarget(r, "", ""))
+	}
+
+	bd := beads.New(townRoot)
+	var reclaim []polecat.WarmPolecat
+	want := 0
+	err = polecat.UpdateWarmPool(r.Path, func(pool *polecat.WarmPool) error {
+		for _, m := range append([]polecat.WarmPolecat(nil), pool.Members...) {
+			if !warmPolecatIdle(bd, PolecatAgentBeadID(townRoot, r.Name, m.Name)) {
+				pool.Remove(m.Name)
+			}
+		}
+		reclaim, want = pool.Plan(size, cfg.IdleTimeoutDuration(), now)
+		for _, m := range reclaim {
+			pool.Remove(m.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("updating warm pool: %w", err)
+	}
+
+	for _, m := range reclaim {
+		if err := bd.CloseAndClearAgentBead(PolecatAgentBeadID(townRoot, r.Name, m.Name), "warm pool: reclaimed idle polecat"); err != nil {
+			return spawned, reclaimed, fmt.Errorf("reclaiming %s: %w", m.Name, err)
+		}
+		reclaimed = append(reclaimed, m.Name)
+	}
+
+	for i := 0; i < want; i++ {
+		res, spawnErr := spawnPolecatForK8s(townRoot, r.Name, r, SpawnOptions{})
+		if spawnErr != nil {
+			err = fmt.Errorf("spawning warm polecat: %w", spawnErr)
+			break
+		}
+		spawned = append(spawned, res.PolecatName)
+	}
+	if len(spawned) > 0 {
+		if addErr := polecat.UpdateWarmPool(r.Path, func(pool *polecat.WarmPool) error {
+			for _, name := range spawned {
+				pool.Add(name, now)
+			}
+			return nil
+		}); addErr != nil && err == nil {
+			err = fmt.Errorf("updating warm pool: %w", addErr)
+		}
+	}
+	return spawned, reclaimed, err
+}
@@ -1,6 +1,7 @@
 // Package terminal provides a backend abstraction for terminal I/O operations.
 //
-// This enables peek/nudge commands to work with K8s pods via Coop.
+// This enables peek/nudge commands to work with K8s pods via Coop, and
+// with local containers via Docker.
 package terminal
 
 import "errors"
@@ -11,7 +12,8 @@ import "errors"
 var ErrNotSupported = errors.New("operation not supported by this backend")
 
 // Backend provides terminal capture and input for agent sessions.
-// The sole implementation is CoopBackend for K8s-hosted agents.
+// CoopBackend serves K8s-hosted agents; DockerBackend serves agents in
+// local containers.
 type Backend interface {
 	// HasSession checks if a terminal session exists and is running.
 	HasSession(session string) (bool, error)
@@ -0,0 +1,72 @@
+package terminal
+
+import "strings"
+
+// maxRedrawLines is how many trailing lines of a previous capture may have
+// been rewritten in place (status lines, spinners, a prompt being typed)
+// before DiffCapture gives up and reports a reset.
+const maxRedrawLines = 3
+
+// OutputDelta describes how to turn a previous capture into the current one.
+// Clients apply it by removing Drop trailing lines from their buffer and then
+// appending Lines. When Reset is true the previous capture could not be
+// aligned (screen cleared, output burst larger than the capture window) and
+// Lines holds the complete current capture.
+type OutputDelta struct {
+	Drop  int
+	Lines []string
+	Reset bool
+}
+
+// Empty reports whether the delta carries no change.
+func (d OutputDelta) Empty() bool {
+	return d.Drop == 0 && len(d.Lines) == 0 && !d.Reset
+}
+
+// SplitCapture splits captured pane output into lines, dropping the trailing
+// blank lines that capture-pane pads the visible screen with.
+func SplitCapture(output string) []string {
+	if output == "" {
+		return nil
+	}
+	lines := strings.Split(output, "\n")
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// DiffCapture computes the delta between two successive captures of the
+// same pane window. Both captures are assumed to be the last N lines of a
+// scrolling terminal, so the tail of prev should reappear as the head of cur.
+// The longest such overlap wins; up to maxRedrawLines trailing lines of prev
+// may be excluded from the overlap to tolerate in-place redraws.
+func DiffCapture(prev, cur []string) OutputDelta {
+	if len(prev) == 0 {
+		return OutputDelta{Lines: cur}
+	}
+	if len(cur) == 0 {
+		return OutputDelta{Reset: true}
+	}
+
+	for k := min(len(prev), len(cur)); k > 0; k-- {
+		for drop := 0; drop <= maxRedrawLines && k+drop <= len(prev); drop++ {
+			if linesEqual(prev[len(prev)-drop-k:len(prev)-drop], cur[:k]) {
+				return OutputDelta{Drop: drop, Lines: cur[k:]}
+			}
+		}
+	}
+	return OutputDelta{Lines: cur, Reset: true}
+}
+
+func linesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+package terminal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitCapture_TrimsTrailingBlankLines(t *testing.T) {
+	got := SplitCapture("a\nb\n\n  \n")
+	want := []string{"a", "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SplitCapture = %q, want %q", got, want)
+	}
+	if SplitCapture("") != nil {
+		t.Error("expected nil for empty output")
+	}
+}
+
+func TestDiffCapture(t *testing.T) {
+	tests := []struct {
+		name string
+		prev []string
+		cur  []string
+		want OutputDelta
+	}{
+		{
+			name: "first capture",
+			prev: nil,
+			cur:  []string{"a", "b"},
+			want: OutputDelta{Lines: []string{"a", "b"}},
+		},
+		{
+			name: "unchanged",
+			prev: []string{"a", "b", "c"},
+			cur:  []string{"a", "b", "c"},
+			want: OutputDelta{Lines: []string{}},
+		},
+		{
+			name: "appended within window",
+			prev: []string{"a", "b"},
+			cur:  []string{"a", "b", "c", "d"},
+			want: OutputDelta{Lines: []string{"c", "d"}},
+		},
+		{
+			name: "window scrolled",
+			prev: []string{"a", "b", "c"},
+			cur:  []string{"c", "d", "e"},
+			want: OutputDelta{Lines: []string{"d", "e"}},
+		},
+		{
+			name: "last line redrawn",
+			prev: []string{"a", "b", "> typ"},
+			cur:  []string{"a", "b", "> typing", "ok"},
+			want: OutputDelta{Drop: 1, Lines: []string{"> typing", "ok"}},
+		},
+		{
+			name: "no overlap",
+			prev: []string{"a", "b"},
+			cur:  []string{"x", "y"},
+			want: OutputDelta{Lines: []string{"x", "y"}, Reset: true},
+		},
+		{
+			name: "cleared",
+			prev: []string{"a"},
+			cur:  nil,
+			want: OutputDelta{Reset: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffCapture(tt.prev, tt.cur)
+			if got.Drop != tt.want.Drop || got.Reset != tt.want.Reset {
+				t.Fatalf("DiffCapture = %+v, want %+v", got, tt.want)
+			}
+			if len(got.Lines) != len(tt.want.Lines) || (len(got.Lines) > 0 && !reflect.DeepEqual(got.Lines, tt.want.Lines)) {
+				t.Errorf("Lines = %q, want %q", got.Lines, tt.want.Lines)
+			}
+		})
+	}
+}
+
+func TestOutputDelta_Empty(t *testing.T) {
+	if !(OutputDelta{}).Empty() {
+		t.Error("zero delta should be empty")
+	}
+	if (OutputDelta{Reset: true}).Empty() {
+		t.Error("reset delta should not be empty")
+	}
+}
@@ -0,0 +1,371 @@
+package terminal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// dockerTmuxSession is the tmux session each agent container runs as its
+// main process. The agent runs in the session's shell, so input goes in
+// with tmux send-keys and the screen comes out with tmux capture-pane.
+const dockerTmuxSession = "gt"
+
+// dockerNudgeDelay separates a nudge's text from its Enter, so agent TUIs
+// that debounce pasted input see the Enter as a separate keypress.
+const dockerNudgeDelay = 500 * time.Millisecond
+
+// dockerShells are pane commands that mean the agent isn't running: its
+// process has exited and left the container's shell at the prompt.
+var dockerShells = map[string]bool{"bash": true, "sh": true, "zsh": true, "ash": true, "dash": true}
+
+// DockerBackend runs agents in local Docker containers, one container per
+// session, so a single-machine town can isolate agents without K8s.
+//
+// Each container runs a tmux session as its main process. Input and
+// capture go through `docker exec <container> tmux ...`; once a container
+// has stopped, capture falls back to `docker logs`. The session parameter
+// in Backend methods is the container name unless AddSession maps it to
+// another one.
+type DockerBackend struct {
+	// command is the docker CLI binary.
+	command string
+
+	// mu protects sessions map.
+	mu sync.RWMutex
+	// sessions maps session name → container name.
+	sessions map[string]string
+}
+
+// DockerConfig configures a DockerBackend.
+type DockerConfig struct {
+	// Command is the docker CLI binary. Default: "docker".
+	// Any CLI that accepts docker's arguments (e.g. podman) works.
+	Command string
+}
+
+// DockerRunOptions describes the container StartContainer creates.
+type DockerRunOptions struct {
+	// Image is the agent image. It must provide tmux, a shell, the agent
+	// CLI, gt and bd.
+	Image string
+
+	// WorkDir is the container's working directory.
+	WorkDir string
+
+	// Mounts are bind mounts in docker's -v form ("/host:/container[:ro]").
+	Mounts []string
+
+	// Env is set in the container.
+	Env map[string]string
+
+	// Network is the docker network to attach to. Empty uses docker's default.
+	Network string
+
+	// User runs the container as "uid:gid", so files written to mounts
+	// keep the host user's ownership. Empty uses the image's user.
+	User string
+}
+
+// NewDockerBackend creates a Backend that talks to local containers via
+// the docker CLI.
+func NewDockerBackend(cfg DockerConfig) *DockerBackend {
+	command := cfg.Command
+	if command == "" {
+		command = "docker"
+	}
+	return &DockerBackend{
+		command:  command,
+		sessions: make(map[string]string),
+	}
+}
+
+// AddSession maps a session name to the container that runs it.
+func (b *DockerBackend) AddSession(session, container string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sessions[session] = container
+}
+
+// container returns the container for a session: the one registered via
+// AddSession, or the session name itself.
+func (b *DockerBackend) container(session string) string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if c, ok := b.sessions[session]; ok {
+		return c
+	}
+	return session
+}
+
+// docker runs the docker CLI and returns its trimmed stdout. Errors
+// include docker's stderr.
+func (b *DockerBackend) docker(args ...string) (string, error) {
+	cmd := exec.Command(b.command, args...) //nolint:gosec // G204: args are built internally
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("docker %s: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("docker %s: %w", args[0], err)
+	}
+	return strings.TrimRight(string(out), "\n"), nil
+}
+
+// tmux runs a tmux command inside a session's container.
+func (b *DockerBackend) tmux(session string, args ...string) (string, error) {
+	return b.docker(append([]string{"exec", b.container(session), "tmux"}, args...)...)
+}
+
+// StartContainer creates the container for a session and starts its tmux
+// session. A stopped container left by an earlier run is removed first,
+// so the name can be reused; a running one is an error.
+func (b *DockerBackend) StartContainer(session string, opts DockerRunOptions) error {
+	if opts.Image == "" {
+		return errors.New("docker: no image configured")
+	}
+	container := b.container(session)
+	running, err := b.HasSession(session)
+	if err != nil {
+		return err
+	}
+	if running {
+		return fmt.Errorf("docker: container %s is already running", container)
+	}
+	_, _ = b.docker("rm", "-f", container)
+
+	args := []string{"run", "-d", "-t", "--name", container, "--label", "gastown.session=" + session}
+	if opts.User != "" {
+		args = append(args, "--user", opts.User)
+	}
+	if opts.Network != "" {
+		args = append(args, "--network", opts.Network)
+	}
+	if opts.WorkDir != "" {
+		args = append(args, "-w", opts.WorkDir)
+	}
+	for _, m := range opts.Mounts {
+		args = append(args, "-v", m)
+	}
+	keys := make([]string, 0, len(opts.Env))
+	for k := range opts.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, "-e", k+"="+opts.Env[k])
+	}
+	// tmux runs in the foreground on the container's TTY, so the container
+	// lives as long as the session and docker logs shows its screen.
+	args = append(args, opts.Image, "tmux", "new-session", "-s", dockerTmuxSession, "-x", "200", "-y", "50")
+	if _, err := b.docker(args...); err != nil {
+		return err
+	}
+
+	// Wait for the tmux server to come up before anyone sends input.
+	for i := 0; i < 20; i++ {
+		if _, err := b.tmux(session, "has-session", "-t", dockerTmuxSession); err == nil {
+			return nil
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+	return fmt.Errorf("docker: tmux did not start in container %s", container)
+}
+
+// HasSession reports whether the session's container is running.
+// Missing containers are not an error.
+func (b *DockerBackend) HasSession(session string) (bool, error) {
+	out, err := b.docker("inspect", "-f", "{{.State.Running}}", b.container(session))
+	if err != nil {
+		return false, nil // No such container → not running
+	}
+	return out == "true", nil
+}
+
+// CapturePane returns the last lines of the agent's screen. A stopped
+// container can't be exec'd into, so its output comes from docker logs.
+func (b *DockerBackend) CapturePane(session string, lines int) (string, error) {
+	args := []string{"capture-pane", "-p", "-J", "-t", dockerTmuxSession}
+	if lines > 0 {
+		args = append(args, "-S", "-"+strconv.Itoa(lines))
+	} else {
+		args = append(args, "-S", "-")
+	}
+	if out, err := b.tmux(session, args...); err == nil {
+		return trimLines(out, lines), nil
+	}
+
+	logArgs := []string{"logs"}
+	if lines > 0 {
+		logArgs = append(logArgs, "--tail", strconv.Itoa(lines))
+	}
+	out, err := b.docker(append(logArgs, b.container(session))...)
+	if err != nil {
+		return "", err
+	}
+	return trimLines(out, lines), nil
+}
+
+func (b *DockerBackend) CapturePaneAll(session string) (string, error) {
+	return b.CapturePane(session, 0)
+}
+
+func (b *DockerBackend) CapturePaneLines(session string, lines int) ([]string, error) {
+	out, err := b.CapturePane(session, lines)
+	if err != nil {
+		return nil, err
+	}
+	if out == "" {
+		return nil, nil
+	}
+	return strings.Split(out, "\n"), nil
+}
+
+// trimLines keeps the last n lines of s, after dropping trailing blank
+// lines (tmux pads the screen with them). n <= 0 keeps every line.
+func trimLines(s string, n int) string {
+	all := strings.Split(strings.TrimRight(s, "\n "), "\n")
+	if n > 0 && len(all) > n {
+		all = all[len(all)-n:]
+	}
+	return strings.Join(all, "\n")
+}
+
+func (b *DockerBackend) NudgeSession(session string, message string) error {
+	if _, err := b.tmux(session, "send-keys", "-t", dockerTmuxSession, "-l", message); err != nil {
+		return err
+	}
+	time.Sleep(dockerNudgeDelay)
+	_, err := b.tmux(session, "send-keys", "-t", dockerTmuxSession, "Enter")
+	return err
+}
+
+func (b *DockerBackend) SendKeys(session string, keys string) error {
+	keyList := strings.Fields(keys)
+	if len(keyList) == 0 {
+		return nil
+	}
+	_, err := b.tmux(session, append([]string{"send-keys", "-t", dockerTmuxSession}, keyList...)...)
+	return err
+}
+
+func (b *DockerBackend) SendInput(session string, text string, enter bool) error {
+	if _, err := b.tmux(session, "send-keys", "-t", dockerTmuxSession, "-l", text); err != nil {
+		return err
+	}
+	if !enter {
+		return nil
+	}
+	_, err := b.tmux(session, "send-keys", "-t", dockerTmuxSession, "Enter")
+	return err
+}
+
+// IsPaneDead reports whether the container has stopped.
+func (b *DockerBackend) IsPaneDead(session string) (bool, error) {
+	running, err := b.HasSession(session)
+	return !running, err
+}
+
+func (b *DockerBackend) SetPaneDiedHook(session, agentID string) error {
+	// No-op for docker: a dead session stops its container, which
+	// IsPaneDead sees when polled.
+	return nil
+}
+
+// KillSession stops the session's container. The container is kept so
+// its output can still be read with docker logs; StartContainer removes it.
+func (b *DockerBackend) KillSession(session string) error {
+	_, err := b.docker("stop", "-t", "10", b.container(session))
+	return err
+}
+
+// IsAgentRunning reports whether the agent process is in the foreground
+// of the container's tmux pane, rather than the shell it was started from.
+func (b *DockerBackend) IsAgentRunning(session string) (bool, error) {
+	out, err := b.tmux(session, "display-message", "-p", "-t", dockerTmuxSession, "#{pane_current_command}")
+	if err != nil {
+		return false, err
+	}
+	return out != "" && !dockerShells[out], nil
+}
+
+// GetAgentState returns "running" while the agent runs and "exited" once
+// it has left its shell prompt or its container has stopped. Containers
+// have no finer-grained agent state.
+func (b *DockerBackend) GetAgentState(session string) (string, error) {
+	running, err := b.HasSession(session)
+	if err != nil {
+		return "", err
+	}
+	if !running {
+		return "exited", nil
+	}
+	agentRunning, err := b.IsAgentRunning(session)
+	if err != nil {
+		return "", err
+	}
+	if !agentRunning {
+		return "exited", nil
+	}
+	return "running", nil
+}
+
+func (b *DockerBackend) SetEnvironment(session, key, value string) error {
+	_, err := b.tmux(session, "set-environment", "-t", dockerTmuxSession, key, value)
+	return err
+}
+
+func (b *DockerBackend) GetEnvironment(session, key string) (string, error) {
+	out, err := b.tmux(session, "show-environment", "-t", dockerTmuxSession, key)
+	if err != nil {
+		return "", err
+	}
+	// Output is "KEY=value", or "-KEY" for a variable removed from the session.
+	_, value, ok := strings.Cut(out, "=")
+	if !ok {
+		return "", fmt.Errorf("docker: env var %q not found", key)
+	}
+	return value, nil
+}
+
+func (b *DockerBackend) GetPaneWorkDir(session string) (string, error) {
+	return b.tmux(session, "display-message", "-p", "-t", dockerTmuxSession, "#{pane_current_path}")
+}
+
+// RespawnPane kills the pane's process and starts a fresh shell in the
+// same container.
+func (b *DockerBackend) RespawnPane(session string) error {
+	_, err := b.tmux(session, "respawn-pane", "-k", "-t", dockerTmuxSession)
+	return err
+}
+
+// SwitchSession stages cfg.ExtraEnv in the tmux session and respawns the
+// pane, so the new shell starts with it.
+func (b *DockerBackend) SwitchSession(session string, cfg SwitchConfig) error {
+	for k, v := range cfg.ExtraEnv {
+		if err := b.SetEnvironment(session, k, v); err != nil {
+			return err
+		}
+	}
+	return b.RespawnPane(session)
+}
+
+// AttachSession attaches to the container's tmux session by execing into
+// `docker exec -it <container> tmux attach`.
+// This replaces the current process — it does not return on success.
+func (b *DockerBackend) AttachSession(session string) error {
+	dockerPath, err := exec.LookPath(b.command)
+	if err != nil {
+		return fmt.Errorf("docker binary not found: %w", err)
+	}
+	return syscall.Exec(dockerPath, []string{b.command, "exec", "-it", b.container(session), "tmux", "attach", "-t", dockerTmuxSession}, os.Environ())
+}
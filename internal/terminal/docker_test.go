@@ -0,0 +1,211 @@
+package terminal
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestDockerBackendImplementsInterface verifies DockerBackend satisfies Backend.
+func TestDockerBackendImplementsInterface(t *testing.T) {
+	var _ Backend = (*DockerBackend)(nil)
+}
+
+// fakeDocker is a docker CLI stand-in that logs each call's arguments and
+// answers inspect, exec and logs from environment variables. Once run has
+// been called, exec works as if the container were running.
+const fakeDocker = `#!/bin/sh
+echo "$@" >> "$FAKE_DOCKER_LOG"
+case "$1" in
+inspect) echo "$FAKE_DOCKER_RUNNING" ;;
+logs) printf 'log 1\nlog 2\nlog 3\n' ;;
+run) touch "$FAKE_DOCKER_LOG.run" ;;
+exec)
+	if [ "$FAKE_DOCKER_RUNNING" != "true" ] && [ ! -e "$FAKE_DOCKER_LOG.run" ]; then
+		echo "Error response from daemon: container $2 is not running" >&2
+		exit 1
+	fi
+	case "$4" in
+	capture-pane) printf 'screen 1\nscreen 2\nscreen 3\n\n\n' ;;
+	display-message) echo "$FAKE_DOCKER_PANE" ;;
+	show-environment) echo "GT_ISSUE=gt-abc" ;;
+	esac ;;
+esac
+`
+
+// newTestDocker returns a DockerBackend running the fake docker CLI, and
+// a func returning the calls it has received.
+func newTestDocker(t *testing.T, running bool) (*DockerBackend, func() []string) {
+	t.Helper()
+	dir := t.TempDir()
+	script := filepath.Join(dir, "docker")
+	if err := os.WriteFile(script, []byte(fakeDocker), 0755); err != nil {
+		t.Fatal(err)
+	}
+	logPath := filepath.Join(dir, "calls.log")
+	t.Setenv("FAKE_DOCKER_LOG", logPath)
+	if running {
+		t.Setenv("FAKE_DOCKER_RUNNING", "true")
+	} else {
+		t.Setenv("FAKE_DOCKER_RUNNING", "false")
+	}
+
+	b := NewDockerBackend(DockerConfig{Command: script})
+	b.AddSession("claude", "gt-gastown-Toast")
+	return b, func() []string {
+		data, _ := os.ReadFile(logPath)
+		return strings.Split(strings.TrimSpace(string(data)), "\n")
+	}
+}
+
+func TestDockerBackend_CapturePane(t *testing.T) {
+	b, calls := newTestDocker(t, true)
+
+	out, err := b.CapturePane("claude", 2)
+	if err != nil {
+		t.Fatalf("CapturePane: %v", err)
+	}
+	if out != "screen 2\nscreen 3" {
+		t.Errorf("CapturePane = %q, want last two screen lines", out)
+	}
+	if got := calls()[0]; got != "exec gt-gastown-Toast tmux capture-pane -p -J -t gt -S -2" {
+		t.Errorf("docker call = %q", got)
+	}
+}
+
+func TestDockerBackend_CapturePaneStoppedUsesLogs(t *testing.T) {
+	b, calls := newTestDocker(t, false)
+
+	out, err := b.CapturePane("claude", 2)
+	if err != nil {
+		t.Fatalf("CapturePane: %v", err)
+	}
+	if !strings.Contains(out, "log 3") {
+		t.Errorf("CapturePane = %q, want docker logs output", out)
+	}
+	if got := calls()[1]; got != "logs --tail 2 gt-gastown-Toast" {
+		t.Errorf("docker call = %q", got)
+	}
+}
+
+func TestDockerBackend_HasSession(t *testing.T) {
+	b, _ := newTestDocker(t, true)
+	if ok, err := b.HasSession("claude"); err != nil || !ok {
+		t.Errorf("HasSession = %v, %v; want true", ok, err)
+	}
+
+	b, _ = newTestDocker(t, false)
+	if ok, err := b.HasSession("claude"); err != nil || ok {
+		t.Errorf("HasSession = %v, %v; want false", ok, err)
+	}
+	if dead, _ := b.IsPaneDead("claude"); !dead {
+		t.Error("stopped container should be a dead pane")
+	}
+}
+
+func TestDockerBackend_SendInput(t *testing.T) {
+	b, calls := newTestDocker(t, true)
+
+	if err := b.SendInput("claude", "claude --resume", true); err != nil {
+		t.Fatalf("SendInput: %v", err)
+	}
+	want := []string{
+		"exec gt-gastown-Toast tmux send-keys -t gt -l claude --resume",
+		"exec gt-gastown-Toast tmux send-keys -t gt Enter",
+	}
+	got := calls()
+	if len(got) != len(want) {
+		t.Fatalf("docker calls = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("call %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDockerBackend_IsAgentRunning(t *testing.T) {
+	tests := []struct {
+		pane  string
+		want  bool
+		state string
+	}{
+		{"claude", true, "running"},
+		{"node", true, "running"},
+		{"bash", false, "exited"},
+		{"sh", false, "exited"},
+	}
+	for _, tt := range tests {
+		b, _ := newTestDocker(t, true)
+		t.Setenv("FAKE_DOCKER_PANE", tt.pane)
+
+		running, err := b.IsAgentRunning("claude")
+		if err != nil {
+			t.Fatalf("IsAgentRunning: %v", err)
+		}
+		if running != tt.want {
+			t.Errorf("IsAgentRunning with pane command %q = %v, want %v", tt.pane, running, tt.want)
+		}
+		if state, _ := b.GetAgentState("claude"); state != tt.state {
+			t.Errorf("GetAgentState with pane command %q = %q, want %q", tt.pane, state, tt.state)
+		}
+	}
+}
+
+func TestDockerBackend_GetEnvironment(t *testing.T) {
+	b, _ := newTestDocker(t, true)
+
+	val, err := b.GetEnvironment("claude", "GT_ISSUE")
+	if err != nil {
+		t.Fatalf("GetEnvironment: %v", err)
+	}
+	if val != "gt-abc" {
+		t.Errorf("GetEnvironment = %q, want %q", val, "gt-abc")
+	}
+}
+
+func TestDockerBackend_StartContainerNeedsImage(t *testing.T) {
+	b, _ := newTestDocker(t, false)
+	if err := b.StartContainer("claude", DockerRunOptions{}); err == nil {
+		t.Error("StartContainer without an image should fail")
+	}
+}
+
+func TestDockerBackend_StartContainer(t *testing.T) {
+	b, calls := newTestDocker(t, false)
+	err := b.StartContainer("claude", DockerRunOptions{
+		Image:   "gastown/agent",
+		WorkDir: "/town/gastown/polecats/Toast/gastown",
+		Mounts:  []string{"/town:/town"},
+		Env:     map[string]string{"B": "2", "A": "1"},
+		User:    "1000:1000",
+	})
+	if err != nil {
+		t.Fatalf("StartContainer: %v", err)
+	}
+
+	got := calls()
+	if len(got) != 4 {
+		t.Fatalf("docker calls = %q, want inspect, rm, run, has-session", got)
+	}
+	if got[1] != "rm -f gt-gastown-Toast" {
+		t.Errorf("expected stale container removal, got %q", got[1])
+	}
+	want := "run -d -t --name gt-gastown-Toast --label gastown.session=claude --user 1000:1000 " +
+		"-w /town/gastown/polecats/Toast/gastown -v /town:/town -e A=1 -e B=2 " +
+		"gastown/agent tmux new-session -s gt -x 200 -y 50"
+	if got[2] != want {
+		t.Errorf("docker run = %q\nwant %q", got[2], want)
+	}
+	if got[3] != "exec gt-gastown-Toast tmux has-session -t gt" {
+		t.Errorf("expected tmux readiness check, got %q", got[3])
+	}
+}
+
+func TestDockerBackend_StartContainerAlreadyRunning(t *testing.T) {
+	b, _ := newTestDocker(t, true)
+	if err := b.StartContainer("claude", DockerRunOptions{Image: "gastown/agent"}); err == nil {
+		t.Error("StartContainer should refuse to replace a running container")
+	}
+}
@@ -0,0 +1,278 @@
+package terminal
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MaxHubLines bounds each session's shared output buffer in an OutputHub.
+const MaxHubLines = 1000
+
+// OutputHub shares one capture loop per session among all of the session's
+// watchers (daemon, dashboard, mobile clients), so N watchers cost one
+// HasSession and CapturePane per poll instead of N.
+//
+// Each session's output is kept in a buffer of at most MaxHubLines lines,
+// oldest dropped first. Watchers read it at their own pace: one that falls
+// behind, or asks for a slower interval, skips intermediate captures and
+// diffs straight to the latest rather than queueing them, so a slow client
+// never holds up the others or the capture loop.
+type OutputHub struct {
+	backend Backend
+
+	mu       sync.Mutex
+	sessions map[string]*hubSession
+}
+
+// hubSession is one watched session. Fields below mu are guarded by the
+// hub's mu.
+type hubSession struct {
+	name  string
+	capMu sync.Mutex    // serializes captures so they apply in order
+	ready chan struct{} // closed after the first capture
+	stop  chan struct{} // closed when the last subscriber leaves
+	wake  chan struct{} // the poll interval shrank
+
+	subs     map[*OutputSubscription]struct{}
+	window   int           // capture window: the largest any subscriber asked for
+	interval time.Duration // poll interval: the shortest any subscriber asked for
+
+	lines   []string // output buffer, newest last
+	lastCap int      // lines in the most recent capture (the tail of lines)
+	exists  bool
+	err     error     // error from the last poll; cleared by a good one
+	version uint64    // bumped whenever lines or exists change
+	at      time.Time // when the last poll ran
+}
+
+// NewOutputHub creates a hub that captures through backend.
+func NewOutputHub(backend Backend) *OutputHub {
+	return &OutputHub{
+		backend:  backend,
+		sessions: make(map[string]*hubSession),
+	}
+}
+
+// OutputSnapshot is a session's output as of the hub's latest poll.
+type OutputSnapshot struct {
+	Lines   []string // The last window lines of output, newest last
+	Exists  bool
+	Err     error // Set if the last poll failed; Lines and Exists are from the poll before
+	Time    time.Time
+	Version uint64 // Increases with every change to Lines or Exists
+}
+
+// OutputSubscription is one watcher of a session in an OutputHub.
+type OutputSubscription struct {
+	hub      *OutputHub
+	hs       *hubSession
+	window   int
+	interval time.Duration
+
+	seen    uint64 // hub version returned by the previous Latest
+	skipped uint64 // versions coalesced away because the watcher was behind
+	closed  bool
+}
+
+// Subscribe starts watching a session, capturing window lines every
+// interval. The first subscriber of a session, or one wanting a larger
+// window than the session has, captures immediately; Subscribe returns once
+// the session has been captured at least once.
+func (h *OutputHub) Subscribe(ctx context.Context, session string, window int, interval time.Duration) (*OutputSubscription, error) {
+	window = min(max(window, 1), MaxHubLines)
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	h.mu.Lock()
+	hs := h.sessions[session]
+	created := hs == nil
+	if created {
+		hs = &hubSession{
+			name:     session,
+			ready:    make(chan struct{}),
+			stop:     make(chan struct{}),
+			wake:     make(chan struct{}, 1),
+			subs:     make(map[*OutputSubscription]struct{}),
+			window:   window,
+			interval: interval,
+		}
+		h.sessions[session] = hs
+	}
+	sub := &OutputSubscription{hub: h, hs: hs, window: window, interval: interval}
+	hs.subs[sub] = struct{}{}
+	grew := window > hs.window
+	if grew {
+		hs.window = window
+	}
+	if interval < hs.interval {
+		hs.interval = interval
+		select {
+		case hs.wake <- struct{}{}:
+		default:
+		}
+	}
+	h.mu.Unlock()
+
+	if created {
+		h.poll(hs)
+		close(hs.ready)
+		go h.run(hs)
+		return sub, nil
+	}
+
+	select {
+	case <-hs.ready:
+	case <-ctx.Done():
+		sub.Close()
+		return nil, ctx.Err()
+	}
+	if grew {
+		h.poll(hs)
+	}
+	return sub, nil
+}
+
+// Interval returns how often the subscriber asked to be updated.
+func (s *OutputSubscription) Interval() time.Duration {
+	return s.interval
+}
+
+// Latest returns the session's current output and whether it changed since
+// the previous call. Changes the subscriber didn't call Latest in time to
+// see individually are coalesced into this one and counted by Skipped.
+func (s *OutputSubscription) Latest() (OutputSnapshot, bool) {
+	h, hs := s.hub, s.hs
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	snap := OutputSnapshot{
+		Lines:   append([]string(nil), hs.lines[max(len(hs.lines)-s.window, 0):]...),
+		Exists:  hs.exists,
+		Err:     hs.err,
+		Time:    hs.at,
+		Version: hs.version,
+	}
+	changed := hs.version != s.seen
+	if changed && s.seen > 0 && hs.version > s.seen+1 {
+		s.skipped += hs.version - s.seen - 1
+	}
+	s.seen = hs.version
+	return snap, changed
+}
+
+// Skipped returns how many changes were coalesced away because the
+// subscriber read them too slowly.
+func (s *OutputSubscription) Skipped() uint64 {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	return s.skipped
+}
+
+// Close stops the subscription. The session's capture loop stops with its
+// last subscriber.
+func (s *OutputSubscription) Close() {
+	h, hs := s.hub, s.hs
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	delete(hs.subs, s)
+
+	if len(hs.subs) == 0 {
+		delete(h.sessions, hs.name)
+		close(hs.stop)
+		return
+	}
+	hs.window, hs.interval = 0, 0
+	for sub := range hs.subs {
+		hs.window = max(hs.window, sub.window)
+		if hs.interval == 0 || sub.interval < hs.interval {
+			hs.interval = sub.interval
+		}
+	}
+}
+
+// Watchers returns how many subscribers are watching a session.
+func (h *OutputHub) Watchers(session string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if hs := h.sessions[session]; hs != nil {
+		return len(hs.subs)
+	}
+	return 0
+}
+
+// run polls a session until its last subscriber leaves.
+func (h *OutputHub) run(hs *hubSession) {
+	for {
+		h.mu.Lock()
+		interval := hs.interval
+		h.mu.Unlock()
+
+		t := time.NewTimer(interval)
+		select {
+		case <-hs.stop:
+			t.Stop()
+			return
+		case <-hs.wake:
+			t.Stop()
+			continue
+		case <-t.C:
+		}
+		h.poll(hs)
+	}
+}
+
+// poll captures a session once and folds the capture into its buffer.
+func (h *OutputHub) poll(hs *hubSession) {
+	hs.capMu.Lock()
+	defer hs.capMu.Unlock()
+
+	h.mu.Lock()
+	window := hs.window
+	h.mu.Unlock()
+
+	exists, err := h.backend.HasSession(hs.name)
+	var output string
+	if err == nil && exists {
+		output, err = h.backend.CapturePane(hs.name, window)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	hs.at = time.Now()
+	hs.err = err
+	if err != nil {
+		return
+	}
+
+	if !exists {
+		if hs.exists || hs.version == 0 {
+			hs.exists = false
+			hs.version++
+		}
+		return
+	}
+
+	cur := SplitCapture(output)
+	var delta OutputDelta
+	if hs.exists {
+		delta = DiffCapture(hs.lines[len(hs.lines)-hs.lastCap:], cur)
+	} else {
+		delta = OutputDelta{Lines: cur, Reset: true}
+	}
+	hs.lastCap = len(cur)
+	if delta.Empty() && hs.exists {
+		return
+	}
+	hs.exists = true
+	hs.lines = ApplyDelta(hs.lines, delta)
+	if over := len(hs.lines) - MaxHubLines; over > 0 {
+		hs.lines = append([]string(nil), hs.lines[over:]...)
+	}
+	hs.version++
+}
@@ -0,0 +1,209 @@
+package terminal
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// hubBackend is a Backend whose sessions print whatever the test sets,
+// counting captures.
+type hubBackend struct {
+	Backend
+
+	mu       sync.Mutex
+	output   map[string][]string
+	captures int
+}
+
+func newHubBackend() *hubBackend {
+	return &hubBackend{output: make(map[string][]string)}
+}
+
+func (b *hubBackend) set(session string, lines ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.output[session] = lines
+}
+
+func (b *hubBackend) remove(session string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.output, session)
+}
+
+func (b *hubBackend) captureCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.captures
+}
+
+func (b *hubBackend) HasSession(session string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.output[session]
+	return ok, nil
+}
+
+func (b *hubBackend) CapturePane(session string, lines int) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.captures++
+	out := b.output[session]
+	return strings.Join(out[max(len(out)-lines, 0):], "\n"), nil
+}
+
+// waitVersion polls sub until the hub has a version past v.
+func waitVersion(t *testing.T, sub *OutputSubscription, v uint64) OutputSnapshot {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		sub.hub.mu.Lock()
+		cur := sub.hs.version
+		sub.hub.mu.Unlock()
+		if cur > v {
+			snap, _ := sub.Latest()
+			return snap
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("no new output after version %d", v)
+	return OutputSnapshot{}
+}
+
+func TestOutputHubSharesCaptureLoop(t *testing.T) {
+	b := newHubBackend()
+	b.set("gt-a", "one", "two")
+	hub := NewOutputHub(b)
+	ctx := context.Background()
+
+	first, err := hub.Subscribe(ctx, "gt-a", 10, time.Hour)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	second, err := hub.Subscribe(ctx, "gt-a", 10, time.Hour)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	if got := b.captureCount(); got != 1 {
+		t.Errorf("captures after two subscribers = %d, want 1", got)
+	}
+	if got := hub.Watchers("gt-a"); got != 2 {
+		t.Errorf("Watchers = %d, want 2", got)
+	}
+
+	for _, sub := range []*OutputSubscription{first, second} {
+		snap, changed := sub.Latest()
+		if !changed || !snap.Exists || strings.Join(snap.Lines, ",") != "one,two" {
+			t.Errorf("Latest = %+v, changed=%v", snap, changed)
+		}
+		if _, changed := sub.Latest(); changed {
+			t.Error("Latest reported a change twice")
+		}
+	}
+
+	first.Close()
+	first.Close() // idempotent
+	if got := hub.Watchers("gt-a"); got != 1 {
+		t.Errorf("Watchers after Close = %d, want 1", got)
+	}
+	second.Close()
+	if got := hub.Watchers("gt-a"); got != 0 {
+		t.Errorf("Watchers after last Close = %d, want 0", got)
+	}
+}
+
+func TestOutputHubBuffersBeyondWindow(t *testing.T) {
+	b := newHubBackend()
+	b.set("gt-a", "1", "2", "3")
+	hub := NewOutputHub(b)
+
+	sub, err := hub.Subscribe(context.Background(), "gt-a", 3, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer sub.Close()
+	snap, _ := sub.Latest()
+
+	b.set("gt-a", "1", "2", "3", "4", "5")
+	snap = waitVersion(t, sub, snap.Version)
+	if got := strings.Join(snap.Lines, ","); got != "3,4,5" {
+		t.Errorf("window = %q, want 3,4,5", got)
+	}
+
+	// A later subscriber can backfill more than the first one's window from
+	// the shared buffer.
+	wide, err := hub.Subscribe(context.Background(), "gt-a", 100, time.Hour)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer wide.Close()
+	snap, _ = wide.Latest()
+	if got := strings.Join(snap.Lines, ","); got != "1,2,3,4,5" {
+		t.Errorf("wide window = %q, want 1,2,3,4,5", got)
+	}
+}
+
+func TestOutputHubCoalescesSlowSubscriber(t *testing.T) {
+	b := newHubBackend()
+	b.set("gt-a", "a")
+	hub := NewOutputHub(b)
+
+	fast, err := hub.Subscribe(context.Background(), "gt-a", 50, 5*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer fast.Close()
+	slow, err := hub.Subscribe(context.Background(), "gt-a", 50, time.Hour)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer slow.Close()
+	snap, _ := slow.Latest()
+
+	// Three changes land while the slow subscriber isn't reading.
+	lines := []string{"a"}
+	v := snap.Version
+	for _, l := range []string{"b", "c", "d"} {
+		lines = append(lines, l)
+		b.set("gt-a", lines...)
+		v = waitVersion(t, fast, v).Version
+	}
+
+	snap, changed := slow.Latest()
+	if !changed || strings.Join(snap.Lines, ",") != "a,b,c,d" {
+		t.Errorf("slow Latest = %v, changed=%v", snap.Lines, changed)
+	}
+	if got := slow.Skipped(); got != 2 {
+		t.Errorf("Skipped = %d, want 2", got)
+	}
+}
+
+func TestOutputHubSessionGone(t *testing.T) {
+	b := newHubBackend()
+	hub := NewOutputHub(b)
+
+	sub, err := hub.Subscribe(context.Background(), "gt-missing", 10, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer sub.Close()
+	if snap, changed := sub.Latest(); !changed || snap.Exists {
+		t.Errorf("missing session: Latest = %+v, changed=%v", snap, changed)
+	}
+
+	b.set("gt-a", "x")
+	live, err := hub.Subscribe(context.Background(), "gt-a", 10, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer live.Close()
+	snap, _ := live.Latest()
+
+	b.remove("gt-a")
+	if snap = waitVersion(t, live, snap.Version); snap.Exists {
+		t.Error("session still reported as existing after removal")
+	}
+}
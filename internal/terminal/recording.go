@@ -0,0 +1,232 @@
+package terminal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// recordingVersion is the version written in recording headers.
+const recordingVersion = 1
+
+// recordingIDFormat names recording files after their start time (UTC), so
+// a session's recordings sort oldest first.
+const recordingIDFormat = "20060102T150405Z"
+
+// ErrNoRecording is returned when a session has no recordings, or none
+// with the requested ID.
+var ErrNoRecording = errors.New("no recording found")
+
+// RecordingHeader is the first line of a recording file.
+type RecordingHeader struct {
+	Version int       `json:"version"`
+	Agent   string    `json:"agent"`
+	Session string    `json:"session"`
+	Started time.Time `json:"started"`
+}
+
+// RecordingFrame is one change to a session's output: the OutputDelta
+// between two captures, and when the later capture was taken.
+type RecordingFrame struct {
+	// Offset is seconds since the recording started.
+	Offset float64  `json:"t"`
+	Drop   int      `json:"drop,omitempty"`
+	Lines  []string `json:"lines,omitempty"`
+	Reset  bool     `json:"reset,omitempty"`
+}
+
+// Delta returns the frame's change as an OutputDelta.
+func (f RecordingFrame) Delta() OutputDelta {
+	return OutputDelta{Drop: f.Drop, Lines: f.Lines, Reset: f.Reset}
+}
+
+// Recording is a recorded session: a header line followed by one JSON frame
+// per line, in the spirit of asciinema's asciicast files but holding line
+// deltas of pane captures rather than raw terminal output.
+type Recording struct {
+	RecordingHeader
+
+	// ID identifies the recording among its session's recordings.
+	ID string `json:"id"`
+
+	Frames []RecordingFrame `json:"frames"`
+}
+
+// FrameTime returns when frame f was captured.
+func (r *Recording) FrameTime(f RecordingFrame) time.Time {
+	return r.Started.Add(time.Duration(f.Offset * float64(time.Second)))
+}
+
+// ScreenAt returns the session's output as of t: every frame captured at
+// or before t, applied in order.
+func (r *Recording) ScreenAt(t time.Time) []string {
+	var buf []string
+	for _, f := range r.Frames {
+		if r.FrameTime(f).After(t) {
+			break
+		}
+		buf = ApplyDelta(buf, f.Delta())
+	}
+	return buf
+}
+
+// End returns when the last frame was captured.
+func (r *Recording) End() time.Time {
+	if len(r.Frames) == 0 {
+		return r.Started
+	}
+	return r.FrameTime(r.Frames[len(r.Frames)-1])
+}
+
+// ApplyDelta applies d to buf the way a client applies a stream of deltas:
+// replace it on reset, otherwise drop trailing lines and append.
+func ApplyDelta(buf []string, d OutputDelta) []string {
+	if d.Reset {
+		return append([]string(nil), d.Lines...)
+	}
+	buf = buf[:max(len(buf)-d.Drop, 0)]
+	return append(buf, d.Lines...)
+}
+
+// RecordingsDir returns where a town keeps session recordings, one
+// directory per session.
+func RecordingsDir(townRoot string) string {
+	return filepath.Join(townRoot, ".runtime", "recordings")
+}
+
+// Recorder appends a session's output changes to a recording file.
+type Recorder struct {
+	f       *os.File
+	started time.Time
+	prev    []string
+}
+
+// StartRecording creates a new recording for a session, removing the
+// session's oldest recordings so at most keep remain including the new one
+// (keep <= 0 keeps them all).
+func StartRecording(townRoot, agent, session string, keep int, now time.Time) (*Recorder, error) {
+	dir := filepath.Join(RecordingsDir(townRoot), session)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("creating recordings dir: %w", err)
+	}
+	if keep > 0 {
+		if ids, err := ListRecordings(townRoot, session); err == nil && len(ids) >= keep {
+			for _, id := range ids[:len(ids)-keep+1] {
+				_ = os.Remove(filepath.Join(dir, id+".jsonl"))
+			}
+		}
+	}
+
+	now = now.UTC().Truncate(time.Second)
+	f, err := os.Create(filepath.Join(dir, now.Format(recordingIDFormat)+".jsonl"))
+	if err != nil {
+		return nil, fmt.Errorf("creating recording: %w", err)
+	}
+	r := &Recorder{f: f, started: now}
+	if err := r.write(RecordingHeader{Version: recordingVersion, Agent: agent, Session: session, Started: now}); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// Record diffs a capture taken at t against the previous one and appends
+// the change, if there is one.
+func (r *Recorder) Record(capture string, t time.Time) error {
+	cur := SplitCapture(capture)
+	delta := DiffCapture(r.prev, cur)
+	r.prev = cur
+	if delta.Empty() {
+		return nil
+	}
+	return r.write(RecordingFrame{
+		Offset: t.Sub(r.started).Round(time.Millisecond).Seconds(),
+		Drop:   delta.Drop,
+		Lines:  delta.Lines,
+		Reset:  delta.Reset,
+	})
+}
+
+// write appends one JSON line. Lines go straight to the file, so a
+// recording is readable up to the moment its recorder stopped.
+func (r *Recorder) write(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = r.f.Write(append(data, '\n'))
+	return err
+}
+
+// Close ends the recording.
+func (r *Recorder) Close() error {
+	return r.f.Close()
+}
+
+// ListRecordings returns the IDs of a session's recordings, oldest first.
+func ListRecordings(townRoot, session string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(RecordingsDir(townRoot), session))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var ids []string
+	for _, e := range entries {
+		if id, ok := strings.CutSuffix(e.Name(), ".jsonl"); ok && !e.IsDir() {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// LoadRecording reads one of a session's recordings; an empty id reads the
+// latest. A frame cut short by a crash ends the recording rather than
+// failing it.
+func LoadRecording(townRoot, session, id string) (*Recording, error) {
+	if id == "" {
+		ids, err := ListRecordings(townRoot, session)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return nil, fmt.Errorf("%w for %s", ErrNoRecording, session)
+		}
+		id = ids[len(ids)-1]
+	}
+
+	f, err := os.Open(filepath.Join(RecordingsDir(townRoot), session, filepath.Base(id)+".jsonl"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w for %s with ID %s", ErrNoRecording, session, id)
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	rec := &Recording{ID: id}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	if !scanner.Scan() {
+		return nil, fmt.Errorf("recording %s/%s is empty", session, id)
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &rec.RecordingHeader); err != nil {
+		return nil, fmt.Errorf("parsing recording header: %w", err)
+	}
+	for scanner.Scan() {
+		var frame RecordingFrame
+		if err := json.Unmarshal(scanner.Bytes(), &frame); err != nil {
+			break
+		}
+		rec.Frames = append(rec.Frames, frame)
+	}
+	return rec, nil
+}
@@ -0,0 +1,114 @@
+package terminal
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRecording_RoundTrip(t *testing.T) {
+	town := t.TempDir()
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	rec, err := StartRecording(town, "gastown/polecats/Toast", "gt-gastown-Toast", 0, start)
+	if err != nil {
+		t.Fatalf("StartRecording: %v", err)
+	}
+	captures := []string{"a\nb", "a\nb", "a\nb\nc", "a\nB\nc", "x"}
+	for i, c := range captures {
+		if err := rec.Record(c, start.Add(time.Duration(i+1)*time.Second)); err != nil {
+			t.Fatalf("Record: %v", err)
+		}
+	}
+	if err := rec.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	got, err := LoadRecording(town, "gt-gastown-Toast", "")
+	if err != nil {
+		t.Fatalf("LoadRecording: %v", err)
+	}
+	if got.ID != "20260102T030405Z" || got.Agent != "gastown/polecats/Toast" || !got.Started.Equal(start) {
+		t.Errorf("header = %+v, id %q", got.RecordingHeader, got.ID)
+	}
+	// The repeated capture records nothing.
+	if len(got.Frames) != 4 {
+		t.Fatalf("got %d frames, want 4: %+v", len(got.Frames), got.Frames)
+	}
+
+	tests := []struct {
+		at   time.Duration
+		want []string
+	}{
+		{0, nil},
+		{1 * time.Second, []string{"a", "b"}},
+		{2500 * time.Millisecond, []string{"a", "b"}},
+		{3 * time.Second, []string{"a", "b", "c"}},
+		{4 * time.Second, []string{"a", "B", "c"}},
+		{time.Hour, []string{"x"}},
+	}
+	for _, tt := range tests {
+		if screen := got.ScreenAt(start.Add(tt.at)); !reflect.DeepEqual(screen, tt.want) {
+			t.Errorf("ScreenAt(+%v) = %q, want %q", tt.at, screen, tt.want)
+		}
+	}
+	if end := got.End(); !end.Equal(start.Add(5 * time.Second)) {
+		t.Errorf("End = %v, want +5s", end)
+	}
+}
+
+func TestStartRecording_PrunesOldest(t *testing.T) {
+	town := t.TempDir()
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		rec, err := StartRecording(town, "mayor", "hq-mayor", 2, start.Add(time.Duration(i)*time.Minute))
+		if err != nil {
+			t.Fatalf("StartRecording: %v", err)
+		}
+		_ = rec.Close()
+	}
+
+	ids, err := ListRecordings(town, "hq-mayor")
+	if err != nil {
+		t.Fatalf("ListRecordings: %v", err)
+	}
+	want := []string{"20260102T030605Z", "20260102T030705Z"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("ListRecordings = %v, want %v", ids, want)
+	}
+}
+
+func TestLoadRecording_TruncatedFrame(t *testing.T) {
+	town := t.TempDir()
+	dir := filepath.Join(RecordingsDir(town), "hq-mayor")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := `{"version":1,"agent":"mayor","session":"hq-mayor","started":"2026-01-02T03:04:05Z"}
+{"t":1,"lines":["hello"]}
+{"t":2,"li`
+	if err := os.WriteFile(filepath.Join(dir, "20260102T030405Z.jsonl"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err := LoadRecording(town, "hq-mayor", "20260102T030405Z")
+	if err != nil {
+		t.Fatalf("LoadRecording: %v", err)
+	}
+	if len(rec.Frames) != 1 {
+		t.Errorf("got %d frames, want 1", len(rec.Frames))
+	}
+}
+
+func TestLoadRecording_Missing(t *testing.T) {
+	town := t.TempDir()
+	if _, err := LoadRecording(town, "hq-mayor", ""); !errors.Is(err, ErrNoRecording) {
+		t.Errorf("err = %v, want ErrNoRecording", err)
+	}
+	if _, err := LoadRecording(town, "hq-mayor", "20260102T030405Z"); !errors.Is(err, ErrNoRecording) {
+		t.Errorf("err = %v, want ErrNoRecording", err)
+	}
+}
@@ -35,8 +35,8 @@ func discoverTownRoot() string {
 
 // resolveRigAgent tries to resolve a rig/name agent by constructing the
 // proper bead ID using the rig's configured prefix from rigs.json.
-// Returns the resolved coop config, or nil if not found.
-func resolveRigAgent(rigName, agentName string) *coopResolvedConfig {
+// Returns the agent's backend, or nil if not found.
+func resolveRigAgent(rigName, agentName string) Backend {
 	townRoot := discoverTownRoot()
 	if townRoot == "" {
 		return nil
@@ -51,9 +51,8 @@ func resolveRigAgent(rigName, agentName string) *coopResolvedConfig {
 		agentBeadID(prefix, rigName, "crew", agentName),
 	}
 	for _, beadID := range candidates {
-		cfg, err := resolveCoopConfig(beadID)
-		if err == nil && cfg != nil {
-			return cfg
+		if b := resolveAgentBackend(beadID); b != nil {
+			return b
 		}
 	}
 	return nil
@@ -77,11 +76,12 @@ func agentBeadID(prefix, rig, role, name string) string {
 }
 
 // ResolveBackend returns the appropriate Backend for the given agent.
-// All agents are Coop-backed in the K8s-only architecture.
+// Agents are Coop-backed in K8s, or Docker-backed when their rig runs
+// them in local containers.
 //
 // The agentID follows the standard format: "rig/polecat" or "rig/crew/name".
 // Backend detection checks the agent bead for a "backend" field set by
-// the K8s pod manager or Coop sidecar deployment.
+// the K8s pod manager, Coop sidecar deployment, or container start.
 //
 // Bare names (e.g., "nux") are resolved by searching all agent beads for
 // matching polecat or crew suffixes.
@@ -95,11 +95,7 @@ func ResolveBackend(agentID string) Backend {
 	}
 
 	for _, id := range candidates {
-		// Check if agent has Coop backend metadata
-		coopCfg, err := resolveCoopConfig(id)
-		if err == nil && coopCfg != nil {
-			b := NewCoopBackend(coopCfg.CoopConfig)
-			b.AddSession("claude", coopCfg.baseURL)
+		if b := resolveAgentBackend(id); b != nil {
 			return b
 		}
 	}
@@ -115,9 +111,7 @@ func ResolveBackend(agentID string) Backend {
 			rigName, agentName = parts[0], parts[2]
 		}
 		if rigName != "" && agentName != "" {
-			if cfg := resolveRigAgent(rigName, agentName); cfg != nil {
-				b := NewCoopBackend(cfg.CoopConfig)
-				b.AddSession("claude", cfg.baseURL)
+			if b := resolveRigAgent(rigName, agentName); b != nil {
 				return b
 			}
 		}
@@ -126,10 +120,7 @@ func ResolveBackend(agentID string) Backend {
 	// For bare names, search agent beads by name suffix.
 	if isBare {
 		if beadID := findAgentBeadByName(agentID); beadID != "" {
-			coopCfg, err := resolveCoopConfig(beadID)
-			if err == nil && coopCfg != nil {
-				b := NewCoopBackend(coopCfg.CoopConfig)
-				b.AddSession("claude", coopCfg.baseURL)
+			if b := resolveAgentBackend(beadID); b != nil {
 				return b
 			}
 		}
@@ -140,6 +131,50 @@ func ResolveBackend(agentID string) Backend {
 	return NewCoopBackend(CoopConfig{})
 }
 
+// resolveAgentBackend builds the backend an agent bead's metadata
+// describes, with the agent's session registered as "claude". Returns nil
+// if the bead can't be read or names no backend.
+func resolveAgentBackend(agentID string) Backend {
+	notes, err := getAgentNotes(agentID)
+	if err != nil {
+		return nil
+	}
+	if container := parseDockerContainer(notes); container != "" {
+		b := NewDockerBackend(DockerConfig{})
+		b.AddSession("claude", container)
+		return b
+	}
+	coopCfg, err := parseCoopConfig(notes)
+	if err != nil || coopCfg == nil {
+		return nil
+	}
+	b := NewCoopBackend(coopCfg.CoopConfig)
+	b.AddSession("claude", coopCfg.baseURL)
+	return b
+}
+
+// parseDockerContainer returns the docker_container named by bead metadata
+// with "backend: docker", or "" for other backends.
+func parseDockerContainer(notes string) string {
+	var backend, container string
+	for _, line := range strings.Split(notes, "\n") {
+		key, val, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "backend":
+			backend = strings.TrimSpace(val)
+		case "docker_container":
+			container = strings.TrimSpace(val)
+		}
+	}
+	if backend != "docker" {
+		return ""
+	}
+	return container
+}
+
 // resolveCoopConfig checks agent bead metadata for Coop sidecar configuration.
 // Returns nil if the agent doesn't use Coop.
 func resolveCoopConfig(agentID string) (*coopResolvedConfig, error) {
@@ -214,3 +214,22 @@ func TestRoutingDispatch_LocalBackendNotes(t *testing.T) {
 		t.Error("local notes should not produce a coop config")
 	}
 }
+
+func TestParseDockerContainer(t *testing.T) {
+	tests := []struct {
+		notes string
+		want  string
+	}{
+		{"backend: docker\ndocker_container: gt-gastown-Toast", "gt-gastown-Toast"},
+		// A rig named "coop" must not make container notes look like Coop's.
+		{"backend: docker\ndocker_container: gt-coop-Toast", "gt-coop-Toast"},
+		{"backend: coop\ncoop_url: http://localhost:8080", ""},
+		{"docker_container: gt-gastown-Toast", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := parseDockerContainer(tt.notes); got != tt.want {
+			t.Errorf("parseDockerContainer(%q) = %q, want %q", tt.notes, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package terminal
+
+import "regexp"
+
+// SearchMatch is one line of captured output that matched a search, with
+// the lines around it.
+type SearchMatch struct {
+	// Line is the matching line's number in the capture, counting from 1.
+	Line int
+
+	// Start is the line number of Context[0].
+	Start int
+
+	// Context is the matching line with up to the requested number of
+	// lines before and after it.
+	Context []string
+}
+
+// SearchLines returns the lines that match re, each with up to before
+// lines preceding it and after lines following it, like grep -B/-A.
+// At most maxMatches are returned (maxMatches <= 0 means no limit);
+// truncated reports whether more matched.
+func SearchLines(lines []string, re *regexp.Regexp, before, after, maxMatches int) (matches []SearchMatch, truncated bool) {
+	before, after = max(before, 0), max(after, 0)
+	for i, line := range lines {
+		if !re.MatchString(line) {
+			continue
+		}
+		if maxMatches > 0 && len(matches) == maxMatches {
+			return matches, true
+		}
+		start := max(i-before, 0)
+		end := min(i+after+1, len(lines))
+		matches = append(matches, SearchMatch{
+			Line:    i + 1,
+			Start:   start + 1,
+			Context: append([]string(nil), lines[start:end]...),
+		})
+	}
+	return matches, false
+}
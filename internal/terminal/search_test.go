@@ -0,0 +1,39 @@
+package terminal
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestSearchLines(t *testing.T) {
+	lines := []string{"ok 1", "FAIL a", "ok 2", "ok 3", "FAIL b", "ok 4"}
+	re := regexp.MustCompile(`^FAIL`)
+
+	matches, truncated := SearchLines(lines, re, 1, 1, 0)
+	if truncated {
+		t.Error("unlimited search should not be truncated")
+	}
+	want := []SearchMatch{
+		{Line: 2, Start: 1, Context: []string{"ok 1", "FAIL a", "ok 2"}},
+		{Line: 5, Start: 4, Context: []string{"ok 3", "FAIL b", "ok 4"}},
+	}
+	if !reflect.DeepEqual(matches, want) {
+		t.Errorf("SearchLines = %+v, want %+v", matches, want)
+	}
+
+	// Context is clipped at the edges of the capture.
+	matches, _ = SearchLines(lines, regexp.MustCompile(`ok 1|ok 4`), 3, 3, 0)
+	if len(matches) != 2 || matches[0].Start != 1 || len(matches[0].Context) != 4 || len(matches[1].Context) != 4 {
+		t.Errorf("edge context = %+v", matches)
+	}
+
+	matches, truncated = SearchLines(lines, re, 0, 0, 1)
+	if !truncated || len(matches) != 1 || matches[0].Line != 2 {
+		t.Errorf("max 1: matches = %+v, truncated = %v", matches, truncated)
+	}
+
+	if matches, _ := SearchLines(lines, regexp.MustCompile(`panic`), 2, 2, 10); matches != nil {
+		t.Errorf("no match should return nil, got %+v", matches)
+	}
+}
@@ -0,0 +1,172 @@
+package towndeploy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// runFunc runs a command with optional stdin and returns its combined output.
+type runFunc func(ctx context.Context, stdin []byte, name string, args ...string) ([]byte, error)
+
+func execRun(ctx context.Context, stdin []byte, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...) //nolint:gosec // G204: kubectl/helm with constructed args
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		msg := strings.TrimSpace(string(out))
+		if msg == "" {
+			return out, fmt.Errorf("%s %s: %w", name, args[0], err)
+		}
+		return out, fmt.Errorf("%s %s: %s", name, args[0], msg)
+	}
+	return out, nil
+}
+
+// Kubectl runs kubectl against one cluster and namespace.
+type Kubectl struct {
+	Kubeconfig string // Empty uses kubectl's default
+	Context    string // Empty uses the kubeconfig's current context
+	Namespace  string
+
+	run runFunc
+}
+
+// NewKubectl creates a Kubectl for a namespace.
+func NewKubectl(kubeconfig, kubeContext, namespace string) *Kubectl {
+	return &Kubectl{
+		Kubeconfig: kubeconfig,
+		Context:    kubeContext,
+		Namespace:  namespace,
+		run:        execRun,
+	}
+}
+
+func (k *Kubectl) args(args ...string) []string {
+	var out []string
+	if k.Kubeconfig != "" {
+		out = append(out, "--kubeconfig", k.Kubeconfig)
+	}
+	if k.Context != "" {
+		out = append(out, "--context", k.Context)
+	}
+	if k.Namespace != "" {
+		out = append(out, "--namespace", k.Namespace)
+	}
+	return append(out, args...)
+}
+
+func (k *Kubectl) kubectl(ctx context.Context, stdin []byte, args ...string) ([]byte, error) {
+	return k.run(ctx, stdin, "kubectl", k.args(args...)...)
+}
+
+// Apply applies a manifest stream with server-side apply.
+func (k *Kubectl) Apply(ctx context.Context, manifest string) error {
+	_, err := k.kubectl(ctx, []byte(manifest), "apply", "--server-side", "--field-manager=gt", "-f", "-")
+	return err
+}
+
+// MissingSecrets returns which of names don't exist in the namespace. A
+// namespace that doesn't exist yet has no secrets.
+func (k *Kubectl) MissingSecrets(ctx context.Context, names []string) ([]string, error) {
+	out, err := k.kubectl(ctx, nil, "get", "secrets", "-o", "name")
+	if err != nil {
+		return nil, err
+	}
+	have := make(map[string]bool)
+	for _, line := range strings.Split(string(out), "\n") {
+		if name, ok := strings.CutPrefix(strings.TrimSpace(line), "secret/"); ok {
+			have[name] = true
+		}
+	}
+	var missing []string
+	for _, n := range names {
+		if !have[n] {
+			missing = append(missing, n)
+		}
+	}
+	return missing, nil
+}
+
+// WaitRollout waits for a Deployment, StatefulSet or DaemonSet to finish
+// rolling out.
+func (k *Kubectl) WaitRollout(ctx context.Context, obj Object, timeout time.Duration) error {
+	_, err := k.kubectl(ctx, nil, "rollout", "status", obj.String(), "--timeout", timeout.String())
+	return err
+}
+
+// DeleteJob deletes a Job and its pods, if it exists. Jobs are immutable,
+// so a rerun must delete the previous one first.
+func (k *Kubectl) DeleteJob(ctx context.Context, name string) error {
+	_, err := k.kubectl(ctx, nil, "delete", "job", name, "--ignore-not-found", "--wait=true")
+	return err
+}
+
+// WaitJob waits for a Job to complete.
+func (k *Kubectl) WaitJob(ctx context.Context, name string, timeout time.Duration) error {
+	_, err := k.kubectl(ctx, nil, "wait", "--for=condition=complete", "job/"+name, "--timeout", timeout.String())
+	return err
+}
+
+// JobLogs returns the last lines of a Job's output, for reporting failures.
+func (k *Kubectl) JobLogs(ctx context.Context, name string, lines int) (string, error) {
+	out, err := k.kubectl(ctx, nil, "logs", "job/"+name, "--tail", fmt.Sprint(lines))
+	return string(out), err
+}
+
+// RenderChart renders a Gas Town Helm chart for cfg with helm template. The
+// release is named after the town, so object names match the embedded
+// manifests. Chart dependencies must already be built (helm dependency build).
+func RenderChart(ctx context.Context, chartDir string, cfg Config, valuesFiles []string) (string, error) {
+	return renderChart(ctx, execRun, chartDir, cfg, valuesFiles)
+}
+
+func renderChart(ctx context.Context, run runFunc, chartDir string, cfg Config, valuesFiles []string) (string, error) {
+	if err := cfg.WithDefaults().Validate(); err != nil {
+		return "", err
+	}
+	out, err := run(ctx, nil, "helm", chartArgs(chartDir, cfg, valuesFiles)...)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// chartArgs builds the helm template arguments. Only what was set
+// explicitly in cfg is passed with --set, which takes precedence over
+// values files; everything else is left to the chart and valuesFiles.
+func chartArgs(chartDir string, cfg Config, valuesFiles []string) []string {
+	namespace := cfg.WithDefaults().Namespace
+	args := []string{"template", cfg.Town, chartDir, "--namespace", namespace}
+	for _, f := range valuesFiles {
+		args = append(args, "--values", f)
+	}
+
+	set := []string{
+		"agentController.enabled=true",
+		"agentController.townName=" + cfg.Town,
+	}
+	if cfg.ImageTag != "" {
+		set = append(set,
+			"agentController.image.tag="+cfg.ImageTag,
+			"agentController.agentImage.tag="+cfg.ImageTag)
+	}
+	if cfg.Secrets.DaemonToken != "" {
+		set = append(set, "agentController.daemonTokenSecret="+cfg.Secrets.DaemonToken)
+	}
+	if cfg.Secrets.ClaudeCredentials != "" {
+		set = append(set, "agentController.credentialsSecret="+cfg.Secrets.ClaudeCredentials)
+	}
+	if cfg.Secrets.GitCredentials != "" {
+		set = append(set, "agentController.gitCredentialsSecret="+cfg.Secrets.GitCredentials)
+	}
+	for _, s := range set {
+		args = append(args, "--set", s)
+	}
+	return args
+}
@@ -0,0 +1,322 @@
+// Package towndeploy renders and applies the Kubernetes manifests for a Gas
+// Town namespace: Dolt, NATS, the beads daemon and the agent controller, plus
+// a one-shot Job that seeds the town's initial beads.
+//
+// Manifests come from templates embedded in the binary, or from the Helm
+// chart when a chart directory is given. Either way the objects share the
+// chart's naming (<town>-bd-daemon-daemon, <town>-agent-controller, ...) so
+// a town installed one way can later be managed the other.
+package towndeploy
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"go.yaml.in/yaml/v2"
+)
+
+//go:embed manifests/*.tmpl
+var manifestFS embed.FS
+
+// Default images. The gastown images take Config.ImageTag; the others are
+// pinned to the versions the chart is tested with.
+const (
+	DefaultControllerRepo = "ghcr.io/groblegark/gastown/agent-controller"
+	DefaultAgentRepo      = "ghcr.io/groblegark/gastown/gastown-agent"
+	DefaultDaemonImage    = "ghcr.io/groblegark/beads:latest"
+	DefaultDoltImage      = "dolthub/dolt-sql-server:1.80.2"
+	DefaultNATSImage      = "public.ecr.aws/docker/library/nats:2.10-alpine"
+	DefaultImageTag       = "latest"
+)
+
+// townNameRe matches town names usable as a Kubernetes name prefix. The
+// length cap leaves room for the longest suffix within the 63-character
+// label limit.
+var townNameRe = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,44}[a-z0-9])?$`)
+
+// Secrets names the Secrets the workloads reference. They are never
+// rendered; they must exist in the namespace before deploying.
+type Secrets struct {
+	// DaemonToken holds the daemon's auth token under key "token". Required.
+	DaemonToken string
+
+	// ClaudeCredentials holds Claude OAuth credentials for agent pods.
+	// Optional.
+	ClaudeCredentials string
+
+	// GitCredentials holds git credentials for agent pods. Optional.
+	GitCredentials string
+}
+
+// Config describes the town to deploy.
+type Config struct {
+	Town      string // Town name; also the object name prefix
+	Namespace string // Defaults to Town
+
+	ImageTag        string // Tag for the controller and agent images
+	ControllerImage string // Overrides the controller image entirely
+	AgentImage      string // Overrides the agent image entirely
+	DaemonImage     string
+	DoltImage       string
+	NATSImage       string
+
+	StorageClass string // Empty uses the cluster default
+	DoltStorage  string // Defaults to 10Gi
+	NATSStorage  string // Defaults to 1Gi
+
+	Secrets Secrets
+
+	// Rigs to register when seeding, as "name:prefix:git_url".
+	Rigs []string
+
+	// SeedConfig also seeds extended config beads (gt bootstrap --seed-config).
+	SeedConfig bool
+}
+
+// WithDefaults returns a copy of c with unset fields filled in.
+func (c Config) WithDefaults() Config {
+	if c.Namespace == "" {
+		c.Namespace = c.Town
+	}
+	if c.ImageTag == "" {
+		c.ImageTag = DefaultImageTag
+	}
+	if c.ControllerImage == "" {
+		c.ControllerImage = DefaultControllerRepo + ":" + c.ImageTag
+	}
+	if c.AgentImage == "" {
+		c.AgentImage = DefaultAgentRepo + ":" + c.ImageTag
+	}
+	if c.DaemonImage == "" {
+		c.DaemonImage = DefaultDaemonImage
+	}
+	if c.DoltImage == "" {
+		c.DoltImage = DefaultDoltImage
+	}
+	if c.NATSImage == "" {
+		c.NATSImage = DefaultNATSImage
+	}
+	if c.DoltStorage == "" {
+		c.DoltStorage = "10Gi"
+	}
+	if c.NATSStorage == "" {
+		c.NATSStorage = "1Gi"
+	}
+	if c.Secrets.DaemonToken == "" {
+		c.Secrets.DaemonToken = c.DaemonName() + "-token"
+	}
+	return c
+}
+
+// Validate reports whether the config can be rendered.
+func (c Config) Validate() error {
+	if !townNameRe.MatchString(c.Town) {
+		return fmt.Errorf("invalid town name %q: must be lowercase letters, digits and '-', at most 46 characters", c.Town)
+	}
+	if c.Namespace != "" && !townNameRe.MatchString(c.Namespace) {
+		return fmt.Errorf("invalid namespace %q", c.Namespace)
+	}
+	for _, rig := range c.Rigs {
+		if parts := strings.SplitN(rig, ":", 3); len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return fmt.Errorf("invalid rig spec %q — expected name:prefix:git_url", rig)
+		}
+	}
+	return nil
+}
+
+// DaemonName is the name of the daemon Deployment and Service.
+func (c Config) DaemonName() string { return c.Town + "-bd-daemon-daemon" }
+
+// DoltName is the name of the Dolt StatefulSet and Service.
+func (c Config) DoltName() string { return c.Town + "-bd-daemon-dolt" }
+
+// NATSName is the name of the NATS StatefulSet and Service.
+func (c Config) NATSName() string { return c.Town + "-bd-daemon-nats" }
+
+// ControllerName is the name of the agent controller Deployment and its RBAC.
+func (c Config) ControllerName() string { return c.Town + "-agent-controller" }
+
+// SeedJobName is the name of the Job that seeds the town's beads.
+func (c Config) SeedJobName() string { return c.Town + "-seed" }
+
+// NATSURL is the in-cluster NATS client URL.
+func (c Config) NATSURL() string {
+	return fmt.Sprintf("nats://%s.%s.svc.cluster.local:4222", c.NATSName(), c.Namespace)
+}
+
+// SecretNames returns the Secrets the rendered workloads reference.
+func (c Config) SecretNames() []string {
+	var names []string
+	for _, n := range []string{c.Secrets.DaemonToken, c.Secrets.ClaudeCredentials, c.Secrets.GitCredentials} {
+		if n != "" {
+			names = append(names, n)
+		}
+	}
+	return names
+}
+
+// LabelBlock returns a component's labels as YAML lines indented by n
+// spaces; called from templates.
+func (c Config) LabelBlock(component string, n int) string {
+	return c.SelectorBlock(component, n) + "\n" + strings.Repeat(" ", n) + "app.kubernetes.io/managed-by: gt"
+}
+
+// SelectorBlock returns the labels that select a component's pods, as YAML
+// lines indented by n spaces; called from templates.
+func (c Config) SelectorBlock(component string, n int) string {
+	pad := strings.Repeat(" ", n)
+	return pad + "app.kubernetes.io/name: gastown\n" +
+		pad + "app.kubernetes.io/instance: " + c.Town + "\n" +
+		pad + "app.kubernetes.io/component: " + component
+}
+
+func parseTemplates() (*template.Template, error) {
+	return template.New("manifests").
+		Funcs(template.FuncMap{"quote": strconv.Quote}).
+		ParseFS(manifestFS, "manifests/*.tmpl")
+}
+
+// Render returns the town's manifests as a multi-document YAML stream, in
+// apply order. The seed Job is rendered separately by RenderSeedJob.
+func Render(cfg Config) (string, error) {
+	cfg = cfg.WithDefaults()
+	if err := cfg.Validate(); err != nil {
+		return "", err
+	}
+	tmpl, err := parseTemplates()
+	if err != nil {
+		return "", fmt.Errorf("parsing manifests: %w", err)
+	}
+
+	var names []string
+	for _, t := range tmpl.Templates() {
+		// Numbered files are the install set; others are helpers or the seed Job.
+		if name := t.Name(); strings.HasSuffix(name, ".yaml.tmpl") && name[0] >= '0' && name[0] <= '9' {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var out bytes.Buffer
+	for _, name := range names {
+		if out.Len() > 0 {
+			out.WriteString("---\n")
+		}
+		if err := tmpl.ExecuteTemplate(&out, name, cfg); err != nil {
+			return "", fmt.Errorf("rendering %s: %w", name, err)
+		}
+	}
+	return out.String(), nil
+}
+
+// RenderSeedJob returns the Job that runs gt bootstrap against the town's
+// daemon.
+func RenderSeedJob(cfg Config) (string, error) {
+	return renderOne(cfg, "seed-job.yaml.tmpl")
+}
+
+// RenderNamespace returns just the town's Namespace, for installs from a
+// chart, which leaves creating the namespace to the caller.
+func RenderNamespace(cfg Config) (string, error) {
+	return renderOne(cfg, "00-namespace.yaml.tmpl")
+}
+
+func renderOne(cfg Config, name string) (string, error) {
+	cfg = cfg.WithDefaults()
+	if err := cfg.Validate(); err != nil {
+		return "", err
+	}
+	tmpl, err := parseTemplates()
+	if err != nil {
+		return "", fmt.Errorf("parsing manifests: %w", err)
+	}
+	var out bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&out, name, cfg); err != nil {
+		return "", fmt.Errorf("rendering %s: %w", name, err)
+	}
+	return out.String(), nil
+}
+
+// SecretManifest returns an Opaque Secret holding a single key, for applying
+// generated secrets through stdin rather than the command line.
+func SecretManifest(name, namespace, key, value string) (string, error) {
+	doc := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"type":       "Opaque",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+			"labels":    map[string]string{"app.kubernetes.io/managed-by": "gt"},
+		},
+		"stringData": map[string]string{key: value},
+	}
+	data, err := yaml.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Object identifies one object in a manifest stream.
+type Object struct {
+	Kind      string
+	Name      string
+	Namespace string
+}
+
+// String returns the object as kubectl's kind/name reference.
+func (o Object) String() string {
+	return strings.ToLower(o.Kind) + "/" + o.Name
+}
+
+// Objects lists the objects in a multi-document YAML stream, skipping empty
+// documents (helm template emits a few).
+func Objects(manifest string) ([]Object, error) {
+	var objs []Object
+	for i, doc := range splitDocuments(manifest) {
+		var meta struct {
+			Kind     string `yaml:"kind"`
+			Metadata struct {
+				Name      string `yaml:"name"`
+				Namespace string `yaml:"namespace"`
+			} `yaml:"metadata"`
+		}
+		if err := yaml.Unmarshal([]byte(doc), &meta); err != nil {
+			return nil, fmt.Errorf("parsing document %d: %w", i+1, err)
+		}
+		if meta.Kind == "" {
+			continue
+		}
+		objs = append(objs, Object{Kind: meta.Kind, Name: meta.Metadata.Name, Namespace: meta.Metadata.Namespace})
+	}
+	return objs, nil
+}
+
+// Workloads returns the objects whose rollout can be waited on.
+func Workloads(objs []Object) []Object {
+	var out []Object
+	for _, o := range objs {
+		switch o.Kind {
+		case "Deployment", "StatefulSet", "DaemonSet":
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+func splitDocuments(manifest string) []string {
+	var docs []string
+	for _, doc := range strings.Split("\n"+manifest, "\n---") {
+		if strings.TrimSpace(doc) != "" {
+			docs = append(docs, doc)
+		}
+	}
+	return docs
+}
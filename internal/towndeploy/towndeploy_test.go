@@ -0,0 +1,306 @@
+package towndeploy
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.yaml.in/yaml/v2"
+)
+
+func TestRender(t *testing.T) {
+	manifest, err := Render(Config{Town: "alpha", ImageTag: "v1.2.3"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	objs, err := Objects(manifest)
+	if err != nil {
+		t.Fatalf("Objects: %v", err)
+	}
+	want := []string{
+		"namespace/alpha",
+		"service/alpha-bd-daemon-dolt",
+		"statefulset/alpha-bd-daemon-dolt",
+		"service/alpha-bd-daemon-nats",
+		"statefulset/alpha-bd-daemon-nats",
+		"service/alpha-bd-daemon-daemon",
+		"deployment/alpha-bd-daemon-daemon",
+		"serviceaccount/alpha-agent-controller",
+		"role/alpha-agent-controller",
+		"rolebinding/alpha-agent-controller",
+		"deployment/alpha-agent-controller",
+	}
+	if len(objs) != len(want) {
+		t.Fatalf("got %d objects, want %d:\n%s", len(objs), len(want), manifest)
+	}
+	for i, o := range objs {
+		if o.String() != want[i] {
+			t.Errorf("object %d = %s, want %s", i, o, want[i])
+		}
+		if o.Kind != "Namespace" && o.Namespace != "alpha" {
+			t.Errorf("%s namespace = %q, want alpha", o, o.Namespace)
+		}
+	}
+
+	for _, s := range []string{
+		`"ghcr.io/groblegark/gastown/agent-controller:v1.2.3"`,
+		`"ghcr.io/groblegark/gastown/gastown-agent:v1.2.3"`,
+		"name: alpha-bd-daemon-daemon-token",
+		"nats://alpha-bd-daemon-nats.alpha.svc.cluster.local:4222",
+		"app.kubernetes.io/component: daemon",
+	} {
+		if !strings.Contains(manifest, s) {
+			t.Errorf("manifest missing %q", s)
+		}
+	}
+	// Optional credentials are only wired in when named.
+	if strings.Contains(manifest, "CLAUDE_CREDENTIALS_SECRET") {
+		t.Error("manifest references Claude credentials without a secret name")
+	}
+}
+
+func TestRenderParsesAsYAML(t *testing.T) {
+	manifest, err := Render(Config{
+		Town:         "alpha",
+		Namespace:    "gt-alpha",
+		StorageClass: "fast",
+		Secrets:      Secrets{ClaudeCredentials: "claude", GitCredentials: "git"},
+	})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	for i, doc := range splitDocuments(manifest) {
+		var v map[string]interface{}
+		if err := yaml.Unmarshal([]byte(doc), &v); err != nil {
+			t.Fatalf("document %d: %v\n%s", i+1, err, doc)
+		}
+	}
+	for _, s := range []string{"CLAUDE_CREDENTIALS_SECRET", "GIT_CREDENTIALS_SECRET", `storageClassName: "fast"`, "namespace: gt-alpha"} {
+		if !strings.Contains(manifest, s) {
+			t.Errorf("manifest missing %q", s)
+		}
+	}
+}
+
+func TestRenderSelectorsMatchPodLabels(t *testing.T) {
+	manifest, err := Render(Config{Town: "alpha"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	for _, doc := range splitDocuments(manifest) {
+		var w struct {
+			Kind     string `yaml:"kind"`
+			Metadata struct {
+				Name string `yaml:"name"`
+			} `yaml:"metadata"`
+			Spec struct {
+				Selector struct {
+					MatchLabels map[string]string `yaml:"matchLabels"`
+				} `yaml:"selector"`
+				Template struct {
+					Metadata struct {
+						Labels map[string]string `yaml:"labels"`
+					} `yaml:"metadata"`
+				} `yaml:"template"`
+			} `yaml:"spec"`
+		}
+		if err := yaml.Unmarshal([]byte(doc), &w); err != nil {
+			t.Fatal(err)
+		}
+		if w.Kind != "Deployment" && w.Kind != "StatefulSet" {
+			continue
+		}
+		if len(w.Spec.Selector.MatchLabels) == 0 {
+			t.Errorf("%s/%s has no selector", w.Kind, w.Metadata.Name)
+		}
+		for k, v := range w.Spec.Selector.MatchLabels {
+			if w.Spec.Template.Metadata.Labels[k] != v {
+				t.Errorf("%s/%s pod label %s = %q, selector wants %q", w.Kind, w.Metadata.Name, k, w.Spec.Template.Metadata.Labels[k], v)
+			}
+		}
+	}
+}
+
+func TestRenderSeedJob(t *testing.T) {
+	job, err := RenderSeedJob(Config{
+		Town:       "alpha",
+		Rigs:       []string{"gastown:gt:https://github.com/org/gastown.git"},
+		SeedConfig: true,
+	})
+	if err != nil {
+		t.Fatalf("RenderSeedJob: %v", err)
+	}
+	objs, err := Objects(job)
+	if err != nil {
+		t.Fatalf("Objects: %v", err)
+	}
+	if len(objs) != 1 || objs[0].String() != "job/alpha-seed" {
+		t.Fatalf("objects = %v, want [job/alpha-seed]", objs)
+	}
+
+	var parsed struct {
+		Spec struct {
+			Template struct {
+				Spec struct {
+					Containers []struct {
+						Command []string `yaml:"command"`
+					} `yaml:"containers"`
+				} `yaml:"spec"`
+			} `yaml:"template"`
+		} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal([]byte(job), &parsed); err != nil {
+		t.Fatalf("parsing job: %v", err)
+	}
+	got := strings.Join(parsed.Spec.Template.Spec.Containers[0].Command, " ")
+	want := "gt bootstrap --town alpha --rig gastown:gt:https://github.com/org/gastown.git --seed-config"
+	if got != want {
+		t.Errorf("command = %q, want %q", got, want)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		ok   bool
+	}{
+		{"simple", Config{Town: "gastown"}, true},
+		{"dashes", Config{Town: "gastown-next"}, true},
+		{"empty", Config{}, false},
+		{"uppercase", Config{Town: "Gastown"}, false},
+		{"trailing dash", Config{Town: "gastown-"}, false},
+		{"too long", Config{Town: strings.Repeat("a", 47)}, false},
+		{"bad namespace", Config{Town: "gastown", Namespace: "Bad_NS"}, false},
+		{"bad rig", Config{Town: "gastown", Rigs: []string{"gastown:gt"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err == nil) != tt.ok {
+				t.Errorf("Validate() = %v, want ok=%v", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestObjectsSkipsEmptyDocuments(t *testing.T) {
+	manifest := "---\n# Source: chart/templates/empty.yaml\n---\n# Source: chart/templates/svc.yaml\napiVersion: v1\nkind: Service\nmetadata:\n  name: svc\n"
+	objs, err := Objects(manifest)
+	if err != nil {
+		t.Fatalf("Objects: %v", err)
+	}
+	if len(objs) != 1 || objs[0].String() != "service/svc" {
+		t.Errorf("objects = %v, want [service/svc]", objs)
+	}
+
+	workloads := Workloads([]Object{{Kind: "Service"}, {Kind: "Deployment", Name: "d"}, {Kind: "StatefulSet", Name: "s"}})
+	if len(workloads) != 2 {
+		t.Errorf("Workloads = %v, want the Deployment and StatefulSet", workloads)
+	}
+}
+
+func TestSecretManifest(t *testing.T) {
+	manifest, err := SecretManifest("tok", "alpha", "token", "s3cret")
+	if err != nil {
+		t.Fatalf("SecretManifest: %v", err)
+	}
+	objs, err := Objects(manifest)
+	if err != nil {
+		t.Fatalf("Objects: %v", err)
+	}
+	if len(objs) != 1 || objs[0] != (Object{Kind: "Secret", Name: "tok", Namespace: "alpha"}) {
+		t.Errorf("objects = %v", objs)
+	}
+	if !strings.Contains(manifest, "token: s3cret") {
+		t.Errorf("manifest missing stringData:\n%s", manifest)
+	}
+}
+
+// fakeRun records commands and returns canned output by subcommand.
+type fakeRun struct {
+	calls  [][]string
+	stdin  []string
+	output map[string]string
+	err    map[string]error
+}
+
+func (f *fakeRun) run(_ context.Context, stdin []byte, name string, args ...string) ([]byte, error) {
+	f.calls = append(f.calls, append([]string{name}, args...))
+	f.stdin = append(f.stdin, string(stdin))
+	sub := ""
+	for _, a := range args {
+		if !strings.HasPrefix(a, "-") && a != "kc" && a != "prod" && a != "alpha" {
+			sub = a
+			break
+		}
+	}
+	return []byte(f.output[sub]), f.err[sub]
+}
+
+func TestKubectlArgs(t *testing.T) {
+	f := &fakeRun{}
+	k := &Kubectl{Kubeconfig: "kc", Context: "prod", Namespace: "alpha", run: f.run}
+
+	if err := k.Apply(context.Background(), "kind: Namespace\n"); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if err := k.WaitRollout(context.Background(), Object{Kind: "StatefulSet", Name: "db"}, 2*time.Minute); err != nil {
+		t.Fatalf("WaitRollout: %v", err)
+	}
+
+	want := [][]string{
+		{"kubectl", "--kubeconfig", "kc", "--context", "prod", "--namespace", "alpha", "apply", "--server-side", "--field-manager=gt", "-f", "-"},
+		{"kubectl", "--kubeconfig", "kc", "--context", "prod", "--namespace", "alpha", "rollout", "status", "statefulset/db", "--timeout", "2m0s"},
+	}
+	for i := range want {
+		if strings.Join(f.calls[i], " ") != strings.Join(want[i], " ") {
+			t.Errorf("call %d = %v, want %v", i, f.calls[i], want[i])
+		}
+	}
+	if f.stdin[0] != "kind: Namespace\n" {
+		t.Errorf("apply stdin = %q", f.stdin[0])
+	}
+}
+
+func TestMissingSecrets(t *testing.T) {
+	f := &fakeRun{output: map[string]string{"get": "secret/alpha-bd-daemon-daemon-token\nsecret/other\n"}}
+	k := &Kubectl{Namespace: "alpha", run: f.run}
+
+	missing, err := k.MissingSecrets(context.Background(), []string{"alpha-bd-daemon-daemon-token", "claude"})
+	if err != nil {
+		t.Fatalf("MissingSecrets: %v", err)
+	}
+	if len(missing) != 1 || missing[0] != "claude" {
+		t.Errorf("missing = %v, want [claude]", missing)
+	}
+
+	f.err = map[string]error{"get": errors.New("forbidden")}
+	if _, err := k.MissingSecrets(context.Background(), nil); err == nil {
+		t.Error("expected error from kubectl get")
+	}
+}
+
+func TestChartArgs(t *testing.T) {
+	args := chartArgs("helm/gastown", Config{Town: "alpha", ImageTag: "v2", Secrets: Secrets{GitCredentials: "git"}}, []string{"prod.yaml"})
+	got := strings.Join(args, " ")
+	for _, s := range []string{
+		"template alpha helm/gastown --namespace alpha",
+		"--values prod.yaml",
+		"--set agentController.enabled=true",
+		"--set agentController.townName=alpha",
+		"--set agentController.image.tag=v2",
+		"--set agentController.gitCredentialsSecret=git",
+	} {
+		if !strings.Contains(got, s) {
+			t.Errorf("args missing %q: %s", s, got)
+		}
+	}
+	// Unset values are left to the chart and values files.
+	if strings.Contains(got, "daemonTokenSecret") || strings.Contains(got, "credentialsSecret=") {
+		t.Errorf("args set values that weren't given: %s", got)
+	}
+}
@@ -0,0 +1,122 @@
+// Package tracing emits OpenTelemetry spans for the stages a work item goes
+// through: sling, polecat spawn, nudge, and merge.
+//
+// The stages run in different processes (gt sling, the RPC server, the K8s
+// controller, gt done in the polecat) with no request to carry a trace
+// context between them. Instead every stage derives the same parent from the
+// bead ID: the trace ID and parent span ID are hashes of it, so all spans for
+// one bead land in one trace without storing anything on the bead. The
+// parent itself is never exported; trace viewers show it as a missing root.
+//
+// Tracing is off unless an OTLP endpoint is configured with the standard
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT
+// variables. When off, spans are no-ops.
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Span attribute keys.
+const (
+	AttrBeadID      = attribute.Key("gt.bead.id")
+	AttrAgent       = attribute.Key("gt.agent")
+	AttrRig         = attribute.Key("gt.rig")
+	AttrAgentBeadID = attribute.Key("gt.agent.bead.id")
+)
+
+const tracerName = "github.com/steveyegge/gastown"
+
+// Enabled reports whether an OTLP trace endpoint is configured.
+func Enabled() bool {
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// Init installs a global tracer provider that exports over OTLP/HTTP, named
+// service unless OTEL_SERVICE_NAME overrides it. The returned function
+// flushes buffered spans and must be called before the process exits. When
+// tracing is not enabled, Init does nothing and the shutdown is a no-op.
+func Init(ctx context.Context, service string) (shutdown func(context.Context) error, err error) {
+	shutdown = func(context.Context) error { return nil }
+	if !Enabled() {
+		return shutdown, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return shutdown, err
+	}
+	// Later detectors win, so OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES
+	// override the default service name.
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", service)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return shutdown, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return provider.Shutdown, nil
+}
+
+// BeadSpanContext is the shared parent of every span for beadID.
+func BeadSpanContext(beadID string) trace.SpanContext {
+	sum := sha256.Sum256([]byte("gt-bead:" + beadID))
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	copy(traceID[:], sum[:16])
+	copy(spanID[:], sum[16:24])
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+}
+
+// Start starts a span for one stage of beadID's lifecycle. If ctx already
+// carries a span, the new span is its child; otherwise it is parented to the
+// bead's shared span context.
+func Start(ctx context.Context, beadID, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() && beadID != "" {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, BeadSpanContext(beadID))
+	}
+	attrs = append(attrs, AttrBeadID.String(beadID))
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// LinkBead records on span that work continues in another bead's trace,
+// e.g. a polecat's agent bead whose pod the controller creates.
+func LinkBead(span trace.Span, beadID string) {
+	span.AddLink(trace.Link{
+		SpanContext: BeadSpanContext(beadID),
+		Attributes:  []attribute.KeyValue{AttrBeadID.String(beadID)},
+	})
+}
+
+// End records err, if any, on span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,69 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestBeadSpanContext(t *testing.T) {
+	a, b := BeadSpanContext("gt-abc"), BeadSpanContext("gt-abc")
+	if !a.Equal(b) {
+		t.Error("span context should be deterministic for a bead")
+	}
+	if a.TraceID() == BeadSpanContext("gt-xyz").TraceID() {
+		t.Error("different beads should get different traces")
+	}
+	if !a.IsValid() || !a.IsSampled() {
+		t.Errorf("span context = %v; want valid and sampled", a)
+	}
+	// Pinned: the controller module derives the same IDs independently.
+	if a.TraceID().String() != "bf49356d3263898783e219bdaf194ed3" || a.SpanID().String() != "c1fa2c1c05603564" {
+		t.Errorf("span context = %v; derivation changed", a)
+	}
+}
+
+func TestStartSharesBeadTrace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(prev)
+
+	// Two stages in "different processes": no shared context, same bead
+	ctx, sling := Start(context.Background(), "gt-abc", "sling")
+	_, spawn := Start(ctx, "gt-abc", "spawn_polecat")
+	LinkBead(spawn, "gt-gastown-polecat-nux")
+	End(spawn, nil)
+	End(sling, nil)
+	_, merge := Start(context.Background(), "gt-abc", "merge")
+	End(merge, errors.New("conflict"))
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("got %d spans, want 3", len(spans))
+	}
+	root := BeadSpanContext("gt-abc")
+	spawnSpan, slingSpan, mergeSpan := spans[0], spans[1], spans[2]
+	for _, s := range spans {
+		if s.SpanContext().TraceID() != root.TraceID() {
+			t.Errorf("%s trace = %s, want bead trace %s", s.Name(), s.SpanContext().TraceID(), root.TraceID())
+		}
+	}
+	if slingSpan.Parent().SpanID() != root.SpanID() || mergeSpan.Parent().SpanID() != root.SpanID() {
+		t.Error("stages without a context should be parented to the bead span")
+	}
+	if spawnSpan.Parent().SpanID() != slingSpan.SpanContext().SpanID() {
+		t.Error("spawn should be a child of sling")
+	}
+	if len(spawnSpan.Links()) != 1 || spawnSpan.Links()[0].SpanContext.TraceID() != BeadSpanContext("gt-gastown-polecat-nux").TraceID() {
+		t.Errorf("spawn links = %v", spawnSpan.Links())
+	}
+	if mergeSpan.Status().Code != codes.Error {
+		t.Errorf("merge status = %v, want error", mergeSpan.Status())
+	}
+}
@@ -156,5 +156,9 @@ var (
 		"nudge":   "⚡",
 		"boot":    "🔌",
 		"halt":    "⏹",
+		// Issue changes made over RPC
+		"issue_created": "+",
+		"issue_updated": "→",
+		"issue_closed":  "✓",
 	}
 )
@@ -0,0 +1,441 @@
+// Package top implements gt top, a terminal dashboard of agents, hooked
+// work, convoys, the merge queue and recent events. It reads the same
+// collectors as the web dashboard (gt dashboard).
+package top
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/steveyegge/gastown/internal/monitoring"
+	"github.com/steveyegge/gastown/internal/web"
+)
+
+// DefaultInterval is how often the dashboard refreshes.
+const DefaultInterval = 5 * time.Second
+
+// actionTimeout bounds the gt commands run for peek, nudge and kill.
+const actionTimeout = 30 * time.Second
+
+// Source supplies the dashboard's data. *web.LiveConvoyFetcher implements it.
+type Source interface {
+	FetchWorkers() ([]web.WorkerRow, error)
+	FetchHooks() ([]web.HookRow, error)
+	FetchConvoys() ([]web.ConvoyRow, error)
+	FetchMergeQueue() ([]web.MergeQueueRow, error)
+	FetchActivity() ([]web.ActivityRow, error)
+}
+
+// Runner runs a gt command and returns its combined output.
+type Runner func(args ...string) (string, error)
+
+// runGT runs the gt binary on PATH.
+func runGT(args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), actionTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "gt", args...) //nolint:gosec // G204: args are built internally
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	err := cmd.Run()
+	if err != nil && out.Len() > 0 {
+		err = fmt.Errorf("%s", strings.TrimSpace(out.String()))
+	}
+	return out.String(), err
+}
+
+// Agent is one row of the agents panel.
+type Agent struct {
+	web.WorkerRow
+
+	// Status is the agent's monitoring status, from its work status.
+	Status monitoring.AgentStatus
+
+	// Hook is the bead on the agent's hook, if any.
+	Hook *web.HookRow
+}
+
+// Address returns the agent's address as gt commands take it.
+func (a Agent) Address() string {
+	if a.AgentType == "refinery" {
+		return a.Rig + "/refinery"
+	}
+	return a.Rig + "/" + a.Name
+}
+
+// Snapshot is one refresh of the dashboard's data.
+type Snapshot struct {
+	Agents     []Agent
+	Convoys    []web.ConvoyRow
+	MergeQueue []web.MergeQueueRow
+	Activity   []web.ActivityRow
+	Fetched    time.Time
+}
+
+// mode is what the keyboard is driving.
+type mode int
+
+const (
+	modeList mode = iota
+	modePeek
+	modeNudge
+	modeConfirmKill
+)
+
+// Model is the bubbletea model for gt top.
+type Model struct {
+	source   Source
+	run      Runner
+	interval time.Duration
+
+	snapshot Snapshot
+	err      error
+	loaded   bool
+	cursor   int // Selected agent
+
+	mode      mode
+	nudge     textinput.Model
+	peek      viewport.Model
+	peekAgent string
+	flash     string // Result of the last action
+
+	keys     KeyMap
+	help     help.Model
+	showHelp bool
+	width    int
+	height   int
+}
+
+// New creates a gt top model that refreshes from source every interval.
+func New(source Source, interval time.Duration) Model {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ti := textinput.New()
+	ti.Placeholder = "message"
+	ti.CharLimit = 500
+	return Model{
+		source:   source,
+		run:      runGT,
+		interval: interval,
+		nudge:    ti,
+		peek:     viewport.New(80, 20),
+		keys:     DefaultKeyMap(),
+		help:     help.New(),
+	}
+}
+
+// WithRunner returns the model with gt commands run by run instead.
+func (m Model) WithRunner(run Runner) Model {
+	m.run = run
+	return m
+}
+
+// Init starts the first refresh.
+func (m Model) Init() tea.Cmd {
+	return m.fetch
+}
+
+// snapshotMsg is the result of a refresh.
+type snapshotMsg struct {
+	snapshot Snapshot
+	err      error
+}
+
+// tickMsg triggers a refresh.
+type tickMsg time.Time
+
+// peekMsg carries an agent's captured output.
+type peekMsg struct {
+	agent  string
+	output string
+	err    error
+}
+
+// actionMsg reports how a nudge or kill went.
+type actionMsg struct {
+	text string
+	err  error
+}
+
+// fetch collects a snapshot from the source, querying each panel in
+// parallel. A panel that fails to load is left empty; the error is shown
+// only when the agent list itself fails.
+func (m Model) fetch() tea.Msg {
+	var (
+		wg         sync.WaitGroup
+		workers    []web.WorkerRow
+		workersErr error
+		hooks      []web.HookRow
+		convoys    []web.ConvoyRow
+		mq         []web.MergeQueueRow
+		activity   []web.ActivityRow
+	)
+	wg.Add(5)
+	go func() { defer wg.Done(); workers, workersErr = m.source.FetchWorkers() }()
+	go func() { defer wg.Done(); hooks, _ = m.source.FetchHooks() }()
+	go func() { defer wg.Done(); convoys, _ = m.source.FetchConvoys() }()
+	go func() { defer wg.Done(); mq, _ = m.source.FetchMergeQueue() }()
+	go func() { defer wg.Done(); activity, _ = m.source.FetchActivity() }()
+	wg.Wait()
+
+	return snapshotMsg{
+		snapshot: Snapshot{
+			Agents:     buildAgents(workers, hooks),
+			Convoys:    openConvoys(convoys),
+			MergeQueue: mq,
+			Activity:   activity,
+			Fetched:    time.Now(),
+		},
+		err: workersErr,
+	}
+}
+
+// buildAgents joins workers with the beads on their hooks.
+func buildAgents(workers []web.WorkerRow, hooks []web.HookRow) []Agent {
+	byAssignee := make(map[string]*web.HookRow, len(hooks))
+	for i := range hooks {
+		byAssignee[hooks[i].Assignee] = &hooks[i]
+	}
+	agents := make([]Agent, 0, len(workers))
+	for _, w := range workers {
+		a := Agent{WorkerRow: w, Status: agentStatus(w.WorkStatus)}
+		if w.AgentType == "refinery" {
+			a.Hook = byAssignee[w.Rig+"/refinery"]
+		} else {
+			a.Hook = byAssignee[w.Rig+"/polecats/"+w.Name]
+		}
+		agents = append(agents, a)
+	}
+	return agents
+}
+
+// agentStatus maps a dashboard work status to a monitoring status.
+func agentStatus(workStatus string) monitoring.AgentStatus {
+	switch workStatus {
+	case "working":
+		return monitoring.StatusWorking
+	case "stale":
+		return monitoring.StatusIdle
+	case "stuck":
+		return monitoring.StatusError
+	default:
+		return monitoring.StatusAvailable
+	}
+}
+
+// openConvoys drops closed convoys.
+func openConvoys(convoys []web.ConvoyRow) []web.ConvoyRow {
+	var open []web.ConvoyRow
+	for _, c := range convoys {
+		if c.Status != "closed" {
+			open = append(open, c)
+		}
+	}
+	return open
+}
+
+// tick schedules the next refresh.
+func (m Model) tick() tea.Cmd {
+	return tea.Tick(m.interval, func(t time.Time) tea.Msg {
+		return tickMsg(t)
+	})
+}
+
+// selected returns the selected agent, if there is one.
+func (m Model) selected() (Agent, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.snapshot.Agents) {
+		return Agent{}, false
+	}
+	return m.snapshot.Agents[m.cursor], true
+}
+
+// peekCmd captures an agent's recent output with gt peek.
+func (m Model) peekCmd(address string) tea.Cmd {
+	lines := max(m.height-4, 20)
+	return func() tea.Msg {
+		out, err := m.run("peek", address, "-n", fmt.Sprintf("%d", lines))
+		return peekMsg{agent: address, output: out, err: err}
+	}
+}
+
+// nudgeCmd sends a message to an agent with gt nudge.
+func (m Model) nudgeCmd(address, message string) tea.Cmd {
+	return func() tea.Msg {
+		_, err := m.run("nudge", address, message)
+		return actionMsg{text: "Nudged " + address, err: err}
+	}
+}
+
+// killCmd stops a polecat's session with gt all stop.
+func (m Model) killCmd(address string) tea.Cmd {
+	return func() tea.Msg {
+		_, err := m.run("all", "stop", address)
+		return actionMsg{text: "Stopped " + address, err: err}
+	}
+}
+
+// Update handles messages.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.help.Width = msg.Width
+		m.peek.Width = msg.Width
+		m.peek.Height = max(msg.Height-3, 1)
+		return m, nil
+
+	case tickMsg:
+		return m, m.fetch
+
+	case snapshotMsg:
+		m.snapshot = msg.snapshot
+		m.err = msg.err
+		m.loaded = true
+		if m.cursor >= len(m.snapshot.Agents) {
+			m.cursor = max(len(m.snapshot.Agents)-1, 0)
+		}
+		return m, m.tick()
+
+	case peekMsg:
+		if m.mode != modePeek || msg.agent != m.peekAgent {
+			return m, nil
+		}
+		content := msg.output
+		if msg.err != nil {
+			content = errorStyle.Render(msg.err.Error())
+		}
+		m.peek.SetContent(content)
+		m.peek.GotoBottom()
+		return m, nil
+
+	case actionMsg:
+		if msg.err != nil {
+			m.flash = errorStyle.Render(msg.err.Error())
+		} else {
+			m.flash = okStyle.Render(msg.text)
+		}
+		return m, m.fetch
+
+	case tea.KeyMsg:
+		switch m.mode {
+		case modePeek:
+			return m.updatePeek(msg)
+		case modeNudge:
+			return m.updateNudge(msg)
+		case modeConfirmKill:
+			return m.updateConfirmKill(msg)
+		}
+		return m.updateList(msg)
+	}
+	return m, nil
+}
+
+// updateList handles keys on the main dashboard.
+func (m Model) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Help):
+		m.showHelp = !m.showHelp
+	case key.Matches(msg, m.keys.Up):
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case key.Matches(msg, m.keys.Down):
+		if m.cursor < len(m.snapshot.Agents)-1 {
+			m.cursor++
+		}
+	case key.Matches(msg, m.keys.Refresh):
+		return m, m.fetch
+	case key.Matches(msg, m.keys.Peek):
+		if a, ok := m.selected(); ok {
+			m.mode = modePeek
+			m.peekAgent = a.Address()
+			m.peek.SetContent(dimStyle.Render("Capturing " + m.peekAgent + "..."))
+			return m, m.peekCmd(m.peekAgent)
+		}
+	case key.Matches(msg, m.keys.Nudge):
+		if _, ok := m.selected(); ok {
+			m.mode = modeNudge
+			m.nudge.SetValue("")
+			m.nudge.Focus()
+			return m, textinput.Blink
+		}
+	case key.Matches(msg, m.keys.Kill):
+		if a, ok := m.selected(); ok {
+			if a.AgentType != "polecat" {
+				m.flash = errorStyle.Render("Only polecat sessions can be killed from here")
+				return m, nil
+			}
+			m.mode = modeConfirmKill
+		}
+	}
+	return m, nil
+}
+
+// updatePeek handles keys while an agent's output is shown.
+func (m Model) updatePeek(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Back), key.Matches(msg, m.keys.Quit):
+		m.mode = modeList
+		m.peekAgent = ""
+		return m, nil
+	case key.Matches(msg, m.keys.Refresh):
+		return m, m.peekCmd(m.peekAgent)
+	}
+	var cmd tea.Cmd
+	m.peek, cmd = m.peek.Update(msg)
+	return m, cmd
+}
+
+// updateNudge handles keys while a nudge is being typed.
+func (m Model) updateNudge(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.mode = modeList
+		m.nudge.Blur()
+		return m, nil
+	case tea.KeyEnter:
+		m.mode = modeList
+		m.nudge.Blur()
+		message := strings.TrimSpace(m.nudge.Value())
+		a, ok := m.selected()
+		if message == "" || !ok {
+			return m, nil
+		}
+		m.flash = dimStyle.Render("Nudging " + a.Address() + "...")
+		return m, m.nudgeCmd(a.Address(), message)
+	}
+	var cmd tea.Cmd
+	m.nudge, cmd = m.nudge.Update(msg)
+	return m, cmd
+}
+
+// updateConfirmKill handles the kill confirmation prompt.
+func (m Model) updateConfirmKill(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.mode = modeList
+	a, ok := m.selected()
+	if msg.String() != "y" || !ok {
+		return m, nil
+	}
+	m.flash = dimStyle.Render("Stopping " + a.Address() + "...")
+	return m, m.killCmd(a.Address())
+}
+
+// View renders the model.
+func (m Model) View() string {
+	return m.renderView()
+}
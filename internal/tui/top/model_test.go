@@ -0,0 +1,153 @@
+package top
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/steveyegge/gastown/internal/monitoring"
+	"github.com/steveyegge/gastown/internal/web"
+)
+
+// fakeSource serves fixed dashboard data.
+type fakeSource struct{}
+
+func (fakeSource) FetchWorkers() ([]web.WorkerRow, error) {
+	return []web.WorkerRow{
+		{Name: "nux", Rig: "gastown", WorkStatus: "working", AgentType: "polecat"},
+		{Name: "toast", Rig: "gastown", WorkStatus: "stuck", AgentType: "polecat"},
+		{Name: "refinery", Rig: "gastown", WorkStatus: "idle", AgentType: "refinery", StatusHint: "Idle - Waiting for PRs"},
+	}, nil
+}
+
+func (fakeSource) FetchHooks() ([]web.HookRow, error) {
+	return []web.HookRow{{ID: "gt-abc", Title: "Fix login", Assignee: "gastown/polecats/nux"}}, nil
+}
+
+func (fakeSource) FetchConvoys() ([]web.ConvoyRow, error) {
+	return []web.ConvoyRow{
+		{ID: "hq-cv-1", Title: "Auth", Status: "open", Progress: "1/2", Completed: 1, Total: 2},
+		{ID: "hq-cv-2", Title: "Done", Status: "closed"},
+	}, nil
+}
+
+func (fakeSource) FetchMergeQueue() ([]web.MergeQueueRow, error) {
+	return []web.MergeQueueRow{{Number: 7, Repo: "gastown", Title: "Add top", CIStatus: "pass", Mergeable: "ready"}}, nil
+}
+
+func (fakeSource) FetchActivity() ([]web.ActivityRow, error) {
+	return []web.ActivityRow{{Time: "1m ago", Icon: "🎯", Summary: "nux hooked gt-abc"}}, nil
+}
+
+// loadedModel returns a model that has received one snapshot, with gt
+// commands recorded in calls.
+func loadedModel(t *testing.T, calls *[][]string) Model {
+	t.Helper()
+	m := New(fakeSource{}, 0).WithRunner(func(args ...string) (string, error) {
+		*calls = append(*calls, args)
+		return "agent output", nil
+	})
+	updated, _ := m.Update(m.fetch())
+	return updated.(Model)
+}
+
+func press(t *testing.T, m Model, keys ...string) (Model, tea.Cmd) {
+	t.Helper()
+	var cmd tea.Cmd
+	for _, k := range keys {
+		var msg tea.KeyMsg
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+		var updated tea.Model
+		updated, cmd = m.Update(msg)
+		m = updated.(Model)
+	}
+	return m, cmd
+}
+
+func TestFetch_JoinsHooksAndStatus(t *testing.T) {
+	var calls [][]string
+	m := loadedModel(t, &calls)
+
+	agents := m.snapshot.Agents
+	if len(agents) != 3 {
+		t.Fatalf("got %d agents, want 3", len(agents))
+	}
+	if agents[0].Hook == nil || agents[0].Hook.ID != "gt-abc" || agents[0].Status != monitoring.StatusWorking {
+		t.Errorf("nux = %+v, want working with gt-abc hooked", agents[0])
+	}
+	if agents[1].Status != monitoring.StatusError || agents[1].Hook != nil {
+		t.Errorf("toast = %+v, want error status and no hook", agents[1])
+	}
+	if agents[2].Address() != "gastown/refinery" {
+		t.Errorf("refinery address = %q", agents[2].Address())
+	}
+	if len(m.snapshot.Convoys) != 1 {
+		t.Errorf("closed convoys should be dropped, got %v", m.snapshot.Convoys)
+	}
+
+	view := m.View()
+	for _, want := range []string{"3 agents", "1 need attention", "gt-abc", "hq-cv-1", "#7", "nux hooked gt-abc"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q:\n%s", want, view)
+		}
+	}
+}
+
+func TestActions(t *testing.T) {
+	var calls [][]string
+	m := loadedModel(t, &calls)
+
+	// Peek the selected agent, then go back.
+	m, cmd := press(t, m, "p")
+	if m.mode != modePeek || m.peekAgent != "gastown/nux" {
+		t.Fatalf("mode = %v, peek agent = %q", m.mode, m.peekAgent)
+	}
+	updated, _ := m.Update(cmd())
+	m = updated.(Model)
+	if !strings.Contains(m.View(), "agent output") {
+		t.Errorf("peek view missing output:\n%s", m.View())
+	}
+	m, _ = press(t, m, "esc")
+	if m.mode != modeList {
+		t.Errorf("esc should leave peek, mode = %v", m.mode)
+	}
+
+	// Nudge the second agent.
+	m, cmd = press(t, m, "j", "n", "h", "i", "enter")
+	if m.mode != modeList || cmd == nil {
+		t.Fatalf("enter should send the nudge, mode = %v", m.mode)
+	}
+	cmd()
+
+	// Kill needs confirmation and only works on polecats.
+	m, cmd = press(t, m, "x", "y")
+	if cmd == nil {
+		t.Fatal("confirmed kill should run a command")
+	}
+	cmd()
+	m, _ = press(t, m, "j", "x")
+	if m.mode != modeList || !strings.Contains(m.flash, "Only polecat") {
+		t.Errorf("killing the refinery should be refused, flash = %q", m.flash)
+	}
+
+	want := [][]string{
+		{"peek", "gastown/nux", "-n", "20"},
+		{"nudge", "gastown/toast", "hi"},
+		{"all", "stop", "gastown/toast"},
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if strings.Join(calls[i], " ") != strings.Join(want[i], " ") {
+			t.Errorf("call %d = %v, want %v", i, calls[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package top
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/steveyegge/gastown/internal/monitoring"
+	"github.com/steveyegge/gastown/internal/web"
+)
+
+// Styles for gt top
+var (
+	titleStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("12"))
+
+	sectionStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("15"))
+
+	selectedStyle = lipgloss.NewStyle().
+			Background(lipgloss.Color("236")).
+			Foreground(lipgloss.Color("15"))
+
+	dimStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("8")) // gray
+
+	okStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color("10")) // green
+
+	warnStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("11")) // yellow
+
+	errorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("9")) // red
+)
+
+// Panel sizes. The agents panel scrolls when it has more rows than fit;
+// events fill whatever height is left.
+const (
+	maxConvoyRows = 5
+	maxMQRows     = 5
+	minEventRows  = 3
+)
+
+// renderView renders the entire view.
+func (m Model) renderView() string {
+	if m.mode == modePeek {
+		return m.renderPeek()
+	}
+
+	var b strings.Builder
+	b.WriteString(m.renderHeader())
+	b.WriteString("\n\n")
+
+	if m.err != nil {
+		b.WriteString(errorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(m.renderAgents())
+	b.WriteString("\n")
+	b.WriteString(renderConvoys(m.snapshot.Convoys))
+	b.WriteString("\n")
+	b.WriteString(renderMergeQueue(m.snapshot.MergeQueue))
+	b.WriteString("\n")
+
+	footer := m.renderFooter()
+	used := lipgloss.Height(b.String()) + lipgloss.Height(footer) + 1
+	eventRows := minEventRows
+	if m.height > 0 {
+		eventRows = max(m.height-used, minEventRows)
+	}
+	b.WriteString(renderActivity(m.snapshot.Activity, eventRows))
+	b.WriteString("\n")
+	b.WriteString(footer)
+	return b.String()
+}
+
+// renderHeader renders the title line with town-wide counts.
+func (m Model) renderHeader() string {
+	title := titleStyle.Render("Gas Town")
+	if !m.loaded {
+		return title + dimStyle.Render("  loading...")
+	}
+	working, hooked, attention := 0, 0, 0
+	for _, a := range m.snapshot.Agents {
+		if a.Status == monitoring.StatusWorking {
+			working++
+		}
+		if a.Hook != nil {
+			hooked++
+		}
+		if a.Status.NeedsAttention() {
+			attention++
+		}
+	}
+	stats := fmt.Sprintf("  %d agents · %d working · %d hooked", len(m.snapshot.Agents), working, hooked)
+	if attention > 0 {
+		stats += " · " + warnStyle.Render(fmt.Sprintf("%d need attention", attention))
+	}
+	updated := dimStyle.Render(fmt.Sprintf("  updated %s", m.snapshot.Fetched.Format("15:04:05")))
+	return title + stats + updated
+}
+
+// agentRows is how many agents fit in the agents panel.
+func (m Model) agentRows() int {
+	if m.height <= 0 {
+		return len(m.snapshot.Agents)
+	}
+	return max(m.height/3, 5)
+}
+
+// renderAgents renders the agents panel, scrolled to keep the cursor in view.
+func (m Model) renderAgents() string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render(fmt.Sprintf("AGENTS (%d)", len(m.snapshot.Agents))))
+	b.WriteString("\n")
+	if len(m.snapshot.Agents) == 0 {
+		b.WriteString(dimStyle.Render("  No agents"))
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	rows := m.agentRows()
+	start := 0
+	if m.cursor >= rows {
+		start = m.cursor - rows + 1
+	}
+	end := min(start+rows, len(m.snapshot.Agents))
+	for i := start; i < end; i++ {
+		a := m.snapshot.Agents[i]
+		line := fmt.Sprintf("  %-10s %-24s %-12s %s",
+			a.Status, truncate(a.Address(), 24), hookID(a), truncate(agentWork(a), max(m.width-54, 20)))
+		switch {
+		case i == m.cursor:
+			b.WriteString(selectedStyle.Render(line))
+		case a.Status.NeedsAttention():
+			b.WriteString(warnStyle.Render(line))
+		case a.Status == monitoring.StatusWorking:
+			b.WriteString(okStyle.Render(line))
+		default:
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+	if hidden := len(m.snapshot.Agents) - (end - start); hidden > 0 {
+		b.WriteString(dimStyle.Render(fmt.Sprintf("  … %d more", hidden)))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// hookID returns the bead on an agent's hook, or a dash.
+func hookID(a Agent) string {
+	if a.Hook != nil {
+		return a.Hook.ID
+	}
+	return "—"
+}
+
+// agentWork describes what an agent is doing: its hooked bead, its
+// assigned issue, or its status hint.
+func agentWork(a Agent) string {
+	switch {
+	case a.Hook != nil:
+		work := a.Hook.Title
+		if a.Hook.IsStale {
+			work += " (hooked " + a.Hook.Age + ")"
+		}
+		return work
+	case a.IssueTitle != "":
+		return a.IssueID + " " + a.IssueTitle
+	default:
+		return a.StatusHint
+	}
+}
+
+// renderConvoys renders open convoys with progress bars.
+func renderConvoys(convoys []web.ConvoyRow) string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render(fmt.Sprintf("CONVOYS (%d)", len(convoys))))
+	b.WriteString("\n")
+	if len(convoys) == 0 {
+		b.WriteString(dimStyle.Render("  No open convoys"))
+		b.WriteString("\n")
+	}
+	for i, c := range convoys {
+		if i == maxConvoyRows {
+			b.WriteString(dimStyle.Render(fmt.Sprintf("  … %d more", len(convoys)-i)))
+			b.WriteString("\n")
+			break
+		}
+		fmt.Fprintf(&b, "  %-12s %s %-5s %s\n", c.ID, progressBar(c.Completed, c.Total, 10), c.Progress, truncate(c.Title, 50))
+	}
+	return b.String()
+}
+
+// progressBar draws done out of total as a bar width cells wide.
+func progressBar(done, total, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = min(done*width/total, width)
+	}
+	return okStyle.Render(strings.Repeat("█", filled)) + dimStyle.Render(strings.Repeat("░", width-filled))
+}
+
+// renderMergeQueue renders open PRs with CI and mergeability.
+func renderMergeQueue(mq []web.MergeQueueRow) string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render(fmt.Sprintf("MERGE QUEUE (%d)", len(mq))))
+	b.WriteString("\n")
+	if len(mq) == 0 {
+		b.WriteString(dimStyle.Render("  Empty"))
+		b.WriteString("\n")
+	}
+	for i, pr := range mq {
+		if i == maxMQRows {
+			b.WriteString(dimStyle.Render(fmt.Sprintf("  … %d more", len(mq)-i)))
+			b.WriteString("\n")
+			break
+		}
+		ci := pr.CIStatus
+		switch ci {
+		case "pass":
+			ci = okStyle.Render(fmt.Sprintf("%-7s", ci))
+		case "fail":
+			ci = errorStyle.Render(fmt.Sprintf("%-7s", ci))
+		default:
+			ci = warnStyle.Render(fmt.Sprintf("%-7s", ci))
+		}
+		held := ""
+		if pr.Held {
+			held = dimStyle.Render(" (held)")
+		}
+		fmt.Fprintf(&b, "  #%-5d %-12s %s %-8s %s%s\n", pr.Number, truncate(pr.Repo, 12), ci, pr.Mergeable, truncate(pr.Title, 50), held)
+	}
+	return b.String()
+}
+
+// renderActivity renders up to rows recent events.
+func renderActivity(activity []web.ActivityRow, rows int) string {
+	var b strings.Builder
+	b.WriteString(sectionStyle.Render("RECENT EVENTS"))
+	b.WriteString("\n")
+	if len(activity) == 0 {
+		b.WriteString(dimStyle.Render("  No recent events"))
+		b.WriteString("\n")
+	}
+	for i, e := range activity {
+		if i == rows {
+			break
+		}
+		fmt.Fprintf(&b, "  %s %s %s\n", dimStyle.Render(fmt.Sprintf("%-8s", e.Time)), e.Icon, truncate(e.Summary, 80))
+	}
+	return b.String()
+}
+
+// renderFooter renders the prompt for the current mode, the last action's
+// result, and key help.
+func (m Model) renderFooter() string {
+	var b strings.Builder
+	a, _ := m.selected()
+	switch m.mode {
+	case modeNudge:
+		b.WriteString("Nudge " + a.Address() + ": " + m.nudge.View())
+		b.WriteString("\n")
+		b.WriteString(dimStyle.Render("enter:send  esc:cancel"))
+		return b.String()
+	case modeConfirmKill:
+		b.WriteString(warnStyle.Render("Stop " + a.Address() + "'s session? (y/N)"))
+		return b.String()
+	}
+	if m.flash != "" {
+		b.WriteString(m.flash)
+		b.WriteString("\n")
+	}
+	if m.showHelp {
+		b.WriteString(m.help.View(m.keys))
+	} else {
+		b.WriteString(dimStyle.Render("j/k:select  p:peek  n:nudge  x:kill  r:refresh  q:quit  ?:help"))
+	}
+	return b.String()
+}
+
+// renderPeek renders an agent's captured output full-screen.
+func (m Model) renderPeek() string {
+	header := titleStyle.Render("Peek: "+m.peekAgent) + dimStyle.Render("  esc:back  r:refresh  ↑/↓:scroll")
+	return header + "\n\n" + m.peek.View()
+}
+
+// truncate shortens a string to the given rune length, preserving UTF-8.
+func truncate(s string, maxLen int) string {
+	if utf8.RuneCountInString(s) <= maxLen {
+		return s
+	}
+	runes := []rune(s)
+	if maxLen <= 3 {
+		return "..."
+	}
+	return string(runes[:maxLen-3]) + "..."
+}
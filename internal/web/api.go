@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/steveyegge/gastown/internal/bdcmd"
+	"github.com/steveyegge/gastown/internal/mail"
 )
 
 const (
@@ -94,6 +95,8 @@ func (h *APIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		h.handleMailRead(w, r)
 	case path == "/mail/send" && r.Method == http.MethodPost:
 		h.handleMailSend(w, r)
+	case path == "/mail/thread" && r.Method == http.MethodGet:
+		h.handleMailThread(w, r)
 	case path == "/issues/show" && r.Method == http.MethodGet:
 		h.handleIssueShow(w, r)
 	case path == "/pr/show" && r.Method == http.MethodGet:
@@ -233,6 +236,20 @@ type MailMessage struct {
 	Timestamp string `json:"timestamp"`
 	Read      bool   `json:"read"`
 	Priority  string `json:"priority,omitempty"`
+	ThreadID  string `json:"thread_id,omitempty"`
+	ReplyTo   string `json:"reply_to,omitempty"`
+
+	Attachments []MailAttachment `json:"attachments,omitempty"`
+}
+
+// MailAttachment is a file attached to a mail message. Inline attachments
+// carry base64 content in Data; referenced files carry only Path.
+type MailAttachment struct {
+	Name        string `json:"name"`
+	ContentType string `json:"content_type,omitempty"`
+	Size        int64  `json:"size"`
+	Path        string `json:"path,omitempty"`
+	Data        string `json:"data,omitempty"`
 }
 
 // MailInboxResponse is the response for /api/mail/inbox.
@@ -299,17 +316,54 @@ func (h *APIHandler) handleMailRead(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	output, err := h.runGtCommand(r.Context(), 10*time.Second, []string{"mail", "read", msgID})
-	if err != nil {
-		h.sendError(w, "Failed to read message: "+err.Error(), http.StatusInternalServerError)
+	// JSON output carries attachments; fall back to parsing the text view.
+	var msg MailMessage
+	output, err := h.runGtCommand(r.Context(), 10*time.Second, []string{"mail", "read", msgID, "--json"})
+	if err != nil || json.Unmarshal([]byte(output), &msg) != nil {
+		output, err = h.runGtCommand(r.Context(), 10*time.Second, []string{"mail", "read", msgID})
+		if err != nil {
+			h.sendError(w, "Failed to read message: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		msg = parseMailReadOutput(output, msgID)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(msg)
+}
+
+// MailThreadResponse is the response for /api/mail/thread.
+type MailThreadResponse struct {
+	ThreadID string             `json:"thread_id"`
+	Entries  []mail.ThreadEntry `json:"entries"`
+}
+
+// handleMailThread returns a conversation in reply order. The id may be a
+// thread ID or any message ID in the thread.
+func (h *APIHandler) handleMailThread(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		h.sendError(w, "Missing thread ID", http.StatusBadRequest)
 		return
 	}
 
-	// Parse the message output
-	msg := parseMailReadOutput(output, msgID)
+	output, err := h.runGtCommand(r.Context(), 10*time.Second, []string{"mail", "thread", id, "--json"})
+	if err != nil {
+		h.sendError(w, "Failed to fetch thread: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var messages []*mail.Message
+	if err := json.Unmarshal([]byte(output), &messages); err != nil {
+		h.sendError(w, "Failed to parse thread: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
 
+	resp := MailThreadResponse{ThreadID: id, Entries: mail.OrderThread(messages)}
+	if len(messages) > 0 && messages[0].ThreadID != "" {
+		resp.ThreadID = messages[0].ThreadID
+	}
 	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(msg)
+	_ = json.NewEncoder(w).Encode(resp)
 }
 
 // MailSendRequest is the request body for /api/mail/send.
@@ -318,6 +372,7 @@ type MailSendRequest struct {
 	Subject string `json:"subject"`
 	Body    string `json:"body"`
 	ReplyTo string `json:"reply_to,omitempty"`
+	Receipt string `json:"receipt,omitempty"` // "event" or "mail"
 }
 
 // handleMailSend sends a new message.
@@ -340,6 +395,9 @@ func (h *APIHandler) handleMailSend(w http.ResponseWriter, r *http.Request) {
 	if req.ReplyTo != "" {
 		args = append(args, "--reply-to", req.ReplyTo)
 	}
+	if req.Receipt != "" {
+		args = append(args, "--receipt="+req.Receipt)
+	}
 
 	output, err := h.runGtCommand(r.Context(), 30*time.Second, args)
 	if err != nil {
@@ -343,6 +343,19 @@ func TestAPIHandler_Run_EmptyCommand(t *testing.T) {
 	}
 }
 
+func TestAPIHandler_MailThread_MissingID(t *testing.T) {
+	handler := NewAPIHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/mail/thread", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("GET /api/mail/thread without id status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
 func TestAPIHandler_NotFound(t *testing.T) {
 	handler := NewAPIHandler()
 
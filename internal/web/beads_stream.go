@@ -0,0 +1,87 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// BeadWatcher is implemented by fetchers that can stream bead changes.
+// ok is false when no change source is available (e.g., no beads daemon),
+// in which case the dashboard keeps relying on its refresh timer.
+type BeadWatcher interface {
+	WatchBeads(ctx context.Context) (changes <-chan beads.ChangeEvent, ok bool)
+}
+
+// WatchBeads streams create, update, status and delete events from the
+// beads daemon. Comments don't change anything the dashboard shows.
+func (f *LiveConvoyFetcher) WatchBeads(ctx context.Context) (<-chan beads.ChangeEvent, bool) {
+	if f.daemon == nil {
+		return nil, false
+	}
+	return f.daemon.Watch(ctx, beads.WatchFilter{
+		Types: []beads.ChangeType{beads.ChangeCreate, beads.ChangeUpdate, beads.ChangeStatus, beads.ChangeDelete},
+	}), true
+}
+
+// beadsStreamKeepalive is how often an idle stream sends an SSE comment so
+// proxies don't close it.
+const beadsStreamKeepalive = 30 * time.Second
+
+// beadsStreamHandler serves GET /api/beads/stream as Server-Sent Events.
+// Each bead change is sent as a "bead" event whose data is the ChangeEvent.
+type beadsStreamHandler struct {
+	watcher BeadWatcher
+}
+
+func (h *beadsStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "SSE not supported", http.StatusInternalServerError)
+		return
+	}
+
+	ctx := r.Context()
+	changes, ok := h.watcher.WatchBeads(ctx)
+	if !ok {
+		// 204 tells EventSource clients not to reconnect.
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	fmt.Fprint(w, ": connected\n\n")
+	flusher.Flush()
+
+	keepalive := time.NewTicker(beadsStreamKeepalive)
+	defer keepalive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepalive.C:
+			fmt.Fprint(w, ": keepalive\n\n")
+			flusher.Flush()
+		case ev, ok := <-changes:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(ev)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "event: bead\ndata: %s\n\n", data)
+			flusher.Flush()
+		}
+	}
+}
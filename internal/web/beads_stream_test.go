@@ -0,0 +1,53 @@
+package web
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+type stubBeadWatcher struct {
+	events []beads.ChangeEvent
+	ok     bool
+}
+
+func (s *stubBeadWatcher) WatchBeads(ctx context.Context) (<-chan beads.ChangeEvent, bool) {
+	if !s.ok {
+		return nil, false
+	}
+	ch := make(chan beads.ChangeEvent, len(s.events))
+	for _, ev := range s.events {
+		ch <- ev
+	}
+	close(ch)
+	return ch, true
+}
+
+func TestBeadsStreamHandler(t *testing.T) {
+	h := &beadsStreamHandler{watcher: &stubBeadWatcher{ok: true, events: []beads.ChangeEvent{
+		{Type: beads.ChangeStatus, IssueID: "gt-1", NewStatus: "hooked"},
+	}}}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/beads/stream", nil))
+
+	if ct := rec.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "event: bead\ndata: {") || !strings.Contains(body, `"IssueID":"gt-1"`) {
+		t.Errorf("body missing bead event:\n%s", body)
+	}
+}
+
+func TestBeadsStreamHandlerNoDaemon(t *testing.T) {
+	h := &beadsStreamHandler{watcher: &stubBeadWatcher{}}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/beads/stream", nil))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want 204 when no daemon is configured", rec.Code)
+	}
+}
@@ -0,0 +1,260 @@
+package web
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ConvoyHistoryFile is the JSONL time series of convoy progress, relative to
+// the town root.
+const ConvoyHistoryFile = ".runtime/convoy-history.jsonl"
+
+// maxConvoyHistorySize is the size at which the history file is rotated to
+// <file>.1, replacing the previous segment. Burndowns read both, so about
+// this much history is always kept and never more than twice it.
+const maxConvoyHistorySize = 4 << 20
+
+// DefaultHistoryInterval is how often the dashboard snapshots convoy progress.
+const DefaultHistoryInterval = 15 * time.Minute
+
+// ConvoySnapshot is one recorded progress sample for a convoy.
+type ConvoySnapshot struct {
+	Time      time.Time `json:"ts"`
+	ConvoyID  string    `json:"convoy"`
+	Completed int       `json:"completed"`
+	Total     int       `json:"total"`
+}
+
+// BurndownPoint is a convoy progress sample as returned to charts.
+type BurndownPoint struct {
+	Time      time.Time `json:"ts"`
+	Completed int       `json:"completed"`
+	Total     int       `json:"total"`
+	Remaining int       `json:"remaining"`
+}
+
+// ConvoyHistory records convoy progress snapshots to a JSONL file and reads
+// them back as burndown series. Once the file reaches maxConvoyHistorySize
+// it is rotated, dropping the oldest samples. It is safe for concurrent use.
+type ConvoyHistory struct {
+	path    string
+	maxSize int64 // Rotate the file at this many bytes
+
+	mu sync.Mutex
+	// last holds the most recent snapshot per convoy so unchanged progress
+	// is only written once per heartbeat.
+	last map[string]ConvoySnapshot
+}
+
+// historyHeartbeat is the longest gap between snapshots of a convoy whose
+// progress has not changed, so charts still show flat stretches.
+const historyHeartbeat = 6 * time.Hour
+
+// NewConvoyHistory creates a history store backed by path.
+func NewConvoyHistory(path string) *ConvoyHistory {
+	return &ConvoyHistory{path: path, maxSize: maxConvoyHistorySize}
+}
+
+// Record appends a snapshot for each convoy whose progress changed since the
+// last recorded sample, or whose last sample is older than the heartbeat.
+func (h *ConvoyHistory) Record(rows []ConvoyRow, now time.Time) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.last == nil {
+		if err := h.loadLast(); err != nil {
+			return err
+		}
+	}
+
+	var batch []ConvoySnapshot
+	for _, row := range rows {
+		snap := ConvoySnapshot{Time: now.UTC(), ConvoyID: row.ID, Completed: row.Completed, Total: row.Total}
+		prev, ok := h.last[row.ID]
+		if ok && prev.Completed == snap.Completed && prev.Total == snap.Total && now.Sub(prev.Time) < historyHeartbeat {
+			continue
+		}
+		batch = append(batch, snap)
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(h.path), 0755); err != nil {
+		return fmt.Errorf("creating history dir: %w", err)
+	}
+	f, err := os.OpenFile(h.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening convoy history: %w", err)
+	}
+
+	enc := json.NewEncoder(f)
+	for _, snap := range batch {
+		if err := enc.Encode(snap); err != nil {
+			_ = f.Close()
+			return fmt.Errorf("writing convoy history: %w", err)
+		}
+		h.last[snap.ConvoyID] = snap
+	}
+	info, err := f.Stat()
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("writing convoy history: %w", err)
+	}
+	if info.Size() >= h.maxSize {
+		if err := os.Rename(h.path, h.path+".1"); err != nil {
+			return fmt.Errorf("rotating convoy history: %w", err)
+		}
+	}
+	return nil
+}
+
+// Burndown returns the recorded samples for convoyID since the given time,
+// oldest first.
+func (h *ConvoyHistory) Burndown(convoyID string, since time.Time) ([]BurndownPoint, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	points := []BurndownPoint{}
+	err := h.scan(func(snap ConvoySnapshot) {
+		if snap.ConvoyID != convoyID || snap.Time.Before(since) {
+			return
+		}
+		points = append(points, BurndownPoint{
+			Time:      snap.Time,
+			Completed: snap.Completed,
+			Total:     snap.Total,
+			Remaining: snap.Total - snap.Completed,
+		})
+	})
+	return points, err
+}
+
+// loadLast seeds h.last from the file so a restarted dashboard does not
+// re-record unchanged convoys.
+func (h *ConvoyHistory) loadLast() error {
+	h.last = make(map[string]ConvoySnapshot)
+	return h.scan(func(snap ConvoySnapshot) {
+		h.last[snap.ConvoyID] = snap
+	})
+}
+
+// scan calls fn for each snapshot, oldest first: the rotated segment, then
+// the live file. Missing files are empty; malformed lines are skipped.
+func (h *ConvoyHistory) scan(fn func(ConvoySnapshot)) error {
+	if err := scanHistoryFile(h.path+".1", fn); err != nil {
+		return err
+	}
+	return scanHistoryFile(h.path, fn)
+}
+
+func scanHistoryFile(path string, fn func(ConvoySnapshot)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("opening convoy history: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var snap ConvoySnapshot
+		if json.Unmarshal(scanner.Bytes(), &snap) != nil || snap.ConvoyID == "" {
+			continue
+		}
+		fn(snap)
+	}
+	return scanner.Err()
+}
+
+// RunConvoyHistoryRecorder snapshots fetcher's convoys into history every
+// interval until ctx is canceled. The first snapshot is taken immediately.
+func RunConvoyHistoryRecorder(ctx context.Context, fetcher ConvoyFetcher, history *ConvoyHistory, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		rows, err := fetcher.FetchConvoys()
+		if err != nil {
+			log.Printf("dashboard: convoy history: FetchConvoys failed: %v", err)
+		} else if err := history.Record(rows, time.Now()); err != nil {
+			log.Printf("dashboard: convoy history: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// BurndownFetcher is implemented by fetchers that can serve convoy burndown
+// history.
+type BurndownFetcher interface {
+	FetchConvoyBurndown(convoyID string, since time.Time) ([]BurndownPoint, error)
+}
+
+// FetchConvoyBurndown returns recorded progress for a convoy since the given time.
+func (f *LiveConvoyFetcher) FetchConvoyBurndown(convoyID string, since time.Time) ([]BurndownPoint, error) {
+	return f.History().Burndown(convoyID, since)
+}
+
+// History returns the convoy history store for this town.
+func (f *LiveConvoyFetcher) History() *ConvoyHistory {
+	f.historyOnce.Do(func() {
+		f.history = NewConvoyHistory(filepath.Join(f.townRoot, ConvoyHistoryFile))
+	})
+	return f.history
+}
+
+// burndownHandler serves GET /api/convoys/burndown?id=<convoy>&days=<n>.
+type burndownHandler struct {
+	fetcher BurndownFetcher
+}
+
+func (h *burndownHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		http.Error(w, "Missing convoy id", http.StatusBadRequest)
+		return
+	}
+	days := 14
+	if s := r.URL.Query().Get("days"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			http.Error(w, "Invalid days", http.StatusBadRequest)
+			return
+		}
+		days = n
+	}
+
+	points, err := h.fetcher.FetchConvoyBurndown(id, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"convoy": id,
+		"days":   days,
+		"points": points,
+	})
+}
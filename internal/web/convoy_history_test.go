@@ -0,0 +1,134 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestConvoyHistory_RecordAndBurndown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".runtime", "convoy-history.jsonl")
+	h := NewConvoyHistory(path)
+	t0 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	steps := []struct {
+		at        time.Time
+		completed int
+	}{
+		{t0, 0},
+		{t0.Add(15 * time.Minute), 0}, // unchanged: skipped
+		{t0.Add(time.Hour), 2},
+		{t0.Add(8 * time.Hour), 2}, // unchanged past heartbeat: kept
+	}
+	for _, s := range steps {
+		rows := []ConvoyRow{{ID: "hq-cv-1", Completed: s.completed, Total: 5}, {ID: "hq-cv-2", Total: 1}}
+		if err := h.Record(rows, s.at); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	points, err := h.Burndown("hq-cv-1", t0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(points) != 3 {
+		t.Fatalf("got %d points, want 3: %+v", len(points), points)
+	}
+	if points[1].Completed != 2 || points[1].Remaining != 3 {
+		t.Errorf("points[1] = %+v", points[1])
+	}
+
+	// Since filters older samples.
+	points, _ = h.Burndown("hq-cv-1", t0.Add(30*time.Minute))
+	if len(points) != 2 {
+		t.Errorf("got %d points since +30m, want 2", len(points))
+	}
+
+	// A fresh store picks up the last snapshot from disk.
+	h2 := NewConvoyHistory(path)
+	if err := h2.Record([]ConvoyRow{{ID: "hq-cv-1", Completed: 2, Total: 5}}, t0.Add(9*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	points, _ = h2.Burndown("hq-cv-1", t0)
+	if len(points) != 3 {
+		t.Errorf("reopened store re-recorded unchanged progress: %d points", len(points))
+	}
+}
+
+func TestConvoyHistory_Rotates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".runtime", "convoy-history.jsonl")
+	h := NewConvoyHistory(path)
+	h.maxSize = 400 // a handful of samples
+	t0 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	const samples = 50
+	for i := 0; i < samples; i++ {
+		rows := []ConvoyRow{{ID: "hq-cv-1", Completed: i, Total: samples}}
+		if err := h.Record(rows, t0.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, p := range []string{path, path + ".1"} {
+		if info, err := os.Stat(p); err != nil || info.Size() > h.maxSize+100 {
+			t.Errorf("%s: %v, %v; want at most about %d bytes", filepath.Base(p), info, err, h.maxSize)
+		}
+	}
+	points, err := h.Burndown("hq-cv-1", t0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(points) == 0 || len(points) >= samples {
+		t.Fatalf("got %d points, want the oldest of %d dropped", len(points), samples)
+	}
+	if last := points[len(points)-1]; last.Completed != samples-1 {
+		t.Errorf("last point = %+v, want the newest sample", last)
+	}
+	for i := 1; i < len(points); i++ {
+		if !points[i].Time.After(points[i-1].Time) {
+			t.Fatalf("points out of order at %d: %+v", i, points)
+		}
+	}
+}
+
+type stubBurndownFetcher struct {
+	points []BurndownPoint
+	id     string
+}
+
+func (s *stubBurndownFetcher) FetchConvoyBurndown(convoyID string, _ time.Time) ([]BurndownPoint, error) {
+	s.id = convoyID
+	return s.points, nil
+}
+
+func TestBurndownHandler(t *testing.T) {
+	stub := &stubBurndownFetcher{points: []BurndownPoint{{Completed: 1, Total: 3, Remaining: 2}}}
+	h := &burndownHandler{fetcher: stub}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/convoys/burndown?id=hq-cv-1&days=7", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var resp struct {
+		Convoy string          `json:"convoy"`
+		Days   int             `json:"days"`
+		Points []BurndownPoint `json:"points"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if stub.id != "hq-cv-1" || resp.Days != 7 || len(resp.Points) != 1 || resp.Points[0].Remaining != 2 {
+		t.Errorf("resp = %+v", resp)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/convoys/burndown", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("missing id: status = %d, want 400", rec.Code)
+	}
+}
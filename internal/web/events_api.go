@@ -0,0 +1,99 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+// EventQuerier is implemented by fetchers that can run filtered, paginated
+// queries over the event log.
+type EventQuerier interface {
+	QueryEvents(q events.Query) (events.Page, error)
+}
+
+// QueryEvents runs q against the town's event log.
+func (f *LiveConvoyFetcher) QueryEvents(q events.Query) (events.Page, error) {
+	return events.Open(f.townRoot).Query(q)
+}
+
+// eventsHandler serves GET /api/events with optional filters:
+//
+//	type=sling,hook  actor=gastown/crew/joe  rig=gastown  visibility=feed
+//	since=<RFC3339>  until=<RFC3339>  limit=<n>  cursor=<next_cursor>
+type eventsHandler struct {
+	querier EventQuerier
+}
+
+// eventsResponse is the JSON body returned by /api/events.
+type eventsResponse struct {
+	Events     []eventJSON `json:"events"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+	Total      int         `json:"total"`
+}
+
+// eventJSON is an event plus the formatted fields the activity feed shows.
+type eventJSON struct {
+	events.Event
+	Icon    string `json:"icon"`
+	Summary string `json:"summary"`
+}
+
+func (h *eventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	params := r.URL.Query()
+	q := events.Query{
+		Actor:      params.Get("actor"),
+		Rig:        params.Get("rig"),
+		Visibility: params.Get("visibility"),
+		Cursor:     params.Get("cursor"),
+	}
+	for _, t := range strings.Split(params.Get("type"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			q.Types = append(q.Types, t)
+		}
+	}
+	for name, dst := range map[string]*time.Time{"since": &q.Since, "until": &q.Until} {
+		if s := params.Get(name); s != "" {
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				http.Error(w, "Invalid "+name+": want RFC3339", http.StatusBadRequest)
+				return
+			}
+			*dst = t
+		}
+	}
+	if s := params.Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+		q.Limit = n
+	}
+
+	page, err := h.querier.QueryEvents(q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := eventsResponse{Events: []eventJSON{}, NextCursor: page.NextCursor, Total: page.Total}
+	for _, ev := range page.Events {
+		resp.Events = append(resp.Events, eventJSON{
+			Event:   ev,
+			Icon:    eventIcon(ev.Type),
+			Summary: eventSummary(ev.Type, ev.Actor, ev.Payload),
+		})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
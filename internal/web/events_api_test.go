@@ -0,0 +1,61 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+type stubEventQuerier struct {
+	got  events.Query
+	page events.Page
+}
+
+func (s *stubEventQuerier) QueryEvents(q events.Query) (events.Page, error) {
+	s.got = q
+	return s.page, nil
+}
+
+func TestEventsHandler(t *testing.T) {
+	stub := &stubEventQuerier{page: events.Page{
+		Events:     []events.Event{{Type: "sling", Actor: "mayor", Payload: map[string]interface{}{"bead": "gt-1", "target": "gastown/nux"}}},
+		NextCursor: "7",
+		Total:      12,
+	}}
+	h := &eventsHandler{querier: stub}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet,
+		"/api/events?type=sling,+hook&rig=gastown&since=2026-03-01T00:00:00Z&limit=5&cursor=9", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+
+	q := stub.got
+	if len(q.Types) != 2 || q.Types[1] != "hook" || q.Rig != "gastown" || q.Limit != 5 || q.Cursor != "9" {
+		t.Errorf("query = %+v", q)
+	}
+	if !q.Since.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) || !q.Until.IsZero() {
+		t.Errorf("time range = %v..%v", q.Since, q.Until)
+	}
+
+	var resp eventsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Total != 12 || resp.NextCursor != "7" || len(resp.Events) != 1 || resp.Events[0].Icon == "" || resp.Events[0].Summary == "" {
+		t.Errorf("resp = %+v", resp)
+	}
+
+	for _, bad := range []string{"/api/events?since=yesterday", "/api/events?limit=0"} {
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, bad, nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", bad, rec.Code)
+		}
+	}
+}
@@ -10,18 +10,24 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/activity"
 	"github.com/steveyegge/gastown/internal/bdcmd"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/dog"
+	"github.com/steveyegge/gastown/internal/escalation"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 // Command timeout constants
 const (
 	cmdTimeout   = 15 * time.Second // timeout for most commands (bd can be slow with large datasets)
-	ghCmdTimeout = 10 * time.Second // longer timeout for GitHub API calls
+	ghCmdTimeout = 10 * time.Second // timeout for forge API calls (gh, GitLab, Bitbucket)
 )
 
 // runCmd executes a command with a timeout and returns stdout.
@@ -70,6 +76,13 @@ func runBdCmd(beadsDir string, args ...string) (*bytes.Buffer, error) {
 type LiveConvoyFetcher struct {
 	townRoot  string
 	townBeads string
+	daemon    *beads.DaemonClient // nil unless a beads daemon is configured
+
+	historyOnce sync.Once
+	history     *ConvoyHistory
+
+	mqOnce  sync.Once
+	mqCache *mergeQueueCache
 }
 
 // NewLiveConvoyFetcher creates a fetcher for the current workspace.
@@ -82,27 +95,55 @@ func NewLiveConvoyFetcher() (*LiveConvoyFetcher, error) {
 	return &LiveConvoyFetcher{
 		townRoot:  townRoot,
 		townBeads: filepath.Join(townRoot, ".beads"),
+		daemon:    beads.NewDaemonClientFromEnv(),
 	}, nil
 }
 
+// listIssues lists town beads through the beads daemon's HTTP API when one
+// is configured, otherwise through bd list. A zero Limit leaves the bound to
+// the daemon's or bd's default.
+func (f *LiveConvoyFetcher) listIssues(opts beads.DaemonListOptions) ([]*beads.Issue, error) {
+	if f.daemon != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+		return f.daemon.ListIssues(ctx, opts)
+	}
+
+	args := []string{"list", "--json"}
+	if opts.Limit > 0 {
+		args = append(args, fmt.Sprintf("--limit=%d", opts.Limit))
+	}
+	if opts.Type != "" {
+		args = append(args, "--type="+opts.Type)
+	}
+	if opts.Status != "" {
+		args = append(args, "--status="+opts.Status)
+	}
+	for _, label := range opts.Labels {
+		args = append(args, "--label="+label)
+	}
+	if opts.Assignee != "" {
+		args = append(args, "--assignee="+opts.Assignee)
+	}
+	stdout, err := runBdCmd(f.townBeads, args...)
+	if err != nil {
+		return nil, err
+	}
+	var issues []*beads.Issue
+	if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil {
+		return nil, fmt.Errorf("parsing bd list output: %w", err)
+	}
+	return issues, nil
+}
+
 // FetchConvoys fetches all open convoys with their activity data.
 func (f *LiveConvoyFetcher) FetchConvoys() ([]ConvoyRow, error) {
 	// List all open convoy-type issues
-	stdout, err := runBdCmd(f.townBeads, "list", "--type=convoy", "--status=open", "--json")
+	convoys, err := f.listIssues(beads.DaemonListOptions{Type: "convoy", Status: "open"})
 	if err != nil {
 		return nil, fmt.Errorf("listing convoys: %w", err)
 	}
 
-	var convoys []struct {
-		ID        string `json:"id"`
-		Title     string `json:"title"`
-		Status    string `json:"status"`
-		CreatedAt string `json:"created_at"`
-	}
-	if err := json.Unmarshal(stdout.Bytes(), &convoys); err != nil {
-		return nil, fmt.Errorf("parsing convoy list: %w", err)
-	}
-
 	// Build convoy rows with activity data
 	rows := make([]ConvoyRow, 0, len(convoys))
 	for _, c := range convoys {
@@ -280,23 +321,28 @@ func (f *LiveConvoyFetcher) getIssueDetailsBatch(issueIDs []string) map[string]*
 		return result
 	}
 
-	args := append([]string{"show"}, issueIDs...)
-	args = append(args, "--json")
-
-	stdout, err := runCmd(cmdTimeout, "bd", args...)
-	if err != nil {
-		return result
-	}
+	var issues []*beads.Issue
+	if f.daemon != nil {
+		// One request per ID, but over pooled connections rather than a
+		// bd subprocess for the whole batch.
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+		for _, id := range issueIDs {
+			if issue, err := f.daemon.Show(ctx, id); err == nil {
+				issues = append(issues, issue)
+			}
+		}
+	} else {
+		args := append([]string{"show"}, issueIDs...)
+		args = append(args, "--json")
 
-	var issues []struct {
-		ID        string `json:"id"`
-		Title     string `json:"title"`
-		Status    string `json:"status"`
-		Assignee  string `json:"assignee"`
-		UpdatedAt string `json:"updated_at"`
-	}
-	if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil {
-		return result
+		stdout, err := runCmd(cmdTimeout, "bd", args...)
+		if err != nil {
+			return result
+		}
+		if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil {
+			return result
+		}
 	}
 
 	for _, issue := range issues {
@@ -392,7 +438,9 @@ func calculateWorkStatus(completed, total int, activityColor string) string {
 	}
 }
 
-// FetchMergeQueue fetches open PRs from registered rigs.
+// FetchMergeQueue fetches open PRs from registered rigs. The forge (GitHub,
+// GitLab or Bitbucket) is chosen from each rig's git URL. Repos are fetched
+// concurrently and cached briefly; see mergeQueueCache.
 func (f *LiveConvoyFetcher) FetchMergeQueue() ([]MergeQueueRow, error) {
 	// Load registered rigs from config
 	rigsConfigPath := filepath.Join(f.townRoot, "mayor", "rigs.json")
@@ -401,46 +449,27 @@ func (f *LiveConvoyFetcher) FetchMergeQueue() ([]MergeQueueRow, error) {
 		return nil, fmt.Errorf("loading rigs config: %w", err)
 	}
 
-	var result []MergeQueueRow
-
+	var jobs []mergeQueueJob
 	for rigName, entry := range rigsConfig.Rigs {
-		// Convert git URL to owner/repo format for gh CLI
-		repoPath := gitURLToRepoPath(entry.GitURL)
-		if repoPath == "" {
+		remote, ok := parseGitRemote(entry.GitURL)
+		if !ok {
 			continue
 		}
-
-		prs, err := f.fetchPRsForRepo(repoPath, rigName)
-		if err != nil {
-			// Non-fatal: continue with other repos
+		provider := f.providerFor(remote)
+		if provider == nil {
 			continue
 		}
-		result = append(result, prs...)
+		jobs = append(jobs, mergeQueueJob{rig: rigName, remote: remote, provider: provider})
 	}
 
-	return result, nil
-}
-
-// gitURLToRepoPath converts a git URL to owner/repo format.
-// Supports HTTPS (https://github.com/owner/repo.git) and
-// SSH (git@github.com:owner/repo.git) formats.
-func gitURLToRepoPath(gitURL string) string {
-	// Handle HTTPS format: https://github.com/owner/repo.git
-	if strings.HasPrefix(gitURL, "https://github.com/") {
-		path := strings.TrimPrefix(gitURL, "https://github.com/")
-		path = strings.TrimSuffix(path, ".git")
-		return path
-	}
+	rows := f.mergeQueueCache().fetchAll(jobs)
 
-	// Handle SSH format: git@github.com:owner/repo.git
-	if strings.HasPrefix(gitURL, "git@github.com:") {
-		path := strings.TrimPrefix(gitURL, "git@github.com:")
-		path = strings.TrimSuffix(path, ".git")
-		return path
+	// Overlay refinery ordering from MR beads; forge data alone has no priority.
+	mrs, err := f.listIssues(beads.DaemonListOptions{Type: "merge-request", Status: "open"})
+	if err == nil {
+		annotateMergeQueue(rows, mrs)
 	}
-
-	// Unsupported format
-	return ""
+	return rows, nil
 }
 
 // prResponse represents the JSON response from gh pr list.
@@ -656,20 +685,11 @@ func (f *LiveConvoyFetcher) getAssignedIssuesMap() map[string]assignedIssue {
 	result := make(map[string]assignedIssue)
 
 	// Query all in_progress issues (these are the ones being worked on)
-	stdout, err := runBdCmd(f.townBeads, "list", "--status=in_progress", "--json")
+	issues, err := f.listIssues(beads.DaemonListOptions{Status: "in_progress"})
 	if err != nil {
 		return result // Return empty map on error
 	}
 
-	var issues []struct {
-		ID       string `json:"id"`
-		Title    string `json:"title"`
-		Assignee string `json:"assignee"`
-	}
-	if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil {
-		return result
-	}
-
 	for _, issue := range issues {
 		if issue.Assignee != "" {
 			result[issue.Assignee] = assignedIssue{
@@ -716,25 +736,11 @@ func truncateStatusHint(line string) string {
 // FetchMail fetches recent mail messages from the beads database.
 func (f *LiveConvoyFetcher) FetchMail() ([]MailRow, error) {
 	// List all message-type issues (mail)
-	stdout, err := runBdCmd(f.townBeads, "list", "--type=message", "--json", "--limit=50")
+	messages, err := f.listIssues(beads.DaemonListOptions{Type: "message", Limit: 50})
 	if err != nil {
 		return nil, fmt.Errorf("listing mail: %w", err)
 	}
 
-	var messages []struct {
-		ID        string   `json:"id"`
-		Title     string   `json:"title"`
-		Status    string   `json:"status"`
-		CreatedAt string   `json:"created_at"`
-		Priority  int      `json:"priority"`
-		Assignee  string   `json:"assignee"`   // "to" address stored here
-		CreatedBy string   `json:"created_by"` // "from" address
-		Labels    []string `json:"labels"`
-	}
-	if err := json.Unmarshal(stdout.Bytes(), &messages); err != nil {
-		return nil, fmt.Errorf("parsing mail list: %w", err)
-	}
-
 	rows := make([]MailRow, 0, len(messages))
 	for _, m := range messages {
 		// Parse timestamp
@@ -812,6 +818,14 @@ func formatMailAge(d time.Duration) string {
 	return fmt.Sprintf("%dd ago", int(d.Hours()/24))
 }
 
+// formatTimeUntil formats a duration until a future event (e.g., "in 3h").
+func formatTimeUntil(d time.Duration) string {
+	if d < time.Minute {
+		return "due"
+	}
+	return "in " + strings.TrimSuffix(formatMailAge(d), " ago")
+}
+
 // formatAgentAddress shortens agent addresses for display.
 // "gastown/polecats/Toast" -> "Toast (gastown)"
 // "mayor/" -> "Mayor"
@@ -897,53 +911,28 @@ func (f *LiveConvoyFetcher) FetchRigs() ([]RigRow, error) {
 	return rows, nil
 }
 
-// FetchDogs returns all dogs in the kennel with their state.
+// FetchDogs returns all dogs in the kennel with their state and the most
+// recent entry in each dog's event log.
 func (f *LiveConvoyFetcher) FetchDogs() ([]DogRow, error) {
-	kennelPath := filepath.Join(f.townRoot, "deacon", "dogs")
-
-	entries, err := os.ReadDir(kennelPath)
+	mgr := dog.NewManager(f.townRoot, nil)
+	dogs, err := mgr.List()
 	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, nil // No kennel yet
-		}
-		return nil, fmt.Errorf("reading kennel: %w", err)
+		return nil, err
 	}
 
-	var rows []DogRow
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-		name := entry.Name()
-		if strings.HasPrefix(name, ".") {
-			continue
-		}
-
-		// Read dog state file
-		stateFile := filepath.Join(kennelPath, name, ".dog.json")
-		data, err := os.ReadFile(stateFile)
-		if err != nil {
-			continue // Not a valid dog
-		}
-
-		var state struct {
-			Name       string            `json:"name"`
-			State      string            `json:"state"`
-			LastActive time.Time         `json:"last_active"`
-			Work       string            `json:"work,omitempty"`
-			Worktrees  map[string]string `json:"worktrees,omitempty"`
+	rows := make([]DogRow, 0, len(dogs))
+	for _, d := range dogs {
+		row := DogRow{
+			Name:       d.Name,
+			State:      string(d.State),
+			Work:       d.Work,
+			LastActive: formatMailAge(time.Since(d.LastActive)),
+			RigCount:   len(d.Worktrees),
 		}
-		if err := json.Unmarshal(data, &state); err != nil {
-			continue
+		if events, err := mgr.Events(d.Name, 1); err == nil && len(events) > 0 {
+			row.LastEvent = formatDogEvent(events[0])
 		}
-
-		rows = append(rows, DogRow{
-			Name:       state.Name,
-			State:      state.State,
-			Work:       state.Work,
-			LastActive: formatMailAge(time.Since(state.LastActive)),
-			RigCount:   len(state.Worktrees),
-		})
+		rows = append(rows, row)
 	}
 
 	// Sort by name
@@ -954,62 +943,57 @@ func (f *LiveConvoyFetcher) FetchDogs() ([]DogRow, error) {
 	return rows, nil
 }
 
-// FetchEscalations returns open escalations needing attention.
+// formatDogEvent renders a dog event for the dashboard, e.g.
+// "recalled gt-abc: stuck".
+func formatDogEvent(ev dog.Event) string {
+	s := strings.ReplaceAll(string(ev.Kind), "_", " ")
+	if ev.Work != "" {
+		s += " " + ev.Work
+	}
+	if ev.Message != "" {
+		s += ": " + ev.Message
+	}
+	return s
+}
+
+// FetchEscalations returns open escalations needing attention, with their
+// acknowledgement, assignment and SLA state.
 func (f *LiveConvoyFetcher) FetchEscalations() ([]EscalationRow, error) {
 	// List open escalations
-	stdout, err := runBdCmd(f.townBeads, "list", "--label=gt:escalation", "--status=open", "--json")
+	issues, err := f.listIssues(beads.DaemonListOptions{Labels: []string{"gt:escalation"}, Status: "open"})
 	if err != nil {
 		return nil, nil // No escalations or bd not available
 	}
 
-	var issues []struct {
-		ID          string   `json:"id"`
-		Title       string   `json:"title"`
-		CreatedAt   string   `json:"created_at"`
-		CreatedBy   string   `json:"created_by"`
-		Labels      []string `json:"labels"`
-		Description string   `json:"description"`
-	}
-	if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil {
-		return nil, fmt.Errorf("parsing escalations: %w", err)
+	cfg, err := config.LoadOrCreateEscalationConfig(config.EscalationConfigPath(f.townRoot))
+	if err != nil {
+		cfg = config.NewEscalationConfig()
 	}
 
-	var rows []EscalationRow
+	list := make([]*escalation.Escalation, 0, len(issues))
 	for _, issue := range issues {
-		row := EscalationRow{
-			ID:          issue.ID,
-			Title:       issue.Title,
-			EscalatedBy: formatAgentAddress(issue.CreatedBy),
-			Severity:    "medium", // default
-		}
+		list = append(list, escalation.FromBead(issue, cfg))
+	}
+	escalation.Sort(list)
 
-		// Parse severity from labels
-		for _, label := range issue.Labels {
-			if strings.HasPrefix(label, "severity:") {
-				row.Severity = strings.TrimPrefix(label, "severity:")
-			}
-			if label == "acked" {
-				row.Acked = true
-			}
+	now := time.Now()
+	rows := make([]EscalationRow, 0, len(list))
+	for _, e := range list {
+		row := EscalationRow{
+			ID:          e.ID,
+			Title:       e.Title,
+			Severity:    e.Severity,
+			EscalatedBy: formatAgentAddress(e.EscalatedBy),
+			Acked:       e.State != beads.EscalationOpen,
+			AssignedTo:  e.AssignedTo,
+			Breached:    e.Breached(now),
 		}
-
-		// Calculate age
-		if issue.CreatedAt != "" {
-			if t, err := time.Parse(time.RFC3339, issue.CreatedAt); err == nil {
-				row.Age = formatMailAge(time.Since(t))
-			}
+		if !e.CreatedAt.IsZero() {
+			row.Age = formatMailAge(now.Sub(e.CreatedAt))
 		}
-
 		rows = append(rows, row)
 	}
 
-	// Sort by severity (critical first), then by age
-	severityOrder := map[string]int{"critical": 0, "high": 1, "medium": 2, "low": 3}
-	sort.Slice(rows, func(i, j int) bool {
-		si, sj := severityOrder[rows[i].Severity], severityOrder[rows[j].Severity]
-		return si < sj
-	})
-
 	return rows, nil
 }
 
@@ -1055,27 +1039,50 @@ func (f *LiveConvoyFetcher) FetchHealth() (*HealthRow, error) {
 		}
 	}
 
+	row.Jobs = scheduledJobRows(f.townRoot, time.Now())
+
 	return row, nil
 }
 
+// scheduledJobRows reports the deacon's maintenance schedule.
+func scheduledJobRows(townRoot string, now time.Time) []ScheduledJobRow {
+	state, err := deacon.LoadScheduleState(townRoot)
+	if err != nil {
+		return nil
+	}
+	rows := make([]ScheduledJobRow, 0, len(state.Jobs))
+	for _, job := range state.Jobs {
+		r := ScheduledJobRow{
+			Name:    job.Name,
+			Cron:    job.Cron,
+			LastRun: "never",
+			NextRun: "—",
+			Failed:  job.LastError != "",
+			Error:   job.LastError,
+			Result:  job.Result,
+		}
+		if !job.LastRun.IsZero() {
+			r.LastRun = formatMailAge(now.Sub(job.LastRun))
+		}
+		switch {
+		case job.Disabled:
+			r.NextRun = "disabled"
+		case !job.NextRun.IsZero():
+			r.NextRun = formatTimeUntil(job.NextRun.Sub(now))
+		}
+		rows = append(rows, r)
+	}
+	return rows
+}
+
 // FetchQueues returns work queues and their status.
 func (f *LiveConvoyFetcher) FetchQueues() ([]QueueRow, error) {
 	// List queue-type beads
-	stdout, err := runBdCmd(f.townBeads, "list", "--type=queue", "--json")
+	queues, err := f.listIssues(beads.DaemonListOptions{Type: "queue"})
 	if err != nil {
 		return nil, nil // No queues or bd not available
 	}
 
-	var queues []struct {
-		ID          string `json:"id"`
-		Title       string `json:"title"`
-		Status      string `json:"status"`
-		Description string `json:"description"`
-	}
-	if err := json.Unmarshal(stdout.Bytes(), &queues); err != nil {
-		return nil, fmt.Errorf("parsing queues: %w", err)
-	}
-
 	var rows []QueueRow
 	for _, q := range queues {
 		row := QueueRow{
@@ -1119,23 +1126,13 @@ func (f *LiveConvoyFetcher) FetchSessions() ([]SessionRow, error) {
 // FetchHooks returns all hooked beads (work pinned to agents).
 func (f *LiveConvoyFetcher) FetchHooks() ([]HookRow, error) {
 	// Query all beads with status=hooked
-	stdout, err := runBdCmd(f.townBeads, "list", "--status=hooked", "--json", "--limit=0")
+	hooked, err := f.listIssues(beads.DaemonListOptions{Status: "hooked"})
 	if err != nil {
 		return nil, nil // No hooked beads or bd not available
 	}
 
-	var beads []struct {
-		ID        string `json:"id"`
-		Title     string `json:"title"`
-		Assignee  string `json:"assignee"`
-		UpdatedAt string `json:"updated_at"`
-	}
-	if err := json.Unmarshal(stdout.Bytes(), &beads); err != nil {
-		return nil, fmt.Errorf("parsing hooked beads: %w", err)
-	}
-
 	var rows []HookRow
-	for _, bead := range beads {
+	for _, bead := range hooked {
 		row := HookRow{
 			ID:       bead.ID,
 			Title:    bead.Title,
@@ -1176,25 +1173,13 @@ func (f *LiveConvoyFetcher) FetchMayor() (*MayorStatus, error) {
 // FetchIssues returns open issues (the backlog).
 func (f *LiveConvoyFetcher) FetchIssues() ([]IssueRow, error) {
 	// Query open issues (excluding internal types like messages, convoys, queues)
-	stdout, err := runBdCmd(f.townBeads, "list", "--status=open", "--json", "--limit=50")
+	issues, err := f.listIssues(beads.DaemonListOptions{Status: "open", Limit: 50})
 	if err != nil {
 		return nil, nil // No issues or bd not available
 	}
 
-	var beads []struct {
-		ID        string   `json:"id"`
-		Title     string   `json:"title"`
-		Type      string   `json:"type"`
-		Priority  int      `json:"priority"`
-		Labels    []string `json:"labels"`
-		CreatedAt string   `json:"created_at"`
-	}
-	if err := json.Unmarshal(stdout.Bytes(), &beads); err != nil {
-		return nil, fmt.Errorf("parsing issues: %w", err)
-	}
-
 	var rows []IssueRow
-	for _, bead := range beads {
+	for _, bead := range issues {
 		// Skip internal types (messages, convoys, queues, merge-requests, wisps)
 		switch bead.Type {
 		case "message", "convoy", "queue", "merge-request", "wisp", "agent":
@@ -1254,66 +1239,34 @@ func (f *LiveConvoyFetcher) FetchIssues() ([]IssueRow, error) {
 
 // FetchActivity returns recent activity from the event log.
 func (f *LiveConvoyFetcher) FetchActivity() ([]ActivityRow, error) {
-	eventsPath := filepath.Join(f.townRoot, ".events.jsonl")
-
-	// Read events file
-	data, err := os.ReadFile(eventsPath)
+	page, err := events.Open(f.townRoot).Query(events.Query{FeedOnly: true, Limit: 20})
 	if err != nil {
-		return nil, nil // No events file
+		return nil, nil // Unreadable events file: show no activity
 	}
 
-	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
-	if len(lines) == 0 {
-		return nil, nil
+	rows := make([]ActivityRow, 0, len(page.Events))
+	for _, event := range page.Events {
+		rows = append(rows, activityRow(event))
 	}
+	return rows, nil
+}
 
-	// Take last 20 events (most recent)
-	start := 0
-	if len(lines) > 20 {
-		start = len(lines) - 20
+// activityRow formats an event for the activity feed.
+func activityRow(event events.Event) ActivityRow {
+	row := ActivityRow{
+		Type:  event.Type,
+		Actor: formatAgentAddress(event.Actor),
+		Icon:  eventIcon(event.Type),
 	}
 
-	var rows []ActivityRow
-	for i := len(lines) - 1; i >= start; i-- {
-		line := lines[i]
-		if line == "" {
-			continue
-		}
-
-		var event struct {
-			Timestamp  string                 `json:"ts"`
-			Type       string                 `json:"type"`
-			Actor      string                 `json:"actor"`
-			Payload    map[string]interface{} `json:"payload"`
-			Visibility string                 `json:"visibility"`
-		}
-		if err := json.Unmarshal([]byte(line), &event); err != nil {
-			continue
-		}
-
-		// Skip audit-only events
-		if event.Visibility == "audit" {
-			continue
-		}
-
-		row := ActivityRow{
-			Type:  event.Type,
-			Actor: formatAgentAddress(event.Actor),
-			Icon:  eventIcon(event.Type),
-		}
-
-		// Calculate time ago
-		if t, err := time.Parse(time.RFC3339, event.Timestamp); err == nil {
-			row.Time = formatMailAge(time.Since(t))
-		}
-
-		// Generate human-readable summary
-		row.Summary = eventSummary(event.Type, event.Actor, event.Payload)
-
-		rows = append(rows, row)
+	// Calculate time ago
+	if t, err := time.Parse(time.RFC3339, event.Timestamp); err == nil {
+		row.Time = formatMailAge(time.Since(t))
 	}
 
-	return rows, nil
+	// Generate human-readable summary
+	row.Summary = eventSummary(event.Type, event.Actor, event.Payload)
+	return row
 }
 
 // eventIcon returns an emoji for an event type.
@@ -1322,6 +1275,7 @@ func eventIcon(eventType string) string {
 		"sling":             "🎯",
 		"hook":              "🪝",
 		"unhook":            "🔓",
+		"resling":           "🔁",
 		"done":              "✅",
 		"mail":              "📬",
 		"spawn":             "🦨",
@@ -1342,6 +1296,7 @@ func eventIcon(eventType string) string {
 		"merge_failed":      "❌",
 		"boot":              "🚀",
 		"halt":              "🛑",
+		"hook_run":          "🧪",
 	}
 	if icon, ok := icons[eventType]; ok {
 		return icon
@@ -1378,6 +1333,11 @@ func eventSummary(eventType, actor string, payload map[string]interface{}) strin
 	case "unhook":
 		bead, _ := payload["bead"].(string)
 		return fmt.Sprintf("%s unhooked %s", shortActor, bead)
+	case "resling":
+		bead, _ := payload["bead"].(string)
+		from, _ := payload["from"].(string)
+		target, _ := payload["target"].(string)
+		return fmt.Sprintf("%s reassigned from %s to %s", bead, formatAgentAddress(from), formatAgentAddress(target))
 	case "merged":
 		branch, _ := payload["branch"].(string)
 		return fmt.Sprintf("merged %s", branch)
@@ -1395,6 +1355,19 @@ func eventSummary(eventType, actor string, payload map[string]interface{}) strin
 	case "mass_death":
 		count, _ := payload["count"].(float64)
 		return fmt.Sprintf("%.0f sessions died", count)
+	case "hook_run":
+		name, _ := payload["title"].(string)
+		if name == "" {
+			name, _ = payload["hook_id"].(string)
+		}
+		if success, _ := payload["success"].(bool); success {
+			return fmt.Sprintf("hook %s passed", name)
+		}
+		if timedOut, _ := payload["timed_out"].(bool); timedOut {
+			return fmt.Sprintf("hook %s timed out", name)
+		}
+		exitCode, _ := payload["exit_code"].(float64)
+		return fmt.Sprintf("hook %s failed (exit %.0f)", name, exitCode)
 	default:
 		return eventType
 	}
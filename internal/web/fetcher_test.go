@@ -1,9 +1,15 @@
 package web
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/steveyegge/gastown/internal/activity"
+	"github.com/steveyegge/gastown/internal/beads"
 )
 
 func TestCalculateWorkStatus(t *testing.T) {
@@ -328,3 +334,64 @@ func TestTruncateStatusHint(t *testing.T) {
 	}
 }
 
+
+func TestFetchHooksViaDaemon(t *testing.T) {
+	var gotStatus string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bd.v1.BeadsService/List" {
+			t.Errorf("unexpected daemon call %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		gotStatus, _ = body["status"].(string)
+		_, _ = w.Write([]byte(`[{"id":"gt-abc","title":"Fix it","status":"hooked","assignee":"gastown/polecats/nux"}]`))
+	}))
+	defer srv.Close()
+
+	f := &LiveConvoyFetcher{daemon: beads.NewDaemonClient(srv.URL)}
+	rows, err := f.FetchHooks()
+	if err != nil {
+		t.Fatalf("FetchHooks: %v", err)
+	}
+	if gotStatus != "hooked" {
+		t.Errorf("daemon List status = %q, want hooked", gotStatus)
+	}
+	if len(rows) != 1 || rows[0].ID != "gt-abc" || rows[0].Assignee != "gastown/polecats/nux" {
+		t.Errorf("rows = %+v", rows)
+	}
+}
+
+func TestFetchDogs(t *testing.T) {
+	root := t.TempDir()
+	kennel := filepath.Join(root, "deacon", "dogs")
+	for name, content := range map[string]string{
+		"bravo/.dog.json":         `{"name":"bravo","state":"idle","worktrees":{"gastown":"x","beads":"y"}}`,
+		"bravo/.dog-events.jsonl": `{"kind":"recalled","work":"gt-abc","message":"stuck"}` + "\n",
+		"alpha/.dog.json":         `{"name":"alpha","state":"working","work":"gt-xyz"}`,
+		"boot/.boot-status.json":  `{}`, // Not a dog
+	} {
+		path := filepath.Join(kennel, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, err := (&LiveConvoyFetcher{townRoot: root}).FetchDogs()
+	if err != nil {
+		t.Fatalf("FetchDogs: %v", err)
+	}
+	if len(rows) != 2 || rows[0].Name != "alpha" || rows[1].Name != "bravo" {
+		t.Fatalf("rows = %+v, want alpha, bravo", rows)
+	}
+	if rows[0].Work != "gt-xyz" || rows[0].LastEvent != "" {
+		t.Errorf("alpha = %+v", rows[0])
+	}
+	if rows[1].RigCount != 2 || rows[1].LastEvent != "recalled gt-abc: stuck" {
+		t.Errorf("bravo = %+v", rows[1])
+	}
+}
@@ -0,0 +1,63 @@
+package web
+
+import (
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// FindingsFetcher is implemented by fetchers that can list witness findings.
+type FindingsFetcher interface {
+	FetchFindings() ([]FindingRow, error)
+}
+
+// FetchFindings returns open witness findings, most severe first.
+func (f *LiveConvoyFetcher) FetchFindings() ([]FindingRow, error) {
+	issues, err := f.listIssues(beads.DaemonListOptions{Labels: []string{beads.FindingLabel}, Status: "open"})
+	if err != nil {
+		return nil, nil // No findings or bd not available
+	}
+
+	findings := make([]*beads.Finding, 0, len(issues))
+	for _, issue := range issues {
+		findings = append(findings, &beads.Finding{
+			ID:            issue.ID,
+			Title:         issue.Title,
+			Status:        issue.Status,
+			CreatedAt:     issue.CreatedAt,
+			FindingFields: *beads.ParseFindingFields(issue.Description),
+		})
+	}
+	beads.RankFindings(findings)
+
+	rows := make([]FindingRow, 0, len(findings))
+	for _, fd := range findings {
+		row := FindingRow{
+			ID:              fd.ID,
+			Title:           fd.Title,
+			Agent:           formatAgentAddress(fd.Agent),
+			Category:        fd.Category,
+			Severity:        fd.Severity,
+			Evidence:        fd.Evidence,
+			SuggestedAction: fd.SuggestedAction,
+			Occurrences:     fd.Occurrences,
+		}
+		if t, err := time.Parse(time.RFC3339, fd.ReportedAt); err == nil {
+			row.Age = formatMailAge(time.Since(t))
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// addFindings counts critical and high findings as alerts.
+func (s *DashboardSummary) addFindings(findings []FindingRow) {
+	for _, f := range findings {
+		if f.Severity == "critical" || f.Severity == "high" {
+			s.SevereFindings++
+		}
+	}
+	if s.SevereFindings > 0 {
+		s.HasAlerts = true
+	}
+}
@@ -0,0 +1,42 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestFetchFindingsRanked(t *testing.T) {
+	low := beads.FormatFindingDescription("slow", &beads.FindingFields{Agent: "gastown/polecats/a", Category: "slow", Severity: "low"})
+	crit := beads.FormatFindingDescription("looping", &beads.FindingFields{Agent: "gastown/polecats/b", Category: "crash-loop", Severity: "critical", Occurrences: 4})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if labels, _ := body["labels"].([]interface{}); len(labels) != 1 || labels[0] != beads.FindingLabel {
+			t.Errorf("List labels = %v, want [%s]", body["labels"], beads.FindingLabel)
+		}
+		_ = json.NewEncoder(w).Encode([]beads.Issue{
+			{ID: "hq-1", Title: "slow", Status: "open", Description: low},
+			{ID: "hq-2", Title: "looping", Status: "open", Description: crit},
+		})
+	}))
+	defer srv.Close()
+
+	f := &LiveConvoyFetcher{daemon: beads.NewDaemonClient(srv.URL)}
+	rows, err := f.FetchFindings()
+	if err != nil {
+		t.Fatalf("FetchFindings: %v", err)
+	}
+	if len(rows) != 2 || rows[0].ID != "hq-2" || rows[0].Category != "crash-loop" || rows[0].Occurrences != 4 {
+		t.Fatalf("rows = %+v, want critical finding first", rows)
+	}
+
+	summary := &DashboardSummary{}
+	summary.addFindings(rows)
+	if summary.SevereFindings != 1 || !summary.HasAlerts {
+		t.Errorf("summary = %+v, want 1 severe finding and alerts", summary)
+	}
+}
@@ -0,0 +1,304 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// Dependency graph limits. A convoy's graph is usually a few dozen beads;
+// the caps keep a runaway epic from producing an unreadable page.
+const (
+	DefaultGraphDepth = 4
+	MaxGraphDepth     = 10
+	MaxGraphNodes     = 200
+)
+
+// Edge types in a bead graph. Edges point from a bead to what it depends on.
+const (
+	GraphEdgeBlocks = "blocks" // To must close before From can proceed
+	GraphEdgeTracks = "tracks" // From is a convoy tracking To
+	GraphEdgeBond   = "bond"   // To is the molecule attached to From
+	GraphEdgeParent = "parent-child"
+)
+
+// GraphNode is a bead in a dependency graph.
+type GraphNode struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Status   string `json:"status"`
+	Type     string `json:"type,omitempty"`
+	Assignee string `json:"assignee,omitempty"`
+	Depth    int    `json:"depth"`
+	// Blocked is set on open beads with an open blocker, or, for convoys,
+	// an open tracked bead that is itself blocked.
+	Blocked bool `json:"blocked"`
+}
+
+// GraphEdge is a dependency between two beads in a graph.
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"`
+	// Blocking is set when the edge is why From is blocked.
+	Blocking bool `json:"blocking"`
+}
+
+// BeadGraph is the dependency graph reachable from a root bead.
+type BeadGraph struct {
+	Root      string      `json:"root"`
+	Nodes     []GraphNode `json:"nodes"`
+	Edges     []GraphEdge `json:"edges"`
+	Truncated bool        `json:"truncated"` // MaxGraphNodes or the depth limit cut it short
+}
+
+// BuildBeadGraph walks what root depends on (blockers, tracked beads,
+// parents and attached molecules) breadth first, up to depth levels, using
+// show to fetch each level's beads in one batch.
+func BuildBeadGraph(root string, depth int, show func(ids []string) (map[string]*beads.Issue, error)) (*BeadGraph, error) {
+	g := &BeadGraph{Root: root, Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+	index := make(map[string]int) // ID -> position in g.Nodes
+	seenEdge := make(map[GraphEdge]bool)
+
+	frontier := []string{root}
+	for level := 0; len(frontier) > 0; level++ {
+		issues, err := show(frontier)
+		if err != nil {
+			if level == 0 {
+				return nil, err
+			}
+			issues = nil // shown as unknown beads
+		}
+		if level == 0 && issues[root] == nil {
+			return nil, fmt.Errorf("bead %s not found", root)
+		}
+
+		var next []string
+		for _, id := range frontier {
+			node := GraphNode{ID: id, Status: "unknown", Depth: level}
+			issue := issues[id]
+			if issue != nil {
+				node.Title = issue.Title
+				node.Status = issue.Status
+				node.Type = issue.Type
+				node.Assignee = issue.Assignee
+			}
+			index[id] = len(g.Nodes)
+			g.Nodes = append(g.Nodes, node)
+			if issue == nil {
+				continue
+			}
+
+			for _, e := range graphEdges(issue) {
+				if seenEdge[e] {
+					continue
+				}
+				seenEdge[e] = true
+				g.Edges = append(g.Edges, e)
+				if _, ok := index[e.To]; ok || containsString(next, e.To) || containsString(frontier, e.To) {
+					continue
+				}
+				if level+1 > depth || len(g.Nodes)+len(next) >= MaxGraphNodes {
+					g.Truncated = true
+					continue
+				}
+				next = append(next, e.To)
+			}
+		}
+		frontier = next
+	}
+
+	// Drop edges to beads that were cut off.
+	kept := g.Edges[:0]
+	for _, e := range g.Edges {
+		if _, ok := index[e.To]; ok {
+			kept = append(kept, e)
+		}
+	}
+	g.Edges = kept
+
+	g.markBlocked(index)
+	return g, nil
+}
+
+// graphEdges returns an issue's outgoing dependency edges.
+func graphEdges(issue *beads.Issue) []GraphEdge {
+	var edges []GraphEdge
+	for _, dep := range issue.Dependencies {
+		depType := dep.DependencyType
+		if depType == "" {
+			depType = GraphEdgeBlocks
+		}
+		edges = append(edges, GraphEdge{From: issue.ID, To: normalizeExternalRef(dep.ID), Type: depType})
+	}
+	if fields := beads.ParseAttachmentFields(issue); fields != nil && fields.AttachedMolecule != "" {
+		edges = append(edges, GraphEdge{From: issue.ID, To: fields.AttachedMolecule, Type: GraphEdgeBond})
+	}
+	return edges
+}
+
+// normalizeExternalRef turns "external:<project>:<id>" into <id>.
+func normalizeExternalRef(id string) string {
+	if strings.HasPrefix(id, "external:") {
+		if parts := strings.SplitN(id, ":", 3); len(parts) == 3 {
+			return parts[2]
+		}
+	}
+	return id
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// markBlocked flags blocked beads and the edges that block them. It
+// repeats until nothing changes so a convoy is blocked when a bead it
+// tracks is, however deep the blocker.
+func (g *BeadGraph) markBlocked(index map[string]int) {
+	for changed := true; changed; {
+		changed = false
+		for ei := range g.Edges {
+			e := &g.Edges[ei]
+			from, to := &g.Nodes[index[e.From]], g.Nodes[index[e.To]]
+			if e.Blocking || from.Status == "closed" || to.Status == "closed" {
+				continue
+			}
+			if e.Type == GraphEdgeBlocks || (e.Type == GraphEdgeTracks && to.Blocked) {
+				e.Blocking = true
+				from.Blocked = true
+				changed = true
+			}
+		}
+	}
+}
+
+// DOT renders the graph in Graphviz DOT. Blocked beads and blocking edges
+// are red; closed beads are grey.
+func (g *BeadGraph) DOT() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", g.Root)
+	b.WriteString("  rankdir=LR;\n  node [shape=box, style=\"rounded,filled\", fillcolor=white];\n")
+	for _, n := range g.Nodes {
+		label := n.ID
+		if n.Title != "" {
+			label += "\\n" + strings.ReplaceAll(n.Title, `"`, `\"`)
+		}
+		attrs := fmt.Sprintf("label=\"%s\"", label)
+		switch {
+		case n.Blocked:
+			attrs += ", color=red, fillcolor=mistyrose"
+		case n.Status == "closed":
+			attrs += ", color=gray, fontcolor=gray"
+		}
+		if n.ID == g.Root {
+			attrs += ", penwidth=2"
+		}
+		fmt.Fprintf(&b, "  %q [%s];\n", n.ID, attrs)
+	}
+	for _, e := range g.Edges {
+		attrs := fmt.Sprintf("label=%q", e.Type)
+		if e.Blocking {
+			attrs += ", color=red"
+		} else if e.Type != GraphEdgeBlocks {
+			attrs += ", style=dashed"
+		}
+		fmt.Fprintf(&b, "  %q -> %q [%s];\n", e.From, e.To, attrs)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// GraphFetcher is implemented by fetchers that can build bead dependency
+// graphs.
+type GraphFetcher interface {
+	FetchBeadGraph(rootID string, depth int) (*BeadGraph, error)
+}
+
+// FetchBeadGraph builds the dependency graph reachable from rootID.
+func (f *LiveConvoyFetcher) FetchBeadGraph(rootID string, depth int) (*BeadGraph, error) {
+	return BuildBeadGraph(rootID, depth, f.showIssues)
+}
+
+// showIssues fetches full issues, dependencies included, keyed by ID.
+func (f *LiveConvoyFetcher) showIssues(ids []string) (map[string]*beads.Issue, error) {
+	var issues []*beads.Issue
+	if f.daemon != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+		for _, id := range ids {
+			if issue, err := f.daemon.Show(ctx, id); err == nil {
+				issues = append(issues, issue)
+			}
+		}
+	} else {
+		args := append([]string{"show"}, ids...)
+		args = append(args, "--json")
+		stdout, err := runBdCmd(f.townBeads, args...)
+		if err != nil {
+			return nil, fmt.Errorf("bd show: %w", err)
+		}
+		if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil {
+			return nil, fmt.Errorf("parsing bd show output: %w", err)
+		}
+	}
+
+	result := make(map[string]*beads.Issue, len(issues))
+	for _, issue := range issues {
+		result[issue.ID] = issue
+	}
+	return result, nil
+}
+
+// graphHandler serves GET /api/graph?id=<bead>&depth=<n>&format=json|dot.
+type graphHandler struct {
+	fetcher GraphFetcher
+}
+
+func (h *graphHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		http.Error(w, "Missing bead id", http.StatusBadRequest)
+		return
+	}
+	depth := DefaultGraphDepth
+	if s := r.URL.Query().Get("depth"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 || n > MaxGraphDepth {
+			http.Error(w, fmt.Sprintf("Invalid depth (0-%d)", MaxGraphDepth), http.StatusBadRequest)
+			return
+		}
+		depth = n
+	}
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "dot" {
+		http.Error(w, "Invalid format (json or dot)", http.StatusBadRequest)
+		return
+	}
+
+	graph, err := h.fetcher.FetchBeadGraph(id, depth)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if format == "dot" {
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		_, _ = w.Write([]byte(graph.DOT()))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(graph)
+}
@@ -0,0 +1,142 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// graphFixture is a convoy tracking two beads: gt-a, blocked by the open
+// gt-c, and the closed gt-b, which has a molecule attached.
+func graphFixture() map[string]*beads.Issue {
+	return map[string]*beads.Issue{
+		"hq-cv-1": {ID: "hq-cv-1", Title: "Release", Status: "open", Type: "convoy", Dependencies: []beads.IssueDep{
+			{ID: "external:gastown:gt-a", DependencyType: "tracks"},
+			{ID: "gt-b", DependencyType: "tracks"},
+		}},
+		"gt-a": {ID: "gt-a", Title: "Feature", Status: "open", Dependencies: []beads.IssueDep{
+			{ID: "gt-c", DependencyType: "blocks"},
+		}},
+		"gt-b": {ID: "gt-b", Title: "Docs", Status: "closed", Description: "attached_molecule: gt-wisp-1"},
+		"gt-c": {ID: "gt-c", Title: "Schema migration", Status: "in_progress"},
+	}
+}
+
+func showFrom(issues map[string]*beads.Issue, calls *int) func([]string) (map[string]*beads.Issue, error) {
+	return func(ids []string) (map[string]*beads.Issue, error) {
+		*calls++
+		out := make(map[string]*beads.Issue)
+		for _, id := range ids {
+			if issue, ok := issues[id]; ok {
+				out[id] = issue
+			}
+		}
+		return out, nil
+	}
+}
+
+func TestBuildBeadGraph(t *testing.T) {
+	var calls int
+	g, err := BuildBeadGraph("hq-cv-1", DefaultGraphDepth, showFrom(graphFixture(), &calls))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("show called %d times, want one batch per level (3)", calls)
+	}
+
+	nodes := make(map[string]GraphNode)
+	for _, n := range g.Nodes {
+		nodes[n.ID] = n
+	}
+	if len(nodes) != 5 || nodes["gt-wisp-1"].Status != "unknown" || nodes["gt-c"].Depth != 2 {
+		t.Errorf("nodes = %+v", g.Nodes)
+	}
+	for id, want := range map[string]bool{"hq-cv-1": true, "gt-a": true, "gt-b": false, "gt-c": false} {
+		if nodes[id].Blocked != want {
+			t.Errorf("%s blocked = %v, want %v", id, nodes[id].Blocked, want)
+		}
+	}
+
+	blocking := map[string]bool{}
+	for _, e := range g.Edges {
+		if e.Blocking {
+			blocking[e.From+"->"+e.To] = true
+		}
+		if e.To == "gt-wisp-1" && e.Type != GraphEdgeBond {
+			t.Errorf("molecule edge type = %q, want bond", e.Type)
+		}
+	}
+	if len(blocking) != 2 || !blocking["hq-cv-1->gt-a"] || !blocking["gt-a->gt-c"] {
+		t.Errorf("blocking edges = %v, want the path hq-cv-1 -> gt-a -> gt-c", blocking)
+	}
+
+	dot := g.DOT()
+	if !strings.HasPrefix(dot, `digraph "hq-cv-1" {`) || !strings.Contains(dot, `"gt-a" -> "gt-c" [label="blocks", color=red]`) {
+		t.Errorf("DOT output:\n%s", dot)
+	}
+}
+
+func TestBuildBeadGraph_Depth(t *testing.T) {
+	var calls int
+	g, err := BuildBeadGraph("hq-cv-1", 1, showFrom(graphFixture(), &calls))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Nodes) != 3 || !g.Truncated {
+		t.Errorf("depth 1: %d nodes, truncated=%v; want 3 nodes, truncated", len(g.Nodes), g.Truncated)
+	}
+	for _, e := range g.Edges {
+		if e.To == "gt-c" || e.To == "gt-wisp-1" {
+			t.Errorf("edge to cut-off bead kept: %+v", e)
+		}
+	}
+
+	if _, err := BuildBeadGraph("gt-missing", 1, showFrom(graphFixture(), &calls)); err == nil {
+		t.Error("missing root: want error")
+	}
+}
+
+type stubGraphFetcher struct{ depth int }
+
+func (s *stubGraphFetcher) FetchBeadGraph(rootID string, depth int) (*BeadGraph, error) {
+	s.depth = depth
+	var calls int
+	return BuildBeadGraph(rootID, depth, showFrom(graphFixture(), &calls))
+}
+
+func TestGraphHandler(t *testing.T) {
+	stub := &stubGraphFetcher{}
+	h := &graphHandler{fetcher: stub}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/graph?id=hq-cv-1&depth=2", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var g BeadGraph
+	if err := json.Unmarshal(rec.Body.Bytes(), &g); err != nil {
+		t.Fatal(err)
+	}
+	if stub.depth != 2 || g.Root != "hq-cv-1" || len(g.Nodes) != 5 {
+		t.Errorf("depth = %d, graph = %+v", stub.depth, g)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/graph?id=hq-cv-1&format=dot", nil))
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/vnd.graphviz") || !strings.HasPrefix(rec.Body.String(), "digraph") {
+		t.Errorf("dot: content type %q, body %q", ct, rec.Body.String())
+	}
+
+	for _, q := range []string{"", "?id=hq-cv-1&depth=99", "?id=hq-cv-1&format=svg"} {
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/graph"+q, nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%q: status = %d, want 400", q, rec.Code)
+		}
+	}
+}
@@ -197,6 +197,45 @@ func (h *ConvoyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
+	var findings []FindingRow
+	if ff, ok := h.fetcher.(FindingsFetcher); ok {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var err error
+			findings, err = ff.FetchFindings()
+			if err != nil {
+				log.Printf("dashboard: FetchFindings failed: %v", err)
+			}
+		}()
+	}
+
+	var molecules []MoleculeRow
+	if mf, ok := h.fetcher.(MoleculesFetcher); ok {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var err error
+			molecules, err = mf.FetchMolecules()
+			if err != nil {
+				log.Printf("dashboard: FetchMolecules failed: %v", err)
+			}
+		}()
+	}
+
+	var usage *UsagePanel
+	if uf, ok := h.fetcher.(UsageFetcher); ok {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var err error
+			usage, err = uf.FetchUsage()
+			if err != nil {
+				log.Printf("dashboard: FetchUsage failed: %v", err)
+			}
+		}()
+	}
+
 	// Wait for fetches or timeout
 	done := make(chan struct{})
 	go func() {
@@ -213,6 +252,8 @@ func (h *ConvoyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// Compute summary from already-fetched data
 	summary := computeSummary(workers, hooks, issues, convoys, escalations, activity)
+	summary.addFindings(findings)
+	summary.addUsage(usage)
 
 	data := ConvoyData{
 		Convoys:     convoys,
@@ -222,6 +263,9 @@ func (h *ConvoyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Rigs:        rigs,
 		Dogs:        dogs,
 		Escalations: escalations,
+		Findings:    findings,
+		Molecules:   molecules,
+		Usage:       usage,
 		Health:      health,
 		Queues:      queues,
 		Sessions:    sessions,
@@ -315,6 +359,18 @@ func NewDashboardMux(fetcher ConvoyFetcher) (http.Handler, error) {
 	staticHandler := http.FileServer(http.FS(staticFS))
 
 	mux := http.NewServeMux()
+	if bf, ok := fetcher.(BurndownFetcher); ok {
+		mux.Handle("/api/convoys/burndown", &burndownHandler{fetcher: bf})
+	}
+	if eq, ok := fetcher.(EventQuerier); ok {
+		mux.Handle("/api/events", &eventsHandler{querier: eq})
+	}
+	if gf, ok := fetcher.(GraphFetcher); ok {
+		mux.Handle("/api/graph", &graphHandler{fetcher: gf})
+	}
+	if bw, ok := fetcher.(BeadWatcher); ok {
+		mux.Handle("/api/beads/stream", &beadsStreamHandler{watcher: bw})
+	}
 	mux.Handle("/api/", apiHandler)
 	mux.Handle("/static/", http.StripPrefix("/static/", staticHandler))
 	mux.Handle("/", convoyHandler)
@@ -0,0 +1,467 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// gitRemote is a rig's git URL split into host and repository path.
+type gitRemote struct {
+	Host string // e.g. "github.com", "gitlab.example.com"
+	Path string // e.g. "owner/repo", "group/subgroup/repo"
+}
+
+// parseGitRemote parses HTTPS (https://host/owner/repo.git), scp-style SSH
+// (git@host:owner/repo.git) and ssh:// URLs. Returns false for anything else.
+func parseGitRemote(gitURL string) (gitRemote, bool) {
+	var host, path string
+	switch {
+	case strings.HasPrefix(gitURL, "https://"), strings.HasPrefix(gitURL, "http://"), strings.HasPrefix(gitURL, "ssh://"):
+		u, err := url.Parse(gitURL)
+		if err != nil {
+			return gitRemote{}, false
+		}
+		host, path = u.Hostname(), u.Path
+	case strings.Contains(gitURL, "@") && strings.Contains(gitURL, ":"):
+		// scp-style: git@host:owner/repo.git
+		rest := gitURL[strings.Index(gitURL, "@")+1:]
+		host, path, _ = strings.Cut(rest, ":")
+	default:
+		return gitRemote{}, false
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if host == "" || !strings.Contains(path, "/") {
+		return gitRemote{}, false
+	}
+	return gitRemote{Host: host, Path: path}, true
+}
+
+// mergeQueueProvider lists open pull/merge requests for one forge.
+type mergeQueueProvider interface {
+	fetchOpen(ctx context.Context, remote gitRemote, rigName string) ([]MergeQueueRow, error)
+}
+
+// providerFor picks the merge queue provider for a remote by host name.
+// Self-hosted instances are recognised when the host contains the forge name
+// (e.g. gitlab.example.com).
+func (f *LiveConvoyFetcher) providerFor(remote gitRemote) mergeQueueProvider {
+	host := strings.ToLower(remote.Host)
+	switch {
+	case strings.Contains(host, "gitlab"):
+		return &gitlabProvider{client: http.DefaultClient, token: os.Getenv("GITLAB_TOKEN")}
+	case strings.Contains(host, "bitbucket"):
+		return &bitbucketProvider{
+			client:   http.DefaultClient,
+			username: os.Getenv("BITBUCKET_USERNAME"),
+			password: os.Getenv("BITBUCKET_APP_PASSWORD"),
+			token:    os.Getenv("BITBUCKET_TOKEN"),
+		}
+	case host == "github.com" || strings.Contains(host, "github"):
+		return githubProvider{f: f}
+	default:
+		return nil
+	}
+}
+
+// githubProvider lists PRs with the gh CLI.
+type githubProvider struct {
+	f *LiveConvoyFetcher
+}
+
+func (p githubProvider) fetchOpen(_ context.Context, remote gitRemote, rigName string) ([]MergeQueueRow, error) {
+	repo := remote.Path
+	if remote.Host != "github.com" {
+		repo = remote.Host + "/" + repo
+	}
+	return p.f.fetchPRsForRepo(repo, rigName)
+}
+
+// gitlabProvider lists merge requests through the GitLab REST API.
+// GITLAB_TOKEN is sent as a private token when set.
+type gitlabProvider struct {
+	client  *http.Client
+	baseURL string // defaults to https://<host>
+	token   string
+}
+
+// gitlabMR is the subset of the GitLab merge request resource we display.
+type gitlabMR struct {
+	IID                 int    `json:"iid"`
+	Title               string `json:"title"`
+	WebURL              string `json:"web_url"`
+	Draft               bool   `json:"draft"`
+	HasConflicts        bool   `json:"has_conflicts"`
+	MergeStatus         string `json:"merge_status"`
+	DetailedMergeStatus string `json:"detailed_merge_status"`
+}
+
+func (p *gitlabProvider) fetchOpen(ctx context.Context, remote gitRemote, rigName string) ([]MergeQueueRow, error) {
+	base := p.baseURL
+	if base == "" {
+		base = "https://" + remote.Host
+	}
+	endpoint := fmt.Sprintf("%s/api/v4/projects/%s/merge_requests?state=opened&per_page=100",
+		base, url.PathEscape(remote.Path))
+
+	headers := map[string]string{}
+	if p.token != "" {
+		headers["PRIVATE-TOKEN"] = p.token
+	}
+	var mrs []gitlabMR
+	if err := getJSON(ctx, p.client, endpoint, headers, "", "", &mrs); err != nil {
+		return nil, fmt.Errorf("fetching merge requests for %s: %w", remote.Path, err)
+	}
+
+	rows := make([]MergeQueueRow, 0, len(mrs))
+	for _, mr := range mrs {
+		row := MergeQueueRow{
+			Number:    mr.IID,
+			Repo:      rigName,
+			Title:     mr.Title,
+			URL:       mr.WebURL,
+			CIStatus:  gitlabCIStatus(mr.DetailedMergeStatus),
+			Mergeable: gitlabMergeable(mr),
+		}
+		row.ColorClass = determineColorClass(row.CIStatus, row.Mergeable)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// gitlabCIStatus derives a CI status from detailed_merge_status, which is
+// the only pipeline signal in the merge request list response.
+func gitlabCIStatus(detailed string) string {
+	switch detailed {
+	case "ci_must_pass":
+		return "fail"
+	case "ci_still_running", "checking", "unchecked", "preparing", "":
+		return "pending"
+	default:
+		return "pass"
+	}
+}
+
+func gitlabMergeable(mr gitlabMR) string {
+	if mr.HasConflicts || mr.DetailedMergeStatus == "conflict" || mr.MergeStatus == "cannot_be_merged" {
+		return "conflict"
+	}
+	if mr.DetailedMergeStatus == "mergeable" || mr.MergeStatus == "can_be_merged" {
+		return "ready"
+	}
+	return "pending"
+}
+
+// bitbucketProvider lists pull requests through the Bitbucket Cloud API.
+// It authenticates with BITBUCKET_TOKEN (bearer) or BITBUCKET_USERNAME and
+// BITBUCKET_APP_PASSWORD when set.
+type bitbucketProvider struct {
+	client   *http.Client
+	baseURL  string // defaults to https://api.bitbucket.org/2.0
+	username string
+	password string
+	token    string
+}
+
+type bitbucketPR struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+	Links struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+}
+
+type bitbucketStatus struct {
+	State string `json:"state"` // SUCCESSFUL, FAILED, INPROGRESS, STOPPED
+}
+
+func (p *bitbucketProvider) fetchOpen(ctx context.Context, remote gitRemote, rigName string) ([]MergeQueueRow, error) {
+	base := p.baseURL
+	if base == "" {
+		base = "https://api.bitbucket.org/2.0"
+	}
+
+	var page struct {
+		Values []bitbucketPR `json:"values"`
+	}
+	endpoint := fmt.Sprintf("%s/repositories/%s/pullrequests?state=OPEN&pagelen=50", base, remote.Path)
+	if err := p.get(ctx, endpoint, &page); err != nil {
+		return nil, fmt.Errorf("fetching pull requests for %s: %w", remote.Path, err)
+	}
+
+	rows := make([]MergeQueueRow, 0, len(page.Values))
+	for _, pr := range page.Values {
+		row := MergeQueueRow{
+			Number: pr.ID,
+			Repo:   rigName,
+			Title:  pr.Title,
+			URL:    pr.Links.HTML.Href,
+			// Bitbucket does not report conflicts in the API, so
+			// mergeability is always unknown.
+			Mergeable: "pending",
+			CIStatus:  "pending",
+		}
+		var statuses struct {
+			Values []bitbucketStatus `json:"values"`
+		}
+		statusURL := fmt.Sprintf("%s/repositories/%s/pullrequests/%d/statuses", base, remote.Path, pr.ID)
+		if err := p.get(ctx, statusURL, &statuses); err == nil {
+			row.CIStatus = bitbucketCIStatus(statuses.Values)
+		}
+		row.ColorClass = determineColorClass(row.CIStatus, row.Mergeable)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (p *bitbucketProvider) get(ctx context.Context, endpoint string, out interface{}) error {
+	headers := map[string]string{}
+	if p.token != "" {
+		headers["Authorization"] = "Bearer " + p.token
+	}
+	return getJSON(ctx, p.client, endpoint, headers, p.username, p.password, out)
+}
+
+func bitbucketCIStatus(statuses []bitbucketStatus) string {
+	if len(statuses) == 0 {
+		return "pending"
+	}
+	result := "pass"
+	for _, s := range statuses {
+		switch s.State {
+		case "FAILED", "STOPPED":
+			return "fail"
+		case "INPROGRESS":
+			result = "pending"
+		}
+	}
+	return result
+}
+
+// getJSON performs a GET and decodes the JSON response into out. Basic auth
+// is used when username is set.
+func getJSON(ctx context.Context, client *http.Client, endpoint string, headers map[string]string, username, password string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if username != "" {
+		req.SetBasicAuth(username, password)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: HTTP %d", req.URL.Path, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+const (
+	// mergeQueueWorkers bounds concurrent forge requests per dashboard refresh.
+	mergeQueueWorkers = 4
+
+	// mergeQueueFreshTTL is how long a repo's PR list is served without
+	// refetching.
+	mergeQueueFreshTTL = 30 * time.Second
+
+	// mergeQueueStaleTTL is how long an expired entry may still be served
+	// while it is refreshed in the background.
+	mergeQueueStaleTTL = 10 * time.Minute
+)
+
+// mergeQueueJob is one rig's repo to fetch.
+type mergeQueueJob struct {
+	rig      string
+	remote   gitRemote
+	provider mergeQueueProvider
+}
+
+type mergeQueueEntry struct {
+	rows       []MergeQueueRow
+	fetchedAt  time.Time
+	refreshing bool
+}
+
+// mergeQueueCache caches open PR lists per repo with stale-while-revalidate
+// semantics: fresh entries are returned as-is, stale entries are returned
+// immediately while one background refresh runs, and missing or expired
+// entries are fetched inline. Failed fetches keep the previous entry.
+type mergeQueueCache struct {
+	fresh   time.Duration
+	stale   time.Duration
+	workers int
+	timeout time.Duration
+	now     func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*mergeQueueEntry
+}
+
+func newMergeQueueCache() *mergeQueueCache {
+	return &mergeQueueCache{
+		fresh:   mergeQueueFreshTTL,
+		stale:   mergeQueueStaleTTL,
+		workers: mergeQueueWorkers,
+		timeout: ghCmdTimeout,
+		now:     time.Now,
+		entries: make(map[string]*mergeQueueEntry),
+	}
+}
+
+func (f *LiveConvoyFetcher) mergeQueueCache() *mergeQueueCache {
+	f.mqOnce.Do(func() { f.mqCache = newMergeQueueCache() })
+	return f.mqCache
+}
+
+// fetchAll returns the merge queue rows for jobs, fetching up to c.workers
+// repos at once. Rows are sorted by rig then number. Repos that fail with no
+// cached data are omitted.
+func (c *mergeQueueCache) fetchAll(jobs []mergeQueueJob) []MergeQueueRow {
+	results := make([][]MergeQueueRow, len(jobs))
+	sem := make(chan struct{}, c.workers)
+	var wg sync.WaitGroup
+	for i, job := range jobs {
+		wg.Add(1)
+		go func(i int, job mergeQueueJob) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = c.get(job)
+		}(i, job)
+	}
+	wg.Wait()
+
+	var rows []MergeQueueRow
+	for _, r := range results {
+		rows = append(rows, r...)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Repo != rows[j].Repo {
+			return rows[i].Repo < rows[j].Repo
+		}
+		return rows[i].Number < rows[j].Number
+	})
+	return rows
+}
+
+// get returns the rows for one job, consulting the cache.
+func (c *mergeQueueCache) get(job mergeQueueJob) []MergeQueueRow {
+	key := job.remote.Host + "/" + job.remote.Path
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if ok {
+		age := c.now().Sub(e.fetchedAt)
+		if age < c.fresh {
+			rows := e.rows
+			c.mu.Unlock()
+			return withRig(rows, job.rig)
+		}
+		if age < c.stale {
+			if !e.refreshing {
+				e.refreshing = true
+				go c.refresh(key, job)
+			}
+			rows := e.rows
+			c.mu.Unlock()
+			return withRig(rows, job.rig)
+		}
+	}
+	c.mu.Unlock()
+
+	return withRig(c.refresh(key, job), job.rig)
+}
+
+// refresh fetches a repo and stores the result. On error the previous entry,
+// if any, is kept and returned.
+func (c *mergeQueueCache) refresh(key string, job mergeQueueJob) []MergeQueueRow {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	rows, err := job.provider.fetchOpen(ctx, job.remote, job.rig)
+	cancel()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		e = &mergeQueueEntry{}
+		c.entries[key] = e
+	}
+	e.refreshing = false
+	if err != nil {
+		return e.rows
+	}
+	e.rows = rows
+	e.fetchedAt = c.now()
+	return rows
+}
+
+// withRig returns a copy of rows labelled with rig, since several rigs may
+// share one cached repo.
+func withRig(rows []MergeQueueRow, rig string) []MergeQueueRow {
+	out := make([]MergeQueueRow, len(rows))
+	for i, r := range rows {
+		r.Repo = rig
+		out[i] = r
+	}
+	return out
+}
+
+// annotateMergeQueue fills in priority, bump and hold state for PRs that have
+// an MR bead, matched by PR URL, then reorders each rig's rows the way the
+// refinery will process them: bumped first, held last.
+func annotateMergeQueue(rows []MergeQueueRow, mrs []*beads.Issue) {
+	type mrOrder struct {
+		priority int
+		fields   *beads.MRFields
+	}
+	byURL := make(map[string]mrOrder)
+	for _, mr := range mrs {
+		if fields := beads.ParseMRFields(mr); fields != nil && fields.PRUrl != "" {
+			byURL[fields.PRUrl] = mrOrder{priority: mr.Priority, fields: fields}
+		}
+	}
+
+	for i := range rows {
+		if mr, ok := byURL[rows[i].URL]; ok {
+			rows[i].Priority = fmt.Sprintf("P%d", mr.priority)
+			rows[i].Bumped = mr.fields.QueuePriority > 0
+			rows[i].Held = mr.fields.Held
+		}
+	}
+
+	bumpLevel := func(r MergeQueueRow) int {
+		if mr, ok := byURL[r.URL]; ok {
+			return mr.fields.QueuePriority
+		}
+		return 0
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Repo != rows[j].Repo {
+			return rows[i].Repo < rows[j].Repo
+		}
+		if rows[i].Held != rows[j].Held {
+			return !rows[i].Held
+		}
+		return bumpLevel(rows[i]) > bumpLevel(rows[j])
+	})
+}
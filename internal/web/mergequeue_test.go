@@ -0,0 +1,280 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestParseGitRemote(t *testing.T) {
+	tests := []struct {
+		url  string
+		want gitRemote
+		ok   bool
+	}{
+		{"https://github.com/steveyegge/gastown.git", gitRemote{"github.com", "steveyegge/gastown"}, true},
+		{"git@github.com:steveyegge/gastown.git", gitRemote{"github.com", "steveyegge/gastown"}, true},
+		{"https://gitlab.com/group/sub/repo", gitRemote{"gitlab.com", "group/sub/repo"}, true},
+		{"ssh://git@gitlab.example.com:2222/group/repo.git", gitRemote{"gitlab.example.com", "group/repo"}, true},
+		{"git@bitbucket.org:team/repo.git", gitRemote{"bitbucket.org", "team/repo"}, true},
+		{"/srv/git/repo.git", gitRemote{}, false},
+		{"https://github.com/justowner", gitRemote{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseGitRemote(tt.url)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseGitRemote(%q) = %+v, %v; want %+v, %v", tt.url, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestProviderFor(t *testing.T) {
+	f := &LiveConvoyFetcher{}
+	if _, ok := f.providerFor(gitRemote{Host: "github.com"}).(githubProvider); !ok {
+		t.Error("github.com should use the gh provider")
+	}
+	if _, ok := f.providerFor(gitRemote{Host: "gitlab.example.com"}).(*gitlabProvider); !ok {
+		t.Error("self-hosted GitLab should use the GitLab provider")
+	}
+	if _, ok := f.providerFor(gitRemote{Host: "bitbucket.org"}).(*bitbucketProvider); !ok {
+		t.Error("bitbucket.org should use the Bitbucket provider")
+	}
+	if p := f.providerFor(gitRemote{Host: "git.sr.ht"}); p != nil {
+		t.Errorf("unknown forge should have no provider, got %T", p)
+	}
+}
+
+func TestGitlabProvider_FetchOpen(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v4/projects/group%2Frepo/merge_requests" {
+			t.Errorf("path = %s", r.URL.EscapedPath())
+		}
+		if r.Header.Get("PRIVATE-TOKEN") != "secret" {
+			t.Errorf("missing private token")
+		}
+		_, _ = w.Write([]byte(`[
+		  {"iid": 7, "title": "Ready", "web_url": "https://gl/7", "detailed_merge_status": "mergeable"},
+		  {"iid": 8, "title": "Conflicted", "web_url": "https://gl/8", "has_conflicts": true, "detailed_merge_status": "conflict"},
+		  {"iid": 9, "title": "Running", "web_url": "https://gl/9", "detailed_merge_status": "ci_still_running"}
+		]`))
+	}))
+	defer srv.Close()
+
+	p := &gitlabProvider{client: srv.Client(), baseURL: srv.URL, token: "secret"}
+	rows, err := p.fetchOpen(context.Background(), gitRemote{Host: "gitlab.com", Path: "group/repo"}, "myrig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("got %d rows, want 3", len(rows))
+	}
+	want := []struct{ ci, mergeable, color string }{
+		{"pass", "ready", "mq-green"},
+		{"pass", "conflict", "mq-red"},
+		{"pending", "pending", "mq-yellow"},
+	}
+	for i, w := range want {
+		r := rows[i]
+		if r.CIStatus != w.ci || r.Mergeable != w.mergeable || r.ColorClass != w.color || r.Repo != "myrig" {
+			t.Errorf("rows[%d] = %+v, want ci=%s mergeable=%s color=%s", i, r, w.ci, w.mergeable, w.color)
+		}
+	}
+}
+
+func TestBitbucketProvider_FetchOpen(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != "bot" || p != "pw" {
+			t.Errorf("basic auth = %q, %q, %v", u, p, ok)
+		}
+		switch r.URL.Path {
+		case "/repositories/team/repo/pullrequests":
+			_, _ = w.Write([]byte(`{"values": [
+			  {"id": 3, "title": "Fix", "links": {"html": {"href": "https://bb/3"}}},
+			  {"id": 4, "title": "Feature", "links": {"html": {"href": "https://bb/4"}}}
+			]}`))
+		case "/repositories/team/repo/pullrequests/3/statuses":
+			_, _ = w.Write([]byte(`{"values": [{"state": "SUCCESSFUL"}, {"state": "FAILED"}]}`))
+		case "/repositories/team/repo/pullrequests/4/statuses":
+			_, _ = w.Write([]byte(`{"values": [{"state": "SUCCESSFUL"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	p := &bitbucketProvider{client: srv.Client(), baseURL: srv.URL, username: "bot", password: "pw"}
+	rows, err := p.fetchOpen(context.Background(), gitRemote{Host: "bitbucket.org", Path: "team/repo"}, "myrig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	if rows[0].CIStatus != "fail" || rows[0].ColorClass != "mq-red" || rows[0].URL != "https://bb/3" {
+		t.Errorf("rows[0] = %+v", rows[0])
+	}
+	if rows[1].CIStatus != "pass" || rows[1].Mergeable != "pending" {
+		t.Errorf("rows[1] = %+v", rows[1])
+	}
+}
+
+// countingProvider returns one row per call, numbered by call count.
+type countingProvider struct {
+	mu    sync.Mutex
+	calls int
+	fail  bool
+	block chan struct{}
+}
+
+func (p *countingProvider) fetchOpen(_ context.Context, _ gitRemote, rig string) ([]MergeQueueRow, error) {
+	if p.block != nil {
+		<-p.block
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls++
+	if p.fail {
+		return nil, errors.New("forge down")
+	}
+	return []MergeQueueRow{{Number: p.calls, Repo: rig}}, nil
+}
+
+func (p *countingProvider) count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.calls
+}
+
+func TestMergeQueueCache_StaleWhileRevalidate(t *testing.T) {
+	var clockMu sync.Mutex
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	advance := func(d time.Duration) {
+		clockMu.Lock()
+		defer clockMu.Unlock()
+		now = now.Add(d)
+	}
+	c := newMergeQueueCache()
+	c.now = func() time.Time {
+		clockMu.Lock()
+		defer clockMu.Unlock()
+		return now
+	}
+
+	p := &countingProvider{}
+	job := mergeQueueJob{rig: "gastown", remote: gitRemote{"github.com", "o/r"}, provider: p}
+
+	if rows := c.get(job); len(rows) != 1 || rows[0].Number != 1 {
+		t.Fatalf("first get = %+v", rows)
+	}
+	// Fresh: served from cache.
+	advance(10 * time.Second)
+	if rows := c.get(job); rows[0].Number != 1 || p.count() != 1 {
+		t.Fatalf("fresh get refetched: %+v, calls=%d", rows, p.count())
+	}
+
+	// Stale: old rows now, refresh in the background.
+	advance(time.Minute)
+	if rows := c.get(job); rows[0].Number != 1 {
+		t.Fatalf("stale get should serve cached rows, got %+v", rows)
+	}
+	deadline := time.Now().Add(time.Second)
+	for p.count() < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if p.count() != 2 {
+		t.Fatal("stale entry was not refreshed")
+	}
+
+	// Expired past the stale window with a failing forge: keep old rows.
+	advance(time.Hour)
+	p.mu.Lock()
+	p.fail = true
+	p.mu.Unlock()
+	if rows := c.get(job); len(rows) != 1 {
+		t.Errorf("failed refresh should keep previous rows, got %+v", rows)
+	}
+}
+
+func TestMergeQueueCache_FetchAllBoundedAndSorted(t *testing.T) {
+	c := newMergeQueueCache()
+	c.workers = 2
+
+	block := make(chan struct{})
+	var jobs []mergeQueueJob
+	var providers []*countingProvider
+	for _, rig := range []string{"zeta", "alpha", "mid"} {
+		p := &countingProvider{block: block}
+		providers = append(providers, p)
+		jobs = append(jobs, mergeQueueJob{rig: rig, remote: gitRemote{"github.com", "o/" + rig}, provider: p})
+	}
+
+	done := make(chan []MergeQueueRow)
+	go func() { done <- c.fetchAll(jobs) }()
+	close(block)
+
+	rows := <-done
+	if len(rows) != 3 || rows[0].Repo != "alpha" || rows[2].Repo != "zeta" {
+		t.Errorf("rows = %+v, want sorted by rig", rows)
+	}
+	for _, p := range providers {
+		if p.count() != 1 {
+			t.Errorf("provider called %d times, want 1", p.count())
+		}
+	}
+}
+
+func TestMergeQueueCache_SharedRepoKeepsRigName(t *testing.T) {
+	c := newMergeQueueCache()
+	p := &countingProvider{}
+	remote := gitRemote{"github.com", "o/shared"}
+
+	a := c.get(mergeQueueJob{rig: "rig-a", remote: remote, provider: p})
+	b := c.get(mergeQueueJob{rig: "rig-b", remote: remote, provider: p})
+	if p.count() != 1 {
+		t.Errorf("shared repo fetched %d times, want 1", p.count())
+	}
+	if a[0].Repo != "rig-a" || b[0].Repo != "rig-b" {
+		t.Errorf("repos = %q, %q", a[0].Repo, b[0].Repo)
+	}
+}
+
+func TestAnnotateMergeQueue(t *testing.T) {
+	url := func(n int) string { return fmt.Sprintf("https://github.com/o/r/pull/%d", n) }
+	rows := []MergeQueueRow{
+		{Number: 1, Repo: "gastown", URL: url(1)},
+		{Number: 2, Repo: "gastown", URL: url(2)},
+		{Number: 3, Repo: "gastown", URL: url(3)},
+		{Number: 4, Repo: "gastown", URL: url(4)},
+	}
+	mrs := []*beads.Issue{
+		{ID: "gt-mr-1", Priority: 2, Description: "pr_url: " + url(1) + "\nheld: true"},
+		{ID: "gt-mr-3", Priority: 0, Description: "pr_url: " + url(3) + "\nqueue_priority: 1"},
+		{ID: "gt-mr-4", Priority: 1, Description: "pr_url: " + url(4) + "\nqueue_priority: 2"},
+	}
+
+	annotateMergeQueue(rows, mrs)
+
+	var order []int
+	for _, r := range rows {
+		order = append(order, r.Number)
+	}
+	if fmt.Sprint(order) != "[4 3 2 1]" {
+		t.Errorf("order = %v, want [4 3 2 1]", order)
+	}
+	if !rows[0].Bumped || rows[0].Priority != "P1" {
+		t.Errorf("bumped row = %+v", rows[0])
+	}
+	if rows[2].Priority != "" {
+		t.Errorf("PR without MR bead got priority %q", rows[2].Priority)
+	}
+	if !rows[3].Held {
+		t.Errorf("held row = %+v", rows[3])
+	}
+}
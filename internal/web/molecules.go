@@ -0,0 +1,97 @@
+package web
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// MaxMoleculeRows caps the molecules panel. Each row costs a few bd calls.
+const MaxMoleculeRows = 12
+
+// MoleculesFetcher is implemented by fetchers that can report molecule
+// progress for hooked work.
+type MoleculesFetcher interface {
+	FetchMolecules() ([]MoleculeRow, error)
+}
+
+// FetchMolecules returns the progress of molecules attached to hooked and
+// in-progress beads, stalled ones first.
+func (f *LiveConvoyFetcher) FetchMolecules() ([]MoleculeRow, error) {
+	var work []*beads.Issue
+	for _, status := range []string{beads.StatusHooked, "in_progress"} {
+		issues, err := f.listIssues(beads.DaemonListOptions{Status: status})
+		if err != nil {
+			return nil, nil // No hooked work or bd not available
+		}
+		work = append(work, issues...)
+	}
+	return moleculeRows(work, beads.New(f.townRoot).MoleculeProgress), nil
+}
+
+// moleculeRows builds a row for each bead in work with an attached molecule,
+// computing progress concurrently with progress.
+func moleculeRows(work []*beads.Issue, progress func(rootID string) (*beads.MoleculeProgress, error)) []MoleculeRow {
+	var rows []MoleculeRow
+	var roots []string
+	for _, issue := range work {
+		a := beads.ParseAttachmentFields(issue)
+		if a == nil || a.AttachedMolecule == "" {
+			continue
+		}
+		rows = append(rows, MoleculeRow{
+			BeadID:    issue.ID,
+			BeadTitle: issue.Title,
+			Agent:     formatAgentAddress(issue.Assignee),
+			RootID:    a.AttachedMolecule,
+		})
+		roots = append(roots, a.AttachedMolecule)
+		if len(rows) == MaxMoleculeRows {
+			break
+		}
+	}
+
+	results := make([]*beads.MoleculeProgress, len(rows))
+	var wg sync.WaitGroup
+	for i, root := range roots {
+		wg.Add(1)
+		go func(i int, root string) {
+			defer wg.Done()
+			if p, err := progress(root); err == nil {
+				results[i] = p
+			}
+		}(i, root)
+	}
+	wg.Wait()
+
+	kept := rows[:0]
+	for i, row := range rows {
+		p := results[i]
+		if p == nil {
+			continue // Root gone or has no steps
+		}
+		row.Percent = p.Percent
+		row.DoneSteps = p.DoneSteps
+		row.TotalSteps = p.TotalSteps
+		row.Complete = p.Complete
+		if step := p.CurrentStep(); step != nil {
+			row.CurrentStepID = step.ID
+			row.CurrentStep = step.Title
+		}
+		for _, s := range p.Blockers() {
+			row.Blockers = append(row.Blockers, s.ID+" ← "+strings.Join(s.BlockedBy, ", "))
+		}
+		row.Stalled = !p.Complete && row.CurrentStepID == ""
+		kept = append(kept, row)
+	}
+
+	sort.SliceStable(kept, func(i, j int) bool {
+		if kept[i].Stalled != kept[j].Stalled {
+			return kept[i].Stalled
+		}
+		return kept[i].Percent < kept[j].Percent
+	})
+	return kept
+}
@@ -0,0 +1,39 @@
+package web
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestMoleculeRows(t *testing.T) {
+	work := []*beads.Issue{
+		{ID: "gt-a", Title: "Moving", Assignee: "gastown/polecats/nux", Description: "attached_molecule: gt-wa"},
+		{ID: "gt-b", Title: "Stuck", Assignee: "gastown/polecats/toast", Description: "attached_molecule: gt-wb"},
+		{ID: "gt-c", Title: "No molecule"},
+		{ID: "gt-d", Title: "Root gone", Description: "attached_molecule: gt-wd"},
+	}
+	progress := map[string]*beads.MoleculeProgress{
+		"gt-wa": {RootID: "gt-wa", TotalSteps: 2, DoneSteps: 1, Percent: 50, Steps: []beads.StepProgress{
+			{ID: "gt-wa.1", State: beads.StepDone},
+			{ID: "gt-wa.2", Title: "Test", State: beads.StepInProgress},
+		}},
+		"gt-wb": {RootID: "gt-wb", TotalSteps: 2, DoneSteps: 1, Percent: 50, Steps: []beads.StepProgress{
+			{ID: "gt-wb.1", State: beads.StepDone},
+			{ID: "gt-wb.2", State: beads.StepBlocked, BlockedBy: []string{"gt-ext"}},
+		}},
+	}
+	rows := moleculeRows(work, func(root string) (*beads.MoleculeProgress, error) {
+		return progress[root], nil
+	})
+
+	if len(rows) != 2 {
+		t.Fatalf("rows = %+v, want 2", rows)
+	}
+	if rows[0].BeadID != "gt-b" || !rows[0].Stalled || len(rows[0].Blockers) != 1 || rows[0].Blockers[0] != "gt-wb.2 ← gt-ext" {
+		t.Errorf("rows[0] = %+v, want stalled gt-b first", rows[0])
+	}
+	if rows[1].BeadID != "gt-a" || rows[1].CurrentStep != "Test" || rows[1].Stalled {
+		t.Errorf("rows[1] = %+v", rows[1])
+	}
+}
@@ -33,6 +33,19 @@
         });
     });
 
+    // ============================================
+    // LIVE BEAD CHANGES
+    // ============================================
+    // Refresh as soon as the beads daemon reports a change instead of
+    // waiting for the next timer tick. The server answers 204 when no
+    // daemon is configured, which stops EventSource from reconnecting.
+    if (window.EventSource) {
+        var beadStream = new EventSource('/api/beads/stream');
+        beadStream.addEventListener('bead', function() {
+            document.body.dispatchEvent(new Event('beadchange'));
+        });
+    }
+
     // ============================================
     // COMMAND PALETTE
     // ============================================
@@ -647,6 +660,8 @@
         document.getElementById('mail-detail-from').textContent = from || '';
         document.getElementById('mail-detail-body').textContent = '';
         document.getElementById('mail-detail-time').textContent = '';
+        document.getElementById('mail-detail-attachments').innerHTML = '';
+        document.getElementById('mail-detail-thread').style.display = 'none';
 
         // Hide both list views and compose, show detail
         mailList.style.display = 'none';
@@ -662,12 +677,102 @@
                 document.getElementById('mail-detail-from').textContent = msg.from || from;
                 document.getElementById('mail-detail-body').textContent = msg.body || '(no content)';
                 document.getElementById('mail-detail-time').textContent = msg.timestamp || '';
+                renderMailAttachments(msg.attachments || []);
+                if (msg.thread_id) {
+                    loadMailThread(msg.thread_id, msgId);
+                }
             })
             .catch(function(err) {
                 document.getElementById('mail-detail-body').textContent = 'Error loading message: ' + err.message;
             });
     }
 
+    // Show the rest of the conversation under the open message. Replies are
+    // indented under the message they answer; click one to open it.
+    function loadMailThread(threadId, currentId) {
+        var container = document.getElementById('mail-detail-thread');
+        fetch('/api/mail/thread?id=' + encodeURIComponent(threadId))
+            .then(function(r) { return r.json(); })
+            .then(function(thread) {
+                var entries = thread.entries || [];
+                if (entries.length < 2 || currentMessageId !== currentId) {
+                    return;
+                }
+                container.innerHTML = '';
+                var title = document.createElement('div');
+                title.className = 'mail-thread-title';
+                title.textContent = '🧵 Conversation (' + entries.length + ' messages)';
+                container.appendChild(title);
+
+                entries.forEach(function(e) {
+                    var row = document.createElement('div');
+                    row.className = 'mail-thread-entry' + (e.id === currentId ? ' mail-thread-current' : '');
+                    row.style.marginLeft = (Math.min(e.depth, 6) * 16) + 'px';
+                    if (e.id !== currentId) {
+                        row.classList.add('mail-row');
+                        row.setAttribute('data-msg-id', e.id);
+                        row.setAttribute('data-from', e.from);
+                    }
+
+                    var head = document.createElement('div');
+                    head.className = 'mail-thread-head';
+                    head.textContent = e.from + ' · ' + formatMailTime(e.timestamp);
+                    row.appendChild(head);
+
+                    var body = document.createElement('div');
+                    body.className = 'mail-thread-body';
+                    body.textContent = e.body || e.subject || '';
+                    row.appendChild(body);
+                    container.appendChild(row);
+                });
+                container.style.display = 'block';
+            })
+            .catch(function() {
+                container.style.display = 'none';
+            });
+    }
+
+    // Inline attachments download from a data: URL (images are previewed);
+    // referenced files live on the town host, so only their path is shown.
+    function renderMailAttachments(attachments) {
+        var container = document.getElementById('mail-detail-attachments');
+        container.innerHTML = '';
+        attachments.forEach(function(a) {
+            var item = document.createElement('div');
+            item.className = 'mail-attachment';
+            var type = a.content_type || 'application/octet-stream';
+            var label;
+            if (a.data) {
+                label = document.createElement('a');
+                label.href = 'data:' + type + ';base64,' + a.data;
+                label.download = a.name;
+            } else {
+                label = document.createElement('span');
+            }
+            label.textContent = '📎 ' + a.name;
+            item.appendChild(label);
+
+            var meta = document.createElement('span');
+            meta.className = 'mail-attachment-meta';
+            meta.textContent = formatBytes(a.size || 0) + (a.path ? ' · ' + a.path : '');
+            item.appendChild(meta);
+
+            if (a.data && type.indexOf('image/') === 0) {
+                var img = document.createElement('img');
+                img.src = label.href;
+                img.alt = a.name;
+                item.appendChild(img);
+            }
+            container.appendChild(item);
+        });
+    }
+
+    function formatBytes(n) {
+        if (n < 1024) return n + ' B';
+        if (n < 1024 * 1024) return (n / 1024).toFixed(1) + ' KiB';
+        return (n / (1024 * 1024)).toFixed(1) + ' MiB';
+    }
+
     // Back button from detail view - return to correct tab
     document.getElementById('mail-back-btn').addEventListener('click', function() {
         mailDetail.style.display = 'none';
@@ -697,6 +802,7 @@
         document.getElementById('compose-subject').value = replySubject;
         document.getElementById('compose-reply-to').value = currentMessageId || '';
         document.getElementById('compose-body').value = '';
+        document.getElementById('compose-receipt').checked = false;
 
         // Populate To dropdown and select the sender
         populateToDropdown(currentMessageFrom);
@@ -715,6 +821,7 @@
         document.getElementById('compose-subject').value = '';
         document.getElementById('compose-body').value = '';
         document.getElementById('compose-reply-to').value = '';
+        document.getElementById('compose-receipt').checked = false;
 
         // Populate To dropdown
         populateToDropdown(null);
@@ -751,6 +858,7 @@
         var subject = document.getElementById('compose-subject').value;
         var body = document.getElementById('compose-body').value;
         var replyTo = document.getElementById('compose-reply-to').value;
+        var receipt = document.getElementById('compose-receipt').checked;
 
         if (!to || !subject) {
             showToast('error', 'Missing fields', 'Please fill in To and Subject');
@@ -768,7 +876,8 @@
                 to: to,
                 subject: subject,
                 body: body,
-                reply_to: replyTo || undefined
+                reply_to: replyTo || undefined,
+                receipt: receipt ? 'mail' : undefined
             })
         })
         .then(function(r) { return r.json(); })
@@ -1097,4 +1206,110 @@
         });
     }
 
+    // ============================================
+    // CONVOY DEPENDENCY GRAPH
+    // ============================================
+    // Elements are looked up on use: the dashboard is re-rendered by HTMX.
+
+    // Click on convoy row to view its dependency graph
+    document.addEventListener('click', function(e) {
+        var convoyRow = e.target.closest('.convoy-row');
+        if (convoyRow && convoyRow.hasAttribute('data-convoy-id')) {
+            e.preventDefault();
+            openConvoyGraph(convoyRow.getAttribute('data-convoy-id'));
+            return;
+        }
+        if (e.target.closest('#convoy-graph-back-btn')) {
+            document.getElementById('convoy-graph').style.display = 'none';
+            document.getElementById('convoy-list').style.display = 'block';
+            // Resume HTMX refresh
+            window.pauseRefresh = false;
+        }
+    });
+
+    function openConvoyGraph(convoyId) {
+        // Pause HTMX refresh while viewing the graph
+        window.pauseRefresh = true;
+
+        var url = '/api/graph?id=' + encodeURIComponent(convoyId);
+        document.getElementById('convoy-graph-title').textContent = convoyId;
+        document.getElementById('convoy-graph-dot').href = url + '&format=dot';
+        document.getElementById('convoy-graph-status').textContent = 'Loading...';
+        document.getElementById('convoy-graph-canvas').innerHTML = '';
+        document.getElementById('convoy-list').style.display = 'none';
+        document.getElementById('convoy-graph').style.display = 'block';
+
+        fetch(url)
+            .then(function(r) {
+                if (!r.ok) {
+                    return r.text().then(function(t) { throw new Error(t.trim() || r.statusText); });
+                }
+                return r.json();
+            })
+            .then(function(graph) {
+                var blocked = graph.nodes.filter(function(n) { return n.blocked; }).length;
+                var status = graph.nodes.length + ' beads, ' + graph.edges.length + ' dependencies';
+                if (blocked > 0) status += ' · ' + blocked + ' blocked';
+                if (graph.truncated) status += ' · truncated';
+                document.getElementById('convoy-graph-status').textContent = status;
+                document.getElementById('convoy-graph-canvas').innerHTML = renderGraphSvg(graph);
+            })
+            .catch(function(err) {
+                document.getElementById('convoy-graph-status').textContent = 'Failed to load graph: ' + err.message;
+            });
+    }
+
+    // renderGraphSvg lays beads out in columns by depth from the root, with
+    // edges drawn from each bead to what it depends on.
+    function renderGraphSvg(graph) {
+        var nodeW = 190, nodeH = 38, colGap = 60, rowGap = 12, pad = 10;
+        var columns = [];
+        var pos = {};
+        graph.nodes.forEach(function(n) {
+            var col = columns[n.depth] || (columns[n.depth] = []);
+            pos[n.id] = {
+                x: pad + n.depth * (nodeW + colGap),
+                y: pad + col.length * (nodeH + rowGap)
+            };
+            col.push(n);
+        });
+        var rows = Math.max.apply(null, columns.map(function(c) { return c ? c.length : 0; }));
+        var width = pad * 2 + columns.length * (nodeW + colGap) - colGap;
+        var height = pad * 2 + rows * (nodeH + rowGap) - rowGap;
+
+        var svg = '<svg xmlns="http://www.w3.org/2000/svg" width="' + width + '" height="' + height + '">';
+        graph.edges.forEach(function(e) {
+            var from = pos[e.from], to = pos[e.to];
+            if (!from || !to) return;
+            var x1 = from.x + nodeW, y1 = from.y + nodeH / 2;
+            var x2 = to.x, y2 = to.y + nodeH / 2;
+            if (x2 <= from.x) {
+                // Same or earlier column: loop around the right-hand side.
+                x2 = to.x + nodeW;
+            }
+            var mid = (x1 + x2) / 2 + (x2 <= x1 ? colGap / 2 : 0);
+            var cls = 'graph-edge';
+            if (e.blocking) cls += ' blocking';
+            else if (e.type !== 'blocks') cls += ' dashed';
+            svg += '<path class="' + cls + '" d="M' + x1 + ' ' + y1 + ' C' + mid + ' ' + y1 + ' ' + mid + ' ' + y2 + ' ' + x2 + ' ' + y2 + '">' +
+                '<title>' + escapeHtml(e.from + ' ' + e.type + ' ' + e.to) + '</title></path>';
+        });
+        graph.nodes.forEach(function(n) {
+            var p = pos[n.id];
+            var cls = 'graph-node';
+            if (n.blocked) cls += ' blocked';
+            else if (n.status === 'closed') cls += ' closed';
+            if (n.id === graph.root) cls += ' root';
+            var title = n.title || '';
+            if (title.length > 28) title = title.substring(0, 27) + '…';
+            svg += '<g class="' + cls + '" transform="translate(' + p.x + ',' + p.y + ')">' +
+                '<title>' + escapeHtml(n.id + ' [' + n.status + ']' + (n.assignee ? ' → ' + n.assignee : '') + '\n' + (n.title || '')) + '</title>' +
+                '<rect width="' + nodeW + '" height="' + nodeH + '" rx="4"></rect>' +
+                '<text x="6" y="15">' + escapeHtml(n.id + ' · ' + n.status) + '</text>' +
+                '<text x="6" y="30">' + escapeHtml(title) + '</text>' +
+                '</g>';
+        });
+        return svg + '</svg>';
+    }
+
 })();
@@ -21,6 +21,9 @@ type ConvoyData struct {
 	Rigs        []RigRow
 	Dogs        []DogRow
 	Escalations []EscalationRow
+	Findings    []FindingRow
+	Molecules   []MoleculeRow
+	Usage       *UsagePanel
 	Health      *HealthRow
 	Queues      []QueueRow
 	Sessions    []SessionRow
@@ -49,6 +52,7 @@ type DogRow struct {
 	Work       string // Current work assignment
 	LastActive string // Formatted age (e.g., "5m ago")
 	RigCount   int    // Number of worktrees
+	LastEvent  string // Latest kennel event (e.g., "recalled gt-abc: stuck")
 }
 
 // EscalationRow represents an escalation needing attention.
@@ -59,6 +63,64 @@ type EscalationRow struct {
 	EscalatedBy string
 	Age         string
 	Acked       bool
+	AssignedTo  string // Owner, if assigned
+	Breached    bool   // Unacknowledged past its SLA
+}
+
+// FindingRow represents a witness finding about an agent.
+type FindingRow struct {
+	ID              string
+	Title           string
+	Agent           string // Formatted agent name
+	Category        string // stuck, crash-loop, zombie, etc.
+	Severity        string // critical, high, medium, low
+	Evidence        string
+	SuggestedAction string
+	Occurrences     int    // Times reported while open
+	Age             string // Since last report
+}
+
+// MoleculeRow is the progress of a molecule attached to hooked work.
+type MoleculeRow struct {
+	BeadID        string
+	BeadTitle     string
+	Agent         string // Formatted assignee
+	RootID        string // Molecule root
+	Percent       int
+	DoneSteps     int
+	TotalSteps    int
+	CurrentStepID string   // Empty when complete or every open step is blocked
+	CurrentStep   string   // Current step title
+	Blockers      []string // "step ← blocker, ..." per blocked step
+	Stalled       bool     // Incomplete with no step that can start
+	Complete      bool
+}
+
+// UsagePanel is today's token spend across the town.
+type UsagePanel struct {
+	TotalCost   string
+	TotalTokens string
+	Agents      []UsageRow // Highest spend first
+	Beads       []UsageRow
+	Budgets     []BudgetRow
+}
+
+// UsageRow is the spend of one agent or bead.
+type UsageRow struct {
+	Name   string
+	Cost   string // e.g., "$12.34"
+	Tokens string // e.g., "1.2M"
+}
+
+// BudgetRow is spend against one daily budget.
+type BudgetRow struct {
+	Name     string // "town", rig name, or formatted agent name
+	Scope    string // town, rig, agent
+	Spent    string
+	Limit    string
+	Percent  int
+	Exceeded bool
+	Warning  bool // 80% or more, not yet exceeded
 }
 
 // HealthRow represents system health status.
@@ -70,6 +132,18 @@ type HealthRow struct {
 	IsPaused        bool
 	PauseReason     string
 	HeartbeatFresh  bool // true if < 5min old
+	Jobs            []ScheduledJobRow
+}
+
+// ScheduledJobRow is one deacon maintenance job in the health panel.
+type ScheduledJobRow struct {
+	Name    string
+	Cron    string
+	LastRun string // e.g., "3h ago", or "never"
+	NextRun string // e.g., "in 21h", or "—" when not scheduled
+	Failed  bool
+	Error   string
+	Result  string
 }
 
 // QueueRow represents a work queue.
@@ -144,6 +218,8 @@ type DashboardSummary struct {
 	UnackedEscalations int
 	DeadSessions       int // Sessions that died recently
 	HighPriorityIssues int // P1/P2 issues
+	SevereFindings     int // Critical/high witness findings
+	OverBudget         int // Daily usage budgets exceeded
 
 	// Computed
 	HasAlerts bool
@@ -186,6 +262,11 @@ type MergeQueueRow struct {
 	CIStatus   string // "pass", "fail", "pending"
 	Mergeable  string // "ready", "conflict", "pending"
 	ColorClass string // "mq-green", "mq-yellow", "mq-red"
+
+	// Queue ordering from the matching MR bead, if any
+	Priority string // e.g., "P1"; empty when no MR bead tracks the PR
+	Bumped   bool   // Bumped ahead with gt mq bump
+	Held     bool   // Held with gt mq hold; the refinery skips it
 }
 
 // ConvoyRow represents a single convoy in the dashboard.
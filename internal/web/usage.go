@@ -0,0 +1,96 @@
+package web
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/monitoring"
+)
+
+// usagePanelRows is how many agents and beads the usage panel lists.
+const usagePanelRows = 8
+
+// UsageFetcher is implemented by fetchers that can report token usage.
+type UsageFetcher interface {
+	FetchUsage() (*UsagePanel, error)
+}
+
+// FetchUsage returns today's token spend and the state of the daily
+// budgets. It returns nil when nothing has been recorded today.
+func (f *LiveConvoyFetcher) FetchUsage() (*UsagePanel, error) {
+	records, err := monitoring.NewUsageLedger(f.townRoot).Records(monitoring.StartOfDay(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	var budgets *config.UsageBudgets
+	if settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(f.townRoot)); err == nil {
+		budgets = settings.UsageBudgets
+	}
+	if len(records) == 0 && budgets == nil {
+		return nil, nil
+	}
+	return buildUsagePanel(monitoring.SummarizeUsage(records), budgets), nil
+}
+
+func buildUsagePanel(today *monitoring.UsageSummary, budgets *config.UsageBudgets) *UsagePanel {
+	panel := &UsagePanel{
+		TotalCost:   formatUSD(today.Total.CostUSD),
+		TotalTokens: monitoring.FormatTokens(today.Total.TotalTokens()),
+		Agents:      usageRows(today.ByAgent, formatAgentAddress),
+		Beads:       usageRows(today.ByBead, func(s string) string { return s }),
+	}
+	for _, b := range monitoring.CheckBudgets(today, budgets) {
+		name := b.Scope
+		if b.Key != "" {
+			name = b.Key
+			if b.Scope == monitoring.BudgetScopeAgent {
+				name = formatAgentAddress(b.Key)
+			}
+		}
+		panel.Budgets = append(panel.Budgets, BudgetRow{
+			Name:     name,
+			Scope:    b.Scope,
+			Spent:    formatUSD(b.SpentUSD),
+			Limit:    formatUSD(b.LimitUSD),
+			Percent:  int(b.Fraction()*100 + 0.5),
+			Exceeded: b.Exceeded(),
+			Warning:  b.Warning(),
+		})
+	}
+	return panel
+}
+
+func usageRows(totals []monitoring.UsageTotal, name func(string) string) []UsageRow {
+	if len(totals) > usagePanelRows {
+		totals = totals[:usagePanelRows]
+	}
+	rows := make([]UsageRow, 0, len(totals))
+	for _, t := range totals {
+		rows = append(rows, UsageRow{
+			Name:   name(t.Key),
+			Cost:   formatUSD(t.CostUSD),
+			Tokens: monitoring.FormatTokens(t.TotalTokens()),
+		})
+	}
+	return rows
+}
+
+func formatUSD(v float64) string {
+	return fmt.Sprintf("$%.2f", v)
+}
+
+// addUsage counts exceeded budgets as alerts.
+func (s *DashboardSummary) addUsage(panel *UsagePanel) {
+	if panel == nil {
+		return
+	}
+	for _, b := range panel.Budgets {
+		if b.Exceeded {
+			s.OverBudget++
+		}
+	}
+	if s.OverBudget > 0 {
+		s.HasAlerts = true
+	}
+}
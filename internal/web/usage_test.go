@@ -0,0 +1,69 @@
+package web
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/monitoring"
+)
+
+func TestFetchUsageWithBudgets(t *testing.T) {
+	townRoot := t.TempDir()
+	settings := config.NewTownSettings()
+	settings.UsageBudgets = &config.UsageBudgets{DailyUSD: 20, AgentDailyUSD: 10}
+	if err := config.SaveTownSettings(config.TownSettingsPath(townRoot), settings); err != nil {
+		t.Fatal(err)
+	}
+	if err := monitoring.NewUsageLedger(townRoot).Append(
+		monitoring.UsageRecord{Time: time.Now(), Agent: "gastown/polecats/nux", Rig: "gastown", Bead: "gt-abc",
+			TokenUsage: monitoring.TokenUsage{OutputTokens: 1_500_000, CostUSD: 12.5}},
+		monitoring.UsageRecord{Time: time.Now().Add(-48 * time.Hour), Agent: "gastown/polecats/toast", Rig: "gastown",
+			TokenUsage: monitoring.TokenUsage{CostUSD: 99}},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	f := &LiveConvoyFetcher{townRoot: townRoot}
+	panel, err := f.FetchUsage()
+	if err != nil {
+		t.Fatalf("FetchUsage: %v", err)
+	}
+	if panel.TotalCost != "$12.50" || panel.TotalTokens != "1.5M" {
+		t.Errorf("totals = %s, %s; want today's usage only", panel.TotalCost, panel.TotalTokens)
+	}
+	if len(panel.Agents) != 1 || panel.Agents[0].Name != "nux (gastown)" || len(panel.Beads) != 1 {
+		t.Errorf("rows = %+v, %+v", panel.Agents, panel.Beads)
+	}
+	if len(panel.Budgets) != 2 || !panel.Budgets[0].Exceeded || panel.Budgets[0].Percent != 125 ||
+		panel.Budgets[1].Scope != "town" || panel.Budgets[1].Exceeded {
+		t.Errorf("budgets = %+v, want nux exceeded then town", panel.Budgets)
+	}
+
+	summary := &DashboardSummary{}
+	summary.addUsage(panel)
+	if summary.OverBudget != 1 || !summary.HasAlerts {
+		t.Errorf("summary = %+v, want 1 over budget and alerts", summary)
+	}
+
+	tmpl, err := LoadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "convoy.html", ConvoyData{Usage: panel, Summary: summary}); err != nil {
+		t.Fatalf("ExecuteTemplate: %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "gt-abc") || !strings.Contains(out, "1 over budget") {
+		t.Error("dashboard should show bead usage and the budget alert")
+	}
+}
+
+func TestFetchUsageEmpty(t *testing.T) {
+	f := &LiveConvoyFetcher{townRoot: t.TempDir()}
+	if panel, err := f.FetchUsage(); err != nil || panel != nil {
+		t.Errorf("FetchUsage = %+v, %v; want nil with no usage and no budgets", panel, err)
+	}
+}